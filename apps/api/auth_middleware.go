@@ -14,8 +14,22 @@ import (
 	tenantsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
 )
 
+// buildServiceTokenSigner returns the signer for service account tokens, or nil when SERVICE_TOKEN_SIGNING_KEY is unset.
+func buildServiceTokenSigner(cfg config, logger *zap.Logger) *platformauth.ServiceTokenSigner {
+	if cfg.ServiceTokenKey == "" {
+		return nil
+	}
+	signer, err := platformauth.NewServiceTokenSigner([]byte(cfg.ServiceTokenKey), cfg.ServiceTokenTTL)
+	if err != nil {
+		logger.Fatal("init service token signer", zap.Error(err))
+	}
+	return signer
+}
+
 // buildAuthMiddleware constructs the JWT middleware with tenant claim enforcement and external->internal tenant mapping.
-func buildAuthMiddleware(ctx context.Context, cfg config, tenantService *tenantsservice.Service, logger *zap.Logger) func(http.Handler) http.Handler {
+// Service account tokens are verified with serviceTokens when it is set. Revoked credentials are rejected by
+// platformauth.RejectRevoked, mounted after every middleware that authenticates.
+func buildAuthMiddleware(ctx context.Context, cfg config, tenantService *tenantsservice.Service, serviceTokens *platformauth.ServiceTokenSigner, logger *zap.Logger) func(http.Handler) http.Handler {
 	var verify platformauth.VerifyFunc
 	switch cfg.AuthProvider {
 	case "firebase":
@@ -30,6 +44,7 @@ func buildAuthMiddleware(ctx context.Context, cfg config, tenantService *tenants
 	default:
 		logger.Fatal("unsupported auth provider", zap.String("provider", cfg.AuthProvider))
 	}
+	verify = platformauth.ServiceTokenVerifier(serviceTokens, verify)
 
 	authExtractor := func(claims map[string]interface{}) (*platformauth.UserCredentials, error) {
 		creds, err := platformauth.DefaultCredentialExtractor(claims)
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/caarlos0/env/v11"
+	"github.com/redis/go-redis/v9"
+
+	platformconfig "github.com/zenGate-Global/palmyra-pro-saas/platform/go/config"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/doctor"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/errorreporting"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/kms"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	platformmiddleware "github.com/zenGate-Global/palmyra-pro-saas/platform/go/middleware"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/scheduler"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// checkTimeout bounds each check of --check-config.
+const checkTimeout = 10 * time.Second
+
+// runConfigCheck backs --check-config: it validates the environment, reaches the database and storage and loads the
+// contracts without starting the server, prints the readiness report to out and reports whether it is ready.
+func runConfigCheck(ctx context.Context, out io.Writer) bool {
+	var cfg config
+	parseErr := env.Parse(&cfg)
+	checks := []doctor.Check{
+		doctor.Errors("environment", "every variable is valid", func() []error {
+			if parseErr != nil {
+				return []error{parseErr}
+			}
+			return validateConfig(cfg)
+		}),
+	}
+	// Without the required variables there is nothing to connect to.
+	if parseErr == nil {
+		checks = append(checks, doctor.Database("database", cfg.DatabaseURL))
+		if cfg.DatabaseReadURL != "" {
+			checks = append(checks, doctor.Database("database (read replica)", cfg.DatabaseReadURL))
+		}
+		checks = append(checks,
+			doctor.Migrations(cfg.DatabaseURL, tenant.BuildSchemaName(cfg.EnvKey, tenant.ToSnake(cfg.AdminTenantSlug))),
+			doctor.Contracts("contracts", contractFiles()),
+			doctor.Errors("generated contracts", fmt.Sprintf("%d embedded contract(s) load", len(swaggerLoaders)), loadGeneratedContracts),
+			storageCheck(cfg),
+		)
+	}
+
+	report := doctor.Run(ctx, checkTimeout, checks...)
+	report.Write(out)
+	return report.Ready()
+}
+
+// validateConfig returns every invalid or missing variable of cfg, so a deployment learns about all of them at once.
+func validateConfig(cfg config) []error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	oneOf := func(name, value string, allowed ...string) bool {
+		for _, candidate := range allowed {
+			if value == candidate {
+				return true
+			}
+		}
+		invalid("invalid %s %q (use %s)", name, value, strings.Join(allowed, ", "))
+		return false
+	}
+
+	if port, err := strconv.Atoi(cfg.Port); err != nil || port <= 0 || port > 65535 {
+		invalid("invalid PORT %q", cfg.Port)
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		invalid("SHUTDOWN_TIMEOUT must be positive")
+	}
+	if cfg.RequestTimeout <= 0 {
+		invalid("REQUEST_TIMEOUT must be positive")
+	}
+	if _, err := platformlogging.ParseLevel(cfg.LogLevel); err != nil {
+		invalid("invalid LOG_LEVEL: %v", err)
+	}
+	if cfg.AuditLogOutput != "" {
+		if _, err := platformlogging.ParseAuditRoutes(cfg.AuditLogRoutes); err != nil {
+			invalid("invalid AUDIT_LOG_ROUTES: %v", err)
+		}
+	}
+	if strings.TrimSpace(cfg.EnvKey) == "" {
+		invalid("ENV_KEY is required")
+	}
+	oneOf("AUTH_PROVIDER", cfg.AuthProvider, "firebase", "dev")
+	if err := platformmiddleware.CheckCORSOrigins(cfg.CORSOrigins, cfg.CORSCredentials); err != nil {
+		invalid("invalid CORS_ALLOWED_ORIGINS with CORS_ALLOW_CREDENTIALS=true: %v", err)
+	}
+
+	if oneOf("STORAGE_BACKEND", cfg.StorageBackend, "gcs", "local") {
+		if cfg.StorageBackend == "gcs" && strings.TrimSpace(cfg.StorageBucket) == "" {
+			invalid("STORAGE_BUCKET is required when STORAGE_BACKEND=gcs")
+		}
+		if cfg.StorageBackend == "local" && strings.TrimSpace(cfg.StorageLocalDir) == "" {
+			invalid("STORAGE_LOCAL_DIR is required when STORAGE_BACKEND=local")
+		}
+	}
+	for _, pct := range cfg.StorageAlertPcts {
+		if pct <= 0 {
+			invalid("invalid STORAGE_USAGE_ALERT_PERCENTS (use positive percentages)")
+			break
+		}
+	}
+
+	if oneOf("EVENTS_PUBLISHER", cfg.EventsPublisher, "none", "log", "pubsub", "nats") &&
+		cfg.EventsPublisher == "pubsub" && strings.TrimSpace(cfg.PubSubProjectID) == "" {
+		invalid("PUBSUB_PROJECT_ID is required when EVENTS_PUBLISHER=pubsub")
+	}
+	if oneOf("SCHEMA_CACHE", cfg.SchemaCache, "none", "memory", "redis") && cfg.SchemaCache == "redis" {
+		if _, err := redis.ParseURL(cfg.RedisURL); err != nil {
+			invalid("invalid REDIS_URL: %v", err)
+		}
+	}
+	oneOf("RESPONSE_VALIDATION", cfg.ResponseCheck, "off", "log", "fail")
+	if cfg.ResponseCheck == "fail" && cfg.EnvKey == "prod" {
+		invalid("RESPONSE_VALIDATION=fail is for dev and staging; use log or off when ENV_KEY=prod")
+	}
+	oneOf("SEARCH_BACKEND", cfg.SearchBackend, "none", "opensearch")
+	oneOf("ANCHOR_PROVIDER", cfg.AnchorProvider, "none", "mock")
+
+	if oneOf("MAILER", cfg.Mailer, "log", "smtp", "sendgrid") {
+		if cfg.Mailer == "smtp" && (cfg.SMTPAddr == "" || cfg.SMTPFrom == "") {
+			invalid("SMTP_ADDR and SMTP_FROM are required when MAILER=smtp")
+		}
+		if cfg.Mailer == "sendgrid" && (cfg.SendGridAPIKey == "" || cfg.SendGridFrom == "") {
+			invalid("SENDGRID_API_KEY and SENDGRID_FROM are required when MAILER=sendgrid")
+		}
+	}
+
+	if oneOf("ENCRYPTION_KEY_MANAGER", cfg.EncryptionKeys, "none", "local", "gcp") {
+		switch cfg.EncryptionKeys {
+		case "local":
+			masterKey, err := base64.StdEncoding.DecodeString(cfg.EncryptLocalKey)
+			if err == nil {
+				_, err = kms.NewLocalKeyManager(masterKey)
+			}
+			if err != nil {
+				invalid("invalid ENCRYPTION_LOCAL_KEY (use a base64 encoded 32 byte key)")
+			}
+		case "gcp":
+			if strings.TrimSpace(cfg.EncryptKMSKey) == "" {
+				invalid("ENCRYPTION_KMS_KEY is required when ENCRYPTION_KEY_MANAGER=gcp")
+			}
+		}
+	}
+
+	for _, schedule := range []struct{ name, value string }{
+		{"RETENTION_CLEANUP_SCHEDULE", cfg.RetentionSchedule},
+		{"USAGE_METERING_SCHEDULE", cfg.UsageSchedule},
+		{"PROVISIONING_RECONCILE_SCHEDULE", cfg.ReconcileSchedule},
+	} {
+		if strings.TrimSpace(schedule.value) == "" {
+			continue
+		}
+		if _, err := scheduler.Parse(schedule.value); err != nil {
+			invalid("invalid %s: %v", schedule.name, err)
+		}
+	}
+
+	if cfg.ErrorReportingDSN != "" && cfg.ErrorReportingDSN != "gcp" {
+		if _, err := errorreporting.NewSentryTransport(errorreporting.SentryConfig{DSN: cfg.ErrorReportingDSN}); err != nil {
+			invalid("invalid ERROR_REPORTING_DSN: %v", err)
+		}
+	}
+	if cfg.ReloadPath != "" {
+		if _, err := platformconfig.Load(cfg.ReloadPath); err != nil {
+			invalid("invalid CONFIG_RELOAD_PATH: %v", err)
+		}
+	}
+	if cfg.ReloadInterval < 0 {
+		invalid("CONFIG_RELOAD_INTERVAL must not be negative")
+	}
+	if cfg.TraceSampleRatio < 0 || cfg.TraceSampleRatio > 1 {
+		invalid("OTEL_TRACES_SAMPLE_RATIO must be between 0 and 1")
+	}
+	return errs
+}
+
+// contractFiles lists the contract files the api server mounts, relative to the contracts directory.
+func contractFiles() []string {
+	files := make([]string, 0, len(swaggerLoaders))
+	for path := range swaggerLoaders {
+		files = append(files, strings.TrimPrefix(path, "contracts/"))
+	}
+	return files
+}
+
+// loadGeneratedContracts loads the contracts embedded by the code generator, which the request validators use, and
+// reads the schedules of their deprecated operations.
+func loadGeneratedContracts() []error {
+	var errs []error
+	for path, load := range swaggerLoaders {
+		spec, err := load()
+		if err == nil {
+			_, err = platformmiddleware.DeprecatedOperations(spec)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errs
+}
+
+// storageCheck verifies the credentials of STORAGE_BACKEND: listing the gcs bucket, or writing to the local directory.
+func storageCheck(cfg config) doctor.Check {
+	switch cfg.StorageBackend {
+	case "gcs":
+		return doctor.Check{Name: "storage", Run: func(ctx context.Context) (string, error) {
+			detail := "gcs bucket " + cfg.StorageBucket
+			if cfg.StorageBucket == "" {
+				return "", errors.New("STORAGE_BUCKET is empty")
+			}
+			client, err := gcs.NewClient(ctx)
+			if err != nil {
+				return detail, fmt.Errorf("init gcs client: %w", err)
+			}
+			defer client.Close()
+			return detail, storage.NewGCSObjectStore(client, cfg.StorageBucket).Probe(ctx)
+		}}
+	case "local":
+		if strings.TrimSpace(cfg.StorageLocalDir) == "" {
+			break
+		}
+		return doctor.Probe("storage", "local directory "+cfg.StorageLocalDir, storage.NewLocalObjectStore(cfg.StorageLocalDir))
+	}
+	return doctor.Check{Name: "storage", Run: func(context.Context) (string, error) {
+		return "", fmt.Errorf("no usable storage backend %q", cfg.StorageBackend)
+	}}
+}
@@ -5,9 +5,13 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"sync"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/openapi"
 )
 
 // docSpecs maps public documentation names to their contract files.
@@ -60,6 +64,7 @@ const swaggerUITemplate = `<!doctype html>
 
 func registerDocsRoutes(router chi.Router, logger *zap.Logger) {
 	router.Get("/docs", docsUIHandler())
+	router.Get("/docs/openapi.json", mergedOpenAPIHandler(logger))
 	router.Get("/openapi/{name}.json", openapiJSONHandler(logger))
 }
 
@@ -97,6 +102,53 @@ func openapiJSONHandler(logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
+// mergedOpenAPIHandler serves one document covering every domain in swaggerLoaders. It is merged on the first
+// request and reused, since the embedded contracts cannot change while the server runs.
+func mergedOpenAPIHandler(logger *zap.Logger) http.HandlerFunc {
+	merged := sync.OnceValues(func() ([]byte, error) {
+		paths := make([]string, 0, len(swaggerLoaders))
+		for path := range swaggerLoaders {
+			paths = append(paths, path)
+		}
+		// A stable order keeps the names of renamed components stable between releases.
+		sort.Strings(paths)
+
+		specs := make([]openapi.Spec, 0, len(paths))
+		for _, path := range paths {
+			doc, err := swaggerLoaders[path]()
+			if err != nil {
+				return nil, fmt.Errorf("load %s: %w", path, err)
+			}
+			// contracts/v2/users.yaml is named v2/users, so its renamed components do not clash with v1's.
+			name := strings.TrimSuffix(strings.TrimPrefix(path, "contracts/"), ".yaml")
+			specs = append(specs, openapi.Spec{Name: name, Doc: doc})
+		}
+
+		doc, err := openapi.Merge(&openapi3.Info{
+			Title:       "Palmyra Pro API",
+			Version:     "1.0.0",
+			Description: "Every Palmyra Pro domain API in one document.",
+		}, specs)
+		if err != nil {
+			return nil, err
+		}
+		return doc.MarshalJSON()
+	})
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, err := merged()
+		if err != nil {
+			logger.Error("merge openapi specs", zap.Error(err))
+			http.Error(w, "failed to merge OpenAPI", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(b)
+	}
+}
+
 func buildDocSpecsList() string {
 	names := make([]string, 0, len(docSpecs))
 	for name := range docSpecs {
@@ -105,10 +157,9 @@ func buildDocSpecsList() string {
 	sort.Strings(names)
 
 	var builder strings.Builder
-	for i, name := range names {
-		if i > 0 {
-			builder.WriteString(",\n")
-		}
+	builder.WriteString("        { url: '/docs/openapi.json', name: 'all domains' }")
+	for _, name := range names {
+		builder.WriteString(",\n")
 		builder.WriteString(fmt.Sprintf("        { url: '/openapi/%s.json', name: '%s' }", name, name))
 	}
 	return builder.String()
@@ -2,52 +2,118 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"maps"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
-	"os/signal"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
-	"cloud.google.com/go/storage"
+	"cloud.google.com/go/pubsub/v2"
+	gcs "cloud.google.com/go/storage"
 	"github.com/caarlos0/env/v11"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/nats-io/nats.go"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
+	attachmentshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/attachments/be/handler"
+	attachmentsrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/attachments/be/repo"
+	attachmentsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/attachments/be/service"
+	authhandler "github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/handler"
+	authrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/repo"
+	authservice "github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/service"
 	entitieshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/handler"
+	entitiesmiddleware "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/middleware"
 	entitiesrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/repo"
 	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	featureshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/features/be/handler"
+	featuresrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/features/be/repo"
+	featuresservice "github.com/zenGate-Global/palmyra-pro-saas/domains/features/be/service"
+	graphqlhandler "github.com/zenGate-Global/palmyra-pro-saas/domains/graphql/be/handler"
+	graphqlrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/graphql/be/repo"
+	graphqlservice "github.com/zenGate-Global/palmyra-pro-saas/domains/graphql/be/service"
+	jobshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/handler"
+	jobsrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/repo"
+	jobsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/service"
+	logginghandler "github.com/zenGate-Global/palmyra-pro-saas/domains/logging/be/handler"
+	loggingservice "github.com/zenGate-Global/palmyra-pro-saas/domains/logging/be/service"
+	privacyhandler "github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/handler"
+	privacyrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/repo"
+	privacyservice "github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/service"
+	schedulerhandler "github.com/zenGate-Global/palmyra-pro-saas/domains/scheduler/be/handler"
+	schedulerrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/scheduler/be/repo"
+	schedulerservice "github.com/zenGate-Global/palmyra-pro-saas/domains/scheduler/be/service"
 	schemacategorieshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/handler"
 	schemacategoriesrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/repo"
 	schemacategoriesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/service"
 	schemarepositoryhandler "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/handler"
 	schemarepositoryrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/repo"
 	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	statshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/stats/be/handler"
+	statsrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/stats/be/repo"
+	statsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/stats/be/service"
 	tenantshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/handler"
 	tenantsprov "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/provisioning"
 	tenantsrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/repo"
 	tenantsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
 	usershandler "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/handler"
+	usersmiddleware "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/middleware"
+	usersprov "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/provisioning"
 	usersrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/repo"
 	usersservice "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	webhookshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/handler"
+	webhooksrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/repo"
+	webhooksservice "github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/service"
+	attachmentsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/attachments"
 	authapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/auth"
 	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	featuresapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/features"
+	graphqlapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/graphql"
+	jobsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/jobs"
+	loggingapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/logging"
+	privacyapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/privacy"
+	entitiesv1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/proto/palmyra/entities/v1"
+	schemarepositoryv1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/proto/palmyra/schemarepository/v1"
+	schedulerapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/scheduler"
 	schemacategories "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-categories"
 	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	statsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/stats"
 	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
 	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	webhooksapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/webhooks"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/anchoring"
 	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	platformconfig "github.com/zenGate-Global/palmyra-pro-saas/platform/go/config"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/errorreporting"
+	platformevents "github.com/zenGate-Global/palmyra-pro-saas/platform/go/events"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/features"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/gcp"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/kms"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/lifecycle"
 	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
 	platformmiddleware "github.com/zenGate-Global/palmyra-pro-saas/platform/go/middleware"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/rpc"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/search"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/telemetry"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 	tenantmiddleware "github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant/middleware"
 )
@@ -59,35 +125,154 @@ var swaggerLoaders = map[string]func() (*openapi3.T, error){
 	"contracts/schema-repository.yaml": schemarepository.GetSwagger,
 	"contracts/users.yaml":             users.GetSwagger,
 	"contracts/tenants.yaml":           tenantsapi.GetSwagger,
+	"contracts/webhooks.yaml":          webhooksapi.GetSwagger,
+	"contracts/attachments.yaml":       attachmentsapi.GetSwagger,
+	"contracts/jobs.yaml":              jobsapi.GetSwagger,
+	"contracts/scheduler.yaml":         schedulerapi.GetSwagger,
+	"contracts/privacy.yaml":           privacyapi.GetSwagger,
+	"contracts/graphql.yaml":           graphqlapi.GetSwagger,
+	"contracts/features.yaml":          featuresapi.GetSwagger,
+	"contracts/stats.yaml":             statsapi.GetSwagger,
+	"contracts/logging.yaml":           loggingapi.GetSwagger,
+}
+
+func init() {
+	// Bulk imports accept newline-delimited JSON; the request validator only needs to see it as an opaque string.
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.PlainBodyDecoder)
 }
 
 type config struct {
-	Port            string        `env:"PORT" envDefault:"3000"`
-	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"10s"`
-	RequestTimeout  time.Duration `env:"REQUEST_TIMEOUT" envDefault:"15s"`
-	LogLevel        string        `env:"LOG_LEVEL" envDefault:"info"`
-	DatabaseURL     string        `env:"DATABASE_URL,required"`
-	AuthProvider    string        `env:"AUTH_PROVIDER" envDefault:"firebase"`
-	EnvKey          string        `env:"ENV_KEY,required"`
-	AdminTenantSlug string        `env:"ADMIN_TENANT_SLUG" envDefault:"admin"`
-	StorageBackend  string        `env:"STORAGE_BACKEND" envDefault:"gcs"`               // gcs | local
-	StorageBucket   string        `env:"STORAGE_BUCKET"`                                 // required when STORAGE_BACKEND=gcs
-	StorageLocalDir string        `env:"STORAGE_LOCAL_DIR" envDefault:"./.data/storage"` // used when STORAGE_BACKEND=local
+	Port              string        `env:"PORT" envDefault:"3000"`
+	GRPCPort          string        `env:"GRPC_PORT"` // empty disables the gRPC server
+	ShutdownTimeout   time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"10s"`
+	RequestTimeout    time.Duration `env:"REQUEST_TIMEOUT" envDefault:"15s"`
+	MaxBodyBytes      int64         `env:"MAX_REQUEST_BODY_BYTES" envDefault:"10485760"` // 0 disables the limit
+	ResponseCheck     string        `env:"RESPONSE_VALIDATION" envDefault:"off"`         // off | log | fail (dev and staging)
+	LogLevel          string        `env:"LOG_LEVEL" envDefault:"info"`
+	AuditLogOutput    string        `env:"AUDIT_LOG_OUTPUT"` // stdout | stderr | file path; empty disables audit entries
+	AuditLogRoutes    []string      `env:"AUDIT_LOG_ROUTES"` // comma-separated "METHOD /path/pattern" entries
+	AuditLogMaxBody   int           `env:"AUDIT_LOG_MAX_BODY_BYTES" envDefault:"4096"`
+	DatabaseURL       string        `env:"DATABASE_URL,required"`
+	DatabaseReadURL   string        `env:"DATABASE_READ_URL"`                    // optional read replica for list/get reads
+	StatementTimeout  time.Duration `env:"DB_STATEMENT_TIMEOUT" envDefault:"0s"` // 0 = only the request deadline
+	LockTimeout       time.Duration `env:"DB_LOCK_TIMEOUT" envDefault:"0s"`      // 0 = server default
+	AuthProvider      string        `env:"AUTH_PROVIDER" envDefault:"firebase"`
+	EnvKey            string        `env:"ENV_KEY,required"`
+	AdminTenantSlug   string        `env:"ADMIN_TENANT_SLUG" envDefault:"admin"`
+	StorageBackend    string        `env:"STORAGE_BACKEND" envDefault:"gcs"`               // gcs | local
+	StorageBucket     string        `env:"STORAGE_BUCKET"`                                 // required when STORAGE_BACKEND=gcs
+	StorageLocalDir   string        `env:"STORAGE_LOCAL_DIR" envDefault:"./.data/storage"` // used when STORAGE_BACKEND=local
+	StorageLocalURL   string        `env:"STORAGE_LOCAL_URL" envDefault:"http://localhost:3000/storage/local"`
+	StorageSigningKey string        `env:"STORAGE_LOCAL_SIGNING_KEY"` // random per process when empty
+	AttachmentURLTTL  time.Duration `env:"ATTACHMENT_URL_TTL" envDefault:"15m"`
+	AttachmentMaxSize int64         `env:"ATTACHMENT_MAX_BYTES" envDefault:"26214400"`
+	AttachmentTypes   []string      `env:"ATTACHMENT_CONTENT_TYPES" envDefault:"image/*,application/pdf"`
+	BackgroundWorkers bool          `env:"BACKGROUND_WORKERS" envDefault:"true"` // false when apps/worker runs the background loops
+	WebhookInterval   time.Duration `env:"WEBHOOK_DISPATCH_INTERVAL" envDefault:"5s"`
+	EventsPublisher   string        `env:"EVENTS_PUBLISHER" envDefault:"none"` // none | log | pubsub | nats
+	EventsPrefix      string        `env:"EVENTS_TOPIC_PREFIX" envDefault:"palmyra"`
+	EventsInterval    time.Duration `env:"EVENTS_RELAY_INTERVAL" envDefault:"2s"`
+	ProvisionInterval time.Duration `env:"PROVISIONING_WORKER_INTERVAL" envDefault:"5s"`
+	ProvisionWorkers  int           `env:"PROVISIONING_WORKER_CONCURRENCY" envDefault:"1"` // jobs (e.g. tenant migrations) run at once
+	JobsInterval      time.Duration `env:"JOBS_WORKER_INTERVAL" envDefault:"2s"`
+	JobsWorkers       int           `env:"JOBS_WORKER_CONCURRENCY" envDefault:"4"` // 0 leaves background jobs to other processes
+	SchedulerInterval time.Duration `env:"SCHEDULER_INTERVAL" envDefault:"30s"`
+	RetentionSchedule string        `env:"RETENTION_CLEANUP_SCHEDULE" envDefault:"0 3 * * *"` // cron (UTC); empty disables the task
+	RetentionWindow   time.Duration `env:"RETENTION_WINDOW" envDefault:"720h"`
+	UsageSchedule     string        `env:"USAGE_METERING_SCHEDULE" envDefault:"0 * * * *"`
+	ReconcileSchedule string        `env:"PROVISIONING_RECONCILE_SCHEDULE" envDefault:"*/15 * * * *"`
+	MaintenanceRetry  time.Duration `env:"TENANT_MAINTENANCE_RETRY_AFTER" envDefault:"2m"`
+	PartitionEntities bool          `env:"ENTITY_PARTITIONING" envDefault:"false"` // new entity tables partitioned by month
+	PartitionPremake  int           `env:"ENTITY_PARTITION_PREMAKE" envDefault:"3"`
+	PartitionRetain   int           `env:"ENTITY_PARTITION_RETENTION_MONTHS" envDefault:"0"` // 0 keeps every partition attached
+	PartitionDrop     bool          `env:"ENTITY_PARTITION_DROP_DETACHED" envDefault:"false"`
+	PartitionInterval time.Duration `env:"ENTITY_PARTITION_INTERVAL" envDefault:"6h"`
+	ArchiveAfterDays  int           `env:"ENTITY_ARCHIVE_AFTER_DAYS" envDefault:"0"` // 0 disables archiving cold versions
+	ArchiveBatchSize  int           `env:"ENTITY_ARCHIVE_BATCH_SIZE" envDefault:"500"`
+	ArchiveInterval   time.Duration `env:"ENTITY_ARCHIVE_INTERVAL" envDefault:"1h"`
+	AnchorProvider    string        `env:"ANCHOR_PROVIDER" envDefault:"none"` // none | mock
+	AnchorInterval    time.Duration `env:"ANCHOR_INTERVAL" envDefault:"10m"`
+	AnchorBatchSize   int           `env:"ANCHOR_BATCH_SIZE" envDefault:"1000"`
+	SearchBackend     string        `env:"SEARCH_BACKEND" envDefault:"none"` // none | opensearch
+	OpenSearchURL     string        `env:"OPENSEARCH_URL" envDefault:"http://127.0.0.1:9200"`
+	OpenSearchUser    string        `env:"OPENSEARCH_USERNAME"`
+	OpenSearchPass    string        `env:"OPENSEARCH_PASSWORD"`
+	SearchPrefix      string        `env:"SEARCH_INDEX_PREFIX" envDefault:"palmyra-"` // followed by the tenant schema name
+	SearchInterval    time.Duration `env:"SEARCH_INDEXER_INTERVAL" envDefault:"2s"`
+	GraphQLMaxDepth   int           `env:"GRAPHQL_MAX_DEPTH" envDefault:"10"`
+	StorageUsageEvery time.Duration `env:"STORAGE_USAGE_INTERVAL" envDefault:"1h"` // 0 disables storage accounting
+	StorageAlertPcts  []int         `env:"STORAGE_USAGE_ALERT_PERCENTS" envDefault:"80,90,100"`
+	PrivacySigningKey string        `env:"PRIVACY_REPORT_SIGNING_KEY"` // empty disables erasure requests
+	ErasureAttempts   int           `env:"PRIVACY_ERASURE_MAX_ATTEMPTS" envDefault:"5"`
+	EncryptionKeys    string        `env:"ENCRYPTION_KEY_MANAGER" envDefault:"none"` // none | local | gcp
+	EncryptLocalKey   string        `env:"ENCRYPTION_LOCAL_KEY"`                     // base64 AES-256 key; required when local
+	EncryptKMSKey     string        `env:"ENCRYPTION_KMS_KEY"`                       // crypto key resource; required when gcp
+	PubSubProjectID   string        `env:"PUBSUB_PROJECT_ID"`                        // required when EVENTS_PUBLISHER=pubsub
+	NATSURL           string        `env:"NATS_URL" envDefault:"nats://127.0.0.1:4222"`
+	SchemaCache       string        `env:"SCHEMA_CACHE" envDefault:"none"` // none | memory | redis
+	SchemaCacheTTL    time.Duration `env:"SCHEMA_CACHE_TTL" envDefault:"1m"`
+	RedisURL          string        `env:"REDIS_URL" envDefault:"redis://127.0.0.1:6379/0"`
+	Mailer            string        `env:"MAILER" envDefault:"log"` // log | smtp | sendgrid
+	SMTPAddr          string        `env:"SMTP_ADDR"`               // required when MAILER=smtp
+	SMTPFrom          string        `env:"SMTP_FROM"`               // required when MAILER=smtp
+	SMTPUsername      string        `env:"SMTP_USERNAME"`
+	SMTPPassword      string        `env:"SMTP_PASSWORD"`
+	SendGridAPIKey    string        `env:"SENDGRID_API_KEY"` // required when MAILER=sendgrid
+	SendGridFrom      string        `env:"SENDGRID_FROM"`    // required when MAILER=sendgrid
+	SendGridFromName  string        `env:"SENDGRID_FROM_NAME"`
+	InvitationTTL     time.Duration `env:"INVITATION_TTL" envDefault:"168h"`
+	InvitationURL     string        `env:"INVITATION_ACCEPT_URL"`
+	UserSyncInterval  time.Duration `env:"USER_SYNC_INTERVAL" envDefault:"1h"` // 0 disables the background sync
+	OTLPEndpoint      string        `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`        // empty disables span export
+	OTELServiceName   string        `env:"OTEL_SERVICE_NAME" envDefault:"palmyra-api"`
+	TraceSampleRatio  float64       `env:"OTEL_TRACES_SAMPLE_RATIO" envDefault:"1"`
+	ErrorReportingDSN string        `env:"ERROR_REPORTING_DSN"` // Sentry DSN, or "gcp" for GCP Error Reporting; empty disables
+	ErrorRelease      string        `env:"ERROR_REPORTING_RELEASE"`
+	CORSOrigins       []string      `env:"CORS_ALLOWED_ORIGINS" envDefault:"*"` // comma-separated; tenants may add their own
+	CORSMethods       []string      `env:"CORS_ALLOWED_METHODS" envDefault:"GET,POST,PUT,PATCH,DELETE,OPTIONS"`
+	CORSHeaders       []string      `env:"CORS_ALLOWED_HEADERS" envDefault:"Authorization,Content-Type,Content-Encoding,Idempotency-Key,If-Match,X-API-Key,X-Palmyra-Tenant,X-Palmyra-Impersonate-Tenant"`
+	CORSCredentials   bool          `env:"CORS_ALLOW_CREDENTIALS" envDefault:"false"`
+	CORSMaxAge        time.Duration `env:"CORS_MAX_AGE" envDefault:"10m"`
+	ServiceTokenKey   string        `env:"SERVICE_TOKEN_SIGNING_KEY"` // empty disables service account tokens
+	ServiceTokenTTL   time.Duration `env:"SERVICE_TOKEN_TTL" envDefault:"1h"`
+	RevocationRefresh time.Duration `env:"TOKEN_REVOCATION_REFRESH" envDefault:"5s"`
+	RevocationTTL     time.Duration `env:"TOKEN_REVOCATION_RETENTION" envDefault:"24h"` // must outlast the revoked tokens
+	TenantCacheTTL    time.Duration `env:"TENANT_CACHE_TTL" envDefault:"5m"`            // fallback; changes invalidate immediately
+	FeatureCacheTTL   time.Duration `env:"FEATURE_FLAGS_CACHE_TTL" envDefault:"30s"`    // how long other processes serve stale flags
+	ReloadPath        string        `env:"CONFIG_RELOAD_PATH"`                          // .env file or ConfigMap directory of reloadable settings
+	ReloadInterval    time.Duration `env:"CONFIG_RELOAD_INTERVAL" envDefault:"0s"`      // 0 reloads on SIGHUP only
 }
 
 func main() {
 	ctx := context.Background()
 
+	checkConfig := flag.Bool("check-config", false, "Validate the configuration, database, contracts and storage, print a readiness report and exit")
+	flag.Parse()
+	if *checkConfig {
+		if !runConfigCheck(ctx, os.Stdout) {
+			os.Exit(1)
+		}
+		return
+	}
+
 	var cfg config
 	if err := env.Parse(&cfg); err != nil {
 		log.Fatalf("load config: %v", err)
 	}
+	if errs := validateConfig(cfg); len(errs) > 0 {
+		log.Fatalf("invalid config: %v", errors.Join(errs...))
+	}
 
 	adminSchema := tenant.BuildSchemaName(cfg.EnvKey, tenant.ToSnake(cfg.AdminTenantSlug))
 
+	// logLevels can be changed at runtime through /admin/logging/level, for every component or for one.
+	logLevels, err := platformlogging.NewLevels(cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("parse LOG_LEVEL: %v", err)
+	}
 	logger, err := platformlogging.NewLogger(platformlogging.Config{
 		Component: "api-server",
-		Level:     cfg.LogLevel,
+		Levels:    logLevels,
 	})
 	if err != nil {
 		log.Fatalf("init zap logger: %v", err)
@@ -96,15 +281,60 @@ func main() {
 		_ = logger.Sync()
 	}()
 
-	pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: cfg.DatabaseURL})
+	// components stops what main starts, in reverse order, once SIGINT or SIGTERM arrives.
+	components := lifecycle.New(logger)
+
+	requestAudit, err := buildRequestAudit(cfg)
+	if err != nil {
+		logger.Fatal("init request audit", zap.Error(err))
+	}
+	if requestAudit.Sink != nil {
+		components.OnClose("request audit", func() {
+			_ = requestAudit.Sink.Sync()
+		})
+	}
+
+	shutdownTracing, err := telemetry.Setup(ctx, telemetry.Config{
+		Endpoint:    cfg.OTLPEndpoint,
+		ServiceName: cfg.OTELServiceName,
+		SampleRatio: cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		logger.Fatal("init tracing", zap.Error(err))
+	}
+	components.OnStop("tracing", shutdownTracing)
+
+	errorReporter := buildErrorReporter(cfg, logger)
+	components.OnStop("error reporting", errorReporter.Close)
+
+	poolConfig := persistence.PoolConfig{ConnString: cfg.DatabaseURL}
+	if cfg.DatabaseReadURL != "" {
+		poolConfig.ReadPool = &persistence.PoolConfig{ConnString: cfg.DatabaseReadURL}
+	}
+	pool, err := persistence.NewPool(ctx, poolConfig)
 	if err != nil {
 		logger.Fatal("init postgres pool", zap.Error(err))
 	}
-	defer persistence.ClosePool(pool)
+	components.OnClose("postgres pool", func() {
+		persistence.ClosePool(pool)
+	})
+
+	readPool, err := persistence.NewReadPool(ctx, poolConfig)
+	if err != nil {
+		logger.Fatal("init postgres read pool", zap.Error(err))
+	}
+	components.OnClose("postgres read pool", func() {
+		persistence.ClosePool(readPool)
+	})
 
 	spaceDB := persistence.NewSpaceDB(persistence.SpaceDBConfig{
 		Pool:        pool,
+		ReadPool:    readPool,
 		AdminSchema: adminSchema,
+		Timeouts: persistence.QueryTimeouts{
+			Statement: cfg.StatementTimeout,
+			Lock:      cfg.LockTimeout,
+		},
 	})
 
 	categoryStore, err := persistence.NewSchemaCategoryStore(ctx, pool)
@@ -114,42 +344,78 @@ func main() {
 
 	categoryRepo := schemacategoriesrepo.NewPostgresRepository(spaceDB, categoryStore)
 	categoryService := schemacategoriesservice.New(categoryRepo)
-	categoryHTTPHandler := schemacategorieshandler.New(categoryService, logger)
+	categoryHTTPHandler := schemacategorieshandler.New(categoryService, logger.Named("schema-categories"))
 
 	schemaStore, err := persistence.NewSchemaRepositoryStore(ctx, pool)
 	if err != nil {
 		logger.Fatal("init schema repository store", zap.Error(err))
 	}
+	schemaCache, closeSchemaCache := buildSchemaCache(cfg, logger)
+	components.OnClose("schema cache", closeSchemaCache)
+	if schemaCache != nil {
+		schemaStore.UseCache(schemaCache)
+	}
 
-	schemaRepo := schemarepositoryrepo.NewPostgresRepository(spaceDB, schemaStore)
-	schemaService := schemarepositoryservice.New(schemaRepo)
-	schemaHTTPHandler := schemarepositoryhandler.New(schemaService, logger)
+	eventPublisher, closePublisher := buildEventPublisher(ctx, cfg, logger.Named("events"))
+	components.OnClose("event publisher", closePublisher)
+
+	// The outbox is only written when a publisher is configured; otherwise nothing would drain it.
+	var outboxStore *persistence.OutboxStore
+	if eventPublisher != nil {
+		outboxStore = persistence.NewOutboxStore()
+	}
 
 	tenantStore, err := persistence.NewTenantStore(ctx, pool, adminSchema)
 	if err != nil {
 		logger.Fatal("init tenant store", zap.Error(err))
 	}
+	if outboxStore != nil {
+		tenantStore.UseOutbox(outboxStore)
+	}
+
+	// Entity tables are created when schemas are activated and when tenants are provisioned or migrated.
+	entityPartitioning := persistence.EntityPartitioning{
+		Enabled:      cfg.PartitionEntities,
+		Premake:      cfg.PartitionPremake,
+		RetainMonths: cfg.PartitionRetain,
+		DropDetached: cfg.PartitionDrop,
+	}
+	entityTables := persistence.NewEntityTableStore(spaceDB, entityPartitioning)
 
 	tenantRepo := tenantsrepo.NewPostgresRepository(tenantStore)
 	dbProv := tenantsprov.NewDBProvisioner(pool, adminSchema)
+	dbProv.UseEntityPartitioning(entityPartitioning)
 	authProv := tenantsprov.NewAuthProvisioner()
-	var storageProv tenantsservice.StorageProvisioner
+	var (
+		storageProv  tenantsservice.StorageProvisioner
+		objectStore  storage.ObjectStore
+		signedStore  storage.SignedStore
+		prefixMeter  storage.PrefixMeter
+		localSigning *storage.LocalSignedStore
+	)
 	switch cfg.StorageBackend {
 	case "gcs":
 		if cfg.StorageBucket == "" {
 			logger.Fatal("storage bucket required when STORAGE_BACKEND=gcs")
 		}
-		gcsClient, err := storage.NewClient(ctx)
+		gcsClient, err := gcs.NewClient(ctx)
 		if err != nil {
 			logger.Fatal("init gcs client", zap.Error(err))
 		}
-		defer gcsClient.Close()
+		components.OnClose("gcs client", func() {
+			_ = gcsClient.Close()
+		})
 		storageProv = tenantsprov.NewGCSStorageProvisioner(gcsClient, cfg.StorageBucket)
+		gcsObjects := storage.NewGCSObjectStore(gcsClient, cfg.StorageBucket)
+		objectStore, signedStore, prefixMeter = gcsObjects, gcsObjects, gcsObjects
 	case "local":
 		if strings.TrimSpace(cfg.StorageLocalDir) == "" {
 			logger.Fatal("storage local dir required when STORAGE_BACKEND=local")
 		}
 		storageProv = tenantsprov.NewLocalStorageProvisioner(cfg.StorageLocalDir)
+		localObjects := storage.NewLocalObjectStore(cfg.StorageLocalDir)
+		localSigning = storage.NewLocalSignedStore(localObjects, cfg.StorageLocalURL, localSigningKey(cfg, logger), cfg.AttachmentMaxSize)
+		objectStore, signedStore, prefixMeter = localObjects, localSigning, localObjects
 	default:
 		logger.Fatal("invalid STORAGE_BACKEND (use gcs or local)", zap.String("backend", cfg.StorageBackend))
 	}
@@ -162,9 +428,35 @@ func main() {
 			Storage: storageProv,
 		},
 	)
-	tenantHTTPHandler := tenantshandler.New(tenantService, logger)
+	tenantService.UseJobs(tenantsrepo.NewPostgresJobRepository(persistence.NewTenantJobStore(pool, adminSchema)))
+	usageMeter := persistence.NewTenantUsageMeter(spaceDB)
+	tenantService.UseUsageMeter(usageMeter)
+	tenantService.UseCloner(persistence.NewTenantCloneStore(spaceDB))
+	schemaMigrator := persistence.NewMigrator(pool)
+	schemaMigrator.UseEntityTables(entityTables)
+	tenantService.UseMigrator(tenantsrepo.NewPostgresSchemaMigrator(schemaMigrator, adminSchema))
+	outgoingMail := buildMailer(cfg, logger.Named("mailer"))
+	tenantService.UseMailer(outgoingMail, logger)
+	tenantChanges := persistence.NewTenantChanges(pool)
+	tenantService.UseChangeNotifier(tenantChanges)
+	tenantSpaces := tenantmiddleware.NewTTLCache(cfg.TenantCacheTTL)
+	tenantHTTPHandler := tenantshandler.New(tenantService, logger.Named("tenants"))
+
+	schemaBundleStore := persistence.NewSchemaBundleStore(schemaStore, categoryStore)
+	schemaRepo := schemarepositoryrepo.NewPostgresRepository(spaceDB, schemaStore, schemaBundleStore, entityTables)
+	jobStore := persistence.NewJobStore(pool, adminSchema)
+	jobQueue := jobs.NewQueue(jobStore)
+	// Activation queues the entity tables of every tenant as a job rather than creating them within the request.
+	schemaService := schemarepositoryservice.New(schemaRepo, tenantService, jobQueue)
+	schemaHTTPHandler := schemarepositoryhandler.New(schemaService, logger.Named("schema-repository"))
 
-	authMiddleware := buildAuthMiddleware(ctx, cfg, tenantService, logger)
+	membershipStore := persistence.NewMembershipStore(pool, adminSchema)
+	authRepo := authrepo.NewPostgresRepository(membershipStore, persistence.NewTokenRevocationStore(pool, adminSchema))
+	revocations := platformauth.NewRevocationList(authservice.RevocationLoader(authRepo), cfg.RevocationRefresh, logger.Named("revocations"))
+
+	serviceTokens := buildServiceTokenSigner(cfg, logger)
+	authMiddleware := buildAuthMiddleware(ctx, cfg, tenantService, serviceTokens, logger)
+	rejectRevoked := platformauth.RejectRevoked(revocations)
 
 	schemaValidator := persistence.NewSchemaValidator()
 
@@ -173,25 +465,219 @@ func main() {
 		logger.Fatal("init user store", zap.Error(err))
 	}
 
-	userRepo := usersrepo.NewPostgresRepository(userStore)
-	userService := usersservice.New(userRepo)
-	userHTTPHandler := usershandler.New(userService, logger)
+	userRepo := usersrepo.NewPostgresRepository(userStore, persistence.NewRoleStore(spaceDB), persistence.NewInvitationStore(spaceDB), membershipStore, persistence.NewAPIKeyStore(spaceDB), persistence.NewServiceAccountStore(spaceDB), usersrepo.AttributeSchemas{
+		DB:        spaceDB,
+		Store:     schemaStore,
+		Validator: schemaValidator,
+	})
+	invitationDeps := buildInvitationDeps(ctx, cfg, outgoingMail, logger)
+	userService := usersservice.New(userRepo, usageMeter, invitationDeps)
+	userHTTPHandler := usershandler.New(userService, logger.Named("users"))
+
+	tokenDeps := authservice.ServiceTokenDeps{}
+	if serviceTokens != nil {
+		tokenDeps = authservice.ServiceTokenDeps{
+			Accounts: usersservice.NewServiceAccountAuthenticator(userRepo, tenantService),
+			Signer:   serviceTokens,
+		}
+	}
+	authService := authservice.New(authRepo, tokenDeps, authservice.RevocationDeps{
+		Cache:     revocations,
+		Retention: cfg.RevocationTTL,
+	})
+	authHTTPHandler := authhandler.New(authService, logger.Named("auth"))
+
+	webhookStore, err := persistence.NewWebhookStore(ctx, spaceDB)
+	if err != nil {
+		logger.Fatal("init webhook store", zap.Error(err))
+	}
+
+	webhookRepo := webhooksrepo.NewPostgresRepository(webhookStore)
+	webhookService := webhooksservice.New(webhookRepo)
+	webhookHTTPHandler := webhookshandler.New(webhookService, logger.Named("webhooks"))
+
+	// runInBackground starts a background loop unless BACKGROUND_WORKERS=false leaves them to apps/worker. The loops
+	// stop after the servers and before the stores and publishers they use.
+	runInBackground := func(name string, run func(context.Context)) {
+		if cfg.BackgroundWorkers {
+			components.Go(name, run)
+		}
+	}
+	webhookDispatcher := webhooksservice.NewDispatcher(webhookStore, tenantService, logger, webhooksservice.DispatcherConfig{
+		Interval: cfg.WebhookInterval,
+	})
+	runInBackground("webhook dispatcher", webhookDispatcher.Run)
+
+	provisioningWorker := tenantsservice.NewProvisioningWorker(tenantService, logger, tenantsservice.ProvisioningWorkerConfig{
+		Interval:    cfg.ProvisionInterval,
+		Concurrency: cfg.ProvisionWorkers,
+	})
+	runInBackground("provisioning worker", provisioningWorker.Run)
+
+	if invitationDeps.Directory != nil && cfg.UserSyncInterval > 0 {
+		userSync := usersservice.NewSyncWorker(userService, tenantService, logger, usersservice.SyncWorkerConfig{
+			Interval: cfg.UserSyncInterval,
+		})
+		runInBackground("user sync", userSync.Run)
+	}
+
+	components.Go("tenant changes", func(ctx context.Context) {
+		tenantChanges.Run(ctx, tenantSpaces, func(err error) {
+			logger.Warn("tenant change listener failed", zap.Error(err))
+		})
+	})
+
+	entityChanges := persistence.NewEntityChangeHub(pool)
+	components.Go("entity changes", func(ctx context.Context) {
+		entityChanges.Run(ctx, func(err error) {
+			logger.Warn("entity change listener failed", zap.Error(err))
+		})
+	})
+
+	entityEvents := persistence.EntityEventSinks{webhookStore, persistence.NewEntityChangeNotifier()}
+	if outboxStore != nil {
+		entityEvents = append(entityEvents, outboxStore)
+		relay := platformevents.NewRelay(spaceDB, outboxStore, eventPublisher, tenantService, logger, platformevents.RelayConfig{
+			Interval: cfg.EventsInterval,
+		})
+		runInBackground("event relay", relay.Run)
+	}
+
+	searchIndex := buildSearchIndex(cfg, logger.Named("search"))
+	if searchIndex != nil {
+		searchOutbox := persistence.NewSearchOutboxStore()
+		entityEvents = append(entityEvents, searchOutbox)
+		indexer := search.NewIndexer(spaceDB, searchOutbox, searchIndex, tenantService, logger, search.IndexerConfig{
+			Interval: cfg.SearchInterval,
+		})
+		runInBackground("search indexer", indexer.Run)
+	}
+
+	// Writes never create partitions, so the maintenance runs even with partitioning off: tables partitioned while it
+	// was on still need their coming months.
+	partitionWorker := entitiesservice.NewPartitionWorker(persistence.NewEntityPartitionStore(spaceDB, entityPartitioning), tenantService, logger, entitiesservice.PartitionWorkerConfig{
+		Interval: cfg.PartitionInterval,
+	})
+	runInBackground("partition worker", partitionWorker.Run)
 
-	entitiesRepo := entitiesrepo.New(spaceDB, schemaStore, schemaValidator)
-	entitiesService := entitiesservice.New(entitiesRepo)
-	entitiesHTTPHandler := entitieshandler.New(entitiesService, logger)
+	// Archived versions stay readable even when archiving is later disabled, so the reader is always wired.
+	entityArchive := persistence.NewEntityArchiveStore(spaceDB, objectStore, persistence.EntityArchiving{
+		MinAge:    time.Duration(cfg.ArchiveAfterDays) * 24 * time.Hour,
+		BatchSize: cfg.ArchiveBatchSize,
+	})
+	if cfg.ArchiveAfterDays > 0 {
+		archiveWorker := entitiesservice.NewArchiveWorker(entityArchive, tenantService, logger, entitiesservice.ArchiveWorkerConfig{
+			Interval: cfg.ArchiveInterval,
+		})
+		runInBackground("archive worker", archiveWorker.Run)
+	}
+
+	if anchor := buildAnchor(cfg, logger); anchor != nil {
+		anchorWorker := entitiesservice.NewAnchorWorker(persistence.NewEntityAnchorStore(spaceDB), anchor, tenantService, logger, entitiesservice.AnchorWorkerConfig{
+			Interval:  cfg.AnchorInterval,
+			BatchSize: cfg.AnchorBatchSize,
+		})
+		runInBackground("anchor worker", anchorWorker.Run)
+	}
+
+	if cfg.StorageUsageEvery > 0 {
+		for _, pct := range cfg.StorageAlertPcts {
+			if pct <= 0 {
+				logger.Fatal("invalid STORAGE_USAGE_ALERT_PERCENTS (use positive percentages)", zap.Ints("percents", cfg.StorageAlertPcts))
+			}
+		}
+		storageUsage := persistence.NewStorageUsageStore(spaceDB, outboxStore, cfg.StorageAlertPcts)
+		storageUsageWorker := tenantsservice.NewStorageUsageWorker(prefixMeter, storageUsage, tenantService, logger, tenantsservice.StorageUsageWorkerConfig{
+			Interval: cfg.StorageUsageEvery,
+		})
+		storageUsageWorker.UseMailer(outgoingMail)
+		runInBackground("storage usage worker", storageUsageWorker.Run)
+	}
+
+	entitiesRepo := entitiesrepo.New(spaceDB, schemaStore, schemaValidator, entityEvents, entityChanges, entityArchive, newEntityEncryption(ctx, cfg, spaceDB, logger), searchIndex)
+	entitiesService := entitiesservice.New(entitiesRepo, usageMeter)
+	entitiesHTTPHandler := entitieshandler.New(entitiesService, logger.Named("entities"))
+
+	graphqlService := graphqlservice.New(graphqlrepo.NewPostgresRepository(spaceDB, schemaStore), entitiesService, graphqlservice.Config{
+		MaxDepth: cfg.GraphQLMaxDepth,
+	})
+	graphqlHTTPHandler := graphqlhandler.New(graphqlService, logger.Named("graphql"))
+
+	attachmentRepo := attachmentsrepo.NewPostgresRepository(persistence.NewAttachmentStore(spaceDB))
+	attachmentService := attachmentsservice.New(attachmentRepo, entitiesRepo, signedStore, attachmentsservice.Config{
+		URLTTL:       cfg.AttachmentURLTTL,
+		MaxBytes:     cfg.AttachmentMaxSize,
+		ContentTypes: cfg.AttachmentTypes,
+	})
+	attachmentHTTPHandler := attachmentshandler.New(attachmentService, logger.Named("attachments"))
+
+	// Features queue their work on jobQueue and register the handlers of its kinds on jobPool; GET /jobs/{jobId}
+	// reports progress.
+	jobPool := jobs.NewPool(jobStore, tenantService, logger, jobs.PoolConfig{
+		Interval:    cfg.JobsInterval,
+		Concurrency: cfg.JobsWorkers,
+	})
+
+	privacyRepo := privacyrepo.NewPostgresRepository(persistence.NewSubjectErasureStore(spaceDB), userStore, membershipStore)
+	privacyService := privacyservice.New(privacyRepo, jobQueue, privacyservice.Config{
+		SigningKey:  []byte(cfg.PrivacySigningKey),
+		MaxAttempts: cfg.ErasureAttempts,
+	})
+	privacyHTTPHandler := privacyhandler.New(privacyService, logger.Named("privacy"))
+	jobPool.Register(privacyservice.ErasureJobKind, privacyService.RunErasure)
+	jobPool.Register(schemarepositoryservice.EntityTablesJobKind, schemaService.RunEntityTables)
+
+	if cfg.JobsWorkers > 0 {
+		runInBackground("jobs", jobPool.Run)
+	}
+	jobsHTTPHandler := jobshandler.New(jobsservice.New(jobsrepo.NewPostgresRepository(jobStore)), logger.Named("jobs"))
+
+	// Only the process holding the scheduler lock runs the periodic tasks; /admin/scheduled-tasks lists and triggers them.
+	runInBackground("scheduler", buildScheduler(cfg, pool, adminSchema, spaceDB, schemaStore, tenantService, logger.Named("scheduler")).Run)
+	schedulerHTTPHandler := schedulerhandler.New(schedulerservice.New(schedulerrepo.NewPostgresRepository(persistence.NewScheduledTaskStore(pool, adminSchema))), logger.Named("scheduler"))
+
+	// Flags are evaluated lazily per request; admin changes reach this process at once and the others within the TTL.
+	featureFlagStore := persistence.NewFeatureFlagStore(pool, adminSchema)
+	featureEvaluator := features.NewEvaluator(featureFlagStore, logger, features.Config{TTL: cfg.FeatureCacheTTL})
+	featuresHTTPHandler := featureshandler.New(featuresservice.New(featuresrepo.NewPostgresRepository(featureFlagStore), featureEvaluator), logger.Named("features"))
+
+	loggingLogger := logger.Named("logging")
+	loggingHTTPHandler := logginghandler.New(loggingservice.New(logLevels, loggingLogger), loggingLogger)
+
+	// LOG_LEVEL, CORS_ALLOWED_ORIGINS and FEATURE_FLAGS_CACHE_TTL reload on SIGHUP and every CONFIG_RELOAD_INTERVAL.
+	corsOrigins := platformmiddleware.NewCORSOrigins(cfg.CORSOrigins)
+	settings := buildSettingsWatcher(cfg, logLevels, corsOrigins, featureEvaluator, logger.Named("config"))
+	components.Go("settings watcher", settings.Run)
+
+	statsHTTPHandler := statshandler.New(statsservice.New(statsrepo.NewPostgresRepository(persistence.NewPlatformStatsStore(pool, adminSchema)), cfg.EnvKey), logger.Named("stats"))
 
 	rootRouter := chi.NewRouter()
 
 	rootRouter.Use(
 		chimw.RequestID,
 		chimw.RealIP,
+		platformmiddleware.Tracing(),
 		chimw.Recoverer,
-		chimw.Timeout(cfg.RequestTimeout),
-		platformmiddleware.DefaultCORS(),
+		platformmiddleware.TimeoutUnlessStreaming(cfg.RequestTimeout),
+		platformmiddleware.CORS(platformmiddleware.CORSConfig{
+			Origins:          corsOrigins,
+			AllowedMethods:   cfg.CORSMethods,
+			AllowedHeaders:   cfg.CORSHeaders,
+			AllowCredentials: cfg.CORSCredentials,
+			MaxAge:           cfg.CORSMaxAge,
+			TenantOrigins:    tenantService,
+			TenantOriginsTTL: time.Minute,
+		}),
 	)
 
-	rootRouter.Use(platformlogging.RequestLogger(logger))
+	rootRouter.Use(
+		platformmiddleware.Compress(5),
+		platformmiddleware.DecompressRequest,
+		platformmiddleware.LimitRequestBody(cfg.MaxBodyBytes),
+	)
+	// Inside the compression middleware, so audited bodies are captured uncompressed.
+	rootRouter.Use(platformlogging.RequestLogger(logger, requestAudit))
+	rootRouter.Use(errorreporting.Middleware(errorReporter))
 
 	rootRouter.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -200,19 +686,44 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	// Signed upload and download URLs of the local storage backend; the signature authorises the request.
+	if localSigning != nil {
+		rootRouter.Mount("/storage/local", http.StripPrefix("/storage/local", localSigning))
+	}
+
 	// ---- Swagger UI + OpenAPI JSON (public) ----
 	registerDocsRoutes(rootRouter, logger)
 
-	apiRouter := chi.NewRouter()
-	apiRouter.Use(authMiddleware)
-	apiRouter.Use(platformmiddleware.RequestTrace)
-	apiRouter.Use(tenantmiddleware.WithTenantSpace(tenantService, tenantmiddleware.Config{
-		EnvKey:   cfg.EnvKey,
-		CacheTTL: time.Minute,
-	}))
+	// The same pipeline fronts the REST routes and the gRPC services.
+	apiMiddleware := []func(http.Handler) http.Handler{
+		authMiddleware,
+		platformauth.APIKey(usersservice.NewAPIKeyResolver(userRepo, tenantService)),
+		// After both the bearer token and the API key, so a revoked user loses their keys too.
+		rejectRevoked,
+		platformmiddleware.RequestTrace,
+		tenantmiddleware.WithTenantSpace(tenantService, tenantmiddleware.Config{
+			EnvKey:                cfg.EnvKey,
+			Cache:                 tenantSpaces,
+			MaintenanceRetryAfter: cfg.MaintenanceRetry,
+			Memberships:           membershipStore,
+		}),
+		// Tenant origins are allowed by the CORS middleware above; once the tenant is known, only its own.
+		platformmiddleware.TenantCORS,
+		platformmiddleware.BlockSuspendedWrites(platformmiddleware.ReadOnlyActions...),
+		usersmiddleware.TrackActivity(userService, logger),
+		features.Middleware(featureEvaluator),
+	}
+
+	// Each major version has its own router behind the same middleware; a domain mounts on the version its
+	// contract's server names.
+	apiV1 := newAPIVersion(apiMiddleware, nil)
+
+	// Operation scopes declared in the contracts are resolved against the caller's tenant roles.
+	authenticate := platformmiddleware.ValidateAuthenticationViaSwagger(userService)
+	responseValidation := platformmiddleware.ResponseValidationConfig{Mode: platformmiddleware.ResponseValidationMode(cfg.ResponseCheck)}
 
-	schemaCategoriesValidator := mustNewSpecValidator(logger, "contracts/schema-categories.yaml")
-	apiRouter.Group(func(r chi.Router) {
+	schemaCategoriesValidator := mustNewSpecValidator(logger, "contracts/schema-categories.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
 		r.Use(schemaCategoriesValidator)
 		_ = schemacategories.HandlerWithOptions(
 			schemacategories.NewStrictHandler(categoryHTTPHandler, nil),
@@ -220,8 +731,8 @@ func main() {
 		)
 	})
 
-	schemaRepositoryValidator := mustNewSpecValidator(logger, "contracts/schema-repository.yaml")
-	apiRouter.Group(func(r chi.Router) {
+	schemaRepositoryValidator := mustNewSpecValidator(logger, "contracts/schema-repository.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
 		r.Use(schemaRepositoryValidator)
 		_ = schemarepository.HandlerWithOptions(
 			schemarepository.NewStrictHandler(schemaHTTPHandler, nil),
@@ -229,17 +740,30 @@ func main() {
 		)
 	})
 
-	entitiesValidator := mustNewSpecValidator(logger, "contracts/entities.yaml")
-	apiRouter.Group(func(r chi.Router) {
+	entitiesValidator := mustNewSpecValidator(logger, "contracts/entities.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
 		r.Use(entitiesValidator)
+		r.Use(entitiesmiddleware.PayloadDecryption(userService, entitiesservice.PermissionEntitiesDecrypt, logger))
+		r.Use(entitiesmiddleware.PIIAccess(userService, logger))
 		_ = entitiesapi.HandlerWithOptions(
 			entitiesapi.NewStrictHandler(entitiesHTTPHandler, nil),
 			entitiesapi.ChiServerOptions{BaseRouter: r},
 		)
 	})
 
-	usersValidator := mustNewSpecValidator(logger, "contracts/users.yaml")
-	apiRouter.Group(func(r chi.Router) {
+	graphqlValidator := mustNewSpecValidator(logger, "contracts/graphql.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(graphqlValidator)
+		r.Use(entitiesmiddleware.PayloadDecryption(userService, entitiesservice.PermissionEntitiesDecrypt, logger))
+		r.Use(entitiesmiddleware.PIIAccess(userService, logger))
+		_ = graphqlapi.HandlerWithOptions(
+			graphqlapi.NewStrictHandler(graphqlHTTPHandler, nil),
+			graphqlapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	usersValidator := mustNewSpecValidator(logger, "contracts/users.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
 		r.Use(usersValidator)
 		_ = users.HandlerWithOptions(
 			users.NewStrictHandler(userHTTPHandler, nil),
@@ -247,9 +771,80 @@ func main() {
 		)
 	})
 
-	tenantsValidator := mustNewSpecValidator(logger, "contracts/tenants.yaml")
-	apiRouter.Group(func(r chi.Router) {
-		r.Use(platformauth.RequireRole("admin"))
+	webhooksValidator := mustNewSpecValidator(logger, "contracts/webhooks.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(webhooksValidator)
+		_ = webhooksapi.HandlerWithOptions(
+			webhooksapi.NewStrictHandler(webhookHTTPHandler, nil),
+			webhooksapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	attachmentsValidator := mustNewSpecValidator(logger, "contracts/attachments.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(attachmentsValidator)
+		_ = attachmentsapi.HandlerWithOptions(
+			attachmentsapi.NewStrictHandler(attachmentHTTPHandler, nil),
+			attachmentsapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	privacyValidator := mustNewSpecValidator(logger, "contracts/privacy.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(privacyValidator)
+		_ = privacyapi.HandlerWithOptions(
+			privacyapi.NewStrictHandler(privacyHTTPHandler, nil),
+			privacyapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	jobsValidator := mustNewSpecValidator(logger, "contracts/jobs.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(jobsValidator)
+		_ = jobsapi.HandlerWithOptions(
+			jobsapi.NewStrictHandler(jobsHTTPHandler, nil),
+			jobsapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	schedulerValidator := mustNewSpecValidator(logger, "contracts/scheduler.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(schedulerValidator)
+		_ = schedulerapi.HandlerWithOptions(
+			schedulerapi.NewStrictHandler(schedulerHTTPHandler, nil),
+			schedulerapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	featuresValidator := mustNewSpecValidator(logger, "contracts/features.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(featuresValidator)
+		_ = featuresapi.HandlerWithOptions(
+			featuresapi.NewStrictHandler(featuresHTTPHandler, nil),
+			featuresapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	statsValidator := mustNewSpecValidator(logger, "contracts/stats.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(statsValidator)
+		_ = statsapi.HandlerWithOptions(
+			statsapi.NewStrictHandler(statsHTTPHandler, nil),
+			statsapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	loggingValidator := mustNewSpecValidator(logger, "contracts/logging.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
+		r.Use(loggingValidator)
+		_ = loggingapi.HandlerWithOptions(
+			loggingapi.NewStrictHandler(loggingHTTPHandler, nil),
+			loggingapi.ChiServerOptions{BaseRouter: r},
+		)
+	})
+
+	tenantsValidator := mustNewSpecValidator(logger, "contracts/tenants.yaml", authenticate, responseValidation)
+	apiV1.Group(func(r chi.Router) {
 		r.Use(tenantsValidator)
 		_ = tenantsapi.HandlerWithOptions(
 			tenantsapi.NewStrictHandler(tenantHTTPHandler, nil),
@@ -257,7 +852,28 @@ func main() {
 		)
 	})
 
-	rootRouter.Mount("/api/v1", apiRouter)
+	// Breaking changes ship as /api/v2 contracts (contracts/v2/<domain>.yaml) mounted here, while v1 keeps serving
+	// until its sunset. No domain has one yet.
+	apiV2 := newAPIVersion(apiMiddleware, nil)
+
+	rootRouter.Mount("/api/v1", apiV1)
+	rootRouter.Mount("/api/v2", apiV2)
+
+	// The auth routes describe the token's own identity across tenants, so they stay outside the tenant space
+	// middleware (and its tenant switching).
+	authValidator := mustNewSpecValidator(logger, "contracts/auth.yaml", authenticate, responseValidation)
+	rootRouter.Group(func(r chi.Router) {
+		r.Use(authMiddleware)
+		r.Use(rejectRevoked)
+		r.Use(platformmiddleware.RequestTrace)
+		// No tenant space here: tenant origins get no CORS headers, only the deployment-wide ones do.
+		r.Use(platformmiddleware.TenantCORS)
+		r.Use(authValidator)
+		_ = authapi.HandlerWithOptions(
+			authapi.NewStrictHandler(authHTTPHandler, nil),
+			authapi.ChiServerOptions{BaseRouter: r, BaseURL: "/api/v1"},
+		)
+	})
 
 	server := &http.Server{
 		Addr:         ":" + cfg.Port,
@@ -273,90 +889,385 @@ func main() {
 			logger.Fatal("server listen failed", zap.Error(err))
 		}
 	}()
+	components.OnStop("http server", server.Shutdown)
 
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt)
-	<-stop
+	if cfg.GRPCPort != "" {
+		methods := entitieshandler.GRPCMethods()
+		maps.Copy(methods, schemarepositoryhandler.GRPCMethods())
+		grpcServer := grpc.NewServer(grpc.UnaryInterceptor(rpc.UnaryServerInterceptor(rpc.Config{
+			Middleware: append(slices.Clone(apiMiddleware),
+				entitiesmiddleware.PayloadDecryption(userService, entitiesservice.PermissionEntitiesDecrypt, logger),
+				entitiesmiddleware.PIIAccess(userService, logger),
+			),
+			Authenticate: authenticate,
+			Methods:      methods,
+		})))
+		entitiesv1.RegisterEntitiesServiceServer(grpcServer, entitieshandler.NewGRPCServer(entitiesService, logger.Named("entities")))
+		schemarepositoryv1.RegisterSchemaRepositoryServiceServer(grpcServer, schemarepositoryhandler.NewGRPCServer(schemaService, logger.Named("schema-repository")))
+
+		listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			logger.Fatal("grpc listen failed", zap.Error(err))
+		}
+		go func() {
+			logger.Info("starting grpc server", zap.String("port", cfg.GRPCPort))
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Fatal("grpc server failed", zap.Error(err))
+			}
+		}()
+		components.OnStop("grpc server", func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				grpcServer.Stop()
+				return ctx.Err()
+			}
+		})
+	}
+
+	received := lifecycle.WaitForSignal()
+	logger.Info("shutting down", zap.String("signal", received.String()), zap.Duration("timeout", cfg.ShutdownTimeout))
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
-
-	if err := server.Shutdown(shutdownCtx); err != nil {
+	if err := components.Shutdown(shutdownCtx); err != nil {
 		logger.Error("graceful shutdown failed", zap.Error(err))
 	}
 }
 
-// mustNewSpecValidator loads the OpenAPI document and builds oapi-codegen validator middleware.
-// This can be reused by each domain group to guarantee contract compliance per docs/api-server.md
-func mustNewSpecValidator(logger *zap.Logger, path string) func(http.Handler) http.Handler {
-	if loaderFn, ok := swaggerLoaders[path]; ok {
-		spec, err := loaderFn()
+// buildEventPublisher returns the configured domain event publisher, or nil when EVENTS_PUBLISHER=none.
+// The returned func releases the publisher and any broker connection it owns.
+func buildEventPublisher(ctx context.Context, cfg config, logger *zap.Logger) (platformevents.Publisher, func()) {
+	switch cfg.EventsPublisher {
+	case "", "none":
+		return nil, func() {}
+	case "log":
+		return platformevents.NewLogPublisher(logger), func() {}
+	case "pubsub":
+		if strings.TrimSpace(cfg.PubSubProjectID) == "" {
+			logger.Fatal("pubsub project id required when EVENTS_PUBLISHER=pubsub")
+		}
+		client, err := pubsub.NewClient(ctx, cfg.PubSubProjectID)
 		if err != nil {
-			logger.Fatal("load generated swagger", zap.String("path", path), zap.Error(err))
+			logger.Fatal("init pubsub client", zap.Error(err))
 		}
-		logSecuritySchemes(logger, path, spec)
-		return oapimiddleware.OapiRequestValidatorWithOptions(spec, &oapimiddleware.Options{
-			Options: openapi3filter.Options{
-				AuthenticationFunc: platformmiddleware.ValidateAuthenticationViaSwagger,
-			},
+		prefix := cfg.EventsPrefix
+		if prefix != "" {
+			prefix += "-"
+		}
+		publisher := platformevents.NewPubSubPublisher(client, prefix)
+		return publisher, func() {
+			_ = publisher.Close()
+			_ = client.Close()
+		}
+	case "nats":
+		conn, err := nats.Connect(cfg.NATSURL, nats.Name("palmyra-api"))
+		if err != nil {
+			logger.Fatal("connect nats", zap.Error(err))
+		}
+		publisher, err := platformevents.NewNATSPublisher(conn, cfg.EventsPrefix)
+		if err != nil {
+			logger.Fatal("init nats publisher", zap.Error(err))
+		}
+		return publisher, func() {
+			_ = publisher.Close()
+			conn.Close()
+		}
+	default:
+		logger.Fatal("invalid EVENTS_PUBLISHER (use none, log, pubsub or nats)", zap.String("publisher", cfg.EventsPublisher))
+		return nil, func() {}
+	}
+}
+
+// buildSchemaCache returns the configured schema version cache, or nil when SCHEMA_CACHE=none. The returned func
+// releases any Redis connection it owns.
+func buildSchemaCache(cfg config, logger *zap.Logger) (persistence.SchemaCache, func()) {
+	switch cfg.SchemaCache {
+	case "", "none":
+		return nil, func() {}
+	case "memory":
+		return persistence.NewMemorySchemaCache(cfg.SchemaCacheTTL), func() {}
+	case "redis":
+		options, err := redis.ParseURL(cfg.RedisURL)
+		if err != nil {
+			logger.Fatal("invalid REDIS_URL", zap.Error(err))
+		}
+		client := redis.NewClient(options)
+		return persistence.NewRedisSchemaCache(client, "palmyra:"+cfg.EnvKey+":", cfg.SchemaCacheTTL), func() {
+			_ = client.Close()
+		}
+	default:
+		logger.Fatal("invalid SCHEMA_CACHE (use none, memory or redis)", zap.String("cache", cfg.SchemaCache))
+		return nil, func() {}
+	}
+}
+
+// buildSearchIndex returns the configured document search index, or nil when SEARCH_BACKEND=none, in which case
+// document search runs in Postgres.
+func buildSearchIndex(cfg config, logger *zap.Logger) search.Index {
+	switch cfg.SearchBackend {
+	case "", "none":
+		return nil
+	case "opensearch":
+		index, err := search.NewOpenSearchIndex(search.OpenSearchConfig{
+			URL:         cfg.OpenSearchURL,
+			Username:    cfg.OpenSearchUser,
+			Password:    cfg.OpenSearchPass,
+			IndexPrefix: cfg.SearchPrefix,
 		})
+		if err != nil {
+			logger.Fatal("init opensearch index", zap.Error(err))
+		}
+		return index
+	default:
+		logger.Fatal("invalid SEARCH_BACKEND (use none or opensearch)", zap.String("backend", cfg.SearchBackend))
+		return nil
 	}
+}
 
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
+// buildAnchor returns the configured ledger for entity hash anchoring, or nil when ANCHOR_PROVIDER=none.
+func buildAnchor(cfg config, logger *zap.Logger) anchoring.Anchor {
+	switch cfg.AnchorProvider {
+	case "", "none":
+		return nil
+	case "mock":
+		return anchoring.NewMockAnchor()
+	default:
+		logger.Fatal("invalid ANCHOR_PROVIDER (use none or mock)", zap.String("provider", cfg.AnchorProvider))
+		return nil
+	}
+}
 
-	absPath, err := filepath.Abs(path)
-	if err != nil {
-		logger.Fatal("resolve spec path", zap.String("path", path), zap.Error(err))
+// buildInvitationDeps wires the user invitation flow: the configured mailer and, with Firebase auth, identity
+// provisioning in the tenant's Identity Platform tenant. Dev auth has no identities to create.
+func buildInvitationDeps(ctx context.Context, cfg config, outgoing mailer.Mailer, logger *zap.Logger) usersservice.InvitationDeps {
+	deps := usersservice.InvitationDeps{
+		Mailer:    outgoing,
+		AcceptURL: cfg.InvitationURL,
+		TTL:       cfg.InvitationTTL,
 	}
 
-	baseDir := filepath.Dir(absPath)
-	loader.ReadFromURIFunc = func(loader *openapi3.Loader, ref *url.URL) ([]byte, error) {
-		if ref == nil {
-			return nil, errors.New("nil reference URI")
+	if cfg.AuthProvider == "firebase" {
+		_, fbAuth, err := gcp.InitFirebaseAuth(ctx)
+		if err != nil {
+			logger.Fatal("init firebase auth", zap.Error(err))
 		}
+		provisioner := usersprov.NewFirebaseIdentityProvisioner(fbAuth, cfg.EnvKey)
+		deps.Identity = provisioner
+		deps.Directory = provisioner
+	}
+	return deps
+}
 
-		if ref.IsAbs() {
-			switch ref.Scheme {
-			case "file":
-				data, err := os.ReadFile(ref.Path)
-				if err != nil {
-					return nil, fmt.Errorf("read reference %q: %w", ref.Path, err)
-				}
-				return data, nil
-			default:
-				return nil, fmt.Errorf("unsupported reference scheme %q", ref.String())
-			}
+// buildMailer selects the outgoing email transport from MAILER.
+func buildMailer(cfg config, logger *zap.Logger) mailer.Mailer {
+	switch cfg.Mailer {
+	case "log":
+		return mailer.NewLogMailer(logger)
+	case "smtp":
+		smtpMailer, err := mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Addr:     cfg.SMTPAddr,
+			From:     cfg.SMTPFrom,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		})
+		if err != nil {
+			logger.Fatal("init smtp mailer", zap.Error(err))
 		}
+		return smtpMailer
+	case "sendgrid":
+		sendGridMailer, err := mailer.NewSendGridMailer(mailer.SendGridConfig{
+			APIKey:   cfg.SendGridAPIKey,
+			From:     cfg.SendGridFrom,
+			FromName: cfg.SendGridFromName,
+		})
+		if err != nil {
+			logger.Fatal("init sendgrid mailer", zap.Error(err))
+		}
+		return sendGridMailer
+	default:
+		logger.Fatal("invalid MAILER (use log, smtp or sendgrid)", zap.String("mailer", cfg.Mailer))
+		return nil
+	}
+}
 
-		refPath := filepath.Clean(ref.Path)
-		if refPath == "" {
-			return nil, fmt.Errorf("empty reference path for %q", ref.String())
+// buildErrorReporter forwards server errors to Sentry or, when ERROR_REPORTING_DSN is "gcp", to GCP Error Reporting
+// through the logs. It returns nil, which reports nothing, while ERROR_REPORTING_DSN is empty.
+func buildErrorReporter(cfg config, logger *zap.Logger) *errorreporting.Reporter {
+	var transport errorreporting.Transport
+	switch cfg.ErrorReportingDSN {
+	case "":
+		return nil
+	case "gcp":
+		gcpTransport, err := errorreporting.NewGCPTransport(logger, errorreporting.GCPConfig{
+			Service: cfg.OTELServiceName,
+			Version: cfg.ErrorRelease,
+		})
+		if err != nil {
+			logger.Fatal("init gcp error reporting", zap.Error(err))
 		}
+		transport = gcpTransport
+	default:
+		sentryTransport, err := errorreporting.NewSentryTransport(errorreporting.SentryConfig{
+			DSN:         cfg.ErrorReportingDSN,
+			Environment: cfg.EnvKey,
+			Release:     cfg.ErrorRelease,
+			ServerName:  cfg.OTELServiceName,
+		})
+		if err != nil {
+			logger.Fatal("init sentry error reporting", zap.Error(err))
+		}
+		transport = sentryTransport
+	}
+	return errorreporting.NewReporter(transport, logger.Named("errorreporting"), errorreporting.Config{})
+}
 
-		candidate := filepath.Join(baseDir, refPath)
-		data, err := os.ReadFile(candidate)
+// buildSettingsWatcher registers the settings that can change without a restart. A change of LOG_LEVEL replaces
+// the default level, also when it was changed through /admin/logging/level, and keeps the component overrides.
+func buildSettingsWatcher(cfg config, logLevels *platformlogging.Levels, corsOrigins *platformmiddleware.CORSOrigins, featureEvaluator *features.Evaluator, logger *zap.Logger) *platformconfig.Watcher {
+	watcher := platformconfig.NewWatcher(logger, platformconfig.WatcherConfig{
+		Path:     cfg.ReloadPath,
+		Interval: cfg.ReloadInterval,
+	})
+	watcher.Register("LOG_LEVEL", func(value string) error {
+		level, err := platformlogging.ParseLevel(value)
 		if err != nil {
-			return nil, fmt.Errorf("read reference %q: %w", candidate, err)
+			return err
 		}
-		return data, nil
+		logLevels.SetDefault(level)
+		return nil
+	})
+	watcher.Register("CORS_ALLOWED_ORIGINS", func(value string) error {
+		origins := strings.Split(value, ",")
+		if err := platformmiddleware.CheckCORSOrigins(origins, cfg.CORSCredentials); err != nil {
+			return err
+		}
+		corsOrigins.Set(origins)
+		return nil
+	})
+	watcher.Register("FEATURE_FLAGS_CACHE_TTL", func(value string) error {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		featureEvaluator.SetTTL(ttl)
+		return nil
+	})
+
+	// A settings file that differs from the environment already applies at startup.
+	if err := watcher.Reload(); err != nil {
+		logger.Fatal("load reloadable settings", zap.Error(err))
 	}
+	return watcher
+}
 
-	spec, err := loader.LoadFromFile(absPath)
+// buildRequestAudit configures the audit entries of the routes in AUDIT_LOG_ROUTES; they go to their own
+// AUDIT_LOG_OUTPUT sink and are disabled while it is empty.
+func buildRequestAudit(cfg config) (platformlogging.AuditConfig, error) {
+	if cfg.AuditLogOutput == "" {
+		return platformlogging.AuditConfig{}, nil
+	}
+	routes, err := platformlogging.ParseAuditRoutes(cfg.AuditLogRoutes)
 	if err != nil {
-		logger.Fatal("load openapi spec", zap.String("path", path), zap.Error(err))
+		return platformlogging.AuditConfig{}, err
+	}
+	sink, err := platformlogging.NewLogger(platformlogging.Config{
+		Component: "api-audit",
+		Level:     "info",
+		Output:    cfg.AuditLogOutput,
+	})
+	if err != nil {
+		return platformlogging.AuditConfig{}, fmt.Errorf("open audit log: %w", err)
+	}
+	return platformlogging.AuditConfig{Sink: sink, Routes: routes, MaxBodyBytes: cfg.AuditLogMaxBody}, nil
+}
+
+// localSigningKey returns the key signing the local storage URLs. Without STORAGE_LOCAL_SIGNING_KEY a random key is
+// used, so URLs issued before a restart stop working.
+func localSigningKey(cfg config, logger *zap.Logger) []byte {
+	if cfg.StorageSigningKey != "" {
+		return []byte(cfg.StorageSigningKey)
 	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		logger.Fatal("generate local storage signing key", zap.Error(err))
+	}
+	return key
+}
 
-	logSecuritySchemes(logger, path, spec)
+// newEntityEncryption builds the payload field encryption from ENCRYPTION_KEY_MANAGER; nil when it is none.
+func newEntityEncryption(ctx context.Context, cfg config, spaceDB *persistence.SpaceDB, logger *zap.Logger) *persistence.EntityEncryption {
+	var keys persistence.DataKeyWrapper
+	switch cfg.EncryptionKeys {
+	case "none":
+		return nil
+	case "local":
+		masterKey, err := base64.StdEncoding.DecodeString(cfg.EncryptLocalKey)
+		if err != nil {
+			logger.Fatal("invalid ENCRYPTION_LOCAL_KEY (use a base64 encoded 32 byte key)", zap.Error(err))
+		}
+		local, err := kms.NewLocalKeyManager(masterKey)
+		if err != nil {
+			logger.Fatal("invalid ENCRYPTION_LOCAL_KEY (use a base64 encoded 32 byte key)", zap.Error(err))
+		}
+		keys = local
+	case "gcp":
+		cloud, err := kms.NewGCPKeyManager(ctx, cfg.EncryptKMSKey)
+		if err != nil {
+			logger.Fatal("create cloud kms key manager", zap.Error(err))
+		}
+		keys = cloud
+	default:
+		logger.Fatal("invalid ENCRYPTION_KEY_MANAGER (use none, local or gcp)", zap.String("keyManager", cfg.EncryptionKeys))
+	}
+	return persistence.NewEntityEncryption(spaceDB, keys)
+}
+
+// newAPIVersion builds the router of one major API version behind the shared middleware. A version being retired
+// passes its schedule, which every response announces; deprecated operations announce their own.
+func newAPIVersion(middleware []func(http.Handler) http.Handler, retired *platformmiddleware.Deprecation) chi.Router {
+	router := chi.NewRouter()
+	if retired != nil {
+		router.Use(platformmiddleware.DeprecationHeaders(*retired))
+	}
+	router.Use(middleware...)
+	return router
+}
 
-	return oapimiddleware.OapiRequestValidatorWithOptions(spec, &oapimiddleware.Options{
+// mustNewSpecValidator loads the OpenAPI document and builds oapi-codegen validator middleware.
+// This can be reused by each domain group to guarantee contract compliance per docs/api-server.md.
+// authenticate enforces the security requirements, including the scopes, each operation declares; responses
+// checks what the handlers answer against the same document.
+func mustNewSpecValidator(logger *zap.Logger, path string, authenticate openapi3filter.AuthenticationFunc, responses platformmiddleware.ResponseValidationConfig) func(http.Handler) http.Handler {
+	spec := mustLoadSpec(logger, path)
+	// Deprecated operations announce their retirement schedule, even on the requests the validator rejects.
+	deprecated, err := platformmiddleware.DeprecatedOperations(spec)
+	if err != nil {
+		logger.Fatal("read deprecated operations", zap.String("path", path), zap.Error(err))
+	}
+	announce := platformmiddleware.OperationDeprecations(deprecated)
+	validate := oapimiddleware.OapiRequestValidatorWithOptions(spec, &oapimiddleware.Options{
 		Options: openapi3filter.Options{
-			AuthenticationFunc: platformmiddleware.ValidateAuthenticationViaSwagger,
+			AuthenticationFunc: authenticate,
 		},
+		ErrorHandlerWithOpts: platformmiddleware.SpecValidationErrorHandler,
 	})
+	checkResponses, err := platformmiddleware.ValidateResponses(spec, logger.Named("contract"), responses)
+	if err != nil {
+		logger.Fatal("build response validator", zap.String("path", path), zap.Error(err))
+	}
+	return func(next http.Handler) http.Handler {
+		return announce(validate(checkResponses(next)))
+	}
 }
 
-// mustLoadSpec loads and returns the OpenAPI document for docs serving.
+// mustLoadSpec loads and returns the OpenAPI document for request validation and docs serving.
 func mustLoadSpec(logger *zap.Logger, path string) *openapi3.T {
 	if loaderFn, ok := swaggerLoaders[path]; ok {
 		spec, err := loaderFn()
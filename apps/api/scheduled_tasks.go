@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	tenantsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/scheduler"
+)
+
+// buildScheduler registers the periodic platform tasks that have a schedule; an empty schedule disables a task.
+// Every process of the environment may run the scheduler: the advisory lock keyed by the admin schema elects the one
+// that runs the tasks. schemaStore may be nil, in which case purged schema versions are not evicted from its cache.
+func buildScheduler(cfg config, pool *pgxpool.Pool, adminSchema string, spaceDB *persistence.SpaceDB, schemaStore *persistence.SchemaRepositoryStore, tenantService *tenantsservice.Service, logger *zap.Logger) *scheduler.Scheduler {
+	s := scheduler.New(
+		persistence.NewScheduledTaskStore(pool, adminSchema),
+		persistence.NewAdvisoryLock(pool, "scheduler:"+adminSchema),
+		logger,
+		scheduler.Config{Interval: cfg.SchedulerInterval},
+	)
+
+	register := func(name, schedule string, run func(context.Context) error) {
+		schedule = strings.TrimSpace(schedule)
+		if schedule == "" {
+			return
+		}
+		if _, err := scheduler.Parse(schedule); err != nil {
+			logger.Fatal("invalid scheduled task schedule", zap.String("task", name), zap.Error(err))
+		}
+		s.Register(scheduler.Task{Name: name, Schedule: schedule, Run: run})
+	}
+
+	retention := entitiesservice.NewRetentionCleanup(persistence.NewRetentionStore(spaceDB, schemaStore), tenantService, logger, entitiesservice.RetentionConfig{
+		Window: cfg.RetentionWindow,
+	})
+	register("retention-cleanup", cfg.RetentionSchedule, retention.RunOnce)
+
+	snapshots := persistence.NewTenantUsageSnapshotStore(spaceDB)
+	register("usage-metering", cfg.UsageSchedule, func(ctx context.Context) error {
+		return tenantService.MeterUsage(ctx, snapshots)
+	})
+
+	register("provisioning-reconcile", cfg.ReconcileSchedule, tenantService.ReconcileProvisioning)
+	return s
+}
@@ -0,0 +1,248 @@
+package apiconn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesConfig = `apiUrl: https://api.example.com
+env: prod
+currentProfile: local
+profiles:
+  local:
+    apiUrl: http://localhost:3000
+    env: dev
+    databaseUrl: postgres://localhost:5432/palmyra
+    devtoken:
+      projectId: demo-palmyra
+      tenant: admin
+      userId: admin-user
+      email: admin@example.com
+      emailVerified: true
+  ci:
+    token: ci-token
+    tenant: acme
+  anonymous:
+    tenant: acme
+`
+
+func TestConfigSelect(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Profile:        Profile{APIURL: "https://api.example.com", Env: "prod", Token: "top-token"},
+		CurrentProfile: "local",
+		Profiles: map[string]Profile{
+			"local": {APIURL: "http://localhost:3000", Env: "dev", Devtoken: &Devtoken{Email: "admin@example.com"}},
+			"ci":    {Tenant: "acme"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		profile string
+		want    Profile
+		wantErr string
+	}{
+		{
+			name: "current profile over the top-level settings",
+			cfg:  cfg,
+			want: Profile{APIURL: "http://localhost:3000", Env: "dev", Token: "top-token", Devtoken: &Devtoken{Email: "admin@example.com"}},
+		},
+		{
+			name:    "named profile keeps the settings it leaves out",
+			cfg:     cfg,
+			profile: "ci",
+			want:    Profile{APIURL: "https://api.example.com", Env: "prod", Token: "top-token", Tenant: "acme"},
+		},
+		{
+			name: "top-level settings without a current profile",
+			cfg:  Config{Profile: cfg.Profile, Profiles: cfg.Profiles},
+			want: cfg.Profile,
+		},
+		{
+			name:    "unknown profile",
+			cfg:     cfg,
+			profile: "staging",
+			wantErr: `profile "staging" not found; create it with: config set staging`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.cfg.Select(tt.profile)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// profileCommand is a command with the connection and database flags, parsed from args against a config file
+// holding profilesConfig.
+func profileCommand(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(profilesConfig), 0o600))
+
+	root := &cobra.Command{Use: "cli-platform-admin"}
+	AddRootFlags(root)
+	cmd := &cobra.Command{Use: "list"}
+	AddFlags(cmd)
+	cmd.Flags().String("env-key", "dev", "")
+	root.AddCommand(cmd)
+	require.NoError(t, root.ParseFlags(nil))
+	require.NoError(t, cmd.ParseFlags(append(args, "--config", path)))
+	return cmd
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	for _, env := range []string{EnvAPIURL, EnvToken, EnvTenant, EnvProfile} {
+		if os.Getenv(env) != "" {
+			t.Skipf("$%s is set", env)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		args         []string
+		want         Settings
+		wantDevtoken bool
+		wantErr      string
+	}{
+		{
+			name:         "current profile",
+			want:         Settings{APIURL: "http://localhost:3000"},
+			wantDevtoken: true,
+		},
+		{
+			name: "selected profile",
+			args: []string{"--profile", "ci"},
+			want: Settings{APIURL: "https://api.example.com", Token: "ci-token", Tenant: "acme"},
+		},
+		{
+			name: "flags win over the profile",
+			args: []string{"--profile", "ci", "--api-url", "http://127.0.0.1:3000", "--tenant", " globex "},
+			want: Settings{APIURL: "http://127.0.0.1:3000", Token: "ci-token", Tenant: "globex"},
+		},
+		{
+			name:    "unknown profile",
+			args:    []string{"--profile", "staging"},
+			wantErr: `profile "staging" not found; create it with: config set staging`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := profileCommand(t, tt.args...)
+			got, err := Resolve(cmd)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDevtoken, got.Devtoken != nil)
+			got.Devtoken, got.ConfigPath = nil, ""
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestApplyProfile(t *testing.T) {
+	t.Parallel()
+	if os.Getenv(EnvProfile) != "" {
+		t.Skipf("$%s is set", EnvProfile)
+	}
+
+	tests := []struct {
+		name            string
+		args            []string
+		skip            bool
+		wantDatabaseURL string
+		wantEnvKey      string
+	}{
+		{
+			name:            "fills the flags left out",
+			wantDatabaseURL: "postgres://localhost:5432/palmyra",
+			wantEnvKey:      "dev",
+		},
+		{
+			name:            "keeps the flags passed",
+			args:            []string{"--profile", "ci", "--database-url", "postgres://db:5432/palmyra", "--env-key", "stg"},
+			wantDatabaseURL: "postgres://db:5432/palmyra",
+			wantEnvKey:      "stg",
+		},
+		{
+			name:       "falls back to the top-level settings",
+			args:       []string{"--profile", "ci"},
+			wantEnvKey: "prod",
+		},
+		{
+			name:       "skipped commands keep their defaults",
+			skip:       true,
+			wantEnvKey: "dev",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := profileCommand(t, tt.args...)
+			if tt.skip {
+				SkipProfile(cmd.Parent())
+			}
+			require.NoError(t, ApplyProfile(cmd))
+			databaseURL, _ := cmd.Flags().GetString("database-url")
+			envKey, _ := cmd.Flags().GetString("env-key")
+			require.Equal(t, tt.wantDatabaseURL, databaseURL)
+			require.Equal(t, tt.wantEnvKey, envKey)
+		})
+	}
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	if os.Getenv(EnvAPIURL) != "" || os.Getenv(EnvToken) != "" || os.Getenv(EnvProfile) != "" {
+		t.Skip("the environment sets connection settings")
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "dev token claims of the profile"},
+		{name: "bearer token", args: []string{"--profile", "ci"}},
+		{name: "without a token", args: []string{"--profile", "anonymous"}, wantErr: "no API token: pass --token"},
+		{name: "invalid API URL", args: []string{"--profile", "ci", "--api-url", "localhost"}, wantErr: `invalid base url "localhost"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := Connect(profileCommand(t, tt.args...))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, client)
+		})
+	}
+}
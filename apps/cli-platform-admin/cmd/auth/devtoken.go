@@ -1,10 +1,17 @@
 package auth
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth/devtoken"
 )
 
@@ -13,26 +20,96 @@ func devTokenCommand() *cobra.Command {
 	var palmyraRoles []string
 	var tenantRoles []string
 	var expiresIn time.Duration
+	var save bool
+	var format string
+	var url string
+	var copyOutput bool
+	var personasPath string
 
 	cmd := &cobra.Command{
 		Use:   "devtoken",
 		Short: "Generate an unsigned Firebase-compatible JWT for dev/local use",
+		Long: `Generate an unsigned Firebase-compatible JWT for dev/local use.
+
+--format prints the bare token, an Authorization header, a curl command or a shell export. --personas generates one
+token per persona of a YAML file instead, e.g. an admin, a tenant user and a read-only user; the claim flags then
+apply to every persona, under the file's defaults and each persona's own claims. See the README for the file format.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+
 			params.PalmyraRoles = palmyraRoles
 			params.TenantRoles = tenantRoles
 			params.ExpiresIn = expiresIn
 
-			token, err := devtoken.BuildUnsignedFirebaseToken(params, time.Now().UTC())
-			if err != nil {
+			var personas []namedParams
+			if personasPath != "" {
+				if save {
+					return errors.New("--save stores a single set of claims; it cannot be combined with --personas")
+				}
+				loaded, err := loadPersonas(personasPath, params)
+				if err != nil {
+					return err
+				}
+				personas = loaded
+			} else {
+				if err := requireClaimFlags(cmd); err != nil {
+					return err
+				}
+				personas = []namedParams{{Params: params}}
+			}
+
+			now := time.Now().UTC()
+			views := make([]tokenView, 0, len(personas))
+			for _, p := range personas {
+				token, err := devtoken.BuildUnsignedFirebaseToken(p.Params, now)
+				if err != nil {
+					if p.Name != "" {
+						return fmt.Errorf("persona %s: %w", p.Name, err)
+					}
+					return err
+				}
+				views = append(views, tokenView{Persona: p.Name, Email: p.Params.Email, Token: token, Authorization: authorization(token)})
+			}
+
+			if save {
+				return saveDevtoken(cmd, params)
+			}
+
+			// --copy puts on the clipboard exactly what is printed.
+			var copied bytes.Buffer
+			if copyOutput {
+				cmd.SetOut(io.MultiWriter(cmd.OutOrStdout(), &copied))
+			}
+
+			var result any = views
+			if personasPath == "" {
+				result = views[0]
+			}
+			if err := output.Print(cmd, result, func(out io.Writer) error {
+				for _, view := range views {
+					if view.Persona != "" {
+						fmt.Fprintf(out, "# %s (%s)\n", view.Persona, view.Email)
+					}
+					renderToken(out, format, view.Persona, view.Token, url)
+				}
+				return nil
+			}); err != nil {
 				return err
 			}
 
-			fmt.Fprintln(cmd.OutOrStdout(), token)
+			if copyOutput {
+				if err := copyToClipboard(copied.String()); err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.ErrOrStderr(), "Copied to the clipboard.")
+			}
 			return nil
 		},
 	}
 
-	// Required claims
+	// Required claims, unless --personas sets them
 	cmd.Flags().StringVar(&params.ProjectID, "project-id", "", "Firebase project ID (iss/aud)")
 	cmd.Flags().StringVar(&params.Tenant, "tenant", "", "firebase.tenant claim")
 	cmd.Flags().StringVar(&params.UserID, "user-id", "", "user_id/sub/uid claim")
@@ -48,11 +125,68 @@ func devTokenCommand() *cobra.Command {
 	cmd.Flags().DurationVar(&expiresIn, "expires-in", time.Hour, "token lifetime (e.g. 30m, 2h)")
 	cmd.Flags().StringVar(&params.Audience, "audience", "", "override aud; defaults to project-id")
 	cmd.Flags().StringVar(&params.Issuer, "issuer", "", "override iss; defaults to securetoken URL")
+	cmd.Flags().BoolVar(&save, "save", false, "store the claims in the config profile (--profile, else the current one) instead of printing a token; API commands then mint a fresh token per request")
 
-	_ = cmd.MarkFlagRequired("project-id")
-	_ = cmd.MarkFlagRequired("tenant")
-	_ = cmd.MarkFlagRequired("user-id")
-	_ = cmd.MarkFlagRequired("email")
+	// Output
+	cmd.Flags().StringVar(&format, "format", formatToken, "print the token as: token, header (Authorization header), curl (curl command) or env (shell export)")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the --format curl command (default $"+apiconn.EnvAPIURL+")")
+	cmd.Flags().BoolVar(&copyOutput, "copy", false, "also copy the output to the clipboard (pbcopy, wl-copy, xclip, xsel or clip)")
+	cmd.Flags().StringVar(&personasPath, "personas", "", "YAML file of personas to generate one token each for")
+
+	// --save may name a profile that does not exist yet.
+	apiconn.SkipProfile(cmd)
 
 	return cmd
 }
+
+// requireClaimFlags fails like a required flag would when a claim flag without a default is missing; they are
+// optional with --personas.
+func requireClaimFlags(cmd *cobra.Command) error {
+	var missing []string
+	for _, name := range []string{"project-id", "tenant", "user-id", "email"} {
+		if !cmd.Flags().Changed(name) {
+			missing = append(missing, `"`+name+`"`)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("required flag(s) %s not set", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// saveDevtoken stores params as the devtoken claims of the selected profile, or of the top-level settings when no
+// profile is selected.
+func saveDevtoken(cmd *cobra.Command, params devtoken.Params) error {
+	// Saving creates the config file when it is missing.
+	path, _ := apiconn.ConfigPath(cmd)
+	cfg, err := apiconn.LoadConfig(path, false)
+	if err != nil {
+		return err
+	}
+
+	name := apiconn.ProfileName(cmd)
+	if name == "" {
+		name = cfg.CurrentProfile
+	}
+	if name == "" {
+		cfg.Devtoken = apiconn.NewDevtoken(params)
+	} else {
+		if cfg.Profiles == nil {
+			cfg.Profiles = map[string]apiconn.Profile{}
+		}
+		profile := cfg.Profiles[name]
+		profile.Devtoken = apiconn.NewDevtoken(params)
+		cfg.Profiles[name] = profile
+	}
+
+	if err := apiconn.SaveConfig(path, cfg); err != nil {
+		return err
+	}
+	if name == "" {
+		name = "default settings"
+	} else {
+		name = "profile " + name
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Saved dev token claims for %s to %s (%s)\n", params.Email, name, path)
+	return nil
+}
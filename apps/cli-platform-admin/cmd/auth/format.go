@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+)
+
+// devtoken --format values.
+const (
+	formatToken  = "token"
+	formatHeader = "header"
+	formatCurl   = "curl"
+	formatEnv    = "env"
+)
+
+func checkFormat(format string) error {
+	switch format {
+	case formatToken, formatHeader, formatCurl, formatEnv:
+		return nil
+	default:
+		return fmt.Errorf("invalid --format %q (use token, header, curl or env)", format)
+	}
+}
+
+// tokenView is what --output json|yaml prints for a token.
+type tokenView struct {
+	Persona       string `json:"persona,omitempty"`
+	Email         string `json:"email"`
+	Token         string `json:"token"`
+	Authorization string `json:"authorization"`
+}
+
+func authorization(token string) string {
+	return "Authorization: Bearer " + token
+}
+
+// renderToken writes token in format. persona names the token in a batch: env suffixes the variable with it, so the
+// tokens of every persona can be sourced together.
+func renderToken(out io.Writer, format, persona, token, url string) {
+	switch format {
+	case formatHeader:
+		fmt.Fprintln(out, authorization(token))
+	case formatCurl:
+		target := `"$` + apiconn.EnvAPIURL + `"`
+		if url != "" {
+			target = shellQuote(url)
+		}
+		fmt.Fprintf(out, "curl -H %s %s\n", shellQuote(authorization(token)), target)
+	case formatEnv:
+		fmt.Fprintf(out, "export %s=%s\n", envName(persona), shellQuote(token))
+	default:
+		fmt.Fprintln(out, token)
+	}
+}
+
+// envName is $PALMYRA_TOKEN, suffixed with the persona in upper case, e.g. PALMYRA_TOKEN_READ_ONLY.
+func envName(persona string) string {
+	if persona == "" {
+		return apiconn.EnvToken
+	}
+	suffix := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, persona)
+	return apiconn.EnvToken + "_" + suffix
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// clipboardCommands are tried in order; the first one on the PATH receives the text on stdin.
+var clipboardCommands = [][]string{
+	{"pbcopy"},
+	{"wl-copy"},
+	{"xclip", "-selection", "clipboard"},
+	{"xsel", "--clipboard", "--input"},
+	{"clip"},
+}
+
+func copyToClipboard(text string) error {
+	for _, command := range clipboardCommands {
+		path, err := exec.LookPath(command[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, command[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("copy to clipboard with %s: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return nil
+	}
+	return errors.New("copy to clipboard: none of pbcopy, wl-copy, xclip, xsel or clip is installed")
+}
@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth/devtoken"
+)
+
+func TestRenderToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		format  string
+		persona string
+		token   string
+		url     string
+		want    string
+	}{
+		{
+			name:   "token",
+			format: formatToken,
+			token:  "abc.def.",
+			want:   "abc.def.\n",
+		},
+		{
+			name:   "header",
+			format: formatHeader,
+			token:  "abc.def.",
+			want:   "Authorization: Bearer abc.def.\n",
+		},
+		{
+			name:   "curl against the api url variable",
+			format: formatCurl,
+			token:  "abc.def.",
+			want:   `curl -H 'Authorization: Bearer abc.def.' "$PALMYRA_API_URL"` + "\n",
+		},
+		{
+			name:   "curl against a url",
+			format: formatCurl,
+			token:  "abc.def.",
+			url:    "https://api.example.com/api/v1/entities?q=it's",
+			want:   `curl -H 'Authorization: Bearer abc.def.' 'https://api.example.com/api/v1/entities?q=it'\''s'` + "\n",
+		},
+		{
+			name:   "env without a persona",
+			format: formatEnv,
+			token:  "abc.def.",
+			want:   "export PALMYRA_TOKEN='abc.def.'\n",
+		},
+		{
+			name:    "env with a persona",
+			format:  formatEnv,
+			persona: "read-only admin2",
+			token:   "abc.def.",
+			want:    "export PALMYRA_TOKEN_READ_ONLY_ADMIN2='abc.def.'\n",
+		},
+		{
+			name:    "env quotes the token",
+			format:  formatEnv,
+			persona: "Editor",
+			token:   "it's",
+			want:    `export PALMYRA_TOKEN_EDITOR='it'\''s'` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			renderToken(&out, tt.format, tt.persona, tt.token, tt.url)
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestLoadPersonas(t *testing.T) {
+	t.Parallel()
+
+	base := devtoken.Params{
+		ProjectID: "palmyra-dev",
+		Tenant:    "acme-1a2b",
+		UserID:    "flag-user",
+		Email:     "flag@acme.test",
+		ExpiresIn: time.Hour,
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    []namedParams
+		wantErr string
+	}{
+		{
+			name: "defaults and personas override the flags",
+			file: `defaults:
+  tenant: acme-9z8y
+  emailVerified: true
+personas:
+  - persona: admin
+    userId: admin-1
+    email: admin@acme.test
+    isAdmin: true
+  - persona: viewer
+    userId: viewer-1
+    email: viewer@acme.test
+    tenant: beta-7x6w
+    tenantRoles: [viewer]
+`,
+			want: []namedParams{
+				{Name: "admin", Params: devtoken.Params{
+					ProjectID: "palmyra-dev", Tenant: "acme-9z8y", UserID: "admin-1", Email: "admin@acme.test",
+					EmailVerified: true, IsAdmin: true, ExpiresIn: time.Hour,
+				}},
+				{Name: "viewer", Params: devtoken.Params{
+					ProjectID: "palmyra-dev", Tenant: "beta-7x6w", UserID: "viewer-1", Email: "viewer@acme.test",
+					EmailVerified: true, TenantRoles: []string{"viewer"}, ExpiresIn: time.Hour,
+				}},
+			},
+		},
+		{
+			name: "without defaults the flags apply",
+			file: `personas:
+  - persona: " editor "
+    name: Ed
+`,
+			want: []namedParams{
+				{Name: "editor", Params: devtoken.Params{
+					ProjectID: "palmyra-dev", Tenant: "acme-1a2b", UserID: "flag-user", Email: "flag@acme.test",
+					Name: "Ed", ExpiresIn: time.Hour,
+				}},
+			},
+		},
+		{
+			name: "missing persona name",
+			file: `personas:
+  - persona: admin
+  - email: nobody@acme.test
+`,
+			wantErr: "personas[1]: persona is required",
+		},
+		{
+			name: "duplicate persona",
+			file: `personas:
+  - persona: admin
+  - persona: admin
+`,
+			wantErr: `personas[1]: duplicate persona "admin"`,
+		},
+		{
+			name:    "no personas",
+			file:    "defaults:\n  tenant: acme-9z8y\n",
+			wantErr: "no personas",
+		},
+		{
+			name:    "empty file",
+			wantErr: "no personas",
+		},
+		{
+			name: "unknown persona field",
+			file: `personas:
+  - persona: admin
+    role: admin
+`,
+			wantErr: "field role not found",
+		},
+		{
+			name: "unknown defaults field",
+			file: `defaults:
+  projectID: other
+personas:
+  - persona: admin
+`,
+			wantErr: "field projectID not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "personas.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.file), 0o600))
+
+			personas, err := loadPersonas(path, base)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, personas)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := loadPersonas(filepath.Join(t.TempDir(), "missing.yaml"), base)
+		require.ErrorContains(t, err, "read personas file")
+	})
+}
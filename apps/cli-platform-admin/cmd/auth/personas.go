@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth/devtoken"
+)
+
+// personaFile is the content of a --personas file: claims shared by every persona and the personas themselves, in
+// the field names of the devtoken config entry.
+type personaFile struct {
+	Defaults apiconn.Devtoken `yaml:"defaults"`
+	Personas []persona        `yaml:"personas"`
+}
+
+// persona is named by Persona, since name is the display name claim.
+type persona struct {
+	Persona          string `yaml:"persona"`
+	apiconn.Devtoken `yaml:",inline"`
+}
+
+// namedParams are the claims of one persona.
+type namedParams struct {
+	Name   string
+	Params devtoken.Params
+}
+
+// loadPersonas reads the persona file at path. The claim flags are the base of every persona; the file's defaults
+// and then each persona override the claims they set.
+func loadPersonas(path string, base devtoken.Params) ([]namedParams, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read personas file: %w", err)
+	}
+
+	// A typed pass catches unknown fields, which decoding the raw nodes below would ignore.
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var typed personaFile
+	if err := dec.Decode(&typed); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	var raw struct {
+		Defaults yaml.Node   `yaml:"defaults"`
+		Personas []yaml.Node `yaml:"personas"`
+	}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if len(raw.Personas) == 0 {
+		return nil, fmt.Errorf("%s: no personas", path)
+	}
+
+	seen := map[string]bool{}
+	personas := make([]namedParams, 0, len(raw.Personas))
+	for i, node := range raw.Personas {
+		// Decoding into a filled struct keeps the fields the node does not set.
+		p := persona{Devtoken: *apiconn.NewDevtoken(base)}
+		if !raw.Defaults.IsZero() {
+			if err := raw.Defaults.Decode(&p.Devtoken); err != nil {
+				return nil, fmt.Errorf("%s: defaults: %w", path, err)
+			}
+		}
+		if err := node.Decode(&p); err != nil {
+			return nil, fmt.Errorf("%s: personas[%d]: %w", path, i, err)
+		}
+
+		name := strings.TrimSpace(p.Persona)
+		switch {
+		case name == "":
+			return nil, fmt.Errorf("%s: personas[%d]: persona is required", path, i)
+		case seen[name]:
+			return nil, fmt.Errorf("%s: personas[%d]: duplicate persona %q", path, i, name)
+		}
+		seen[name] = true
+		personas = append(personas, namedParams{Name: name, Params: p.Params()})
+	}
+	return personas, nil
+}
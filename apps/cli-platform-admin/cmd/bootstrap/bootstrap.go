@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/spf13/cobra"
 
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
@@ -31,6 +33,15 @@ func Command() *cobra.Command {
 	return cmd
 }
 
+// platformResult is what bootstrap platform prints with --output json|yaml.
+type platformResult struct {
+	AdminTenantID   uuid.UUID `json:"adminTenantId"`
+	AdminTenantSlug string    `json:"adminTenantSlug"`
+	AdminSchema     string    `json:"adminSchema"`
+	AdminUserID     uuid.UUID `json:"adminUserId"`
+	AdminEmail      string    `json:"adminEmail"`
+}
+
 func platformCommand() *cobra.Command {
 	var (
 		databaseURL     string
@@ -104,8 +115,17 @@ func platformCommand() *cobra.Command {
 				}
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "Platform bootstrap complete. Admin tenant: %s (%s)\n", tenantRec.Slug, tenantRec.TenantID)
-			return nil
+			result := platformResult{
+				AdminTenantID:   tenantRec.TenantID,
+				AdminTenantSlug: tenantRec.Slug,
+				AdminSchema:     adminSchema,
+				AdminUserID:     adminUserID,
+				AdminEmail:      adminEmail,
+			}
+			return output.Print(cmd, result, func(out io.Writer) error {
+				fmt.Fprintf(out, "Platform bootstrap complete. Admin tenant: %s (%s)\n", tenantRec.Slug, tenantRec.TenantID)
+				return nil
+			})
 		},
 	}
 
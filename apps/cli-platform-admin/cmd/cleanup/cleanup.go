@@ -0,0 +1,247 @@
+package cleanupcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// purgedBy names the cleanup command as the actor in purge_audit.
+const purgedBy = "cli-platform-admin cleanup"
+
+// Command permanently removes soft-deleted records older than the retention window: the entities of every
+// database-ready tenant (the admin tenant included), then the platform's schema versions and categories.
+func Command() *cobra.Command {
+	var (
+		databaseURL     string
+		envKey          string
+		adminTenantSlug string
+		tenantSlug      string
+		retention       time.Duration
+		dryRun          bool
+	)
+
+	c := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Purge soft-deleted entities, schema versions and categories older than the retention window",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if retention <= 0 {
+				return errors.New("--retention must be positive")
+			}
+			ctx := context.Background()
+
+			pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: databaseURL})
+			if err != nil {
+				return fmt.Errorf("init pool: %w", err)
+			}
+			defer persistence.ClosePool(pool)
+
+			adminSchema := tenant.BuildSchemaName(envKey, tenant.ToSnake(adminTenantSlug))
+			tenantStore, err := persistence.NewTenantStore(ctx, pool, adminSchema)
+			if err != nil {
+				return fmt.Errorf("init tenant store: %w", err)
+			}
+			spaces, err := listTenantSpaces(ctx, tenantStore, strings.TrimSpace(tenantSlug))
+			if err != nil {
+				return err
+			}
+
+			out := output.Progress(cmd)
+			store := persistence.NewRetentionStore(persistence.NewSpaceDB(persistence.SpaceDBConfig{Pool: pool, AdminSchema: adminSchema}), nil)
+			opts := persistence.PurgeOptions{
+				Before:   time.Now().UTC().Add(-retention),
+				DryRun:   dryRun,
+				RunID:    uuid.New(),
+				PurgedBy: purgedBy,
+			}
+
+			verb := "purged"
+			if dryRun {
+				verb = "would purge"
+			}
+			failed, purged := 0, 0
+			result := cleanupResult{RunID: opts.RunID, DryRun: dryRun, Before: opts.Before, Targets: []targetResult{}}
+			for _, space := range spaces {
+				report, err := store.PurgeEntities(ctx, space, opts)
+				if err != nil {
+					failed++
+					fmt.Fprintf(out, "%s: failed: %v\n", space.Slug, err)
+					result.Targets = append(result.Targets, newTargetResult(space.Slug, persistence.PurgeReport{}, err))
+					continue
+				}
+				printReport(out, space.Slug, verb, report)
+				purged += len(report.Purged)
+				result.Targets = append(result.Targets, newTargetResult(space.Slug, report, nil))
+			}
+
+			if strings.TrimSpace(tenantSlug) == "" {
+				report, err := store.PurgeSchemas(ctx, opts)
+				if err != nil {
+					failed++
+					fmt.Fprintf(out, "platform: failed: %v\n", err)
+				} else {
+					printReport(out, "platform", verb, report)
+					purged += len(report.Purged)
+				}
+				result.Targets = append(result.Targets, newTargetResult("platform", report, err))
+			}
+			result.Purged, result.Failed = purged, failed
+
+			if err := output.Print(cmd, result, func(out io.Writer) error {
+				fmt.Fprintf(out, "Run %s: %s %d record(s) deleted before %s, %d failed\n", opts.RunID, verb, purged, opts.Before.Format(time.RFC3339), failed)
+				if dryRun {
+					fmt.Fprintln(out, "Dry run: nothing was removed.")
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return fmt.Errorf("cleanup failed for %d target(s)", failed)
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&databaseURL, "database-url", "", "PostgreSQL connection string")
+	c.Flags().StringVar(&envKey, "env-key", "dev", "Environment key prefix (e.g. dev, stg, prod)")
+	c.Flags().StringVar(&adminTenantSlug, "admin-tenant-slug", "admin", "Slug of the admin tenant (derives the admin schema)")
+	c.Flags().StringVar(&tenantSlug, "tenant-slug", "", "Only purge this tenant's entities (skips schema versions and categories)")
+	c.Flags().DurationVar(&retention, "retention", 30*24*time.Hour, "How long soft-deleted records are kept before they are purged")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "List the records that would be purged without removing them")
+	_ = c.MarkFlagRequired("database-url")
+
+	return c
+}
+
+func printReport(out io.Writer, target, verb string, report persistence.PurgeReport) {
+	if len(report.Purged)+len(report.Skipped) == 0 {
+		fmt.Fprintf(out, "%s: nothing to purge\n", target)
+		return
+	}
+	for _, record := range report.Purged {
+		fmt.Fprintf(out, "%s: %s %s (deleted %s)\n", target, verb, describe(record), record.DeletedAt.UTC().Format(time.RFC3339))
+	}
+	for _, record := range report.Skipped {
+		fmt.Fprintf(out, "%s: kept %s: %s\n", target, describe(record), record.Reason)
+	}
+}
+
+// cleanupResult is what cleanup prints with --output json|yaml. Purged counts the records removed, or that would be
+// on a dry run.
+type cleanupResult struct {
+	RunID   uuid.UUID      `json:"runId"`
+	DryRun  bool           `json:"dryRun"`
+	Before  time.Time      `json:"before"`
+	Purged  int            `json:"purged"`
+	Failed  int            `json:"failed"`
+	Targets []targetResult `json:"targets"`
+}
+
+// targetResult covers a tenant, by slug, or "platform" for schema versions and categories.
+type targetResult struct {
+	Target  string       `json:"target"`
+	Purged  []recordView `json:"purged"`
+	Skipped []recordView `json:"skipped"`
+	Error   string       `json:"error,omitempty"`
+}
+
+type recordView struct {
+	Kind      persistence.PurgeKind `json:"kind"`
+	TenantID  *uuid.UUID            `json:"tenantId,omitempty"`
+	TableName string                `json:"tableName,omitempty"`
+	RecordID  string                `json:"recordId"`
+	Version   string                `json:"version,omitempty"`
+	Versions  int                   `json:"versions,omitempty"`
+	DeletedAt time.Time             `json:"deletedAt"`
+	Reason    string                `json:"reason,omitempty"`
+}
+
+func newTargetResult(target string, report persistence.PurgeReport, err error) targetResult {
+	result := targetResult{Target: target, Purged: recordViews(report.Purged), Skipped: recordViews(report.Skipped)}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func recordViews(records []persistence.PurgedRecord) []recordView {
+	views := make([]recordView, 0, len(records))
+	for _, record := range records {
+		views = append(views, recordView{
+			Kind:      record.Kind,
+			TenantID:  record.TenantID,
+			TableName: record.TableName,
+			RecordID:  record.RecordID,
+			Version:   record.Version,
+			Versions:  record.Versions,
+			DeletedAt: record.DeletedAt.UTC(),
+			Reason:    record.Reason,
+		})
+	}
+	return views
+}
+
+func describe(record persistence.PurgedRecord) string {
+	switch record.Kind {
+	case persistence.PurgeEntity:
+		return fmt.Sprintf("entity %s/%s (%d versions)", record.TableName, record.RecordID, record.Versions)
+	case persistence.PurgeSchemaVersion:
+		return fmt.Sprintf("schema %s@%s (%s)", record.RecordID, record.Version, record.TableName)
+	default:
+		return fmt.Sprintf("%s %s", record.Kind, record.RecordID)
+	}
+}
+
+// listTenantSpaces returns the spaces of every database-ready tenant, or only the one with slug when set.
+func listTenantSpaces(ctx context.Context, store *persistence.TenantStore, slug string) ([]tenant.Space, error) {
+	if slug != "" {
+		rec, err := store.GetBySlug(ctx, slug)
+		if err != nil {
+			return nil, fmt.Errorf("get tenant %s: %w", slug, err)
+		}
+		if !rec.DBReady {
+			return nil, fmt.Errorf("tenant %s database is not provisioned", slug)
+		}
+		return []tenant.Space{spaceOf(rec)}, nil
+	}
+
+	const pageSize = 100
+
+	spaces := make([]tenant.Space, 0)
+	for offset := 0; ; offset += pageSize {
+		records, total, err := store.ListActive(ctx, persistence.TenantListParams{}, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list tenants: %w", err)
+		}
+		for _, rec := range records {
+			if rec.DBReady {
+				spaces = append(spaces, spaceOf(rec))
+			}
+		}
+		if offset+pageSize >= total || len(records) == 0 {
+			return spaces, nil
+		}
+	}
+}
+
+func spaceOf(rec persistence.TenantRecord) tenant.Space {
+	return tenant.Space{
+		TenantID:      rec.TenantID,
+		Slug:          rec.Slug,
+		ShortTenantID: rec.ShortTenantID,
+		SchemaName:    rec.SchemaName,
+		BasePrefix:    rec.BasePrefix,
+		RoleName:      rec.RoleName,
+	}
+}
@@ -0,0 +1,278 @@
+package configcmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+)
+
+// Command groups the config profile helpers. Profiles live in the --config file and hold the settings other
+// commands would otherwise need as flags on every call.
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage config profiles (API URL, token, database URL, environment)",
+	}
+
+	// The flags of config set are values to store, not settings to fill from the profile.
+	apiconn.SkipProfile(cmd)
+	cmd.AddCommand(setCommand())
+	cmd.AddCommand(useCommand())
+	cmd.AddCommand(listCommand())
+	cmd.AddCommand(deleteCommand())
+	return cmd
+}
+
+func setCommand() *cobra.Command {
+	var (
+		profile    apiconn.Profile
+		tokenStdin bool
+		use        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set <name>",
+		Short: "Create a profile or update the settings passed as flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[0])
+			if name == "" {
+				return errors.New("profile name is required")
+			}
+			if tokenStdin {
+				if cmd.Flags().Changed("token") {
+					return errors.New("pass either --token or --token-stdin")
+				}
+				token, err := readToken(cmd)
+				if err != nil {
+					return err
+				}
+				profile.Token = token
+			}
+
+			path, cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+			if cfg.Profiles == nil {
+				cfg.Profiles = map[string]apiconn.Profile{}
+			}
+			current, exists := cfg.Profiles[name]
+
+			// Only the flags that were passed change; passing one empty clears the setting.
+			set := func(flag string, dst *string, value string) {
+				if cmd.Flags().Changed(flag) {
+					*dst = strings.TrimSpace(value)
+				}
+			}
+			set("env", &current.Env, profile.Env)
+			set("api-url", &current.APIURL, profile.APIURL)
+			set("token", &current.Token, profile.Token)
+			if tokenStdin {
+				current.Token = profile.Token
+			}
+			set("tenant", &current.Tenant, profile.Tenant)
+			set("database-url", &current.DatabaseURL, profile.DatabaseURL)
+			set("admin-tenant-slug", &current.AdminTenantSlug, profile.AdminTenantSlug)
+			cfg.Profiles[name] = current
+			if use || cfg.CurrentProfile == "" {
+				cfg.CurrentProfile = name
+			}
+
+			if err := apiconn.SaveConfig(path, cfg); err != nil {
+				return err
+			}
+			verb := "Updated"
+			if !exists {
+				verb = "Created"
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s profile %s in %s\n", verb, name, path)
+			if cfg.CurrentProfile == name {
+				fmt.Fprintf(cmd.OutOrStdout(), "Current profile: %s\n", name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&profile.APIURL, "api-url", "", "Palmyra API root, e.g. https://api.example.com")
+	cmd.Flags().StringVar(&profile.Token, "token", "", "Bearer token for the API (prefer --token-stdin, which keeps it out of the shell history)")
+	cmd.Flags().BoolVar(&tokenStdin, "token-stdin", false, "Read the bearer token from stdin")
+	cmd.Flags().StringVar(&profile.Tenant, "tenant", "", "Tenant id or slug to run API requests in")
+	cmd.Flags().StringVar(&profile.Env, "env", "", "Environment key for --env-key (e.g. dev, stg, prod)")
+	cmd.Flags().StringVar(&profile.DatabaseURL, "database-url", "", "PostgreSQL connection string for --direct and the database commands")
+	cmd.Flags().StringVar(&profile.AdminTenantSlug, "admin-tenant-slug", "", "Admin tenant slug for --admin-tenant-slug")
+	cmd.Flags().BoolVar(&use, "use", false, "Also make the profile the current one (the first profile always becomes current)")
+	return cmd
+}
+
+func useCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Make a profile the current one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+			name := strings.TrimSpace(args[0])
+			if _, ok := cfg.Profiles[name]; !ok {
+				return fmt.Errorf("profile %q not found in %s", name, path)
+			}
+
+			cfg.CurrentProfile = name
+			if err := apiconn.SaveConfig(path, cfg); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Current profile: %s\n", name)
+			return nil
+		},
+	}
+}
+
+func listCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List profiles; tokens and database passwords are redacted",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+			names := make([]string, 0, len(cfg.Profiles))
+			for name := range cfg.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			views := make([]profileView, 0, len(names))
+			for _, name := range names {
+				// Show what commands would use: the profile over the top-level settings.
+				profile, err := cfg.Select(name)
+				if err != nil {
+					return err
+				}
+				view := profileView{
+					Name:            name,
+					Current:         name == cfg.CurrentProfile,
+					Env:             profile.Env,
+					APIURL:          profile.APIURL,
+					Tenant:          profile.Tenant,
+					DatabaseURL:     redactDatabaseURL(profile.DatabaseURL),
+					AdminTenantSlug: profile.AdminTenantSlug,
+				}
+				switch {
+				case profile.Token != "":
+					view.Token = "set"
+				case profile.Devtoken != nil:
+					view.Token = "devtoken " + profile.Devtoken.Email
+				}
+				views = append(views, view)
+			}
+
+			return output.Print(cmd, views, func(out io.Writer) error {
+				if len(views) == 0 {
+					fmt.Fprintf(out, "No profiles in %s; create one with: config set <name>\n", path)
+					return nil
+				}
+
+				tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "CURRENT\tNAME\tENV\tAPI_URL\tTENANT\tTOKEN\tDATABASE_URL")
+				for _, view := range views {
+					current := ""
+					if view.Current {
+						current = "*"
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", current, view.Name, view.Env, view.APIURL, view.Tenant, view.Token, view.DatabaseURL)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+}
+
+func deleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+			name := strings.TrimSpace(args[0])
+			if _, ok := cfg.Profiles[name]; !ok {
+				return fmt.Errorf("profile %q not found in %s", name, path)
+			}
+
+			delete(cfg.Profiles, name)
+			if cfg.CurrentProfile == name {
+				cfg.CurrentProfile = ""
+			}
+			if err := apiconn.SaveConfig(path, cfg); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted profile %s\n", name)
+			return nil
+		},
+	}
+}
+
+// profileView is a config list row. Token only says whether a token or dev token claims are set, and DatabaseURL
+// has its password redacted.
+type profileView struct {
+	Name            string `json:"name"`
+	Current         bool   `json:"current"`
+	Env             string `json:"env"`
+	APIURL          string `json:"apiUrl"`
+	Tenant          string `json:"tenant"`
+	Token           string `json:"token"`
+	DatabaseURL     string `json:"databaseUrl"`
+	AdminTenantSlug string `json:"adminTenantSlug"`
+}
+
+// loadConfig reads the --config file; a missing one is empty, since set creates it.
+func loadConfig(cmd *cobra.Command) (string, apiconn.Config, error) {
+	path, _ := apiconn.ConfigPath(cmd)
+	cfg, err := apiconn.LoadConfig(path, false)
+	return path, cfg, err
+}
+
+func readToken(cmd *cobra.Command) (string, error) {
+	scanner := bufio.NewScanner(cmd.InOrStdin())
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return "", fmt.Errorf("read token from stdin: %w", err)
+		}
+		return "", errors.New("no token on stdin")
+	}
+	token := strings.TrimSpace(scanner.Text())
+	if token == "" {
+		return "", errors.New("no token on stdin")
+	}
+	return token, nil
+}
+
+// redactDatabaseURL hides the password of a connection URL.
+func redactDatabaseURL(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "(unparsable)"
+	}
+	return u.Redacted()
+}
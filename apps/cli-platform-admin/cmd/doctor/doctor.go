@@ -0,0 +1,133 @@
+package doctorcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/doctor"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Command checks that an environment is ready for the api server and worker: the database is reachable and
+// migrated, the contracts load and the storage credentials work. Flags default to the api server variables, so a
+// pipeline can run it with the deployment's environment. It exits non-zero when the environment is not ready.
+func Command() *cobra.Command {
+	var (
+		databaseURL     string
+		envKey          string
+		adminTenantSlug string
+		contractsDir    string
+		storageBackend  string
+		storageBucket   string
+		storageLocalDir string
+		timeout         time.Duration
+	)
+
+	c := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the database, migrations, contracts and storage of an environment and print a readiness report",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if timeout <= 0 {
+				return errors.New("--timeout must be positive")
+			}
+			checks := []doctor.Check{
+				doctor.Database("database", databaseURL),
+				doctor.Migrations(databaseURL, tenant.BuildSchemaName(envKey, tenant.ToSnake(adminTenantSlug))),
+				doctor.Contracts(contractsDir, nil),
+				storageCheck(storageBackend, storageBucket, storageLocalDir),
+			}
+
+			report := doctor.Run(context.Background(), timeout, checks...)
+			if err := output.Print(cmd, newReportView(report), func(out io.Writer) error {
+				report.Write(out)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if !report.Ready() {
+				return errors.New("environment is not ready")
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&databaseURL, "database-url", os.Getenv("DATABASE_URL"), "PostgreSQL connection string (default $DATABASE_URL)")
+	c.Flags().StringVar(&envKey, "env-key", envOr("ENV_KEY", "dev"), "Environment key prefix (e.g. dev, stg, prod)")
+	c.Flags().StringVar(&adminTenantSlug, "admin-tenant-slug", envOr("ADMIN_TENANT_SLUG", "admin"), "Slug of the admin tenant (derives the admin schema)")
+	c.Flags().StringVar(&contractsDir, "contracts-dir", "contracts", "Directory of the OpenAPI contracts")
+	c.Flags().StringVar(&storageBackend, "storage-backend", envOr("STORAGE_BACKEND", "gcs"), "Storage backend to verify (gcs or local)")
+	c.Flags().StringVar(&storageBucket, "storage-bucket", os.Getenv("STORAGE_BUCKET"), "GCS bucket, for --storage-backend gcs")
+	c.Flags().StringVar(&storageLocalDir, "storage-local-dir", envOr("STORAGE_LOCAL_DIR", "./.data/storage"), "Storage directory, for --storage-backend local")
+	c.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Time allowed for each check")
+
+	return c
+}
+
+// reportView is what doctor prints with --output json|yaml.
+type reportView struct {
+	Ready  bool        `json:"ready"`
+	Checks []checkView `json:"checks"`
+}
+
+type checkView struct {
+	Name       string        `json:"name"`
+	Status     doctor.Status `json:"status"`
+	Detail     string        `json:"detail"`
+	DurationMS int64         `json:"durationMs"`
+}
+
+func newReportView(report doctor.Report) reportView {
+	view := reportView{Ready: report.Ready(), Checks: make([]checkView, 0, len(report.Results))}
+	for _, result := range report.Results {
+		view.Checks = append(view.Checks, checkView{
+			Name:       result.Name,
+			Status:     result.Status,
+			Detail:     result.Detail,
+			DurationMS: result.Duration.Milliseconds(),
+		})
+	}
+	return view
+}
+
+// storageCheck lists the gcs bucket with the application default credentials, or writes to the local directory.
+func storageCheck(backend, bucket, localDir string) doctor.Check {
+	switch backend {
+	case "gcs":
+		return doctor.Check{Name: "storage", Run: func(ctx context.Context) (string, error) {
+			if strings.TrimSpace(bucket) == "" {
+				return "", errors.New("--storage-bucket is required for gcs")
+			}
+			detail := "gcs bucket " + bucket
+			client, err := gcs.NewClient(ctx)
+			if err != nil {
+				return detail, fmt.Errorf("init gcs client: %w", err)
+			}
+			defer client.Close()
+			return detail, storage.NewGCSObjectStore(client, bucket).Probe(ctx)
+		}}
+	case "local":
+		if strings.TrimSpace(localDir) != "" {
+			return doctor.Probe("storage", "local directory "+localDir, storage.NewLocalObjectStore(localDir))
+		}
+	}
+	return doctor.Check{Name: "storage", Run: func(context.Context) (string, error) {
+		return "", fmt.Errorf("invalid storage backend %q (use gcs or local with a directory)", backend)
+	}}
+}
+
+func envOr(name, fallback string) string {
+	if value := strings.TrimSpace(os.Getenv(name)); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,20 @@
+package entitiescmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+)
+
+// Command groups the entity bulk helpers. They only work through the API, in the tenant of the token or of --tenant.
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entities",
+		Short: "Bulk export and import the entity documents of a table as NDJSON through the API",
+	}
+
+	apiconn.AddAPIFlags(cmd)
+	cmd.AddCommand(exportCommand())
+	cmd.AddCommand(importCommand())
+	return cmd
+}
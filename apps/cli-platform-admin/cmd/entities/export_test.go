@@ -0,0 +1,139 @@
+package entitiescmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pageServer fakes the listing of the orders table: pages of two documents chained by cursors that name the offset
+// of the next page, c2, c4, ... When fail is set, listing from failCursor fails.
+type pageServer struct {
+	ids        []string
+	fail       bool
+	failCursor string
+
+	mu      sync.Mutex
+	cursors []string
+}
+
+func (s *pageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/v1/entities/orders/documents" || r.URL.Query().Get("sort") != "createdAt" {
+		http.NotFound(w, r)
+		return
+	}
+	cursor := r.URL.Query().Get("cursor")
+	s.mu.Lock()
+	s.cursors = append(s.cursors, cursor)
+	s.mu.Unlock()
+	if s.fail && cursor == s.failCursor {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"title":"Bad Request","status":400}`))
+		return
+	}
+
+	start := 0
+	if cursor != "" {
+		_, _ = fmt.Sscanf(cursor, "c%d", &start)
+	}
+	end := min(start+2, len(s.ids))
+	items := []map[string]any{}
+	for _, id := range s.ids[start:end] {
+		items = append(items, map[string]any{
+			"entityId":      id,
+			"entityVersion": "1.0.0",
+			"schemaId":      "00000000-0000-0000-0000-000000000001",
+			"schemaVersion": "1.0.0",
+			"hash":          "h-" + id,
+			"isActive":      true,
+			"isDeleted":     false,
+			"createdAt":     "2026-01-01T00:00:00Z",
+			"payload":       map[string]any{"id": id},
+		})
+	}
+	page := map[string]any{"items": items, "page": 1, "pageSize": 2, "totalItems": len(s.ids), "totalPages": 1}
+	if end < len(s.ids) {
+		page["nextCursor"] = fmt.Sprintf("c%d", end)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+func TestExportDocuments(t *testing.T) {
+	t.Parallel()
+
+	ids := []string{"o-1", "o-2", "o-3", "o-4", "o-5"}
+	tests := []struct {
+		name        string
+		cursor      string
+		fail        bool
+		failCursor  string
+		wantCursors []string
+		wantIDs     []string
+		wantErr     string
+	}{
+		{
+			name:        "walks every page",
+			wantCursors: []string{"", "c2", "c4"},
+			wantIDs:     ids,
+		},
+		{
+			name:        "resumes after the cursor",
+			cursor:      "c2",
+			wantCursors: []string{"c2", "c4"},
+			wantIDs:     []string{"o-3", "o-4", "o-5"},
+		},
+		{
+			name:        "names the cursor to resume from",
+			fail:        true,
+			failCursor:  "c4",
+			wantCursors: []string{"", "c2", "c4"},
+			wantIDs:     []string{"o-1", "o-2", "o-3", "o-4"},
+			wantErr:     "export orders stopped after 4 document(s): api answered 400: Bad Request; resume with --cursor c4",
+		},
+		{
+			name:        "a failed first page leaves nothing to resume",
+			fail:        true,
+			wantCursors: []string{""},
+			wantErr:     "export orders stopped after 0 document(s): api answered 400: Bad Request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &pageServer{ids: ids, fail: tt.fail, failCursor: tt.failCursor}
+			var file bytes.Buffer
+			n, err := exportDocuments(context.Background(), testCommand(), testClient(t, server), "orders", tt.cursor, 2, &file)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantCursors, server.cursors)
+			require.Equal(t, len(tt.wantIDs), n)
+
+			var got []string
+			for _, line := range strings.Split(strings.TrimSuffix(file.String(), "\n"), "\n") {
+				if line == "" {
+					continue
+				}
+				var doc struct {
+					EntityID string `json:"entityId"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(line), &doc))
+				got = append(got, doc.EntityID)
+			}
+			require.Equal(t, tt.wantIDs, got)
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package entitiescmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+// maxLineBytes bounds one NDJSON line, i.e. one document.
+const maxLineBytes = 64 << 20
+
+type importOptions struct {
+	table        string
+	batchSize    int
+	concurrency  int
+	offset       int
+	validateOnly bool
+}
+
+// importReport is what import prints. Rows count the non-blank lines read after --offset.
+type importReport struct {
+	Table        string `json:"table"`
+	ValidateOnly bool   `json:"validateOnly"`
+	Offset       int    `json:"offset"`
+	Rows         int    `json:"rows"`
+	Succeeded    int    `json:"succeeded"`
+	Failed       int    `json:"failed"`
+	// NextOffset is the --offset that continues an interrupted import.
+	NextOffset int          `json:"nextOffset"`
+	Failures   []rowFailure `json:"failures"`
+}
+
+type rowFailure struct {
+	Line     int    `json:"line"`
+	EntityID string `json:"entityId,omitempty"`
+	Error    string `json:"error"`
+}
+
+// importRow is a non-blank input line; rows that do not parse keep the reason and are never sent.
+type importRow struct {
+	line    int
+	request entitiesapi.CreateEntityDocumentRequest
+	err     error
+}
+
+type batchResult struct {
+	rows      int
+	succeeded int
+	failures  []rowFailure
+	err       error
+}
+
+func importCommand() *cobra.Command {
+	var (
+		opts   importOptions
+		inPath string
+	)
+
+	c := &cobra.Command{
+		Use:   "import",
+		Short: "Import an NDJSON file of documents into a table through the bulk endpoint",
+		Long: `Import an NDJSON file of documents into a table through the bulk endpoint.
+
+Each line holds an optional entityId and a payload, as export writes them. Rows are sent in batches, several at
+a time; rows the API rejects are reported without stopping the import. When a request fails the import stops and
+prints the --offset to resume from. --validate-only checks every row against the active schema without writing.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			switch {
+			case opts.batchSize < 1 || opts.batchSize > 1000:
+				return errors.New("--batch-size must be between 1 and 1000")
+			case opts.concurrency < 1:
+				return errors.New("--concurrency must be at least 1")
+			case opts.offset < 0:
+				return errors.New("--offset must not be negative")
+			}
+
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			in := io.Reader(cmd.InOrStdin())
+			total := 0
+			if inPath != "-" {
+				total, err = countRows(inPath)
+				if err != nil {
+					return err
+				}
+				total = max(total-opts.offset, 0)
+
+				file, err := os.Open(inPath)
+				if err != nil {
+					return fmt.Errorf("open input file: %w", err)
+				}
+				defer file.Close()
+				in = file
+			}
+
+			report, importErr := importEntities(context.Background(), cmd, client, opts, in, total)
+			if err := output.Print(cmd, report, func(out io.Writer) error {
+				printImportReport(out, report)
+				return nil
+			}); err != nil {
+				return err
+			}
+			switch {
+			case importErr != nil:
+				return fmt.Errorf("%w; resume with --offset %d", importErr, report.NextOffset)
+			case report.Failed > 0:
+				return fmt.Errorf("%d row(s) failed", report.Failed)
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&opts.table, "table", "", "Table name of the entity schema")
+	c.Flags().StringVar(&inPath, "in", "", "NDJSON file to read, or - for stdin")
+	c.Flags().IntVar(&opts.batchSize, "batch-size", 500, "Rows per bulk request (max 1000)")
+	c.Flags().IntVar(&opts.concurrency, "concurrency", 4, "Number of requests in flight")
+	c.Flags().IntVar(&opts.offset, "offset", 0, "Skip this many rows, e.g. the --offset printed by an interrupted import")
+	c.Flags().BoolVar(&opts.validateOnly, "validate-only", false, "Validate every row against the active schema without importing")
+	_ = c.MarkFlagRequired("table")
+	_ = c.MarkFlagRequired("in")
+	return c
+}
+
+// importEntities sends the rows after opts.offset in batches, at most opts.concurrency at a time. A failed request
+// stops the dispatch of further batches; the batches in flight still finish. NextOffset then points after the
+// leading batches that all went through, so a resumed import may send some later rows again: rows with an entityId
+// are rejected as duplicates, rows without one are created twice.
+func importEntities(ctx context.Context, cmd *cobra.Command, client *apiclient.Client, opts importOptions, in io.Reader, total int) (importReport, error) {
+	report := importReport{Table: opts.table, ValidateOnly: opts.validateOnly, Offset: opts.offset, Failures: []rowFailure{}}
+	label := "Importing " + opts.table
+	if opts.validateOnly {
+		label = "Validating " + opts.table
+	}
+	bar := output.NewBar(cmd, label, total)
+
+	var (
+		mu      sync.Mutex
+		results []batchResult
+		stopErr error
+		wg      sync.WaitGroup
+	)
+	slots := make(chan struct{}, opts.concurrency)
+	stopped := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return stopErr != nil
+	}
+	dispatch := func(rows []importRow) {
+		mu.Lock()
+		i := len(results)
+		results = append(results, batchResult{rows: len(rows)})
+		mu.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			var result batchResult
+			if opts.validateOnly {
+				result = validateBatch(ctx, client, opts.table, rows)
+			} else {
+				result = createBatch(ctx, client, opts.table, rows)
+			}
+			bar.Add(len(rows))
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[i] = result
+			if result.err != nil && stopErr == nil {
+				stopErr = result.err
+			}
+		}()
+	}
+
+	readErr := readRows(in, opts.offset, opts.batchSize, func(rows []importRow) bool {
+		// Waiting for a slot first lets a failure in flight stop this batch too.
+		slots <- struct{}{}
+		if stopped() {
+			<-slots
+			return false
+		}
+		dispatch(rows)
+		return true
+	})
+	wg.Wait()
+	bar.Finish()
+
+	report.NextOffset = opts.offset
+	leading := true
+	for _, result := range results {
+		if result.err != nil {
+			leading = false
+			continue
+		}
+		if leading {
+			report.NextOffset += result.rows
+		}
+		report.Rows += result.rows
+		report.Succeeded += result.succeeded
+		report.Failed += len(result.failures)
+		report.Failures = append(report.Failures, result.failures...)
+	}
+	sort.Slice(report.Failures, func(i, j int) bool { return report.Failures[i].Line < report.Failures[j].Line })
+
+	switch {
+	case stopErr != nil:
+		return report, fmt.Errorf("import %s stopped: %w", opts.table, stopErr)
+	case readErr != nil:
+		return report, fmt.Errorf("read input: %w", readErr)
+	}
+	return report, nil
+}
+
+// readRows hands the rows after offset to batch in groups of size, until the input ends or batch returns false.
+func readRows(in io.Reader, offset, size int, batch func([]importRow) bool) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+
+	var (
+		line, seen int
+		rows       []importRow
+	)
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		seen++
+		if seen <= offset {
+			continue
+		}
+
+		rows = append(rows, parseRow(line, text))
+		if len(rows) == size {
+			if !batch(rows) {
+				return nil
+			}
+			rows = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(rows) > 0 {
+		batch(rows)
+	}
+	return nil
+}
+
+func parseRow(line int, text []byte) importRow {
+	row := importRow{line: line}
+	if err := json.Unmarshal(text, &row.request); err != nil {
+		row.err = fmt.Errorf("invalid JSON: %w", err)
+		return row
+	}
+	if row.request.Payload == nil {
+		row.err = errors.New("payload is required")
+	}
+	return row
+}
+
+// createBatch sends the rows that parsed to the bulk endpoint and maps its per-row report back onto input lines.
+func createBatch(ctx context.Context, client *apiclient.Client, table string, rows []importRow) batchResult {
+	result := batchResult{rows: len(rows)}
+	body := make(entitiesapi.BulkCreateEntityDocumentsRequest, 0, len(rows))
+	sent := make([]importRow, 0, len(rows))
+	for _, row := range rows {
+		if row.err != nil {
+			result.failures = append(result.failures, failureOf(row, row.err.Error()))
+			continue
+		}
+		body = append(body, row.request)
+		sent = append(sent, row)
+	}
+	if len(body) == 0 {
+		return result
+	}
+
+	rsp, err := client.Entities.BulkCreateDocumentsWithResponse(ctx, table, body)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+		result.err = err
+		return result
+	}
+
+	result.succeeded = rsp.JSON200.Succeeded
+	for _, r := range rsp.JSON200.Results {
+		if r.Status != entitiesapi.Failed || r.Index < 0 || r.Index >= len(sent) {
+			continue
+		}
+		reason := "rejected"
+		if r.Error != nil {
+			reason = *r.Error
+		}
+		result.failures = append(result.failures, failureOf(sent[r.Index], reason))
+	}
+	return result
+}
+
+// validateBatch checks the rows one by one against the active schema; nothing is written.
+func validateBatch(ctx context.Context, client *apiclient.Client, table string, rows []importRow) batchResult {
+	result := batchResult{rows: len(rows)}
+	for _, row := range rows {
+		if row.err != nil {
+			result.failures = append(result.failures, failureOf(row, row.err.Error()))
+			continue
+		}
+
+		rsp, err := client.Entities.ValidateDocumentWithResponse(ctx, table, row.request)
+		if err != nil {
+			result.err = err
+			return result
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			result.err = err
+			return result
+		}
+		if !rsp.JSON200.Valid {
+			result.failures = append(result.failures, failureOf(row, formatValidationErrors(rsp.JSON200.Errors)))
+			continue
+		}
+		result.succeeded++
+	}
+	return result
+}
+
+func failureOf(row importRow, reason string) rowFailure {
+	failure := rowFailure{Line: row.line, Error: reason}
+	if row.request.EntityId != nil {
+		failure.EntityID = *row.request.EntityId
+	}
+	return failure
+}
+
+func formatValidationErrors(fields map[string][]string) string {
+	if len(fields) == 0 {
+		return "invalid"
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+": "+strings.Join(fields[name], ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// countRows counts the non-blank lines of path, for the progress bar.
+func countRows(path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("open input file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+	rows := 0
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) > 0 {
+			rows++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("read input file: %w", err)
+	}
+	return rows, nil
+}
+
+func printImportReport(out io.Writer, report importReport) {
+	if report.ValidateOnly {
+		fmt.Fprintf(out, "%d of %d row(s) valid for %s; %d invalid\n", report.Succeeded, report.Rows, report.Table, report.Failed)
+	} else {
+		fmt.Fprintf(out, "Imported %d of %d row(s) into %s; %d failed\n", report.Succeeded, report.Rows, report.Table, report.Failed)
+	}
+	if len(report.Failures) == 0 {
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "LINE\tENTITY_ID\tERROR")
+	for _, f := range report.Failures {
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", f.Line, f.EntityID, f.Error)
+	}
+	_ = tw.Flush()
+}
@@ -0,0 +1,281 @@
+package entitiescmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+// bulkServer fakes the bulk and validate endpoints of the orders table. Rows whose entityId is in reject fail with
+// that reason; the call numbered failCall (from 1) fails as a whole.
+type bulkServer struct {
+	reject   map[string]string
+	failCall int
+
+	mu    sync.Mutex
+	calls int
+	sent  []string
+}
+
+func (s *bulkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.calls == s.failCall {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"title":"Bad Request","status":400}`))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/api/v1/entities/orders/documents:bulk":
+		var rows entitiesapi.BulkCreateEntityDocumentsRequest
+		if err := json.NewDecoder(r.Body).Decode(&rows); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		report := entitiesapi.BulkCreateEntityDocumentsReport{Total: len(rows), Results: []entitiesapi.BulkCreateEntityDocumentResult{}}
+		for i, row := range rows {
+			id := s.record(row)
+			result := entitiesapi.BulkCreateEntityDocumentResult{Index: i, Status: entitiesapi.Created}
+			if reason, ok := s.reject[id]; ok {
+				result.Status = entitiesapi.Failed
+				result.Error = &reason
+				report.Failed++
+			} else {
+				report.Succeeded++
+			}
+			report.Results = append(report.Results, result)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	case "/api/v1/entities/orders/documents:validate":
+		var row entitiesapi.CreateEntityDocumentRequest
+		if err := json.NewDecoder(r.Body).Decode(&row); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result := entitiesapi.EntityValidationResult{Valid: true, Errors: map[string][]string{}}
+		if reason, ok := s.reject[s.record(row)]; ok {
+			result.Valid = false
+			result.Errors = map[string][]string{"total": {reason}, "customer": {"is required"}}
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// record notes the row as sent and returns its entityId, or its payload's name when it has none.
+func (s *bulkServer) record(row entitiesapi.CreateEntityDocumentRequest) string {
+	id, _ := row.Payload["name"].(string)
+	if row.EntityId != nil {
+		id = *row.EntityId
+	}
+	s.sent = append(s.sent, id)
+	return id
+}
+
+func testClient(t *testing.T, handler http.Handler) *apiclient.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := apiclient.New(server.URL, apiclient.WithRetryPolicy(apiclient.RetryPolicy{MaxAttempts: 1}))
+	require.NoError(t, err)
+	return client
+}
+
+func testCommand() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	return cmd
+}
+
+func TestImportEntities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		opts      importOptions
+		reject    map[string]string
+		failCall  int
+		wantSent  []string
+		wantErr   string
+		wantRows  int
+		wantOK    int
+		wantNext  int
+		wantFails []rowFailure
+	}{
+		{
+			name: "imports every row",
+			input: `{"entityId":"o-1","payload":{"total":1}}
+{"entityId":"o-2","payload":{"total":2}}
+{"payload":{"name":"o-3"}}
+`,
+			opts:     importOptions{batchSize: 2},
+			wantSent: []string{"o-1", "o-2", "o-3"},
+			wantRows: 3, wantOK: 3, wantNext: 3,
+		},
+		{
+			name: "resumes after the offset, which skips blank lines",
+			input: `{"entityId":"o-1","payload":{}}
+
+{"entityId":"o-2","payload":{}}
+{"entityId":"o-3","payload":{}}
+
+{"entityId":"o-4","payload":{}}
+`,
+			opts:     importOptions{batchSize: 10, offset: 2},
+			wantSent: []string{"o-3", "o-4"},
+			wantRows: 2, wantOK: 2, wantNext: 4,
+		},
+		{
+			name: "reports malformed lines without sending them",
+			input: `{"entityId":"o-1","payload":{}}
+{"entityId":"o-2",
+{"entityId":"o-3"}
+{"entityId":"o-4","payload":{}}
+`,
+			opts:     importOptions{batchSize: 10},
+			wantSent: []string{"o-1", "o-4"},
+			wantRows: 4, wantOK: 2, wantNext: 4,
+			wantFails: []rowFailure{
+				{Line: 2, Error: "invalid JSON: unexpected end of JSON input"},
+				{Line: 3, EntityID: "o-3", Error: "payload is required"},
+			},
+		},
+		{
+			name: "maps rejected rows back to their lines",
+			input: `{"entityId":"o-1","payload":{}}
+not json
+{"entityId":"o-2","payload":{}}
+{"payload":{"name":"o-3"}}
+`,
+			opts:     importOptions{batchSize: 2},
+			reject:   map[string]string{"o-2": "duplicate entityId", "o-3": "payload.total: is required"},
+			wantSent: []string{"o-1", "o-2", "o-3"},
+			wantRows: 4, wantOK: 1, wantNext: 4,
+			wantFails: []rowFailure{
+				{Line: 2, Error: "invalid JSON: invalid character 'o' in literal null (expecting 'u')"},
+				{Line: 3, EntityID: "o-2", Error: "duplicate entityId"},
+				{Line: 4, Error: "payload.total: is required"},
+			},
+		},
+		{
+			name: "stops at a failed request and points the offset at it",
+			input: `{"entityId":"o-1","payload":{}}
+{"entityId":"o-2","payload":{}}
+{"entityId":"o-3","payload":{}}
+{"entityId":"o-4","payload":{}}
+`,
+			opts:     importOptions{batchSize: 1, offset: 1},
+			failCall: 2,
+			wantSent: []string{"o-2"},
+			wantErr:  "import orders stopped",
+			wantRows: 1, wantOK: 1, wantNext: 2,
+		},
+		{
+			name: "validates rows without importing them",
+			input: `{"entityId":"o-1","payload":{}}
+{"entityId":"o-2","payload":{}}
+`,
+			opts:     importOptions{batchSize: 10, validateOnly: true},
+			reject:   map[string]string{"o-2": "must be positive"},
+			wantSent: []string{"o-1", "o-2"},
+			wantRows: 2, wantOK: 1, wantNext: 2,
+			wantFails: []rowFailure{
+				{Line: 2, EntityID: "o-2", Error: "customer: is required; total: must be positive"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &bulkServer{reject: tt.reject, failCall: tt.failCall}
+			opts := tt.opts
+			opts.table = "orders"
+			opts.concurrency = 1
+
+			report, err := importEntities(context.Background(), testCommand(), testClient(t, server), opts, strings.NewReader(tt.input), 0)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.wantSent, server.sent)
+			require.Equal(t, opts.offset, report.Offset)
+			require.Equal(t, tt.wantRows, report.Rows)
+			require.Equal(t, tt.wantOK, report.Succeeded)
+			require.Equal(t, len(tt.wantFails), report.Failed)
+			require.Equal(t, tt.wantNext, report.NextOffset)
+			if tt.wantFails == nil {
+				tt.wantFails = []rowFailure{}
+			}
+			require.Equal(t, tt.wantFails, report.Failures)
+		})
+	}
+}
+
+func TestPrintImportReport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		report importReport
+		want   string
+	}{
+		{
+			name:   "import without failures",
+			report: importReport{Table: "orders", Rows: 3, Succeeded: 3, Failures: []rowFailure{}},
+			want:   "Imported 3 of 3 row(s) into orders; 0 failed\n",
+		},
+		{
+			name: "partial failure lists the failed lines",
+			report: importReport{Table: "orders", Rows: 4, Succeeded: 2, Failed: 2, Failures: []rowFailure{
+				{Line: 2, Error: "invalid JSON"},
+				{Line: 13, EntityID: "o-13", Error: "duplicate entityId"},
+			}},
+			want: "Imported 2 of 4 row(s) into orders; 2 failed\n" +
+				"LINE  ENTITY_ID  ERROR\n" +
+				"2                invalid JSON\n" +
+				"13    o-13       duplicate entityId\n",
+		},
+		{
+			name: "validate only",
+			report: importReport{Table: "orders", ValidateOnly: true, Rows: 2, Succeeded: 1, Failed: 1, Failures: []rowFailure{
+				{Line: 1, EntityID: "o-1", Error: "total: is required"},
+			}},
+			want: "1 of 2 row(s) valid for orders; 1 invalid\n" +
+				"LINE  ENTITY_ID  ERROR\n" +
+				"1     o-1        total: is required\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			printImportReport(&out, tt.report)
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -28,6 +28,7 @@ new_category() {
     name="cli-cat-$(date +%s%N)"
     slug="${name}"
     if run "${BIN_PATH}" schema categories upsert \
+      --direct \
       --database-url "${DB_URL}" \
       --env-key "${ENV_KEY}" \
       --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -46,6 +47,7 @@ new_category() {
 
 first_category_id() {
   "${BIN_PATH}" schema categories list \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -54,6 +56,7 @@ first_category_id() {
 
 list_categories() {
   run "${BIN_PATH}" schema categories list \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}"
@@ -62,6 +65,7 @@ list_categories() {
 update_category() {
   local id=$1
   run "${BIN_PATH}" schema categories upsert \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -72,6 +76,7 @@ update_category() {
 delete_category() {
   local id=$1
   run "${BIN_PATH}" schema categories delete \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -80,6 +85,7 @@ delete_category() {
 
 schema_list_all() {
   run "${BIN_PATH}" schema definitions list \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}"
@@ -87,6 +93,7 @@ schema_list_all() {
 
 schema_list_all_full() {
   run "${BIN_PATH}" schema definitions list \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -108,6 +115,7 @@ schema_upsert() {
   fi
 
   run "${BIN_PATH}" schema definitions upsert \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -121,6 +129,7 @@ schema_upsert() {
 schema_delete() {
   local schema_id=$1 version=$2
   run "${BIN_PATH}" schema definitions delete \
+    --direct \
     --database-url "${DB_URL}" \
     --env-key "${ENV_KEY}" \
     --admin-tenant-slug "${ADMIN_SLUG}" \
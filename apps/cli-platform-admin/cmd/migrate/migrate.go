@@ -0,0 +1,219 @@
+package migratecmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Command applies the versioned migrations under database/migrations: the admin set and the tenant set to the admin
+// schema, then the tenant set to every database-ready tenant schema, followed by the entity tables of the active
+// schemas that a tenant schema lacks.
+func Command() *cobra.Command {
+	var (
+		databaseURL       string
+		envKey            string
+		adminTenantSlug   string
+		tenantSlug        string
+		dryRun            bool
+		parallelism       int
+		partitionEntities bool
+	)
+
+	c := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply pending database migrations to the admin schema and every tenant schema",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+
+			pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: databaseURL})
+			if err != nil {
+				return fmt.Errorf("init pool: %w", err)
+			}
+			defer persistence.ClosePool(pool)
+
+			adminSchema := tenant.BuildSchemaName(envKey, tenant.ToSnake(adminTenantSlug))
+			tenantStore, err := persistence.NewTenantStore(ctx, pool, adminSchema)
+			if err != nil {
+				return fmt.Errorf("init tenant store: %w", err)
+			}
+
+			out := output.Progress(cmd)
+			migrator := persistence.NewMigrator(pool)
+			spaceDB := persistence.NewSpaceDB(persistence.SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+			migrator.UseEntityTables(persistence.NewEntityTableStore(spaceDB, persistence.EntityPartitioning{Enabled: partitionEntities}))
+			tenantSlug = strings.TrimSpace(tenantSlug)
+			report := migrateReport{DryRun: dryRun, Schemas: []schemaMigrations{}}
+
+			if tenantSlug == "" {
+				migrations, err := migrateAdmin(ctx, out, migrator, adminSchema, dryRun)
+				if err != nil {
+					return err
+				}
+				report.Schemas = append(report.Schemas, newSchemaMigrations(adminSchema, "", migrations, nil))
+			}
+
+			spaces, err := listTenantSpaces(ctx, tenantStore, adminSchema, tenantSlug)
+			if err != nil {
+				return err
+			}
+
+			verb := "applied"
+			if dryRun {
+				verb = "pending"
+			}
+			failed := 0
+			results := migrator.MigrateTenants(ctx, spaces, persistence.TenantMigrationOptions{DryRun: dryRun, Parallelism: parallelism})
+			for _, result := range results {
+				printMigrations(out, result.Space.Slug, verb, result.Migrations)
+				if result.Err != nil {
+					failed++
+					fmt.Fprintf(out, "%s: failed: %v\n", result.Space.Slug, result.Err)
+				}
+				report.Schemas = append(report.Schemas, newSchemaMigrations(result.Space.SchemaName, result.Space.Slug, result.Migrations, result.Err))
+			}
+			report.Failed = failed
+
+			if err := output.Print(cmd, report, func(out io.Writer) error {
+				fmt.Fprintf(out, "Migrated %d tenant schema(s), %d failed\n", len(spaces), failed)
+				if dryRun {
+					fmt.Fprintln(out, "Dry run: no migration was applied.")
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return fmt.Errorf("migration failed for %d tenant(s)", failed)
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&databaseURL, "database-url", "", "PostgreSQL connection string")
+	c.Flags().StringVar(&envKey, "env-key", "dev", "Environment key prefix (e.g. dev, stg, prod)")
+	c.Flags().StringVar(&adminTenantSlug, "admin-tenant-slug", "admin", "Slug of the admin tenant (derives the admin schema)")
+	c.Flags().StringVar(&tenantSlug, "tenant-slug", "", "Only migrate this tenant's schema (skips the admin schema)")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "List the pending migrations without applying them")
+	c.Flags().IntVar(&parallelism, "parallelism", 4, "Number of tenant schemas migrated concurrently")
+	c.Flags().BoolVar(&partitionEntities, "partition-entities", false, "Partition the missing entity tables created in tenant schemas by month (like ENTITY_PARTITIONING)")
+	_ = c.MarkFlagRequired("database-url")
+
+	return c
+}
+
+// migrateAdmin migrates the admin schema, or lists its pending migrations on a dry run, and returns them.
+func migrateAdmin(ctx context.Context, out io.Writer, migrator *persistence.Migrator, adminSchema string, dryRun bool) ([]persistence.Migration, error) {
+	if dryRun {
+		var all []persistence.Migration
+		for _, set := range []string{persistence.AdminMigrationSet, persistence.TenantMigrationSet} {
+			pending, err := migrator.Pending(ctx, set, adminSchema)
+			if err != nil {
+				return nil, fmt.Errorf("list pending admin migrations: %w", err)
+			}
+			printMigrations(out, adminSchema, "pending", pending)
+			all = append(all, pending...)
+		}
+		return all, nil
+	}
+
+	applied, err := migrator.MigrateAdmin(ctx, adminSchema)
+	printMigrations(out, adminSchema, "applied", applied)
+	if err != nil {
+		return nil, fmt.Errorf("migrate admin schema: %w", err)
+	}
+	return applied, nil
+}
+
+// migrateReport is what migrate prints with --output json|yaml: the migrations applied to each schema, or pending
+// on a dry run.
+type migrateReport struct {
+	DryRun  bool               `json:"dryRun"`
+	Schemas []schemaMigrations `json:"schemas"`
+	Failed  int                `json:"failed"`
+}
+
+type schemaMigrations struct {
+	Schema     string          `json:"schema"`
+	TenantSlug string          `json:"tenantSlug,omitempty"`
+	Migrations []migrationView `json:"migrations"`
+	Error      string          `json:"error,omitempty"`
+}
+
+type migrationView struct {
+	Set     string `json:"set"`
+	Version string `json:"version"`
+}
+
+func newSchemaMigrations(schema, tenantSlug string, migrations []persistence.Migration, err error) schemaMigrations {
+	view := schemaMigrations{Schema: schema, TenantSlug: tenantSlug, Migrations: make([]migrationView, 0, len(migrations))}
+	for _, migration := range migrations {
+		view.Migrations = append(view.Migrations, migrationView{Set: migration.Set, Version: migration.Version})
+	}
+	if err != nil {
+		view.Error = err.Error()
+	}
+	return view
+}
+
+func printMigrations(out io.Writer, target, verb string, migrations []persistence.Migration) {
+	if len(migrations) == 0 {
+		fmt.Fprintf(out, "%s: up to date\n", target)
+		return
+	}
+	for _, migration := range migrations {
+		fmt.Fprintf(out, "%s: %s %s/%s\n", target, verb, migration.Set, migration.Version)
+	}
+}
+
+// listTenantSpaces returns the spaces of database-ready tenants other than the admin tenant, whose schema
+// MigrateAdmin covers. With slug set only that tenant is returned.
+func listTenantSpaces(ctx context.Context, store *persistence.TenantStore, adminSchema, slug string) ([]tenant.Space, error) {
+	if slug != "" {
+		rec, err := store.GetBySlug(ctx, slug)
+		if err != nil {
+			return nil, fmt.Errorf("get tenant %s: %w", slug, err)
+		}
+		if !rec.DBReady {
+			return nil, fmt.Errorf("tenant %s database is not provisioned", slug)
+		}
+		return []tenant.Space{spaceOf(rec)}, nil
+	}
+
+	const pageSize = 100
+
+	spaces := make([]tenant.Space, 0)
+	for offset := 0; ; offset += pageSize {
+		records, total, err := store.ListActive(ctx, persistence.TenantListParams{}, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list tenants: %w", err)
+		}
+		for _, rec := range records {
+			if !rec.DBReady || rec.SchemaName == adminSchema {
+				continue
+			}
+			spaces = append(spaces, spaceOf(rec))
+		}
+		if offset+pageSize >= total || len(records) == 0 {
+			return spaces, nil
+		}
+	}
+}
+
+func spaceOf(rec persistence.TenantRecord) tenant.Space {
+	return tenant.Space{
+		TenantID:      rec.TenantID,
+		Slug:          rec.Slug,
+		ShortTenantID: rec.ShortTenantID,
+		SchemaName:    rec.SchemaName,
+		BasePrefix:    rec.BasePrefix,
+		RoleName:      rec.RoleName,
+	}
+}
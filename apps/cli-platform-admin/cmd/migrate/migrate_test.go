@@ -0,0 +1,105 @@
+package migratecmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+func TestMigrateReportOutput(t *testing.T) {
+	t.Parallel()
+
+	report := migrateReport{
+		Schemas: []schemaMigrations{
+			newSchemaMigrations("tenant_admin", "", []persistence.Migration{{Set: "admin", Version: "0002", SQL: "ALTER TABLE"}}, nil),
+			newSchemaMigrations("tenant_acme", "acme", nil, nil),
+			newSchemaMigrations("tenant_globex", "globex", nil, errors.New("permission denied")),
+		},
+		Failed: 1,
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: "json",
+			want: `{
+  "dryRun": false,
+  "schemas": [
+    {
+      "schema": "tenant_admin",
+      "migrations": [
+        {
+          "set": "admin",
+          "version": "0002"
+        }
+      ]
+    },
+    {
+      "schema": "tenant_acme",
+      "tenantSlug": "acme",
+      "migrations": []
+    },
+    {
+      "schema": "tenant_globex",
+      "tenantSlug": "globex",
+      "migrations": [],
+      "error": "permission denied"
+    }
+  ],
+  "failed": 1
+}
+`,
+		},
+		{
+			format: "yaml",
+			want: `dryRun: false
+schemas:
+  - schema: tenant_admin
+    migrations:
+      - set: admin
+        version: "0002"
+  - schema: tenant_acme
+    tenantSlug: acme
+    migrations: []
+  - schema: tenant_globex
+    tenantSlug: globex
+    migrations: []
+    error: permission denied
+failed: 1
+`,
+		},
+		{
+			format: "table",
+			want:   "table\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+
+			root := &cobra.Command{Use: "cli-platform-admin"}
+			output.AddFlag(root)
+			cmd := &cobra.Command{Use: "migrate"}
+			root.AddCommand(cmd)
+			var out bytes.Buffer
+			root.SetOut(&out)
+			require.NoError(t, cmd.ParseFlags([]string{"--output", tt.format}))
+
+			require.NoError(t, output.Print(cmd, report, func(out io.Writer) error {
+				_, err := io.WriteString(out, "table\n")
+				return err
+			}))
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package schemacmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func bundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Export or import schema bundles (categories and schema versions)",
+	}
+
+	cmd.PersistentFlags().String("database-url", "", "PostgreSQL connection string")
+	cmd.PersistentFlags().String("env-key", "dev", "Environment key used to derive admin schema (e.g. dev, stg, prod)")
+	cmd.PersistentFlags().String("admin-tenant-slug", "admin", "Admin tenant slug used to derive admin schema")
+	cmd.PersistentFlags().Bool("partition-entities", false, "Partition the entity tables created for imported active versions by month (like ENTITY_PARTITIONING)")
+	_ = cmd.MarkPersistentFlagRequired("database-url")
+
+	cmd.AddCommand(exportBundleCommand())
+	cmd.AddCommand(importBundleCommand())
+
+	return cmd
+}
+
+func exportBundleCommand() *cobra.Command {
+	var (
+		outputPath     string
+		schemaIDInputs []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export schema categories and live schema versions to a JSON bundle",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			databaseURL, err := cmd.Flags().GetString("database-url")
+			if err != nil {
+				return err
+			}
+			envKey, _ := cmd.Flags().GetString("env-key")
+			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
+
+			schemaIDs := make([]uuid.UUID, 0, len(schemaIDInputs))
+			for _, input := range schemaIDInputs {
+				schemaID, parseErr := uuid.Parse(strings.TrimSpace(input))
+				if parseErr != nil {
+					return fmt.Errorf("invalid schema id %q: %w", input, parseErr)
+				}
+				schemaIDs = append(schemaIDs, schemaID)
+			}
+
+			ctx := context.Background()
+			svc, cleanup, err := newSchemaDefinitionService(ctx, databaseURL, envKey, adminTenantSlug, entityPartitioning(cmd))
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			audit := requesttrace.System("cli-schema-bundle-export")
+			ctx = requesttrace.IntoContext(ctx, audit)
+
+			bundle, err := svc.ExportBundle(ctx, audit, schemaIDs)
+			if err != nil {
+				return fmt.Errorf("export schema bundle: %w", err)
+			}
+
+			data, err := json.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return fmt.Errorf("encode schema bundle: %w", err)
+			}
+			data = append(data, '\n')
+
+			if strings.TrimSpace(outputPath) == "" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+			if err := os.WriteFile(strings.TrimSpace(outputPath), data, 0o644); err != nil {
+				return fmt.Errorf("write bundle file: %w", err)
+			}
+
+			result := bundleExportView{File: outputPath, Categories: len(bundle.Categories), Schemas: len(bundle.Schemas)}
+			return output.Print(cmd, result, func(out io.Writer) error {
+				fmt.Fprintf(out, "Exported %d categories and %d schema versions to %s\n", len(bundle.Categories), len(bundle.Schemas), outputPath)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&outputPath, "file", "", "Path of the bundle file to write; when omitted the bundle is printed to stdout")
+	cmd.Flags().StringSliceVar(&schemaIDInputs, "schema-id", nil, "Schema ID to export (repeatable); when omitted every schema is exported")
+
+	return cmd
+}
+
+func importBundleCommand() *cobra.Command {
+	var (
+		inputPath string
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a JSON bundle, creating or updating categories and schema versions",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			databaseURL, err := cmd.Flags().GetString("database-url")
+			if err != nil {
+				return err
+			}
+			envKey, _ := cmd.Flags().GetString("env-key")
+			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
+
+			data, err := os.ReadFile(strings.TrimSpace(inputPath))
+			if err != nil {
+				return fmt.Errorf("read bundle file: %w", err)
+			}
+
+			var bundle persistence.SchemaBundle
+			if err := json.Unmarshal(data, &bundle); err != nil {
+				return fmt.Errorf("decode bundle file: %w", err)
+			}
+
+			ctx := context.Background()
+			svc, cleanup, err := newSchemaDefinitionService(ctx, databaseURL, envKey, adminTenantSlug, entityPartitioning(cmd))
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			audit := requesttrace.System("cli-schema-bundle-import")
+			ctx = requesttrace.IntoContext(ctx, audit)
+
+			report, err := svc.ImportBundle(ctx, audit, bundle, dryRun)
+			if err != nil {
+				var conflictErr *schemarepositoryservice.BundleConflictError
+				if errors.As(err, &conflictErr) {
+					return fmt.Errorf("import conflicts, nothing was written:\n%s", formatFieldErrors(map[string][]string(conflictErr.Fields())))
+				}
+				return wrapDefinitionError("import", err)
+			}
+
+			// Keep empty lists as [] for scripts.
+			if report.Categories == nil {
+				report.Categories = []persistence.SchemaBundleItem{}
+			}
+			if report.Schemas == nil {
+				report.Schemas = []persistence.SchemaBundleItem{}
+			}
+			if report.Conflicts == nil {
+				report.Conflicts = []persistence.SchemaBundleConflict{}
+			}
+			return output.Print(cmd, report, func(out io.Writer) error {
+				if err := printBundleReport(out, report); err != nil {
+					return err
+				}
+				if dryRun {
+					fmt.Fprintln(out, "Dry run: no changes were written.")
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&inputPath, "file", "", "Path of the bundle file to import (required)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report the planned changes without writing them")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// bundleExportView is what export prints with --file; without it the bundle itself goes to stdout.
+type bundleExportView struct {
+	File       string `json:"file"`
+	Categories int    `json:"categories"`
+	Schemas    int    `json:"schemas"`
+}
+
+func printBundleReport(out io.Writer, report persistence.SchemaBundleReport) error {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tKEY\tACTION")
+	for _, item := range report.Categories {
+		fmt.Fprintf(tw, "category\t%s\t%s\n", item.Key, item.Action)
+	}
+	for _, item := range report.Schemas {
+		fmt.Fprintf(tw, "schema\t%s\t%s\n", item.Key, item.Action)
+	}
+	return tw.Flush()
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
 	schemacategoriesrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/repo"
 	schemacategoriesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/service"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
@@ -25,10 +27,9 @@ func categoriesCommand() *cobra.Command {
 		Short: "Manage schema categories (list, upsert, delete)",
 	}
 
-	cmd.PersistentFlags().String("database-url", "", "PostgreSQL connection string")
-	cmd.PersistentFlags().String("env-key", "dev", "Environment key used to derive admin schema (e.g. dev, stg, prod)")
-	cmd.PersistentFlags().String("admin-tenant-slug", "admin", "Admin tenant slug used to derive admin schema")
-	_ = cmd.MarkPersistentFlagRequired("database-url")
+	apiconn.AddFlags(cmd)
+	cmd.PersistentFlags().String("env-key", "dev", "Environment key used to derive admin schema with --direct (e.g. dev, stg, prod)")
+	cmd.PersistentFlags().String("admin-tenant-slug", "admin", "Admin tenant slug used to derive admin schema with --direct")
 
 	cmd.AddCommand(listCategoriesCommand())
 	cmd.AddCommand(upsertCategoryCommand())
@@ -43,15 +44,8 @@ func listCategoriesCommand() *cobra.Command {
 		Use:   "list",
 		Short: "List schema categories",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			databaseURL, err := cmd.Flags().GetString("database-url")
-			if err != nil {
-				return err
-			}
-			envKey, _ := cmd.Flags().GetString("env-key")
-			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
-
 			ctx := context.Background()
-			svc, cleanup, err := newSchemaCategoryService(ctx, databaseURL, envKey, adminTenantSlug)
+			svc, cleanup, err := openCategoryService(ctx, cmd)
 			if err != nil {
 				return err
 			}
@@ -65,25 +59,31 @@ func listCategoriesCommand() *cobra.Command {
 				return fmt.Errorf("list schema categories: %w", err)
 			}
 
-			if len(categories) == 0 {
-				fmt.Fprintln(cmd.OutOrStdout(), "No schema categories found.")
-				return nil
-			}
-
-			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
-			fmt.Fprintln(tw, "ID\tNAME\tSLUG\tPARENT\tDELETED_AT")
+			views := make([]categoryView, 0, len(categories))
 			for _, c := range categories {
-				parent := "-"
-				if c.ParentID != nil {
-					parent = c.ParentID.String()
+				views = append(views, newCategoryView(c))
+			}
+			return output.Print(cmd, views, func(out io.Writer) error {
+				if len(categories) == 0 {
+					fmt.Fprintln(out, "No schema categories found.")
+					return nil
 				}
-				deleted := ""
-				if c.DeletedAt != nil {
-					deleted = c.DeletedAt.UTC().Format(time.RFC3339)
+
+				tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "ID\tNAME\tSLUG\tPARENT\tDELETED_AT")
+				for _, c := range categories {
+					parent := "-"
+					if c.ParentID != nil {
+						parent = c.ParentID.String()
+					}
+					deleted := ""
+					if c.DeletedAt != nil {
+						deleted = c.DeletedAt.UTC().Format(time.RFC3339)
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.ID, c.Name, c.Slug, parent, deleted)
 				}
-				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.ID, c.Name, c.Slug, parent, deleted)
-			}
-			return tw.Flush()
+				return tw.Flush()
+			})
 		},
 	}
 
@@ -104,15 +104,8 @@ func upsertCategoryCommand() *cobra.Command {
 		Use:   "upsert",
 		Short: "Create a new category or update an existing one",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			databaseURL, err := cmd.Flags().GetString("database-url")
-			if err != nil {
-				return err
-			}
-			envKey, _ := cmd.Flags().GetString("env-key")
-			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
-
 			ctx := context.Background()
-			svc, cleanup, err := newSchemaCategoryService(ctx, databaseURL, envKey, adminTenantSlug)
+			svc, cleanup, err := openCategoryService(ctx, cmd)
 			if err != nil {
 				return err
 			}
@@ -144,9 +137,7 @@ func upsertCategoryCommand() *cobra.Command {
 					return wrapCategoryError("create", createErr)
 				}
 
-				fmt.Fprintf(cmd.OutOrStdout(), "Created schema category %s (%s)\n", category.Name, category.ID)
-				printCategorySummary(cmd.OutOrStdout(), category)
-				return nil
+				return printCategoryResult(cmd, "created", category)
 			}
 
 			categoryID, err := uuid.Parse(strings.TrimSpace(categoryIDInput))
@@ -184,16 +175,12 @@ func upsertCategoryCommand() *cobra.Command {
 					if createErr != nil {
 						return wrapCategoryError("create", createErr)
 					}
-					fmt.Fprintf(cmd.OutOrStdout(), "Created schema category %s (%s)\n", created.Name, created.ID)
-					printCategorySummary(cmd.OutOrStdout(), created)
-					return nil
+					return printCategoryResult(cmd, "created", created)
 				}
 				return wrapCategoryError("update", updateErr)
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "Updated schema category %s (%s)\n", category.Name, category.ID)
-			printCategorySummary(cmd.OutOrStdout(), category)
-			return nil
+			return printCategoryResult(cmd, "updated", category)
 		},
 	}
 
@@ -207,26 +194,23 @@ func upsertCategoryCommand() *cobra.Command {
 }
 
 func deleteCategoryCommand() *cobra.Command {
-	var categoryIDInput string
+	var (
+		categoryIDInput string
+		mode            string
+		dryRun          bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Soft delete a schema category by ID",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			databaseURL, err := cmd.Flags().GetString("database-url")
-			if err != nil {
-				return err
-			}
-			envKey, _ := cmd.Flags().GetString("env-key")
-			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
-
 			categoryID, err := uuid.Parse(strings.TrimSpace(categoryIDInput))
 			if err != nil {
 				return fmt.Errorf("invalid category id: %w", err)
 			}
 
 			ctx := context.Background()
-			svc, cleanup, err := newSchemaCategoryService(ctx, databaseURL, envKey, adminTenantSlug)
+			svc, cleanup, err := openCategoryService(ctx, cmd)
 			if err != nil {
 				return err
 			}
@@ -235,21 +219,76 @@ func deleteCategoryCommand() *cobra.Command {
 			audit := requesttrace.System("cli-schema-categories-delete")
 			ctx = requesttrace.IntoContext(ctx, audit)
 
-			if err := svc.Delete(ctx, audit, categoryID); err != nil {
+			deletion, err := svc.Delete(ctx, audit, categoryID, schemacategoriesservice.DeleteMode(strings.TrimSpace(mode)), dryRun)
+			if err != nil {
 				return wrapCategoryError("delete", err)
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "Soft deleted schema category %s\n", categoryID)
-			return nil
+			if dryRun {
+				view := newCategoryDeletionView(deletion)
+				return output.Print(cmd, categoryResult{Action: "dry-run", CategoryID: &categoryID, Deletion: &view}, func(out io.Writer) error {
+					printCategoryDeletion(out, view)
+					return nil
+				})
+			}
+			return output.Print(cmd, categoryResult{Action: "deleted", CategoryID: &categoryID}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Soft deleted schema category %s\n", categoryID)
+				return nil
+			})
 		},
 	}
 
 	cmd.Flags().StringVar(&categoryIDInput, "id", "", "Category ID to delete")
+	cmd.Flags().StringVar(&mode, "mode", string(schemacategoriesservice.DeleteRestrict), "How child categories and schemas are handled: restrict, reparent or cascade")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report the categories and schema versions the delete would change without changing them")
 	_ = cmd.MarkFlagRequired("id")
 
 	return cmd
 }
 
+func printCategoryDeletion(out io.Writer, view categoryDeletionView) {
+	fmt.Fprintf(out, "Deleting schema category %s with mode %s would:\n", view.CategoryID, view.Mode)
+	fmt.Fprintf(out, "  soft delete categories: %s\n", joinIDs(view.DeletedCategories))
+	fmt.Fprintf(out, "  soft delete schema versions: %d\n", view.DeletedSchemaVersions)
+	fmt.Fprintf(out, "  move to the parent category: %d child categories, %d schema versions\n", view.ReparentedCategories, view.ReparentedSchemaVersions)
+	fmt.Fprintln(out, "Dry run: nothing was deleted.")
+}
+
+func joinIDs(ids []uuid.UUID) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, id.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// categoryService is the part of the schema categories service the commands use. With --direct the service runs
+// on the database; otherwise apiCategoryService forwards to the API.
+type categoryService interface {
+	List(ctx context.Context, audit requesttrace.AuditInfo, includeDeleted bool) ([]schemacategoriesservice.Category, error)
+	Create(ctx context.Context, audit requesttrace.AuditInfo, input schemacategoriesservice.CreateInput) (schemacategoriesservice.Category, error)
+	Update(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input schemacategoriesservice.UpdateInput) (schemacategoriesservice.Category, error)
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, mode schemacategoriesservice.DeleteMode, dryRun bool) (schemacategoriesservice.Deletion, error)
+}
+
+func openCategoryService(ctx context.Context, cmd *cobra.Command) (categoryService, func(), error) {
+	if !apiconn.Direct(cmd) {
+		client, err := apiconn.Connect(cmd)
+		if err != nil {
+			return nil, nil, err
+		}
+		return apiCategoryService{client: client.SchemaCategories}, func() {}, nil
+	}
+
+	databaseURL, err := apiconn.DatabaseURL(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	envKey, _ := cmd.Flags().GetString("env-key")
+	adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
+	return newSchemaCategoryService(ctx, databaseURL, envKey, adminTenantSlug)
+}
+
 func newSchemaCategoryService(ctx context.Context, databaseURL, envKey, adminTenantSlug string) (schemacategoriesservice.Service, func(), error) {
 	pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: databaseURL})
 	if err != nil {
@@ -279,6 +318,20 @@ func newSchemaCategoryService(ctx context.Context, databaseURL, envKey, adminTen
 	return svc, cleanup, nil
 }
 
+// printCategoryResult reports a created or updated category.
+func printCategoryResult(cmd *cobra.Command, action string, category schemacategoriesservice.Category) error {
+	view := newCategoryView(category)
+	return output.Print(cmd, categoryResult{Action: action, Category: &view}, func(out io.Writer) error {
+		verb := "Created"
+		if action == "updated" {
+			verb = "Updated"
+		}
+		fmt.Fprintf(out, "%s schema category %s (%s)\n", verb, category.Name, category.ID)
+		printCategorySummary(out, category)
+		return nil
+	})
+}
+
 func printCategorySummary(out io.Writer, category schemacategoriesservice.Category) {
 	parent := "-"
 	if category.ParentID != nil {
@@ -0,0 +1,139 @@
+package schemacmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	schemacategoriesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/service"
+	schemacategories "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-categories"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// apiCategoryService serves the category commands through the schema categories API. The API records the caller
+// of the token as the actor, so the audit argument is unused.
+type apiCategoryService struct {
+	client *schemacategories.ClientWithResponses
+}
+
+func (s apiCategoryService) List(ctx context.Context, _ requesttrace.AuditInfo, includeDeleted bool) ([]schemacategoriesservice.Category, error) {
+	rsp, err := s.client.ListSchemaCategoriesWithResponse(ctx, &schemacategories.ListSchemaCategoriesParams{IncludeDeleted: &includeDeleted})
+	if err != nil {
+		return nil, err
+	}
+	if err := categoryAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return nil, err
+	}
+
+	categories := make([]schemacategoriesservice.Category, 0, len(rsp.JSON200.Items))
+	for _, c := range rsp.JSON200.Items {
+		categories = append(categories, categoryFromAPI(c))
+	}
+	return categories, nil
+}
+
+func (s apiCategoryService) Create(ctx context.Context, _ requesttrace.AuditInfo, input schemacategoriesservice.CreateInput) (schemacategoriesservice.Category, error) {
+	if input.CategoryID != nil {
+		return schemacategoriesservice.Category{}, errors.New("the API assigns category ids; creating a category with --id needs --direct")
+	}
+
+	rsp, err := s.client.CreateSchemaCategoryWithResponse(ctx, schemacategories.CreateSchemaCategoryRequest{
+		Name:             input.Name,
+		Slug:             input.Slug,
+		ParentCategoryId: input.ParentID,
+		Description:      input.Description,
+	})
+	if err != nil {
+		return schemacategoriesservice.Category{}, err
+	}
+	if err := categoryAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemacategoriesservice.Category{}, err
+	}
+	return categoryFromAPI(*rsp.JSON201), nil
+}
+
+func (s apiCategoryService) Update(ctx context.Context, _ requesttrace.AuditInfo, id uuid.UUID, input schemacategoriesservice.UpdateInput) (schemacategoriesservice.Category, error) {
+	rsp, err := s.client.UpdateSchemaCategoryWithResponse(ctx, id, schemacategories.UpdateSchemaCategoryRequest{
+		Name:             input.Name,
+		Slug:             input.Slug,
+		ParentCategoryId: input.ParentID,
+		Description:      input.Description,
+	})
+	if err != nil {
+		return schemacategoriesservice.Category{}, err
+	}
+	if err := categoryAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemacategoriesservice.Category{}, err
+	}
+	return categoryFromAPI(*rsp.JSON200), nil
+}
+
+// Delete only reports the affected rows on a dry run; the API answers a real delete with no content.
+func (s apiCategoryService) Delete(ctx context.Context, _ requesttrace.AuditInfo, id uuid.UUID, mode schemacategoriesservice.DeleteMode, dryRun bool) (schemacategoriesservice.Deletion, error) {
+	params := &schemacategories.DeleteSchemaCategoryParams{DryRun: &dryRun}
+	if mode != "" {
+		apiMode := schemacategories.DeleteSchemaCategoryParamsMode(mode)
+		params.Mode = &apiMode
+	}
+
+	rsp, err := s.client.DeleteSchemaCategoryWithResponse(ctx, id, params)
+	if err != nil {
+		return schemacategoriesservice.Deletion{}, err
+	}
+	if err := categoryAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemacategoriesservice.Deletion{}, err
+	}
+	if rsp.JSON200 == nil {
+		return schemacategoriesservice.Deletion{CategoryID: id, Mode: mode}, nil
+	}
+	report := rsp.JSON200
+	return schemacategoriesservice.Deletion{
+		CategoryID:               report.CategoryId,
+		Mode:                     schemacategoriesservice.DeleteMode(report.Mode),
+		Categories:               report.DeletedCategories,
+		ReparentedCategories:     report.ReparentedCategories,
+		ReparentedSchemaVersions: report.ReparentedSchemaVersions,
+		DeletedSchemaVersions:    report.DeletedSchemaVersions,
+		DryRun:                   report.DryRun,
+	}, nil
+}
+
+func categoryFromAPI(c schemacategories.SchemaCategory) schemacategoriesservice.Category {
+	return schemacategoriesservice.Category{
+		ID:          c.CategoryId,
+		ParentID:    c.ParentCategoryId,
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+		DeletedAt:   c.DeletedAt,
+	}
+}
+
+// categoryAPIError maps an API answer onto the service errors wrapCategoryError reports; other failures keep the
+// problem details the API sent.
+func categoryAPIError(status int, body []byte) error {
+	err := apiclient.Check(status, body)
+	var apiErr *apiclient.Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	var fields map[string][]string
+	if apiErr.Problem != nil && apiErr.Problem.Errors != nil {
+		fields = *apiErr.Problem.Errors
+	}
+	switch {
+	case apiErr.Status == http.StatusNotFound:
+		return schemacategoriesservice.ErrNotFound
+	case len(fields) > 0 && apiErr.Status != http.StatusConflict:
+		return &schemacategoriesservice.ValidationError{Fields: fields}
+	case len(fields) > 0:
+		return fmt.Errorf("%w\n%s", err, formatFieldErrors(fields))
+	}
+	return err
+}
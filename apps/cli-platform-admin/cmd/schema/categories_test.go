@@ -0,0 +1,167 @@
+package schemacmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+)
+
+const (
+	categoryID = "5d0a3c1e-8f47-4b7e-9a55-0c2f6e1d9b21"
+	unknownID  = "9e6f1b2a-3c4d-4e5f-8a6b-7c8d9e0f1a2b"
+)
+
+// categoriesServer fakes the schema categories API with a single "cards" category.
+type categoriesServer struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (s *categoriesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.calls = append(s.calls, r.Method+" "+r.URL.RequestURI())
+	s.mu.Unlock()
+
+	category := map[string]any{
+		"categoryId": categoryID, "parentCategoryId": nil, "name": "Cards", "slug": "cards", "description": nil,
+		"createdAt": "2026-01-02T03:04:05Z", "updatedAt": "2026-01-02T03:04:05Z", "deletedAt": nil,
+	}
+	writeJSON := func(status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/schema-categories":
+		writeJSON(http.StatusOK, map[string]any{"items": []any{category}})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/schema-categories":
+		writeJSON(http.StatusCreated, category)
+	case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/schema-categories/"+categoryID:
+		writeJSON(http.StatusOK, map[string]any{
+			"categoryId": categoryID, "mode": r.URL.Query().Get("mode"), "deletedCategories": []string{categoryID},
+			"reparentedCategories": 0, "reparentedSchemaVersions": 0, "deletedSchemaVersions": 2, "dryRun": true,
+		})
+	default:
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"title":"Resource not found","status":404}`))
+	}
+}
+
+func TestCategoriesCommands(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantCalls []string
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name:    "direct needs a database url",
+			args:    []string{"list", "--direct"},
+			wantErr: "--database-url is required with --direct",
+		},
+		{
+			name:    "delete rejects a malformed id",
+			args:    []string{"delete", "--id", "cards"},
+			wantErr: "invalid category id: invalid UUID length: 5",
+		},
+		{
+			name:    "upsert needs a slug to create",
+			args:    []string{"upsert", "--name", "Cards"},
+			wantErr: "slug is required when creating a category",
+		},
+		{
+			name:    "upsert rejects a malformed parent",
+			args:    []string{"upsert", "--name", "Cards", "--slug", "cards", "--parent-id", "root"},
+			wantErr: "parent-id must be a valid UUID",
+		},
+		{
+			name:      "list as JSON",
+			args:      []string{"list", "-o", "json"},
+			wantCalls: []string{"GET /api/v1/schema-categories?includeDeleted=false"},
+			wantOut: `[
+  {
+    "categoryId": "` + categoryID + `",
+    "parentCategoryId": null,
+    "name": "Cards",
+    "slug": "cards",
+    "description": null,
+    "createdAt": "2026-01-02T03:04:05Z",
+    "updatedAt": "2026-01-02T03:04:05Z",
+    "deletedAt": null
+  }
+]
+`,
+		},
+		{
+			name:      "upsert creates through the API",
+			args:      []string{"upsert", "--name", "Cards", "--slug", "cards"},
+			wantCalls: []string{"POST /api/v1/schema-categories"},
+			wantOut:   "Created schema category Cards (" + categoryID + ")\nName: Cards\nSlug: cards\nParent: -\nDescription: \nDeletedAt: \n",
+		},
+		{
+			name:      "creating with an id needs direct",
+			args:      []string{"upsert", "--id", unknownID, "--name", "Cards", "--slug", "cards"},
+			wantCalls: []string{"PATCH /api/v1/schema-categories/" + unknownID},
+			wantErr:   "create failed: the API assigns category ids; creating a category with --id needs --direct",
+		},
+		{
+			name:      "delete dry run as YAML",
+			args:      []string{"delete", "--id", categoryID, "--dry-run", "-o", "yaml"},
+			wantCalls: []string{"DELETE /api/v1/schema-categories/" + categoryID + "?dryRun=true&mode=restrict"},
+			wantOut: "action: dry-run\ncategoryId: " + categoryID + "\ndeletion:\n  categoryId: " + categoryID +
+				"\n  mode: restrict\n  deletedCategories:\n    - " + categoryID +
+				"\n  reparentedCategories: 0\n  reparentedSchemaVersions: 0\n  deletedSchemaVersions: 2\n  dryRun: true\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := &categoriesServer{}
+			server := httptest.NewServer(api)
+			t.Cleanup(server.Close)
+
+			// An empty config file keeps the profile of the user running the tests out.
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(configPath, nil, 0o600))
+			args := append([]string{"schema", "categories"}, tt.args...)
+			args = append(args, "--config", configPath, "--api-url", server.URL, "--token", "test-token")
+
+			root := &cobra.Command{Use: "cli-platform-admin", SilenceUsage: true, SilenceErrors: true}
+			apiconn.AddRootFlags(root)
+			output.AddFlag(root)
+			root.AddCommand(Command())
+			var out bytes.Buffer
+			root.SetOut(&out)
+			root.SetErr(&bytes.Buffer{})
+			root.SetArgs(args)
+
+			err := root.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), tt.wantErr), "got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantCalls, api.calls)
+			require.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package schemacmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/exitcode"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// diff --fail-on values.
+const (
+	failOnNone     = "none"
+	failOnBreaking = "breaking"
+	failOnAny      = "any"
+)
+
+func diffDefinitionCommand() *cobra.Command {
+	var (
+		schemaIDInput string
+		fromInput     string
+		toInput       string
+		failOn        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes between two versions of a schema and whether the newer one breaks existing documents",
+		Long: `Show the changes between two versions of a schema and whether --to breaks documents valid under --from.
+
+The diff comes from the schema repository; the compatibility check is the one the repository runs when a version is
+created. With --fail-on the command exits with status 2 when the versions differ (any) or are incompatible
+(breaking), for CI gates; other failures exit with status 1.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			switch failOn {
+			case failOnNone, failOnBreaking, failOnAny:
+			default:
+				return fmt.Errorf("invalid --fail-on %q (use none, breaking or any)", failOn)
+			}
+
+			schemaID, err := uuid.Parse(strings.TrimSpace(schemaIDInput))
+			if err != nil {
+				return fmt.Errorf("invalid schema id: %w", err)
+			}
+			from, err := parseSemanticVersion(fromInput, "from")
+			if err != nil {
+				return err
+			}
+			to, err := parseSemanticVersion(toInput, "to")
+			if err != nil {
+				return err
+			}
+			if from == nil || to == nil {
+				return errors.New("from and to are required")
+			}
+
+			ctx := context.Background()
+			svc, cleanup, err := openDefinitionService(ctx, cmd)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			audit := requesttrace.System("cli-schema-definitions-diff")
+			ctx = requesttrace.IntoContext(ctx, audit)
+
+			diff, err := svc.Diff(ctx, audit, schemaID, *from, *to)
+			if err != nil {
+				return wrapDefinitionError("diff", err)
+			}
+
+			versions, err := svc.List(ctx, audit, schemaID, false)
+			if err != nil {
+				return wrapDefinitionError("diff", err)
+			}
+			base, err := findVersion(versions, *from)
+			if err != nil {
+				return err
+			}
+			target, err := findVersion(versions, *to)
+			if err != nil {
+				return err
+			}
+			compatibility, err := compatibilityOf(base.Definition, target.Definition, from.String())
+			if err != nil {
+				return err
+			}
+
+			view := newDiffView(diff, compatibility)
+			if err := output.Print(cmd, view, func(out io.Writer) error {
+				printDiff(out, view)
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			return diffFailure(view, failOn)
+		},
+	}
+
+	cmd.Flags().StringVar(&schemaIDInput, "schema-id", "", "Schema ID to compare")
+	cmd.Flags().StringVar(&fromInput, "from", "", "Base version (e.g. 1.0.0)")
+	cmd.Flags().StringVar(&toInput, "to", "", "Version compared against --from (e.g. 1.1.0)")
+	cmd.Flags().StringVar(&failOn, "fail-on", failOnNone, "Exit with status 2 on: none, breaking (incompatible changes) or any (any change)")
+	_ = cmd.MarkFlagRequired("schema-id")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func checkDefinitionCommand() *cobra.Command {
+	var (
+		definitionFile  string
+		schemaIDInput   string
+		baseVersionFlag string
+		baseFile        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check that a definition file does not break documents valid under the active version (or a base file)",
+		Long: `Check that a definition file does not break documents valid under a base definition: the active version of
+--schema-id (or --base-version), or --base-file, which needs neither the API nor the database.
+
+The check is the one the schema repository runs when a version is created. The command exits with status 2 when
+the definition is not backward compatible, for CI gates; other failures exit with status 1.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			candidate, err := readDefinition(definitionFile)
+			if err != nil {
+				return err
+			}
+
+			view := checkView{}
+			var base json.RawMessage
+			switch {
+			case strings.TrimSpace(baseFile) != "" && strings.TrimSpace(schemaIDInput) != "":
+				return errors.New("pass either --schema-id or --base-file, not both")
+			case strings.TrimSpace(baseFile) != "":
+				if strings.TrimSpace(baseVersionFlag) != "" {
+					return errors.New("--base-version needs --schema-id")
+				}
+				base, err = readDefinition(baseFile)
+				if err != nil {
+					return err
+				}
+				view.BaseFile = strings.TrimSpace(baseFile)
+			case strings.TrimSpace(schemaIDInput) != "":
+				schemaID, err := uuid.Parse(strings.TrimSpace(schemaIDInput))
+				if err != nil {
+					return fmt.Errorf("invalid schema id: %w", err)
+				}
+				version, err := parseSemanticVersion(baseVersionFlag, "base-version")
+				if err != nil {
+					return err
+				}
+
+				schema, err := loadBaseVersion(cmd, schemaID, version)
+				if err != nil {
+					return err
+				}
+				base = schema.Definition
+				view.SchemaID = &schemaID
+				view.BaseVersion = schema.Version.String()
+			default:
+				return errors.New("pass --schema-id or --base-file")
+			}
+
+			compatibility, err := compatibilityOf(base, candidate, view.BaseVersion)
+			if err != nil {
+				return err
+			}
+			view.Level = compatibility.Level
+			view.Issues = compatibility.Issues
+
+			if err := output.Print(cmd, view, func(out io.Writer) error {
+				printCheck(out, view)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if view.Level == persistence.SchemaCompatibilityBreaking {
+				return exitcode.New(exitcode.Failed, fmt.Errorf("%s is not backward compatible with %s: %d issue(s)", definitionFile, checkBase(view), len(view.Issues)))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&definitionFile, "definition-file", "", "Path to the candidate JSON schema definition")
+	cmd.Flags().StringVar(&schemaIDInput, "schema-id", "", "Schema whose active version is the base")
+	cmd.Flags().StringVar(&baseVersionFlag, "base-version", "", "Compare against this version of --schema-id instead of the active one")
+	cmd.Flags().StringVar(&baseFile, "base-file", "", "Compare against this JSON schema definition file instead of a stored version")
+	_ = cmd.MarkFlagRequired("definition-file")
+
+	return cmd
+}
+
+// loadBaseVersion returns version of the schema, or its active version when version is nil.
+func loadBaseVersion(cmd *cobra.Command, schemaID uuid.UUID, version *persistence.SemanticVersion) (schemarepositoryservice.Schema, error) {
+	ctx := context.Background()
+	svc, cleanup, err := openDefinitionService(ctx, cmd)
+	if err != nil {
+		return schemarepositoryservice.Schema{}, err
+	}
+	defer cleanup()
+
+	audit := requesttrace.System("cli-schema-definitions-check")
+	ctx = requesttrace.IntoContext(ctx, audit)
+
+	versions, err := svc.List(ctx, audit, schemaID, false)
+	if err != nil {
+		return schemarepositoryservice.Schema{}, wrapDefinitionError("check", err)
+	}
+	if version != nil {
+		return findVersion(versions, *version)
+	}
+	for _, schema := range versions {
+		if schema.IsActive && !schema.IsDeleted {
+			return schema, nil
+		}
+	}
+	return schemarepositoryservice.Schema{}, fmt.Errorf("schema %s has no active version; pass --base-version", schemaID)
+}
+
+func findVersion(versions []schemarepositoryservice.Schema, version persistence.SemanticVersion) (schemarepositoryservice.Schema, error) {
+	for _, schema := range versions {
+		if schema.Version == version && !schema.IsDeleted {
+			return schema, nil
+		}
+	}
+	return schemarepositoryservice.Schema{}, fmt.Errorf("schema version %s not found", version)
+}
+
+// compatibilityOf runs the schema repository's compatibility check of candidate against base.
+func compatibilityOf(base, candidate json.RawMessage, baseVersion string) (persistence.SchemaCompatibility, error) {
+	issues, err := schemarepositoryservice.CheckCompatibility(base, candidate)
+	if err != nil {
+		return persistence.SchemaCompatibility{}, err
+	}
+
+	compatibility := persistence.SchemaCompatibility{
+		Level:       persistence.SchemaCompatibilityBackward,
+		BaseVersion: baseVersion,
+		Issues:      issues,
+	}
+	if len(issues) > 0 {
+		compatibility.Level = persistence.SchemaCompatibilityBreaking
+	}
+	return compatibility, nil
+}
+
+// diffFailure returns the exit status error of diff for --fail-on, or nil when the diff passes.
+func diffFailure(view diffView, failOn string) error {
+	switch {
+	case failOn == failOnAny && hasChanges(view):
+		return exitcode.New(exitcode.Failed, fmt.Errorf("schema %s changed between %s and %s", view.SchemaID, view.FromVersion, view.ToVersion))
+	case failOn != failOnNone && view.Compatibility.Level == persistence.SchemaCompatibilityBreaking:
+		return exitcode.New(exitcode.Failed, fmt.Errorf("schema %s version %s is not backward compatible with %s: %d issue(s)", view.SchemaID, view.ToVersion, view.FromVersion, len(view.Compatibility.Issues)))
+	}
+	return nil
+}
+
+func hasChanges(view diffView) bool {
+	return len(view.AddedProperties) > 0 || len(view.RemovedProperties) > 0 || len(view.ChangedProperties) > 0 ||
+		len(view.RequiredAdded) > 0 || len(view.RequiredRemoved) > 0
+}
+
+func printDiff(out io.Writer, view diffView) {
+	fmt.Fprintf(out, "Schema %s: %s -> %s\n", view.SchemaID, view.FromVersion, view.ToVersion)
+	if !hasChanges(view) {
+		fmt.Fprintln(out, "No differences.")
+	}
+	for _, path := range view.AddedProperties {
+		fmt.Fprintf(out, "+ %s\n", path)
+	}
+	for _, path := range view.RemovedProperties {
+		fmt.Fprintf(out, "- %s\n", path)
+	}
+	for _, change := range view.ChangedProperties {
+		for _, keyword := range change.Keywords {
+			fmt.Fprintf(out, "~ %s %s: %s -> %s\n", change.Path, keyword, keywordValue(change.Before, keyword), keywordValue(change.After, keyword))
+		}
+	}
+	for _, path := range view.RequiredAdded {
+		fmt.Fprintf(out, "+ required %s\n", path)
+	}
+	for _, path := range view.RequiredRemoved {
+		fmt.Fprintf(out, "- required %s\n", path)
+	}
+	fmt.Fprintln(out)
+	printCompatibility(out, view.Compatibility.Level, view.Compatibility.Issues)
+}
+
+func printCheck(out io.Writer, view checkView) {
+	fmt.Fprintf(out, "Base: %s\n", checkBase(view))
+	printCompatibility(out, view.Level, view.Issues)
+}
+
+func checkBase(view checkView) string {
+	if view.BaseFile != "" {
+		return view.BaseFile
+	}
+	return fmt.Sprintf("schema %s version %s", view.SchemaID, view.BaseVersion)
+}
+
+func printCompatibility(out io.Writer, level string, issues []persistence.SchemaCompatibilityIssue) {
+	fmt.Fprintf(out, "Compatibility: %s\n", level)
+	if len(issues) == 0 {
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tPATH\tMESSAGE")
+	for _, issue := range issues {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", issue.Kind, issue.Path, issue.Message)
+	}
+	_ = tw.Flush()
+}
+
+// keywordValue renders a keyword value of a property change as compact JSON; absent keywords show as (none).
+func keywordValue(values map[string]any, keyword string) string {
+	value, ok := values[keyword]
+	if !ok {
+		return "(none)"
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
@@ -0,0 +1,134 @@
+package schemacmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/exitcode"
+	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+const baseDefinition = `{
+	"type": "object",
+	"properties": {"name": {"type": "string"}, "total": {"type": "number"}},
+	"required": ["name"]
+}`
+
+// candidate is a successor of baseDefinition and what diff and check should make of it.
+type candidate struct {
+	definition string
+	changed    bool
+	breaking   bool
+}
+
+var candidates = map[string]candidate{
+	"identical": {
+		definition: baseDefinition,
+	},
+	"compatible": {
+		definition: `{
+			"type": "object",
+			"properties": {"name": {"type": "string"}, "total": {"type": "number"}, "note": {"type": "string"}},
+			"required": ["name"]
+		}`,
+		changed: true,
+	},
+	"breaking": {
+		definition: `{
+			"type": "object",
+			"properties": {"name": {"type": "string"}, "total": {"type": "string"}},
+			"required": ["name", "total"]
+		}`,
+		changed:  true,
+		breaking: true,
+	},
+}
+
+func TestDiffFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		definition string
+		failOn     string
+		want       int
+	}{
+		{definition: "identical", failOn: failOnNone, want: 0},
+		{definition: "identical", failOn: failOnBreaking, want: 0},
+		{definition: "identical", failOn: failOnAny, want: 0},
+		{definition: "compatible", failOn: failOnNone, want: 0},
+		{definition: "compatible", failOn: failOnBreaking, want: 0},
+		{definition: "compatible", failOn: failOnAny, want: exitcode.Failed},
+		{definition: "breaking", failOn: failOnNone, want: 0},
+		{definition: "breaking", failOn: failOnBreaking, want: exitcode.Failed},
+		{definition: "breaking", failOn: failOnAny, want: exitcode.Failed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.definition+"/"+tt.failOn, func(t *testing.T) {
+			t.Parallel()
+
+			next := candidates[tt.definition]
+			diff, err := schemarepositoryservice.DiffDefinitions(json.RawMessage(baseDefinition), json.RawMessage(next.definition))
+			require.NoError(t, err)
+			compatibility, err := compatibilityOf(json.RawMessage(baseDefinition), json.RawMessage(next.definition), "1.0.0")
+			require.NoError(t, err)
+
+			view := newDiffView(diff, compatibility)
+			require.Equal(t, next.changed, hasChanges(view))
+			require.Equal(t, next.breaking, compatibility.Level == persistence.SchemaCompatibilityBreaking)
+			require.Equal(t, tt.want, exitcode.Of(diffFailure(view, tt.failOn)))
+		})
+	}
+}
+
+func TestCheckDefinitionExitCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		definition string
+		want       int
+		wantLevel  string
+	}{
+		{definition: "identical", want: 0, wantLevel: persistence.SchemaCompatibilityBackward},
+		{definition: "compatible", want: 0, wantLevel: persistence.SchemaCompatibilityBackward},
+		{definition: "breaking", want: exitcode.Failed, wantLevel: persistence.SchemaCompatibilityBreaking},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.definition, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			basePath := filepath.Join(dir, "base.json")
+			candidatePath := filepath.Join(dir, "candidate.json")
+			require.NoError(t, os.WriteFile(basePath, []byte(baseDefinition), 0o600))
+			require.NoError(t, os.WriteFile(candidatePath, []byte(candidates[tt.definition].definition), 0o600))
+
+			var out bytes.Buffer
+			cmd := checkDefinitionCommand()
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"--definition-file", candidatePath, "--base-file", basePath})
+
+			require.Equal(t, tt.want, exitcode.Of(cmd.Execute()))
+			require.Contains(t, out.String(), "Compatibility: "+tt.wantLevel+"\n")
+		})
+	}
+
+	t.Run("unreadable definition", func(t *testing.T) {
+		t.Parallel()
+
+		cmd := checkDefinitionCommand()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--definition-file", filepath.Join(t.TempDir(), "missing.json"), "--base-file", "base.json"})
+
+		require.Equal(t, exitcode.Error, exitcode.Of(cmd.Execute()))
+	})
+}
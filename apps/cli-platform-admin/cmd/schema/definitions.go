@@ -15,6 +15,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
 	schemarepositoryrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/repo"
 	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
@@ -25,17 +27,21 @@ import (
 func definitionsCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "definitions",
-		Short: "Manage schema definitions (list, upsert, delete)",
+		Short: "Manage schema definitions (list, upsert, delete, rename-slug, migrate-indexes, diff, check)",
 	}
 
-	cmd.PersistentFlags().String("database-url", "", "PostgreSQL connection string")
-	cmd.PersistentFlags().String("env-key", "dev", "Environment key used to derive admin schema (e.g. dev, stg, prod)")
-	cmd.PersistentFlags().String("admin-tenant-slug", "admin", "Admin tenant slug used to derive admin schema")
-	_ = cmd.MarkPersistentFlagRequired("database-url")
+	apiconn.AddFlags(cmd)
+	cmd.PersistentFlags().String("env-key", "dev", "Environment key used to derive admin schema with --direct (e.g. dev, stg, prod)")
+	cmd.PersistentFlags().String("admin-tenant-slug", "admin", "Admin tenant slug used to derive admin schema with --direct")
+	cmd.PersistentFlags().Bool("partition-entities", false, "Partition the entity tables created for activated versions by month with --direct (like ENTITY_PARTITIONING)")
 
 	cmd.AddCommand(listDefinitionsCommand())
 	cmd.AddCommand(upsertDefinitionCommand())
 	cmd.AddCommand(deleteDefinitionCommand())
+	cmd.AddCommand(renameDefinitionSlugCommand())
+	cmd.AddCommand(migrateIndexesCommand())
+	cmd.AddCommand(diffDefinitionCommand())
+	cmd.AddCommand(checkDefinitionCommand())
 
 	return cmd
 }
@@ -51,15 +57,8 @@ func listDefinitionsCommand() *cobra.Command {
 		Use:   "list",
 		Short: "List schema definitions",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			databaseURL, err := cmd.Flags().GetString("database-url")
-			if err != nil {
-				return err
-			}
-			envKey, _ := cmd.Flags().GetString("env-key")
-			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
-
 			ctx := context.Background()
-			svc, cleanup, err := newSchemaDefinitionService(ctx, databaseURL, envKey, adminTenantSlug)
+			svc, cleanup, err := openDefinitionService(ctx, cmd)
 			if err != nil {
 				return err
 			}
@@ -85,26 +84,32 @@ func listDefinitionsCommand() *cobra.Command {
 				return fmt.Errorf("list schema definitions: %w", err)
 			}
 
-			if len(schemas) == 0 {
-				fmt.Fprintln(cmd.OutOrStdout(), "No schema definitions found.")
-				return nil
-			}
-
-			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
-			fmt.Fprintln(tw, "SCHEMA_ID\tVERSION\tACTIVE\tDELETED\tTABLE\tSLUG\tCATEGORY_ID\tCREATED_AT")
+			views := make([]schemaView, 0, len(schemas))
 			for _, s := range schemas {
-				fmt.Fprintf(tw, "%s\t%s\t%t\t%t\t%s\t%s\t%s\t%s\n",
-					s.SchemaID,
-					s.Version.String(),
-					s.IsActive,
-					s.IsDeleted,
-					s.TableName,
-					s.Slug,
-					s.CategoryID,
-					s.CreatedAt.UTC().Format(time.RFC3339),
-				)
+				views = append(views, newSchemaView(s))
 			}
-			return tw.Flush()
+			return output.Print(cmd, views, func(out io.Writer) error {
+				if len(schemas) == 0 {
+					fmt.Fprintln(out, "No schema definitions found.")
+					return nil
+				}
+
+				tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "SCHEMA_ID\tVERSION\tACTIVE\tDELETED\tTABLE\tSLUG\tCATEGORY_ID\tCREATED_AT")
+				for _, s := range schemas {
+					fmt.Fprintf(tw, "%s\t%s\t%t\t%t\t%s\t%s\t%s\t%s\n",
+						s.SchemaID,
+						s.Version.String(),
+						s.IsActive,
+						s.IsDeleted,
+						s.TableName,
+						s.Slug,
+						s.CategoryID,
+						s.CreatedAt.UTC().Format(time.RFC3339),
+					)
+				}
+				return tw.Flush()
+			})
 		},
 	}
 
@@ -129,15 +134,8 @@ func upsertDefinitionCommand() *cobra.Command {
 		Use:   "upsert",
 		Short: "Create a new schema definition or a new version of an existing schema",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			databaseURL, err := cmd.Flags().GetString("database-url")
-			if err != nil {
-				return err
-			}
-			envKey, _ := cmd.Flags().GetString("env-key")
-			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
-
 			ctx := context.Background()
-			svc, cleanup, err := newSchemaDefinitionService(ctx, databaseURL, envKey, adminTenantSlug)
+			svc, cleanup, err := openDefinitionService(ctx, cmd)
 			if err != nil {
 				return err
 			}
@@ -182,9 +180,12 @@ func upsertDefinitionCommand() *cobra.Command {
 				return wrapDefinitionError("upsert", createErr)
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "Upserted schema definition %s version %s\n", schema.SchemaID, schema.Version.String())
-			printDefinitionSummary(cmd.OutOrStdout(), schema)
-			return nil
+			view := newSchemaView(schema)
+			return output.Print(cmd, schemaResult{Action: "upserted", Schema: &view}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Upserted schema definition %s version %s\n", schema.SchemaID, schema.Version.String())
+				printDefinitionSummary(out, schema)
+				return nil
+			})
 		},
 	}
 
@@ -207,19 +208,14 @@ func deleteDefinitionCommand() *cobra.Command {
 	var (
 		schemaIDInput      string
 		schemaVersionInput string
+		force              bool
+		dryRun             bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Soft delete a schema definition version",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			databaseURL, err := cmd.Flags().GetString("database-url")
-			if err != nil {
-				return err
-			}
-			envKey, _ := cmd.Flags().GetString("env-key")
-			adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
-
 			schemaID, err := uuid.Parse(strings.TrimSpace(schemaIDInput))
 			if err != nil {
 				return fmt.Errorf("invalid schema id: %w", err)
@@ -234,7 +230,7 @@ func deleteDefinitionCommand() *cobra.Command {
 			}
 
 			ctx := context.Background()
-			svc, cleanup, err := newSchemaDefinitionService(ctx, databaseURL, envKey, adminTenantSlug)
+			svc, cleanup, err := openDefinitionService(ctx, cmd)
 			if err != nil {
 				return err
 			}
@@ -243,24 +239,136 @@ func deleteDefinitionCommand() *cobra.Command {
 			audit := requesttrace.System("cli-schema-definitions-delete")
 			ctx = requesttrace.IntoContext(ctx, audit)
 
-			if err := svc.Delete(ctx, audit, schemaID, *version); err != nil {
+			deletion, err := svc.Delete(ctx, audit, schemaID, *version, force, dryRun)
+			if err != nil {
 				return wrapDefinitionError("delete", err)
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "Soft deleted schema definition %s version %s\n", schemaID, version.String())
-			return nil
+			if dryRun {
+				view := newSchemaDeletionView(deletion)
+				return output.Print(cmd, schemaResult{Action: "dry-run", SchemaID: &schemaID, SchemaVersion: version.String(), Deletion: &view}, func(out io.Writer) error {
+					printSchemaDeletion(out, view)
+					return nil
+				})
+			}
+
+			return output.Print(cmd, schemaResult{Action: "deleted", SchemaID: &schemaID, SchemaVersion: version.String()}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Soft deleted schema definition %s version %s\n", schemaID, version.String())
+				return nil
+			})
 		},
 	}
 
 	cmd.Flags().StringVar(&schemaIDInput, "schema-id", "", "Schema ID to delete")
 	cmd.Flags().StringVar(&schemaVersionInput, "schema-version", "", "Semantic version to delete (e.g. 1.0.0)")
+	cmd.Flags().BoolVar(&force, "force", false, "Delete even when the version is active or referenced by entity rows")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report what the delete would affect without deleting; refusals are reported as usual")
 	_ = cmd.MarkFlagRequired("schema-id")
 	_ = cmd.MarkFlagRequired("schema-version")
 
 	return cmd
 }
 
-func newSchemaDefinitionService(ctx context.Context, databaseURL, envKey, adminTenantSlug string) (schemarepositoryservice.Service, func(), error) {
+func printSchemaDeletion(out io.Writer, view schemaDeletionView) {
+	fmt.Fprintf(out, "Deleting schema definition %s version %s would:\n", view.SchemaID, view.SchemaVersion)
+	fmt.Fprintf(out, "  soft delete the version of table %s\n", view.TableName)
+	if view.WasActive {
+		fmt.Fprintln(out, "  leave the schema without an active version")
+	}
+	fmt.Fprintf(out, "  orphan %d entity row(s) in %d tenant table(s); they keep their data\n", view.EntityRows, view.EntityTables)
+	fmt.Fprintln(out, "Dry run: nothing was deleted.")
+}
+
+func renameDefinitionSlugCommand() *cobra.Command {
+	var (
+		schemaIDInput string
+		slugInput     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rename-slug",
+		Short: "Give a schema a new slug; the previous slug keeps resolving as an alias",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			schemaID, err := uuid.Parse(strings.TrimSpace(schemaIDInput))
+			if err != nil {
+				return fmt.Errorf("invalid schema id: %w", err)
+			}
+
+			ctx := context.Background()
+			svc, cleanup, err := openDefinitionService(ctx, cmd)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			audit := requesttrace.System("cli-schema-definitions-rename-slug")
+			ctx = requesttrace.IntoContext(ctx, audit)
+
+			info, err := svc.RenameSlug(ctx, audit, schemaID, slugInput)
+			if err != nil {
+				return wrapDefinitionError("rename-slug", err)
+			}
+
+			aliases := make([]string, 0, len(info.Aliases))
+			for _, alias := range info.Aliases {
+				aliases = append(aliases, alias.Slug)
+			}
+			return output.Print(cmd, slugView{SchemaID: info.SchemaID, Slug: info.Slug, Aliases: aliases}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Schema %s slug is now %s\n", info.SchemaID, info.Slug)
+				if len(aliases) > 0 {
+					fmt.Fprintf(out, "Aliases: %s\n", strings.Join(aliases, ", "))
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&schemaIDInput, "schema-id", "", "Schema ID to rename")
+	cmd.Flags().StringVar(&slugInput, "slug", "", "New canonical slug")
+	_ = cmd.MarkFlagRequired("schema-id")
+	_ = cmd.MarkFlagRequired("slug")
+
+	return cmd
+}
+
+// definitionService is the part of the schema repository service the commands use. With --direct the service runs
+// on the database; otherwise apiDefinitionService forwards to the API.
+type definitionService interface {
+	Create(ctx context.Context, audit requesttrace.AuditInfo, input schemarepositoryservice.CreateInput) (schemarepositoryservice.Schema, error)
+	ListAll(ctx context.Context, audit requesttrace.AuditInfo, includeInactive bool) ([]schemarepositoryservice.Schema, error)
+	List(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, includeDeleted bool) ([]schemarepositoryservice.Schema, error)
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion, force, dryRun bool) (schemarepositoryservice.Deletion, error)
+	RenameSlug(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, slug string) (schemarepositoryservice.SlugInfo, error)
+	Diff(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, from, to persistence.SemanticVersion) (schemarepositoryservice.SchemaDiff, error)
+	MigrateIndexes(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, dryRun bool, progress func(persistence.EntityIndexMigration)) (schemarepositoryservice.IndexMigrationReport, error)
+}
+
+func openDefinitionService(ctx context.Context, cmd *cobra.Command) (definitionService, func(), error) {
+	if !apiconn.Direct(cmd) {
+		client, err := apiconn.Connect(cmd)
+		if err != nil {
+			return nil, nil, err
+		}
+		return apiDefinitionService{client: client.SchemaRepository}, func() {}, nil
+	}
+
+	databaseURL, err := apiconn.DatabaseURL(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	envKey, _ := cmd.Flags().GetString("env-key")
+	adminTenantSlug, _ := cmd.Flags().GetString("admin-tenant-slug")
+	return newSchemaDefinitionService(ctx, databaseURL, envKey, adminTenantSlug, entityPartitioning(cmd))
+}
+
+// entityPartitioning reads --partition-entities, which lays out the entity tables the service creates when it
+// activates a version.
+func entityPartitioning(cmd *cobra.Command) persistence.EntityPartitioning {
+	enabled, _ := cmd.Flags().GetBool("partition-entities")
+	return persistence.EntityPartitioning{Enabled: enabled}
+}
+
+func newSchemaDefinitionService(ctx context.Context, databaseURL, envKey, adminTenantSlug string, partitioning persistence.EntityPartitioning) (schemarepositoryservice.Service, func(), error) {
 	pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: databaseURL})
 	if err != nil {
 		return nil, nil, fmt.Errorf("init pool: %w", err)
@@ -279,8 +387,20 @@ func newSchemaDefinitionService(ctx context.Context, databaseURL, envKey, adminT
 		return nil, nil, fmt.Errorf("init schema repository store: %w", err)
 	}
 
-	repo := schemarepositoryrepo.NewPostgresRepository(spaceDB, store)
-	svc := schemarepositoryservice.New(repo)
+	categoryStore, err := persistence.NewSchemaCategoryStore(ctx, pool)
+	if err != nil {
+		persistence.ClosePool(pool)
+		return nil, nil, fmt.Errorf("init schema category store: %w", err)
+	}
+
+	tenantStore, err := persistence.NewTenantStore(ctx, pool, adminSchema)
+	if err != nil {
+		persistence.ClosePool(pool)
+		return nil, nil, fmt.Errorf("init tenant store: %w", err)
+	}
+
+	repo := schemarepositoryrepo.NewPostgresRepository(spaceDB, store, persistence.NewSchemaBundleStore(store, categoryStore), persistence.NewEntityTableStore(spaceDB, partitioning))
+	svc := schemarepositoryservice.New(repo, tenantSpaceLister{store: tenantStore}, nil)
 
 	cleanup := func() {
 		persistence.ClosePool(pool)
@@ -291,11 +411,16 @@ func newSchemaDefinitionService(ctx context.Context, databaseURL, envKey, adminT
 
 func wrapDefinitionError(action string, err error) error {
 	var validationErr *schemarepositoryservice.ValidationError
+	var inUseErr *schemarepositoryservice.InUseError
 	switch {
 	case errors.As(err, &validationErr):
 		return fmt.Errorf("%s validation failed:\n%s", action, formatFieldErrors(map[string][]string(validationErr.Fields)))
+	case errors.As(err, &inUseErr):
+		return fmt.Errorf("%s refused:\n%s\nre-run with --force to delete anyway", action, formatFieldErrors(map[string][]string(inUseErr.Fields())))
 	case errors.Is(err, schemarepositoryservice.ErrConflict):
 		return fmt.Errorf("%s conflict: schema version already exists", action)
+	case errors.Is(err, schemarepositoryservice.ErrSlugTaken):
+		return fmt.Errorf("%s conflict: slug is already used by another schema", action)
 	case errors.Is(err, schemarepositoryservice.ErrNotFound):
 		return fmt.Errorf("%s failed: schema or version not found", action)
 	default:
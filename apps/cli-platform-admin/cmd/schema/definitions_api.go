@@ -0,0 +1,258 @@
+package schemacmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// apiDefinitionService serves the definition commands through the schema repository API. The API records the
+// caller of the token as the actor, so the audit argument is unused.
+type apiDefinitionService struct {
+	client *schemarepository.ClientWithResponses
+}
+
+func (s apiDefinitionService) Create(ctx context.Context, _ requesttrace.AuditInfo, input schemarepositoryservice.CreateInput) (schemarepositoryservice.Schema, error) {
+	if input.SchemaID != nil || input.Version != nil {
+		return schemarepositoryservice.Schema{}, errors.New("the API resolves the schema by slug and picks the next version; --schema-id and --schema-version need --direct")
+	}
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal(input.Definition, &definition); err != nil {
+		return schemarepositoryservice.Schema{}, fmt.Errorf("definition file must hold a JSON object: %w", err)
+	}
+
+	rsp, err := s.client.CreateSchemaVersionWithResponse(ctx, schemarepository.CreateSchemaVersionRequest{
+		CategoryId:       input.CategoryID,
+		SchemaDefinition: definition,
+		Slug:             input.Slug,
+		TableName:        input.TableName,
+	})
+	if err != nil {
+		return schemarepositoryservice.Schema{}, err
+	}
+	if err := definitionAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemarepositoryservice.Schema{}, err
+	}
+	return schemaFromAPI(*rsp.JSON201)
+}
+
+func (s apiDefinitionService) ListAll(ctx context.Context, _ requesttrace.AuditInfo, includeInactive bool) ([]schemarepositoryservice.Schema, error) {
+	rsp, err := s.client.ListAllSchemaVersionsWithResponse(ctx, &schemarepository.ListAllSchemaVersionsParams{IncludeInactive: &includeInactive})
+	if err != nil {
+		return nil, err
+	}
+	if err := definitionAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return nil, err
+	}
+
+	schemas := make([]schemarepositoryservice.Schema, 0, len(rsp.JSON200.Items))
+	for _, item := range rsp.JSON200.Items {
+		schema, err := schemaFromAPI(item)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+// List filters every version, inactive ones included, down to schemaID; the API does not list deleted versions.
+func (s apiDefinitionService) List(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, includeDeleted bool) ([]schemarepositoryservice.Schema, error) {
+	if includeDeleted {
+		return nil, errors.New("the API does not list deleted versions; --include-deleted needs --direct")
+	}
+
+	all, err := s.ListAll(ctx, audit, true)
+	if err != nil {
+		return nil, err
+	}
+	schemas := make([]schemarepositoryservice.Schema, 0)
+	for _, schema := range all {
+		if schema.SchemaID == schemaID {
+			schemas = append(schemas, schema)
+		}
+	}
+	return schemas, nil
+}
+
+// Delete only reports the affected rows on a dry run; the API answers a real delete with no content.
+func (s apiDefinitionService) Delete(ctx context.Context, _ requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion, force, dryRun bool) (schemarepositoryservice.Deletion, error) {
+	rsp, err := s.client.DeleteSchemaVersionWithResponse(ctx, schemaID, version.String(), &schemarepository.DeleteSchemaVersionParams{Force: &force, DryRun: &dryRun})
+	if err != nil {
+		return schemarepositoryservice.Deletion{}, err
+	}
+	if err := definitionAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemarepositoryservice.Deletion{}, err
+	}
+	if rsp.JSON200 == nil {
+		return schemarepositoryservice.Deletion{SchemaID: schemaID, Version: version}, nil
+	}
+	report := rsp.JSON200
+	return schemarepositoryservice.Deletion{
+		SchemaID:    report.SchemaId,
+		Version:     version,
+		TableName:   report.TableName,
+		WasActive:   report.WasActive,
+		EntityCount: report.EntityRows,
+		TableCount:  report.EntityTables,
+		DryRun:      report.DryRun,
+	}, nil
+}
+
+func (s apiDefinitionService) RenameSlug(ctx context.Context, _ requesttrace.AuditInfo, schemaID uuid.UUID, slug string) (schemarepositoryservice.SlugInfo, error) {
+	rsp, err := s.client.RenameSchemaSlugWithResponse(ctx, schemaID, schemarepository.RenameSchemaSlugRequest{Slug: slug})
+	if err != nil {
+		return schemarepositoryservice.SlugInfo{}, err
+	}
+	if err := definitionAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemarepositoryservice.SlugInfo{}, err
+	}
+
+	info := schemarepositoryservice.SlugInfo{SchemaID: rsp.JSON200.SchemaId, Slug: rsp.JSON200.Slug}
+	for _, alias := range rsp.JSON200.Aliases {
+		info.Aliases = append(info.Aliases, persistence.SchemaSlugAlias{
+			Slug:      alias.Slug,
+			SchemaID:  rsp.JSON200.SchemaId,
+			CreatedAt: alias.CreatedAt,
+		})
+	}
+	return info, nil
+}
+
+func (s apiDefinitionService) Diff(ctx context.Context, _ requesttrace.AuditInfo, schemaID uuid.UUID, from, to persistence.SemanticVersion) (schemarepositoryservice.SchemaDiff, error) {
+	rsp, err := s.client.DiffSchemaVersionsWithResponse(ctx, schemaID, &schemarepository.DiffSchemaVersionsParams{From: from.String(), To: to.String()})
+	if err != nil {
+		return schemarepositoryservice.SchemaDiff{}, err
+	}
+	if err := definitionAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemarepositoryservice.SchemaDiff{}, err
+	}
+
+	diff := schemarepositoryservice.SchemaDiff{
+		SchemaID:          rsp.JSON200.SchemaId,
+		From:              from,
+		To:                to,
+		AddedProperties:   rsp.JSON200.AddedProperties,
+		RemovedProperties: rsp.JSON200.RemovedProperties,
+		RequiredAdded:     rsp.JSON200.RequiredAdded,
+		RequiredRemoved:   rsp.JSON200.RequiredRemoved,
+	}
+	for _, change := range rsp.JSON200.ChangedProperties {
+		diff.ChangedProperties = append(diff.ChangedProperties, schemarepositoryservice.PropertyChange{
+			Path:     change.Path,
+			Keywords: change.Keywords,
+			Before:   change.Before,
+			After:    change.After,
+		})
+	}
+	return diff, nil
+}
+
+// MigrateIndexes reports the tenants to progress once the API answers, since it returns the whole report at once.
+func (s apiDefinitionService) MigrateIndexes(ctx context.Context, _ requesttrace.AuditInfo, schemaID uuid.UUID, dryRun bool, progress func(persistence.EntityIndexMigration)) (schemarepositoryservice.IndexMigrationReport, error) {
+	rsp, err := s.client.MigrateSchemaIndexesWithResponse(ctx, schemaID, &schemarepository.MigrateSchemaIndexesParams{DryRun: &dryRun})
+	if err != nil {
+		return schemarepositoryservice.IndexMigrationReport{}, err
+	}
+	if err := definitionAPIError(rsp.StatusCode(), rsp.Body); err != nil {
+		return schemarepositoryservice.IndexMigrationReport{}, err
+	}
+
+	version, err := persistence.ParseSemanticVersion(rsp.JSON200.SchemaVersion)
+	if err != nil {
+		return schemarepositoryservice.IndexMigrationReport{}, fmt.Errorf("api answered an invalid schema version: %w", err)
+	}
+	report := schemarepositoryservice.IndexMigrationReport{
+		SchemaID:      rsp.JSON200.SchemaId,
+		Version:       version,
+		IndexedFields: rsp.JSON200.IndexedFields,
+		DryRun:        rsp.JSON200.DryRun,
+	}
+	for _, t := range rsp.JSON200.Tenants {
+		result := persistence.EntityIndexMigration{
+			TenantID:    t.TenantId,
+			TenantSlug:  t.TenantSlug,
+			TableExists: t.TableExists,
+			Created:     t.CreatedIndexes,
+			Dropped:     t.DroppedIndexes,
+		}
+		if t.Error != nil {
+			result.Error = *t.Error
+		}
+		report.Tenants = append(report.Tenants, result)
+		if progress != nil {
+			progress(result)
+		}
+	}
+	return report, nil
+}
+
+func schemaFromAPI(v schemarepository.SchemaVersion) (schemarepositoryservice.Schema, error) {
+	version, err := persistence.ParseSemanticVersion(v.SchemaVersion)
+	if err != nil {
+		return schemarepositoryservice.Schema{}, fmt.Errorf("api answered an invalid schema version: %w", err)
+	}
+	definition, err := json.Marshal(v.SchemaDefinition)
+	if err != nil {
+		return schemarepositoryservice.Schema{}, fmt.Errorf("encode schema definition: %w", err)
+	}
+
+	schema := schemarepositoryservice.Schema{
+		SchemaID:   v.SchemaId,
+		Version:    version,
+		Definition: definition,
+		TableName:  v.TableName,
+		Slug:       v.Slug,
+		CategoryID: v.CategoryId,
+		CreatedAt:  v.CreatedAt,
+		IsActive:   v.IsActive,
+		IsDeleted:  v.IsDeleted,
+		Status:     string(v.Status),
+	}
+	if v.Compatibility != nil {
+		// Both shapes share their JSON encoding.
+		raw, err := json.Marshal(v.Compatibility)
+		if err != nil {
+			return schemarepositoryservice.Schema{}, fmt.Errorf("encode schema compatibility: %w", err)
+		}
+		schema.Compatibility = &persistence.SchemaCompatibility{}
+		if err := json.Unmarshal(raw, schema.Compatibility); err != nil {
+			return schemarepositoryservice.Schema{}, fmt.Errorf("decode schema compatibility: %w", err)
+		}
+	}
+	return schema, nil
+}
+
+// definitionAPIError maps an API answer onto the service errors wrapDefinitionError reports; other failures, such
+// as conflicts and in-use refusals, keep the problem details the API sent.
+func definitionAPIError(status int, body []byte) error {
+	err := apiclient.Check(status, body)
+	var apiErr *apiclient.Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	var fields map[string][]string
+	if apiErr.Problem != nil && apiErr.Problem.Errors != nil {
+		fields = *apiErr.Problem.Errors
+	}
+	switch {
+	case apiErr.Status == http.StatusNotFound:
+		return schemarepositoryservice.ErrNotFound
+	case len(fields) > 0 && apiErr.Status != http.StatusConflict:
+		return &schemarepositoryservice.ValidationError{Fields: fields}
+	case len(fields) > 0:
+		return fmt.Errorf("%w\n%s", err, formatFieldErrors(fields))
+	}
+	return err
+}
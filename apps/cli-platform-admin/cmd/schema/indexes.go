@@ -0,0 +1,115 @@
+package schemacmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func migrateIndexesCommand() *cobra.Command {
+	var (
+		schemaIDInput string
+		dryRun        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-indexes",
+		Short: "Align every tenant's entity table indexes with the x-indexed fields of the active schema version",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			schemaID, err := uuid.Parse(strings.TrimSpace(schemaIDInput))
+			if err != nil {
+				return fmt.Errorf("invalid schema id: %w", err)
+			}
+
+			ctx := context.Background()
+			svc, cleanup, err := openDefinitionService(ctx, cmd)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			audit := requesttrace.System("cli-schema-definitions-migrate-indexes")
+			ctx = requesttrace.IntoContext(ctx, audit)
+
+			out := output.Progress(cmd)
+			failed := 0
+			report, err := svc.MigrateIndexes(ctx, audit, schemaID, dryRun, func(result persistence.EntityIndexMigration) {
+				switch {
+				case result.Error != "":
+					failed++
+					fmt.Fprintf(out, "%s: failed: %s\n", result.TenantSlug, result.Error)
+				case !result.TableExists:
+					fmt.Fprintf(out, "%s: no entity table, skipped\n", result.TenantSlug)
+				default:
+					fmt.Fprintf(out, "%s: created [%s] dropped [%s]\n", result.TenantSlug, strings.Join(result.Created, ", "), strings.Join(result.Dropped, ", "))
+				}
+			})
+			if err != nil {
+				return wrapDefinitionError("migrate-indexes", err)
+			}
+
+			if err := output.Print(cmd, newIndexMigrationView(report), func(out io.Writer) error {
+				fmt.Fprintf(out, "Schema %s version %s: %d tenant(s), %d failed\n", report.SchemaID, report.Version.String(), len(report.Tenants), failed)
+				if dryRun {
+					fmt.Fprintln(out, "Dry run: no indexes were changed.")
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return fmt.Errorf("index migration failed for %d tenant(s)", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&schemaIDInput, "schema-id", "", "Schema ID whose entity tables are migrated")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report the planned index changes without applying them")
+	_ = cmd.MarkFlagRequired("schema-id")
+
+	return cmd
+}
+
+// tenantSpaceLister lists the spaces of active, database-ready tenants straight from the tenant store.
+type tenantSpaceLister struct {
+	store *persistence.TenantStore
+}
+
+func (l tenantSpaceLister) ListActiveSpaces(ctx context.Context) ([]tenant.Space, error) {
+	const pageSize = 100
+
+	status := "active"
+	spaces := make([]tenant.Space, 0)
+	for offset := 0; ; offset += pageSize {
+		records, total, err := l.store.ListActive(ctx, persistence.TenantListParams{Status: &status}, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list tenants: %w", err)
+		}
+		for _, rec := range records {
+			if !rec.DBReady {
+				continue
+			}
+			spaces = append(spaces, tenant.Space{
+				TenantID:      rec.TenantID,
+				Slug:          rec.Slug,
+				ShortTenantID: rec.ShortTenantID,
+				SchemaName:    rec.SchemaName,
+				BasePrefix:    rec.BasePrefix,
+				RoleName:      rec.RoleName,
+			})
+		}
+		if offset+pageSize >= total || len(records) == 0 {
+			return spaces, nil
+		}
+	}
+}
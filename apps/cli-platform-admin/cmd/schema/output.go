@@ -0,0 +1,258 @@
+package schemacmd
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	schemacategoriesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-categories/be/service"
+	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// The views below are what --output json|yaml prints. Their field names follow the schema categories and schema
+// repository contracts, so scripts can treat CLI and API answers alike.
+
+type categoryView struct {
+	CategoryID       uuid.UUID  `json:"categoryId"`
+	ParentCategoryID *uuid.UUID `json:"parentCategoryId"`
+	Name             string     `json:"name"`
+	Slug             string     `json:"slug"`
+	Description      *string    `json:"description"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+	DeletedAt        *time.Time `json:"deletedAt"`
+}
+
+// categoryResult is printed by the commands that change a category; Action is created, updated or deleted.
+type categoryResult struct {
+	Action   string        `json:"action"`
+	Category *categoryView `json:"category,omitempty"`
+	// CategoryID identifies a deleted category.
+	CategoryID *uuid.UUID `json:"categoryId,omitempty"`
+	// Deletion is what a dry-run delete would change.
+	Deletion *categoryDeletionView `json:"deletion,omitempty"`
+}
+
+// categoryDeletionView follows the SchemaCategoryDeletion contract.
+type categoryDeletionView struct {
+	CategoryID               uuid.UUID   `json:"categoryId"`
+	Mode                     string      `json:"mode"`
+	DeletedCategories        []uuid.UUID `json:"deletedCategories"`
+	ReparentedCategories     int64       `json:"reparentedCategories"`
+	ReparentedSchemaVersions int64       `json:"reparentedSchemaVersions"`
+	DeletedSchemaVersions    int64       `json:"deletedSchemaVersions"`
+	DryRun                   bool        `json:"dryRun"`
+}
+
+func newCategoryDeletionView(d schemacategoriesservice.Deletion) categoryDeletionView {
+	categories := d.Categories
+	if categories == nil {
+		categories = []uuid.UUID{}
+	}
+	return categoryDeletionView{
+		CategoryID:               d.CategoryID,
+		Mode:                     string(d.Mode),
+		DeletedCategories:        categories,
+		ReparentedCategories:     d.ReparentedCategories,
+		ReparentedSchemaVersions: d.ReparentedSchemaVersions,
+		DeletedSchemaVersions:    d.DeletedSchemaVersions,
+		DryRun:                   d.DryRun,
+	}
+}
+
+func newCategoryView(c schemacategoriesservice.Category) categoryView {
+	return categoryView{
+		CategoryID:       c.ID,
+		ParentCategoryID: c.ParentID,
+		Name:             c.Name,
+		Slug:             c.Slug,
+		Description:      c.Description,
+		CreatedAt:        c.CreatedAt.UTC(),
+		UpdatedAt:        c.UpdatedAt.UTC(),
+		DeletedAt:        utcOrNil(c.DeletedAt),
+	}
+}
+
+type schemaView struct {
+	SchemaID         uuid.UUID                        `json:"schemaId"`
+	SchemaVersion    string                           `json:"schemaVersion"`
+	Slug             string                           `json:"slug"`
+	TableName        string                           `json:"tableName"`
+	CategoryID       uuid.UUID                        `json:"categoryId"`
+	IsActive         bool                             `json:"isActive"`
+	IsDeleted        bool                             `json:"isDeleted"`
+	Status           string                           `json:"status"`
+	CreatedAt        time.Time                        `json:"createdAt"`
+	SchemaDefinition json.RawMessage                  `json:"schemaDefinition"`
+	Compatibility    *persistence.SchemaCompatibility `json:"compatibility,omitempty"`
+}
+
+// schemaResult is printed by the commands that change a schema version; Action is upserted or deleted.
+type schemaResult struct {
+	Action        string      `json:"action"`
+	Schema        *schemaView `json:"schema,omitempty"`
+	SchemaID      *uuid.UUID  `json:"schemaId,omitempty"`
+	SchemaVersion string      `json:"schemaVersion,omitempty"`
+	// Deletion is what a dry-run delete would change.
+	Deletion *schemaDeletionView `json:"deletion,omitempty"`
+}
+
+// schemaDeletionView follows the SchemaVersionDeletion contract.
+type schemaDeletionView struct {
+	SchemaID      uuid.UUID `json:"schemaId"`
+	SchemaVersion string    `json:"schemaVersion"`
+	TableName     string    `json:"tableName"`
+	WasActive     bool      `json:"wasActive"`
+	EntityRows    int64     `json:"entityRows"`
+	EntityTables  int       `json:"entityTables"`
+	DryRun        bool      `json:"dryRun"`
+}
+
+func newSchemaDeletionView(d schemarepositoryservice.Deletion) schemaDeletionView {
+	return schemaDeletionView{
+		SchemaID:      d.SchemaID,
+		SchemaVersion: d.Version.String(),
+		TableName:     d.TableName,
+		WasActive:     d.WasActive,
+		EntityRows:    d.EntityCount,
+		EntityTables:  d.TableCount,
+		DryRun:        d.DryRun,
+	}
+}
+
+func newSchemaView(s schemarepositoryservice.Schema) schemaView {
+	return schemaView{
+		SchemaID:         s.SchemaID,
+		SchemaVersion:    s.Version.String(),
+		Slug:             s.Slug,
+		TableName:        s.TableName,
+		CategoryID:       s.CategoryID,
+		IsActive:         s.IsActive,
+		IsDeleted:        s.IsDeleted,
+		Status:           s.Status,
+		CreatedAt:        s.CreatedAt.UTC(),
+		SchemaDefinition: s.Definition,
+		Compatibility:    s.Compatibility,
+	}
+}
+
+type slugView struct {
+	SchemaID uuid.UUID `json:"schemaId"`
+	Slug     string    `json:"slug"`
+	Aliases  []string  `json:"aliases"`
+}
+
+type indexMigrationView struct {
+	SchemaID      uuid.UUID                  `json:"schemaId"`
+	SchemaVersion string                     `json:"schemaVersion"`
+	IndexedFields []string                   `json:"indexedFields"`
+	DryRun        bool                       `json:"dryRun"`
+	Tenants       []tenantIndexMigrationView `json:"tenants"`
+}
+
+type tenantIndexMigrationView struct {
+	TenantID       uuid.UUID `json:"tenantId"`
+	TenantSlug     string    `json:"tenantSlug"`
+	TableExists    bool      `json:"tableExists"`
+	CreatedIndexes []string  `json:"createdIndexes"`
+	DroppedIndexes []string  `json:"droppedIndexes"`
+	Error          string    `json:"error,omitempty"`
+}
+
+func newIndexMigrationView(report schemarepositoryservice.IndexMigrationReport) indexMigrationView {
+	view := indexMigrationView{
+		SchemaID:      report.SchemaID,
+		SchemaVersion: report.Version.String(),
+		IndexedFields: nonNil(report.IndexedFields),
+		DryRun:        report.DryRun,
+		Tenants:       make([]tenantIndexMigrationView, 0, len(report.Tenants)),
+	}
+	for _, t := range report.Tenants {
+		view.Tenants = append(view.Tenants, tenantIndexMigrationView{
+			TenantID:       t.TenantID,
+			TenantSlug:     t.TenantSlug,
+			TableExists:    t.TableExists,
+			CreatedIndexes: nonNil(t.Created),
+			DroppedIndexes: nonNil(t.Dropped),
+			Error:          t.Error,
+		})
+	}
+	return view
+}
+
+// diffView follows the SchemaDiff contract, plus the compatibility of the target version with the base one.
+type diffView struct {
+	SchemaID          uuid.UUID                       `json:"schemaId"`
+	FromVersion       string                          `json:"fromVersion"`
+	ToVersion         string                          `json:"toVersion"`
+	AddedProperties   []string                        `json:"addedProperties"`
+	RemovedProperties []string                        `json:"removedProperties"`
+	ChangedProperties []propertyChangeView            `json:"changedProperties"`
+	RequiredAdded     []string                        `json:"requiredAdded"`
+	RequiredRemoved   []string                        `json:"requiredRemoved"`
+	Compatibility     persistence.SchemaCompatibility `json:"compatibility"`
+}
+
+type propertyChangeView struct {
+	Path     string         `json:"path"`
+	Keywords []string       `json:"keywords"`
+	Before   map[string]any `json:"before"`
+	After    map[string]any `json:"after"`
+}
+
+func newDiffView(diff schemarepositoryservice.SchemaDiff, compatibility persistence.SchemaCompatibility) diffView {
+	changes := make([]propertyChangeView, 0, len(diff.ChangedProperties))
+	for _, change := range diff.ChangedProperties {
+		changes = append(changes, propertyChangeView{
+			Path:     change.Path,
+			Keywords: nonNil(change.Keywords),
+			Before:   nonNilMap(change.Before),
+			After:    nonNilMap(change.After),
+		})
+	}
+	return diffView{
+		SchemaID:          diff.SchemaID,
+		FromVersion:       diff.From.String(),
+		ToVersion:         diff.To.String(),
+		AddedProperties:   nonNil(diff.AddedProperties),
+		RemovedProperties: nonNil(diff.RemovedProperties),
+		ChangedProperties: changes,
+		RequiredAdded:     nonNil(diff.RequiredAdded),
+		RequiredRemoved:   nonNil(diff.RequiredRemoved),
+		Compatibility:     compatibility,
+	}
+}
+
+// checkView is what check prints; the base is either a stored version or a file.
+type checkView struct {
+	SchemaID    *uuid.UUID                             `json:"schemaId,omitempty"`
+	BaseVersion string                                 `json:"baseVersion,omitempty"`
+	BaseFile    string                                 `json:"baseFile,omitempty"`
+	Level       string                                 `json:"level"`
+	Issues      []persistence.SchemaCompatibilityIssue `json:"issues"`
+}
+
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// nonNil keeps empty lists as [] rather than null in the output.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func nonNilMap(values map[string]any) map[string]any {
+	if values == nil {
+		return map[string]any{}
+	}
+	return values
+}
@@ -16,11 +16,12 @@ import (
 func Command() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "schema",
-		Short: "Schema utilities (categories, repository)",
+		Short: "Schema utilities (categories, repository, bundles)",
 	}
 
 	cmd.AddCommand(categoriesCommand())
 	cmd.AddCommand(definitionsCommand())
+	cmd.AddCommand(bundleCommand())
 	return cmd
 }
 
@@ -55,9 +56,12 @@ func formatFieldErrors(fields map[string][]string) string {
 
 func wrapCategoryError(action string, err error) error {
 	var validationErr *schemacategoriesservice.ValidationError
+	var inUseErr *schemacategoriesservice.InUseError
 	switch {
 	case errors.As(err, &validationErr):
 		return fmt.Errorf("%s validation failed:\n%s", action, formatFieldErrors(map[string][]string(validationErr.Fields)))
+	case errors.As(err, &inUseErr):
+		return fmt.Errorf("%s refused, category is in use:\n%s", action, formatFieldErrors(map[string][]string(inUseErr.Fields())))
 	case errors.Is(err, schemacategoriesservice.ErrConflict):
 		return fmt.Errorf("%s conflict: name or slug already exists", action)
 	case errors.Is(err, schemacategoriesservice.ErrNotFound):
@@ -0,0 +1,457 @@
+package seedcmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	schemacategories "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-categories"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	usersapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+const (
+	// jobPollInterval is how often seed asks for a provisioning job.
+	jobPollInterval = 2 * time.Second
+	// batchGetLimit and bulkLimit are the most ids documents:batchGet and rows documents:bulk take per request.
+	batchGetLimit = 100
+	bulkLimit     = 1000
+)
+
+type seeder struct {
+	client   *apiclient.Client
+	dir      string
+	wait     time.Duration
+	progress io.Writer
+	items    []seedItem
+	// categories maps the slugs of the live categories to their ids.
+	categories map[string]uuid.UUID
+}
+
+func (s *seeder) record(kind, key, action, detail string) {
+	s.items = append(s.items, seedItem{Kind: kind, Key: key, Action: action, Detail: detail})
+}
+
+// run seeds the manifest in dependency order and stops at the first failure; what was seeded before stays.
+func (s *seeder) run(ctx context.Context, m manifest) error {
+	if len(m.Categories) > 0 || len(m.Schemas) > 0 {
+		if err := s.loadCategories(ctx); err != nil {
+			return err
+		}
+	}
+	for _, c := range m.Categories {
+		if err := s.seedCategory(ctx, c); err != nil {
+			return fmt.Errorf("seed category %s: %w", c.Slug, err)
+		}
+	}
+
+	if len(m.Schemas) > 0 {
+		active, err := s.activeSchemas(ctx)
+		if err != nil {
+			return err
+		}
+		for _, f := range m.Schemas {
+			if err := s.seedSchema(ctx, f, active); err != nil {
+				return fmt.Errorf("seed schema %s: %w", f.Slug, err)
+			}
+		}
+	}
+
+	for _, t := range m.Tenants {
+		if err := s.seedTenant(ctx, t); err != nil {
+			return fmt.Errorf("seed tenant %s: %w", t.Slug, err)
+		}
+	}
+
+	for _, e := range m.Entities {
+		if err := s.seedEntities(ctx, e); err != nil {
+			return fmt.Errorf("seed entities %s/%s: %w", e.Tenant, e.Table, err)
+		}
+	}
+	return nil
+}
+
+func (s *seeder) loadCategories(ctx context.Context) error {
+	rsp, err := s.client.SchemaCategories.ListSchemaCategoriesWithResponse(ctx, &schemacategories.ListSchemaCategoriesParams{})
+	if err != nil {
+		return fmt.Errorf("list categories: %w", err)
+	}
+	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+		return fmt.Errorf("list categories: %w", err)
+	}
+
+	s.categories = map[string]uuid.UUID{}
+	for _, c := range rsp.JSON200.Items {
+		s.categories[c.Slug] = c.CategoryId
+	}
+	return nil
+}
+
+func (s *seeder) seedCategory(ctx context.Context, f categoryFixture) error {
+	var parentID *uuid.UUID
+	if f.Parent != "" {
+		id, ok := s.categories[f.Parent]
+		if !ok {
+			return fmt.Errorf("parent category %s not found; list it before its children", f.Parent)
+		}
+		parentID = &id
+	}
+
+	id, exists := s.categories[f.Slug]
+	if !exists {
+		rsp, err := s.client.SchemaCategories.CreateSchemaCategoryWithResponse(ctx, schemacategories.CreateSchemaCategoryRequest{
+			Name:             f.Name,
+			Slug:             f.Slug,
+			ParentCategoryId: parentID,
+			Description:      f.Description,
+		})
+		if err != nil {
+			return err
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return err
+		}
+		s.categories[f.Slug] = rsp.JSON201.CategoryId
+		s.record("category", f.Slug, "created", "")
+		return nil
+	}
+
+	current, err := s.client.SchemaCategories.GetSchemaCategoryWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := apiclient.Check(current.StatusCode(), current.Body); err != nil {
+		return err
+	}
+	c := current.JSON200
+	if c.Name == f.Name && reflect.DeepEqual(c.ParentCategoryId, parentID) && reflect.DeepEqual(c.Description, f.Description) {
+		s.record("category", f.Slug, "unchanged", "")
+		return nil
+	}
+
+	rsp, err := s.client.SchemaCategories.UpdateSchemaCategoryWithResponse(ctx, id, schemacategories.UpdateSchemaCategoryRequest{
+		Name:             &f.Name,
+		ParentCategoryId: parentID,
+		Description:      f.Description,
+	})
+	if err != nil {
+		return err
+	}
+	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+		return err
+	}
+	s.record("category", f.Slug, "updated", "")
+	return nil
+}
+
+// activeSchemas maps the slugs of the schemas to their active version.
+func (s *seeder) activeSchemas(ctx context.Context) (map[string]schemarepository.SchemaVersion, error) {
+	includeInactive := false
+	rsp, err := s.client.SchemaRepository.ListAllSchemaVersionsWithResponse(ctx, &schemarepository.ListAllSchemaVersionsParams{IncludeInactive: &includeInactive})
+	if err != nil {
+		return nil, fmt.Errorf("list schemas: %w", err)
+	}
+	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+		return nil, fmt.Errorf("list schemas: %w", err)
+	}
+
+	active := map[string]schemarepository.SchemaVersion{}
+	for _, v := range rsp.JSON200.Items {
+		if v.IsActive && !v.IsDeleted {
+			active[v.Slug] = v
+		}
+	}
+	return active, nil
+}
+
+// seedSchema creates a version when the schema is new or its definition, table or category changed; the API picks
+// the next version number and activates it.
+func (s *seeder) seedSchema(ctx context.Context, f schemaFixture, active map[string]schemarepository.SchemaVersion) error {
+	categoryID, ok := s.categories[f.Category]
+	if !ok {
+		return fmt.Errorf("category %s not found", f.Category)
+	}
+
+	data, err := os.ReadFile(s.path(f.Definition))
+	if err != nil {
+		return fmt.Errorf("read definition: %w", err)
+	}
+	var definition map[string]interface{}
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return fmt.Errorf("definition %s must hold a JSON object: %w", f.Definition, err)
+	}
+
+	current, exists := active[f.Slug]
+	if exists && current.TableName == f.TableName && current.CategoryId == categoryID && reflect.DeepEqual(current.SchemaDefinition, definition) {
+		s.record("schema", f.Slug, "unchanged", "version "+current.SchemaVersion)
+		return nil
+	}
+
+	rsp, err := s.client.SchemaRepository.CreateSchemaVersionWithResponse(ctx, schemarepository.CreateSchemaVersionRequest{
+		CategoryId:       categoryID,
+		SchemaDefinition: definition,
+		Slug:             f.Slug,
+		TableName:        f.TableName,
+	})
+	if err != nil {
+		return err
+	}
+	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+		return err
+	}
+
+	action := "created"
+	if exists {
+		action = "updated"
+	}
+	s.record("schema", f.Slug, action, "version "+rsp.JSON201.SchemaVersion)
+	return nil
+}
+
+// seedTenant creates the tenant when missing, provisions it until its database, auth and storage are ready, and adds
+// its users while impersonating it.
+func (s *seeder) seedTenant(ctx context.Context, f tenantFixture) error {
+	t, found, err := s.findTenant(ctx, f.Slug)
+	if err != nil {
+		return err
+	}
+	if !found {
+		body := tenantsapi.CreateTenant{Slug: f.Slug}
+		if f.Name != "" {
+			body.DisplayName = &f.Name
+		}
+		rsp, err := s.client.Tenants.TenantsCreateWithResponse(ctx, body)
+		if err != nil {
+			return err
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return err
+		}
+		t = *rsp.JSON201
+		s.record("tenant", f.Slug, "created", "")
+	} else {
+		s.record("tenant", f.Slug, "unchanged", "")
+	}
+
+	if !ready(t.Provisioning) {
+		if err := s.provision(ctx, t); err != nil {
+			return err
+		}
+		s.record("tenant", f.Slug, "provisioned", "")
+	}
+
+	for _, u := range f.Users {
+		rsp, err := s.client.Users.UsersCreateWithResponse(ctx, usersapi.CreateUser{
+			Email:    primitives.Email(strings.TrimSpace(u.Email)),
+			FullName: strings.TrimSpace(u.FullName),
+		}, apiclient.Impersonate(t.TenantId.String()))
+		if err != nil {
+			return fmt.Errorf("create user %s: %w", u.Email, err)
+		}
+		switch err := apiclient.Check(rsp.StatusCode(), rsp.Body); {
+		case apiclient.StatusOf(err) == http.StatusConflict:
+			s.record("user", f.Slug+"/"+u.Email, "unchanged", "")
+		case err != nil:
+			return fmt.Errorf("create user %s: %w", u.Email, err)
+		default:
+			s.record("user", f.Slug+"/"+u.Email, "created", "")
+		}
+	}
+	return nil
+}
+
+func (s *seeder) findTenant(ctx context.Context, slug string) (tenantsapi.Tenant, bool, error) {
+	for t, err := range apiclient.Items(ctx, func(ctx context.Context, page int) ([]tenantsapi.Tenant, int, error) {
+		list, err := s.client.Tenants.TenantsListWithResponse(ctx, &tenantsapi.TenantsListParams{Page: &page, Q: &slug})
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := apiclient.Check(list.StatusCode(), list.Body); err != nil {
+			return nil, 0, err
+		}
+		return list.JSON200.Items, list.JSON200.TotalPages, nil
+	}) {
+		if err != nil {
+			return tenantsapi.Tenant{}, false, fmt.Errorf("find tenant: %w", err)
+		}
+		if t.Slug == slug {
+			return t, true, nil
+		}
+	}
+	return tenantsapi.Tenant{}, false, nil
+}
+
+func (s *seeder) provision(ctx context.Context, t tenantsapi.Tenant) error {
+	rsp, err := s.client.Tenants.TenantsProvisionWithResponse(ctx, t.TenantId)
+	if err != nil {
+		return fmt.Errorf("provision tenant: %w", err)
+	}
+	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+		return fmt.Errorf("provision tenant: %w", err)
+	}
+	job := *rsp.JSON202
+	fmt.Fprintf(s.progress, "Provisioning tenant %s (job %s)\n", t.Slug, job.JobId)
+
+	ctx, cancel := context.WithTimeout(ctx, s.wait)
+	defer cancel()
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+	for {
+		switch job.Status {
+		case tenantsapi.ProvisioningJobStatusSucceeded:
+			return nil
+		case tenantsapi.ProvisioningJobStatusFailed:
+			lastError := ""
+			if job.LastError != nil {
+				lastError = ": " + *job.LastError
+			}
+			return fmt.Errorf("provisioning job %s failed%s", job.JobId, lastError)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("provisioning job %s still %s after %s", job.JobId, job.Status, s.wait)
+		case <-ticker.C:
+		}
+
+		rsp, err := s.client.Tenants.TenantsGetJobWithResponse(ctx, job.TenantId, job.JobId)
+		if err != nil {
+			return fmt.Errorf("get provisioning job: %w", err)
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return fmt.Errorf("get provisioning job: %w", err)
+		}
+		job = *rsp.JSON200
+	}
+}
+
+func ready(status tenantsapi.TenantProvisioningStatus) bool {
+	isTrue := func(v *bool) bool { return v != nil && *v }
+	return isTrue(status.DbReady) && isTrue(status.AuthReady) && isTrue(status.StorageReady)
+}
+
+// seedEntities imports the documents of the file whose entityId the table does not hold yet.
+func (s *seeder) seedEntities(ctx context.Context, f entityFixture) error {
+	rows, err := readEntityFile(s.path(f.File))
+	if err != nil {
+		return err
+	}
+	key := f.Tenant + "/" + f.Table
+	if len(rows) == 0 {
+		s.record("entities", key, "unchanged", "no rows")
+		return nil
+	}
+	impersonate := apiclient.Impersonate(f.Tenant)
+
+	var missing []entitiesapi.CreateEntityDocumentRequest
+	for start := 0; start < len(rows); start += batchGetLimit {
+		chunk := rows[start:min(start+batchGetLimit, len(rows))]
+		ids := make([]string, 0, len(chunk))
+		for _, row := range chunk {
+			ids = append(ids, *row.EntityId)
+		}
+		rsp, err := s.client.Entities.BatchGetDocumentsWithResponse(ctx, f.Table, entitiesapi.BatchGetEntityDocumentsRequest{EntityIds: ids}, impersonate)
+		if err != nil {
+			return fmt.Errorf("look up documents: %w", err)
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return fmt.Errorf("look up documents: %w", err)
+		}
+
+		absent := map[string]bool{}
+		for _, id := range rsp.JSON200.Missing {
+			absent[id] = true
+		}
+		for _, row := range chunk {
+			if absent[*row.EntityId] {
+				missing = append(missing, row)
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		s.record("entities", key, "unchanged", fmt.Sprintf("%d document(s)", len(rows)))
+		return nil
+	}
+	for start := 0; start < len(missing); start += bulkLimit {
+		batch := missing[start:min(start+bulkLimit, len(missing))]
+		rsp, err := s.client.Entities.BulkCreateDocumentsWithResponse(ctx, f.Table, batch, impersonate)
+		if err != nil {
+			return fmt.Errorf("import documents: %w", err)
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return fmt.Errorf("import documents: %w", err)
+		}
+		for _, r := range rsp.JSON200.Results {
+			if r.Status != entitiesapi.Failed {
+				continue
+			}
+			reason := "rejected"
+			if r.Error != nil {
+				reason = *r.Error
+			}
+			return fmt.Errorf("document %s rejected: %s", *batch[r.Index].EntityId, reason)
+		}
+	}
+	s.record("entities", key, "created", fmt.Sprintf("%d of %d document(s)", len(missing), len(rows)))
+	return nil
+}
+
+// readEntityFile reads an NDJSON file of documents; every row needs an entityId, which keeps seeding idempotent.
+func readEntityFile(path string) ([]entitiesapi.CreateEntityDocumentRequest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open entity file: %w", err)
+	}
+	defer file.Close()
+
+	var rows []entitiesapi.CreateEntityDocumentRequest
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var row entitiesapi.CreateEntityDocumentRequest
+		if err := json.Unmarshal(text, &row); err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid JSON: %w", path, line, err)
+		}
+		if row.EntityId == nil || strings.TrimSpace(*row.EntityId) == "" {
+			return nil, fmt.Errorf("%s:%d: entityId is required", path, line)
+		}
+		if row.Payload == nil {
+			return nil, fmt.Errorf("%s:%d: payload is required", path, line)
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read entity file: %w", err)
+	}
+	return rows, nil
+}
+
+func (s *seeder) path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(s.dir, name)
+}
@@ -0,0 +1,386 @@
+package seedcmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	schemacategories "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-categories"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	usersapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+// fakeAPI keeps what seed writes in memory and logs every write as "METHOD path", so tests can check the order of
+// the writes and that a second run only repeats the ones the API answers as no-ops.
+type fakeAPI struct {
+	t *testing.T
+
+	mu         sync.Mutex
+	writes     []string
+	categories map[uuid.UUID]schemacategories.SchemaCategory
+	schemas    map[string]schemarepository.SchemaVersion
+	tenants    map[string]tenantsapi.Tenant
+	users      map[string]bool
+	documents  map[string]bool
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	return &fakeAPI{
+		t:          t,
+		categories: map[uuid.UUID]schemacategories.SchemaCategory{},
+		schemas:    map[string]schemarepository.SchemaVersion{},
+		tenants:    map[string]tenantsapi.Tenant{},
+		users:      map[string]bool{},
+		documents:  map[string]bool{},
+	}
+}
+
+func (f *fakeAPI) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/schema-categories", func(w http.ResponseWriter, _ *http.Request) {
+		list := schemacategories.SchemaCategoryList{Items: []schemacategories.SchemaCategory{}}
+		for _, c := range f.categories {
+			list.Items = append(list.Items, c)
+		}
+		reply(w, http.StatusOK, list)
+	})
+	mux.HandleFunc("POST /api/v1/schema-categories", func(w http.ResponseWriter, r *http.Request) {
+		var body schemacategories.CreateSchemaCategoryRequest
+		f.decode(r, &body)
+		c := schemacategories.SchemaCategory{CategoryId: uuid.New(), Name: body.Name, Slug: body.Slug, ParentCategoryId: body.ParentCategoryId, Description: body.Description}
+		f.categories[c.CategoryId] = c
+		reply(w, http.StatusCreated, c)
+	})
+	mux.HandleFunc("GET /api/v1/schema-categories/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK, f.categories[uuid.MustParse(r.PathValue("id"))])
+	})
+	mux.HandleFunc("PATCH /api/v1/schema-categories/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var body schemacategories.UpdateSchemaCategoryRequest
+		f.decode(r, &body)
+		c := f.categories[uuid.MustParse(r.PathValue("id"))]
+		c.Name, c.ParentCategoryId, c.Description = *body.Name, body.ParentCategoryId, body.Description
+		f.categories[c.CategoryId] = c
+		reply(w, http.StatusOK, c)
+	})
+
+	mux.HandleFunc("GET /api/v1/schema-repository/schemas", func(w http.ResponseWriter, _ *http.Request) {
+		list := schemarepository.SchemaVersionList{Items: []schemarepository.SchemaVersion{}}
+		for _, s := range f.schemas {
+			list.Items = append(list.Items, s)
+		}
+		reply(w, http.StatusOK, list)
+	})
+	mux.HandleFunc("POST /api/v1/schema-repository/schemas", func(w http.ResponseWriter, r *http.Request) {
+		var body schemarepository.CreateSchemaVersionRequest
+		f.decode(r, &body)
+		version, minor := f.schemas[body.Slug], 0
+		if version.SchemaVersion != "" {
+			_, _ = fmt.Sscanf(version.SchemaVersion, "1.%d.0", &minor)
+			minor++
+		}
+		version = schemarepository.SchemaVersion{
+			SchemaId:         uuid.New(),
+			Slug:             body.Slug,
+			TableName:        body.TableName,
+			CategoryId:       body.CategoryId,
+			SchemaDefinition: body.SchemaDefinition,
+			SchemaVersion:    fmt.Sprintf("1.%d.0", minor),
+			IsActive:         true,
+		}
+		f.schemas[body.Slug] = version
+		reply(w, http.StatusCreated, version)
+	})
+
+	mux.HandleFunc("GET /api/v1/admin/tenants", func(w http.ResponseWriter, r *http.Request) {
+		items := []tenantsapi.Tenant{}
+		if t, ok := f.tenants[r.URL.Query().Get("q")]; ok {
+			items = append(items, t)
+		}
+		reply(w, http.StatusOK, map[string]any{"items": items, "page": 1, "pageSize": 20, "totalItems": len(items), "totalPages": 1})
+	})
+	mux.HandleFunc("POST /api/v1/admin/tenants", func(w http.ResponseWriter, r *http.Request) {
+		var body tenantsapi.CreateTenant
+		f.decode(r, &body)
+		t := tenantsapi.Tenant{TenantId: uuid.New(), Slug: body.Slug, DisplayName: body.DisplayName}
+		f.tenants[t.Slug] = t
+		reply(w, http.StatusCreated, t)
+	})
+	mux.HandleFunc("POST /api/v1/admin/tenants/{action}", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := strings.CutSuffix(r.PathValue("action"), ":provision")
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		tenantID := uuid.MustParse(id)
+		yes := true
+		for slug, t := range f.tenants {
+			if t.TenantId == tenantID {
+				t.Provisioning = tenantsapi.TenantProvisioningStatus{DbReady: &yes, AuthReady: &yes, StorageReady: &yes}
+				f.tenants[slug] = t
+			}
+		}
+		reply(w, http.StatusAccepted, tenantsapi.ProvisioningJob{JobId: uuid.New(), TenantId: tenantID, Status: tenantsapi.ProvisioningJobStatusSucceeded})
+	})
+
+	mux.HandleFunc("POST /api/v1/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		var body usersapi.CreateUser
+		f.decode(r, &body)
+		key := r.Header.Get(apiclient.ImpersonateTenantHeader) + "/" + string(body.Email)
+		if f.users[key] {
+			reply(w, http.StatusConflict, map[string]any{"title": "Conflict", "status": http.StatusConflict})
+			return
+		}
+		f.users[key] = true
+		reply(w, http.StatusCreated, usersapi.User{Id: uuid.New(), Email: body.Email, FullName: body.FullName})
+	})
+
+	mux.HandleFunc("POST /api/v1/entities/{table}/documents:batchGet", func(w http.ResponseWriter, r *http.Request) {
+		var body entitiesapi.BatchGetEntityDocumentsRequest
+		f.decode(r, &body)
+		result := entitiesapi.BatchGetEntityDocumentsResponse{Items: []entitiesapi.EntityDocument{}, Missing: []string{}}
+		for _, id := range body.EntityIds {
+			if !f.documents[f.documentKey(r, id)] {
+				result.Missing = append(result.Missing, id)
+			}
+		}
+		reply(w, http.StatusOK, result)
+	})
+	mux.HandleFunc("POST /api/v1/entities/{table}/documents:bulk", func(w http.ResponseWriter, r *http.Request) {
+		var rows entitiesapi.BulkCreateEntityDocumentsRequest
+		f.decode(r, &rows)
+		report := entitiesapi.BulkCreateEntityDocumentsReport{Total: len(rows), Succeeded: len(rows), Results: []entitiesapi.BulkCreateEntityDocumentResult{}}
+		for i, row := range rows {
+			f.documents[f.documentKey(r, *row.EntityId)] = true
+			report.Results = append(report.Results, entitiesapi.BulkCreateEntityDocumentResult{Index: i, EntityId: row.EntityId, Status: entitiesapi.Created})
+		}
+		reply(w, http.StatusOK, report)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if r.Method != http.MethodGet && !strings.HasSuffix(r.URL.Path, ":batchGet") {
+			f.writes = append(f.writes, r.Method+" "+strings.TrimPrefix(r.URL.Path, apiclient.BasePath))
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// decode reads the request body into v; handlers run off the test goroutine, so a bad body fails the test with Errorf.
+func (f *fakeAPI) decode(r *http.Request, v any) {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		f.t.Errorf("decode %s %s: %v", r.Method, r.URL.Path, err)
+	}
+}
+
+// documentKey scopes an entity id to the impersonated tenant and the table.
+func (f *fakeAPI) documentKey(r *http.Request, id string) string {
+	return r.Header.Get(apiclient.ImpersonateTenantHeader) + "/" + r.PathValue("table") + "/" + id
+}
+
+// takeWrites returns the writes logged since the last call.
+func (f *fakeAPI) takeWrites() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	writes := f.writes
+	f.writes = nil
+	return writes
+}
+
+func reply(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if status >= http.StatusBadRequest {
+		w.Header().Set("Content-Type", "application/problem+json")
+	}
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+const (
+	testManifest = `categories:
+  - slug: sales
+    name: Sales
+schemas:
+  - slug: orders
+    tableName: orders
+    category: sales
+    definition: orders.json
+tenants:
+  - slug: acme
+    name: Acme
+    users:
+      - email: ada@acme.test
+        fullName: Ada Lovelace
+entities:
+  - tenant: acme
+    table: orders
+    file: orders.ndjson
+`
+	testDefinition = `{"type": "object", "properties": {"total": {"type": "number"}}}`
+	testDocuments  = `{"entityId": "o-1", "payload": {"total": 1}}
+{"entityId": "o-2", "payload": {"total": 2}}
+`
+)
+
+// writeFixtures writes the fixtures directory of testManifest.
+func writeFixtures(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeFixture(t, dir, ManifestFile, testManifest)
+	writeFixture(t, dir, "orders.json", testDefinition)
+	writeFixture(t, dir, "orders.ndjson", testDocuments)
+	return dir
+}
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+// seed runs the seeder over the fixtures in dir and returns what it did.
+func seed(t *testing.T, api *fakeAPI, dir string) []seedItem {
+	t.Helper()
+	server := httptest.NewServer(api.handler())
+	t.Cleanup(server.Close)
+	client, err := apiclient.New(server.URL, apiclient.WithRetryPolicy(apiclient.RetryPolicy{MaxAttempts: 1}))
+	require.NoError(t, err)
+
+	m, err := loadManifest(dir)
+	require.NoError(t, err)
+	s := &seeder{client: client, dir: dir, wait: time.Second, progress: io.Discard}
+	require.NoError(t, s.run(context.Background(), m))
+	return s.items
+}
+
+func TestSeederRunsInDependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	api := newFakeAPI(t)
+	items := seed(t, api, writeFixtures(t))
+
+	require.Equal(t, []seedItem{
+		{Kind: "category", Key: "sales", Action: "created"},
+		{Kind: "schema", Key: "orders", Action: "created", Detail: "version 1.0.0"},
+		{Kind: "tenant", Key: "acme", Action: "created"},
+		{Kind: "tenant", Key: "acme", Action: "provisioned"},
+		{Kind: "user", Key: "acme/ada@acme.test", Action: "created"},
+		{Kind: "entities", Key: "acme/orders", Action: "created", Detail: "2 of 2 document(s)"},
+	}, items)
+
+	tenantID := api.tenants["acme"].TenantId
+	require.Equal(t, []string{
+		"POST /schema-categories",
+		"POST /schema-repository/schemas",
+		"POST /admin/tenants",
+		"POST /admin/tenants/" + tenantID.String() + ":provision",
+		"POST /admin/users",
+		"POST /entities/orders/documents:bulk",
+	}, api.takeWrites())
+	require.True(t, api.users[tenantID.String()+"/ada@acme.test"], "users are created in their tenant")
+	require.True(t, api.documents["acme/orders/o-2"], "documents are created in their tenant")
+}
+
+func TestSeederRerun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// change edits the fixtures between the two runs.
+		change     func(t *testing.T, dir string)
+		wantItems  []seedItem
+		wantWrites []string
+	}{
+		{
+			name:   "unchanged fixtures write nothing",
+			change: func(*testing.T, string) {},
+			wantItems: []seedItem{
+				{Kind: "category", Key: "sales", Action: "unchanged"},
+				{Kind: "schema", Key: "orders", Action: "unchanged", Detail: "version 1.0.0"},
+				{Kind: "tenant", Key: "acme", Action: "unchanged"},
+				{Kind: "user", Key: "acme/ada@acme.test", Action: "unchanged"},
+				{Kind: "entities", Key: "acme/orders", Action: "unchanged", Detail: "2 document(s)"},
+			},
+			// Users are matched by the conflict the API answers.
+			wantWrites: []string{"POST /admin/users"},
+		},
+		{
+			name: "a renamed category is updated",
+			change: func(t *testing.T, dir string) {
+				writeFixture(t, dir, ManifestFile, strings.Replace(testManifest, "name: Sales", "name: Sales and orders", 1))
+			},
+			wantItems: []seedItem{
+				{Kind: "category", Key: "sales", Action: "updated"},
+				{Kind: "schema", Key: "orders", Action: "unchanged", Detail: "version 1.0.0"},
+				{Kind: "tenant", Key: "acme", Action: "unchanged"},
+				{Kind: "user", Key: "acme/ada@acme.test", Action: "unchanged"},
+				{Kind: "entities", Key: "acme/orders", Action: "unchanged", Detail: "2 document(s)"},
+			},
+			wantWrites: []string{"PATCH /schema-categories/{id}", "POST /admin/users"},
+		},
+		{
+			name: "a changed definition creates a version",
+			change: func(t *testing.T, dir string) {
+				writeFixture(t, dir, "orders.json", `{"type": "object", "properties": {"total": {"type": "number"}, "note": {"type": "string"}}}`)
+			},
+			wantItems: []seedItem{
+				{Kind: "category", Key: "sales", Action: "unchanged"},
+				{Kind: "schema", Key: "orders", Action: "updated", Detail: "version 1.1.0"},
+				{Kind: "tenant", Key: "acme", Action: "unchanged"},
+				{Kind: "user", Key: "acme/ada@acme.test", Action: "unchanged"},
+				{Kind: "entities", Key: "acme/orders", Action: "unchanged", Detail: "2 document(s)"},
+			},
+			wantWrites: []string{"POST /schema-repository/schemas", "POST /admin/users"},
+		},
+		{
+			name: "only new documents are imported",
+			change: func(t *testing.T, dir string) {
+				writeFixture(t, dir, "orders.ndjson", testDocuments+`{"entityId": "o-3", "payload": {"total": 3}}`+"\n")
+			},
+			wantItems: []seedItem{
+				{Kind: "category", Key: "sales", Action: "unchanged"},
+				{Kind: "schema", Key: "orders", Action: "unchanged", Detail: "version 1.0.0"},
+				{Kind: "tenant", Key: "acme", Action: "unchanged"},
+				{Kind: "user", Key: "acme/ada@acme.test", Action: "unchanged"},
+				{Kind: "entities", Key: "acme/orders", Action: "created", Detail: "1 of 3 document(s)"},
+			},
+			wantWrites: []string{"POST /admin/users", "POST /entities/orders/documents:bulk"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := newFakeAPI(t)
+			dir := writeFixtures(t)
+			seed(t, api, dir)
+			api.takeWrites()
+
+			tt.change(t, dir)
+			require.Equal(t, tt.wantItems, seed(t, api, dir))
+
+			writes := api.takeWrites()
+			for i := range writes {
+				for id := range api.categories {
+					writes[i] = strings.ReplaceAll(writes[i], id.String(), "{id}")
+				}
+			}
+			require.Equal(t, tt.wantWrites, writes)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package seedcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+)
+
+// ManifestFile is the file of a fixtures directory that lists what to seed; the schema definitions and entity
+// files it names are relative to the directory.
+const ManifestFile = "fixtures.yaml"
+
+// Command loads a fixtures directory through the API. Every step matches what exists by slug, email or entity id
+// and only writes what is missing or changed, so running it again is a no-op.
+func Command() *cobra.Command {
+	var (
+		fixtureDir string
+		wait       time.Duration
+	)
+
+	c := &cobra.Command{
+		Use:   "seed",
+		Short: "Load categories, schema definitions, tenants, users and sample entities from a fixtures directory",
+		Long: `Load categories, schema definitions, tenants, users and sample entities from a fixtures directory through the
+API, in that order. The directory holds a ` + ManifestFile + ` manifest; see the README for its format.
+
+Seeding is idempotent: categories and schemas are matched by slug, tenants by slug, users by email and entities by
+entityId, and only what is missing or changed is written. The token must belong to a platform admin.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			manifest, err := loadManifest(fixtureDir)
+			if err != nil {
+				return err
+			}
+
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			s := &seeder{client: client, dir: fixtureDir, wait: wait, progress: output.Progress(cmd)}
+			seedErr := s.run(context.Background(), manifest)
+			if s.items == nil {
+				s.items = []seedItem{}
+			}
+			if err := output.Print(cmd, s.items, func(out io.Writer) error {
+				return printItems(out, s.items)
+			}); err != nil {
+				return err
+			}
+			return seedErr
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	c.Flags().StringVar(&fixtureDir, "fixture-dir", "", "Directory holding "+ManifestFile+" and the files it names")
+	c.Flags().DurationVar(&wait, "wait", 5*time.Minute, "How long to wait for each tenant's provisioning job")
+	_ = c.MarkFlagRequired("fixture-dir")
+	return c
+}
+
+// manifest is the content of fixtures.yaml.
+type manifest struct {
+	Categories []categoryFixture `yaml:"categories"`
+	Schemas    []schemaFixture   `yaml:"schemas"`
+	Tenants    []tenantFixture   `yaml:"tenants"`
+	Entities   []entityFixture   `yaml:"entities"`
+}
+
+type categoryFixture struct {
+	Slug        string  `yaml:"slug"`
+	Name        string  `yaml:"name"`
+	Description *string `yaml:"description"`
+	// Parent is the slug of the parent category, seeded earlier or already present.
+	Parent string `yaml:"parent"`
+}
+
+type schemaFixture struct {
+	Slug      string `yaml:"slug"`
+	TableName string `yaml:"tableName"`
+	// Category is a category slug.
+	Category string `yaml:"category"`
+	// Definition is the path of the JSON schema definition.
+	Definition string `yaml:"definition"`
+}
+
+type tenantFixture struct {
+	Slug  string        `yaml:"slug"`
+	Name  string        `yaml:"name"`
+	Users []userFixture `yaml:"users"`
+}
+
+type userFixture struct {
+	Email    string `yaml:"email"`
+	FullName string `yaml:"fullName"`
+}
+
+type entityFixture struct {
+	// Tenant is the slug of the tenant the documents go to, e.g. one of the seeded tenants or admin.
+	Tenant string `yaml:"tenant"`
+	Table  string `yaml:"table"`
+	// File is an NDJSON file with an entityId and a payload on every line, as entities export writes them.
+	File string `yaml:"file"`
+}
+
+func loadManifest(dir string) (manifest, error) {
+	path := filepath.Join(dir, ManifestFile)
+	file, err := os.Open(path)
+	if err != nil {
+		return manifest{}, fmt.Errorf("open fixtures manifest: %w", err)
+	}
+	defer file.Close()
+
+	var m manifest
+	dec := yaml.NewDecoder(file)
+	dec.KnownFields(true)
+	if err := dec.Decode(&m); err != nil && !errors.Is(err, io.EOF) {
+		return manifest{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := m.validate(); err != nil {
+		return manifest{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+func (m manifest) validate() error {
+	var problems []string
+	require := func(section string, i int, field, value string) {
+		if strings.TrimSpace(value) == "" {
+			problems = append(problems, fmt.Sprintf("%s[%d]: %s is required", section, i, field))
+		}
+	}
+	for i, c := range m.Categories {
+		require("categories", i, "slug", c.Slug)
+		require("categories", i, "name", c.Name)
+	}
+	for i, s := range m.Schemas {
+		require("schemas", i, "slug", s.Slug)
+		require("schemas", i, "tableName", s.TableName)
+		require("schemas", i, "category", s.Category)
+		require("schemas", i, "definition", s.Definition)
+	}
+	for i, t := range m.Tenants {
+		require("tenants", i, "slug", t.Slug)
+		for j, u := range t.Users {
+			require(fmt.Sprintf("tenants[%d].users", i), j, "email", u.Email)
+			require(fmt.Sprintf("tenants[%d].users", i), j, "fullName", u.FullName)
+		}
+	}
+	for i, e := range m.Entities {
+		require("entities", i, "tenant", e.Tenant)
+		require("entities", i, "table", e.Table)
+		require("entities", i, "file", e.File)
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// seedItem records what seed did with one fixture: created, updated, unchanged or provisioned.
+type seedItem struct {
+	Kind   string `json:"kind"`
+	Key    string `json:"key"`
+	Action string `json:"action"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func printItems(out io.Writer, items []seedItem) error {
+	if len(items) == 0 {
+		fmt.Fprintln(out, "Nothing to seed.")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tKEY\tACTION\tDETAIL")
+	for _, item := range items {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", item.Kind, item.Key, item.Action, item.Detail)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,211 @@
+package tenantcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/provisioning"
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// archiveResult is what tenant export and import print with --output json|yaml.
+type archiveResult struct {
+	TenantSlug           string     `json:"tenantSlug"`
+	Archive              string     `json:"archive"`
+	DownloadURL          string     `json:"downloadUrl,omitempty"`
+	DownloadURLExpiresAt *time.Time `json:"downloadUrlExpiresAt,omitempty"`
+}
+
+func exportCommand() *cobra.Command {
+	var urlTTL time.Duration
+
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "Export a tenant space (entities, users, settings) to an archive under its storage prefix",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+			session, err := newArchiveService(ctx, cmd)
+			if err != nil {
+				return err
+			}
+			defer session.cleanup()
+			if urlTTL > 0 && session.signer == nil {
+				return fmt.Errorf("storage-local-signing-key is required for --url-ttl when storage-backend=local")
+			}
+
+			location, err := session.svc.Export(ctx, session.tenant.ID)
+			if err != nil {
+				return err
+			}
+			result := archiveResult{TenantSlug: session.tenant.Slug, Archive: location}
+
+			if urlTTL > 0 {
+				downloadURL, expires, err := storage.SignURL(ctx, session.signer, http.MethodGet, location, urlTTL)
+				if err != nil {
+					return fmt.Errorf("sign download url: %w", err)
+				}
+				expires = expires.UTC()
+				result.DownloadURL, result.DownloadURLExpiresAt = downloadURL, &expires
+			}
+			return output.Print(cmd, result, func(out io.Writer) error {
+				fmt.Fprintf(out, "Tenant %s exported to %s\n", result.TenantSlug, result.Archive)
+				if result.DownloadURL != "" {
+					fmt.Fprintf(out, "Download URL (valid until %s): %s\n", result.DownloadURLExpiresAt.Format(time.RFC3339), result.DownloadURL)
+				}
+				return nil
+			})
+		},
+	}
+	addArchiveFlags(c)
+	c.Flags().DurationVar(&urlTTL, "url-ttl", 0, "When set, also print a signed download URL for the archive valid this long (max 168h)")
+	c.Flags().String("storage-local-url", "http://localhost:3000/storage/local", "Public URL of the API's local storage handler, used to sign URLs when storage-backend=local")
+	c.Flags().String("storage-local-signing-key", "", "The API's STORAGE_LOCAL_SIGNING_KEY, used to sign URLs when storage-backend=local")
+	return c
+}
+
+func importCommand() *cobra.Command {
+	var archive string
+
+	c := &cobra.Command{
+		Use:   "import",
+		Short: "Restore a tenant archive into a freshly provisioned tenant",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+			session, err := newArchiveService(ctx, cmd)
+			if err != nil {
+				return err
+			}
+			defer session.cleanup()
+
+			if _, err := session.svc.Import(ctx, session.tenant.ID, archive); err != nil {
+				return err
+			}
+			return output.Print(cmd, archiveResult{TenantSlug: session.tenant.Slug, Archive: archive}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Archive %s imported into tenant %s\n", archive, session.tenant.Slug)
+				return nil
+			})
+		},
+	}
+	addArchiveFlags(c)
+	c.Flags().StringVar(&archive, "archive", "", "Full storage path of the archive, as printed by tenant export")
+	_ = c.MarkFlagRequired("archive")
+	return c
+}
+
+func addArchiveFlags(c *cobra.Command) {
+	c.Flags().String("database-url", "", "PostgreSQL connection string")
+	c.Flags().String("env-key", "dev", "Environment key prefix (e.g. dev, stg, prod)")
+	c.Flags().String("tenant-slug", "", "Slug of the tenant to export from or import into")
+	c.Flags().String("storage-backend", "gcs", "Storage backend holding tenant prefixes (gcs or local)")
+	c.Flags().String("storage-bucket", "", "Bucket name, required when storage-backend=gcs")
+	c.Flags().String("storage-local-dir", "./.data/storage", "Base directory, used when storage-backend=local")
+
+	_ = c.MarkFlagRequired("database-url")
+	_ = c.MarkFlagRequired("tenant-slug")
+}
+
+// archiveSession is the tenant service, tenant and storage wired by newArchiveService.
+type archiveSession struct {
+	svc    *service.Service
+	tenant service.Tenant
+	// signer is nil for the local backend unless a signing key was given.
+	signer  storage.SignedStore
+	cleanup func()
+}
+
+// newArchiveService wires a tenant service with a storage archiver and resolves the tenant named by the flags.
+func newArchiveService(ctx context.Context, cmd *cobra.Command) (archiveSession, error) {
+	flags := cmd.Flags()
+	databaseURL, _ := flags.GetString("database-url")
+	envKey, _ := flags.GetString("env-key")
+	tenantSlug, _ := flags.GetString("tenant-slug")
+	backend, _ := flags.GetString("storage-backend")
+	bucket, _ := flags.GetString("storage-bucket")
+	localDir, _ := flags.GetString("storage-local-dir")
+
+	var (
+		objects storage.ObjectStore
+		signer  storage.SignedStore
+		cleanup = func() {}
+	)
+	switch backend {
+	case "gcs":
+		if strings.TrimSpace(bucket) == "" {
+			return archiveSession{}, fmt.Errorf("storage-bucket is required when storage-backend=gcs")
+		}
+		client, err := gcs.NewClient(ctx)
+		if err != nil {
+			return archiveSession{}, fmt.Errorf("init gcs client: %w", err)
+		}
+		gcsObjects := storage.NewGCSObjectStore(client, bucket)
+		objects, signer = gcsObjects, gcsObjects
+		cleanup = func() { _ = client.Close() }
+	case "local":
+		if strings.TrimSpace(localDir) == "" {
+			return archiveSession{}, fmt.Errorf("storage-local-dir is required when storage-backend=local")
+		}
+		localObjects := storage.NewLocalObjectStore(localDir)
+		objects = localObjects
+		// Only export defines the signing flags.
+		localURL, _ := flags.GetString("storage-local-url")
+		signingKey, _ := flags.GetString("storage-local-signing-key")
+		if signingKey != "" && strings.TrimSpace(localURL) != "" {
+			signer = storage.NewLocalSignedStore(localObjects, localURL, []byte(signingKey), 0)
+		}
+	default:
+		return archiveSession{}, fmt.Errorf("invalid storage-backend %q (use gcs or local)", backend)
+	}
+
+	pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: databaseURL})
+	if err != nil {
+		cleanup()
+		return archiveSession{}, fmt.Errorf("init pool: %w", err)
+	}
+	closeStorage := cleanup
+	cleanup = func() {
+		persistence.ClosePool(pool)
+		closeStorage()
+	}
+
+	adminSchema := tenant.BuildSchemaName(envKey, tenant.ToSnake("admin"))
+	tenantStore, err := persistence.NewTenantStore(ctx, pool, adminSchema)
+	if err != nil {
+		cleanup()
+		return archiveSession{}, fmt.Errorf("init tenant store: %w", err)
+	}
+	tenantRepo := repo.NewPostgresRepository(tenantStore)
+
+	svc := service.New(
+		tenantRepo,
+		envKey,
+		service.ProvisioningDeps{
+			DB:      provisioning.NewDBProvisioner(pool, adminSchema),
+			Auth:    readyAuthProvisioner{},
+			Storage: readyStorageProvisioner{},
+		},
+	)
+	spaceDB := persistence.NewSpaceDB(persistence.SpaceDBConfig{
+		Pool:        pool,
+		AdminSchema: adminSchema,
+	})
+	svc.UseArchiver(provisioning.NewStorageArchiver(persistence.NewTenantArchiveStore(spaceDB), objects))
+
+	t, err := tenantRepo.FindBySlug(ctx, tenantSlug)
+	if err != nil {
+		cleanup()
+		return archiveSession{}, fmt.Errorf("find tenant %s: %w", tenantSlug, err)
+	}
+	return archiveSession{svc: svc, tenant: t, signer: signer, cleanup: cleanup}, nil
+}
@@ -0,0 +1,162 @@
+package tenantcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	usersapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+// jobPollInterval is how often createViaAPI asks for the provisioning job.
+const jobPollInterval = 2 * time.Second
+
+type apiCreateRequest struct {
+	Slug       string
+	Name       string
+	AdminEmail string
+	AdminName  string
+	// Wait bounds the wait for the provisioning job.
+	Wait time.Duration
+}
+
+// createViaAPI mirrors the --direct create through the tenants and users APIs: it creates the tenant (or reuses
+// the one with the slug), provisions it, waits for the provisioning job, and adds the tenant admin user while
+// impersonating the tenant. Progress goes to out.
+func createViaAPI(ctx context.Context, out io.Writer, client *apiclient.Client, req apiCreateRequest) (createResult, error) {
+	t, err := createOrFindTenant(ctx, client, req.Slug, req.Name)
+	if err != nil {
+		return createResult{}, err
+	}
+
+	provision, err := client.Tenants.TenantsProvisionWithResponse(ctx, t.TenantId)
+	if err != nil {
+		return createResult{}, fmt.Errorf("provision tenant: %w", err)
+	}
+	if err := apiclient.Check(provision.StatusCode(), provision.Body); err != nil {
+		return createResult{}, fmt.Errorf("provision tenant: %w", err)
+	}
+	fmt.Fprintf(out, "Provisioning tenant %s (job %s)\n", t.Slug, provision.JSON202.JobId)
+
+	job, err := waitForJob(ctx, client, *provision.JSON202, req.Wait, nil)
+	if err != nil {
+		return createResult{}, err
+	}
+	if job.Status != tenantsapi.ProvisioningJobStatusSucceeded {
+		lastError := ""
+		if job.LastError != nil {
+			lastError = ": " + *job.LastError
+		}
+		return createResult{}, fmt.Errorf("provisioning job %s %s%s", job.JobId, job.Status, lastError)
+	}
+
+	created, err := client.Users.UsersCreateWithResponse(ctx, usersapi.CreateUser{
+		Email:    primitives.Email(strings.TrimSpace(req.AdminEmail)),
+		FullName: strings.TrimSpace(req.AdminName),
+	}, apiclient.Impersonate(t.TenantId.String()))
+	if err != nil {
+		return createResult{}, fmt.Errorf("create tenant admin user: %w", err)
+	}
+	switch err := apiclient.Check(created.StatusCode(), created.Body); {
+	case apiclient.StatusOf(err) == http.StatusConflict:
+		fmt.Fprintf(out, "Tenant admin user %s already exists\n", req.AdminEmail)
+	case err != nil:
+		return createResult{}, fmt.Errorf("create tenant admin user: %w", err)
+	}
+
+	result := createResult{TenantID: t.TenantId, Slug: t.Slug, AdminEmail: strings.TrimSpace(req.AdminEmail)}
+	if t.SchemaName != nil {
+		result.SchemaName = *t.SchemaName
+	}
+	return result, nil
+}
+
+// createOrFindTenant creates the tenant, or returns the existing one when the slug is taken, like the --direct
+// create does.
+func createOrFindTenant(ctx context.Context, client *apiclient.Client, slug, name string) (tenantsapi.Tenant, error) {
+	rsp, err := client.Tenants.TenantsCreateWithResponse(ctx, tenantsapi.CreateTenant{
+		Slug:        slug,
+		DisplayName: strPtrOrNil(name),
+	})
+	if err != nil {
+		return tenantsapi.Tenant{}, fmt.Errorf("create tenant: %w", err)
+	}
+	err = apiclient.Check(rsp.StatusCode(), rsp.Body)
+	if err == nil {
+		return *rsp.JSON201, nil
+	}
+	if apiclient.StatusOf(err) != http.StatusConflict {
+		return tenantsapi.Tenant{}, fmt.Errorf("create tenant: %w", err)
+	}
+
+	t, found, err := findTenantBySlug(ctx, client, slug)
+	if err != nil {
+		return tenantsapi.Tenant{}, fmt.Errorf("tenant exists but could not fetch: %w", err)
+	}
+	if !found {
+		return tenantsapi.Tenant{}, fmt.Errorf("tenant %s exists but is not listed", slug)
+	}
+	return t, nil
+}
+
+// findTenantBySlug searches the tenants list for the tenant with slug.
+func findTenantBySlug(ctx context.Context, client *apiclient.Client, slug string) (tenantsapi.Tenant, bool, error) {
+	for t, err := range apiclient.Items(ctx, func(ctx context.Context, page int) ([]tenantsapi.Tenant, int, error) {
+		list, err := client.Tenants.TenantsListWithResponse(ctx, &tenantsapi.TenantsListParams{Page: &page, Q: &slug})
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := apiclient.Check(list.StatusCode(), list.Body); err != nil {
+			return nil, 0, err
+		}
+		return list.JSON200.Items, list.JSON200.TotalPages, nil
+	}) {
+		if err != nil {
+			return tenantsapi.Tenant{}, false, err
+		}
+		if t.Slug == slug {
+			return t, true, nil
+		}
+	}
+	return tenantsapi.Tenant{}, false, nil
+}
+
+// waitForJob polls the provisioning job until it succeeds or fails, for at most wait. onChange, when set, sees
+// every poll answer whose status or attempt count changed.
+func waitForJob(ctx context.Context, client *apiclient.Client, job tenantsapi.ProvisioningJob, wait time.Duration, onChange func(tenantsapi.ProvisioningJob)) (tenantsapi.ProvisioningJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+	for {
+		switch job.Status {
+		case tenantsapi.ProvisioningJobStatusSucceeded, tenantsapi.ProvisioningJobStatusFailed:
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, fmt.Errorf("provisioning job %s still %s after %s; check it with the tenants API", job.JobId, job.Status, wait)
+		case <-ticker.C:
+		}
+
+		rsp, err := client.Tenants.TenantsGetJobWithResponse(ctx, job.TenantId, job.JobId)
+		if err != nil {
+			return job, fmt.Errorf("get provisioning job: %w", err)
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return job, fmt.Errorf("get provisioning job: %w", err)
+		}
+		if onChange != nil && (rsp.JSON200.Status != job.Status || rsp.JSON200.Attempts != job.Attempts) {
+			onChange(*rsp.JSON200)
+		}
+		job = *rsp.JSON200
+	}
+}
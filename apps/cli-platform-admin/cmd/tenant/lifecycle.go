@@ -0,0 +1,620 @@
+package tenantcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+// The lifecycle commands below work through the tenants admin API only; the token must belong to a platform admin.
+
+func listCommand() *cobra.Command {
+	var (
+		status string
+		query  string
+	)
+
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List tenants",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			tenants, err := apiclient.All(ctx, func(ctx context.Context, page int) ([]tenantsapi.Tenant, int, error) {
+				params := &tenantsapi.TenantsListParams{Page: &page}
+				if strings.TrimSpace(status) != "" {
+					tenantStatus := tenantsapi.TenantStatus(strings.TrimSpace(status))
+					params.Status = &tenantStatus
+				}
+				if strings.TrimSpace(query) != "" {
+					params.Q = &query
+				}
+				rsp, err := client.Tenants.TenantsListWithResponse(ctx, params)
+				if err != nil {
+					return nil, 0, err
+				}
+				if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+					return nil, 0, err
+				}
+				return rsp.JSON200.Items, rsp.JSON200.TotalPages, nil
+			})
+			if err != nil {
+				return fmt.Errorf("list tenants: %w", err)
+			}
+			if tenants == nil {
+				tenants = []tenantsapi.Tenant{}
+			}
+
+			return output.Print(cmd, tenants, func(out io.Writer) error {
+				if len(tenants) == 0 {
+					fmt.Fprintln(out, "No tenants found.")
+					return nil
+				}
+
+				tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "ID\tSLUG\tDISPLAY_NAME\tSTATUS\tDB\tAUTH\tSTORAGE\tCREATED_AT")
+				for _, t := range tenants {
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+						t.TenantId,
+						t.Slug,
+						deref(t.DisplayName),
+						t.Status,
+						readyFlag(t.Provisioning.DbReady),
+						readyFlag(t.Provisioning.AuthReady),
+						readyFlag(t.Provisioning.StorageReady),
+						t.CreatedAt.UTC().Format(time.RFC3339),
+					)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	c.Flags().StringVar(&status, "status", "", "Only list tenants in this status (pending, provisioning, active, maintenance, suspended, disabled)")
+	c.Flags().StringVar(&query, "query", "", "Only list tenants whose slug or display name matches")
+	return c
+}
+
+func getCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "get",
+		Short: "Show a tenant by ID or slug",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			t, err := resolveTenant(context.Background(), cmd, client)
+			if err != nil {
+				return err
+			}
+			return output.Print(cmd, t, func(out io.Writer) error {
+				printTenant(out, t)
+				return nil
+			})
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	addTenantRefFlags(c)
+	return c
+}
+
+func updateCommand() *cobra.Command {
+	var (
+		displayName     string
+		status          string
+		corsOrigins     []string
+		maxUsers        int64
+		maxEntities     int64
+		maxSchemas      int64
+		maxStorageBytes int64
+		maxObjectBytes  int64
+		fromAddress     string
+		fromName        string
+		notifyAddress   string
+	)
+
+	c := &cobra.Command{
+		Use:   "update",
+		Short: "Update a tenant's display name, status, CORS origins, quotas or email settings",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			t, err := resolveTenant(ctx, cmd, client)
+			if err != nil {
+				return err
+			}
+
+			changed := cmd.Flags().Changed
+			var body tenantsapi.UpdateTenant
+			if changed("display-name") {
+				body.DisplayName = &displayName
+			}
+			if changed("status") {
+				tenantStatus := tenantsapi.TenantStatus(strings.TrimSpace(status))
+				body.Status = &tenantStatus
+			}
+			if changed("cors-origins") {
+				origins := tenantsapi.CORSOrigins(corsOrigins)
+				if origins == nil {
+					origins = tenantsapi.CORSOrigins{}
+				}
+				body.CorsOrigins = &origins
+			}
+
+			// The API replaces all quotas and all email settings at once, so the flags not passed keep their values.
+			quotas, quotasChanged := t.Quotas, false
+			for _, limit := range []struct {
+				flag  string
+				value int64
+				dst   **int64
+			}{
+				{"max-users", maxUsers, &quotas.MaxUsers},
+				{"max-entities", maxEntities, &quotas.MaxEntities},
+				{"max-schemas", maxSchemas, &quotas.MaxSchemas},
+				{"max-storage-bytes", maxStorageBytes, &quotas.MaxStorageBytes},
+				{"max-object-bytes", maxObjectBytes, &quotas.MaxObjectBytes},
+			} {
+				if !changed(limit.flag) {
+					continue
+				}
+				quotasChanged = true
+				*limit.dst = nil
+				if limit.value > 0 {
+					value := limit.value
+					*limit.dst = &value
+				}
+			}
+			if quotasChanged {
+				body.Quotas = &quotas
+			}
+
+			email, emailChanged := t.Email, false
+			for _, setting := range []struct {
+				flag  string
+				value string
+				dst   **string
+			}{
+				{"email-from-address", fromAddress, &email.FromAddress},
+				{"email-from-name", fromName, &email.FromName},
+				{"email-notify-address", notifyAddress, &email.NotifyAddress},
+			} {
+				if !changed(setting.flag) {
+					continue
+				}
+				emailChanged = true
+				*setting.dst = strPtrOrNil(setting.value)
+			}
+			if emailChanged {
+				body.Email = &email
+			}
+
+			if body == (tenantsapi.UpdateTenant{}) {
+				return errors.New("nothing to update: pass at least one of the update flags")
+			}
+
+			rsp, err := client.Tenants.TenantsUpdateWithResponse(ctx, t.TenantId, body)
+			if err != nil {
+				return fmt.Errorf("update tenant: %w", err)
+			}
+			if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+				return fmt.Errorf("update tenant: %w", err)
+			}
+
+			updated := *rsp.JSON200
+			return output.Print(cmd, updated, func(out io.Writer) error {
+				fmt.Fprintf(out, "Updated tenant %s (%s)\n", updated.Slug, updated.TenantId)
+				printTenant(out, updated)
+				return nil
+			})
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	addTenantRefFlags(c)
+	c.Flags().StringVar(&displayName, "display-name", "", "Display name; empty clears it")
+	c.Flags().StringVar(&status, "status", "", "Status (pending, provisioning, active, maintenance, suspended, disabled)")
+	c.Flags().StringSliceVar(&corsOrigins, "cors-origins", nil, "Browser origins allowed on top of the deployment's CORS list (comma-separated; empty removes them)")
+	c.Flags().Int64Var(&maxUsers, "max-users", 0, "User quota; 0 removes the limit")
+	c.Flags().Int64Var(&maxEntities, "max-entities", 0, "Entity quota; 0 removes the limit")
+	c.Flags().Int64Var(&maxSchemas, "max-schemas", 0, "Schema quota; 0 removes the limit")
+	c.Flags().Int64Var(&maxStorageBytes, "max-storage-bytes", 0, "Database storage quota in bytes; 0 removes the limit")
+	c.Flags().Int64Var(&maxObjectBytes, "max-object-bytes", 0, "Object storage quota in bytes; 0 removes the limit")
+	c.Flags().StringVar(&fromAddress, "email-from-address", "", "Sender address of the tenant's emails; empty falls back to the platform sender")
+	c.Flags().StringVar(&fromName, "email-from-name", "", "Sender name of the tenant's emails")
+	c.Flags().StringVar(&notifyAddress, "email-notify-address", "", "Recipient of quota and provisioning notifications")
+	return c
+}
+
+func provisionCommand() *cobra.Command {
+	var (
+		watch bool
+		wait  time.Duration
+	)
+
+	c := &cobra.Command{
+		Use:   "provision",
+		Short: "Start provisioning a tenant (database, auth, storage); --watch follows the job until it ends",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			t, err := resolveTenant(ctx, cmd, client)
+			if err != nil {
+				return err
+			}
+
+			rsp, err := client.Tenants.TenantsProvisionWithResponse(ctx, t.TenantId)
+			if err != nil {
+				return fmt.Errorf("provision tenant: %w", err)
+			}
+			if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+				return fmt.Errorf("provision tenant: %w", err)
+			}
+			job := *rsp.JSON202
+
+			if watch {
+				progress := output.Progress(cmd)
+				fmt.Fprintf(progress, "Provisioning tenant %s (job %s): %s\n", t.Slug, job.JobId, job.Status)
+				job, err = waitForJob(ctx, client, job, wait, func(job tenantsapi.ProvisioningJob) {
+					printJobProgress(progress, job)
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := output.Print(cmd, job, func(out io.Writer) error {
+				if !watch {
+					fmt.Fprintf(out, "Provisioning tenant %s: job %s %s\n", t.Slug, job.JobId, job.Status)
+					fmt.Fprintln(out, "Follow it with: tenants provision-status --watch --tenant-slug "+string(t.Slug))
+					return nil
+				}
+				fmt.Fprintf(out, "Provisioning job %s %s after %d attempt(s)\n", job.JobId, job.Status, job.Attempts)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if job.Status == tenantsapi.ProvisioningJobStatusFailed {
+				return fmt.Errorf("provisioning job %s failed: %s", job.JobId, deref(job.LastError))
+			}
+			return nil
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	addTenantRefFlags(c)
+	c.Flags().BoolVar(&watch, "watch", false, "Poll the provisioning job until it succeeds or fails")
+	c.Flags().DurationVar(&wait, "wait", 5*time.Minute, "How long --watch waits for the job")
+	return c
+}
+
+func provisionStatusCommand() *cobra.Command {
+	var (
+		watch bool
+		wait  time.Duration
+	)
+
+	c := &cobra.Command{
+		Use:   "provision-status",
+		Short: "Check a tenant's database, auth and storage against the backing systems; --watch polls until all are ready",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			t, err := resolveTenant(ctx, cmd, client)
+			if err != nil {
+				return err
+			}
+
+			check := func(ctx context.Context) (tenantsapi.TenantProvisioningStatus, error) {
+				rsp, err := client.Tenants.TenantsProvisionStatusWithResponse(ctx, t.TenantId)
+				if err != nil {
+					return tenantsapi.TenantProvisioningStatus{}, fmt.Errorf("check provisioning status: %w", err)
+				}
+				if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+					return tenantsapi.TenantProvisioningStatus{}, fmt.Errorf("check provisioning status: %w", err)
+				}
+				return *rsp.JSON200, nil
+			}
+
+			status, err := check(ctx)
+			if err != nil {
+				return err
+			}
+			if watch {
+				status, err = watchProvisioning(ctx, output.Progress(cmd), t, status, wait, check)
+				if err != nil {
+					return err
+				}
+			}
+
+			return output.Print(cmd, status, func(out io.Writer) error {
+				fmt.Fprintf(out, "Tenant %s (%s)\n", t.Slug, t.TenantId)
+				printProvisioning(out, status)
+				return nil
+			})
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	addTenantRefFlags(c)
+	c.Flags().BoolVar(&watch, "watch", false, "Poll until the database, auth and storage are all ready")
+	c.Flags().DurationVar(&wait, "wait", 5*time.Minute, "How long --watch waits")
+	return c
+}
+
+func deprovisionCommand() *cobra.Command {
+	var (
+		confirmSlug string
+		export      bool
+		dryRun      bool
+	)
+
+	c := &cobra.Command{
+		Use:   "deprovision",
+		Short: "Tear down a tenant's environment: disable it, then remove its auth tenant, storage, schema and role",
+		Long: `Tear down a tenant's environment: disable it, then remove its auth tenant, storage, schema and role.
+
+With --dry-run nothing is disabled, exported or removed; the command reports the tables and rows, storage objects
+and auth tenant the teardown would remove.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			t, err := resolveTenant(ctx, cmd, client)
+			if err != nil {
+				return err
+			}
+			// The API checks it too; failing here avoids a round trip on a typo.
+			if strings.TrimSpace(confirmSlug) != string(t.Slug) {
+				return fmt.Errorf("--confirm-slug %q does not match tenant slug %q", confirmSlug, t.Slug)
+			}
+
+			rsp, err := client.Tenants.TenantsDeprovisionWithResponse(ctx, t.TenantId, tenantsapi.DeprovisionTenant{
+				ConfirmSlug: t.Slug,
+				Export:      &export,
+				DryRun:      &dryRun,
+			})
+			if err != nil {
+				return fmt.Errorf("deprovision tenant: %w", err)
+			}
+			if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+				return fmt.Errorf("deprovision tenant: %w", err)
+			}
+
+			result := *rsp.JSON200
+			if result.DryRun {
+				return output.Print(cmd, result, func(out io.Writer) error {
+					fmt.Fprintf(out, "Deprovisioning tenant %s (%s) would remove:\n", result.Tenant.Slug, result.Tenant.TenantId)
+					printTeardown(out, result.Teardown)
+					fmt.Fprintln(out, "Dry run: nothing was removed.")
+					return nil
+				})
+			}
+			if err := output.Print(cmd, result, func(out io.Writer) error {
+				fmt.Fprintf(out, "Deprovisioned tenant %s (%s), status %s\n", result.Tenant.Slug, result.Tenant.TenantId, result.Tenant.Status)
+				if result.ExportLocation != nil {
+					fmt.Fprintf(out, "Exported to %s\n", *result.ExportLocation)
+				}
+				printTeardown(out, result.Teardown)
+				printProvisioning(out, result.Tenant.Provisioning)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if result.Tenant.Provisioning.LastError != nil {
+				return fmt.Errorf("deprovision incomplete: %s; run it again to retry the failed steps", *result.Tenant.Provisioning.LastError)
+			}
+			return nil
+		},
+	}
+
+	apiconn.AddAPIFlags(c)
+	addTenantRefFlags(c)
+	c.Flags().StringVar(&confirmSlug, "confirm-slug", "", "Repeat the tenant slug to confirm the teardown")
+	c.Flags().BoolVar(&export, "export", false, "Export the tenant before tearing it down")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "Report what the teardown would remove without changing anything")
+	_ = c.MarkFlagRequired("confirm-slug")
+	return c
+}
+
+// addTenantRefFlags registers --tenant-id and --tenant-slug, which resolveTenant reads.
+func addTenantRefFlags(c *cobra.Command) {
+	c.Flags().String("tenant-id", "", "Tenant ID")
+	c.Flags().String("tenant-slug", "", "Tenant slug, instead of --tenant-id")
+	c.MarkFlagsMutuallyExclusive("tenant-id", "tenant-slug")
+}
+
+// resolveTenant fetches the tenant named by --tenant-id or --tenant-slug.
+func resolveTenant(ctx context.Context, cmd *cobra.Command, client *apiclient.Client) (tenantsapi.Tenant, error) {
+	idInput, _ := cmd.Flags().GetString("tenant-id")
+	slug, _ := cmd.Flags().GetString("tenant-slug")
+
+	switch {
+	case strings.TrimSpace(idInput) != "":
+		tenantID, err := uuid.Parse(strings.TrimSpace(idInput))
+		if err != nil {
+			return tenantsapi.Tenant{}, fmt.Errorf("invalid tenant id: %w", err)
+		}
+		rsp, err := client.Tenants.TenantsGetWithResponse(ctx, tenantID)
+		if err != nil {
+			return tenantsapi.Tenant{}, fmt.Errorf("get tenant: %w", err)
+		}
+		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return tenantsapi.Tenant{}, fmt.Errorf("get tenant: %w", err)
+		}
+		return *rsp.JSON200, nil
+	case strings.TrimSpace(slug) != "":
+		t, found, err := findTenantBySlug(ctx, client, strings.TrimSpace(slug))
+		if err != nil {
+			return tenantsapi.Tenant{}, fmt.Errorf("find tenant: %w", err)
+		}
+		if !found {
+			return tenantsapi.Tenant{}, fmt.Errorf("tenant %s not found", slug)
+		}
+		return t, nil
+	default:
+		return tenantsapi.Tenant{}, errors.New("pass --tenant-id or --tenant-slug")
+	}
+}
+
+// watchProvisioning polls check until the database, auth and storage are all ready, for at most wait, printing
+// each change to out.
+func watchProvisioning(ctx context.Context, out io.Writer, t tenantsapi.Tenant, status tenantsapi.TenantProvisioningStatus, wait time.Duration, check func(context.Context) (tenantsapi.TenantProvisioningStatus, error)) (tenantsapi.TenantProvisioningStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+	last := provisioningLine(status)
+	fmt.Fprintf(out, "%s: %s\n", t.Slug, last)
+	for !allReady(status) {
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("tenant %s not ready after %s: %s", t.Slug, wait, last)
+		case <-ticker.C:
+		}
+
+		next, err := check(ctx)
+		if err != nil {
+			return status, err
+		}
+		status = next
+		if line := provisioningLine(status); line != last {
+			last = line
+			fmt.Fprintf(out, "%s: %s\n", t.Slug, line)
+		}
+	}
+	return status, nil
+}
+
+func allReady(status tenantsapi.TenantProvisioningStatus) bool {
+	return isTrue(status.DbReady) && isTrue(status.AuthReady) && isTrue(status.StorageReady)
+}
+
+func provisioningLine(status tenantsapi.TenantProvisioningStatus) string {
+	line := fmt.Sprintf("db %s, auth %s, storage %s", readyFlag(status.DbReady), readyFlag(status.AuthReady), readyFlag(status.StorageReady))
+	if status.LastError != nil {
+		line += " (last error: " + *status.LastError + ")"
+	}
+	return line
+}
+
+func printJobProgress(out io.Writer, job tenantsapi.ProvisioningJob) {
+	line := fmt.Sprintf("job %s: %s, attempt %d", job.JobId, job.Status, job.Attempts)
+	if job.LastError != nil {
+		line += " (last error: " + *job.LastError + ")"
+	}
+	fmt.Fprintln(out, line)
+}
+
+func printTenant(out io.Writer, t tenantsapi.Tenant) {
+	fmt.Fprintf(out, "ID: %s\nSlug: %s\nDisplayName: %s\nStatus: %s\nSchema: %s\nCreatedAt: %s\n",
+		t.TenantId, t.Slug, deref(t.DisplayName), t.Status, deref(t.SchemaName), t.CreatedAt.UTC().Format(time.RFC3339))
+	printProvisioning(out, t.Provisioning)
+	fmt.Fprintf(out, "CORSOrigins: %s\n", strings.Join(t.CorsOrigins, ", "))
+	fmt.Fprintf(out, "Quotas: users %s, entities %s, schemas %s, storage bytes %s, object bytes %s\n",
+		limit(t.Quotas.MaxUsers), limit(t.Quotas.MaxEntities), limit(t.Quotas.MaxSchemas), limit(t.Quotas.MaxStorageBytes), limit(t.Quotas.MaxObjectBytes))
+	fmt.Fprintf(out, "Email: from %s <%s>, notify %s\n", deref(t.Email.FromName), deref(t.Email.FromAddress), deref(t.Email.NotifyAddress))
+}
+
+// printTeardown lists what each teardown step removed, or would remove on a dry run, with the error of failed steps.
+func printTeardown(out io.Writer, teardown tenantsapi.TenantTeardown) {
+	db := teardown.Database
+	fmt.Fprintf(out, "Database: schema %s (%s), role %s (%s)%s\n",
+		db.SchemaName, presence(db.SchemaExists), db.RoleName, presence(db.RoleExists), stepError(db.Error))
+	if len(db.Tables) > 0 {
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  TABLE\tROWS")
+		for _, table := range db.Tables {
+			fmt.Fprintf(tw, "  %s\t%d\n", table.Name, table.Rows)
+		}
+		_ = tw.Flush()
+	}
+	fmt.Fprintf(out, "Storage: prefix %s, %d object(s), %d bytes%s\n",
+		teardown.Storage.Prefix, teardown.Storage.Objects, teardown.Storage.Bytes, stepError(teardown.Storage.Error))
+	fmt.Fprintf(out, "Auth: tenant %s%s\n", teardown.Auth.ExternalTenant, stepError(teardown.Auth.Error))
+}
+
+func presence(exists bool) string {
+	if exists {
+		return "present"
+	}
+	return "absent"
+}
+
+func stepError(err *string) string {
+	if err == nil {
+		return ""
+	}
+	return " (error: " + *err + ")"
+}
+
+func printProvisioning(out io.Writer, status tenantsapi.TenantProvisioningStatus) {
+	fmt.Fprintf(out, "Provisioning: %s\n", provisioningLine(status))
+	if status.LastProvisionedAt != nil {
+		fmt.Fprintf(out, "LastProvisionedAt: %s\n", status.LastProvisionedAt.UTC().Format(time.RFC3339))
+	}
+}
+
+func readyFlag(ready *bool) string {
+	switch {
+	case ready == nil:
+		return "-"
+	case *ready:
+		return "ready"
+	default:
+		return "not ready"
+	}
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
+
+func limit(value *int64) string {
+	if value == nil {
+		return "unlimited"
+	}
+	return fmt.Sprint(*value)
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,386 @@
+package tenantcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+const (
+	tenantID  = "0f8e2b9c-4d1a-4c3b-8e7f-6a5b4c3d2e1f"
+	jobID     = "3c5d7e9f-1a2b-4c3d-8e4f-5a6b7c8d9e0f"
+	unknownID = "9e6f1b2a-3c4d-4e5f-8a6b-7c8d9e0f1a2b"
+)
+
+// acmeDetails is how printTenant shows the tenant tenantsServer serves.
+const acmeDetails = `ID: ` + tenantID + `
+Slug: acme
+DisplayName: Acme Corp
+Status: active
+Schema: tenant_acme
+CreatedAt: 2026-01-02T03:04:05Z
+Provisioning: db ready, auth ready, storage not ready
+CORSOrigins: https://acme.example.com
+Quotas: users 10, entities 1000, schemas unlimited, storage bytes unlimited, object bytes unlimited
+` + "Email: from Acme <>, notify \n"
+
+// acmeTeardown is how printTeardown shows the teardown tenantsServer reports.
+const acmeTeardown = `Database: schema tenant_acme (present), role tenant_acme_role (present)
+  TABLE     ROWS
+  entities  42
+  users     3
+Storage: prefix tenants/acme/, 7 object(s), 2048 bytes
+Auth: tenant acme-x1y2
+`
+
+// tenantsServer fakes the tenants API with the single tenant "acme", whose slug is taken for creates. Provisioning
+// jobs start queued and end in jobStatus, "succeeded" when empty; creating the tenant admin user conflicts when the
+// request impersonates the tenant.
+type tenantsServer struct {
+	jobStatus string
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (s *tenantsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	call := r.Method + " " + r.URL.RequestURI()
+	if len(body) > 0 {
+		call += " " + string(body)
+	}
+	s.mu.Lock()
+	s.calls = append(s.calls, call)
+	s.mu.Unlock()
+
+	tenant := map[string]any{
+		"tenantId": tenantID, "slug": "acme", "displayName": "Acme Corp", "status": "active", "schemaName": "tenant_acme",
+		"createdBy": unknownID, "createdAt": "2026-01-02T03:04:05Z", "corsOrigins": []string{"https://acme.example.com"},
+		"provisioning": map[string]any{"dbReady": true, "authReady": true, "storageReady": false},
+		"quotas":       map[string]any{"maxUsers": 10, "maxEntities": 1000},
+		"email":        map[string]any{"fromName": "Acme"},
+	}
+	job := func(status string, attempts int) map[string]any {
+		component := map[string]any{"attempts": attempts, "status": "pending"}
+		job := map[string]any{
+			"jobId": jobID, "tenantId": tenantID, "kind": "provision", "status": status, "attempts": attempts,
+			"components": map[string]any{"db": component, "auth": component, "storage": component},
+			"createdAt":  "2026-01-02T03:04:05Z", "updatedAt": "2026-01-02T03:04:05Z", "nextAttemptAt": "2026-01-02T03:04:05Z",
+		}
+		if status == "failed" {
+			job["lastError"] = "storage bucket quota exceeded"
+		}
+		return job
+	}
+	writeJSON := func(status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	writeProblem := func(status int, title string) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]any{"title": title, "status": status})
+	}
+
+	const base = "/api/v1/admin/tenants"
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == base:
+		items := []any{tenant}
+		if r.URL.Query().Get("q") == "nobody" {
+			items = []any{}
+		}
+		writeJSON(http.StatusOK, map[string]any{"items": items, "page": 1, "pageSize": 20, "totalItems": len(items), "totalPages": 1})
+	case r.Method == http.MethodPost && r.URL.Path == base:
+		writeProblem(http.StatusConflict, "Conflict")
+	case r.Method == http.MethodGet && r.URL.Path == base+"/"+tenantID:
+		writeJSON(http.StatusOK, tenant)
+	case r.Method == http.MethodPatch && r.URL.Path == base+"/"+tenantID:
+		writeJSON(http.StatusOK, tenant)
+	case r.Method == http.MethodPost && r.URL.Path == base+"/"+tenantID+":provision":
+		writeJSON(http.StatusAccepted, job("queued", 0))
+	case r.Method == http.MethodGet && r.URL.Path == base+"/"+tenantID+"/jobs/"+jobID:
+		status := s.jobStatus
+		if status == "" {
+			status = "succeeded"
+		}
+		writeJSON(http.StatusOK, job(status, 1))
+	case r.Method == http.MethodGet && r.URL.Path == base+"/"+tenantID+":provision-status":
+		writeJSON(http.StatusOK, map[string]any{
+			"dbReady": true, "authReady": true, "storageReady": true, "lastProvisionedAt": "2026-01-02T03:05:00Z",
+		})
+	case r.Method == http.MethodPost && r.URL.Path == base+"/"+tenantID+":deprovision":
+		var req struct {
+			DryRun bool `json:"dryRun"`
+			Export bool `json:"export"`
+		}
+		_ = json.Unmarshal(body, &req)
+		result := map[string]any{
+			"dryRun": req.DryRun,
+			"tenant": tenant,
+			"teardown": map[string]any{
+				"database": map[string]any{
+					"schemaName": "tenant_acme", "schemaExists": true, "roleName": "tenant_acme_role", "roleExists": true,
+					"tables": []any{map[string]any{"name": "entities", "rows": 42}, map[string]any{"name": "users", "rows": 3}},
+				},
+				"storage": map[string]any{"prefix": "tenants/acme/", "objects": 7, "bytes": 2048},
+				"auth":    map[string]any{"externalTenant": "acme-x1y2"},
+			},
+		}
+		if !req.DryRun {
+			tenant["status"] = "disabled"
+			tenant["provisioning"] = map[string]any{"dbReady": false, "authReady": false, "storageReady": false, "lastError": "auth teardown failed"}
+		}
+		if req.Export {
+			result["exportLocation"] = "gs://exports/acme.tar.gz"
+		}
+		writeJSON(http.StatusOK, result)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/users":
+		if r.Header.Get(apiclient.ImpersonateTenantHeader) != tenantID {
+			writeProblem(http.StatusForbidden, "Forbidden")
+			return
+		}
+		writeProblem(http.StatusConflict, "Conflict")
+	default:
+		writeProblem(http.StatusNotFound, "Resource not found")
+	}
+}
+
+func TestLifecycleCommands(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		args      []string
+		jobStatus string
+		wantCalls []string
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name:    "get needs a tenant",
+			args:    []string{"get"},
+			wantErr: "pass --tenant-id or --tenant-slug",
+		},
+		{
+			name:    "get takes the tenant from one flag",
+			args:    []string{"get", "--tenant-id", tenantID, "--tenant-slug", "acme"},
+			wantErr: "if any flags in the group [tenant-id tenant-slug] are set none of the others can be",
+		},
+		{
+			name:    "get rejects a malformed id",
+			args:    []string{"get", "--tenant-id", "acme"},
+			wantErr: "invalid tenant id: invalid UUID length: 4",
+		},
+		{
+			name:      "get an unknown id",
+			args:      []string{"get", "--tenant-id", unknownID},
+			wantCalls: []string{"GET /api/v1/admin/tenants/" + unknownID},
+			wantErr:   "get tenant: ",
+		},
+		{
+			name:      "get an unknown slug",
+			args:      []string{"get", "--tenant-slug", "nobody"},
+			wantCalls: []string{"GET /api/v1/admin/tenants?page=1&q=nobody"},
+			wantErr:   "tenant nobody not found",
+		},
+		{
+			name:      "get by slug",
+			args:      []string{"get", "--tenant-slug", "acme"},
+			wantCalls: []string{"GET /api/v1/admin/tenants?page=1&q=acme"},
+			wantOut:   acmeDetails,
+		},
+		{
+			name:      "list as a table",
+			args:      []string{"list", "--status", "active"},
+			wantCalls: []string{"GET /api/v1/admin/tenants?page=1&status=active"},
+			wantOut: "ID                                    SLUG  DISPLAY_NAME  STATUS  DB     AUTH   STORAGE    CREATED_AT\n" +
+				tenantID + "  acme  Acme Corp     active  ready  ready  not ready  2026-01-02T03:04:05Z\n",
+		},
+		{
+			name:      "list without matches",
+			args:      []string{"list", "--query", "nobody"},
+			wantCalls: []string{"GET /api/v1/admin/tenants?page=1&q=nobody"},
+			wantOut:   "No tenants found.\n",
+		},
+		{
+			name:      "list without matches as JSON",
+			args:      []string{"list", "--query", "nobody", "-o", "json"},
+			wantCalls: []string{"GET /api/v1/admin/tenants?page=1&q=nobody"},
+			wantOut:   "[]\n",
+		},
+		{
+			name:      "update needs a change",
+			args:      []string{"update", "--tenant-id", tenantID},
+			wantCalls: []string{"GET /api/v1/admin/tenants/" + tenantID},
+			wantErr:   "nothing to update: pass at least one of the update flags",
+		},
+		{
+			name: "update keeps the quotas not passed",
+			args: []string{"update", "--tenant-id", tenantID, "--max-entities", "500", "--max-users", "0"},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants/" + tenantID,
+				"PATCH /api/v1/admin/tenants/" + tenantID + ` {"quotas":{"maxEntities":500}}`,
+			},
+			wantOut: "Updated tenant acme (" + tenantID + ")\n" + acmeDetails,
+		},
+		{
+			name: "update with empty origins removes them",
+			args: []string{"update", "--tenant-slug", "acme", "--cors-origins", "", "--status", " suspended "},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants?page=1&q=acme",
+				"PATCH /api/v1/admin/tenants/" + tenantID + ` {"corsOrigins":[],"status":"suspended"}`,
+			},
+			wantOut: "Updated tenant acme (" + tenantID + ")\n" + acmeDetails,
+		},
+		{
+			name: "provision starts the job",
+			args: []string{"provision", "--tenant-id", tenantID},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants/" + tenantID,
+				"POST /api/v1/admin/tenants/" + tenantID + ":provision",
+			},
+			wantOut: "Provisioning tenant acme: job " + jobID + " queued\n" +
+				"Follow it with: tenants provision-status --watch --tenant-slug acme\n",
+		},
+		{
+			name:      "provision watches the job fail",
+			args:      []string{"provision", "--tenant-id", tenantID, "--watch"},
+			jobStatus: "failed",
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants/" + tenantID,
+				"POST /api/v1/admin/tenants/" + tenantID + ":provision",
+				"GET /api/v1/admin/tenants/" + tenantID + "/jobs/" + jobID,
+			},
+			wantOut: "Provisioning tenant acme (job " + jobID + "): queued\n" +
+				"job " + jobID + ": failed, attempt 1 (last error: storage bucket quota exceeded)\n" +
+				"Provisioning job " + jobID + " failed after 1 attempt(s)\n",
+			wantErr: "provisioning job " + jobID + " failed: storage bucket quota exceeded",
+		},
+		{
+			name: "provision status as JSON keeps progress off stdout",
+			args: []string{"provision-status", "--tenant-id", tenantID, "--watch", "-o", "json"},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants/" + tenantID,
+				"GET /api/v1/admin/tenants/" + tenantID + ":provision-status",
+			},
+			wantOut: `{
+  "authReady": true,
+  "dbReady": true,
+  "lastProvisionedAt": "2026-01-02T03:05:00Z",
+  "storageReady": true
+}
+`,
+		},
+		{
+			name: "provision status as YAML",
+			args: []string{"provision-status", "--tenant-slug", "acme", "-o", "yaml"},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants?page=1&q=acme",
+				"GET /api/v1/admin/tenants/" + tenantID + ":provision-status",
+			},
+			wantOut: "authReady: true\ndbReady: true\nlastProvisionedAt: \"2026-01-02T03:05:00Z\"\nstorageReady: true\n",
+		},
+		{
+			name:    "deprovision requires the confirmation",
+			args:    []string{"deprovision", "--tenant-id", tenantID},
+			wantErr: `required flag(s) "confirm-slug" not set`,
+		},
+		{
+			name:      "deprovision checks the confirmation before calling",
+			args:      []string{"deprovision", "--tenant-id", tenantID, "--confirm-slug", "globex"},
+			wantCalls: []string{"GET /api/v1/admin/tenants/" + tenantID},
+			wantErr:   `--confirm-slug "globex" does not match tenant slug "acme"`,
+		},
+		{
+			name: "deprovision dry run",
+			args: []string{"deprovision", "--tenant-slug", "acme", "--confirm-slug", "acme", "--dry-run"},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants?page=1&q=acme",
+				"POST /api/v1/admin/tenants/" + tenantID + `:deprovision {"confirmSlug":"acme","dryRun":true,"export":false}`,
+			},
+			wantOut: "Deprovisioning tenant acme (" + tenantID + ") would remove:\n" + acmeTeardown +
+				"Dry run: nothing was removed.\n",
+		},
+		{
+			name: "deprovision reports the failed steps",
+			args: []string{"deprovision", "--tenant-id", tenantID, "--confirm-slug", "acme", "--export"},
+			wantCalls: []string{
+				"GET /api/v1/admin/tenants/" + tenantID,
+				"POST /api/v1/admin/tenants/" + tenantID + `:deprovision {"confirmSlug":"acme","dryRun":false,"export":true}`,
+			},
+			wantOut: "Deprovisioned tenant acme (" + tenantID + "), status disabled\nExported to gs://exports/acme.tar.gz\n" +
+				acmeTeardown +
+				"Provisioning: db not ready, auth not ready, storage not ready (last error: auth teardown failed)\n",
+			wantErr: "deprovision incomplete: auth teardown failed; run it again to retry the failed steps",
+		},
+		{
+			name:    "create requires its flags",
+			args:    []string{"create", "--tenant-slug", "acme"},
+			wantErr: `required flag(s) "admin-email", "admin-full-name", "env-key" not set`,
+		},
+		{
+			name: "create through the API reuses the tenant and its admin",
+			args: []string{"create", "--env-key", "dev", "--tenant-slug", "acme", "--tenant-name", "Acme Corp",
+				"--admin-email", "ada@example.com", "--admin-full-name", "Ada Lovelace"},
+			wantCalls: []string{
+				`POST /api/v1/admin/tenants {"displayName":"Acme Corp","slug":"acme"}`,
+				"GET /api/v1/admin/tenants?page=1&q=acme",
+				"POST /api/v1/admin/tenants/" + tenantID + ":provision",
+				"GET /api/v1/admin/tenants/" + tenantID + "/jobs/" + jobID,
+				`POST /api/v1/admin/users {"email":"ada@example.com","fullName":"Ada Lovelace"}`,
+			},
+			wantOut: "Provisioning tenant acme (job " + jobID + ")\nTenant admin user ada@example.com already exists\n" +
+				"Tenant bootstrap complete. Tenant: acme (" + tenantID + ")\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := &tenantsServer{jobStatus: tt.jobStatus}
+			server := httptest.NewServer(api)
+			t.Cleanup(server.Close)
+
+			// An empty config file keeps the profile of the user running the tests out.
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(configPath, nil, 0o600))
+			args := append([]string{"tenant"}, tt.args...)
+			args = append(args, "--config", configPath, "--api-url", server.URL, "--token", "test-token")
+
+			root := &cobra.Command{Use: "cli-platform-admin", SilenceUsage: true, SilenceErrors: true}
+			apiconn.AddRootFlags(root)
+			output.AddFlag(root)
+			root.AddCommand(Command())
+			var out bytes.Buffer
+			root.SetOut(&out)
+			root.SetErr(&bytes.Buffer{})
+			root.SetArgs(args)
+
+			err := root.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), tt.wantErr), "got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantCalls, api.calls)
+			require.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/spf13/cobra"
 
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/provisioning"
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/repo"
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
@@ -22,22 +26,32 @@ import (
 // Command groups tenant-related helpers.
 func Command() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tenant",
-		Short: "Tenant utilities (create/provision)",
+		Use:     "tenant",
+		Aliases: []string{"tenants"},
+		Short:   "Tenant lifecycle (list, create, get, update, provision, deprovision) and export/import",
 	}
 
+	cmd.AddCommand(listCommand())
 	cmd.AddCommand(createCommand())
+	cmd.AddCommand(getCommand())
+	cmd.AddCommand(updateCommand())
+	cmd.AddCommand(provisionCommand())
+	cmd.AddCommand(provisionStatusCommand())
+	cmd.AddCommand(deprovisionCommand())
+	cmd.AddCommand(exportCommand())
+	cmd.AddCommand(importCommand())
 	return cmd
 }
 
 func createCommand() *cobra.Command {
 	var (
-		databaseURL string
-		envKey      string
-		tenantSlug  string
-		tenantName  string
-		adminEmail  string
-		adminName   string
+		envKey            string
+		tenantSlug        string
+		tenantName        string
+		adminEmail        string
+		adminName         string
+		wait              time.Duration
+		partitionEntities bool
 	)
 
 	c := &cobra.Command{
@@ -46,6 +60,29 @@ func createCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
+			if !apiconn.Direct(cmd) {
+				client, err := apiconn.Connect(cmd)
+				if err != nil {
+					return err
+				}
+				result, err := createViaAPI(ctx, output.Progress(cmd), client, apiCreateRequest{
+					Slug:       tenantSlug,
+					Name:       tenantName,
+					AdminEmail: adminEmail,
+					AdminName:  adminName,
+					Wait:       wait,
+				})
+				if err != nil {
+					return err
+				}
+				return printCreateResult(cmd, result)
+			}
+
+			databaseURL, err := apiconn.DatabaseURL(cmd)
+			if err != nil {
+				return err
+			}
+
 			pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: databaseURL})
 			if err != nil {
 				return fmt.Errorf("init pool: %w", err)
@@ -65,6 +102,7 @@ func createCommand() *cobra.Command {
 			}
 
 			dbProv := provisioning.NewDBProvisioner(pool, adminSchema)
+			dbProv.UseEntityPartitioning(persistence.EntityPartitioning{Enabled: partitionEntities})
 			authProv := readyAuthProvisioner{}
 			storageProv := readyStorageProvisioner{}
 
@@ -126,19 +164,24 @@ func createCommand() *cobra.Command {
 				return fmt.Errorf("seed tenant admin user: %w", err)
 			}
 
-			fmt.Fprintf(cmd.OutOrStdout(), "Tenant bootstrap complete. Tenant: %s (%s)\n", t.Slug, t.ID)
-			return nil
+			return printCreateResult(cmd, createResult{
+				TenantID:   t.ID,
+				Slug:       t.Slug,
+				SchemaName: t.SchemaName,
+				AdminEmail: strings.TrimSpace(adminEmail),
+			})
 		},
 	}
 
-	c.Flags().StringVar(&databaseURL, "database-url", "", "PostgreSQL connection string")
-	c.Flags().StringVar(&envKey, "env-key", "dev", "Environment key prefix (e.g. dev, stg, prod)")
+	apiconn.AddFlags(c)
+	c.Flags().StringVar(&envKey, "env-key", "dev", "Environment key prefix with --direct (e.g. dev, stg, prod)")
 	c.Flags().StringVar(&tenantSlug, "tenant-slug", "", "Slug for tenant to create/bootstrap")
 	c.Flags().StringVar(&tenantName, "tenant-name", "", "Display name for tenant")
 	c.Flags().StringVar(&adminEmail, "admin-email", "", "Tenant admin user email")
 	c.Flags().StringVar(&adminName, "admin-full-name", "", "Tenant admin user full name")
+	c.Flags().DurationVar(&wait, "wait", 5*time.Minute, "How long to wait for the provisioning job, without --direct")
+	c.Flags().BoolVar(&partitionEntities, "partition-entities", false, "Partition the tenant's entity tables by month with --direct (like ENTITY_PARTITIONING)")
 
-	_ = c.MarkFlagRequired("database-url")
 	_ = c.MarkFlagRequired("env-key")
 	_ = c.MarkFlagRequired("tenant-slug")
 	_ = c.MarkFlagRequired("admin-email")
@@ -148,6 +191,21 @@ func createCommand() *cobra.Command {
 }
 
 // seedTenantAdminUser inserts or updates an admin user inside the tenant schema.
+// createResult is the bootstrapped tenant, as tenant create prints it.
+type createResult struct {
+	TenantID   uuid.UUID `json:"tenantId"`
+	Slug       string    `json:"slug"`
+	SchemaName string    `json:"schemaName"`
+	AdminEmail string    `json:"adminEmail"`
+}
+
+func printCreateResult(cmd *cobra.Command, result createResult) error {
+	return output.Print(cmd, result, func(out io.Writer) error {
+		fmt.Fprintf(out, "Tenant bootstrap complete. Tenant: %s (%s)\n", result.Slug, result.TenantID)
+		return nil
+	})
+}
+
 func seedTenantAdminUser(ctx context.Context, spaceDB *persistence.SpaceDB, space tenant.Space, email, fullName string) error {
 	email = strings.TrimSpace(email)
 	fullName = strings.TrimSpace(fullName)
@@ -195,6 +253,10 @@ func (readyAuthProvisioner) Check(context.Context, string) (service.AuthProvisio
 	return service.AuthProvisionResult{Ready: true}, nil
 }
 
+func (readyAuthProvisioner) Teardown(context.Context, string, bool) error {
+	return nil
+}
+
 // readyStorageProvisioner is a no-op storage provisioner that reports readiness.
 type readyStorageProvisioner struct{}
 
@@ -205,3 +267,7 @@ func (readyStorageProvisioner) Ensure(context.Context, string) (service.StorageP
 func (readyStorageProvisioner) Check(context.Context, string) (service.StorageProvisionResult, error) {
 	return service.StorageProvisionResult{Ready: true}, nil
 }
+
+func (readyStorageProvisioner) Teardown(context.Context, string, bool) (service.StorageTeardownResult, error) {
+	return service.StorageTeardownResult{}, nil
+}
@@ -0,0 +1,223 @@
+package userscmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+	"github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	usersapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/apiclient"
+)
+
+// Command groups the user helpers. They only work through the API, in the tenant of the token or of --tenant.
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "Manage the users of a tenant through the API (list, create, invite, delete)",
+	}
+
+	apiconn.AddAPIFlags(cmd)
+	cmd.AddCommand(listCommand())
+	cmd.AddCommand(createCommand())
+	cmd.AddCommand(inviteCommand())
+	cmd.AddCommand(deleteCommand())
+	return cmd
+}
+
+func listCommand() *cobra.Command {
+	var email string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			users, err := apiclient.All(ctx, func(ctx context.Context, page int) ([]usersapi.User, int, error) {
+				params := &usersapi.UsersListParams{Page: &page}
+				if strings.TrimSpace(email) != "" {
+					params.Email = &email
+				}
+				rsp, err := client.Users.UsersListWithResponse(ctx, params)
+				if err != nil {
+					return nil, 0, err
+				}
+				if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+					return nil, 0, err
+				}
+				return rsp.JSON200.Items, rsp.JSON200.TotalPages, nil
+			})
+			if err != nil {
+				return fmt.Errorf("list users: %w", err)
+			}
+
+			if users == nil {
+				users = []usersapi.User{}
+			}
+			// JSON and YAML print the users as the API answers them.
+			return output.Print(cmd, users, func(out io.Writer) error {
+				if len(users) == 0 {
+					fmt.Fprintln(out, "No users found.")
+					return nil
+				}
+
+				tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				fmt.Fprintln(tw, "ID\tEMAIL\tFULL_NAME\tCREATED_AT\tLAST_LOGIN_AT")
+				for _, u := range users {
+					lastLogin := ""
+					if u.LastLoginAt != nil {
+						lastLogin = u.LastLoginAt.UTC().Format(time.RFC3339)
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", u.Id, u.Email, u.FullName, u.CreatedAt.UTC().Format(time.RFC3339), lastLogin)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Only list users whose email matches")
+	return cmd
+}
+
+func createCommand() *cobra.Command {
+	var (
+		email    string
+		fullName string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user without sending an invitation",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			rsp, err := client.Users.UsersCreateWithResponse(context.Background(), usersapi.CreateUser{
+				Email:    primitives.Email(strings.TrimSpace(email)),
+				FullName: strings.TrimSpace(fullName),
+			})
+			if err != nil {
+				return fmt.Errorf("create user: %w", err)
+			}
+			if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+				return fmt.Errorf("create user: %w", err)
+			}
+
+			return output.Print(cmd, userResult{Action: "created", User: rsp.JSON201}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Created user %s (%s)\n", rsp.JSON201.Email, rsp.JSON201.Id)
+				printUserSummary(out, *rsp.JSON201)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "User email")
+	cmd.Flags().StringVar(&fullName, "full-name", "", "User full name")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("full-name")
+	return cmd
+}
+
+func inviteCommand() *cobra.Command {
+	var (
+		email    string
+		fullName string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "invite",
+		Short: "Invite a user by email; they join once they accept",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			rsp, err := client.Users.UsersInviteWithResponse(context.Background(), usersapi.InviteUser{
+				Email:    primitives.Email(strings.TrimSpace(email)),
+				FullName: strings.TrimSpace(fullName),
+			})
+			if err != nil {
+				return fmt.Errorf("invite user: %w", err)
+			}
+			if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+				return fmt.Errorf("invite user: %w", err)
+			}
+
+			return output.Print(cmd, userResult{Action: "invited", Email: strings.TrimSpace(email)}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Invited %s\n", strings.TrimSpace(email))
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Email to invite")
+	cmd.Flags().StringVar(&fullName, "full-name", "", "Full name of the invited user")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("full-name")
+	return cmd
+}
+
+func deleteCommand() *cobra.Command {
+	var userIDInput string
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a user by ID",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			userID, err := uuid.Parse(strings.TrimSpace(userIDInput))
+			if err != nil {
+				return fmt.Errorf("invalid user id: %w", err)
+			}
+
+			client, err := apiconn.Connect(cmd)
+			if err != nil {
+				return err
+			}
+
+			rsp, err := client.Users.UsersDeleteWithResponse(context.Background(), userID)
+			if err != nil {
+				return fmt.Errorf("delete user: %w", err)
+			}
+			if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+				return fmt.Errorf("delete user: %w", err)
+			}
+
+			return output.Print(cmd, userResult{Action: "deleted", UserID: &userID}, func(out io.Writer) error {
+				fmt.Fprintf(out, "Deleted user %s\n", userID)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&userIDInput, "id", "", "User ID to delete")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// userResult is what the commands that change a user print with --output json|yaml.
+type userResult struct {
+	Action string         `json:"action"`
+	User   *usersapi.User `json:"user,omitempty"`
+	Email  string         `json:"email,omitempty"`
+	UserID *uuid.UUID     `json:"userId,omitempty"`
+}
+
+func printUserSummary(out io.Writer, u usersapi.User) {
+	fmt.Fprintf(out, "Email: %s\nFullName: %s\nCreatedAt: %s\n", u.Email, u.FullName, u.CreatedAt.UTC().Format(time.RFC3339))
+}
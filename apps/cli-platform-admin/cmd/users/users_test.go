@@ -0,0 +1,199 @@
+package userscmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
+)
+
+const userID = "7b0cf0a6-5e5a-4d43-9d3a-1f0f3c3f6a10"
+
+// usersServer fakes the users API: one user, whose email is taken for creates, and deletes that always succeed.
+type usersServer struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (s *usersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.calls = append(s.calls, r.Method+" "+r.URL.Path+" "+r.Header.Get("Authorization"))
+	s.mu.Unlock()
+
+	user := map[string]any{
+		"id": userID, "email": "ada@example.com", "fullName": "Ada Lovelace", "attributes": map[string]any{},
+		"loginCount": 0, "createdAt": "2026-01-02T03:04:05Z", "updatedAt": "2026-01-02T03:04:05Z",
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/users":
+		items := []map[string]any{user}
+		if r.URL.Query().Get("email") == "nobody" {
+			items = []map[string]any{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": items, "page": 1, "pageSize": 20, "totalItems": len(items), "totalPages": 1})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/users":
+		var body struct {
+			Email    string `json:"email"`
+			FullName string `json:"fullName"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Email == "ada@example.com" {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"title":"Conflict","status":409,"detail":"email already registered"}`))
+			return
+		}
+		user["email"], user["fullName"] = body.Email, body.FullName
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(user)
+	case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/admin/users/"+userID:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestUsersCommands(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		// withoutURL and withoutToken leave out --api-url and --token.
+		withoutURL   bool
+		withoutToken bool
+		wantCalls    []string
+		wantOut      string
+		wantErr      string
+	}{
+		{
+			name:    "create requires its flags",
+			args:    []string{"create", "--email", "grace@example.com"},
+			wantErr: `required flag(s) "full-name" not set`,
+		},
+		{
+			name:    "delete rejects a malformed id",
+			args:    []string{"delete", "--id", "ada"},
+			wantErr: "invalid user id: invalid UUID length: 3",
+		},
+		{
+			name:       "without an API URL",
+			args:       []string{"list"},
+			withoutURL: true,
+			wantErr:    "no API URL",
+		},
+		{
+			name:         "without a token",
+			args:         []string{"list"},
+			withoutToken: true,
+			wantErr:      "no API token",
+		},
+		{
+			name:    "unknown output format",
+			args:    []string{"list", "-o", "xml"},
+			wantErr: `invalid --output "xml" (use table, json or yaml)`,
+			// The format is checked once the users are listed.
+			wantCalls: []string{"GET /api/v1/admin/users Bearer test-token"},
+		},
+		{
+			name:      "list as a table",
+			args:      []string{"list"},
+			wantCalls: []string{"GET /api/v1/admin/users Bearer test-token"},
+			wantOut: "ID                                    EMAIL            FULL_NAME     CREATED_AT            LAST_LOGIN_AT\n" +
+				userID + "  ada@example.com  Ada Lovelace  2026-01-02T03:04:05Z  \n",
+		},
+		{
+			name:      "empty list as JSON",
+			args:      []string{"list", "--email", "nobody", "-o", "json"},
+			wantCalls: []string{"GET /api/v1/admin/users Bearer test-token"},
+			wantOut:   "[]\n",
+		},
+		{
+			name:      "create as JSON",
+			args:      []string{"create", "--email", "grace@example.com", "--full-name", "Grace Hopper", "-o", "json"},
+			wantCalls: []string{"POST /api/v1/admin/users Bearer test-token"},
+			wantOut: `{
+  "action": "created",
+  "user": {
+    "attributes": {},
+    "createdAt": "2026-01-02T03:04:05Z",
+    "email": "grace@example.com",
+    "fullName": "Grace Hopper",
+    "id": "` + userID + `",
+    "loginCount": 0,
+    "updatedAt": "2026-01-02T03:04:05Z"
+  }
+}
+`,
+		},
+		{
+			name:      "create reports the API problem",
+			args:      []string{"create", "--email", "ada@example.com", "--full-name", "Ada Lovelace"},
+			wantCalls: []string{"POST /api/v1/admin/users Bearer test-token"},
+			wantErr:   "create user: api answered 409: Conflict: email already registered",
+		},
+		{
+			name:      "delete as YAML",
+			args:      []string{"delete", "--id", userID, "-o", "yaml"},
+			wantCalls: []string{"DELETE /api/v1/admin/users/" + userID + " Bearer test-token"},
+			wantOut:   "action: deleted\nuserId: " + userID + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if (tt.withoutURL && os.Getenv(apiconn.EnvAPIURL) != "") || (tt.withoutToken && os.Getenv(apiconn.EnvToken) != "") {
+				t.Skip("the environment sets the connection setting left out")
+			}
+
+			api := &usersServer{}
+			server := httptest.NewServer(api)
+			t.Cleanup(server.Close)
+
+			// An empty config file keeps the profile of the user running the tests out.
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(configPath, nil, 0o600))
+			args := append([]string{"users"}, tt.args...)
+			args = append(args, "--config", configPath)
+			if !tt.withoutURL {
+				args = append(args, "--api-url", server.URL)
+			}
+			if !tt.withoutToken {
+				args = append(args, "--token", "test-token")
+			}
+
+			root := &cobra.Command{Use: "cli-platform-admin", SilenceUsage: true, SilenceErrors: true}
+			apiconn.AddRootFlags(root)
+			output.AddFlag(root)
+			root.AddCommand(Command())
+			var out bytes.Buffer
+			root.SetOut(&out)
+			root.SetErr(&bytes.Buffer{})
+			root.SetArgs(args)
+
+			err := root.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), tt.wantErr), "got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantCalls, api.calls)
+			require.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Package exitcode lets commands pick the process exit status, so CI gates can tell a check that ran and did not
+// pass from a command that could not run.
+package exitcode
+
+import "errors"
+
+const (
+	// Error is the status of a command that failed.
+	Error = 1
+	// Failed is the status of a check that ran and did not pass, e.g. a breaking schema change.
+	Failed = 2
+)
+
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
+// New returns err carrying the exit status code.
+func New(code int, err error) error {
+	return &exitError{code: code, err: err}
+}
+
+// Of returns the exit status for err: 0 for nil, the code given to New, Error otherwise.
+func Of(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	return Error
+}
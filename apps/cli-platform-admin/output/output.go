@@ -0,0 +1,118 @@
+// Package output prints command results as a table for people, or as JSON or YAML for scripts, as picked by the
+// global --output flag. The JSON and YAML forms share the field names of the JSON encoding, so jq filters and YAML
+// readers see the same structure.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an --output value.
+type Format string
+
+const (
+	Table Format = "table"
+	JSON  Format = "json"
+	YAML  Format = "yaml"
+)
+
+// AddFlag registers --output (-o) as a persistent flag of the root command.
+func AddFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringP("output", "o", string(Table), "Output format: table, json or yaml")
+}
+
+// FormatOf returns the --output of cmd, checking it is a known format. Commands without the flag print tables.
+func FormatOf(cmd *cobra.Command) (Format, error) {
+	value, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return Table, nil
+	}
+	switch format := Format(value); format {
+	case Table, JSON, YAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid --output %q (use table, json or yaml)", value)
+	}
+}
+
+// Machine reports whether cmd prints JSON or YAML.
+func Machine(cmd *cobra.Command) bool {
+	format, _ := FormatOf(cmd)
+	return format == JSON || format == YAML
+}
+
+// Progress is where commands write their progress and status lines: stdout for tables, stderr for JSON and YAML
+// so stdout holds only the document.
+func Progress(cmd *cobra.Command) io.Writer {
+	if Machine(cmd) {
+		return cmd.ErrOrStderr()
+	}
+	return cmd.OutOrStdout()
+}
+
+// Print writes v as JSON or YAML, or calls table for --output table. Lists should be passed as slices, including
+// empty ones, so scripts always get an array.
+func Print(cmd *cobra.Command, v any, table func(out io.Writer) error) error {
+	format, err := FormatOf(cmd)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	switch format {
+	case JSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case YAML:
+		return writeYAML(out, v)
+	default:
+		return table(out)
+	}
+}
+
+// writeYAML encodes v through its JSON form, which keeps the json field names and their order.
+func writeYAML(out io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+	blockStyle(&doc)
+
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+	return enc.Close()
+}
+
+// blockStyle drops the flow and quoting styles the JSON text gave the nodes; the encoder still quotes the strings
+// that YAML 1.2 would not read back as strings. Strings YAML 1.1 readers take for booleans (yes, off) keep their
+// quotes too.
+func blockStyle(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || node.Tag != "!!str" || !yaml11Bool(node.Value) {
+		node.Style = 0
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+func yaml11Bool(value string) bool {
+	switch strings.ToLower(value) {
+	case "y", "yes", "n", "no", "on", "off":
+		return true
+	}
+	return false
+}
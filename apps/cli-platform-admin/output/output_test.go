@@ -0,0 +1,174 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+type tenantRow struct {
+	Slug      string   `json:"slug"`
+	Status    string   `json:"status"`
+	Schema    *string  `json:"schema"`
+	Origins   []string `json:"origins"`
+	Suspended bool     `json:"suspended"`
+}
+
+// command is a subcommand of a root with --output, parsed from args; flag false leaves --output out.
+func command(t *testing.T, flag bool, args ...string) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+
+	root := &cobra.Command{Use: "cli-platform-admin"}
+	if flag {
+		AddFlag(root)
+	}
+	cmd := &cobra.Command{Use: "list"}
+	root.AddCommand(cmd)
+	var out, errOut bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&errOut)
+	require.NoError(t, cmd.ParseFlags(args))
+	return cmd, &out, &errOut
+}
+
+func TestPrint(t *testing.T) {
+	t.Parallel()
+
+	schema := "tenant_acme"
+	rows := []tenantRow{
+		{Slug: "acme", Status: "active", Schema: &schema, Origins: []string{"https://acme.example.com"}},
+		{Slug: "off", Status: "no", Origins: []string{}, Suspended: true},
+	}
+	table := func(out io.Writer) error {
+		for _, row := range rows {
+			fmt.Fprintln(out, row.Slug)
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		flag    bool
+		args    []string
+		value   any
+		table   func(io.Writer) error
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "table by default",
+			flag:  true,
+			value: rows,
+			table: table,
+			want:  "acme\noff\n",
+		},
+		{
+			name:  "table without the flag",
+			value: rows,
+			table: table,
+			want:  "acme\noff\n",
+		},
+		{
+			name:  "json",
+			flag:  true,
+			args:  []string{"--output", "json"},
+			value: rows[:1],
+			want: `[
+  {
+    "slug": "acme",
+    "status": "active",
+    "schema": "tenant_acme",
+    "origins": [
+      "https://acme.example.com"
+    ],
+    "suspended": false
+  }
+]
+`,
+		},
+		{
+			name:  "empty list as json",
+			flag:  true,
+			args:  []string{"-o", "json"},
+			value: []tenantRow{},
+			want:  "[]\n",
+		},
+		{
+			name:  "yaml keeps the json names and order",
+			flag:  true,
+			args:  []string{"-o", "yaml"},
+			value: rows,
+			want: `- slug: acme
+  status: active
+  schema: tenant_acme
+  origins:
+    - https://acme.example.com
+  suspended: false
+- slug: "off"
+  status: "no"
+  schema: null
+  origins: []
+  suspended: true
+`,
+		},
+		{
+			name:    "unknown format",
+			flag:    true,
+			args:    []string{"-o", "csv"},
+			value:   rows,
+			wantErr: `invalid --output "csv" (use table, json or yaml)`,
+		},
+		{
+			name:    "table errors are returned",
+			flag:    true,
+			value:   rows,
+			table:   func(io.Writer) error { return errors.New("broken pipe") },
+			wantErr: "broken pipe",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd, out, _ := command(t, tt.flag, tt.args...)
+			err := Print(cmd, tt.value, tt.table)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format     string
+		wantStdout bool
+	}{
+		{format: "table", wantStdout: true},
+		{format: "json"},
+		{format: "yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+
+			cmd, out, errOut := command(t, true, "-o", tt.format)
+			fmt.Fprint(Progress(cmd), "imported 10 rows")
+			require.Equal(t, tt.wantStdout, out.Len() > 0)
+			require.Equal(t, !tt.wantStdout, errOut.Len() > 0)
+			require.Equal(t, !tt.wantStdout, Machine(cmd))
+		})
+	}
+}
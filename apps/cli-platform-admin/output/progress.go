@@ -0,0 +1,103 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	barWidth = 30
+	// barRedraw throttles terminal redraws; lines elsewhere are printed every tenth of the total.
+	barRedraw = 100 * time.Millisecond
+	// barUnknownStep is how many items apart the lines are when the total is unknown.
+	barUnknownStep = 1000
+)
+
+// Bar reports the progress of a long command on the Progress writer: a redrawn bar on a terminal, a line every
+// tenth of the way elsewhere, so logs stay short. It is safe for concurrent use.
+type Bar struct {
+	mu       sync.Mutex
+	out      io.Writer
+	label    string
+	total    int
+	done     int
+	tty      bool
+	drawn    time.Time
+	lastStep int
+	// printed is the count of the last line written off a terminal.
+	printed int
+}
+
+// NewBar starts a bar for total items; total 0 means unknown, and only the count is shown.
+func NewBar(cmd *cobra.Command, label string, total int) *Bar {
+	out := Progress(cmd)
+	return &Bar{out: out, label: label, total: total, tty: isTerminal(out)}
+}
+
+// Add records n more items done.
+func (b *Bar) Add(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.done += n
+	if b.tty {
+		if time.Since(b.drawn) >= barRedraw {
+			b.draw()
+		}
+		return
+	}
+
+	step := b.done / barUnknownStep
+	if b.total > 0 {
+		step = b.done * 10 / b.total
+	}
+	if step > b.lastStep {
+		b.lastStep = step
+		b.printed = b.done
+		fmt.Fprintln(b.out, b.line())
+	}
+}
+
+// Finish draws the final state and ends the bar's line.
+func (b *Bar) Finish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tty {
+		b.draw()
+		fmt.Fprintln(b.out)
+		return
+	}
+	if b.done == 0 || b.printed != b.done {
+		fmt.Fprintln(b.out, b.line())
+	}
+}
+
+func (b *Bar) draw() {
+	b.drawn = time.Now()
+	fmt.Fprintf(b.out, "\r%s", b.line())
+}
+
+func (b *Bar) line() string {
+	if b.total <= 0 {
+		return fmt.Sprintf("%s %d", b.label, b.done)
+	}
+	filled := min(b.done*barWidth/b.total, barWidth)
+	return fmt.Sprintf("%s [%s%s] %3d%% %d/%d",
+		b.label, strings.Repeat("=", filled), strings.Repeat(" ", barWidth-filled), min(b.done*100/b.total, 100), b.done, b.total)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -2,6 +2,9 @@ package root
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/apiconn"
+	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/output"
 )
 
 // rootCmd is the base command for the Palmyra admin CLI. Subcommands (auth, bootstrap, etc.) are attached here.
@@ -11,6 +14,18 @@ var rootCmd = &cobra.Command{
 	Long:          "Administrative utilities for Palmyra (dev tokens, bootstrap helpers, tenant/user management).",
 	SilenceErrors: true,
 	SilenceUsage:  true,
+	// Fill the flags the user left out from the config profile before cobra checks the required ones.
+	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		if _, err := output.FormatOf(cmd); err != nil {
+			return err
+		}
+		return apiconn.ApplyProfile(cmd)
+	},
+}
+
+func init() {
+	apiconn.AddRootFlags(rootCmd)
+	output.AddFlag(rootCmd)
 }
 
 // Execute runs the CLI.
@@ -3,13 +3,27 @@ package root
 import (
 	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/auth"
 	"github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/bootstrap"
+	cleanupcmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/cleanup"
+	configcmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/config"
+	doctorcmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/doctor"
+	entitiescmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/entities"
+	migratecmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/migrate"
 	schemacmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/schema"
+	seedcmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/seed"
 	tenantcmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/tenant"
+	userscmd "github.com/zenGate-Global/palmyra-pro-saas/apps/cli-platform-admin/cmd/users"
 )
 
 func init() {
 	Root().AddCommand(auth.Command())
 	Root().AddCommand(bootstrap.Command())
+	Root().AddCommand(cleanupcmd.Command())
+	Root().AddCommand(configcmd.Command())
+	Root().AddCommand(doctorcmd.Command())
+	Root().AddCommand(entitiescmd.Command())
+	Root().AddCommand(migratecmd.Command())
 	Root().AddCommand(schemacmd.Command())
+	Root().AddCommand(seedcmd.Command())
 	Root().AddCommand(tenantcmd.Command())
+	Root().AddCommand(userscmd.Command())
 }
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	usersprov "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/provisioning"
+	usersservice "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/anchoring"
+	platformevents "github.com/zenGate-Global/palmyra-pro-saas/platform/go/events"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/gcp"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/search"
+)
+
+// buildEventPublisher returns the configured domain event publisher, or nil when EVENTS_PUBLISHER=none.
+// The returned func releases the publisher and any broker connection it owns.
+func buildEventPublisher(ctx context.Context, cfg config, logger *zap.Logger) (platformevents.Publisher, func()) {
+	switch cfg.EventsPublisher {
+	case "", "none":
+		return nil, func() {}
+	case "log":
+		return platformevents.NewLogPublisher(logger), func() {}
+	case "pubsub":
+		if strings.TrimSpace(cfg.PubSubProjectID) == "" {
+			logger.Fatal("pubsub project id required when EVENTS_PUBLISHER=pubsub")
+		}
+		client, err := pubsub.NewClient(ctx, cfg.PubSubProjectID)
+		if err != nil {
+			logger.Fatal("init pubsub client", zap.Error(err))
+		}
+		prefix := cfg.EventsPrefix
+		if prefix != "" {
+			prefix += "-"
+		}
+		publisher := platformevents.NewPubSubPublisher(client, prefix)
+		return publisher, func() {
+			_ = publisher.Close()
+			_ = client.Close()
+		}
+	case "nats":
+		conn, err := nats.Connect(cfg.NATSURL, nats.Name("palmyra-worker"))
+		if err != nil {
+			logger.Fatal("connect nats", zap.Error(err))
+		}
+		publisher, err := platformevents.NewNATSPublisher(conn, cfg.EventsPrefix)
+		if err != nil {
+			logger.Fatal("init nats publisher", zap.Error(err))
+		}
+		return publisher, func() {
+			_ = publisher.Close()
+			conn.Close()
+		}
+	default:
+		logger.Fatal("invalid EVENTS_PUBLISHER (use none, log, pubsub or nats)", zap.String("publisher", cfg.EventsPublisher))
+		return nil, func() {}
+	}
+}
+
+// buildSearchIndex returns the configured document search index, or nil when SEARCH_BACKEND=none.
+func buildSearchIndex(cfg config, logger *zap.Logger) search.Index {
+	switch cfg.SearchBackend {
+	case "", "none":
+		return nil
+	case "opensearch":
+		index, err := search.NewOpenSearchIndex(search.OpenSearchConfig{
+			URL:         cfg.OpenSearchURL,
+			Username:    cfg.OpenSearchUser,
+			Password:    cfg.OpenSearchPass,
+			IndexPrefix: cfg.SearchPrefix,
+		})
+		if err != nil {
+			logger.Fatal("init opensearch index", zap.Error(err))
+		}
+		return index
+	default:
+		logger.Fatal("invalid SEARCH_BACKEND (use none or opensearch)", zap.String("backend", cfg.SearchBackend))
+		return nil
+	}
+}
+
+// buildAnchor returns the configured ledger for entity hash anchoring, or nil when ANCHOR_PROVIDER=none.
+func buildAnchor(cfg config, logger *zap.Logger) anchoring.Anchor {
+	switch cfg.AnchorProvider {
+	case "", "none":
+		return nil
+	case "mock":
+		return anchoring.NewMockAnchor()
+	default:
+		logger.Fatal("invalid ANCHOR_PROVIDER (use none or mock)", zap.String("provider", cfg.AnchorProvider))
+		return nil
+	}
+}
+
+// buildUserDirectory returns the identity directory the user sync reads, or nil with dev auth, which has none.
+func buildUserDirectory(ctx context.Context, cfg config, logger *zap.Logger) usersservice.IdentityDirectory {
+	if cfg.AuthProvider != "firebase" {
+		return nil
+	}
+	_, fbAuth, err := gcp.InitFirebaseAuth(ctx)
+	if err != nil {
+		logger.Fatal("init firebase auth", zap.Error(err))
+	}
+	return usersprov.NewFirebaseIdentityProvisioner(fbAuth, cfg.EnvKey)
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/caarlos0/env/v11"
+	"go.uber.org/zap"
+
+	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	privacyrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/repo"
+	privacyservice "github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/service"
+	schemarepositoryrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/repo"
+	schemarepositoryservice "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	tenantsprov "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/provisioning"
+	tenantsrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/repo"
+	tenantsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	usersrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/repo"
+	usersservice "github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	webhooksservice "github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/service"
+	platformevents "github.com/zenGate-Global/palmyra-pro-saas/platform/go/events"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/lifecycle"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/search"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/telemetry"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// config mirrors the api server variables the background loops read, so both binaries can share one environment.
+type config struct {
+	ShutdownTimeout   time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"30s"`
+	LogLevel          string        `env:"LOG_LEVEL" envDefault:"info"`
+	DatabaseURL       string        `env:"DATABASE_URL,required"`
+	StatementTimeout  time.Duration `env:"DB_STATEMENT_TIMEOUT" envDefault:"0s"`
+	LockTimeout       time.Duration `env:"DB_LOCK_TIMEOUT" envDefault:"0s"`
+	AuthProvider      string        `env:"AUTH_PROVIDER" envDefault:"firebase"`
+	EnvKey            string        `env:"ENV_KEY,required"`
+	AdminTenantSlug   string        `env:"ADMIN_TENANT_SLUG" envDefault:"admin"`
+	StorageBackend    string        `env:"STORAGE_BACKEND" envDefault:"gcs"`               // gcs | local
+	StorageBucket     string        `env:"STORAGE_BUCKET"`                                 // required when STORAGE_BACKEND=gcs
+	StorageLocalDir   string        `env:"STORAGE_LOCAL_DIR" envDefault:"./.data/storage"` // used when STORAGE_BACKEND=local
+	WebhookInterval   time.Duration `env:"WEBHOOK_DISPATCH_INTERVAL" envDefault:"5s"`
+	EventsPublisher   string        `env:"EVENTS_PUBLISHER" envDefault:"none"` // none | log | pubsub | nats
+	EventsPrefix      string        `env:"EVENTS_TOPIC_PREFIX" envDefault:"palmyra"`
+	EventsInterval    time.Duration `env:"EVENTS_RELAY_INTERVAL" envDefault:"2s"`
+	ProvisionInterval time.Duration `env:"PROVISIONING_WORKER_INTERVAL" envDefault:"5s"`
+	ProvisionWorkers  int           `env:"PROVISIONING_WORKER_CONCURRENCY" envDefault:"1"`
+	JobsInterval      time.Duration `env:"JOBS_WORKER_INTERVAL" envDefault:"2s"`
+	JobsWorkers       int           `env:"JOBS_WORKER_CONCURRENCY" envDefault:"4"` // 0 leaves background jobs to other processes
+	SchedulerInterval time.Duration `env:"SCHEDULER_INTERVAL" envDefault:"30s"`
+	RetentionSchedule string        `env:"RETENTION_CLEANUP_SCHEDULE" envDefault:"0 3 * * *"` // cron (UTC); empty disables the task
+	RetentionWindow   time.Duration `env:"RETENTION_WINDOW" envDefault:"720h"`
+	UsageSchedule     string        `env:"USAGE_METERING_SCHEDULE" envDefault:"0 * * * *"`
+	ReconcileSchedule string        `env:"PROVISIONING_RECONCILE_SCHEDULE" envDefault:"*/15 * * * *"`
+	PartitionEntities bool          `env:"ENTITY_PARTITIONING" envDefault:"false"`
+	PartitionPremake  int           `env:"ENTITY_PARTITION_PREMAKE" envDefault:"3"`
+	PartitionRetain   int           `env:"ENTITY_PARTITION_RETENTION_MONTHS" envDefault:"0"`
+	PartitionDrop     bool          `env:"ENTITY_PARTITION_DROP_DETACHED" envDefault:"false"`
+	PartitionInterval time.Duration `env:"ENTITY_PARTITION_INTERVAL" envDefault:"6h"`
+	ArchiveAfterDays  int           `env:"ENTITY_ARCHIVE_AFTER_DAYS" envDefault:"0"` // 0 disables archiving cold versions
+	ArchiveBatchSize  int           `env:"ENTITY_ARCHIVE_BATCH_SIZE" envDefault:"500"`
+	ArchiveInterval   time.Duration `env:"ENTITY_ARCHIVE_INTERVAL" envDefault:"1h"`
+	AnchorProvider    string        `env:"ANCHOR_PROVIDER" envDefault:"none"` // none | mock
+	AnchorInterval    time.Duration `env:"ANCHOR_INTERVAL" envDefault:"10m"`
+	AnchorBatchSize   int           `env:"ANCHOR_BATCH_SIZE" envDefault:"1000"`
+	SearchBackend     string        `env:"SEARCH_BACKEND" envDefault:"none"` // none | opensearch
+	OpenSearchURL     string        `env:"OPENSEARCH_URL" envDefault:"http://127.0.0.1:9200"`
+	OpenSearchUser    string        `env:"OPENSEARCH_USERNAME"`
+	OpenSearchPass    string        `env:"OPENSEARCH_PASSWORD"`
+	SearchPrefix      string        `env:"SEARCH_INDEX_PREFIX" envDefault:"palmyra-"`
+	SearchInterval    time.Duration `env:"SEARCH_INDEXER_INTERVAL" envDefault:"2s"`
+	StorageUsageEvery time.Duration `env:"STORAGE_USAGE_INTERVAL" envDefault:"1h"` // 0 disables storage accounting
+	StorageAlertPcts  []int         `env:"STORAGE_USAGE_ALERT_PERCENTS" envDefault:"80,90,100"`
+	Mailer            string        `env:"MAILER" envDefault:"log"` // log | smtp | sendgrid
+	SMTPAddr          string        `env:"SMTP_ADDR"`               // required when MAILER=smtp
+	SMTPFrom          string        `env:"SMTP_FROM"`               // required when MAILER=smtp
+	SMTPUsername      string        `env:"SMTP_USERNAME"`
+	SMTPPassword      string        `env:"SMTP_PASSWORD"`
+	SendGridAPIKey    string        `env:"SENDGRID_API_KEY"` // required when MAILER=sendgrid
+	SendGridFrom      string        `env:"SENDGRID_FROM"`    // required when MAILER=sendgrid
+	SendGridFromName  string        `env:"SENDGRID_FROM_NAME"`
+	PrivacySigningKey string        `env:"PRIVACY_REPORT_SIGNING_KEY"`
+	ErasureAttempts   int           `env:"PRIVACY_ERASURE_MAX_ATTEMPTS" envDefault:"5"`
+	PubSubProjectID   string        `env:"PUBSUB_PROJECT_ID"` // required when EVENTS_PUBLISHER=pubsub
+	NATSURL           string        `env:"NATS_URL" envDefault:"nats://127.0.0.1:4222"`
+	UserSyncInterval  time.Duration `env:"USER_SYNC_INTERVAL" envDefault:"1h"` // 0 disables the background sync
+	OTLPEndpoint      string        `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`        // empty disables span export
+	OTELServiceName   string        `env:"OTEL_SERVICE_NAME" envDefault:"palmyra-worker"`
+	TraceSampleRatio  float64       `env:"OTEL_TRACES_SAMPLE_RATIO" envDefault:"1"`
+}
+
+func main() {
+	ctx := context.Background()
+
+	var cfg config
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+
+	adminSchema := tenant.BuildSchemaName(cfg.EnvKey, tenant.ToSnake(cfg.AdminTenantSlug))
+
+	logger, err := platformlogging.NewLogger(platformlogging.Config{
+		Component: "worker",
+		Level:     cfg.LogLevel,
+	})
+	if err != nil {
+		log.Fatalf("init zap logger: %v", err)
+	}
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	// components stops the workers and then what they use, in reverse order, once SIGINT or SIGTERM arrives.
+	components := lifecycle.New(logger)
+
+	shutdownTracing, err := telemetry.Setup(ctx, telemetry.Config{
+		Endpoint:    cfg.OTLPEndpoint,
+		ServiceName: cfg.OTELServiceName,
+		SampleRatio: cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		logger.Fatal("init tracing", zap.Error(err))
+	}
+	components.OnStop("tracing", shutdownTracing)
+
+	pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: cfg.DatabaseURL})
+	if err != nil {
+		logger.Fatal("init postgres pool", zap.Error(err))
+	}
+	components.OnClose("postgres pool", func() {
+		persistence.ClosePool(pool)
+	})
+
+	spaceDB := persistence.NewSpaceDB(persistence.SpaceDBConfig{
+		Pool:        pool,
+		AdminSchema: adminSchema,
+		Timeouts: persistence.QueryTimeouts{
+			Statement: cfg.StatementTimeout,
+			Lock:      cfg.LockTimeout,
+		},
+	})
+
+	eventPublisher, closePublisher := buildEventPublisher(ctx, cfg, logger)
+	components.OnClose("event publisher", closePublisher)
+
+	var outboxStore *persistence.OutboxStore
+	if eventPublisher != nil {
+		outboxStore = persistence.NewOutboxStore()
+	}
+
+	tenantStore, err := persistence.NewTenantStore(ctx, pool, adminSchema)
+	if err != nil {
+		logger.Fatal("init tenant store", zap.Error(err))
+	}
+	if outboxStore != nil {
+		tenantStore.UseOutbox(outboxStore)
+	}
+
+	var (
+		storageProv tenantsservice.StorageProvisioner
+		objectStore storage.ObjectStore
+		prefixMeter storage.PrefixMeter
+	)
+	switch cfg.StorageBackend {
+	case "gcs":
+		if cfg.StorageBucket == "" {
+			logger.Fatal("storage bucket required when STORAGE_BACKEND=gcs")
+		}
+		gcsClient, err := gcs.NewClient(ctx)
+		if err != nil {
+			logger.Fatal("init gcs client", zap.Error(err))
+		}
+		components.OnClose("gcs client", func() {
+			_ = gcsClient.Close()
+		})
+		storageProv = tenantsprov.NewGCSStorageProvisioner(gcsClient, cfg.StorageBucket)
+		gcsObjects := storage.NewGCSObjectStore(gcsClient, cfg.StorageBucket)
+		objectStore, prefixMeter = gcsObjects, gcsObjects
+	case "local":
+		if strings.TrimSpace(cfg.StorageLocalDir) == "" {
+			logger.Fatal("storage local dir required when STORAGE_BACKEND=local")
+		}
+		storageProv = tenantsprov.NewLocalStorageProvisioner(cfg.StorageLocalDir)
+		localObjects := storage.NewLocalObjectStore(cfg.StorageLocalDir)
+		objectStore, prefixMeter = localObjects, localObjects
+	default:
+		logger.Fatal("invalid STORAGE_BACKEND (use gcs or local)", zap.String("backend", cfg.StorageBackend))
+	}
+
+	entityPartitioning := persistence.EntityPartitioning{
+		Enabled:      cfg.PartitionEntities,
+		Premake:      cfg.PartitionPremake,
+		RetainMonths: cfg.PartitionRetain,
+		DropDetached: cfg.PartitionDrop,
+	}
+	dbProv := tenantsprov.NewDBProvisioner(pool, adminSchema)
+	dbProv.UseEntityPartitioning(entityPartitioning)
+	tenantService := tenantsservice.New(
+		tenantsrepo.NewPostgresRepository(tenantStore),
+		cfg.EnvKey,
+		tenantsservice.ProvisioningDeps{
+			DB:      dbProv,
+			Auth:    tenantsprov.NewAuthProvisioner(),
+			Storage: storageProv,
+		},
+	)
+	tenantService.UseJobs(tenantsrepo.NewPostgresJobRepository(persistence.NewTenantJobStore(pool, adminSchema)))
+	usageMeter := persistence.NewTenantUsageMeter(spaceDB)
+	tenantService.UseUsageMeter(usageMeter)
+	tenantService.UseCloner(persistence.NewTenantCloneStore(spaceDB))
+	schemaMigrator := persistence.NewMigrator(pool)
+	schemaMigrator.UseEntityTables(persistence.NewEntityTableStore(spaceDB, entityPartitioning))
+	tenantService.UseMigrator(tenantsrepo.NewPostgresSchemaMigrator(schemaMigrator, adminSchema))
+	outgoingMail := buildMailer(cfg, logger)
+	tenantService.UseMailer(outgoingMail, logger)
+	tenantService.UseChangeNotifier(persistence.NewTenantChanges(pool))
+
+	webhookStore, err := persistence.NewWebhookStore(ctx, spaceDB)
+	if err != nil {
+		logger.Fatal("init webhook store", zap.Error(err))
+	}
+
+	userStore, err := persistence.NewUserStore(ctx, spaceDB)
+	if err != nil {
+		logger.Fatal("init user store", zap.Error(err))
+	}
+	membershipStore := persistence.NewMembershipStore(pool, adminSchema)
+
+	var workers []func(context.Context)
+
+	workers = append(workers, webhooksservice.NewDispatcher(webhookStore, tenantService, logger, webhooksservice.DispatcherConfig{
+		Interval: cfg.WebhookInterval,
+	}).Run)
+
+	workers = append(workers, tenantsservice.NewProvisioningWorker(tenantService, logger, tenantsservice.ProvisioningWorkerConfig{
+		Interval:    cfg.ProvisionInterval,
+		Concurrency: cfg.ProvisionWorkers,
+	}).Run)
+
+	if outboxStore != nil {
+		workers = append(workers, platformevents.NewRelay(spaceDB, outboxStore, eventPublisher, tenantService, logger, platformevents.RelayConfig{
+			Interval: cfg.EventsInterval,
+		}).Run)
+	}
+
+	if searchIndex := buildSearchIndex(cfg, logger); searchIndex != nil {
+		workers = append(workers, search.NewIndexer(spaceDB, persistence.NewSearchOutboxStore(), searchIndex, tenantService, logger, search.IndexerConfig{
+			Interval: cfg.SearchInterval,
+		}).Run)
+	}
+
+	// Writes never create partitions, so the maintenance runs even with partitioning off (see apps/api).
+	workers = append(workers, entitiesservice.NewPartitionWorker(persistence.NewEntityPartitionStore(spaceDB, entityPartitioning), tenantService, logger, entitiesservice.PartitionWorkerConfig{
+		Interval: cfg.PartitionInterval,
+	}).Run)
+
+	if cfg.ArchiveAfterDays > 0 {
+		entityArchive := persistence.NewEntityArchiveStore(spaceDB, objectStore, persistence.EntityArchiving{
+			MinAge:    time.Duration(cfg.ArchiveAfterDays) * 24 * time.Hour,
+			BatchSize: cfg.ArchiveBatchSize,
+		})
+		workers = append(workers, entitiesservice.NewArchiveWorker(entityArchive, tenantService, logger, entitiesservice.ArchiveWorkerConfig{
+			Interval: cfg.ArchiveInterval,
+		}).Run)
+	}
+
+	if anchor := buildAnchor(cfg, logger); anchor != nil {
+		workers = append(workers, entitiesservice.NewAnchorWorker(persistence.NewEntityAnchorStore(spaceDB), anchor, tenantService, logger, entitiesservice.AnchorWorkerConfig{
+			Interval:  cfg.AnchorInterval,
+			BatchSize: cfg.AnchorBatchSize,
+		}).Run)
+	}
+
+	if cfg.StorageUsageEvery > 0 {
+		for _, pct := range cfg.StorageAlertPcts {
+			if pct <= 0 {
+				logger.Fatal("invalid STORAGE_USAGE_ALERT_PERCENTS (use positive percentages)", zap.Ints("percents", cfg.StorageAlertPcts))
+			}
+		}
+		storageUsage := persistence.NewStorageUsageStore(spaceDB, outboxStore, cfg.StorageAlertPcts)
+		storageUsageWorker := tenantsservice.NewStorageUsageWorker(prefixMeter, storageUsage, tenantService, logger, tenantsservice.StorageUsageWorkerConfig{
+			Interval: cfg.StorageUsageEvery,
+		})
+		storageUsageWorker.UseMailer(outgoingMail)
+		workers = append(workers, storageUsageWorker.Run)
+	}
+
+	schemaStore, err := persistence.NewSchemaRepositoryStore(ctx, pool)
+	if err != nil {
+		logger.Fatal("init schema repository store", zap.Error(err))
+	}
+
+	if directory := buildUserDirectory(ctx, cfg, logger); directory != nil && cfg.UserSyncInterval > 0 {
+		userRepo := usersrepo.NewPostgresRepository(userStore, persistence.NewRoleStore(spaceDB), persistence.NewInvitationStore(spaceDB), membershipStore, persistence.NewAPIKeyStore(spaceDB), persistence.NewServiceAccountStore(spaceDB), usersrepo.AttributeSchemas{
+			DB:        spaceDB,
+			Store:     schemaStore,
+			Validator: persistence.NewSchemaValidator(),
+		})
+		userService := usersservice.New(userRepo, usageMeter, usersservice.InvitationDeps{Directory: directory})
+		workers = append(workers, usersservice.NewSyncWorker(userService, tenantService, logger, usersservice.SyncWorkerConfig{
+			Interval: cfg.UserSyncInterval,
+		}).Run)
+	}
+
+	// Like in the api server, every job kind is registered on jobPool, so either process can run any job.
+	jobStore := persistence.NewJobStore(pool, adminSchema)
+	jobPool := jobs.NewPool(jobStore, tenantService, logger, jobs.PoolConfig{
+		Interval:    cfg.JobsInterval,
+		Concurrency: cfg.JobsWorkers,
+	})
+	privacyRepo := privacyrepo.NewPostgresRepository(persistence.NewSubjectErasureStore(spaceDB), userStore, membershipStore)
+	privacyService := privacyservice.New(privacyRepo, jobs.NewQueue(jobStore), privacyservice.Config{
+		SigningKey:  []byte(cfg.PrivacySigningKey),
+		MaxAttempts: cfg.ErasureAttempts,
+	})
+	jobPool.Register(privacyservice.ErasureJobKind, privacyService.RunErasure)
+	categoryStore, err := persistence.NewSchemaCategoryStore(ctx, pool)
+	if err != nil {
+		logger.Fatal("init schema category store", zap.Error(err))
+	}
+	schemaRepo := schemarepositoryrepo.NewPostgresRepository(spaceDB, schemaStore, persistence.NewSchemaBundleStore(schemaStore, categoryStore), persistence.NewEntityTableStore(spaceDB, entityPartitioning))
+	schemaService := schemarepositoryservice.New(schemaRepo, tenantService, jobs.NewQueue(jobStore))
+	jobPool.Register(schemarepositoryservice.EntityTablesJobKind, schemaService.RunEntityTables)
+	if cfg.JobsWorkers > 0 {
+		workers = append(workers, jobPool.Run)
+	}
+
+	// Like the api server, only the process holding the scheduler lock runs the periodic tasks.
+	workers = append(workers, buildScheduler(cfg, pool, adminSchema, spaceDB, nil, tenantService, logger).Run)
+
+	for _, run := range workers {
+		components.Go("worker", run)
+	}
+	logger.Info("starting background workers", zap.Int("workers", len(workers)))
+
+	received := lifecycle.WaitForSignal()
+	logger.Info("shutting down", zap.String("signal", received.String()), zap.Duration("timeout", cfg.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := components.Shutdown(shutdownCtx); err != nil {
+		logger.Error("graceful shutdown failed", zap.Error(err))
+	}
+}
+
+// buildMailer selects the outgoing email transport from MAILER.
+func buildMailer(cfg config, logger *zap.Logger) mailer.Mailer {
+	switch cfg.Mailer {
+	case "log":
+		return mailer.NewLogMailer(logger)
+	case "smtp":
+		smtpMailer, err := mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Addr:     cfg.SMTPAddr,
+			From:     cfg.SMTPFrom,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		})
+		if err != nil {
+			logger.Fatal("init smtp mailer", zap.Error(err))
+		}
+		return smtpMailer
+	case "sendgrid":
+		sendGridMailer, err := mailer.NewSendGridMailer(mailer.SendGridConfig{
+			APIKey:   cfg.SendGridAPIKey,
+			From:     cfg.SendGridFrom,
+			FromName: cfg.SendGridFromName,
+		})
+		if err != nil {
+			logger.Fatal("init sendgrid mailer", zap.Error(err))
+		}
+		return sendGridMailer
+	default:
+		logger.Fatal("invalid MAILER (use log, smtp or sendgrid)", zap.String("mailer", cfg.Mailer))
+		return nil
+	}
+}
@@ -0,0 +1,2 @@
+-- Records the compatibility analysis of each schema version against the version it replaced.
+ALTER TABLE schema_repository ADD COLUMN IF NOT EXISTS compatibility JSONB;
@@ -0,0 +1,7 @@
+-- Draft schema versions can be iterated on before they are published and activated.
+ALTER TABLE schema_repository
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'published' CHECK (status IN ('draft', 'published'));
+
+ALTER TABLE schema_repository DROP CONSTRAINT IF EXISTS schema_repository_draft_inactive;
+ALTER TABLE schema_repository
+    ADD CONSTRAINT schema_repository_draft_inactive CHECK (status = 'published' OR NOT is_active);
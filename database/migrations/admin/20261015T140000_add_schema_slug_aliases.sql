@@ -0,0 +1,10 @@
+-- Schema slugs can be renamed; the previous slugs are kept as aliases that still resolve to the schema.
+CREATE TABLE IF NOT EXISTS schema_slug_aliases (
+    slug TEXT PRIMARY KEY CHECK (slug ~ '^[a-z0-9]+(?:-[a-z0-9]+)*$'),
+    schema_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by TEXT
+);
+
+CREATE INDEX IF NOT EXISTS schema_slug_aliases_schema_idx
+    ON schema_slug_aliases(schema_id);
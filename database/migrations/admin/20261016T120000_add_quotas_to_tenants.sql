@@ -0,0 +1,5 @@
+-- Per-tenant quotas; NULL means unlimited.
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS max_entities BIGINT NULL CHECK (max_entities >= 0);
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS max_schemas BIGINT NULL CHECK (max_schemas >= 0);
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS max_storage_bytes BIGINT NULL CHECK (max_storage_bytes >= 0);
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS max_users BIGINT NULL CHECK (max_users >= 0);
@@ -0,0 +1,2 @@
+-- Extra browser origins allowed by CORS for each tenant, on top of the deployment-wide list.
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS cors_origins TEXT[] NOT NULL DEFAULT '{}';
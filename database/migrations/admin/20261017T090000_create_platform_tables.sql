@@ -0,0 +1,65 @@
+-- Platform tables that used to be created lazily by their stores on first use.
+
+-- Tenants each user may switch to, keyed by normalized email.
+CREATE TABLE IF NOT EXISTS tenant_memberships (
+    email TEXT NOT NULL,
+    tenant_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    roles TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (email, tenant_id)
+);
+
+CREATE INDEX IF NOT EXISTS tenant_memberships_tenant_user_idx ON tenant_memberships (tenant_id, user_id);
+
+-- Background tenant jobs (provisioning, teardown, clone, ...).
+CREATE TABLE IF NOT EXISTS tenant_jobs (
+    job_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    kind TEXT NOT NULL,
+    status TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    components JSONB NOT NULL DEFAULT '{}'::jsonb,
+    params JSONB NOT NULL DEFAULT '{}'::jsonb,
+    last_error TEXT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ NULL
+);
+
+ALTER TABLE tenant_jobs ADD COLUMN IF NOT EXISTS params JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE UNIQUE INDEX IF NOT EXISTS tenant_jobs_open_one_per_kind
+    ON tenant_jobs (tenant_id, kind) WHERE status IN ('queued', 'running');
+CREATE INDEX IF NOT EXISTS tenant_jobs_due_idx
+    ON tenant_jobs (next_attempt_at) WHERE status IN ('queued', 'running');
+
+-- Platform-wide token revocation list.
+CREATE TABLE IF NOT EXISTS token_revocations (
+    revocation_id UUID PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('user', 'token')),
+    subject TEXT NOT NULL,
+    reason TEXT,
+    revoked_by TEXT,
+    revoked_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS token_revocations_expires_idx ON token_revocations (expires_at);
+
+-- Transactional outbox for tenant registry events.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    event_id UUID PRIMARY KEY,
+    topic TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    event_key TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events (occurred_at) WHERE published_at IS NULL;
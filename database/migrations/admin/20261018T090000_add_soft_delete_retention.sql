@@ -0,0 +1,21 @@
+-- When each schema version was soft-deleted, so the retention cleanup can purge it once the window has passed.
+ALTER TABLE schema_repository ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- One row per record permanently removed by the retention cleanup. tenant_id is NULL for platform records
+-- (schema versions and categories); versions counts the entity versions removed with an entity.
+CREATE TABLE IF NOT EXISTS purge_audit (
+    purge_id UUID PRIMARY KEY,
+    run_id UUID NOT NULL,
+    tenant_id UUID NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('entity', 'schema_version', 'category')),
+    table_name TEXT NULL,
+    record_id TEXT NOT NULL,
+    record_version TEXT NULL,
+    versions INT NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMPTZ NULL,
+    purged_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    purged_by TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS purge_audit_tenant_idx ON purge_audit (tenant_id, purged_at);
+CREATE INDEX IF NOT EXISTS purge_audit_run_idx ON purge_audit (run_id);
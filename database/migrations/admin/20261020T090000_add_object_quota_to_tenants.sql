@@ -0,0 +1,2 @@
+-- Quota on the bytes a tenant keeps in object storage under its prefix; NULL means unlimited.
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS max_object_bytes BIGINT NULL CHECK (max_object_bytes >= 0);
@@ -0,0 +1,28 @@
+-- Bookkeeping of the periodic tasks run by the leader-elected scheduler: one row per task name, holding its
+-- schedule, the next due time, the outcome of the last run and any pending manual trigger.
+CREATE TABLE IF NOT EXISTS scheduled_tasks (
+    name TEXT PRIMARY KEY,
+    schedule TEXT NOT NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    trigger_requested_at TIMESTAMPTZ NULL,
+    trigger_requested_by TEXT NULL,
+    last_status TEXT NULL,
+    last_error TEXT NULL,
+    last_started_at TIMESTAMPTZ NULL,
+    last_finished_at TIMESTAMPTZ NULL,
+    last_duration_ms BIGINT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Usage samples recorded by the usage metering task, for billing and trend reporting.
+CREATE TABLE IF NOT EXISTS tenant_usage_snapshots (
+    tenant_id UUID NOT NULL,
+    measured_at TIMESTAMPTZ NOT NULL,
+    entities BIGINT NOT NULL,
+    schemas BIGINT NOT NULL,
+    storage_bytes BIGINT NOT NULL,
+    users BIGINT NOT NULL,
+    objects BIGINT NOT NULL,
+    object_bytes BIGINT NOT NULL,
+    PRIMARY KEY (tenant_id, measured_at)
+);
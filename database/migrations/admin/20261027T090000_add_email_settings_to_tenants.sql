@@ -0,0 +1,6 @@
+-- Per-tenant outgoing email: the sender shown on the tenant's messages and where tenant notifications are sent.
+-- NULL sender fields fall back to the platform sender; without notify_email no notifications are emailed.
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS email_from_name TEXT NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS email_from_address TEXT NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS email_reply_to TEXT NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS notify_email TEXT NULL;
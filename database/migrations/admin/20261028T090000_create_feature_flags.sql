@@ -0,0 +1,26 @@
+-- Feature flags: a platform-wide default per flag and per-tenant overrides, so features can be rolled out tenant by
+-- tenant. Tenants are versioned rows, so overrides reference the tenant id without a foreign key.
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key TEXT PRIMARY KEY CHECK (key ~ '^[a-z][a-z0-9]*([.-][a-z0-9]+)*$'),
+    description TEXT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by TEXT NULL
+);
+
+CREATE TABLE IF NOT EXISTS feature_flag_overrides (
+    flag_key TEXT NOT NULL REFERENCES feature_flags (key) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by TEXT NULL,
+    PRIMARY KEY (flag_key, tenant_id)
+);
+
+CREATE INDEX IF NOT EXISTS feature_flag_overrides_tenant_idx ON feature_flag_overrides (tenant_id);
+
+-- Bulk import predates the flags: it stays on everywhere until an admin turns the default off.
+INSERT INTO feature_flags (key, description, enabled)
+VALUES ('entities.bulk-import', 'Bulk import of entity documents (POST /entities/{tableName}/documents:bulk).', TRUE)
+ON CONFLICT (key) DO NOTHING;
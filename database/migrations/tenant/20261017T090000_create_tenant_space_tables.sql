@@ -0,0 +1,154 @@
+-- Tenant space tables that used to be created lazily by their stores on first use. Runs in every tenant schema,
+-- including the admin schema, after database/schema/tenant_space/users.sql.
+
+CREATE TABLE IF NOT EXISTS users (
+    user_id UUID PRIMARY KEY,
+    email TEXT NOT NULL UNIQUE,
+    full_name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS last_login_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_active_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS login_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS users_created_at_idx ON users(created_at DESC);
+CREATE INDEX IF NOT EXISTS users_last_active_at_idx ON users(last_active_at);
+
+-- Single-row tenant settings for user profiles.
+CREATE TABLE IF NOT EXISTS user_profile_settings (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    attributes_schema TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Tenant roles and their assignment to users; the admin role is seeded with every permission.
+CREATE TABLE IF NOT EXISTS roles (
+    role_name TEXT PRIMARY KEY,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_name TEXT NOT NULL REFERENCES roles(role_name) ON DELETE CASCADE,
+    permission TEXT NOT NULL,
+    PRIMARY KEY (role_name, permission)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    role_name TEXT NOT NULL REFERENCES roles(role_name) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role_name)
+);
+
+CREATE INDEX IF NOT EXISTS user_roles_role_name_idx ON user_roles(role_name);
+
+WITH seeded AS (
+    INSERT INTO roles (role_name, description) VALUES ('admin', 'Full access to the tenant')
+    ON CONFLICT (role_name) DO NOTHING
+    RETURNING role_name
+)
+INSERT INTO role_permissions (role_name, permission) SELECT role_name, '*' FROM seeded;
+
+-- Pending and accepted user invitations.
+CREATE TABLE IF NOT EXISTS user_invitations (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    invited_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    sent_at TIMESTAMPTZ,
+    send_count INT NOT NULL DEFAULT 0,
+    accepted_at TIMESTAMPTZ
+);
+
+-- Personal API keys.
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    secret_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_user_idx ON api_keys (user_id);
+
+-- Service accounts for server-to-server access.
+CREATE TABLE IF NOT EXISTS service_accounts (
+    service_account_id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    secret_hash TEXT NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS service_accounts_name_live_idx ON service_accounts (name) WHERE revoked_at IS NULL;
+
+-- Webhook subscriptions, the entity event outbox they fan out from, and their deliveries.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    webhook_id UUID PRIMARY KEY,
+    url TEXT NOT NULL,
+    event_types TEXT[] NOT NULL CHECK (cardinality(event_types) > 0),
+    secret TEXT NOT NULL,
+    description TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by TEXT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_outbox (
+    event_id UUID PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    table_name TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    entity_version TEXT NULL,
+    payload JSONB NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    dispatched_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_outbox_pending_idx ON webhook_outbox (occurred_at) WHERE dispatched_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    delivery_id UUID PRIMARY KEY,
+    webhook_id UUID NOT NULL REFERENCES webhook_subscriptions (webhook_id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES webhook_outbox (event_id),
+    status TEXT NOT NULL CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_status_code INT NULL,
+    last_error TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id, created_at DESC);
+
+-- Transactional outbox for entity events.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    event_id UUID PRIMARY KEY,
+    topic TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    event_key TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_pending_idx ON outbox_events (occurred_at) WHERE published_at IS NULL;
@@ -0,0 +1,16 @@
+-- Files attached to entity documents. The bytes live in object storage under the tenant prefix; each row links one
+-- object to the entity version that was active when the upload was issued.
+CREATE TABLE IF NOT EXISTS entity_attachments (
+    attachment_id UUID PRIMARY KEY,
+    table_name TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    entity_version TEXT NOT NULL CHECK (entity_version ~ '^\d+\.\d+\.\d+$'),
+    file_name TEXT NOT NULL CHECK (char_length(file_name) BETWEEN 1 AND 255),
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes >= 0),
+    object_path TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS entity_attachments_entity_idx ON entity_attachments (table_name, entity_id, created_at DESC);
@@ -0,0 +1,9 @@
+-- Latest object storage measurement of the tenant prefix, written by the storage usage worker. The single row also
+-- remembers the highest quota alert threshold already announced, so each crossing is announced once.
+CREATE TABLE IF NOT EXISTS storage_usage (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    object_count BIGINT NOT NULL CHECK (object_count >= 0),
+    object_bytes BIGINT NOT NULL CHECK (object_bytes >= 0),
+    measured_at TIMESTAMPTZ NOT NULL,
+    alerted_percent INT NULL CHECK (alerted_percent > 0)
+);
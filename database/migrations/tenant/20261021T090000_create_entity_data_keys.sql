@@ -0,0 +1,12 @@
+-- Per-tenant data keys for payload fields declared x-encrypt or x-pii. Only the data key wrapped by the key
+-- management service is stored; key_name records which key-encryption key wrapped it. Exactly one key is active and
+-- used for new writes, retired keys stay to decrypt the values they sealed.
+CREATE TABLE IF NOT EXISTS entity_data_keys (
+    key_id UUID PRIMARY KEY,
+    key_name TEXT NOT NULL,
+    wrapped_key TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS entity_data_keys_active_idx ON entity_data_keys (is_active) WHERE is_active;
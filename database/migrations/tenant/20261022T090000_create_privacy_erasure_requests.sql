@@ -0,0 +1,21 @@
+-- Subject erasure requests (GDPR right to erasure). Each request is run once by the erasure worker, which leases it
+-- through next_attempt_at; the completion report is kept with its HMAC signature as evidence of the erasure.
+CREATE TABLE IF NOT EXISTS privacy_erasure_requests (
+    request_id UUID PRIMARY KEY,
+    subject_id TEXT NOT NULL CHECK (char_length(subject_id) BETWEEN 1 AND 256),
+    user_id UUID NULL,
+    mode TEXT NOT NULL CHECK (mode IN ('anonymize', 'purge')),
+    status TEXT NOT NULL CHECK (status IN ('queued', 'running', 'succeeded', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    report JSONB NULL,
+    signature TEXT NULL,
+    last_error TEXT NULL,
+    requested_by TEXT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS privacy_erasure_requests_due_idx ON privacy_erasure_requests (next_attempt_at)
+    WHERE status IN ('queued', 'running');
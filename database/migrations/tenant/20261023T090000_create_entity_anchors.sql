@@ -0,0 +1,28 @@
+-- Anchoring of entity version hashes. The anchor worker groups versions that are not anchored yet into a batch,
+-- stores the Merkle root of their leaf hashes and submits it to the configured ledger; a batch stays pending until
+-- the ledger accepted it. Each version belongs to at most one batch.
+CREATE TABLE IF NOT EXISTS entity_anchor_batches (
+    batch_id UUID PRIMARY KEY,
+    anchor TEXT NOT NULL,
+    merkle_root TEXT NOT NULL CHECK (merkle_root ~ '^[a-f0-9]{64}$'),
+    leaf_count INT NOT NULL CHECK (leaf_count > 0),
+    status TEXT NOT NULL CHECK (status IN ('pending', 'anchored')),
+    reference TEXT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    anchored_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS entity_anchor_batches_pending_idx ON entity_anchor_batches (created_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS entity_anchor_leaves (
+    table_name TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    entity_version TEXT NOT NULL CHECK (entity_version ~ '^\d+\.\d+\.\d+$'),
+    hash TEXT NOT NULL,
+    batch_id UUID NOT NULL REFERENCES entity_anchor_batches (batch_id) ON DELETE CASCADE,
+    leaf_index INT NOT NULL CHECK (leaf_index >= 0),
+    PRIMARY KEY (table_name, entity_id, entity_version),
+    UNIQUE (batch_id, leaf_index)
+);
@@ -0,0 +1,16 @@
+-- Entity changes waiting to be mirrored into the tenant's search index. Only written while a search backend is
+-- configured; rows are kept once indexed, like outbox_events.
+CREATE TABLE IF NOT EXISTS search_outbox (
+    event_id UUID PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    table_name TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    entity_version TEXT NULL,
+    payload JSONB NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    indexed_at TIMESTAMPTZ NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS search_outbox_pending_idx ON search_outbox (occurred_at) WHERE indexed_at IS NULL;
@@ -0,0 +1,12 @@
+-- The request and response of the latest attempt of each webhook delivery, so tenants can debug their endpoints.
+-- Bodies are kept as bytes since endpoints may answer with anything; both are truncated by the dispatcher.
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS last_attempt_at TIMESTAMPTZ NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS last_duration_ms BIGINT NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS request_headers JSONB NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS request_body BYTEA NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS response_headers JSONB NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS response_body BYTEA NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS redelivered_at TIMESTAMPTZ NULL;
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS redelivered_by TEXT NULL;
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_status_idx ON webhook_deliveries (webhook_id, status, created_at DESC);
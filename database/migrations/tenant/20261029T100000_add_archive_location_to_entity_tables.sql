@@ -0,0 +1,26 @@
+-- Entity tables created before archiving existed gain archive_location, which the archival sets on the stubs of the
+-- versions it moved to object storage. Entity tables are named after their schema, so they are found by their columns;
+-- partitions inherit the column from their parent.
+DO $$
+DECLARE
+    entity_table TEXT;
+BEGIN
+    FOR entity_table IN
+        SELECT c.relname
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = current_schema()
+          AND c.relkind IN ('r', 'p')
+          AND NOT c.relispartition
+          AND (
+              SELECT count(*)
+              FROM pg_attribute a
+              WHERE a.attrelid = c.oid
+                AND NOT a.attisdropped
+                AND a.attname IN ('entity_id', 'entity_version', 'schema_version', 'payload', 'hash', 'is_active')
+          ) = 6
+    LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS archive_location TEXT NULL', entity_table);
+    END LOOP;
+END
+$$;
@@ -0,0 +1,3 @@
+-- Erasure requests are run by a privacy.erasure job of the same id on the job pool, which schedules the retries;
+-- next_attempt_at is no longer read, so its index goes.
+DROP INDEX IF EXISTS privacy_erasure_requests_due_idx;
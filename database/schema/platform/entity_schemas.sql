@@ -31,8 +31,12 @@ CREATE TABLE IF NOT EXISTS schema_repository (
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     created_by TEXT,
     is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    deleted_at TIMESTAMPTZ,
     is_active BOOLEAN NOT NULL DEFAULT FALSE,
-    PRIMARY KEY (schema_id, schema_version)
+    compatibility JSONB,
+    status TEXT NOT NULL DEFAULT 'published' CHECK (status IN ('draft', 'published')),
+    PRIMARY KEY (schema_id, schema_version),
+    CONSTRAINT schema_repository_draft_inactive CHECK (status = 'published' OR NOT is_active)
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS schema_repository_active_schema_idx
@@ -50,3 +54,14 @@ CREATE UNIQUE INDEX IF NOT EXISTS schema_repository_slug_idx
 CREATE INDEX IF NOT EXISTS schema_repository_category_idx
     ON schema_repository(category_id)
     WHERE NOT is_deleted;
+
+-- Previous slugs of renamed schemas keep resolving to the schema they used to name.
+CREATE TABLE IF NOT EXISTS schema_slug_aliases (
+    slug TEXT PRIMARY KEY CHECK (slug ~ '^[a-z0-9]+(?:-[a-z0-9]+)*$'),
+    schema_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by TEXT
+);
+
+CREATE INDEX IF NOT EXISTS schema_slug_aliases_schema_idx
+    ON schema_slug_aliases(schema_id);
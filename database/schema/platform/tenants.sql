@@ -18,6 +18,16 @@ CREATE TABLE IF NOT EXISTS tenants (
     auth_ready BOOLEAN NOT NULL DEFAULT FALSE,
     last_provisioned_at TIMESTAMPTZ NULL,
     last_error TEXT NULL,
+    max_entities BIGINT NULL CHECK (max_entities >= 0),
+    max_schemas BIGINT NULL CHECK (max_schemas >= 0),
+    max_storage_bytes BIGINT NULL CHECK (max_storage_bytes >= 0),
+    max_users BIGINT NULL CHECK (max_users >= 0),
+    max_object_bytes BIGINT NULL CHECK (max_object_bytes >= 0),
+    cors_origins TEXT[] NOT NULL DEFAULT '{}',
+    email_from_name TEXT NULL,
+    email_from_address TEXT NULL,
+    email_reply_to TEXT NULL,
+    notify_email TEXT NULL,
     PRIMARY KEY (tenant_id, tenant_version)
 );
 
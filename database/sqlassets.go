@@ -1,6 +1,6 @@
 package sqlassets
 
-import _ "embed"
+import "embed"
 
 //go:embed schema/tenant_space/users.sql
 var UsersSQL string
@@ -10,3 +10,9 @@ var EntitySchemasSQL string
 
 //go:embed schema/platform/tenants.sql
 var TenantsSQL string
+
+// Migrations holds the versioned migrations: migrations/admin for the admin schema and migrations/tenant for every
+// tenant schema (the admin schema included).
+//
+//go:embed migrations/admin/*.sql migrations/tenant/*.sql
+var Migrations embed.FS
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/attachments/be/service"
+	attachments "github.com/zenGate-Global/palmyra-pro-saas/generated/go/attachments"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	createOperation operation = "attachmentsCreate"
+	listOperation   operation = "attachmentsList"
+	getOperation    operation = "attachmentsGet"
+	deleteOperation operation = "attachmentsDelete"
+)
+
+// Handler wires the attachments service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("attachments service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) AttachmentsList(ctx context.Context, request attachments.AttachmentsListRequestObject) (attachments.AttachmentsListResponseObject, error) {
+	page := pagination.FromQuery(request.Params.Page, request.Params.PageSize)
+	opts := service.ListOptions{Page: page.Page, PageSize: page.PageSize}
+
+	result, err := h.svc.List(ctx, h.audit(ctx), string(request.TableName), string(request.EntityId), opts)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, listOperation)
+		return attachments.AttachmentsListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]attachments.Attachment, 0, len(result.Attachments))
+	for _, attachment := range result.Attachments {
+		items = append(items, toAPIAttachment(attachment))
+	}
+
+	return attachments.AttachmentsList200JSONResponse{
+		Items:      items,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (h *Handler) AttachmentsCreate(ctx context.Context, request attachments.AttachmentsCreateRequestObject) (attachments.AttachmentsCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return attachments.AttachmentsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	input := service.CreateInput{
+		FileName:    request.Body.FileName,
+		ContentType: request.Body.ContentType,
+		SizeBytes:   request.Body.SizeBytes,
+	}
+
+	created, err := h.svc.Create(ctx, h.audit(ctx), string(request.TableName), string(request.EntityId), input)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, createOperation)
+		return attachments.AttachmentsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	attachment := toAPIAttachment(created.Attachment)
+	location := fmt.Sprintf("/api/v1/entities/%s/documents/%s/attachments/%s", created.TableName, url.PathEscape(created.EntityID), created.ID.String())
+
+	return attachments.AttachmentsCreate201JSONResponse{
+		Headers: attachments.AttachmentsCreate201ResponseHeaders{Location: location},
+		Body: attachments.AttachmentUpload{
+			AttachmentId:  attachment.AttachmentId,
+			TableName:     attachment.TableName,
+			EntityId:      attachment.EntityId,
+			EntityVersion: attachment.EntityVersion,
+			FileName:      attachment.FileName,
+			ContentType:   attachment.ContentType,
+			SizeBytes:     attachment.SizeBytes,
+			CreatedAt:     attachment.CreatedAt,
+			UploadUrl:     created.URL,
+			ExpiresAt:     externalRef2.Timestamp(created.ExpiresAt),
+		},
+	}, nil
+}
+
+func (h *Handler) AttachmentsGet(ctx context.Context, request attachments.AttachmentsGetRequestObject) (attachments.AttachmentsGetResponseObject, error) {
+	signed, err := h.svc.Get(ctx, h.audit(ctx), string(request.TableName), string(request.EntityId), uuid.UUID(request.AttachmentId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return attachments.AttachmentsGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	attachment := toAPIAttachment(signed.Attachment)
+	return attachments.AttachmentsGet200JSONResponse{
+		AttachmentId:  attachment.AttachmentId,
+		TableName:     attachment.TableName,
+		EntityId:      attachment.EntityId,
+		EntityVersion: attachment.EntityVersion,
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		SizeBytes:     attachment.SizeBytes,
+		CreatedAt:     attachment.CreatedAt,
+		DownloadUrl:   signed.URL,
+		ExpiresAt:     externalRef2.Timestamp(signed.ExpiresAt),
+	}, nil
+}
+
+func (h *Handler) AttachmentsDelete(ctx context.Context, request attachments.AttachmentsDeleteRequestObject) (attachments.AttachmentsDeleteResponseObject, error) {
+	if err := h.svc.Delete(ctx, h.audit(ctx), string(request.TableName), string(request.EntityId), uuid.UUID(request.AttachmentId)); err != nil {
+		status, problem := h.problemForError(ctx, err, deleteOperation)
+		return attachments.AttachmentsDeletedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return attachments.AttachmentsDelete204Response{}, nil
+}
+
+func toAPIAttachment(attachment service.Attachment) attachments.Attachment {
+	return attachments.Attachment{
+		AttachmentId:  externalRef2.UUID(attachment.ID),
+		TableName:     externalRef2.TableName(attachment.TableName),
+		EntityId:      externalRef2.EntityIdentifier(attachment.EntityID),
+		EntityVersion: externalRef2.SemanticVersion(attachment.EntityVersion.String()),
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		SizeBytes:     attachment.SizeBytes,
+		CreatedAt:     externalRef2.Timestamp(attachment.CreatedAt),
+	}
+}
+
+// errorCatalog maps attachments service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("attachments")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation("one or more fields are invalid", err.Fields)
+	})
+	c.Register(service.ErrNotFound, problems.NotFound("attachment not found"))
+	c.Register(service.ErrDocumentNotFound, problems.NotFound("document not found"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
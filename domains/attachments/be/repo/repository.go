@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Repository defines the tenant-scoped persistence operations required by the attachments API.
+type Repository interface {
+	Create(ctx context.Context, params persistence.CreateAttachmentParams) (persistence.EntityAttachment, error)
+	List(ctx context.Context, tableName, entityID string, limit, offset int) ([]persistence.EntityAttachment, int, error)
+	Get(ctx context.Context, tableName, entityID string, id uuid.UUID) (persistence.EntityAttachment, error)
+	Delete(ctx context.Context, tableName, entityID string, id uuid.UUID) error
+	// ObjectPath resolves a tenant-relative object key below the caller's storage prefix.
+	ObjectPath(ctx context.Context, logicalKey string) (string, error)
+}
+
+type postgresRepository struct {
+	store *persistence.AttachmentStore
+}
+
+// NewPostgresRepository constructs a repository backed by the shared persistence layer.
+func NewPostgresRepository(store *persistence.AttachmentStore) Repository {
+	if store == nil {
+		panic("attachment store is required")
+	}
+	return &postgresRepository{store: store}
+}
+
+func (r *postgresRepository) Create(ctx context.Context, params persistence.CreateAttachmentParams) (persistence.EntityAttachment, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.EntityAttachment{}, err
+	}
+	return r.store.CreateAttachment(ctx, space, params)
+}
+
+func (r *postgresRepository) List(ctx context.Context, tableName, entityID string, limit, offset int) ([]persistence.EntityAttachment, int, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return r.store.ListAttachments(ctx, space, tableName, entityID, limit, offset)
+}
+
+func (r *postgresRepository) Get(ctx context.Context, tableName, entityID string, id uuid.UUID) (persistence.EntityAttachment, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.EntityAttachment{}, err
+	}
+	return r.store.GetAttachment(ctx, space, tableName, entityID, id)
+}
+
+func (r *postgresRepository) Delete(ctx context.Context, tableName, entityID string, id uuid.UUID) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.store.DeleteAttachment(ctx, space, tableName, entityID, id)
+}
+
+func (r *postgresRepository) ObjectPath(ctx context.Context, logicalKey string) (string, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return "", err
+	}
+	return storage.ResolveObjectPath(space, logicalKey)
+}
+
+func requireTenantSpace(ctx context.Context) (tenant.Space, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return tenant.Space{}, errors.New("tenant space missing from context")
+	}
+	return space, nil
+}
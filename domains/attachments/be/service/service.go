@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/attachments/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+)
+
+// FieldErrors maps request fields to validation issues.
+type FieldErrors map[string][]string
+
+func (f FieldErrors) add(field, message string) {
+	f[field] = append(f[field], message)
+}
+
+// ValidationError is returned when the input payload is invalid.
+type ValidationError struct {
+	Fields FieldErrors
+}
+
+func (v *ValidationError) Error() string {
+	return "validation error"
+}
+
+// ErrNotFound is returned when the attachment does not exist on the document.
+var ErrNotFound = errors.New("attachment not found")
+
+// ErrDocumentNotFound is returned when the table or the document the attachment belongs to does not exist.
+var ErrDocumentNotFound = errors.New("document not found")
+
+// EntityLookup resolves the active version of the document an attachment is created for.
+type EntityLookup interface {
+	Get(ctx context.Context, tableName string, entityID string) (persistence.EntityRecord, error)
+}
+
+// Config tunes the attachments service. Zero values fall back to defaults: URLs valid for 15 minutes, files up to
+// 25 MiB, and images and PDFs only. ContentTypes entries may end in "/*" to allow a whole top-level type.
+type Config struct {
+	URLTTL       time.Duration
+	MaxBytes     int64
+	ContentTypes []string
+}
+
+// Attachment represents the domain view of an attachment.
+type Attachment struct {
+	ID            uuid.UUID
+	TableName     string
+	EntityID      string
+	EntityVersion persistence.SemanticVersion
+	FileName      string
+	ContentType   string
+	SizeBytes     int64
+	CreatedAt     time.Time
+}
+
+// SignedAttachment carries an attachment together with a signed upload or download URL.
+type SignedAttachment struct {
+	Attachment
+	URL       string
+	ExpiresAt time.Time
+}
+
+// CreateInput represents the payload required to create an attachment upload.
+type CreateInput struct {
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+}
+
+// ListOptions controls pagination.
+type ListOptions struct {
+	Page     int
+	PageSize int
+}
+
+// ListResult wraps a page of attachments with pagination metadata.
+type ListResult struct {
+	Attachments []Attachment
+	pagination.Envelope
+}
+
+// Service defines the business operations for the attachments domain.
+type Service interface {
+	Create(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, input CreateInput) (SignedAttachment, error)
+	List(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, opts ListOptions) (ListResult, error)
+	Get(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, id uuid.UUID) (SignedAttachment, error)
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, id uuid.UUID) error
+}
+
+type service struct {
+	repo     repo.Repository
+	entities EntityLookup
+	objects  storage.SignedStore
+	cfg      Config
+	now      func() time.Time
+}
+
+// New constructs an attachments Service with defaults applied to cfg.
+func New(r repo.Repository, entities EntityLookup, objects storage.SignedStore, cfg Config) Service {
+	if r == nil {
+		panic("attachments repository is required")
+	}
+	if entities == nil {
+		panic("entity lookup is required")
+	}
+	if objects == nil {
+		panic("signed object store is required")
+	}
+
+	if cfg.URLTTL <= 0 {
+		cfg.URLTTL = 15 * time.Minute
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = 25 << 20
+	}
+	if len(cfg.ContentTypes) == 0 {
+		cfg.ContentTypes = []string{"image/*", "application/pdf"}
+	}
+
+	return &service{repo: r, entities: entities, objects: objects, cfg: cfg, now: time.Now}
+}
+
+func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, input CreateInput) (SignedAttachment, error) {
+	fieldErrors := FieldErrors{}
+
+	fileName := strings.TrimSpace(input.FileName)
+	if err := validateFileName(fileName); err != nil {
+		fieldErrors.add("fileName", err.Error())
+	}
+
+	contentType, err := s.normalizeContentType(input.ContentType)
+	if err != nil {
+		fieldErrors.add("contentType", err.Error())
+	}
+
+	switch {
+	case input.SizeBytes < 0:
+		fieldErrors.add("sizeBytes", "must not be negative")
+	case input.SizeBytes > s.cfg.MaxBytes:
+		fieldErrors.add("sizeBytes", fmt.Sprintf("must not exceed %d bytes", s.cfg.MaxBytes))
+	}
+
+	if len(fieldErrors) > 0 {
+		return SignedAttachment{}, &ValidationError{Fields: fieldErrors}
+	}
+
+	// The attachment records the entity's current version, so it is read from the primary.
+	entity, err := s.entities.Get(persistence.WithPrimaryReads(ctx), tableName, entityID)
+	if err != nil {
+		return SignedAttachment{}, mapPersistenceError(err)
+	}
+
+	// The key avoids the client-supplied entity id, which may contain path separators.
+	id := uuid.New()
+	objectPath, err := s.repo.ObjectPath(ctx, fmt.Sprintf("entities/%s/attachments/%s/%s", tableName, id, fileName))
+	if err != nil {
+		return SignedAttachment{}, err
+	}
+
+	// Sign before recording the row so a signing failure leaves nothing behind.
+	expiresAt := s.now().Add(s.cfg.URLTTL)
+	uploadURL, err := s.objects.SignedURL(ctx, objectPath, storage.SignedURLOptions{Method: http.MethodPut, ContentType: contentType, Expires: expiresAt})
+	if err != nil {
+		return SignedAttachment{}, err
+	}
+
+	record, err := s.repo.Create(ctx, persistence.CreateAttachmentParams{
+		AttachmentID:  id,
+		TableName:     tableName,
+		EntityID:      entity.EntityID,
+		EntityVersion: entity.EntityVersion,
+		FileName:      fileName,
+		ContentType:   contentType,
+		SizeBytes:     input.SizeBytes,
+		ObjectPath:    objectPath,
+		CreatedBy:     audit.UserID,
+	})
+	if err != nil {
+		return SignedAttachment{}, mapPersistenceError(err)
+	}
+
+	return SignedAttachment{Attachment: mapAttachment(record), URL: uploadURL, ExpiresAt: expiresAt}, nil
+}
+
+func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, opts ListOptions) (ListResult, error) { //nolint:revive // audit reserved for persistence layer wiring
+	page := pagination.New(opts.Page, opts.PageSize)
+
+	normalized, err := persistence.NormalizeEntityIdentifier(entityID)
+	if err != nil {
+		return ListResult{}, ErrDocumentNotFound
+	}
+
+	records, total, err := s.repo.List(ctx, tableName, normalized, page.Limit(), page.Offset())
+	if err != nil {
+		return ListResult{}, mapPersistenceError(err)
+	}
+
+	attachments := make([]Attachment, 0, len(records))
+	for _, record := range records {
+		attachments = append(attachments, mapAttachment(record))
+	}
+
+	return ListResult{Attachments: attachments, Envelope: page.Envelope(total)}, nil
+}
+
+func (s *service) Get(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, id uuid.UUID) (SignedAttachment, error) { //nolint:revive // audit reserved for persistence layer wiring
+	record, err := s.get(ctx, tableName, entityID, id)
+	if err != nil {
+		return SignedAttachment{}, err
+	}
+
+	expiresAt := s.now().Add(s.cfg.URLTTL)
+	downloadURL, err := s.objects.SignedURL(ctx, record.ObjectPath, storage.SignedURLOptions{Method: http.MethodGet, ContentType: record.ContentType, Expires: expiresAt})
+	if err != nil {
+		return SignedAttachment{}, err
+	}
+
+	return SignedAttachment{Attachment: mapAttachment(record), URL: downloadURL, ExpiresAt: expiresAt}, nil
+}
+
+func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, id uuid.UUID) error { //nolint:revive // audit reserved for persistence layer wiring
+	// Read from the primary so an attachment created just before can be deleted.
+	record, err := s.get(persistence.WithPrimaryReads(ctx), tableName, entityID, id)
+	if err != nil {
+		return err
+	}
+
+	// The file goes first: if removing it fails the metadata stays and the delete can be retried.
+	if err := s.objects.Delete(ctx, record.ObjectPath); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, record.TableName, record.EntityID, record.AttachmentID); err != nil {
+		return mapPersistenceError(err)
+	}
+
+	return nil
+}
+
+func (s *service) get(ctx context.Context, tableName, entityID string, id uuid.UUID) (persistence.EntityAttachment, error) {
+	if id == uuid.Nil {
+		return persistence.EntityAttachment{}, ErrNotFound
+	}
+	normalized, err := persistence.NormalizeEntityIdentifier(entityID)
+	if err != nil {
+		return persistence.EntityAttachment{}, ErrNotFound
+	}
+
+	record, err := s.repo.Get(ctx, tableName, normalized, id)
+	if err != nil {
+		return persistence.EntityAttachment{}, mapPersistenceError(err)
+	}
+	return record, nil
+}
+
+// normalizeContentType parses raw as a MIME type, drops its parameters and checks it against the allowed types.
+func (s *service) normalizeContentType(raw string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(raw))
+	if err != nil || !strings.Contains(mediaType, "/") {
+		return "", errors.New("must be a MIME type such as image/png")
+	}
+	for _, allowed := range s.cfg.ContentTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*"))) {
+			return mediaType, nil
+		}
+	}
+	return "", fmt.Errorf("content type %q is not allowed", mediaType)
+}
+
+func validateFileName(name string) error {
+	if name == "" {
+		return errors.New("fileName is required")
+	}
+	if len(name) > 255 {
+		return errors.New("must be at most 255 bytes")
+	}
+	if name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return errors.New("must be a plain file name without directories")
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return errors.New("must not contain control characters")
+		}
+	}
+	return nil
+}
+
+func mapAttachment(record persistence.EntityAttachment) Attachment {
+	return Attachment{
+		ID:            record.AttachmentID,
+		TableName:     record.TableName,
+		EntityID:      record.EntityID,
+		EntityVersion: record.EntityVersion,
+		FileName:      record.FileName,
+		ContentType:   record.ContentType,
+		SizeBytes:     record.SizeBytes,
+		CreatedAt:     record.CreatedAt,
+	}
+}
+
+func mapPersistenceError(err error) error {
+	switch {
+	case errors.Is(err, persistence.ErrAttachmentNotFound):
+		return ErrNotFound
+	case errors.Is(err, persistence.ErrEntityNotFound), errors.Is(err, persistence.ErrSchemaNotFound):
+		return ErrDocumentNotFound
+	default:
+		var idErr *persistence.InvalidEntityIdentifierError
+		if errors.As(err, &idErr) {
+			return ErrDocumentNotFound
+		}
+		return err
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+)
+
+type mockRepository struct {
+	createFn func(ctx context.Context, params persistence.CreateAttachmentParams) (persistence.EntityAttachment, error)
+	getFn    func(ctx context.Context, tableName, entityID string, id uuid.UUID) (persistence.EntityAttachment, error)
+	deleteFn func(ctx context.Context, tableName, entityID string, id uuid.UUID) error
+}
+
+func (m *mockRepository) Create(ctx context.Context, params persistence.CreateAttachmentParams) (persistence.EntityAttachment, error) {
+	if m.createFn == nil {
+		panic("createFn not configured")
+	}
+	return m.createFn(ctx, params)
+}
+
+func (m *mockRepository) List(context.Context, string, string, int, int) ([]persistence.EntityAttachment, int, error) {
+	panic("List not configured")
+}
+
+func (m *mockRepository) Get(ctx context.Context, tableName, entityID string, id uuid.UUID) (persistence.EntityAttachment, error) {
+	if m.getFn == nil {
+		panic("getFn not configured")
+	}
+	return m.getFn(ctx, tableName, entityID, id)
+}
+
+func (m *mockRepository) Delete(ctx context.Context, tableName, entityID string, id uuid.UUID) error {
+	if m.deleteFn == nil {
+		panic("deleteFn not configured")
+	}
+	return m.deleteFn(ctx, tableName, entityID, id)
+}
+
+func (m *mockRepository) ObjectPath(_ context.Context, logicalKey string) (string, error) {
+	return "dev/acme/" + logicalKey, nil
+}
+
+type mockEntities struct {
+	getFn func(ctx context.Context, tableName, entityID string) (persistence.EntityRecord, error)
+}
+
+func (m *mockEntities) Get(ctx context.Context, tableName, entityID string) (persistence.EntityRecord, error) {
+	if m.getFn == nil {
+		panic("getFn not configured")
+	}
+	return m.getFn(ctx, tableName, entityID)
+}
+
+type mockSigned struct {
+	signed  []storage.SignedURLOptions
+	deleted []string
+}
+
+func (m *mockSigned) SignedURL(_ context.Context, fullPath string, opts storage.SignedURLOptions) (string, error) {
+	m.signed = append(m.signed, opts)
+	return "https://signed.example/" + fullPath + "?method=" + opts.Method, nil
+}
+
+func (m *mockSigned) Delete(_ context.Context, fullPath string) error {
+	m.deleted = append(m.deleted, fullPath)
+	return nil
+}
+
+func TestServiceCreateValidation(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{}, &mockEntities{}, &mockSigned{}, Config{MaxBytes: 10})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Create(context.Background(), audit, "cards", "card-1", CreateInput{
+		FileName:    "../secret.png",
+		ContentType: "text/html",
+		SizeBytes:   11,
+	})
+	require.Error(t, err)
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, validationErr.Fields, "fileName")
+	require.Contains(t, validationErr.Fields, "contentType")
+	require.Contains(t, validationErr.Fields, "sizeBytes")
+}
+
+func TestServiceCreateSignsUpload(t *testing.T) {
+	t.Parallel()
+
+	var captured persistence.CreateAttachmentParams
+	repo := &mockRepository{
+		createFn: func(_ context.Context, params persistence.CreateAttachmentParams) (persistence.EntityAttachment, error) {
+			captured = params
+			return persistence.EntityAttachment{
+				AttachmentID:  params.AttachmentID,
+				TableName:     params.TableName,
+				EntityID:      params.EntityID,
+				EntityVersion: params.EntityVersion,
+				FileName:      params.FileName,
+				ContentType:   params.ContentType,
+				SizeBytes:     params.SizeBytes,
+				ObjectPath:    params.ObjectPath,
+				CreatedAt:     time.Now(),
+			}, nil
+		},
+	}
+	entities := &mockEntities{
+		getFn: func(_ context.Context, tableName, entityID string) (persistence.EntityRecord, error) {
+			require.Equal(t, "cards", tableName)
+			return persistence.EntityRecord{EntityID: entityID, EntityVersion: persistence.SemanticVersion{Major: 1, Minor: 2}}, nil
+		},
+	}
+	signed := &mockSigned{}
+	svc := New(repo, entities, signed, Config{})
+
+	created, err := svc.Create(context.Background(), requesttrace.Anonymous("test"), "cards", "card-1", CreateInput{
+		FileName:    "photo.png",
+		ContentType: "Image/PNG; charset=binary",
+		SizeBytes:   42,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", created.ContentType)
+	require.Equal(t, "1.2.0", created.EntityVersion.String())
+	require.True(t, strings.HasPrefix(captured.ObjectPath, "dev/acme/entities/cards/attachments/"+created.ID.String()+"/"))
+	require.True(t, strings.HasSuffix(captured.ObjectPath, "/photo.png"))
+	require.Len(t, signed.signed, 1)
+	require.Equal(t, http.MethodPut, signed.signed[0].Method)
+	require.Equal(t, "image/png", signed.signed[0].ContentType)
+	require.Equal(t, created.ExpiresAt, signed.signed[0].Expires)
+	require.Contains(t, created.URL, captured.ObjectPath)
+}
+
+func TestServiceCreateUnknownDocument(t *testing.T) {
+	t.Parallel()
+
+	entities := &mockEntities{
+		getFn: func(context.Context, string, string) (persistence.EntityRecord, error) {
+			return persistence.EntityRecord{}, persistence.ErrEntityNotFound
+		},
+	}
+	svc := New(&mockRepository{}, entities, &mockSigned{}, Config{})
+
+	_, err := svc.Create(context.Background(), requesttrace.Anonymous("test"), "cards", "card-1", CreateInput{
+		FileName:    "doc.pdf",
+		ContentType: "application/pdf",
+		SizeBytes:   1,
+	})
+	require.ErrorIs(t, err, ErrDocumentNotFound)
+}
+
+func TestServiceDeleteRemovesObjectThenRow(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	signed := &mockSigned{}
+	var deletedRow bool
+	repo := &mockRepository{
+		getFn: func(_ context.Context, tableName, entityID string, got uuid.UUID) (persistence.EntityAttachment, error) {
+			require.Equal(t, id, got)
+			return persistence.EntityAttachment{AttachmentID: id, TableName: tableName, EntityID: entityID, ObjectPath: "dev/acme/file.pdf"}, nil
+		},
+		deleteFn: func(context.Context, string, string, uuid.UUID) error {
+			require.Equal(t, []string{"dev/acme/file.pdf"}, signed.deleted)
+			deletedRow = true
+			return nil
+		},
+	}
+	svc := New(repo, &mockEntities{}, signed, Config{})
+
+	require.NoError(t, svc.Delete(context.Background(), requesttrace.Anonymous("test"), "cards", "card-1", id))
+	require.True(t, deletedRow)
+
+	repo.getFn = func(context.Context, string, string, uuid.UUID) (persistence.EntityAttachment, error) {
+		return persistence.EntityAttachment{}, persistence.ErrAttachmentNotFound
+	}
+	require.ErrorIs(t, svc.Delete(context.Background(), requesttrace.Anonymous("test"), "cards", "card-1", id), ErrNotFound)
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/service"
+	authapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/auth"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	meTenantsOperation operation = "authMeTenants"
+	signupOperation    operation = "authSignup"
+	loginOperation     operation = "authLogin"
+	refreshOperation   operation = "authRefresh"
+	logoutOperation    operation = "authLogout"
+	tokenOperation     operation = "authToken"
+)
+
+// Handler wires the auth service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("auth service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+func (h *Handler) AuthMeTenants(ctx context.Context, _ authapi.AuthMeTenantsRequestObject) (authapi.AuthMeTenantsResponseObject, error) {
+	creds, _ := platformauth.UserFromContext(ctx)
+
+	tenants, err := h.svc.ListTenants(ctx, h.audit(ctx), creds)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, meTenantsOperation)
+		return authapi.AuthMeTenantsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]authapi.TenantMembership, 0, len(tenants))
+	for _, t := range tenants {
+		items = append(items, toAPITenant(t))
+	}
+	return authapi.AuthMeTenants200JSONResponse{Items: items}, nil
+}
+
+func (h *Handler) AuthToken(ctx context.Context, request authapi.AuthTokenRequestObject) (authapi.AuthTokenResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return authapi.AuthTokendefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	token, err := h.svc.IssueServiceToken(ctx, h.audit(ctx), request.Body.Key)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, tokenOperation)
+		return authapi.AuthTokendefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return authapi.AuthToken200JSONResponse{
+		AccessToken: token.AccessToken,
+		TokenType:   authapi.Bearer,
+		ExpiresIn:   int(time.Until(token.ExpiresAt).Seconds()),
+		ExpiresAt:   externalRef1.Timestamp(token.ExpiresAt),
+	}, nil
+}
+
+// The password flows belong to the identity provider (Firebase / Identity Platform); the API only verifies its
+// tokens, so these operations answer 501.
+
+func (h *Handler) AuthSignup(ctx context.Context, _ authapi.AuthSignupRequestObject) (authapi.AuthSignupResponseObject, error) {
+	status, problem := h.problemForError(ctx, service.ErrNotImplemented, signupOperation)
+	return authapi.AuthSignupdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+}
+
+func (h *Handler) AuthLogin(ctx context.Context, _ authapi.AuthLoginRequestObject) (authapi.AuthLoginResponseObject, error) {
+	status, problem := h.problemForError(ctx, service.ErrNotImplemented, loginOperation)
+	return authapi.AuthLogindefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+}
+
+func (h *Handler) AuthRefresh(ctx context.Context, _ authapi.AuthRefreshRequestObject) (authapi.AuthRefreshResponseObject, error) {
+	status, problem := h.problemForError(ctx, service.ErrNotImplemented, refreshOperation)
+	return authapi.AuthRefreshdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+}
+
+func (h *Handler) AuthLogout(ctx context.Context, _ authapi.AuthLogoutRequestObject) (authapi.AuthLogoutResponseObject, error) {
+	status, problem := h.problemForError(ctx, service.ErrNotImplemented, logoutOperation)
+	return authapi.AuthLogoutdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+}
+
+func toAPITenant(t service.Tenant) authapi.TenantMembership {
+	out := authapi.TenantMembership{
+		TenantId:    externalRef1.UUID(t.TenantID),
+		Slug:        t.Slug,
+		DisplayName: t.DisplayName,
+		Status:      t.Status,
+		Roles:       t.Roles,
+		Current:     t.Current,
+	}
+	if t.UserID != nil {
+		userID := externalRef1.UUID(*t.UserID)
+		out.UserId = &userID
+	}
+	return out
+}
+
+// errorCatalog maps auth service errors to problems.
+var errorCatalog = problems.NewCatalog("auth").
+	Register(service.ErrUnauthenticated, problems.Unauthorized("missing credentials")).
+	RegisterDetail(service.ErrNotImplemented, problems.New(http.StatusNotImplemented, "Not implemented", problems.TypeNotImplemented, "")).
+	Register(service.ErrInvalidServiceAccountKey, problems.Unauthorized("invalid service account key")).
+	Register(service.ErrServiceTokensUnavailable, problems.NotConfigured("Service tokens unavailable", "no service token signing key is configured")).
+	Register(service.ErrRevocationNotFound, problems.NotFound("token revocation not found")).
+	RegisterDetail(service.ErrInvalidRevocation, problems.Validation("", map[string][]string{"userId": {"exactly one of userId or tokenId is required"}}))
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
+
+var _ authapi.StrictServerInterface = (*Handler)(nil)
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/service"
+	authapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/auth"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	revocationsListOperation   operation = "authRevocationsList"
+	revocationsCreateOperation operation = "authRevocationsCreate"
+	revocationsDeleteOperation operation = "authRevocationsDelete"
+)
+
+func (h *Handler) AuthRevocationsList(ctx context.Context, _ authapi.AuthRevocationsListRequestObject) (authapi.AuthRevocationsListResponseObject, error) {
+	revocations, err := h.svc.ListRevocations(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, revocationsListOperation)
+		return authapi.AuthRevocationsListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]authapi.TokenRevocation, 0, len(revocations))
+	for _, revocation := range revocations {
+		items = append(items, toAPITokenRevocation(revocation))
+	}
+	return authapi.AuthRevocationsList200JSONResponse{Items: items}, nil
+}
+
+func (h *Handler) AuthRevocationsCreate(ctx context.Context, request authapi.AuthRevocationsCreateRequestObject) (authapi.AuthRevocationsCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return authapi.AuthRevocationsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	revocation, err := h.svc.RevokeTokens(ctx, h.audit(ctx), service.RevokeTokensInput{
+		UserID:  request.Body.UserId,
+		TokenID: request.Body.TokenId,
+		Reason:  request.Body.Reason,
+	})
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, revocationsCreateOperation)
+		return authapi.AuthRevocationsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+	return authapi.AuthRevocationsCreate201JSONResponse(toAPITokenRevocation(revocation)), nil
+}
+
+func (h *Handler) AuthRevocationsDelete(ctx context.Context, request authapi.AuthRevocationsDeleteRequestObject) (authapi.AuthRevocationsDeleteResponseObject, error) {
+	if err := h.svc.ClearRevocation(ctx, h.audit(ctx), uuid.UUID(request.RevocationId)); err != nil {
+		status, problem := h.problemForError(ctx, err, revocationsDeleteOperation)
+		return authapi.AuthRevocationsDeletedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+	return authapi.AuthRevocationsDelete204Response{}, nil
+}
+
+func toAPITokenRevocation(revocation service.TokenRevocation) authapi.TokenRevocation {
+	return authapi.TokenRevocation{
+		Id:        externalRef1.UUID(revocation.ID),
+		Kind:      authapi.TokenRevocationKind(revocation.Kind),
+		Subject:   revocation.Subject,
+		Reason:    revocation.Reason,
+		RevokedBy: revocation.RevokedBy,
+		RevokedAt: externalRef1.Timestamp(revocation.RevokedAt),
+		ExpiresAt: externalRef1.Timestamp(revocation.ExpiresAt),
+	}
+}
@@ -0,0 +1,50 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Repository defines the persistence operations required by the auth service.
+type Repository interface {
+	ListMembershipTenants(ctx context.Context, email string, homeTenantID *uuid.UUID) ([]persistence.MembershipTenant, error)
+	CreateTokenRevocation(ctx context.Context, params persistence.CreateTokenRevocationParams) (persistence.TokenRevocation, error)
+	ListTokenRevocations(ctx context.Context, now time.Time) ([]persistence.TokenRevocation, error)
+	DeleteTokenRevocation(ctx context.Context, id uuid.UUID) error
+}
+
+type postgresRepository struct {
+	memberships *persistence.MembershipStore
+	revocations *persistence.TokenRevocationStore
+}
+
+// NewPostgresRepository constructs a repository backed by the admin-schema membership and revocation stores.
+func NewPostgresRepository(memberships *persistence.MembershipStore, revocations *persistence.TokenRevocationStore) Repository {
+	if memberships == nil {
+		panic("membership store is required")
+	}
+	if revocations == nil {
+		panic("token revocation store is required")
+	}
+	return &postgresRepository{memberships: memberships, revocations: revocations}
+}
+
+func (r *postgresRepository) ListMembershipTenants(ctx context.Context, email string, homeTenantID *uuid.UUID) ([]persistence.MembershipTenant, error) {
+	return r.memberships.ListMembershipTenants(ctx, email, homeTenantID)
+}
+
+func (r *postgresRepository) CreateTokenRevocation(ctx context.Context, params persistence.CreateTokenRevocationParams) (persistence.TokenRevocation, error) {
+	return r.revocations.CreateTokenRevocation(ctx, params)
+}
+
+func (r *postgresRepository) ListTokenRevocations(ctx context.Context, now time.Time) ([]persistence.TokenRevocation, error) {
+	return r.revocations.ListTokenRevocations(ctx, now)
+}
+
+func (r *postgresRepository) DeleteTokenRevocation(ctx context.Context, id uuid.UUID) error {
+	return r.revocations.DeleteTokenRevocation(ctx, id)
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/repo"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// Revocation sentinel errors.
+var (
+	ErrRevocationNotFound = errors.New("token revocation not found")
+	// ErrInvalidRevocation is returned unless exactly one of the user id and the token id is given.
+	ErrInvalidRevocation = errors.New("exactly one of userId or tokenId is required")
+)
+
+// defaultRevocationRetention keeps revocations well past the lifetime of identity provider tokens (one hour).
+const defaultRevocationRetention = 24 * time.Hour
+
+// RevocationCache is the in-memory revocation list checked by the auth middleware; platformauth.RevocationList
+// implements it.
+type RevocationCache interface {
+	Invalidate()
+}
+
+// RevocationDeps wires the token revocation list. Retention must outlast the tokens being revoked; zero keeps
+// entries for 24 hours.
+type RevocationDeps struct {
+	Cache     RevocationCache
+	Retention time.Duration
+}
+
+// TokenRevocation is an entry of the revocation list.
+type TokenRevocation struct {
+	ID        uuid.UUID
+	Kind      string
+	Subject   string
+	Reason    *string
+	RevokedBy *string
+	RevokedAt time.Time
+	ExpiresAt time.Time
+}
+
+// RevokeTokensInput names the tokens to revoke: every token of UserID, or the one token with the TokenID jti.
+type RevokeTokensInput struct {
+	UserID  *string
+	TokenID *string
+	Reason  *string
+}
+
+// RevokeTokens records a revocation and drops the cached list so it applies to the next request.
+func (s *service) RevokeTokens(ctx context.Context, audit requesttrace.AuditInfo, input RevokeTokensInput) (TokenRevocation, error) {
+	userID, tokenID := trimmedOrEmpty(input.UserID), trimmedOrEmpty(input.TokenID)
+	params := persistence.CreateTokenRevocationParams{
+		Reason:    input.Reason,
+		RevokedBy: audit.UserID,
+		RevokedAt: s.now().UTC(),
+	}
+	switch {
+	case userID != "" && tokenID == "":
+		params.Kind, params.Subject = platformauth.RevocationKindUser, userID
+	case tokenID != "" && userID == "":
+		params.Kind, params.Subject = platformauth.RevocationKindToken, tokenID
+	default:
+		return TokenRevocation{}, ErrInvalidRevocation
+	}
+	params.ExpiresAt = params.RevokedAt.Add(s.revocations.Retention)
+
+	record, err := s.repo.CreateTokenRevocation(ctx, params)
+	if err != nil {
+		return TokenRevocation{}, fmt.Errorf("create token revocation: %w", err)
+	}
+	s.invalidateRevocations()
+	return mapTokenRevocation(record), nil
+}
+
+// ListRevocations returns the revocations in force, newest first.
+func (s *service) ListRevocations(ctx context.Context, audit requesttrace.AuditInfo) ([]TokenRevocation, error) { //nolint:revive
+	records, err := s.repo.ListTokenRevocations(ctx, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("list token revocations: %w", err)
+	}
+
+	revocations := make([]TokenRevocation, 0, len(records))
+	for _, record := range records {
+		revocations = append(revocations, mapTokenRevocation(record))
+	}
+	return revocations, nil
+}
+
+// ClearRevocation deletes a revocation; the tokens it covered are accepted again.
+func (s *service) ClearRevocation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error { //nolint:revive
+	err := s.repo.DeleteTokenRevocation(ctx, id)
+	if errors.Is(err, persistence.ErrTokenRevocationNotFound) {
+		return ErrRevocationNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("delete token revocation: %w", err)
+	}
+	s.invalidateRevocations()
+	return nil
+}
+
+func (s *service) invalidateRevocations() {
+	if s.revocations.Cache != nil {
+		s.revocations.Cache.Invalidate()
+	}
+}
+
+// RevocationLoader adapts the repository to platformauth.NewRevocationList.
+func RevocationLoader(r repo.Repository) platformauth.RevocationLoader {
+	if r == nil {
+		panic("auth repository is required")
+	}
+	return func(ctx context.Context) ([]platformauth.Revocation, error) {
+		records, err := r.ListTokenRevocations(ctx, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		revocations := make([]platformauth.Revocation, 0, len(records))
+		for _, record := range records {
+			revocations = append(revocations, platformauth.Revocation{Kind: record.Kind, Subject: record.Subject, RevokedAt: record.RevokedAt})
+		}
+		return revocations, nil
+	}
+}
+
+func mapTokenRevocation(record persistence.TokenRevocation) TokenRevocation {
+	return TokenRevocation{
+		ID:        record.RevocationID,
+		Kind:      record.Kind,
+		Subject:   record.Subject,
+		Reason:    record.Reason,
+		RevokedBy: record.RevokedBy,
+		RevokedAt: record.RevokedAt,
+		ExpiresAt: record.ExpiresAt,
+	}
+}
+
+func trimmedOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return strings.TrimSpace(*value)
+}
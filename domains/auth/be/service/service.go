@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/auth/be/repo"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// Domain sentinel errors.
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	// ErrNotImplemented is returned by the password flows; sign-up and sign-in happen at the identity provider.
+	ErrNotImplemented = errors.New("handled by the identity provider")
+	// ErrInvalidServiceAccountKey is returned when a token is requested with an unknown or revoked key.
+	ErrInvalidServiceAccountKey = errors.New("invalid service account key")
+	// ErrServiceTokensUnavailable is returned when the deployment has no service token signing key.
+	ErrServiceTokensUnavailable = errors.New("service account tokens are not configured")
+)
+
+// Tenant is a tenant the caller can use.
+type Tenant struct {
+	TenantID    uuid.UUID
+	Slug        string
+	DisplayName *string
+	Status      string
+	UserID      *uuid.UUID
+	Roles       []string
+	Current     bool
+}
+
+// Service defines the business operations for the auth domain.
+type Service interface {
+	ListTenants(ctx context.Context, audit requesttrace.AuditInfo, creds *platformauth.UserCredentials) ([]Tenant, error)
+	IssueServiceToken(ctx context.Context, audit requesttrace.AuditInfo, key string) (ServiceToken, error)
+	RevokeTokens(ctx context.Context, audit requesttrace.AuditInfo, input RevokeTokensInput) (TokenRevocation, error)
+	ListRevocations(ctx context.Context, audit requesttrace.AuditInfo) ([]TokenRevocation, error)
+	ClearRevocation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+}
+
+type service struct {
+	repo        repo.Repository
+	tokens      ServiceTokenDeps
+	revocations RevocationDeps
+	now         func() time.Time
+}
+
+// New constructs an auth Service backed by the provided repository. tokens without a Signer disables service
+// account tokens.
+func New(r repo.Repository, tokens ServiceTokenDeps, revocations RevocationDeps) Service {
+	if r == nil {
+		panic("auth repository is required")
+	}
+	if tokens.Signer != nil && tokens.Accounts == nil {
+		panic("service account authenticator is required with a signer")
+	}
+	if revocations.Retention <= 0 {
+		revocations.Retention = defaultRevocationRetention
+	}
+	return &service{repo: r, tokens: tokens, revocations: revocations, now: time.Now}
+}
+
+// ListTenants returns the tenant of the token plus the tenants where the caller's email holds a membership. The
+// email only counts once the identity provider has verified it.
+func (s *service) ListTenants(ctx context.Context, audit requesttrace.AuditInfo, creds *platformauth.UserCredentials) ([]Tenant, error) { //nolint:revive
+	if creds == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	var home *uuid.UUID
+	if creds.TenantID != nil {
+		if id, err := uuid.Parse(*creds.TenantID); err == nil {
+			home = &id
+		}
+	}
+	email := ""
+	if creds.EmailVerified {
+		email = creds.Email
+	}
+
+	records, err := s.repo.ListMembershipTenants(ctx, email, home)
+	if err != nil {
+		return nil, fmt.Errorf("list tenants: %w", err)
+	}
+
+	tenants := make([]Tenant, 0, len(records))
+	for _, record := range records {
+		item := Tenant{
+			TenantID:    record.TenantID,
+			Slug:        record.Slug,
+			DisplayName: record.DisplayName,
+			Status:      record.Status,
+			UserID:      record.UserID,
+			Roles:       record.Roles,
+			Current:     record.Home,
+		}
+		// Without a membership in the home tenant, the token itself describes the caller there.
+		if record.Home && record.UserID == nil {
+			item.Roles = creds.TenantRoles
+			if id, err := uuid.Parse(creds.Id); err == nil {
+				item.UserID = &id
+			}
+		}
+		if item.Roles == nil {
+			item.Roles = []string{}
+		}
+		tenants = append(tenants, item)
+	}
+	return tenants, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type mockRepository struct {
+	listFn        func(ctx context.Context, email string, homeTenantID *uuid.UUID) ([]persistence.MembershipTenant, error)
+	revocations   []persistence.TokenRevocation
+	revocationErr error
+}
+
+func (m *mockRepository) ListMembershipTenants(ctx context.Context, email string, homeTenantID *uuid.UUID) ([]persistence.MembershipTenant, error) {
+	if m.listFn == nil {
+		panic("listFn not configured")
+	}
+	return m.listFn(ctx, email, homeTenantID)
+}
+
+func (m *mockRepository) CreateTokenRevocation(_ context.Context, params persistence.CreateTokenRevocationParams) (persistence.TokenRevocation, error) {
+	record := persistence.TokenRevocation{
+		RevocationID: uuid.New(),
+		Kind:         params.Kind,
+		Subject:      params.Subject,
+		Reason:       params.Reason,
+		RevokedBy:    params.RevokedBy,
+		RevokedAt:    params.RevokedAt,
+		ExpiresAt:    params.ExpiresAt,
+	}
+	m.revocations = append(m.revocations, record)
+	return record, nil
+}
+
+func (m *mockRepository) ListTokenRevocations(_ context.Context, now time.Time) ([]persistence.TokenRevocation, error) {
+	var out []persistence.TokenRevocation
+	for _, record := range m.revocations {
+		if record.ExpiresAt.After(now) {
+			out = append(out, record)
+		}
+	}
+	return out, m.revocationErr
+}
+
+func (m *mockRepository) DeleteTokenRevocation(_ context.Context, id uuid.UUID) error {
+	for i, record := range m.revocations {
+		if record.RevocationID == id {
+			m.revocations = append(m.revocations[:i], m.revocations[i+1:]...)
+			return nil
+		}
+	}
+	return persistence.ErrTokenRevocationNotFound
+}
+
+func TestServiceListTenants(t *testing.T) {
+	t.Parallel()
+
+	homeID := uuid.New()
+	memberTenant := uuid.New()
+	memberUser := uuid.New()
+	tokenUser := uuid.New()
+
+	var gotEmail string
+	repository := &mockRepository{listFn: func(ctx context.Context, email string, home *uuid.UUID) ([]persistence.MembershipTenant, error) {
+		gotEmail = email
+		require.Equal(t, homeID, *home)
+		return []persistence.MembershipTenant{
+			{TenantID: homeID, Slug: "home", Status: "active", Home: true},
+			{TenantID: memberTenant, Slug: "other", Status: "active", UserID: &memberUser, Roles: []string{"editor"}},
+		}, nil
+	}}
+	svc := New(repository, ServiceTokenDeps{}, RevocationDeps{})
+
+	home := homeID.String()
+	creds := &platformauth.UserCredentials{Id: tokenUser.String(), Email: "ann@example.com", TenantID: &home, TenantRoles: []string{"admin"}}
+
+	tenants, err := svc.ListTenants(context.Background(), requesttrace.Anonymous("test"), creds)
+	require.NoError(t, err)
+	require.Empty(t, gotEmail, "unverified emails must not match memberships")
+	require.Len(t, tenants, 2)
+	require.True(t, tenants[0].Current)
+	require.Equal(t, tokenUser, *tenants[0].UserID)
+	require.Equal(t, []string{"admin"}, tenants[0].Roles)
+	require.Equal(t, memberUser, *tenants[1].UserID)
+
+	creds.EmailVerified = true
+	_, err = svc.ListTenants(context.Background(), requesttrace.Anonymous("test"), creds)
+	require.NoError(t, err)
+	require.Equal(t, "ann@example.com", gotEmail)
+
+	_, err = svc.ListTenants(context.Background(), requesttrace.Anonymous("test"), nil)
+	require.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+type stubAccounts map[string]platformauth.ServiceAccount
+
+func (s stubAccounts) AuthenticateServiceAccount(_ context.Context, key string) (platformauth.ServiceAccount, error) {
+	account, ok := s[key]
+	if !ok {
+		return platformauth.ServiceAccount{}, platformauth.ErrInvalidServiceAccountKey
+	}
+	return account, nil
+}
+
+func TestServiceIssueServiceToken(t *testing.T) {
+	t.Parallel()
+
+	audit := requesttrace.Anonymous("test")
+	_, err := New(&mockRepository{}, ServiceTokenDeps{}, RevocationDeps{}).IssueServiceToken(context.Background(), audit, "psa_key")
+	require.ErrorIs(t, err, ErrServiceTokensUnavailable)
+
+	signer, err := platformauth.NewServiceTokenSigner([]byte("0123456789abcdef0123456789abcdef"), time.Hour)
+	require.NoError(t, err)
+	account := platformauth.ServiceAccount{ID: uuid.New(), TenantID: uuid.New(), Scopes: []string{"entities:read"}}
+	svc := New(&mockRepository{}, ServiceTokenDeps{Accounts: stubAccounts{"psa_key": account}, Signer: signer}, RevocationDeps{})
+
+	token, err := svc.IssueServiceToken(context.Background(), audit, "psa_key")
+	require.NoError(t, err)
+	require.NotEmpty(t, token.AccessToken)
+	require.True(t, token.ExpiresAt.After(time.Now()))
+
+	claims, err := signer.Verify(context.Background(), token.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, account.ID.String(), claims["sub"])
+
+	_, err = svc.IssueServiceToken(context.Background(), audit, "psa_unknown")
+	require.ErrorIs(t, err, ErrInvalidServiceAccountKey)
+}
+
+type countingCache struct{ invalidated int }
+
+func (c *countingCache) Invalidate() { c.invalidated++ }
+
+func TestServiceTokenRevocations(t *testing.T) {
+	t.Parallel()
+
+	repository := &mockRepository{}
+	cache := &countingCache{}
+	svc := New(repository, ServiceTokenDeps{}, RevocationDeps{Cache: cache, Retention: time.Hour})
+	admin := "admin-1"
+	audit := requesttrace.AuditInfo{UserID: &admin}
+	ctx := context.Background()
+
+	uid, jti, blank := "uid-1", "jti-1", "  "
+	_, err := svc.RevokeTokens(ctx, audit, RevokeTokensInput{UserID: &uid, TokenID: &jti})
+	require.ErrorIs(t, err, ErrInvalidRevocation)
+	_, err = svc.RevokeTokens(ctx, audit, RevokeTokensInput{UserID: &blank})
+	require.ErrorIs(t, err, ErrInvalidRevocation)
+	require.Zero(t, cache.invalidated)
+
+	revocation, err := svc.RevokeTokens(ctx, audit, RevokeTokensInput{UserID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, platformauth.RevocationKindUser, revocation.Kind)
+	require.Equal(t, uid, revocation.Subject)
+	require.Equal(t, admin, *revocation.RevokedBy)
+	require.Equal(t, time.Hour, revocation.ExpiresAt.Sub(revocation.RevokedAt))
+	require.Equal(t, 1, cache.invalidated)
+
+	loaded, err := RevocationLoader(repository)(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []platformauth.Revocation{{Kind: platformauth.RevocationKindUser, Subject: uid, RevokedAt: revocation.RevokedAt}}, loaded)
+
+	listed, err := svc.ListRevocations(ctx, audit)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	require.NoError(t, svc.ClearRevocation(ctx, audit, revocation.ID))
+	require.Equal(t, 2, cache.invalidated)
+	require.ErrorIs(t, svc.ClearRevocation(ctx, audit, revocation.ID), ErrRevocationNotFound)
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// ServiceAccountAuthenticator checks service account keys; the users domain implements it.
+type ServiceAccountAuthenticator interface {
+	AuthenticateServiceAccount(ctx context.Context, key string) (platformauth.ServiceAccount, error)
+}
+
+// ServiceTokenSigner signs tokens for authenticated service accounts; platformauth.ServiceTokenSigner implements it.
+type ServiceTokenSigner interface {
+	Issue(account platformauth.ServiceAccount) (string, time.Time, error)
+}
+
+// ServiceTokenDeps wires the service account token exchange.
+type ServiceTokenDeps struct {
+	Accounts ServiceAccountAuthenticator
+	Signer   ServiceTokenSigner
+}
+
+// ServiceToken is a bearer token issued to a service account.
+type ServiceToken struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// IssueServiceToken exchanges a service account key for a short-lived bearer token.
+func (s *service) IssueServiceToken(ctx context.Context, audit requesttrace.AuditInfo, key string) (ServiceToken, error) { //nolint:revive
+	if s.tokens.Signer == nil {
+		return ServiceToken{}, ErrServiceTokensUnavailable
+	}
+
+	account, err := s.tokens.Accounts.AuthenticateServiceAccount(ctx, key)
+	if errors.Is(err, platformauth.ErrInvalidServiceAccountKey) {
+		return ServiceToken{}, ErrInvalidServiceAccountKey
+	}
+	if err != nil {
+		return ServiceToken{}, fmt.Errorf("authenticate service account: %w", err)
+	}
+
+	token, expiresAt, err := s.tokens.Signer.Issue(account)
+	if err != nil {
+		return ServiceToken{}, err
+	}
+	return ServiceToken{AccessToken: token, ExpiresAt: expiresAt}, nil
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	entitiesv1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/proto/palmyra/entities/v1"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/rpc"
+)
+
+// GRPCServer wires the entities service to the gRPC contract. Errors map to status codes through the same problem
+// catalog as the HTTP handler.
+type GRPCServer struct {
+	entitiesv1.UnimplementedEntitiesServiceServer
+
+	svc    service.Service
+	logger *zap.Logger
+}
+
+// NewGRPCServer constructs a GRPCServer instance.
+func NewGRPCServer(svc service.Service, logger *zap.Logger) *GRPCServer {
+	if svc == nil {
+		panic("entities service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &GRPCServer{svc: svc, logger: logger}
+}
+
+// GRPCMethods declares the entities RPCs for rpc.Config, mirroring the security of contracts/entities.yaml.
+func GRPCMethods() map[string]rpc.Method {
+	return map[string]rpc.Method{
+		entitiesv1.EntitiesService_GetDocument_FullMethodName:         {Read: true},
+		entitiesv1.EntitiesService_ListDocuments_FullMethodName:       {Read: true},
+		entitiesv1.EntitiesService_BatchGetDocuments_FullMethodName:   {Read: true},
+		entitiesv1.EntitiesService_CreateDocument_FullMethodName:      {},
+		entitiesv1.EntitiesService_BulkCreateDocuments_FullMethodName: {},
+		entitiesv1.EntitiesService_UpdateDocument_FullMethodName:      {},
+		entitiesv1.EntitiesService_DeleteDocument_FullMethodName:      {},
+	}
+}
+
+func (s *GRPCServer) GetDocument(ctx context.Context, request *entitiesv1.GetDocumentRequest) (*entitiesv1.Document, error) {
+	doc, err := s.svc.Get(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), request.GetEntityId())
+	if err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+	return toProtoDocument(doc)
+}
+
+func (s *GRPCServer) ListDocuments(ctx context.Context, request *entitiesv1.ListDocumentsRequest) (*entitiesv1.ListDocumentsResponse, error) {
+	result, err := s.svc.List(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), service.ListOptions{
+		Page:     int(request.GetPage()),
+		PageSize: int(request.GetPageSize()),
+		Sort:     request.GetSort(),
+		Cursor:   request.GetCursor(),
+	})
+	if err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+
+	items, err := toProtoDocuments(result.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &entitiesv1.ListDocumentsResponse{
+		Items:      items,
+		Page:       int32(result.Page),
+		PageSize:   int32(result.PageSize),
+		TotalItems: int32(result.TotalItems),
+		TotalPages: int32(result.TotalPages),
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func (s *GRPCServer) BatchGetDocuments(ctx context.Context, request *entitiesv1.BatchGetDocumentsRequest) (*entitiesv1.BatchGetDocumentsResponse, error) {
+	result, err := s.svc.BatchGet(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), request.GetEntityIds())
+	if err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+
+	items, err := toProtoDocuments(result.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &entitiesv1.BatchGetDocumentsResponse{Items: items, Missing: result.Missing}, nil
+}
+
+func (s *GRPCServer) CreateDocument(ctx context.Context, request *entitiesv1.CreateDocumentRequest) (*entitiesv1.Document, error) {
+	if request.GetPayload() == nil {
+		return nil, status.Error(codes.InvalidArgument, "payload is required")
+	}
+
+	doc, err := s.svc.Create(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), optionalString(request.GetEntityId()), request.GetPayload().AsMap())
+	if err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+	return toProtoDocument(doc)
+}
+
+func (s *GRPCServer) BulkCreateDocuments(ctx context.Context, request *entitiesv1.BulkCreateDocumentsRequest) (*entitiesv1.BulkCreateDocumentsResponse, error) {
+	items := make([]service.BulkItem, 0, len(request.GetItems()))
+	for _, item := range request.GetItems() {
+		items = append(items, service.BulkItem{EntityID: optionalString(item.GetEntityId()), Payload: item.GetPayload().AsMap()})
+	}
+
+	result, err := s.svc.BulkCreate(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), items)
+	if err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+
+	results := make([]*entitiesv1.BulkCreateResult, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		item := &entitiesv1.BulkCreateResult{Index: int32(row.Index)}
+		if row.Err != nil {
+			item.Error = bulkRowError(s.logger, row.Err)
+		} else {
+			doc, convErr := toProtoDocument(*row.Document)
+			if convErr != nil {
+				return nil, convErr
+			}
+			item.Document = doc
+		}
+		results = append(results, item)
+	}
+	return &entitiesv1.BulkCreateDocumentsResponse{
+		Total:     int32(result.Total),
+		Succeeded: int32(result.Succeeded),
+		Failed:    int32(result.Failed),
+		Results:   results,
+	}, nil
+}
+
+func (s *GRPCServer) UpdateDocument(ctx context.Context, request *entitiesv1.UpdateDocumentRequest) (*entitiesv1.Document, error) {
+	if request.GetPayload() == nil {
+		return nil, status.Error(codes.InvalidArgument, "payload is required")
+	}
+
+	doc, err := s.svc.Update(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), request.GetEntityId(), request.GetPayload().AsMap(), optionalString(request.GetExpectedHash()))
+	if err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+	return toProtoDocument(doc)
+}
+
+func (s *GRPCServer) DeleteDocument(ctx context.Context, request *entitiesv1.DeleteDocumentRequest) (*entitiesv1.DeleteDocumentResponse, error) {
+	if err := s.svc.Delete(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetTableName(), request.GetEntityId()); err != nil {
+		return nil, s.statusForError(ctx, err)
+	}
+	return &entitiesv1.DeleteDocumentResponse{}, nil
+}
+
+func (s *GRPCServer) statusForError(ctx context.Context, err error) error {
+	return rpc.FromProblem(errorCatalog.Resolve(ctx, s.logger, err, ""))
+}
+
+func toProtoDocuments(docs []service.Document) ([]*entitiesv1.Document, error) {
+	items := make([]*entitiesv1.Document, 0, len(docs))
+	for _, doc := range docs {
+		item, err := toProtoDocument(doc)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func toProtoDocument(doc service.Document) (*entitiesv1.Document, error) {
+	payload, err := structpb.NewStruct(doc.Payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+
+	return &entitiesv1.Document{
+		EntityId:      doc.EntityID,
+		EntityVersion: doc.EntityVersion.String(),
+		SchemaId:      doc.SchemaID.String(),
+		SchemaVersion: doc.SchemaVersion.String(),
+		Payload:       payload,
+		Hash:          doc.Hash,
+		CreatedAt:     timestamppb.New(doc.CreatedAt),
+		IsActive:      doc.IsActive,
+		IsDeleted:     doc.IsDeleted,
+	}, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// compile-time assertions to ensure interface compliance
+var _ entitiesv1.EntitiesServiceServer = (*GRPCServer)(nil)
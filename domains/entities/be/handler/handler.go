@@ -1,10 +1,15 @@
 package handler
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"io"
+	"sort"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -12,14 +17,10 @@ import (
 	externalPrimitives "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
 	externalProblems "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
 	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
-)
-
-const (
-	problemTypeValidation = "https://palmyra.pro/problems/validation-error"
-	problemTypeNotFound   = "https://palmyra.pro/problems/not-found"
-	problemTypeConflict   = "https://palmyra.pro/problems/conflict"
-	problemTypeInternal   = "https://palmyra.pro/problems/internal-error"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // Handler wires the entities service to the generated HTTP contract.
@@ -46,26 +47,20 @@ func New(svc service.Service, logger *zap.Logger) *Handler {
 
 func (h *Handler) ListDocuments(ctx context.Context, request entitiesapi.ListDocumentsRequestObject) (entitiesapi.ListDocumentsResponseObject, error) {
 	audit := h.audit(ctx)
-	page := 1
-	if request.Params.Page != nil {
-		page = int(*request.Params.Page)
-	}
-	pageSize := 20
-	if request.Params.PageSize != nil {
-		pageSize = int(*request.Params.PageSize)
-	}
+	page := pagination.FromQuery(request.Params.Page, request.Params.PageSize).WithCursor(request.Params.Cursor)
 	sort := ""
 	if request.Params.Sort != nil {
 		sort = string(*request.Params.Sort)
 	}
 
 	result, err := h.svc.List(ctx, audit, string(request.TableName), service.ListOptions{
-		Page:     page,
-		PageSize: pageSize,
+		Page:     page.Page,
+		PageSize: page.PageSize,
 		Sort:     sort,
+		Cursor:   page.Cursor,
 	})
 	if err != nil {
-		status, problem := h.problemForError(err)
+		status, problem := h.problemForError(ctx, err)
 		return entitiesapi.ListDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
@@ -73,17 +68,59 @@ func (h *Handler) ListDocuments(ctx context.Context, request entitiesapi.ListDoc
 	for _, doc := range result.Items {
 		apiDoc, convErr := toAPIDocument(doc)
 		if convErr != nil {
-			status, problem := h.problemForInternal(convErr)
+			status, problem := h.problemForError(ctx, convErr)
 			return entitiesapi.ListDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 		}
 		items = append(items, apiDoc)
 	}
 
-	return entitiesapi.ListDocuments200JSONResponse{
+	response := entitiesapi.ListDocuments200JSONResponse{
 		Items:      items,
 		Page:       result.Page,
 		PageSize:   result.PageSize,
-		TotalItems: int(result.TotalItems),
+		TotalItems: result.TotalItems,
+		TotalPages: result.TotalPages,
+	}
+	if result.NextCursor != "" {
+		response.NextCursor = strPtr(result.NextCursor)
+	}
+
+	return response, nil
+}
+
+func (h *Handler) SearchDocuments(ctx context.Context, request entitiesapi.SearchDocumentsRequestObject) (entitiesapi.SearchDocumentsResponseObject, error) {
+	audit := h.audit(ctx)
+	page := pagination.FromQuery(request.Params.Page, request.Params.PageSize)
+
+	opts := service.SearchOptions{Page: page.Page, PageSize: page.PageSize}
+	if request.Params.Q != nil {
+		opts.Query = *request.Params.Q
+	}
+	if request.Params.Filter != nil {
+		opts.Filters = *request.Params.Filter
+	}
+
+	result, err := h.svc.Search(ctx, audit, string(request.TableName), opts)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.SearchDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]entitiesapi.EntityDocument, 0, len(result.Items))
+	for _, doc := range result.Items {
+		apiDoc, convErr := toAPIDocument(doc)
+		if convErr != nil {
+			status, problem := h.problemForError(ctx, convErr)
+			return entitiesapi.SearchDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+		}
+		items = append(items, apiDoc)
+	}
+
+	return entitiesapi.SearchDocuments200JSONResponse{
+		Items:      items,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
 		TotalPages: result.TotalPages,
 	}, nil
 }
@@ -103,13 +140,13 @@ func (h *Handler) CreateDocument(ctx context.Context, request entitiesapi.Create
 
 	doc, err := h.svc.Create(ctx, audit, string(request.TableName), entityID, request.Body.Payload)
 	if err != nil {
-		status, problem := h.problemForError(err)
+		status, problem := h.problemForError(ctx, err)
 		return entitiesapi.CreateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
 	apiDoc, convErr := toAPIDocument(doc)
 	if convErr != nil {
-		status, problem := h.problemForInternal(convErr)
+		status, problem := h.problemForError(ctx, convErr)
 		return entitiesapi.CreateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
@@ -123,52 +160,308 @@ func (h *Handler) CreateDocument(ctx context.Context, request entitiesapi.Create
 	}, nil
 }
 
+func (h *Handler) BulkCreateDocuments(ctx context.Context, request entitiesapi.BulkCreateDocumentsRequestObject) (entitiesapi.BulkCreateDocumentsResponseObject, error) {
+	audit := h.audit(ctx)
+
+	var items []service.BulkItem
+	switch {
+	case request.JSONBody != nil:
+		items = make([]service.BulkItem, 0, len(*request.JSONBody))
+		for _, row := range *request.JSONBody {
+			items = append(items, toBulkItem(row))
+		}
+	case request.Body != nil:
+		var err error
+		items, err = decodeNDJSON(request.Body)
+		if err != nil {
+			status, problem := h.validationProblem(err.Error())
+			return entitiesapi.BulkCreateDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+		}
+	default:
+		status, problem := h.validationProblem("request body is required")
+		return entitiesapi.BulkCreateDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	result, err := h.svc.BulkCreate(ctx, audit, string(request.TableName), items)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.BulkCreateDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	results := make([]entitiesapi.BulkCreateEntityDocumentResult, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		item := entitiesapi.BulkCreateEntityDocumentResult{Index: row.Index}
+		if row.Err != nil {
+			item.Status = entitiesapi.Failed
+			item.Error = strPtr(bulkRowError(h.logger, row.Err))
+		} else {
+			entityID := externalPrimitives.EntityIdentifier(row.Document.EntityID)
+			entityVersion := externalPrimitives.SemanticVersion(row.Document.EntityVersion.String())
+			item.Status = entitiesapi.Created
+			item.EntityId = &entityID
+			item.EntityVersion = &entityVersion
+		}
+		results = append(results, item)
+	}
+
+	return entitiesapi.BulkCreateDocuments200JSONResponse{
+		Total:     result.Total,
+		Succeeded: result.Succeeded,
+		Failed:    result.Failed,
+		Results:   results,
+	}, nil
+}
+
 func (h *Handler) GetDocument(ctx context.Context, request entitiesapi.GetDocumentRequestObject) (entitiesapi.GetDocumentResponseObject, error) {
 	audit := h.audit(ctx)
 
 	doc, err := h.svc.Get(ctx, audit, string(request.TableName), string(request.EntityId))
 	if err != nil {
-		status, problem := h.problemForError(err)
+		status, problem := h.problemForError(ctx, err)
 		return entitiesapi.GetDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
 	apiDoc, convErr := toAPIDocument(doc)
 	if convErr != nil {
-		status, problem := h.problemForInternal(convErr)
+		status, problem := h.problemForError(ctx, convErr)
 		return entitiesapi.GetDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
 	return entitiesapi.GetDocument200JSONResponse(apiDoc), nil
 }
 
-func (h *Handler) UpdateDocument(ctx context.Context, request entitiesapi.UpdateDocumentRequestObject) (entitiesapi.UpdateDocumentResponseObject, error) {
+func (h *Handler) GetDocumentVersion(ctx context.Context, request entitiesapi.GetDocumentVersionRequestObject) (entitiesapi.GetDocumentVersionResponseObject, error) {
+	audit := h.audit(ctx)
+
+	doc, err := h.svc.GetVersion(ctx, audit, string(request.TableName), string(request.EntityId), string(request.EntityVersion))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.GetDocumentVersiondefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	apiDoc, convErr := toAPIDocument(doc)
+	if convErr != nil {
+		status, problem := h.problemForError(ctx, convErr)
+		return entitiesapi.GetDocumentVersiondefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return entitiesapi.GetDocumentVersion200JSONResponse(apiDoc), nil
+}
+
+func (h *Handler) BatchGetDocuments(ctx context.Context, request entitiesapi.BatchGetDocumentsRequestObject) (entitiesapi.BatchGetDocumentsResponseObject, error) {
+	if request.Body == nil {
+		status, problem := h.validationProblem("entityIds is required")
+		return entitiesapi.BatchGetDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	audit := h.audit(ctx)
+
+	ids := make([]string, 0, len(request.Body.EntityIds))
+	for _, id := range request.Body.EntityIds {
+		ids = append(ids, string(id))
+	}
+
+	result, err := h.svc.BatchGet(ctx, audit, string(request.TableName), ids)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.BatchGetDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]entitiesapi.EntityDocument, 0, len(result.Items))
+	for _, doc := range result.Items {
+		apiDoc, convErr := toAPIDocument(doc)
+		if convErr != nil {
+			status, problem := h.problemForError(ctx, convErr)
+			return entitiesapi.BatchGetDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+		}
+		items = append(items, apiDoc)
+	}
+
+	missing := make([]externalPrimitives.EntityIdentifier, 0, len(result.Missing))
+	for _, id := range result.Missing {
+		missing = append(missing, externalPrimitives.EntityIdentifier(id))
+	}
+
+	return entitiesapi.BatchGetDocuments200JSONResponse{Items: items, Missing: missing}, nil
+}
+
+func (h *Handler) ValidateDocument(ctx context.Context, request entitiesapi.ValidateDocumentRequestObject) (entitiesapi.ValidateDocumentResponseObject, error) {
 	if request.Body == nil || request.Body.Payload == nil {
 		status, problem := h.validationProblem("payload is required")
-		return entitiesapi.UpdateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+		return entitiesapi.ValidateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
 	audit := h.audit(ctx)
 
-	doc, err := h.svc.Update(ctx, audit, string(request.TableName), string(request.EntityId), *request.Body.Payload)
+	var entityID *string
+	if request.Body.EntityId != nil {
+		id := string(*request.Body.EntityId)
+		entityID = &id
+	}
+
+	result, err := h.svc.Validate(ctx, audit, string(request.TableName), entityID, request.Body.Payload)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.ValidateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	errs := make(map[string][]string, len(result.Errors))
+	for field, messages := range result.Errors {
+		errs[field] = append([]string(nil), messages...)
+	}
+
+	return entitiesapi.ValidateDocument200JSONResponse{
+		Valid:         result.Valid(),
+		SchemaId:      externalPrimitives.UUID(result.SchemaID),
+		SchemaVersion: externalPrimitives.SemanticVersion(result.SchemaVersion.String()),
+		Errors:        errs,
+	}, nil
+}
+
+func (h *Handler) UpdateDocument(ctx context.Context, request entitiesapi.UpdateDocumentRequestObject) (entitiesapi.UpdateDocumentResponseObject, error) {
+	audit := h.audit(ctx)
+	tableName := string(request.TableName)
+	entityID := string(request.EntityId)
+	expectedHash := parseIfMatch(request.Params.IfMatch)
+
+	var (
+		doc service.Document
+		err error
+	)
+	switch {
+	case request.ApplicationJSONPatchPlusJSONBody != nil:
+		doc, err = h.patchDocument(ctx, audit, tableName, entityID, service.PatchFormatJSONPatch, request.ApplicationJSONPatchPlusJSONBody, expectedHash)
+	case request.ApplicationMergePatchPlusJSONBody != nil:
+		doc, err = h.patchDocument(ctx, audit, tableName, entityID, service.PatchFormatMergePatch, request.ApplicationMergePatchPlusJSONBody, expectedHash)
+	case request.JSONBody != nil && request.JSONBody.Payload != nil:
+		doc, err = h.svc.Update(ctx, audit, tableName, entityID, *request.JSONBody.Payload, expectedHash)
+	default:
+		status, problem := h.validationProblem("payload is required")
+		return entitiesapi.UpdateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
 	if err != nil {
-		status, problem := h.problemForError(err)
+		if errors.Is(err, service.ErrStaleDocument) {
+			_, problem := h.problemForError(ctx, err)
+			return entitiesapi.UpdateDocument412ApplicationProblemPlusJSONResponse(problem), nil
+		}
+		status, problem := h.problemForError(ctx, err)
 		return entitiesapi.UpdateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
 	apiDoc, convErr := toAPIDocument(doc)
 	if convErr != nil {
-		status, problem := h.problemForInternal(convErr)
+		status, problem := h.problemForError(ctx, convErr)
 		return entitiesapi.UpdateDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
 	return entitiesapi.UpdateDocument200JSONResponse(apiDoc), nil
 }
 
+func (h *Handler) patchDocument(ctx context.Context, audit requesttrace.AuditInfo, tableName, entityID string, format service.PatchFormat, body interface{}, expectedHash *string) (service.Document, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return service.Document{}, fmt.Errorf("encode patch: %w", err)
+	}
+	return h.svc.Patch(ctx, audit, tableName, entityID, service.Patch{Format: format, Body: raw}, expectedHash)
+}
+
+func (h *Handler) DiffDocumentVersions(ctx context.Context, request entitiesapi.DiffDocumentVersionsRequestObject) (entitiesapi.DiffDocumentVersionsResponseObject, error) {
+	audit := h.audit(ctx)
+
+	diff, err := h.svc.Diff(ctx, audit, string(request.TableName), string(request.EntityId), string(request.Params.From), string(request.Params.To))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.DiffDocumentVersionsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	changes := make([]entitiesapi.EntityDocumentChange, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		changes = append(changes, entitiesapi.EntityDocumentChange{
+			Op:       entitiesapi.EntityDocumentChangeOp(change.Op),
+			Path:     change.Path,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+
+	return entitiesapi.DiffDocumentVersions200JSONResponse{
+		EntityId:    externalPrimitives.EntityIdentifier(diff.EntityID),
+		FromVersion: externalPrimitives.SemanticVersion(diff.FromVersion.String()),
+		ToVersion:   externalPrimitives.SemanticVersion(diff.ToVersion.String()),
+		Changes:     changes,
+	}, nil
+}
+
+func (h *Handler) VerifyDocument(ctx context.Context, request entitiesapi.VerifyDocumentRequestObject) (entitiesapi.VerifyDocumentResponseObject, error) {
+	audit := h.audit(ctx)
+
+	verification, err := h.svc.Verify(ctx, audit, string(request.TableName), string(request.EntityId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.VerifyDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	mismatches := make([]entitiesapi.EntityHashMismatch, 0, len(verification.Mismatches))
+	for _, mismatch := range verification.Mismatches {
+		mismatches = append(mismatches, entitiesapi.EntityHashMismatch{
+			EntityVersion: externalPrimitives.SemanticVersion(mismatch.Version.String()),
+			StoredHash:    mismatch.StoredHash,
+			ComputedHash:  mismatch.ComputedHash,
+			Archived:      mismatch.Archived,
+		})
+	}
+
+	return entitiesapi.VerifyDocument200JSONResponse{
+		EntityId:        externalPrimitives.EntityIdentifier(verification.EntityID),
+		Valid:           verification.Valid(),
+		VersionsChecked: verification.Versions,
+		Mismatches:      mismatches,
+	}, nil
+}
+
+func (h *Handler) GetDocumentVersionProof(ctx context.Context, request entitiesapi.GetDocumentVersionProofRequestObject) (entitiesapi.GetDocumentVersionProofResponseObject, error) {
+	audit := h.audit(ctx)
+
+	proof, err := h.svc.Proof(ctx, audit, string(request.TableName), string(request.EntityId), string(request.EntityVersion))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.GetDocumentVersionProofdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	steps := make([]entitiesapi.MerkleProofStep, 0, len(proof.Steps))
+	for _, step := range proof.Steps {
+		steps = append(steps, entitiesapi.MerkleProofStep{
+			Position: entitiesapi.MerkleProofStepPosition(step.Position),
+			Hash:     step.Hash,
+		})
+	}
+
+	response := entitiesapi.GetDocumentVersionProof200JSONResponse{
+		TableName:     externalPrimitives.TableName(proof.TableName),
+		EntityId:      externalPrimitives.EntityIdentifier(proof.EntityID),
+		EntityVersion: externalPrimitives.SemanticVersion(proof.EntityVersion.String()),
+		Hash:          proof.Hash,
+		Leaf:          proof.Leaf,
+		LeafIndex:     proof.LeafIndex,
+		Proof:         steps,
+		MerkleRoot:    proof.MerkleRoot,
+		BatchId:       externalPrimitives.UUID(proof.BatchID),
+		Anchor:        proof.Anchor,
+		Status:        entitiesapi.EntityVersionProofStatus(proof.Status),
+		Reference:     proof.Reference,
+	}
+	if proof.AnchoredAt != nil {
+		anchoredAt := externalPrimitives.Timestamp(*proof.AnchoredAt)
+		response.AnchoredAt = &anchoredAt
+	}
+	return response, nil
+}
+
 func (h *Handler) DeleteDocument(ctx context.Context, request entitiesapi.DeleteDocumentRequestObject) (entitiesapi.DeleteDocumentResponseObject, error) {
 	audit := h.audit(ctx)
 
 	if err := h.svc.Delete(ctx, audit, string(request.TableName), string(request.EntityId)); err != nil {
-		status, problem := h.problemForError(err)
+		status, problem := h.problemForError(ctx, err)
 		return entitiesapi.DeleteDocumentdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
@@ -189,6 +482,7 @@ func toAPIDocument(doc service.Document) (entitiesapi.EntityDocument, error) {
 		SchemaId:      externalPrimitives.UUID(doc.SchemaID),
 		SchemaVersion: externalPrimitives.SemanticVersion(doc.SchemaVersion.String()),
 		Payload:       payload,
+		Hash:          doc.Hash,
 		CreatedAt:     externalPrimitives.Timestamp(doc.CreatedAt),
 		IsActive:      doc.IsActive,
 		IsDeleted:     doc.IsDeleted,
@@ -197,56 +491,116 @@ func toAPIDocument(doc service.Document) (entitiesapi.EntityDocument, error) {
 	return apiDoc, nil
 }
 
-func (h *Handler) validationProblem(detail string) (int, externalProblems.ProblemDetails) {
-	problem := externalProblems.ProblemDetails{
-		Type:   strPtr(problemTypeValidation),
-		Title:  "Validation error",
-		Detail: strPtr(detail),
-		Status: http.StatusBadRequest,
+func toBulkItem(row entitiesapi.CreateEntityDocumentRequest) service.BulkItem {
+	item := service.BulkItem{Payload: row.Payload}
+	if row.EntityId != nil {
+		id := string(*row.EntityId)
+		item.EntityID = &id
 	}
-	return http.StatusBadRequest, problem
+	return item
 }
 
-func (h *Handler) problemForError(err error) (int, externalProblems.ProblemDetails) {
-	var validationErr *service.ValidationError
-	if errors.As(err, &validationErr) {
-		return h.validationProblem(validationErr.Error())
-	}
+// decodeNDJSON reads one create request per line so large imports never need to be held as a single JSON document.
+// Malformed lines become failed rows instead of rejecting the whole body.
+func decodeNDJSON(body io.Reader) ([]service.BulkItem, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	var items []service.BulkItem
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if len(items) == service.MaxBulkItems {
+			return nil, fmt.Errorf("at most %d documents can be imported per request", service.MaxBulkItems)
+		}
 
-	if errors.Is(err, service.ErrTableNotFound) || errors.Is(err, service.ErrDocumentNotFound) {
-		problem := externalProblems.ProblemDetails{
-			Type:   strPtr(problemTypeNotFound),
-			Title:  "Not found",
-			Detail: strPtr("resource not found"),
-			Status: http.StatusNotFound,
+		var row entitiesapi.CreateEntityDocumentRequest
+		if err := json.Unmarshal(line, &row); err != nil {
+			items = append(items, service.BulkItem{Err: fmt.Errorf("invalid JSON: %w", err)})
+			continue
 		}
-		return http.StatusNotFound, problem
+		items = append(items, toBulkItem(row))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ndjson body: %w", err)
 	}
 
-	if errors.Is(err, service.ErrConflict) {
-		problem := externalProblems.ProblemDetails{
-			Type:   strPtr(problemTypeConflict),
-			Title:  "Conflict",
-			Detail: strPtr("entity already exists"),
-			Status: http.StatusConflict,
+	return items, nil
+}
+
+// bulkRowError renders why a bulk row failed; unexpected errors are logged and not exposed.
+func bulkRowError(logger *zap.Logger, err error) string {
+	var validationErr *service.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		if len(validationErr.Fields) == 0 {
+			return validationErr.Reason
+		}
+		fields := make([]string, 0, len(validationErr.Fields))
+		for field := range validationErr.Fields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		parts := make([]string, 0, len(fields))
+		for _, field := range fields {
+			parts = append(parts, fmt.Sprintf("%s: %s", field, strings.Join(validationErr.Fields[field], "; ")))
 		}
-		return http.StatusConflict, problem
+		return strings.Join(parts, "; ")
+	case errors.Is(err, service.ErrConflict):
+		return "entity already exists"
+	default:
+		if logger != nil {
+			logger.Error("entities bulk row", zap.Error(err))
+		}
+		return "unexpected error"
 	}
-
-	return h.problemForInternal(err)
 }
 
-func (h *Handler) problemForInternal(err error) (int, externalProblems.ProblemDetails) {
-	if h.logger != nil {
-		h.logger.Error("entities handler", zap.Error(err))
+// parseIfMatch extracts the expected hash from an If-Match header; "*" and empty values disable the check.
+func parseIfMatch(header *string) *string {
+	if header == nil {
+		return nil
 	}
-	problem := externalProblems.ProblemDetails{
-		Type:   strPtr(problemTypeInternal),
-		Title:  "Internal error",
-		Detail: strPtr("unexpected error"),
-		Status: http.StatusInternalServerError,
+	value := strings.TrimSpace(*header)
+	value = strings.TrimPrefix(value, "W/")
+	value = strings.Trim(value, `"`)
+	if value == "" || value == "*" {
+		return nil
 	}
-	return http.StatusInternalServerError, problem
+	return &value
+}
+
+func (h *Handler) validationProblem(detail string) (int, externalProblems.ProblemDetails) {
+	problem := problems.Validation(detail, nil)
+	return problem.Status, problem.Details()
+}
+
+// errorCatalog maps entities service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("entities")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation(err.Error(), err.Fields)
+	})
+	c.Register(service.ErrTableNotFound, problems.NotFound("resource not found")).
+		Register(service.ErrDocumentNotFound, problems.NotFound("resource not found")).
+		Register(service.ErrNotAnchored, problems.NotFound(service.ErrNotAnchored.Error())).
+		Register(service.ErrConflict, problems.Conflict("entity already exists")).
+		Register(service.ErrStaleDocument, problems.PreconditionFailed("document has been modified since it was read")).
+		Register(service.ErrDecryptionRequired, problems.Forbidden(service.ErrDecryptionRequired.Error())).
+		Register(service.ErrPIIReadRequired, problems.Forbidden(service.ErrPIIReadRequired.Error())).
+		Register(service.ErrBulkImportDisabled, problems.Forbidden(service.ErrBulkImportDisabled.Error())).
+		Register(service.ErrEncryptionUnavailable, problems.NotConfigured("Encryption unavailable", "no key manager is configured for encrypted fields")).
+		Register(service.ErrTableNotProvisioned, problems.NotConfigured("Entity table unavailable", "the entity table has not been created for this tenant; run the tenant migrations"))
+	problems.RegisterAs(c, func(err *tenant.QuotaExceededError) problems.Problem {
+		return problems.QuotaExceeded(err.Error())
+	})
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error) (int, externalProblems.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, "")
 }
 
 func strPtr(value string) *string {
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// watchKeepAliveInterval controls how often an SSE comment is sent to keep idle proxies from closing the stream.
+const watchKeepAliveInterval = 15 * time.Second
+
+func (h *Handler) WatchDocuments(ctx context.Context, request entitiesapi.WatchDocumentsRequestObject) (entitiesapi.WatchDocumentsResponseObject, error) {
+	audit := h.audit(ctx)
+
+	changes, cancel, err := h.svc.Watch(ctx, audit, string(request.TableName))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err)
+		return entitiesapi.WatchDocumentsdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return watchDocumentsStream{ctx: ctx, changes: changes, cancel: cancel}, nil
+}
+
+// watchDocumentsStream writes entity changes as Server-Sent Events until the client goes away
+// or the subscription is closed. The generated text/event-stream response only copies an io.Reader,
+// which cannot flush per event, hence this hand-written response object.
+type watchDocumentsStream struct {
+	ctx     context.Context
+	changes <-chan persistence.EntityChange
+	cancel  func()
+}
+
+func (s watchDocumentsStream) VisitWatchDocumentsResponse(w http.ResponseWriter) error {
+	defer s.cancel()
+
+	controller := http.NewResponseController(w)
+	// The stream is long-lived; lift the server-wide write deadline for this response only.
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		return fmt.Errorf("flush event stream: %w", err)
+	}
+
+	keepAlive := time.NewTicker(watchKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		case change, ok := <-s.changes:
+			if !ok {
+				return nil
+			}
+			if err := writeChangeEvent(w, change); err != nil {
+				return nil
+			}
+		}
+
+		if err := controller.Flush(); err != nil {
+			return nil
+		}
+	}
+}
+
+func writeChangeEvent(w io.Writer, change persistence.EntityChange) error {
+	event := entitiesapi.EntityChangeEvent{
+		Type:       entitiesapi.EntityChangeEventType(change.Type),
+		EntityId:   change.EntityID,
+		OccurredAt: change.OccurredAt,
+	}
+	if change.EntityVersion != "" {
+		event.EntityVersion = strPtr(change.EntityVersion)
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", change.Type, data)
+	return err
+}
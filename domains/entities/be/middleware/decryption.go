@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// PayloadDecryption lets callers granted permission read encrypted payload fields in clear; everyone else reads them
+// as null. A failure to resolve the caller's permissions is logged and treated as not granted. Mount it after the
+// tenant space middleware, since roles are tenant scoped.
+func PayloadDecryption(resolver platformauth.PermissionResolver, permission string, logger *zap.Logger) func(http.Handler) http.Handler {
+	return grantOnPermission(resolver, permission, logger, "payload decryption", persistence.WithPayloadDecryption)
+}
+
+// grantOnPermission marks the request context with grant when Authorize grants permission to the caller.
+func grantOnPermission(resolver platformauth.PermissionResolver, permission string, logger *zap.Logger, name string, grant func(context.Context) context.Context) func(http.Handler) http.Handler {
+	if resolver == nil {
+		panic("permission resolver is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			creds, ok := platformauth.UserFromContext(ctx)
+			if !ok || creds == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err := platformauth.Authorize(ctx, resolver, creds, permission)
+			switch {
+			case err == nil:
+				r = r.WithContext(grant(ctx))
+			case !errors.Is(err, platformauth.ErrPermissionDenied):
+				requestLogger := logger
+				if l, ok := platformlogging.FromContext(ctx); ok {
+					requestLogger = l
+				}
+				requestLogger.Warn("resolve "+name+" permission failed", zap.String("user_id", creds.Id), zap.Error(err))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
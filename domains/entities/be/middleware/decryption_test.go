@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+type stubResolver struct {
+	permissions []string
+	err         error
+}
+
+func (s stubResolver) ResolvePermissions(context.Context, *platformauth.UserCredentials) ([]string, error) {
+	return s.permissions, s.err
+}
+
+func TestPayloadDecryption(t *testing.T) {
+	t.Parallel()
+
+	serve := func(resolver stubResolver, creds *platformauth.UserCredentials) bool {
+		var allowed bool
+		handler := PayloadDecryption(resolver, "entities:decrypt", zaptest.NewLogger(t))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed = persistence.PayloadDecryptionAllowed(r.Context())
+			w.WriteHeader(http.StatusOK)
+		}))
+		ctx := context.Background()
+		if creds != nil {
+			ctx = platformauth.WithUserCredentials(ctx, creds)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/entities/people_entities/documents", nil).WithContext(ctx))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return allowed
+	}
+
+	user := &platformauth.UserCredentials{Id: "ann"}
+	require.False(t, serve(stubResolver{}, nil))
+	require.False(t, serve(stubResolver{permissions: []string{"users:read"}}, user))
+	require.True(t, serve(stubResolver{permissions: []string{"entities:decrypt"}}, user))
+	require.True(t, serve(stubResolver{permissions: []string{platformauth.PermissionAll}}, user))
+	require.True(t, serve(stubResolver{}, &platformauth.UserCredentials{Id: "root", IsAdmin: true}))
+	require.False(t, serve(stubResolver{err: errors.New("db down")}, user), "resolution failures do not grant decryption")
+
+	apiKey := &platformauth.UserCredentials{Id: "ann", APIKeyID: "key-1", Scopes: []string{"entities:read"}}
+	require.False(t, serve(stubResolver{permissions: []string{platformauth.PermissionAll}}, apiKey), "api key scopes narrow the grant")
+}
+
+func TestPIIAccess(t *testing.T) {
+	t.Parallel()
+
+	serve := func(resolver stubResolver) bool {
+		var allowed bool
+		handler := PIIAccess(resolver, zaptest.NewLogger(t))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed = service.PIIAccessAllowed(r.Context())
+			w.WriteHeader(http.StatusOK)
+		}))
+		ctx := platformauth.WithUserCredentials(context.Background(), &platformauth.UserCredentials{Id: "ann"})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/entities/people_entities/documents", nil).WithContext(ctx))
+		return allowed
+	}
+
+	require.False(t, serve(stubResolver{permissions: []string{"entities:decrypt"}}))
+	require.True(t, serve(stubResolver{permissions: []string{"pii:read"}}))
+}
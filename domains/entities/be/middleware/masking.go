@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+)
+
+// PIIAccess lets callers granted service.PermissionPIIRead read x-pii payload fields unmasked; everyone else gets
+// them masked as their schema's x-pii-mask says. Like PayloadDecryption, it must run after the tenant space
+// middleware.
+func PIIAccess(resolver platformauth.PermissionResolver, logger *zap.Logger) func(http.Handler) http.Handler {
+	return grantOnPermission(resolver, service.PermissionPIIRead, logger, "pii access", service.WithPIIAccess)
+}
@@ -6,41 +6,83 @@ import (
 	"errors"
 	"time"
 
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/search"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // ListParams defines pagination and sorting inputs for listing entities.
+// Cursor switches the listing to keyset pagination and takes precedence over Page.
 type ListParams struct {
 	Page       int
 	PageSize   int
 	SortColumn string
 	SortOrder  string
+	Cursor     *persistence.EntityCursor
 }
 
 // ListResult wraps persistence records with total count metadata.
+// NextCursor is set when more records follow the returned page.
 type ListResult struct {
-	Records []persistence.EntityRecord
-	Total   int64
+	Records    []persistence.EntityRecord
+	Total      int64
+	NextCursor *persistence.EntityCursor
+}
+
+// SearchParams narrows a search over the active documents of a table.
+type SearchParams struct {
+	Text     string
+	Filters  []persistence.EntityFilter
+	Page     int
+	PageSize int
+}
+
+// BulkItem is a single row submitted for bulk creation.
+type BulkItem struct {
+	EntityID string
+	Payload  json.RawMessage
 }
 
 // Repository exposes entity persistence operations scoped by table name.
 type Repository interface {
 	List(ctx context.Context, tableName string, params ListParams) (ListResult, error)
+	Search(ctx context.Context, tableName string, params SearchParams) (ListResult, error)
 	Create(ctx context.Context, tableName string, entityID string, payload json.RawMessage, createdBy *string) (persistence.EntityRecord, error)
+	BulkCreate(ctx context.Context, tableName string, items []BulkItem, createdBy *string) ([]persistence.BulkEntityResult, error)
 	Get(ctx context.Context, tableName string, entityID string) (persistence.EntityRecord, error)
-	Update(ctx context.Context, tableName string, entityID string, payload json.RawMessage, createdBy *string) (persistence.EntityRecord, error)
+	GetVersion(ctx context.Context, tableName string, entityID string, version persistence.SemanticVersion) (persistence.EntityRecord, error)
+	GetMany(ctx context.Context, tableName string, entityIDs []string) ([]persistence.EntityRecord, error)
+	References(ctx context.Context, tableName string) ([]persistence.ReferenceField, error)
+	EncryptedFields(ctx context.Context, tableName string) ([]persistence.EncryptedField, error)
+	PIIMasking(ctx context.Context, tableName string) (persistence.PIIMasking, error)
+	Validate(ctx context.Context, tableName string, payload json.RawMessage) (persistence.SchemaRecord, error)
+	Update(ctx context.Context, tableName string, entityID string, payload json.RawMessage, expectedHash *string, createdBy *string) (persistence.EntityRecord, error)
 	Delete(ctx context.Context, tableName string, entityID string) error
+	Verify(ctx context.Context, tableName string, entityID string) (persistence.EntityVerification, error)
+	AnchorProof(ctx context.Context, tableName string, entityID string, version persistence.SemanticVersion) (persistence.EntityAnchorProof, error)
+	Watch(ctx context.Context, tableName string) (<-chan persistence.EntityChange, func(), error)
 }
 
 type repository struct {
 	spaceDB     *persistence.SpaceDB
 	schemaStore *persistence.SchemaRepositoryStore
 	validator   *persistence.SchemaValidator
+	events      persistence.EntityEventSink
+	changes     *persistence.EntityChangeHub
+	archive     persistence.EntityArchiveReader
+	encryption  *persistence.EntityEncryption
+	anchors     *persistence.EntityAnchorStore
+	search      search.Index
 }
 
 // New constructs a Repository backed by the shared persistence layer.
-func New(spaceDB *persistence.SpaceDB, schemaStore *persistence.SchemaRepositoryStore, validator *persistence.SchemaValidator) Repository {
+// events is optional; when provided every entity write also records an event in the same transaction.
+// changes is optional too; without it Watch is unavailable. archive is optional; without it reading an archived
+// version fails. encryption is optional; without it writes to tables with encrypted fields fail. searchIndex is
+// optional; without it Search runs in Postgres. Entity tables are not created here; writes to a tenant without the
+// table fail with persistence.ErrEntityTableMissing.
+func New(spaceDB *persistence.SpaceDB, schemaStore *persistence.SchemaRepositoryStore, validator *persistence.SchemaValidator, events persistence.EntityEventSink, changes *persistence.EntityChangeHub, archive persistence.EntityArchiveReader, encryption *persistence.EntityEncryption, searchIndex search.Index) Repository {
 	if spaceDB == nil {
 		panic("space db is required")
 	}
@@ -51,7 +93,7 @@ func New(spaceDB *persistence.SpaceDB, schemaStore *persistence.SchemaRepository
 		panic("schema validator is required")
 	}
 
-	return &repository{spaceDB: spaceDB, schemaStore: schemaStore, validator: validator}
+	return &repository{spaceDB: spaceDB, schemaStore: schemaStore, validator: validator, events: events, changes: changes, archive: archive, encryption: encryption, anchors: persistence.NewEntityAnchorStore(spaceDB), search: searchIndex}
 }
 
 func (r *repository) List(ctx context.Context, tableName string, params ListParams) (ListResult, error) {
@@ -65,22 +107,17 @@ func (r *repository) List(ctx context.Context, tableName string, params ListPara
 		return ListResult{}, err
 	}
 
-	page := params.Page
-	if page < 1 {
-		page = 1
-	}
-	pageSize := params.PageSize
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 20
-	}
+	page := pagination.New(params.Page, params.PageSize)
 
+	// Fetch one extra row to learn whether a next page exists without a second query.
 	listParams := persistence.ListEntitiesParams{
 		OnlyActive:     true,
 		IncludeDeleted: false,
-		Limit:          pageSize,
-		Offset:         (page - 1) * pageSize,
+		Limit:          page.Limit() + 1,
+		Offset:         page.Offset(),
 		SortField:      params.SortColumn,
 		SortOrder:      params.SortOrder,
+		Cursor:         params.Cursor,
 	}
 
 	records, err := repo.ListEntities(ctx, space, listParams)
@@ -88,12 +125,81 @@ func (r *repository) List(ctx context.Context, tableName string, params ListPara
 		return ListResult{}, err
 	}
 
+	var next *persistence.EntityCursor
+	if len(records) > page.PageSize {
+		records = records[:page.PageSize]
+		cursor := persistence.CursorFor(records[len(records)-1])
+		next = &cursor
+	}
+
 	total, err := repo.CountEntities(ctx, space, listParams)
 	if err != nil {
 		return ListResult{}, err
 	}
 
-	return ListResult{Records: records, Total: total}, nil
+	return ListResult{Records: records, Total: total, NextCursor: next}, nil
+}
+
+// Search pages through matching active documents. With a search index the index picks and orders the matches and
+// their current versions are then read from Postgres; documents the index still lists after they were deleted are
+// dropped from the page.
+func (r *repository) Search(ctx context.Context, tableName string, params SearchParams) (ListResult, error) {
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	schemaRecord, err := r.activeSchema(ctx, tableName)
+	if err != nil {
+		return ListResult{}, err
+	}
+	repo, err := r.entityRepoFor(ctx, schemaRecord)
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	page := pagination.New(params.Page, params.PageSize)
+
+	if r.search == nil {
+		records, total, err := repo.SearchEntities(ctx, space, persistence.SearchEntitiesParams{
+			Text:    params.Text,
+			Filters: params.Filters,
+			Limit:   page.Limit(),
+			Offset:  page.Offset(),
+		})
+		if err != nil {
+			return ListResult{}, err
+		}
+		return ListResult{Records: records, Total: total}, nil
+	}
+
+	result, err := r.search.Search(ctx, space, search.Query{
+		TableName: schemaRecord.TableName,
+		Text:      params.Text,
+		Filters:   params.Filters,
+		From:      page.Offset(),
+		Size:      page.Limit(),
+	})
+	if err != nil {
+		return ListResult{}, err
+	}
+
+	records, err := repo.GetEntitiesByIDs(ctx, space, result.EntityIDs)
+	if err != nil {
+		return ListResult{}, err
+	}
+	byID := make(map[string]persistence.EntityRecord, len(records))
+	for _, record := range records {
+		byID[record.EntityID] = record
+	}
+	ordered := make([]persistence.EntityRecord, 0, len(records))
+	for _, entityID := range result.EntityIDs {
+		if record, ok := byID[entityID]; ok {
+			ordered = append(ordered, record)
+		}
+	}
+
+	return ListResult{Records: ordered, Total: result.Total}, nil
 }
 
 func (r *repository) Create(ctx context.Context, tableName string, entityID string, payload json.RawMessage, createdBy *string) (persistence.EntityRecord, error) {
@@ -114,6 +220,29 @@ func (r *repository) Create(ctx context.Context, tableName string, entityID stri
 	})
 }
 
+func (r *repository) BulkCreate(ctx context.Context, tableName string, items []BulkItem, createdBy *string) ([]persistence.BulkEntityResult, error) {
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := r.resolveEntityRepo(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]persistence.CreateEntityParams, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, persistence.CreateEntityParams{
+			EntityID:  item.EntityID,
+			Payload:   item.Payload,
+			CreatedBy: createdBy,
+		})
+	}
+
+	return repo.BulkCreateEntities(ctx, space, rows)
+}
+
 func (r *repository) Get(ctx context.Context, tableName string, entityID string) (persistence.EntityRecord, error) {
 	space, err := r.requireTenantSpace(ctx)
 	if err != nil {
@@ -128,7 +257,118 @@ func (r *repository) Get(ctx context.Context, tableName string, entityID string)
 	return repo.GetEntityByID(ctx, space, entityID)
 }
 
-func (r *repository) Update(ctx context.Context, tableName string, entityID string, payload json.RawMessage, createdBy *string) (persistence.EntityRecord, error) {
+func (r *repository) GetVersion(ctx context.Context, tableName string, entityID string, version persistence.SemanticVersion) (persistence.EntityRecord, error) {
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.EntityRecord{}, err
+	}
+
+	repo, err := r.resolveEntityRepo(ctx, tableName)
+	if err != nil {
+		return persistence.EntityRecord{}, err
+	}
+
+	return repo.GetEntityVersion(ctx, space, entityID, version)
+}
+
+func (r *repository) Verify(ctx context.Context, tableName string, entityID string) (persistence.EntityVerification, error) {
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.EntityVerification{}, err
+	}
+
+	repo, err := r.resolveEntityRepo(ctx, tableName)
+	if err != nil {
+		return persistence.EntityVerification{}, err
+	}
+
+	return repo.VerifyEntity(ctx, space, entityID)
+}
+
+func (r *repository) AnchorProof(ctx context.Context, tableName string, entityID string, version persistence.SemanticVersion) (persistence.EntityAnchorProof, error) {
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.EntityAnchorProof{}, err
+	}
+
+	schemaRecord, err := r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+	if err != nil {
+		return persistence.EntityAnchorProof{}, err
+	}
+
+	return r.anchors.AnchorProof(ctx, space, schemaRecord.TableName, entityID, version)
+}
+
+func (r *repository) GetMany(ctx context.Context, tableName string, entityIDs []string) ([]persistence.EntityRecord, error) {
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := r.resolveEntityRepo(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.GetEntitiesByIDs(ctx, space, entityIDs)
+}
+
+func (r *repository) References(ctx context.Context, tableName string) ([]persistence.ReferenceField, error) {
+	if tableName == "" {
+		return nil, errors.New("table name is required")
+	}
+
+	schemaRecord, err := r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return persistence.ReferenceFields(schemaRecord.SchemaDefinition)
+}
+
+func (r *repository) EncryptedFields(ctx context.Context, tableName string) ([]persistence.EncryptedField, error) {
+	if tableName == "" {
+		return nil, errors.New("table name is required")
+	}
+
+	schemaRecord, err := r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return persistence.EncryptedFields(schemaRecord.SchemaDefinition)
+}
+
+// PIIMasking returns how the active schema of tableName masks its x-pii properties.
+func (r *repository) PIIMasking(ctx context.Context, tableName string) (persistence.PIIMasking, error) {
+	if tableName == "" {
+		return persistence.PIIMasking{}, errors.New("table name is required")
+	}
+
+	schemaRecord, err := r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+	if err != nil {
+		return persistence.PIIMasking{}, err
+	}
+
+	return persistence.PIIMaskingFor(schemaRecord.SchemaDefinition)
+}
+
+// Validate checks payload against the table's active schema without touching tenant data.
+// The schema is returned even when validation fails so callers can report which version was used.
+func (r *repository) Validate(ctx context.Context, tableName string, payload json.RawMessage) (persistence.SchemaRecord, error) {
+	if tableName == "" {
+		return persistence.SchemaRecord{}, errors.New("table name is required")
+	}
+
+	schemaRecord, err := r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+	if err != nil {
+		return persistence.SchemaRecord{}, err
+	}
+
+	return schemaRecord, r.validator.Validate(ctx, schemaRecord, payload)
+}
+
+func (r *repository) Update(ctx context.Context, tableName string, entityID string, payload json.RawMessage, expectedHash *string, createdBy *string) (persistence.EntityRecord, error) {
 	space, err := r.requireTenantSpace(ctx)
 	if err != nil {
 		return persistence.EntityRecord{}, err
@@ -140,9 +380,10 @@ func (r *repository) Update(ctx context.Context, tableName string, entityID stri
 	}
 
 	return repo.UpdateEntity(ctx, space, persistence.UpdateEntityParams{
-		EntityID:  entityID,
-		Payload:   payload,
-		CreatedBy: createdBy,
+		EntityID:     entityID,
+		Payload:      payload,
+		CreatedBy:    createdBy,
+		ExpectedHash: expectedHash,
 	})
 }
 
@@ -160,18 +401,47 @@ func (r *repository) Delete(ctx context.Context, tableName string, entityID stri
 	return repo.DeleteEntity(ctx, space, entityID, time.Now().UTC())
 }
 
-func (r *repository) resolveEntityRepo(ctx context.Context, tableName string) (*persistence.EntityRepository, error) {
-	if tableName == "" {
-		return nil, errors.New("table name is required")
+func (r *repository) Watch(ctx context.Context, tableName string) (<-chan persistence.EntityChange, func(), error) {
+	if r.changes == nil {
+		return nil, nil, errors.New("entity change hub is not configured")
 	}
 
-	schemaRecord, err := r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+	space, err := r.requireTenantSpace(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Resolve the table first so unknown tables fail instead of producing a silent stream.
+	if _, err := r.resolveEntityRepo(ctx, tableName); err != nil {
+		return nil, nil, err
+	}
+
+	changes, cancel := r.changes.Subscribe(space.SchemaName, tableName)
+	return changes, cancel, nil
+}
+
+func (r *repository) resolveEntityRepo(ctx context.Context, tableName string) (*persistence.EntityRepository, error) {
+	schemaRecord, err := r.activeSchema(ctx, tableName)
 	if err != nil {
 		return nil, err
 	}
 
+	return r.entityRepoFor(ctx, schemaRecord)
+}
+
+func (r *repository) activeSchema(ctx context.Context, tableName string) (persistence.SchemaRecord, error) {
+	if tableName == "" {
+		return persistence.SchemaRecord{}, errors.New("table name is required")
+	}
+	return r.schemaStore.GetActiveSchemaByTableName(ctx, r.spaceDB, tableName)
+}
+
+func (r *repository) entityRepoFor(ctx context.Context, schemaRecord persistence.SchemaRecord) (*persistence.EntityRepository, error) {
 	return persistence.NewEntityRepository(ctx, r.spaceDB, r.schemaStore, r.validator, persistence.EntityRepositoryConfig{
-		SchemaID: schemaRecord.SchemaID,
+		SchemaID:   schemaRecord.SchemaID,
+		Events:     r.events,
+		Archive:    r.archive,
+		Encryption: r.encryption,
 	})
 }
 
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/anchoring"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// ErrNotAnchored indicates a document version that has not been added to an anchor batch yet.
+var ErrNotAnchored = errors.New("document version not anchored yet")
+
+// Proof is the Merkle inclusion proof of a document version in its anchor batch. Reference and AnchoredAt are set
+// once the batch root has been recorded by the anchor.
+type Proof struct {
+	TableName     string
+	EntityID      string
+	EntityVersion persistence.SemanticVersion
+	Hash          string
+	Leaf          string
+	LeafIndex     int
+	Steps         []anchoring.ProofStep
+	MerkleRoot    string
+	BatchID       uuid.UUID
+	Anchor        string
+	Status        string
+	Reference     *string
+	AnchoredAt    *time.Time
+}
+
+// Proof rebuilds the inclusion proof of a document version from the leaves of the batch it was anchored in.
+func (s *service) Proof(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, version string) (Proof, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return Proof{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if strings.TrimSpace(entityID) == "" {
+		return Proof{}, &ValidationError{Reason: "entityId is required"}
+	}
+	parsed, err := persistence.ParseSemanticVersion(version)
+	if err != nil {
+		return Proof{}, &ValidationError{Reason: fmt.Sprintf("invalid entity version %q", version)}
+	}
+
+	stored, err := s.repo.AnchorProof(ctx, tableName, entityID, parsed)
+	if err != nil {
+		if errors.Is(err, persistence.ErrAnchorLeafNotFound) {
+			return Proof{}, ErrNotAnchored
+		}
+		return Proof{}, translateError(err)
+	}
+
+	tree, err := anchoring.NewTree(anchorLeaves(stored.Leaves))
+	if err != nil {
+		return Proof{}, fmt.Errorf("rebuild anchor batch %s: %w", stored.Batch.BatchID, err)
+	}
+	if tree.Root() != stored.Batch.MerkleRoot {
+		return Proof{}, fmt.Errorf("anchor batch %s leaves do not match its merkle root", stored.Batch.BatchID)
+	}
+	steps, err := tree.Proof(stored.Leaf.Index)
+	if err != nil {
+		return Proof{}, fmt.Errorf("build proof: %w", err)
+	}
+
+	return Proof{
+		TableName:     stored.Leaf.TableName,
+		EntityID:      stored.Leaf.EntityID,
+		EntityVersion: stored.Leaf.Version,
+		Hash:          stored.Leaf.Hash,
+		Leaf:          anchorLeaf(stored.Leaf),
+		LeafIndex:     stored.Leaf.Index,
+		Steps:         steps,
+		MerkleRoot:    stored.Batch.MerkleRoot,
+		BatchID:       stored.Batch.BatchID,
+		Anchor:        stored.Batch.Anchor,
+		Status:        stored.Batch.Status,
+		Reference:     stored.Batch.Reference,
+		AnchoredAt:    stored.Batch.AnchoredAt,
+	}, nil
+}
+
+func anchorLeaf(leaf persistence.EntityAnchorLeaf) string {
+	return anchoring.EntityLeaf(leaf.TableName, leaf.EntityID, leaf.Version.String(), leaf.Hash)
+}
+
+func anchorLeaves(leaves []persistence.EntityAnchorLeaf) []string {
+	hashes := make([]string, len(leaves))
+	for i, leaf := range leaves {
+		hashes[i] = anchorLeaf(leaf)
+	}
+	return hashes
+}
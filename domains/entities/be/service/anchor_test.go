@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/anchoring"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func anchorTestLeaves() []persistence.EntityAnchorLeaf {
+	leaves := make([]persistence.EntityAnchorLeaf, 3)
+	for i := range leaves {
+		leaves[i] = persistence.EntityAnchorLeaf{
+			TableName: "cards_entities",
+			EntityID:  []string{"card-1", "card-2", "card-3"}[i],
+			Version:   persistence.SemanticVersion{Major: 1},
+			Hash:      strings.Repeat(string(rune('a'+i)), 64),
+			Index:     i,
+		}
+	}
+	return leaves
+}
+
+func TestService_Proof(t *testing.T) {
+	leaves := anchorTestLeaves()
+	tree, err := anchoring.NewTree(anchorLeaves(leaves))
+	require.NoError(t, err)
+	reference := "mock:ref"
+	batch := persistence.EntityAnchorBatch{BatchID: uuid.New(), Anchor: "mock", MerkleRoot: tree.Root(), LeafCount: 3, Status: persistence.AnchorBatchAnchored, Reference: &reference}
+
+	repo := &stubRepository{
+		anchorProofFn: func(_ context.Context, table, entityID string, version persistence.SemanticVersion) (persistence.EntityAnchorProof, error) {
+			require.Equal(t, "cards_entities", table)
+			require.Equal(t, persistence.SemanticVersion{Major: 1}, version)
+			if entityID != "card-2" {
+				return persistence.EntityAnchorProof{}, persistence.ErrAnchorLeafNotFound
+			}
+			return persistence.EntityAnchorProof{Batch: batch, Leaf: leaves[1], Leaves: leaves}, nil
+		},
+	}
+	svc := New(repo, nil)
+	audit := requesttrace.Anonymous("")
+
+	proof, err := svc.Proof(context.Background(), audit, "cards_entities", "card-2", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, 1, proof.LeafIndex)
+	require.Equal(t, &reference, proof.Reference)
+	require.True(t, anchoring.VerifyProof(proof.Leaf, proof.Steps, proof.MerkleRoot))
+
+	_, err = svc.Proof(context.Background(), audit, "cards_entities", "card-9", "1.0.0")
+	require.ErrorIs(t, err, ErrNotAnchored)
+
+	_, err = svc.Proof(context.Background(), audit, "cards_entities", "card-2", "one")
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+type stubAnchorStore struct {
+	unanchored [][]persistence.EntityAnchorLeaf
+	pending    []persistence.EntityAnchorBatch
+	created    []persistence.EntityAnchorBatch
+	anchored   map[uuid.UUID]string
+	failures   map[uuid.UUID]string
+}
+
+func (s *stubAnchorStore) UnanchoredVersions(context.Context, tenant.Space, int) ([]persistence.EntityAnchorLeaf, error) {
+	if len(s.unanchored) == 0 {
+		return nil, nil
+	}
+	next := s.unanchored[0]
+	s.unanchored = s.unanchored[1:]
+	return next, nil
+}
+
+func (s *stubAnchorStore) CreateAnchorBatch(_ context.Context, _ tenant.Space, batch persistence.EntityAnchorBatch, leaves []persistence.EntityAnchorLeaf) (persistence.EntityAnchorBatch, error) {
+	batch.LeafCount = len(leaves)
+	batch.Status = persistence.AnchorBatchPending
+	s.created = append(s.created, batch)
+	return batch, nil
+}
+
+func (s *stubAnchorStore) PendingAnchorBatches(context.Context, tenant.Space, int) ([]persistence.EntityAnchorBatch, error) {
+	return s.pending, nil
+}
+
+func (s *stubAnchorStore) MarkAnchorBatchAnchored(_ context.Context, _ tenant.Space, batchID uuid.UUID, reference string, _ time.Time) error {
+	s.anchored[batchID] = reference
+	return nil
+}
+
+func (s *stubAnchorStore) RecordAnchorBatchFailure(_ context.Context, _ tenant.Space, batchID uuid.UUID, message string) error {
+	s.failures[batchID] = message
+	return nil
+}
+
+type stubSpaceLister []tenant.Space
+
+func (s stubSpaceLister) ListActiveSpaces(context.Context) ([]tenant.Space, error) {
+	return s, nil
+}
+
+type failingAnchor struct{}
+
+func (failingAnchor) Name() string { return "failing" }
+
+func (failingAnchor) Submit(context.Context, string) (anchoring.Receipt, error) {
+	return anchoring.Receipt{}, errors.New("ledger unavailable")
+}
+
+func TestAnchorWorker_RunOnce(t *testing.T) {
+	leaves := anchorTestLeaves()
+	pending := persistence.EntityAnchorBatch{BatchID: uuid.New(), MerkleRoot: strings.Repeat("f", 64)}
+	store := &stubAnchorStore{
+		// A full batch is followed by another lookup; the short one ends the run.
+		unanchored: [][]persistence.EntityAnchorLeaf{leaves[:2], leaves[2:]},
+		pending:    []persistence.EntityAnchorBatch{pending},
+		anchored:   map[uuid.UUID]string{},
+		failures:   map[uuid.UUID]string{},
+	}
+	spaces := stubSpaceLister{{TenantID: uuid.New(), Slug: "acme"}}
+	anchor := anchoring.NewMockAnchor()
+
+	worker := NewAnchorWorker(store, anchor, spaces, zap.NewNop(), AnchorWorkerConfig{BatchSize: 2})
+	require.NoError(t, worker.RunOnce(context.Background()))
+
+	require.Len(t, store.created, 2)
+	require.Len(t, store.anchored, 3)
+	require.Contains(t, store.anchored, pending.BatchID)
+	tree, err := anchoring.NewTree(anchorLeaves(leaves[:2]))
+	require.NoError(t, err)
+	require.Equal(t, tree.Root(), store.created[0].MerkleRoot)
+	require.Equal(t, "mock", store.created[0].Anchor)
+
+	failing := &stubAnchorStore{
+		unanchored: [][]persistence.EntityAnchorLeaf{leaves},
+		anchored:   map[uuid.UUID]string{},
+		failures:   map[uuid.UUID]string{},
+	}
+	worker = NewAnchorWorker(failing, failingAnchor{}, spaces, zap.NewNop(), AnchorWorkerConfig{})
+	require.NoError(t, worker.RunOnce(context.Background()))
+	require.Len(t, failing.created, 1)
+	require.Empty(t, failing.anchored)
+	require.Equal(t, "ledger unavailable", failing.failures[failing.created[0].BatchID])
+}
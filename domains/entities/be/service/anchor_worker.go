@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/anchoring"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// VersionAnchorStore keeps the anchor batches of a tenant; satisfied by persistence.EntityAnchorStore.
+type VersionAnchorStore interface {
+	UnanchoredVersions(ctx context.Context, space tenant.Space, limit int) ([]persistence.EntityAnchorLeaf, error)
+	CreateAnchorBatch(ctx context.Context, space tenant.Space, batch persistence.EntityAnchorBatch, leaves []persistence.EntityAnchorLeaf) (persistence.EntityAnchorBatch, error)
+	PendingAnchorBatches(ctx context.Context, space tenant.Space, limit int) ([]persistence.EntityAnchorBatch, error)
+	MarkAnchorBatchAnchored(ctx context.Context, space tenant.Space, batchID uuid.UUID, reference string, anchoredAt time.Time) error
+	RecordAnchorBatchFailure(ctx context.Context, space tenant.Space, batchID uuid.UUID, message string) error
+}
+
+// AnchorWorkerConfig tunes the anchoring. Zero values fall back to defaults.
+type AnchorWorkerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// AnchorWorker periodically groups the entity versions of every active tenant that are not anchored yet into a batch,
+// computes its Merkle root and submits the root to an anchor. Batches whose submission fails stay pending and are
+// submitted again on the next run.
+type AnchorWorker struct {
+	store  VersionAnchorStore
+	anchor anchoring.Anchor
+	spaces SpaceLister
+	logger *zap.Logger
+	cfg    AnchorWorkerConfig
+}
+
+// NewAnchorWorker constructs an AnchorWorker with defaults applied to cfg.
+func NewAnchorWorker(store VersionAnchorStore, anchor anchoring.Anchor, spaces SpaceLister, logger *zap.Logger, cfg AnchorWorkerConfig) *AnchorWorker {
+	if store == nil {
+		panic("anchor store is required")
+	}
+	if anchor == nil {
+		panic("anchor is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 10 * time.Minute
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 1000
+	}
+
+	return &AnchorWorker{store: store, anchor: anchor, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// Run anchors every tenant each Interval until ctx is cancelled.
+func (w *AnchorWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			w.logger.Error("entity anchoring failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce anchors every active tenant once. Failures in one tenant are logged and do not stop the others.
+func (w *AnchorWorker) RunOnce(ctx context.Context) error {
+	spaces, err := w.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := w.anchorSpace(ctx, space); err != nil {
+			w.logger.Error("entity anchoring for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (w *AnchorWorker) anchorSpace(ctx context.Context, space tenant.Space) error {
+	// Batches are built from and submitted for what these reads return, so a lagging replica must not answer them.
+	ctx = persistence.WithPrimaryReads(ctx)
+	pending, err := w.store.PendingAnchorBatches(ctx, space, w.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("list pending anchor batches: %w", err)
+	}
+	for _, batch := range pending {
+		if err := w.submit(ctx, space, batch); err != nil {
+			// The anchor is likely unavailable; new batches would only pile up behind this one.
+			return err
+		}
+	}
+
+	for {
+		leaves, err := w.store.UnanchoredVersions(ctx, space, w.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("list unanchored versions: %w", err)
+		}
+		if len(leaves) == 0 {
+			return nil
+		}
+
+		tree, err := anchoring.NewTree(anchorLeaves(leaves))
+		if err != nil {
+			return fmt.Errorf("build merkle tree: %w", err)
+		}
+		batch, err := w.store.CreateAnchorBatch(ctx, space, persistence.EntityAnchorBatch{
+			BatchID:    uuid.New(),
+			Anchor:     w.anchor.Name(),
+			MerkleRoot: tree.Root(),
+		}, leaves)
+		if errors.Is(err, persistence.ErrAnchorLeafTaken) {
+			// Another replica batched some of these versions first; leave them to it.
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("create anchor batch: %w", err)
+		}
+
+		if err := w.submit(ctx, space, batch); err != nil {
+			return err
+		}
+		if len(leaves) < w.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (w *AnchorWorker) submit(ctx context.Context, space tenant.Space, batch persistence.EntityAnchorBatch) error {
+	receipt, err := w.anchor.Submit(ctx, batch.MerkleRoot)
+	if err != nil {
+		if recordErr := w.store.RecordAnchorBatchFailure(ctx, space, batch.BatchID, err.Error()); recordErr != nil {
+			w.logger.Error("record anchor failure", zap.String("tenant", space.Slug), zap.Stringer("batch", batch.BatchID), zap.Error(recordErr))
+		}
+		return fmt.Errorf("submit anchor batch %s: %w", batch.BatchID, err)
+	}
+
+	anchoredAt := receipt.AnchoredAt
+	if anchoredAt.IsZero() {
+		anchoredAt = time.Now().UTC()
+	}
+	if err := w.store.MarkAnchorBatchAnchored(ctx, space, batch.BatchID, receipt.Reference, anchoredAt); err != nil {
+		return fmt.Errorf("mark anchor batch %s anchored: %w", batch.BatchID, err)
+	}
+
+	w.logger.Info("entity versions anchored",
+		zap.String("tenant", space.Slug),
+		zap.Stringer("batch", batch.BatchID),
+		zap.String("anchor", w.anchor.Name()),
+		zap.String("root", batch.MerkleRoot),
+		zap.Int("versions", batch.LeafCount),
+		zap.String("reference", receipt.Reference),
+	)
+	return nil
+}
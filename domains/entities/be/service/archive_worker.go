@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// VersionArchiver moves a tenant's cold entity versions to object storage.
+type VersionArchiver interface {
+	ArchiveVersions(ctx context.Context, space tenant.Space) ([]persistence.EntityArchiveReport, error)
+}
+
+// ArchiveWorkerConfig tunes the archival. Zero values fall back to defaults.
+type ArchiveWorkerConfig struct {
+	Interval time.Duration
+}
+
+// ArchiveWorker periodically archives the cold entity versions of every active tenant, so superseded history does not
+// keep growing the tenant tables.
+type ArchiveWorker struct {
+	archiver VersionArchiver
+	spaces   SpaceLister
+	logger   *zap.Logger
+	cfg      ArchiveWorkerConfig
+}
+
+// NewArchiveWorker constructs an ArchiveWorker with defaults applied to cfg.
+func NewArchiveWorker(archiver VersionArchiver, spaces SpaceLister, logger *zap.Logger, cfg ArchiveWorkerConfig) *ArchiveWorker {
+	if archiver == nil {
+		panic("version archiver is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Hour
+	}
+
+	return &ArchiveWorker{archiver: archiver, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// Run archives every tenant each Interval until ctx is cancelled.
+func (w *ArchiveWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			w.logger.Error("entity version archival failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce archives every active tenant once. Failures in one tenant are logged and do not stop the others.
+func (w *ArchiveWorker) RunOnce(ctx context.Context) error {
+	spaces, err := w.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		reports, err := w.archiver.ArchiveVersions(ctx, space)
+		if err != nil {
+			w.logger.Error("entity version archival for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+		}
+		for _, report := range reports {
+			w.logger.Info("entity versions archived",
+				zap.String("tenant", space.Slug),
+				zap.String("table", report.Table),
+				zap.Int("versions", report.Versions),
+				zap.Strings("objects", report.Objects),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestService_DiffVersions(t *testing.T) {
+	payloads := map[string]string{
+		"1.0.0": `{"name":"Lotus","rarity":"rare","tags":["a","b"],"meta/x":{"set":"alpha"}}`,
+		"1.0.3": `{"name":"Black Lotus","tags":["a"],"meta/x":{"set":"alpha","reprint":false},"price":100}`,
+	}
+	repo := &stubRepository{
+		getVersionFn: func(_ context.Context, table string, entityID string, version persistence.SemanticVersion) (persistence.EntityRecord, error) {
+			require.Equal(t, "cards_entities", table)
+			return persistence.EntityRecord{EntityID: entityID, EntityVersion: version, Payload: json.RawMessage(payloads[version.String()])}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	diff, err := svc.Diff(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", "1.0.0", "1.0.3")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", diff.FromVersion.String())
+	require.Equal(t, "1.0.3", diff.ToVersion.String())
+	require.Equal(t, []Change{
+		{Op: ChangeAdded, Path: "/meta~1x/reprint", NewValue: false},
+		{Op: ChangeChanged, Path: "/name", OldValue: "Lotus", NewValue: "Black Lotus"},
+		{Op: ChangeAdded, Path: "/price", NewValue: float64(100)},
+		{Op: ChangeRemoved, Path: "/rarity", OldValue: "rare"},
+		{Op: ChangeRemoved, Path: "/tags/1", OldValue: "b"},
+	}, diff.Changes)
+}
+
+func TestService_DiffInvalidVersion(t *testing.T) {
+	svc := New(&stubRepository{}, nil)
+	_, err := svc.Diff(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", "latest", "1.0.1")
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
+func TestService_DiffMissingVersion(t *testing.T) {
+	repo := &stubRepository{
+		getVersionFn: func(context.Context, string, string, persistence.SemanticVersion) (persistence.EntityRecord, error) {
+			return persistence.EntityRecord{}, persistence.ErrEntityNotFound
+		},
+	}
+	svc := New(repo, nil)
+	_, err := svc.Diff(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", "1.0.0", "1.0.1")
+	require.ErrorIs(t, err, ErrDocumentNotFound)
+}
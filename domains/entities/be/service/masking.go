@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// PermissionPIIRead lets callers read properties declared x-pii unmasked.
+const PermissionPIIRead = "pii:read"
+
+// ErrPIIReadRequired rejects patches to documents with masked fields from callers that cannot read them, since a
+// patch could copy or test their values.
+var ErrPIIReadRequired = errors.New("patching documents with x-pii fields requires " + PermissionPIIRead)
+
+type piiAccessKey struct{}
+
+// WithPIIAccess marks ctx as granted PermissionPIIRead, so documents are returned without masking.
+func WithPIIAccess(ctx context.Context) context.Context {
+	return context.WithValue(ctx, piiAccessKey{}, true)
+}
+
+// PIIAccessAllowed reports whether ctx was marked by WithPIIAccess.
+func PIIAccessAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(piiAccessKey{}).(bool)
+	return allowed
+}
+
+// piiMasking returns the masking applied to the documents of tableName returned to the caller: the x-pii-mask of
+// the table's active schema, or none for callers granted PermissionPIIRead. The x-pii fields are redacted from the
+// request's audit entry whatever the caller may read.
+func (s *service) piiMasking(ctx context.Context, tableName string) (persistence.PIIMasking, error) {
+	if PIIAccessAllowed(ctx) && !platformlogging.Auditing(ctx) {
+		return persistence.PIIMasking{Mode: persistence.PIIMaskNone}, nil
+	}
+	masking, err := s.repo.PIIMasking(ctx, tableName)
+	if err != nil {
+		return persistence.PIIMasking{}, translateError(err)
+	}
+	platformlogging.RedactFields(ctx, masking.Fields...)
+	if PIIAccessAllowed(ctx) {
+		return persistence.PIIMasking{Mode: persistence.PIIMaskNone}, nil
+	}
+	return masking, nil
+}
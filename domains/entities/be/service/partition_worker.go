@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceLister enumerates the tenant spaces whose entity tables the partition worker maintains.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// PartitionMaintainer pre-creates and retires the monthly partitions of a tenant's partitioned entity tables.
+type PartitionMaintainer interface {
+	MaintainPartitions(ctx context.Context, space tenant.Space) ([]persistence.EntityPartitionMaintenance, error)
+}
+
+// PartitionWorkerConfig tunes the partition maintenance. Zero values fall back to defaults.
+type PartitionWorkerConfig struct {
+	Interval time.Duration
+}
+
+// PartitionWorker periodically maintains the partitions of every active tenant's partitioned entity tables, so the
+// coming months always have a partition and expired history is detached.
+type PartitionWorker struct {
+	partitions PartitionMaintainer
+	spaces     SpaceLister
+	logger     *zap.Logger
+	cfg        PartitionWorkerConfig
+}
+
+// NewPartitionWorker constructs a PartitionWorker with defaults applied to cfg.
+func NewPartitionWorker(partitions PartitionMaintainer, spaces SpaceLister, logger *zap.Logger, cfg PartitionWorkerConfig) *PartitionWorker {
+	if partitions == nil {
+		panic("partition maintainer is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 6 * time.Hour
+	}
+
+	return &PartitionWorker{partitions: partitions, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// Run maintains every tenant each Interval until ctx is cancelled.
+func (w *PartitionWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			w.logger.Error("entity partition maintenance failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce maintains every active tenant once. Failures in one tenant are logged and do not stop the others.
+func (w *PartitionWorker) RunOnce(ctx context.Context) error {
+	spaces, err := w.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		reports, err := w.partitions.MaintainPartitions(ctx, space)
+		if err != nil {
+			w.logger.Error("entity partition maintenance for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+		}
+		for _, report := range reports {
+			if len(report.Created)+len(report.Detached)+len(report.Kept) == 0 {
+				continue
+			}
+			w.logger.Info("entity partition maintenance",
+				zap.String("tenant", space.Slug),
+				zap.String("table", report.Table),
+				zap.Strings("created", report.Created),
+				zap.Strings("detached", report.Detached),
+				zap.Strings("dropped", report.Dropped),
+				zap.Strings("kept", report.Kept),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// verifyReferences checks that every x-ref property in the payload points at an active entity of the referenced
+// table within the caller's tenant. Missing targets are reported per field under "payload.<path>". Targets are read
+// from the primary, so a document written just before is found.
+func (s *service) verifyReferences(ctx context.Context, tableName string, payload map[string]interface{}) error {
+	ctx = persistence.WithPrimaryReads(ctx)
+	fields, err := s.repo.References(ctx, tableName)
+	if err != nil {
+		return translateError(err)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	fieldErrors := FieldErrors{}
+	for _, field := range fields {
+		ids := field.Values(payload)
+		if len(ids) == 0 {
+			continue
+		}
+		key := "payload." + field.Name()
+
+		records, err := s.repo.GetMany(ctx, field.Table, ids)
+		if err != nil {
+			var idErr *persistence.InvalidEntityIdentifierError
+			switch {
+			case errors.Is(err, persistence.ErrSchemaNotFound):
+				fieldErrors.add(key, fmt.Sprintf("referenced table %q does not exist", field.Table))
+				continue
+			case errors.As(err, &idErr):
+				fieldErrors.add(key, idErr.Error())
+				continue
+			default:
+				return err
+			}
+		}
+
+		found := make(map[string]struct{}, len(records))
+		for _, record := range records {
+			found[record.EntityID] = struct{}{}
+		}
+		for _, id := range ids {
+			if _, ok := found[strings.TrimSpace(id)]; !ok {
+				fieldErrors.add(key, fmt.Sprintf("referenced entity %q not found in %s", id, field.Table))
+			}
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return &ValidationError{Reason: "payload references missing entities", Fields: fieldErrors}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestService_CreateRejectsDanglingReferences(t *testing.T) {
+	created := false
+	repo := &stubRepository{
+		referencesFn: func(_ context.Context, table string) ([]persistence.ReferenceField, error) {
+			require.Equal(t, "cards_entities", table)
+			return []persistence.ReferenceField{
+				{Path: []string{"deckId"}, Table: "decks_entities"},
+				{Path: []string{"setIds"}, Table: "sets_entities", Many: true},
+			}, nil
+		},
+		getManyFn: func(_ context.Context, table string, ids []string) ([]persistence.EntityRecord, error) {
+			switch table {
+			case "decks_entities":
+				require.Equal(t, []string{"deck-1"}, ids)
+				return []persistence.EntityRecord{{EntityID: "deck-1"}}, nil
+			case "sets_entities":
+				return nil, persistence.ErrSchemaNotFound
+			}
+			t.Fatalf("unexpected table %s", table)
+			return nil, nil
+		},
+		createFn: func(context.Context, string, string, json.RawMessage, *string) (persistence.EntityRecord, error) {
+			created = true
+			return persistence.EntityRecord{}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	_, err := svc.Create(context.Background(), requesttrace.Anonymous(""), "cards_entities", nil, map[string]interface{}{
+		"deckId": "deck-1",
+		"setIds": []interface{}{"alpha"},
+	})
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+	require.Contains(t, valErr.Fields, "payload.setIds")
+	require.NotContains(t, valErr.Fields, "payload.deckId")
+	require.False(t, created)
+}
+
+func TestService_UpdateRejectsInactiveReference(t *testing.T) {
+	repo := &stubRepository{
+		referencesFn: func(context.Context, string) ([]persistence.ReferenceField, error) {
+			return []persistence.ReferenceField{{Path: []string{"owner", "id"}, Table: "players_entities"}}, nil
+		},
+		getManyFn: func(context.Context, string, []string) ([]persistence.EntityRecord, error) {
+			return nil, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	_, err := svc.Update(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", map[string]interface{}{
+		"owner": map[string]interface{}{"id": "player-9"},
+	}, nil)
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+	require.Equal(t, []string{`referenced entity "player-9" not found in players_entities`}, valErr.Fields["payload.owner.id"])
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// RetentionPurger permanently removes soft-deleted records past their retention window.
+type RetentionPurger interface {
+	PurgeEntities(ctx context.Context, space tenant.Space, opts persistence.PurgeOptions) (persistence.PurgeReport, error)
+	PurgeSchemas(ctx context.Context, opts persistence.PurgeOptions) (persistence.PurgeReport, error)
+}
+
+// RetentionConfig tunes the retention cleanup. Zero values fall back to defaults: soft-deleted records are kept
+// for 30 days.
+type RetentionConfig struct {
+	Window time.Duration
+}
+
+// retentionPurgedBy names the scheduled cleanup as the actor in purge_audit.
+const retentionPurgedBy = "scheduler retention-cleanup"
+
+// RetentionCleanup purges the soft-deleted entities of every active tenant, then the platform's schema versions and
+// categories, once they are older than the retention window. It is the scheduled counterpart of the platform admin
+// CLI cleanup command.
+type RetentionCleanup struct {
+	purger RetentionPurger
+	spaces SpaceLister
+	logger *zap.Logger
+	cfg    RetentionConfig
+	now    func() time.Time
+}
+
+// NewRetentionCleanup constructs a RetentionCleanup with defaults applied to cfg.
+func NewRetentionCleanup(purger RetentionPurger, spaces SpaceLister, logger *zap.Logger, cfg RetentionConfig) *RetentionCleanup {
+	if purger == nil {
+		panic("retention purger is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Window <= 0 {
+		cfg.Window = 30 * 24 * time.Hour
+	}
+
+	return &RetentionCleanup{purger: purger, spaces: spaces, logger: logger, cfg: cfg, now: time.Now}
+}
+
+// RunOnce runs one cleanup. Failures in one tenant do not stop the others; they are returned together.
+func (c *RetentionCleanup) RunOnce(ctx context.Context) error {
+	spaces, err := c.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	opts := persistence.PurgeOptions{
+		Before:   c.now().UTC().Add(-c.cfg.Window),
+		RunID:    uuid.New(),
+		PurgedBy: retentionPurgedBy,
+	}
+	var errs []error
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		report, err := c.purger.PurgeEntities(ctx, space, opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", space.Slug, err))
+			continue
+		}
+		c.log(space.Slug, opts.RunID, report)
+	}
+
+	report, err := c.purger.PurgeSchemas(ctx, opts)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("platform schemas: %w", err))
+	} else {
+		c.log("platform", opts.RunID, report)
+	}
+	return errors.Join(errs...)
+}
+
+func (c *RetentionCleanup) log(target string, runID uuid.UUID, report persistence.PurgeReport) {
+	if len(report.Purged)+len(report.Skipped) == 0 {
+		return
+	}
+	c.logger.Info("soft-deleted records purged",
+		zap.String("target", target),
+		zap.String("run", runID.String()),
+		zap.Int("purged", len(report.Purged)),
+		zap.Int("kept", len(report.Skipped)),
+	)
+}
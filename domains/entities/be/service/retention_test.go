@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type staticSpaces []tenant.Space
+
+func (s staticSpaces) ListActiveSpaces(context.Context) ([]tenant.Space, error) { return s, nil }
+
+type recordingPurger struct {
+	tenants []string
+	schemas int
+	before  time.Time
+}
+
+func (p *recordingPurger) PurgeEntities(_ context.Context, space tenant.Space, opts persistence.PurgeOptions) (persistence.PurgeReport, error) {
+	p.before = opts.Before
+	if space.Slug == "broken" {
+		return persistence.PurgeReport{}, errors.New("lock timeout")
+	}
+	p.tenants = append(p.tenants, space.Slug)
+	return persistence.PurgeReport{}, nil
+}
+
+func (p *recordingPurger) PurgeSchemas(context.Context, persistence.PurgeOptions) (persistence.PurgeReport, error) {
+	p.schemas++
+	return persistence.PurgeReport{}, nil
+}
+
+func TestRetentionCleanupPurgesEveryTenantAndSchemas(t *testing.T) {
+	purger := &recordingPurger{}
+	cleanup := NewRetentionCleanup(purger, staticSpaces{{Slug: "acme"}, {Slug: "broken"}, {Slug: "globex"}}, zap.NewNop(), RetentionConfig{Window: 24 * time.Hour})
+	now := time.Date(2026, 1, 14, 3, 0, 0, 0, time.UTC)
+	cleanup.now = func() time.Time { return now }
+
+	err := cleanup.RunOnce(context.Background())
+	require.ErrorContains(t, err, "tenant broken: lock timeout")
+	require.Equal(t, []string{"acme", "globex"}, purger.tenants)
+	require.Equal(t, 1, purger.schemas)
+	require.Equal(t, now.Add(-24*time.Hour), purger.before)
+}
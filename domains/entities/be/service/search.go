@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	domainrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// payloadFilterPrefix marks filter fields, like sort fields, as payload properties.
+const payloadFilterPrefix = "payload."
+
+// SearchOptions defines a document search. Filters are "payload.<path>:<value>" expressions that must all match.
+type SearchOptions struct {
+	Query    string
+	Filters  []string
+	Page     int
+	PageSize int
+}
+
+// Search returns the active documents of a table matching the query text and filters, most relevant first.
+func (s *service) Search(ctx context.Context, audit requesttrace.AuditInfo, tableName string, opts SearchOptions) (ListResult, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return ListResult{}, &ValidationError{Reason: "tableName is required"}
+	}
+
+	filters, fieldErrs := parseSearchFilters(opts.Filters)
+	if len(fieldErrs) > 0 {
+		return ListResult{}, &ValidationError{Reason: "invalid search filter", Fields: fieldErrs}
+	}
+
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return ListResult{}, err
+	}
+	// Filtering on a masked value would reveal it one guess at a time.
+	if masking.Enabled() {
+		for _, filter := range filters {
+			if maskedPath(masking.Fields, filter.Path) {
+				return ListResult{}, &ValidationError{Reason: fmt.Sprintf("filtering on x-pii field %q requires %s", strings.Join(filter.Path, "."), PermissionPIIRead)}
+			}
+		}
+	}
+
+	page := pagination.New(opts.Page, opts.PageSize)
+	result, err := s.repo.Search(ctx, tableName, domainrepo.SearchParams{
+		Text:     strings.TrimSpace(opts.Query),
+		Filters:  filters,
+		Page:     page.Page,
+		PageSize: page.PageSize,
+	})
+	if err != nil {
+		return ListResult{}, translateError(err)
+	}
+
+	items := make([]Document, 0, len(result.Records))
+	for _, record := range result.Records {
+		doc, mapErr := mapRecord(record, masking)
+		if mapErr != nil {
+			return ListResult{}, mapErr
+		}
+		items = append(items, doc)
+	}
+
+	return ListResult{Items: items, Envelope: page.Envelope(int(result.Total))}, nil
+}
+
+// parseSearchFilters turns "payload.<path>:<value>" expressions into payload filters.
+func parseSearchFilters(raw []string) ([]persistence.EntityFilter, FieldErrors) {
+	filters := make([]persistence.EntityFilter, 0, len(raw))
+	fieldErrs := FieldErrors{}
+	for _, expression := range raw {
+		field, value, ok := strings.Cut(expression, ":")
+		path := strings.Split(strings.TrimPrefix(field, payloadFilterPrefix), ".")
+		if !ok || !strings.HasPrefix(field, payloadFilterPrefix) || slices.Contains(path, "") {
+			fieldErrs.add("filter", fmt.Sprintf("%q must have the form payload.<path>:<value>", expression))
+			continue
+		}
+		filters = append(filters, persistence.EntityFilter{Path: path, Value: value})
+	}
+	return filters, fieldErrs
+}
+
+// maskedPath reports whether path is a masked field or lies below one.
+func maskedPath(masked [][]string, path []string) bool {
+	for _, field := range masked {
+		if len(field) <= len(path) && slices.Equal(path[:len(field)], field) {
+			return true
+		}
+	}
+	return false
+}
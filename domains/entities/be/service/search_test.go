@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domainrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestService_Search(t *testing.T) {
+	repo := &stubRepository{
+		piiMaskingFn: func(context.Context, string) (persistence.PIIMasking, error) {
+			return persistence.PIIMasking{Mode: persistence.PIIMaskNull, Fields: [][]string{{"owner", "email"}}}, nil
+		},
+		searchFn: func(_ context.Context, table string, params domainrepo.SearchParams) (domainrepo.ListResult, error) {
+			require.Equal(t, "cards_entities", table)
+			require.Equal(t, "black lotus", params.Text)
+			require.Equal(t, []persistence.EntityFilter{{Path: []string{"set", "code"}, Value: "LEA:1"}}, params.Filters)
+			require.Equal(t, 2, params.Page)
+			return domainrepo.ListResult{
+				Records: []persistence.EntityRecord{{
+					EntityID: "card-1",
+					Payload:  json.RawMessage(`{"name":"Black Lotus","owner":{"email":"ann@example.com"}}`),
+				}},
+				Total: 21,
+			}, nil
+		},
+	}
+	svc := New(repo, nil)
+	audit := requesttrace.Anonymous("")
+
+	result, err := svc.Search(context.Background(), audit, "cards_entities", SearchOptions{
+		Query:    " black lotus ",
+		Filters:  []string{"payload.set.code:LEA:1"},
+		Page:     2,
+		PageSize: 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 21, result.TotalItems)
+	require.Len(t, result.Items, 1)
+	require.Nil(t, result.Items[0].Payload["owner"].(map[string]interface{})["email"], "masking applies to search results")
+
+	var validationErr *ValidationError
+	_, err = svc.Search(context.Background(), audit, "cards_entities", SearchOptions{Filters: []string{"name:lotus"}})
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "filter")
+
+	_, err = svc.Search(context.Background(), audit, "cards_entities", SearchOptions{Filters: []string{"payload.owner.email:ann@example.com"}})
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Reason, PermissionPIIRead)
+}
@@ -5,21 +5,33 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"strings"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/google/uuid"
 	"github.com/santhosh-tekuri/jsonschema/v5"
 
 	domainrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/features"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
+// FieldErrors maps payload fields to validation issues.
+type FieldErrors map[string][]string
+
+func (f FieldErrors) add(field, message string) {
+	f[field] = append(f[field], message)
+}
+
 // ValidationError captures payload validation issues surfaced by the JSON schema validator.
+// Fields is populated when the issue can be attributed to specific payload properties.
 type ValidationError struct {
 	Reason string
+	Fields FieldErrors
 }
 
 func (e *ValidationError) Error() string {
@@ -31,8 +43,21 @@ var (
 	ErrTableNotFound    = errors.New("table not found")
 	ErrDocumentNotFound = errors.New("document not found")
 	ErrConflict         = errors.New("entity conflict")
+	ErrStaleDocument    = errors.New("document version is stale")
+	// ErrDecryptionRequired rejects patches to documents with encrypted fields from callers that cannot read them,
+	// since a patch could copy or test their values.
+	ErrDecryptionRequired    = errors.New("patching documents with encrypted fields requires " + PermissionEntitiesDecrypt)
+	ErrEncryptionUnavailable = errors.New("payload encryption is not configured")
+	// ErrBulkImportDisabled is returned while the features.BulkImport flag is off for the caller's tenant.
+	ErrBulkImportDisabled = errors.New("bulk import is not enabled for this tenant")
+	// ErrTableNotProvisioned is returned for writes to a table that was never created in the caller's tenant; the
+	// tenant migrations create it.
+	ErrTableNotProvisioned = errors.New("entity table has not been created for this tenant")
 )
 
+// PermissionEntitiesDecrypt lets callers read payload fields declared x-encrypt or x-pii in clear.
+const PermissionEntitiesDecrypt = "entities:decrypt"
+
 // Document represents an entity record enriched for API rendering.
 type Document struct {
 	EntityID      string
@@ -40,6 +65,7 @@ type Document struct {
 	SchemaID      uuid.UUID
 	SchemaVersion persistence.SemanticVersion
 	Payload       map[string]interface{}
+	Hash          string
 	CreatedAt     time.Time
 	IsActive      bool
 	IsDeleted     bool
@@ -47,40 +73,100 @@ type Document struct {
 
 // ListResult contains paginated documents and metadata.
 type ListResult struct {
-	Items      []Document
-	Page       int
-	PageSize   int
-	TotalItems int64
-	TotalPages int
+	Items []Document
+	pagination.Envelope
 }
 
 // ListOptions defines pagination inputs.
+// Cursor holds an opaque token from a previous ListResult.NextCursor; when set, Page is ignored.
 type ListOptions struct {
 	Page     int
 	PageSize int
 	Sort     string
+	Cursor   string
+}
+
+// MaxBulkItems caps the number of rows accepted by a single bulk import.
+const MaxBulkItems = 1000
+
+// MaxBatchGetIDs caps the number of identifiers accepted by a single batch get.
+const MaxBatchGetIDs = 100
+
+// BatchGetResult holds the documents found by a batch get, in request order, plus the ids that were not found.
+type BatchGetResult struct {
+	Items   []Document
+	Missing []string
+}
+
+// BulkItem is a single row submitted for bulk import.
+// Err carries a decoding failure detected upstream; such rows are reported as failed and never persisted.
+type BulkItem struct {
+	EntityID *string
+	Payload  map[string]interface{}
+	Err      error
+}
+
+// BulkRowResult reports the outcome of one bulk row; exactly one of Document or Err is set.
+type BulkRowResult struct {
+	Index    int
+	Document *Document
+	Err      error
+}
+
+// BulkResult aggregates the per-row outcome of a bulk import.
+type BulkResult struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Rows      []BulkRowResult
+}
+
+// PatchFormat identifies how a partial update document should be interpreted.
+type PatchFormat string
+
+// Supported partial update formats.
+const (
+	PatchFormatJSONPatch  PatchFormat = "json-patch"  // RFC 6902
+	PatchFormatMergePatch PatchFormat = "merge-patch" // RFC 7386
+)
+
+// Patch carries a raw partial update document together with its format.
+type Patch struct {
+	Format PatchFormat
+	Body   json.RawMessage
 }
 
 // Service exposes entity operations backed by the persistence layer.
 type Service interface {
 	List(ctx context.Context, audit requesttrace.AuditInfo, tableName string, opts ListOptions) (ListResult, error)
+	Search(ctx context.Context, audit requesttrace.AuditInfo, tableName string, opts SearchOptions) (ListResult, error)
 	Create(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID *string, payload map[string]interface{}) (Document, error)
+	BulkCreate(ctx context.Context, audit requesttrace.AuditInfo, tableName string, items []BulkItem) (BulkResult, error)
 	Get(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) (Document, error)
-	Update(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, payload map[string]interface{}) (Document, error)
+	GetVersion(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, version string) (Document, error)
+	BatchGet(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityIDs []string) (BatchGetResult, error)
+	Update(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, payload map[string]interface{}, expectedHash *string) (Document, error)
+	Diff(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, from string, to string) (Diff, error)
+	Patch(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, patch Patch, expectedHash *string) (Document, error)
 	Delete(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) error
+	Validate(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID *string, payload map[string]interface{}) (ValidationResult, error)
+	Verify(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) (Verification, error)
+	Proof(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, version string) (Proof, error)
+	Watch(ctx context.Context, audit requesttrace.AuditInfo, tableName string) (<-chan persistence.EntityChange, func(), error)
 }
 
 type service struct {
-	repo domainrepo.Repository
+	repo   domainrepo.Repository
+	quotas tenant.QuotaChecker
 }
 
-// New constructs a Service instance.
-func New(repo domainrepo.Repository) Service {
+// New constructs a Service instance. quotas may be nil, which disables tenant quota enforcement.
+func New(repo domainrepo.Repository, quotas tenant.QuotaChecker) Service {
 	if repo == nil {
 		panic("entities repository is required")
 	}
 
-	return &service{repo: repo}
+	return &service{repo: repo, quotas: quotas}
 }
 
 func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, tableName string, opts ListOptions) (ListResult, error) { //nolint:revive // audit reserved for persistence layer wiring
@@ -88,22 +174,30 @@ func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, tableN
 		return ListResult{}, &ValidationError{Reason: "tableName is required"}
 	}
 
-	page := opts.Page
-	if page < 1 {
-		page = 1
-	}
-	pageSize := opts.PageSize
-	if pageSize <= 0 || pageSize > 100 {
-		pageSize = 20
-	}
+	page := pagination.New(opts.Page, opts.PageSize)
 
 	sortColumn, sortOrder := normalizeSort(opts.Sort)
 
+	var cursor *persistence.EntityCursor
+	if opts.Cursor != "" {
+		decoded, err := persistence.DecodeEntityCursor(opts.Cursor)
+		if err != nil {
+			return ListResult{}, &ValidationError{Reason: "cursor is invalid"}
+		}
+		cursor = &decoded
+	}
+
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return ListResult{}, err
+	}
+
 	result, err := s.repo.List(ctx, tableName, domainrepo.ListParams{
-		Page:       page,
-		PageSize:   pageSize,
+		Page:       page.Page,
+		PageSize:   page.PageSize,
 		SortColumn: sortColumn,
 		SortOrder:  sortOrder,
+		Cursor:     cursor,
 	})
 	if err != nil {
 		return ListResult{}, translateError(err)
@@ -111,25 +205,19 @@ func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, tableN
 
 	items := make([]Document, 0, len(result.Records))
 	for _, record := range result.Records {
-		doc, mapErr := mapRecord(record)
+		doc, mapErr := mapRecord(record, masking)
 		if mapErr != nil {
 			return ListResult{}, mapErr
 		}
 		items = append(items, doc)
 	}
 
-	totalPages := 0
-	if pageSize > 0 {
-		totalPages = int(math.Ceil(float64(result.Total) / float64(pageSize)))
+	envelope := page.Envelope(int(result.Total))
+	if result.NextCursor != nil {
+		envelope.NextCursor = result.NextCursor.Encode()
 	}
 
-	return ListResult{
-		Items:      items,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalItems: result.Total,
-		TotalPages: totalPages,
-	}, nil
+	return ListResult{Items: items, Envelope: envelope}, nil
 }
 
 func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID *string, payload map[string]interface{}) (Document, error) { //nolint:revive // audit reserved for persistence layer wiring
@@ -148,17 +236,130 @@ func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, tabl
 		}
 	}
 
+	// Resolved first so the x-pii fields are redacted from the audit entry even when the write fails.
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return Document{}, err
+	}
+	if err := s.verifyReferences(ctx, tableName, payload); err != nil {
+		return Document{}, err
+	}
+
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return Document{}, fmt.Errorf("encode payload: %w", err)
 	}
+	if err := s.checkQuotas(ctx, tableName, 1, len(body)); err != nil {
+		return Document{}, err
+	}
 
 	record, err := s.repo.Create(ctx, tableName, desiredID, body, audit.UserID)
 	if err != nil {
 		return Document{}, translateError(err)
 	}
 
-	return mapRecord(record)
+	return mapRecord(record, masking)
+}
+
+func (s *service) BulkCreate(ctx context.Context, audit requesttrace.AuditInfo, tableName string, items []BulkItem) (BulkResult, error) {
+	if !features.Enabled(ctx, features.BulkImport) {
+		return BulkResult{}, ErrBulkImportDisabled
+	}
+	if strings.TrimSpace(tableName) == "" {
+		return BulkResult{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if len(items) == 0 {
+		return BulkResult{}, &ValidationError{Reason: "at least one document is required"}
+	}
+	if len(items) > MaxBulkItems {
+		return BulkResult{}, &ValidationError{Reason: fmt.Sprintf("at most %d documents can be imported per request", MaxBulkItems)}
+	}
+	// Resolved first so the x-pii fields are redacted from the audit entry even when every row fails.
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	rows := make([]BulkRowResult, len(items))
+	accepted := make([]domainrepo.BulkItem, 0, len(items))
+	positions := make([]int, 0, len(items))
+	acceptedBytes := 0
+	for i, item := range items {
+		rows[i].Index = i
+		if item.Err != nil {
+			rows[i].Err = &ValidationError{Reason: item.Err.Error()}
+			continue
+		}
+		if item.Payload == nil {
+			rows[i].Err = &ValidationError{Reason: "payload is required"}
+			continue
+		}
+
+		var desiredID string
+		if item.EntityID != nil {
+			desiredID = strings.TrimSpace(*item.EntityID)
+			if desiredID == "" {
+				rows[i].Err = &ValidationError{Reason: "entityId cannot be blank"}
+				continue
+			}
+		}
+
+		if err := s.verifyReferences(ctx, tableName, item.Payload); err != nil {
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				return BulkResult{}, err
+			}
+			rows[i].Err = err
+			continue
+		}
+
+		body, err := json.Marshal(item.Payload)
+		if err != nil {
+			return BulkResult{}, fmt.Errorf("encode payload: %w", err)
+		}
+
+		accepted = append(accepted, domainrepo.BulkItem{EntityID: desiredID, Payload: body})
+		positions = append(positions, i)
+		acceptedBytes += len(body)
+	}
+
+	if len(accepted) > 0 {
+		if err := s.checkQuotas(ctx, tableName, len(accepted), acceptedBytes); err != nil {
+			return BulkResult{}, err
+		}
+
+		outcomes, err := s.repo.BulkCreate(ctx, tableName, accepted, audit.UserID)
+		if err != nil {
+			return BulkResult{}, translateError(err)
+		}
+		if len(outcomes) != len(accepted) {
+			return BulkResult{}, fmt.Errorf("bulk create returned %d results for %d rows", len(outcomes), len(accepted))
+		}
+
+		for i, outcome := range outcomes {
+			idx := positions[i]
+			if outcome.Err != nil {
+				rows[idx].Err = translateError(outcome.Err)
+				continue
+			}
+			doc, mapErr := mapRecord(*outcome.Record, masking)
+			if mapErr != nil {
+				return BulkResult{}, mapErr
+			}
+			rows[idx].Document = &doc
+		}
+	}
+
+	result := BulkResult{Total: len(items), Rows: rows}
+	for _, row := range rows {
+		if row.Err != nil {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+	}
+
+	return result, nil
 }
 
 func (s *service) Get(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) (Document, error) { //nolint:revive // audit reserved for persistence layer wiring
@@ -169,15 +370,102 @@ func (s *service) Get(ctx context.Context, audit requesttrace.AuditInfo, tableNa
 		return Document{}, &ValidationError{Reason: "entityId is required"}
 	}
 
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return Document{}, err
+	}
+
 	record, err := s.repo.Get(ctx, tableName, entityID)
 	if err != nil {
 		return Document{}, translateError(err)
 	}
 
-	return mapRecord(record)
+	return mapRecord(record, masking)
+}
+
+func (s *service) GetVersion(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, version string) (Document, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return Document{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if strings.TrimSpace(entityID) == "" {
+		return Document{}, &ValidationError{Reason: "entityId is required"}
+	}
+	parsed, err := persistence.ParseSemanticVersion(version)
+	if err != nil {
+		return Document{}, &ValidationError{Reason: fmt.Sprintf("invalid entity version %q", version)}
+	}
+
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return Document{}, err
+	}
+
+	record, err := s.repo.GetVersion(ctx, tableName, entityID, parsed)
+	if err != nil {
+		return Document{}, translateError(err)
+	}
+
+	return mapRecord(record, masking)
 }
 
-func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, payload map[string]interface{}) (Document, error) { //nolint:revive // audit reserved for persistence layer wiring
+func (s *service) BatchGet(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityIDs []string) (BatchGetResult, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return BatchGetResult{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if len(entityIDs) == 0 {
+		return BatchGetResult{}, &ValidationError{Reason: "at least one entityId is required"}
+	}
+	if len(entityIDs) > MaxBatchGetIDs {
+		return BatchGetResult{}, &ValidationError{Reason: fmt.Sprintf("at most %d entityIds can be requested at once", MaxBatchGetIDs)}
+	}
+
+	ids := make([]string, 0, len(entityIDs))
+	seen := make(map[string]struct{}, len(entityIDs))
+	for _, entityID := range entityIDs {
+		id := strings.TrimSpace(entityID)
+		if id == "" {
+			return BatchGetResult{}, &ValidationError{Reason: "entityId cannot be blank"}
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return BatchGetResult{}, err
+	}
+
+	records, err := s.repo.GetMany(ctx, tableName, ids)
+	if err != nil {
+		return BatchGetResult{}, translateError(err)
+	}
+
+	byID := make(map[string]persistence.EntityRecord, len(records))
+	for _, record := range records {
+		byID[record.EntityID] = record
+	}
+
+	result := BatchGetResult{Items: make([]Document, 0, len(records)), Missing: []string{}}
+	for _, id := range ids {
+		record, ok := byID[id]
+		if !ok {
+			result.Missing = append(result.Missing, id)
+			continue
+		}
+		doc, mapErr := mapRecord(record, masking)
+		if mapErr != nil {
+			return BatchGetResult{}, mapErr
+		}
+		result.Items = append(result.Items, doc)
+	}
+
+	return result, nil
+}
+
+func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, payload map[string]interface{}, expectedHash *string) (Document, error) { //nolint:revive // audit reserved for persistence layer wiring
 	if strings.TrimSpace(tableName) == "" {
 		return Document{}, &ValidationError{Reason: "tableName is required"}
 	}
@@ -188,17 +476,89 @@ func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, tabl
 		return Document{}, &ValidationError{Reason: "payload is required"}
 	}
 
+	// Resolved first so the x-pii fields are redacted from the audit entry even when the write fails.
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return Document{}, err
+	}
+	if err := s.verifyReferences(ctx, tableName, payload); err != nil {
+		return Document{}, err
+	}
+
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return Document{}, fmt.Errorf("encode payload: %w", err)
 	}
+	if err := s.checkQuotas(ctx, tableName, 0, len(body)); err != nil {
+		return Document{}, err
+	}
+
+	record, err := s.repo.Update(ctx, tableName, entityID, body, expectedHash, audit.UserID)
+	if err != nil {
+		return Document{}, translateError(err)
+	}
+
+	return mapRecord(record, masking)
+}
+
+func (s *service) Patch(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string, patch Patch, expectedHash *string) (Document, error) {
+	if strings.TrimSpace(tableName) == "" {
+		return Document{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if strings.TrimSpace(entityID) == "" {
+		return Document{}, &ValidationError{Reason: "entityId is required"}
+	}
+	if len(patch.Body) == 0 {
+		return Document{}, &ValidationError{Reason: "patch document is required"}
+	}
 
-	record, err := s.repo.Update(ctx, tableName, entityID, body, audit.UserID)
+	if !persistence.PayloadDecryptionAllowed(ctx) {
+		encrypted, err := s.repo.EncryptedFields(ctx, tableName)
+		if err != nil {
+			return Document{}, translateError(err)
+		}
+		if len(encrypted) > 0 {
+			return Document{}, ErrDecryptionRequired
+		}
+	}
+	masking, err := s.piiMasking(ctx, tableName)
+	if err != nil {
+		return Document{}, err
+	}
+	if masking.Enabled() {
+		return Document{}, ErrPIIReadRequired
+	}
+
+	// The patch applies to the current version and pins the update to its hash, so it is read from the primary: a
+	// lagging replica would turn every patch of a recent write into a conflict, or check expectedHash against an old
+	// version.
+	ctx = persistence.WithPrimaryReads(ctx)
+	current, err := s.repo.Get(ctx, tableName, entityID)
+	if err != nil {
+		return Document{}, translateError(err)
+	}
+	if expectedHash != nil && *expectedHash != current.Hash {
+		return Document{}, ErrStaleDocument
+	}
+
+	patched, patchedObject, err := applyPatch(current.Payload, patch)
+	if err != nil {
+		return Document{}, err
+	}
+	if err := s.verifyReferences(ctx, tableName, patchedObject); err != nil {
+		return Document{}, err
+	}
+	if err := s.checkQuotas(ctx, tableName, 0, len(patched)); err != nil {
+		return Document{}, err
+	}
+
+	// Pin the update to the version the patch was applied to so a concurrent writer cannot be overwritten.
+	record, err := s.repo.Update(ctx, tableName, entityID, patched, &current.Hash, audit.UserID)
 	if err != nil {
 		return Document{}, translateError(err)
 	}
 
-	return mapRecord(record)
+	return mapRecord(record, masking)
 }
 
 func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) error { //nolint:revive // audit reserved for persistence layer wiring
@@ -216,7 +576,52 @@ func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, tabl
 	return nil
 }
 
-func mapRecord(record persistence.EntityRecord) (Document, error) {
+// Watch subscribes to committed changes of tableName in the caller's tenant.
+// The returned cancel func must be called once the caller stops reading.
+func (s *service) Watch(ctx context.Context, audit requesttrace.AuditInfo, tableName string) (<-chan persistence.EntityChange, func(), error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return nil, nil, &ValidationError{Reason: "tableName is required"}
+	}
+
+	changes, cancel, err := s.repo.Watch(ctx, tableName)
+	if err != nil {
+		return nil, nil, translateError(err)
+	}
+
+	return changes, cancel, nil
+}
+
+func applyPatch(payload json.RawMessage, patch Patch) (json.RawMessage, map[string]interface{}, error) {
+	var (
+		patched []byte
+		err     error
+	)
+	switch patch.Format {
+	case PatchFormatJSONPatch:
+		ops, decodeErr := jsonpatch.DecodePatch(patch.Body)
+		if decodeErr != nil {
+			return nil, nil, &ValidationError{Reason: fmt.Sprintf("invalid JSON patch: %v", decodeErr)}
+		}
+		patched, err = ops.Apply(payload)
+	case PatchFormatMergePatch:
+		patched, err = jsonpatch.MergePatch(payload, patch.Body)
+	default:
+		return nil, nil, &ValidationError{Reason: fmt.Sprintf("unsupported patch format %q", patch.Format)}
+	}
+	if err != nil {
+		return nil, nil, &ValidationError{Reason: fmt.Sprintf("apply patch: %v", err)}
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(patched, &object); err != nil || object == nil {
+		return nil, nil, &ValidationError{Reason: "patched payload must be a JSON object"}
+	}
+
+	return patched, object, nil
+}
+
+// mapRecord converts record into a Document with masking applied to its payload.
+func mapRecord(record persistence.EntityRecord, masking persistence.PIIMasking) (Document, error) {
 	var payload map[string]interface{}
 	if len(record.Payload) > 0 {
 		if err := json.Unmarshal(record.Payload, &payload); err != nil {
@@ -225,6 +630,7 @@ func mapRecord(record persistence.EntityRecord) (Document, error) {
 	} else {
 		payload = map[string]interface{}{}
 	}
+	masking.Apply(payload)
 
 	return Document{
 		EntityID:      record.EntityID,
@@ -232,6 +638,7 @@ func mapRecord(record persistence.EntityRecord) (Document, error) {
 		SchemaID:      record.SchemaID,
 		SchemaVersion: record.SchemaVersion,
 		Payload:       payload,
+		Hash:          record.Hash,
 		CreatedAt:     record.CreatedAt,
 		IsActive:      record.IsActive,
 		IsDeleted:     record.IsDeleted,
@@ -252,14 +659,34 @@ func normalizeSort(sort string) (string, string) {
 		field = strings.TrimPrefix(first, "-")
 	}
 
-	switch field {
-	case "createdAt":
+	switch {
+	case field == "createdAt":
 		return "created_at", order
+	case strings.HasPrefix(field, "payload.") && len(field) > len("payload."):
+		// Payload paths are checked against the schema's x-indexed properties by the persistence layer.
+		return field, order
 	default:
 		return "created_at", "desc"
 	}
 }
 
+// checkQuotas rejects a write of entities new documents carrying size payload bytes when it would take the tenant
+// over its quotas. Every write stores a new version, so updates count against the storage quota too.
+func (s *service) checkQuotas(ctx context.Context, tableName string, entities, size int) error {
+	if s.quotas == nil {
+		return nil
+	}
+	if entities > 0 {
+		if err := s.quotas.CheckTableQuota(ctx, tableName); err != nil {
+			return err
+		}
+		if err := s.quotas.CheckQuota(ctx, tenant.QuotaEntities, int64(entities)); err != nil {
+			return err
+		}
+	}
+	return s.quotas.CheckQuota(ctx, tenant.QuotaStorageBytes, int64(size))
+}
+
 func translateError(err error) error {
 	switch {
 	case errors.Is(err, persistence.ErrSchemaNotFound):
@@ -268,15 +695,24 @@ func translateError(err error) error {
 		return ErrDocumentNotFound
 	case errors.Is(err, persistence.ErrEntityAlreadyExists):
 		return ErrConflict
+	case errors.Is(err, persistence.ErrEntityHashMismatch):
+		return ErrStaleDocument
+	case errors.Is(err, persistence.ErrPayloadEncryptionUnavailable):
+		return ErrEncryptionUnavailable
+	case errors.Is(err, persistence.ErrEntityTableMissing):
+		return ErrTableNotProvisioned
 	default:
 		var validationErr *jsonschema.ValidationError
 		if errors.As(err, &validationErr) {
-			return &ValidationError{Reason: validationErr.Error()}
+			return &ValidationError{Reason: validationErr.Error(), Fields: schemaFieldErrors(validationErr)}
 		}
 		var idErr *persistence.InvalidEntityIdentifierError
 		if errors.As(err, &idErr) {
 			return &ValidationError{Reason: idErr.Error()}
 		}
+		if errors.Is(err, persistence.ErrUnsupportedSort) {
+			return &ValidationError{Reason: err.Error()}
+		}
 		return err
 	}
 }
@@ -3,15 +3,20 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	domainrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/features"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 func TestService_ListSuccess(t *testing.T) {
@@ -39,7 +44,7 @@ func TestService_ListSuccess(t *testing.T) {
 		},
 	}
 
-	svc := New(repo)
+	svc := New(repo, nil)
 	audit := requesttrace.Anonymous("")
 	res, err := svc.List(ctx, audit, "cards_entities", ListOptions{Page: 1, PageSize: 20, Sort: "-createdAt"})
 	require.NoError(t, err)
@@ -49,8 +54,49 @@ func TestService_ListSuccess(t *testing.T) {
 	require.Equal(t, "Lotus", res.Items[0].Payload["name"])
 }
 
+func TestService_ListWithCursor(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	token := persistence.EntityCursor{CreatedAt: createdAt, EntityID: "entity-1"}.Encode()
+	repo := &stubRepository{
+		listFn: func(_ context.Context, _ string, params domainrepo.ListParams) (domainrepo.ListResult, error) {
+			require.NotNil(t, params.Cursor)
+			require.Equal(t, "entity-1", params.Cursor.EntityID)
+			require.True(t, createdAt.Equal(params.Cursor.CreatedAt))
+			next := persistence.EntityCursor{CreatedAt: createdAt, EntityID: "entity-0"}
+			return domainrepo.ListResult{NextCursor: &next}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	res, err := svc.List(context.Background(), requesttrace.Anonymous(""), "cards_entities", ListOptions{PageSize: 10, Cursor: token})
+	require.NoError(t, err)
+	decoded, err := persistence.DecodeEntityCursor(res.NextCursor)
+	require.NoError(t, err)
+	require.Equal(t, "entity-0", decoded.EntityID)
+
+	_, err = svc.List(context.Background(), requesttrace.Anonymous(""), "cards_entities", ListOptions{Cursor: "garbage"})
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
+func TestService_ListPayloadSort(t *testing.T) {
+	repo := &stubRepository{
+		listFn: func(_ context.Context, _ string, params domainrepo.ListParams) (domainrepo.ListResult, error) {
+			require.Equal(t, "payload.address.city", params.SortColumn)
+			require.Equal(t, "desc", params.SortOrder)
+			return domainrepo.ListResult{}, fmt.Errorf("%w: payload field %q is not indexed", persistence.ErrUnsupportedSort, params.SortColumn)
+		},
+	}
+
+	svc := New(repo, nil)
+	_, err := svc.List(context.Background(), requesttrace.Anonymous(""), "cards_entities", ListOptions{Sort: "-payload.address.city"})
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+	require.Contains(t, valErr.Reason, "not indexed")
+}
+
 func TestService_CreateValidation(t *testing.T) {
-	svc := New(&stubRepository{})
+	svc := New(&stubRepository{}, nil)
 	_, err := svc.Create(context.Background(), requesttrace.Anonymous(""), "", nil, map[string]interface{}{"name": "test"})
 	require.Error(t, err)
 	var valErr *ValidationError
@@ -63,36 +109,383 @@ func TestService_CreateNotFound(t *testing.T) {
 			return persistence.EntityRecord{}, persistence.ErrSchemaNotFound
 		},
 	}
-	svc := New(repo)
+	svc := New(repo, nil)
 	_, err := svc.Create(context.Background(), requesttrace.Anonymous(""), "cards_entities", nil, map[string]interface{}{"name": "test"})
 	require.ErrorIs(t, err, ErrTableNotFound)
 }
 
 func TestService_UpdateRequiresPayload(t *testing.T) {
-	svc := New(&stubRepository{})
-	_, err := svc.Update(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-123", nil)
+	svc := New(&stubRepository{}, nil)
+	_, err := svc.Update(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-123", nil, nil)
 	require.Error(t, err)
 	var valErr *ValidationError
 	require.ErrorAs(t, err, &valErr)
 }
 
+func TestService_UpdateStaleHash(t *testing.T) {
+	expected := "stale-hash"
+	repo := &stubRepository{
+		updateFn: func(_ context.Context, _ string, _ string, _ json.RawMessage, expectedHash *string, _ *string) (persistence.EntityRecord, error) {
+			require.NotNil(t, expectedHash)
+			require.Equal(t, expected, *expectedHash)
+			return persistence.EntityRecord{}, persistence.ErrEntityHashMismatch
+		},
+	}
+	svc := New(repo, nil)
+	_, err := svc.Update(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-123", map[string]interface{}{"name": "test"}, &expected)
+	require.ErrorIs(t, err, ErrStaleDocument)
+}
+
+func TestService_PatchAppliesToActivePayload(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch Patch
+		want  string
+	}{
+		{
+			name:  "json-patch",
+			patch: Patch{Format: PatchFormatJSONPatch, Body: json.RawMessage(`[{"op":"replace","path":"/name","value":"Mox"},{"op":"remove","path":"/rarity"}]`)},
+			want:  `{"name":"Mox"}`,
+		},
+		{
+			name:  "merge-patch",
+			patch: Patch{Format: PatchFormatMergePatch, Body: json.RawMessage(`{"rarity":null,"set":"alpha"}`)},
+			want:  `{"name":"Lotus","set":"alpha"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubRepository{
+				getFn: func(context.Context, string, string) (persistence.EntityRecord, error) {
+					return persistence.EntityRecord{EntityID: "entity-1", Hash: "h1", Payload: json.RawMessage(`{"name":"Lotus","rarity":"rare"}`)}, nil
+				},
+				updateFn: func(_ context.Context, _ string, _ string, payload json.RawMessage, expectedHash *string, _ *string) (persistence.EntityRecord, error) {
+					require.JSONEq(t, tt.want, string(payload))
+					require.NotNil(t, expectedHash)
+					require.Equal(t, "h1", *expectedHash)
+					return persistence.EntityRecord{EntityID: "entity-1", Payload: payload}, nil
+				},
+			}
+			svc := New(repo, nil)
+			doc, err := svc.Patch(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-1", tt.patch, nil)
+			require.NoError(t, err)
+			require.Equal(t, "entity-1", doc.EntityID)
+		})
+	}
+}
+
+func TestService_PatchReadsCurrentVersionFromPrimary(t *testing.T) {
+	// The replica still serves the version before the latest write.
+	stale := persistence.EntityRecord{EntityID: "entity-1", Hash: "h1", Payload: json.RawMessage(`{"name":"Lotus"}`)}
+	current := persistence.EntityRecord{EntityID: "entity-1", Hash: "h2", Payload: json.RawMessage(`{"name":"Black Lotus"}`)}
+	repo := &stubRepository{
+		getFn: func(ctx context.Context, _ string, _ string) (persistence.EntityRecord, error) {
+			if persistence.PrimaryReadsRequired(ctx) {
+				return current, nil
+			}
+			return stale, nil
+		},
+		updateFn: func(_ context.Context, _ string, _ string, payload json.RawMessage, expectedHash *string, _ *string) (persistence.EntityRecord, error) {
+			require.Equal(t, "h2", *expectedHash)
+			require.JSONEq(t, `{"name":"Black Lotus","set":"alpha"}`, string(payload))
+			return persistence.EntityRecord{EntityID: "entity-1", Hash: "h3", Payload: payload}, nil
+		},
+	}
+	svc := New(repo, nil)
+	patch := Patch{Format: PatchFormatMergePatch, Body: json.RawMessage(`{"set":"alpha"}`)}
+
+	latest := "h2"
+	doc, err := svc.Patch(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-1", patch, &latest)
+	require.NoError(t, err)
+	require.Equal(t, "h3", doc.Hash)
+
+	// The hash the replica still reports is stale.
+	lagging := "h1"
+	_, err = svc.Patch(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-1", patch, &lagging)
+	require.ErrorIs(t, err, ErrStaleDocument)
+}
+
+func TestService_PatchInvalidOperation(t *testing.T) {
+	repo := &stubRepository{
+		getFn: func(context.Context, string, string) (persistence.EntityRecord, error) {
+			return persistence.EntityRecord{Hash: "h1", Payload: json.RawMessage(`{"name":"Lotus"}`)}, nil
+		},
+	}
+	svc := New(repo, nil)
+	_, err := svc.Patch(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-1",
+		Patch{Format: PatchFormatJSONPatch, Body: json.RawMessage(`[{"op":"remove","path":"/missing"}]`)}, nil)
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
+func TestService_PatchEncryptedRequiresDecryption(t *testing.T) {
+	updated := false
+	repo := &stubRepository{
+		encryptedFieldsFn: func(context.Context, string) ([]persistence.EncryptedField, error) {
+			return []persistence.EncryptedField{{Path: []string{"email"}}}, nil
+		},
+		getFn: func(context.Context, string, string) (persistence.EntityRecord, error) {
+			return persistence.EntityRecord{Hash: "h1", Payload: json.RawMessage(`{"name":"Ada","email":"ada@example.com"}`)}, nil
+		},
+		updateFn: func(_ context.Context, _ string, _ string, payload json.RawMessage, _ *string, _ *string) (persistence.EntityRecord, error) {
+			updated = true
+			return persistence.EntityRecord{Payload: payload}, nil
+		},
+	}
+	svc := New(repo, nil)
+	patch := Patch{Format: PatchFormatMergePatch, Body: json.RawMessage(`{"name":"Grace"}`)}
+
+	_, err := svc.Patch(context.Background(), requesttrace.Anonymous(""), "people_entities", "ada", patch, nil)
+	require.ErrorIs(t, err, ErrDecryptionRequired)
+	require.False(t, updated)
+
+	_, err = svc.Patch(persistence.WithPayloadDecryption(context.Background()), requesttrace.Anonymous(""), "people_entities", "ada", patch, nil)
+	require.NoError(t, err)
+	require.True(t, updated)
+}
+
+func TestService_GetMasksPII(t *testing.T) {
+	repo := &stubRepository{
+		piiMaskingFn: func(context.Context, string) (persistence.PIIMasking, error) {
+			return persistence.PIIMasking{Mode: persistence.PIIMaskOmit, Fields: [][]string{{"email"}}}, nil
+		},
+		getFn: func(context.Context, string, string) (persistence.EntityRecord, error) {
+			return persistence.EntityRecord{EntityID: "ada", Payload: json.RawMessage(`{"name":"Ada","email":"ada@example.com"}`)}, nil
+		},
+	}
+	svc := New(repo, nil)
+
+	doc, err := svc.Get(context.Background(), requesttrace.Anonymous(""), "people_entities", "ada")
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"name": "Ada"}, doc.Payload)
+
+	doc, err = svc.Get(WithPIIAccess(context.Background()), requesttrace.Anonymous(""), "people_entities", "ada")
+	require.NoError(t, err)
+	require.Equal(t, "ada@example.com", doc.Payload["email"])
+
+	_, err = svc.Patch(context.Background(), requesttrace.Anonymous(""), "people_entities", "ada",
+		Patch{Format: PatchFormatMergePatch, Body: json.RawMessage(`{"name":"Grace"}`)}, nil)
+	require.ErrorIs(t, err, ErrPIIReadRequired)
+}
+
+func TestService_BatchGetPreservesOrder(t *testing.T) {
+	repo := &stubRepository{
+		getManyFn: func(_ context.Context, table string, ids []string) ([]persistence.EntityRecord, error) {
+			require.Equal(t, "cards_entities", table)
+			require.Equal(t, []string{"b", "a", "c"}, ids)
+			return []persistence.EntityRecord{
+				{EntityID: "a", Payload: json.RawMessage(`{"name":"A"}`)},
+				{EntityID: "b", Payload: json.RawMessage(`{"name":"B"}`)},
+			}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	res, err := svc.BatchGet(context.Background(), requesttrace.Anonymous(""), "cards_entities", []string{"b", " a", "b", "c"})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 2)
+	require.Equal(t, "b", res.Items[0].EntityID)
+	require.Equal(t, "a", res.Items[1].EntityID)
+	require.Equal(t, []string{"c"}, res.Missing)
+
+	_, err = svc.BatchGet(context.Background(), requesttrace.Anonymous(""), "cards_entities", make([]string, MaxBatchGetIDs+1))
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
+func TestService_GetVersion(t *testing.T) {
+	repo := &stubRepository{
+		getVersionFn: func(_ context.Context, table string, entityID string, version persistence.SemanticVersion) (persistence.EntityRecord, error) {
+			require.Equal(t, "cards_entities", table)
+			if version.Patch == 9 {
+				return persistence.EntityRecord{}, persistence.ErrEntityNotFound
+			}
+			return persistence.EntityRecord{EntityID: entityID, EntityVersion: version, Payload: json.RawMessage(`{"name":"old"}`)}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	doc, err := svc.GetVersion(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", "1.0.1")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.1", doc.EntityVersion.String())
+	require.Equal(t, "old", doc.Payload["name"])
+
+	_, err = svc.GetVersion(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", "1.0.9")
+	require.ErrorIs(t, err, ErrDocumentNotFound)
+
+	_, err = svc.GetVersion(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", "latest")
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
 func TestService_DeleteNotFound(t *testing.T) {
 	repo := &stubRepository{
 		deleteFn: func(context.Context, string, string) error {
 			return persistence.ErrEntityNotFound
 		},
 	}
-	svc := New(repo)
+	svc := New(repo, nil)
 	err := svc.Delete(context.Background(), requesttrace.Anonymous(""), "cards_entities", "entity-123")
 	require.ErrorIs(t, err, ErrDocumentNotFound)
 }
 
+func TestService_BulkCreateReportsPerRow(t *testing.T) {
+	blank := "  "
+	repo := &stubRepository{
+		bulkCreateFn: func(_ context.Context, table string, items []domainrepo.BulkItem, _ *string) ([]persistence.BulkEntityResult, error) {
+			require.Equal(t, "cards_entities", table)
+			require.Len(t, items, 2)
+			return []persistence.BulkEntityResult{
+				{Record: &persistence.EntityRecord{EntityID: "card-1", Payload: items[0].Payload, IsActive: true}},
+				{Err: persistence.ErrEntityAlreadyExists},
+			}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	res, err := svc.BulkCreate(bulkImportContext(true), requesttrace.Anonymous(""), "cards_entities", []BulkItem{
+		{Payload: map[string]interface{}{"name": "Lotus"}},
+		{EntityID: &blank, Payload: map[string]interface{}{"name": "Blank"}},
+		{Payload: map[string]interface{}{"name": "Dup"}},
+		{Err: errors.New("invalid JSON")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, res.Total)
+	require.Equal(t, 1, res.Succeeded)
+	require.Equal(t, 3, res.Failed)
+	require.NotNil(t, res.Rows[0].Document)
+	require.Equal(t, "Lotus", res.Rows[0].Document.Payload["name"])
+	var valErr *ValidationError
+	require.ErrorAs(t, res.Rows[1].Err, &valErr)
+	require.ErrorIs(t, res.Rows[2].Err, ErrConflict)
+	require.ErrorAs(t, res.Rows[3].Err, &valErr)
+}
+
+func TestService_BulkCreateRejectsOversizedBatch(t *testing.T) {
+	svc := New(&stubRepository{}, nil)
+	items := make([]BulkItem, MaxBulkItems+1)
+	_, err := svc.BulkCreate(bulkImportContext(true), requesttrace.Anonymous(""), "cards_entities", items)
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
+func TestService_BulkCreateRequiresTheFeatureFlag(t *testing.T) {
+	svc := New(&stubRepository{}, nil)
+	items := []BulkItem{{Payload: map[string]interface{}{"name": "Lotus"}}}
+
+	_, err := svc.BulkCreate(bulkImportContext(false), requesttrace.Anonymous(""), "cards_entities", items)
+	require.ErrorIs(t, err, ErrBulkImportDisabled)
+	_, err = svc.BulkCreate(context.Background(), requesttrace.Anonymous(""), "cards_entities", items)
+	require.ErrorIs(t, err, ErrBulkImportDisabled)
+}
+
+type staticFlags map[string]bool
+
+func (f staticFlags) TenantFlags(context.Context, uuid.UUID) (map[string]bool, error) {
+	return f, nil
+}
+
+// bulkImportContext returns a tenant context in which features.BulkImport is on or off.
+func bulkImportContext(enabled bool) context.Context {
+	evaluator := features.NewEvaluator(staticFlags{features.BulkImport: enabled}, zap.NewNop(), features.Config{})
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{TenantID: uuid.New(), Slug: "acme"})
+	return features.WithEvaluator(ctx, evaluator)
+}
+
+type stubQuotas struct {
+	resources []tenant.QuotaResource
+	tables    []string
+	limit     tenant.QuotaResource
+}
+
+func (s *stubQuotas) CheckQuota(_ context.Context, resource tenant.QuotaResource, n int64) error {
+	s.resources = append(s.resources, resource)
+	if resource == s.limit {
+		return &tenant.QuotaExceededError{Resource: resource, Limit: 1, Used: 1}
+	}
+	return nil
+}
+
+func (s *stubQuotas) CheckTableQuota(_ context.Context, tableName string) error {
+	s.tables = append(s.tables, tableName)
+	return nil
+}
+
+func TestService_CreateEnforcesQuotas(t *testing.T) {
+	repo := &stubRepository{
+		createFn: func(context.Context, string, string, json.RawMessage, *string) (persistence.EntityRecord, error) {
+			t.Fatal("repository must not be called when a quota is exceeded")
+			return persistence.EntityRecord{}, nil
+		},
+	}
+	quotas := &stubQuotas{limit: tenant.QuotaEntities}
+
+	svc := New(repo, quotas)
+	_, err := svc.Create(context.Background(), requesttrace.Anonymous(""), "cards_entities", nil, map[string]interface{}{"name": "Lotus"})
+	var quotaErr *tenant.QuotaExceededError
+	require.ErrorAs(t, err, &quotaErr)
+	require.Equal(t, []string{"cards_entities"}, quotas.tables)
+}
+
+func TestService_UpdateChecksOnlyStorageQuota(t *testing.T) {
+	repo := &stubRepository{
+		updateFn: func(_ context.Context, _ string, entityID string, payload json.RawMessage, _ *string, _ *string) (persistence.EntityRecord, error) {
+			return persistence.EntityRecord{EntityID: entityID, Payload: payload, IsActive: true}, nil
+		},
+	}
+	quotas := &stubQuotas{limit: tenant.QuotaEntities}
+
+	svc := New(repo, quotas)
+	_, err := svc.Update(context.Background(), requesttrace.Anonymous(""), "cards_entities", "card-1", map[string]interface{}{"name": "Lotus"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []tenant.QuotaResource{tenant.QuotaStorageBytes}, quotas.resources)
+	require.Empty(t, quotas.tables)
+}
+
+func TestService_Watch(t *testing.T) {
+	changes := make(chan persistence.EntityChange, 1)
+	changes <- persistence.EntityChange{Type: persistence.EntityEventCreated, TableName: "cards_entities", EntityID: "card-1"}
+	repo := &stubRepository{
+		watchFn: func(_ context.Context, table string) (<-chan persistence.EntityChange, func(), error) {
+			if table != "cards_entities" {
+				return nil, nil, persistence.ErrSchemaNotFound
+			}
+			return changes, func() {}, nil
+		},
+	}
+
+	svc := New(repo, nil)
+	stream, cancel, err := svc.Watch(context.Background(), requesttrace.Anonymous(""), "cards_entities")
+	require.NoError(t, err)
+	defer cancel()
+	require.Equal(t, "card-1", (<-stream).EntityID)
+
+	_, _, err = svc.Watch(context.Background(), requesttrace.Anonymous(""), "missing_entities")
+	require.ErrorIs(t, err, ErrTableNotFound)
+
+	_, _, err = svc.Watch(context.Background(), requesttrace.Anonymous(""), " ")
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+}
+
 type stubRepository struct {
-	listFn   func(context.Context, string, domainrepo.ListParams) (domainrepo.ListResult, error)
-	createFn func(context.Context, string, string, json.RawMessage, *string) (persistence.EntityRecord, error)
-	getFn    func(context.Context, string, string) (persistence.EntityRecord, error)
-	updateFn func(context.Context, string, string, json.RawMessage, *string) (persistence.EntityRecord, error)
-	deleteFn func(context.Context, string, string) error
+	listFn            func(context.Context, string, domainrepo.ListParams) (domainrepo.ListResult, error)
+	createFn          func(context.Context, string, string, json.RawMessage, *string) (persistence.EntityRecord, error)
+	bulkCreateFn      func(context.Context, string, []domainrepo.BulkItem, *string) ([]persistence.BulkEntityResult, error)
+	getFn             func(context.Context, string, string) (persistence.EntityRecord, error)
+	getVersionFn      func(context.Context, string, string, persistence.SemanticVersion) (persistence.EntityRecord, error)
+	getManyFn         func(context.Context, string, []string) ([]persistence.EntityRecord, error)
+	referencesFn      func(context.Context, string) ([]persistence.ReferenceField, error)
+	encryptedFieldsFn func(context.Context, string) ([]persistence.EncryptedField, error)
+	piiMaskingFn      func(context.Context, string) (persistence.PIIMasking, error)
+	updateFn          func(context.Context, string, string, json.RawMessage, *string, *string) (persistence.EntityRecord, error)
+	deleteFn          func(context.Context, string, string) error
+	validateFn        func(context.Context, string, json.RawMessage) (persistence.SchemaRecord, error)
+	searchFn          func(context.Context, string, domainrepo.SearchParams) (domainrepo.ListResult, error)
+	verifyFn          func(context.Context, string, string) (persistence.EntityVerification, error)
+	anchorProofFn     func(context.Context, string, string, persistence.SemanticVersion) (persistence.EntityAnchorProof, error)
+	watchFn           func(context.Context, string) (<-chan persistence.EntityChange, func(), error)
 }
 
 func (s *stubRepository) List(ctx context.Context, table string, params domainrepo.ListParams) (domainrepo.ListResult, error) {
@@ -109,6 +502,13 @@ func (s *stubRepository) Create(ctx context.Context, table string, entityID stri
 	return s.createFn(ctx, table, entityID, payload, createdBy)
 }
 
+func (s *stubRepository) BulkCreate(ctx context.Context, table string, items []domainrepo.BulkItem, createdBy *string) ([]persistence.BulkEntityResult, error) {
+	if s.bulkCreateFn == nil {
+		return make([]persistence.BulkEntityResult, len(items)), nil
+	}
+	return s.bulkCreateFn(ctx, table, items, createdBy)
+}
+
 func (s *stubRepository) Get(ctx context.Context, table string, entityID string) (persistence.EntityRecord, error) {
 	if s.getFn == nil {
 		return persistence.EntityRecord{}, nil
@@ -116,11 +516,46 @@ func (s *stubRepository) Get(ctx context.Context, table string, entityID string)
 	return s.getFn(ctx, table, entityID)
 }
 
-func (s *stubRepository) Update(ctx context.Context, table string, entityID string, payload json.RawMessage, createdBy *string) (persistence.EntityRecord, error) {
+func (s *stubRepository) GetVersion(ctx context.Context, table string, entityID string, version persistence.SemanticVersion) (persistence.EntityRecord, error) {
+	if s.getVersionFn == nil {
+		return persistence.EntityRecord{}, nil
+	}
+	return s.getVersionFn(ctx, table, entityID, version)
+}
+
+func (s *stubRepository) GetMany(ctx context.Context, table string, entityIDs []string) ([]persistence.EntityRecord, error) {
+	if s.getManyFn == nil {
+		return nil, nil
+	}
+	return s.getManyFn(ctx, table, entityIDs)
+}
+
+func (s *stubRepository) References(ctx context.Context, table string) ([]persistence.ReferenceField, error) {
+	if s.referencesFn == nil {
+		return nil, nil
+	}
+	return s.referencesFn(ctx, table)
+}
+
+func (s *stubRepository) EncryptedFields(ctx context.Context, table string) ([]persistence.EncryptedField, error) {
+	if s.encryptedFieldsFn == nil {
+		return nil, nil
+	}
+	return s.encryptedFieldsFn(ctx, table)
+}
+
+func (s *stubRepository) PIIMasking(ctx context.Context, table string) (persistence.PIIMasking, error) {
+	if s.piiMaskingFn == nil {
+		return persistence.PIIMasking{Mode: persistence.PIIMaskNull}, nil
+	}
+	return s.piiMaskingFn(ctx, table)
+}
+
+func (s *stubRepository) Update(ctx context.Context, table string, entityID string, payload json.RawMessage, expectedHash *string, createdBy *string) (persistence.EntityRecord, error) {
 	if s.updateFn == nil {
 		return persistence.EntityRecord{}, nil
 	}
-	return s.updateFn(ctx, table, entityID, payload, createdBy)
+	return s.updateFn(ctx, table, entityID, payload, expectedHash, createdBy)
 }
 
 func (s *stubRepository) Delete(ctx context.Context, table string, entityID string) error {
@@ -129,3 +564,38 @@ func (s *stubRepository) Delete(ctx context.Context, table string, entityID stri
 	}
 	return s.deleteFn(ctx, table, entityID)
 }
+
+func (s *stubRepository) Validate(ctx context.Context, table string, payload json.RawMessage) (persistence.SchemaRecord, error) {
+	if s.validateFn == nil {
+		return persistence.SchemaRecord{}, nil
+	}
+	return s.validateFn(ctx, table, payload)
+}
+
+func (s *stubRepository) Search(ctx context.Context, table string, params domainrepo.SearchParams) (domainrepo.ListResult, error) {
+	if s.searchFn == nil {
+		return domainrepo.ListResult{}, nil
+	}
+	return s.searchFn(ctx, table, params)
+}
+
+func (s *stubRepository) Verify(ctx context.Context, table string, entityID string) (persistence.EntityVerification, error) {
+	if s.verifyFn == nil {
+		return persistence.EntityVerification{}, nil
+	}
+	return s.verifyFn(ctx, table, entityID)
+}
+
+func (s *stubRepository) AnchorProof(ctx context.Context, table string, entityID string, version persistence.SemanticVersion) (persistence.EntityAnchorProof, error) {
+	if s.anchorProofFn == nil {
+		return persistence.EntityAnchorProof{}, persistence.ErrAnchorLeafNotFound
+	}
+	return s.anchorProofFn(ctx, table, entityID, version)
+}
+
+func (s *stubRepository) Watch(ctx context.Context, table string) (<-chan persistence.EntityChange, func(), error) {
+	if s.watchFn == nil {
+		return nil, func() {}, nil
+	}
+	return s.watchFn(ctx, table)
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// ValidationResult reports the outcome of a dry-run validation against the table's active schema.
+type ValidationResult struct {
+	SchemaID      uuid.UUID
+	SchemaVersion persistence.SemanticVersion
+	Errors        FieldErrors
+}
+
+// Valid reports whether no issues were found.
+func (r ValidationResult) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Validate runs the create-time checks (entity id, schema, references) without persisting anything.
+// Payload issues are returned in the result; only request or infrastructure problems surface as errors.
+func (s *service) Validate(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID *string, payload map[string]interface{}) (ValidationResult, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return ValidationResult{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if payload == nil {
+		return ValidationResult{}, &ValidationError{Reason: "payload is required"}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return ValidationResult{}, fmt.Errorf("encode payload: %w", err)
+	}
+
+	result := ValidationResult{Errors: FieldErrors{}}
+	if entityID != nil {
+		if _, err := persistence.NormalizeEntityIdentifier(*entityID); err != nil {
+			result.Errors.add("entityId", err.Error())
+		}
+	}
+
+	schema, err := s.repo.Validate(ctx, tableName, body)
+	if err != nil {
+		var schemaErr *jsonschema.ValidationError
+		if !errors.As(err, &schemaErr) {
+			return ValidationResult{}, translateError(err)
+		}
+		result.Errors.merge(schemaFieldErrors(schemaErr))
+	}
+	result.SchemaID = schema.SchemaID
+	result.SchemaVersion = schema.SchemaVersion
+
+	if err := s.verifyReferences(ctx, tableName, payload); err != nil {
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			return ValidationResult{}, err
+		}
+		result.Errors.merge(validationErr.Fields)
+	}
+
+	return result, nil
+}
+
+func (f FieldErrors) merge(other FieldErrors) {
+	for field, messages := range other {
+		f[field] = append(f[field], messages...)
+	}
+}
+
+// schemaFieldErrors flattens a JSON Schema validation error into its leaf causes, keyed as "payload.<path>".
+func schemaFieldErrors(err *jsonschema.ValidationError) FieldErrors {
+	fields := FieldErrors{}
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			fields.add(payloadField(e.InstanceLocation), e.Message)
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(err)
+	return fields
+}
+
+// payloadField converts a JSON Pointer into the dotted field name used in problem details.
+func payloadField(pointer string) string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return "payload"
+	}
+
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	segments := strings.Split(pointer, "/")
+	for i, segment := range segments {
+		segments[i] = unescape.Replace(segment)
+	}
+	return "payload." + strings.Join(segments, ".")
+}
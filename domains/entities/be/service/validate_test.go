@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+const validateTestSchema = `{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "address": {"type": "object", "properties": {"city": {"type": "string"}}}
+  }
+}`
+
+func validateAgainstTestSchema(t *testing.T, payload json.RawMessage) error {
+	t.Helper()
+	compiler := jsonschema.NewCompiler()
+	require.NoError(t, compiler.AddResource("memory://test", strings.NewReader(validateTestSchema)))
+	compiled, err := compiler.Compile("memory://test")
+	require.NoError(t, err)
+
+	var document any
+	require.NoError(t, json.Unmarshal(payload, &document))
+	return compiled.Validate(document)
+}
+
+func TestService_Validate(t *testing.T) {
+	schemaID := uuid.New()
+	repo := &stubRepository{
+		validateFn: func(_ context.Context, table string, payload json.RawMessage) (persistence.SchemaRecord, error) {
+			if table != "cards_entities" {
+				return persistence.SchemaRecord{}, persistence.ErrSchemaNotFound
+			}
+			schema := persistence.SchemaRecord{SchemaID: schemaID, SchemaVersion: persistence.SemanticVersion{Major: 1}}
+			return schema, validateAgainstTestSchema(t, payload)
+		},
+	}
+	svc := New(repo, nil)
+	audit := requesttrace.Anonymous("")
+	blank := " "
+
+	tests := []struct {
+		name     string
+		entityID *string
+		payload  map[string]interface{}
+		errors   map[string]int
+	}{
+		{name: "valid", payload: map[string]interface{}{"name": "Lotus"}, errors: map[string]int{}},
+		{name: "missing required", payload: map[string]interface{}{}, errors: map[string]int{"payload": 1}},
+		{name: "nested type", payload: map[string]interface{}{"name": "Lotus", "address": map[string]interface{}{"city": 7}}, errors: map[string]int{"payload.address.city": 1}},
+		{name: "blank entity id", entityID: &blank, payload: map[string]interface{}{"name": "Lotus"}, errors: map[string]int{"entityId": 1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := svc.Validate(context.Background(), audit, "cards_entities", tc.entityID, tc.payload)
+			require.NoError(t, err)
+			require.Equal(t, schemaID, res.SchemaID)
+			require.Equal(t, len(tc.errors) == 0, res.Valid())
+			require.Len(t, res.Errors, len(tc.errors))
+			for field, count := range tc.errors {
+				require.Len(t, res.Errors[field], count, field)
+			}
+		})
+	}
+
+	_, err := svc.Validate(context.Background(), audit, "missing_entities", nil, map[string]interface{}{})
+	require.ErrorIs(t, err, ErrTableNotFound)
+}
+
+func TestPayloadField(t *testing.T) {
+	require.Equal(t, "payload", payloadField(""))
+	require.Equal(t, "payload.address.city", payloadField("/address/city"))
+	require.Equal(t, "payload.a/b.c~d", payloadField("/a~1b/c~0d"))
+}
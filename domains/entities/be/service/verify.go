@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// Verification reports whether every stored version of a document still hashes to its persisted hash.
+type Verification struct {
+	EntityID   string
+	Versions   int
+	Mismatches []HashMismatch
+}
+
+// HashMismatch is a stored version whose payload no longer matches its hash.
+type HashMismatch struct {
+	Version      persistence.SemanticVersion
+	StoredHash   string
+	ComputedHash string
+	Archived     bool
+}
+
+// Valid reports whether no mismatches were found.
+func (v Verification) Valid() bool {
+	return len(v.Mismatches) == 0
+}
+
+// Verify recomputes the canonical hash of every stored version of a document and reports the versions whose payload
+// was changed outside the repository. Mismatches are returned in the result, not as errors.
+func (s *service) Verify(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) (Verification, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if strings.TrimSpace(tableName) == "" {
+		return Verification{}, &ValidationError{Reason: "tableName is required"}
+	}
+	if strings.TrimSpace(entityID) == "" {
+		return Verification{}, &ValidationError{Reason: "entityId is required"}
+	}
+
+	verification, err := s.repo.Verify(ctx, tableName, entityID)
+	if err != nil {
+		return Verification{}, translateError(err)
+	}
+
+	result := Verification{
+		EntityID:   verification.EntityID,
+		Versions:   verification.Versions,
+		Mismatches: make([]HashMismatch, 0, len(verification.Mismatches)),
+	}
+	for _, mismatch := range verification.Mismatches {
+		result.Mismatches = append(result.Mismatches, HashMismatch{
+			Version:      mismatch.Version,
+			StoredHash:   mismatch.StoredHash,
+			ComputedHash: mismatch.ComputedHash,
+			Archived:     mismatch.Archived,
+		})
+	}
+	return result, nil
+}
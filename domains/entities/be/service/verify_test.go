@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestService_Verify(t *testing.T) {
+	version := persistence.SemanticVersion{Major: 1, Minor: 0, Patch: 1}
+	repo := &stubRepository{
+		verifyFn: func(_ context.Context, table, entityID string) (persistence.EntityVerification, error) {
+			require.Equal(t, "cards_entities", table)
+			if entityID == "missing" {
+				return persistence.EntityVerification{}, persistence.ErrEntityNotFound
+			}
+			return persistence.EntityVerification{
+				EntityID: entityID,
+				Versions: 2,
+				Mismatches: []persistence.EntityHashMismatch{
+					{Version: version, StoredHash: "stored", ComputedHash: "computed", Archived: true},
+				},
+			}, nil
+		},
+	}
+	svc := New(repo, nil)
+	audit := requesttrace.Anonymous("")
+
+	result, err := svc.Verify(context.Background(), audit, "cards_entities", "card-1")
+	require.NoError(t, err)
+	require.False(t, result.Valid())
+	require.Equal(t, 2, result.Versions)
+	require.Equal(t, []HashMismatch{{Version: version, StoredHash: "stored", ComputedHash: "computed", Archived: true}}, result.Mismatches)
+
+	_, err = svc.Verify(context.Background(), audit, "cards_entities", "missing")
+	require.ErrorIs(t, err, ErrDocumentNotFound)
+
+	_, err = svc.Verify(context.Background(), audit, "cards_entities", " ")
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
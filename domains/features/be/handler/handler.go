@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/features/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	features "github.com/zenGate-Global/palmyra-pro-saas/generated/go/features"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	featuresGetOperation    operation = "featuresGet"
+	listOperation           operation = "featureFlagsList"
+	createOperation         operation = "featureFlagsCreate"
+	getOperation            operation = "featureFlagsGet"
+	updateOperation         operation = "featureFlagsUpdate"
+	deleteOperation         operation = "featureFlagsDelete"
+	setOverrideOperation    operation = "featureFlagsSetOverride"
+	deleteOverrideOperation operation = "featureFlagsDeleteOverride"
+)
+
+// Handler wires the feature flag service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("feature flag service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) FeaturesGet(ctx context.Context, _ features.FeaturesGetRequestObject) (features.FeaturesGetResponseObject, error) {
+	flags, err := h.svc.TenantFlags(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, featuresGetOperation)
+		return features.FeaturesGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeaturesGet200JSONResponse(features.TenantFeatures{Flags: flags}), nil
+}
+
+func (h *Handler) FeatureFlagsList(ctx context.Context, _ features.FeatureFlagsListRequestObject) (features.FeatureFlagsListResponseObject, error) {
+	flags, err := h.svc.List(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, listOperation)
+		return features.FeatureFlagsListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]features.FeatureFlag, 0, len(flags))
+	for _, flag := range flags {
+		items = append(items, toAPIFlag(flag))
+	}
+	return features.FeatureFlagsList200JSONResponse(features.FeatureFlagList{Items: items}), nil
+}
+
+func (h *Handler) FeatureFlagsCreate(ctx context.Context, request features.FeatureFlagsCreateRequestObject) (features.FeatureFlagsCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return features.FeatureFlagsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	input := service.CreateInput{Key: request.Body.Key, Description: request.Body.Description}
+	if request.Body.Enabled != nil {
+		input.Enabled = *request.Body.Enabled
+	}
+	flag, err := h.svc.Create(ctx, h.audit(ctx), input)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, createOperation)
+		return features.FeatureFlagsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeatureFlagsCreate201JSONResponse(toAPIFlag(flag)), nil
+}
+
+func (h *Handler) FeatureFlagsGet(ctx context.Context, request features.FeatureFlagsGetRequestObject) (features.FeatureFlagsGetResponseObject, error) {
+	flag, err := h.svc.Get(ctx, h.audit(ctx), request.FlagKey)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return features.FeatureFlagsGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeatureFlagsGet200JSONResponse(toAPIFlag(flag)), nil
+}
+
+func (h *Handler) FeatureFlagsUpdate(ctx context.Context, request features.FeatureFlagsUpdateRequestObject) (features.FeatureFlagsUpdateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return features.FeatureFlagsUpdatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	flag, err := h.svc.Update(ctx, h.audit(ctx), request.FlagKey, service.UpdateInput{
+		Description: request.Body.Description,
+		Enabled:     request.Body.Enabled,
+	})
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, updateOperation)
+		return features.FeatureFlagsUpdatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeatureFlagsUpdate200JSONResponse(toAPIFlag(flag)), nil
+}
+
+func (h *Handler) FeatureFlagsDelete(ctx context.Context, request features.FeatureFlagsDeleteRequestObject) (features.FeatureFlagsDeleteResponseObject, error) {
+	if err := h.svc.Delete(ctx, h.audit(ctx), request.FlagKey); err != nil {
+		status, problem := h.problemForError(ctx, err, deleteOperation)
+		return features.FeatureFlagsDeletedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeatureFlagsDelete204Response{}, nil
+}
+
+func (h *Handler) FeatureFlagsSetOverride(ctx context.Context, request features.FeatureFlagsSetOverrideRequestObject) (features.FeatureFlagsSetOverrideResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return features.FeatureFlagsSetOverridedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	flag, err := h.svc.SetOverride(ctx, h.audit(ctx), request.FlagKey, request.TenantId, request.Body.Enabled)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, setOverrideOperation)
+		return features.FeatureFlagsSetOverridedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeatureFlagsSetOverride200JSONResponse(toAPIFlag(flag)), nil
+}
+
+func (h *Handler) FeatureFlagsDeleteOverride(ctx context.Context, request features.FeatureFlagsDeleteOverrideRequestObject) (features.FeatureFlagsDeleteOverrideResponseObject, error) {
+	flag, err := h.svc.DeleteOverride(ctx, h.audit(ctx), request.FlagKey, request.TenantId)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, deleteOverrideOperation)
+		return features.FeatureFlagsDeleteOverridedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return features.FeatureFlagsDeleteOverride200JSONResponse(toAPIFlag(flag)), nil
+}
+
+func toAPIFlag(flag service.Flag) features.FeatureFlag {
+	overrides := make([]features.FeatureFlagOverride, 0, len(flag.Overrides))
+	for _, override := range flag.Overrides {
+		overrides = append(overrides, features.FeatureFlagOverride{
+			TenantId:  externalRef2.UUID(override.TenantID),
+			Enabled:   override.Enabled,
+			UpdatedAt: externalRef2.Timestamp(override.UpdatedAt),
+			UpdatedBy: override.UpdatedBy,
+		})
+	}
+	return features.FeatureFlag{
+		Key:         flag.Key,
+		Description: flag.Description,
+		Enabled:     flag.Enabled,
+		Overrides:   overrides,
+		CreatedAt:   externalRef2.Timestamp(flag.CreatedAt),
+		UpdatedAt:   externalRef2.Timestamp(flag.UpdatedAt),
+		UpdatedBy:   flag.UpdatedBy,
+	}
+}
+
+// errorCatalog maps feature flag service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("features")
+	c.Register(service.ErrNotFound, problems.NotFound("feature flag not found"))
+	c.Register(service.ErrExists, problems.Conflict("feature flag already exists"))
+	c.RegisterDetail(service.ErrInvalidKey, problems.Validation("", map[string][]string{"key": {"must be lower-case words separated by dots or dashes"}}))
+	c.Register(service.ErrNoOverride, problems.NotFound("tenant has no override for the feature flag"))
+	c.Register(service.ErrTenantNotFound, problems.NotFound("tenant not found"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
+
+// compile-time assertions to ensure interface compliance
+var _ features.StrictServerInterface = (*Handler)(nil)
@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Repository defines the persistence operations required by the feature flag API.
+type Repository interface {
+	ListFlags(ctx context.Context) ([]persistence.FeatureFlagRecord, error)
+	// GetFlag returns persistence.ErrFeatureFlagNotFound when no flag has the key.
+	GetFlag(ctx context.Context, key string) (persistence.FeatureFlagRecord, error)
+	// CreateFlag returns persistence.ErrFeatureFlagExists when the key is taken.
+	CreateFlag(ctx context.Context, key string, description *string, enabled bool, createdBy *string) (persistence.FeatureFlagRecord, error)
+	UpdateFlag(ctx context.Context, key string, update persistence.FeatureFlagUpdate, updatedBy *string) (persistence.FeatureFlagRecord, error)
+	DeleteFlag(ctx context.Context, key string) error
+	// SetOverride returns persistence.ErrFeatureFlagTenantUnknown when the tenant is not active.
+	SetOverride(ctx context.Context, key string, tenantID uuid.UUID, enabled bool, updatedBy *string) (persistence.FeatureFlagRecord, error)
+	// DeleteOverride returns persistence.ErrFeatureFlagNoOverride when the tenant follows the default.
+	DeleteOverride(ctx context.Context, key string, tenantID uuid.UUID) (persistence.FeatureFlagRecord, error)
+}
+
+type postgresRepository struct {
+	store *persistence.FeatureFlagStore
+}
+
+// NewPostgresRepository constructs a repository backed by the admin feature flag tables.
+func NewPostgresRepository(store *persistence.FeatureFlagStore) Repository {
+	if store == nil {
+		panic("feature flag store is required")
+	}
+	return &postgresRepository{store: store}
+}
+
+func (r *postgresRepository) ListFlags(ctx context.Context) ([]persistence.FeatureFlagRecord, error) {
+	return r.store.List(ctx)
+}
+
+func (r *postgresRepository) GetFlag(ctx context.Context, key string) (persistence.FeatureFlagRecord, error) {
+	return r.store.Get(ctx, key)
+}
+
+func (r *postgresRepository) CreateFlag(ctx context.Context, key string, description *string, enabled bool, createdBy *string) (persistence.FeatureFlagRecord, error) {
+	return r.store.Create(ctx, key, description, enabled, createdBy)
+}
+
+func (r *postgresRepository) UpdateFlag(ctx context.Context, key string, update persistence.FeatureFlagUpdate, updatedBy *string) (persistence.FeatureFlagRecord, error) {
+	return r.store.Update(ctx, key, update, updatedBy)
+}
+
+func (r *postgresRepository) DeleteFlag(ctx context.Context, key string) error {
+	return r.store.Delete(ctx, key)
+}
+
+func (r *postgresRepository) SetOverride(ctx context.Context, key string, tenantID uuid.UUID, enabled bool, updatedBy *string) (persistence.FeatureFlagRecord, error) {
+	return r.store.SetOverride(ctx, key, tenantID, enabled, updatedBy)
+}
+
+func (r *postgresRepository) DeleteOverride(ctx context.Context, key string, tenantID uuid.UUID) (persistence.FeatureFlagRecord, error) {
+	return r.store.DeleteOverride(ctx, key, tenantID)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/features/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Errors returned by Service.
+var (
+	ErrNotFound       = errors.New("feature flag not found")
+	ErrExists         = errors.New("feature flag already exists")
+	ErrInvalidKey     = errors.New("feature flag key must be lower-case words separated by dots or dashes")
+	ErrNoOverride     = errors.New("tenant has no override for the feature flag")
+	ErrTenantNotFound = errors.New("tenant not found")
+)
+
+// keyPattern mirrors the check constraint on feature_flags.key.
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9]*([.-][a-z0-9]+)*$`)
+
+// Flag represents the domain view of a feature flag.
+type Flag struct {
+	Key         string
+	Description *string
+	Enabled     bool
+	Overrides   []Override
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	UpdatedBy   *string
+}
+
+// Override replaces a flag's default for one tenant.
+type Override struct {
+	TenantID  uuid.UUID
+	Enabled   bool
+	UpdatedAt time.Time
+	UpdatedBy *string
+}
+
+// CreateInput describes a new flag. Flags start off unless Enabled says otherwise.
+type CreateInput struct {
+	Key         string
+	Description *string
+	Enabled     bool
+}
+
+// UpdateInput holds the fields to change; nil fields keep their value.
+type UpdateInput struct {
+	Description *string
+	Enabled     *bool
+}
+
+// Evaluator answers the flags of a tenant; implemented by features.Evaluator. Invalidate is called after every
+// change so this process serves it immediately.
+type Evaluator interface {
+	Flags(ctx context.Context, tenantID uuid.UUID) (map[string]bool, error)
+	Invalidate()
+}
+
+// Service evaluates the caller's flags and manages flags and tenant overrides for platform admins.
+type Service interface {
+	TenantFlags(ctx context.Context, audit requesttrace.AuditInfo) (map[string]bool, error)
+	List(ctx context.Context, audit requesttrace.AuditInfo) ([]Flag, error)
+	Get(ctx context.Context, audit requesttrace.AuditInfo, key string) (Flag, error)
+	Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (Flag, error)
+	Update(ctx context.Context, audit requesttrace.AuditInfo, key string, input UpdateInput) (Flag, error)
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, key string) error
+	SetOverride(ctx context.Context, audit requesttrace.AuditInfo, key string, tenantID uuid.UUID, enabled bool) (Flag, error)
+	DeleteOverride(ctx context.Context, audit requesttrace.AuditInfo, key string, tenantID uuid.UUID) (Flag, error)
+}
+
+type service struct {
+	repo      repo.Repository
+	evaluator Evaluator
+}
+
+// New constructs a Service instance.
+func New(r repo.Repository, evaluator Evaluator) Service {
+	if r == nil {
+		panic("feature flag repository is required")
+	}
+	if evaluator == nil {
+		panic("feature flag evaluator is required")
+	}
+	return &service{repo: r, evaluator: evaluator}
+}
+
+func (s *service) TenantFlags(ctx context.Context, _ requesttrace.AuditInfo) (map[string]bool, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("tenant space missing from context")
+	}
+	return s.evaluator.Flags(ctx, space.TenantID)
+}
+
+func (s *service) List(ctx context.Context, _ requesttrace.AuditInfo) ([]Flag, error) {
+	records, err := s.repo.ListFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flags := make([]Flag, 0, len(records))
+	for _, record := range records {
+		flags = append(flags, mapFlag(record))
+	}
+	return flags, nil
+}
+
+func (s *service) Get(ctx context.Context, _ requesttrace.AuditInfo, key string) (Flag, error) {
+	record, err := s.repo.GetFlag(ctx, key)
+	if err != nil {
+		return Flag{}, mapError(err)
+	}
+	return mapFlag(record), nil
+}
+
+func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (Flag, error) {
+	if !keyPattern.MatchString(input.Key) {
+		return Flag{}, ErrInvalidKey
+	}
+	record, err := s.repo.CreateFlag(ctx, input.Key, input.Description, input.Enabled, audit.UserID)
+	if err != nil {
+		return Flag{}, mapError(err)
+	}
+	s.evaluator.Invalidate()
+	return mapFlag(record), nil
+}
+
+func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, key string, input UpdateInput) (Flag, error) {
+	record, err := s.repo.UpdateFlag(ctx, key, persistence.FeatureFlagUpdate{
+		Description: input.Description,
+		Enabled:     input.Enabled,
+	}, audit.UserID)
+	if err != nil {
+		return Flag{}, mapError(err)
+	}
+	s.evaluator.Invalidate()
+	return mapFlag(record), nil
+}
+
+func (s *service) Delete(ctx context.Context, _ requesttrace.AuditInfo, key string) error {
+	if err := s.repo.DeleteFlag(ctx, key); err != nil {
+		return mapError(err)
+	}
+	s.evaluator.Invalidate()
+	return nil
+}
+
+func (s *service) SetOverride(ctx context.Context, audit requesttrace.AuditInfo, key string, tenantID uuid.UUID, enabled bool) (Flag, error) {
+	record, err := s.repo.SetOverride(ctx, key, tenantID, enabled, audit.UserID)
+	if err != nil {
+		return Flag{}, mapError(err)
+	}
+	s.evaluator.Invalidate()
+	return mapFlag(record), nil
+}
+
+func (s *service) DeleteOverride(ctx context.Context, _ requesttrace.AuditInfo, key string, tenantID uuid.UUID) (Flag, error) {
+	record, err := s.repo.DeleteOverride(ctx, key, tenantID)
+	if err != nil {
+		return Flag{}, mapError(err)
+	}
+	s.evaluator.Invalidate()
+	return mapFlag(record), nil
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, persistence.ErrFeatureFlagNotFound):
+		return ErrNotFound
+	case errors.Is(err, persistence.ErrFeatureFlagExists):
+		return ErrExists
+	case errors.Is(err, persistence.ErrFeatureFlagNoOverride):
+		return ErrNoOverride
+	case errors.Is(err, persistence.ErrFeatureFlagTenantUnknown):
+		return ErrTenantNotFound
+	default:
+		return err
+	}
+}
+
+func mapFlag(record persistence.FeatureFlagRecord) Flag {
+	overrides := make([]Override, 0, len(record.Overrides))
+	for _, override := range record.Overrides {
+		overrides = append(overrides, Override{
+			TenantID:  override.TenantID,
+			Enabled:   override.Enabled,
+			UpdatedAt: override.UpdatedAt,
+			UpdatedBy: override.UpdatedBy,
+		})
+	}
+	return Flag{
+		Key:         record.Key,
+		Description: record.Description,
+		Enabled:     record.Enabled,
+		Overrides:   overrides,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+		UpdatedBy:   record.UpdatedBy,
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type mockRepository struct {
+	flags map[string]persistence.FeatureFlagRecord
+}
+
+func (m *mockRepository) ListFlags(context.Context) ([]persistence.FeatureFlagRecord, error) {
+	out := make([]persistence.FeatureFlagRecord, 0, len(m.flags))
+	for _, flag := range m.flags {
+		out = append(out, flag)
+	}
+	return out, nil
+}
+
+func (m *mockRepository) GetFlag(_ context.Context, key string) (persistence.FeatureFlagRecord, error) {
+	flag, ok := m.flags[key]
+	if !ok {
+		return persistence.FeatureFlagRecord{}, persistence.ErrFeatureFlagNotFound
+	}
+	return flag, nil
+}
+
+func (m *mockRepository) CreateFlag(_ context.Context, key string, description *string, enabled bool, createdBy *string) (persistence.FeatureFlagRecord, error) {
+	if _, ok := m.flags[key]; ok {
+		return persistence.FeatureFlagRecord{}, persistence.ErrFeatureFlagExists
+	}
+	now := time.Now().UTC()
+	flag := persistence.FeatureFlagRecord{Key: key, Description: description, Enabled: enabled, CreatedAt: now, UpdatedAt: now, UpdatedBy: createdBy}
+	m.flags[key] = flag
+	return flag, nil
+}
+
+func (m *mockRepository) UpdateFlag(_ context.Context, key string, update persistence.FeatureFlagUpdate, updatedBy *string) (persistence.FeatureFlagRecord, error) {
+	flag, ok := m.flags[key]
+	if !ok {
+		return persistence.FeatureFlagRecord{}, persistence.ErrFeatureFlagNotFound
+	}
+	if update.Description != nil {
+		flag.Description = update.Description
+	}
+	if update.Enabled != nil {
+		flag.Enabled = *update.Enabled
+	}
+	flag.UpdatedBy = updatedBy
+	m.flags[key] = flag
+	return flag, nil
+}
+
+func (m *mockRepository) DeleteFlag(_ context.Context, key string) error {
+	if _, ok := m.flags[key]; !ok {
+		return persistence.ErrFeatureFlagNotFound
+	}
+	delete(m.flags, key)
+	return nil
+}
+
+func (m *mockRepository) SetOverride(_ context.Context, key string, tenantID uuid.UUID, enabled bool, updatedBy *string) (persistence.FeatureFlagRecord, error) {
+	flag, ok := m.flags[key]
+	if !ok {
+		return persistence.FeatureFlagRecord{}, persistence.ErrFeatureFlagNotFound
+	}
+	flag.Overrides = append(flag.Overrides, persistence.FeatureFlagOverride{TenantID: tenantID, Enabled: enabled, UpdatedBy: updatedBy})
+	m.flags[key] = flag
+	return flag, nil
+}
+
+func (m *mockRepository) DeleteOverride(_ context.Context, key string, tenantID uuid.UUID) (persistence.FeatureFlagRecord, error) {
+	flag, ok := m.flags[key]
+	if !ok {
+		return persistence.FeatureFlagRecord{}, persistence.ErrFeatureFlagNotFound
+	}
+	for i, override := range flag.Overrides {
+		if override.TenantID == tenantID {
+			flag.Overrides = append(flag.Overrides[:i], flag.Overrides[i+1:]...)
+			m.flags[key] = flag
+			return flag, nil
+		}
+	}
+	return persistence.FeatureFlagRecord{}, persistence.ErrFeatureFlagNoOverride
+}
+
+type fakeEvaluator struct {
+	flags       map[string]bool
+	invalidated int
+}
+
+func (e *fakeEvaluator) Flags(context.Context, uuid.UUID) (map[string]bool, error) {
+	return e.flags, nil
+}
+
+func (e *fakeEvaluator) Invalidate() {
+	e.invalidated++
+}
+
+func TestFlagChangesInvalidateTheEvaluator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	evaluator := &fakeEvaluator{}
+	svc := New(&mockRepository{flags: map[string]persistence.FeatureFlagRecord{}}, evaluator)
+	admin := "admin-1"
+	audit := requesttrace.AuditInfo{UserID: &admin, RequestID: "test"}
+
+	_, err := svc.Create(ctx, audit, CreateInput{Key: "Bulk Import"})
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	flag, err := svc.Create(ctx, audit, CreateInput{Key: "entities.bulk-import"})
+	require.NoError(t, err)
+	require.False(t, flag.Enabled)
+	require.Equal(t, admin, *flag.UpdatedBy)
+	_, err = svc.Create(ctx, audit, CreateInput{Key: "entities.bulk-import"})
+	require.ErrorIs(t, err, ErrExists)
+
+	tenantID := uuid.New()
+	flag, err = svc.SetOverride(ctx, audit, "entities.bulk-import", tenantID, true)
+	require.NoError(t, err)
+	require.Len(t, flag.Overrides, 1)
+	require.True(t, flag.Overrides[0].Enabled)
+
+	flag, err = svc.DeleteOverride(ctx, audit, "entities.bulk-import", tenantID)
+	require.NoError(t, err)
+	require.Empty(t, flag.Overrides)
+	_, err = svc.DeleteOverride(ctx, audit, "entities.bulk-import", tenantID)
+	require.ErrorIs(t, err, ErrNoOverride)
+
+	enabled := true
+	flag, err = svc.Update(ctx, audit, "entities.bulk-import", UpdateInput{Enabled: &enabled})
+	require.NoError(t, err)
+	require.True(t, flag.Enabled)
+
+	require.NoError(t, svc.Delete(ctx, audit, "entities.bulk-import"))
+	_, err = svc.Get(ctx, audit, "entities.bulk-import")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, 5, evaluator.invalidated)
+}
+
+func TestTenantFlagsRequireATenantSpace(t *testing.T) {
+	t.Parallel()
+
+	evaluator := &fakeEvaluator{flags: map[string]bool{"entities.bulk-import": true}}
+	svc := New(&mockRepository{flags: map[string]persistence.FeatureFlagRecord{}}, evaluator)
+	audit := requesttrace.AuditInfo{RequestID: "test"}
+
+	_, err := svc.TenantFlags(context.Background(), audit)
+	require.Error(t, err)
+
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{TenantID: uuid.New(), Slug: "acme"})
+	flags, err := svc.TenantFlags(ctx, audit)
+	require.NoError(t, err)
+	require.True(t, flags["entities.bulk-import"])
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/graphql/be/service"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	graphqlapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/graphql"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const queryOperation operation = "graphqlQuery"
+
+// Handler wires the GraphQL service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("graphql service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) GraphqlQuery(ctx context.Context, request graphqlapi.GraphqlQueryRequestObject) (graphqlapi.GraphqlQueryResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return graphqlapi.GraphqlQuerydefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	input := service.Request{Query: request.Body.Query}
+	if request.Body.OperationName != nil {
+		input.OperationName = *request.Body.OperationName
+	}
+	if request.Body.Variables != nil {
+		input.Variables = *request.Body.Variables
+	}
+
+	result, err := h.svc.Execute(ctx, requesttrace.FromContextOrAnonymous(ctx), input)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, queryOperation)
+		return graphqlapi.GraphqlQuerydefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	response := graphqlapi.GraphqlQuery200JSONResponse{}
+	if result.Data != nil {
+		response.Data = &result.Data
+	}
+	if len(result.Errors) > 0 {
+		errs := make([]graphqlapi.GraphQLError, 0, len(result.Errors))
+		for _, queryErr := range result.Errors {
+			if errors.Is(queryErr.Cause, service.ErrInternal) {
+				platformlogging.FromContextOr(ctx, h.logger).Error("graphql resolver failed", zap.Any("path", queryErr.Path), zap.Error(queryErr.Cause))
+			}
+			errs = append(errs, toAPIError(queryErr))
+		}
+		response.Errors = &errs
+	}
+	return response, nil
+}
+
+func toAPIError(queryErr service.QueryError) graphqlapi.GraphQLError {
+	out := graphqlapi.GraphQLError{Message: queryErr.Message}
+	if len(queryErr.Locations) > 0 {
+		locations := make([]graphqlapi.GraphQLErrorLocation, 0, len(queryErr.Locations))
+		for _, location := range queryErr.Locations {
+			locations = append(locations, graphqlapi.GraphQLErrorLocation{Line: location.Line, Column: location.Column})
+		}
+		out.Locations = &locations
+	}
+	if len(queryErr.Path) > 0 {
+		path := queryErr.Path
+		out.Path = &path
+	}
+	return out
+}
+
+// errorCatalog maps GraphQL service errors to problems. Query errors are reported in the response body, so only
+// failures to load the schema reach it.
+var errorCatalog = problems.NewCatalog("graphql")
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/graphql/be/service"
+	graphqlapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/graphql"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type stubService struct {
+	result service.Result
+}
+
+func (s stubService) Execute(context.Context, requesttrace.AuditInfo, service.Request) (service.Result, error) {
+	return s.result, nil
+}
+
+func TestGraphqlQueryLogsResolverFailuresOnTheRequestLogger(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		queryError service.QueryError
+		wantLogged int
+	}{
+		{
+			name:       "resolver failure",
+			queryError: service.QueryError{Message: service.ErrInternal.Error(), Path: []interface{}{"cards"}, Cause: fmt.Errorf("list cards: %w", service.ErrInternal)},
+			wantLogged: 1,
+		},
+		{
+			name:       "validation error",
+			queryError: service.QueryError{Message: `Cannot query field "price" on type "Card".`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fallbackCore, fallbackLogs := observer.New(zap.InfoLevel)
+			requestCore, requestLogs := observer.New(zap.InfoLevel)
+			h := New(stubService{result: service.Result{Errors: []service.QueryError{tc.queryError}}}, zap.New(fallbackCore))
+			ctx := platformlogging.WithLogger(context.Background(), zap.New(requestCore))
+
+			response, err := h.GraphqlQuery(ctx, graphqlapi.GraphqlQueryRequestObject{Body: &graphqlapi.GraphqlQueryJSONRequestBody{Query: "{ cards { name } }"}})
+			require.NoError(t, err)
+			body, ok := response.(graphqlapi.GraphqlQuery200JSONResponse)
+			require.True(t, ok)
+			require.NotNil(t, body.Errors)
+			require.Equal(t, tc.queryError.Message, (*body.Errors)[0].Message)
+
+			require.Equal(t, tc.wantLogged, requestLogs.FilterMessage("graphql resolver failed").Len())
+			require.Zero(t, fallbackLogs.Len())
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Repository exposes the schema catalog the GraphQL schema is generated from.
+type Repository interface {
+	// ListActiveSchemas returns the active, published, non-deleted version of every schema.
+	ListActiveSchemas(ctx context.Context) ([]persistence.SchemaRecord, error)
+}
+
+type postgresRepository struct {
+	spaceDB *persistence.SpaceDB
+	schemas *persistence.SchemaRepositoryStore
+}
+
+// NewPostgresRepository constructs a Repository backed by the shared persistence layer.
+func NewPostgresRepository(spaceDB *persistence.SpaceDB, schemas *persistence.SchemaRepositoryStore) Repository {
+	if spaceDB == nil {
+		panic("admin db is required")
+	}
+	if schemas == nil {
+		panic("schema repository store is required")
+	}
+	return &postgresRepository{spaceDB: spaceDB, schemas: schemas}
+}
+
+func (r *postgresRepository) ListActiveSchemas(ctx context.Context) ([]persistence.SchemaRecord, error) {
+	records, err := r.schemas.ListAllSchemaVersions(ctx, r.spaceDB, false)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]persistence.SchemaRecord, 0, len(records))
+	for _, record := range records {
+		if record.IsActive && !record.IsDeleted && record.Status == persistence.SchemaStatusPublished {
+			active = append(active, record)
+		}
+	}
+	return active, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// checkDepth rejects queries whose field selections nest deeper than maxDepth, counting through fragments, before
+// any resolver runs. Queries that do not parse are left to the executor, which reports the syntax error.
+func checkDepth(query string, maxDepth int) *QueryError {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil
+	}
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if depth := selectionDepth(operation.SelectionSet, fragments, map[string]bool{}); depth > maxDepth {
+			return &QueryError{Message: fmt.Sprintf("query depth %d exceeds the limit of %d", depth, maxDepth)}
+		}
+	}
+	return nil
+}
+
+// selectionDepth returns the deepest field nesting below set. visiting guards against fragment cycles, which the
+// executor's validation rejects anyway.
+func selectionDepth(set *ast.SelectionSet, fragments map[string]*ast.FragmentDefinition, visiting map[string]bool) int {
+	if set == nil {
+		return 0
+	}
+
+	deepest := 0
+	for _, selection := range set.Selections {
+		depth := 0
+		switch typed := selection.(type) {
+		case *ast.Field:
+			depth = 1 + selectionDepth(typed.SelectionSet, fragments, visiting)
+		case *ast.InlineFragment:
+			depth = selectionDepth(typed.SelectionSet, fragments, visiting)
+		case *ast.FragmentSpread:
+			if typed.Name == nil {
+				continue
+			}
+			fragment, ok := fragments[typed.Name.Value]
+			if !ok || visiting[typed.Name.Value] {
+				continue
+			}
+			visiting[typed.Name.Value] = true
+			depth = selectionDepth(fragment.SelectionSet, fragments, visiting)
+			delete(visiting, typed.Name.Value)
+		}
+		deepest = max(deepest, depth)
+	}
+	return deepest
+}
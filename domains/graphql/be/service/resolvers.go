@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+
+	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type requestStateKey struct{}
+
+// requestState is shared by the resolvers of one request. Documents are cached by table and identifier, so a
+// document referenced from many places is read once; a nil entry records a missing document.
+type requestState struct {
+	audit requesttrace.AuditInfo
+
+	mu        sync.Mutex
+	documents map[string]*entitiesservice.Document
+}
+
+func withRequestState(ctx context.Context, state *requestState) context.Context {
+	return context.WithValue(ctx, requestStateKey{}, state)
+}
+
+func stateFrom(ctx context.Context) (*requestState, error) {
+	state, ok := ctx.Value(requestStateKey{}).(*requestState)
+	if !ok {
+		return nil, fmt.Errorf("%w: graphql request state missing from context", ErrInternal)
+	}
+	return state, nil
+}
+
+func documentKey(tableName, entityID string) string {
+	return tableName + "\x00" + entityID
+}
+
+func (s *requestState) get(ctx context.Context, entities EntityReader, tableName, entityID string) (*entitiesservice.Document, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := documentKey(tableName, entityID)
+	if doc, ok := s.documents[key]; ok {
+		return doc, nil
+	}
+
+	doc, err := entities.Get(ctx, s.audit, tableName, entityID)
+	if errors.Is(err, entitiesservice.ErrDocumentNotFound) {
+		s.documents[key] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	s.documents[key] = &doc
+	return &doc, nil
+}
+
+// getMany returns the documents of entityIDs that exist, in the given order.
+func (s *requestState) getMany(ctx context.Context, entities EntityReader, tableName string, entityIDs []string) ([]entitiesservice.Document, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pending []string
+	seen := make(map[string]struct{}, len(entityIDs))
+	for _, entityID := range entityIDs {
+		key := documentKey(tableName, entityID)
+		if _, cached := s.documents[key]; cached {
+			continue
+		}
+		if _, dup := seen[entityID]; dup {
+			continue
+		}
+		seen[entityID] = struct{}{}
+		pending = append(pending, entityID)
+	}
+
+	for start := 0; start < len(pending); start += entitiesservice.MaxBatchGetIDs {
+		chunk := pending[start:min(start+entitiesservice.MaxBatchGetIDs, len(pending))]
+		result, err := entities.BatchGet(ctx, s.audit, tableName, chunk)
+		if err != nil {
+			return nil, resolverError(err)
+		}
+		for _, entityID := range chunk {
+			s.documents[documentKey(tableName, entityID)] = nil
+		}
+		for i := range result.Items {
+			s.documents[documentKey(tableName, result.Items[i].EntityID)] = &result.Items[i]
+		}
+	}
+
+	docs := make([]entitiesservice.Document, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		if doc := s.documents[documentKey(tableName, entityID)]; doc != nil {
+			docs = append(docs, *doc)
+		}
+	}
+	return docs, nil
+}
+
+func (s *requestState) remember(tableName string, docs []entitiesservice.Document) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range docs {
+		s.documents[documentKey(tableName, docs[i].EntityID)] = &docs[i]
+	}
+}
+
+func (b *schemaBuilder) resolveGet(tableName string) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		state, err := stateFrom(p.Context)
+		if err != nil {
+			return nil, err
+		}
+		entityID, _ := p.Args["entityId"].(string)
+		doc, err := state.get(p.Context, b.entities, tableName, entityID)
+		if err != nil || doc == nil {
+			return nil, err
+		}
+		return documentSource(*doc), nil
+	}
+}
+
+func (b *schemaBuilder) resolveList(tableName string) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		state, err := stateFrom(p.Context)
+		if err != nil {
+			return nil, err
+		}
+
+		opts := entitiesservice.ListOptions{}
+		opts.Page, _ = p.Args["page"].(int)
+		opts.PageSize, _ = p.Args["pageSize"].(int)
+		opts.Sort, _ = p.Args["sort"].(string)
+		opts.Cursor, _ = p.Args["cursor"].(string)
+
+		result, err := b.entities.List(p.Context, state.audit, tableName, opts)
+		if err != nil {
+			return nil, resolverError(err)
+		}
+		state.remember(tableName, result.Items)
+
+		items := make([]interface{}, 0, len(result.Items))
+		for _, doc := range result.Items {
+			items = append(items, documentSource(doc))
+		}
+		page := map[string]interface{}{
+			"items":      items,
+			"page":       result.Page,
+			"pageSize":   result.PageSize,
+			"totalItems": result.TotalItems,
+			"totalPages": result.TotalPages,
+		}
+		if result.NextCursor != "" {
+			page["nextCursor"] = result.NextCursor
+		}
+		return page, nil
+	}
+}
+
+// resolveRef follows the x-ref property key of the payload object being resolved.
+func (b *schemaBuilder) resolveRef(tableName, key string, many bool) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		state, err := stateFrom(p.Context)
+		if err != nil {
+			return nil, err
+		}
+		source, _ := p.Source.(map[string]interface{})
+
+		if !many {
+			entityID, ok := source[key].(string)
+			if !ok || entityID == "" {
+				return nil, nil
+			}
+			doc, err := state.get(p.Context, b.entities, tableName, entityID)
+			if err != nil || doc == nil {
+				return nil, err
+			}
+			return documentSource(*doc), nil
+		}
+
+		values, _ := source[key].([]interface{})
+		entityIDs := make([]string, 0, len(values))
+		for _, value := range values {
+			if entityID, ok := value.(string); ok && entityID != "" {
+				entityIDs = append(entityIDs, entityID)
+			}
+		}
+		docs, err := state.getMany(p.Context, b.entities, tableName, entityIDs)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]interface{}, 0, len(docs))
+		for _, doc := range docs {
+			items = append(items, documentSource(doc))
+		}
+		return items, nil
+	}
+}
+
+// resolverError keeps the messages of client-facing entity errors and hides everything else behind ErrInternal.
+func resolverError(err error) error {
+	var validationErr *entitiesservice.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return errors.New(validationErr.Reason)
+	case errors.Is(err, entitiesservice.ErrTableNotFound),
+		errors.Is(err, entitiesservice.ErrEncryptionUnavailable):
+		return err
+	default:
+		return fmt.Errorf("%w: %w", ErrInternal, err)
+	}
+}
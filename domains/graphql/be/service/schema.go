@@ -0,0 +1,307 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// referenceKeyword mirrors the persistence layer's "x-ref" schema extension.
+const referenceKeyword = "x-ref"
+
+// tablesField lists the tables exposed by the schema. Table names never start with an underscore, so it cannot
+// clash with a table field, and it keeps Query valid when no schema is active.
+const tablesField = "_tables"
+
+var namePattern = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// jsonScalar carries payload values that have no GraphQL representation, such as untyped or free-form properties.
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Any JSON value. Output only.",
+	Serialize:   func(value interface{}) interface{} { return value },
+	ParseValue:  func(value interface{}) interface{} { return value },
+	ParseLiteral: func(ast.Value) interface{} {
+		return nil
+	},
+})
+
+type schemaBuilder struct {
+	entities EntityReader
+	tables   map[string]*graphql.Object
+	payloads map[string]graphql.Output
+	names    map[string]struct{}
+}
+
+// buildSchema generates a read-only GraphQL schema with an object type, a get field and a list field per table.
+// records must be sorted by table name so type names are stable across builds.
+func buildSchema(records []persistence.SchemaRecord, entities EntityReader) (graphql.Schema, error) {
+	b := &schemaBuilder{
+		entities: entities,
+		tables:   make(map[string]*graphql.Object, len(records)),
+		payloads: make(map[string]graphql.Output, len(records)),
+		names: map[string]struct{}{
+			"Query": {}, "JSON": {}, "ID": {}, "String": {}, "Int": {}, "Float": {}, "Boolean": {},
+		},
+	}
+
+	type table struct {
+		name       string
+		typeName   string
+		definition map[string]interface{}
+	}
+	tables := make([]table, 0, len(records))
+	for _, record := range records {
+		typeName := pascalCase(record.TableName)
+		if !b.reserve(typeName, typeName+"Page", typeName+"Payload") {
+			// Table names differing only in underscores map to the same type; the first one wins.
+			continue
+		}
+		var definition map[string]interface{}
+		if err := json.Unmarshal(record.SchemaDefinition, &definition); err != nil {
+			return graphql.Schema{}, fmt.Errorf("decode schema definition of %s: %w", record.TableName, err)
+		}
+		tables = append(tables, table{name: record.TableName, typeName: typeName, definition: definition})
+	}
+
+	// Entity types are declared before any payload type so references can point at tables in any order,
+	// including back at their own table.
+	for _, t := range tables {
+		tableName := t.name
+		b.tables[tableName] = graphql.NewObject(graphql.ObjectConfig{
+			Name:        t.typeName,
+			Description: fmt.Sprintf("A document of the %s table.", tableName),
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return entityFields(b.payloads[tableName])
+			}),
+		})
+	}
+	for _, t := range tables {
+		b.payloads[t.name] = b.objectType(t.typeName+"Payload", t.definition)
+	}
+
+	names := make([]string, 0, len(tables))
+	fields := graphql.Fields{}
+	for _, t := range tables {
+		names = append(names, t.name)
+		field := lowerFirst(t.typeName)
+		fields[field] = &graphql.Field{
+			Type:        b.tables[t.name],
+			Description: fmt.Sprintf("Fetches a %s document; null when it does not exist.", t.name),
+			Args: graphql.FieldConfigArgument{
+				"entityId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: b.resolveGet(t.name),
+		}
+		fields[field+"List"] = &graphql.Field{
+			Type:        b.pageType(t.typeName+"Page", b.tables[t.name]),
+			Description: fmt.Sprintf("Lists %s documents, newest first unless sort says otherwise.", t.name),
+			Args: graphql.FieldConfigArgument{
+				"page":     &graphql.ArgumentConfig{Type: graphql.Int},
+				"pageSize": &graphql.ArgumentConfig{Type: graphql.Int},
+				"sort":     &graphql.ArgumentConfig{Type: graphql.String, Description: "Sort expression as accepted by the REST list endpoint."},
+				"cursor":   &graphql.ArgumentConfig{Type: graphql.String, Description: "nextCursor of a previous page."},
+			},
+			Resolve: b.resolveList(t.name),
+		}
+	}
+	fields[tablesField] = &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+		Description: "Tables exposed by this schema.",
+		Resolve:     func(graphql.ResolveParams) (interface{}, error) { return names, nil },
+	}
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: fields}),
+	})
+}
+
+func entityFields(payload graphql.Output) graphql.Fields {
+	return graphql.Fields{
+		"entityId":      &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+		"entityVersion": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"schemaVersion": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"createdAt":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"hash":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"payload":       &graphql.Field{Type: payload},
+	}
+}
+
+func (b *schemaBuilder) pageType(name string, entity *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.Fields{
+			"items":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(entity)))},
+			"page":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"pageSize":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"totalItems": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"totalPages": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"nextCursor": &graphql.Field{Type: graphql.String},
+		},
+	})
+}
+
+// objectType maps an object schema to a GraphQL object. Properties whose names are not valid GraphQL names are left
+// out; they stay reachable through the payload of the REST contract. Objects without any usable property map to
+// JSON, since GraphQL objects need at least one field.
+func (b *schemaBuilder) objectType(name string, node map[string]interface{}) graphql.Output {
+	properties, _ := node["properties"].(map[string]interface{})
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := graphql.Fields{}
+	for _, key := range keys {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok || !validName(key) {
+			continue
+		}
+		description, _ := property["description"].(string)
+		fields[key] = &graphql.Field{Type: b.propertyType(name+"_"+key, property), Description: description}
+	}
+
+	// Reference fields are added last so they never shadow a declared property.
+	for _, key := range keys {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok || !validName(key) {
+			continue
+		}
+		target, _ := property[referenceKeyword].(string)
+		entity, ok := b.tables[target]
+		if !ok {
+			continue
+		}
+
+		many := schemaType(property) == "array"
+		field := &graphql.Field{
+			Type:        entity,
+			Description: fmt.Sprintf("The %s document referenced by %s.", target, key),
+			Resolve:     b.resolveRef(target, key, many),
+		}
+		refName := key + "Ref"
+		if many {
+			field.Type = graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(entity)))
+			field.Description = fmt.Sprintf("The %s documents referenced by %s; missing documents are skipped.", target, key)
+			refName = key + "Refs"
+		}
+		if _, exists := fields[refName]; !exists {
+			fields[refName] = field
+		}
+	}
+
+	if len(fields) == 0 {
+		return jsonScalar
+	}
+	return graphql.NewObject(graphql.ObjectConfig{Name: b.unique(name), Fields: fields})
+}
+
+// propertyType maps a JSON Schema property to a GraphQL output type. Every field is nullable because masking and
+// optional properties can leave any value null. Integers map to Float, as GraphQL Int is limited to 32 bits.
+func (b *schemaBuilder) propertyType(name string, property map[string]interface{}) graphql.Output {
+	switch schemaType(property) {
+	case "string":
+		return graphql.String
+	case "integer", "number":
+		return graphql.Float
+	case "boolean":
+		return graphql.Boolean
+	case "object":
+		return b.objectType(name, property)
+	case "array":
+		if items, ok := property["items"].(map[string]interface{}); ok {
+			return graphql.NewList(b.propertyType(name+"_item", items))
+		}
+		return graphql.NewList(jsonScalar)
+	default:
+		return jsonScalar
+	}
+}
+
+// reserve claims type names for a table, reporting false when any of them is already taken.
+func (b *schemaBuilder) reserve(names ...string) bool {
+	for _, name := range names {
+		if _, taken := b.names[name]; taken {
+			return false
+		}
+	}
+	for _, name := range names {
+		b.names[name] = struct{}{}
+	}
+	return true
+}
+
+// unique claims name, appending a counter when a property path already produced it.
+func (b *schemaBuilder) unique(name string) string {
+	candidate := name
+	for i := 2; ; i++ {
+		if b.reserve(candidate) {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+}
+
+// schemaType returns the JSON Schema type of a property; for type unions such as ["string", "null"] the first
+// non-null member wins.
+func schemaType(property map[string]interface{}) string {
+	switch typed := property["type"].(type) {
+	case string:
+		return typed
+	case []interface{}:
+		for _, item := range typed {
+			if kind, ok := item.(string); ok && kind != "null" {
+				return kind
+			}
+		}
+	}
+	if _, ok := property["properties"]; ok {
+		return "object"
+	}
+	return ""
+}
+
+func validName(name string) bool {
+	return namePattern.MatchString(name) && !strings.HasPrefix(name, "__")
+}
+
+// pascalCase turns a table name such as "cards_entities" into "CardsEntities".
+func pascalCase(tableName string) string {
+	var builder strings.Builder
+	for _, part := range strings.Split(tableName, "_") {
+		if part == "" {
+			continue
+		}
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return builder.String()
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// documentSource exposes a document to the default field resolvers.
+func documentSource(doc entitiesservice.Document) map[string]interface{} {
+	return map[string]interface{}{
+		"entityId":      doc.EntityID,
+		"entityVersion": doc.EntityVersion.String(),
+		"schemaVersion": doc.SchemaVersion.String(),
+		"createdAt":     doc.CreatedAt.UTC().Format(time.RFC3339Nano),
+		"hash":          doc.Hash,
+		"payload":       doc.Payload,
+	}
+}
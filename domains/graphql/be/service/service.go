@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+
+	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/graphql/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// ErrInternal replaces the message of resolver failures that must not leak to callers; QueryError.Cause keeps the
+// original error for logging.
+var ErrInternal = errors.New("internal error")
+
+// EntityReader is the subset of the entities service the resolvers read documents through, so GraphQL results get
+// the same soft-delete filtering and x-pii masking as the REST contract.
+type EntityReader interface {
+	Get(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityID string) (entitiesservice.Document, error)
+	List(ctx context.Context, audit requesttrace.AuditInfo, tableName string, opts entitiesservice.ListOptions) (entitiesservice.ListResult, error)
+	BatchGet(ctx context.Context, audit requesttrace.AuditInfo, tableName string, entityIDs []string) (entitiesservice.BatchGetResult, error)
+}
+
+// Request is a GraphQL request as received over HTTP.
+type Request struct {
+	Query         string
+	OperationName string
+	Variables     map[string]interface{}
+}
+
+// Location points at the part of the query an error refers to.
+type Location struct {
+	Line   int
+	Column int
+}
+
+// QueryError is a GraphQL error reported next to the data.
+type QueryError struct {
+	Message   string
+	Locations []Location
+	Path      []interface{}
+	// Cause is the resolver error Message was derived from; nil for syntax and validation errors.
+	Cause error
+}
+
+// Result is the outcome of a GraphQL request. Data is nil when the query could not be executed at all.
+type Result struct {
+	Data   map[string]interface{}
+	Errors []QueryError
+}
+
+// Service executes read-only GraphQL queries against a schema generated from the active schema definitions.
+type Service interface {
+	Execute(ctx context.Context, audit requesttrace.AuditInfo, request Request) (Result, error)
+}
+
+// Config tunes the GraphQL service. Zero values fall back to defaults: queries may nest 10 levels deep.
+type Config struct {
+	MaxDepth int
+}
+
+// maxCachedSchemas bounds the generated schemas kept in memory; the cache is reset when it fills up.
+const maxCachedSchemas = 16
+
+type service struct {
+	repo     repo.Repository
+	entities EntityReader
+	cfg      Config
+
+	mu      sync.Mutex
+	schemas map[string]graphql.Schema
+}
+
+// New constructs a Service instance.
+func New(repository repo.Repository, entities EntityReader, cfg Config) Service {
+	if repository == nil {
+		panic("graphql repository is required")
+	}
+	if entities == nil {
+		panic("entity reader is required")
+	}
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = 10
+	}
+	return &service{repo: repository, entities: entities, cfg: cfg, schemas: make(map[string]graphql.Schema)}
+}
+
+func (s *service) Execute(ctx context.Context, audit requesttrace.AuditInfo, request Request) (Result, error) {
+	schema, err := s.schema(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if depthErr := checkDepth(request.Query, s.cfg.MaxDepth); depthErr != nil {
+		return Result{Errors: []QueryError{*depthErr}}, nil
+	}
+
+	executed := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        withRequestState(ctx, &requestState{audit: audit, documents: make(map[string]*entitiesservice.Document)}),
+	})
+
+	result := Result{Errors: queryErrors(executed.Errors)}
+	if data, ok := executed.Data.(map[string]interface{}); ok {
+		result.Data = data
+	}
+	return result, nil
+}
+
+// schema returns the GraphQL schema for the active definitions, generating it on first use. Schemas are cached by
+// a fingerprint of the definitions, so publishing or activating a schema version takes effect on the next request.
+func (s *service) schema(ctx context.Context) (graphql.Schema, error) {
+	records, err := s.repo.ListActiveSchemas(ctx)
+	if err != nil {
+		return graphql.Schema{}, fmt.Errorf("list active schemas: %w", err)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].TableName < records[j].TableName })
+	key := fingerprint(records)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if schema, ok := s.schemas[key]; ok {
+		return schema, nil
+	}
+
+	schema, err := buildSchema(records, s.entities)
+	if err != nil {
+		return graphql.Schema{}, fmt.Errorf("build graphql schema: %w", err)
+	}
+	if len(s.schemas) >= maxCachedSchemas {
+		s.schemas = make(map[string]graphql.Schema)
+	}
+	s.schemas[key] = schema
+	return schema, nil
+}
+
+func fingerprint(records []persistence.SchemaRecord) string {
+	hash := sha256.New()
+	for _, record := range records {
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\x00", record.TableName, record.SchemaID, record.Hash)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func queryErrors(formatted []gqlerrors.FormattedError) []QueryError {
+	if len(formatted) == 0 {
+		return nil
+	}
+
+	errs := make([]QueryError, 0, len(formatted))
+	for _, item := range formatted {
+		queryErr := QueryError{Message: item.Message, Path: item.Path, Cause: resolverCause(item.OriginalError())}
+		if errors.Is(queryErr.Cause, ErrInternal) {
+			queryErr.Message = ErrInternal.Error()
+		}
+		for _, location := range item.Locations {
+			queryErr.Locations = append(queryErr.Locations, Location{Line: location.Line, Column: location.Column})
+		}
+		errs = append(errs, queryErr)
+	}
+	return errs
+}
+
+// resolverCause unwraps the located error graphql-go wraps resolver failures in.
+func resolverCause(err error) error {
+	var located *gqlerrors.Error
+	if errors.As(err, &located) {
+		return located.OriginalError
+	}
+	return err
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	entitiesservice "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type stubRepository struct {
+	records []persistence.SchemaRecord
+	calls   int
+}
+
+func (s *stubRepository) ListActiveSchemas(context.Context) ([]persistence.SchemaRecord, error) {
+	s.calls++
+	return s.records, nil
+}
+
+type stubEntities struct {
+	documents map[string]map[string]entitiesservice.Document
+	gets      int
+	getErr    error
+}
+
+func (s *stubEntities) Get(_ context.Context, _ requesttrace.AuditInfo, tableName string, entityID string) (entitiesservice.Document, error) {
+	s.gets++
+	if s.getErr != nil {
+		return entitiesservice.Document{}, s.getErr
+	}
+	doc, ok := s.documents[tableName][entityID]
+	if !ok {
+		return entitiesservice.Document{}, entitiesservice.ErrDocumentNotFound
+	}
+	return doc, nil
+}
+
+func (s *stubEntities) List(_ context.Context, _ requesttrace.AuditInfo, tableName string, opts entitiesservice.ListOptions) (entitiesservice.ListResult, error) {
+	if opts.Sort == "bogus" {
+		return entitiesservice.ListResult{}, &entitiesservice.ValidationError{Reason: "unsupported sort field"}
+	}
+	items := make([]entitiesservice.Document, 0, len(s.documents[tableName]))
+	for _, doc := range s.documents[tableName] {
+		items = append(items, doc)
+	}
+	return entitiesservice.ListResult{Items: items, Envelope: pagination.Envelope{Page: 1, PageSize: 20, TotalItems: len(items), TotalPages: 1}}, nil
+}
+
+func (s *stubEntities) BatchGet(_ context.Context, _ requesttrace.AuditInfo, tableName string, entityIDs []string) (entitiesservice.BatchGetResult, error) {
+	var result entitiesservice.BatchGetResult
+	for _, entityID := range entityIDs {
+		if doc, ok := s.documents[tableName][entityID]; ok {
+			result.Items = append(result.Items, doc)
+		} else {
+			result.Missing = append(result.Missing, entityID)
+		}
+	}
+	return result, nil
+}
+
+func schemaRecord(tableName, definition string) persistence.SchemaRecord {
+	return persistence.SchemaRecord{
+		SchemaID:         uuid.New(),
+		TableName:        tableName,
+		SchemaDefinition: persistence.SchemaDefinition(definition),
+		Hash:             tableName,
+		IsActive:         true,
+		Status:           persistence.SchemaStatusPublished,
+	}
+}
+
+func newTestService() (*stubRepository, *stubEntities, Service) {
+	repository := &stubRepository{records: []persistence.SchemaRecord{
+		schemaRecord("cards_entities", `{"type":"object","properties":{
+			"name":{"type":"string"},
+			"power":{"type":"integer"},
+			"owner":{"type":"object","properties":{"email":{"type":["string","null"]}}},
+			"setId":{"type":"string","x-ref":"sets_entities"},
+			"relatedIds":{"type":"array","items":{"type":"string"},"x-ref":"cards_entities"},
+			"extra":{}
+		}}`),
+		schemaRecord("sets_entities", `{"type":"object","properties":{"code":{"type":"string"}}}`),
+	}}
+	entities := &stubEntities{documents: map[string]map[string]entitiesservice.Document{
+		"cards_entities": {
+			"card-1": {EntityID: "card-1", Payload: map[string]interface{}{
+				"name": "Black Lotus", "power": float64(0), "owner": map[string]interface{}{"email": nil},
+				"setId": "lea", "relatedIds": []interface{}{"card-2", "card-9"},
+				"extra": map[string]interface{}{"note": "mint"},
+			}},
+			"card-2": {EntityID: "card-2", Payload: map[string]interface{}{"name": "Mox Pearl", "setId": "lea"}},
+		},
+		"sets_entities": {
+			"lea": {EntityID: "lea", Payload: map[string]interface{}{"code": "LEA"}},
+		},
+	}}
+	return repository, entities, New(repository, entities, Config{MaxDepth: 6})
+}
+
+func execute(t *testing.T, svc Service, query string, variables map[string]interface{}) Result {
+	t.Helper()
+	result, err := svc.Execute(context.Background(), requesttrace.Anonymous(""), Request{Query: query, Variables: variables})
+	require.NoError(t, err)
+	return result
+}
+
+func asJSON(t *testing.T, value interface{}) string {
+	t.Helper()
+	raw, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(raw)
+}
+
+func TestService_ExecuteResolvesNestedReferences(t *testing.T) {
+	repository, entities, svc := newTestService()
+
+	result := execute(t, svc, `query Card($id: ID!) {
+		cardsEntities(entityId: $id) {
+			entityId
+			payload {
+				name power extra
+				owner { email }
+				setIdRef { payload { code } }
+				relatedIdsRefs { entityId payload { setIdRef { entityId } } }
+			}
+		}
+		missing: cardsEntities(entityId: "nope") { entityId }
+		_tables
+	}`, map[string]interface{}{"id": "card-1"})
+	require.Empty(t, result.Errors)
+	require.JSONEq(t, `{
+		"cardsEntities": {
+			"entityId": "card-1",
+			"payload": {
+				"name": "Black Lotus", "power": 0, "extra": {"note": "mint"},
+				"owner": {"email": null},
+				"setIdRef": {"payload": {"code": "LEA"}},
+				"relatedIdsRefs": [{"entityId": "card-2", "payload": {"setIdRef": {"entityId": "lea"}}}]
+			}
+		},
+		"missing": null,
+		"_tables": ["cards_entities", "sets_entities"]
+	}`, asJSON(t, result.Data))
+	require.Equal(t, 3, entities.gets, "the set referenced twice is read once")
+
+	listed := execute(t, svc, `{ setsEntitiesList { totalItems items { payload { code } } } }`, nil)
+	require.Empty(t, listed.Errors)
+	require.JSONEq(t, `{"setsEntitiesList": {"totalItems": 1, "items": [{"payload": {"code": "LEA"}}]}}`, asJSON(t, listed.Data))
+	require.Equal(t, 2, repository.calls)
+}
+
+func TestService_ExecuteReportsErrors(t *testing.T) {
+	_, entities, svc := newTestService()
+
+	result := execute(t, svc, `{ setsEntitiesList(sort: "bogus") { totalItems } }`, nil)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "unsupported sort field", result.Errors[0].Message)
+	require.Equal(t, []interface{}{"setsEntitiesList"}, result.Errors[0].Path)
+
+	entities.getErr = errors.New("connection reset")
+	result = execute(t, svc, `{ setsEntities(entityId: "lea") { entityId } }`, nil)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "internal error", result.Errors[0].Message)
+	require.ErrorIs(t, result.Errors[0].Cause, ErrInternal)
+	require.ErrorContains(t, result.Errors[0].Cause, "connection reset")
+
+	result = execute(t, svc, `{ cardsEntities(entityId: "card-1") { unknown } }`, nil)
+	require.Nil(t, result.Data)
+	require.Len(t, result.Errors, 1)
+	require.NotEmpty(t, result.Errors[0].Locations)
+
+	result = execute(t, svc, `mutation { cardsEntities(entityId: "card-1") { entityId } }`, nil)
+	require.NotEmpty(t, result.Errors)
+}
+
+func TestService_ExecuteLimitsDepth(t *testing.T) {
+	_, _, svc := newTestService()
+
+	result := execute(t, svc, `
+		query { cardsEntities(entityId: "card-1") { ...Deep } }
+		fragment Deep on CardsEntities { payload { relatedIdsRefs { payload { relatedIdsRefs { payload { name } } } } } }
+	`, nil)
+	require.Nil(t, result.Data)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "query depth 7 exceeds the limit of 6", result.Errors[0].Message)
+}
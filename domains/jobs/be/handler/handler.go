@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	jobs "github.com/zenGate-Global/palmyra-pro-saas/generated/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	getOperation operation = "jobsGet"
+)
+
+// Handler wires the jobs service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("jobs service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) JobsGet(ctx context.Context, request jobs.JobsGetRequestObject) (jobs.JobsGetResponseObject, error) {
+	found, err := h.svc.Get(ctx, h.audit(ctx), uuid.UUID(request.JobId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return jobs.JobsGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return jobs.JobsGet200JSONResponse(toAPIJob(found)), nil
+}
+
+func toAPIJob(job service.Job) jobs.Job {
+	out := jobs.Job{
+		JobId:       externalRef2.UUID(job.ID),
+		Kind:        job.Kind,
+		Status:      jobs.JobStatus(job.Status),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   job.LastError,
+		CreatedAt:   externalRef2.Timestamp(job.CreatedAt),
+		UpdatedAt:   externalRef2.Timestamp(job.UpdatedAt),
+	}
+	if job.Result != nil {
+		result := job.Result
+		out.Result = &result
+	}
+	if job.NextAttemptAt != nil {
+		nextAttemptAt := externalRef2.Timestamp(*job.NextAttemptAt)
+		out.NextAttemptAt = &nextAttemptAt
+	}
+	if job.StartedAt != nil {
+		startedAt := externalRef2.Timestamp(*job.StartedAt)
+		out.StartedAt = &startedAt
+	}
+	if job.FinishedAt != nil {
+		finishedAt := externalRef2.Timestamp(*job.FinishedAt)
+		out.FinishedAt = &finishedAt
+	}
+	return out
+}
+
+// errorCatalog maps jobs service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("jobs")
+	c.Register(service.ErrNotFound, problems.NotFound("job not found"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
+
+// compile-time assertions to ensure interface compliance
+var _ jobs.StrictServerInterface = (*Handler)(nil)
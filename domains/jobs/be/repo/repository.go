@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Repository defines the persistence operations required by the jobs API.
+type Repository interface {
+	// GetJob returns a job of any tenant; callers scope it. It returns persistence.ErrJobNotFound when the job
+	// does not exist.
+	GetJob(ctx context.Context, jobID uuid.UUID) (persistence.JobRecord, error)
+}
+
+type postgresRepository struct {
+	store *persistence.JobStore
+}
+
+// NewPostgresRepository constructs a repository backed by the shared job queue.
+func NewPostgresRepository(store *persistence.JobStore) Repository {
+	if store == nil {
+		panic("job store is required")
+	}
+	return &postgresRepository{store: store}
+}
+
+func (r *postgresRepository) GetJob(ctx context.Context, jobID uuid.UUID) (persistence.JobRecord, error) {
+	return r.store.Get(ctx, jobID)
+}
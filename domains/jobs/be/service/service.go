@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrNotFound is returned when the job does not exist or belongs to another tenant.
+var ErrNotFound = errors.New("job not found")
+
+// Job represents the domain view of a background job.
+type Job struct {
+	ID            uuid.UUID
+	Kind          string
+	Status        string
+	Attempts      int
+	MaxAttempts   int
+	LastError     *string
+	Result        map[string]interface{}
+	NextAttemptAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+// Service exposes the status of the caller's tenant jobs.
+type Service interface {
+	Get(ctx context.Context, audit requesttrace.AuditInfo, jobID uuid.UUID) (Job, error)
+}
+
+type service struct {
+	repo repo.Repository
+}
+
+// New constructs a Service instance.
+func New(r repo.Repository) Service {
+	if r == nil {
+		panic("jobs repository is required")
+	}
+	return &service{repo: r}
+}
+
+func (s *service) Get(ctx context.Context, _ requesttrace.AuditInfo, jobID uuid.UUID) (Job, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return Job{}, errors.New("tenant space missing from context")
+	}
+
+	record, err := s.repo.GetJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return Job{}, ErrNotFound
+		}
+		return Job{}, err
+	}
+	if record.TenantID == nil || *record.TenantID != space.TenantID {
+		return Job{}, ErrNotFound
+	}
+	return mapJob(record)
+}
+
+func mapJob(record persistence.JobRecord) (Job, error) {
+	job := Job{
+		ID:          record.JobID,
+		Kind:        record.Kind,
+		Status:      record.Status,
+		Attempts:    record.Attempts,
+		MaxAttempts: record.MaxAttempts,
+		LastError:   record.LastError,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+		StartedAt:   record.StartedAt,
+		FinishedAt:  record.FinishedAt,
+	}
+	if record.Status == persistence.JobQueued {
+		nextAttemptAt := record.NextAttemptAt
+		job.NextAttemptAt = &nextAttemptAt
+	}
+	if len(record.Result) > 0 && string(record.Result) != "null" {
+		// Handlers may return any JSON; the contract exposes objects, so other values are wrapped.
+		if err := json.Unmarshal(record.Result, &job.Result); err != nil {
+			var value interface{}
+			if err := json.Unmarshal(record.Result, &value); err != nil {
+				return Job{}, fmt.Errorf("decode job result: %w", err)
+			}
+			job.Result = map[string]interface{}{"value": value}
+		}
+	}
+	return job, nil
+}
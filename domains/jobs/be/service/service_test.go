@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type mockRepository struct {
+	jobs map[uuid.UUID]persistence.JobRecord
+}
+
+func (m *mockRepository) GetJob(_ context.Context, jobID uuid.UUID) (persistence.JobRecord, error) {
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return persistence.JobRecord{}, persistence.ErrJobNotFound
+	}
+	return job, nil
+}
+
+func TestGetScopesJobsToTheTenant(t *testing.T) {
+	t.Parallel()
+
+	tenantID, otherTenant := uuid.New(), uuid.New()
+	finishedAt := time.Now().UTC()
+	own := persistence.JobRecord{
+		JobID: uuid.New(), TenantID: &tenantID, Kind: "export", Status: persistence.JobSucceeded,
+		Attempts: 1, MaxAttempts: 5, Result: json.RawMessage(`{"rows":2}`), FinishedAt: &finishedAt,
+	}
+	scalar := persistence.JobRecord{JobID: uuid.New(), TenantID: &tenantID, Kind: "count", Status: persistence.JobSucceeded, Result: json.RawMessage(`7`)}
+	queued := persistence.JobRecord{JobID: uuid.New(), TenantID: &tenantID, Kind: "import", Status: persistence.JobQueued, NextAttemptAt: finishedAt}
+	foreign := persistence.JobRecord{JobID: uuid.New(), TenantID: &otherTenant, Kind: "export", Status: persistence.JobQueued}
+	platform := persistence.JobRecord{JobID: uuid.New(), Kind: "migrate", Status: persistence.JobQueued}
+
+	svc := New(&mockRepository{jobs: map[uuid.UUID]persistence.JobRecord{
+		own.JobID: own, scalar.JobID: scalar, queued.JobID: queued, foreign.JobID: foreign, platform.JobID: platform,
+	}})
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{TenantID: tenantID, Slug: "acme"})
+	audit := requesttrace.Anonymous("test")
+
+	job, err := svc.Get(ctx, audit, own.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobSucceeded, job.Status)
+	require.Equal(t, map[string]interface{}{"rows": float64(2)}, job.Result)
+	require.Nil(t, job.NextAttemptAt)
+
+	job, err = svc.Get(ctx, audit, scalar.JobID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"value": float64(7)}, job.Result)
+
+	job, err = svc.Get(ctx, audit, queued.JobID)
+	require.NoError(t, err)
+	require.Equal(t, finishedAt, *job.NextAttemptAt)
+
+	for _, id := range []uuid.UUID{foreign.JobID, platform.JobID, uuid.New()} {
+		_, err = svc.Get(ctx, audit, id)
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+}
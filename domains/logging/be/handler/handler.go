@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/logging/be/service"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	logging "github.com/zenGate-Global/palmyra-pro-saas/generated/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	getOperation             operation = "loggingLevelGet"
+	setOperation             operation = "loggingLevelSet"
+	setComponentOperation    operation = "loggingComponentLevelSet"
+	deleteComponentOperation operation = "loggingComponentLevelDelete"
+)
+
+// Handler wires the log level service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("logging service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) LoggingLevelGet(ctx context.Context, _ logging.LoggingLevelGetRequestObject) (logging.LoggingLevelGetResponseObject, error) {
+	settings, err := h.svc.Get(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return logging.LoggingLevelGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return logging.LoggingLevelGet200JSONResponse(toAPILevels(settings)), nil
+}
+
+func (h *Handler) LoggingLevelSet(ctx context.Context, request logging.LoggingLevelSetRequestObject) (logging.LoggingLevelSetResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return logging.LoggingLevelSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	settings, err := h.svc.SetLevel(ctx, h.audit(ctx), string(request.Body.Level))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, setOperation)
+		return logging.LoggingLevelSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return logging.LoggingLevelSet200JSONResponse(toAPILevels(settings)), nil
+}
+
+func (h *Handler) LoggingComponentLevelSet(ctx context.Context, request logging.LoggingComponentLevelSetRequestObject) (logging.LoggingComponentLevelSetResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return logging.LoggingComponentLevelSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	settings, err := h.svc.SetComponentLevel(ctx, h.audit(ctx), request.Component, string(request.Body.Level))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, setComponentOperation)
+		return logging.LoggingComponentLevelSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return logging.LoggingComponentLevelSet200JSONResponse(toAPILevels(settings)), nil
+}
+
+func (h *Handler) LoggingComponentLevelDelete(ctx context.Context, request logging.LoggingComponentLevelDeleteRequestObject) (logging.LoggingComponentLevelDeleteResponseObject, error) {
+	settings, err := h.svc.DeleteComponentLevel(ctx, h.audit(ctx), request.Component)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, deleteComponentOperation)
+		return logging.LoggingComponentLevelDeletedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return logging.LoggingComponentLevelDelete200JSONResponse(toAPILevels(settings)), nil
+}
+
+func toAPILevels(settings service.Settings) logging.LogLevels {
+	components := make(map[string]logging.LogLevel, len(settings.Components))
+	for component, level := range settings.Components {
+		components[component] = logging.LogLevel(level)
+	}
+	return logging.LogLevels{Level: logging.LogLevel(settings.Level), Components: components}
+}
+
+// errorCatalog maps log level service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("logging")
+	c.RegisterDetail(service.ErrInvalidLevel, problems.Validation("", map[string][]string{"level": {"must be debug, info, warn or error"}}))
+	c.RegisterDetail(service.ErrInvalidComponent, problems.Validation("", map[string][]string{"component": {"must be lower-case words separated by dots"}}))
+	c.Register(service.ErrNoOverride, problems.NotFound("component has no log level override"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
+
+// compile-time assertions to ensure interface compliance
+var _ logging.StrictServerInterface = (*Handler)(nil)
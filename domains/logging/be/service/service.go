@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// Errors returned by Service.
+var (
+	ErrInvalidLevel     = errors.New("log level must be debug, info, warn or error")
+	ErrInvalidComponent = errors.New("log component must be lower-case words separated by dots")
+	ErrNoOverride       = errors.New("component has no log level override")
+)
+
+// componentPattern mirrors the LogComponent schema of the contract.
+var componentPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-z][a-z0-9-]*)*$`)
+
+// Settings are the log levels of this process: the default and the per-component overrides.
+type Settings struct {
+	Level      string
+	Components map[string]string
+}
+
+// Levels holds the log levels of the process; implemented by platformlogging.Levels.
+type Levels interface {
+	Default() zapcore.Level
+	SetDefault(level zapcore.Level)
+	Overrides() map[string]zapcore.Level
+	SetOverride(component string, level zapcore.Level)
+	ClearOverride(component string) bool
+}
+
+// Service reads and changes the log levels of the API process.
+type Service interface {
+	Get(ctx context.Context, audit requesttrace.AuditInfo) (Settings, error)
+	SetLevel(ctx context.Context, audit requesttrace.AuditInfo, level string) (Settings, error)
+	SetComponentLevel(ctx context.Context, audit requesttrace.AuditInfo, component, level string) (Settings, error)
+	DeleteComponentLevel(ctx context.Context, audit requesttrace.AuditInfo, component string) (Settings, error)
+}
+
+type service struct {
+	levels Levels
+	logger *zap.Logger
+}
+
+// New constructs a Service instance that logs every change to logger.
+func New(levels Levels, logger *zap.Logger) Service {
+	if levels == nil {
+		panic("log levels are required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+	return &service{levels: levels, logger: logger}
+}
+
+func (s *service) Get(_ context.Context, _ requesttrace.AuditInfo) (Settings, error) {
+	return s.settings(), nil
+}
+
+func (s *service) SetLevel(ctx context.Context, audit requesttrace.AuditInfo, level string) (Settings, error) {
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return Settings{}, err
+	}
+	s.levels.SetDefault(parsed)
+	s.logChange(ctx, audit, "", parsed.String())
+	return s.settings(), nil
+}
+
+func (s *service) SetComponentLevel(ctx context.Context, audit requesttrace.AuditInfo, component, level string) (Settings, error) {
+	if !componentPattern.MatchString(component) {
+		return Settings{}, ErrInvalidComponent
+	}
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return Settings{}, err
+	}
+	s.levels.SetOverride(component, parsed)
+	s.logChange(ctx, audit, component, parsed.String())
+	return s.settings(), nil
+}
+
+func (s *service) DeleteComponentLevel(ctx context.Context, audit requesttrace.AuditInfo, component string) (Settings, error) {
+	if !s.levels.ClearOverride(component) {
+		return Settings{}, ErrNoOverride
+	}
+	s.logChange(ctx, audit, component, "default")
+	return s.settings(), nil
+}
+
+func (s *service) settings() Settings {
+	overrides := s.levels.Overrides()
+	components := make(map[string]string, len(overrides))
+	for component, level := range overrides {
+		components[component] = level.String()
+	}
+	return Settings{Level: s.levels.Default().String(), Components: components}
+}
+
+// logChange is written at warn so it is kept even when the change raises the level above info.
+func (s *service) logChange(ctx context.Context, audit requesttrace.AuditInfo, component, level string) {
+	fields := []zap.Field{
+		zap.String("level", level),
+		zap.Stringp("changed_by", audit.UserID),
+	}
+	if component != "" {
+		fields = append(fields, zap.String("log_component", component))
+	}
+	platformlogging.FromContextOr(ctx, s.logger).Warn("log level changed", fields...)
+}
+
+// parseLevel accepts the severities offered by the contract; zap's panic and fatal levels are left out.
+func parseLevel(level string) (zapcore.Level, error) {
+	parsed, err := platformlogging.ParseLevel(level)
+	if err != nil || parsed < zapcore.DebugLevel || parsed > zapcore.ErrorLevel {
+		return zapcore.InfoLevel, ErrInvalidLevel
+	}
+	return parsed, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestServiceChangesLevels(t *testing.T) {
+	t.Parallel()
+
+	levels, err := platformlogging.NewLevels("info")
+	require.NoError(t, err)
+	svc := New(levels, zap.NewNop())
+	ctx := context.Background()
+	audit := requesttrace.AuditInfo{}
+
+	settings, err := svc.Get(ctx, audit)
+	require.NoError(t, err)
+	require.Equal(t, Settings{Level: "info", Components: map[string]string{}}, settings)
+
+	settings, err = svc.SetComponentLevel(ctx, audit, "scheduler", "DEBUG")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"scheduler": "debug"}, settings.Components)
+
+	settings, err = svc.SetLevel(ctx, audit, "warn")
+	require.NoError(t, err)
+	require.Equal(t, Settings{Level: "warn", Components: map[string]string{"scheduler": "debug"}}, settings)
+
+	settings, err = svc.DeleteComponentLevel(ctx, audit, "scheduler")
+	require.NoError(t, err)
+	require.Empty(t, settings.Components)
+
+	_, err = svc.DeleteComponentLevel(ctx, audit, "scheduler")
+	require.ErrorIs(t, err, ErrNoOverride)
+}
+
+func TestServiceRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	levels, err := platformlogging.NewLevels("info")
+	require.NoError(t, err)
+	svc := New(levels, zap.NewNop())
+	ctx := context.Background()
+	audit := requesttrace.AuditInfo{}
+
+	_, err = svc.SetLevel(ctx, audit, "fatal")
+	require.ErrorIs(t, err, ErrInvalidLevel)
+	_, err = svc.SetComponentLevel(ctx, audit, "Scheduler", "debug")
+	require.ErrorIs(t, err, ErrInvalidComponent)
+	_, err = svc.SetComponentLevel(ctx, audit, "scheduler", "trace")
+	require.ErrorIs(t, err, ErrInvalidLevel)
+	require.Equal(t, "info", levels.Default().String())
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	privacy "github.com/zenGate-Global/palmyra-pro-saas/generated/go/privacy"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	createOperation operation = "privacyErasureRequestsCreate"
+	getOperation    operation = "privacyErasureRequestsGet"
+)
+
+// Handler wires the privacy service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("privacy service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) PrivacyErasureRequestsCreate(ctx context.Context, request privacy.PrivacyErasureRequestsCreateRequestObject) (privacy.PrivacyErasureRequestsCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return privacy.PrivacyErasureRequestsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	input := service.CreateInput{
+		SubjectID: request.Body.SubjectId,
+		Mode:      persistence.ErasureMode(request.Body.Mode),
+	}
+	if request.Body.UserId != nil {
+		userID := uuid.UUID(*request.Body.UserId)
+		input.UserID = &userID
+	}
+
+	created, err := h.svc.CreateErasureRequest(ctx, h.audit(ctx), input)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, createOperation)
+		return privacy.PrivacyErasureRequestsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return privacy.PrivacyErasureRequestsCreate202JSONResponse{
+		Headers: privacy.PrivacyErasureRequestsCreate202ResponseHeaders{Location: "/api/v1/privacy/erasure-requests/" + created.ID.String()},
+		Body:    toAPIRequest(created),
+	}, nil
+}
+
+func (h *Handler) PrivacyErasureRequestsGet(ctx context.Context, request privacy.PrivacyErasureRequestsGetRequestObject) (privacy.PrivacyErasureRequestsGetResponseObject, error) {
+	found, err := h.svc.GetErasureRequest(ctx, h.audit(ctx), uuid.UUID(request.RequestId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return privacy.PrivacyErasureRequestsGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return privacy.PrivacyErasureRequestsGet200JSONResponse(toAPIRequest(found)), nil
+}
+
+func toAPIRequest(request service.ErasureRequest) privacy.ErasureRequest {
+	out := privacy.ErasureRequest{
+		RequestId: externalRef2.UUID(request.ID),
+		SubjectId: request.SubjectID,
+		Mode:      privacy.ErasureMode(request.Mode),
+		Status:    privacy.ErasureStatus(request.Status),
+		Attempts:  request.Attempts,
+		LastError: request.LastError,
+		Signature: request.Signature,
+		CreatedAt: externalRef2.Timestamp(request.CreatedAt),
+		UpdatedAt: externalRef2.Timestamp(request.UpdatedAt),
+	}
+	if request.UserID != nil {
+		userID := externalRef2.UUID(*request.UserID)
+		out.UserId = &userID
+	}
+	if request.FinishedAt != nil {
+		finishedAt := externalRef2.Timestamp(*request.FinishedAt)
+		out.FinishedAt = &finishedAt
+	}
+	if request.Report != nil {
+		out.Report = toAPIReport(*request.Report)
+	}
+	return out
+}
+
+func toAPIReport(report service.Report) *privacy.ErasureReport {
+	entities := make([]privacy.ErasedEntity, 0, len(report.Entities))
+	for _, entity := range report.Entities {
+		entities = append(entities, privacy.ErasedEntity{
+			TableName: externalRef2.TableName(entity.TableName),
+			EntityId:  externalRef2.EntityIdentifier(entity.EntityID),
+			Versions:  entity.Versions,
+		})
+	}
+	archived := report.ArchivedObjects
+	if archived == nil {
+		archived = []string{}
+	}
+
+	return &privacy.ErasureReport{
+		RequestId:       externalRef2.UUID(report.RequestID),
+		TenantId:        externalRef2.UUID(report.TenantID),
+		SubjectId:       report.SubjectID,
+		Mode:            privacy.ErasureMode(report.Mode),
+		Entities:        entities,
+		UserRemoved:     report.UserRemoved,
+		ArchivedObjects: archived,
+		CompletedAt:     externalRef2.Timestamp(report.CompletedAt),
+	}
+}
+
+// errorCatalog maps privacy service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("privacy")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation("one or more fields are invalid", err.Fields)
+	})
+	c.Register(service.ErrNotFound, problems.NotFound("erasure request not found"))
+	c.Register(service.ErrSigningUnavailable, problems.NotConfigured("Erasure unavailable", "no erasure report signing key is configured"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Repository defines the tenant-scoped persistence operations required by the privacy API and its erasure jobs.
+type Repository interface {
+	CreateErasureRequest(ctx context.Context, params persistence.CreateErasureRequestParams) (persistence.ErasureRequest, error)
+	GetErasureRequest(ctx context.Context, id uuid.UUID) (persistence.ErasureRequest, error)
+	// StartErasureRequest marks an open request running and counts the attempt; a finished request is returned
+	// unchanged. It returns persistence.ErrErasureRequestNotFound when the request does not exist.
+	StartErasureRequest(ctx context.Context, id uuid.UUID) (persistence.ErasureRequest, error)
+	SaveErasureRequest(ctx context.Context, request persistence.ErasureRequest) error
+	EraseSubject(ctx context.Context, subjectID string, mode persistence.ErasureMode) (persistence.SubjectErasureReport, error)
+	// DeleteUser removes the tenant user and the memberships other tenants hold through it. It returns
+	// persistence.ErrUserNotFound when the user does not exist.
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+}
+
+type postgresRepository struct {
+	erasures    *persistence.SubjectErasureStore
+	users       *persistence.UserStore
+	memberships *persistence.MembershipStore
+}
+
+// NewPostgresRepository constructs a repository backed by the shared persistence layer.
+func NewPostgresRepository(erasures *persistence.SubjectErasureStore, users *persistence.UserStore, memberships *persistence.MembershipStore) Repository {
+	if erasures == nil {
+		panic("subject erasure store is required")
+	}
+	if users == nil {
+		panic("user store is required")
+	}
+	if memberships == nil {
+		panic("membership store is required")
+	}
+	return &postgresRepository{erasures: erasures, users: users, memberships: memberships}
+}
+
+func (r *postgresRepository) CreateErasureRequest(ctx context.Context, params persistence.CreateErasureRequestParams) (persistence.ErasureRequest, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.ErasureRequest{}, err
+	}
+	return r.erasures.CreateErasureRequest(ctx, space, params)
+}
+
+func (r *postgresRepository) GetErasureRequest(ctx context.Context, id uuid.UUID) (persistence.ErasureRequest, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.ErasureRequest{}, err
+	}
+	return r.erasures.GetErasureRequest(ctx, space, id)
+}
+
+func (r *postgresRepository) StartErasureRequest(ctx context.Context, id uuid.UUID) (persistence.ErasureRequest, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.ErasureRequest{}, err
+	}
+	return r.erasures.StartErasureRequest(ctx, space, id)
+}
+
+func (r *postgresRepository) SaveErasureRequest(ctx context.Context, request persistence.ErasureRequest) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.erasures.SaveErasureRequest(ctx, space, request)
+}
+
+func (r *postgresRepository) EraseSubject(ctx context.Context, subjectID string, mode persistence.ErasureMode) (persistence.SubjectErasureReport, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.SubjectErasureReport{}, err
+	}
+	return r.erasures.EraseSubject(ctx, space, subjectID, mode)
+}
+
+func (r *postgresRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.users.DeleteUser(ctx, space, id); err != nil {
+		return err
+	}
+	return r.memberships.DeleteUserMemberships(ctx, space.TenantID, id)
+}
+
+func requireTenantSpace(ctx context.Context) (tenant.Space, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return tenant.Space{}, errors.New("tenant space missing from context")
+	}
+	return space, nil
+}
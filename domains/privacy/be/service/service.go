@@ -0,0 +1,394 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/privacy/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// FieldErrors maps request fields to validation issues.
+type FieldErrors map[string][]string
+
+func (f FieldErrors) add(field, message string) {
+	f[field] = append(f[field], message)
+}
+
+// ValidationError is returned when the input payload is invalid.
+type ValidationError struct {
+	Fields FieldErrors
+}
+
+func (v *ValidationError) Error() string {
+	return "validation error"
+}
+
+// ErrNotFound is returned when the erasure request does not exist.
+var ErrNotFound = errors.New("erasure request not found")
+
+// ErrSigningUnavailable is returned when no report signing key is configured, so completed erasures could not be
+// proven.
+var ErrSigningUnavailable = errors.New("erasure report signing unavailable")
+
+// SignaturePrefix names the algorithm of a report signature, which is followed by the hex-encoded MAC.
+const SignaturePrefix = "hmac-sha256="
+
+const maxSubjectIDLength = 256
+
+// Config tunes the privacy service. Zero values fall back to defaults: an erasure is attempted up to 5 times, with
+// the job pool's backoff between attempts.
+type Config struct {
+	// SigningKey signs completed erasure reports; requests are rejected while it is empty.
+	SigningKey  []byte
+	MaxAttempts int
+}
+
+// ErasureJobKind is the kind of the job that runs an erasure request. The job has the id of the request, so
+// GET /jobs/{jobId} reports its attempts as well.
+const ErasureJobKind = "privacy.erasure"
+
+// JobQueue queues the jobs that run erasure requests. *jobs.Queue implements it.
+type JobQueue interface {
+	Enqueue(ctx context.Context, request jobs.EnqueueRequest) (persistence.JobRecord, error)
+}
+
+// erasureJob is the payload of an ErasureJobKind job.
+type erasureJob struct {
+	RequestID uuid.UUID `json:"requestId"`
+}
+
+// erasureResult is the result of a succeeded ErasureJobKind job; the request holds the signed report.
+type erasureResult struct {
+	RequestID   uuid.UUID `json:"requestId"`
+	Entities    int       `json:"entities"`
+	UserRemoved bool      `json:"userRemoved"`
+}
+
+// Report is the signed record of a completed erasure.
+type Report struct {
+	RequestID       uuid.UUID                  `json:"requestId"`
+	TenantID        uuid.UUID                  `json:"tenantId"`
+	SubjectID       string                     `json:"subjectId"`
+	Mode            persistence.ErasureMode    `json:"mode"`
+	Entities        []persistence.ErasedEntity `json:"entities"`
+	UserRemoved     bool                       `json:"userRemoved"`
+	ArchivedObjects []string                   `json:"archivedObjects"`
+	CompletedAt     time.Time                  `json:"completedAt"`
+}
+
+// ErasureRequest represents the domain view of an erasure request. Report and Signature are set once it succeeded.
+type ErasureRequest struct {
+	ID         uuid.UUID
+	SubjectID  string
+	UserID     *uuid.UUID
+	Mode       persistence.ErasureMode
+	Status     string
+	Attempts   int
+	LastError  *string
+	Report     *Report
+	Signature  *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// CreateInput represents the payload required to request an erasure.
+type CreateInput struct {
+	SubjectID string
+	Mode      persistence.ErasureMode
+	UserID    *uuid.UUID
+}
+
+// Service defines the business operations for the privacy domain.
+type Service interface {
+	CreateErasureRequest(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (ErasureRequest, error)
+	GetErasureRequest(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (ErasureRequest, error)
+	// RunErasure is the jobs.Handler of ErasureJobKind: it runs one attempt of the erasure request named by the job
+	// in the tenant space of ctx.
+	RunErasure(ctx context.Context, job jobs.Job) (any, error)
+}
+
+type service struct {
+	repo  repo.Repository
+	queue JobQueue
+	cfg   Config
+	now   func() time.Time
+}
+
+// New constructs a privacy Service with defaults applied to cfg. Erasure requests are run by the ErasureJobKind jobs
+// queued on queue; register RunErasure for the kind on the job pool.
+func New(r repo.Repository, queue JobQueue, cfg Config) Service {
+	if r == nil {
+		panic("privacy repository is required")
+	}
+	if queue == nil {
+		panic("job queue is required")
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+
+	return &service{repo: r, queue: queue, cfg: cfg, now: time.Now}
+}
+
+func (s *service) CreateErasureRequest(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (ErasureRequest, error) {
+	if len(s.cfg.SigningKey) == 0 {
+		return ErasureRequest{}, ErrSigningUnavailable
+	}
+
+	fieldErrors := FieldErrors{}
+	subjectID := strings.TrimSpace(input.SubjectID)
+	switch {
+	case subjectID == "":
+		fieldErrors.add("subjectId", "subjectId is required")
+	case utf8.RuneCountInString(subjectID) > maxSubjectIDLength:
+		fieldErrors.add("subjectId", fmt.Sprintf("subjectId must be at most %d characters", maxSubjectIDLength))
+	}
+	switch input.Mode {
+	case persistence.ErasureAnonymize, persistence.ErasurePurge:
+	default:
+		fieldErrors.add("mode", "mode must be anonymize or purge")
+	}
+	if input.UserID != nil && *input.UserID == uuid.Nil {
+		fieldErrors.add("userId", "userId must be a valid identifier")
+	}
+	if len(fieldErrors) > 0 {
+		return ErasureRequest{}, &ValidationError{Fields: fieldErrors}
+	}
+
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return ErasureRequest{}, errors.New("tenant space missing from context")
+	}
+	var createdBy string
+	if audit.UserID != nil {
+		createdBy = *audit.UserID
+	}
+
+	// The job goes first: a job whose request was never stored fails once its attempts run out, while a stored
+	// request without a job would stay queued forever.
+	requestID := uuid.New()
+	_, err := s.queue.Enqueue(ctx, jobs.EnqueueRequest{
+		ID:          requestID,
+		TenantID:    &space.TenantID,
+		Kind:        ErasureJobKind,
+		Payload:     erasureJob{RequestID: requestID},
+		MaxAttempts: s.cfg.MaxAttempts,
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return ErasureRequest{}, fmt.Errorf("queue erasure job: %w", err)
+	}
+
+	record, err := s.repo.CreateErasureRequest(ctx, persistence.CreateErasureRequestParams{
+		RequestID:   requestID,
+		SubjectID:   subjectID,
+		UserID:      input.UserID,
+		Mode:        input.Mode,
+		RequestedBy: audit.UserID,
+	})
+	if err != nil {
+		return ErasureRequest{}, err
+	}
+	return mapRequest(record)
+}
+
+func (s *service) GetErasureRequest(ctx context.Context, _ requesttrace.AuditInfo, id uuid.UUID) (ErasureRequest, error) {
+	record, err := s.repo.GetErasureRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrErasureRequestNotFound) {
+			return ErasureRequest{}, ErrNotFound
+		}
+		return ErasureRequest{}, err
+	}
+	return mapRequest(record)
+}
+
+func (s *service) RunErasure(ctx context.Context, job jobs.Job) (any, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("tenant space missing from context")
+	}
+	var payload erasureJob
+	if err := job.Decode(&payload); err != nil {
+		return nil, err
+	}
+
+	// A request missing here may not be committed yet, so the attempt is retried like any other failure.
+	request, err := s.repo.StartErasureRequest(ctx, payload.RequestID)
+	if err != nil {
+		return nil, fmt.Errorf("start erasure request %s: %w", payload.RequestID, err)
+	}
+	if request.FinishedAt != nil {
+		// An earlier attempt finished the request but not the job.
+		return erasureOutcome(request)
+	}
+
+	if err := s.erase(ctx, space.TenantID, &request); err != nil {
+		s.recordFailure(&request, err, job.Attempt >= job.MaxAttempts)
+		if saveErr := s.repo.SaveErasureRequest(ctx, request); saveErr != nil {
+			return nil, fmt.Errorf("save erasure request %s: %w", request.RequestID, saveErr)
+		}
+		return nil, err
+	}
+	if err := s.repo.SaveErasureRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("save erasure request %s: %w", request.RequestID, err)
+	}
+	return erasureOutcome(request)
+}
+
+// erase runs the erasure of request and records its signed report on success.
+func (s *service) erase(ctx context.Context, tenantID uuid.UUID, request *persistence.ErasureRequest) error {
+	if len(s.cfg.SigningKey) == 0 {
+		return ErrSigningUnavailable
+	}
+
+	erased, err := s.repo.EraseSubject(ctx, request.SubjectID, request.Mode)
+	if err != nil {
+		return fmt.Errorf("erase subject documents: %w", err)
+	}
+
+	userRemoved := false
+	if request.UserID != nil {
+		switch err := s.repo.DeleteUser(ctx, *request.UserID); {
+		case err == nil:
+			userRemoved = true
+		case !errors.Is(err, persistence.ErrUserNotFound):
+			return fmt.Errorf("remove user: %w", err)
+		}
+	}
+
+	completedAt := s.now().UTC()
+	report := Report{
+		RequestID:       request.RequestID,
+		TenantID:        tenantID,
+		SubjectID:       request.SubjectID,
+		Mode:            request.Mode,
+		Entities:        erased.Entities,
+		UserRemoved:     userRemoved,
+		ArchivedObjects: erased.ArchivedObjects,
+		CompletedAt:     completedAt,
+	}
+	if report.Entities == nil {
+		report.Entities = []persistence.ErasedEntity{}
+	}
+	if report.ArchivedObjects == nil {
+		report.ArchivedObjects = []string{}
+	}
+
+	payload, err := CanonicalReport(report)
+	if err != nil {
+		return err
+	}
+	signature := SignReport(s.cfg.SigningKey, payload)
+
+	request.Status = persistence.ErasureSucceeded
+	request.Report = payload
+	request.Signature = &signature
+	request.LastError = nil
+	request.FinishedAt = &completedAt
+	return nil
+}
+
+// recordFailure records a failed attempt on request; the last attempt fails it, earlier ones leave it queued for
+// the job's retry.
+func (s *service) recordFailure(request *persistence.ErasureRequest, cause error, last bool) {
+	message := cause.Error()
+	request.LastError = &message
+
+	if !last {
+		request.Status = persistence.ErasureQueued
+		return
+	}
+	now := s.now().UTC()
+	request.Status = persistence.ErasureFailed
+	request.FinishedAt = &now
+}
+
+// erasureOutcome is the job outcome of a finished request: its result when it succeeded, a permanent error when it
+// failed.
+func erasureOutcome(request persistence.ErasureRequest) (any, error) {
+	if request.Status != persistence.ErasureSucceeded {
+		cause := "erasure failed"
+		if request.LastError != nil {
+			cause = *request.LastError
+		}
+		return nil, jobs.Permanent(fmt.Errorf("erasure request %s: %s", request.RequestID, cause))
+	}
+	var report Report
+	if err := json.Unmarshal(request.Report, &report); err != nil {
+		return nil, jobs.Permanent(fmt.Errorf("decode erasure report: %w", err))
+	}
+	return erasureResult{RequestID: request.RequestID, Entities: len(report.Entities), UserRemoved: report.UserRemoved}, nil
+}
+
+// CanonicalReport renders report as compact JSON with object keys in lexical order, the form its signature covers.
+func CanonicalReport(report Report) ([]byte, error) {
+	encoded, err := json.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("encode erasure report: %w", err)
+	}
+	return canonicalJSON(encoded)
+}
+
+// SignReport returns the signature of a canonical report payload.
+func SignReport(key, payload []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// canonicalJSON re-encodes a JSON document through generic maps, which encoding/json writes with sorted keys.
+func canonicalJSON(document []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("decode erasure report: %w", err)
+	}
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("encode erasure report: %w", err)
+	}
+	return canonical, nil
+}
+
+func mapRequest(record persistence.ErasureRequest) (ErasureRequest, error) {
+	request := ErasureRequest{
+		ID:         record.RequestID,
+		SubjectID:  record.SubjectID,
+		UserID:     record.UserID,
+		Mode:       record.Mode,
+		Status:     record.Status,
+		Attempts:   record.Attempts,
+		LastError:  record.LastError,
+		Signature:  record.Signature,
+		CreatedAt:  record.CreatedAt,
+		UpdatedAt:  record.UpdatedAt,
+		FinishedAt: record.FinishedAt,
+	}
+	if len(record.Report) > 0 {
+		var report Report
+		if err := json.Unmarshal(record.Report, &report); err != nil {
+			return ErasureRequest{}, fmt.Errorf("decode erasure report: %w", err)
+		}
+		request.Report = &report
+	}
+	return request, nil
+}
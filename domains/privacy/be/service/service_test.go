@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	jobshandler "github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/handler"
+	jobsservice "github.com/zenGate-Global/palmyra-pro-saas/domains/jobs/be/service"
+	primitives "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	jobsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type mockRepository struct {
+	createFn func(ctx context.Context, params persistence.CreateErasureRequestParams) (persistence.ErasureRequest, error)
+	requests map[uuid.UUID]persistence.ErasureRequest
+	eraseFn  func(ctx context.Context, subjectID string, mode persistence.ErasureMode) (persistence.SubjectErasureReport, error)
+	deleteFn func(ctx context.Context, id uuid.UUID) error
+	saved    []persistence.ErasureRequest
+}
+
+func (m *mockRepository) CreateErasureRequest(ctx context.Context, params persistence.CreateErasureRequestParams) (persistence.ErasureRequest, error) {
+	if m.createFn == nil {
+		panic("createFn not configured")
+	}
+	return m.createFn(ctx, params)
+}
+
+func (m *mockRepository) GetErasureRequest(context.Context, uuid.UUID) (persistence.ErasureRequest, error) {
+	return persistence.ErasureRequest{}, persistence.ErrErasureRequestNotFound
+}
+
+func (m *mockRepository) StartErasureRequest(_ context.Context, id uuid.UUID) (persistence.ErasureRequest, error) {
+	request, ok := m.requests[id]
+	if !ok {
+		return persistence.ErasureRequest{}, persistence.ErrErasureRequestNotFound
+	}
+	if request.FinishedAt == nil {
+		request.Status = persistence.ErasureRunning
+		request.Attempts++
+		m.requests[id] = request
+	}
+	return request, nil
+}
+
+func (m *mockRepository) SaveErasureRequest(_ context.Context, request persistence.ErasureRequest) error {
+	m.saved = append(m.saved, request)
+	if m.requests != nil {
+		m.requests[request.RequestID] = request
+	}
+	return nil
+}
+
+func (m *mockRepository) EraseSubject(ctx context.Context, subjectID string, mode persistence.ErasureMode) (persistence.SubjectErasureReport, error) {
+	if m.eraseFn == nil {
+		panic("eraseFn not configured")
+	}
+	return m.eraseFn(ctx, subjectID, mode)
+}
+
+func (m *mockRepository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	if m.deleteFn == nil {
+		panic("deleteFn not configured")
+	}
+	return m.deleteFn(ctx, id)
+}
+
+// memoryJobs is an in-memory job queue: a jobs.Store for the queue and pool, and the jobs API repository.
+type memoryJobs struct {
+	mu   sync.Mutex
+	data map[uuid.UUID]persistence.JobRecord
+}
+
+func newMemoryJobs() *memoryJobs {
+	return &memoryJobs{data: make(map[uuid.UUID]persistence.JobRecord)}
+}
+
+func (m *memoryJobs) Enqueue(_ context.Context, rec persistence.JobRecord) (persistence.JobRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec.Status = persistence.JobQueued
+	m.data[rec.JobID] = rec
+	return rec, nil
+}
+
+func (m *memoryJobs) Get(_ context.Context, jobID uuid.UUID) (persistence.JobRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.data[jobID]
+	if !ok {
+		return persistence.JobRecord{}, persistence.ErrJobNotFound
+	}
+	return rec, nil
+}
+
+func (m *memoryJobs) GetJob(ctx context.Context, jobID uuid.UUID) (persistence.JobRecord, error) {
+	return m.Get(ctx, jobID)
+}
+
+func (m *memoryJobs) ClaimDue(_ context.Context, kinds []string, limit int, _ time.Duration) ([]persistence.JobRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var claimed []persistence.JobRecord
+	for id, rec := range m.data {
+		if len(claimed) >= limit || !slices.Contains(kinds, rec.Kind) || rec.Status != persistence.JobQueued || rec.NextAttemptAt.After(time.Now()) {
+			continue
+		}
+		rec.Status = persistence.JobRunning
+		rec.Attempts++
+		m.data[id] = rec
+		claimed = append(claimed, rec)
+	}
+	return claimed, nil
+}
+
+func (m *memoryJobs) Save(_ context.Context, rec persistence.JobRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[rec.JobID] = rec
+	return nil
+}
+
+type staticSpaces map[uuid.UUID]tenant.Space
+
+func (s staticSpaces) ResolveTenantSpace(_ context.Context, id uuid.UUID) (tenant.Space, error) {
+	space, ok := s[id]
+	if !ok {
+		return tenant.Space{}, errors.New("tenant not found")
+	}
+	return space, nil
+}
+
+func erasureJobFor(request persistence.ErasureRequest, attempt, maxAttempts int) jobs.Job {
+	return jobs.Job{
+		ID:          request.RequestID,
+		Kind:        ErasureJobKind,
+		Payload:     json.RawMessage(`{"requestId":"` + request.RequestID.String() + `"}`),
+		Attempt:     attempt,
+		MaxAttempts: maxAttempts,
+	}
+}
+
+func TestServiceCreateErasureRequestValidation(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{}, jobs.NewQueue(newMemoryJobs()), Config{SigningKey: []byte("secret")})
+
+	_, err := svc.CreateErasureRequest(context.Background(), requesttrace.Anonymous(""), CreateInput{SubjectID: "  ", Mode: "shred"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "subjectId")
+	require.Contains(t, validationErr.Fields, "mode")
+
+	unsigned := New(&mockRepository{}, jobs.NewQueue(newMemoryJobs()), Config{})
+	_, err = unsigned.CreateErasureRequest(context.Background(), requesttrace.Anonymous(""), CreateInput{SubjectID: "ann", Mode: persistence.ErasurePurge})
+	require.ErrorIs(t, err, ErrSigningUnavailable)
+}
+
+func TestServiceCreateErasureRequestQueuesJob(t *testing.T) {
+	t.Parallel()
+
+	userID, tenantID := uuid.New(), uuid.New()
+	repo := &mockRepository{
+		createFn: func(_ context.Context, params persistence.CreateErasureRequestParams) (persistence.ErasureRequest, error) {
+			require.Equal(t, "ann", params.SubjectID)
+			require.Equal(t, persistence.ErasureAnonymize, params.Mode)
+			require.Equal(t, &userID, params.UserID)
+			require.NotEqual(t, uuid.Nil, params.RequestID)
+			return persistence.ErasureRequest{RequestID: params.RequestID, SubjectID: params.SubjectID, Mode: params.Mode, Status: persistence.ErasureQueued}, nil
+		},
+	}
+	queue := newMemoryJobs()
+	svc := New(repo, jobs.NewQueue(queue), Config{SigningKey: []byte("secret"), MaxAttempts: 3})
+
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{TenantID: tenantID, Slug: "acme"})
+	created, err := svc.CreateErasureRequest(ctx, requesttrace.Anonymous(""), CreateInput{SubjectID: " ann ", Mode: persistence.ErasureAnonymize, UserID: &userID})
+	require.NoError(t, err)
+	require.Equal(t, persistence.ErasureQueued, created.Status)
+	require.Nil(t, created.Report)
+
+	job, err := queue.Get(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, ErasureJobKind, job.Kind)
+	require.Equal(t, &tenantID, job.TenantID)
+	require.Equal(t, 3, job.MaxAttempts)
+	require.JSONEq(t, `{"requestId":"`+created.ID.String()+`"}`, string(job.Payload))
+}
+
+func TestServiceRunErasureSignsReport(t *testing.T) {
+	t.Parallel()
+
+	requestID, userID, tenantID := uuid.New(), uuid.New(), uuid.New()
+	request := persistence.ErasureRequest{RequestID: requestID, SubjectID: "ann", UserID: &userID, Mode: persistence.ErasurePurge, Status: persistence.ErasureQueued}
+	repo := &mockRepository{
+		requests: map[uuid.UUID]persistence.ErasureRequest{requestID: request},
+		eraseFn: func(_ context.Context, subjectID string, mode persistence.ErasureMode) (persistence.SubjectErasureReport, error) {
+			require.Equal(t, "ann", subjectID)
+			require.Equal(t, persistence.ErasurePurge, mode)
+			return persistence.SubjectErasureReport{Entities: []persistence.ErasedEntity{{TableName: "orders_entities", EntityID: "o-1", Versions: 2}}}, nil
+		},
+		deleteFn: func(_ context.Context, id uuid.UUID) error {
+			require.Equal(t, userID, id)
+			return nil
+		},
+	}
+	key := []byte("secret")
+	svc := New(repo, jobs.NewQueue(newMemoryJobs()), Config{SigningKey: key})
+
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{TenantID: tenantID, Slug: "acme"})
+	result, err := svc.RunErasure(ctx, erasureJobFor(request, 1, 5))
+	require.NoError(t, err)
+	require.Equal(t, erasureResult{RequestID: requestID, Entities: 1, UserRemoved: true}, result)
+
+	require.Len(t, repo.saved, 1)
+	saved := repo.saved[0]
+	require.Equal(t, persistence.ErasureSucceeded, saved.Status)
+	require.Equal(t, 1, saved.Attempts)
+	require.NotNil(t, saved.FinishedAt)
+	require.NotNil(t, saved.Signature)
+	require.Equal(t, SignReport(key, saved.Report), *saved.Signature)
+
+	var report Report
+	require.NoError(t, json.Unmarshal(saved.Report, &report))
+	require.Equal(t, tenantID, report.TenantID)
+	require.True(t, report.UserRemoved)
+	require.Equal(t, []string{}, report.ArchivedObjects)
+
+	canonical, err := CanonicalReport(report)
+	require.NoError(t, err)
+	require.Equal(t, string(saved.Report), string(canonical), "the stored report is already canonical")
+	require.Regexp(t, `^\{"archivedObjects":\[\],"completedAt":`, string(saved.Report))
+
+	// A repeated attempt does not erase again.
+	repo.eraseFn = nil
+	again, err := svc.RunErasure(ctx, erasureJobFor(request, 2, 5))
+	require.NoError(t, err)
+	require.Equal(t, result, again)
+	require.Len(t, repo.saved, 1)
+}
+
+func TestServiceRunErasureRetriesThenFails(t *testing.T) {
+	t.Parallel()
+
+	request := persistence.ErasureRequest{RequestID: uuid.New(), SubjectID: "ann", Mode: persistence.ErasureAnonymize, Status: persistence.ErasureQueued}
+	repo := &mockRepository{
+		requests: map[uuid.UUID]persistence.ErasureRequest{request.RequestID: request},
+		eraseFn: func(context.Context, string, persistence.ErasureMode) (persistence.SubjectErasureReport, error) {
+			return persistence.SubjectErasureReport{}, errors.New("database unavailable")
+		},
+	}
+	svc := New(repo, jobs.NewQueue(newMemoryJobs()), Config{SigningKey: []byte("secret")})
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{TenantID: uuid.New(), Slug: "acme"})
+
+	_, err := svc.RunErasure(ctx, erasureJobFor(request, 1, 2))
+	require.ErrorContains(t, err, "database unavailable")
+	require.False(t, jobs.IsPermanent(err))
+	require.Equal(t, persistence.ErasureQueued, repo.saved[0].Status)
+	require.Contains(t, *repo.saved[0].LastError, "database unavailable")
+	require.Nil(t, repo.saved[0].FinishedAt)
+
+	_, err = svc.RunErasure(ctx, erasureJobFor(request, 2, 2))
+	require.Error(t, err)
+	require.Equal(t, persistence.ErasureFailed, repo.saved[1].Status)
+	require.Equal(t, 2, repo.saved[1].Attempts)
+	require.NotNil(t, repo.saved[1].FinishedAt)
+	require.Nil(t, repo.saved[1].Signature)
+
+	// The job of a failed request fails at once.
+	_, err = svc.RunErasure(ctx, erasureJobFor(request, 3, 3))
+	require.True(t, jobs.IsPermanent(err))
+
+	// A request that is not stored yet is retried.
+	_, err = svc.RunErasure(ctx, erasureJobFor(persistence.ErasureRequest{RequestID: uuid.New()}, 1, 5))
+	require.ErrorIs(t, err, persistence.ErrErasureRequestNotFound)
+	require.False(t, jobs.IsPermanent(err))
+}
+
+// TestErasureJobReportsThroughJobsAPI follows an erasure from the request through the job pool to GET /jobs/{jobId}.
+func TestErasureJobReportsThroughJobsAPI(t *testing.T) {
+	t.Parallel()
+
+	tenantID := uuid.New()
+	space := tenant.Space{TenantID: tenantID, Slug: "acme"}
+	repo := &mockRepository{
+		requests: map[uuid.UUID]persistence.ErasureRequest{},
+		eraseFn: func(context.Context, string, persistence.ErasureMode) (persistence.SubjectErasureReport, error) {
+			return persistence.SubjectErasureReport{Entities: []persistence.ErasedEntity{{TableName: "orders_entities", EntityID: "o-1", Versions: 1}}}, nil
+		},
+	}
+	repo.createFn = func(_ context.Context, params persistence.CreateErasureRequestParams) (persistence.ErasureRequest, error) {
+		request := persistence.ErasureRequest{RequestID: params.RequestID, SubjectID: params.SubjectID, Mode: params.Mode, Status: persistence.ErasureQueued}
+		repo.requests[params.RequestID] = request
+		return request, nil
+	}
+
+	queue := newMemoryJobs()
+	svc := New(repo, jobs.NewQueue(queue), Config{SigningKey: []byte("secret")})
+	pool := jobs.NewPool(queue, staticSpaces{tenantID: space}, zap.NewNop(), jobs.PoolConfig{})
+	pool.Register(ErasureJobKind, svc.RunErasure)
+
+	ctx := tenant.WithSpace(context.Background(), space)
+	created, err := svc.CreateErasureRequest(ctx, requesttrace.Anonymous(""), CreateInput{SubjectID: "ann", Mode: persistence.ErasurePurge})
+	require.NoError(t, err)
+
+	jobsAPI := jobshandler.New(jobsservice.New(queue), zap.NewNop())
+	response, err := jobsAPI.JobsGet(ctx, jobsapi.JobsGetRequestObject{JobId: primitives.UUID(created.ID)})
+	require.NoError(t, err)
+	require.IsType(t, jobsapi.JobsGet200JSONResponse{}, response)
+	require.Equal(t, jobsapi.Queued, response.(jobsapi.JobsGet200JSONResponse).Status)
+
+	require.NoError(t, pool.RunOnce(ctx))
+
+	response, err = jobsAPI.JobsGet(ctx, jobsapi.JobsGetRequestObject{JobId: primitives.UUID(created.ID)})
+	require.NoError(t, err)
+	job := response.(jobsapi.JobsGet200JSONResponse)
+	require.Equal(t, jobsapi.Succeeded, job.Status)
+	require.Equal(t, ErasureJobKind, job.Kind)
+	require.Equal(t, 1, job.Attempts)
+	require.Equal(t, map[string]interface{}{"requestId": created.ID.String(), "entities": float64(1), "userRemoved": false}, *job.Result)
+	require.Equal(t, persistence.ErasureSucceeded, repo.requests[created.ID].Status)
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/scheduler/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	scheduler "github.com/zenGate-Global/palmyra-pro-saas/generated/go/scheduler"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	listOperation operation = "scheduledTasksList"
+	runOperation  operation = "scheduledTasksRun"
+)
+
+// Handler wires the scheduler service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("scheduler service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) ScheduledTasksList(ctx context.Context, _ scheduler.ScheduledTasksListRequestObject) (scheduler.ScheduledTasksListResponseObject, error) {
+	tasks, err := h.svc.List(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, listOperation)
+		return scheduler.ScheduledTasksListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]scheduler.ScheduledTask, 0, len(tasks))
+	for _, task := range tasks {
+		items = append(items, toAPITask(task))
+	}
+	return scheduler.ScheduledTasksList200JSONResponse(scheduler.ScheduledTaskList{Items: items}), nil
+}
+
+func (h *Handler) ScheduledTasksRun(ctx context.Context, request scheduler.ScheduledTasksRunRequestObject) (scheduler.ScheduledTasksRunResponseObject, error) {
+	task, err := h.svc.Run(ctx, h.audit(ctx), request.TaskName)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, runOperation)
+		return scheduler.ScheduledTasksRundefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return scheduler.ScheduledTasksRun202JSONResponse(toAPITask(task)), nil
+}
+
+func toAPITask(task service.Task) scheduler.ScheduledTask {
+	out := scheduler.ScheduledTask{
+		Name:           task.Name,
+		Schedule:       task.Schedule,
+		NextRunAt:      externalRef2.Timestamp(task.NextRunAt),
+		RunRequestedBy: task.RunRequestedBy,
+		LastError:      task.LastError,
+	}
+	if task.RunRequestedAt != nil {
+		requestedAt := externalRef2.Timestamp(*task.RunRequestedAt)
+		out.RunRequestedAt = &requestedAt
+	}
+	if task.LastStatus != nil {
+		status := scheduler.ScheduledTaskStatus(*task.LastStatus)
+		out.LastStatus = &status
+	}
+	if task.LastStartedAt != nil {
+		startedAt := externalRef2.Timestamp(*task.LastStartedAt)
+		out.LastStartedAt = &startedAt
+	}
+	if task.LastFinishedAt != nil {
+		finishedAt := externalRef2.Timestamp(*task.LastFinishedAt)
+		out.LastFinishedAt = &finishedAt
+	}
+	if task.LastDuration != nil {
+		durationMs := task.LastDuration.Milliseconds()
+		out.LastDurationMs = &durationMs
+	}
+	return out
+}
+
+// errorCatalog maps scheduler service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("scheduler")
+	c.Register(service.ErrNotFound, problems.NotFound("scheduled task not found"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
+
+// compile-time assertions to ensure interface compliance
+var _ scheduler.StrictServerInterface = (*Handler)(nil)
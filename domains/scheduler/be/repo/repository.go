@@ -0,0 +1,34 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Repository defines the persistence operations required by the scheduler API.
+type Repository interface {
+	ListTasks(ctx context.Context) ([]persistence.ScheduledTaskRecord, error)
+	// RequestRun returns persistence.ErrScheduledTaskNotFound when the task was never registered.
+	RequestRun(ctx context.Context, name string, requestedBy *string) (persistence.ScheduledTaskRecord, error)
+}
+
+type postgresRepository struct {
+	store *persistence.ScheduledTaskStore
+}
+
+// NewPostgresRepository constructs a repository backed by the scheduled task bookkeeping.
+func NewPostgresRepository(store *persistence.ScheduledTaskStore) Repository {
+	if store == nil {
+		panic("scheduled task store is required")
+	}
+	return &postgresRepository{store: store}
+}
+
+func (r *postgresRepository) ListTasks(ctx context.Context) ([]persistence.ScheduledTaskRecord, error) {
+	return r.store.List(ctx)
+}
+
+func (r *postgresRepository) RequestRun(ctx context.Context, name string, requestedBy *string) (persistence.ScheduledTaskRecord, error) {
+	return r.store.RequestRun(ctx, name, requestedBy)
+}
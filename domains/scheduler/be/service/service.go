@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/scheduler/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// ErrNotFound is returned when no scheduled task has the name.
+var ErrNotFound = errors.New("scheduled task not found")
+
+// Task represents the domain view of a scheduled task and its last run.
+type Task struct {
+	Name           string
+	Schedule       string
+	NextRunAt      time.Time
+	RunRequestedAt *time.Time
+	RunRequestedBy *string
+	LastStatus     *string
+	LastError      *string
+	LastStartedAt  *time.Time
+	LastFinishedAt *time.Time
+	LastDuration   *time.Duration
+}
+
+// Service lists the scheduled tasks and requests manual runs.
+type Service interface {
+	List(ctx context.Context, audit requesttrace.AuditInfo) ([]Task, error)
+	Run(ctx context.Context, audit requesttrace.AuditInfo, name string) (Task, error)
+}
+
+type service struct {
+	repo repo.Repository
+}
+
+// New constructs a Service instance.
+func New(r repo.Repository) Service {
+	if r == nil {
+		panic("scheduler repository is required")
+	}
+	return &service{repo: r}
+}
+
+func (s *service) List(ctx context.Context, _ requesttrace.AuditInfo) ([]Task, error) {
+	records, err := s.repo.ListTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]Task, 0, len(records))
+	for _, record := range records {
+		tasks = append(tasks, mapTask(record))
+	}
+	return tasks, nil
+}
+
+func (s *service) Run(ctx context.Context, audit requesttrace.AuditInfo, name string) (Task, error) {
+	record, err := s.repo.RequestRun(ctx, name, audit.UserID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrScheduledTaskNotFound) {
+			return Task{}, ErrNotFound
+		}
+		return Task{}, err
+	}
+	return mapTask(record), nil
+}
+
+func mapTask(record persistence.ScheduledTaskRecord) Task {
+	return Task{
+		Name:           record.Name,
+		Schedule:       record.Schedule,
+		NextRunAt:      record.NextRunAt,
+		RunRequestedAt: record.TriggerRequestedAt,
+		RunRequestedBy: record.TriggerRequestedBy,
+		LastStatus:     record.LastStatus,
+		LastError:      record.LastError,
+		LastStartedAt:  record.LastStartedAt,
+		LastFinishedAt: record.LastFinishedAt,
+		LastDuration:   record.LastDuration,
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type mockRepository struct {
+	tasks map[string]persistence.ScheduledTaskRecord
+}
+
+func (m *mockRepository) ListTasks(context.Context) ([]persistence.ScheduledTaskRecord, error) {
+	out := make([]persistence.ScheduledTaskRecord, 0, len(m.tasks))
+	for _, task := range m.tasks {
+		out = append(out, task)
+	}
+	return out, nil
+}
+
+func (m *mockRepository) RequestRun(_ context.Context, name string, requestedBy *string) (persistence.ScheduledTaskRecord, error) {
+	task, ok := m.tasks[name]
+	if !ok {
+		return persistence.ScheduledTaskRecord{}, persistence.ErrScheduledTaskNotFound
+	}
+	now := time.Now().UTC()
+	task.TriggerRequestedAt, task.TriggerRequestedBy = &now, requestedBy
+	m.tasks[name] = task
+	return task, nil
+}
+
+func TestRunRequestsRegisteredTasks(t *testing.T) {
+	t.Parallel()
+
+	duration := 1500 * time.Millisecond
+	status := persistence.ScheduledTaskSucceeded
+	svc := New(&mockRepository{tasks: map[string]persistence.ScheduledTaskRecord{
+		"usage-metering": {Name: "usage-metering", Schedule: "0 * * * *", LastStatus: &status, LastDuration: &duration},
+	}})
+	admin := "admin-1"
+	audit := requesttrace.AuditInfo{UserID: &admin, RequestID: "test"}
+
+	task, err := svc.Run(context.Background(), audit, "usage-metering")
+	require.NoError(t, err)
+	require.NotNil(t, task.RunRequestedAt)
+	require.Equal(t, admin, *task.RunRequestedBy)
+	require.Equal(t, duration, *task.LastDuration)
+
+	_, err = svc.Run(context.Background(), audit, "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	tasks, err := svc.List(context.Background(), audit)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "0 * * * *", tasks[0].Schedule)
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 
@@ -13,26 +12,24 @@ import (
 	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
 	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
 	schemacategories "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-categories"
-	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
 )
 
 const (
-	problemTypeValidation    = "https://palmyra.pro/problems/validation-error"
-	problemTypeNotFound      = "https://palmyra.pro/problems/not-found"
-	problemTypeConflict      = "https://palmyra.pro/problems/conflict"
-	problemTypeInternal      = "https://palmyra.pro/problems/internal-error"
 	schemaCategoriesBasePath = "/api/v1/schema-categories"
 )
 
 type operation string
 
 const (
-	listOperation   operation = "listSchemaCategories"
-	createOperation operation = "createSchemaCategory"
-	getOperation    operation = "getSchemaCategory"
-	updateOperation operation = "updateSchemaCategory"
-	deleteOperation operation = "deleteSchemaCategory"
+	listOperation    operation = "listSchemaCategories"
+	treeOperation    operation = "getSchemaCategoryTree"
+	schemasOperation operation = "listSchemaCategorySchemas"
+	createOperation  operation = "createSchemaCategory"
+	getOperation     operation = "getSchemaCategory"
+	updateOperation  operation = "updateSchemaCategory"
+	deleteOperation  operation = "deleteSchemaCategory"
 )
 
 // Handler wires the schema categories service to the generated HTTP contract.
@@ -81,10 +78,55 @@ func (h *Handler) ListSchemaCategories(ctx context.Context, request schemacatego
 	return schemacategories.ListSchemaCategories200JSONResponse(schemacategories.SchemaCategoryList{Items: items}), nil
 }
 
+func (h *Handler) GetSchemaCategoryTree(ctx context.Context, request schemacategories.GetSchemaCategoryTreeRequestObject) (schemacategories.GetSchemaCategoryTreeResponseObject, error) {
+	audit := h.audit(ctx)
+
+	nodes, err := h.svc.Tree(ctx, audit)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, treeOperation)
+		return schemacategories.GetSchemaCategoryTreedefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemacategories.GetSchemaCategoryTree200JSONResponse(schemacategories.SchemaCategoryTree{Items: toAPICategoryNodes(nodes)}), nil
+}
+
+func (h *Handler) ListSchemaCategorySchemas(ctx context.Context, request schemacategories.ListSchemaCategorySchemasRequestObject) (schemacategories.ListSchemaCategorySchemasResponseObject, error) {
+	audit := h.audit(ctx)
+	includeDescendants := request.Params.IncludeDescendants != nil && *request.Params.IncludeDescendants
+
+	schemas, err := h.svc.ListSchemas(ctx, audit, uuidFromExternal(request.CategoryId), includeDescendants)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, schemasOperation)
+		return schemacategories.ListSchemaCategorySchemasdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	items := make([]schemacategories.CategorySchemaSummary, 0, len(schemas))
+	for _, schema := range schemas {
+		items = append(items, schemacategories.CategorySchemaSummary{
+			SchemaId:      externalRef2.UUID(schema.SchemaID),
+			SchemaVersion: externalRef2.SemanticVersion(schema.Version.String()),
+			Slug:          externalRef2.Slug(schema.Slug),
+			TableName:     externalRef2.TableName(schema.TableName),
+			CategoryId:    externalRef2.UUID(schema.CategoryID),
+			IsActive:      schema.IsActive,
+			Status:        schemacategories.CategorySchemaSummaryStatus(schema.Status),
+			CreatedAt:     externalRef2.Timestamp(schema.CreatedAt),
+		})
+	}
+
+	return schemacategories.ListSchemaCategorySchemas200JSONResponse(schemacategories.CategorySchemaList{Items: items}), nil
+}
+
 func (h *Handler) CreateSchemaCategory(ctx context.Context, request schemacategories.CreateSchemaCategoryRequestObject) (schemacategories.CreateSchemaCategoryResponseObject, error) {
 	audit := h.audit(ctx)
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return schemacategories.CreateSchemaCategorydefaultApplicationProblemPlusJSONResponse{
 			Body:       problem,
 			StatusCode: http.StatusBadRequest,
@@ -121,7 +163,13 @@ func (h *Handler) CreateSchemaCategory(ctx context.Context, request schemacatego
 func (h *Handler) DeleteSchemaCategory(ctx context.Context, request schemacategories.DeleteSchemaCategoryRequestObject) (schemacategories.DeleteSchemaCategoryResponseObject, error) {
 	id := uuidFromExternal(request.CategoryId)
 	audit := h.audit(ctx)
-	if err := h.svc.Delete(ctx, audit, id); err != nil {
+	mode := service.DeleteRestrict
+	if request.Params.Mode != nil {
+		mode = service.DeleteMode(*request.Params.Mode)
+	}
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	deletion, err := h.svc.Delete(ctx, audit, id, mode, dryRun)
+	if err != nil {
 		status, problem := h.problemForError(ctx, err, deleteOperation)
 		return schemacategories.DeleteSchemaCategorydefaultApplicationProblemPlusJSONResponse{
 			Body:       problem,
@@ -129,9 +177,28 @@ func (h *Handler) DeleteSchemaCategory(ctx context.Context, request schemacatego
 		}, nil
 	}
 
+	if dryRun {
+		return schemacategories.DeleteSchemaCategory200JSONResponse(toAPIDeletion(deletion)), nil
+	}
 	return schemacategories.DeleteSchemaCategory204Response{}, nil
 }
 
+func toAPIDeletion(deletion service.Deletion) schemacategories.SchemaCategoryDeletion {
+	categories := make([]externalRef2.UUID, 0, len(deletion.Categories))
+	for _, id := range deletion.Categories {
+		categories = append(categories, externalRef2.UUID(id))
+	}
+	return schemacategories.SchemaCategoryDeletion{
+		CategoryId:               externalRef2.UUID(deletion.CategoryID),
+		Mode:                     string(deletion.Mode),
+		DeletedCategories:        categories,
+		ReparentedCategories:     deletion.ReparentedCategories,
+		ReparentedSchemaVersions: deletion.ReparentedSchemaVersions,
+		DeletedSchemaVersions:    deletion.DeletedSchemaVersions,
+		DryRun:                   deletion.DryRun,
+	}
+}
+
 func (h *Handler) GetSchemaCategory(ctx context.Context, request schemacategories.GetSchemaCategoryRequestObject) (schemacategories.GetSchemaCategoryResponseObject, error) {
 	audit := h.audit(ctx)
 	category, err := h.svc.Get(ctx, audit, uuidFromExternal(request.CategoryId))
@@ -149,7 +216,7 @@ func (h *Handler) GetSchemaCategory(ctx context.Context, request schemacategorie
 func (h *Handler) UpdateSchemaCategory(ctx context.Context, request schemacategories.UpdateSchemaCategoryRequestObject) (schemacategories.UpdateSchemaCategoryResponseObject, error) {
 	audit := requesttrace.FromContextOrAnonymous(ctx)
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return schemacategories.UpdateSchemaCategorydefaultApplicationProblemPlusJSONResponse{
 			Body:       problem,
 			StatusCode: http.StatusBadRequest,
@@ -210,88 +277,45 @@ func toAPICategory(category service.Category) schemacategories.SchemaCategory {
 	return apiCategory
 }
 
-func uuidFromExternal(id externalRef2.UUID) uuid.UUID {
-	return uuid.UUID(id)
-}
-
-func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
-	status, title, detail, problemType, fieldErrors := h.classifyError(err)
-
-	logger := h.loggerFrom(ctx)
-	fields := []zap.Field{
-		zap.String("operation", string(op)),
-		zap.Int("status", status),
-	}
-
-	switch {
-	case status >= http.StatusInternalServerError:
-		logger.Error("schema categories operation failed", append(fields, zap.Error(err))...)
-	case status == http.StatusNotFound:
-		logger.Info("schema categories resource not found", append(fields, zap.Error(err))...)
-	default:
-		logger.Warn("schema categories request rejected", append(fields, zap.Error(err))...)
+func toAPICategoryNodes(nodes []service.CategoryNode) []schemacategories.SchemaCategoryNode {
+	items := make([]schemacategories.SchemaCategoryNode, 0, len(nodes))
+	for _, node := range nodes {
+		item := schemacategories.SchemaCategoryNode{
+			CategoryId:       externalRef2.UUID(node.ID),
+			Name:             node.Name,
+			Slug:             externalRef2.Slug(node.Slug),
+			Description:      node.Description,
+			SchemaCount:      node.SchemaCount,
+			TotalSchemaCount: node.TotalSchemaCount,
+			Children:         toAPICategoryNodes(node.Children),
+		}
+		if node.ParentID != nil {
+			parent := externalRef2.UUID(*node.ParentID)
+			item.ParentCategoryId = &parent
+		}
+		items = append(items, item)
 	}
-
-	return status, h.buildProblem(title, detail, problemType, status, fieldErrors)
+	return items
 }
 
-func (h *Handler) classifyError(err error) (status int, title, detail, problemType string, fieldErrors service.FieldErrors) {
-	var validationErr *service.ValidationError
-	switch {
-	case errors.As(err, &validationErr):
-		return http.StatusBadRequest,
-			"Validation failed",
-			"one or more fields are invalid",
-			problemTypeValidation,
-			validationErr.Fields
-	case errors.Is(err, service.ErrNotFound):
-		return http.StatusNotFound,
-			"Resource not found",
-			"schema category not found",
-			problemTypeNotFound,
-			nil
-	case errors.Is(err, service.ErrConflict):
-		return http.StatusConflict,
-			"Conflict",
-			"schema category already exists",
-			problemTypeConflict,
-			nil
-	default:
-		return http.StatusInternalServerError,
-			"Internal server error",
-			"an unexpected error occurred",
-			problemTypeInternal,
-			nil
-	}
+func uuidFromExternal(id externalRef2.UUID) uuid.UUID {
+	return uuid.UUID(id)
 }
 
-func (h *Handler) buildProblem(title, detail, problemType string, status int, fieldErrors service.FieldErrors) externalRef3.ProblemDetails {
-	problem := externalRef3.ProblemDetails{
-		Title:  title,
-		Status: status,
-	}
+// errorCatalog maps schema-categories service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("schema-categories")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation("one or more fields are invalid", err.Fields)
+	})
+	problems.RegisterAs(c, func(err *service.InUseError) problems.Problem {
+		return problems.Conflict("schema category still has child categories or schemas").WithFields(err.Fields())
+	})
+	c.Register(service.ErrNotFound, problems.NotFound("schema category not found")).
+		Register(service.ErrConflict, problems.Conflict("schema category already exists"))
+	return c
+}()
 
-	if detail != "" {
-		problem.Detail = &detail
-	}
-	if problemType != "" {
-		problem.Type = &problemType
-	}
-
-	if len(fieldErrors) > 0 {
-		copied := make(map[string][]string, len(fieldErrors))
-		for field, messages := range fieldErrors {
-			copied[field] = append([]string(nil), messages...)
-		}
-		problem.Errors = &copied
-	}
-
-	return problem
-}
-
-func (h *Handler) loggerFrom(ctx context.Context) *zap.Logger {
-	if logger, ok := platformlogging.FromContext(ctx); ok {
-		return logger
-	}
-	return h.logger
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
 }
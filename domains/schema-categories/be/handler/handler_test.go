@@ -17,11 +17,13 @@ import (
 )
 
 type mockService struct {
-	listFn   func(ctx context.Context, audit requesttrace.AuditInfo, includeDeleted bool) ([]service.Category, error)
-	createFn func(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.Category, error)
-	getFn    func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Category, error)
-	updateFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input service.UpdateInput) (service.Category, error)
-	deleteFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+	listFn    func(ctx context.Context, audit requesttrace.AuditInfo, includeDeleted bool) ([]service.Category, error)
+	treeFn    func(ctx context.Context, audit requesttrace.AuditInfo) ([]service.CategoryNode, error)
+	schemasFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, includeDescendants bool) ([]service.SchemaSummary, error)
+	createFn  func(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.Category, error)
+	getFn     func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Category, error)
+	updateFn  func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input service.UpdateInput) (service.Category, error)
+	deleteFn  func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, mode service.DeleteMode, dryRun bool) (service.Deletion, error)
 }
 
 func (m *mockService) List(ctx context.Context, audit requesttrace.AuditInfo, includeDeleted bool) ([]service.Category, error) {
@@ -31,6 +33,20 @@ func (m *mockService) List(ctx context.Context, audit requesttrace.AuditInfo, in
 	return m.listFn(ctx, audit, includeDeleted)
 }
 
+func (m *mockService) Tree(ctx context.Context, audit requesttrace.AuditInfo) ([]service.CategoryNode, error) {
+	if m.treeFn == nil {
+		panic("treeFn not configured")
+	}
+	return m.treeFn(ctx, audit)
+}
+
+func (m *mockService) ListSchemas(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, includeDescendants bool) ([]service.SchemaSummary, error) {
+	if m.schemasFn == nil {
+		panic("schemasFn not configured")
+	}
+	return m.schemasFn(ctx, audit, id, includeDescendants)
+}
+
 func (m *mockService) Create(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.Category, error) {
 	if m.createFn == nil {
 		panic("createFn not configured")
@@ -52,11 +68,11 @@ func (m *mockService) Update(ctx context.Context, audit requesttrace.AuditInfo,
 	return m.updateFn(ctx, audit, id, input)
 }
 
-func (m *mockService) Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error {
+func (m *mockService) Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, mode service.DeleteMode, dryRun bool) (service.Deletion, error) {
 	if m.deleteFn == nil {
 		panic("deleteFn not configured")
 	}
-	return m.deleteFn(ctx, audit, id)
+	return m.deleteFn(ctx, audit, id, mode, dryRun)
 }
 
 func TestHandlerListSchemaCategories(t *testing.T) {
@@ -92,6 +108,75 @@ func TestHandlerListSchemaCategories(t *testing.T) {
 	require.Equal(t, "Cards", success.Items[0].Name)
 }
 
+func TestHandlerGetSchemaCategoryTree(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockService{}
+	handler := New(svc, zaptest.NewLogger(t))
+
+	rootID := uuid.New()
+	svc.treeFn = func(ctx context.Context, audit requesttrace.AuditInfo) ([]service.CategoryNode, error) {
+		return []service.CategoryNode{
+			{
+				Category:         service.Category{ID: rootID, Name: "Root", Slug: "root"},
+				TotalSchemaCount: 2,
+				Children: []service.CategoryNode{
+					{
+						Category:         service.Category{ID: uuid.New(), ParentID: &rootID, Name: "Cards", Slug: "cards"},
+						SchemaCount:      2,
+						TotalSchemaCount: 2,
+					},
+				},
+			},
+		}, nil
+	}
+
+	response, err := handler.GetSchemaCategoryTree(context.Background(), schemacategories.GetSchemaCategoryTreeRequestObject{})
+	require.NoError(t, err)
+
+	success, ok := response.(schemacategories.GetSchemaCategoryTree200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, success.Items, 1)
+	require.Equal(t, int64(2), success.Items[0].TotalSchemaCount)
+	require.Len(t, success.Items[0].Children, 1)
+	require.Equal(t, externalRef2.UUID(rootID), *success.Items[0].Children[0].ParentCategoryId)
+	require.NotNil(t, success.Items[0].Children[0].Children)
+}
+
+func TestHandlerListSchemaCategorySchemas(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockService{}
+	handler := New(svc, zaptest.NewLogger(t))
+
+	categoryID := uuid.New()
+	svc.schemasFn = func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, includeDescendants bool) ([]service.SchemaSummary, error) {
+		require.Equal(t, categoryID, id)
+		require.True(t, includeDescendants)
+		return []service.SchemaSummary{{
+			SchemaID:   uuid.New(),
+			Slug:       "cards",
+			TableName:  "cards_entities",
+			CategoryID: categoryID,
+			IsActive:   true,
+			Status:     "published",
+		}}, nil
+	}
+
+	includeDescendants := true
+	response, err := handler.ListSchemaCategorySchemas(context.Background(), schemacategories.ListSchemaCategorySchemasRequestObject{
+		CategoryId: externalRef2.UUID(categoryID),
+		Params:     schemacategories.ListSchemaCategorySchemasParams{IncludeDescendants: &includeDescendants},
+	})
+	require.NoError(t, err)
+
+	success, ok := response.(schemacategories.ListSchemaCategorySchemas200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, success.Items, 1)
+	require.Equal(t, externalRef2.Slug("cards"), success.Items[0].Slug)
+	require.Equal(t, schemacategories.Published, success.Items[0].Status)
+}
+
 func TestHandlerCreateSchemaCategory(t *testing.T) {
 	t.Parallel()
 
@@ -173,6 +258,57 @@ func TestHandlerGetSchemaCategoryNotFound(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, problem.StatusCode)
 }
 
+func TestHandlerDeleteSchemaCategoryInUse(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockService{}
+	handler := New(svc, zaptest.NewLogger(t))
+
+	svc.deleteFn = func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, mode service.DeleteMode, dryRun bool) (service.Deletion, error) {
+		require.Equal(t, service.DeleteRestrict, mode)
+		return service.Deletion{}, &service.InUseError{Mode: mode, ChildCategories: 1}
+	}
+
+	response, err := handler.DeleteSchemaCategory(context.Background(), schemacategories.DeleteSchemaCategoryRequestObject{
+		CategoryId: externalRef2.UUID(uuid.New()),
+	})
+	require.NoError(t, err)
+
+	problem, ok := response.(schemacategories.DeleteSchemaCategorydefaultApplicationProblemPlusJSONResponse)
+	require.True(t, ok)
+	require.Equal(t, http.StatusConflict, problem.StatusCode)
+	require.NotNil(t, problem.Body.Errors)
+	require.Contains(t, *problem.Body.Errors, "childCategories")
+}
+
+func TestHandlerDeleteSchemaCategoryDryRun(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockService{}
+	handler := New(svc, zaptest.NewLogger(t))
+
+	id := uuid.New()
+	svc.deleteFn = func(ctx context.Context, audit requesttrace.AuditInfo, got uuid.UUID, mode service.DeleteMode, dryRun bool) (service.Deletion, error) {
+		require.True(t, dryRun)
+		return service.Deletion{CategoryID: got, Mode: mode, Categories: []uuid.UUID{got}, ReparentedCategories: 2, DryRun: true}, nil
+	}
+
+	dryRun := true
+	mode := schemacategories.Reparent
+	response, err := handler.DeleteSchemaCategory(context.Background(), schemacategories.DeleteSchemaCategoryRequestObject{
+		CategoryId: externalRef2.UUID(id),
+		Params:     schemacategories.DeleteSchemaCategoryParams{Mode: &mode, DryRun: &dryRun},
+	})
+	require.NoError(t, err)
+
+	report, ok := response.(schemacategories.DeleteSchemaCategory200JSONResponse)
+	require.True(t, ok)
+	require.Equal(t, "reparent", report.Mode)
+	require.Equal(t, []externalRef2.UUID{externalRef2.UUID(id)}, report.DeletedCategories)
+	require.Equal(t, int64(2), report.ReparentedCategories)
+	require.True(t, report.DryRun)
+}
+
 func TestHandlerUpdateSchemaCategoryMissingBody(t *testing.T) {
 	t.Parallel()
 
@@ -12,10 +12,12 @@ import (
 // Repository exposes persistence operations required by the schema categories service.
 type Repository interface {
 	List(ctx context.Context, includeDeleted bool) ([]persistence.SchemaCategory, error)
+	Tree(ctx context.Context) ([]persistence.SchemaCategoryTreeNode, error)
+	ListSchemas(ctx context.Context, id uuid.UUID, includeDescendants bool) ([]persistence.SchemaRecord, error)
 	Create(ctx context.Context, params persistence.CreateSchemaCategoryParams) (persistence.SchemaCategory, error)
 	Get(ctx context.Context, id uuid.UUID) (persistence.SchemaCategory, error)
 	Update(ctx context.Context, id uuid.UUID, params persistence.UpdateSchemaCategoryParams) (persistence.SchemaCategory, error)
-	Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error
+	Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error)
 }
 
 type postgresRepository struct {
@@ -38,6 +40,14 @@ func (r *postgresRepository) List(ctx context.Context, includeDeleted bool) ([]p
 	return r.store.ListSchemaCategories(ctx, r.adminDB, includeDeleted)
 }
 
+func (r *postgresRepository) Tree(ctx context.Context) ([]persistence.SchemaCategoryTreeNode, error) {
+	return r.store.SchemaCategoryTree(ctx, r.adminDB)
+}
+
+func (r *postgresRepository) ListSchemas(ctx context.Context, id uuid.UUID, includeDescendants bool) ([]persistence.SchemaRecord, error) {
+	return r.store.ListCategorySchemas(ctx, r.adminDB, id, includeDescendants)
+}
+
 func (r *postgresRepository) Create(ctx context.Context, params persistence.CreateSchemaCategoryParams) (persistence.SchemaCategory, error) {
 	return r.store.CreateSchemaCategory(ctx, r.adminDB, params)
 }
@@ -50,6 +60,6 @@ func (r *postgresRepository) Update(ctx context.Context, id uuid.UUID, params pe
 	return r.store.UpdateSchemaCategory(ctx, r.adminDB, id, params)
 }
 
-func (r *postgresRepository) Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error {
-	return r.store.DeleteSchemaCategory(ctx, r.adminDB, id, deletedAt)
+func (r *postgresRepository) Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error) {
+	return r.store.DeleteSchemaCategory(ctx, r.adminDB, id, deletedAt, mode, dryRun)
 }
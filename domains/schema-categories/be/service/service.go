@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -25,6 +26,58 @@ func (v *ValidationError) Error() string {
 	return "validation error"
 }
 
+// InUseError is returned by Delete when the category still has live child categories or schemas that the chosen
+// delete mode cannot handle.
+type InUseError struct {
+	Mode            DeleteMode
+	ChildCategories int64
+	Schemas         int64
+}
+
+func (e *InUseError) Error() string {
+	return fmt.Sprintf("schema category has %d child categories and %d schemas", e.ChildCategories, e.Schemas)
+}
+
+// Fields reports the blocking children for problem responses.
+func (e *InUseError) Fields() FieldErrors {
+	fields := FieldErrors{}
+	if e.ChildCategories > 0 {
+		fields.add("childCategories", fmt.Sprintf("%d child categories; delete with mode reparent or cascade", e.ChildCategories))
+	}
+	if e.Schemas > 0 {
+		if e.Mode == DeleteReparent {
+			fields.add("schemas", fmt.Sprintf("%d schemas cannot be moved because the category has no parent; use mode cascade", e.Schemas))
+		} else {
+			fields.add("schemas", fmt.Sprintf("%d schemas; delete with mode reparent or cascade", e.Schemas))
+		}
+	}
+	return fields
+}
+
+// Deletion reports what Delete changed, or would change on a dry run. The schema counts are version rows.
+type Deletion struct {
+	CategoryID uuid.UUID
+	Mode       DeleteMode
+	// Categories are the soft-deleted categories: the category itself and, on cascade, its subtree.
+	Categories               []uuid.UUID
+	ReparentedCategories     int64
+	ReparentedSchemaVersions int64
+	DeletedSchemaVersions    int64
+	DryRun                   bool
+}
+
+// DeleteMode selects how Delete treats the live child categories and schemas of a category.
+type DeleteMode string
+
+const (
+	// DeleteRestrict refuses to delete a category that still has children. It is the default.
+	DeleteRestrict DeleteMode = "restrict"
+	// DeleteReparent moves children to the parent of the deleted category.
+	DeleteReparent DeleteMode = "reparent"
+	// DeleteCascade soft deletes the whole subtree, including its schema versions.
+	DeleteCascade DeleteMode = "cascade"
+)
+
 // Domain-level error sentinel values.
 var (
 	ErrNotFound = errors.New("schema category not found")
@@ -43,6 +96,27 @@ type Category struct {
 	DeletedAt   *time.Time
 }
 
+// CategoryNode is a category of the hierarchy together with its children and schema counts.
+type CategoryNode struct {
+	Category
+	SchemaCount      int64
+	TotalSchemaCount int64
+	Children         []CategoryNode
+}
+
+// SchemaSummary describes a schema listed under a category through its representative version: the active one,
+// or the newest live one when none is active.
+type SchemaSummary struct {
+	SchemaID   uuid.UUID
+	Version    persistence.SemanticVersion
+	Slug       string
+	TableName  string
+	CategoryID uuid.UUID
+	IsActive   bool
+	Status     string
+	CreatedAt  time.Time
+}
+
 // CreateInput defines the payload required to create a schema category.
 type CreateInput struct {
 	CategoryID  *uuid.UUID
@@ -63,10 +137,12 @@ type UpdateInput struct {
 // Service exposes the schema categories domain operations.
 type Service interface {
 	List(ctx context.Context, audit requesttrace.AuditInfo, includeDeleted bool) ([]Category, error)
+	Tree(ctx context.Context, audit requesttrace.AuditInfo) ([]CategoryNode, error)
+	ListSchemas(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, includeDescendants bool) ([]SchemaSummary, error)
 	Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (Category, error)
 	Get(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Category, error)
 	Update(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input UpdateInput) (Category, error)
-	Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, mode DeleteMode, dryRun bool) (Deletion, error)
 }
 
 type service struct {
@@ -96,6 +172,45 @@ func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, includ
 	return categories, nil
 }
 
+func (s *service) Tree(ctx context.Context, audit requesttrace.AuditInfo) ([]CategoryNode, error) { //nolint:revive
+	records, err := s.repo.Tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapCategoryNodes(records), nil
+}
+
+func (s *service) ListSchemas(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, includeDescendants bool) ([]SchemaSummary, error) { //nolint:revive
+	if id == uuid.Nil {
+		return nil, ErrNotFound
+	}
+
+	records, err := s.repo.ListSchemas(ctx, id, includeDescendants)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	summaries := make([]SchemaSummary, 0, len(records))
+	for _, record := range records {
+		summaries = append(summaries, SchemaSummary{
+			SchemaID:   record.SchemaID,
+			Version:    record.SchemaVersion,
+			Slug:       record.Slug,
+			TableName:  record.TableName,
+			CategoryID: record.CategoryID,
+			IsActive:   record.IsActive,
+			Status:     record.Status,
+			CreatedAt:  record.CreatedAt,
+		})
+	}
+
+	return summaries, nil
+}
+
 func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (Category, error) { //nolint:revive
 	if err := s.ensureParentExists(ctx, input.ParentID, uuid.Nil); err != nil {
 		return Category{}, err
@@ -160,7 +275,12 @@ func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, id u
 
 	record, err := s.repo.Update(ctx, id, params)
 	if err != nil {
+		var cycleErr *persistence.SchemaCategoryCycleError
 		switch {
+		case errors.As(err, &cycleErr):
+			return Category{}, &ValidationError{Fields: FieldErrors{"parentCategoryId": []string{
+				"parent category would create a cycle: " + strings.Join(cycleErr.Path, " -> "),
+			}}}
 		case errors.Is(err, persistence.ErrSchemaNotFound):
 			return Category{}, ErrNotFound
 		case errors.Is(err, persistence.ErrSchemaCategoryConflict):
@@ -173,19 +293,42 @@ func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, id u
 	return mapCategory(record), nil
 }
 
-func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error { //nolint:revive
+// Delete soft deletes a category. With dryRun the delete runs, including the in-use checks, and is rolled back, so
+// the report is exactly what a real run changes.
+func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, mode DeleteMode, dryRun bool) (Deletion, error) { //nolint:revive
 	if id == uuid.Nil {
-		return ErrNotFound
+		return Deletion{}, ErrNotFound
 	}
 
-	if err := s.repo.Delete(ctx, id, s.now().UTC()); err != nil {
-		if errors.Is(err, persistence.ErrSchemaNotFound) {
-			return ErrNotFound
+	switch mode {
+	case "":
+		mode = DeleteRestrict
+	case DeleteRestrict, DeleteReparent, DeleteCascade:
+	default:
+		return Deletion{}, &ValidationError{Fields: FieldErrors{"mode": []string{"mode must be one of restrict, reparent, cascade"}}}
+	}
+
+	deletion, err := s.repo.Delete(ctx, id, s.now().UTC(), persistence.SchemaCategoryDeleteMode(mode), dryRun)
+	if err != nil {
+		var inUseErr *persistence.SchemaCategoryInUseError
+		switch {
+		case errors.Is(err, persistence.ErrSchemaNotFound):
+			return Deletion{}, ErrNotFound
+		case errors.As(err, &inUseErr):
+			return Deletion{}, &InUseError{Mode: mode, ChildCategories: inUseErr.ChildCategories, Schemas: inUseErr.Schemas}
 		}
-		return err
+		return Deletion{}, err
 	}
 
-	return nil
+	return Deletion{
+		CategoryID:               id,
+		Mode:                     mode,
+		Categories:               deletion.Categories,
+		ReparentedCategories:     deletion.ReparentedCategories,
+		ReparentedSchemaVersions: deletion.ReparentedSchemaVersions,
+		DeletedSchemaVersions:    deletion.DeletedSchemaVersions,
+		DryRun:                   dryRun,
+	}, nil
 }
 
 type normalizedCreateInput struct {
@@ -302,6 +445,19 @@ func mapCategory(record persistence.SchemaCategory) Category {
 	}
 }
 
+func mapCategoryNodes(records []persistence.SchemaCategoryTreeNode) []CategoryNode {
+	nodes := make([]CategoryNode, 0, len(records))
+	for _, record := range records {
+		nodes = append(nodes, CategoryNode{
+			Category:         mapCategory(record.SchemaCategory),
+			SchemaCount:      record.SchemaCount,
+			TotalSchemaCount: record.TotalSchemaCount,
+			Children:         mapCategoryNodes(record.Children),
+		})
+	}
+	return nodes
+}
+
 func (f FieldErrors) add(field, message string) {
 	if _, ok := f[field]; !ok {
 		f[field] = []string{message}
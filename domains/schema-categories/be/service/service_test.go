@@ -13,11 +13,13 @@ import (
 )
 
 type mockRepository struct {
-	listFn   func(ctx context.Context, includeDeleted bool) ([]persistence.SchemaCategory, error)
-	createFn func(ctx context.Context, params persistence.CreateSchemaCategoryParams) (persistence.SchemaCategory, error)
-	getFn    func(ctx context.Context, id uuid.UUID) (persistence.SchemaCategory, error)
-	updateFn func(ctx context.Context, id uuid.UUID, params persistence.UpdateSchemaCategoryParams) (persistence.SchemaCategory, error)
-	deleteFn func(ctx context.Context, id uuid.UUID, deletedAt time.Time) error
+	listFn    func(ctx context.Context, includeDeleted bool) ([]persistence.SchemaCategory, error)
+	treeFn    func(ctx context.Context) ([]persistence.SchemaCategoryTreeNode, error)
+	schemasFn func(ctx context.Context, id uuid.UUID, includeDescendants bool) ([]persistence.SchemaRecord, error)
+	createFn  func(ctx context.Context, params persistence.CreateSchemaCategoryParams) (persistence.SchemaCategory, error)
+	getFn     func(ctx context.Context, id uuid.UUID) (persistence.SchemaCategory, error)
+	updateFn  func(ctx context.Context, id uuid.UUID, params persistence.UpdateSchemaCategoryParams) (persistence.SchemaCategory, error)
+	deleteFn  func(ctx context.Context, id uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error)
 }
 
 func (m *mockRepository) List(ctx context.Context, includeDeleted bool) ([]persistence.SchemaCategory, error) {
@@ -27,6 +29,20 @@ func (m *mockRepository) List(ctx context.Context, includeDeleted bool) ([]persi
 	return m.listFn(ctx, includeDeleted)
 }
 
+func (m *mockRepository) Tree(ctx context.Context) ([]persistence.SchemaCategoryTreeNode, error) {
+	if m.treeFn == nil {
+		panic("treeFn not configured")
+	}
+	return m.treeFn(ctx)
+}
+
+func (m *mockRepository) ListSchemas(ctx context.Context, id uuid.UUID, includeDescendants bool) ([]persistence.SchemaRecord, error) {
+	if m.schemasFn == nil {
+		panic("schemasFn not configured")
+	}
+	return m.schemasFn(ctx, id, includeDescendants)
+}
+
 func (m *mockRepository) Create(ctx context.Context, params persistence.CreateSchemaCategoryParams) (persistence.SchemaCategory, error) {
 	if m.createFn == nil {
 		panic("createFn not configured")
@@ -48,11 +64,11 @@ func (m *mockRepository) Update(ctx context.Context, id uuid.UUID, params persis
 	return m.updateFn(ctx, id, params)
 }
 
-func (m *mockRepository) Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error {
+func (m *mockRepository) Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error) {
 	if m.deleteFn == nil {
 		panic("deleteFn not configured")
 	}
-	return m.deleteFn(ctx, id, deletedAt)
+	return m.deleteFn(ctx, id, deletedAt, mode, dryRun)
 }
 
 func TestServiceCreateSuccess(t *testing.T) {
@@ -263,6 +279,25 @@ func TestServiceUpdateParentSelfReference(t *testing.T) {
 	require.Contains(t, validationErr.Fields, "parentCategoryId")
 }
 
+func TestServiceUpdateParentCycle(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepository{}
+	id := uuid.New()
+	parentID := uuid.New()
+	repo.getFn = func(ctx context.Context, got uuid.UUID) (persistence.SchemaCategory, error) {
+		return persistence.SchemaCategory{CategoryID: got}, nil
+	}
+	repo.updateFn = func(ctx context.Context, got uuid.UUID, params persistence.UpdateSchemaCategoryParams) (persistence.SchemaCategory, error) {
+		return persistence.SchemaCategory{}, &persistence.SchemaCategoryCycleError{Path: []string{"a", "b", "a"}}
+	}
+
+	_, err := New(repo).Update(context.Background(), requesttrace.Anonymous("test"), id, UpdateInput{ParentID: &parentID})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Equal(t, []string{"parent category would create a cycle: a -> b -> a"}, validationErr.Fields["parentCategoryId"])
+}
+
 func TestServiceUpdateValidation(t *testing.T) {
 	t.Parallel()
 
@@ -280,17 +315,64 @@ func TestServiceDeleteNotFound(t *testing.T) {
 	t.Parallel()
 
 	repo := &mockRepository{}
-	repo.deleteFn = func(ctx context.Context, id uuid.UUID, deletedAt time.Time) error {
-		return persistence.ErrSchemaNotFound
+	repo.deleteFn = func(ctx context.Context, id uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error) {
+		require.Equal(t, persistence.SchemaCategoryDeleteRestrict, mode)
+		return persistence.SchemaCategoryDeletion{}, persistence.ErrSchemaNotFound
 	}
 
 	svc := New(repo)
 	audit := requesttrace.Anonymous("test")
 
-	err := svc.Delete(context.Background(), audit, uuid.New())
+	_, err := svc.Delete(context.Background(), audit, uuid.New(), "", false)
 	require.ErrorIs(t, err, ErrNotFound)
 }
 
+func TestServiceDeleteInUse(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepository{}
+	repo.deleteFn = func(ctx context.Context, id uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error) {
+		require.Equal(t, persistence.SchemaCategoryDeleteReparent, mode)
+		return persistence.SchemaCategoryDeletion{}, &persistence.SchemaCategoryInUseError{Schemas: 2}
+	}
+
+	_, err := New(repo).Delete(context.Background(), requesttrace.Anonymous("test"), uuid.New(), DeleteReparent, false)
+	var inUseErr *InUseError
+	require.ErrorAs(t, err, &inUseErr)
+	require.Equal(t, int64(2), inUseErr.Schemas)
+	require.Contains(t, inUseErr.Fields(), "schemas")
+	require.NotContains(t, inUseErr.Fields(), "childCategories")
+}
+
+func TestServiceDeleteInvalidMode(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&mockRepository{}).Delete(context.Background(), requesttrace.Anonymous("test"), uuid.New(), "orphan", false)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "mode")
+}
+
+func TestServiceDeleteDryRun(t *testing.T) {
+	t.Parallel()
+
+	id, child := uuid.New(), uuid.New()
+	repo := &mockRepository{}
+	repo.deleteFn = func(ctx context.Context, got uuid.UUID, deletedAt time.Time, mode persistence.SchemaCategoryDeleteMode, dryRun bool) (persistence.SchemaCategoryDeletion, error) {
+		require.Equal(t, persistence.SchemaCategoryDeleteCascade, mode)
+		require.True(t, dryRun)
+		return persistence.SchemaCategoryDeletion{Categories: []uuid.UUID{got, child}, DeletedSchemaVersions: 3}, nil
+	}
+
+	deletion, err := New(repo).Delete(context.Background(), requesttrace.Anonymous("test"), id, DeleteCascade, true)
+	require.NoError(t, err)
+	require.Equal(t, id, deletion.CategoryID)
+	require.Equal(t, DeleteCascade, deletion.Mode)
+	require.Equal(t, []uuid.UUID{id, child}, deletion.Categories)
+	require.Equal(t, int64(3), deletion.DeletedSchemaVersions)
+	require.True(t, deletion.DryRun)
+}
+
 func TestServiceList(t *testing.T) {
 	t.Parallel()
 
@@ -318,6 +400,76 @@ func TestServiceList(t *testing.T) {
 	require.Equal(t, "Cards", list[0].Name)
 }
 
+func TestServiceTree(t *testing.T) {
+	t.Parallel()
+
+	rootID := uuid.New()
+	repo := &mockRepository{}
+	repo.treeFn = func(ctx context.Context) ([]persistence.SchemaCategoryTreeNode, error) {
+		return []persistence.SchemaCategoryTreeNode{
+			{
+				SchemaCategory:   persistence.SchemaCategory{CategoryID: rootID, Name: "Root", Slug: "root"},
+				SchemaCount:      1,
+				TotalSchemaCount: 3,
+				Children: []persistence.SchemaCategoryTreeNode{
+					{
+						SchemaCategory:   persistence.SchemaCategory{CategoryID: uuid.New(), ParentCategoryID: &rootID, Name: "Cards", Slug: "cards"},
+						SchemaCount:      2,
+						TotalSchemaCount: 2,
+					},
+				},
+			},
+		}, nil
+	}
+
+	tree, err := New(repo).Tree(context.Background(), requesttrace.Anonymous("test"))
+	require.NoError(t, err)
+	require.Len(t, tree, 1)
+	require.Equal(t, int64(3), tree[0].TotalSchemaCount)
+	require.Len(t, tree[0].Children, 1)
+	require.Equal(t, "Cards", tree[0].Children[0].Name)
+	require.Equal(t, &rootID, tree[0].Children[0].ParentID)
+	require.Empty(t, tree[0].Children[0].Children)
+}
+
+func TestServiceListSchemas(t *testing.T) {
+	t.Parallel()
+
+	categoryID := uuid.New()
+	repo := &mockRepository{}
+	repo.schemasFn = func(ctx context.Context, id uuid.UUID, includeDescendants bool) ([]persistence.SchemaRecord, error) {
+		require.Equal(t, categoryID, id)
+		require.True(t, includeDescendants)
+		return []persistence.SchemaRecord{{
+			SchemaID:      uuid.New(),
+			SchemaVersion: persistence.SemanticVersion{Major: 1},
+			Slug:          "cards",
+			TableName:     "cards_entities",
+			CategoryID:    categoryID,
+			IsActive:      true,
+			Status:        persistence.SchemaStatusPublished,
+		}}, nil
+	}
+
+	schemas, err := New(repo).ListSchemas(context.Background(), requesttrace.Anonymous("test"), categoryID, true)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+	require.Equal(t, "cards", schemas[0].Slug)
+	require.Equal(t, "1.0.0", schemas[0].Version.String())
+}
+
+func TestServiceListSchemasCategoryNotFound(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepository{}
+	repo.schemasFn = func(ctx context.Context, id uuid.UUID, includeDescendants bool) ([]persistence.SchemaRecord, error) {
+		return nil, persistence.ErrSchemaNotFound
+	}
+
+	_, err := New(repo).ListSchemas(context.Background(), requesttrace.Anonymous("test"), uuid.New(), false)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
 func stringPtr(value string) *string {
 	return &value
 }
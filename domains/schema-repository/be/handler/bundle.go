@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	exportBundleOperation operation = "exportSchemaBundle"
+	importBundleOperation operation = "importSchemaBundle"
+)
+
+func (h *Handler) ExportSchemaBundle(ctx context.Context, request schemarepository.ExportSchemaBundleRequestObject) (schemarepository.ExportSchemaBundleResponseObject, error) {
+	audit := h.audit(ctx)
+
+	var schemaIDs []uuid.UUID
+	if request.Params.SchemaId != nil {
+		for _, id := range *request.Params.SchemaId {
+			schemaIDs = append(schemaIDs, uuidFromExternal(id))
+		}
+	}
+
+	bundle, err := h.svc.ExportBundle(ctx, audit, schemaIDs)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, exportBundleOperation)
+		return schemarepository.ExportSchemaBundledefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	apiBundle, err := toAPIBundle(bundle)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, exportBundleOperation)
+		return schemarepository.ExportSchemaBundledefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemarepository.ExportSchemaBundle200JSONResponse(apiBundle), nil
+}
+
+func (h *Handler) ImportSchemaBundle(ctx context.Context, request schemarepository.ImportSchemaBundleRequestObject) (schemarepository.ImportSchemaBundleResponseObject, error) {
+	audit := h.audit(ctx)
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return schemarepository.ImportSchemaBundledefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: http.StatusBadRequest,
+		}, nil
+	}
+
+	bundle, err := bundleFromAPI(*request.Body)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, importBundleOperation)
+		return schemarepository.ImportSchemaBundledefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	report, err := h.svc.ImportBundle(ctx, audit, bundle, dryRun)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, importBundleOperation)
+		return schemarepository.ImportSchemaBundledefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemarepository.ImportSchemaBundle200JSONResponse{
+		Applied:    report.Applied,
+		Categories: toAPIBundleItems(report.Categories),
+		Schemas:    toAPIBundleItems(report.Schemas),
+	}, nil
+}
+
+func toAPIBundle(bundle persistence.SchemaBundle) (schemarepository.SchemaBundle, error) {
+	exportedAt := externalRef2.Timestamp(bundle.ExportedAt)
+	apiBundle := schemarepository.SchemaBundle{
+		FormatVersion: schemarepository.SchemaBundleFormatVersion(bundle.FormatVersion),
+		ExportedAt:    &exportedAt,
+		Categories:    make([]schemarepository.SchemaBundleCategory, 0, len(bundle.Categories)),
+		Schemas:       make([]schemarepository.SchemaBundleVersion, 0, len(bundle.Schemas)),
+	}
+
+	for _, category := range bundle.Categories {
+		apiCategory := schemarepository.SchemaBundleCategory{
+			CategoryId:  externalRef2.UUID(category.CategoryID),
+			Name:        category.Name,
+			Slug:        externalRef2.Slug(category.Slug),
+			Description: category.Description,
+		}
+		if category.ParentCategoryID != nil {
+			parentID := externalRef2.UUID(*category.ParentCategoryID)
+			apiCategory.ParentCategoryId = &parentID
+		}
+		apiBundle.Categories = append(apiBundle.Categories, apiCategory)
+	}
+
+	for _, version := range bundle.Schemas {
+		definition, err := rawMessageToMap(version.Definition)
+		if err != nil {
+			return schemarepository.SchemaBundle{}, err
+		}
+		status := schemarepository.SchemaStatus(version.Status)
+		apiBundle.Schemas = append(apiBundle.Schemas, schemarepository.SchemaBundleVersion{
+			SchemaId:         externalRef2.UUID(version.SchemaID),
+			SchemaVersion:    externalRef2.SemanticVersion(version.SchemaVersion.String()),
+			TableName:        externalRef2.TableName(version.TableName),
+			Slug:             externalRef2.Slug(version.Slug),
+			CategoryId:       externalRef2.UUID(version.CategoryID),
+			SchemaDefinition: definition,
+			Status:           &status,
+			Active:           version.Active,
+		})
+	}
+
+	return apiBundle, nil
+}
+
+func bundleFromAPI(apiBundle schemarepository.SchemaBundle) (persistence.SchemaBundle, error) {
+	bundle := persistence.SchemaBundle{
+		FormatVersion: int(apiBundle.FormatVersion),
+		Categories:    make([]persistence.SchemaBundleCategory, 0, len(apiBundle.Categories)),
+		Schemas:       make([]persistence.SchemaBundleVersion, 0, len(apiBundle.Schemas)),
+	}
+	if apiBundle.ExportedAt != nil {
+		bundle.ExportedAt = time.Time(*apiBundle.ExportedAt)
+	}
+
+	for _, category := range apiBundle.Categories {
+		bundleCategory := persistence.SchemaBundleCategory{
+			CategoryID:  uuidFromExternal(category.CategoryId),
+			Name:        category.Name,
+			Slug:        string(category.Slug),
+			Description: category.Description,
+		}
+		if category.ParentCategoryId != nil {
+			parentID := uuidFromExternal(*category.ParentCategoryId)
+			bundleCategory.ParentCategoryID = &parentID
+		}
+		bundle.Categories = append(bundle.Categories, bundleCategory)
+	}
+
+	fieldErrors := service.FieldErrors{}
+	for i, version := range apiBundle.Schemas {
+		parsed, err := persistence.ParseSemanticVersion(string(version.SchemaVersion))
+		if err != nil {
+			field := fmt.Sprintf("schemas[%d].schemaVersion", i)
+			fieldErrors[field] = append(fieldErrors[field], fmt.Sprintf("invalid semantic version: %v", err))
+			continue
+		}
+		definition, err := json.Marshal(version.SchemaDefinition)
+		if err != nil {
+			return persistence.SchemaBundle{}, fmt.Errorf("encode schemaDefinition: %w", err)
+		}
+		status := ""
+		if version.Status != nil {
+			status = string(*version.Status)
+		}
+		bundle.Schemas = append(bundle.Schemas, persistence.SchemaBundleVersion{
+			SchemaID:      uuidFromExternal(version.SchemaId),
+			SchemaVersion: parsed,
+			TableName:     string(version.TableName),
+			Slug:          string(version.Slug),
+			CategoryID:    uuidFromExternal(version.CategoryId),
+			Definition:    definition,
+			Status:        status,
+			Active:        version.Active,
+		})
+	}
+	if len(fieldErrors) > 0 {
+		return persistence.SchemaBundle{}, &service.ValidationError{Fields: fieldErrors}
+	}
+
+	return bundle, nil
+}
+
+func toAPIBundleItems(items []persistence.SchemaBundleItem) []schemarepository.SchemaBundleItem {
+	apiItems := make([]schemarepository.SchemaBundleItem, 0, len(items))
+	for _, item := range items {
+		apiItems = append(apiItems, schemarepository.SchemaBundleItem{
+			Key:    item.Key,
+			Action: schemarepository.SchemaBundleItemAction(item.Action),
+		})
+	}
+	return apiItems
+}
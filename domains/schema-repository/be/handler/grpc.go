@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	schemarepositoryv1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/proto/palmyra/schemarepository/v1"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/rpc"
+)
+
+// GRPCServer wires the schema repository service to the gRPC contract. Errors map to status codes through the
+// same problem catalog as the HTTP handler.
+type GRPCServer struct {
+	schemarepositoryv1.UnimplementedSchemaRepositoryServiceServer
+
+	svc    service.Service
+	logger *zap.Logger
+}
+
+// NewGRPCServer constructs a GRPCServer instance.
+func NewGRPCServer(svc service.Service, logger *zap.Logger) *GRPCServer {
+	if svc == nil {
+		panic("schema repository service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &GRPCServer{svc: svc, logger: logger}
+}
+
+// GRPCMethods declares the schema repository RPCs for rpc.Config, mirroring the security of
+// contracts/schema-repository.yaml.
+func GRPCMethods() map[string]rpc.Method {
+	return map[string]rpc.Method{
+		schemarepositoryv1.SchemaRepositoryService_ListSchemas_FullMethodName:        {Read: true},
+		schemarepositoryv1.SchemaRepositoryService_ListSchemaVersions_FullMethodName: {Read: true},
+		schemarepositoryv1.SchemaRepositoryService_GetSchema_FullMethodName:          {Read: true},
+	}
+}
+
+func (s *GRPCServer) ListSchemas(ctx context.Context, request *schemarepositoryv1.ListSchemasRequest) (*schemarepositoryv1.ListSchemasResponse, error) {
+	schemas, err := s.svc.ListAll(ctx, requesttrace.FromContextOrAnonymous(ctx), request.GetIncludeInactive())
+	if err != nil {
+		return nil, s.statusForError(ctx, err, listOperation)
+	}
+	return toProtoSchemaList(schemas)
+}
+
+func (s *GRPCServer) ListSchemaVersions(ctx context.Context, request *schemarepositoryv1.ListSchemaVersionsRequest) (*schemarepositoryv1.ListSchemasResponse, error) {
+	schemaID, err := parseSchemaID(request.GetSchemaId())
+	if err != nil {
+		return nil, s.statusForError(ctx, err, listOperation)
+	}
+
+	schemas, err := s.svc.List(ctx, requesttrace.FromContextOrAnonymous(ctx), schemaID, request.GetIncludeDeleted())
+	if err != nil {
+		return nil, s.statusForError(ctx, err, listOperation)
+	}
+	return toProtoSchemaList(schemas)
+}
+
+func (s *GRPCServer) GetSchema(ctx context.Context, request *schemarepositoryv1.GetSchemaRequest) (*schemarepositoryv1.Schema, error) {
+	audit := requesttrace.FromContextOrAnonymous(ctx)
+	schemaID, err := parseSchemaID(request.GetSchemaId())
+	if err != nil {
+		return nil, s.statusForError(ctx, err, getOperation)
+	}
+
+	var schema service.Schema
+	if request.GetVersion() == "" {
+		schema, err = s.svc.GetActive(ctx, audit, schemaID)
+	} else {
+		version, parseErr := persistence.ParseSemanticVersion(request.GetVersion())
+		if parseErr != nil {
+			validationErr := &service.ValidationError{
+				Fields: service.FieldErrors{"version": {fmt.Sprintf("invalid semantic version: %v", parseErr)}},
+			}
+			return nil, s.statusForError(ctx, validationErr, getOperation)
+		}
+		schema, err = s.svc.Get(ctx, audit, schemaID, version)
+	}
+	if err != nil {
+		return nil, s.statusForError(ctx, err, getOperation)
+	}
+	return toProtoSchema(schema)
+}
+
+func (s *GRPCServer) statusForError(ctx context.Context, err error, op operation) error {
+	return rpc.FromProblem(errorCatalog.Resolve(ctx, s.logger, err, string(op)))
+}
+
+func parseSchemaID(raw string) (uuid.UUID, error) {
+	schemaID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, &service.ValidationError{Fields: service.FieldErrors{"schemaId": {"must be a UUID"}}}
+	}
+	return schemaID, nil
+}
+
+func toProtoSchemaList(schemas []service.Schema) (*schemarepositoryv1.ListSchemasResponse, error) {
+	items := make([]*schemarepositoryv1.Schema, 0, len(schemas))
+	for _, schema := range schemas {
+		item, err := toProtoSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return &schemarepositoryv1.ListSchemasResponse{Schemas: items}, nil
+}
+
+func toProtoSchema(schema service.Schema) (*schemarepositoryv1.Schema, error) {
+	var definition map[string]interface{}
+	if err := json.Unmarshal(schema.Definition, &definition); err != nil {
+		return nil, status.Errorf(codes.Internal, "decode schema definition: %v", err)
+	}
+	encoded, err := structpb.NewStruct(definition)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode schema definition: %v", err)
+	}
+
+	return &schemarepositoryv1.Schema{
+		SchemaId:      schema.SchemaID.String(),
+		SchemaVersion: schema.Version.String(),
+		TableName:     schema.TableName,
+		Slug:          schema.Slug,
+		CategoryId:    schema.CategoryID.String(),
+		Definition:    encoded,
+		CreatedAt:     timestamppb.New(schema.CreatedAt),
+		IsActive:      schema.IsActive,
+		IsDeleted:     schema.IsDeleted,
+		Status:        schema.Status,
+	}, nil
+}
+
+// compile-time assertions to ensure interface compliance
+var _ schemarepositoryv1.SchemaRepositoryServiceServer = (*GRPCServer)(nil)
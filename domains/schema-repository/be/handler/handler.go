@@ -3,7 +3,6 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 
@@ -14,20 +13,21 @@ import (
 	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
 	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
 	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
-	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
 )
 
 const (
-	problemTypeValidation              = "https://palmyra.pro/problems/validation-error"
-	problemTypeNotFound                = "https://palmyra.pro/problems/not-found"
-	problemTypeConflict                = "https://palmyra.pro/problems/conflict"
-	problemTypeInternal                = "https://palmyra.pro/problems/internal-error"
 	schemaRepositoryBasePath           = "/api/v1/schema-repository/schemas"
 	listOperation            operation = "listSchemaVersions"
 	createOperation          operation = "createSchemaVersion"
 	getOperation             operation = "getSchemaVersion"
+	diffOperation            operation = "diffSchemaVersions"
+	publishOperation         operation = "publishSchemaVersion"
+	validateOperation        operation = "validateSchemaPayload"
+	deleteOperation          operation = "deleteSchemaVersion"
+	verifyExamplesOperation  operation = "verifySchemaExamples"
 )
 
 type operation string
@@ -57,7 +57,7 @@ func New(svc service.Service, logger *zap.Logger) *Handler {
 func (h *Handler) CreateSchemaVersion(ctx context.Context, request schemarepository.CreateSchemaVersionRequestObject) (schemarepository.CreateSchemaVersionResponseObject, error) {
 	audit := h.audit(ctx)
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return schemarepository.CreateSchemaVersiondefaultApplicationProblemPlusJSONResponse{
 			Body:       problem,
 			StatusCode: http.StatusBadRequest,
@@ -162,6 +162,227 @@ func (h *Handler) GetSchemaVersion(ctx context.Context, request schemarepository
 	return schemarepository.GetSchemaVersion200JSONResponse(apiSchema), nil
 }
 
+func (h *Handler) DiffSchemaVersions(ctx context.Context, request schemarepository.DiffSchemaVersionsRequestObject) (schemarepository.DiffSchemaVersionsResponseObject, error) {
+	audit := h.audit(ctx)
+	schemaID := uuidFromExternal(request.SchemaId)
+
+	fieldErrors := service.FieldErrors{}
+	from, err := persistence.ParseSemanticVersion(string(request.Params.From))
+	if err != nil {
+		fieldErrors["from"] = []string{fmt.Sprintf("invalid semantic version: %v", err)}
+	}
+	to, err := persistence.ParseSemanticVersion(string(request.Params.To))
+	if err != nil {
+		fieldErrors["to"] = []string{fmt.Sprintf("invalid semantic version: %v", err)}
+	}
+	if len(fieldErrors) > 0 {
+		status, problem := h.problemForError(ctx, &service.ValidationError{Fields: fieldErrors}, diffOperation)
+		return schemarepository.DiffSchemaVersionsdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	diff, err := h.svc.Diff(ctx, audit, schemaID, from, to)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, diffOperation)
+		return schemarepository.DiffSchemaVersionsdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	changes := make([]schemarepository.SchemaPropertyChange, 0, len(diff.ChangedProperties))
+	for _, change := range diff.ChangedProperties {
+		changes = append(changes, schemarepository.SchemaPropertyChange{
+			Path:     change.Path,
+			Keywords: change.Keywords,
+			Before:   change.Before,
+			After:    change.After,
+		})
+	}
+
+	return schemarepository.DiffSchemaVersions200JSONResponse{
+		SchemaId:          externalRef2.UUID(diff.SchemaID),
+		FromVersion:       externalRef2.SemanticVersion(diff.From.String()),
+		ToVersion:         externalRef2.SemanticVersion(diff.To.String()),
+		AddedProperties:   diff.AddedProperties,
+		RemovedProperties: diff.RemovedProperties,
+		ChangedProperties: changes,
+		RequiredAdded:     diff.RequiredAdded,
+		RequiredRemoved:   diff.RequiredRemoved,
+	}, nil
+}
+
+func (h *Handler) DeleteSchemaVersion(ctx context.Context, request schemarepository.DeleteSchemaVersionRequestObject) (schemarepository.DeleteSchemaVersionResponseObject, error) {
+	audit := h.audit(ctx)
+	schemaID := uuidFromExternal(request.SchemaId)
+	version, err := parseVersionParam(request.SchemaVersion)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, deleteOperation)
+		return schemarepository.DeleteSchemaVersiondefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	force := request.Params.Force != nil && *request.Params.Force
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	deletion, err := h.svc.Delete(ctx, audit, schemaID, version, force, dryRun)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, deleteOperation)
+		return schemarepository.DeleteSchemaVersiondefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	if dryRun {
+		return schemarepository.DeleteSchemaVersion200JSONResponse(toAPIDeletion(deletion)), nil
+	}
+	return schemarepository.DeleteSchemaVersion204Response{}, nil
+}
+
+func toAPIDeletion(deletion service.Deletion) schemarepository.SchemaVersionDeletion {
+	return schemarepository.SchemaVersionDeletion{
+		SchemaId:      externalRef2.UUID(deletion.SchemaID),
+		SchemaVersion: externalRef2.SemanticVersion(deletion.Version.String()),
+		TableName:     externalRef2.TableName(deletion.TableName),
+		WasActive:     deletion.WasActive,
+		EntityRows:    deletion.EntityCount,
+		EntityTables:  deletion.TableCount,
+		DryRun:        deletion.DryRun,
+	}
+}
+
+func (h *Handler) VerifySchemaExamples(ctx context.Context, request schemarepository.VerifySchemaExamplesRequestObject) (schemarepository.VerifySchemaExamplesResponseObject, error) {
+	audit := h.audit(ctx)
+	schemaID := uuidFromExternal(request.SchemaId)
+	version, err := parseVersionParam(request.SchemaVersion)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, verifyExamplesOperation)
+		return schemarepository.VerifySchemaExamplesdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	result, err := h.svc.VerifyExamples(ctx, audit, schemaID, version)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, verifyExamplesOperation)
+		return schemarepository.VerifySchemaExamplesdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	errs := make(map[string][]string, len(result.Errors))
+	for field, messages := range result.Errors {
+		errs[field] = append([]string(nil), messages...)
+	}
+
+	return schemarepository.VerifySchemaExamples200JSONResponse{
+		Valid:        len(errs) == 0,
+		ExampleCount: result.ExampleCount,
+		Errors:       errs,
+	}, nil
+}
+
+func (h *Handler) PublishSchemaVersion(ctx context.Context, request schemarepository.PublishSchemaVersionRequestObject) (schemarepository.PublishSchemaVersionResponseObject, error) {
+	audit := h.audit(ctx)
+	schemaID := uuidFromExternal(request.SchemaId)
+	version, err := parseVersionParam(request.SchemaVersion)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, publishOperation)
+		return schemarepository.PublishSchemaVersiondefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	schemaVersion, err := h.svc.Publish(ctx, audit, schemaID, version)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, publishOperation)
+		return schemarepository.PublishSchemaVersiondefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	apiSchema, convertErr := toAPISchemaSafe(schemaVersion)
+	if convertErr != nil {
+		status, problem := h.problemForError(ctx, convertErr, publishOperation)
+		return schemarepository.PublishSchemaVersiondefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemarepository.PublishSchemaVersion200JSONResponse(apiSchema), nil
+}
+
+func (h *Handler) ValidateSchemaPayload(ctx context.Context, request schemarepository.ValidateSchemaPayloadRequestObject) (schemarepository.ValidateSchemaPayloadResponseObject, error) {
+	audit := h.audit(ctx)
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return schemarepository.ValidateSchemaPayloaddefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: http.StatusBadRequest,
+		}, nil
+	}
+
+	schemaID := uuidFromExternal(request.SchemaId)
+	version, err := parseVersionParam(request.SchemaVersion)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, validateOperation)
+		return schemarepository.ValidateSchemaPayloaddefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	payload, err := json.Marshal(request.Body.Payload)
+	if err != nil {
+		status, problem := h.problemForError(ctx, fmt.Errorf("encode payload: %w", err), validateOperation)
+		return schemarepository.ValidateSchemaPayloaddefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	fieldErrors, err := h.svc.ValidatePayload(ctx, audit, schemaID, version, payload)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, validateOperation)
+		return schemarepository.ValidateSchemaPayloaddefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	errs := make(map[string][]string, len(fieldErrors))
+	for field, messages := range fieldErrors {
+		errs[field] = append([]string(nil), messages...)
+	}
+
+	return schemarepository.ValidateSchemaPayload200JSONResponse{
+		Valid:  len(errs) == 0,
+		Errors: errs,
+	}, nil
+}
+
+// parseVersionParam parses a schemaVersion path parameter, reporting failures as field errors.
+func parseVersionParam(raw externalRef2.SemanticVersion) (persistence.SemanticVersion, error) {
+	version, err := persistence.ParseSemanticVersion(string(raw))
+	if err != nil {
+		return persistence.SemanticVersion{}, &service.ValidationError{
+			Fields: service.FieldErrors{
+				"schemaVersion": {fmt.Sprintf("invalid semantic version: %v", err)},
+			},
+		}
+	}
+	return version, nil
+}
+
 func (h *Handler) createInputFromRequest(ctx context.Context, body *schemarepository.CreateSchemaVersionRequest) (service.CreateInput, error) {
 	definitionBytes, err := json.Marshal(body.SchemaDefinition)
 	if err != nil {
@@ -174,6 +395,12 @@ func (h *Handler) createInputFromRequest(ctx context.Context, body *schemareposi
 		Slug:       string(body.Slug),
 		CategoryID: uuidFromExternal(body.CategoryId),
 	}
+	if body.CompatibilityMode != nil {
+		input.CompatibilityMode = service.CompatibilityMode(*body.CompatibilityMode)
+	}
+	if body.Status != nil {
+		input.Status = string(*body.Status)
+	}
 
 	return input, nil
 }
@@ -203,11 +430,32 @@ func toAPISchemaSafe(schema service.Schema) (schemarepository.SchemaVersion, err
 		CreatedAt:        externalRef2.Timestamp(schema.CreatedAt),
 		IsActive:         schema.IsActive,
 		IsDeleted:        schema.IsDeleted,
+		Status:           schemarepository.SchemaStatus(schema.Status),
+	}
+	if schema.Compatibility != nil {
+		apiSchema.Compatibility = toAPICompatibility(*schema.Compatibility)
 	}
 
 	return apiSchema, nil
 }
 
+func toAPICompatibility(compatibility persistence.SchemaCompatibility) *schemarepository.SchemaCompatibility {
+	issues := make([]schemarepository.SchemaCompatibilityIssue, 0, len(compatibility.Issues))
+	for _, issue := range compatibility.Issues {
+		issues = append(issues, schemarepository.SchemaCompatibilityIssue{
+			Path:    issue.Path,
+			Kind:    schemarepository.SchemaCompatibilityIssueKind(issue.Kind),
+			Message: issue.Message,
+		})
+	}
+
+	return &schemarepository.SchemaCompatibility{
+		Level:       schemarepository.SchemaCompatibilityLevel(compatibility.Level),
+		BaseVersion: externalRef2.SemanticVersion(compatibility.BaseVersion),
+		Issues:      issues,
+	}
+}
+
 func rawMessageToMap(raw json.RawMessage) (map[string]interface{}, error) {
 	var payload map[string]interface{}
 	if err := json.Unmarshal(raw, &payload); err != nil {
@@ -220,84 +468,30 @@ func uuidFromExternal(id externalRef2.UUID) uuid.UUID {
 	return uuid.UUID(id)
 }
 
-func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
-	status, title, detail, problemType, fieldErrors := h.classifyError(err)
+// errorCatalog maps schema-repository service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("schema-repository")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation("one or more fields are invalid", err.Fields)
+	})
+	problems.RegisterAs(c, func(err *service.IncompatibleSchemaError) problems.Problem {
+		return problems.New(http.StatusConflict, "Incompatible schema", problems.TypeIncompatibleSchema, err.Error()).WithFields(err.Fields())
+	})
+	problems.RegisterAs(c, func(err *service.BundleConflictError) problems.Problem {
+		return problems.Conflict(err.Error()).WithFields(err.Fields())
+	})
+	problems.RegisterAs(c, func(err *service.InUseError) problems.Problem {
+		return problems.Conflict(err.Error()).WithFields(err.Fields())
+	})
+	c.Register(service.ErrNotFound, problems.NotFound("schema version not found")).
+		Register(service.ErrConflict, problems.Conflict("schema version already exists")).
+		Register(service.ErrDraft, problems.Conflict("draft schema versions must be published before they can be activated")).
+		Register(service.ErrSlugTaken, problems.Conflict("slug is already used by another schema")).
+		Register(service.ErrSpacesUnavailable, problems.NotConfigured("Service unavailable", "tenant spaces are not available")).
+		Register(service.ErrNotDraft, problems.Conflict("schema version is already published"))
+	return c
+}()
 
-	logger := h.loggerFrom(ctx)
-	fields := []zap.Field{
-		zap.String("operation", string(op)),
-		zap.Int("status", status),
-	}
-
-	switch {
-	case status >= http.StatusInternalServerError:
-		logger.Error("schema repository operation failed", append(fields, zap.Error(err))...)
-	case status == http.StatusNotFound:
-		logger.Info("schema repository resource not found", append(fields, zap.Error(err))...)
-	default:
-		logger.Warn("schema repository request rejected", append(fields, zap.Error(err))...)
-	}
-
-	return status, h.buildProblem(title, detail, problemType, status, fieldErrors)
-}
-
-func (h *Handler) classifyError(err error) (status int, title, detail, problemType string, fieldErrors service.FieldErrors) {
-	var validationErr *service.ValidationError
-	switch {
-	case errors.As(err, &validationErr):
-		return http.StatusBadRequest,
-			"Validation failed",
-			"one or more fields are invalid",
-			problemTypeValidation,
-			validationErr.Fields
-	case errors.Is(err, service.ErrNotFound):
-		return http.StatusNotFound,
-			"Resource not found",
-			"schema version not found",
-			problemTypeNotFound,
-			nil
-	case errors.Is(err, service.ErrConflict):
-		return http.StatusConflict,
-			"Conflict",
-			"schema version already exists",
-			problemTypeConflict,
-			nil
-	default:
-		return http.StatusInternalServerError,
-			"Internal server error",
-			"an unexpected error occurred",
-			problemTypeInternal,
-			nil
-	}
-}
-
-func (h *Handler) buildProblem(title, detail, problemType string, status int, fieldErrors service.FieldErrors) externalRef3.ProblemDetails {
-	problem := externalRef3.ProblemDetails{
-		Title:  title,
-		Status: status,
-	}
-
-	if detail != "" {
-		problem.Detail = &detail
-	}
-	if problemType != "" {
-		problem.Type = &problemType
-	}
-
-	if len(fieldErrors) > 0 {
-		copied := make(map[string][]string, len(fieldErrors))
-		for field, messages := range fieldErrors {
-			copied[field] = append([]string(nil), messages...)
-		}
-		problem.Errors = &copied
-	}
-
-	return problem
-}
-
-func (h *Handler) loggerFrom(ctx context.Context) *zap.Logger {
-	if logger, ok := platformlogging.FromContext(ctx); ok {
-		return logger
-	}
-	return h.logger
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
 }
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+)
+
+const migrateIndexesOperation operation = "migrateSchemaIndexes"
+
+func (h *Handler) MigrateSchemaIndexes(ctx context.Context, request schemarepository.MigrateSchemaIndexesRequestObject) (schemarepository.MigrateSchemaIndexesResponseObject, error) {
+	audit := h.audit(ctx)
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	report, err := h.svc.MigrateIndexes(ctx, audit, uuidFromExternal(request.SchemaId), dryRun, nil)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, migrateIndexesOperation)
+		return schemarepository.MigrateSchemaIndexesdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemarepository.MigrateSchemaIndexes200JSONResponse(toAPIIndexMigrationReport(report)), nil
+}
+
+func toAPIIndexMigrationReport(report service.IndexMigrationReport) schemarepository.EntityIndexMigrationReport {
+	tenants := make([]schemarepository.EntityIndexMigrationTenant, 0, len(report.Tenants))
+	for _, result := range report.Tenants {
+		item := schemarepository.EntityIndexMigrationTenant{
+			TenantId:       externalRef2.UUID(result.TenantID),
+			TenantSlug:     externalRef2.Slug(result.TenantSlug),
+			TableExists:    result.TableExists,
+			CreatedIndexes: append([]string{}, result.Created...),
+			DroppedIndexes: append([]string{}, result.Dropped...),
+		}
+		if result.Error != "" {
+			errMsg := result.Error
+			item.Error = &errMsg
+		}
+		tenants = append(tenants, item)
+	}
+
+	return schemarepository.EntityIndexMigrationReport{
+		SchemaId:      externalRef2.UUID(report.SchemaID),
+		SchemaVersion: externalRef2.SemanticVersion(report.Version.String()),
+		IndexedFields: append([]string{}, report.IndexedFields...),
+		DryRun:        report.DryRun,
+		Tenants:       tenants,
+	}
+}
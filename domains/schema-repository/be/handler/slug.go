@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	getSlugsOperation   operation = "getSchemaSlugs"
+	renameSlugOperation operation = "renameSchemaSlug"
+)
+
+func (h *Handler) GetSchemaSlugs(ctx context.Context, request schemarepository.GetSchemaSlugsRequestObject) (schemarepository.GetSchemaSlugsResponseObject, error) {
+	audit := h.audit(ctx)
+
+	info, err := h.svc.GetSlugs(ctx, audit, uuidFromExternal(request.SchemaId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getSlugsOperation)
+		return schemarepository.GetSchemaSlugsdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemarepository.GetSchemaSlugs200JSONResponse(toAPISlugs(info)), nil
+}
+
+func (h *Handler) RenameSchemaSlug(ctx context.Context, request schemarepository.RenameSchemaSlugRequestObject) (schemarepository.RenameSchemaSlugResponseObject, error) {
+	audit := h.audit(ctx)
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return schemarepository.RenameSchemaSlugdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: http.StatusBadRequest,
+		}, nil
+	}
+
+	info, err := h.svc.RenameSlug(ctx, audit, uuidFromExternal(request.SchemaId), string(request.Body.Slug))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, renameSlugOperation)
+		return schemarepository.RenameSchemaSlugdefaultApplicationProblemPlusJSONResponse{
+			Body:       problem,
+			StatusCode: status,
+		}, nil
+	}
+
+	return schemarepository.RenameSchemaSlug200JSONResponse(toAPISlugs(info)), nil
+}
+
+func toAPISlugs(info service.SlugInfo) schemarepository.SchemaSlugs {
+	aliases := make([]schemarepository.SchemaSlugAlias, 0, len(info.Aliases))
+	for _, alias := range info.Aliases {
+		aliases = append(aliases, schemarepository.SchemaSlugAlias{
+			Slug:      externalRef2.Slug(alias.Slug),
+			CreatedAt: externalRef2.Timestamp(alias.CreatedAt),
+		})
+	}
+
+	return schemarepository.SchemaSlugs{
+		SchemaId: externalRef2.UUID(info.SchemaID),
+		Slug:     externalRef2.Slug(info.Slug),
+		Aliases:  aliases,
+	}
+}
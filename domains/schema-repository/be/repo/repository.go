@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // Repository exposes persistence operations for schema repository records.
@@ -17,24 +18,40 @@ type Repository interface {
 	List(ctx context.Context, schemaID uuid.UUID) ([]persistence.SchemaRecord, error)
 	ListAll(ctx context.Context, includeInactive bool) ([]persistence.SchemaRecord, error)
 	GetLatestBySlug(ctx context.Context, slug string) (persistence.SchemaRecord, error)
+	RenameSlug(ctx context.Context, schemaID uuid.UUID, slug string, createdBy *string) (string, error)
+	ListSlugAliases(ctx context.Context, schemaID uuid.UUID) ([]persistence.SchemaSlugAlias, error)
+	MigrateIndexes(ctx context.Context, schema persistence.SchemaRecord, spaces []tenant.Space, dryRun bool, progress func(persistence.EntityIndexMigration)) ([]persistence.EntityIndexMigration, error)
+	EnsureEntityTables(ctx context.Context, schema persistence.SchemaRecord, spaces []tenant.Space) error
 	Activate(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) error
-	Delete(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion, deletedAt time.Time) error
+	Publish(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) error
+	Delete(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion, deletedAt time.Time, force, dryRun bool) (persistence.SchemaVersionDeletion, error)
+	ExportBundle(ctx context.Context, schemaIDs []uuid.UUID) (persistence.SchemaBundle, error)
+	ImportBundle(ctx context.Context, bundle persistence.SchemaBundle, dryRun bool) (persistence.SchemaBundleReport, error)
 }
 
 type postgresRepository struct {
 	spaceDB *persistence.SpaceDB
 	store   *persistence.SchemaRepositoryStore
+	bundles *persistence.SchemaBundleStore
+	tables  *persistence.EntityTableStore
 }
 
-// NewPostgresRepository constructs a Repository backed by the shared persistence layer.
-func NewPostgresRepository(spaceDB *persistence.SpaceDB, store *persistence.SchemaRepositoryStore) Repository {
+// NewPostgresRepository constructs a Repository backed by the shared persistence layer. tables creates the entity
+// tables of activated schemas in the tenant spaces.
+func NewPostgresRepository(spaceDB *persistence.SpaceDB, store *persistence.SchemaRepositoryStore, bundles *persistence.SchemaBundleStore, tables *persistence.EntityTableStore) Repository {
 	if spaceDB == nil {
 		panic("admin db is required")
 	}
 	if store == nil {
 		panic("schema repository store is required")
 	}
-	return &postgresRepository{spaceDB: spaceDB, store: store}
+	if bundles == nil {
+		panic("schema bundle store is required")
+	}
+	if tables == nil {
+		panic("entity table store is required")
+	}
+	return &postgresRepository{spaceDB: spaceDB, store: store, bundles: bundles, tables: tables}
 }
 
 func (r *postgresRepository) Upsert(ctx context.Context, params persistence.CreateSchemaParams) (persistence.SchemaRecord, error) {
@@ -61,10 +78,38 @@ func (r *postgresRepository) GetLatestBySlug(ctx context.Context, slug string) (
 	return r.store.GetLatestSchemaBySlug(ctx, r.spaceDB, slug)
 }
 
+func (r *postgresRepository) RenameSlug(ctx context.Context, schemaID uuid.UUID, slug string, createdBy *string) (string, error) {
+	return r.store.RenameSchemaSlug(ctx, r.spaceDB, schemaID, slug, createdBy)
+}
+
+func (r *postgresRepository) ListSlugAliases(ctx context.Context, schemaID uuid.UUID) ([]persistence.SchemaSlugAlias, error) {
+	return r.store.ListSchemaSlugAliases(ctx, r.spaceDB, schemaID)
+}
+
+func (r *postgresRepository) MigrateIndexes(ctx context.Context, schema persistence.SchemaRecord, spaces []tenant.Space, dryRun bool, progress func(persistence.EntityIndexMigration)) ([]persistence.EntityIndexMigration, error) {
+	return r.store.MigrateEntityIndexes(ctx, r.spaceDB, schema, spaces, dryRun, progress)
+}
+
+func (r *postgresRepository) EnsureEntityTables(ctx context.Context, schema persistence.SchemaRecord, spaces []tenant.Space) error {
+	return r.tables.EnsureTable(ctx, schema, spaces)
+}
+
 func (r *postgresRepository) Activate(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) error {
 	return r.store.ActivateSchemaVersion(ctx, r.spaceDB, schemaID, version)
 }
 
-func (r *postgresRepository) Delete(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion, deletedAt time.Time) error {
-	return r.store.DeleteSchema(ctx, r.spaceDB, schemaID, version, deletedAt)
+func (r *postgresRepository) Publish(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) error {
+	return r.store.PublishSchemaVersion(ctx, r.spaceDB, schemaID, version)
+}
+
+func (r *postgresRepository) Delete(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion, deletedAt time.Time, force, dryRun bool) (persistence.SchemaVersionDeletion, error) {
+	return r.store.DeleteSchema(ctx, r.spaceDB, schemaID, version, deletedAt, force, dryRun)
+}
+
+func (r *postgresRepository) ExportBundle(ctx context.Context, schemaIDs []uuid.UUID) (persistence.SchemaBundle, error) {
+	return r.bundles.ExportBundle(ctx, r.spaceDB, schemaIDs)
+}
+
+func (r *postgresRepository) ImportBundle(ctx context.Context, bundle persistence.SchemaBundle, dryRun bool) (persistence.SchemaBundleReport, error) {
+	return r.bundles.ImportBundle(ctx, r.spaceDB, bundle, dryRun)
 }
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// BundleConflictError is returned by ImportBundle when the bundle clashes with the target environment.
+// Nothing was written; Report lists every conflict.
+type BundleConflictError struct {
+	Report persistence.SchemaBundleReport
+}
+
+func (e *BundleConflictError) Error() string {
+	return fmt.Sprintf("schema bundle has %d conflict(s)", len(e.Report.Conflicts))
+}
+
+// Fields groups the conflict messages by bundle item key for problem responses.
+func (e *BundleConflictError) Fields() FieldErrors {
+	fields := FieldErrors{}
+	for _, conflict := range e.Report.Conflicts {
+		addFieldError(fields, conflict.Key, conflict.Message)
+	}
+	return fields
+}
+
+func (s *service) ExportBundle(ctx context.Context, audit requesttrace.AuditInfo, schemaIDs []uuid.UUID) (persistence.SchemaBundle, error) { //nolint:revive
+	return s.repo.ExportBundle(ctx, schemaIDs)
+}
+
+func (s *service) ImportBundle(ctx context.Context, audit requesttrace.AuditInfo, bundle persistence.SchemaBundle, dryRun bool) (persistence.SchemaBundleReport, error) { //nolint:revive
+	if bundle.FormatVersion != persistence.SchemaBundleFormatVersion {
+		return persistence.SchemaBundleReport{}, &ValidationError{Fields: FieldErrors{
+			"formatVersion": {fmt.Sprintf("formatVersion must be %d", persistence.SchemaBundleFormatVersion)},
+		}}
+	}
+
+	report, err := s.repo.ImportBundle(ctx, bundle, dryRun)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaBundleConflict) {
+			return report, &BundleConflictError{Report: report}
+		}
+		return persistence.SchemaBundleReport{}, err
+	}
+
+	if report.Applied {
+		for _, version := range bundle.Schemas {
+			if !version.Active {
+				continue
+			}
+			err := s.ensureEntityTables(ctx, persistence.SchemaRecord{
+				SchemaID:         version.SchemaID,
+				SchemaVersion:    version.SchemaVersion,
+				SchemaDefinition: version.Definition,
+				TableName:        version.TableName,
+			})
+			if err != nil {
+				// The import is idempotent, so running it again retries the tables.
+				return report, err
+			}
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestServiceImportBundleRejectsUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	svc := New(newFakeRepository(), nil, nil)
+	_, err := svc.ImportBundle(context.Background(), requesttrace.Anonymous("test"), persistence.SchemaBundle{FormatVersion: 99}, false)
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "formatVersion")
+}
+
+func TestServiceImportBundleReportsConflicts(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	repo.bundleConflicts = []persistence.SchemaBundleConflict{
+		{Key: "cards@1.0.0", Message: "version exists with a different definition"},
+	}
+	svc := New(repo, nil, nil)
+
+	_, err := svc.ImportBundle(context.Background(), requesttrace.Anonymous("test"), persistence.SchemaBundle{
+		FormatVersion: persistence.SchemaBundleFormatVersion,
+	}, true)
+
+	var conflictErr *BundleConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	require.Equal(t, []string{"version exists with a different definition"}, conflictErr.Fields()["cards@1.0.0"])
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// CompatibilityMode controls how Create treats breaking changes against the active version.
+type CompatibilityMode string
+
+const (
+	// CompatibilityModeNone skips the compatibility check.
+	CompatibilityModeNone CompatibilityMode = "none"
+	// CompatibilityModeAnnotate records the outcome on the new version; this is the default.
+	CompatibilityModeAnnotate CompatibilityMode = "annotate"
+	// CompatibilityModeEnforce rejects versions that break backward compatibility.
+	CompatibilityModeEnforce CompatibilityMode = "enforce"
+)
+
+// Compatibility issue kinds reported by CheckCompatibility.
+const (
+	IssueRequiredRemoved = "required-removed"
+	IssueRequiredAdded   = "required-added"
+	IssueTypeChanged     = "type-changed"
+	IssueEnumNarrowed    = "enum-narrowed"
+)
+
+// IncompatibleSchemaError is returned by Create in enforce mode when the new definition breaks
+// documents that are valid under the active version.
+type IncompatibleSchemaError struct {
+	Compatibility persistence.SchemaCompatibility
+}
+
+func (e *IncompatibleSchemaError) Error() string {
+	return fmt.Sprintf("schema version is not backward compatible with %s", e.Compatibility.BaseVersion)
+}
+
+// Fields groups the issues by property path for problem responses.
+func (e *IncompatibleSchemaError) Fields() FieldErrors {
+	fields := FieldErrors{}
+	for _, issue := range e.Compatibility.Issues {
+		addFieldError(fields, issue.Path, issue.Message)
+	}
+	return fields
+}
+
+// CheckCompatibility compares candidate against base and reports changes that would make documents
+// valid under base invalid under candidate. Only the JSON Schema keywords the entity schemas rely on
+// are inspected: properties, required, type, enum and items.
+func CheckCompatibility(base, candidate json.RawMessage) ([]persistence.SchemaCompatibilityIssue, error) {
+	var baseNode, candidateNode map[string]any
+	if err := json.Unmarshal(base, &baseNode); err != nil {
+		return nil, fmt.Errorf("decode base schema definition: %w", err)
+	}
+	if err := json.Unmarshal(candidate, &candidateNode); err != nil {
+		return nil, fmt.Errorf("decode candidate schema definition: %w", err)
+	}
+
+	issues := []persistence.SchemaCompatibilityIssue{}
+	compareSchemaNodes("", baseNode, candidateNode, &issues)
+	return issues, nil
+}
+
+func compareSchemaNodes(path string, base, candidate map[string]any, issues *[]persistence.SchemaCompatibilityIssue) {
+	report := func(at, kind, message string) {
+		if at == "" {
+			at = "$"
+		}
+		*issues = append(*issues, persistence.SchemaCompatibilityIssue{Path: at, Kind: kind, Message: message})
+	}
+
+	baseTypes, candidateTypes := schemaTypes(base), schemaTypes(candidate)
+	if len(candidateTypes) > 0 {
+		if len(baseTypes) == 0 {
+			report(path, IssueTypeChanged, fmt.Sprintf("type restricted to %s", strings.Join(candidateTypes, ", ")))
+		} else {
+			for _, baseType := range baseTypes {
+				if !typeAccepted(baseType, candidateTypes) {
+					report(path, IssueTypeChanged, fmt.Sprintf("type %s is no longer accepted", baseType))
+				}
+			}
+		}
+	}
+
+	if candidateEnum, ok := candidate["enum"].([]any); ok {
+		baseEnum, hasBaseEnum := base["enum"].([]any)
+		if !hasBaseEnum {
+			report(path, IssueEnumNarrowed, "enum introduced")
+		} else {
+			for _, value := range baseEnum {
+				if !containsValue(candidateEnum, value) {
+					report(path, IssueEnumNarrowed, fmt.Sprintf("value %v is no longer allowed", value))
+				}
+			}
+		}
+	}
+
+	baseProperties, candidateProperties := schemaProperties(base), schemaProperties(candidate)
+	baseRequired, candidateRequired := stringSet(base["required"]), stringSet(candidate["required"])
+
+	for _, name := range sortedKeys(candidateRequired) {
+		if !baseRequired[name] {
+			report(joinSchemaPath(path, name), IssueRequiredAdded, "property is now required")
+		}
+	}
+	for _, name := range sortedKeys(baseRequired) {
+		if _, ok := baseProperties[name]; !ok {
+			continue
+		}
+		if _, ok := candidateProperties[name]; !ok {
+			report(joinSchemaPath(path, name), IssueRequiredRemoved, "required property was removed")
+		}
+	}
+
+	for _, name := range sortedKeys(baseProperties) {
+		candidateProperty, ok := candidateProperties[name]
+		if !ok {
+			continue
+		}
+		compareSchemaNodes(joinSchemaPath(path, name), baseProperties[name], candidateProperty, issues)
+	}
+
+	baseItems, baseOK := base["items"].(map[string]any)
+	candidateItems, candidateOK := candidate["items"].(map[string]any)
+	if baseOK && candidateOK {
+		compareSchemaNodes(path+"[]", baseItems, candidateItems, issues)
+	}
+}
+
+func schemaTypes(node map[string]any) []string {
+	switch value := node["type"].(type) {
+	case string:
+		return []string{value}
+	case []any:
+		types := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+// typeAccepted reports whether values of baseType still validate; integers remain valid numbers.
+func typeAccepted(baseType string, candidateTypes []string) bool {
+	for _, candidateType := range candidateTypes {
+		if candidateType == baseType || (baseType == "integer" && candidateType == "number") {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaProperties(node map[string]any) map[string]map[string]any {
+	raw, ok := node["properties"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	properties := make(map[string]map[string]any, len(raw))
+	for name, value := range raw {
+		if property, ok := value.(map[string]any); ok {
+			properties[name] = property
+		}
+	}
+	return properties
+}
+
+func stringSet(value any) map[string]bool {
+	items, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			set[s] = true
+		}
+	}
+	return set
+}
+
+func containsValue(values []any, target any) bool {
+	for _, value := range values {
+		if reflect.DeepEqual(value, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// checkCompatibility compares the definition with the active version in existing, if any.
+func checkCompatibility(existing []persistence.SchemaRecord, definition json.RawMessage, mode CompatibilityMode) (*persistence.SchemaCompatibility, error) {
+	if mode == CompatibilityModeNone {
+		return nil, nil
+	}
+
+	var active *persistence.SchemaRecord
+	for i := range existing {
+		if existing[i].IsActive && !existing[i].IsDeleted {
+			active = &existing[i]
+			break
+		}
+	}
+	if active == nil {
+		return nil, nil
+	}
+
+	issues, err := CheckCompatibility(active.SchemaDefinition, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	compatibility := &persistence.SchemaCompatibility{
+		Level:       persistence.SchemaCompatibilityBackward,
+		BaseVersion: active.SchemaVersion.String(),
+		Issues:      issues,
+	}
+	if len(issues) > 0 {
+		compatibility.Level = persistence.SchemaCompatibilityBreaking
+		if mode == CompatibilityModeEnforce {
+			return nil, &IncompatibleSchemaError{Compatibility: *compatibility}
+		}
+	}
+
+	return compatibility, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	t.Parallel()
+
+	base := `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string"},
+			"count": {"type": "integer"},
+			"rarity": {"type": "string", "enum": ["common", "rare"]},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`
+
+	cases := []struct {
+		name      string
+		candidate string
+		want      []persistence.SchemaCompatibilityIssue
+	}{
+		{
+			name: "optional property added and integer widened",
+			candidate: `{
+				"type": "object",
+				"required": ["name"],
+				"properties": {
+					"name": {"type": "string"},
+					"count": {"type": "number"},
+					"rarity": {"type": "string", "enum": ["common", "rare", "epic"]},
+					"tags": {"type": "array", "items": {"type": "string"}},
+					"notes": {"type": "string"}
+				}
+			}`,
+			want: []persistence.SchemaCompatibilityIssue{},
+		},
+		{
+			name: "required property removed",
+			candidate: `{
+				"type": "object",
+				"properties": {"count": {"type": "integer"}}
+			}`,
+			want: []persistence.SchemaCompatibilityIssue{
+				{Path: "name", Kind: IssueRequiredRemoved, Message: "required property was removed"},
+			},
+		},
+		{
+			name: "new required property and narrowed types",
+			candidate: `{
+				"type": "object",
+				"required": ["name", "count"],
+				"properties": {
+					"name": {"type": "string"},
+					"count": {"type": "integer"},
+					"rarity": {"type": "string", "enum": ["common"]},
+					"tags": {"type": "array", "items": {"type": "integer"}}
+				}
+			}`,
+			want: []persistence.SchemaCompatibilityIssue{
+				{Path: "count", Kind: IssueRequiredAdded, Message: "property is now required"},
+				{Path: "rarity", Kind: IssueEnumNarrowed, Message: "value rare is no longer allowed"},
+				{Path: "tags[]", Kind: IssueTypeChanged, Message: "type string is no longer accepted"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			issues, err := CheckCompatibility(json.RawMessage(base), json.RawMessage(tc.candidate))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, issues)
+		})
+	}
+}
+
+func TestServiceCreateCompatibilityModes(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	svc := New(repo, nil, nil)
+	audit := requesttrace.Anonymous("test")
+	categoryID := uuid.New()
+
+	v1, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, v1.Compatibility)
+
+	breaking := json.RawMessage(`{"type":"object","required":["name"],"properties":{"name":{"type":"string"}}}`)
+
+	_, err = svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:          uuidPtr(v1.SchemaID),
+		Definition:        breaking,
+		TableName:         "cards_entities",
+		Slug:              "cards",
+		CategoryID:        categoryID,
+		CompatibilityMode: CompatibilityModeEnforce,
+	})
+	var incompatibleErr *IncompatibleSchemaError
+	require.True(t, errors.As(err, &incompatibleErr))
+	require.Equal(t, "1.0.0", incompatibleErr.Compatibility.BaseVersion)
+	require.Equal(t, FieldErrors{"name": {"property is now required"}}, incompatibleErr.Fields())
+
+	v2, err := svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:   uuidPtr(v1.SchemaID),
+		Definition: breaking,
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, v2.Compatibility)
+	require.Equal(t, persistence.SchemaCompatibilityBreaking, v2.Compatibility.Level)
+	require.Len(t, v2.Compatibility.Issues, 1)
+
+	v3, err := svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:          uuidPtr(v1.SchemaID),
+		Definition:        json.RawMessage(`{"type":"object"}`),
+		TableName:         "cards_entities",
+		Slug:              "cards",
+		CategoryID:        categoryID,
+		CompatibilityMode: CompatibilityModeNone,
+	})
+	require.NoError(t, err)
+	require.Nil(t, v3.Compatibility)
+}
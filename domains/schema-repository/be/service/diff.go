@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// SchemaDiff is the structural difference between two versions of one schema.
+type SchemaDiff struct {
+	SchemaID          uuid.UUID
+	From              persistence.SemanticVersion
+	To                persistence.SemanticVersion
+	AddedProperties   []string
+	RemovedProperties []string
+	ChangedProperties []PropertyChange
+	RequiredAdded     []string
+	RequiredRemoved   []string
+}
+
+// PropertyChange lists the keywords of a property present in both versions whose values differ.
+type PropertyChange struct {
+	Path     string
+	Keywords []string
+	Before   map[string]any
+	After    map[string]any
+}
+
+// structuralKeywords are walked recursively rather than compared as values.
+var structuralKeywords = map[string]bool{"properties": true, "required": true, "items": true}
+
+func (s *service) Diff(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, from, to persistence.SemanticVersion) (SchemaDiff, error) { //nolint:revive
+	if schemaID == uuid.Nil {
+		return SchemaDiff{}, ErrNotFound
+	}
+
+	fromRecord, err := s.repo.GetByVersion(ctx, schemaID, from)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return SchemaDiff{}, ErrNotFound
+		}
+		return SchemaDiff{}, err
+	}
+
+	toRecord, err := s.repo.GetByVersion(ctx, schemaID, to)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return SchemaDiff{}, ErrNotFound
+		}
+		return SchemaDiff{}, err
+	}
+
+	diff, err := DiffDefinitions(fromRecord.SchemaDefinition, toRecord.SchemaDefinition)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	diff.SchemaID = schemaID
+	diff.From = from
+	diff.To = to
+
+	return diff, nil
+}
+
+// DiffDefinitions compares two JSON Schema documents, matching properties by path through
+// properties and items. Identifier and version fields of the result are left empty.
+func DiffDefinitions(from, to json.RawMessage) (SchemaDiff, error) {
+	var fromNode, toNode map[string]any
+	if err := json.Unmarshal(from, &fromNode); err != nil {
+		return SchemaDiff{}, fmt.Errorf("decode schema definition: %w", err)
+	}
+	if err := json.Unmarshal(to, &toNode); err != nil {
+		return SchemaDiff{}, fmt.Errorf("decode schema definition: %w", err)
+	}
+
+	diff := SchemaDiff{
+		AddedProperties:   []string{},
+		RemovedProperties: []string{},
+		ChangedProperties: []PropertyChange{},
+		RequiredAdded:     []string{},
+		RequiredRemoved:   []string{},
+	}
+	diffSchemaNodes("", fromNode, toNode, &diff)
+	return diff, nil
+}
+
+func diffSchemaNodes(path string, from, to map[string]any, diff *SchemaDiff) {
+	if change, ok := diffKeywords(path, from, to); ok {
+		diff.ChangedProperties = append(diff.ChangedProperties, change)
+	}
+
+	fromRequired, toRequired := stringSet(from["required"]), stringSet(to["required"])
+	for _, name := range sortedKeys(toRequired) {
+		if !fromRequired[name] {
+			diff.RequiredAdded = append(diff.RequiredAdded, joinSchemaPath(path, name))
+		}
+	}
+	for _, name := range sortedKeys(fromRequired) {
+		if !toRequired[name] {
+			diff.RequiredRemoved = append(diff.RequiredRemoved, joinSchemaPath(path, name))
+		}
+	}
+
+	fromProperties, toProperties := schemaProperties(from), schemaProperties(to)
+	for _, name := range sortedKeys(toProperties) {
+		if _, ok := fromProperties[name]; !ok {
+			diff.AddedProperties = append(diff.AddedProperties, joinSchemaPath(path, name))
+		}
+	}
+	for _, name := range sortedKeys(fromProperties) {
+		toProperty, ok := toProperties[name]
+		if !ok {
+			diff.RemovedProperties = append(diff.RemovedProperties, joinSchemaPath(path, name))
+			continue
+		}
+		diffSchemaNodes(joinSchemaPath(path, name), fromProperties[name], toProperty, diff)
+	}
+
+	fromItems, fromOK := from["items"].(map[string]any)
+	toItems, toOK := to["items"].(map[string]any)
+	if fromOK && toOK {
+		diffSchemaNodes(path+"[]", fromItems, toItems, diff)
+	}
+}
+
+func diffKeywords(path string, from, to map[string]any) (PropertyChange, bool) {
+	keys := make(map[string]struct{}, len(from)+len(to))
+	for key := range from {
+		keys[key] = struct{}{}
+	}
+	for key := range to {
+		keys[key] = struct{}{}
+	}
+
+	change := PropertyChange{Path: path, Keywords: []string{}, Before: map[string]any{}, After: map[string]any{}}
+	if change.Path == "" {
+		change.Path = "$"
+	}
+
+	for _, key := range sortedKeys(keys) {
+		if structuralKeywords[key] {
+			// Non-object items (tuples) are not walked, so compare them as values.
+			if key != "items" || isObject(from[key]) && isObject(to[key]) {
+				continue
+			}
+		}
+
+		before, inFrom := from[key]
+		after, inTo := to[key]
+		if inFrom == inTo && reflect.DeepEqual(before, after) {
+			continue
+		}
+
+		change.Keywords = append(change.Keywords, key)
+		if inFrom {
+			change.Before[key] = before
+		}
+		if inTo {
+			change.After[key] = after
+		}
+	}
+
+	return change, len(change.Keywords) > 0
+}
+
+func isObject(value any) bool {
+	_, ok := value.(map[string]any)
+	return ok
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestDiffDefinitions(t *testing.T) {
+	t.Parallel()
+
+	from := json.RawMessage(`{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string"},
+			"legacy": {"type": "string"},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`)
+	to := json.RawMessage(`{
+		"type": "object",
+		"required": ["name", "rarity"],
+		"properties": {
+			"name": {"type": "string", "maxLength": 80},
+			"rarity": {"type": "string", "enum": ["common", "rare"]},
+			"tags": {"type": "array", "items": {"type": "integer"}}
+		}
+	}`)
+
+	diff, err := DiffDefinitions(from, to)
+	require.NoError(t, err)
+	require.Equal(t, []string{"rarity"}, diff.AddedProperties)
+	require.Equal(t, []string{"legacy"}, diff.RemovedProperties)
+	require.Equal(t, []string{"rarity"}, diff.RequiredAdded)
+	require.Empty(t, diff.RequiredRemoved)
+	require.Equal(t, []PropertyChange{
+		{Path: "name", Keywords: []string{"maxLength"}, Before: map[string]any{}, After: map[string]any{"maxLength": float64(80)}},
+		{Path: "tags[]", Keywords: []string{"type"}, Before: map[string]any{"type": "string"}, After: map[string]any{"type": "integer"}},
+	}, diff.ChangedProperties)
+}
+
+func TestServiceDiff(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	svc := New(repo, nil, nil)
+	audit := requesttrace.Anonymous("test")
+	categoryID := uuid.New()
+
+	v1, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"title":"cards","properties":{"name":{"type":"string"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+
+	v2, err := svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:   uuidPtr(v1.SchemaID),
+		Definition: json.RawMessage(`{"title":"cards v2","properties":{"name":{"type":"string"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+
+	diff, err := svc.Diff(context.Background(), audit, v1.SchemaID, v1.Version, v2.Version)
+	require.NoError(t, err)
+	require.Equal(t, v1.SchemaID, diff.SchemaID)
+	require.Equal(t, []string{"title"}, diff.ChangedProperties[0].Keywords)
+	require.Equal(t, "$", diff.ChangedProperties[0].Path)
+
+	_, err = svc.Diff(context.Background(), audit, v1.SchemaID, v1.Version, persistence.SemanticVersion{Major: 9})
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func (s *service) Publish(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) (Schema, error) { //nolint:revive
+	if schemaID == uuid.Nil {
+		return Schema{}, ErrNotFound
+	}
+
+	draft, err := s.verifyStoredExamples(ctx, schemaID, version)
+	if err != nil {
+		return Schema{}, err
+	}
+	if draft.Status != persistence.SchemaStatusDraft {
+		return Schema{}, ErrNotDraft
+	}
+	// Publishing activates the version.
+	if err := s.ensureEntityTables(ctx, draft); err != nil {
+		return Schema{}, err
+	}
+
+	if err := s.repo.Publish(ctx, schemaID, version); err != nil {
+		switch {
+		case errors.Is(err, persistence.ErrSchemaNotFound):
+			return Schema{}, ErrNotFound
+		case errors.Is(err, persistence.ErrSchemaVersionPublished):
+			return Schema{}, ErrNotDraft
+		}
+		return Schema{}, err
+	}
+
+	record, err := s.repo.GetByVersion(ctx, schemaID, version)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return Schema{}, ErrNotFound
+		}
+		return Schema{}, err
+	}
+
+	return mapRecord(record), nil
+}
+
+// ValidatePayload checks a sample payload against any live version, drafts included, and returns the
+// validation failures keyed by payload path. An empty result means the payload is valid.
+func (s *service) ValidatePayload(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion, payload json.RawMessage) (FieldErrors, error) { //nolint:revive
+	if schemaID == uuid.Nil {
+		return nil, ErrNotFound
+	}
+
+	record, err := s.repo.GetByVersion(ctx, schemaID, version)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if record.IsDeleted {
+		return nil, ErrNotFound
+	}
+
+	if !isJSONObject(payload) {
+		return nil, &ValidationError{Fields: FieldErrors{"payload": {"payload must be a JSON object"}}}
+	}
+
+	fieldErrors := FieldErrors{}
+	if err := s.validator.Validate(ctx, record, payload); err != nil {
+		var validationErr *jsonschema.ValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, err
+		}
+		collectPayloadErrors(validationErr, fieldErrors)
+	}
+
+	return fieldErrors, nil
+}
+
+// collectPayloadErrors records the leaf causes of a validation error under dotted payload paths.
+func collectPayloadErrors(err *jsonschema.ValidationError, fields FieldErrors) {
+	if len(err.Causes) == 0 {
+		field := "payload"
+		if err.InstanceLocation != "" {
+			field += "." + strings.ReplaceAll(strings.TrimPrefix(err.InstanceLocation, "/"), "/", ".")
+		}
+		addFieldError(fields, field, err.Message)
+		return
+	}
+	for _, cause := range err.Causes {
+		collectPayloadErrors(cause, fields)
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestServiceDraftLifecycle(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	svc := New(repo, nil, nil)
+	audit := requesttrace.Anonymous("test")
+	categoryID := uuid.New()
+
+	v1, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, persistence.SchemaStatusPublished, v1.Status)
+
+	draft, err := svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:   uuidPtr(v1.SchemaID),
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"integer"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+		Status:     persistence.SchemaStatusDraft,
+	})
+	require.NoError(t, err)
+	require.Equal(t, persistence.SchemaStatusDraft, draft.Status)
+	require.False(t, draft.IsActive)
+
+	// A second draft submission rewrites the same version.
+	revised, err := svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:   uuidPtr(v1.SchemaID),
+		Definition: json.RawMessage(`{"type":"object","required":["name"],"properties":{"name":{"type":"string"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+		Status:     persistence.SchemaStatusDraft,
+	})
+	require.NoError(t, err)
+	require.Equal(t, draft.Version, revised.Version)
+
+	active, err := svc.GetActive(context.Background(), audit, v1.SchemaID)
+	require.NoError(t, err)
+	require.Equal(t, v1.Version, active.Version)
+
+	_, err = svc.Activate(context.Background(), audit, v1.SchemaID, revised.Version)
+	require.ErrorIs(t, err, ErrDraft)
+
+	fieldErrors, err := svc.ValidatePayload(context.Background(), audit, v1.SchemaID, revised.Version, json.RawMessage(`{}`))
+	require.NoError(t, err)
+	require.Contains(t, fieldErrors, "payload")
+
+	fieldErrors, err = svc.ValidatePayload(context.Background(), audit, v1.SchemaID, revised.Version, json.RawMessage(`{"name":"Pikachu"}`))
+	require.NoError(t, err)
+	require.Empty(t, fieldErrors)
+
+	published, err := svc.Publish(context.Background(), audit, v1.SchemaID, revised.Version)
+	require.NoError(t, err)
+	require.Equal(t, persistence.SchemaStatusPublished, published.Status)
+	require.True(t, published.IsActive)
+
+	_, err = svc.Publish(context.Background(), audit, v1.SchemaID, revised.Version)
+	require.ErrorIs(t, err, ErrNotDraft)
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// ExamplesResult reports how the x-examples stored on a schema version fare against its definition.
+// Errors are keyed by example path, e.g. "x-examples[1].name"; an empty map means every example validates.
+type ExamplesResult struct {
+	ExampleCount int
+	Errors       FieldErrors
+}
+
+func (s *service) VerifyExamples(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) (ExamplesResult, error) { //nolint:revive
+	if schemaID == uuid.Nil {
+		return ExamplesResult{}, ErrNotFound
+	}
+
+	record, err := s.repo.GetByVersion(ctx, schemaID, version)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return ExamplesResult{}, ErrNotFound
+		}
+		return ExamplesResult{}, err
+	}
+	if record.IsDeleted {
+		return ExamplesResult{}, ErrNotFound
+	}
+
+	examples, err := persistence.SchemaExamples(record.SchemaDefinition)
+	if err != nil {
+		return ExamplesResult{}, err
+	}
+
+	result := ExamplesResult{ExampleCount: len(examples), Errors: FieldErrors{}}
+	if err := addExampleErrors(result.Errors, "", record.SchemaDefinition); err != nil {
+		return ExamplesResult{}, err
+	}
+	return result, nil
+}
+
+// verifyStoredExamples blocks activation of a version whose stored examples no longer validate, and returns the
+// version otherwise.
+func (s *service) verifyStoredExamples(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) (persistence.SchemaRecord, error) {
+	record, err := s.repo.GetByVersion(ctx, schemaID, version)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return persistence.SchemaRecord{}, ErrNotFound
+		}
+		return persistence.SchemaRecord{}, err
+	}
+
+	fieldErrors := FieldErrors{}
+	if err := addExampleErrors(fieldErrors, "schemaDefinition.", record.SchemaDefinition); err != nil {
+		return persistence.SchemaRecord{}, err
+	}
+	if len(fieldErrors) > 0 {
+		return persistence.SchemaRecord{}, &ValidationError{Fields: fieldErrors}
+	}
+	return record, nil
+}
+
+// addExampleErrors records every failing x-examples payload of the definition under prefix + example path.
+func addExampleErrors(fields FieldErrors, prefix string, definition persistence.SchemaDefinition) error {
+	issues, err := persistence.VerifySchemaExamples(definition)
+	if err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		addFieldError(fields, prefix+issue.ExamplePath(), issue.Message)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestServiceCreateRejectsFailingExamples(t *testing.T) {
+	t.Parallel()
+
+	svc := New(newFakeRepository(), nil, nil)
+	_, err := svc.Create(context.Background(), requesttrace.Anonymous("test"), CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"x-examples":[{"name":"Ace"},{"name":7}]}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: uuid.New(),
+	})
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "schemaDefinition.x-examples[1].name")
+}
+
+func TestServiceActivateVerifiesStoredExamples(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	svc := New(repo, nil, nil)
+	audit := requesttrace.Anonymous("test")
+
+	created, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"x-examples":[{"name":"Ace"}]}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	result, err := svc.VerifyExamples(context.Background(), audit, created.SchemaID, created.Version)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.ExampleCount)
+	require.Empty(t, result.Errors)
+
+	// Simulate a version stored before examples were verified.
+	stale := persistence.SemanticVersion{Major: 0, Minor: 9, Patch: 0}
+	repo.records[created.SchemaID][stale.String()] = persistence.SchemaRecord{
+		SchemaID:         created.SchemaID,
+		SchemaVersion:    stale,
+		SchemaDefinition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"x-examples":[{"name":7}]}`),
+		TableName:        "cards_entities",
+		Slug:             "cards",
+		Status:           persistence.SchemaStatusPublished,
+	}
+
+	result, err = svc.VerifyExamples(context.Background(), audit, created.SchemaID, stale)
+	require.NoError(t, err)
+	require.Contains(t, result.Errors, "x-examples[0].name")
+
+	_, err = svc.Activate(context.Background(), audit, created.SchemaID, stale)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	active, err := svc.GetActive(context.Background(), audit, created.SchemaID)
+	require.NoError(t, err)
+	require.Equal(t, created.Version, active.Version)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrSpacesUnavailable is returned by MigrateIndexes when the service was built without a SpaceLister.
+var ErrSpacesUnavailable = errors.New("tenant spaces are not available")
+
+// SpaceLister enumerates the tenant spaces whose entity tables activation creates and MigrateIndexes updates.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// IndexMigrationReport summarises an index migration of a schema's entity tables across tenants.
+type IndexMigrationReport struct {
+	SchemaID      uuid.UUID
+	Version       persistence.SemanticVersion
+	IndexedFields []string
+	DryRun        bool
+	Tenants       []persistence.EntityIndexMigration
+}
+
+// MigrateIndexes aligns the payload indexes of the schema's entity table in every active tenant with the x-indexed
+// fields of the active version. progress, when set, is called after each tenant.
+func (s *service) MigrateIndexes(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, dryRun bool, progress func(persistence.EntityIndexMigration)) (IndexMigrationReport, error) { //nolint:revive
+	if schemaID == uuid.Nil {
+		return IndexMigrationReport{}, ErrNotFound
+	}
+	if s.spaces == nil {
+		return IndexMigrationReport{}, ErrSpacesUnavailable
+	}
+
+	active, err := s.repo.GetActive(ctx, schemaID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return IndexMigrationReport{}, ErrNotFound
+		}
+		return IndexMigrationReport{}, err
+	}
+
+	fields, err := persistence.IndexedFields(active.SchemaDefinition)
+	if err != nil {
+		return IndexMigrationReport{}, err
+	}
+
+	spaces, err := s.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return IndexMigrationReport{}, err
+	}
+
+	tenants, err := s.repo.MigrateIndexes(ctx, active, spaces, dryRun, progress)
+	if err != nil {
+		return IndexMigrationReport{}, err
+	}
+
+	report := IndexMigrationReport{
+		SchemaID:      schemaID,
+		Version:       active.SchemaVersion,
+		IndexedFields: make([]string, 0, len(fields)),
+		DryRun:        dryRun,
+		Tenants:       tenants,
+	}
+	for _, field := range fields {
+		report.IndexedFields = append(report.IndexedFields, strings.Join(field.Path, "."))
+	}
+	return report, nil
+}
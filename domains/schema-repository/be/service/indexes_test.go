@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type fakeSpaceLister []tenant.Space
+
+func (f fakeSpaceLister) ListActiveSpaces(context.Context) ([]tenant.Space, error) {
+	return f, nil
+}
+
+func TestServiceMigrateIndexesReportsEveryTenant(t *testing.T) {
+	t.Parallel()
+
+	spaces := fakeSpaceLister{
+		{TenantID: uuid.New(), Slug: "acme", SchemaName: "tenant_acme"},
+		{TenantID: uuid.New(), Slug: "globex", SchemaName: "tenant_globex"},
+	}
+	svc := New(newFakeRepository(), spaces, nil)
+	audit := requesttrace.Anonymous("test")
+
+	created, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string","x-indexed":true}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	var seen []string
+	report, err := svc.MigrateIndexes(context.Background(), audit, created.SchemaID, true, func(result persistence.EntityIndexMigration) {
+		seen = append(seen, result.TenantSlug)
+	})
+	require.NoError(t, err)
+	require.True(t, report.DryRun)
+	require.Equal(t, created.Version, report.Version)
+	require.Equal(t, []string{"name"}, report.IndexedFields)
+	require.Len(t, report.Tenants, 2)
+	require.Equal(t, []string{"acme", "globex"}, seen)
+}
+
+func TestServiceMigrateIndexesRequiresSpaces(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(newFakeRepository(), nil, nil).MigrateIndexes(context.Background(), requesttrace.Anonymous("test"), uuid.New(), false, nil)
+	require.ErrorIs(t, err, ErrSpacesUnavailable)
+}
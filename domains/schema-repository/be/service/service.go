@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 
 	domainrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
 )
@@ -29,10 +30,40 @@ func (v *ValidationError) Error() string {
 	return "validation error"
 }
 
+// InUseError is returned by Delete when the version is active or still referenced by entity rows and
+// force was not requested.
+type InUseError struct {
+	Active      bool
+	EntityCount int64
+	TableCount  int
+}
+
+func (e *InUseError) Error() string {
+	if e.Active {
+		return fmt.Sprintf("schema version is active and referenced by %d entity row(s) in %d table(s)", e.EntityCount, e.TableCount)
+	}
+	return fmt.Sprintf("schema version is referenced by %d entity row(s) in %d table(s)", e.EntityCount, e.TableCount)
+}
+
+// Fields reports the blocking counts for problem responses.
+func (e *InUseError) Fields() FieldErrors {
+	fields := FieldErrors{}
+	if e.Active {
+		addFieldError(fields, "isActive", "schema version is the active version; activate another version first or pass force")
+	}
+	if e.EntityCount > 0 {
+		addFieldError(fields, "entities", fmt.Sprintf("%d entity row(s) in %d table(s) reference this version", e.EntityCount, e.TableCount))
+	}
+	return fields
+}
+
 // Domain-level error sentinel values.
 var (
-	ErrNotFound = errors.New("schema version not found")
-	ErrConflict = errors.New("schema version conflict")
+	ErrNotFound  = errors.New("schema version not found")
+	ErrConflict  = errors.New("schema version conflict")
+	ErrDraft     = errors.New("schema version is a draft")
+	ErrNotDraft  = errors.New("schema version is not a draft")
+	ErrSlugTaken = errors.New("schema slug is already in use")
 )
 
 // Schema represents a schema repository record managed by the domain service.
@@ -46,6 +77,21 @@ type Schema struct {
 	CreatedAt  time.Time
 	IsActive   bool
 	IsDeleted  bool
+	Status     string
+	// Compatibility is set when the version was checked against a previously active version.
+	Compatibility *persistence.SchemaCompatibility
+}
+
+// Deletion reports what Delete changed, or would change on a dry run. The entity rows pinned to the version keep
+// their data but no longer resolve to a live version.
+type Deletion struct {
+	SchemaID    uuid.UUID
+	Version     persistence.SemanticVersion
+	TableName   string
+	WasActive   bool
+	EntityCount int64
+	TableCount  int
+	DryRun      bool
 }
 
 // CreateInput defines the payload required to register a schema version.
@@ -56,6 +102,10 @@ type CreateInput struct {
 	TableName  string
 	Slug       string
 	CategoryID uuid.UUID
+	// CompatibilityMode defaults to CompatibilityModeAnnotate when empty.
+	CompatibilityMode CompatibilityMode
+	// Status defaults to persistence.SchemaStatusPublished. Drafts are stored inactive.
+	Status string
 }
 
 // Service exposes schema repository operations.
@@ -66,25 +116,46 @@ type Service interface {
 	Get(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) (Schema, error)
 	GetActive(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID) (Schema, error)
 	Activate(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) (Schema, error)
-	Delete(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) error
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion, force, dryRun bool) (Deletion, error)
+	Diff(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, from, to persistence.SemanticVersion) (SchemaDiff, error)
+	Publish(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) (Schema, error)
+	ValidatePayload(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion, payload json.RawMessage) (FieldErrors, error)
+	VerifyExamples(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) (ExamplesResult, error)
+	GetSlugs(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID) (SlugInfo, error)
+	RenameSlug(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, slug string) (SlugInfo, error)
+	MigrateIndexes(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, dryRun bool, progress func(persistence.EntityIndexMigration)) (IndexMigrationReport, error)
+	ExportBundle(ctx context.Context, audit requesttrace.AuditInfo, schemaIDs []uuid.UUID) (persistence.SchemaBundle, error)
+	ImportBundle(ctx context.Context, audit requesttrace.AuditInfo, bundle persistence.SchemaBundle, dryRun bool) (persistence.SchemaBundleReport, error)
+	// RunEntityTables is the jobs.Handler of EntityTablesJobKind: it creates the entity table named by the job in
+	// every active tenant.
+	RunEntityTables(ctx context.Context, job jobs.Job) (any, error)
 }
 
 type service struct {
-	repo domainrepo.Repository
-	now  func() time.Time
+	repo      domainrepo.Repository
+	spaces    SpaceLister
+	queue     JobQueue
+	validator *persistence.SchemaValidator
+	now       func() time.Time
 }
 
 var tableNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
 
-// New builds a schema repository Service backed by the provided repository.
-func New(repo domainrepo.Repository) Service {
+// New builds a schema repository Service backed by the provided repository. spaces may be nil, in which case
+// MigrateIndexes returns ErrSpacesUnavailable and activating a version leaves its entity tables to the tenant
+// migrations. With a queue, activation queues an EntityTablesJobKind job for the tables instead of creating them
+// within the request; register RunEntityTables for the kind on the job pool.
+func New(repo domainrepo.Repository, spaces SpaceLister, queue JobQueue) Service {
 	if repo == nil {
 		panic("schema repository repo is required")
 	}
 
 	return &service{
-		repo: repo,
-		now:  func() time.Time { return time.Now().UTC() },
+		repo:      repo,
+		spaces:    spaces,
+		queue:     queue,
+		validator: persistence.NewSchemaValidator(),
+		now:       func() time.Time { return time.Now().UTC() },
 	}
 }
 
@@ -98,15 +169,22 @@ func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, inpu
 	if err != nil {
 		return Schema{}, err
 	}
+	if input.SchemaID == nil && len(existingRecords) > 0 {
+		// The slug may be an alias of a renamed schema; new versions keep its canonical slug.
+		normalized.slug = existingRecords[0].Slug
+	}
 
 	version, err := s.resolveVersion(existingRecords, input.Version)
 	if err != nil {
 		return Schema{}, err
 	}
 
-	if _, err := s.repo.GetByVersion(ctx, schemaID, version); err == nil {
-		return Schema{}, ErrConflict
-	} else if err != nil && !errors.Is(err, persistence.ErrSchemaNotFound) {
+	// Drafts are rewritten in place; every other existing version is immutable.
+	if existing, err := s.repo.GetByVersion(ctx, schemaID, version); err == nil {
+		if existing.IsDeleted || existing.Status != persistence.SchemaStatusDraft {
+			return Schema{}, ErrConflict
+		}
+	} else if !errors.Is(err, persistence.ErrSchemaNotFound) {
 		return Schema{}, err
 	}
 
@@ -114,15 +192,34 @@ func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, inpu
 		return Schema{}, err
 	}
 
+	compatibility, err := checkCompatibility(existingRecords, input.Definition, normalized.compatibilityMode)
+	if err != nil {
+		return Schema{}, err
+	}
+
 	params := persistence.CreateSchemaParams{
-		SchemaID:   schemaID,
-		Version:    version,
-		Definition: cloneRawMessage(input.Definition),
-		TableName:  normalized.tableName,
-		Slug:       normalized.slug,
-		CategoryID: input.CategoryID,
-		Activate:   true,
-		CreatedBy:  audit.UserID,
+		SchemaID:      schemaID,
+		Version:       version,
+		Definition:    cloneRawMessage(input.Definition),
+		TableName:     normalized.tableName,
+		Slug:          normalized.slug,
+		CategoryID:    input.CategoryID,
+		Activate:      normalized.status == persistence.SchemaStatusPublished,
+		CreatedBy:     audit.UserID,
+		Compatibility: compatibility,
+		Status:        normalized.status,
+	}
+
+	if params.Activate {
+		err := s.ensureEntityTables(ctx, persistence.SchemaRecord{
+			SchemaID:         schemaID,
+			SchemaVersion:    version,
+			SchemaDefinition: params.Definition,
+			TableName:        params.TableName,
+		})
+		if err != nil {
+			return Schema{}, err
+		}
 	}
 
 	record, err := s.repo.Upsert(ctx, params)
@@ -211,9 +308,23 @@ func (s *service) Activate(ctx context.Context, audit requesttrace.AuditInfo, sc
 		return Schema{}, ErrNotFound
 	}
 
+	target, err := s.verifyStoredExamples(ctx, schemaID, version)
+	if err != nil {
+		return Schema{}, err
+	}
+	if target.Status == persistence.SchemaStatusDraft {
+		return Schema{}, ErrDraft
+	}
+	if err := s.ensureEntityTables(ctx, target); err != nil {
+		return Schema{}, err
+	}
+
 	if err := s.repo.Activate(ctx, schemaID, version); err != nil {
-		if errors.Is(err, persistence.ErrSchemaNotFound) {
+		switch {
+		case errors.Is(err, persistence.ErrSchemaNotFound):
 			return Schema{}, ErrNotFound
+		case errors.Is(err, persistence.ErrSchemaVersionDraft):
+			return Schema{}, ErrDraft
 		}
 		return Schema{}, err
 	}
@@ -229,24 +340,41 @@ func (s *service) Activate(ctx context.Context, audit requesttrace.AuditInfo, sc
 	return mapRecord(record), nil
 }
 
-func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion) error { //nolint:revive
+// Delete soft deletes a schema version. With dryRun the delete runs, including the in-use checks, and is rolled
+// back, so the report is exactly what a real run changes.
+func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, version persistence.SemanticVersion, force, dryRun bool) (Deletion, error) { //nolint:revive
 	if schemaID == uuid.Nil {
-		return ErrNotFound
+		return Deletion{}, ErrNotFound
 	}
 
-	if err := s.repo.Delete(ctx, schemaID, version, s.now()); err != nil {
-		if errors.Is(err, persistence.ErrSchemaNotFound) {
-			return ErrNotFound
+	deletion, err := s.repo.Delete(ctx, schemaID, version, s.now(), force, dryRun)
+	if err != nil {
+		var inUseErr *persistence.SchemaVersionInUseError
+		switch {
+		case errors.Is(err, persistence.ErrSchemaNotFound):
+			return Deletion{}, ErrNotFound
+		case errors.As(err, &inUseErr):
+			return Deletion{}, &InUseError{Active: inUseErr.Active, EntityCount: inUseErr.EntityCount, TableCount: inUseErr.TableCount}
 		}
-		return err
-	}
-
-	return nil
+		return Deletion{}, err
+	}
+
+	return Deletion{
+		SchemaID:    schemaID,
+		Version:     version,
+		TableName:   deletion.TableName,
+		WasActive:   deletion.WasActive,
+		EntityCount: deletion.EntityCount,
+		TableCount:  deletion.TableCount,
+		DryRun:      dryRun,
+	}, nil
 }
 
 type normalizedCreateInput struct {
-	slug      string
-	tableName string
+	slug              string
+	tableName         string
+	compatibilityMode CompatibilityMode
+	status            string
 }
 
 func (s *service) validateCreateInput(input CreateInput) (normalizedCreateInput, error) {
@@ -282,6 +410,34 @@ func (s *service) validateCreateInput(input CreateInput) (normalizedCreateInput,
 		addFieldError(fieldErrors, "schemaDefinition", "schemaDefinition is required")
 	} else if !isJSONObject(input.Definition) {
 		addFieldError(fieldErrors, "schemaDefinition", "schemaDefinition must be a JSON object")
+	} else if err := persistence.ValidateSchemaDefinition(input.Definition); err != nil {
+		var definitionErr *persistence.InvalidSchemaDefinitionError
+		if !errors.As(err, &definitionErr) {
+			return normalizedCreateInput{}, err
+		}
+		for _, issue := range definitionErr.Issues {
+			addFieldError(fieldErrors, definitionField(issue.Pointer), issue.Message)
+		}
+	} else if err := addExampleErrors(fieldErrors, "schemaDefinition.", input.Definition); err != nil {
+		return normalizedCreateInput{}, err
+	}
+
+	switch input.CompatibilityMode {
+	case "":
+		normalized.compatibilityMode = CompatibilityModeAnnotate
+	case CompatibilityModeNone, CompatibilityModeAnnotate, CompatibilityModeEnforce:
+		normalized.compatibilityMode = input.CompatibilityMode
+	default:
+		addFieldError(fieldErrors, "compatibilityMode", "compatibilityMode must be one of none, annotate, enforce")
+	}
+
+	switch input.Status {
+	case "":
+		normalized.status = persistence.SchemaStatusPublished
+	case persistence.SchemaStatusDraft, persistence.SchemaStatusPublished:
+		normalized.status = input.Status
+	default:
+		addFieldError(fieldErrors, "status", "status must be one of draft, published")
 	}
 
 	if len(fieldErrors) > 0 {
@@ -335,14 +491,19 @@ func (s *service) resolveVersion(existing []persistence.SchemaRecord, requested
 		return persistence.SemanticVersion{Major: 1, Minor: 0, Patch: 0}, nil
 	}
 
-	maxVersion := existing[0].SchemaVersion
+	latest := existing[0]
 	for _, record := range existing[1:] {
-		if record.SchemaVersion.Compare(maxVersion) > 0 {
-			maxVersion = record.SchemaVersion
+		if record.SchemaVersion.Compare(latest.SchemaVersion) > 0 {
+			latest = record
 		}
 	}
 
-	return maxVersion.NextPatch(), nil
+	// Keep iterating on an unpublished draft instead of allocating a new version for every revision.
+	if latest.Status == persistence.SchemaStatusDraft && !latest.IsDeleted {
+		return latest.SchemaVersion, nil
+	}
+
+	return latest.SchemaVersion.NextPatch(), nil
 }
 
 func (s *service) ensureSchemaConsistency(existing []persistence.SchemaRecord, normalized normalizedCreateInput) error {
@@ -408,6 +569,19 @@ func addFieldError(m FieldErrors, field, message string) {
 	m[field] = append(m[field], message)
 }
 
+// definitionField renders a JSON pointer into the definition as a dotted field name,
+// e.g. "/properties/name/type" becomes "schemaDefinition.properties.name.type".
+func definitionField(pointer string) string {
+	if pointer == "" {
+		return "schemaDefinition"
+	}
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	return "schemaDefinition." + strings.Join(segments, ".")
+}
+
 func isJSONObject(raw json.RawMessage) bool {
 	var payload any
 	if err := json.Unmarshal(raw, &payload); err != nil {
@@ -419,15 +593,17 @@ func isJSONObject(raw json.RawMessage) bool {
 
 func mapRecord(record persistence.SchemaRecord) Schema {
 	return Schema{
-		SchemaID:   record.SchemaID,
-		Version:    record.SchemaVersion,
-		Definition: cloneRawMessage(record.SchemaDefinition),
-		TableName:  record.TableName,
-		Slug:       record.Slug,
-		CategoryID: record.CategoryID,
-		CreatedAt:  record.CreatedAt,
-		IsActive:   record.IsActive,
-		IsDeleted:  record.IsDeleted,
+		SchemaID:      record.SchemaID,
+		Version:       record.SchemaVersion,
+		Definition:    cloneRawMessage(record.SchemaDefinition),
+		TableName:     record.TableName,
+		Slug:          record.Slug,
+		CategoryID:    record.CategoryID,
+		CreatedAt:     record.CreatedAt,
+		IsActive:      record.IsActive,
+		IsDeleted:     record.IsDeleted,
+		Status:        record.Status,
+		Compatibility: record.Compatibility,
 	}
 }
 
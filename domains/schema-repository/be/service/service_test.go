@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	domainrepo "github.com/zenGate-Global/palmyra-pro-saas/domains/schema-repository/be/repo"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 func TestServiceCreateSuccess(t *testing.T) {
@@ -19,7 +21,7 @@ func TestServiceCreateSuccess(t *testing.T) {
 
 	repo := newFakeRepository()
 	audit := requesttrace.Anonymous("test")
-	svc := New(repo)
+	svc := New(repo, nil, nil)
 
 	categoryID := uuid.New()
 
@@ -40,7 +42,7 @@ func TestServiceCreateConflict(t *testing.T) {
 	t.Parallel()
 
 	repo := newFakeRepository()
-	svc := New(repo)
+	svc := New(repo, nil, nil)
 	audit := requesttrace.Anonymous("test")
 
 	initial, err := svc.Create(context.Background(), audit, CreateInput{
@@ -68,7 +70,7 @@ func TestServiceCreateWithExplicitSchemaIDWhenMissing(t *testing.T) {
 	repo := newFakeRepository()
 	schemaID := uuid.MustParse("00000000-0000-4000-8000-0000000000aa")
 
-	svc := New(repo).(*service)
+	svc := New(repo, nil, nil).(*service)
 
 	audit := requesttrace.Anonymous("test")
 
@@ -89,7 +91,7 @@ func TestServiceCreateRejectsSlugChange(t *testing.T) {
 	t.Parallel()
 
 	repo := newFakeRepository()
-	svc := New(repo)
+	svc := New(repo, nil, nil)
 	audit := requesttrace.Anonymous("test")
 
 	result, err := svc.Create(context.Background(), audit, CreateInput{
@@ -117,7 +119,7 @@ func TestServiceListFiltersDeleted(t *testing.T) {
 	t.Parallel()
 
 	repo := newFakeRepository()
-	svc := New(repo)
+	svc := New(repo, nil, nil)
 	audit := requesttrace.Anonymous("test")
 
 	first, err := svc.Create(context.Background(), audit, CreateInput{
@@ -128,7 +130,8 @@ func TestServiceListFiltersDeleted(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	require.NoError(t, svc.Delete(context.Background(), audit, first.SchemaID, first.Version))
+	_, err = svc.Delete(context.Background(), audit, first.SchemaID, first.Version, true, false)
+	require.NoError(t, err)
 
 	second, err := svc.Create(context.Background(), audit, CreateInput{
 		SchemaID:   uuidPtr(first.SchemaID),
@@ -153,7 +156,7 @@ func TestServiceListAll(t *testing.T) {
 	t.Parallel()
 
 	repo := newFakeRepository()
-	svc := New(repo)
+	svc := New(repo, nil, nil)
 	audit := requesttrace.Anonymous("test")
 
 	first, err := svc.Create(context.Background(), audit, CreateInput{
@@ -176,7 +179,8 @@ func TestServiceListAll(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, all, 2)
 
-	require.NoError(t, svc.Delete(context.Background(), audit, first.SchemaID, first.Version))
+	_, err = svc.Delete(context.Background(), audit, first.SchemaID, first.Version, true, false)
+	require.NoError(t, err)
 
 	activeOnly, err := svc.ListAll(context.Background(), audit, false)
 	require.NoError(t, err)
@@ -191,7 +195,7 @@ func TestServiceActivateSwitchesActiveVersion(t *testing.T) {
 	t.Parallel()
 
 	repo := newFakeRepository()
-	svc := New(repo)
+	svc := New(repo, nil, nil)
 	audit := requesttrace.Anonymous("test")
 
 	createdV1, err := svc.Create(context.Background(), audit, CreateInput{
@@ -220,14 +224,112 @@ func TestServiceActivateSwitchesActiveVersion(t *testing.T) {
 	require.False(t, fetchedV1.IsActive)
 }
 
+func TestServiceActivationCreatesEntityTables(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	spaces := fakeSpaceLister{{Slug: "acme"}, {Slug: "globex"}}
+	svc := New(repo, spaces, nil)
+	audit := requesttrace.Anonymous("test")
+
+	created, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"title":"schema-v1"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards-schema",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"acme.cards_entities", "globex.cards_entities"}, repo.tables)
+
+	repo.tables = nil
+	draft, err := svc.Create(context.Background(), audit, CreateInput{
+		SchemaID:   uuidPtr(created.SchemaID),
+		Definition: json.RawMessage(`{"title":"schema-v2"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards-schema",
+		CategoryID: created.CategoryID,
+		Status:     persistence.SchemaStatusDraft,
+	})
+	require.NoError(t, err)
+	require.Empty(t, repo.tables, "drafts are never active")
+
+	_, err = svc.Activate(context.Background(), audit, created.SchemaID, draft.Version)
+	require.ErrorIs(t, err, ErrDraft)
+	require.Empty(t, repo.tables)
+
+	_, err = svc.Publish(context.Background(), audit, created.SchemaID, draft.Version)
+	require.NoError(t, err)
+	require.Len(t, repo.tables, 2)
+
+	_, err = svc.Activate(context.Background(), audit, created.SchemaID, created.Version)
+	require.NoError(t, err)
+	require.Len(t, repo.tables, 4)
+}
+
+func TestServiceActivationFailsWithoutEntityTables(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	repo.tablesErr = errors.New("tenant acme: permission denied")
+	svc := New(repo, fakeSpaceLister{{Slug: "acme"}}, nil)
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"title":"schema-v1"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards-schema",
+		CategoryID: uuid.New(),
+	})
+	require.ErrorIs(t, err, repo.tablesErr)
+
+	schemas, err := svc.ListAll(context.Background(), audit, true)
+	require.NoError(t, err)
+	require.Empty(t, schemas, "the version is not stored when its tables cannot be created")
+}
+
 func TestServiceDeleteNotFound(t *testing.T) {
 	t.Parallel()
 
 	repo := newFakeRepository()
-	svc := New(repo)
+	svc := New(repo, nil, nil)
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Delete(context.Background(), audit, uuid.New(), persistence.SemanticVersion{Major: 1, Minor: 0, Patch: 0}, false, false)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestServiceDeleteRefusesActiveVersion(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	svc := New(repo, nil, nil)
 	audit := requesttrace.Anonymous("test")
 
-	err := svc.Delete(context.Background(), audit, uuid.New(), persistence.SemanticVersion{Major: 1, Minor: 0, Patch: 0})
+	created, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"title":"schema-v1"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards-schema",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Delete(context.Background(), audit, created.SchemaID, created.Version, false, true)
+	var inUseErr *InUseError
+	require.ErrorAs(t, err, &inUseErr, "a dry run refuses what a real run refuses")
+	require.True(t, inUseErr.Active)
+
+	deletion, err := svc.Delete(context.Background(), audit, created.SchemaID, created.Version, true, true)
+	require.NoError(t, err)
+	require.True(t, deletion.DryRun)
+	require.True(t, deletion.WasActive)
+	require.Equal(t, "cards_entities", deletion.TableName)
+	_, err = svc.Get(context.Background(), audit, created.SchemaID, created.Version)
+	require.NoError(t, err)
+
+	deletion, err = svc.Delete(context.Background(), audit, created.SchemaID, created.Version, true, false)
+	require.NoError(t, err)
+	require.False(t, deletion.DryRun)
+	_, err = svc.Get(context.Background(), audit, created.SchemaID, created.Version)
 	require.ErrorIs(t, err, ErrNotFound)
 }
 
@@ -239,12 +341,18 @@ func extractTitle(t *testing.T, raw json.RawMessage) string {
 }
 
 type fakeRepository struct {
-	records map[uuid.UUID]map[string]persistence.SchemaRecord
+	records         map[uuid.UUID]map[string]persistence.SchemaRecord
+	aliases         map[string]uuid.UUID
+	bundleConflicts []persistence.SchemaBundleConflict
+	// tables records the entity tables ensured, as "<tenant slug>.<table>"; tablesErr fails EnsureEntityTables.
+	tables    []string
+	tablesErr error
 }
 
 func newFakeRepository() *fakeRepository {
 	return &fakeRepository{
 		records: make(map[uuid.UUID]map[string]persistence.SchemaRecord),
+		aliases: make(map[string]uuid.UUID),
 	}
 }
 
@@ -265,6 +373,8 @@ func (f *fakeRepository) Upsert(ctx context.Context, params persistence.CreateSc
 		record.CategoryID = params.CategoryID
 		record.Slug = params.Slug
 		record.TableName = params.TableName
+		record.Compatibility = params.Compatibility
+		record.Status = params.Status
 		record.IsDeleted = false
 		if params.Activate {
 			f.deactivateAll(params.SchemaID)
@@ -288,6 +398,8 @@ func (f *fakeRepository) Upsert(ctx context.Context, params persistence.CreateSc
 		CreatedAt:        now,
 		IsActive:         params.Activate,
 		IsDeleted:        false,
+		Compatibility:    params.Compatibility,
+		Status:           params.Status,
 	}
 
 	schemaMap[versionKey] = record
@@ -357,7 +469,7 @@ func (f *fakeRepository) GetLatestBySlug(ctx context.Context, slug string) (pers
 	var latest *persistence.SchemaRecord
 	for _, schemaMap := range f.records {
 		for _, record := range schemaMap {
-			if record.Slug != slug || record.IsDeleted {
+			if (record.Slug != slug && f.aliases[slug] != record.SchemaID) || record.IsDeleted {
 				continue
 			}
 			if latest == nil || record.CreatedAt.After(latest.CreatedAt) {
@@ -372,6 +484,49 @@ func (f *fakeRepository) GetLatestBySlug(ctx context.Context, slug string) (pers
 	return *latest, nil
 }
 
+func (f *fakeRepository) RenameSlug(ctx context.Context, schemaID uuid.UUID, slug string, createdBy *string) (string, error) {
+	schemaMap, ok := f.records[schemaID]
+	if !ok {
+		return "", persistence.ErrSchemaNotFound
+	}
+
+	var previous string
+	for _, record := range schemaMap {
+		previous = record.Slug
+	}
+	if previous == slug {
+		return previous, nil
+	}
+	for id, other := range f.records {
+		for _, record := range other {
+			if id != schemaID && record.Slug == slug {
+				return "", persistence.ErrSchemaSlugTaken
+			}
+		}
+	}
+	if owner, ok := f.aliases[slug]; ok && owner != schemaID {
+		return "", persistence.ErrSchemaSlugTaken
+	}
+
+	delete(f.aliases, slug)
+	f.aliases[previous] = schemaID
+	for key, record := range schemaMap {
+		record.Slug = slug
+		schemaMap[key] = record
+	}
+	return previous, nil
+}
+
+func (f *fakeRepository) ListSlugAliases(ctx context.Context, schemaID uuid.UUID) ([]persistence.SchemaSlugAlias, error) {
+	var aliases []persistence.SchemaSlugAlias
+	for slug, owner := range f.aliases {
+		if owner == schemaID {
+			aliases = append(aliases, persistence.SchemaSlugAlias{Slug: slug, SchemaID: owner})
+		}
+	}
+	return aliases, nil
+}
+
 func (f *fakeRepository) Activate(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) error {
 	schemaMap, ok := f.records[schemaID]
 	if !ok {
@@ -382,6 +537,9 @@ func (f *fakeRepository) Activate(ctx context.Context, schemaID uuid.UUID, versi
 	if !ok || record.IsDeleted {
 		return persistence.ErrSchemaNotFound
 	}
+	if record.Status == persistence.SchemaStatusDraft {
+		return persistence.ErrSchemaVersionDraft
+	}
 
 	f.deactivateAll(schemaID)
 
@@ -391,20 +549,75 @@ func (f *fakeRepository) Activate(ctx context.Context, schemaID uuid.UUID, versi
 	return nil
 }
 
-func (f *fakeRepository) Delete(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion, deletedAt time.Time) error {
+func (f *fakeRepository) Publish(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion) error {
+	record, ok := f.records[schemaID][version.String()]
+	if !ok || record.IsDeleted {
+		return persistence.ErrSchemaNotFound
+	}
+	if record.Status != persistence.SchemaStatusDraft {
+		return persistence.ErrSchemaVersionPublished
+	}
+
+	record.Status = persistence.SchemaStatusPublished
+	f.records[schemaID][version.String()] = record
+	return f.Activate(ctx, schemaID, version)
+}
+
+func (f *fakeRepository) Delete(ctx context.Context, schemaID uuid.UUID, version persistence.SemanticVersion, deletedAt time.Time, force, dryRun bool) (persistence.SchemaVersionDeletion, error) {
 	schemaMap, ok := f.records[schemaID]
 	if !ok {
-		return persistence.ErrSchemaNotFound
+		return persistence.SchemaVersionDeletion{}, persistence.ErrSchemaNotFound
 	}
 
 	record, ok := schemaMap[version.String()]
 	if !ok || record.IsDeleted {
-		return persistence.ErrSchemaNotFound
+		return persistence.SchemaVersionDeletion{}, persistence.ErrSchemaNotFound
+	}
+
+	if record.IsActive && !force {
+		return persistence.SchemaVersionDeletion{}, &persistence.SchemaVersionInUseError{Active: true}
 	}
 
+	deletion := persistence.SchemaVersionDeletion{TableName: record.TableName, WasActive: record.IsActive}
+	if dryRun {
+		return deletion, nil
+	}
 	record.IsActive = false
 	record.IsDeleted = true
 	schemaMap[version.String()] = record
+	return deletion, nil
+}
+
+func (f *fakeRepository) ExportBundle(ctx context.Context, schemaIDs []uuid.UUID) (persistence.SchemaBundle, error) {
+	return persistence.SchemaBundle{FormatVersion: persistence.SchemaBundleFormatVersion}, nil
+}
+
+func (f *fakeRepository) ImportBundle(ctx context.Context, bundle persistence.SchemaBundle, dryRun bool) (persistence.SchemaBundleReport, error) {
+	if f.bundleConflicts != nil {
+		return persistence.SchemaBundleReport{Conflicts: f.bundleConflicts}, persistence.ErrSchemaBundleConflict
+	}
+	return persistence.SchemaBundleReport{Applied: !dryRun}, nil
+}
+
+func (f *fakeRepository) MigrateIndexes(ctx context.Context, schema persistence.SchemaRecord, spaces []tenant.Space, dryRun bool, progress func(persistence.EntityIndexMigration)) ([]persistence.EntityIndexMigration, error) {
+	results := make([]persistence.EntityIndexMigration, 0, len(spaces))
+	for _, space := range spaces {
+		result := persistence.EntityIndexMigration{TenantID: space.TenantID, TenantSlug: space.Slug, SchemaName: space.SchemaName}
+		if progress != nil {
+			progress(result)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (f *fakeRepository) EnsureEntityTables(ctx context.Context, schema persistence.SchemaRecord, spaces []tenant.Space) error {
+	if f.tablesErr != nil {
+		return f.tablesErr
+	}
+	for _, space := range spaces {
+		f.tables = append(f.tables, space.Slug+"."+schema.TableName)
+	}
 	return nil
 }
 
@@ -430,3 +643,21 @@ var _ domainrepo.Repository = (*fakeRepository)(nil)
 func versionPtr(v persistence.SemanticVersion) *persistence.SemanticVersion {
 	return &v
 }
+
+func TestServiceCreateRejectsMalformedDefinition(t *testing.T) {
+	t.Parallel()
+
+	svc := New(newFakeRepository(), nil, nil)
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"text"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: uuid.New(),
+	})
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "schemaDefinition.properties.name.type")
+}
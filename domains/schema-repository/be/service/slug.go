@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// SlugInfo is the canonical slug of a schema together with the previous slugs that still resolve to it.
+type SlugInfo struct {
+	SchemaID uuid.UUID
+	Slug     string
+	Aliases  []persistence.SchemaSlugAlias
+}
+
+func (s *service) GetSlugs(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID) (SlugInfo, error) { //nolint:revive
+	if schemaID == uuid.Nil {
+		return SlugInfo{}, ErrNotFound
+	}
+
+	records, err := s.repo.List(ctx, schemaID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrSchemaNotFound) {
+			return SlugInfo{}, ErrNotFound
+		}
+		return SlugInfo{}, err
+	}
+	if len(records) == 0 {
+		return SlugInfo{}, ErrNotFound
+	}
+
+	aliases, err := s.repo.ListSlugAliases(ctx, schemaID)
+	if err != nil {
+		return SlugInfo{}, err
+	}
+
+	return SlugInfo{SchemaID: schemaID, Slug: records[0].Slug, Aliases: aliases}, nil
+}
+
+// RenameSlug makes slug the canonical slug of every version of the schema. The previous slug becomes an alias,
+// so lookups by it keep resolving to the schema.
+func (s *service) RenameSlug(ctx context.Context, audit requesttrace.AuditInfo, schemaID uuid.UUID, slug string) (SlugInfo, error) {
+	if schemaID == uuid.Nil {
+		return SlugInfo{}, ErrNotFound
+	}
+
+	normalized, err := persistence.NormalizeSlug(slug)
+	if err != nil {
+		return SlugInfo{}, &ValidationError{Fields: FieldErrors{"slug": {err.Error()}}}
+	}
+
+	if _, err := s.repo.RenameSlug(ctx, schemaID, normalized, audit.UserID); err != nil {
+		switch {
+		case errors.Is(err, persistence.ErrSchemaNotFound):
+			return SlugInfo{}, ErrNotFound
+		case errors.Is(err, persistence.ErrSchemaSlugTaken):
+			return SlugInfo{}, ErrSlugTaken
+		}
+		return SlugInfo{}, err
+	}
+
+	return s.GetSlugs(ctx, audit, schemaID)
+}
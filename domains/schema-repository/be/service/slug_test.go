@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func TestServiceRenameSlugKeepsAlias(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	svc := New(repo, nil, nil)
+	audit := requesttrace.Anonymous("test")
+	categoryID := uuid.New()
+
+	created, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+
+	info, err := svc.RenameSlug(context.Background(), audit, created.SchemaID, "playing-cards")
+	require.NoError(t, err)
+	require.Equal(t, "playing-cards", info.Slug)
+	require.Len(t, info.Aliases, 1)
+	require.Equal(t, "cards", info.Aliases[0].Slug)
+
+	// Registering a version under the old slug resolves the renamed schema.
+	next, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}}}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: categoryID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.SchemaID, next.SchemaID)
+	require.Equal(t, "playing-cards", next.Slug)
+}
+
+func TestServiceRenameSlugRejectsTakenSlug(t *testing.T) {
+	t.Parallel()
+
+	svc := New(newFakeRepository(), nil, nil)
+	audit := requesttrace.Anonymous("test")
+
+	cards, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+	_, err = svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"type":"object"}`),
+		TableName:  "decks_entities",
+		Slug:       "decks",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.RenameSlug(context.Background(), audit, cards.SchemaID, "decks")
+	require.ErrorIs(t, err, ErrSlugTaken)
+
+	_, err = svc.RenameSlug(context.Background(), audit, cards.SchemaID, "Not A Slug")
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
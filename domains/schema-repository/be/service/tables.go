@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// EntityTablesJobKind is the kind of the platform job that creates the entity table of an activated version in every
+// active tenant. Tenants that fail do not stop the others; the job is retried and its last error names them.
+const EntityTablesJobKind = "schema-repository.entity-tables"
+
+// JobQueue queues the entity table jobs. *jobs.Queue implements it.
+type JobQueue interface {
+	Enqueue(ctx context.Context, request jobs.EnqueueRequest) (persistence.JobRecord, error)
+}
+
+// entityTablesJob is the payload of an EntityTablesJobKind job. It carries the definition, so the job does not
+// depend on the activation having committed when it runs.
+type entityTablesJob struct {
+	SchemaID      uuid.UUID       `json:"schemaId"`
+	SchemaVersion string          `json:"schemaVersion"`
+	TableName     string          `json:"tableName"`
+	Definition    json.RawMessage `json:"definition"`
+}
+
+// entityTablesResult is the result of a succeeded EntityTablesJobKind job.
+type entityTablesResult struct {
+	TableName string `json:"tableName"`
+	Tenants   int    `json:"tenants"`
+}
+
+// ensureEntityTables gets the entity table of a version about to become active created in every active tenant, with
+// the payload indexes it declares, so entity requests never create tables. With a queue the tables are left to an
+// EntityTablesJobKind job, so activation does not grow with the number of tenants; until it ran, writes to a new
+// table answer ErrEntityTableMissing. Without a SpaceLister the tables are left to the tenant migrations.
+func (s *service) ensureEntityTables(ctx context.Context, schema persistence.SchemaRecord) error {
+	if s.spaces == nil {
+		return nil
+	}
+	if s.queue != nil {
+		_, err := s.queue.Enqueue(ctx, jobs.EnqueueRequest{
+			Kind: EntityTablesJobKind,
+			Payload: entityTablesJob{
+				SchemaID:      schema.SchemaID,
+				SchemaVersion: schema.SchemaVersion.String(),
+				TableName:     schema.TableName,
+				Definition:    json.RawMessage(schema.SchemaDefinition),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("queue entity tables: %w", err)
+		}
+		return nil
+	}
+	if _, err := s.createEntityTables(ctx, schema); err != nil {
+		return err
+	}
+	return nil
+}
+
+// createEntityTables creates the entity table of schema in every active tenant and returns how many there are.
+func (s *service) createEntityTables(ctx context.Context, schema persistence.SchemaRecord) (int, error) {
+	spaces, err := s.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.repo.EnsureEntityTables(ctx, schema, spaces); err != nil {
+		return 0, fmt.Errorf("create entity tables: %w", err)
+	}
+	return len(spaces), nil
+}
+
+func (s *service) RunEntityTables(ctx context.Context, job jobs.Job) (any, error) {
+	if s.spaces == nil {
+		return nil, jobs.Permanent(ErrSpacesUnavailable)
+	}
+	var payload entityTablesJob
+	if err := job.Decode(&payload); err != nil {
+		return nil, err
+	}
+	version, err := persistence.ParseSemanticVersion(payload.SchemaVersion)
+	if err != nil {
+		return nil, jobs.Permanent(fmt.Errorf("decode %s job payload: %w", job.Kind, err))
+	}
+
+	tenants, err := s.createEntityTables(ctx, persistence.SchemaRecord{
+		SchemaID:         payload.SchemaID,
+		SchemaVersion:    version,
+		SchemaDefinition: persistence.SchemaDefinition(payload.Definition),
+		TableName:        payload.TableName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entityTablesResult{TableName: payload.TableName, Tenants: tenants}, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/jobs"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// fakeJobQueue keeps the queued jobs as the job store would hand them to a handler.
+type fakeJobQueue struct {
+	queued []jobs.Job
+}
+
+func (f *fakeJobQueue) Enqueue(_ context.Context, request jobs.EnqueueRequest) (persistence.JobRecord, error) {
+	payload, err := json.Marshal(request.Payload)
+	if err != nil {
+		return persistence.JobRecord{}, err
+	}
+	f.queued = append(f.queued, jobs.Job{ID: uuid.New(), Kind: request.Kind, Payload: payload, Attempt: 1})
+	return persistence.JobRecord{Kind: request.Kind, Payload: payload}, nil
+}
+
+func TestServiceActivationQueuesEntityTables(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRepository()
+	queue := &fakeJobQueue{}
+	svc := New(repo, fakeSpaceLister{{Slug: "acme"}, {Slug: "globex"}}, queue)
+	audit := requesttrace.Anonymous("test")
+
+	created, err := svc.Create(context.Background(), audit, CreateInput{
+		Definition: json.RawMessage(`{"title":"schema-v1"}`),
+		TableName:  "cards_entities",
+		Slug:       "cards-schema",
+		CategoryID: uuid.New(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, repo.tables, "the request leaves the tables to the job")
+	require.Len(t, queue.queued, 1)
+	require.Equal(t, EntityTablesJobKind, queue.queued[0].Kind)
+
+	result, err := svc.RunEntityTables(context.Background(), queue.queued[0])
+	require.NoError(t, err)
+	require.Equal(t, entityTablesResult{TableName: "cards_entities", Tenants: 2}, result)
+	require.Equal(t, []string{"acme.cards_entities", "globex.cards_entities"}, repo.tables)
+
+	_, err = svc.Activate(context.Background(), audit, created.SchemaID, created.Version)
+	require.NoError(t, err)
+	require.Len(t, queue.queued, 2)
+}
+
+func TestServiceRunEntityTables(t *testing.T) {
+	t.Parallel()
+
+	job := func(payload string) jobs.Job {
+		return jobs.Job{ID: uuid.New(), Kind: EntityTablesJobKind, Payload: json.RawMessage(payload), Attempt: 1}
+	}
+	valid := `{"schemaId":"` + uuid.NewString() + `","schemaVersion":"1.0.0","tableName":"cards_entities","definition":{"type":"object"}}`
+
+	tests := []struct {
+		name          string
+		spaces        SpaceLister
+		tablesErr     error
+		payload       string
+		wantErr       error
+		wantPermanent bool
+	}{
+		{
+			name:    "creates the table in every tenant",
+			spaces:  fakeSpaceLister{{Slug: "acme"}},
+			payload: valid,
+		},
+		{
+			name:      "failed tenants are retried",
+			spaces:    fakeSpaceLister{{Slug: "acme"}, {Slug: "globex"}},
+			tablesErr: errors.New("tenant globex: permission denied"),
+			payload:   valid,
+			wantErr:   errors.New("create entity tables: tenant globex: permission denied"),
+		},
+		{
+			name:          "malformed payload",
+			spaces:        fakeSpaceLister{{Slug: "acme"}},
+			payload:       `{"schemaVersion":"one"}`,
+			wantPermanent: true,
+		},
+		{
+			name:          "without tenant spaces",
+			payload:       valid,
+			wantErr:       ErrSpacesUnavailable,
+			wantPermanent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newFakeRepository()
+			repo.tablesErr = tt.tablesErr
+			svc := New(repo, tt.spaces, &fakeJobQueue{})
+
+			_, err := svc.RunEntityTables(context.Background(), job(tt.payload))
+			switch {
+			case tt.wantPermanent:
+				require.True(t, jobs.IsPermanent(err), "got %v", err)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+				}
+			case tt.wantErr != nil:
+				require.EqualError(t, err, tt.wantErr.Error())
+				require.False(t, jobs.IsPermanent(err))
+			default:
+				require.NoError(t, err)
+				require.Equal(t, []string{"acme.cards_entities"}, repo.tables)
+			}
+		})
+	}
+}
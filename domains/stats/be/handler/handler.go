@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/stats/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	stats "github.com/zenGate-Global/palmyra-pro-saas/generated/go/stats"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	getOperation operation = "platformStatsGet"
+)
+
+// Handler wires the platform statistics service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("stats service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) PlatformStatsGet(ctx context.Context, _ stats.PlatformStatsGetRequestObject) (stats.PlatformStatsGetResponseObject, error) {
+	result, err := h.svc.Get(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return stats.PlatformStatsGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return stats.PlatformStatsGet200JSONResponse(toAPIStats(result)), nil
+}
+
+func toAPIStats(result service.Stats) stats.PlatformStats {
+	failures := make([]stats.ProvisioningFailure, 0, len(result.Failures))
+	for _, failure := range result.Failures {
+		failures = append(failures, stats.ProvisioningFailure{
+			JobId:      externalRef2.UUID(failure.JobID),
+			TenantId:   externalRef2.UUID(failure.TenantID),
+			TenantSlug: failure.TenantSlug,
+			Kind:       stats.ProvisioningFailureKind(failure.Kind),
+			Attempts:   failure.Attempts,
+			LastError:  failure.LastError,
+			FailedAt:   externalRef2.Timestamp(failure.FailedAt),
+		})
+	}
+
+	usage := stats.UsageStats{
+		Users:           result.Usage.Users,
+		Entities:        result.Usage.Entities,
+		MeasuredTenants: result.Usage.MeasuredTenants,
+	}
+	if result.Usage.MeasuredAt != nil {
+		measuredAt := externalRef2.Timestamp(*result.Usage.MeasuredAt)
+		usage.UsageMeasuredAt = &measuredAt
+	}
+
+	pingMs := result.Database.Ping.Milliseconds()
+	return stats.PlatformStats{
+		Environment: result.Environment,
+		Tenants: stats.TenantStats{
+			Total:    result.TenantsTotal,
+			ByStatus: result.TenantsByStatus,
+		},
+		Usage:                      usage,
+		RecentProvisioningFailures: failures,
+		Database: stats.DatabaseHealth{
+			Ok:                result.Database.OK,
+			PingMs:            &pingMs,
+			Error:             result.Database.Error,
+			MaxConns:          result.Database.MaxConns,
+			TotalConns:        result.Database.TotalConns,
+			IdleConns:         result.Database.IdleConns,
+			AcquiredConns:     result.Database.AcquiredConns,
+			EmptyAcquireCount: result.Database.EmptyAcquireCount,
+		},
+		GeneratedAt: externalRef2.Timestamp(result.GeneratedAt),
+	}
+}
+
+// errorCatalog maps stats service errors to problems; every failure is internal.
+var errorCatalog = problems.NewCatalog("stats")
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
+
+// compile-time assertions to ensure interface compliance
+var _ stats.StrictServerInterface = (*Handler)(nil)
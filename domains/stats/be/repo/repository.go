@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Repository defines the persistence operations required by the platform statistics API.
+type Repository interface {
+	TenantStatusCounts(ctx context.Context) (persistence.TenantStatusCounts, error)
+	UsageTotals(ctx context.Context) (persistence.UsageTotals, error)
+	RecentTenantJobFailures(ctx context.Context, limit int) ([]persistence.TenantJobFailure, error)
+	PoolHealth(ctx context.Context) persistence.PoolHealth
+}
+
+type postgresRepository struct {
+	store *persistence.PlatformStatsStore
+}
+
+// NewPostgresRepository constructs a repository backed by the admin schema.
+func NewPostgresRepository(store *persistence.PlatformStatsStore) Repository {
+	if store == nil {
+		panic("platform stats store is required")
+	}
+	return &postgresRepository{store: store}
+}
+
+func (r *postgresRepository) TenantStatusCounts(ctx context.Context) (persistence.TenantStatusCounts, error) {
+	return r.store.TenantStatusCounts(ctx)
+}
+
+func (r *postgresRepository) UsageTotals(ctx context.Context) (persistence.UsageTotals, error) {
+	return r.store.UsageTotals(ctx)
+}
+
+func (r *postgresRepository) RecentTenantJobFailures(ctx context.Context, limit int) ([]persistence.TenantJobFailure, error) {
+	return r.store.RecentTenantJobFailures(ctx, limit)
+}
+
+func (r *postgresRepository) PoolHealth(ctx context.Context) persistence.PoolHealth {
+	return r.store.PoolHealth(ctx)
+}
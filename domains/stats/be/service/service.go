@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/stats/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// recentFailures caps the provisioning failures returned in the overview.
+const recentFailures = 10
+
+// Stats is the platform overview shown on the admin dashboard.
+type Stats struct {
+	Environment     string
+	TenantsByStatus map[string]int64
+	TenantsTotal    int64
+	Usage           Usage
+	Failures        []ProvisioningFailure
+	Database        DatabaseHealth
+	GeneratedAt     time.Time
+}
+
+// Usage adds up the latest usage snapshot of every tenant.
+type Usage struct {
+	Users           int64
+	Entities        int64
+	MeasuredTenants int64
+	MeasuredAt      *time.Time
+}
+
+// ProvisioningFailure is a failed tenant job.
+type ProvisioningFailure struct {
+	JobID      uuid.UUID
+	TenantID   uuid.UUID
+	TenantSlug *string
+	Kind       string
+	Attempts   int
+	LastError  *string
+	FailedAt   time.Time
+}
+
+// DatabaseHealth reports whether the database answers and how busy the connection pool is.
+type DatabaseHealth struct {
+	OK                bool
+	Ping              time.Duration
+	Error             *string
+	MaxConns          int32
+	TotalConns        int32
+	IdleConns         int32
+	AcquiredConns     int32
+	EmptyAcquireCount int64
+}
+
+// Service builds the platform overview.
+type Service interface {
+	Get(ctx context.Context, audit requesttrace.AuditInfo) (Stats, error)
+}
+
+type service struct {
+	repo        repo.Repository
+	environment string
+	now         func() time.Time
+}
+
+// New constructs a Service instance reporting on the environment named envKey.
+func New(r repo.Repository, envKey string) Service {
+	if r == nil {
+		panic("stats repository is required")
+	}
+	return &service{repo: r, environment: envKey, now: time.Now}
+}
+
+func (s *service) Get(ctx context.Context, _ requesttrace.AuditInfo) (Stats, error) {
+	counts, err := s.repo.TenantStatusCounts(ctx)
+	if err != nil {
+		return Stats{}, err
+	}
+	totals, err := s.repo.UsageTotals(ctx)
+	if err != nil {
+		return Stats{}, err
+	}
+	failures, err := s.repo.RecentTenantJobFailures(ctx, recentFailures)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{
+		Environment:     s.environment,
+		TenantsByStatus: make(map[string]int64, len(counts)),
+		Usage: Usage{
+			Users:           totals.Users,
+			Entities:        totals.Entities,
+			MeasuredTenants: totals.MeasuredTenants,
+			MeasuredAt:      totals.MeasuredAt,
+		},
+		Failures:    make([]ProvisioningFailure, 0, len(failures)),
+		Database:    mapHealth(s.repo.PoolHealth(ctx)),
+		GeneratedAt: s.now().UTC(),
+	}
+	for status, count := range counts {
+		stats.TenantsByStatus[status] = count
+		stats.TenantsTotal += count
+	}
+	for _, failure := range failures {
+		stats.Failures = append(stats.Failures, ProvisioningFailure(failure))
+	}
+	return stats, nil
+}
+
+func mapHealth(health persistence.PoolHealth) DatabaseHealth {
+	out := DatabaseHealth{
+		OK:                health.PingErr == nil,
+		Ping:              health.PingDuration,
+		MaxConns:          health.MaxConns,
+		TotalConns:        health.TotalConns,
+		IdleConns:         health.IdleConns,
+		AcquiredConns:     health.AcquiredConns,
+		EmptyAcquireCount: health.EmptyAcquireCount,
+	}
+	if health.PingErr != nil {
+		msg := health.PingErr.Error()
+		out.Error = &msg
+	}
+	return out
+}
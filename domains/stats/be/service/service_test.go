@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type mockRepository struct {
+	counts   persistence.TenantStatusCounts
+	totals   persistence.UsageTotals
+	failures []persistence.TenantJobFailure
+	health   persistence.PoolHealth
+	err      error
+	limit    int
+}
+
+func (m *mockRepository) TenantStatusCounts(context.Context) (persistence.TenantStatusCounts, error) {
+	return m.counts, m.err
+}
+
+func (m *mockRepository) UsageTotals(context.Context) (persistence.UsageTotals, error) {
+	return m.totals, nil
+}
+
+func (m *mockRepository) RecentTenantJobFailures(_ context.Context, limit int) ([]persistence.TenantJobFailure, error) {
+	m.limit = limit
+	return m.failures, nil
+}
+
+func (m *mockRepository) PoolHealth(context.Context) persistence.PoolHealth {
+	return m.health
+}
+
+func TestGetAggregatesTheOverview(t *testing.T) {
+	t.Parallel()
+
+	measuredAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	msg := "create role: permission denied"
+	repository := &mockRepository{
+		counts: persistence.TenantStatusCounts{"active": 4, "suspended": 1},
+		totals: persistence.UsageTotals{Users: 12, Entities: 3400, MeasuredTenants: 4, MeasuredAt: &measuredAt},
+		failures: []persistence.TenantJobFailure{
+			{JobID: uuid.New(), TenantID: uuid.New(), Kind: persistence.TenantJobKindProvision, Attempts: 5, LastError: &msg},
+		},
+		health: persistence.PoolHealth{PingDuration: 2 * time.Millisecond, MaxConns: 10, TotalConns: 3, IdleConns: 2, AcquiredConns: 1},
+	}
+	svc := New(repository, "dev")
+
+	stats, err := svc.Get(context.Background(), requesttrace.AuditInfo{RequestID: "test"})
+	require.NoError(t, err)
+	require.Equal(t, "dev", stats.Environment)
+	require.Equal(t, int64(5), stats.TenantsTotal)
+	require.Equal(t, int64(4), stats.TenantsByStatus["active"])
+	require.Equal(t, int64(3400), stats.Usage.Entities)
+	require.Equal(t, recentFailures, repository.limit)
+	require.Len(t, stats.Failures, 1)
+	require.Equal(t, msg, *stats.Failures[0].LastError)
+	require.True(t, stats.Database.OK)
+	require.Equal(t, int32(1), stats.Database.AcquiredConns)
+
+	repository.health.PingErr = errors.New("connection refused")
+	stats, err = svc.Get(context.Background(), requesttrace.AuditInfo{RequestID: "test"})
+	require.NoError(t, err)
+	require.False(t, stats.Database.OK)
+	require.Equal(t, "connection refused", *stats.Database.Error)
+
+	repository.err = errors.New("boom")
+	_, err = svc.Get(context.Background(), requesttrace.AuditInfo{RequestID: "test"})
+	require.Error(t, err)
+}
@@ -14,13 +14,9 @@ import (
 	externalProblems "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
 	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
 	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
-)
-
-const (
-	problemTypeValidation = "https://palmyra.pro/problems/validation-error"
-	problemTypeNotFound   = "https://palmyra.pro/problems/not-found"
-	problemTypeConflict   = "https://palmyra.pro/problems/conflict"
-	problemTypeInternal   = "https://palmyra.pro/problems/internal-error"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // Handler wires tenants service to generated HTTP contract.
@@ -45,7 +41,7 @@ func (h *Handler) TenantsList(ctx context.Context, request tenantsapi.TenantsLis
 	opts := buildListOptions(request.Params)
 	result, err := h.svc.List(ctx, opts)
 	if err != nil {
-		status, problem := h.problemForError(ctx, err, http.StatusInternalServerError)
+		status, problem := h.problemForError(ctx, err)
 		return tenantsapi.TenantsListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
 	}
 
@@ -66,13 +62,13 @@ func (h *Handler) TenantsList(ctx context.Context, request tenantsapi.TenantsLis
 // TenantsCreate implements POST /admin/tenants
 func (h *Handler) TenantsCreate(ctx context.Context, request tenantsapi.TenantsCreateRequestObject) (tenantsapi.TenantsCreateResponseObject, error) {
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return tenantsapi.TenantsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
 	}
 
 	createdBy, err := h.extractAdminID(ctx)
 	if err != nil {
-		problem := h.buildProblem("Forbidden", err.Error(), problemTypeValidation, http.StatusForbidden, nil)
+		problem := problems.Forbidden(err.Error()).Details()
 		return tenantsapi.TenantsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusForbidden}, nil
 	}
 
@@ -87,10 +83,19 @@ func (h *Handler) TenantsCreate(ctx context.Context, request tenantsapi.TenantsC
 		Status:      status,
 		CreatedBy:   createdBy,
 	}
+	if request.Body.Quotas != nil {
+		input.Quotas = fromAPIQuotas(*request.Body.Quotas)
+	}
+	if request.Body.CorsOrigins != nil {
+		input.CORSOrigins = *request.Body.CorsOrigins
+	}
+	if request.Body.Email != nil {
+		input.Email = fromAPIEmailSettings(*request.Body.Email)
+	}
 
 	t, err := h.svc.Create(ctx, input)
 	if err != nil {
-		statusCode, problem := h.problemForError(ctx, err, http.StatusInternalServerError)
+		statusCode, problem := h.problemForError(ctx, err)
 		return tenantsapi.TenantsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: statusCode}, nil
 	}
 
@@ -105,7 +110,7 @@ func (h *Handler) TenantsCreate(ctx context.Context, request tenantsapi.TenantsC
 func (h *Handler) TenantsGet(ctx context.Context, request tenantsapi.TenantsGetRequestObject) (tenantsapi.TenantsGetResponseObject, error) {
 	t, err := h.svc.Get(ctx, uuid.UUID(request.TenantId))
 	if err != nil {
-		statusCode, problem := h.problemForError(ctx, err, http.StatusNotFound)
+		statusCode, problem := h.problemForError(ctx, err)
 		return tenantsapi.TenantsGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: statusCode}, nil
 	}
 	return tenantsapi.TenantsGet200JSONResponse(toAPITenant(t)), nil
@@ -114,7 +119,7 @@ func (h *Handler) TenantsGet(ctx context.Context, request tenantsapi.TenantsGetR
 // TenantsUpdate implements PATCH /admin/tenants/{tenantId}
 func (h *Handler) TenantsUpdate(ctx context.Context, request tenantsapi.TenantsUpdateRequestObject) (tenantsapi.TenantsUpdateResponseObject, error) {
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return tenantsapi.TenantsUpdatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
 	}
 
@@ -122,10 +127,22 @@ func (h *Handler) TenantsUpdate(ctx context.Context, request tenantsapi.TenantsU
 		DisplayName: request.Body.DisplayName,
 		Status:      request.Body.Status,
 	}
+	if request.Body.Quotas != nil {
+		quotas := fromAPIQuotas(*request.Body.Quotas)
+		input.Quotas = &quotas
+	}
+	if request.Body.CorsOrigins != nil {
+		origins := *request.Body.CorsOrigins
+		input.CORSOrigins = &origins
+	}
+	if request.Body.Email != nil {
+		email := fromAPIEmailSettings(*request.Body.Email)
+		input.Email = &email
+	}
 
 	updated, err := h.svc.Update(ctx, uuid.UUID(request.TenantId), input)
 	if err != nil {
-		statusCode, problem := h.problemForError(ctx, err, http.StatusInternalServerError)
+		statusCode, problem := h.problemForError(ctx, err)
 		return tenantsapi.TenantsUpdatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: statusCode}, nil
 	}
 
@@ -134,24 +151,154 @@ func (h *Handler) TenantsUpdate(ctx context.Context, request tenantsapi.TenantsU
 
 // TenantsProvision implements POST /admin/tenants/{tenantId}:provision
 func (h *Handler) TenantsProvision(ctx context.Context, request tenantsapi.TenantsProvisionRequestObject) (tenantsapi.TenantsProvisionResponseObject, error) {
-	t, err := h.svc.Provision(ctx, uuid.UUID(request.TenantId))
+	job, err := h.svc.EnqueueProvision(ctx, uuid.UUID(request.TenantId))
 	if err != nil {
-		statusCode, problem := h.problemForError(ctx, err, http.StatusInternalServerError)
+		statusCode, problem := h.problemForError(ctx, err)
 		return tenantsapi.TenantsProvisiondefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: statusCode}, nil
 	}
-	return tenantsapi.TenantsProvision202JSONResponse(toAPITenant(t)), nil
+
+	location := fmt.Sprintf("/api/v1/admin/tenants/%s/jobs/%s", job.TenantID, job.ID)
+	return tenantsapi.TenantsProvision202JSONResponse{
+		Headers: tenantsapi.TenantsProvision202ResponseHeaders{Location: location},
+		Body:    toAPIProvisioningJob(job),
+	}, nil
+}
+
+// TenantsClone implements POST /admin/tenants/{tenantId}:clone
+func (h *Handler) TenantsClone(ctx context.Context, request tenantsapi.TenantsCloneRequestObject) (tenantsapi.TenantsCloneResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return tenantsapi.TenantsClonedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	createdBy, err := h.extractAdminID(ctx)
+	if err != nil {
+		problem := problems.Forbidden(err.Error()).Details()
+		return tenantsapi.TenantsClonedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusForbidden}, nil
+	}
+
+	input := service.CloneInput{
+		Slug:        string(request.Body.Slug),
+		DisplayName: request.Body.DisplayName,
+		IncludeData: true,
+		CreatedBy:   createdBy,
+	}
+	if request.Body.IncludeData != nil {
+		input.IncludeData = *request.Body.IncludeData
+	}
+
+	job, err := h.svc.Clone(ctx, uuid.UUID(request.TenantId), input)
+	if err != nil {
+		statusCode, problem := h.problemForError(ctx, err)
+		return tenantsapi.TenantsClonedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: statusCode}, nil
+	}
+
+	location := fmt.Sprintf("/api/v1/admin/tenants/%s/jobs/%s", job.TenantID, job.ID)
+	return tenantsapi.TenantsClone202JSONResponse{
+		Headers: tenantsapi.TenantsClone202ResponseHeaders{Location: location},
+		Body:    toAPIProvisioningJob(job),
+	}, nil
+}
+
+// TenantsMigrate implements POST /admin/tenants:migrate
+func (h *Handler) TenantsMigrate(ctx context.Context, request tenantsapi.TenantsMigrateRequestObject) (tenantsapi.TenantsMigrateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return tenantsapi.TenantsMigratedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	var input service.MigrateInput
+	if request.Body.TenantIds != nil {
+		for _, id := range *request.Body.TenantIds {
+			input.TenantIDs = append(input.TenantIDs, uuid.UUID(id))
+		}
+	}
+	if request.Body.DryRun != nil {
+		input.DryRun = *request.Body.DryRun
+	}
+
+	jobs, err := h.svc.EnqueueMigrations(ctx, input)
+	if err != nil {
+		statusCode, problem := h.problemForError(ctx, err)
+		return tenantsapi.TenantsMigratedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: statusCode}, nil
+	}
+
+	body := tenantsapi.TenantsMigrate202JSONResponse{Jobs: make([]tenantsapi.ProvisioningJob, 0, len(jobs))}
+	for _, job := range jobs {
+		body.Jobs = append(body.Jobs, toAPIProvisioningJob(job))
+	}
+	return body, nil
+}
+
+// TenantsGetUsage implements GET /admin/tenants/{tenantId}/usage
+func (h *Handler) TenantsGetUsage(ctx context.Context, request tenantsapi.TenantsGetUsageRequestObject) (tenantsapi.TenantsGetUsageResponseObject, error) {
+	usage, err := h.svc.Usage(ctx, uuid.UUID(request.TenantId))
+	if err != nil {
+		code, problem := h.problemForError(ctx, err)
+		return tenantsapi.TenantsGetUsagedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: code}, nil
+	}
+	return tenantsapi.TenantsGetUsage200JSONResponse{
+		TenantId:          externalPrimitives.UUID(usage.TenantID),
+		Entities:          usage.Usage.Entities,
+		Schemas:           usage.Usage.Schemas,
+		StorageBytes:      usage.Usage.StorageBytes,
+		Users:             usage.Usage.Users,
+		Objects:           usage.Usage.Objects,
+		ObjectBytes:       usage.Usage.ObjectBytes,
+		ObjectsMeasuredAt: (*externalPrimitives.Timestamp)(usage.Usage.ObjectsMeasuredAt),
+		Quotas:            toAPIQuotas(usage.Quotas),
+		MeasuredAt:        externalPrimitives.Timestamp(usage.MeasuredAt),
+	}, nil
+}
+
+// TenantsGetJob implements GET /admin/tenants/{tenantId}/jobs/{jobId}
+func (h *Handler) TenantsGetJob(ctx context.Context, request tenantsapi.TenantsGetJobRequestObject) (tenantsapi.TenantsGetJobResponseObject, error) {
+	job, err := h.svc.GetProvisioningJob(ctx, uuid.UUID(request.TenantId), uuid.UUID(request.JobId))
+	if err != nil {
+		code, problem := h.problemForError(ctx, err)
+		return tenantsapi.TenantsGetJobdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: code}, nil
+	}
+	return tenantsapi.TenantsGetJob200JSONResponse(toAPIProvisioningJob(job)), nil
 }
 
 // TenantsProvisionStatus implements GET /admin/tenants/{tenantId}:provision-status
 func (h *Handler) TenantsProvisionStatus(ctx context.Context, request tenantsapi.TenantsProvisionStatusRequestObject) (tenantsapi.TenantsProvisionStatusResponseObject, error) {
 	status, err := h.svc.ProvisionStatus(ctx, uuid.UUID(request.TenantId))
 	if err != nil {
-		code, problem := h.problemForError(ctx, err, http.StatusInternalServerError)
+		code, problem := h.problemForError(ctx, err)
 		return tenantsapi.TenantsProvisionStatusdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: code}, nil
 	}
 	return tenantsapi.TenantsProvisionStatus200JSONResponse(toAPIProvisioningStatus(status)), nil
 }
 
+// TenantsDeprovision implements POST /admin/tenants/{tenantId}:deprovision
+func (h *Handler) TenantsDeprovision(ctx context.Context, request tenantsapi.TenantsDeprovisionRequestObject) (tenantsapi.TenantsDeprovisionResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return tenantsapi.TenantsDeprovisiondefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	input := service.DeprovisionInput{ConfirmSlug: string(request.Body.ConfirmSlug)}
+	if request.Body.Export != nil {
+		input.Export = *request.Body.Export
+	}
+	if request.Body.DryRun != nil {
+		input.DryRun = *request.Body.DryRun
+	}
+
+	result, err := h.svc.Deprovision(ctx, uuid.UUID(request.TenantId), input)
+	if err != nil {
+		code, problem := h.problemForError(ctx, err)
+		return tenantsapi.TenantsDeprovisiondefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: code}, nil
+	}
+	return tenantsapi.TenantsDeprovision200JSONResponse{
+		Tenant:         toAPITenant(result.Tenant),
+		ExportLocation: result.ExportLocation,
+		DryRun:         result.DryRun,
+		Teardown:       toAPITeardown(result.Teardown),
+	}, nil
+}
+
 func (h *Handler) extractAdminID(ctx context.Context) (uuid.UUID, error) {
 	creds, ok := platformauth.UserFromContext(ctx)
 	if !ok || creds == nil {
@@ -167,39 +314,47 @@ func (h *Handler) extractAdminID(ctx context.Context) (uuid.UUID, error) {
 	return id, nil
 }
 
-func (h *Handler) problemForError(ctx context.Context, err error, defaultStatus int) (int, externalProblems.ProblemDetails) {
-	switch {
-	case errors.Is(err, service.ErrNotFound):
-		return http.StatusNotFound, h.buildProblem("Not found", err.Error(), problemTypeNotFound, http.StatusNotFound, nil)
-	case errors.Is(err, service.ErrConflictSlug):
-		return http.StatusConflict, h.buildProblem("Conflict", err.Error(), problemTypeConflict, http.StatusConflict, nil)
-	default:
-		h.logger.Error("tenant operation failed", zap.Error(err))
-		return defaultStatus, h.buildProblem("Internal error", "internal error", problemTypeInternal, http.StatusInternalServerError, nil)
-	}
-}
+// errorCatalog maps tenants service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("tenants")
+	c.RegisterDetail(service.ErrNotFound, problems.NotFound("")).
+		RegisterDetail(service.ErrJobNotFound, problems.NotFound("")).
+		RegisterDetail(service.ErrDisabled, problems.Conflict("")).
+		RegisterDetail(service.ErrCloneSourceNotReady, problems.Conflict("")).
+		RegisterDetail(service.ErrMigrationTenantNotReady, problems.Conflict("")).
+		RegisterDetail(service.ErrConflictSlug, problems.Conflict("")).
+		Register(service.ErrConfirmationMismatch, problems.Validation(service.ErrConfirmationMismatch.Error(), problems.FieldErrors{"confirmSlug": {service.ErrConfirmationMismatch.Error()}})).
+		RegisterDetail(service.ErrInvalidListOptions, problems.BadRequest("")).
+		Register(service.ErrExportUnavailable, problems.Validation(service.ErrExportUnavailable.Error(), problems.FieldErrors{"export": {service.ErrExportUnavailable.Error()}})).
+		RegisterDetail(service.ErrInvalidCORSOrigin, problems.Validation("", problems.FieldErrors{"corsOrigins": {"must list http(s) origins without paths or wildcards"}})).
+		RegisterDetail(service.ErrInvalidEmailSettings, problems.Validation("", problems.FieldErrors{"email": {"addresses must be plain email addresses and fromName a single line"}}))
+	return c
+}()
 
-func (h *Handler) buildProblem(title, detail, problemType string, status int, errs map[string][]string) externalProblems.ProblemDetails {
-	return externalProblems.ProblemDetails{
-		Title:  title,
-		Detail: strPtr(detail),
-		Status: status,
-		Type:   strPtr(problemType),
-		Errors: mapPtr(errs),
-	}
+func (h *Handler) problemForError(ctx context.Context, err error) (int, externalProblems.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, "")
 }
 
 func buildListOptions(params tenantsapi.TenantsListParams) service.ListOptions {
-	opts := service.ListOptions{Page: 1, PageSize: 20}
-	if params.Page != nil {
-		opts.Page = int(*params.Page)
-	}
-	if params.PageSize != nil {
-		opts.PageSize = int(*params.PageSize)
-	}
+	page := pagination.FromQuery(params.Page, params.PageSize)
+	opts := service.ListOptions{Page: page.Page, PageSize: page.PageSize}
 	if params.Status != nil {
 		opts.Status = params.Status
 	}
+	if params.Q != nil {
+		opts.Search = params.Q
+	}
+	if params.CreatedAfter != nil {
+		after := params.CreatedAfter.UTC()
+		opts.CreatedAfter = &after
+	}
+	if params.CreatedBefore != nil {
+		before := params.CreatedBefore.UTC()
+		opts.CreatedBefore = &before
+	}
+	if params.Sort != nil {
+		opts.Sort = params.Sort
+	}
 	return opts
 }
 
@@ -215,9 +370,38 @@ func toAPITenant(t service.Tenant) tenantsapi.Tenant {
 		CreatedAt:     externalPrimitives.Timestamp(t.CreatedAt),
 		CreatedBy:     externalPrimitives.UUID(t.CreatedBy),
 		Provisioning:  toAPIProvisioningStatus(t.Provisioning),
+		Quotas:        toAPIQuotas(t.Quotas),
+		CorsOrigins:   corsOrigins(t.CORSOrigins),
+		Email:         toAPIEmailSettings(t.Email),
 	}
 }
 
+func toAPIEmailSettings(e tenant.EmailSettings) tenantsapi.TenantEmailSettings {
+	return tenantsapi.TenantEmailSettings{
+		FromName:      e.FromName,
+		FromAddress:   e.FromAddress,
+		ReplyTo:       e.ReplyTo,
+		NotifyAddress: e.NotifyAddress,
+	}
+}
+
+func fromAPIEmailSettings(e tenantsapi.TenantEmailSettings) tenant.EmailSettings {
+	return tenant.EmailSettings{
+		FromName:      e.FromName,
+		FromAddress:   e.FromAddress,
+		ReplyTo:       e.ReplyTo,
+		NotifyAddress: e.NotifyAddress,
+	}
+}
+
+// corsOrigins keeps the required corsOrigins field an array rather than null.
+func corsOrigins(origins []string) tenantsapi.CORSOrigins {
+	if origins == nil {
+		return tenantsapi.CORSOrigins{}
+	}
+	return origins
+}
+
 func toAPIProvisioningStatus(p service.ProvisioningStatus) tenantsapi.TenantProvisioningStatus {
 	return tenantsapi.TenantProvisioningStatus{
 		DbReady:           &p.DBReady,
@@ -228,13 +412,133 @@ func toAPIProvisioningStatus(p service.ProvisioningStatus) tenantsapi.TenantProv
 	}
 }
 
-func strPtr(v string) *string {
-	return &v
+func toAPIQuotas(q tenant.Quotas) tenantsapi.TenantQuotas {
+	return tenantsapi.TenantQuotas{
+		MaxEntities:     q.MaxEntities,
+		MaxSchemas:      q.MaxSchemas,
+		MaxStorageBytes: q.MaxStorageBytes,
+		MaxUsers:        q.MaxUsers,
+		MaxObjectBytes:  q.MaxObjectBytes,
+	}
+}
+
+func fromAPIQuotas(q tenantsapi.TenantQuotas) tenant.Quotas {
+	return tenant.Quotas{
+		MaxEntities:     q.MaxEntities,
+		MaxSchemas:      q.MaxSchemas,
+		MaxStorageBytes: q.MaxStorageBytes,
+		MaxUsers:        q.MaxUsers,
+		MaxObjectBytes:  q.MaxObjectBytes,
+	}
+}
+
+func toAPIProvisioningJob(job service.ProvisioningJob) tenantsapi.ProvisioningJob {
+	kind := job.Kind
+	if kind == "" {
+		kind = service.JobProvision
+	}
+	return tenantsapi.ProvisioningJob{
+		JobId:    externalPrimitives.UUID(job.ID),
+		TenantId: externalPrimitives.UUID(job.TenantID),
+		Kind:     tenantsapi.ProvisioningJobKind(kind),
+		Status:   tenantsapi.ProvisioningJobStatus(job.Status),
+		Attempts: job.Attempts,
+		Components: tenantsapi.ProvisioningJobComponents{
+			Db:      toAPIJobComponent(job.Components[service.ComponentDB]),
+			Auth:    toAPIJobComponent(job.Components[service.ComponentAuth]),
+			Storage: toAPIJobComponent(job.Components[service.ComponentStorage]),
+		},
+		Clone:         toAPIJobClone(job),
+		Migration:     toAPIJobMigration(job),
+		LastError:     job.LastError,
+		NextAttemptAt: externalPrimitives.Timestamp(job.NextAttemptAt),
+		CreatedAt:     externalPrimitives.Timestamp(job.CreatedAt),
+		UpdatedAt:     externalPrimitives.Timestamp(job.UpdatedAt),
+		FinishedAt:    (*externalPrimitives.Timestamp)(job.FinishedAt),
+	}
+}
+
+func toAPIJobClone(job service.ProvisioningJob) *tenantsapi.ProvisioningJobClone {
+	if job.Clone == nil {
+		return nil
+	}
+	clone := &tenantsapi.ProvisioningJobClone{
+		SourceTenantId: externalPrimitives.UUID(job.Clone.SourceTenantID),
+		IncludeData:    job.Clone.IncludeData,
+		TotalTables:    len(job.Copies),
+		Tables:         make(map[string]tenantsapi.ProvisioningJobComponent, len(job.Copies)),
+	}
+	for table, progress := range job.Copies {
+		if progress.Status == service.ComponentReady {
+			clone.CopiedTables++
+		}
+		clone.Tables[table] = toAPIJobComponent(progress)
+	}
+	return clone
+}
+
+func toAPIJobMigration(job service.ProvisioningJob) *tenantsapi.ProvisioningJobMigration {
+	if job.Migration == nil {
+		return nil
+	}
+	migration := &tenantsapi.ProvisioningJobMigration{
+		DryRun:          job.Migration.DryRun,
+		TotalMigrations: len(job.Migrations),
+		Migrations:      make(map[string]tenantsapi.ProvisioningJobComponent, len(job.Migrations)),
+	}
+	for version, progress := range job.Migrations {
+		if progress.Status == service.ComponentReady {
+			migration.AppliedMigrations++
+		}
+		migration.Migrations[version] = toAPIJobComponent(progress)
+	}
+	return migration
+}
+
+func toAPIJobComponent(progress service.ComponentProgress) tenantsapi.ProvisioningJobComponent {
+	status := progress.Status
+	if status == "" {
+		status = service.ComponentPending
+	}
+	return tenantsapi.ProvisioningJobComponent{
+		Status:    tenantsapi.ProvisioningJobComponentStatus(status),
+		Attempts:  progress.Attempts,
+		LastError: progress.LastError,
+		UpdatedAt: (*externalPrimitives.Timestamp)(progress.UpdatedAt),
+	}
+}
+
+func toAPITeardown(report service.TeardownReport) tenantsapi.TenantTeardown {
+	tables := make([]tenantsapi.TenantTeardownTable, 0, len(report.Database.Result.Tables))
+	for _, table := range report.Database.Result.Tables {
+		tables = append(tables, tenantsapi.TenantTeardownTable{Name: table.Name, Rows: table.Rows})
+	}
+	return tenantsapi.TenantTeardown{
+		Database: tenantsapi.TenantTeardownDatabase{
+			SchemaName:   report.Database.SchemaName,
+			RoleName:     report.Database.RoleName,
+			SchemaExists: report.Database.Result.SchemaExists,
+			RoleExists:   report.Database.Result.RoleExists,
+			Tables:       tables,
+			Error:        errorMessage(report.Database.Err),
+		},
+		Auth: tenantsapi.TenantTeardownAuth{
+			ExternalTenant: report.Auth.ExternalTenant,
+			Error:          errorMessage(report.Auth.Err),
+		},
+		Storage: tenantsapi.TenantTeardownStorage{
+			Prefix:  report.Storage.Prefix,
+			Objects: report.Storage.Result.Objects,
+			Bytes:   report.Storage.Result.Bytes,
+			Error:   errorMessage(report.Storage.Err),
+		},
+	}
 }
 
-func mapPtr(m map[string][]string) *map[string][]string {
-	if m == nil {
+func errorMessage(err error) *string {
+	if err == nil {
 		return nil
 	}
-	return &m
+	msg := err.Error()
+	return &msg
 }
@@ -0,0 +1,72 @@
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// StorageArchiver writes tenant archives under "exports/" in the tenant storage prefix and reads them back.
+type StorageArchiver struct {
+	store   *persistence.TenantArchiveStore
+	objects storage.ObjectStore
+	now     func() time.Time
+}
+
+func NewStorageArchiver(store *persistence.TenantArchiveStore, objects storage.ObjectStore) *StorageArchiver {
+	if store == nil {
+		panic("storage archiver requires archive store")
+	}
+	if objects == nil {
+		panic("storage archiver requires object store")
+	}
+	return &StorageArchiver{store: store, objects: objects, now: time.Now}
+}
+
+// Export streams the archive of space into a timestamped object and returns its full path.
+func (a *StorageArchiver) Export(ctx context.Context, space tenant.Space) (string, error) {
+	key := fmt.Sprintf("exports/%s-%s.tar.gz", space.Slug, a.now().UTC().Format("20060102T150405Z"))
+	fullPath, err := storage.ResolveObjectPath(space, key)
+	if err != nil {
+		return "", err
+	}
+
+	// Cancelling the writer context discards the object, so a failed export never leaves a truncated archive.
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := a.objects.NewWriter(writeCtx, fullPath)
+	if err != nil {
+		return "", err
+	}
+	if _, err := a.store.Export(ctx, space, w); err != nil {
+		cancel()
+		_ = w.Close()
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("store archive %s: %w", fullPath, err)
+	}
+	return fullPath, nil
+}
+
+// Import restores the archive stored at location, a full object path as returned by Export, into space.
+func (a *StorageArchiver) Import(ctx context.Context, space tenant.Space, location string) (tenant.Quotas, error) {
+	r, err := a.objects.NewReader(ctx, location)
+	if err != nil {
+		return tenant.Quotas{}, err
+	}
+	defer r.Close()
+
+	manifest, err := a.store.Import(ctx, space, r)
+	if err != nil {
+		return tenant.Quotas{}, err
+	}
+	return manifest.Quotas, nil
+}
+
+var _ service.TenantArchiver = (*StorageArchiver)(nil)
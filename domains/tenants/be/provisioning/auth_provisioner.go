@@ -21,4 +21,8 @@ func (a *AuthProvisioner) Check(ctx context.Context, externalTenant string) (ser
 	return service.AuthProvisionResult{Ready: false}, fmt.Errorf("auth provisioner not implemented")
 }
 
+func (a *AuthProvisioner) Teardown(ctx context.Context, externalTenant string, dryRun bool) error {
+	return fmt.Errorf("auth provisioner not implemented")
+}
+
 var _ service.AuthProvisioner = (*AuthProvisioner)(nil)
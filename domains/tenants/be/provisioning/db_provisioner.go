@@ -9,7 +9,6 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
-	sqlassets "github.com/zenGate-Global/palmyra-pro-saas/database"
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
@@ -19,6 +18,7 @@ import (
 type DBProvisioner struct {
 	pool        *pgxpool.Pool
 	spaceDB     *persistence.SpaceDB
+	migrator    *persistence.Migrator
 	adminSchema string
 }
 
@@ -32,14 +32,23 @@ func NewDBProvisioner(pool *pgxpool.Pool, adminSchema string) *DBProvisioner {
 		panic("db provisioner requires admin schema")
 	}
 
-	return &DBProvisioner{
+	p := &DBProvisioner{
 		pool:        pool,
 		adminSchema: adminSchema,
 		spaceDB: persistence.NewSpaceDB(persistence.SpaceDBConfig{
 			Pool:        pool,
 			AdminSchema: adminSchema,
 		}),
+		migrator: persistence.NewMigrator(pool),
 	}
+	p.UseEntityPartitioning(persistence.EntityPartitioning{})
+	return p
+}
+
+// UseEntityPartitioning sets how the entity tables created for new tenants are laid out; they are not partitioned
+// by default.
+func (p *DBProvisioner) UseEntityPartitioning(partitioning persistence.EntityPartitioning) {
+	p.migrator.UseEntityTables(persistence.NewEntityTableStore(p.spaceDB, partitioning))
 }
 
 func (p *DBProvisioner) Ensure(ctx context.Context, req service.DBProvisionRequest) (service.DBProvisionResult, error) {
@@ -166,6 +175,87 @@ func (p *DBProvisioner) Check(ctx context.Context, req service.DBProvisionReques
 	return service.DBProvisionResult{Ready: ready}, nil
 }
 
+// Teardown drops the tenant schema with everything in it, then the objects and privileges owned by the tenant role
+// and the role itself. Missing artifacts are skipped so the call can be retried after a partial failure. The result
+// lists what existed before the drop, with the row count of every table; with dryRun the drops run and are rolled
+// back, so nothing changes.
+func (p *DBProvisioner) Teardown(ctx context.Context, req service.DBProvisionRequest, dryRun bool) (service.DBTeardownResult, error) {
+	if req.RoleName == "" || req.SchemaName == "" {
+		return service.DBTeardownResult{}, fmt.Errorf("role and schema required")
+	}
+
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return service.DBTeardownResult{}, fmt.Errorf("acquire conn: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return service.DBTeardownResult{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	result := service.DBTeardownResult{Tables: []service.TableRows{}}
+	if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = $1)", req.SchemaName).Scan(&result.SchemaExists); err != nil {
+		return service.DBTeardownResult{}, fmt.Errorf("check schema existence: %w", err)
+	}
+	if result.SchemaExists {
+		if result.Tables, err = countTenantTables(ctx, tx, req.SchemaName); err != nil {
+			return service.DBTeardownResult{}, err
+		}
+	}
+
+	dropSchema := fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", pgx.Identifier{req.SchemaName}.Sanitize())
+	if _, err := tx.Exec(ctx, dropSchema); err != nil {
+		return service.DBTeardownResult{}, fmt.Errorf("drop schema: %w", err)
+	}
+
+	if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", req.RoleName).Scan(&result.RoleExists); err != nil {
+		return service.DBTeardownResult{}, fmt.Errorf("check role existence: %w", err)
+	}
+	if result.RoleExists {
+		// Revokes the grants on the admin schema and catalog tables so the role can be dropped.
+		if _, err := tx.Exec(ctx, fmt.Sprintf("DROP OWNED BY %s", pgx.Identifier{req.RoleName}.Sanitize())); err != nil {
+			return service.DBTeardownResult{}, fmt.Errorf("drop owned by role: %w", err)
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf("DROP ROLE %s", pgx.Identifier{req.RoleName}.Sanitize())); err != nil {
+			return service.DBTeardownResult{}, fmt.Errorf("drop role: %w", err)
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return service.DBTeardownResult{}, fmt.Errorf("commit: %w", err)
+	}
+	return result, nil
+}
+
+// countTenantTables returns the tables of the schema by name with their row counts.
+func countTenantTables(ctx context.Context, tx pgx.Tx, schemaName string) ([]service.TableRows, error) {
+	rows, err := tx.Query(ctx, "SELECT tablename FROM pg_tables WHERE schemaname = $1 ORDER BY tablename", schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("list tenant tables: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("list tenant tables: %w", err)
+	}
+
+	tables := make([]service.TableRows, 0, len(names))
+	for _, name := range names {
+		table := service.TableRows{Name: name}
+		countSQL := "SELECT COUNT(*) FROM " + pgx.Identifier{schemaName, name}.Sanitize()
+		if err := tx.QueryRow(ctx, countSQL).Scan(&table.Rows); err != nil {
+			return nil, fmt.Errorf("count rows of %s: %w", name, err)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
 func (p *DBProvisioner) ensureRoleSchemaAndGrants(ctx context.Context, req service.DBProvisionRequest) (bool, error) {
 	conn, err := p.pool.Acquire(ctx)
 	if err != nil {
@@ -248,30 +338,16 @@ func (p *DBProvisioner) ensureRoleSchemaAndGrants(ctx context.Context, req servi
 	return true, nil
 }
 
+// ensureBaseTables applies the tenant migrations, which create the shared tenant space tables (users, roles, ...),
+// and creates the entity tables of the active schemas.
 func (p *DBProvisioner) ensureBaseTables(ctx context.Context, req service.DBProvisionRequest) error {
-	return p.spaceDB.WithTenant(ctx, tenant.Space{
+	if _, err := p.migrator.MigrateTenant(ctx, tenant.Space{
 		SchemaName: req.SchemaName,
 		RoleName:   req.RoleName,
-	}, func(tx pgx.Tx) error {
-		// If the users table already exists (e.g., created by init SQL), skip creation.
-		var exists bool
-		if err := tx.QueryRow(ctx, `
-			SELECT EXISTS (
-				SELECT 1
-				FROM pg_class c
-				JOIN pg_namespace n ON n.oid = c.relnamespace
-				WHERE n.nspname = $1 AND c.relname = 'users'
-			)`, req.SchemaName).Scan(&exists); err != nil {
-			return fmt.Errorf("check users table: %w", err)
-		}
-		if exists {
-			return nil
-		}
-		if _, err := tx.Exec(ctx, sqlassets.UsersSQL); err != nil {
-			return fmt.Errorf("ensure base users table: %w", err)
-		}
-		return nil
-	})
+	}); err != nil {
+		return fmt.Errorf("migrate tenant schema: %w", err)
+	}
+	return nil
 }
 
 var _ service.DBProvisioner = (*DBProvisioner)(nil)
@@ -108,3 +108,46 @@ func TestDBProvisionerEnsure_NoLeakAndAccess(t *testing.T) {
 	require.Equal(t, schemaName, tableSchema)
 	require.Equal(t, roleName, tableOwner)
 }
+
+func TestDBProvisionerTeardown_DropsSchemaAndRole(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := singleConnTestPool(t)
+	defer cleanup()
+
+	schemaName := "tenant_dev_" + strings.ToLower(uuid.New().String()[:8])
+	req := service.DBProvisionRequest{
+		TenantID:   uuid.New(),
+		SchemaName: schemaName,
+		RoleName:   tenant.BuildRoleName(schemaName),
+	}
+
+	prov := NewDBProvisioner(pool, "tenant_admin")
+	_, err := prov.Ensure(ctx, req)
+	require.NoError(t, err)
+
+	// A dry run reports what exists and keeps it.
+	planned, err := prov.Teardown(ctx, req, true)
+	require.NoError(t, err)
+	require.True(t, planned.SchemaExists)
+	require.True(t, planned.RoleExists)
+	require.NotEmpty(t, planned.Tables)
+
+	dropped, err := prov.Teardown(ctx, req, false)
+	require.NoError(t, err)
+	require.Equal(t, planned, dropped)
+	// Teardown is idempotent.
+	again, err := prov.Teardown(ctx, req, false)
+	require.NoError(t, err)
+	require.False(t, again.SchemaExists)
+	require.False(t, again.RoleExists)
+
+	var schemaExists, roleExists bool
+	require.NoError(t, pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = $1)", schemaName).Scan(&schemaExists))
+	require.NoError(t, pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", req.RoleName).Scan(&roleExists))
+	require.False(t, schemaExists)
+	require.False(t, roleExists)
+}
@@ -71,4 +71,32 @@ func (p *GCSStorageProvisioner) Ensure(ctx context.Context, prefix string) (serv
 	return service.StorageProvisionResult{Ready: true}, nil
 }
 
+// Teardown deletes every object under the prefix. The result counts the objects deleted and their size; with dryRun
+// they are only counted.
+func (p *GCSStorageProvisioner) Teardown(ctx context.Context, prefix string, dryRun bool) (service.StorageTeardownResult, error) {
+	if prefix == "" {
+		return service.StorageTeardownResult{}, fmt.Errorf("storage prefix is required")
+	}
+
+	var result service.StorageTeardownResult
+	bkt := p.Client.Bucket(p.Bucket)
+	it := bkt.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return result, nil
+		}
+		if err != nil {
+			return result, fmt.Errorf("list prefix: %w", err)
+		}
+		if !dryRun {
+			if err := bkt.Object(attrs.Name).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+				return result, fmt.Errorf("delete object %s: %w", attrs.Name, err)
+			}
+		}
+		result.Objects++
+		result.Bytes += attrs.Size
+	}
+}
+
 var _ service.StorageProvisioner = (*GCSStorageProvisioner)(nil)
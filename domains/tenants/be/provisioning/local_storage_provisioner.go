@@ -2,9 +2,12 @@ package provisioning
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
 )
@@ -52,4 +55,45 @@ func (p *LocalStorageProvisioner) Check(ctx context.Context, prefix string) (ser
 	return service.StorageProvisionResult{Ready: true}, nil
 }
 
+// Teardown removes the prefix directory and everything below it; a missing prefix is not an error. The result counts
+// the files removed and their size; with dryRun they are only counted.
+func (p *LocalStorageProvisioner) Teardown(ctx context.Context, prefix string, dryRun bool) (service.StorageTeardownResult, error) {
+	if prefix == "" {
+		return service.StorageTeardownResult{}, fmt.Errorf("storage prefix is required")
+	}
+	base := filepath.Clean(p.BasePath)
+	fullPath := filepath.Join(base, prefix)
+	if fullPath == base || !strings.HasPrefix(fullPath, base+string(filepath.Separator)) {
+		return service.StorageTeardownResult{}, fmt.Errorf("storage prefix %q escapes base path", prefix)
+	}
+
+	var result service.StorageTeardownResult
+	err := filepath.WalkDir(fullPath, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		result.Objects++
+		result.Bytes += info.Size()
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return service.StorageTeardownResult{}, fmt.Errorf("walk prefix path: %w", err)
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	if err := os.RemoveAll(fullPath); err != nil {
+		return service.StorageTeardownResult{}, fmt.Errorf("remove prefix path: %w", err)
+	}
+	return result, nil
+}
+
 var _ service.StorageProvisioner = (*LocalStorageProvisioner)(nil)
@@ -21,4 +21,8 @@ func (s *StorageProvisioner) Check(ctx context.Context, prefix string) (service.
 	return s.Ensure(ctx, prefix)
 }
 
+func (s *StorageProvisioner) Teardown(ctx context.Context, prefix string, dryRun bool) (service.StorageTeardownResult, error) {
+	return service.StorageTeardownResult{}, fmt.Errorf("storage provisioner not implemented")
+}
+
 var _ service.StorageProvisioner = (*StorageProvisioner)(nil)
@@ -0,0 +1,217 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// PostgresJobRepository implements the provisioning job queue on top of TenantJobStore.
+type PostgresJobRepository struct {
+	store *persistence.TenantJobStore
+}
+
+// NewPostgresJobRepository constructs a job repository backed by TenantJobStore.
+func NewPostgresJobRepository(store *persistence.TenantJobStore) *PostgresJobRepository {
+	if store == nil {
+		panic("tenant job store is required")
+	}
+	return &PostgresJobRepository{store: store}
+}
+
+func (r *PostgresJobRepository) EnqueueProvisioning(ctx context.Context, job service.ProvisioningJob) (service.ProvisioningJob, error) {
+	rec, err := toJobRecord(job)
+	if err != nil {
+		return service.ProvisioningJob{}, err
+	}
+	out, err := r.store.Enqueue(ctx, rec)
+	if err != nil {
+		return service.ProvisioningJob{}, err
+	}
+	return toServiceJob(out)
+}
+
+func (r *PostgresJobRepository) GetJob(ctx context.Context, tenantID, jobID uuid.UUID) (service.ProvisioningJob, error) {
+	rec, err := r.store.Get(ctx, tenantID, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrTenantJobNotFound) {
+			return service.ProvisioningJob{}, service.ErrJobNotFound
+		}
+		return service.ProvisioningJob{}, err
+	}
+	return toServiceJob(rec)
+}
+
+func (r *PostgresJobRepository) ClaimDueJobs(ctx context.Context, limit int, lease time.Duration) ([]service.ProvisioningJob, error) {
+	recs, err := r.store.ClaimDue(ctx, limit, lease)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]service.ProvisioningJob, 0, len(recs))
+	for _, rec := range recs {
+		job, err := toServiceJob(rec)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (r *PostgresJobRepository) SaveJob(ctx context.Context, job service.ProvisioningJob) error {
+	rec, err := toJobRecord(job)
+	if err != nil {
+		return err
+	}
+	err = r.store.Save(ctx, rec)
+	if errors.Is(err, persistence.ErrTenantJobNotFound) {
+		return service.ErrJobNotFound
+	}
+	return err
+}
+
+// copyComponentPrefix marks the table copies of a clone job inside the shared components column.
+const copyComponentPrefix = "copy:"
+
+// migrationComponentPrefix marks the schema migrations of a migrate job inside the shared components column.
+const migrationComponentPrefix = "migration:"
+
+// cloneParams is the stored input of a clone job.
+type cloneParams struct {
+	SourceTenantID uuid.UUID `json:"sourceTenantId"`
+	IncludeData    bool      `json:"includeData"`
+}
+
+// migrateParams is the stored input of a migrate job.
+type migrateParams struct {
+	DryRun bool `json:"dryRun"`
+}
+
+func toJobRecord(job service.ProvisioningJob) (persistence.TenantJobRecord, error) {
+	components := make(map[string]persistence.TenantJobComponent, len(job.Components)+len(job.Copies)+len(job.Migrations))
+	for name, progress := range job.Components {
+		components[string(name)] = toJobComponent(progress)
+	}
+	for table, progress := range job.Copies {
+		components[copyComponentPrefix+table] = toJobComponent(progress)
+	}
+	for version, progress := range job.Migrations {
+		components[migrationComponentPrefix+version] = toJobComponent(progress)
+	}
+
+	kind := persistence.TenantJobKindProvision
+	var params json.RawMessage
+	switch job.Kind {
+	case service.JobClone:
+		kind = persistence.TenantJobKindClone
+		if job.Clone != nil {
+			encoded, err := json.Marshal(cloneParams{SourceTenantID: job.Clone.SourceTenantID, IncludeData: job.Clone.IncludeData})
+			if err != nil {
+				return persistence.TenantJobRecord{}, fmt.Errorf("encode clone params: %w", err)
+			}
+			params = encoded
+		}
+	case service.JobMigrate:
+		kind = persistence.TenantJobKindMigrate
+		if job.Migration != nil {
+			encoded, err := json.Marshal(migrateParams{DryRun: job.Migration.DryRun})
+			if err != nil {
+				return persistence.TenantJobRecord{}, fmt.Errorf("encode migrate params: %w", err)
+			}
+			params = encoded
+		}
+	}
+
+	return persistence.TenantJobRecord{
+		JobID:         job.ID,
+		TenantID:      job.TenantID,
+		Kind:          kind,
+		Status:        string(job.Status),
+		Attempts:      job.Attempts,
+		Components:    components,
+		Params:        params,
+		LastError:     job.LastError,
+		NextAttemptAt: job.NextAttemptAt,
+		CreatedAt:     job.CreatedAt,
+		UpdatedAt:     job.UpdatedAt,
+		FinishedAt:    job.FinishedAt,
+	}, nil
+}
+
+func toJobComponent(progress service.ComponentProgress) persistence.TenantJobComponent {
+	return persistence.TenantJobComponent{
+		Status:    string(progress.Status),
+		Attempts:  progress.Attempts,
+		LastError: progress.LastError,
+		UpdatedAt: progress.UpdatedAt,
+	}
+}
+
+func toServiceJob(rec persistence.TenantJobRecord) (service.ProvisioningJob, error) {
+	job := service.ProvisioningJob{
+		ID:            rec.JobID,
+		TenantID:      rec.TenantID,
+		Kind:          service.JobProvision,
+		Status:        service.JobStatus(rec.Status),
+		Attempts:      rec.Attempts,
+		Components:    make(map[service.Component]service.ComponentProgress, len(rec.Components)),
+		LastError:     rec.LastError,
+		NextAttemptAt: rec.NextAttemptAt,
+		CreatedAt:     rec.CreatedAt,
+		UpdatedAt:     rec.UpdatedAt,
+		FinishedAt:    rec.FinishedAt,
+	}
+
+	switch rec.Kind {
+	case persistence.TenantJobKindClone:
+		var params cloneParams
+		if err := json.Unmarshal(rec.Params, &params); err != nil {
+			return service.ProvisioningJob{}, fmt.Errorf("decode clone params: %w", err)
+		}
+		job.Kind = service.JobClone
+		job.Clone = &service.CloneSpec{SourceTenantID: params.SourceTenantID, IncludeData: params.IncludeData}
+		job.Copies = make(map[string]service.ComponentProgress)
+	case persistence.TenantJobKindMigrate:
+		var params migrateParams
+		if err := json.Unmarshal(rec.Params, &params); err != nil {
+			return service.ProvisioningJob{}, fmt.Errorf("decode migrate params: %w", err)
+		}
+		job.Kind = service.JobMigrate
+		job.Migration = &service.MigrationSpec{DryRun: params.DryRun}
+		job.Migrations = make(map[string]service.ComponentProgress)
+	}
+
+	for name, component := range rec.Components {
+		progress := service.ComponentProgress{
+			Status:    service.ComponentStatus(component.Status),
+			Attempts:  component.Attempts,
+			LastError: component.LastError,
+			UpdatedAt: component.UpdatedAt,
+		}
+		if table, ok := strings.CutPrefix(name, copyComponentPrefix); ok {
+			if job.Copies != nil {
+				job.Copies[table] = progress
+			}
+			continue
+		}
+		if version, ok := strings.CutPrefix(name, migrationComponentPrefix); ok {
+			if job.Migrations != nil {
+				job.Migrations[version] = progress
+			}
+			continue
+		}
+		job.Components[service.Component(name)] = progress
+	}
+	return job, nil
+}
+
+// Ensure interface compliance.
+var _ service.JobRepository = (*PostgresJobRepository)(nil)
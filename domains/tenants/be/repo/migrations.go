@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// PostgresSchemaMigrator applies the embedded migrations through persistence.Migrator. The admin tenant's schema
+// also receives the admin set and is migrated without switching role, like the migrate command does.
+type PostgresSchemaMigrator struct {
+	migrator    *persistence.Migrator
+	adminSchema string
+}
+
+// NewPostgresSchemaMigrator constructs a schema migrator; adminSchema identifies the admin tenant's space.
+func NewPostgresSchemaMigrator(migrator *persistence.Migrator, adminSchema string) *PostgresSchemaMigrator {
+	if migrator == nil {
+		panic("migrator is required")
+	}
+	return &PostgresSchemaMigrator{migrator: migrator, adminSchema: adminSchema}
+}
+
+func (m *PostgresSchemaMigrator) PendingMigrations(ctx context.Context, space tenant.Space) ([]string, error) {
+	sets := []string{persistence.TenantMigrationSet}
+	if space.SchemaName == m.adminSchema {
+		sets = []string{persistence.AdminMigrationSet, persistence.TenantMigrationSet}
+	}
+
+	var keys []string
+	for _, set := range sets {
+		pending, err := m.migrator.Pending(ctx, set, space.SchemaName)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, migrationKeys(pending)...)
+	}
+	return keys, nil
+}
+
+func (m *PostgresSchemaMigrator) ApplyMigrations(ctx context.Context, space tenant.Space) ([]string, error) {
+	var (
+		applied []persistence.Migration
+		err     error
+	)
+	if space.SchemaName == m.adminSchema {
+		applied, err = m.migrator.MigrateAdmin(ctx, space.SchemaName)
+	} else {
+		applied, err = m.migrator.MigrateTenant(ctx, space)
+	}
+	return migrationKeys(applied), err
+}
+
+func migrationKeys(migrations []persistence.Migration) []string {
+	keys := make([]string, 0, len(migrations))
+	for _, migration := range migrations {
+		keys = append(keys, migration.Set+"/"+migration.Version)
+	}
+	return keys
+}
+
+// Ensure interface compliance.
+var _ service.SchemaMigrator = (*PostgresSchemaMigrator)(nil)
@@ -10,7 +10,9 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // PostgresRepository implements the tenant repository using the shared persistence layer with immutable versions.
@@ -27,23 +29,20 @@ func NewPostgresRepository(store *persistence.TenantStore) *PostgresRepository {
 }
 
 func (r *PostgresRepository) List(ctx context.Context, opts service.ListOptions) (service.ListResult, error) {
-	page := opts.Page
-	if page < 1 {
-		page = 1
-	}
-	size := opts.PageSize
-	if size <= 0 {
-		size = 20
-	}
-	offset := (page - 1) * size
+	page := pagination.New(opts.Page, opts.PageSize)
 
-	var statusStr *string
+	params := persistence.TenantListParams{
+		Search:        opts.Search,
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
+		Sort:          opts.Sort,
+	}
 	if opts.Status != nil {
 		s := string(*opts.Status)
-		statusStr = &s
+		params.Status = &s
 	}
 
-	rows, total, err := r.store.ListActive(ctx, statusStr, size, offset)
+	rows, total, err := r.store.ListActive(ctx, params, page.Limit(), page.Offset())
 	if err != nil {
 		return service.ListResult{}, err
 	}
@@ -57,8 +56,7 @@ func (r *PostgresRepository) List(ctx context.Context, opts service.ListOptions)
 		tenants = append(tenants, t)
 	}
 
-	totalPages := (total + size - 1) / size
-	return service.ListResult{Tenants: tenants, Page: page, PageSize: size, TotalItems: total, TotalPages: totalPages}, nil
+	return service.ListResult{Tenants: tenants, Envelope: page.Envelope(total)}, nil
 }
 
 func (r *PostgresRepository) Create(ctx context.Context, t service.Tenant) (service.Tenant, error) {
@@ -95,6 +93,10 @@ func (r *PostgresRepository) FindBySlug(ctx context.Context, slug string) (servi
 	return toServiceTenant(rec)
 }
 
+func (r *PostgresRepository) ListCORSOrigins(ctx context.Context) (map[uuid.UUID][]string, error) {
+	return r.store.ListCORSOrigins(ctx)
+}
+
 func toRecord(t service.Tenant) persistence.TenantRecord {
 	return persistence.TenantRecord{
 		TenantID:          t.ID,
@@ -114,6 +116,16 @@ func toRecord(t service.Tenant) persistence.TenantRecord {
 		AuthReady:         t.Provisioning.AuthReady,
 		LastProvisionedAt: t.Provisioning.LastProvisionedAt,
 		LastError:         t.Provisioning.LastError,
+		MaxEntities:       t.Quotas.MaxEntities,
+		MaxSchemas:        t.Quotas.MaxSchemas,
+		MaxStorageBytes:   t.Quotas.MaxStorageBytes,
+		MaxUsers:          t.Quotas.MaxUsers,
+		MaxObjectBytes:    t.Quotas.MaxObjectBytes,
+		CORSOrigins:       t.CORSOrigins,
+		EmailFromName:     t.Email.FromName,
+		EmailFromAddress:  t.Email.FromAddress,
+		EmailReplyTo:      t.Email.ReplyTo,
+		NotifyEmail:       t.Email.NotifyAddress,
 	}
 }
 
@@ -143,6 +155,20 @@ func toServiceTenant(rec persistence.TenantRecord) (service.Tenant, error) {
 			LastProvisionedAt: rec.LastProvisionedAt,
 			LastError:         rec.LastError,
 		},
+		Quotas: tenant.Quotas{
+			MaxEntities:     rec.MaxEntities,
+			MaxSchemas:      rec.MaxSchemas,
+			MaxStorageBytes: rec.MaxStorageBytes,
+			MaxUsers:        rec.MaxUsers,
+			MaxObjectBytes:  rec.MaxObjectBytes,
+		},
+		CORSOrigins: rec.CORSOrigins,
+		Email: tenant.EmailSettings{
+			FromName:      rec.EmailFromName,
+			FromAddress:   rec.EmailFromAddress,
+			ReplyTo:       rec.EmailReplyTo,
+			NotifyAddress: rec.NotifyEmail,
+		},
 	}, nil
 }
 
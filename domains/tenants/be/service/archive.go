@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Errors returned by Export and Import.
+var (
+	ErrArchiveUnavailable    = errors.New("tenant archives are not configured")
+	ErrArchiveTenantNotReady = errors.New("tenant database is not provisioned")
+)
+
+// TenantArchiver writes a tenant space to a backup archive in storage and restores archives into a tenant.
+type TenantArchiver interface {
+	// Export writes the entities, users and settings of space to an archive and returns its storage location.
+	Export(ctx context.Context, space tenant.Space) (string, error)
+	// Import restores the archive at location into space and returns the settings it carried.
+	Import(ctx context.Context, space tenant.Space, location string) (tenant.Quotas, error)
+}
+
+// UseArchiver enables Export and Import.
+func (s *Service) UseArchiver(archiver TenantArchiver) {
+	s.archiver = archiver
+}
+
+// Export writes a full backup of the tenant to its storage prefix and returns where it was written.
+func (s *Service) Export(ctx context.Context, id uuid.UUID) (string, error) {
+	current, err := s.archivable(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	location, err := s.archiver.Export(ctx, spaceFor(current))
+	if err != nil {
+		return "", fmt.Errorf("export tenant: %w", err)
+	}
+	return location, nil
+}
+
+// Import restores the archive at location into a freshly provisioned tenant and applies the quotas it carried.
+func (s *Service) Import(ctx context.Context, id uuid.UUID, location string) (Tenant, error) {
+	location = strings.TrimSpace(location)
+	if location == "" {
+		return Tenant{}, fmt.Errorf("archive location is required")
+	}
+	current, err := s.archivable(ctx, id)
+	if err != nil {
+		return Tenant{}, err
+	}
+	quotas, err := s.archiver.Import(ctx, spaceFor(current), location)
+	if err != nil {
+		return Tenant{}, fmt.Errorf("import tenant: %w", err)
+	}
+	return s.Update(ctx, current.ID, UpdateInput{Quotas: &quotas})
+}
+
+// archivable loads a tenant and checks that its space can be exported or imported.
+func (s *Service) archivable(ctx context.Context, id uuid.UUID) (Tenant, error) {
+	if s.archiver == nil {
+		return Tenant{}, ErrArchiveUnavailable
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return Tenant{}, err
+	}
+	if current.Status == tenantsapi.Disabled {
+		return Tenant{}, ErrDisabled
+	}
+	if !current.Provisioning.DBReady {
+		return Tenant{}, ErrArchiveTenantNotReady
+	}
+	return current, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// stubArchiver records the spaces it exported and returns quotas on import.
+type stubArchiver struct {
+	exported []string
+	imported []string
+	quotas   tenant.Quotas
+}
+
+func (s *stubArchiver) Export(_ context.Context, space tenant.Space) (string, error) {
+	s.exported = append(s.exported, space.Slug)
+	return space.BasePrefix + "exports/" + space.Slug + ".tar.gz", nil
+}
+
+func (s *stubArchiver) Import(_ context.Context, space tenant.Space, location string) (tenant.Quotas, error) {
+	s.imported = append(s.imported, space.Slug+"<"+location)
+	return s.quotas, nil
+}
+
+func TestExportWritesUnderTenantPrefix(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("sigma-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+	_, err := svc.Export(context.Background(), tenantRecord.ID)
+	require.ErrorIs(t, err, ErrArchiveUnavailable)
+
+	archiver := &stubArchiver{}
+	svc.UseArchiver(archiver)
+	location, err := svc.Export(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Equal(t, tenantRecord.BasePrefix+"exports/sigma-co.tar.gz", location)
+	require.Equal(t, []string{"sigma-co"}, archiver.exported)
+}
+
+func TestImportAppliesArchivedQuotas(t *testing.T) {
+	repo := newInMemoryRepo()
+	target := newProvisionedTenant("tau-co")
+	_, _ = repo.Create(context.Background(), target)
+
+	maxUsers := int64(3)
+	archiver := &stubArchiver{quotas: tenant.Quotas{MaxUsers: &maxUsers}}
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+	svc.UseArchiver(archiver)
+
+	_, err := svc.Import(context.Background(), target.ID, " ")
+	require.Error(t, err)
+
+	updated, err := svc.Import(context.Background(), target.ID, "dev/sigma-co-1234/exports/sigma-co.tar.gz")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), *updated.Quotas.MaxUsers)
+	require.Equal(t, []string{"tau-co<dev/sigma-co-1234/exports/sigma-co.tar.gz"}, archiver.imported)
+}
+
+func TestImportRejectsUnprovisionedTenant(t *testing.T) {
+	repo := newInMemoryRepo()
+	target := newTenantRecord("upsilon-co")
+	_, _ = repo.Create(context.Background(), target)
+
+	archiver := &stubArchiver{}
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+	svc.UseArchiver(archiver)
+
+	_, err := svc.Import(context.Background(), target.ID, "dev/sigma-co-1234/exports/sigma-co.tar.gz")
+	require.ErrorIs(t, err, ErrArchiveTenantNotReady)
+	require.Empty(t, archiver.imported)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ChangeNotifier announces that a tenant's record changed so caches of its space can be dropped; implemented
+// by persistence.TenantChanges (Postgres NOTIFY).
+type ChangeNotifier interface {
+	NotifyTenantChanged(ctx context.Context, id uuid.UUID) error
+}
+
+// UseChangeNotifier makes every new tenant version announce itself through notifier.
+func (s *Service) UseChangeNotifier(notifier ChangeNotifier) {
+	s.changes = notifier
+}
+
+// appendVersion stores the next version of a tenant and announces the change.
+func (s *Service) appendVersion(ctx context.Context, next Tenant) (Tenant, error) {
+	updated, err := s.repo.AppendVersion(ctx, next)
+	if err != nil {
+		return Tenant{}, err
+	}
+	if s.changes != nil {
+		// The version is stored; a failed notification only leaves caches to expire on their own.
+		_ = s.changes.NotifyTenantChanged(ctx, updated.ID)
+	}
+	return updated, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Errors returned by Clone.
+var (
+	ErrCloneUnavailable    = errors.New("tenant cloning is not configured")
+	ErrCloneSourceNotReady = errors.New("source tenant database is not provisioned")
+)
+
+// TenantCloner copies tenant schema tables between spaces.
+type TenantCloner interface {
+	// CloneTables lists the tables of source that a clone copies.
+	CloneTables(ctx context.Context, source tenant.Space) ([]string, error)
+	// CopyTable creates table in target and copies its rows from source; entity rows only when includeData is set.
+	CopyTable(ctx context.Context, source, target tenant.Space, table string, includeData bool) error
+}
+
+// CloneInput describes the tenant created by Clone.
+type CloneInput struct {
+	Slug        string
+	DisplayName *string
+	IncludeData bool
+	CreatedBy   uuid.UUID
+}
+
+// CloneSpec is the input of a clone job.
+type CloneSpec struct {
+	SourceTenantID uuid.UUID
+	IncludeData    bool
+}
+
+// UseCloner enables Clone. Cloning also requires jobs (see UseJobs).
+func (s *Service) UseCloner(cloner TenantCloner) {
+	s.cloner = cloner
+}
+
+// Clone creates a new pending tenant with the settings (quotas) of the source and queues a clone job on it. The job
+// provisions the new tenant and then copies the source tables listed when the clone was requested.
+func (s *Service) Clone(ctx context.Context, sourceID uuid.UUID, input CloneInput) (ProvisioningJob, error) {
+	if s.jobs == nil || s.cloner == nil {
+		return ProvisioningJob{}, ErrCloneUnavailable
+	}
+	source, err := s.repo.Get(ctx, sourceID)
+	if err != nil {
+		return ProvisioningJob{}, err
+	}
+	if source.Status == tenantsapi.Disabled {
+		return ProvisioningJob{}, ErrDisabled
+	}
+	if !source.Provisioning.DBReady {
+		return ProvisioningJob{}, ErrCloneSourceNotReady
+	}
+
+	tables, err := s.cloner.CloneTables(ctx, spaceFor(source))
+	if err != nil {
+		return ProvisioningJob{}, err
+	}
+
+	target, err := s.Create(ctx, CreateInput{
+		Slug:        input.Slug,
+		DisplayName: input.DisplayName,
+		Status:      tenantsapi.Pending,
+		CreatedBy:   input.CreatedBy,
+		Quotas:      source.Quotas,
+		CORSOrigins: source.CORSOrigins,
+		Email:       source.Email,
+	})
+	if err != nil {
+		return ProvisioningJob{}, err
+	}
+
+	copies := make(map[string]ComponentProgress, len(tables))
+	for _, table := range tables {
+		copies[table] = ComponentProgress{Status: ComponentPending}
+	}
+	return s.jobs.EnqueueProvisioning(ctx, ProvisioningJob{
+		ID:         uuid.New(),
+		TenantID:   target.ID,
+		Kind:       JobClone,
+		Status:     JobQueued,
+		Components: pendingComponents(),
+		Clone:      &CloneSpec{SourceTenantID: source.ID, IncludeData: input.IncludeData},
+		Copies:     copies,
+	})
+}
+
+// attemptClone provisions the clone while any component is missing, then copies the tables that are not ready
+// yet. Like attemptProvisioning, only a missing source or target is a permanent error.
+func (s *Service) attemptClone(ctx context.Context, job ProvisioningJob) (ProvisioningJob, error) {
+	if job.Clone == nil {
+		return job, errors.New("clone job is missing its source")
+	}
+	if s.cloner == nil {
+		return job, ErrCloneUnavailable
+	}
+
+	if !job.provisioned() {
+		var err error
+		if job, err = s.attemptProvisioning(ctx, job); err != nil {
+			return job, err
+		}
+		if job.Components[ComponentDB].Status != ComponentReady {
+			return job, nil
+		}
+	} else {
+		job.LastError = nil
+	}
+
+	source, err := s.repo.Get(ctx, job.Clone.SourceTenantID)
+	if err != nil {
+		return job, fmt.Errorf("load clone source: %w", err)
+	}
+	if !source.Provisioning.DBReady {
+		return job, ErrCloneSourceNotReady
+	}
+	target, err := s.repo.Get(ctx, job.TenantID)
+	if err != nil {
+		return job, err
+	}
+
+	tables := make([]string, 0, len(job.Copies))
+	for table := range job.Copies {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	copies := make(map[string]ComponentProgress, len(job.Copies))
+	for _, table := range tables {
+		progress := job.Copies[table]
+		if progress.Status != ComponentReady {
+			now := time.Now().UTC()
+			progress.Attempts++
+			progress.UpdatedAt = &now
+			if err := s.cloner.CopyTable(ctx, spaceFor(source), spaceFor(target), table, job.Clone.IncludeData); err != nil {
+				msg := err.Error()
+				progress.Status, progress.LastError = ComponentFailed, &msg
+				if job.LastError == nil {
+					job.LastError = progress.LastError
+				}
+			} else {
+				progress.Status, progress.LastError = ComponentReady, nil
+			}
+		}
+		copies[table] = progress
+	}
+	job.Copies = copies
+	return job, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// stubCloner records copies and fails a table until failures runs out.
+type stubCloner struct {
+	tables   []string
+	failures map[string]int
+	copies   []string
+}
+
+func (s *stubCloner) CloneTables(context.Context, tenant.Space) ([]string, error) {
+	return s.tables, nil
+}
+
+func (s *stubCloner) CopyTable(_ context.Context, source, target tenant.Space, table string, includeData bool) error {
+	if source.TenantID == target.TenantID {
+		return errors.New("copying a tenant onto itself")
+	}
+	s.copies = append(s.copies, table)
+	if s.failures[table] > 0 {
+		s.failures[table]--
+		return errors.New("copy failed")
+	}
+	return nil
+}
+
+func TestCloneCreatesTenantAndCopiesTables(t *testing.T) {
+	repo := newInMemoryRepo()
+	source := newProvisionedTenant("omicron-co")
+	maxUsers := int64(5)
+	source.Quotas.MaxUsers = &maxUsers
+	_, _ = repo.Create(context.Background(), source)
+
+	svc, jobs := newJobService(t, repo, stubDB{ensureRes: DBProvisionResult{Ready: true}}, stubAuth{ensureRes: AuthProvisionResult{Ready: true}})
+	cloner := &stubCloner{tables: []string{"cards_entities", "users"}, failures: map[string]int{"users": 1}}
+	svc.UseCloner(cloner)
+	worker := newTestWorker(svc, 3)
+
+	job, err := svc.Clone(context.Background(), source.ID, CloneInput{Slug: "omicron-staging", IncludeData: true})
+	require.NoError(t, err)
+	require.Equal(t, JobClone, job.Kind)
+	require.NotEqual(t, source.ID, job.TenantID)
+	require.Equal(t, source.ID, job.Clone.SourceTenantID)
+	require.Len(t, job.Copies, 2)
+
+	clone, err := repo.Get(context.Background(), job.TenantID)
+	require.NoError(t, err)
+	require.Equal(t, tenantsapi.Pending, clone.Status)
+	require.Equal(t, int64(5), *clone.Quotas.MaxUsers)
+
+	require.NoError(t, worker.RunOnce(context.Background()))
+	job, _ = jobs.GetJob(context.Background(), job.TenantID, job.ID)
+	require.Equal(t, JobQueued, job.Status)
+	require.Equal(t, ComponentReady, job.Components[ComponentDB].Status)
+	require.Equal(t, ComponentReady, job.Copies["cards_entities"].Status)
+	require.Equal(t, ComponentFailed, job.Copies["users"].Status)
+	require.Equal(t, "copy failed", *job.LastError)
+
+	require.NoError(t, worker.RunOnce(context.Background()))
+	job, _ = jobs.GetJob(context.Background(), job.TenantID, job.ID)
+	require.Equal(t, JobSucceeded, job.Status)
+	require.Equal(t, 2, job.Copies["users"].Attempts)
+	require.Equal(t, 1, job.Copies["cards_entities"].Attempts)
+	require.Equal(t, []string{"cards_entities", "users", "users"}, cloner.copies)
+}
+
+func TestCloneRejectsUnprovisionedSource(t *testing.T) {
+	repo := newInMemoryRepo()
+	source := newTenantRecord("pi-co")
+	_, _ = repo.Create(context.Background(), source)
+
+	svc, _ := newJobService(t, repo, stubDB{}, stubAuth{})
+	_, err := svc.Clone(context.Background(), source.ID, CloneInput{Slug: "pi-staging"})
+	require.ErrorIs(t, err, ErrCloneUnavailable)
+
+	svc.UseCloner(&stubCloner{})
+	_, err = svc.Clone(context.Background(), source.ID, CloneInput{Slug: "pi-staging"})
+	require.ErrorIs(t, err, ErrCloneSourceNotReady)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ListCORSOrigins returns the origins configured on active tenants, by tenant id; the CORS middleware allows them for
+// the requests of their tenant on top of the deployment-wide list.
+func (s *Service) ListCORSOrigins(ctx context.Context) (map[uuid.UUID][]string, error) {
+	return s.repo.ListCORSOrigins(ctx)
+}
+
+// normalizeCORSOrigins validates origins as scheme://host[:port] and returns them lower-cased, sorted and without
+// duplicates. Wildcards are rejected: a tenant may only open the API to front-ends it names.
+func normalizeCORSOrigins(origins []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(origins))
+	out := make([]string, 0, len(origins))
+	for _, raw := range origins {
+		origin := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(raw), "/"))
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" ||
+			u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil || strings.Contains(u.Host, "*") {
+			return nil, fmt.Errorf("%w: %q must be an http(s) origin such as https://app.example.com", ErrInvalidCORSOrigin, raw)
+		}
+		if _, dup := seen[origin]; dup {
+			continue
+		}
+		seen[origin] = struct{}{}
+		out = append(out, origin)
+	}
+	sort.Strings(out)
+	return out, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrInvalidEmailSettings is returned when a tenant's email settings hold a malformed address or name.
+var ErrInvalidEmailSettings = errors.New("invalid tenant email settings")
+
+// UseMailer emails provisioning outcomes to the notify address of each tenant. Failed sends are logged to logger
+// and never fail the operation that triggered them.
+func (s *Service) UseMailer(m mailer.Mailer, logger *zap.Logger) {
+	if m == nil {
+		panic("mailer is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+	s.mailer, s.logger = m, logger
+}
+
+// normalizeEmailSettings trims the settings and clears blank ones. Addresses must be bare addresses such as
+// ops@acme.example.com; the display name goes in FromName.
+func normalizeEmailSettings(in tenant.EmailSettings) (tenant.EmailSettings, error) {
+	out := tenant.EmailSettings{
+		FromName:      trimmedOrNil(in.FromName),
+		FromAddress:   trimmedOrNil(in.FromAddress),
+		ReplyTo:       trimmedOrNil(in.ReplyTo),
+		NotifyAddress: trimmedOrNil(in.NotifyAddress),
+	}
+	if out.FromName != nil && strings.ContainsAny(*out.FromName, "\r\n") {
+		return tenant.EmailSettings{}, fmt.Errorf("%w: fromName must be a single line", ErrInvalidEmailSettings)
+	}
+	for field, value := range map[string]*string{
+		"fromAddress":   out.FromAddress,
+		"replyTo":       out.ReplyTo,
+		"notifyAddress": out.NotifyAddress,
+	} {
+		if value == nil {
+			continue
+		}
+		if addr, err := mail.ParseAddress(*value); err != nil || addr.Name != "" || addr.Address != *value {
+			return tenant.EmailSettings{}, fmt.Errorf("%w: %s %q must be an email address such as ops@example.com", ErrInvalidEmailSettings, field, *value)
+		}
+	}
+	return out, nil
+}
+
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// notifyProvisioned emails the tenant once provisioning takes it from pending or provisioning to active.
+func (s *Service) notifyProvisioned(ctx context.Context, prev, updated Tenant) {
+	if updated.Status != tenantsapi.Active || (prev.Status != tenantsapi.Pending && prev.Status != tenantsapi.Provisioning) {
+		return
+	}
+	s.notify(ctx, updated, mailer.TemplateTenantProvisioned, mailer.TenantData{
+		Workspace: mailer.Workspace(updated.Slug, updated.DisplayName),
+		Slug:      updated.Slug,
+	})
+}
+
+// notifyProvisioningFailed emails the tenant that a provisioning job gave up.
+func (s *Service) notifyProvisioningFailed(ctx context.Context, tenantID uuid.UUID, cause *string) {
+	if s.mailer == nil {
+		return
+	}
+	t, err := s.repo.Get(ctx, tenantID)
+	if err != nil {
+		platformlogging.FromContextOr(ctx, s.logger).Warn("loading tenant for provisioning notification failed", zap.String("tenant", tenantID.String()), zap.Error(err))
+		return
+	}
+	data := mailer.TenantData{Workspace: mailer.Workspace(t.Slug, t.DisplayName), Slug: t.Slug}
+	if cause != nil {
+		data.Error = *cause
+	}
+	s.notify(ctx, t, mailer.TemplateTenantProvisioningFailed, data)
+}
+
+// notify renders a platform notification to the tenant's notify address. Tenants without one are skipped.
+func (s *Service) notify(ctx context.Context, t Tenant, template string, data any) {
+	if s.mailer == nil || t.Email.NotifyAddress == nil {
+		return
+	}
+	msg, err := mailer.DefaultTemplates().Render(template, data)
+	if err == nil {
+		msg.To = *t.Email.NotifyAddress
+		err = s.mailer.Send(ctx, msg)
+	}
+	if err != nil {
+		platformlogging.FromContextOr(ctx, s.logger).Warn("tenant notification email failed",
+			zap.String("tenant", t.Slug),
+			zap.String("template", template),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the provisioning job operations.
+var (
+	ErrJobNotFound     = errors.New("provisioning job not found")
+	ErrJobsUnavailable = errors.New("provisioning jobs are not configured")
+)
+
+// JobKind distinguishes what a job does once the tenant is provisioned.
+type JobKind string
+
+const (
+	JobProvision JobKind = "provision"
+	JobClone     JobKind = "clone"
+	JobMigrate   JobKind = "migrate"
+)
+
+// JobStatus is the lifecycle state of a provisioning job.
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// Component identifies one provisioned resource.
+type Component string
+
+const (
+	ComponentDB      Component = "db"
+	ComponentAuth    Component = "auth"
+	ComponentStorage Component = "storage"
+)
+
+// provisioningComponents lists every component in the order they are provisioned and reported.
+var provisioningComponents = []Component{ComponentDB, ComponentAuth, ComponentStorage}
+
+// ComponentStatus is the progress of one component within a job. Failed components are retried while the job is open.
+type ComponentStatus string
+
+const (
+	ComponentPending ComponentStatus = "pending"
+	ComponentReady   ComponentStatus = "ready"
+	ComponentFailed  ComponentStatus = "failed"
+)
+
+// ComponentProgress tracks the attempts made for one component.
+type ComponentProgress struct {
+	Status    ComponentStatus
+	Attempts  int
+	LastError *string
+	UpdatedAt *time.Time
+}
+
+// ProvisioningJob is a queued provisioning run for a tenant. Clone jobs also carry the clone input and the
+// progress of every table copy, keyed by table name. Migrate jobs carry the migration input and the progress of
+// every pending schema migration, keyed by version.
+type ProvisioningJob struct {
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+	Kind          JobKind
+	Status        JobStatus
+	Attempts      int
+	Components    map[Component]ComponentProgress
+	Clone         *CloneSpec
+	Copies        map[string]ComponentProgress
+	Migration     *MigrationSpec
+	Migrations    map[string]ComponentProgress
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	FinishedAt    *time.Time
+}
+
+// Done reports whether every component, and every table copy of a clone job, is ready. A migrate job is done once
+// every migration is applied, or after its first attempt when it is a dry run.
+func (j ProvisioningJob) Done() bool {
+	if j.Kind == JobMigrate {
+		return j.migrated()
+	}
+	if !j.provisioned() {
+		return false
+	}
+	for _, progress := range j.Copies {
+		if progress.Status != ComponentReady {
+			return false
+		}
+	}
+	return true
+}
+
+func (j ProvisioningJob) provisioned() bool {
+	for _, component := range provisioningComponents {
+		if j.Components[component].Status != ComponentReady {
+			return false
+		}
+	}
+	return true
+}
+
+// pendingComponents returns the initial progress of every component of a new job.
+func pendingComponents() map[Component]ComponentProgress {
+	components := make(map[Component]ComponentProgress, len(provisioningComponents))
+	for _, component := range provisioningComponents {
+		components[component] = ComponentProgress{Status: ComponentPending}
+	}
+	return components
+}
+
+// JobRepository persists provisioning jobs.
+type JobRepository interface {
+	// EnqueueProvisioning stores a queued job, or returns the tenant's open provisioning job if one exists.
+	EnqueueProvisioning(ctx context.Context, job ProvisioningJob) (ProvisioningJob, error)
+	GetJob(ctx context.Context, tenantID, jobID uuid.UUID) (ProvisioningJob, error)
+	// ClaimDueJobs leases open jobs whose next attempt is due, marking them running.
+	ClaimDueJobs(ctx context.Context, limit int, lease time.Duration) ([]ProvisioningJob, error)
+	SaveJob(ctx context.Context, job ProvisioningJob) error
+}
+
+// UseJobs enables asynchronous provisioning through EnqueueProvision and the ProvisioningWorker.
+func (s *Service) UseJobs(jobs JobRepository) {
+	s.jobs = jobs
+}
+
+// EnqueueProvision queues a provisioning job for the tenant and returns it; the ProvisioningWorker runs it.
+func (s *Service) EnqueueProvision(ctx context.Context, id uuid.UUID) (ProvisioningJob, error) {
+	if s.jobs == nil {
+		return ProvisioningJob{}, ErrJobsUnavailable
+	}
+	if _, err := s.provisionable(ctx, id); err != nil {
+		return ProvisioningJob{}, err
+	}
+
+	return s.jobs.EnqueueProvisioning(ctx, ProvisioningJob{
+		ID:         uuid.New(),
+		TenantID:   id,
+		Kind:       JobProvision,
+		Status:     JobQueued,
+		Components: pendingComponents(),
+	})
+}
+
+// GetProvisioningJob returns a provisioning job of the tenant.
+func (s *Service) GetProvisioningJob(ctx context.Context, tenantID, jobID uuid.UUID) (ProvisioningJob, error) {
+	if s.jobs == nil {
+		return ProvisioningJob{}, ErrJobsUnavailable
+	}
+	return s.jobs.GetJob(ctx, tenantID, jobID)
+}
+
+// attemptJob runs one attempt of job according to its kind.
+func (s *Service) attemptJob(ctx context.Context, job ProvisioningJob) (ProvisioningJob, error) {
+	switch job.Kind {
+	case JobClone:
+		return s.attemptClone(ctx, job)
+	case JobMigrate:
+		return s.attemptMigration(ctx, job)
+	}
+	return s.attemptProvisioning(ctx, job)
+}
+
+// attemptProvisioning ensures the components of job that are not ready yet and records the result on the tenant.
+// The returned error is permanent (the tenant cannot be provisioned at all); component failures are reported on
+// the job instead so the caller can schedule a retry.
+func (s *Service) attemptProvisioning(ctx context.Context, job ProvisioningJob) (ProvisioningJob, error) {
+	current, err := s.provisionable(ctx, job.TenantID)
+	if err != nil {
+		return job, err
+	}
+
+	pending := make([]Component, 0, len(provisioningComponents))
+	for _, component := range provisioningComponents {
+		if job.Components[component].Status != ComponentReady {
+			pending = append(pending, component)
+		}
+	}
+	outcomes := s.ensureComponents(ctx, current, pending)
+
+	now := time.Now().UTC()
+	components := make(map[Component]ComponentProgress, len(provisioningComponents))
+	job.LastError = nil
+	for _, component := range provisioningComponents {
+		progress := job.Components[component]
+		if progress.Status == "" {
+			progress.Status = ComponentPending
+		}
+		if outcome, ok := outcomes[component]; ok {
+			progress.Attempts++
+			progress.UpdatedAt = &now
+			switch {
+			case outcome.err != nil:
+				msg := outcome.err.Error()
+				progress.Status, progress.LastError = ComponentFailed, &msg
+			case !outcome.ready:
+				msg := fmt.Sprintf("%s not ready", component)
+				progress.Status, progress.LastError = ComponentFailed, &msg
+			default:
+				progress.Status, progress.LastError = ComponentReady, nil
+			}
+			if progress.LastError != nil && job.LastError == nil {
+				job.LastError = progress.LastError
+			}
+		} else {
+			outcomes[component] = componentOutcome{ready: true}
+		}
+		components[component] = progress
+	}
+	job.Components = components
+
+	if _, err := s.recordProvisioning(ctx, current, outcomes); err != nil {
+		msg := fmt.Sprintf("record provisioning: %v", err)
+		job.LastError = &msg
+	}
+	return job, nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Errors returned by EnqueueMigrations.
+var (
+	ErrMigrationsUnavailable   = errors.New("schema migrations are not configured")
+	ErrMigrationTenantNotReady = errors.New("tenant database is not provisioned")
+)
+
+// SchemaMigrator applies the versioned migrations to a tenant schema. Migrations are identified by a sortable
+// "<set>/<version>" key.
+type SchemaMigrator interface {
+	// PendingMigrations lists the migrations not yet applied to the space, in the order they apply.
+	PendingMigrations(ctx context.Context, space tenant.Space) ([]string, error)
+	// ApplyMigrations applies the pending migrations in order, stopping at the first failure, and returns the ones
+	// applied.
+	ApplyMigrations(ctx context.Context, space tenant.Space) ([]string, error)
+}
+
+// MigrateInput selects the tenants EnqueueMigrations queues a job for. Without TenantIDs every active tenant with a
+// provisioned database is migrated. DryRun jobs only record the pending migrations.
+type MigrateInput struct {
+	TenantIDs []uuid.UUID
+	DryRun    bool
+}
+
+// MigrationSpec is the input of a migrate job.
+type MigrationSpec struct {
+	DryRun bool
+}
+
+// UseMigrator enables EnqueueMigrations. Migrations also require jobs (see UseJobs).
+func (s *Service) UseMigrator(migrator SchemaMigrator) {
+	s.migrator = migrator
+}
+
+// EnqueueMigrations queues a migrate job for every selected tenant and returns the jobs, one per tenant. A tenant
+// that already has an open migrate job gets that job back. The ProvisioningWorker runs them; its concurrency bounds
+// how many tenant schemas migrate at once.
+func (s *Service) EnqueueMigrations(ctx context.Context, input MigrateInput) ([]ProvisioningJob, error) {
+	if s.jobs == nil || s.migrator == nil {
+		return nil, ErrMigrationsUnavailable
+	}
+
+	targets, err := s.migrationTargets(ctx, input.TenantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]ProvisioningJob, 0, len(targets))
+	for _, t := range targets {
+		job, err := s.jobs.EnqueueProvisioning(ctx, ProvisioningJob{
+			ID:         uuid.New(),
+			TenantID:   t.ID,
+			Kind:       JobMigrate,
+			Status:     JobQueued,
+			Components: provisionedComponents(t.Provisioning),
+			Migration:  &MigrationSpec{DryRun: input.DryRun},
+			Migrations: map[string]ComponentProgress{},
+		})
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (s *Service) migrationTargets(ctx context.Context, ids []uuid.UUID) ([]Tenant, error) {
+	if len(ids) == 0 {
+		return s.listActiveProvisioned(ctx)
+	}
+
+	targets := make([]Tenant, 0, len(ids))
+	for _, id := range ids {
+		t, err := s.migratable(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// migratable returns the tenant when its schema can be migrated.
+func (s *Service) migratable(ctx context.Context, id uuid.UUID) (Tenant, error) {
+	t, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return Tenant{}, err
+	}
+	if t.Status == tenantsapi.Disabled {
+		return Tenant{}, ErrDisabled
+	}
+	if !t.Provisioning.DBReady {
+		return Tenant{}, ErrMigrationTenantNotReady
+	}
+	return t, nil
+}
+
+// provisionedComponents reports the tenant's provisioning state as job components, so migrate jobs show which
+// resources the tenant has without provisioning anything.
+func provisionedComponents(status ProvisioningStatus) map[Component]ComponentProgress {
+	ready := map[Component]bool{
+		ComponentDB:      status.DBReady,
+		ComponentAuth:    status.AuthReady,
+		ComponentStorage: status.StorageReady,
+	}
+	components := make(map[Component]ComponentProgress, len(provisioningComponents))
+	for _, component := range provisioningComponents {
+		progress := ComponentProgress{Status: ComponentPending}
+		if ready[component] {
+			progress.Status = ComponentReady
+		}
+		components[component] = progress
+	}
+	return components
+}
+
+func (j ProvisioningJob) migrated() bool {
+	if j.Migration != nil && j.Migration.DryRun {
+		return true
+	}
+	for _, progress := range j.Migrations {
+		if progress.Status != ComponentReady {
+			return false
+		}
+	}
+	return true
+}
+
+// attemptMigration records the pending migrations of the tenant and, unless the job is a dry run, applies them.
+// Only a tenant that can no longer be migrated is a permanent error; migration failures are reported on the job so
+// the worker retries them.
+func (s *Service) attemptMigration(ctx context.Context, job ProvisioningJob) (ProvisioningJob, error) {
+	if job.Migration == nil {
+		return job, errors.New("migrate job is missing its input")
+	}
+	if s.migrator == nil {
+		return job, ErrMigrationsUnavailable
+	}
+	current, err := s.migratable(ctx, job.TenantID)
+	if err != nil {
+		return job, err
+	}
+	space := spaceFor(current)
+
+	job.LastError = nil
+	pending, err := s.migrator.PendingMigrations(ctx, space)
+	if err != nil {
+		msg := err.Error()
+		job.LastError = &msg
+		return job, nil
+	}
+
+	migrations := make(map[string]ComponentProgress, len(job.Migrations)+len(pending))
+	for version, progress := range job.Migrations {
+		migrations[version] = progress
+	}
+	for _, version := range pending {
+		if _, ok := migrations[version]; !ok {
+			migrations[version] = ComponentProgress{Status: ComponentPending}
+		}
+	}
+	job.Migrations = migrations
+	if job.Migration.DryRun || len(pending) == 0 {
+		return job, nil
+	}
+
+	applied, err := s.migrator.ApplyMigrations(ctx, space)
+	now := time.Now().UTC()
+	for i, version := range pending {
+		progress := migrations[version]
+		switch {
+		case i < len(applied):
+			progress.Attempts++
+			progress.Status, progress.LastError, progress.UpdatedAt = ComponentReady, nil, &now
+		case i == len(applied) && err != nil:
+			msg := err.Error()
+			progress.Attempts++
+			progress.Status, progress.LastError, progress.UpdatedAt = ComponentFailed, &msg, &now
+			job.LastError = progress.LastError
+		}
+		migrations[version] = progress
+	}
+	return job, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// stubMigrator keeps the applied migrations per schema and fails a schema's version until failures runs out.
+type stubMigrator struct {
+	mu         sync.Mutex
+	migrations []string
+	failures   map[string]map[string]int
+	applied    map[string]map[string]bool
+}
+
+func newStubMigrator(migrations ...string) *stubMigrator {
+	return &stubMigrator{migrations: migrations, failures: map[string]map[string]int{}, applied: map[string]map[string]bool{}}
+}
+
+func (s *stubMigrator) PendingMigrations(_ context.Context, space tenant.Space) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := make([]string, 0)
+	for _, version := range s.migrations {
+		if !s.applied[space.SchemaName][version] {
+			pending = append(pending, version)
+		}
+	}
+	return pending, nil
+}
+
+func (s *stubMigrator) ApplyMigrations(_ context.Context, space tenant.Space) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.applied[space.SchemaName] == nil {
+		s.applied[space.SchemaName] = map[string]bool{}
+	}
+	applied := make([]string, 0)
+	for _, version := range s.migrations {
+		if s.applied[space.SchemaName][version] {
+			continue
+		}
+		if s.failures[space.SchemaName][version] > 0 {
+			s.failures[space.SchemaName][version]--
+			return applied, errors.New("migration failed")
+		}
+		s.applied[space.SchemaName][version] = true
+		applied = append(applied, version)
+	}
+	return applied, nil
+}
+
+func TestEnqueueMigrationsAppliesPendingMigrationsPerTenant(t *testing.T) {
+	repo := newInMemoryRepo()
+	first, second := newProvisionedTenant("rho-co"), newProvisionedTenant("sigma-co")
+	first.SchemaName, second.SchemaName = "tenant_rho_co", "tenant_sigma_co"
+	_, _ = repo.Create(context.Background(), first)
+	_, _ = repo.Create(context.Background(), second)
+
+	svc, jobs := newJobService(t, repo, stubDB{}, stubAuth{})
+	migrator := newStubMigrator("tenant/001_users", "tenant/002_indexes")
+	migrator.failures[first.SchemaName] = map[string]int{"tenant/002_indexes": 1}
+	migrator.applied[second.SchemaName] = map[string]bool{"tenant/001_users": true}
+	svc.UseMigrator(migrator)
+	worker := newTestWorker(svc, 3)
+
+	queued, err := svc.EnqueueMigrations(context.Background(), MigrateInput{TenantIDs: []uuid.UUID{first.ID, second.ID}})
+	require.NoError(t, err)
+	require.Len(t, queued, 2)
+	require.Equal(t, JobMigrate, queued[0].Kind)
+	require.Equal(t, ComponentReady, queued[0].Components[ComponentDB].Status)
+
+	require.NoError(t, worker.RunOnce(context.Background()))
+	job, _ := jobs.GetJob(context.Background(), first.ID, queued[0].ID)
+	require.Equal(t, JobQueued, job.Status)
+	require.Equal(t, ComponentReady, job.Migrations["tenant/001_users"].Status)
+	require.Equal(t, ComponentFailed, job.Migrations["tenant/002_indexes"].Status)
+	require.Equal(t, "migration failed", *job.LastError)
+
+	job, _ = jobs.GetJob(context.Background(), second.ID, queued[1].ID)
+	require.Equal(t, JobSucceeded, job.Status)
+	require.Len(t, job.Migrations, 1)
+	require.Equal(t, ComponentReady, job.Migrations["tenant/002_indexes"].Status)
+
+	require.NoError(t, worker.RunOnce(context.Background()))
+	job, _ = jobs.GetJob(context.Background(), first.ID, queued[0].ID)
+	require.Equal(t, JobSucceeded, job.Status)
+	require.Equal(t, 1, job.Migrations["tenant/001_users"].Attempts)
+	require.Equal(t, 2, job.Migrations["tenant/002_indexes"].Attempts)
+}
+
+func TestEnqueueMigrationsDryRunOnlyRecordsPending(t *testing.T) {
+	repo := newInMemoryRepo()
+	target := newProvisionedTenant("tau-co")
+	_, _ = repo.Create(context.Background(), target)
+
+	svc, jobs := newJobService(t, repo, stubDB{}, stubAuth{})
+	migrator := newStubMigrator("tenant/001_users")
+	svc.UseMigrator(migrator)
+
+	queued, err := svc.EnqueueMigrations(context.Background(), MigrateInput{TenantIDs: []uuid.UUID{target.ID}, DryRun: true})
+	require.NoError(t, err)
+	require.NoError(t, newTestWorker(svc, 3).RunOnce(context.Background()))
+
+	job, _ := jobs.GetJob(context.Background(), target.ID, queued[0].ID)
+	require.Equal(t, JobSucceeded, job.Status)
+	require.Equal(t, ComponentPending, job.Migrations["tenant/001_users"].Status)
+	require.Empty(t, migrator.applied)
+}
+
+func TestEnqueueMigrationsRejectsUnprovisionedTenant(t *testing.T) {
+	repo := newInMemoryRepo()
+	target := newTenantRecord("upsilon-co")
+	_, _ = repo.Create(context.Background(), target)
+
+	svc, _ := newJobService(t, repo, stubDB{}, stubAuth{})
+	_, err := svc.EnqueueMigrations(context.Background(), MigrateInput{TenantIDs: []uuid.UUID{target.ID}})
+	require.ErrorIs(t, err, ErrMigrationsUnavailable)
+
+	svc.UseMigrator(newStubMigrator())
+	_, err = svc.EnqueueMigrations(context.Background(), MigrateInput{TenantIDs: []uuid.UUID{target.ID}})
+	require.ErrorIs(t, err, ErrMigrationTenantNotReady)
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // DBProvisioner encapsulates creation/check of tenant-specific DB artifacts (role, schema, grants, base tables).
-// Ensure is mutating/idempotent, Check is read-only/health verification.
+// Ensure is mutating/idempotent, Check is read-only/health verification, Teardown drops the schema and role and is
+// idempotent as well. With dryRun, Teardown drops them and rolls back, reporting what a real run removes.
 type DBProvisioner interface {
 	Ensure(ctx context.Context, req DBProvisionRequest) (DBProvisionResult, error)
 	Check(ctx context.Context, req DBProvisionRequest) (DBProvisionResult, error)
+	Teardown(ctx context.Context, req DBProvisionRequest, dryRun bool) (DBTeardownResult, error)
 }
 
 type DBProvisionRequest struct {
@@ -23,11 +27,26 @@ type DBProvisionResult struct {
 	Ready bool
 }
 
+// DBTeardownResult lists what a DB teardown dropped, or would drop on a dry run.
+type DBTeardownResult struct {
+	SchemaExists bool
+	RoleExists   bool
+	Tables       []TableRows
+}
+
+// TableRows is a table of the tenant schema and the rows it held.
+type TableRows struct {
+	Name string
+	Rows int64
+}
+
 // AuthProvisioner manages external auth tenant creation/check.
-// Ensure is mutating/idempotent, Check is read-only/health verification.
+// Ensure is mutating/idempotent, Check is read-only/health verification, Teardown disables the auth tenant, or
+// only checks that it could with dryRun.
 type AuthProvisioner interface {
 	Ensure(ctx context.Context, externalTenant string) (AuthProvisionResult, error)
 	Check(ctx context.Context, externalTenant string) (AuthProvisionResult, error)
+	Teardown(ctx context.Context, externalTenant string, dryRun bool) error
 }
 
 type AuthProvisionResult struct {
@@ -35,18 +54,34 @@ type AuthProvisionResult struct {
 }
 
 // StorageProvisioner validates storage reachability.
-// Ensure is mutating/idempotent, Check is read-only/health verification.
+// Ensure is mutating/idempotent, Check is read-only/health verification, Teardown removes everything under the prefix.
+// With dryRun, Teardown walks the prefix the same way and only counts what it would remove.
 type StorageProvisioner interface {
 	Ensure(ctx context.Context, prefix string) (StorageProvisionResult, error)
 	Check(ctx context.Context, prefix string) (StorageProvisionResult, error)
+	Teardown(ctx context.Context, prefix string, dryRun bool) (StorageTeardownResult, error)
 }
 
 type StorageProvisionResult struct {
 	Ready bool
 }
 
+// StorageTeardownResult counts the objects a storage teardown removed, or would remove on a dry run.
+type StorageTeardownResult struct {
+	Objects int64
+	Bytes   int64
+}
+
+// TenantExporter snapshots a tenant's data before it is deprovisioned and returns where the export was written.
+type TenantExporter interface {
+	Export(ctx context.Context, space tenant.Space) (string, error)
+}
+
+// ProvisioningDeps groups the provisioners used by the tenant service. Exporter is optional; without it
+// deprovisioning requests that ask for an export are rejected.
 type ProvisioningDeps struct {
-	DB      DBProvisioner
-	Auth    AuthProvisioner
-	Storage StorageProvisioner
+	DB       DBProvisioner
+	Auth     AuthProvisioner
+	Storage  StorageProvisioner
+	Exporter TenantExporter
 }
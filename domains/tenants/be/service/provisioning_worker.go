@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ProvisioningWorkerConfig tunes the background provisioning loop. Zero values fall back to defaults. Concurrency
+// is how many claimed jobs run at once (one by default).
+type ProvisioningWorkerConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	Concurrency int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	Lease       time.Duration
+}
+
+// ProvisioningWorker periodically claims queued provisioning jobs and runs them, retrying failed components
+// with exponential backoff until they are ready or MaxAttempts is reached.
+type ProvisioningWorker struct {
+	svc    *Service
+	logger *zap.Logger
+	cfg    ProvisioningWorkerConfig
+	now    func() time.Time
+}
+
+// NewProvisioningWorker constructs a worker with defaults applied to cfg. svc must have jobs enabled via UseJobs.
+func NewProvisioningWorker(svc *Service, logger *zap.Logger, cfg ProvisioningWorkerConfig) *ProvisioningWorker {
+	if svc == nil || svc.jobs == nil {
+		panic("tenant service with provisioning jobs is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 10
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 6
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 10 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 10 * time.Minute
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 5 * time.Minute
+	}
+
+	return &ProvisioningWorker{svc: svc, logger: logger, cfg: cfg, now: time.Now}
+}
+
+// Run processes due jobs every Interval until ctx is cancelled.
+func (w *ProvisioningWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			w.logger.Error("provisioning jobs failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims a batch of due jobs and runs one attempt of each, up to Concurrency at a time.
+func (w *ProvisioningWorker) RunOnce(ctx context.Context) error {
+	jobs, err := w.svc.jobs.ClaimDueJobs(ctx, w.cfg.BatchSize, w.cfg.Lease)
+	if err != nil {
+		return err
+	}
+
+	slots := make(chan struct{}, w.cfg.Concurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(job ProvisioningJob) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := w.svc.jobs.SaveJob(ctx, w.process(ctx, job)); err != nil {
+				w.logger.Error("save provisioning job failed", zap.String("job", job.ID.String()), zap.Error(err))
+			}
+		}(job)
+	}
+	return nil
+}
+
+// process runs one attempt and decides whether the job is finished or scheduled for a retry.
+func (w *ProvisioningWorker) process(ctx context.Context, job ProvisioningJob) ProvisioningJob {
+	updated, err := w.svc.attemptJob(ctx, job)
+	now := w.now().UTC()
+
+	switch {
+	case err != nil:
+		msg := err.Error()
+		updated.Status, updated.LastError, updated.FinishedAt = JobFailed, &msg, &now
+	case updated.Done() && updated.LastError == nil:
+		updated.Status, updated.FinishedAt = JobSucceeded, &now
+	case updated.Attempts < w.cfg.MaxAttempts:
+		updated.Status = JobQueued
+		updated.NextAttemptAt = now.Add(w.backoff(updated.Attempts))
+	default:
+		updated.Status, updated.FinishedAt = JobFailed, &now
+	}
+
+	if updated.Status == JobFailed {
+		w.logger.Warn("provisioning job failed",
+			zap.String("job", updated.ID.String()),
+			zap.String("tenant", updated.TenantID.String()),
+			zap.Stringp("error", updated.LastError))
+		if updated.Kind != JobMigrate {
+			w.svc.notifyProvisioningFailed(ctx, updated.TenantID, updated.LastError)
+		}
+	}
+	return updated
+}
+
+func (w *ProvisioningWorker) backoff(attempt int) time.Duration {
+	delay := w.cfg.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= w.cfg.MaxBackoff {
+			return w.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
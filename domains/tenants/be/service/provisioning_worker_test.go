@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+)
+
+// inMemoryJobs is a minimal in-memory JobRepository for tests.
+type inMemoryJobs struct {
+	mu   sync.Mutex
+	data map[uuid.UUID]ProvisioningJob
+}
+
+func newInMemoryJobs() *inMemoryJobs {
+	return &inMemoryJobs{data: make(map[uuid.UUID]ProvisioningJob)}
+}
+
+func (r *inMemoryJobs) EnqueueProvisioning(ctx context.Context, job ProvisioningJob) (ProvisioningJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.data {
+		if existing.TenantID == job.TenantID && (existing.Status == JobQueued || existing.Status == JobRunning) {
+			return existing, nil
+		}
+	}
+	r.data[job.ID] = job
+	return job, nil
+}
+
+func (r *inMemoryJobs) GetJob(ctx context.Context, tenantID, jobID uuid.UUID) (ProvisioningJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.data[jobID]
+	if !ok || job.TenantID != tenantID {
+		return ProvisioningJob{}, ErrJobNotFound
+	}
+	return job, nil
+}
+
+func (r *inMemoryJobs) ClaimDueJobs(ctx context.Context, limit int, lease time.Duration) ([]ProvisioningJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	claimed := make([]ProvisioningJob, 0)
+	for id, job := range r.data {
+		if (job.Status == JobQueued || job.Status == JobRunning) && !job.NextAttemptAt.After(time.Now()) && len(claimed) < limit {
+			job.Status = JobRunning
+			job.Attempts++
+			r.data[id] = job
+			claimed = append(claimed, job)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *inMemoryJobs) SaveJob(ctx context.Context, job ProvisioningJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.data[job.ID] = job
+	return nil
+}
+
+// flakyDB fails Ensure for the first failures calls.
+type flakyDB struct {
+	stubDB
+	failures int
+	calls    *int
+}
+
+func (s flakyDB) Ensure(context.Context, DBProvisionRequest) (DBProvisionResult, error) {
+	*s.calls++
+	if *s.calls <= s.failures {
+		return DBProvisionResult{}, errors.New("db unavailable")
+	}
+	return DBProvisionResult{Ready: true}, nil
+}
+
+// countingAuth counts Ensure calls.
+type countingAuth struct {
+	stubAuth
+	calls *int
+}
+
+func (s countingAuth) Ensure(context.Context, string) (AuthProvisionResult, error) {
+	*s.calls++
+	return AuthProvisionResult{Ready: true}, nil
+}
+
+func newJobService(t *testing.T, repo *inMemoryRepo, db DBProvisioner, auth AuthProvisioner) (*Service, *inMemoryJobs) {
+	t.Helper()
+	svc := New(repo, "dev", ProvisioningDeps{
+		DB:      db,
+		Auth:    auth,
+		Storage: stubStorage{res: StorageProvisionResult{Ready: true}},
+	})
+	jobs := newInMemoryJobs()
+	svc.UseJobs(jobs)
+	return svc, jobs
+}
+
+func newTestWorker(svc *Service, maxAttempts int) *ProvisioningWorker {
+	worker := NewProvisioningWorker(svc, zap.NewNop(), ProvisioningWorkerConfig{MaxAttempts: maxAttempts})
+	// Run in the past so retries are immediately due.
+	worker.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	return worker
+}
+
+func TestEnqueueProvisionReusesOpenJob(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("eta-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc, _ := newJobService(t, repo, stubDB{}, stubAuth{})
+
+	first, err := svc.EnqueueProvision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Equal(t, JobQueued, first.Status)
+	require.Equal(t, ComponentPending, first.Components[ComponentDB].Status)
+
+	second, err := svc.EnqueueProvision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, second.ID)
+
+	_, err = svc.GetProvisioningJob(context.Background(), uuid.New(), first.ID)
+	require.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestEnqueueProvisionRejectsDisabledTenant(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("theta-co")
+	tenantRecord.Status = tenantsapi.Disabled
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc, _ := newJobService(t, repo, stubDB{}, stubAuth{})
+
+	_, err := svc.EnqueueProvision(context.Background(), tenantRecord.ID)
+	require.ErrorIs(t, err, ErrDisabled)
+}
+
+func TestProvisioningWorkerRetriesOnlyFailedComponents(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("iota-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	dbCalls, authCalls := 0, 0
+	svc, jobs := newJobService(t, repo, flakyDB{failures: 1, calls: &dbCalls}, countingAuth{calls: &authCalls})
+	worker := newTestWorker(svc, 3)
+
+	job, err := svc.EnqueueProvision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, worker.RunOnce(context.Background()))
+	job, _ = jobs.GetJob(context.Background(), tenantRecord.ID, job.ID)
+	require.Equal(t, JobQueued, job.Status)
+	require.Equal(t, ComponentFailed, job.Components[ComponentDB].Status)
+	require.Equal(t, ComponentReady, job.Components[ComponentAuth].Status)
+	require.Equal(t, ComponentReady, job.Components[ComponentStorage].Status)
+	require.Equal(t, "db unavailable", *job.LastError)
+
+	require.NoError(t, worker.RunOnce(context.Background()))
+	job, _ = jobs.GetJob(context.Background(), tenantRecord.ID, job.ID)
+	require.Equal(t, JobSucceeded, job.Status)
+	require.Equal(t, 2, job.Attempts)
+	require.Equal(t, 2, job.Components[ComponentDB].Attempts)
+	require.Equal(t, 1, job.Components[ComponentAuth].Attempts)
+	require.Nil(t, job.LastError)
+	require.NotNil(t, job.FinishedAt)
+	require.Equal(t, 1, authCalls)
+
+	updated, _ := repo.Get(context.Background(), tenantRecord.ID)
+	require.Equal(t, tenantsapi.Active, updated.Status)
+	require.True(t, updated.Provisioning.DBReady)
+}
+
+func TestProvisioningWorkerGivesUpAfterMaxAttempts(t *testing.T) {
+	repo := newInMemoryRepo()
+	notify := "ops@kappa.example.com"
+	tenantRecord := newTenantRecord("kappa-co")
+	tenantRecord.Email.NotifyAddress = &notify
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	dbCalls, authCalls := 0, 0
+	svc, jobs := newJobService(t, repo, flakyDB{failures: 10, calls: &dbCalls}, countingAuth{calls: &authCalls})
+	mail := &recordingMailer{}
+	svc.UseMailer(mail, zap.NewNop())
+	worker := newTestWorker(svc, 2)
+
+	job, err := svc.EnqueueProvision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, worker.RunOnce(context.Background()))
+	}
+
+	job, _ = jobs.GetJob(context.Background(), tenantRecord.ID, job.ID)
+	require.Equal(t, JobFailed, job.Status)
+	require.Equal(t, 2, job.Attempts)
+	require.Equal(t, 2, dbCalls)
+	require.NotNil(t, job.FinishedAt)
+
+	updated, _ := repo.Get(context.Background(), tenantRecord.ID)
+	require.Equal(t, tenantsapi.Provisioning, updated.Status)
+
+	require.Len(t, mail.sent, 1, "only the final failure is emailed")
+	require.Equal(t, notify, mail.sent[0].To)
+	require.Equal(t, "Provisioning kappa-co failed", mail.sent[0].Subject)
+	require.Contains(t, mail.sent[0].Text, *job.LastError)
+}
+
+func TestProvisioningWorkerBackoffIsCapped(t *testing.T) {
+	repo := newInMemoryRepo()
+	svc, _ := newJobService(t, repo, stubDB{}, stubAuth{})
+	worker := NewProvisioningWorker(svc, zap.NewNop(), ProvisioningWorkerConfig{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+	require.Equal(t, time.Second, worker.backoff(1))
+	require.Equal(t, 4*time.Second, worker.backoff(3))
+	require.Equal(t, 5*time.Second, worker.backoff(10))
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+)
+
+// ReconcileProvisioning runs the live provisioning check of every provisioning and active tenant, so tenants whose
+// resources became ready are promoted to active and active tenants that lost a resource fall back to provisioning
+// without waiting for an operator to ask for their status. Failures in one tenant do not stop the others; they are
+// returned together.
+func (s *Service) ReconcileProvisioning(ctx context.Context) error {
+	var errs []error
+	for _, status := range []tenantsapi.TenantStatus{tenantsapi.Provisioning, tenantsapi.Active} {
+		tenants, err := s.listByStatus(ctx, status)
+		if err != nil {
+			return fmt.Errorf("list %s tenants: %w", status, err)
+		}
+		for _, t := range tenants {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if _, err := s.ProvisionStatus(ctx, t.ID); err != nil {
+				errs = append(errs, fmt.Errorf("tenant %s: %w", t.Slug, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
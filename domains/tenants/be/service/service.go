@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
@@ -21,6 +24,11 @@ var (
 	ErrDisabled       = errors.New("tenant disabled")
 	ErrNotImplemented = errors.New("provisioning not implemented yet")
 	ErrEnvMismatch    = errors.New("tenant environment mismatch")
+
+	ErrConfirmationMismatch = errors.New("confirmation slug does not match tenant slug")
+	ErrExportUnavailable    = errors.New("tenant export is not configured")
+	ErrInvalidListOptions   = errors.New("invalid tenant list options")
+	ErrInvalidCORSOrigin    = errors.New("invalid CORS origin")
 )
 
 // Tenant represents the domain model for a tenant registry entry.
@@ -37,6 +45,9 @@ type Tenant struct {
 	CreatedAt     time.Time
 	CreatedBy     uuid.UUID
 	Provisioning  ProvisioningStatus
+	Quotas        tenant.Quotas
+	CORSOrigins   []string
+	Email         tenant.EmailSettings
 }
 
 // ProvisioningStatus captures environment provisioning state.
@@ -51,7 +62,7 @@ type ProvisioningStatus struct {
 // TenantStatusFromString converts stored string to TenantStatus; returns error on unknown.
 func TenantStatusFromString(s string) (tenantsapi.TenantStatus, error) {
 	switch tenantsapi.TenantStatus(s) {
-	case tenantsapi.Active, tenantsapi.Disabled, tenantsapi.Pending, tenantsapi.Provisioning:
+	case tenantsapi.Active, tenantsapi.Disabled, tenantsapi.Maintenance, tenantsapi.Pending, tenantsapi.Provisioning, tenantsapi.Suspended:
 		return tenantsapi.TenantStatus(s), nil
 	default:
 		return tenantsapi.Pending, fmt.Errorf("unknown tenant status: %s", s)
@@ -64,28 +75,81 @@ type CreateInput struct {
 	DisplayName *string
 	Status      tenantsapi.TenantStatus
 	CreatedBy   uuid.UUID
+	Quotas      tenant.Quotas
+	CORSOrigins []string
+	Email       tenant.EmailSettings
 }
 
-// UpdateInput represents mutable fields for a tenant.
+// UpdateInput represents mutable fields for a tenant. Quotas, CORSOrigins and Email, when set, replace the stored
+// values.
 type UpdateInput struct {
 	DisplayName *string
 	Status      *tenantsapi.TenantStatus
+	Quotas      *tenant.Quotas
+	CORSOrigins *[]string
+	Email       *tenant.EmailSettings
+}
+
+// DeprovisionInput represents the request to tear down a tenant. ConfirmSlug must repeat the tenant slug.
+// DryRun runs the checks and the teardown steps without changing anything and writes no export.
+type DeprovisionInput struct {
+	ConfirmSlug string
+	Export      bool
+	DryRun      bool
+}
+
+// DeprovisionResult reports the tenant after teardown and, when requested, where its export was written. On a dry
+// run the tenant is unchanged and Teardown lists what would be removed.
+type DeprovisionResult struct {
+	Tenant         Tenant
+	ExportLocation *string
+	DryRun         bool
+	Teardown       TeardownReport
+}
+
+// TeardownReport lists what each deprovisioning step removed, or would remove on a dry run. A failed step carries
+// its error.
+type TeardownReport struct {
+	Database DatabaseTeardown
+	Auth     AuthTeardown
+	Storage  StorageTeardown
+}
+
+type DatabaseTeardown struct {
+	SchemaName string
+	RoleName   string
+	Result     DBTeardownResult
+	Err        error
+}
+
+type AuthTeardown struct {
+	ExternalTenant string
+	Err            error
+}
+
+type StorageTeardown struct {
+	Prefix string
+	Result StorageTeardownResult
+	Err    error
 }
 
 // ListResult wraps paginated tenants.
 type ListResult struct {
-	Tenants    []Tenant
-	Page       int
-	PageSize   int
-	TotalItems int
-	TotalPages int
+	Tenants []Tenant
+	pagination.Envelope
 }
 
-// ListOptions captures filters and pagination.
+// ListOptions captures filters, sorting and pagination.
 type ListOptions struct {
 	Page     int
 	PageSize int
 	Status   *tenantsapi.TenantStatus
+	// Search matches a case-insensitive substring of the slug or display name.
+	Search        *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Sort is a comma-separated list of slug, displayName, status or createdAt, each optionally prefixed with '-'.
+	Sort *string
 }
 
 // Repository abstracts persistence.
@@ -95,6 +159,7 @@ type Repository interface {
 	Get(ctx context.Context, id uuid.UUID) (Tenant, error)
 	AppendVersion(ctx context.Context, t Tenant) (Tenant, error)
 	FindBySlug(ctx context.Context, slug string) (Tenant, error)
+	ListCORSOrigins(ctx context.Context) (map[uuid.UUID][]string, error)
 }
 
 // Service provides tenant registry operations.
@@ -102,6 +167,14 @@ type Service struct {
 	repo         Repository
 	envKey       string
 	provisioning ProvisioningDeps
+	jobs         JobRepository
+	usage        UsageMeter
+	cloner       TenantCloner
+	archiver     TenantArchiver
+	changes      ChangeNotifier
+	migrator     SchemaMigrator
+	mailer       mailer.Mailer
+	logger       *zap.Logger
 }
 
 // New builds the tenant service with provisioning dependencies.
@@ -118,13 +191,51 @@ func New(repo Repository, envKey string, deps ProvisioningDeps) *Service {
 	return &Service{repo: repo, envKey: envKey, provisioning: deps}
 }
 
-// List tenants with optional status filter.
+// List tenants matching the filters in opts.
 func (s *Service) List(ctx context.Context, opts ListOptions) (ListResult, error) {
+	if err := validateListOptions(opts); err != nil {
+		return ListResult{}, err
+	}
 	return s.repo.List(ctx, opts)
 }
 
+var sortableFields = map[string]struct{}{
+	"slug":        {},
+	"displayName": {},
+	"status":      {},
+	"createdAt":   {},
+}
+
+func validateListOptions(opts ListOptions) error {
+	if opts.CreatedAfter != nil && opts.CreatedBefore != nil && !opts.CreatedAfter.Before(*opts.CreatedBefore) {
+		return fmt.Errorf("%w: createdAfter must be before createdBefore", ErrInvalidListOptions)
+	}
+	if opts.Sort == nil {
+		return nil
+	}
+	for _, raw := range strings.Split(*opts.Sort, ",") {
+		field := strings.TrimPrefix(strings.TrimSpace(raw), "-")
+		if field == "" {
+			continue
+		}
+		if _, ok := sortableFields[field]; !ok {
+			return fmt.Errorf("%w: unsupported sort field %q", ErrInvalidListOptions, field)
+		}
+	}
+	return nil
+}
+
 // Create a new tenant with derived fields.
 func (s *Service) Create(ctx context.Context, input CreateInput) (Tenant, error) {
+	origins, err := normalizeCORSOrigins(input.CORSOrigins)
+	if err != nil {
+		return Tenant{}, err
+	}
+	email, err := normalizeEmailSettings(input.Email)
+	if err != nil {
+		return Tenant{}, err
+	}
+
 	id := uuid.New()
 	version := persistence.SemanticVersion{Major: 1, Minor: 0, Patch: 0}
 	derived := tenant.DeriveIdentifiers(s.envKey, input.Slug, id)
@@ -147,6 +258,9 @@ func (s *Service) Create(ctx context.Context, input CreateInput) (Tenant, error)
 			DBReady:   false,
 			AuthReady: false,
 		},
+		Quotas:      input.Quotas,
+		CORSOrigins: origins,
+		Email:       email,
 	}
 
 	return s.repo.Create(ctx, t)
@@ -171,14 +285,40 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, input UpdateInput) (
 	if input.Status != nil {
 		next.Status = *input.Status
 	}
+	if input.Quotas != nil {
+		next.Quotas = *input.Quotas
+	}
+	if input.CORSOrigins != nil {
+		origins, err := normalizeCORSOrigins(*input.CORSOrigins)
+		if err != nil {
+			return Tenant{}, err
+		}
+		next.CORSOrigins = origins
+	}
+	if input.Email != nil {
+		email, err := normalizeEmailSettings(*input.Email)
+		if err != nil {
+			return Tenant{}, err
+		}
+		next.Email = email
+	}
 	next.Version = current.Version.NextPatch()
 	next.CreatedAt = time.Now().UTC()
 
-	return s.repo.AppendVersion(ctx, next)
+	return s.appendVersion(ctx, next)
 }
 
-// Provision performs full provisioning and updates status accordingly.
+// Provision performs full provisioning synchronously and updates status accordingly.
 func (s *Service) Provision(ctx context.Context, id uuid.UUID) (Tenant, error) {
+	current, err := s.provisionable(ctx, id)
+	if err != nil {
+		return Tenant{}, err
+	}
+	return s.recordProvisioning(ctx, current, s.ensureComponents(ctx, current, provisioningComponents))
+}
+
+// provisionable loads a tenant and checks that it can be provisioned.
+func (s *Service) provisionable(ctx context.Context, id uuid.UUID) (Tenant, error) {
 	current, err := s.repo.Get(ctx, id)
 	if err != nil {
 		return Tenant{}, err
@@ -195,41 +335,64 @@ func (s *Service) Provision(ctx context.Context, id uuid.UUID) (Tenant, error) {
 	if strings.TrimSpace(current.RoleName) == "" {
 		return Tenant{}, fmt.Errorf("tenant missing role name")
 	}
+	return current, nil
+}
 
-	now := time.Now().UTC()
-	roleName := current.RoleName
+// componentOutcome is the result of ensuring one provisioning component.
+type componentOutcome struct {
+	ready bool
+	err   error
+}
 
-	dbRes, dbErr := s.provisioning.DB.Ensure(ctx, DBProvisionRequest{
-		TenantID:   current.ID,
-		SchemaName: current.SchemaName,
-		RoleName:   roleName,
-	})
-	authRes, authErr := s.provisioning.Auth.Ensure(ctx, fmt.Sprintf("%s-%s", s.envKey, current.Slug))
-	storageRes, storageErr := s.provisioning.Storage.Ensure(ctx, current.BasePrefix)
+// ensureComponents runs Ensure on the requested components only.
+func (s *Service) ensureComponents(ctx context.Context, current Tenant, components []Component) map[Component]componentOutcome {
+	outcomes := make(map[Component]componentOutcome, len(components))
+	for _, component := range components {
+		switch component {
+		case ComponentDB:
+			res, err := s.provisioning.DB.Ensure(ctx, DBProvisionRequest{
+				TenantID:   current.ID,
+				SchemaName: current.SchemaName,
+				RoleName:   current.RoleName,
+			})
+			outcomes[component] = componentOutcome{ready: res.Ready, err: err}
+		case ComponentAuth:
+			res, err := s.provisioning.Auth.Ensure(ctx, fmt.Sprintf("%s-%s", s.envKey, current.Slug))
+			outcomes[component] = componentOutcome{ready: res.Ready, err: err}
+		case ComponentStorage:
+			res, err := s.provisioning.Storage.Ensure(ctx, current.BasePrefix)
+			outcomes[component] = componentOutcome{ready: res.Ready, err: err}
+		}
+	}
+	return outcomes
+}
 
-	dbReady := current.Provisioning.DBReady || dbRes.Ready
-	authReady := current.Provisioning.AuthReady || authRes.Ready
-	storageReady := current.Provisioning.StorageReady || storageRes.Ready
+// recordProvisioning folds component outcomes into the tenant provisioning flags and appends a version.
+// Flags that are already set stay set; the tenant becomes active once every component is ready, unless an
+// operator holds its status (see operatorHeld).
+func (s *Service) recordProvisioning(ctx context.Context, current Tenant, outcomes map[Component]componentOutcome) (Tenant, error) {
+	now := time.Now().UTC()
+
+	dbReady := current.Provisioning.DBReady || outcomes[ComponentDB].ready
+	authReady := current.Provisioning.AuthReady || outcomes[ComponentAuth].ready
+	storageReady := current.Provisioning.StorageReady || outcomes[ComponentStorage].ready
 
 	status := current.Status
-	if dbReady && authReady && storageReady {
-		status = tenantsapi.Active
-	} else {
-		status = tenantsapi.Provisioning
+	if !operatorHeld(status) {
+		if dbReady && authReady && storageReady {
+			status = tenantsapi.Active
+		} else {
+			status = tenantsapi.Provisioning
+		}
 	}
 
 	var lastErr *string
-	if dbErr != nil {
-		s := dbErr.Error()
-		lastErr = &s
-	}
-	if authErr != nil && lastErr == nil {
-		s := authErr.Error()
-		lastErr = &s
-	}
-	if storageErr != nil && lastErr == nil {
-		s := storageErr.Error()
-		lastErr = &s
+	for _, component := range provisioningComponents {
+		if err := outcomes[component].err; err != nil {
+			s := err.Error()
+			lastErr = &s
+			break
+		}
 	}
 
 	prov := ProvisioningStatus{
@@ -244,19 +407,118 @@ func (s *Service) Provision(ctx context.Context, id uuid.UUID) (Tenant, error) {
 	}
 
 	next := current
-	next.RoleName = roleName
 	next.Status = status
 	next.Provisioning = prov
 	next.Version = current.Version.NextPatch()
 	next.CreatedAt = now
 
-	updated, err := s.repo.AppendVersion(ctx, next)
+	updated, err := s.appendVersion(ctx, next)
 	if err != nil {
 		return Tenant{}, err
 	}
+	s.notifyProvisioned(ctx, current, updated)
 	return updated, nil
 }
 
+// Deprovision disables a tenant and tears down its auth tenant, storage prefix, database schema and role.
+// The optional export runs before anything is destroyed and aborts the teardown when it fails. Teardown steps
+// run independently like Provision: every ready flag is cleared for the steps that succeeded and the first
+// failure is recorded in LastError, so the call can be repeated until all flags are false. With DryRun the same
+// checks and steps run in their dry-run mode and the tenant is left as it is.
+func (s *Service) Deprovision(ctx context.Context, id uuid.UUID, input DeprovisionInput) (DeprovisionResult, error) {
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return DeprovisionResult{}, err
+	}
+	if strings.TrimSpace(input.ConfirmSlug) != current.Slug {
+		return DeprovisionResult{}, ErrConfirmationMismatch
+	}
+	if strings.TrimSpace(current.SchemaName) == "" {
+		return DeprovisionResult{}, fmt.Errorf("tenant missing schema name")
+	}
+	if strings.TrimSpace(current.BasePrefix) == "" {
+		return DeprovisionResult{}, fmt.Errorf("tenant missing base prefix")
+	}
+	if strings.TrimSpace(current.RoleName) == "" {
+		return DeprovisionResult{}, fmt.Errorf("tenant missing role name")
+	}
+
+	var exportLocation *string
+	if input.Export {
+		if s.provisioning.Exporter == nil {
+			return DeprovisionResult{}, ErrExportUnavailable
+		}
+		if !input.DryRun {
+			location, err := s.provisioning.Exporter.Export(ctx, spaceFor(current))
+			if err != nil {
+				return DeprovisionResult{}, fmt.Errorf("export tenant: %w", err)
+			}
+			exportLocation = &location
+		}
+	}
+
+	if input.DryRun {
+		return DeprovisionResult{Tenant: current, DryRun: true, Teardown: s.teardown(ctx, current, true)}, nil
+	}
+
+	// Disable first so the tenant stops resolving while its resources are being removed.
+	if current.Status != tenantsapi.Disabled {
+		next := current
+		next.Status = tenantsapi.Disabled
+		next.Version = current.Version.NextPatch()
+		next.CreatedAt = time.Now().UTC()
+		if current, err = s.appendVersion(ctx, next); err != nil {
+			return DeprovisionResult{}, err
+		}
+	}
+
+	teardown := s.teardown(ctx, current, false)
+	dbErr, authErr, storageErr := teardown.Database.Err, teardown.Auth.Err, teardown.Storage.Err
+
+	var lastErr *string
+	for _, stepErr := range []error{dbErr, authErr, storageErr} {
+		if stepErr != nil {
+			msg := stepErr.Error()
+			lastErr = &msg
+			break
+		}
+	}
+
+	next := current
+	next.Provisioning = ProvisioningStatus{
+		DBReady:           current.Provisioning.DBReady && dbErr != nil,
+		AuthReady:         current.Provisioning.AuthReady && authErr != nil,
+		StorageReady:      current.Provisioning.StorageReady && storageErr != nil,
+		LastProvisionedAt: current.Provisioning.LastProvisionedAt,
+		LastError:         lastErr,
+	}
+	next.Version = current.Version.NextPatch()
+	next.CreatedAt = time.Now().UTC()
+
+	updated, err := s.appendVersion(ctx, next)
+	if err != nil {
+		return DeprovisionResult{}, err
+	}
+	return DeprovisionResult{Tenant: updated, ExportLocation: exportLocation, Teardown: teardown}, nil
+}
+
+// teardown runs the auth, storage and database teardown steps independently of each other.
+func (s *Service) teardown(ctx context.Context, t Tenant, dryRun bool) TeardownReport {
+	report := TeardownReport{
+		Database: DatabaseTeardown{SchemaName: t.SchemaName, RoleName: t.RoleName},
+		Auth:     AuthTeardown{ExternalTenant: fmt.Sprintf("%s-%s", s.envKey, t.Slug)},
+		Storage:  StorageTeardown{Prefix: t.BasePrefix},
+	}
+	report.Auth.Err = s.provisioning.Auth.Teardown(ctx, report.Auth.ExternalTenant, dryRun)
+	report.Storage.Result, report.Storage.Err = s.provisioning.Storage.Teardown(ctx, t.BasePrefix, dryRun)
+	report.Database.Result, report.Database.Err = s.provisioning.DB.Teardown(ctx, DBProvisionRequest{
+		TenantID:   t.ID,
+		SchemaName: t.SchemaName,
+		RoleName:   t.RoleName,
+	}, dryRun)
+	return report
+}
+
 // ProvisionStatus performs a live check (placeholder) and persists changes if detected.
 func (s *Service) ProvisionStatus(ctx context.Context, id uuid.UUID) (ProvisioningStatus, error) {
 	current, err := s.repo.Get(ctx, id)
@@ -302,9 +564,11 @@ func (s *Service) ProvisionStatus(ctx context.Context, id uuid.UUID) (Provisioni
 	var lastErr *string
 
 	status := current.Status
-	if dbReady && authReady && storageReady {
+	switch {
+	case operatorHeld(status):
+	case dbReady && authReady && storageReady:
 		status = tenantsapi.Active
-	} else if status == tenantsapi.Active {
+	case status == tenantsapi.Active:
 		status = tenantsapi.Provisioning
 	}
 
@@ -333,10 +597,11 @@ func (s *Service) ProvisionStatus(ctx context.Context, id uuid.UUID) (Provisioni
 	next.Version = current.Version.NextPatch()
 	next.CreatedAt = time.Now().UTC()
 
-	updated, err := s.repo.AppendVersion(ctx, next)
+	updated, err := s.appendVersion(ctx, next)
 	if err != nil {
 		return ProvisioningStatus{}, err
 	}
+	s.notifyProvisioned(ctx, current, updated)
 	return updated.Provisioning, nil
 }
 
@@ -371,15 +636,51 @@ func (s *Service) ResolveTenantSpace(ctx context.Context, id uuid.UUID) (tenant.
 	if t.Status == tenantsapi.Disabled {
 		return tenant.Space{}, ErrDisabled
 	}
-	space := tenant.Space{
-		TenantID:      t.ID,
-		Slug:          t.Slug,
-		ShortTenantID: t.ShortTenantID,
-		SchemaName:    t.SchemaName,
-		BasePrefix:    t.BasePrefix,
-		RoleName:      t.RoleName,
+	return spaceFor(t), nil
+}
+
+// ListActiveSpaces returns the spaces of every active tenant whose database has been provisioned.
+// Background workers use it to iterate tenant schemas outside of a request.
+func (s *Service) ListActiveSpaces(ctx context.Context) ([]tenant.Space, error) {
+	tenants, err := s.listActiveProvisioned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spaces := make([]tenant.Space, 0, len(tenants))
+	for _, t := range tenants {
+		spaces = append(spaces, spaceFor(t))
+	}
+	return spaces, nil
+}
+
+// listActiveProvisioned returns every active tenant whose database has been provisioned.
+func (s *Service) listActiveProvisioned(ctx context.Context) ([]Tenant, error) {
+	active, err := s.listByStatus(ctx, tenantsapi.Active)
+	if err != nil {
+		return nil, err
+	}
+	tenants := make([]Tenant, 0, len(active))
+	for _, t := range active {
+		if t.Provisioning.DBReady {
+			tenants = append(tenants, t)
+		}
+	}
+	return tenants, nil
+}
+
+// listByStatus returns every tenant in status, reading all pages.
+func (s *Service) listByStatus(ctx context.Context, status tenantsapi.TenantStatus) ([]Tenant, error) {
+	tenants := make([]Tenant, 0)
+	for page := 1; ; page++ {
+		result, err := s.repo.List(ctx, ListOptions{Page: page, PageSize: pagination.MaxPageSize, Status: &status})
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, result.Tenants...)
+		if !result.HasNext() || len(result.Tenants) == 0 {
+			return tenants, nil
+		}
 	}
-	return space, nil
 }
 
 // ResolveTenantSpaceByExternal maps an external tenant key (envKey-prefixed slug) to a tenant.Space.
@@ -403,6 +704,16 @@ func (s *Service) ResolveTenantSpaceByExternal(ctx context.Context, external str
 		return tenant.Space{}, ErrDisabled
 	}
 
+	return spaceFor(t), nil
+}
+
+// operatorHeld reports whether status was set by an operator on a provisioned tenant (maintenance, suspended), in
+// which case provisioning must leave it alone.
+func operatorHeld(status tenantsapi.TenantStatus) bool {
+	return status == tenantsapi.Maintenance || status == tenantsapi.Suspended
+}
+
+func spaceFor(t Tenant) tenant.Space {
 	return tenant.Space{
 		TenantID:      t.ID,
 		Slug:          t.Slug,
@@ -410,5 +721,9 @@ func (s *Service) ResolveTenantSpaceByExternal(ctx context.Context, external str
 		SchemaName:    t.SchemaName,
 		BasePrefix:    t.BasePrefix,
 		RoleName:      t.RoleName,
-	}, nil
+		Quotas:        t.Quotas,
+		Email:         t.Email,
+		Maintenance:   t.Status == tenantsapi.Maintenance,
+		Suspended:     t.Status == tenantsapi.Suspended,
+	}
 }
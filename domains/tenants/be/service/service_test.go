@@ -9,8 +9,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
@@ -25,8 +27,17 @@ func newInMemoryRepo() *inMemoryRepo {
 	return &inMemoryRepo{data: make(map[uuid.UUID]Tenant)}
 }
 
+// List returns every tenant in one page, honouring only the status filter.
 func (r *inMemoryRepo) List(ctx context.Context, opts ListOptions) (ListResult, error) {
-	return ListResult{}, errors.New("not implemented")
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result ListResult
+	for _, t := range r.data {
+		if opts.Status == nil || t.Status == *opts.Status {
+			result.Tenants = append(result.Tenants, t)
+		}
+	}
+	return result, nil
 }
 
 func (r *inMemoryRepo) Create(ctx context.Context, t Tenant) (Tenant, error) {
@@ -64,6 +75,18 @@ func (r *inMemoryRepo) FindBySlug(ctx context.Context, slug string) (Tenant, err
 	return Tenant{}, ErrNotFound
 }
 
+func (r *inMemoryRepo) ListCORSOrigins(ctx context.Context) (map[uuid.UUID][]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	origins := map[uuid.UUID][]string{}
+	for _, t := range r.data {
+		if t.Status != tenantsapi.Disabled && len(t.CORSOrigins) > 0 {
+			origins[t.ID] = t.CORSOrigins
+		}
+	}
+	return origins, nil
+}
+
 // stub provisioners
 
 type stubDB struct {
@@ -71,8 +94,18 @@ type stubDB struct {
 	ensureErr error
 	checkRes  DBProvisionResult
 	checkErr  error
+	tearRes   DBTeardownResult
+	tearErr   error
+	// tearDryRun records the dryRun argument of Teardown when set.
+	tearDryRun *bool
 }
 
+func (s stubDB) Teardown(_ context.Context, _ DBProvisionRequest, dryRun bool) (DBTeardownResult, error) {
+	if s.tearDryRun != nil {
+		*s.tearDryRun = dryRun
+	}
+	return s.tearRes, s.tearErr
+}
 func (s stubDB) Ensure(context.Context, DBProvisionRequest) (DBProvisionResult, error) {
 	return s.ensureRes, s.ensureErr
 }
@@ -85,6 +118,11 @@ type stubAuth struct {
 	ensureErr error
 	checkRes  AuthProvisionResult
 	checkErr  error
+	tearErr   error
+}
+
+func (s stubAuth) Teardown(context.Context, string, bool) error {
+	return s.tearErr
 }
 
 func (s stubAuth) Ensure(context.Context, string) (AuthProvisionResult, error) {
@@ -95,8 +133,14 @@ func (s stubAuth) Check(context.Context, string) (AuthProvisionResult, error) {
 }
 
 type stubStorage struct {
-	res StorageProvisionResult
-	err error
+	res     StorageProvisionResult
+	err     error
+	tearRes StorageTeardownResult
+	tearErr error
+}
+
+func (s stubStorage) Teardown(context.Context, string, bool) (StorageTeardownResult, error) {
+	return s.tearRes, s.tearErr
 }
 
 func (s stubStorage) Ensure(context.Context, string) (StorageProvisionResult, error) {
@@ -192,3 +236,443 @@ func TestProvisionStatusPromotesWhenReady(t *testing.T) {
 	updated, _ := repo.Get(context.Background(), tenantRecord.ID)
 	require.Equal(t, tenantsapi.Active, updated.Status)
 }
+
+func TestMaintenanceSurvivesProvisioningAndMarksSpace(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("mu-co")
+	tenantRecord.Status = tenantsapi.Maintenance
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	deps := ProvisioningDeps{
+		DB:      stubDB{checkRes: DBProvisionResult{Ready: true}},
+		Auth:    stubAuth{checkRes: AuthProvisionResult{Ready: true}},
+		Storage: stubStorage{res: StorageProvisionResult{Ready: true}},
+	}
+
+	svc := New(repo, "dev", deps)
+
+	_, err := svc.ProvisionStatus(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	updated, _ := repo.Get(context.Background(), tenantRecord.ID)
+	require.Equal(t, tenantsapi.Maintenance, updated.Status)
+
+	space, err := svc.ResolveTenantSpace(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.True(t, space.Maintenance)
+
+	active := tenantsapi.Active
+	_, err = svc.Update(context.Background(), tenantRecord.ID, UpdateInput{Status: &active})
+	require.NoError(t, err)
+	space, err = svc.ResolveTenantSpace(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.False(t, space.Maintenance)
+}
+
+func TestSuspendedTenantKeepsStatusWhenReprovisioned(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("nu-co")
+	tenantRecord.Status = tenantsapi.Suspended
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	deps := ProvisioningDeps{
+		DB:      stubDB{ensureRes: DBProvisionResult{Ready: true}},
+		Auth:    stubAuth{ensureRes: AuthProvisionResult{Ready: true}},
+		Storage: stubStorage{res: StorageProvisionResult{Ready: true}},
+	}
+
+	svc := New(repo, "dev", deps)
+
+	updated, err := svc.Provision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Equal(t, tenantsapi.Suspended, updated.Status)
+
+	space, err := svc.ResolveTenantSpace(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.True(t, space.Suspended)
+	require.False(t, space.Maintenance)
+}
+
+type stubExporter struct {
+	location string
+	err      error
+	calls    *int
+}
+
+func (s stubExporter) Export(context.Context, tenant.Space) (string, error) {
+	if s.calls != nil {
+		*s.calls++
+	}
+	return s.location, s.err
+}
+
+func newProvisionedTenant(slug string) Tenant {
+	t := newTenantRecord(slug)
+	t.Status = tenantsapi.Active
+	t.Provisioning = ProvisioningStatus{DBReady: true, AuthReady: true, StorageReady: true}
+	return t
+}
+
+func TestDeprovisionTearsDownAndDisables(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("delta-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	deps := ProvisioningDeps{
+		DB:       stubDB{},
+		Auth:     stubAuth{},
+		Storage:  stubStorage{},
+		Exporter: stubExporter{location: "exports/delta-co.tar.gz"},
+	}
+
+	svc := New(repo, "dev", deps)
+
+	result, err := svc.Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "delta-co", Export: true})
+	require.NoError(t, err)
+	require.Equal(t, tenantsapi.Disabled, result.Tenant.Status)
+	require.False(t, result.Tenant.Provisioning.DBReady)
+	require.False(t, result.Tenant.Provisioning.AuthReady)
+	require.False(t, result.Tenant.Provisioning.StorageReady)
+	require.Nil(t, result.Tenant.Provisioning.LastError)
+	require.NotNil(t, result.ExportLocation)
+	require.Equal(t, "exports/delta-co.tar.gz", *result.ExportLocation)
+}
+
+func TestDeprovisionPartialFailureKeepsFlags(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("epsilon-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	deps := ProvisioningDeps{
+		DB:      stubDB{tearErr: errors.New("drop schema failed")},
+		Auth:    stubAuth{},
+		Storage: stubStorage{},
+	}
+
+	svc := New(repo, "dev", deps)
+
+	result, err := svc.Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "epsilon-co"})
+	require.NoError(t, err)
+	require.Equal(t, tenantsapi.Disabled, result.Tenant.Status)
+	require.True(t, result.Tenant.Provisioning.DBReady)
+	require.False(t, result.Tenant.Provisioning.AuthReady)
+	require.False(t, result.Tenant.Provisioning.StorageReady)
+	require.NotNil(t, result.Tenant.Provisioning.LastError)
+	require.Equal(t, "drop schema failed", *result.Tenant.Provisioning.LastError)
+	require.Nil(t, result.ExportLocation)
+}
+
+func TestDeprovisionDryRunLeavesTenant(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("eta-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	var dryRun bool
+	exports := 0
+	deps := ProvisioningDeps{
+		DB: stubDB{
+			tearRes:    DBTeardownResult{SchemaExists: true, RoleExists: true, Tables: []TableRows{{Name: "orders", Rows: 12}}},
+			tearDryRun: &dryRun,
+		},
+		Auth:     stubAuth{tearErr: errors.New("auth provisioner not implemented")},
+		Storage:  stubStorage{tearRes: StorageTeardownResult{Objects: 3, Bytes: 2048}},
+		Exporter: stubExporter{location: "exports/eta-co.tar.gz", calls: &exports},
+	}
+	svc := New(repo, "dev", deps)
+
+	result, err := svc.Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "eta-co", Export: true, DryRun: true})
+	require.NoError(t, err)
+	require.True(t, dryRun)
+	require.True(t, result.DryRun)
+	require.Nil(t, result.ExportLocation)
+	require.Zero(t, exports)
+	require.Equal(t, tenantsapi.Active, result.Tenant.Status)
+	require.Equal(t, []TableRows{{Name: "orders", Rows: 12}}, result.Teardown.Database.Result.Tables)
+	require.Equal(t, int64(3), result.Teardown.Storage.Result.Objects)
+	require.Equal(t, "dev-eta-co", result.Teardown.Auth.ExternalTenant)
+	require.ErrorContains(t, result.Teardown.Auth.Err, "not implemented")
+
+	unchanged, _ := repo.Get(context.Background(), tenantRecord.ID)
+	require.Equal(t, tenantRecord.Version, unchanged.Version)
+	require.True(t, unchanged.Provisioning.AuthReady)
+	require.Nil(t, unchanged.Provisioning.LastError)
+
+	_, err = svc.Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "other-co", DryRun: true})
+	require.ErrorIs(t, err, ErrConfirmationMismatch)
+}
+
+func TestDeprovisionRejectsBeforeTeardown(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newProvisionedTenant("zeta-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	exports := 0
+	deps := ProvisioningDeps{
+		DB:       stubDB{},
+		Auth:     stubAuth{},
+		Storage:  stubStorage{},
+		Exporter: stubExporter{err: errors.New("bucket unavailable"), calls: &exports},
+	}
+	svc := New(repo, "dev", deps)
+
+	_, err := svc.Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "other-co"})
+	require.ErrorIs(t, err, ErrConfirmationMismatch)
+
+	_, err = svc.Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "zeta-co", Export: true})
+	require.ErrorContains(t, err, "bucket unavailable")
+	require.Equal(t, 1, exports)
+
+	deps.Exporter = nil
+	_, err = New(repo, "dev", deps).Deprovision(context.Background(), tenantRecord.ID, DeprovisionInput{ConfirmSlug: "zeta-co", Export: true})
+	require.ErrorIs(t, err, ErrExportUnavailable)
+
+	unchanged, _ := repo.Get(context.Background(), tenantRecord.ID)
+	require.Equal(t, tenantsapi.Active, unchanged.Status)
+	require.True(t, unchanged.Provisioning.DBReady)
+}
+
+type stubMeter struct {
+	usage tenant.Usage
+	calls *int
+}
+
+func (s stubMeter) Measure(context.Context, tenant.Space) (tenant.Usage, error) {
+	if s.calls != nil {
+		*s.calls++
+	}
+	return s.usage, nil
+}
+
+func TestUpdateReplacesQuotas(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("lambda-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+
+	maxEntities := int64(100)
+	updated, err := svc.Update(context.Background(), tenantRecord.ID, UpdateInput{Quotas: &tenant.Quotas{MaxEntities: &maxEntities}})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), *updated.Quotas.MaxEntities)
+	require.Nil(t, updated.Quotas.MaxUsers)
+
+	space, err := svc.ResolveTenantSpace(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), *space.Quotas.MaxEntities)
+}
+
+func TestUpdateNormalizesCORSOrigins(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("xi-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+
+	origins := []string{"https://App.Example.com/", "http://localhost:5173", "https://app.example.com"}
+	updated, err := svc.Update(context.Background(), tenantRecord.ID, UpdateInput{CORSOrigins: &origins})
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://localhost:5173", "https://app.example.com"}, updated.CORSOrigins)
+
+	listed, err := svc.ListCORSOrigins(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID][]string{tenantRecord.ID: updated.CORSOrigins}, listed)
+
+	for _, invalid := range []string{"*", "https://*.example.com", "ftp://example.com", "https://example.com/app", "example.com"} {
+		_, err := svc.Update(context.Background(), tenantRecord.ID, UpdateInput{CORSOrigins: &[]string{invalid}})
+		require.ErrorIs(t, err, ErrInvalidCORSOrigin, invalid)
+	}
+}
+
+func TestUpdateNormalizesEmailSettings(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("pi-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+
+	name, blank, notify := " Pi Support ", "  ", "ops@pi.example.com"
+	updated, err := svc.Update(context.Background(), tenantRecord.ID, UpdateInput{Email: &tenant.EmailSettings{
+		FromName:      &name,
+		ReplyTo:       &blank,
+		NotifyAddress: &notify,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "Pi Support", *updated.Email.FromName)
+	require.Nil(t, updated.Email.ReplyTo)
+
+	space, err := svc.ResolveTenantSpace(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Equal(t, notify, *space.Email.NotifyAddress)
+
+	for _, invalid := range []string{"ops", "Ops <ops@pi.example.com>", "ops@pi.example.com\r\nBcc: x@evil.example"} {
+		_, err := svc.Update(context.Background(), tenantRecord.ID, UpdateInput{Email: &tenant.EmailSettings{FromAddress: &invalid}})
+		require.ErrorIs(t, err, ErrInvalidEmailSettings, invalid)
+	}
+	multiline := "Pi\nSupport"
+	_, err = svc.Update(context.Background(), tenantRecord.ID, UpdateInput{Email: &tenant.EmailSettings{FromName: &multiline}})
+	require.ErrorIs(t, err, ErrInvalidEmailSettings)
+}
+
+type recordingMailer struct {
+	mu   sync.Mutex
+	sent []mailer.Message
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestProvisionEmailsNotifyAddressOnce(t *testing.T) {
+	repo := newInMemoryRepo()
+	notify := "ops@rho.example.com"
+	tenantRecord := newTenantRecord("rho-co")
+	tenantRecord.Email.NotifyAddress = &notify
+	_, _ = repo.Create(context.Background(), tenantRecord)
+	quiet := newTenantRecord("sigma-co")
+	_, _ = repo.Create(context.Background(), quiet)
+
+	svc := New(repo, "dev", ProvisioningDeps{
+		DB:      stubDB{ensureRes: DBProvisionResult{Ready: true}},
+		Auth:    stubAuth{ensureRes: AuthProvisionResult{Ready: true}},
+		Storage: stubStorage{res: StorageProvisionResult{Ready: true}},
+	})
+	mail := &recordingMailer{}
+	svc.UseMailer(mail, zap.NewNop())
+
+	_, err := svc.Provision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	require.Len(t, mail.sent, 1)
+	require.Equal(t, notify, mail.sent[0].To)
+	require.Equal(t, "rho-co is ready", mail.sent[0].Subject)
+
+	// Re-provisioning an active tenant, and tenants without a notify address, send nothing.
+	_, err = svc.Provision(context.Background(), tenantRecord.ID)
+	require.NoError(t, err)
+	_, err = svc.Provision(context.Background(), quiet.ID)
+	require.NoError(t, err)
+	require.Len(t, mail.sent, 1)
+}
+
+type recordingNotifier struct{ changed []uuid.UUID }
+
+func (n *recordingNotifier) NotifyTenantChanged(_ context.Context, id uuid.UUID) error {
+	n.changed = append(n.changed, id)
+	return nil
+}
+
+func TestNewVersionsNotifyChanges(t *testing.T) {
+	repo := newInMemoryRepo()
+	tenantRecord := newTenantRecord("omicron-co")
+	_, _ = repo.Create(context.Background(), tenantRecord)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+	notifier := &recordingNotifier{}
+	svc.UseChangeNotifier(notifier)
+
+	disabled := tenantsapi.Disabled
+	_, err := svc.Update(context.Background(), tenantRecord.ID, UpdateInput{Status: &disabled})
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{tenantRecord.ID}, notifier.changed)
+
+	_, err = svc.Update(context.Background(), uuid.New(), UpdateInput{Status: &disabled})
+	require.Error(t, err)
+	require.Len(t, notifier.changed, 1)
+}
+
+func TestUsageMeasuresProvisionedTenants(t *testing.T) {
+	repo := newInMemoryRepo()
+	pending := newTenantRecord("mu-co")
+	provisioned := newProvisionedTenant("nu-co")
+	_, _ = repo.Create(context.Background(), pending)
+	_, _ = repo.Create(context.Background(), provisioned)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+	_, err := svc.Usage(context.Background(), provisioned.ID)
+	require.ErrorIs(t, err, ErrUsageUnavailable)
+
+	calls := 0
+	svc.UseUsageMeter(stubMeter{usage: tenant.Usage{Entities: 42, Users: 3}, calls: &calls})
+
+	usage, err := svc.Usage(context.Background(), provisioned.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), usage.Usage.Entities)
+	require.Equal(t, int64(3), usage.Usage.Users)
+
+	usage, err = svc.Usage(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Equal(t, tenant.Usage{}, usage.Usage)
+	require.Equal(t, 1, calls)
+}
+
+func TestListRejectsInvalidOptions(t *testing.T) {
+	svc := New(newInMemoryRepo(), "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+
+	sort := "slug,-schemaName"
+	_, err := svc.List(context.Background(), ListOptions{Sort: &sort})
+	require.ErrorIs(t, err, ErrInvalidListOptions)
+
+	after := time.Now().UTC()
+	before := after.Add(-time.Hour)
+	_, err = svc.List(context.Background(), ListOptions{CreatedAfter: &after, CreatedBefore: &before})
+	require.ErrorIs(t, err, ErrInvalidListOptions)
+
+	sort = "status,-createdAt"
+	_, err = svc.List(context.Background(), ListOptions{Sort: &sort})
+	require.NotErrorIs(t, err, ErrInvalidListOptions)
+}
+
+type recordingSnapshots map[uuid.UUID]tenant.Usage
+
+func (r recordingSnapshots) RecordUsageSnapshot(_ context.Context, tenantID uuid.UUID, usage tenant.Usage, _ time.Time) error {
+	r[tenantID] = usage
+	return nil
+}
+
+func TestMeterUsageRecordsProvisionedTenants(t *testing.T) {
+	repo := newInMemoryRepo()
+	pending := newTenantRecord("pi-co")
+	provisioned := newProvisionedTenant("rho-co")
+	_, _ = repo.Create(context.Background(), pending)
+	_, _ = repo.Create(context.Background(), provisioned)
+
+	svc := New(repo, "dev", ProvisioningDeps{DB: stubDB{}, Auth: stubAuth{}, Storage: stubStorage{}})
+	snapshots := recordingSnapshots{}
+	require.ErrorIs(t, svc.MeterUsage(context.Background(), snapshots), ErrUsageUnavailable)
+
+	svc.UseUsageMeter(stubMeter{usage: tenant.Usage{Entities: 7}})
+	require.NoError(t, svc.MeterUsage(context.Background(), snapshots))
+	require.Equal(t, recordingSnapshots{provisioned.ID: {Entities: 7}}, snapshots)
+}
+
+func TestReconcileProvisioningFollowsLiveChecks(t *testing.T) {
+	repo := newInMemoryRepo()
+	provisioning := newTenantRecord("sigma-co")
+	provisioning.Status = tenantsapi.Provisioning
+	suspended := newProvisionedTenant("tau-co")
+	suspended.Status = tenantsapi.Suspended
+	_, _ = repo.Create(context.Background(), provisioning)
+	_, _ = repo.Create(context.Background(), suspended)
+
+	svc := New(repo, "dev", ProvisioningDeps{
+		DB:      stubDB{checkRes: DBProvisionResult{Ready: true}},
+		Auth:    stubAuth{checkRes: AuthProvisionResult{Ready: true}},
+		Storage: stubStorage{res: StorageProvisionResult{Ready: true}},
+	})
+	require.NoError(t, svc.ReconcileProvisioning(context.Background()))
+
+	promoted, _ := repo.Get(context.Background(), provisioning.ID)
+	require.Equal(t, tenantsapi.Active, promoted.Status)
+	held, _ := repo.Get(context.Background(), suspended.ID)
+	require.Equal(t, suspended.Version, held.Version)
+
+	// Check failures are reported per tenant.
+	svc = New(repo, "dev", ProvisioningDeps{
+		DB:      stubDB{checkRes: DBProvisionResult{Ready: true}},
+		Auth:    stubAuth{checkErr: errors.New("identity platform unavailable")},
+		Storage: stubStorage{res: StorageProvisionResult{Ready: true}},
+	})
+	err := svc.ReconcileProvisioning(context.Background())
+	require.ErrorContains(t, err, "tenant sigma-co: identity platform unavailable")
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceLister enumerates the tenant spaces whose storage prefix the storage usage worker measures.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// StorageUsageRecorder stores a tenant's object storage measurement and reports the quota threshold it reached.
+type StorageUsageRecorder interface {
+	RecordStorageUsage(ctx context.Context, space tenant.Space, sample persistence.StorageUsageSample) (persistence.StorageUsageReport, error)
+}
+
+// StorageUsageWorkerConfig tunes the storage usage accounting. Zero values fall back to defaults.
+type StorageUsageWorkerConfig struct {
+	Interval time.Duration
+}
+
+// StorageUsageWorker periodically lists every active tenant's storage prefix and records its object count and bytes,
+// which the tenant usage endpoint reports.
+type StorageUsageWorker struct {
+	prefixes storage.PrefixMeter
+	usage    StorageUsageRecorder
+	spaces   SpaceLister
+	logger   *zap.Logger
+	cfg      StorageUsageWorkerConfig
+	mailer   mailer.Mailer
+}
+
+// NewStorageUsageWorker constructs a StorageUsageWorker with defaults applied to cfg.
+func NewStorageUsageWorker(prefixes storage.PrefixMeter, usage StorageUsageRecorder, spaces SpaceLister, logger *zap.Logger, cfg StorageUsageWorkerConfig) *StorageUsageWorker {
+	if prefixes == nil {
+		panic("prefix meter is required")
+	}
+	if usage == nil {
+		panic("storage usage recorder is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Hour
+	}
+
+	return &StorageUsageWorker{prefixes: prefixes, usage: usage, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// UseMailer emails a quota alert to the notify address of every tenant that reaches a storage threshold.
+func (w *StorageUsageWorker) UseMailer(m mailer.Mailer) {
+	w.mailer = m
+}
+
+// Run measures every tenant each Interval until ctx is cancelled.
+func (w *StorageUsageWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			w.logger.Error("storage usage accounting failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce measures every active tenant once. Failures in one tenant are logged and do not stop the others.
+func (w *StorageUsageWorker) RunOnce(ctx context.Context) error {
+	spaces, err := w.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		measured, err := w.prefixes.MeasurePrefix(ctx, space.BasePrefix)
+		if err != nil {
+			w.logger.Error("storage usage measurement for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+			continue
+		}
+		report, err := w.usage.RecordStorageUsage(ctx, space, persistence.StorageUsageSample{
+			Objects:    measured.Objects,
+			Bytes:      measured.Bytes,
+			MeasuredAt: time.Now().UTC(),
+		})
+		if err != nil {
+			w.logger.Error("recording storage usage for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+			continue
+		}
+		if report.Reached > 0 {
+			w.logger.Warn("tenant storage usage reached quota threshold",
+				zap.String("tenant", space.Slug),
+				zap.Int("thresholdPercent", report.Reached),
+				zap.Int64("objectBytes", report.Bytes),
+				zap.Int64("maxObjectBytes", *report.Limit),
+			)
+			w.sendQuotaAlert(ctx, space, report)
+		}
+	}
+	return nil
+}
+
+// sendQuotaAlert emails the tenant's notify address; failures are logged like the other per-tenant failures.
+func (w *StorageUsageWorker) sendQuotaAlert(ctx context.Context, space tenant.Space, report persistence.StorageUsageReport) {
+	if w.mailer == nil || space.Email.NotifyAddress == nil {
+		return
+	}
+	msg, err := mailer.DefaultTemplates().Render(mailer.TemplateQuotaAlert, mailer.QuotaAlertData{
+		Workspace:        space.Slug,
+		Resource:         "storage",
+		ThresholdPercent: report.Reached,
+		Used:             report.Bytes,
+		Limit:            *report.Limit,
+	})
+	if err == nil {
+		msg.To = *space.Email.NotifyAddress
+		err = w.mailer.Send(ctx, msg)
+	}
+	if err != nil {
+		w.logger.Error("sending storage quota alert for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/storage"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type stubSpaces []tenant.Space
+
+func (s stubSpaces) ListActiveSpaces(context.Context) ([]tenant.Space, error) {
+	return s, nil
+}
+
+type stubPrefixMeter map[string]storage.PrefixUsage
+
+func (m stubPrefixMeter) MeasurePrefix(_ context.Context, prefix string) (storage.PrefixUsage, error) {
+	usage, ok := m[prefix]
+	if !ok {
+		return storage.PrefixUsage{}, errors.New("bucket unavailable")
+	}
+	return usage, nil
+}
+
+type recordedUsage map[string]persistence.StorageUsageSample
+
+func (r recordedUsage) RecordStorageUsage(_ context.Context, space tenant.Space, sample persistence.StorageUsageSample) (persistence.StorageUsageReport, error) {
+	r[space.Slug] = sample
+	report := persistence.StorageUsageReport{StorageUsageSample: sample}
+	if limit := space.Quotas.MaxObjectBytes; limit != nil && sample.Bytes*100 >= *limit*80 {
+		report.Limit, report.Reached = limit, 80
+	}
+	return report, nil
+}
+
+func TestStorageUsageWorkerRecordsEachTenant(t *testing.T) {
+	t.Parallel()
+
+	spaces := stubSpaces{
+		{Slug: "acme", BasePrefix: "dev/acme-12345678/"},
+		{Slug: "broken", BasePrefix: "dev/broken-12345678/"},
+		{Slug: "globex", BasePrefix: "dev/globex-12345678/"},
+	}
+	prefixes := stubPrefixMeter{
+		"dev/acme-12345678/":   {Objects: 2, Bytes: 2048},
+		"dev/globex-12345678/": {},
+	}
+	recorded := recordedUsage{}
+
+	worker := NewStorageUsageWorker(prefixes, recorded, spaces, zap.NewNop(), StorageUsageWorkerConfig{})
+	require.NoError(t, worker.RunOnce(context.Background()))
+
+	require.Len(t, recorded, 2, "a tenant whose prefix cannot be listed does not stop the others")
+	require.Equal(t, int64(2), recorded["acme"].Objects)
+	require.Equal(t, int64(2048), recorded["acme"].Bytes)
+	require.False(t, recorded["acme"].MeasuredAt.IsZero())
+	require.Zero(t, recorded["globex"].Bytes)
+}
+
+func TestStorageUsageWorkerEmailsQuotaAlerts(t *testing.T) {
+	t.Parallel()
+
+	limit, notify := int64(2048), "ops@acme.example.com"
+	spaces := stubSpaces{
+		{Slug: "acme", BasePrefix: "dev/acme-12345678/", Quotas: tenant.Quotas{MaxObjectBytes: &limit},
+			Email: tenant.EmailSettings{NotifyAddress: &notify}},
+		{Slug: "globex", BasePrefix: "dev/globex-12345678/", Quotas: tenant.Quotas{MaxObjectBytes: &limit}},
+	}
+	prefixes := stubPrefixMeter{
+		"dev/acme-12345678/":   {Objects: 2, Bytes: 1800},
+		"dev/globex-12345678/": {Objects: 2, Bytes: 1800},
+	}
+
+	worker := NewStorageUsageWorker(prefixes, recordedUsage{}, spaces, zap.NewNop(), StorageUsageWorkerConfig{})
+	mail := &recordingMailer{}
+	worker.UseMailer(mail)
+	require.NoError(t, worker.RunOnce(context.Background()))
+
+	require.Len(t, mail.sent, 1, "tenants without a notify address are not emailed")
+	require.Equal(t, notify, mail.sent[0].To)
+	require.Equal(t, "acme has used 80% of its storage quota", mail.sent[0].Subject)
+	require.Contains(t, mail.sent[0].Text, "1.8 KiB of 2.0 KiB")
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrUsageUnavailable is returned when no usage meter is configured.
+var ErrUsageUnavailable = errors.New("tenant usage metering is not configured")
+
+// UsageMeter measures the current consumption of a tenant space.
+type UsageMeter interface {
+	Measure(ctx context.Context, space tenant.Space) (tenant.Usage, error)
+}
+
+// TenantUsage reports a tenant's consumption next to its quotas.
+type TenantUsage struct {
+	TenantID   uuid.UUID
+	Usage      tenant.Usage
+	Quotas     tenant.Quotas
+	MeasuredAt time.Time
+}
+
+// UseUsageMeter enables Usage.
+func (s *Service) UseUsageMeter(meter UsageMeter) {
+	s.usage = meter
+}
+
+// Usage measures the tenant's current consumption. Tenants whose database is not provisioned report zero usage.
+func (s *Service) Usage(ctx context.Context, id uuid.UUID) (TenantUsage, error) {
+	if s.usage == nil {
+		return TenantUsage{}, ErrUsageUnavailable
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return TenantUsage{}, err
+	}
+
+	usage := TenantUsage{TenantID: current.ID, Quotas: current.Quotas, MeasuredAt: time.Now().UTC()}
+	if !current.Provisioning.DBReady {
+		return usage, nil
+	}
+	if usage.Usage, err = s.usage.Measure(ctx, spaceFor(current)); err != nil {
+		return TenantUsage{}, err
+	}
+	return usage, nil
+}
+
+// UsageSnapshotRecorder stores a tenant's usage sample for metering.
+type UsageSnapshotRecorder interface {
+	RecordUsageSnapshot(ctx context.Context, tenantID uuid.UUID, usage tenant.Usage, measuredAt time.Time) error
+}
+
+// MeterUsage measures every active tenant whose database is provisioned and records the samples with snapshots.
+// Failures in one tenant do not stop the others; they are returned together.
+func (s *Service) MeterUsage(ctx context.Context, snapshots UsageSnapshotRecorder) error {
+	if s.usage == nil {
+		return ErrUsageUnavailable
+	}
+	tenants, err := s.listActiveProvisioned(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenants: %w", err)
+	}
+
+	var errs []error
+	measuredAt := time.Now().UTC()
+	for _, t := range tenants {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		usage, err := s.usage.Measure(ctx, spaceFor(t))
+		if err == nil {
+			err = snapshots.RecordUsageSnapshot(ctx, t.ID, usage, measuredAt)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", t.Slug, err))
+		}
+	}
+	return errors.Join(errs...)
+}
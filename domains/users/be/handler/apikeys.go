@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	apiKeysListOperation   operation = "usersMeApiKeysList"
+	apiKeysCreateOperation operation = "usersMeApiKeysCreate"
+	apiKeysRevokeOperation operation = "usersMeApiKeysRevoke"
+)
+
+func (h *Handler) UsersMeApiKeysList(ctx context.Context, _ users.UsersMeApiKeysListRequestObject) (users.UsersMeApiKeysListResponseObject, error) {
+	credentials, _ := platformauth.UserFromContext(ctx)
+
+	keys, err := h.svc.ListAPIKeys(ctx, h.audit(ctx), credentials)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, apiKeysListOperation)
+		return users.UsersMeApiKeysListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]users.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, toAPIKey(key))
+	}
+	return users.UsersMeApiKeysList200JSONResponse{Items: items}, nil
+}
+
+func (h *Handler) UsersMeApiKeysCreate(ctx context.Context, request users.UsersMeApiKeysCreateRequestObject) (users.UsersMeApiKeysCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.UsersMeApiKeysCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	credentials, _ := platformauth.UserFromContext(ctx)
+	input := service.CreateAPIKeyInput{
+		Name:   request.Body.Name,
+		Scopes: request.Body.Scopes,
+	}
+	if request.Body.ExpiresAt != nil {
+		expiresAt := time.Time(*request.Body.ExpiresAt)
+		input.ExpiresAt = &expiresAt
+	}
+
+	created, err := h.svc.CreateAPIKey(ctx, h.audit(ctx), credentials, input)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, apiKeysCreateOperation)
+		return users.UsersMeApiKeysCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	key := toAPIKey(created.APIKey)
+	return users.UsersMeApiKeysCreate201JSONResponse{
+		Id:         key.Id,
+		Name:       key.Name,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		Key:        created.Key,
+	}, nil
+}
+
+func (h *Handler) UsersMeApiKeysRevoke(ctx context.Context, request users.UsersMeApiKeysRevokeRequestObject) (users.UsersMeApiKeysRevokeResponseObject, error) {
+	credentials, _ := platformauth.UserFromContext(ctx)
+
+	if err := h.svc.RevokeAPIKey(ctx, h.audit(ctx), credentials, uuid.UUID(request.KeyId)); err != nil {
+		status, problem := h.problemForError(ctx, err, apiKeysRevokeOperation)
+		return users.UsersMeApiKeysRevokedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+	return users.UsersMeApiKeysRevoke204Response{}, nil
+}
+
+func toAPIKey(key service.APIKey) users.ApiKey {
+	out := users.ApiKey{
+		Id:        externalRef2.UUID(key.ID),
+		Name:      key.Name,
+		Scopes:    key.Scopes,
+		CreatedAt: externalRef2.Timestamp(key.CreatedAt),
+	}
+	if out.Scopes == nil {
+		out.Scopes = []string{}
+	}
+	if key.ExpiresAt != nil {
+		expiresAt := externalRef2.Timestamp(*key.ExpiresAt)
+		out.ExpiresAt = &expiresAt
+	}
+	if key.LastUsedAt != nil {
+		lastUsedAt := externalRef2.Timestamp(*key.LastUsedAt)
+		out.LastUsedAt = &lastUsedAt
+	}
+	return out
+}
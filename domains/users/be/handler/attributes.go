@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	attributesSchemaGetOperation operation = "usersAttributesSchemaGet"
+	attributesSchemaSetOperation operation = "usersAttributesSchemaSet"
+)
+
+func (h *Handler) UsersAttributesSchemaGet(ctx context.Context, _ users.UsersAttributesSchemaGetRequestObject) (users.UsersAttributesSchemaGetResponseObject, error) {
+	schema, err := h.svc.GetAttributesSchema(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, attributesSchemaGetOperation)
+		return users.UsersAttributesSchemaGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersAttributesSchemaGet200JSONResponse(toAPIAttributesSchema(schema)), nil
+}
+
+func (h *Handler) UsersAttributesSchemaSet(ctx context.Context, request users.UsersAttributesSchemaSetRequestObject) (users.UsersAttributesSchemaSetResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.UsersAttributesSchemaSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	schema, err := h.svc.SetAttributesSchema(ctx, h.audit(ctx), request.Body.SchemaSlug)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, attributesSchemaSetOperation)
+		return users.UsersAttributesSchemaSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersAttributesSchemaSet200JSONResponse(toAPIAttributesSchema(schema)), nil
+}
+
+func toAPIAttributesSchema(schema service.AttributesSchema) users.UserAttributesSchema {
+	return users.UserAttributesSchema{
+		SchemaSlug:    schema.SchemaSlug,
+		SchemaVersion: schema.SchemaVersion,
+	}
+}
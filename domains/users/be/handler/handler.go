@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -15,15 +16,10 @@ import (
 	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
 	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
 	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
-	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
-)
-
-const (
-	problemTypeValidation = "https://palmyra.pro/problems/validation-error"
-	problemTypeNotFound   = "https://palmyra.pro/problems/not-found"
-	problemTypeConflict   = "https://palmyra.pro/problems/conflict"
-	problemTypeInternal   = "https://palmyra.pro/problems/internal-error"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 type operation string
@@ -87,7 +83,7 @@ func (h *Handler) UsersList(ctx context.Context, request users.UsersListRequestO
 func (h *Handler) UsersCreate(ctx context.Context, request users.UsersCreateRequestObject) (users.UsersCreateResponseObject, error) {
 	audit := h.audit(ctx)
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return users.UsersCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
 	}
 
@@ -121,7 +117,7 @@ func (h *Handler) UsersGet(ctx context.Context, request users.UsersGetRequestObj
 func (h *Handler) UsersUpdate(ctx context.Context, request users.UsersUpdateRequestObject) (users.UsersUpdateResponseObject, error) {
 	audit := h.audit(ctx)
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return users.UsersUpdatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
 	}
 
@@ -139,7 +135,7 @@ func (h *Handler) UsersUpdate(ctx context.Context, request users.UsersUpdateRequ
 func (h *Handler) UsersMe(ctx context.Context, _ users.UsersMeRequestObject) (users.UsersMeResponseObject, error) {
 	userID, err := h.extractUserID(ctx)
 	if err != nil {
-		problem := h.buildProblem("Unauthorized", err.Error(), problemTypeValidation, http.StatusUnauthorized, nil)
+		problem := problems.Unauthorized(err.Error()).Details()
 		return users.UsersMedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusUnauthorized}, nil
 	}
 
@@ -155,17 +151,20 @@ func (h *Handler) UsersMe(ctx context.Context, _ users.UsersMeRequestObject) (us
 
 func (h *Handler) UsersUpdateMe(ctx context.Context, request users.UsersUpdateMeRequestObject) (users.UsersUpdateMeResponseObject, error) {
 	if request.Body == nil {
-		problem := h.buildProblem("Invalid request body", "request body is required", problemTypeValidation, http.StatusBadRequest, nil)
+		problem := problems.MissingBody().Details()
 		return users.UsersUpdateMedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
 	}
 
 	userID, err := h.extractUserID(ctx)
 	if err != nil {
-		problem := h.buildProblem("Unauthorized", err.Error(), problemTypeValidation, http.StatusUnauthorized, nil)
+		problem := problems.Unauthorized(err.Error()).Details()
 		return users.UsersUpdateMedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusUnauthorized}, nil
 	}
 
 	input := service.UpdateSelfInput{FullName: request.Body.FullName}
+	if request.Body.Attributes != nil {
+		input.Attributes = *request.Body.Attributes
+	}
 
 	audit := h.audit(ctx)
 	updated, svcErr := h.svc.UpdateSelf(ctx, audit, userID, input)
@@ -188,14 +187,9 @@ func (h *Handler) UsersDelete(ctx context.Context, request users.UsersDeleteRequ
 }
 
 func buildListOptions(params users.UsersListParams) service.ListOptions {
-	opts := service.ListOptions{}
+	page := pagination.FromQuery(params.Page, params.PageSize)
+	opts := service.ListOptions{Page: page.Page, PageSize: page.PageSize}
 
-	if params.Page != nil {
-		opts.Page = int(*params.Page)
-	}
-	if params.PageSize != nil {
-		opts.PageSize = int(*params.PageSize)
-	}
 	if params.Email != nil {
 		email := strings.TrimSpace(*params.Email)
 		opts.Email = &email
@@ -204,18 +198,36 @@ func buildListOptions(params users.UsersListParams) service.ListOptions {
 		s := string(*params.Sort)
 		opts.Sort = &s
 	}
+	if params.LastActiveBefore != nil {
+		before := time.Time(*params.LastActiveBefore)
+		opts.LastActiveBefore = &before
+	}
 
 	return opts
 }
 
 func toAPIUser(user service.User) users.User {
-	return users.User{
-		Id:        externalRef2.UUID(user.ID),
-		Email:     externalRef2.Email(user.Email),
-		FullName:  user.FullName,
-		CreatedAt: externalRef2.Timestamp(user.CreatedAt),
-		UpdatedAt: externalRef2.Timestamp(user.UpdatedAt),
-	}
+	out := users.User{
+		Id:         externalRef2.UUID(user.ID),
+		Email:      externalRef2.Email(user.Email),
+		FullName:   user.FullName,
+		CreatedAt:  externalRef2.Timestamp(user.CreatedAt),
+		UpdatedAt:  externalRef2.Timestamp(user.UpdatedAt),
+		LoginCount: user.LoginCount,
+		Attributes: users.UserAttributes(user.Attributes),
+	}
+	if out.Attributes == nil {
+		out.Attributes = users.UserAttributes{}
+	}
+	if user.LastLoginAt != nil {
+		lastLogin := externalRef2.Timestamp(*user.LastLoginAt)
+		out.LastLoginAt = &lastLogin
+	}
+	if user.LastActiveAt != nil {
+		lastActive := externalRef2.Timestamp(*user.LastActiveAt)
+		out.LastActiveAt = &lastActive
+	}
+	return out
 }
 
 func toServiceCreateInput(body *users.CreateUser) service.CreateInput {
@@ -223,6 +235,9 @@ func toServiceCreateInput(body *users.CreateUser) service.CreateInput {
 		Email:    string(body.Email),
 		FullName: body.FullName,
 	}
+	if body.Attributes != nil {
+		input.Attributes = *body.Attributes
+	}
 
 	return input
 }
@@ -233,6 +248,9 @@ func toServiceUpdateInput(body *users.UsersUpdateJSONRequestBody) service.Update
 	if body.FullName != nil {
 		input.FullName = body.FullName
 	}
+	if body.Attributes != nil {
+		input.Attributes = *body.Attributes
+	}
 
 	return input
 }
@@ -251,84 +269,35 @@ func (h *Handler) extractUserID(ctx context.Context) (uuid.UUID, error) {
 	return id, nil
 }
 
-func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
-	status, title, detail, problemType, fields := h.classifyError(err)
-
-	logger := h.loggerFrom(ctx)
-	fieldsForLog := []zap.Field{
-		zap.String("operation", string(op)),
-		zap.Int("status", status),
-	}
-
-	switch {
-	case status >= http.StatusInternalServerError:
-		logger.Error("users operation failed", append(fieldsForLog, zap.Error(err))...)
-	case status == http.StatusNotFound:
-		logger.Info("users resource not found", append(fieldsForLog, zap.Error(err))...)
-	default:
-		logger.Warn("users request rejected", append(fieldsForLog, zap.Error(err))...)
-	}
+// errorCatalog maps users service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("users")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation("one or more fields are invalid", err.Fields)
+	})
+	c.Register(service.ErrNotFound, problems.NotFound("user not found")).
+		Register(service.ErrConflict, problems.Conflict("user conflict")).
+		Register(service.ErrRoleNotFound, problems.NotFound("role not found")).
+		Register(service.ErrRoleConflict, problems.Conflict("role already exists")).
+		RegisterDetail(service.ErrRoleProtected, problems.Conflict("")).
+		Register(service.ErrInvitationNotFound, problems.NotFound("invitation not found")).
+		Register(service.ErrInvitationAccepted, problems.Conflict("invitation already accepted")).
+		Register(service.ErrInvitationExpired, problems.New(http.StatusGone, "Invitation expired", problems.TypeInvitationExpired, "invitation expired")).
+		Register(service.ErrInvitationMismatch, problems.Forbidden("invitation belongs to another user")).
+		Register(service.ErrInvitationsUnavailable, problems.NotConfigured("Invitations unavailable", "invitations are not configured")).
+		Register(service.ErrAPIKeyNotFound, problems.NotFound("api key not found")).
+		RegisterDetail(service.ErrAPIKeyNotAllowed, problems.Forbidden("")).
+		Register(service.ErrServiceAccountNotFound, problems.NotFound("service account not found")).
+		RegisterDetail(service.ErrServiceAccountConflict, problems.Conflict("")).
+		RegisterDetail(service.ErrServiceAccountNotAllowed, problems.Forbidden("")).
+		Register(service.ErrSyncUnavailable, problems.NotConfigured("User sync unavailable", "the auth provider does not expose its users")).
+		Register(service.ErrInvitationNotSent, problems.New(http.StatusBadGateway, "Invitation not delivered", problems.TypeDeliveryFailed, "the invitation email could not be sent; resend it later"))
+	problems.RegisterAs(c, func(err *tenant.QuotaExceededError) problems.Problem {
+		return problems.QuotaExceeded(err.Error())
+	})
+	return c
+}()
 
-	return status, h.buildProblem(title, detail, problemType, status, fields)
-}
-
-func (h *Handler) classifyError(err error) (status int, title, detail, problemType string, fieldErrors service.FieldErrors) {
-	var validationErr *service.ValidationError
-	switch {
-	case errors.As(err, &validationErr):
-		return http.StatusBadRequest,
-			"Validation failed",
-			"one or more fields are invalid",
-			problemTypeValidation,
-			validationErr.Fields
-	case errors.Is(err, service.ErrNotFound):
-		return http.StatusNotFound,
-			"Resource not found",
-			"user not found",
-			problemTypeNotFound,
-			nil
-	case errors.Is(err, service.ErrConflict):
-		return http.StatusConflict,
-			"Conflict",
-			"user conflict",
-			problemTypeConflict,
-			nil
-	default:
-		return http.StatusInternalServerError,
-			"Internal server error",
-			"an unexpected error occurred",
-			problemTypeInternal,
-			nil
-	}
-}
-
-func (h *Handler) buildProblem(title, detail, problemType string, status int, fieldErrors service.FieldErrors) externalRef3.ProblemDetails {
-	problem := externalRef3.ProblemDetails{
-		Title:  title,
-		Status: status,
-	}
-
-	if detail != "" {
-		problem.Detail = &detail
-	}
-	if problemType != "" {
-		problem.Type = &problemType
-	}
-
-	if len(fieldErrors) > 0 {
-		copied := make(map[string][]string, len(fieldErrors))
-		for field, messages := range fieldErrors {
-			copied[field] = append([]string(nil), messages...)
-		}
-		problem.Errors = &copied
-	}
-
-	return problem
-}
-
-func (h *Handler) loggerFrom(ctx context.Context) *zap.Logger {
-	if logger, ok := platformlogging.FromContext(ctx); ok {
-		return logger
-	}
-	return h.logger
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
 }
@@ -16,6 +16,7 @@ import (
 	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
 	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
 	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
 )
 
@@ -26,6 +27,26 @@ type mockService struct {
 	updateFn     func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input service.UpdateInput) (service.User, error)
 	updateSelfFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input service.UpdateSelfInput) (service.User, error)
 	deleteFn     func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+
+	listRolesFn    func(ctx context.Context, audit requesttrace.AuditInfo) ([]service.Role, error)
+	createRoleFn   func(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateRoleInput) (service.Role, error)
+	deleteRoleFn   func(ctx context.Context, audit requesttrace.AuditInfo, name string) error
+	getUserRolesFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) ([]string, error)
+	setUserRolesFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, roles []string) ([]string, error)
+
+	inviteFn           func(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.Invitation, error)
+	getInvitationFn    func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Invitation, error)
+	resendInvitationFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Invitation, error)
+	expireInvitationFn func(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Invitation, error)
+	acceptInvitationFn func(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, token string) (service.User, error)
+	syncUsersFn        func(ctx context.Context, audit requesttrace.AuditInfo, dryRun bool) (service.SyncReport, error)
+
+	createAPIKeyFn func(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, input service.CreateAPIKeyInput) (service.CreatedAPIKey, error)
+	listAPIKeysFn  func(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials) ([]service.APIKey, error)
+	revokeAPIKeyFn func(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, keyID uuid.UUID) error
+
+	getAttributesSchemaFn func(ctx context.Context, audit requesttrace.AuditInfo) (service.AttributesSchema, error)
+	setAttributesSchemaFn func(ctx context.Context, audit requesttrace.AuditInfo, slug *string) (service.AttributesSchema, error)
 }
 
 func (m *mockService) Create(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.User, error) {
@@ -70,6 +91,138 @@ func (m *mockService) Delete(ctx context.Context, audit requesttrace.AuditInfo,
 	return m.deleteFn(ctx, audit, id)
 }
 
+func (m *mockService) ListRoles(ctx context.Context, audit requesttrace.AuditInfo) ([]service.Role, error) {
+	if m.listRolesFn == nil {
+		panic("listRolesFn not configured")
+	}
+	return m.listRolesFn(ctx, audit)
+}
+
+func (m *mockService) CreateRole(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateRoleInput) (service.Role, error) {
+	if m.createRoleFn == nil {
+		panic("createRoleFn not configured")
+	}
+	return m.createRoleFn(ctx, audit, input)
+}
+
+func (m *mockService) DeleteRole(ctx context.Context, audit requesttrace.AuditInfo, name string) error {
+	if m.deleteRoleFn == nil {
+		panic("deleteRoleFn not configured")
+	}
+	return m.deleteRoleFn(ctx, audit, name)
+}
+
+func (m *mockService) GetUserRoles(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) ([]string, error) {
+	if m.getUserRolesFn == nil {
+		panic("getUserRolesFn not configured")
+	}
+	return m.getUserRolesFn(ctx, audit, id)
+}
+
+func (m *mockService) SetUserRoles(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, roles []string) ([]string, error) {
+	if m.setUserRolesFn == nil {
+		panic("setUserRolesFn not configured")
+	}
+	return m.setUserRolesFn(ctx, audit, id, roles)
+}
+
+func (m *mockService) Invite(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.Invitation, error) {
+	if m.inviteFn == nil {
+		panic("inviteFn not configured")
+	}
+	return m.inviteFn(ctx, audit, input)
+}
+
+func (m *mockService) GetInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Invitation, error) {
+	if m.getInvitationFn == nil {
+		panic("getInvitationFn not configured")
+	}
+	return m.getInvitationFn(ctx, audit, id)
+}
+
+func (m *mockService) ResendInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Invitation, error) {
+	if m.resendInvitationFn == nil {
+		panic("resendInvitationFn not configured")
+	}
+	return m.resendInvitationFn(ctx, audit, id)
+}
+
+func (m *mockService) ExpireInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (service.Invitation, error) {
+	if m.expireInvitationFn == nil {
+		panic("expireInvitationFn not configured")
+	}
+	return m.expireInvitationFn(ctx, audit, id)
+}
+
+func (m *mockService) AcceptInvitation(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, token string) (service.User, error) {
+	if m.acceptInvitationFn == nil {
+		panic("acceptInvitationFn not configured")
+	}
+	return m.acceptInvitationFn(ctx, audit, caller, token)
+}
+
+func (m *mockService) SyncUsers(ctx context.Context, audit requesttrace.AuditInfo, dryRun bool) (service.SyncReport, error) {
+	if m.syncUsersFn == nil {
+		panic("syncUsersFn not configured")
+	}
+	return m.syncUsersFn(ctx, audit, dryRun)
+}
+
+func (m *mockService) GetAttributesSchema(ctx context.Context, audit requesttrace.AuditInfo) (service.AttributesSchema, error) {
+	if m.getAttributesSchemaFn == nil {
+		panic("getAttributesSchemaFn not configured")
+	}
+	return m.getAttributesSchemaFn(ctx, audit)
+}
+
+func (m *mockService) SetAttributesSchema(ctx context.Context, audit requesttrace.AuditInfo, slug *string) (service.AttributesSchema, error) {
+	if m.setAttributesSchemaFn == nil {
+		panic("setAttributesSchemaFn not configured")
+	}
+	return m.setAttributesSchemaFn(ctx, audit, slug)
+}
+
+func (m *mockService) RecordActivity(context.Context, *platformauth.UserCredentials) error {
+	panic("RecordActivity not configured")
+}
+
+func (m *mockService) CreateAPIKey(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, input service.CreateAPIKeyInput) (service.CreatedAPIKey, error) {
+	if m.createAPIKeyFn == nil {
+		panic("createAPIKeyFn not configured")
+	}
+	return m.createAPIKeyFn(ctx, audit, caller, input)
+}
+
+func (m *mockService) ListAPIKeys(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials) ([]service.APIKey, error) {
+	if m.listAPIKeysFn == nil {
+		panic("listAPIKeysFn not configured")
+	}
+	return m.listAPIKeysFn(ctx, audit, caller)
+}
+
+func (m *mockService) RevokeAPIKey(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, keyID uuid.UUID) error {
+	if m.revokeAPIKeyFn == nil {
+		panic("revokeAPIKeyFn not configured")
+	}
+	return m.revokeAPIKeyFn(ctx, audit, caller, keyID)
+}
+
+func (m *mockService) CreateServiceAccount(context.Context, requesttrace.AuditInfo, *platformauth.UserCredentials, service.CreateServiceAccountInput) (service.CreatedServiceAccount, error) {
+	panic("CreateServiceAccount not configured")
+}
+
+func (m *mockService) ListServiceAccounts(context.Context, requesttrace.AuditInfo) ([]service.ServiceAccount, error) {
+	panic("ListServiceAccounts not configured")
+}
+
+func (m *mockService) RevokeServiceAccount(context.Context, requesttrace.AuditInfo, uuid.UUID) error {
+	panic("RevokeServiceAccount not configured")
+}
+
+func (m *mockService) ResolvePermissions(context.Context, *platformauth.UserCredentials) ([]string, error) {
+	panic("ResolvePermissions not configured")
+}
+
 func TestUsersListSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -86,10 +239,7 @@ func TestUsersListSuccess(t *testing.T) {
 				CreatedAt: now,
 				UpdatedAt: now,
 			}},
-			Page:       1,
-			PageSize:   20,
-			TotalItems: 1,
-			TotalPages: 1,
+			Envelope: pagination.Envelope{Page: 1, PageSize: 20, TotalItems: 1, TotalPages: 1},
 		}, nil
 	}
 
@@ -173,6 +323,122 @@ func TestUsersCreateSuccess(t *testing.T) {
 	require.Equal(t, externalRef2.UUID(userID), success.Body.Id)
 }
 
+func TestUsersInviteSuccess(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	userID := uuid.New()
+
+	svc := &mockService{}
+	svc.inviteFn = func(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateInput) (service.Invitation, error) {
+		require.Equal(t, "new@example.com", input.Email)
+		return service.Invitation{
+			UserID:    userID,
+			Email:     input.Email,
+			FullName:  input.FullName,
+			Status:    service.InvitationPending,
+			CreatedAt: now,
+			ExpiresAt: now.Add(service.DefaultInvitationTTL),
+			SentAt:    &now,
+			SendCount: 1,
+		}, nil
+	}
+
+	h := New(svc, zaptest.NewLogger(t))
+
+	resp, err := h.UsersInvite(context.Background(), users.UsersInviteRequestObject{Body: &users.InviteUser{
+		Email:    externalRef2.Email("new@example.com"),
+		FullName: "New User",
+	}})
+	require.NoError(t, err)
+
+	success, ok := resp.(users.UsersInvite201JSONResponse)
+	require.True(t, ok)
+	require.Equal(t, "/api/v1/admin/users/"+userID.String(), success.Headers.Location)
+	require.Equal(t, users.Pending, success.Body.Status)
+	require.NotNil(t, success.Body.SentAt)
+	require.Nil(t, success.Body.AcceptedAt)
+}
+
+func TestUsersSync(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockService{}
+	svc.syncUsersFn = func(ctx context.Context, audit requesttrace.AuditInfo, dryRun bool) (service.SyncReport, error) {
+		require.True(t, dryRun)
+		return service.SyncReport{
+			DryRun:          true,
+			Created:         []service.User{{ID: uuid.New(), Email: "new@example.com", FullName: "New"}},
+			ProviderOrphans: []service.ProviderOrphan{{Identity: service.Identity{UID: "legacy-uid"}, Reason: service.OrphanInvalidUID}},
+		}, nil
+	}
+	h := New(svc, zaptest.NewLogger(t))
+
+	dryRun := true
+	resp, err := h.UsersSync(context.Background(), users.UsersSyncRequestObject{Params: users.UsersSyncParams{DryRun: &dryRun}})
+	require.NoError(t, err)
+
+	success, ok := resp.(users.UsersSync200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, success.Created, 1)
+	require.NotNil(t, success.Updated)
+	require.Equal(t, users.InvalidUid, success.ProviderOrphans[0].Reason)
+	require.Nil(t, success.ProviderOrphans[0].Email)
+
+	svc.syncUsersFn = func(ctx context.Context, audit requesttrace.AuditInfo, dryRun bool) (service.SyncReport, error) {
+		return service.SyncReport{}, service.ErrSyncUnavailable
+	}
+	resp, err = h.UsersSync(context.Background(), users.UsersSyncRequestObject{})
+	require.NoError(t, err)
+
+	problem, ok := resp.(users.UsersSyncdefaultApplicationProblemPlusJSONResponse)
+	require.True(t, ok)
+	require.Equal(t, http.StatusServiceUnavailable, problem.StatusCode)
+}
+
+func TestInvitationsAcceptErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err  error
+		want int
+	}{
+		{err: service.ErrInvitationExpired, want: http.StatusGone},
+		{err: service.ErrInvitationMismatch, want: http.StatusForbidden},
+		{err: service.ErrInvitationAccepted, want: http.StatusConflict},
+		{err: service.ErrInvitationNotFound, want: http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		svc := &mockService{}
+		svc.acceptInvitationFn = func(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, token string) (service.User, error) {
+			return service.User{}, tc.err
+		}
+		h := New(svc, zaptest.NewLogger(t))
+
+		ctx := contextWithCredentials(t, platformauth.UserCredentials{Id: uuid.NewString()})
+		resp, err := h.InvitationsAccept(ctx, users.InvitationsAcceptRequestObject{Body: &users.AcceptInvitation{Token: "token"}})
+		require.NoError(t, err)
+
+		problem, ok := resp.(users.InvitationsAcceptdefaultApplicationProblemPlusJSONResponse)
+		require.True(t, ok)
+		require.Equal(t, tc.want, problem.StatusCode, tc.err.Error())
+	}
+}
+
+func TestInvitationsAcceptUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	h := New(&mockService{}, zaptest.NewLogger(t))
+
+	resp, err := h.InvitationsAccept(context.Background(), users.InvitationsAcceptRequestObject{Body: &users.AcceptInvitation{Token: "token"}})
+	require.NoError(t, err)
+
+	problem, ok := resp.(users.InvitationsAcceptdefaultApplicationProblemPlusJSONResponse)
+	require.True(t, ok)
+	require.Equal(t, http.StatusUnauthorized, problem.StatusCode)
+}
+
 func TestUsersUpdateMissingBody(t *testing.T) {
 	t.Parallel()
 
@@ -279,6 +545,48 @@ func TestUsersDeleteNotFound(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, problem.StatusCode)
 }
 
+func TestRolesCreateSuccess(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	svc := &mockService{}
+	svc.createRoleFn = func(ctx context.Context, audit requesttrace.AuditInfo, input service.CreateRoleInput) (service.Role, error) {
+		require.Equal(t, "editor", input.Name)
+		require.Equal(t, []string{"users:read"}, input.Permissions)
+		return service.Role{Name: "editor", Permissions: input.Permissions, CreatedAt: now, UpdatedAt: now}, nil
+	}
+
+	h := New(svc, zaptest.NewLogger(t))
+
+	resp, err := h.RolesCreate(context.Background(), users.RolesCreateRequestObject{
+		Body: &users.RolesCreateJSONRequestBody{Name: "editor", Permissions: []string{"users:read"}},
+	})
+	require.NoError(t, err)
+
+	created, ok := resp.(users.RolesCreate201JSONResponse)
+	require.True(t, ok)
+	require.Equal(t, "/api/v1/admin/roles/editor", created.Headers.Location)
+	require.Equal(t, []string{"users:read"}, created.Body.Permissions)
+}
+
+func TestRolesDeleteProtected(t *testing.T) {
+	t.Parallel()
+
+	svc := &mockService{}
+	svc.deleteRoleFn = func(ctx context.Context, audit requesttrace.AuditInfo, name string) error {
+		return service.ErrRoleProtected
+	}
+
+	h := New(svc, zaptest.NewLogger(t))
+
+	resp, err := h.RolesDelete(context.Background(), users.RolesDeleteRequestObject{RoleName: "admin"})
+	require.NoError(t, err)
+
+	problem, ok := resp.(users.RolesDeletedefaultApplicationProblemPlusJSONResponse)
+	require.True(t, ok)
+	require.Equal(t, http.StatusConflict, problem.StatusCode)
+}
+
 func contextWithCredentials(t *testing.T, creds platformauth.UserCredentials) context.Context {
 	t.Helper()
 
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	inviteOperation           operation = "usersInvite"
+	invitationGetOperation    operation = "usersInvitationGet"
+	invitationResendOperation operation = "usersInvitationResend"
+	invitationExpireOperation operation = "usersInvitationExpire"
+	invitationAcceptOperation operation = "invitationsAccept"
+)
+
+func (h *Handler) UsersInvite(ctx context.Context, request users.UsersInviteRequestObject) (users.UsersInviteResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.UsersInvitedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	invitation, err := h.svc.Invite(ctx, h.audit(ctx), service.CreateInput{
+		Email:    string(request.Body.Email),
+		FullName: request.Body.FullName,
+	})
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, inviteOperation)
+		return users.UsersInvitedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	location := fmt.Sprintf("/api/v1/admin/users/%s", invitation.UserID)
+
+	return users.UsersInvite201JSONResponse{
+		Headers: users.UsersInvite201ResponseHeaders{Location: location},
+		Body:    toAPIInvitation(invitation),
+	}, nil
+}
+
+func (h *Handler) UsersInvitationGet(ctx context.Context, request users.UsersInvitationGetRequestObject) (users.UsersInvitationGetResponseObject, error) {
+	invitation, err := h.svc.GetInvitation(ctx, h.audit(ctx), uuid.UUID(request.UserId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, invitationGetOperation)
+		return users.UsersInvitationGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersInvitationGet200JSONResponse(toAPIInvitation(invitation)), nil
+}
+
+func (h *Handler) UsersInvitationResend(ctx context.Context, request users.UsersInvitationResendRequestObject) (users.UsersInvitationResendResponseObject, error) {
+	invitation, err := h.svc.ResendInvitation(ctx, h.audit(ctx), uuid.UUID(request.UserId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, invitationResendOperation)
+		return users.UsersInvitationResenddefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersInvitationResend200JSONResponse(toAPIInvitation(invitation)), nil
+}
+
+func (h *Handler) UsersInvitationExpire(ctx context.Context, request users.UsersInvitationExpireRequestObject) (users.UsersInvitationExpireResponseObject, error) {
+	invitation, err := h.svc.ExpireInvitation(ctx, h.audit(ctx), uuid.UUID(request.UserId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, invitationExpireOperation)
+		return users.UsersInvitationExpiredefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersInvitationExpire200JSONResponse(toAPIInvitation(invitation)), nil
+}
+
+func (h *Handler) InvitationsAccept(ctx context.Context, request users.InvitationsAcceptRequestObject) (users.InvitationsAcceptResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.InvitationsAcceptdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	credentials, ok := platformauth.UserFromContext(ctx)
+	if !ok || credentials == nil {
+		problem := problems.Unauthorized("missing credentials").Details()
+		return users.InvitationsAcceptdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusUnauthorized}, nil
+	}
+
+	user, err := h.svc.AcceptInvitation(ctx, h.audit(ctx), credentials, request.Body.Token)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, invitationAcceptOperation)
+		return users.InvitationsAcceptdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.InvitationsAccept200JSONResponse(toAPIUser(user)), nil
+}
+
+func toAPIInvitation(invitation service.Invitation) users.Invitation {
+	out := users.Invitation{
+		UserId:    externalRef2.UUID(invitation.UserID),
+		Email:     externalRef2.Email(invitation.Email),
+		FullName:  invitation.FullName,
+		Status:    users.InvitationStatus(invitation.Status),
+		InvitedBy: invitation.InvitedBy,
+		CreatedAt: externalRef2.Timestamp(invitation.CreatedAt),
+		ExpiresAt: externalRef2.Timestamp(invitation.ExpiresAt),
+		SendCount: invitation.SendCount,
+	}
+	if invitation.SentAt != nil {
+		sentAt := externalRef2.Timestamp(*invitation.SentAt)
+		out.SentAt = &sentAt
+	}
+	if invitation.AcceptedAt != nil {
+		acceptedAt := externalRef2.Timestamp(*invitation.AcceptedAt)
+		out.AcceptedAt = &acceptedAt
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	rolesListOperation    operation = "rolesList"
+	rolesCreateOperation  operation = "rolesCreate"
+	rolesDeleteOperation  operation = "rolesDelete"
+	userRolesGetOperation operation = "usersRolesGet"
+	userRolesSetOperation operation = "usersRolesSet"
+)
+
+func (h *Handler) RolesList(ctx context.Context, _ users.RolesListRequestObject) (users.RolesListResponseObject, error) {
+	roles, err := h.svc.ListRoles(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, rolesListOperation)
+		return users.RolesListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]users.Role, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, toAPIRole(role))
+	}
+	return users.RolesList200JSONResponse{Items: items}, nil
+}
+
+func (h *Handler) RolesCreate(ctx context.Context, request users.RolesCreateRequestObject) (users.RolesCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.RolesCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	created, err := h.svc.CreateRole(ctx, h.audit(ctx), service.CreateRoleInput{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Permissions: request.Body.Permissions,
+	})
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, rolesCreateOperation)
+		return users.RolesCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	location := fmt.Sprintf("/api/v1/admin/roles/%s", url.PathEscape(created.Name))
+
+	return users.RolesCreate201JSONResponse{
+		Headers: users.RolesCreate201ResponseHeaders{Location: location},
+		Body:    toAPIRole(created),
+	}, nil
+}
+
+func (h *Handler) RolesDelete(ctx context.Context, request users.RolesDeleteRequestObject) (users.RolesDeleteResponseObject, error) {
+	if err := h.svc.DeleteRole(ctx, h.audit(ctx), request.RoleName); err != nil {
+		status, problem := h.problemForError(ctx, err, rolesDeleteOperation)
+		return users.RolesDeletedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.RolesDelete204Response{}, nil
+}
+
+func (h *Handler) UsersRolesGet(ctx context.Context, request users.UsersRolesGetRequestObject) (users.UsersRolesGetResponseObject, error) {
+	roles, err := h.svc.GetUserRoles(ctx, h.audit(ctx), uuid.UUID(request.UserId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, userRolesGetOperation)
+		return users.UsersRolesGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersRolesGet200JSONResponse{Roles: roles}, nil
+}
+
+func (h *Handler) UsersRolesSet(ctx context.Context, request users.UsersRolesSetRequestObject) (users.UsersRolesSetResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.UsersRolesSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	roles, err := h.svc.SetUserRoles(ctx, h.audit(ctx), uuid.UUID(request.UserId), request.Body.Roles)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, userRolesSetOperation)
+		return users.UsersRolesSetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersRolesSet200JSONResponse{Roles: roles}, nil
+}
+
+func toAPIRole(role service.Role) users.Role {
+	permissions := role.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return users.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+		CreatedAt:   externalRef2.Timestamp(role.CreatedAt),
+		UpdatedAt:   externalRef2.Timestamp(role.UpdatedAt),
+	}
+}
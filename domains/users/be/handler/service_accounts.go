@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const (
+	serviceAccountsListOperation   operation = "serviceAccountsList"
+	serviceAccountsCreateOperation operation = "serviceAccountsCreate"
+	serviceAccountsRevokeOperation operation = "serviceAccountsRevoke"
+)
+
+func (h *Handler) ServiceAccountsList(ctx context.Context, _ users.ServiceAccountsListRequestObject) (users.ServiceAccountsListResponseObject, error) {
+	accounts, err := h.svc.ListServiceAccounts(ctx, h.audit(ctx))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, serviceAccountsListOperation)
+		return users.ServiceAccountsListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]users.ServiceAccount, 0, len(accounts))
+	for _, account := range accounts {
+		items = append(items, toAPIServiceAccount(account))
+	}
+	return users.ServiceAccountsList200JSONResponse{Items: items}, nil
+}
+
+func (h *Handler) ServiceAccountsCreate(ctx context.Context, request users.ServiceAccountsCreateRequestObject) (users.ServiceAccountsCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return users.ServiceAccountsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	credentials, _ := platformauth.UserFromContext(ctx)
+	created, err := h.svc.CreateServiceAccount(ctx, h.audit(ctx), credentials, service.CreateServiceAccountInput{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Scopes:      request.Body.Scopes,
+	})
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, serviceAccountsCreateOperation)
+		return users.ServiceAccountsCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	account := toAPIServiceAccount(created.ServiceAccount)
+	return users.ServiceAccountsCreate201JSONResponse{
+		Id:          account.Id,
+		Name:        account.Name,
+		Description: account.Description,
+		Scopes:      account.Scopes,
+		CreatedBy:   account.CreatedBy,
+		CreatedAt:   account.CreatedAt,
+		LastUsedAt:  account.LastUsedAt,
+		Key:         created.Key,
+	}, nil
+}
+
+func (h *Handler) ServiceAccountsRevoke(ctx context.Context, request users.ServiceAccountsRevokeRequestObject) (users.ServiceAccountsRevokeResponseObject, error) {
+	if err := h.svc.RevokeServiceAccount(ctx, h.audit(ctx), uuid.UUID(request.ServiceAccountId)); err != nil {
+		status, problem := h.problemForError(ctx, err, serviceAccountsRevokeOperation)
+		return users.ServiceAccountsRevokedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+	return users.ServiceAccountsRevoke204Response{}, nil
+}
+
+func toAPIServiceAccount(account service.ServiceAccount) users.ServiceAccount {
+	out := users.ServiceAccount{
+		Id:          externalRef2.UUID(account.ID),
+		Name:        account.Name,
+		Description: account.Description,
+		Scopes:      account.Scopes,
+		CreatedBy:   (*externalRef2.UUID)(account.CreatedBy),
+		CreatedAt:   externalRef2.Timestamp(account.CreatedAt),
+	}
+	if out.Scopes == nil {
+		out.Scopes = []string{}
+	}
+	if account.LastUsedAt != nil {
+		lastUsedAt := externalRef2.Timestamp(*account.LastUsedAt)
+		out.LastUsedAt = &lastUsedAt
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+)
+
+const syncOperation operation = "usersSync"
+
+func (h *Handler) UsersSync(ctx context.Context, request users.UsersSyncRequestObject) (users.UsersSyncResponseObject, error) {
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	report, err := h.svc.SyncUsers(ctx, h.audit(ctx), dryRun)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, syncOperation)
+		return users.UsersSyncdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return users.UsersSync200JSONResponse{
+		DryRun:          report.DryRun,
+		Created:         toAPIUsers(report.Created),
+		Updated:         toAPIUsers(report.Updated),
+		Unchanged:       report.Unchanged,
+		LocalOrphans:    toAPIUsers(report.LocalOrphans),
+		ProviderOrphans: toAPIProviderOrphans(report.ProviderOrphans),
+	}, nil
+}
+
+func toAPIUsers(list []service.User) []users.User {
+	out := make([]users.User, 0, len(list))
+	for _, user := range list {
+		out = append(out, toAPIUser(user))
+	}
+	return out
+}
+
+func toAPIProviderOrphans(list []service.ProviderOrphan) []users.ProviderIdentity {
+	out := make([]users.ProviderIdentity, 0, len(list))
+	for _, orphan := range list {
+		item := users.ProviderIdentity{Uid: orphan.UID, Reason: users.ProviderIdentityReason(orphan.Reason)}
+		if orphan.Email != "" {
+			email := orphan.Email
+			item.Email = &email
+		}
+		if orphan.DisplayName != "" {
+			name := orphan.DisplayName
+			item.DisplayName = &name
+		}
+		out = append(out, item)
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ActivityRecorder stores the sign-in and activity of the caller; the users service implements it.
+type ActivityRecorder interface {
+	RecordActivity(ctx context.Context, caller *platformauth.UserCredentials) error
+}
+
+// maxTrackedTokens bounds the in-memory record of tokens already seen. Past it the record starts over, which only
+// costs one extra write per active user.
+const maxTrackedTokens = 10000
+
+// TrackActivity records last login and activity for authenticated callers. It writes on the first request of each
+// token (its iat) in a tenant rather than on every request; a failure is logged, retried on the next request and
+// never fails the request. Mount it after the tenant space middleware, since the users row lives in the tenant
+// schema.
+func TrackActivity(recorder ActivityRecorder, logger *zap.Logger) func(http.Handler) http.Handler {
+	if recorder == nil {
+		panic("activity recorder is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	tokens := &seenTokens{issuedAt: make(map[string]time.Time)}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			creds, ok := platformauth.UserFromContext(ctx)
+			space, hasSpace := tenant.FromContext(ctx)
+			if !ok || creds == nil || !hasSpace || creds.IssuedAt.IsZero() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key := space.TenantID.String() + "/" + creds.Id
+			if tokens.firstUse(key, creds.IssuedAt) {
+				if err := recorder.RecordActivity(ctx, creds); err != nil {
+					tokens.forget(key)
+					requestLogger := logger
+					if l, ok := platformlogging.FromContext(ctx); ok {
+						requestLogger = l
+					}
+					requestLogger.Warn("record user activity failed", zap.String("user_id", creds.Id), zap.Error(err))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type seenTokens struct {
+	mu       sync.Mutex
+	issuedAt map[string]time.Time
+}
+
+// firstUse reports whether issuedAt is newer than the last token seen for key, and remembers it.
+func (s *seenTokens) firstUse(key string, issuedAt time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if last, ok := s.issuedAt[key]; ok && !issuedAt.After(last) {
+		return false
+	}
+	if len(s.issuedAt) >= maxTrackedTokens {
+		s.issuedAt = make(map[string]time.Time)
+	}
+	s.issuedAt[key] = issuedAt
+	return true
+}
+
+func (s *seenTokens) forget(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.issuedAt, key)
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type countingRecorder struct {
+	calls int
+	err   error
+}
+
+func (c *countingRecorder) RecordActivity(context.Context, *platformauth.UserCredentials) error {
+	c.calls++
+	return c.err
+}
+
+func TestTrackActivityRecordsOncePerToken(t *testing.T) {
+	t.Parallel()
+
+	recorder := &countingRecorder{}
+	handler := TrackActivity(recorder, zaptest.NewLogger(t))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme"}
+	issued := time.Unix(1700000000, 0)
+	serve := func(issuedAt time.Time) int {
+		creds := &platformauth.UserCredentials{Id: uuid.NewSHA1(uuid.Nil, []byte("ann")).String(), IssuedAt: issuedAt}
+		ctx := platformauth.WithUserCredentials(context.Background(), creds)
+		ctx = tenant.WithSpace(ctx, space)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil).WithContext(ctx))
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(issued))
+	require.Equal(t, http.StatusOK, serve(issued))
+	require.Equal(t, 1, recorder.calls)
+
+	// A refreshed token is recorded again.
+	serve(issued.Add(time.Hour))
+	require.Equal(t, 2, recorder.calls)
+
+	// Failures never fail the request and are retried.
+	recorder.err = errors.New("db down")
+	require.Equal(t, http.StatusOK, serve(issued.Add(2*time.Hour)))
+	serve(issued.Add(2 * time.Hour))
+	require.Equal(t, 4, recorder.calls)
+}
@@ -0,0 +1,106 @@
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/service"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// FirebaseIdentityProvisioner creates invited users in, and lists the users of, the Identity Platform tenant of
+// the tenant in ctx. The external tenant id is <envKey>-<slug>, the same value tokens carry in their tenant claim.
+type FirebaseIdentityProvisioner struct {
+	client *firebaseauth.Client
+	envKey string
+}
+
+func NewFirebaseIdentityProvisioner(client *firebaseauth.Client, envKey string) *FirebaseIdentityProvisioner {
+	if client == nil {
+		panic("firebase auth client is required")
+	}
+	return &FirebaseIdentityProvisioner{client: client, envKey: envKey}
+}
+
+// CreateIdentity creates the identity with the user id as uid. An identity that already exists with that uid is
+// reused, so a retried invite does not fail.
+func (p *FirebaseIdentityProvisioner) CreateIdentity(ctx context.Context, user service.User) error {
+	client, err := p.tenantClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := (&firebaseauth.UserToCreate{}).
+		UID(user.ID.String()).
+		Email(user.Email).
+		DisplayName(user.FullName).
+		EmailVerified(false)
+	if _, err := client.CreateUser(ctx, params); err != nil {
+		switch {
+		case firebaseauth.IsUIDAlreadyExists(err):
+			return nil
+		case firebaseauth.IsEmailAlreadyExists(err):
+			return service.ErrConflict
+		default:
+			return fmt.Errorf("create firebase user: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeleteIdentity removes the identity; a missing identity is not an error.
+func (p *FirebaseIdentityProvisioner) DeleteIdentity(ctx context.Context, userID uuid.UUID) error {
+	client, err := p.tenantClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteUser(ctx, userID.String()); err != nil && !firebaseauth.IsUserNotFound(err) {
+		return fmt.Errorf("delete firebase user: %w", err)
+	}
+	return nil
+}
+
+// ListIdentities pages through every identity of the tenant's Identity Platform tenant.
+func (p *FirebaseIdentityProvisioner) ListIdentities(ctx context.Context) ([]service.Identity, error) {
+	client, err := p.tenantClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var identities []service.Identity
+	it := client.Users(ctx, "")
+	for {
+		user, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return identities, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list firebase users: %w", err)
+		}
+		identities = append(identities, service.Identity{UID: user.UID, Email: user.Email, DisplayName: user.DisplayName})
+	}
+}
+
+func (p *FirebaseIdentityProvisioner) tenantClient(ctx context.Context) (*firebaseauth.TenantClient, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("tenant space missing from context")
+	}
+
+	client, err := p.client.TenantManager.AuthForTenant(p.envKey + "-" + space.Slug)
+	if err != nil {
+		return nil, fmt.Errorf("firebase tenant client: %w", err)
+	}
+	return client, nil
+}
+
+var (
+	_ service.IdentityProvisioner = (*FirebaseIdentityProvisioner)(nil)
+	_ service.IdentityDirectory   = (*FirebaseIdentityProvisioner)(nil)
+)
@@ -2,7 +2,9 @@ package repo
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -17,19 +19,88 @@ type Repository interface {
 	Get(ctx context.Context, id uuid.UUID) (persistence.User, error)
 	Update(ctx context.Context, id uuid.UUID, params persistence.UpdateUserParams) (persistence.User, error)
 	UpdateFullName(ctx context.Context, id uuid.UUID, fullName string) (persistence.User, error)
+	RecordActivity(ctx context.Context, id uuid.UUID, sessionStart, activeAt time.Time) (persistence.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	ListRoles(ctx context.Context) ([]persistence.Role, error)
+	CreateRole(ctx context.Context, params persistence.CreateRoleParams) (persistence.Role, error)
+	DeleteRole(ctx context.Context, name string) error
+	ListUserRoles(ctx context.Context, id uuid.UUID) ([]string, error)
+	SetUserRoles(ctx context.Context, id uuid.UUID, roles []string) ([]string, error)
+	RolePermissions(ctx context.Context, userID *uuid.UUID, roles []string) ([]string, error)
+
+	CreateInvitation(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error)
+	GetInvitation(ctx context.Context, id uuid.UUID) (persistence.Invitation, error)
+	FindInvitationByToken(ctx context.Context, tokenHash string) (persistence.Invitation, error)
+	RenewInvitation(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (persistence.Invitation, error)
+	ExpireInvitation(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error)
+	MarkInvitationSent(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error)
+	AcceptInvitation(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error)
+
+	UpsertMembership(ctx context.Context, email string, userID uuid.UUID, roles []string) error
+	SetMembershipRoles(ctx context.Context, userID uuid.UUID, roles []string) error
+	DeleteMemberships(ctx context.Context, userID uuid.UUID) error
+
+	CreateAPIKey(ctx context.Context, params persistence.CreateAPIKeyParams) (persistence.APIKey, error)
+	GetAPIKey(ctx context.Context, keyID uuid.UUID) (persistence.APIKey, error)
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]persistence.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID uuid.UUID, at time.Time) error
+	TouchAPIKey(ctx context.Context, keyID uuid.UUID, at time.Time) error
+
+	CreateServiceAccount(ctx context.Context, params persistence.CreateServiceAccountParams) (persistence.ServiceAccount, error)
+	GetServiceAccount(ctx context.Context, id uuid.UUID) (persistence.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]persistence.ServiceAccount, error)
+	RevokeServiceAccount(ctx context.Context, id uuid.UUID, at time.Time) error
+	TouchServiceAccount(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	GetAttributesSchema(ctx context.Context) (string, error)
+	SetAttributesSchema(ctx context.Context, slug string) error
+	ResolveAttributesSchema(ctx context.Context, slug string) (persistence.SchemaRecord, error)
+	ValidateAttributes(ctx context.Context, schema persistence.SchemaRecord, attributes json.RawMessage) error
+}
+
+// AttributeSchemas gives the repository read access to the schema repository, whose schemas validate the
+// tenant-defined user attributes.
+type AttributeSchemas struct {
+	DB        *persistence.SpaceDB
+	Store     *persistence.SchemaRepositoryStore
+	Validator *persistence.SchemaValidator
 }
 
 type postgresRepository struct {
-	store *persistence.UserStore
+	store       *persistence.UserStore
+	roles       *persistence.RoleStore
+	invitations *persistence.InvitationStore
+	memberships *persistence.MembershipStore
+	apiKeys     *persistence.APIKeyStore
+	accounts    *persistence.ServiceAccountStore
+	schemas     AttributeSchemas
 }
 
 // NewPostgresRepository constructs a repository backed by the shared persistence layer.
-func NewPostgresRepository(store *persistence.UserStore) Repository {
+func NewPostgresRepository(store *persistence.UserStore, roles *persistence.RoleStore, invitations *persistence.InvitationStore, memberships *persistence.MembershipStore, apiKeys *persistence.APIKeyStore, accounts *persistence.ServiceAccountStore, schemas AttributeSchemas) Repository {
 	if store == nil {
 		panic("user store is required")
 	}
-	return &postgresRepository{store: store}
+	if roles == nil {
+		panic("role store is required")
+	}
+	if invitations == nil {
+		panic("invitation store is required")
+	}
+	if memberships == nil {
+		panic("membership store is required")
+	}
+	if apiKeys == nil {
+		panic("api key store is required")
+	}
+	if accounts == nil {
+		panic("service account store is required")
+	}
+	if schemas.DB == nil || schemas.Store == nil || schemas.Validator == nil {
+		panic("attribute schemas are required")
+	}
+	return &postgresRepository{store: store, roles: roles, invitations: invitations, memberships: memberships, apiKeys: apiKeys, accounts: accounts, schemas: schemas}
 }
 
 func (r *postgresRepository) List(ctx context.Context, params persistence.ListUsersParams) (persistence.ListUsersResult, error) {
@@ -72,6 +143,14 @@ func (r *postgresRepository) UpdateFullName(ctx context.Context, id uuid.UUID, f
 	return r.store.UpdateUserFullName(ctx, space, id, fullName)
 }
 
+func (r *postgresRepository) RecordActivity(ctx context.Context, id uuid.UUID, sessionStart, activeAt time.Time) (persistence.User, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.User{}, err
+	}
+	return r.store.RecordActivity(ctx, space, id, sessionStart, activeAt)
+}
+
 func (r *postgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	space, err := requireTenantSpace(ctx)
 	if err != nil {
@@ -80,6 +159,243 @@ func (r *postgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.store.DeleteUser(ctx, space, id)
 }
 
+func (r *postgresRepository) ListRoles(ctx context.Context) ([]persistence.Role, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.roles.ListRoles(ctx, space)
+}
+
+func (r *postgresRepository) CreateRole(ctx context.Context, params persistence.CreateRoleParams) (persistence.Role, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Role{}, err
+	}
+	return r.roles.CreateRole(ctx, space, params)
+}
+
+func (r *postgresRepository) DeleteRole(ctx context.Context, name string) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.roles.DeleteRole(ctx, space, name)
+}
+
+func (r *postgresRepository) ListUserRoles(ctx context.Context, id uuid.UUID) ([]string, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.roles.ListUserRoles(ctx, space, id)
+}
+
+func (r *postgresRepository) SetUserRoles(ctx context.Context, id uuid.UUID, roles []string) ([]string, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.roles.SetUserRoles(ctx, space, id, roles)
+}
+
+func (r *postgresRepository) RolePermissions(ctx context.Context, userID *uuid.UUID, roles []string) ([]string, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.roles.RolePermissions(ctx, space, userID, roles)
+}
+
+func (r *postgresRepository) CreateInvitation(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.CreateInvitation(ctx, space, params)
+}
+
+func (r *postgresRepository) GetInvitation(ctx context.Context, id uuid.UUID) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.GetInvitation(ctx, space, id)
+}
+
+func (r *postgresRepository) FindInvitationByToken(ctx context.Context, tokenHash string) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.FindInvitationByToken(ctx, space, tokenHash)
+}
+
+func (r *postgresRepository) RenewInvitation(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.RenewInvitation(ctx, space, id, tokenHash, expiresAt)
+}
+
+func (r *postgresRepository) ExpireInvitation(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.ExpireInvitation(ctx, space, id, at)
+}
+
+func (r *postgresRepository) MarkInvitationSent(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.MarkInvitationSent(ctx, space, id, at)
+}
+
+func (r *postgresRepository) AcceptInvitation(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.Invitation{}, err
+	}
+	return r.invitations.AcceptInvitation(ctx, space, id, at)
+}
+
+func (r *postgresRepository) UpsertMembership(ctx context.Context, email string, userID uuid.UUID, roles []string) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.memberships.UpsertMembership(ctx, tenant.Membership{Email: email, TenantID: space.TenantID, UserID: userID, Roles: roles})
+}
+
+func (r *postgresRepository) SetMembershipRoles(ctx context.Context, userID uuid.UUID, roles []string) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.memberships.SetUserMembershipRoles(ctx, space.TenantID, userID, roles)
+}
+
+func (r *postgresRepository) DeleteMemberships(ctx context.Context, userID uuid.UUID) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.memberships.DeleteUserMemberships(ctx, space.TenantID, userID)
+}
+
+func (r *postgresRepository) CreateAPIKey(ctx context.Context, params persistence.CreateAPIKeyParams) (persistence.APIKey, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.APIKey{}, err
+	}
+	return r.apiKeys.CreateAPIKey(ctx, space, params)
+}
+
+func (r *postgresRepository) GetAPIKey(ctx context.Context, keyID uuid.UUID) (persistence.APIKey, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.APIKey{}, err
+	}
+	return r.apiKeys.GetAPIKey(ctx, space, keyID)
+}
+
+func (r *postgresRepository) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]persistence.APIKey, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.apiKeys.ListUserAPIKeys(ctx, space, userID)
+}
+
+func (r *postgresRepository) RevokeAPIKey(ctx context.Context, userID, keyID uuid.UUID, at time.Time) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.apiKeys.RevokeAPIKey(ctx, space, userID, keyID, at)
+}
+
+func (r *postgresRepository) TouchAPIKey(ctx context.Context, keyID uuid.UUID, at time.Time) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.apiKeys.TouchAPIKey(ctx, space, keyID, at)
+}
+
+func (r *postgresRepository) CreateServiceAccount(ctx context.Context, params persistence.CreateServiceAccountParams) (persistence.ServiceAccount, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.ServiceAccount{}, err
+	}
+	return r.accounts.CreateServiceAccount(ctx, space, params)
+}
+
+func (r *postgresRepository) GetServiceAccount(ctx context.Context, id uuid.UUID) (persistence.ServiceAccount, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.ServiceAccount{}, err
+	}
+	return r.accounts.GetServiceAccount(ctx, space, id)
+}
+
+func (r *postgresRepository) ListServiceAccounts(ctx context.Context) ([]persistence.ServiceAccount, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.accounts.ListServiceAccounts(ctx, space)
+}
+
+func (r *postgresRepository) RevokeServiceAccount(ctx context.Context, id uuid.UUID, at time.Time) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.accounts.RevokeServiceAccount(ctx, space, id, at)
+}
+
+func (r *postgresRepository) TouchServiceAccount(ctx context.Context, id uuid.UUID, at time.Time) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.accounts.TouchServiceAccount(ctx, space, id, at)
+}
+
+func (r *postgresRepository) GetAttributesSchema(ctx context.Context) (string, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return "", err
+	}
+	return r.store.GetAttributesSchema(ctx, space)
+}
+
+func (r *postgresRepository) SetAttributesSchema(ctx context.Context, slug string) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.store.SetAttributesSchema(ctx, space, slug)
+}
+
+// ResolveAttributesSchema returns the active published version of the schema with the slug.
+func (r *postgresRepository) ResolveAttributesSchema(ctx context.Context, slug string) (persistence.SchemaRecord, error) {
+	latest, err := r.schemas.Store.GetLatestSchemaBySlug(ctx, r.schemas.DB, slug)
+	if err != nil {
+		return persistence.SchemaRecord{}, err
+	}
+	return r.schemas.Store.GetActiveSchema(ctx, r.schemas.DB, latest.SchemaID)
+}
+
+func (r *postgresRepository) ValidateAttributes(ctx context.Context, schema persistence.SchemaRecord, attributes json.RawMessage) error {
+	return r.schemas.Validator.Validate(ctx, schema, attributes)
+}
+
 func requireTenantSpace(ctx context.Context) (tenant.Space, error) {
 	space, ok := tenant.FromContext(ctx)
 	if !ok {
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// RecordActivity notes a request by the caller in the tenant in ctx. The token's auth_time identifies the sign-in
+// session (iat when auth_time is missing), so a session newer than the stored one counts as a login. Callers
+// without a users row in the tenant, such as impersonating admins, and tokens without either claim are ignored.
+func (s *service) RecordActivity(ctx context.Context, caller *platformauth.UserCredentials) error {
+	if caller == nil || caller.ServiceAccountID != "" {
+		return nil
+	}
+	id, err := uuid.Parse(caller.Id)
+	if err != nil {
+		return nil
+	}
+	sessionStart := caller.AuthTime
+	if sessionStart.IsZero() {
+		sessionStart = caller.IssuedAt
+	}
+	if sessionStart.IsZero() {
+		return nil
+	}
+
+	if _, err := s.repo.RecordActivity(ctx, id, sessionStart, s.now()); err != nil {
+		if errors.Is(err, persistence.ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("record activity: %w", err)
+	}
+	return nil
+}
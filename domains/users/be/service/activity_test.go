@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+func TestServiceRecordActivity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	authTime := now.Add(-2 * time.Hour)
+
+	var gotSession, gotActive time.Time
+	repository := &mockRepository{}
+	repository.activityFn = func(ctx context.Context, id uuid.UUID, sessionStart, activeAt time.Time) (persistence.User, error) {
+		if id != userID {
+			return persistence.User{}, persistence.ErrUserNotFound
+		}
+		gotSession, gotActive = sessionStart, activeAt
+		return persistence.User{UserID: id}, nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{}).(*service)
+	svc.now = func() time.Time { return now }
+
+	require.NoError(t, svc.RecordActivity(context.Background(), &platformauth.UserCredentials{Id: userID.String(), AuthTime: authTime, IssuedAt: now.Add(-time.Minute)}))
+	require.Equal(t, authTime, gotSession)
+	require.Equal(t, now, gotActive)
+
+	// Without auth_time the token itself marks the session.
+	require.NoError(t, svc.RecordActivity(context.Background(), &platformauth.UserCredentials{Id: userID.String(), IssuedAt: now.Add(-time.Minute)}))
+	require.Equal(t, now.Add(-time.Minute), gotSession)
+
+	// Callers without a users row, or a user id, are skipped.
+	require.NoError(t, svc.RecordActivity(context.Background(), &platformauth.UserCredentials{Id: uuid.NewString(), IssuedAt: now}))
+	require.NoError(t, svc.RecordActivity(context.Background(), &platformauth.UserCredentials{Id: "firebase-uid", IssuedAt: now}))
+}
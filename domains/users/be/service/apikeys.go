@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/repo"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// API key sentinel errors.
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrAPIKeyNotAllowed is returned when API keys are managed with an API key, or by a caller without a users row.
+	ErrAPIKeyNotAllowed = errors.New("api keys can only be managed by a signed-in user")
+)
+
+// APIKey is the domain view of a user's API key. The key itself is only known when it is created.
+type APIKey struct {
+	ID         uuid.UUID
+	Name       string
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+// CreatedAPIKey is a new API key together with the key to hand to its owner.
+type CreatedAPIKey struct {
+	APIKey
+	Key string
+}
+
+// CreateAPIKeyInput represents the payload required to mint an API key.
+type CreateAPIKeyInput struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// CreateAPIKey mints a key for the caller in the tenant in ctx. Each scope must be a permission the caller holds.
+func (s *service) CreateAPIKey(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, input CreateAPIKeyInput) (CreatedAPIKey, error) { //nolint:revive
+	userID, err := apiKeyOwner(caller)
+	if err != nil {
+		return CreatedAPIKey{}, err
+	}
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return CreatedAPIKey{}, errors.New("tenant space missing from context")
+	}
+
+	fieldErrors := FieldErrors{}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		fieldErrors.add("name", "name is required")
+	}
+
+	scopes, err := s.grantableScopes(ctx, caller, input.Scopes, fieldErrors)
+	if err != nil {
+		return CreatedAPIKey{}, err
+	}
+
+	now := s.now().UTC()
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(now) {
+		fieldErrors.add("expiresAt", "expiresAt must be in the future")
+	}
+
+	if len(fieldErrors) > 0 {
+		return CreatedAPIKey{}, &ValidationError{Fields: fieldErrors}
+	}
+
+	keyID := uuid.New()
+	key, secretHash, err := platformauth.NewAPIKey(space.TenantID, keyID)
+	if err != nil {
+		return CreatedAPIKey{}, fmt.Errorf("generate api key: %w", err)
+	}
+
+	record, err := s.repo.CreateAPIKey(ctx, persistence.CreateAPIKeyParams{
+		KeyID:      keyID,
+		UserID:     userID,
+		Name:       name,
+		Scopes:     scopes,
+		SecretHash: secretHash,
+		ExpiresAt:  input.ExpiresAt,
+	})
+	if errors.Is(err, persistence.ErrUserNotFound) {
+		return CreatedAPIKey{}, ErrAPIKeyNotAllowed
+	}
+	if err != nil {
+		return CreatedAPIKey{}, err
+	}
+	return CreatedAPIKey{APIKey: mapAPIKey(record), Key: key}, nil
+}
+
+// ListAPIKeys returns the caller's keys that are not revoked.
+func (s *service) ListAPIKeys(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials) ([]APIKey, error) { //nolint:revive
+	userID, err := apiKeyOwner(caller)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.repo.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]APIKey, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, mapAPIKey(record))
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes one of the caller's keys; it stops authenticating immediately.
+func (s *service) RevokeAPIKey(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, keyID uuid.UUID) error { //nolint:revive
+	userID, err := apiKeyOwner(caller)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RevokeAPIKey(ctx, userID, keyID, s.now())
+	if errors.Is(err, persistence.ErrAPIKeyNotFound) {
+		return ErrAPIKeyNotFound
+	}
+	return err
+}
+
+// grantableScopes validates scopes as permissions caller holds, recording problems in fieldErrors, and returns
+// the trimmed scopes.
+func (s *service) grantableScopes(ctx context.Context, caller *platformauth.UserCredentials, requested []string, fieldErrors FieldErrors) ([]string, error) {
+	granted, err := s.ResolvePermissions(ctx, caller)
+	if err != nil {
+		return nil, fmt.Errorf("resolve permissions: %w", err)
+	}
+	scopes := make([]string, 0, len(requested))
+	for _, raw := range requested {
+		scope := strings.TrimSpace(raw)
+		if scope != platformauth.PermissionAll && !permissionPattern.MatchString(scope) {
+			fieldErrors.add("scopes", fmt.Sprintf("invalid scope %q (use <domain>:<action> or %q)", scope, platformauth.PermissionAll))
+			continue
+		}
+		if platformauth.IsPlatformScope(scope) {
+			fieldErrors.add("scopes", fmt.Sprintf("scope %q is reserved for platform admins", scope))
+			continue
+		}
+		if !platformauth.HasPermission(granted, scope) {
+			fieldErrors.add("scopes", fmt.Sprintf("scope %q is not a permission you hold", scope))
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+	if len(requested) == 0 {
+		fieldErrors.add("scopes", "at least one scope is required")
+	}
+	return scopes, nil
+}
+
+// apiKeyOwner returns the users row of caller. Keys cannot mint or manage keys, so a leaked key cannot outlive
+// its revocation.
+func apiKeyOwner(caller *platformauth.UserCredentials) (uuid.UUID, error) {
+	if caller == nil || caller.APIKeyID != "" || caller.ServiceAccountID != "" {
+		return uuid.Nil, ErrAPIKeyNotAllowed
+	}
+	id, err := uuid.Parse(caller.Id)
+	if err != nil {
+		return uuid.Nil, ErrAPIKeyNotAllowed
+	}
+	return id, nil
+}
+
+func mapAPIKey(record persistence.APIKey) APIKey {
+	return APIKey{
+		ID:         record.KeyID,
+		Name:       record.Name,
+		Scopes:     record.Scopes,
+		CreatedAt:  record.CreatedAt,
+		ExpiresAt:  record.ExpiresAt,
+		LastUsedAt: record.LastUsedAt,
+	}
+}
+
+// TenantSpaceResolver maps a tenant id to its space; the tenants service implements it.
+type TenantSpaceResolver interface {
+	ResolveTenantSpace(ctx context.Context, id uuid.UUID) (tenant.Space, error)
+}
+
+// APIKeyResolver implements platformauth.APIKeyResolver. The tenant named by the key locates the schema holding
+// it; the credentials returned act as the key's owner in that tenant.
+type APIKeyResolver struct {
+	repo   repo.Repository
+	spaces TenantSpaceResolver
+	now    func() time.Time
+}
+
+// NewAPIKeyResolver constructs an APIKeyResolver.
+func NewAPIKeyResolver(r repo.Repository, spaces TenantSpaceResolver) *APIKeyResolver {
+	if r == nil {
+		panic("users repository is required")
+	}
+	if spaces == nil {
+		panic("tenant space resolver is required")
+	}
+	return &APIKeyResolver{repo: r, spaces: spaces, now: time.Now}
+}
+
+// ResolveAPIKey returns the owner's credentials. Unknown tenants, unknown, revoked or expired keys and wrong
+// secrets all return platformauth.ErrInvalidAPIKey.
+func (a *APIKeyResolver) ResolveAPIKey(ctx context.Context, key string) (*platformauth.UserCredentials, error) {
+	tenantID, keyID, secret, err := platformauth.ParseAPIKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	space, err := a.spaces.ResolveTenantSpace(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", platformauth.ErrInvalidAPIKey, err)
+	}
+	ctx = tenant.WithSpace(ctx, space)
+
+	record, err := a.repo.GetAPIKey(ctx, keyID)
+	if errors.Is(err, persistence.ErrAPIKeyNotFound) {
+		return nil, platformauth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get api key: %w", err)
+	}
+
+	now := a.now()
+	if subtle.ConstantTimeCompare([]byte(record.SecretHash), []byte(platformauth.HashAPIKeySecret(secret))) != 1 ||
+		record.RevokedAt != nil ||
+		(record.ExpiresAt != nil && !record.ExpiresAt.After(now)) {
+		return nil, platformauth.ErrInvalidAPIKey
+	}
+
+	user, err := a.repo.Get(ctx, record.UserID)
+	if errors.Is(err, persistence.ErrUserNotFound) {
+		return nil, platformauth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get api key owner: %w", err)
+	}
+
+	// Usage tracking is best effort; it never fails an authenticated request.
+	_ = a.repo.TouchAPIKey(ctx, keyID, now)
+
+	tenantValue := space.TenantID.String()
+	return &platformauth.UserCredentials{
+		Id:       user.UserID.String(),
+		Email:    user.Email,
+		TenantID: &tenantValue,
+		APIKeyID: keyID.String(),
+		Scopes:   append([]string(nil), record.Scopes...),
+	}, nil
+}
+
+var _ platformauth.APIKeyResolver = (*APIKeyResolver)(nil)
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type stubSpaces map[uuid.UUID]tenant.Space
+
+func (s stubSpaces) ResolveTenantSpace(_ context.Context, id uuid.UUID) (tenant.Space, error) {
+	space, ok := s[id]
+	if !ok {
+		return tenant.Space{}, errors.New("tenant not found")
+	}
+	return space, nil
+}
+
+func TestServiceCreateAPIKeyLimitsScopes(t *testing.T) {
+	t.Parallel()
+
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme"}
+	ctx := tenant.WithSpace(context.Background(), space)
+	userID := uuid.New()
+	caller := &platformauth.UserCredentials{Id: userID.String()}
+	audit := requesttrace.Anonymous("test")
+
+	var stored persistence.CreateAPIKeyParams
+	repository := &mockRepository{}
+	repository.rolePermissionsFn = func(ctx context.Context, id *uuid.UUID, roles []string) ([]string, error) {
+		return []string{"users:read"}, nil
+	}
+	repository.createAPIKeyFn = func(ctx context.Context, params persistence.CreateAPIKeyParams) (persistence.APIKey, error) {
+		stored = params
+		return persistence.APIKey{KeyID: params.KeyID, UserID: params.UserID, Name: params.Name, Scopes: params.Scopes}, nil
+	}
+	svc := New(repository, nil, InvitationDeps{})
+
+	_, err := svc.CreateAPIKey(ctx, audit, caller, CreateAPIKeyInput{Name: "ci", Scopes: []string{"users:write"}})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "scopes")
+
+	created, err := svc.CreateAPIKey(ctx, audit, caller, CreateAPIKeyInput{Name: " ci ", Scopes: []string{"users:read"}})
+	require.NoError(t, err)
+	require.Equal(t, "ci", created.Name)
+	require.Equal(t, userID, stored.UserID)
+
+	tenantID, keyID, secret, err := platformauth.ParseAPIKey(created.Key)
+	require.NoError(t, err)
+	require.Equal(t, space.TenantID, tenantID)
+	require.Equal(t, stored.KeyID, keyID)
+	require.Equal(t, stored.SecretHash, platformauth.HashAPIKeySecret(secret))
+
+	// Keys cannot mint keys.
+	_, err = svc.CreateAPIKey(ctx, audit, &platformauth.UserCredentials{Id: userID.String(), APIKeyID: keyID.String()}, CreateAPIKeyInput{Name: "ci", Scopes: []string{"users:read"}})
+	require.ErrorIs(t, err, ErrAPIKeyNotAllowed)
+}
+
+func TestAPIKeyResolver(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme"}
+	userID, keyID := uuid.New(), uuid.New()
+	key, secretHash, err := platformauth.NewAPIKey(space.TenantID, keyID)
+	require.NoError(t, err)
+
+	record := persistence.APIKey{KeyID: keyID, UserID: userID, Scopes: []string{"users:read"}, SecretHash: secretHash}
+	var touched bool
+	repository := &mockRepository{}
+	repository.getAPIKeyFn = func(ctx context.Context, id uuid.UUID) (persistence.APIKey, error) {
+		got, ok := tenant.FromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, space.TenantID, got.TenantID)
+		if id != keyID {
+			return persistence.APIKey{}, persistence.ErrAPIKeyNotFound
+		}
+		return record, nil
+	}
+	repository.getFn = func(ctx context.Context, id uuid.UUID) (persistence.User, error) {
+		return persistence.User{UserID: id, Email: "owner@example.com"}, nil
+	}
+	repository.touchAPIKeyFn = func(ctx context.Context, id uuid.UUID, at time.Time) error {
+		touched = true
+		return nil
+	}
+
+	resolver := NewAPIKeyResolver(repository, stubSpaces{space.TenantID: space})
+	resolver.now = func() time.Time { return now }
+
+	creds, err := resolver.ResolveAPIKey(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, userID.String(), creds.Id)
+	require.Equal(t, space.TenantID.String(), *creds.TenantID)
+	require.Equal(t, keyID.String(), creds.APIKeyID)
+	require.Equal(t, []string{"users:read"}, creds.Scopes)
+	require.True(t, touched)
+
+	wrongSecret, _, err := platformauth.NewAPIKey(space.TenantID, keyID)
+	require.NoError(t, err)
+	_, err = resolver.ResolveAPIKey(context.Background(), wrongSecret)
+	require.ErrorIs(t, err, platformauth.ErrInvalidAPIKey)
+
+	otherTenant, _, err := platformauth.NewAPIKey(uuid.New(), keyID)
+	require.NoError(t, err)
+	_, err = resolver.ResolveAPIKey(context.Background(), otherTenant)
+	require.ErrorIs(t, err, platformauth.ErrInvalidAPIKey)
+
+	expired := now.Add(-time.Minute)
+	record.ExpiresAt = &expired
+	_, err = resolver.ResolveAPIKey(context.Background(), key)
+	require.ErrorIs(t, err, platformauth.ErrInvalidAPIKey)
+
+	record.ExpiresAt = nil
+	record.RevokedAt = &now
+	_, err = resolver.ResolveAPIKey(context.Background(), key)
+	require.ErrorIs(t, err, platformauth.ErrInvalidAPIKey)
+}
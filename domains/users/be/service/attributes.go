@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// AttributesSchema names the schema-repository schema that validates user attributes in the tenant.
+type AttributesSchema struct {
+	// SchemaSlug is nil when the tenant has none; attributes cannot be written then.
+	SchemaSlug *string
+	// SchemaVersion is the active published version, nil when the schema has none.
+	SchemaVersion *string
+}
+
+func (s *service) GetAttributesSchema(ctx context.Context, audit requesttrace.AuditInfo) (AttributesSchema, error) { //nolint:revive
+	slug, err := s.repo.GetAttributesSchema(ctx)
+	if err != nil {
+		return AttributesSchema{}, err
+	}
+	if slug == "" {
+		return AttributesSchema{}, nil
+	}
+
+	out := AttributesSchema{SchemaSlug: &slug}
+	schema, err := s.repo.ResolveAttributesSchema(ctx, slug)
+	switch {
+	case errors.Is(err, persistence.ErrSchemaNotFound):
+	case err != nil:
+		return AttributesSchema{}, err
+	default:
+		version := schema.VersionString()
+		out.SchemaVersion = &version
+	}
+	return out, nil
+}
+
+// SetAttributesSchema points user attribute validation at the schema with the slug, which must have an active
+// published version. A nil or blank slug removes the setting.
+func (s *service) SetAttributesSchema(ctx context.Context, audit requesttrace.AuditInfo, slug *string) (AttributesSchema, error) {
+	value := ""
+	if slug != nil {
+		value = strings.TrimSpace(*slug)
+	}
+
+	if value != "" {
+		if _, err := s.repo.ResolveAttributesSchema(ctx, value); err != nil {
+			if errors.Is(err, persistence.ErrSchemaNotFound) {
+				return AttributesSchema{}, newValidationError(map[string]string{"schemaSlug": fmt.Sprintf("schema %q has no active published version", value)})
+			}
+			return AttributesSchema{}, err
+		}
+	}
+
+	if err := s.repo.SetAttributesSchema(ctx, value); err != nil {
+		return AttributesSchema{}, err
+	}
+	return s.GetAttributesSchema(ctx, audit)
+}
+
+// encodeAttributes validates attributes against the tenant's attributes schema and returns them encoded. Nil
+// attributes were not provided and encode to nil.
+func (s *service) encodeAttributes(ctx context.Context, attributes map[string]any) (json.RawMessage, error) {
+	if attributes == nil {
+		return nil, nil
+	}
+
+	slug, err := s.repo.GetAttributesSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if slug == "" {
+		return nil, newValidationError(map[string]string{"attributes": "the tenant has no user attributes schema"})
+	}
+
+	schema, err := s.repo.ResolveAttributesSchema(ctx, slug)
+	if errors.Is(err, persistence.ErrSchemaNotFound) {
+		return nil, newValidationError(map[string]string{"attributes": fmt.Sprintf("attributes schema %q has no active published version", slug)})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("encode attributes: %w", err)
+	}
+	if err := s.repo.ValidateAttributes(ctx, schema, encoded); err != nil {
+		var schemaErr *jsonschema.ValidationError
+		if errors.As(err, &schemaErr) {
+			return nil, &ValidationError{Fields: attributeFieldErrors(schemaErr)}
+		}
+		return nil, err
+	}
+	return encoded, nil
+}
+
+// attributeFieldErrors flattens a JSON Schema validation error into its leaf causes, keyed as "attributes.<path>".
+func attributeFieldErrors(err *jsonschema.ValidationError) FieldErrors {
+	fields := FieldErrors{}
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			fields.add(attributeField(e.InstanceLocation), e.Message)
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(err)
+	return fields
+}
+
+func attributeField(pointer string) string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return "attributes"
+	}
+
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	segments := strings.Split(pointer, "/")
+	for i, segment := range segments {
+		segments[i] = unescape.Replace(segment)
+	}
+	return "attributes." + strings.Join(segments, ".")
+}
+
+func decodeAttributes(raw json.RawMessage) map[string]any {
+	attributes := map[string]any{}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &attributes)
+	}
+	return attributes
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+func attributesRepository(t *testing.T) *mockRepository {
+	t.Helper()
+
+	schema := persistence.SchemaRecord{
+		SchemaID:      uuid.New(),
+		SchemaVersion: persistence.SemanticVersion{Major: 1},
+		Slug:          "user-profile",
+		Hash:          "hash-1",
+		SchemaDefinition: json.RawMessage(`{
+			"type": "object",
+			"properties": {"department": {"type": "string"}, "level": {"type": "integer", "minimum": 1}},
+			"additionalProperties": false
+		}`),
+	}
+	validator := persistence.NewSchemaValidator()
+
+	repository := &mockRepository{attributesSchema: "user-profile"}
+	repository.resolveAttributesFn = func(ctx context.Context, slug string) (persistence.SchemaRecord, error) {
+		if slug != schema.Slug {
+			return persistence.SchemaRecord{}, persistence.ErrSchemaNotFound
+		}
+		return schema, nil
+	}
+	repository.validateAttributesFn = func(ctx context.Context, record persistence.SchemaRecord, attributes json.RawMessage) error {
+		return validator.Validate(ctx, record, attributes)
+	}
+	return repository
+}
+
+func TestServiceUpdateValidatesAttributes(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	repository := attributesRepository(t)
+	repository.updateFn = func(ctx context.Context, id uuid.UUID, params persistence.UpdateUserParams) (persistence.User, error) {
+		require.Nil(t, params.FullName)
+		return persistence.User{UserID: id, Attributes: params.Attributes}, nil
+	}
+	svc := New(repository, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Update(context.Background(), audit, userID, UpdateInput{Attributes: map[string]any{"level": 0, "badge": "x"}})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "attributes.level")
+
+	updated, err := svc.Update(context.Background(), audit, userID, UpdateInput{Attributes: map[string]any{"department": "sales", "level": 2}})
+	require.NoError(t, err)
+	require.Equal(t, "sales", updated.Attributes["department"])
+
+	// Users edit their own attributes through the same validation.
+	me, err := svc.UpdateSelf(context.Background(), audit, userID, UpdateSelfInput{Attributes: map[string]any{"department": "ops"}})
+	require.NoError(t, err)
+	require.Equal(t, "ops", me.Attributes["department"])
+
+	repository.attributesSchema = ""
+	_, err = svc.Update(context.Background(), audit, userID, UpdateInput{Attributes: map[string]any{"department": "sales"}})
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "attributes")
+}
+
+func TestServiceSetAttributesSchema(t *testing.T) {
+	t.Parallel()
+
+	repository := attributesRepository(t)
+	repository.attributesSchema = ""
+	repository.setAttributesFn = func(ctx context.Context, slug string) error {
+		repository.attributesSchema = slug
+		return nil
+	}
+	svc := New(repository, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	missing := "unknown"
+	_, err := svc.SetAttributesSchema(context.Background(), audit, &missing)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "schemaSlug")
+
+	slug := " user-profile "
+	schema, err := svc.SetAttributesSchema(context.Background(), audit, &slug)
+	require.NoError(t, err)
+	require.Equal(t, "user-profile", *schema.SchemaSlug)
+	require.Equal(t, "1.0.0", *schema.SchemaVersion)
+
+	schema, err = svc.SetAttributesSchema(context.Background(), audit, nil)
+	require.NoError(t, err)
+	require.Nil(t, schema.SchemaSlug)
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// DefaultInvitationTTL is how long an invitation link stays valid when InvitationDeps.TTL is unset.
+const DefaultInvitationTTL = 7 * 24 * time.Hour
+
+// Invitation sentinel errors.
+var (
+	ErrInvitationsUnavailable = errors.New("invitations are not configured")
+	ErrInvitationNotFound     = errors.New("invitation not found")
+	ErrInvitationAccepted     = errors.New("invitation already accepted")
+	ErrInvitationExpired      = errors.New("invitation expired")
+	ErrInvitationMismatch     = errors.New("invitation belongs to another user")
+	ErrInvitationNotSent      = errors.New("invitation could not be delivered")
+)
+
+// IdentityProvisioner creates the sign-in identity of an invited user in the auth provider tenant of the tenant
+// in ctx, using the user id as the identity uid so tokens map back to the users row.
+type IdentityProvisioner interface {
+	CreateIdentity(ctx context.Context, user User) error
+	DeleteIdentity(ctx context.Context, userID uuid.UUID) error
+}
+
+// InvitationDeps wires the invitation flow and the auth provider sync. Identity and Directory may be nil when the
+// auth provider has no user management (dev tokens); Mailer is required to invite.
+type InvitationDeps struct {
+	Identity IdentityProvisioner
+	// Directory lists the auth provider identities for SyncUsers; nil disables the sync.
+	Directory IdentityDirectory
+	Mailer    mailer.Mailer
+	// AcceptURL is the page that accepts invitations; the token is appended as the token query parameter.
+	AcceptURL string
+	TTL       time.Duration
+}
+
+// InvitationStatus is the lifecycle state of an invitation.
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationExpired  InvitationStatus = "expired"
+)
+
+// Invitation is the domain view of a user invitation.
+type Invitation struct {
+	UserID     uuid.UUID
+	Email      string
+	FullName   string
+	Status     InvitationStatus
+	InvitedBy  *string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	SentAt     *time.Time
+	SendCount  int
+	AcceptedAt *time.Time
+}
+
+func (s *service) Invite(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (Invitation, error) {
+	if s.invitations.Mailer == nil {
+		return Invitation{}, ErrInvitationsUnavailable
+	}
+
+	params, err := s.newUserParams(ctx, input)
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	token, tokenHash, err := newInvitationToken()
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	record, err := s.repo.CreateInvitation(ctx, persistence.CreateInvitationParams{
+		User:      params,
+		TokenHash: tokenHash,
+		InvitedBy: audit.UserID,
+		ExpiresAt: s.now().Add(s.invitations.TTL),
+	})
+	if err != nil {
+		return Invitation{}, mapInvitationError(err)
+	}
+
+	if s.invitations.Identity != nil {
+		user := User{ID: record.UserID, Email: record.Email, FullName: record.FullName}
+		if err := s.invitations.Identity.CreateIdentity(ctx, user); err != nil {
+			// Without an identity the invitee could never sign in, so the pending user goes too.
+			if deleteErr := s.repo.Delete(ctx, record.UserID); deleteErr != nil {
+				return Invitation{}, errors.Join(fmt.Errorf("create identity: %w", err), fmt.Errorf("remove invited user: %w", deleteErr))
+			}
+			return Invitation{}, fmt.Errorf("create identity: %w", err)
+		}
+	}
+
+	return s.sendInvitation(ctx, record, token)
+}
+
+func (s *service) GetInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Invitation, error) { //nolint:revive
+	if id == uuid.Nil {
+		return Invitation{}, ErrInvitationNotFound
+	}
+
+	record, err := s.repo.GetInvitation(ctx, id)
+	if err != nil {
+		return Invitation{}, mapInvitationError(err)
+	}
+	return s.mapInvitation(record), nil
+}
+
+func (s *service) ResendInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Invitation, error) { //nolint:revive
+	if s.invitations.Mailer == nil {
+		return Invitation{}, ErrInvitationsUnavailable
+	}
+	if id == uuid.Nil {
+		return Invitation{}, ErrInvitationNotFound
+	}
+
+	token, tokenHash, err := newInvitationToken()
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	record, err := s.repo.RenewInvitation(ctx, id, tokenHash, s.now().Add(s.invitations.TTL))
+	if err != nil {
+		return Invitation{}, mapInvitationError(err)
+	}
+	return s.sendInvitation(ctx, record, token)
+}
+
+func (s *service) ExpireInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Invitation, error) { //nolint:revive
+	if id == uuid.Nil {
+		return Invitation{}, ErrInvitationNotFound
+	}
+
+	record, err := s.repo.ExpireInvitation(ctx, id, s.now())
+	if err != nil {
+		return Invitation{}, mapInvitationError(err)
+	}
+	return s.mapInvitation(record), nil
+}
+
+func (s *service) AcceptInvitation(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, token string) (User, error) { //nolint:revive
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return User{}, newValidationError(map[string]string{"token": "token is required"})
+	}
+	if caller == nil {
+		return User{}, ErrInvitationMismatch
+	}
+
+	record, err := s.repo.FindInvitationByToken(ctx, hashInvitationToken(token))
+	if err != nil {
+		return User{}, mapInvitationError(err)
+	}
+	// The identity is created with the user id as uid; the email fallback covers providers that assign their own.
+	if caller.Id != record.UserID.String() && !strings.EqualFold(caller.Email, record.Email) {
+		return User{}, ErrInvitationMismatch
+	}
+	if record.AcceptedAt != nil {
+		return User{}, ErrInvitationAccepted
+	}
+	now := s.now()
+	if !now.Before(record.ExpiresAt) {
+		return User{}, ErrInvitationExpired
+	}
+
+	// The membership lets identities of other tenants with the invited email switch into this tenant. It is
+	// recorded first so a failure leaves the invitation pending and the accept can be retried.
+	roles, err := s.repo.ListUserRoles(ctx, record.UserID)
+	if err != nil {
+		return User{}, mapRoleError(err)
+	}
+	if err := s.repo.UpsertMembership(ctx, record.Email, record.UserID, roles); err != nil {
+		return User{}, fmt.Errorf("record membership: %w", err)
+	}
+
+	if _, err := s.repo.AcceptInvitation(ctx, record.UserID, now); err != nil {
+		return User{}, mapInvitationError(err)
+	}
+
+	user, err := s.repo.Get(ctx, record.UserID)
+	if err != nil {
+		return User{}, mapPersistenceError(err)
+	}
+	return mapUser(user), nil
+}
+
+// sendInvitation emails the invitation link and records the delivery. A failed delivery leaves the invitation
+// pending so it can be resent.
+func (s *service) sendInvitation(ctx context.Context, record persistence.Invitation, token string) (Invitation, error) {
+	workspace := "Palmyra"
+	var sender mailer.Sender
+	if space, ok := tenant.FromContext(ctx); ok && space.Slug != "" {
+		workspace = space.Slug
+		sender = mailer.TenantSender(space.Email)
+	}
+
+	msg, err := mailer.DefaultTemplates().Render(mailer.TemplateInvitation, mailer.InvitationData{
+		Workspace: workspace,
+		FullName:  record.FullName,
+		Link:      invitationLink(s.invitations.AcceptURL, token),
+		ExpiresAt: record.ExpiresAt,
+	})
+	if err != nil {
+		return Invitation{}, fmt.Errorf("%w: %w", ErrInvitationNotSent, err)
+	}
+	msg.To, msg.Sender = record.Email, sender
+	if err := s.invitations.Mailer.Send(ctx, msg); err != nil {
+		return Invitation{}, fmt.Errorf("%w: %w", ErrInvitationNotSent, err)
+	}
+
+	sent, err := s.repo.MarkInvitationSent(ctx, record.UserID, s.now())
+	if err != nil {
+		return Invitation{}, mapInvitationError(err)
+	}
+	return s.mapInvitation(sent), nil
+}
+
+// invitationLink appends token to acceptURL; without an accept URL the bare token is sent.
+func invitationLink(acceptURL, token string) string {
+	u, err := url.Parse(acceptURL)
+	if acceptURL == "" || err != nil {
+		return token
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// newInvitationToken returns a random URL-safe token and the hash stored in its place.
+func newInvitationToken() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("generate invitation token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashInvitationToken(token), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *service) mapInvitation(record persistence.Invitation) Invitation {
+	status := InvitationPending
+	switch {
+	case record.AcceptedAt != nil:
+		status = InvitationAccepted
+	case !s.now().Before(record.ExpiresAt):
+		status = InvitationExpired
+	}
+
+	return Invitation{
+		UserID:     record.UserID,
+		Email:      record.Email,
+		FullName:   record.FullName,
+		Status:     status,
+		InvitedBy:  record.InvitedBy,
+		CreatedAt:  record.CreatedAt,
+		ExpiresAt:  record.ExpiresAt,
+		SentAt:     record.SentAt,
+		SendCount:  record.SendCount,
+		AcceptedAt: record.AcceptedAt,
+	}
+}
+
+func mapInvitationError(err error) error {
+	switch {
+	case errors.Is(err, persistence.ErrInvitationNotFound):
+		return ErrInvitationNotFound
+	case errors.Is(err, persistence.ErrInvitationAccepted):
+		return ErrInvitationAccepted
+	case errors.Is(err, persistence.ErrInvitationExpired):
+		return ErrInvitationExpired
+	default:
+		return mapPersistenceError(err)
+	}
+}
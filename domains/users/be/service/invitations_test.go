@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/mailer"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type recordingMailer struct {
+	sent []mailer.Message
+	err  error
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg mailer.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+type stubIdentity struct {
+	created []User
+	err     error
+}
+
+func (s *stubIdentity) CreateIdentity(_ context.Context, user User) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.created = append(s.created, user)
+	return nil
+}
+
+func (s *stubIdentity) DeleteIdentity(context.Context, uuid.UUID) error { return nil }
+
+func TestServiceInviteSendsTokenLink(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	var stored persistence.Invitation
+	var storedHash string
+
+	repository := &mockRepository{}
+	repository.createInvitationFn = func(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error) {
+		require.Equal(t, "new@example.com", params.User.Email)
+		require.Equal(t, now.Add(48*time.Hour), params.ExpiresAt)
+		require.Equal(t, "admin-1", *params.InvitedBy)
+		storedHash = params.TokenHash
+		stored = persistence.Invitation{UserID: params.User.UserID, Email: params.User.Email, FullName: params.User.FullName, ExpiresAt: params.ExpiresAt}
+		return stored, nil
+	}
+	repository.markSentFn = func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+		require.Equal(t, stored.UserID, id)
+		stored.SentAt = &at
+		stored.SendCount++
+		return stored, nil
+	}
+
+	mail := &recordingMailer{}
+	identity := &stubIdentity{}
+	svc := New(repository, nil, InvitationDeps{Identity: identity, Mailer: mail, AcceptURL: "https://app.example.com/invitations/accept", TTL: 48 * time.Hour}).(*service)
+	svc.now = func() time.Time { return now }
+
+	replyTo := "help@acme.example.com"
+	ctx := tenant.WithSpace(context.Background(), tenant.Space{Slug: "acme", Email: tenant.EmailSettings{ReplyTo: &replyTo}})
+	userID := "admin-1"
+	invitation, err := svc.Invite(ctx, requesttrace.AuditInfo{UserID: &userID}, CreateInput{Email: " New@example.com ", FullName: "New User"})
+	require.NoError(t, err)
+	require.Equal(t, InvitationPending, invitation.Status)
+	require.Equal(t, 1, invitation.SendCount)
+	require.Len(t, identity.created, 1)
+	require.Equal(t, stored.UserID, identity.created[0].ID)
+
+	// The emailed link carries the token whose hash was stored.
+	require.Len(t, mail.sent, 1)
+	require.Equal(t, "new@example.com", mail.sent[0].To)
+	require.Equal(t, "You have been invited to acme", mail.sent[0].Subject)
+	require.Equal(t, mailer.Sender{ReplyTo: replyTo}, mail.sent[0].Sender)
+	require.Contains(t, mail.sent[0].HTML, "Accept the invitation")
+	start := strings.Index(mail.sent[0].Text, "https://")
+	require.GreaterOrEqual(t, start, 0)
+	link, err := url.Parse(strings.Fields(mail.sent[0].Text[start:])[0])
+	require.NoError(t, err)
+	require.Equal(t, storedHash, hashInvitationToken(link.Query().Get("token")))
+}
+
+func TestServiceInviteRemovesUserWhenIdentityFails(t *testing.T) {
+	t.Parallel()
+
+	var deleted uuid.UUID
+	repository := &mockRepository{}
+	repository.createInvitationFn = func(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error) {
+		return persistence.Invitation{UserID: params.User.UserID, Email: params.User.Email}, nil
+	}
+	repository.deleteFn = func(ctx context.Context, id uuid.UUID) error {
+		deleted = id
+		return nil
+	}
+
+	identityErr := errors.New("auth provider down")
+	mail := &recordingMailer{}
+	svc := New(repository, nil, InvitationDeps{Identity: &stubIdentity{err: identityErr}, Mailer: mail})
+
+	_, err := svc.Invite(context.Background(), requesttrace.Anonymous("test"), CreateInput{Email: "new@example.com", FullName: "New User"})
+	require.ErrorIs(t, err, identityErr)
+	require.NotEqual(t, uuid.Nil, deleted)
+	require.Empty(t, mail.sent)
+}
+
+func TestServiceInviteRequiresMailer(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
+
+	_, err := svc.Invite(context.Background(), requesttrace.Anonymous("test"), CreateInput{Email: "new@example.com", FullName: "New User"})
+	require.ErrorIs(t, err, ErrInvitationsUnavailable)
+}
+
+func TestServiceInviteDeliveryFailure(t *testing.T) {
+	t.Parallel()
+
+	repository := &mockRepository{}
+	repository.createInvitationFn = func(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error) {
+		return persistence.Invitation{UserID: params.User.UserID, Email: params.User.Email}, nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{Mailer: &recordingMailer{err: errors.New("smtp refused")}})
+
+	_, err := svc.Invite(context.Background(), requesttrace.Anonymous("test"), CreateInput{Email: "new@example.com", FullName: "New User"})
+	require.ErrorIs(t, err, ErrInvitationNotSent)
+}
+
+func TestServiceAcceptInvitation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	userID := uuid.New()
+	token := "token-value"
+
+	repository := &mockRepository{}
+	repository.findInvitationFn = func(ctx context.Context, tokenHash string) (persistence.Invitation, error) {
+		if tokenHash != hashInvitationToken(token) {
+			return persistence.Invitation{}, persistence.ErrInvitationNotFound
+		}
+		return persistence.Invitation{UserID: userID, Email: "new@example.com", ExpiresAt: now.Add(time.Hour)}, nil
+	}
+	repository.acceptInvitationFn = func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+		require.Equal(t, userID, id)
+		return persistence.Invitation{UserID: id, AcceptedAt: &at}, nil
+	}
+	repository.getFn = func(ctx context.Context, id uuid.UUID) (persistence.User, error) {
+		return persistence.User{UserID: id, Email: "new@example.com"}, nil
+	}
+	repository.listUserRolesFn = func(ctx context.Context, id uuid.UUID) ([]string, error) {
+		return []string{"editor"}, nil
+	}
+	var membership []string
+	repository.upsertMembershipFn = func(ctx context.Context, email string, id uuid.UUID, roles []string) error {
+		require.Equal(t, userID, id)
+		membership = append([]string{email}, roles...)
+		return nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.AcceptInvitation(context.Background(), audit, &platformauth.UserCredentials{Id: userID.String()}, "other")
+	require.ErrorIs(t, err, ErrInvitationNotFound)
+
+	_, err = svc.AcceptInvitation(context.Background(), audit, &platformauth.UserCredentials{Id: "someone", Email: "else@example.com"}, token)
+	require.ErrorIs(t, err, ErrInvitationMismatch)
+
+	user, err := svc.AcceptInvitation(context.Background(), audit, &platformauth.UserCredentials{Id: "provider-uid", Email: "New@example.com"}, token)
+	require.NoError(t, err)
+	require.Equal(t, userID, user.ID)
+	require.Equal(t, []string{"new@example.com", "editor"}, membership)
+
+	repository.acceptInvitationFn = func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+		return persistence.Invitation{}, persistence.ErrInvitationExpired
+	}
+	_, err = svc.AcceptInvitation(context.Background(), audit, &platformauth.UserCredentials{Id: userID.String()}, token)
+	require.ErrorIs(t, err, ErrInvitationExpired)
+}
+
+func TestServiceExpireInvitationReportsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	repository := &mockRepository{}
+	repository.expireInvitationFn = func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+		return persistence.Invitation{UserID: id, ExpiresAt: at}, nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{}).(*service)
+	svc.now = func() time.Time { return now }
+
+	invitation, err := svc.ExpireInvitation(context.Background(), requesttrace.Anonymous("test"), uuid.New())
+	require.NoError(t, err)
+	require.Equal(t, InvitationExpired, invitation.Status)
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// Permissions checked by the users domain routes.
+const (
+	PermissionUsersRead             = "users:read"
+	PermissionUsersWrite            = "users:write"
+	PermissionRolesManage           = "roles:manage"
+	PermissionServiceAccountsManage = "service-accounts:manage"
+)
+
+// Role sentinel errors.
+var (
+	ErrRoleNotFound  = errors.New("role not found")
+	ErrRoleConflict  = errors.New("role conflict")
+	ErrRoleProtected = errors.New("built-in role cannot be deleted")
+)
+
+var (
+	roleNamePattern   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	permissionPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*:[a-z][a-z0-9-]*$`)
+)
+
+// Role is the domain view of a tenant role.
+type Role struct {
+	Name        string
+	Description *string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CreateRoleInput represents the payload required to create a role.
+type CreateRoleInput struct {
+	Name        string
+	Description *string
+	Permissions []string
+}
+
+func (s *service) ListRoles(ctx context.Context, audit requesttrace.AuditInfo) ([]Role, error) { //nolint:revive
+	records, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]Role, 0, len(records))
+	for _, record := range records {
+		roles = append(roles, mapRole(record))
+	}
+	return roles, nil
+}
+
+func (s *service) CreateRole(ctx context.Context, audit requesttrace.AuditInfo, input CreateRoleInput) (Role, error) { //nolint:revive
+	fieldErrors := FieldErrors{}
+
+	name := strings.ToLower(strings.TrimSpace(input.Name))
+	if !roleNamePattern.MatchString(name) {
+		fieldErrors.add("name", "name must start with a letter and contain only lowercase letters, digits, '_' or '-'")
+	}
+
+	permissions := make([]string, 0, len(input.Permissions))
+	for _, raw := range input.Permissions {
+		permission := strings.TrimSpace(raw)
+		if permission != platformauth.PermissionAll && !permissionPattern.MatchString(permission) {
+			fieldErrors.add("permissions", fmt.Sprintf("invalid permission %q (use <domain>:<action> or %q)", permission, platformauth.PermissionAll))
+			continue
+		}
+		if platformauth.IsPlatformScope(permission) {
+			fieldErrors.add("permissions", fmt.Sprintf("permission %q is reserved for platform admins", permission))
+			continue
+		}
+		permissions = append(permissions, permission)
+	}
+	if len(input.Permissions) == 0 {
+		fieldErrors.add("permissions", "at least one permission is required")
+	}
+
+	var description *string
+	if input.Description != nil {
+		if trimmed := strings.TrimSpace(*input.Description); trimmed != "" {
+			description = &trimmed
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return Role{}, &ValidationError{Fields: fieldErrors}
+	}
+
+	record, err := s.repo.CreateRole(ctx, persistence.CreateRoleParams{
+		Name:        name,
+		Description: description,
+		Permissions: permissions,
+	})
+	if err != nil {
+		return Role{}, mapRoleError(err)
+	}
+	return mapRole(record), nil
+}
+
+func (s *service) DeleteRole(ctx context.Context, audit requesttrace.AuditInfo, name string) error { //nolint:revive
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == persistence.AdminRole {
+		return ErrRoleProtected
+	}
+	if name == "" {
+		return ErrRoleNotFound
+	}
+
+	return mapRoleError(s.repo.DeleteRole(ctx, name))
+}
+
+func (s *service) GetUserRoles(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) ([]string, error) { //nolint:revive
+	if id == uuid.Nil {
+		return nil, ErrNotFound
+	}
+
+	roles, err := s.repo.ListUserRoles(ctx, id)
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+	return roles, nil
+}
+
+func (s *service) SetUserRoles(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, roles []string) ([]string, error) { //nolint:revive
+	if id == uuid.Nil {
+		return nil, ErrNotFound
+	}
+
+	assigned, err := s.repo.SetUserRoles(ctx, id, normalizeRoles(roles))
+	if errors.Is(err, persistence.ErrRoleNotFound) {
+		return nil, newValidationError(map[string]string{"roles": "one or more roles do not exist"})
+	}
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+	if err := s.repo.SetMembershipRoles(ctx, id, assigned); err != nil {
+		return nil, fmt.Errorf("update membership roles: %w", err)
+	}
+	return assigned, nil
+}
+
+// ResolvePermissions implements platformauth.PermissionResolver: the permissions of the caller are those of
+// the roles in their token plus the roles assigned to their users row.
+func (s *service) ResolvePermissions(ctx context.Context, creds *platformauth.UserCredentials) ([]string, error) {
+	if creds == nil {
+		return nil, nil
+	}
+
+	var userID *uuid.UUID
+	if id, err := uuid.Parse(creds.Id); err == nil {
+		userID = &id
+	}
+
+	return s.repo.RolePermissions(ctx, userID, normalizeRoles(creds.TenantRoles))
+}
+
+func normalizeRoles(roles []string) []string {
+	normalized := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if name := strings.ToLower(strings.TrimSpace(role)); name != "" {
+			normalized = append(normalized, name)
+		}
+	}
+	return normalized
+}
+
+func mapRole(record persistence.Role) Role {
+	return Role{
+		Name:        record.Name,
+		Description: record.Description,
+		Permissions: record.Permissions,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+	}
+}
+
+func mapRoleError(err error) error {
+	switch {
+	case errors.Is(err, persistence.ErrRoleNotFound):
+		return ErrRoleNotFound
+	case errors.Is(err, persistence.ErrRoleConflict):
+		return ErrRoleConflict
+	default:
+		return mapPersistenceError(err)
+	}
+}
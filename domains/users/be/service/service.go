@@ -10,8 +10,11 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/repo"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 // FieldErrors maps request fields to validation issues.
@@ -39,6 +42,12 @@ type User struct {
 	FullName  string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// LastLoginAt, LastActiveAt and LoginCount are maintained by RecordActivity.
+	LastLoginAt  *time.Time
+	LastActiveAt *time.Time
+	LoginCount   int
+	// Attributes holds the tenant-defined profile fields; see SetAttributesSchema.
+	Attributes map[string]any
 }
 
 // ListOptions controls filtering and pagination.
@@ -47,31 +56,36 @@ type ListOptions struct {
 	Page     int
 	PageSize int
 	Sort     *string
+	// LastActiveBefore keeps users last active before the instant, including users never active.
+	LastActiveBefore *time.Time
 }
 
 // ListResult wraps a page of users with pagination metadata.
 type ListResult struct {
-	Users      []User
-	Page       int
-	PageSize   int
-	TotalItems int
-	TotalPages int
+	Users []User
+	pagination.Envelope
 }
 
 // CreateInput represents the payload required to create a new user.
 type CreateInput struct {
 	Email    string
 	FullName string
+	// Attributes are validated against the tenant's attributes schema when set.
+	Attributes map[string]any
 }
 
 // UpdateInput encapsulates fields that can be modified by administrators.
 type UpdateInput struct {
 	FullName *string
+	// Attributes, when set, replace the stored attributes.
+	Attributes map[string]any
 }
 
 // UpdateSelfInput encapsulates fields that the authenticated user can modify.
 type UpdateSelfInput struct {
 	FullName *string
+	// Attributes, when set, replace the stored attributes.
+	Attributes map[string]any
 }
 
 // Service defines the business operations for the users domain.
@@ -82,32 +96,57 @@ type Service interface {
 	Update(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input UpdateInput) (User, error)
 	UpdateSelf(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, input UpdateSelfInput) (User, error)
 	Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+
+	ListRoles(ctx context.Context, audit requesttrace.AuditInfo) ([]Role, error)
+	CreateRole(ctx context.Context, audit requesttrace.AuditInfo, input CreateRoleInput) (Role, error)
+	DeleteRole(ctx context.Context, audit requesttrace.AuditInfo, name string) error
+	GetUserRoles(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) ([]string, error)
+	SetUserRoles(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, roles []string) ([]string, error)
+
+	Invite(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (Invitation, error)
+	GetInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Invitation, error)
+	ResendInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Invitation, error)
+	ExpireInvitation(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Invitation, error)
+	AcceptInvitation(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, token string) (User, error)
+
+	SyncUsers(ctx context.Context, audit requesttrace.AuditInfo, dryRun bool) (SyncReport, error)
+	RecordActivity(ctx context.Context, caller *platformauth.UserCredentials) error
+
+	CreateAPIKey(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, input CreateAPIKeyInput) (CreatedAPIKey, error)
+	ListAPIKeys(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials) ([]APIKey, error)
+	RevokeAPIKey(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, keyID uuid.UUID) error
+
+	CreateServiceAccount(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, input CreateServiceAccountInput) (CreatedServiceAccount, error)
+	ListServiceAccounts(ctx context.Context, audit requesttrace.AuditInfo) ([]ServiceAccount, error)
+	RevokeServiceAccount(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+
+	GetAttributesSchema(ctx context.Context, audit requesttrace.AuditInfo) (AttributesSchema, error)
+	SetAttributesSchema(ctx context.Context, audit requesttrace.AuditInfo, slug *string) (AttributesSchema, error)
+
+	platformauth.PermissionResolver
 }
 
 type service struct {
-	repo repo.Repository
+	repo        repo.Repository
+	quotas      tenant.QuotaChecker
+	invitations InvitationDeps
+	now         func() time.Time
 }
 
-// New constructs a users Service instance backed by the provided repository.
-func New(r repo.Repository) Service {
+// New constructs a users Service instance backed by the provided repository. quotas may be nil, which disables
+// the tenant user quota; invitations without a Mailer disable the invitation flow.
+func New(r repo.Repository, quotas tenant.QuotaChecker, invitations InvitationDeps) Service {
 	if r == nil {
 		panic("users repository is required")
 	}
-	return &service{repo: r}
+	if invitations.TTL <= 0 {
+		invitations.TTL = DefaultInvitationTTL
+	}
+	return &service{repo: r, quotas: quotas, invitations: invitations, now: time.Now}
 }
 
 func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, opts ListOptions) (ListResult, error) { //nolint:revive
-	page := opts.Page
-	if page < 1 {
-		page = 1
-	}
-	pageSize := opts.PageSize
-	if pageSize <= 0 {
-		pageSize = 20
-	}
-	if pageSize > 100 {
-		pageSize = 100
-	}
+	params := pagination.New(opts.Page, opts.PageSize)
 
 	sortValue, sortErr := sanitizeSort(opts.Sort)
 	if sortErr != nil {
@@ -115,8 +154,8 @@ func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, opts L
 	}
 
 	repoParams := persistence.ListUsersParams{
-		Page:     page,
-		PageSize: pageSize,
+		Page:     params.Page,
+		PageSize: params.PageSize,
 		Sort:     sortValue,
 	}
 
@@ -124,6 +163,7 @@ func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, opts L
 		email := strings.TrimSpace(*opts.Email)
 		repoParams.Email = &email
 	}
+	repoParams.LastActiveBefore = opts.LastActiveBefore
 
 	result, err := s.repo.List(ctx, repoParams)
 	if err != nil {
@@ -135,21 +175,25 @@ func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, opts L
 		users = append(users, mapUser(record))
 	}
 
-	totalPages := 0
-	if result.TotalItems > 0 {
-		totalPages = (result.TotalItems + pageSize - 1) / pageSize
+	return ListResult{Users: users, Envelope: params.Envelope(result.TotalItems)}, nil
+}
+
+func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (User, error) { //nolint:revive
+	params, err := s.newUserParams(ctx, input)
+	if err != nil {
+		return User{}, err
 	}
 
-	return ListResult{
-		Users:      users,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalItems: result.TotalItems,
-		TotalPages: totalPages,
-	}, nil
+	record, err := s.repo.Create(ctx, params)
+	if err != nil {
+		return User{}, mapPersistenceError(err)
+	}
+
+	return mapUser(record), nil
 }
 
-func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (User, error) { //nolint:revive
+// newUserParams validates input and checks the tenant user quota before a user is inserted.
+func (s *service) newUserParams(ctx context.Context, input CreateInput) (persistence.CreateUserParams, error) {
 	fieldErrors := FieldErrors{}
 
 	email := strings.TrimSpace(input.Email)
@@ -165,19 +209,26 @@ func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, inpu
 	}
 
 	if len(fieldErrors) > 0 {
-		return User{}, &ValidationError{Fields: fieldErrors}
+		return persistence.CreateUserParams{}, &ValidationError{Fields: fieldErrors}
 	}
 
-	record, err := s.repo.Create(ctx, persistence.CreateUserParams{
-		UserID:   uuid.New(),
-		Email:    strings.ToLower(email),
-		FullName: fullName,
-	})
+	attributes, err := s.encodeAttributes(ctx, input.Attributes)
 	if err != nil {
-		return User{}, mapPersistenceError(err)
+		return persistence.CreateUserParams{}, err
 	}
 
-	return mapUser(record), nil
+	if s.quotas != nil {
+		if err := s.quotas.CheckQuota(ctx, tenant.QuotaUsers, 1); err != nil {
+			return persistence.CreateUserParams{}, err
+		}
+	}
+
+	return persistence.CreateUserParams{
+		UserID:     uuid.New(),
+		Email:      strings.ToLower(email),
+		FullName:   fullName,
+		Attributes: attributes,
+	}, nil
 }
 
 func (s *service) Get(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (User, error) { //nolint:revive
@@ -198,7 +249,7 @@ func (s *service) Update(ctx context.Context, audit requesttrace.AuditInfo, id u
 		return User{}, ErrNotFound
 	}
 
-	params, err := s.buildUpdateParams(input)
+	params, err := s.buildUpdateParams(ctx, input)
 	if err != nil {
 		return User{}, err
 	}
@@ -216,9 +267,20 @@ func (s *service) UpdateSelf(ctx context.Context, audit requesttrace.AuditInfo,
 		return User{}, ErrNotFound
 	}
 
-	if input.FullName == nil {
+	if input.FullName == nil && input.Attributes == nil {
 		return User{}, newValidationError(map[string]string{"fullName": "fullName is required"})
 	}
+	if input.Attributes != nil {
+		params, err := s.buildUpdateParams(ctx, UpdateInput(input))
+		if err != nil {
+			return User{}, err
+		}
+		record, err := s.repo.Update(ctx, id, params)
+		if err != nil {
+			return User{}, mapPersistenceError(err)
+		}
+		return mapUser(record), nil
+	}
 
 	fullName := strings.TrimSpace(*input.FullName)
 	if fullName == "" {
@@ -241,11 +303,15 @@ func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, id u
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return mapPersistenceError(err)
 	}
+	// Other tenants' members may have switched in through this user; they lose access with it.
+	if err := s.repo.DeleteMemberships(ctx, id); err != nil {
+		return fmt.Errorf("delete memberships: %w", err)
+	}
 
 	return nil
 }
 
-func (s *service) buildUpdateParams(input UpdateInput) (persistence.UpdateUserParams, error) {
+func (s *service) buildUpdateParams(ctx context.Context, input UpdateInput) (persistence.UpdateUserParams, error) {
 	fieldErrors := FieldErrors{}
 	params := persistence.UpdateUserParams{}
 	fieldsSet := 0
@@ -260,6 +326,10 @@ func (s *service) buildUpdateParams(input UpdateInput) (persistence.UpdateUserPa
 		}
 	}
 
+	if input.Attributes != nil {
+		fieldsSet++
+	}
+
 	if fieldsSet == 0 {
 		fieldErrors.add("payload", "at least one field must be provided")
 	}
@@ -268,6 +338,12 @@ func (s *service) buildUpdateParams(input UpdateInput) (persistence.UpdateUserPa
 		return persistence.UpdateUserParams{}, &ValidationError{Fields: fieldErrors}
 	}
 
+	attributes, err := s.encodeAttributes(ctx, input.Attributes)
+	if err != nil {
+		return persistence.UpdateUserParams{}, err
+	}
+	params.Attributes = attributes
+
 	return params, nil
 }
 
@@ -281,10 +357,12 @@ func sanitizeSort(sort *string) (*string, error) {
 	}
 
 	allowed := map[string]struct{}{
-		"email":     {},
-		"fullName":  {},
-		"createdAt": {},
-		"updatedAt": {},
+		"email":        {},
+		"fullName":     {},
+		"createdAt":    {},
+		"updatedAt":    {},
+		"lastLoginAt":  {},
+		"lastActiveAt": {},
 	}
 
 	for _, raw := range strings.Split(trimmed, ",") {
@@ -305,11 +383,15 @@ func sanitizeSort(sort *string) (*string, error) {
 
 func mapUser(record persistence.User) User {
 	return User{
-		ID:        record.UserID,
-		Email:     record.Email,
-		FullName:  record.FullName,
-		CreatedAt: record.CreatedAt,
-		UpdatedAt: record.UpdatedAt,
+		ID:           record.UserID,
+		Email:        record.Email,
+		FullName:     record.FullName,
+		CreatedAt:    record.CreatedAt,
+		UpdatedAt:    record.UpdatedAt,
+		LastLoginAt:  record.LastLoginAt,
+		LastActiveAt: record.LastActiveAt,
+		LoginCount:   record.LoginCount,
+		Attributes:   decodeAttributes(record.Attributes),
 	}
 }
 
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/users/be/repo"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Service account sentinel errors.
+var (
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrServiceAccountConflict = errors.New("service account name already exists")
+	// ErrServiceAccountNotAllowed is returned when an API key or a service account tries to create a service
+	// account, so leaked machine credentials cannot mint longer-lived ones.
+	ErrServiceAccountNotAllowed = errors.New("service accounts can only be created by a signed-in user")
+)
+
+// ServiceAccount is the domain view of a tenant service account. Its key is only known when it is created.
+type ServiceAccount struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	Scopes      []string
+	CreatedBy   *uuid.UUID
+	CreatedAt   time.Time
+	LastUsedAt  *time.Time
+}
+
+// CreatedServiceAccount is a new service account together with the key to hand to the integration.
+type CreatedServiceAccount struct {
+	ServiceAccount
+	Key string
+}
+
+// CreateServiceAccountInput represents the payload required to create a service account.
+type CreateServiceAccountInput struct {
+	Name        string
+	Description *string
+	Scopes      []string
+}
+
+// CreateServiceAccount creates an account in the tenant in ctx. Each scope must be a permission the caller holds.
+func (s *service) CreateServiceAccount(ctx context.Context, audit requesttrace.AuditInfo, caller *platformauth.UserCredentials, input CreateServiceAccountInput) (CreatedServiceAccount, error) { //nolint:revive
+	creatorID, err := apiKeyOwner(caller)
+	if err != nil {
+		return CreatedServiceAccount{}, ErrServiceAccountNotAllowed
+	}
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return CreatedServiceAccount{}, errors.New("tenant space missing from context")
+	}
+
+	fieldErrors := FieldErrors{}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		fieldErrors.add("name", "name is required")
+	}
+	var description *string
+	if input.Description != nil {
+		if trimmed := strings.TrimSpace(*input.Description); trimmed != "" {
+			description = &trimmed
+		}
+	}
+
+	scopes, err := s.grantableScopes(ctx, caller, input.Scopes, fieldErrors)
+	if err != nil {
+		return CreatedServiceAccount{}, err
+	}
+
+	if len(fieldErrors) > 0 {
+		return CreatedServiceAccount{}, &ValidationError{Fields: fieldErrors}
+	}
+
+	accountID := uuid.New()
+	key, secretHash, err := platformauth.NewServiceAccountKey(space.TenantID, accountID)
+	if err != nil {
+		return CreatedServiceAccount{}, fmt.Errorf("generate service account key: %w", err)
+	}
+
+	record, err := s.repo.CreateServiceAccount(ctx, persistence.CreateServiceAccountParams{
+		ServiceAccountID: accountID,
+		Name:             name,
+		Description:      description,
+		Scopes:           scopes,
+		SecretHash:       secretHash,
+		CreatedBy:        &creatorID,
+	})
+	if errors.Is(err, persistence.ErrServiceAccountConflict) {
+		return CreatedServiceAccount{}, ErrServiceAccountConflict
+	}
+	if err != nil {
+		return CreatedServiceAccount{}, err
+	}
+	return CreatedServiceAccount{ServiceAccount: mapServiceAccount(record), Key: key}, nil
+}
+
+// ListServiceAccounts returns the tenant's accounts that are not revoked.
+func (s *service) ListServiceAccounts(ctx context.Context, audit requesttrace.AuditInfo) ([]ServiceAccount, error) { //nolint:revive
+	records, err := s.repo.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]ServiceAccount, 0, len(records))
+	for _, record := range records {
+		accounts = append(accounts, mapServiceAccount(record))
+	}
+	return accounts, nil
+}
+
+// RevokeServiceAccount revokes an account; its key stops obtaining tokens immediately, while tokens already
+// issued run out on their own.
+func (s *service) RevokeServiceAccount(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error { //nolint:revive
+	err := s.repo.RevokeServiceAccount(ctx, id, s.now())
+	if errors.Is(err, persistence.ErrServiceAccountNotFound) {
+		return ErrServiceAccountNotFound
+	}
+	return err
+}
+
+func mapServiceAccount(record persistence.ServiceAccount) ServiceAccount {
+	return ServiceAccount{
+		ID:          record.ServiceAccountID,
+		Name:        record.Name,
+		Description: record.Description,
+		Scopes:      record.Scopes,
+		CreatedBy:   record.CreatedBy,
+		CreatedAt:   record.CreatedAt,
+		LastUsedAt:  record.LastUsedAt,
+	}
+}
+
+// ServiceAccountAuthenticator checks service account keys for the token endpoint of the auth domain. The tenant
+// named by the key locates the schema holding the account.
+type ServiceAccountAuthenticator struct {
+	repo   repo.Repository
+	spaces TenantSpaceResolver
+	now    func() time.Time
+}
+
+// NewServiceAccountAuthenticator constructs a ServiceAccountAuthenticator.
+func NewServiceAccountAuthenticator(r repo.Repository, spaces TenantSpaceResolver) *ServiceAccountAuthenticator {
+	if r == nil {
+		panic("users repository is required")
+	}
+	if spaces == nil {
+		panic("tenant space resolver is required")
+	}
+	return &ServiceAccountAuthenticator{repo: r, spaces: spaces, now: time.Now}
+}
+
+// AuthenticateServiceAccount returns the account a key belongs to. Unknown tenants, unknown or revoked accounts
+// and wrong secrets all return platformauth.ErrInvalidServiceAccountKey.
+func (a *ServiceAccountAuthenticator) AuthenticateServiceAccount(ctx context.Context, key string) (platformauth.ServiceAccount, error) {
+	tenantID, accountID, secret, err := platformauth.ParseServiceAccountKey(key)
+	if err != nil {
+		return platformauth.ServiceAccount{}, err
+	}
+
+	space, err := a.spaces.ResolveTenantSpace(ctx, tenantID)
+	if err != nil {
+		return platformauth.ServiceAccount{}, fmt.Errorf("%w: %w", platformauth.ErrInvalidServiceAccountKey, err)
+	}
+	ctx = tenant.WithSpace(ctx, space)
+
+	record, err := a.repo.GetServiceAccount(ctx, accountID)
+	if errors.Is(err, persistence.ErrServiceAccountNotFound) {
+		return platformauth.ServiceAccount{}, platformauth.ErrInvalidServiceAccountKey
+	}
+	if err != nil {
+		return platformauth.ServiceAccount{}, fmt.Errorf("get service account: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(record.SecretHash), []byte(platformauth.HashAPIKeySecret(secret))) != 1 ||
+		record.RevokedAt != nil {
+		return platformauth.ServiceAccount{}, platformauth.ErrInvalidServiceAccountKey
+	}
+
+	// Usage tracking is best effort; it never fails a token request.
+	_ = a.repo.TouchServiceAccount(ctx, accountID, a.now())
+
+	return platformauth.ServiceAccount{
+		ID:       record.ServiceAccountID,
+		TenantID: space.TenantID,
+		Name:     record.Name,
+		Scopes:   append([]string(nil), record.Scopes...),
+	}, nil
+}
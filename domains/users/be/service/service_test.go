@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -9,8 +10,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 type mockRepository struct {
@@ -19,7 +22,45 @@ type mockRepository struct {
 	getFn        func(ctx context.Context, id uuid.UUID) (persistence.User, error)
 	updateFn     func(ctx context.Context, id uuid.UUID, params persistence.UpdateUserParams) (persistence.User, error)
 	updateNameFn func(ctx context.Context, id uuid.UUID, fullName string) (persistence.User, error)
+	activityFn   func(ctx context.Context, id uuid.UUID, sessionStart, activeAt time.Time) (persistence.User, error)
 	deleteFn     func(ctx context.Context, id uuid.UUID) error
+
+	listRolesFn       func(ctx context.Context) ([]persistence.Role, error)
+	createRoleFn      func(ctx context.Context, params persistence.CreateRoleParams) (persistence.Role, error)
+	deleteRoleFn      func(ctx context.Context, name string) error
+	listUserRolesFn   func(ctx context.Context, id uuid.UUID) ([]string, error)
+	setUserRolesFn    func(ctx context.Context, id uuid.UUID, roles []string) ([]string, error)
+	rolePermissionsFn func(ctx context.Context, userID *uuid.UUID, roles []string) ([]string, error)
+
+	createInvitationFn func(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error)
+	getInvitationFn    func(ctx context.Context, id uuid.UUID) (persistence.Invitation, error)
+	findInvitationFn   func(ctx context.Context, tokenHash string) (persistence.Invitation, error)
+	renewInvitationFn  func(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (persistence.Invitation, error)
+	expireInvitationFn func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error)
+	markSentFn         func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error)
+	acceptInvitationFn func(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error)
+
+	// Membership writes succeed unless configured.
+	upsertMembershipFn   func(ctx context.Context, email string, userID uuid.UUID, roles []string) error
+	setMembershipRolesFn func(ctx context.Context, userID uuid.UUID, roles []string) error
+	deleteMembershipsFn  func(ctx context.Context, userID uuid.UUID) error
+
+	createAPIKeyFn func(ctx context.Context, params persistence.CreateAPIKeyParams) (persistence.APIKey, error)
+	getAPIKeyFn    func(ctx context.Context, keyID uuid.UUID) (persistence.APIKey, error)
+	listAPIKeysFn  func(ctx context.Context, userID uuid.UUID) ([]persistence.APIKey, error)
+	revokeAPIKeyFn func(ctx context.Context, userID, keyID uuid.UUID, at time.Time) error
+	touchAPIKeyFn  func(ctx context.Context, keyID uuid.UUID, at time.Time) error
+
+	createServiceAccountFn func(ctx context.Context, params persistence.CreateServiceAccountParams) (persistence.ServiceAccount, error)
+	getServiceAccountFn    func(ctx context.Context, id uuid.UUID) (persistence.ServiceAccount, error)
+	listServiceAccountsFn  func(ctx context.Context) ([]persistence.ServiceAccount, error)
+	revokeServiceAccountFn func(ctx context.Context, id uuid.UUID, at time.Time) error
+	touchServiceAccountFn  func(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	attributesSchema     string
+	setAttributesFn      func(ctx context.Context, slug string) error
+	resolveAttributesFn  func(ctx context.Context, slug string) (persistence.SchemaRecord, error)
+	validateAttributesFn func(ctx context.Context, schema persistence.SchemaRecord, attributes json.RawMessage) error
 }
 
 func (m *mockRepository) Create(ctx context.Context, params persistence.CreateUserParams) (persistence.User, error) {
@@ -57,6 +98,13 @@ func (m *mockRepository) UpdateFullName(ctx context.Context, id uuid.UUID, fullN
 	return m.updateNameFn(ctx, id, fullName)
 }
 
+func (m *mockRepository) RecordActivity(ctx context.Context, id uuid.UUID, sessionStart, activeAt time.Time) (persistence.User, error) {
+	if m.activityFn == nil {
+		panic("activityFn not configured")
+	}
+	return m.activityFn(ctx, id, sessionStart, activeAt)
+}
+
 func (m *mockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.deleteFn == nil {
 		panic("deleteFn not configured")
@@ -64,10 +112,217 @@ func (m *mockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.deleteFn(ctx, id)
 }
 
+func (m *mockRepository) ListRoles(ctx context.Context) ([]persistence.Role, error) {
+	if m.listRolesFn == nil {
+		panic("listRolesFn not configured")
+	}
+	return m.listRolesFn(ctx)
+}
+
+func (m *mockRepository) CreateRole(ctx context.Context, params persistence.CreateRoleParams) (persistence.Role, error) {
+	if m.createRoleFn == nil {
+		panic("createRoleFn not configured")
+	}
+	return m.createRoleFn(ctx, params)
+}
+
+func (m *mockRepository) DeleteRole(ctx context.Context, name string) error {
+	if m.deleteRoleFn == nil {
+		panic("deleteRoleFn not configured")
+	}
+	return m.deleteRoleFn(ctx, name)
+}
+
+func (m *mockRepository) ListUserRoles(ctx context.Context, id uuid.UUID) ([]string, error) {
+	if m.listUserRolesFn == nil {
+		panic("listUserRolesFn not configured")
+	}
+	return m.listUserRolesFn(ctx, id)
+}
+
+func (m *mockRepository) SetUserRoles(ctx context.Context, id uuid.UUID, roles []string) ([]string, error) {
+	if m.setUserRolesFn == nil {
+		panic("setUserRolesFn not configured")
+	}
+	return m.setUserRolesFn(ctx, id, roles)
+}
+
+func (m *mockRepository) RolePermissions(ctx context.Context, userID *uuid.UUID, roles []string) ([]string, error) {
+	if m.rolePermissionsFn == nil {
+		panic("rolePermissionsFn not configured")
+	}
+	return m.rolePermissionsFn(ctx, userID, roles)
+}
+
+func (m *mockRepository) CreateInvitation(ctx context.Context, params persistence.CreateInvitationParams) (persistence.Invitation, error) {
+	if m.createInvitationFn == nil {
+		panic("createInvitationFn not configured")
+	}
+	return m.createInvitationFn(ctx, params)
+}
+
+func (m *mockRepository) GetInvitation(ctx context.Context, id uuid.UUID) (persistence.Invitation, error) {
+	if m.getInvitationFn == nil {
+		panic("getInvitationFn not configured")
+	}
+	return m.getInvitationFn(ctx, id)
+}
+
+func (m *mockRepository) FindInvitationByToken(ctx context.Context, tokenHash string) (persistence.Invitation, error) {
+	if m.findInvitationFn == nil {
+		panic("findInvitationFn not configured")
+	}
+	return m.findInvitationFn(ctx, tokenHash)
+}
+
+func (m *mockRepository) RenewInvitation(ctx context.Context, id uuid.UUID, tokenHash string, expiresAt time.Time) (persistence.Invitation, error) {
+	if m.renewInvitationFn == nil {
+		panic("renewInvitationFn not configured")
+	}
+	return m.renewInvitationFn(ctx, id, tokenHash, expiresAt)
+}
+
+func (m *mockRepository) ExpireInvitation(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+	if m.expireInvitationFn == nil {
+		panic("expireInvitationFn not configured")
+	}
+	return m.expireInvitationFn(ctx, id, at)
+}
+
+func (m *mockRepository) MarkInvitationSent(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+	if m.markSentFn == nil {
+		panic("markSentFn not configured")
+	}
+	return m.markSentFn(ctx, id, at)
+}
+
+func (m *mockRepository) AcceptInvitation(ctx context.Context, id uuid.UUID, at time.Time) (persistence.Invitation, error) {
+	if m.acceptInvitationFn == nil {
+		panic("acceptInvitationFn not configured")
+	}
+	return m.acceptInvitationFn(ctx, id, at)
+}
+
+func (m *mockRepository) UpsertMembership(ctx context.Context, email string, userID uuid.UUID, roles []string) error {
+	if m.upsertMembershipFn == nil {
+		return nil
+	}
+	return m.upsertMembershipFn(ctx, email, userID, roles)
+}
+
+func (m *mockRepository) SetMembershipRoles(ctx context.Context, userID uuid.UUID, roles []string) error {
+	if m.setMembershipRolesFn == nil {
+		return nil
+	}
+	return m.setMembershipRolesFn(ctx, userID, roles)
+}
+
+func (m *mockRepository) DeleteMemberships(ctx context.Context, userID uuid.UUID) error {
+	if m.deleteMembershipsFn == nil {
+		return nil
+	}
+	return m.deleteMembershipsFn(ctx, userID)
+}
+
+func (m *mockRepository) CreateAPIKey(ctx context.Context, params persistence.CreateAPIKeyParams) (persistence.APIKey, error) {
+	if m.createAPIKeyFn == nil {
+		panic("createAPIKeyFn not configured")
+	}
+	return m.createAPIKeyFn(ctx, params)
+}
+
+func (m *mockRepository) GetAPIKey(ctx context.Context, keyID uuid.UUID) (persistence.APIKey, error) {
+	if m.getAPIKeyFn == nil {
+		panic("getAPIKeyFn not configured")
+	}
+	return m.getAPIKeyFn(ctx, keyID)
+}
+
+func (m *mockRepository) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]persistence.APIKey, error) {
+	if m.listAPIKeysFn == nil {
+		panic("listAPIKeysFn not configured")
+	}
+	return m.listAPIKeysFn(ctx, userID)
+}
+
+func (m *mockRepository) RevokeAPIKey(ctx context.Context, userID, keyID uuid.UUID, at time.Time) error {
+	if m.revokeAPIKeyFn == nil {
+		panic("revokeAPIKeyFn not configured")
+	}
+	return m.revokeAPIKeyFn(ctx, userID, keyID, at)
+}
+
+func (m *mockRepository) GetAttributesSchema(context.Context) (string, error) {
+	return m.attributesSchema, nil
+}
+
+func (m *mockRepository) SetAttributesSchema(ctx context.Context, slug string) error {
+	if m.setAttributesFn == nil {
+		panic("setAttributesFn not configured")
+	}
+	return m.setAttributesFn(ctx, slug)
+}
+
+func (m *mockRepository) ResolveAttributesSchema(ctx context.Context, slug string) (persistence.SchemaRecord, error) {
+	if m.resolveAttributesFn == nil {
+		panic("resolveAttributesFn not configured")
+	}
+	return m.resolveAttributesFn(ctx, slug)
+}
+
+func (m *mockRepository) ValidateAttributes(ctx context.Context, schema persistence.SchemaRecord, attributes json.RawMessage) error {
+	if m.validateAttributesFn == nil {
+		panic("validateAttributesFn not configured")
+	}
+	return m.validateAttributesFn(ctx, schema, attributes)
+}
+
+func (m *mockRepository) TouchAPIKey(ctx context.Context, keyID uuid.UUID, at time.Time) error {
+	if m.touchAPIKeyFn == nil {
+		return nil
+	}
+	return m.touchAPIKeyFn(ctx, keyID, at)
+}
+
+func (m *mockRepository) CreateServiceAccount(ctx context.Context, params persistence.CreateServiceAccountParams) (persistence.ServiceAccount, error) {
+	if m.createServiceAccountFn == nil {
+		panic("createServiceAccountFn not configured")
+	}
+	return m.createServiceAccountFn(ctx, params)
+}
+
+func (m *mockRepository) GetServiceAccount(ctx context.Context, id uuid.UUID) (persistence.ServiceAccount, error) {
+	if m.getServiceAccountFn == nil {
+		panic("getServiceAccountFn not configured")
+	}
+	return m.getServiceAccountFn(ctx, id)
+}
+
+func (m *mockRepository) ListServiceAccounts(ctx context.Context) ([]persistence.ServiceAccount, error) {
+	if m.listServiceAccountsFn == nil {
+		panic("listServiceAccountsFn not configured")
+	}
+	return m.listServiceAccountsFn(ctx)
+}
+
+func (m *mockRepository) RevokeServiceAccount(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if m.revokeServiceAccountFn == nil {
+		panic("revokeServiceAccountFn not configured")
+	}
+	return m.revokeServiceAccountFn(ctx, id, at)
+}
+
+func (m *mockRepository) TouchServiceAccount(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if m.touchServiceAccountFn == nil {
+		return nil
+	}
+	return m.touchServiceAccountFn(ctx, id, at)
+}
+
 func TestServiceCreateValidation(t *testing.T) {
 	t.Parallel()
 
-	svc := New(&mockRepository{})
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	_, err := svc.Create(context.Background(), audit, CreateInput{})
@@ -99,7 +354,7 @@ func TestServiceCreateSuccess(t *testing.T) {
 		}, nil
 	}
 
-	svc := New(repository)
+	svc := New(repository, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	user, err := svc.Create(context.Background(), audit, CreateInput{
@@ -111,6 +366,28 @@ func TestServiceCreateSuccess(t *testing.T) {
 	require.Equal(t, "Admin", user.FullName)
 }
 
+type stubQuotas struct {
+	err error
+}
+
+func (s stubQuotas) CheckQuota(context.Context, tenant.QuotaResource, int64) error { return s.err }
+
+func (s stubQuotas) CheckTableQuota(context.Context, string) error { return nil }
+
+func TestServiceCreateQuotaExceeded(t *testing.T) {
+	t.Parallel()
+
+	quotaErr := &tenant.QuotaExceededError{Resource: tenant.QuotaUsers, Limit: 5, Used: 5}
+	svc := New(&mockRepository{}, stubQuotas{err: quotaErr}, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Create(context.Background(), audit, CreateInput{
+		Email:    "admin@example.com",
+		FullName: "Admin",
+	})
+	require.ErrorIs(t, err, quotaErr)
+}
+
 func TestServiceListSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -138,7 +415,7 @@ func TestServiceListSuccess(t *testing.T) {
 		}, nil
 	}
 
-	svc := New(repository)
+	svc := New(repository, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	sort := "createdAt"
@@ -164,7 +441,7 @@ func TestServiceListInvalidSort(t *testing.T) {
 
 	svc := New(&mockRepository{listFn: func(ctx context.Context, params persistence.ListUsersParams) (persistence.ListUsersResult, error) {
 		return persistence.ListUsersResult{}, nil
-	}})
+	}}, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	sort := "-invalid"
@@ -179,7 +456,7 @@ func TestServiceListInvalidSort(t *testing.T) {
 func TestServiceUpdateValidation(t *testing.T) {
 	t.Parallel()
 
-	svc := New(&mockRepository{})
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 	_, err := svc.Update(context.Background(), audit, uuid.New(), UpdateInput{})
 	require.Error(t, err)
@@ -210,7 +487,7 @@ func TestServiceUpdateSuccess(t *testing.T) {
 		}, nil
 	}
 
-	svc := New(repository)
+	svc := New(repository, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	updated, err := svc.Update(context.Background(), audit, userID, UpdateInput{
@@ -224,7 +501,7 @@ func TestServiceUpdateSuccess(t *testing.T) {
 func TestServiceUpdateSelfValidation(t *testing.T) {
 	t.Parallel()
 
-	svc := New(&mockRepository{})
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 	_, err := svc.UpdateSelf(context.Background(), audit, uuid.New(), UpdateSelfInput{})
 	require.Error(t, err)
@@ -247,7 +524,7 @@ func TestServiceUpdateSelfSuccess(t *testing.T) {
 		return persistence.User{UserID: id, FullName: fullName, CreatedAt: now, UpdatedAt: now}, nil
 	}
 
-	svc := New(repository)
+	svc := New(repository, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	n, err := svc.UpdateSelf(context.Background(), audit, userID, UpdateSelfInput{FullName: ptrString(" Admin ")})
@@ -258,7 +535,7 @@ func TestServiceUpdateSelfSuccess(t *testing.T) {
 func TestServiceDeleteInvalidID(t *testing.T) {
 	t.Parallel()
 
-	svc := New(&mockRepository{})
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	err := svc.Delete(context.Background(), audit, uuid.Nil)
@@ -273,7 +550,7 @@ func TestServiceDeleteNotFound(t *testing.T) {
 		return persistence.ErrUserNotFound
 	}
 
-	svc := New(repository)
+	svc := New(repository, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	err := svc.Delete(context.Background(), audit, uuid.New())
@@ -293,7 +570,7 @@ func TestServiceDeleteSuccess(t *testing.T) {
 		return nil
 	}
 
-	svc := New(repository)
+	svc := New(repository, nil, InvitationDeps{})
 	audit := requesttrace.Anonymous("test")
 
 	err := svc.Delete(context.Background(), audit, userID)
@@ -301,6 +578,103 @@ func TestServiceDeleteSuccess(t *testing.T) {
 	require.True(t, called)
 }
 
+func TestServiceCreateRoleValidation(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.CreateRole(context.Background(), audit, CreateRoleInput{Name: "1bad", Permissions: []string{"users"}})
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, validationErr.Fields, "name")
+	require.Contains(t, validationErr.Fields, "permissions")
+
+	_, err = svc.CreateRole(context.Background(), audit, CreateRoleInput{Name: "operators", Permissions: []string{"tenants:admin"}})
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, validationErr.Fields, "permissions")
+}
+
+func TestServiceCreateRoleSuccess(t *testing.T) {
+	t.Parallel()
+
+	repository := &mockRepository{}
+	repository.createRoleFn = func(ctx context.Context, params persistence.CreateRoleParams) (persistence.Role, error) {
+		require.Equal(t, "editor", params.Name)
+		require.Equal(t, []string{"users:read", "*"}, params.Permissions)
+		require.Nil(t, params.Description)
+		return persistence.Role{Name: params.Name, Permissions: params.Permissions}, nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	role, err := svc.CreateRole(context.Background(), audit, CreateRoleInput{
+		Name:        " Editor ",
+		Description: ptrString("  "),
+		Permissions: []string{"users:read", " * "},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "editor", role.Name)
+}
+
+func TestServiceDeleteAdminRoleProtected(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	err := svc.DeleteRole(context.Background(), audit, "Admin")
+	require.ErrorIs(t, err, ErrRoleProtected)
+}
+
+func TestServiceSetUserRolesUnknownRole(t *testing.T) {
+	t.Parallel()
+
+	repository := &mockRepository{}
+	repository.setUserRolesFn = func(ctx context.Context, id uuid.UUID, roles []string) ([]string, error) {
+		require.Equal(t, []string{"editor"}, roles)
+		return nil, persistence.ErrRoleNotFound
+	}
+
+	svc := New(repository, nil, InvitationDeps{})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.SetUserRoles(context.Background(), audit, uuid.New(), []string{" Editor ", ""})
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, validationErr.Fields, "roles")
+}
+
+func TestServiceResolvePermissions(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	repository := &mockRepository{}
+	repository.rolePermissionsFn = func(ctx context.Context, id *uuid.UUID, roles []string) ([]string, error) {
+		if id == nil {
+			require.Equal(t, []string{"editor"}, roles)
+			return []string{"users:read"}, nil
+		}
+		require.Equal(t, userID, *id)
+		require.Empty(t, roles)
+		return []string{"roles:manage"}, nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{})
+
+	// Token roles resolve even when the subject is not a users row id.
+	permissions, err := svc.ResolvePermissions(context.Background(), &platformauth.UserCredentials{Id: "firebase-uid", TenantRoles: []string{"Editor"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"users:read"}, permissions)
+
+	permissions, err = svc.ResolvePermissions(context.Background(), &platformauth.UserCredentials{Id: userID.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"roles:manage"}, permissions)
+}
+
 func ptrString(v string) *string {
 	s := v
 	return &s
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrSyncUnavailable is returned by SyncUsers when no IdentityDirectory is configured.
+var ErrSyncUnavailable = errors.New("user sync is not configured")
+
+// Identity is a sign-in identity of the auth provider.
+type Identity struct {
+	UID         string
+	Email       string
+	DisplayName string
+}
+
+// IdentityDirectory lists the identities of the auth provider tenant of the tenant in ctx.
+type IdentityDirectory interface {
+	ListIdentities(ctx context.Context) ([]Identity, error)
+}
+
+// OrphanReason explains why a provider identity was not linked to a user.
+type OrphanReason string
+
+const (
+	OrphanInvalidUID    OrphanReason = "invalid_uid"
+	OrphanMissingEmail  OrphanReason = "missing_email"
+	OrphanEmailConflict OrphanReason = "email_conflict"
+	OrphanQuotaExceeded OrphanReason = "quota_exceeded"
+)
+
+// ProviderOrphan is a provider identity without a users row.
+type ProviderOrphan struct {
+	Identity
+	Reason OrphanReason
+}
+
+// SyncReport summarises a SyncUsers run. With DryRun set, Created and Updated describe what would change.
+type SyncReport struct {
+	DryRun          bool
+	Created         []User
+	Updated         []User
+	Unchanged       int
+	LocalOrphans    []User
+	ProviderOrphans []ProviderOrphan
+}
+
+const syncPageSize = 100
+
+// SyncUsers reconciles the users of the tenant in ctx with its auth provider identities. An identity whose uid is
+// a user id is linked to that row: missing rows are created and the provider's email and display name win over
+// the stored ones. Users without an identity and identities that cannot be linked are only reported.
+func (s *service) SyncUsers(ctx context.Context, audit requesttrace.AuditInfo, dryRun bool) (SyncReport, error) { //nolint:revive
+	if s.invitations.Directory == nil {
+		return SyncReport{}, ErrSyncUnavailable
+	}
+
+	identities, err := s.invitations.Directory.ListIdentities(ctx)
+	if err != nil {
+		return SyncReport{}, fmt.Errorf("list identities: %w", err)
+	}
+	sort.Slice(identities, func(i, j int) bool { return identities[i].UID < identities[j].UID })
+
+	locals, err := s.listAllUsers(ctx)
+	if err != nil {
+		return SyncReport{}, err
+	}
+	byID := make(map[uuid.UUID]persistence.User, len(locals))
+	byEmail := make(map[string]uuid.UUID, len(locals))
+	for _, user := range locals {
+		byID[user.UserID] = user
+		byEmail[user.Email] = user.UserID
+	}
+
+	report := SyncReport{
+		DryRun:          dryRun,
+		Created:         []User{},
+		Updated:         []User{},
+		LocalOrphans:    []User{},
+		ProviderOrphans: []ProviderOrphan{},
+	}
+	orphan := func(identity Identity, reason OrphanReason) {
+		report.ProviderOrphans = append(report.ProviderOrphans, ProviderOrphan{Identity: identity, Reason: reason})
+	}
+	linked := make(map[uuid.UUID]struct{}, len(identities))
+
+	for _, identity := range identities {
+		id, err := uuid.Parse(identity.UID)
+		if err != nil {
+			orphan(identity, OrphanInvalidUID)
+			continue
+		}
+		email := strings.ToLower(strings.TrimSpace(identity.Email))
+		fullName := strings.TrimSpace(identity.DisplayName)
+
+		if local, ok := byID[id]; ok {
+			linked[id] = struct{}{}
+
+			params := persistence.UpdateUserParams{}
+			if email != "" && email != local.Email {
+				if owner, taken := byEmail[email]; taken && owner != id {
+					orphan(identity, OrphanEmailConflict)
+					continue
+				}
+				params.Email = &email
+			}
+			if fullName != "" && fullName != local.FullName {
+				params.FullName = &fullName
+			}
+			if params.Email == nil && params.FullName == nil {
+				report.Unchanged++
+				continue
+			}
+
+			updated := local
+			if params.Email != nil {
+				updated.Email = email
+			}
+			if params.FullName != nil {
+				updated.FullName = fullName
+			}
+			if !dryRun {
+				if updated, err = s.repo.Update(ctx, id, params); err != nil {
+					if errors.Is(err, persistence.ErrUserConflict) {
+						orphan(identity, OrphanEmailConflict)
+						continue
+					}
+					return SyncReport{}, fmt.Errorf("update user %s: %w", id, err)
+				}
+			}
+			delete(byEmail, local.Email)
+			byEmail[updated.Email] = id
+			report.Updated = append(report.Updated, mapUser(updated))
+			continue
+		}
+
+		if email == "" {
+			orphan(identity, OrphanMissingEmail)
+			continue
+		}
+		if _, taken := byEmail[email]; taken {
+			orphan(identity, OrphanEmailConflict)
+			continue
+		}
+		if fullName == "" {
+			fullName = email[:strings.Index(email+"@", "@")]
+		}
+		if s.quotas != nil {
+			if err := s.quotas.CheckQuota(ctx, tenant.QuotaUsers, 1); err != nil {
+				var quotaErr *tenant.QuotaExceededError
+				if errors.As(err, &quotaErr) {
+					orphan(identity, OrphanQuotaExceeded)
+					continue
+				}
+				return SyncReport{}, err
+			}
+		}
+
+		created := persistence.User{UserID: id, Email: email, FullName: fullName}
+		if !dryRun {
+			if created, err = s.repo.Create(ctx, persistence.CreateUserParams{UserID: id, Email: email, FullName: fullName}); err != nil {
+				if errors.Is(err, persistence.ErrUserConflict) {
+					orphan(identity, OrphanEmailConflict)
+					continue
+				}
+				return SyncReport{}, fmt.Errorf("create user %s: %w", id, err)
+			}
+		}
+		byEmail[email] = id
+		report.Created = append(report.Created, mapUser(created))
+	}
+
+	for _, user := range locals {
+		if _, ok := linked[user.UserID]; !ok {
+			report.LocalOrphans = append(report.LocalOrphans, mapUser(user))
+		}
+	}
+
+	return report, nil
+}
+
+func (s *service) listAllUsers(ctx context.Context) ([]persistence.User, error) {
+	var users []persistence.User
+	for page := 1; ; page++ {
+		result, err := s.repo.List(ctx, persistence.ListUsersParams{Page: page, PageSize: syncPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("list users: %w", err)
+		}
+		users = append(users, result.Users...)
+		if len(result.Users) < syncPageSize || len(users) >= result.TotalItems {
+			return users, nil
+		}
+	}
+}
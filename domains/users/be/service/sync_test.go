@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type stubDirectory []Identity
+
+func (d stubDirectory) ListIdentities(context.Context) ([]Identity, error) {
+	return append([]Identity(nil), d...), nil
+}
+
+func TestServiceSyncUsers(t *testing.T) {
+	t.Parallel()
+
+	linked := persistence.User{UserID: uuid.New(), Email: "old@example.com", FullName: "Ann"}
+	unchanged := persistence.User{UserID: uuid.New(), Email: "bob@example.com", FullName: "Bob"}
+	localOnly := persistence.User{UserID: uuid.New(), Email: "gone@example.com", FullName: "Gone"}
+	newID := uuid.New()
+	clashID := uuid.New()
+
+	directory := stubDirectory{
+		{UID: linked.UserID.String(), Email: "Ann@Example.com", DisplayName: "Ann"},
+		{UID: unchanged.UserID.String(), Email: "bob@example.com"},
+		{UID: newID.String(), Email: "carol@example.com"},
+		{UID: clashID.String(), Email: "bob@example.com"},
+		{UID: uuid.NewString()},
+		{UID: "console-created"},
+	}
+
+	var created []persistence.CreateUserParams
+	var updated []persistence.UpdateUserParams
+	repository := &mockRepository{}
+	repository.listFn = func(ctx context.Context, params persistence.ListUsersParams) (persistence.ListUsersResult, error) {
+		require.Equal(t, 1, params.Page)
+		return persistence.ListUsersResult{Users: []persistence.User{linked, unchanged, localOnly}, TotalItems: 3}, nil
+	}
+	repository.createFn = func(ctx context.Context, params persistence.CreateUserParams) (persistence.User, error) {
+		created = append(created, params)
+		return persistence.User{UserID: params.UserID, Email: params.Email, FullName: params.FullName}, nil
+	}
+	repository.updateFn = func(ctx context.Context, id uuid.UUID, params persistence.UpdateUserParams) (persistence.User, error) {
+		require.Equal(t, linked.UserID, id)
+		updated = append(updated, params)
+		return persistence.User{UserID: id, Email: *params.Email, FullName: linked.FullName}, nil
+	}
+
+	svc := New(repository, nil, InvitationDeps{Directory: directory})
+
+	report, err := svc.SyncUsers(context.Background(), requesttrace.Anonymous("test"), true)
+	require.NoError(t, err)
+	require.True(t, report.DryRun)
+	require.Len(t, report.Created, 1)
+	require.Len(t, report.Updated, 1)
+	require.Empty(t, created)
+	require.Empty(t, updated)
+
+	report, err = svc.SyncUsers(context.Background(), requesttrace.Anonymous("test"), false)
+	require.NoError(t, err)
+
+	require.Equal(t, []persistence.CreateUserParams{{UserID: newID, Email: "carol@example.com", FullName: "carol"}}, created)
+	require.Len(t, updated, 1)
+	require.Equal(t, "ann@example.com", *updated[0].Email)
+	require.Nil(t, updated[0].FullName)
+	require.Equal(t, 1, report.Unchanged)
+	require.Len(t, report.LocalOrphans, 1)
+	require.Equal(t, localOnly.UserID, report.LocalOrphans[0].ID)
+
+	reasons := map[OrphanReason]int{}
+	for _, orphan := range report.ProviderOrphans {
+		reasons[orphan.Reason]++
+	}
+	require.Equal(t, map[OrphanReason]int{OrphanEmailConflict: 1, OrphanMissingEmail: 1, OrphanInvalidUID: 1}, reasons)
+}
+
+func TestServiceSyncUsersRequiresDirectory(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{}, nil, InvitationDeps{})
+
+	_, err := svc.SyncUsers(context.Background(), requesttrace.Anonymous("test"), false)
+	require.ErrorIs(t, err, ErrSyncUnavailable)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceLister enumerates the tenant spaces the sync worker reconciles.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// SyncWorkerConfig tunes the background user sync. Zero values fall back to defaults.
+type SyncWorkerConfig struct {
+	Interval time.Duration
+}
+
+// SyncWorker periodically runs SyncUsers for every active tenant so local users never drift from the auth
+// provider. Orphans are logged, never deleted.
+type SyncWorker struct {
+	svc    Service
+	spaces SpaceLister
+	logger *zap.Logger
+	cfg    SyncWorkerConfig
+}
+
+// NewSyncWorker constructs a SyncWorker with defaults applied to cfg.
+func NewSyncWorker(svc Service, spaces SpaceLister, logger *zap.Logger, cfg SyncWorkerConfig) *SyncWorker {
+	if svc == nil {
+		panic("users service is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Hour
+	}
+
+	return &SyncWorker{svc: svc, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// Run syncs every tenant each Interval until ctx is cancelled.
+func (w *SyncWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			w.logger.Error("user sync failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce syncs every active tenant once. Failures in one tenant are logged and do not stop the others.
+func (w *SyncWorker) RunOnce(ctx context.Context) error {
+	spaces, err := w.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		report, err := w.svc.SyncUsers(tenant.WithSpace(ctx, space), requesttrace.System(""), false)
+		if err != nil {
+			w.logger.Error("user sync for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+			continue
+		}
+		if len(report.Created)+len(report.Updated)+len(report.LocalOrphans)+len(report.ProviderOrphans) == 0 {
+			continue
+		}
+		w.logger.Info("user sync",
+			zap.String("tenant", space.Slug),
+			zap.Int("created", len(report.Created)),
+			zap.Int("updated", len(report.Updated)),
+			zap.Int("local_orphans", len(report.LocalOrphans)),
+			zap.Int("provider_orphans", len(report.ProviderOrphans)),
+		)
+	}
+	return nil
+}
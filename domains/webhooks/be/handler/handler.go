@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/service"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	webhooks "github.com/zenGate-Global/palmyra-pro-saas/generated/go/webhooks"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type operation string
+
+const (
+	createOperation         operation = "webhooksCreate"
+	listOperation           operation = "webhooksList"
+	getOperation            operation = "webhooksGet"
+	deleteOperation         operation = "webhooksDelete"
+	rotateSecretOperation   operation = "webhooksRotateSecret"
+	listDeliveriesOperation operation = "webhooksListDeliveries"
+	getDeliveryOperation    operation = "webhooksGetDelivery"
+	redeliverOperation      operation = "webhooksRedeliver"
+)
+
+// Handler wires the webhooks service to the generated HTTP contract.
+type Handler struct {
+	svc    service.Service
+	logger *zap.Logger
+}
+
+func (h *Handler) audit(ctx context.Context) requesttrace.AuditInfo {
+	return requesttrace.FromContextOrAnonymous(ctx)
+}
+
+// New constructs a Handler instance.
+func New(svc service.Service, logger *zap.Logger) *Handler {
+	if svc == nil {
+		panic("webhooks service is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) WebhooksList(ctx context.Context, request webhooks.WebhooksListRequestObject) (webhooks.WebhooksListResponseObject, error) {
+	page := pagination.FromQuery(request.Params.Page, request.Params.PageSize)
+	opts := service.ListOptions{Page: page.Page, PageSize: page.PageSize}
+
+	result, err := h.svc.List(ctx, h.audit(ctx), opts)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, listOperation)
+		return webhooks.WebhooksListdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]webhooks.Webhook, 0, len(result.Webhooks))
+	for _, webhook := range result.Webhooks {
+		items = append(items, toAPIWebhook(webhook))
+	}
+
+	return webhooks.WebhooksList200JSONResponse{
+		Items:      items,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (h *Handler) WebhooksCreate(ctx context.Context, request webhooks.WebhooksCreateRequestObject) (webhooks.WebhooksCreateResponseObject, error) {
+	if request.Body == nil {
+		problem := problems.MissingBody().Details()
+		return webhooks.WebhooksCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: http.StatusBadRequest}, nil
+	}
+
+	input := service.CreateInput{
+		URL:         request.Body.Url,
+		EventTypes:  make([]string, 0, len(request.Body.EventTypes)),
+		Description: request.Body.Description,
+	}
+	for _, eventType := range request.Body.EventTypes {
+		input.EventTypes = append(input.EventTypes, string(eventType))
+	}
+
+	created, err := h.svc.Create(ctx, h.audit(ctx), input)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, createOperation)
+		return webhooks.WebhooksCreatedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return webhooks.WebhooksCreate201JSONResponse{
+		Headers: webhooks.WebhooksCreate201ResponseHeaders{Location: fmt.Sprintf("/api/v1/webhooks/%s", created.ID.String())},
+		Body:    toAPIWebhookWithSecret(created),
+	}, nil
+}
+
+func (h *Handler) WebhooksGet(ctx context.Context, request webhooks.WebhooksGetRequestObject) (webhooks.WebhooksGetResponseObject, error) {
+	webhook, err := h.svc.Get(ctx, h.audit(ctx), uuid.UUID(request.WebhookId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getOperation)
+		return webhooks.WebhooksGetdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return webhooks.WebhooksGet200JSONResponse(toAPIWebhook(webhook)), nil
+}
+
+func (h *Handler) WebhooksDelete(ctx context.Context, request webhooks.WebhooksDeleteRequestObject) (webhooks.WebhooksDeleteResponseObject, error) {
+	if err := h.svc.Delete(ctx, h.audit(ctx), uuid.UUID(request.WebhookId)); err != nil {
+		status, problem := h.problemForError(ctx, err, deleteOperation)
+		return webhooks.WebhooksDeletedefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return webhooks.WebhooksDelete204Response{}, nil
+}
+
+func (h *Handler) WebhooksRotateSecret(ctx context.Context, request webhooks.WebhooksRotateSecretRequestObject) (webhooks.WebhooksRotateSecretResponseObject, error) {
+	rotated, err := h.svc.RotateSecret(ctx, h.audit(ctx), uuid.UUID(request.WebhookId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, rotateSecretOperation)
+		return webhooks.WebhooksRotateSecretdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return webhooks.WebhooksRotateSecret200JSONResponse(toAPIWebhookWithSecret(rotated)), nil
+}
+
+func (h *Handler) WebhooksListDeliveries(ctx context.Context, request webhooks.WebhooksListDeliveriesRequestObject) (webhooks.WebhooksListDeliveriesResponseObject, error) {
+	page := pagination.FromQuery(request.Params.Page, request.Params.PageSize)
+	opts := service.DeliveryListOptions{ListOptions: service.ListOptions{Page: page.Page, PageSize: page.PageSize}}
+	if request.Params.Status != nil {
+		status := string(*request.Params.Status)
+		opts.Status = &status
+	}
+
+	result, err := h.svc.ListDeliveries(ctx, h.audit(ctx), uuid.UUID(request.WebhookId), opts)
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, listDeliveriesOperation)
+		return webhooks.WebhooksListDeliveriesdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	items := make([]webhooks.WebhookDelivery, 0, len(result.Deliveries))
+	for _, delivery := range result.Deliveries {
+		items = append(items, toAPIDelivery(delivery))
+	}
+
+	return webhooks.WebhooksListDeliveries200JSONResponse{
+		Items:      items,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (h *Handler) WebhooksGetDelivery(ctx context.Context, request webhooks.WebhooksGetDeliveryRequestObject) (webhooks.WebhooksGetDeliveryResponseObject, error) {
+	detail, err := h.svc.GetDelivery(ctx, h.audit(ctx), uuid.UUID(request.WebhookId), uuid.UUID(request.DeliveryId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, getDeliveryOperation)
+		return webhooks.WebhooksGetDeliverydefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return webhooks.WebhooksGetDelivery200JSONResponse(toAPIDeliveryDetail(detail)), nil
+}
+
+func (h *Handler) WebhooksRedeliver(ctx context.Context, request webhooks.WebhooksRedeliverRequestObject) (webhooks.WebhooksRedeliverResponseObject, error) {
+	delivery, err := h.svc.Redeliver(ctx, h.audit(ctx), uuid.UUID(request.WebhookId), uuid.UUID(request.DeliveryId))
+	if err != nil {
+		status, problem := h.problemForError(ctx, err, redeliverOperation)
+		return webhooks.WebhooksRedeliverdefaultApplicationProblemPlusJSONResponse{Body: problem, StatusCode: status}, nil
+	}
+
+	return webhooks.WebhooksRedeliver202JSONResponse(toAPIDelivery(delivery)), nil
+}
+
+func toAPIWebhook(webhook service.Webhook) webhooks.Webhook {
+	eventTypes := make([]webhooks.WebhookEventType, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, webhooks.WebhookEventType(eventType))
+	}
+
+	return webhooks.Webhook{
+		WebhookId:   externalRef2.UUID(webhook.ID),
+		Url:         webhook.URL,
+		EventTypes:  eventTypes,
+		Description: webhook.Description,
+		CreatedAt:   externalRef2.Timestamp(webhook.CreatedAt),
+	}
+}
+
+func toAPIDelivery(delivery service.Delivery) webhooks.WebhookDelivery {
+	apiDelivery := webhooks.WebhookDelivery{
+		DeliveryId:     externalRef2.UUID(delivery.ID),
+		WebhookId:      externalRef2.UUID(delivery.WebhookID),
+		EventId:        externalRef2.UUID(delivery.EventID),
+		EventType:      webhooks.WebhookEventType(delivery.EventType),
+		TableName:      delivery.TableName,
+		EntityId:       delivery.EntityID,
+		Status:         webhooks.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      externalRef2.Timestamp(delivery.CreatedAt),
+		UpdatedAt:      externalRef2.Timestamp(delivery.UpdatedAt),
+	}
+	if delivery.NextAttemptAt != nil {
+		next := externalRef2.Timestamp(*delivery.NextAttemptAt)
+		apiDelivery.NextAttemptAt = &next
+	}
+	return apiDelivery
+}
+
+func toAPIWebhookWithSecret(created service.CreatedWebhook) webhooks.WebhookWithSecret {
+	webhook := toAPIWebhook(created.Webhook)
+	secret := created.Secret
+
+	return webhooks.WebhookWithSecret{
+		WebhookId:   webhook.WebhookId,
+		Url:         webhook.Url,
+		EventTypes:  webhook.EventTypes,
+		Description: webhook.Description,
+		CreatedAt:   webhook.CreatedAt,
+		Secret:      &secret,
+	}
+}
+
+func toAPIDeliveryDetail(detail service.DeliveryDetail) webhooks.WebhookDeliveryDetail {
+	delivery := toAPIDelivery(detail.Delivery)
+	apiDetail := webhooks.WebhookDeliveryDetail{
+		DeliveryId:     delivery.DeliveryId,
+		WebhookId:      delivery.WebhookId,
+		EventId:        delivery.EventId,
+		EventType:      delivery.EventType,
+		TableName:      delivery.TableName,
+		EntityId:       delivery.EntityId,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		RedeliveredBy:  detail.RedeliveredBy,
+	}
+	if detail.LastAttemptAt != nil {
+		at := externalRef2.Timestamp(*detail.LastAttemptAt)
+		apiDetail.LastAttemptAt = &at
+	}
+	if detail.RedeliveredAt != nil {
+		at := externalRef2.Timestamp(*detail.RedeliveredAt)
+		apiDetail.RedeliveredAt = &at
+	}
+	if exchange := detail.Exchange; exchange != nil {
+		apiExchange := webhooks.WebhookExchange{
+			DurationMs: exchange.Duration.Milliseconds(),
+			Request:    webhooks.WebhookRequest{Headers: nonNilHeaders(exchange.RequestHeaders), Body: exchange.RequestBody},
+		}
+		if response := exchange.Response; response != nil {
+			apiExchange.Response = &webhooks.WebhookResponse{
+				StatusCode: response.StatusCode,
+				Headers:    nonNilHeaders(response.Headers),
+				Body:       response.Body,
+			}
+		}
+		apiDetail.Exchange = &apiExchange
+	}
+	return apiDetail
+}
+
+func nonNilHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return map[string]string{}
+	}
+	return headers
+}
+
+// errorCatalog maps webhooks service errors to problems.
+var errorCatalog = func() *problems.Catalog {
+	c := problems.NewCatalog("webhooks")
+	problems.RegisterAs(c, func(err *service.ValidationError) problems.Problem {
+		return problems.Validation("one or more fields are invalid", err.Fields)
+	})
+	c.Register(service.ErrNotFound, problems.NotFound("webhook not found"))
+	c.Register(service.ErrDeliveryNotFound, problems.NotFound("webhook delivery not found"))
+	c.Register(service.ErrDeliveryPending, problems.Conflict("the delivery is still pending; wait for it to finish before redelivering"))
+	return c
+}()
+
+func (h *Handler) problemForError(ctx context.Context, err error, op operation) (int, externalRef3.ProblemDetails) {
+	return errorCatalog.Resolve(ctx, h.logger, err, string(op))
+}
@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Repository defines the tenant-scoped persistence operations required by the webhooks API.
+type Repository interface {
+	Create(ctx context.Context, params persistence.CreateWebhookParams) (persistence.WebhookSubscription, error)
+	List(ctx context.Context, limit, offset int) ([]persistence.WebhookSubscription, int, error)
+	Get(ctx context.Context, id uuid.UUID) (persistence.WebhookSubscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	RotateSecret(ctx context.Context, id uuid.UUID, secret string) (persistence.WebhookSubscription, error)
+	ListDeliveries(ctx context.Context, id uuid.UUID, status string, limit, offset int) ([]persistence.WebhookDelivery, int, error)
+	GetDelivery(ctx context.Context, id, deliveryID uuid.UUID) (persistence.WebhookDeliveryDetail, error)
+	Redeliver(ctx context.Context, id, deliveryID uuid.UUID, requestedBy *string) (persistence.WebhookDelivery, error)
+}
+
+// DeliveryQueue exposes the outbox operations used by the background dispatcher.
+// Unlike Repository it takes the tenant space explicitly because it runs outside of a request.
+type DeliveryQueue interface {
+	FanOutEvents(ctx context.Context, space tenant.Space, limit int) (int, error)
+	ClaimDueDeliveries(ctx context.Context, space tenant.Space, limit int, lease time.Duration) ([]persistence.ClaimedWebhookDelivery, error)
+	CompleteDelivery(ctx context.Context, space tenant.Space, deliveryID uuid.UUID, outcome persistence.WebhookDeliveryOutcome) error
+}
+
+type postgresRepository struct {
+	store *persistence.WebhookStore
+}
+
+// NewPostgresRepository constructs a repository backed by the shared persistence layer.
+func NewPostgresRepository(store *persistence.WebhookStore) Repository {
+	if store == nil {
+		panic("webhook store is required")
+	}
+	return &postgresRepository{store: store}
+}
+
+func (r *postgresRepository) Create(ctx context.Context, params persistence.CreateWebhookParams) (persistence.WebhookSubscription, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.WebhookSubscription{}, err
+	}
+	return r.store.CreateSubscription(ctx, space, params)
+}
+
+func (r *postgresRepository) List(ctx context.Context, limit, offset int) ([]persistence.WebhookSubscription, int, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return r.store.ListSubscriptions(ctx, space, limit, offset)
+}
+
+func (r *postgresRepository) Get(ctx context.Context, id uuid.UUID) (persistence.WebhookSubscription, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.WebhookSubscription{}, err
+	}
+	return r.store.GetSubscription(ctx, space, id)
+}
+
+func (r *postgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return err
+	}
+	return r.store.DeleteSubscription(ctx, space, id)
+}
+
+func (r *postgresRepository) RotateSecret(ctx context.Context, id uuid.UUID, secret string) (persistence.WebhookSubscription, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.WebhookSubscription{}, err
+	}
+	return r.store.RotateSubscriptionSecret(ctx, space, id, secret)
+}
+
+func (r *postgresRepository) ListDeliveries(ctx context.Context, id uuid.UUID, status string, limit, offset int) ([]persistence.WebhookDelivery, int, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return r.store.ListDeliveries(ctx, space, id, status, limit, offset)
+}
+
+func (r *postgresRepository) GetDelivery(ctx context.Context, id, deliveryID uuid.UUID) (persistence.WebhookDeliveryDetail, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.WebhookDeliveryDetail{}, err
+	}
+	return r.store.GetDelivery(ctx, space, id, deliveryID)
+}
+
+func (r *postgresRepository) Redeliver(ctx context.Context, id, deliveryID uuid.UUID, requestedBy *string) (persistence.WebhookDelivery, error) {
+	space, err := requireTenantSpace(ctx)
+	if err != nil {
+		return persistence.WebhookDelivery{}, err
+	}
+	return r.store.RedeliverDelivery(ctx, space, id, deliveryID, requestedBy)
+}
+
+func requireTenantSpace(ctx context.Context) (tenant.Space, error) {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return tenant.Space{}, errors.New("tenant space missing from context")
+	}
+	return space, nil
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Delivery request headers.
+const (
+	HeaderEvent     = "X-Palmyra-Event"
+	HeaderDelivery  = "X-Palmyra-Delivery"
+	HeaderTimestamp = "X-Palmyra-Timestamp"
+	HeaderSignature = "X-Palmyra-Signature"
+)
+
+// maxExchangeBody caps the request and response bodies kept for inspection, and how much of a response is read.
+const maxExchangeBody = 64 << 10
+
+// SpaceLister enumerates the tenant spaces whose outboxes the dispatcher drains.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// DispatcherConfig tunes the background delivery loop. Zero values fall back to defaults.
+type DispatcherConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	Timeout     time.Duration
+	Client      *http.Client
+}
+
+// Dispatcher periodically fans outbox events out to subscriptions and delivers them.
+type Dispatcher struct {
+	queue  repo.DeliveryQueue
+	spaces SpaceLister
+	logger *zap.Logger
+	cfg    DispatcherConfig
+	now    func() time.Time
+}
+
+// eventEnvelope is the JSON body POSTed to webhook endpoints.
+type eventEnvelope struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurredAt"`
+	TenantID   string    `json:"tenantId"`
+	Data       eventData `json:"data"`
+}
+
+type eventData struct {
+	TableName     string          `json:"tableName"`
+	EntityID      string          `json:"entityId"`
+	EntityVersion string          `json:"entityVersion,omitempty"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+}
+
+// NewDispatcher constructs a Dispatcher with defaults applied to cfg.
+func NewDispatcher(queue repo.DeliveryQueue, spaces SpaceLister, logger *zap.Logger, cfg DispatcherConfig) *Dispatcher {
+	if queue == nil {
+		panic("webhook delivery queue is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 30 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Hour
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Dispatcher{queue: queue, spaces: spaces, logger: logger, cfg: cfg, now: time.Now}
+}
+
+// Run drains outboxes every Interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error("webhook dispatch failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single fan-out and delivery pass over every active tenant space.
+// Failures in one space are logged and do not stop the others.
+func (d *Dispatcher) RunOnce(ctx context.Context) error {
+	spaces, err := d.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := d.dispatchSpace(ctx, space); err != nil {
+			d.logger.Error("webhook dispatch for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) dispatchSpace(ctx context.Context, space tenant.Space) error {
+	if _, err := d.queue.FanOutEvents(ctx, space, d.cfg.BatchSize); err != nil {
+		return err
+	}
+
+	claimed, err := d.queue.ClaimDueDeliveries(ctx, space, d.cfg.BatchSize, d.lease())
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range claimed {
+		outcome := d.deliver(ctx, space, delivery)
+		if err := d.queue.CompleteDelivery(ctx, space, delivery.DeliveryID, outcome); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, space tenant.Space, delivery persistence.ClaimedWebhookDelivery) persistence.WebhookDeliveryOutcome {
+	body, err := json.Marshal(eventEnvelope{
+		ID:         delivery.EventID.String(),
+		Type:       delivery.Event.Type,
+		OccurredAt: delivery.Event.OccurredAt.UTC(),
+		TenantID:   space.TenantID.String(),
+		Data: eventData{
+			TableName:     delivery.Event.TableName,
+			EntityID:      delivery.Event.EntityID,
+			EntityVersion: delivery.Event.EntityVersion,
+			Payload:       delivery.Event.Payload,
+		},
+	})
+	if err != nil {
+		return d.failure(delivery.Attempt, nil, fmt.Sprintf("encode event: %v", err))
+	}
+
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+
+	reqCtx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return d.failure(delivery.Attempt, nil, fmt.Sprintf("build request: %v", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Palmyra-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.Event.Type)
+	req.Header.Set(HeaderDelivery, delivery.DeliveryID.String())
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, body))
+
+	exchange := &persistence.WebhookExchange{
+		RequestHeaders: flattenHeaders(req.Header),
+		RequestBody:    truncateBody(body),
+	}
+	started := d.now()
+
+	resp, err := d.cfg.Client.Do(req)
+	if err != nil {
+		exchange.Duration = d.now().Sub(started)
+		outcome := d.failure(delivery.Attempt, nil, err.Error())
+		outcome.Exchange = exchange
+		return outcome
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxExchangeBody))
+	exchange.Duration = d.now().Sub(started)
+	exchange.ResponseHeaders = flattenHeaders(resp.Header)
+	exchange.ResponseBody = respBody
+
+	status := resp.StatusCode
+	if status >= 200 && status < 300 {
+		return persistence.WebhookDeliveryOutcome{Succeeded: true, StatusCode: &status, Exchange: exchange}
+	}
+	outcome := d.failure(delivery.Attempt, &status, fmt.Sprintf("endpoint responded with status %d", status))
+	outcome.Exchange = exchange
+	return outcome
+}
+
+// flattenHeaders joins repeated header values the way they would appear on the wire.
+func flattenHeaders(header http.Header) map[string]string {
+	flat := make(map[string]string, len(header))
+	for name, values := range header {
+		flat[name] = strings.Join(values, ", ")
+	}
+	return flat
+}
+
+func truncateBody(body []byte) []byte {
+	if len(body) > maxExchangeBody {
+		return body[:maxExchangeBody]
+	}
+	return body
+}
+
+// failure schedules a retry with exponential backoff, or gives up once MaxAttempts is reached.
+func (d *Dispatcher) failure(attempt int, status *int, message string) persistence.WebhookDeliveryOutcome {
+	outcome := persistence.WebhookDeliveryOutcome{StatusCode: status, Error: &message}
+	if attempt < d.cfg.MaxAttempts {
+		retryAt := d.now().Add(d.backoff(attempt)).UTC()
+		outcome.RetryAt = &retryAt
+	}
+	return outcome
+}
+
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// lease keeps a claimed delivery invisible to other dispatchers for longer than one attempt can take.
+func (d *Dispatcher) lease() time.Duration {
+	if lease := 2 * d.cfg.Timeout; lease > time.Minute {
+		return lease
+	}
+	return time.Minute
+}
+
+// Sign returns the X-Palmyra-Signature value for a delivery: the hex HMAC-SHA256 of
+// "<timestamp>.<body>" keyed by the webhook secret, prefixed with "sha256=".
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type fakeQueue struct {
+	claimed   []persistence.ClaimedWebhookDelivery
+	outcomes  map[uuid.UUID]persistence.WebhookDeliveryOutcome
+	fannedOut int
+}
+
+func (q *fakeQueue) FanOutEvents(context.Context, tenant.Space, int) (int, error) {
+	q.fannedOut++
+	return 0, nil
+}
+
+func (q *fakeQueue) ClaimDueDeliveries(context.Context, tenant.Space, int, time.Duration) ([]persistence.ClaimedWebhookDelivery, error) {
+	claimed := q.claimed
+	q.claimed = nil
+	return claimed, nil
+}
+
+func (q *fakeQueue) CompleteDelivery(_ context.Context, _ tenant.Space, deliveryID uuid.UUID, outcome persistence.WebhookDeliveryOutcome) error {
+	q.outcomes[deliveryID] = outcome
+	return nil
+}
+
+type staticSpaces []tenant.Space
+
+func (s staticSpaces) ListActiveSpaces(context.Context) ([]tenant.Space, error) {
+	return s, nil
+}
+
+func newClaim(url string, attempt int) persistence.ClaimedWebhookDelivery {
+	return persistence.ClaimedWebhookDelivery{
+		DeliveryID: uuid.New(),
+		Attempt:    attempt,
+		WebhookID:  uuid.New(),
+		URL:        url,
+		Secret:     "whsec_test",
+		EventID:    uuid.New(),
+		Event: persistence.EntityEvent{
+			Type:          persistence.EntityEventCreated,
+			TableName:     "cards_entities",
+			EntityID:      "card-1",
+			EntityVersion: "1.0.0",
+			Payload:       json.RawMessage(`{"name":"Ace"}`),
+			OccurredAt:    time.Now().UTC(),
+		},
+	}
+}
+
+func TestDispatcherSignsAndDelivers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		gotSignature string
+		gotTimestamp string
+		gotBody      []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(HeaderSignature)
+		gotTimestamp = r.Header.Get(HeaderTimestamp)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	claim := newClaim(server.URL, 1)
+	queue := &fakeQueue{claimed: []persistence.ClaimedWebhookDelivery{claim}, outcomes: map[uuid.UUID]persistence.WebhookDeliveryOutcome{}}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme"}
+
+	dispatcher := NewDispatcher(queue, staticSpaces{space}, zap.NewNop(), DispatcherConfig{})
+	require.NoError(t, dispatcher.RunOnce(context.Background()))
+
+	require.Equal(t, 1, queue.fannedOut)
+	outcome := queue.outcomes[claim.DeliveryID]
+	require.True(t, outcome.Succeeded)
+	require.Equal(t, http.StatusNoContent, *outcome.StatusCode)
+
+	require.Equal(t, Sign("whsec_test", gotTimestamp, gotBody), gotSignature)
+
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &envelope))
+	require.Equal(t, persistence.EntityEventCreated, envelope["type"])
+	require.Equal(t, space.TenantID.String(), envelope["tenantId"])
+}
+
+func TestDispatcherRetriesWithBackoffThenGivesUp(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	retry := newClaim(server.URL, 3)
+	last := newClaim(server.URL, 5)
+	queue := &fakeQueue{claimed: []persistence.ClaimedWebhookDelivery{retry, last}, outcomes: map[uuid.UUID]persistence.WebhookDeliveryOutcome{}}
+
+	dispatcher := NewDispatcher(queue, staticSpaces{{Slug: "acme"}}, zap.NewNop(), DispatcherConfig{
+		MaxAttempts: 5,
+		BaseBackoff: time.Minute,
+	})
+	dispatcher.now = func() time.Time { return now }
+	require.NoError(t, dispatcher.RunOnce(context.Background()))
+
+	retried := queue.outcomes[retry.DeliveryID]
+	require.False(t, retried.Succeeded)
+	require.Equal(t, http.StatusBadGateway, *retried.StatusCode)
+	require.NotNil(t, retried.RetryAt)
+	require.Equal(t, now.Add(4*time.Minute), *retried.RetryAt)
+
+	failed := queue.outcomes[last.DeliveryID]
+	require.False(t, failed.Succeeded)
+	require.Nil(t, failed.RetryAt)
+	require.NotNil(t, failed.Error)
+}
+
+func TestDispatcherRecordsExchange(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, "database is down")
+	}))
+	defer server.Close()
+
+	reachable := newClaim(server.URL, 1)
+	unreachable := newClaim("http://127.0.0.1:1/hook", 1)
+	queue := &fakeQueue{claimed: []persistence.ClaimedWebhookDelivery{reachable, unreachable}, outcomes: map[uuid.UUID]persistence.WebhookDeliveryOutcome{}}
+
+	dispatcher := NewDispatcher(queue, staticSpaces{{Slug: "acme"}}, zap.NewNop(), DispatcherConfig{})
+	require.NoError(t, dispatcher.RunOnce(context.Background()))
+
+	exchange := queue.outcomes[reachable.DeliveryID].Exchange
+	require.NotNil(t, exchange)
+	require.Equal(t, persistence.EntityEventCreated, exchange.RequestHeaders[HeaderEvent])
+	require.Equal(t, reachable.DeliveryID.String(), exchange.RequestHeaders[HeaderDelivery])
+	require.Contains(t, string(exchange.RequestBody), `"entityId":"card-1"`)
+	require.Equal(t, "text/plain", exchange.ResponseHeaders["Content-Type"])
+	require.Equal(t, "database is down", string(exchange.ResponseBody))
+
+	// Transport errors keep the request but have no response.
+	exchange = queue.outcomes[unreachable.DeliveryID].Exchange
+	require.NotNil(t, exchange)
+	require.NotEmpty(t, exchange.RequestBody)
+	require.Nil(t, exchange.ResponseHeaders)
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := NewDispatcher(&fakeQueue{}, staticSpaces{}, zap.NewNop(), DispatcherConfig{
+		BaseBackoff: time.Minute,
+		MaxBackoff:  10 * time.Minute,
+	})
+	require.Equal(t, time.Minute, dispatcher.backoff(1))
+	require.Equal(t, 8*time.Minute, dispatcher.backoff(4))
+	require.Equal(t, 10*time.Minute, dispatcher.backoff(12))
+}
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/webhooks/be/repo"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+// FieldErrors maps request fields to validation issues.
+type FieldErrors map[string][]string
+
+func (f FieldErrors) add(field, message string) {
+	f[field] = append(f[field], message)
+}
+
+// ValidationError is returned when the input payload is invalid.
+type ValidationError struct {
+	Fields FieldErrors
+}
+
+func (v *ValidationError) Error() string {
+	return "validation error"
+}
+
+// ErrNotFound is returned when the webhook does not exist in the caller's tenant.
+var ErrNotFound = errors.New("webhook not found")
+
+// ErrDeliveryNotFound is returned when the delivery does not exist for the webhook.
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// ErrDeliveryPending is returned when redelivering a delivery that has not finished yet.
+var ErrDeliveryPending = errors.New("webhook delivery is still pending")
+
+// supportedEventTypes lists the entity events a webhook can subscribe to.
+var supportedEventTypes = map[string]struct{}{
+	persistence.EntityEventCreated: {},
+	persistence.EntityEventUpdated: {},
+	persistence.EntityEventDeleted: {},
+}
+
+// deliveryStatuses lists the states the delivery log can be filtered by.
+var deliveryStatuses = map[string]struct{}{
+	persistence.WebhookDeliveryPending:   {},
+	persistence.WebhookDeliverySucceeded: {},
+	persistence.WebhookDeliveryFailed:    {},
+}
+
+// Webhook represents the domain view of a subscription. The secret is never exposed after creation.
+type Webhook struct {
+	ID          uuid.UUID
+	URL         string
+	EventTypes  []string
+	Description *string
+	CreatedAt   time.Time
+}
+
+// CreatedWebhook is returned once on registration and carries the signing secret.
+type CreatedWebhook struct {
+	Webhook
+	Secret string
+}
+
+// Delivery is a single entry of a webhook's delivery log.
+type Delivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	TableName      string
+	EntityID       string
+	Status         string
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Exchange is the HTTP request and response of a delivery attempt. Response is nil when the endpoint could not be
+// reached. Bodies that are not valid UTF-8 have the offending bytes replaced.
+type Exchange struct {
+	RequestHeaders map[string]string
+	RequestBody    string
+	Response       *ExchangeResponse
+	Duration       time.Duration
+}
+
+// ExchangeResponse is the response an endpoint returned to a delivery attempt.
+type ExchangeResponse struct {
+	StatusCode int
+	Headers    map[string]string
+	Body       string
+}
+
+// DeliveryDetail is a delivery with the exchange of its latest attempt, if any.
+type DeliveryDetail struct {
+	Delivery
+	LastAttemptAt *time.Time
+	Exchange      *Exchange
+	RedeliveredAt *time.Time
+	RedeliveredBy *string
+}
+
+// CreateInput represents the payload required to register a webhook.
+type CreateInput struct {
+	URL         string
+	EventTypes  []string
+	Description *string
+}
+
+// ListOptions controls pagination.
+type ListOptions struct {
+	Page     int
+	PageSize int
+}
+
+// DeliveryListOptions controls pagination and filtering of the delivery log.
+type DeliveryListOptions struct {
+	ListOptions
+	Status *string
+}
+
+// ListResult wraps a page of webhooks with pagination metadata.
+type ListResult struct {
+	Webhooks []Webhook
+	pagination.Envelope
+}
+
+// DeliveryListResult wraps a page of deliveries with pagination metadata.
+type DeliveryListResult struct {
+	Deliveries []Delivery
+	pagination.Envelope
+}
+
+// Service defines the business operations for the webhooks domain.
+type Service interface {
+	Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (CreatedWebhook, error)
+	List(ctx context.Context, audit requesttrace.AuditInfo, opts ListOptions) (ListResult, error)
+	Get(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Webhook, error)
+	Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error
+	RotateSecret(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (CreatedWebhook, error)
+	ListDeliveries(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, opts DeliveryListOptions) (DeliveryListResult, error)
+	GetDelivery(ctx context.Context, audit requesttrace.AuditInfo, id, deliveryID uuid.UUID) (DeliveryDetail, error)
+	Redeliver(ctx context.Context, audit requesttrace.AuditInfo, id, deliveryID uuid.UUID) (Delivery, error)
+}
+
+type service struct {
+	repo repo.Repository
+}
+
+// New constructs a webhooks Service instance backed by the provided repository.
+func New(r repo.Repository) Service {
+	if r == nil {
+		panic("webhooks repository is required")
+	}
+	return &service{repo: r}
+}
+
+func (s *service) Create(ctx context.Context, audit requesttrace.AuditInfo, input CreateInput) (CreatedWebhook, error) {
+	fieldErrors := FieldErrors{}
+
+	target := strings.TrimSpace(input.URL)
+	if err := validateURL(target); err != nil {
+		fieldErrors.add("url", err.Error())
+	}
+
+	eventTypes := make([]string, 0, len(input.EventTypes))
+	seen := make(map[string]struct{}, len(input.EventTypes))
+	for _, eventType := range input.EventTypes {
+		if _, ok := supportedEventTypes[eventType]; !ok {
+			fieldErrors.add("eventTypes", fmt.Sprintf("unsupported event type %q", eventType))
+			continue
+		}
+		if _, dup := seen[eventType]; dup {
+			continue
+		}
+		seen[eventType] = struct{}{}
+		eventTypes = append(eventTypes, eventType)
+	}
+	if len(input.EventTypes) == 0 {
+		fieldErrors.add("eventTypes", "at least one event type is required")
+	}
+
+	var description *string
+	if input.Description != nil {
+		if trimmed := strings.TrimSpace(*input.Description); trimmed != "" {
+			description = &trimmed
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return CreatedWebhook{}, &ValidationError{Fields: fieldErrors}
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return CreatedWebhook{}, err
+	}
+
+	record, err := s.repo.Create(ctx, persistence.CreateWebhookParams{
+		WebhookID:   uuid.New(),
+		URL:         target,
+		EventTypes:  eventTypes,
+		Secret:      secret,
+		Description: description,
+		CreatedBy:   audit.UserID,
+	})
+	if err != nil {
+		return CreatedWebhook{}, mapPersistenceError(err)
+	}
+
+	return CreatedWebhook{Webhook: mapWebhook(record), Secret: record.Secret}, nil
+}
+
+func (s *service) List(ctx context.Context, audit requesttrace.AuditInfo, opts ListOptions) (ListResult, error) { //nolint:revive // audit reserved for persistence layer wiring
+	page := pagination.New(opts.Page, opts.PageSize)
+
+	records, total, err := s.repo.List(ctx, page.Limit(), page.Offset())
+	if err != nil {
+		return ListResult{}, mapPersistenceError(err)
+	}
+
+	webhooks := make([]Webhook, 0, len(records))
+	for _, record := range records {
+		webhooks = append(webhooks, mapWebhook(record))
+	}
+
+	return ListResult{Webhooks: webhooks, Envelope: page.Envelope(total)}, nil
+}
+
+func (s *service) Get(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (Webhook, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if id == uuid.Nil {
+		return Webhook{}, ErrNotFound
+	}
+
+	record, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return Webhook{}, mapPersistenceError(err)
+	}
+
+	return mapWebhook(record), nil
+}
+
+func (s *service) Delete(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) error { //nolint:revive // audit reserved for persistence layer wiring
+	if id == uuid.Nil {
+		return ErrNotFound
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return mapPersistenceError(err)
+	}
+
+	return nil
+}
+
+func (s *service) RotateSecret(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID) (CreatedWebhook, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if id == uuid.Nil {
+		return CreatedWebhook{}, ErrNotFound
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return CreatedWebhook{}, err
+	}
+
+	record, err := s.repo.RotateSecret(ctx, id, secret)
+	if err != nil {
+		return CreatedWebhook{}, mapPersistenceError(err)
+	}
+
+	return CreatedWebhook{Webhook: mapWebhook(record), Secret: record.Secret}, nil
+}
+
+func (s *service) ListDeliveries(ctx context.Context, audit requesttrace.AuditInfo, id uuid.UUID, opts DeliveryListOptions) (DeliveryListResult, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if id == uuid.Nil {
+		return DeliveryListResult{}, ErrNotFound
+	}
+
+	var status string
+	if opts.Status != nil {
+		status = *opts.Status
+		if _, ok := deliveryStatuses[status]; !ok {
+			fieldErrors := FieldErrors{}
+			fieldErrors.add("status", fmt.Sprintf("unsupported delivery status %q", status))
+			return DeliveryListResult{}, &ValidationError{Fields: fieldErrors}
+		}
+	}
+
+	page := pagination.New(opts.Page, opts.PageSize)
+
+	records, total, err := s.repo.ListDeliveries(ctx, id, status, page.Limit(), page.Offset())
+	if err != nil {
+		return DeliveryListResult{}, mapPersistenceError(err)
+	}
+
+	deliveries := make([]Delivery, 0, len(records))
+	for _, record := range records {
+		deliveries = append(deliveries, mapDelivery(record))
+	}
+
+	return DeliveryListResult{Deliveries: deliveries, Envelope: page.Envelope(total)}, nil
+}
+
+func (s *service) GetDelivery(ctx context.Context, audit requesttrace.AuditInfo, id, deliveryID uuid.UUID) (DeliveryDetail, error) { //nolint:revive // audit reserved for persistence layer wiring
+	if id == uuid.Nil {
+		return DeliveryDetail{}, ErrNotFound
+	}
+	if deliveryID == uuid.Nil {
+		return DeliveryDetail{}, ErrDeliveryNotFound
+	}
+
+	record, err := s.repo.GetDelivery(ctx, id, deliveryID)
+	if err != nil {
+		return DeliveryDetail{}, mapPersistenceError(err)
+	}
+
+	detail := DeliveryDetail{
+		Delivery:      mapDelivery(record.WebhookDelivery),
+		LastAttemptAt: record.LastAttemptAt,
+		RedeliveredAt: record.RedeliveredAt,
+		RedeliveredBy: record.RedeliveredBy,
+	}
+	if exchange := record.Exchange; exchange != nil {
+		detail.Exchange = &Exchange{
+			RequestHeaders: exchange.RequestHeaders,
+			RequestBody:    strings.ToValidUTF8(string(exchange.RequestBody), "\uFFFD"),
+			Duration:       exchange.Duration,
+		}
+		if record.LastStatusCode != nil {
+			detail.Exchange.Response = &ExchangeResponse{
+				StatusCode: *record.LastStatusCode,
+				Headers:    exchange.ResponseHeaders,
+				Body:       strings.ToValidUTF8(string(exchange.ResponseBody), "\uFFFD"),
+			}
+		}
+	}
+	return detail, nil
+}
+
+func (s *service) Redeliver(ctx context.Context, audit requesttrace.AuditInfo, id, deliveryID uuid.UUID) (Delivery, error) {
+	if id == uuid.Nil {
+		return Delivery{}, ErrNotFound
+	}
+	if deliveryID == uuid.Nil {
+		return Delivery{}, ErrDeliveryNotFound
+	}
+
+	record, err := s.repo.Redeliver(ctx, id, deliveryID, audit.UserID)
+	if err != nil {
+		return Delivery{}, mapPersistenceError(err)
+	}
+
+	return mapDelivery(record), nil
+}
+
+func validateURL(raw string) error {
+	if raw == "" {
+		return errors.New("url is required")
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return errors.New("url is not valid")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return errors.New("url must use http or https")
+	}
+	if parsed.Host == "" {
+		return errors.New("url must be absolute")
+	}
+	return nil
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+func mapWebhook(record persistence.WebhookSubscription) Webhook {
+	return Webhook{
+		ID:          record.WebhookID,
+		URL:         record.URL,
+		EventTypes:  append([]string(nil), record.EventTypes...),
+		Description: record.Description,
+		CreatedAt:   record.CreatedAt,
+	}
+}
+
+func mapDelivery(record persistence.WebhookDelivery) Delivery {
+	delivery := Delivery{
+		ID:             record.DeliveryID,
+		WebhookID:      record.WebhookID,
+		EventID:        record.EventID,
+		EventType:      record.EventType,
+		TableName:      record.TableName,
+		EntityID:       record.EntityID,
+		Status:         record.Status,
+		Attempts:       record.Attempts,
+		LastStatusCode: record.LastStatusCode,
+		LastError:      record.LastError,
+		CreatedAt:      record.CreatedAt,
+		UpdatedAt:      record.UpdatedAt,
+	}
+	if record.Status == persistence.WebhookDeliveryPending {
+		next := record.NextAttemptAt
+		delivery.NextAttemptAt = &next
+	}
+	return delivery
+}
+
+func mapPersistenceError(err error) error {
+	switch {
+	case errors.Is(err, persistence.ErrWebhookNotFound):
+		return ErrNotFound
+	case errors.Is(err, persistence.ErrWebhookDeliveryNotFound):
+		return ErrDeliveryNotFound
+	case errors.Is(err, persistence.ErrWebhookDeliveryPending):
+		return ErrDeliveryPending
+	}
+	return err
+}
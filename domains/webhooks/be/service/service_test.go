@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+)
+
+type mockRepository struct {
+	createFn         func(ctx context.Context, params persistence.CreateWebhookParams) (persistence.WebhookSubscription, error)
+	listFn           func(ctx context.Context, limit, offset int) ([]persistence.WebhookSubscription, int, error)
+	getFn            func(ctx context.Context, id uuid.UUID) (persistence.WebhookSubscription, error)
+	deleteFn         func(ctx context.Context, id uuid.UUID) error
+	rotateSecretFn   func(ctx context.Context, id uuid.UUID, secret string) (persistence.WebhookSubscription, error)
+	listDeliveriesFn func(ctx context.Context, id uuid.UUID, status string, limit, offset int) ([]persistence.WebhookDelivery, int, error)
+	getDeliveryFn    func(ctx context.Context, id, deliveryID uuid.UUID) (persistence.WebhookDeliveryDetail, error)
+	redeliverFn      func(ctx context.Context, id, deliveryID uuid.UUID, requestedBy *string) (persistence.WebhookDelivery, error)
+}
+
+func (m *mockRepository) Create(ctx context.Context, params persistence.CreateWebhookParams) (persistence.WebhookSubscription, error) {
+	if m.createFn == nil {
+		panic("createFn not configured")
+	}
+	return m.createFn(ctx, params)
+}
+
+func (m *mockRepository) List(ctx context.Context, limit, offset int) ([]persistence.WebhookSubscription, int, error) {
+	if m.listFn == nil {
+		panic("listFn not configured")
+	}
+	return m.listFn(ctx, limit, offset)
+}
+
+func (m *mockRepository) Get(ctx context.Context, id uuid.UUID) (persistence.WebhookSubscription, error) {
+	if m.getFn == nil {
+		panic("getFn not configured")
+	}
+	return m.getFn(ctx, id)
+}
+
+func (m *mockRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.deleteFn == nil {
+		panic("deleteFn not configured")
+	}
+	return m.deleteFn(ctx, id)
+}
+
+func (m *mockRepository) RotateSecret(ctx context.Context, id uuid.UUID, secret string) (persistence.WebhookSubscription, error) {
+	if m.rotateSecretFn == nil {
+		panic("rotateSecretFn not configured")
+	}
+	return m.rotateSecretFn(ctx, id, secret)
+}
+
+func (m *mockRepository) ListDeliveries(ctx context.Context, id uuid.UUID, status string, limit, offset int) ([]persistence.WebhookDelivery, int, error) {
+	if m.listDeliveriesFn == nil {
+		panic("listDeliveriesFn not configured")
+	}
+	return m.listDeliveriesFn(ctx, id, status, limit, offset)
+}
+
+func (m *mockRepository) GetDelivery(ctx context.Context, id, deliveryID uuid.UUID) (persistence.WebhookDeliveryDetail, error) {
+	if m.getDeliveryFn == nil {
+		panic("getDeliveryFn not configured")
+	}
+	return m.getDeliveryFn(ctx, id, deliveryID)
+}
+
+func (m *mockRepository) Redeliver(ctx context.Context, id, deliveryID uuid.UUID, requestedBy *string) (persistence.WebhookDelivery, error) {
+	if m.redeliverFn == nil {
+		panic("redeliverFn not configured")
+	}
+	return m.redeliverFn(ctx, id, deliveryID, requestedBy)
+}
+
+func TestServiceCreateValidation(t *testing.T) {
+	t.Parallel()
+
+	svc := New(&mockRepository{})
+	audit := requesttrace.Anonymous("test")
+
+	_, err := svc.Create(context.Background(), audit, CreateInput{
+		URL:        "ftp://example.com/hook",
+		EventTypes: []string{"entity.archived"},
+	})
+	require.Error(t, err)
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, validationErr.Fields, "url")
+	require.Contains(t, validationErr.Fields, "eventTypes")
+}
+
+func TestServiceCreateGeneratesSecret(t *testing.T) {
+	t.Parallel()
+
+	var captured persistence.CreateWebhookParams
+	repo := &mockRepository{
+		createFn: func(_ context.Context, params persistence.CreateWebhookParams) (persistence.WebhookSubscription, error) {
+			captured = params
+			return persistence.WebhookSubscription{
+				WebhookID:  params.WebhookID,
+				URL:        params.URL,
+				EventTypes: params.EventTypes,
+				Secret:     params.Secret,
+				CreatedAt:  time.Now().UTC(),
+			}, nil
+		},
+	}
+
+	svc := New(repo)
+	created, err := svc.Create(context.Background(), requesttrace.Anonymous("test"), CreateInput{
+		URL:        " https://example.com/hook ",
+		EventTypes: []string{persistence.EntityEventCreated, persistence.EntityEventCreated, persistence.EntityEventDeleted},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/hook", captured.URL)
+	require.Equal(t, []string{persistence.EntityEventCreated, persistence.EntityEventDeleted}, captured.EventTypes)
+	require.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+	require.Equal(t, captured.Secret, created.Secret)
+	require.Equal(t, captured.WebhookID, created.ID)
+}
+
+func TestServiceListDeliveriesMapsNotFound(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepository{
+		listDeliveriesFn: func(context.Context, uuid.UUID, string, int, int) ([]persistence.WebhookDelivery, int, error) {
+			return nil, 0, persistence.ErrWebhookNotFound
+		},
+	}
+
+	svc := New(repo)
+	_, err := svc.ListDeliveries(context.Background(), requesttrace.Anonymous("test"), uuid.New(), DeliveryListOptions{})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestServiceListDeliveriesFiltersByStatus(t *testing.T) {
+	t.Parallel()
+
+	var gotStatus string
+	repo := &mockRepository{
+		listDeliveriesFn: func(_ context.Context, _ uuid.UUID, status string, _, _ int) ([]persistence.WebhookDelivery, int, error) {
+			gotStatus = status
+			return nil, 0, nil
+		},
+	}
+
+	svc := New(repo)
+	failed := persistence.WebhookDeliveryFailed
+	_, err := svc.ListDeliveries(context.Background(), requesttrace.Anonymous("test"), uuid.New(), DeliveryListOptions{Status: &failed})
+	require.NoError(t, err)
+	require.Equal(t, persistence.WebhookDeliveryFailed, gotStatus)
+
+	unknown := "bounced"
+	_, err = svc.ListDeliveries(context.Background(), requesttrace.Anonymous("test"), uuid.New(), DeliveryListOptions{Status: &unknown})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Contains(t, validationErr.Fields, "status")
+}
+
+func TestServiceGetDeliveryMapsExchange(t *testing.T) {
+	t.Parallel()
+
+	status := 502
+	attemptAt := time.Now().UTC()
+	repo := &mockRepository{
+		getDeliveryFn: func(_ context.Context, id, deliveryID uuid.UUID) (persistence.WebhookDeliveryDetail, error) {
+			return persistence.WebhookDeliveryDetail{
+				WebhookDelivery: persistence.WebhookDelivery{
+					DeliveryID:     deliveryID,
+					WebhookID:      id,
+					Status:         persistence.WebhookDeliveryFailed,
+					LastStatusCode: &status,
+				},
+				LastAttemptAt: &attemptAt,
+				Exchange: &persistence.WebhookExchange{
+					RequestHeaders:  map[string]string{HeaderEvent: persistence.EntityEventCreated},
+					RequestBody:     []byte(`{"id":"1"}`),
+					ResponseHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+					ResponseBody:    []byte{'o', 'k', 0xff},
+					Duration:        time.Second,
+				},
+			}, nil
+		},
+	}
+
+	svc := New(repo)
+	detail, err := svc.GetDelivery(context.Background(), requesttrace.Anonymous("test"), uuid.New(), uuid.New())
+	require.NoError(t, err)
+	require.Equal(t, `{"id":"1"}`, detail.Exchange.RequestBody)
+	require.Equal(t, 502, detail.Exchange.Response.StatusCode)
+	require.Equal(t, "ok\uFFFD", detail.Exchange.Response.Body)
+	require.Nil(t, detail.NextAttemptAt)
+
+	repo.getDeliveryFn = func(context.Context, uuid.UUID, uuid.UUID) (persistence.WebhookDeliveryDetail, error) {
+		return persistence.WebhookDeliveryDetail{}, persistence.ErrWebhookDeliveryNotFound
+	}
+	_, err = svc.GetDelivery(context.Background(), requesttrace.Anonymous("test"), uuid.New(), uuid.New())
+	require.ErrorIs(t, err, ErrDeliveryNotFound)
+}
+
+func TestServiceRedeliverRecordsRequester(t *testing.T) {
+	t.Parallel()
+
+	var gotRequestedBy *string
+	repo := &mockRepository{
+		redeliverFn: func(_ context.Context, id, deliveryID uuid.UUID, requestedBy *string) (persistence.WebhookDelivery, error) {
+			gotRequestedBy = requestedBy
+			return persistence.WebhookDelivery{DeliveryID: deliveryID, WebhookID: id, Status: persistence.WebhookDeliveryPending}, nil
+		},
+	}
+
+	svc := New(repo)
+	userID := "user-1"
+	audit := requesttrace.AuditInfo{UserID: &userID}
+	delivery, err := svc.Redeliver(context.Background(), audit, uuid.New(), uuid.New())
+	require.NoError(t, err)
+	require.Equal(t, persistence.WebhookDeliveryPending, delivery.Status)
+	require.NotNil(t, delivery.NextAttemptAt)
+	require.Equal(t, &userID, gotRequestedBy)
+
+	repo.redeliverFn = func(context.Context, uuid.UUID, uuid.UUID, *string) (persistence.WebhookDelivery, error) {
+		return persistence.WebhookDelivery{}, persistence.ErrWebhookDeliveryPending
+	}
+	_, err = svc.Redeliver(context.Background(), audit, uuid.New(), uuid.New())
+	require.ErrorIs(t, err, ErrDeliveryPending)
+}
+
+func TestServiceRotateSecretReturnsNewSecret(t *testing.T) {
+	t.Parallel()
+
+	var stored string
+	repo := &mockRepository{
+		rotateSecretFn: func(_ context.Context, id uuid.UUID, secret string) (persistence.WebhookSubscription, error) {
+			stored = secret
+			return persistence.WebhookSubscription{WebhookID: id, Secret: secret}, nil
+		},
+	}
+
+	svc := New(repo)
+	rotated, err := svc.RotateSecret(context.Background(), requesttrace.Anonymous("test"), uuid.New())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(rotated.Secret, "whsec_"))
+	require.Equal(t, stored, rotated.Secret)
+
+	repo.rotateSecretFn = func(context.Context, uuid.UUID, string) (persistence.WebhookSubscription, error) {
+		return persistence.WebhookSubscription{}, persistence.ErrWebhookNotFound
+	}
+	_, err = svc.RotateSecret(context.Background(), requesttrace.Anonymous("test"), uuid.New())
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -36,6 +36,7 @@ log "bootstrap platform (admin tenant: ${ADMIN_SLUG})"
 
 log "upsert demo category (${CATEGORY_ID})"
 /usr/local/bin/cli-platform-admin schema categories upsert \
+  --direct \
   --database-url "${DB_URL}" \
   --env-key "${ENV_KEY_VAL}" \
   --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -46,6 +47,7 @@ log "upsert demo category (${CATEGORY_ID})"
 
 log "upsert persons schema (${SCHEMA_ID} v${SCHEMA_VERSION})"
 /usr/local/bin/cli-platform-admin schema definitions upsert \
+  --direct \
   --database-url "${DB_URL}" \
   --env-key "${ENV_KEY_VAL}" \
   --admin-tenant-slug "${ADMIN_SLUG}" \
@@ -58,6 +60,7 @@ log "upsert persons schema (${SCHEMA_ID} v${SCHEMA_VERSION})"
 
 log "create demo tenant (${DEMO_TENANT_SLUG})"
 /usr/local/bin/cli-platform-admin tenant create \
+  --direct \
   --database-url "${DB_URL}" \
   --env-key "${ENV_KEY_VAL}" \
   --tenant-slug "${DEMO_TENANT_SLUG}" \
@@ -0,0 +1,725 @@
+// Package attachments provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AttachmentsList request
+	AttachmentsList(ctx context.Context, tableName TableName, entityId EntityId, params *AttachmentsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AttachmentsCreateWithBody request with any body
+	AttachmentsCreateWithBody(ctx context.Context, tableName TableName, entityId EntityId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AttachmentsCreate(ctx context.Context, tableName TableName, entityId EntityId, body AttachmentsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AttachmentsDelete request
+	AttachmentsDelete(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AttachmentsGet request
+	AttachmentsGet(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AttachmentsList(ctx context.Context, tableName TableName, entityId EntityId, params *AttachmentsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachmentsListRequest(c.Server, tableName, entityId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachmentsCreateWithBody(ctx context.Context, tableName TableName, entityId EntityId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachmentsCreateRequestWithBody(c.Server, tableName, entityId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachmentsCreate(ctx context.Context, tableName TableName, entityId EntityId, body AttachmentsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachmentsCreateRequest(c.Server, tableName, entityId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachmentsDelete(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachmentsDeleteRequest(c.Server, tableName, entityId, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AttachmentsGet(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachmentsGetRequest(c.Server, tableName, entityId, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAttachmentsListRequest generates requests for AttachmentsList
+func NewAttachmentsListRequest(server string, tableName TableName, entityId EntityId, params *AttachmentsListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/attachments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAttachmentsCreateRequest calls the generic AttachmentsCreate builder with application/json body
+func NewAttachmentsCreateRequest(server string, tableName TableName, entityId EntityId, body AttachmentsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAttachmentsCreateRequestWithBody(server, tableName, entityId, "application/json", bodyReader)
+}
+
+// NewAttachmentsCreateRequestWithBody generates requests for AttachmentsCreate with any type of body
+func NewAttachmentsCreateRequestWithBody(server string, tableName TableName, entityId EntityId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/attachments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAttachmentsDeleteRequest generates requests for AttachmentsDelete
+func NewAttachmentsDeleteRequest(server string, tableName TableName, entityId EntityId, attachmentId AttachmentId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAttachmentsGetRequest generates requests for AttachmentsGet
+func NewAttachmentsGetRequest(server string, tableName TableName, entityId EntityId, attachmentId AttachmentId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AttachmentsListWithResponse request
+	AttachmentsListWithResponse(ctx context.Context, tableName TableName, entityId EntityId, params *AttachmentsListParams, reqEditors ...RequestEditorFn) (*AttachmentsListResponse, error)
+
+	// AttachmentsCreateWithBodyWithResponse request with any body
+	AttachmentsCreateWithBodyWithResponse(ctx context.Context, tableName TableName, entityId EntityId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachmentsCreateResponse, error)
+
+	AttachmentsCreateWithResponse(ctx context.Context, tableName TableName, entityId EntityId, body AttachmentsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachmentsCreateResponse, error)
+
+	// AttachmentsDeleteWithResponse request
+	AttachmentsDeleteWithResponse(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*AttachmentsDeleteResponse, error)
+
+	// AttachmentsGetWithResponse request
+	AttachmentsGetWithResponse(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*AttachmentsGetResponse, error)
+}
+
+type AttachmentsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items      []Attachment `json:"items"`
+		Page       int          `json:"page"`
+		PageSize   int          `json:"pageSize"`
+		TotalItems int          `json:"totalItems"`
+		TotalPages int          `json:"totalPages"`
+	}
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachmentsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachmentsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachmentsCreateResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *AttachmentUpload
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachmentsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachmentsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachmentsDeleteResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachmentsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachmentsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachmentsGetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AttachmentDownload
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachmentsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachmentsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AttachmentsListWithResponse request returning *AttachmentsListResponse
+func (c *ClientWithResponses) AttachmentsListWithResponse(ctx context.Context, tableName TableName, entityId EntityId, params *AttachmentsListParams, reqEditors ...RequestEditorFn) (*AttachmentsListResponse, error) {
+	rsp, err := c.AttachmentsList(ctx, tableName, entityId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachmentsListResponse(rsp)
+}
+
+// AttachmentsCreateWithBodyWithResponse request with arbitrary body returning *AttachmentsCreateResponse
+func (c *ClientWithResponses) AttachmentsCreateWithBodyWithResponse(ctx context.Context, tableName TableName, entityId EntityId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachmentsCreateResponse, error) {
+	rsp, err := c.AttachmentsCreateWithBody(ctx, tableName, entityId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachmentsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) AttachmentsCreateWithResponse(ctx context.Context, tableName TableName, entityId EntityId, body AttachmentsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachmentsCreateResponse, error) {
+	rsp, err := c.AttachmentsCreate(ctx, tableName, entityId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachmentsCreateResponse(rsp)
+}
+
+// AttachmentsDeleteWithResponse request returning *AttachmentsDeleteResponse
+func (c *ClientWithResponses) AttachmentsDeleteWithResponse(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*AttachmentsDeleteResponse, error) {
+	rsp, err := c.AttachmentsDelete(ctx, tableName, entityId, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachmentsDeleteResponse(rsp)
+}
+
+// AttachmentsGetWithResponse request returning *AttachmentsGetResponse
+func (c *ClientWithResponses) AttachmentsGetWithResponse(ctx context.Context, tableName TableName, entityId EntityId, attachmentId AttachmentId, reqEditors ...RequestEditorFn) (*AttachmentsGetResponse, error) {
+	rsp, err := c.AttachmentsGet(ctx, tableName, entityId, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachmentsGetResponse(rsp)
+}
+
+// ParseAttachmentsListResponse parses an HTTP response from a AttachmentsListWithResponse call
+func ParseAttachmentsListResponse(rsp *http.Response) (*AttachmentsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachmentsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items      []Attachment `json:"items"`
+			Page       int          `json:"page"`
+			PageSize   int          `json:"pageSize"`
+			TotalItems int          `json:"totalItems"`
+			TotalPages int          `json:"totalPages"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachmentsCreateResponse parses an HTTP response from a AttachmentsCreateWithResponse call
+func ParseAttachmentsCreateResponse(rsp *http.Response) (*AttachmentsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachmentsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AttachmentUpload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachmentsDeleteResponse parses an HTTP response from a AttachmentsDeleteWithResponse call
+func ParseAttachmentsDeleteResponse(rsp *http.Response) (*AttachmentsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachmentsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachmentsGetResponse parses an HTTP response from a AttachmentsGetWithResponse call
+func ParseAttachmentsGetResponse(rsp *http.Response) (*AttachmentsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachmentsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AttachmentDownload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,961 @@
+// Package attachments provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package attachments
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	externalRef0 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/iam"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/pagination"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// AttachmentId RFC 4122 UUID string
+	AttachmentId externalRef2.UUID `json:"attachmentId"`
+	ContentType  string            `json:"contentType"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion externalRef2.SemanticVersion `json:"entityVersion"`
+	FileName      string                       `json:"fileName"`
+
+	// SizeBytes Size declared when the upload was created.
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+}
+
+// AttachmentDownload defines model for AttachmentDownload.
+type AttachmentDownload struct {
+	// AttachmentId RFC 4122 UUID string
+	AttachmentId externalRef2.UUID `json:"attachmentId"`
+	ContentType  string            `json:"contentType"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// DownloadUrl Signed URL serving the file until expiresAt.
+	DownloadUrl string `json:"downloadUrl"`
+
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion externalRef2.SemanticVersion `json:"entityVersion"`
+
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt externalRef2.Timestamp `json:"expiresAt"`
+	FileName  string                 `json:"fileName"`
+
+	// SizeBytes Size declared when the upload was created.
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+}
+
+// AttachmentUpload defines model for AttachmentUpload.
+type AttachmentUpload struct {
+	// AttachmentId RFC 4122 UUID string
+	AttachmentId externalRef2.UUID `json:"attachmentId"`
+	ContentType  string            `json:"contentType"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion externalRef2.SemanticVersion `json:"entityVersion"`
+
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt externalRef2.Timestamp `json:"expiresAt"`
+	FileName  string                 `json:"fileName"`
+
+	// SizeBytes Size declared when the upload was created.
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+
+	// UploadUrl Signed URL accepting a single PUT of the file.
+	UploadUrl string `json:"uploadUrl"`
+}
+
+// CreateAttachment defines model for CreateAttachment.
+type CreateAttachment struct {
+	// ContentType MIME type of the file; must be allowed by the deployment (images and PDFs by default).
+	ContentType string `json:"contentType"`
+
+	// FileName Original file name, without directories.
+	FileName string `json:"fileName"`
+
+	// SizeBytes Size of the file in bytes; must not exceed the deployment limit.
+	SizeBytes int64 `json:"sizeBytes"`
+}
+
+// AttachmentId RFC 4122 UUID string
+type AttachmentId = externalRef2.UUID
+
+// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+type EntityId = externalRef2.EntityIdentifier
+
+// TableName Lowercase snake_case PostgreSQL table identifier
+type TableName = externalRef2.TableName
+
+// AttachmentsListParams defines parameters for AttachmentsList.
+type AttachmentsListParams struct {
+	// Page 1-indexed page number
+	Page *externalRef1.Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Number of items per page (max 100)
+	PageSize *externalRef1.PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// AttachmentsCreateJSONRequestBody defines body for AttachmentsCreate for application/json ContentType.
+type AttachmentsCreateJSONRequestBody = CreateAttachment
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the attachments of a document
+	// (GET /entities/{tableName}/documents/{entityId}/attachments)
+	AttachmentsList(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, params AttachmentsListParams)
+	// Create an upload for a new attachment
+	// (POST /entities/{tableName}/documents/{entityId}/attachments)
+	AttachmentsCreate(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId)
+	// Remove an attachment
+	// (DELETE /entities/{tableName}/documents/{entityId}/attachments/{attachmentId})
+	AttachmentsDelete(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, attachmentId AttachmentId)
+	// Get an attachment with a download URL
+	// (GET /entities/{tableName}/documents/{entityId}/attachments/{attachmentId})
+	AttachmentsGet(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, attachmentId AttachmentId)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List the attachments of a document
+// (GET /entities/{tableName}/documents/{entityId}/attachments)
+func (_ Unimplemented) AttachmentsList(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, params AttachmentsListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an upload for a new attachment
+// (POST /entities/{tableName}/documents/{entityId}/attachments)
+func (_ Unimplemented) AttachmentsCreate(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove an attachment
+// (DELETE /entities/{tableName}/documents/{entityId}/attachments/{attachmentId})
+func (_ Unimplemented) AttachmentsDelete(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, attachmentId AttachmentId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an attachment with a download URL
+// (GET /entities/{tableName}/documents/{entityId}/attachments/{attachmentId})
+func (_ Unimplemented) AttachmentsGet(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, attachmentId AttachmentId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// AttachmentsList operation middleware
+func (siw *ServerInterfaceWrapper) AttachmentsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AttachmentsListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachmentsList(w, r, tableName, entityId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttachmentsCreate operation middleware
+func (siw *ServerInterfaceWrapper) AttachmentsCreate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachmentsCreate(w, r, tableName, entityId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttachmentsDelete operation middleware
+func (siw *ServerInterfaceWrapper) AttachmentsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachmentsDelete(w, r, tableName, entityId, attachmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttachmentsGet operation middleware
+func (siw *ServerInterfaceWrapper) AttachmentsGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachmentsGet(w, r, tableName, entityId, attachmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents/{entityId}/attachments", wrapper.AttachmentsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/entities/{tableName}/documents/{entityId}/attachments", wrapper.AttachmentsCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/entities/{tableName}/documents/{entityId}/attachments/{attachmentId}", wrapper.AttachmentsDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents/{entityId}/attachments/{attachmentId}", wrapper.AttachmentsGet)
+	})
+
+	return r
+}
+
+type AttachmentsListRequestObject struct {
+	TableName TableName `json:"tableName"`
+	EntityId  EntityId  `json:"entityId"`
+	Params    AttachmentsListParams
+}
+
+type AttachmentsListResponseObject interface {
+	VisitAttachmentsListResponse(w http.ResponseWriter) error
+}
+
+type AttachmentsList200JSONResponse struct {
+	Items      []Attachment `json:"items"`
+	Page       int          `json:"page"`
+	PageSize   int          `json:"pageSize"`
+	TotalItems int          `json:"totalItems"`
+	TotalPages int          `json:"totalPages"`
+}
+
+func (response AttachmentsList200JSONResponse) VisitAttachmentsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AttachmentsListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response AttachmentsListdefaultApplicationProblemPlusJSONResponse) VisitAttachmentsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AttachmentsCreateRequestObject struct {
+	TableName TableName `json:"tableName"`
+	EntityId  EntityId  `json:"entityId"`
+	Body      *AttachmentsCreateJSONRequestBody
+}
+
+type AttachmentsCreateResponseObject interface {
+	VisitAttachmentsCreateResponse(w http.ResponseWriter) error
+}
+
+type AttachmentsCreate201ResponseHeaders struct {
+	Location string
+}
+
+type AttachmentsCreate201JSONResponse struct {
+	Body    AttachmentUpload
+	Headers AttachmentsCreate201ResponseHeaders
+}
+
+func (response AttachmentsCreate201JSONResponse) VisitAttachmentsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AttachmentsCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response AttachmentsCreatedefaultApplicationProblemPlusJSONResponse) VisitAttachmentsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AttachmentsDeleteRequestObject struct {
+	TableName    TableName    `json:"tableName"`
+	EntityId     EntityId     `json:"entityId"`
+	AttachmentId AttachmentId `json:"attachmentId"`
+}
+
+type AttachmentsDeleteResponseObject interface {
+	VisitAttachmentsDeleteResponse(w http.ResponseWriter) error
+}
+
+type AttachmentsDelete204Response struct {
+}
+
+func (response AttachmentsDelete204Response) VisitAttachmentsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AttachmentsDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response AttachmentsDeletedefaultApplicationProblemPlusJSONResponse) VisitAttachmentsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AttachmentsGetRequestObject struct {
+	TableName    TableName    `json:"tableName"`
+	EntityId     EntityId     `json:"entityId"`
+	AttachmentId AttachmentId `json:"attachmentId"`
+}
+
+type AttachmentsGetResponseObject interface {
+	VisitAttachmentsGetResponse(w http.ResponseWriter) error
+}
+
+type AttachmentsGet200JSONResponse AttachmentDownload
+
+func (response AttachmentsGet200JSONResponse) VisitAttachmentsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AttachmentsGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response AttachmentsGetdefaultApplicationProblemPlusJSONResponse) VisitAttachmentsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the attachments of a document
+	// (GET /entities/{tableName}/documents/{entityId}/attachments)
+	AttachmentsList(ctx context.Context, request AttachmentsListRequestObject) (AttachmentsListResponseObject, error)
+	// Create an upload for a new attachment
+	// (POST /entities/{tableName}/documents/{entityId}/attachments)
+	AttachmentsCreate(ctx context.Context, request AttachmentsCreateRequestObject) (AttachmentsCreateResponseObject, error)
+	// Remove an attachment
+	// (DELETE /entities/{tableName}/documents/{entityId}/attachments/{attachmentId})
+	AttachmentsDelete(ctx context.Context, request AttachmentsDeleteRequestObject) (AttachmentsDeleteResponseObject, error)
+	// Get an attachment with a download URL
+	// (GET /entities/{tableName}/documents/{entityId}/attachments/{attachmentId})
+	AttachmentsGet(ctx context.Context, request AttachmentsGetRequestObject) (AttachmentsGetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AttachmentsList operation middleware
+func (sh *strictHandler) AttachmentsList(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, params AttachmentsListParams) {
+	var request AttachmentsListRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AttachmentsList(ctx, request.(AttachmentsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AttachmentsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AttachmentsListResponseObject); ok {
+		if err := validResponse.VisitAttachmentsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AttachmentsCreate operation middleware
+func (sh *strictHandler) AttachmentsCreate(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId) {
+	var request AttachmentsCreateRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+
+	var body AttachmentsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AttachmentsCreate(ctx, request.(AttachmentsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AttachmentsCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AttachmentsCreateResponseObject); ok {
+		if err := validResponse.VisitAttachmentsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AttachmentsDelete operation middleware
+func (sh *strictHandler) AttachmentsDelete(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, attachmentId AttachmentId) {
+	var request AttachmentsDeleteRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AttachmentsDelete(ctx, request.(AttachmentsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AttachmentsDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AttachmentsDeleteResponseObject); ok {
+		if err := validResponse.VisitAttachmentsDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AttachmentsGet operation middleware
+func (sh *strictHandler) AttachmentsGet(w http.ResponseWriter, r *http.Request, tableName TableName, entityId EntityId, attachmentId AttachmentId) {
+	var request AttachmentsGetRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AttachmentsGet(ctx, request.(AttachmentsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AttachmentsGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AttachmentsGetResponseObject); ok {
+		if err := validResponse.VisitAttachmentsGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9RZ3XLbuPV/lTP478x/06UkSnZ2U+XKaydbzzgbNbF70US1YfJIQkIAXOBQtuLhTJ+j",
+	"N33FPkIH4IcoipZlJzOd3Nj8AA9+5/uHozsWaZlqhYosG9+xlBsukdD4uyMiHi0kKjqN3b1QbMxSTgsW",
+	"MMUlsjHjzSUBM/hHJgzGbEwmw4DZaIGSu29/MDhjY/Z/g/WGg+KtdY+kVpepEVKQWKK9vLg4PWF5HrBX",
+	"igSt7t0eq9ffbutqRyd6JtB4GOf8OsHf/Z6dOKh+/+2ArPd0CKr3fC4UJ1Fcejgx2siI1D1jYzbsCRXj",
+	"Lcbg3oPK5DUaFhSg/8jQrNaovYQmwBhnPEuIjYcBk0IJmUl/TavUrReKcI5mB5734ksHpt89CNAzEITS",
+	"QoqmQPej5LcwDMNnOwB6kZ0gR2HAJL8tUYbhA5jzSkgrtt1danSKhgT6d7wV90+JXWchRajo3OO4q/BY",
+	"MkLN/XuDnDA+oieEhpBoicvUycFGinxtqFfS/obGet89VuR7lFyRiCoBecBmYp06kt+eoZrTgo1Hz597",
+	"h1X3w2DbRFZ8wV9XhHY7plxYQIxRwg3GcLNABbRAyNJE8xhuuIXSvn0WsJk2klMRDT8fskaghNuBEjSy",
+	"+SuTdl0KPrQrZbNiNKrYpvkbxtuMp6ZpmpE0rdXR158wIqfNOtJP9I1y5vExniRvZ2z8YbeC629ZHrTT",
+	"JC7FXZikyz9zhTFcvDsDi2Yp1Nz7x+kDmSKRAN6mwqA9og0PZUawjkioF39VsrRc0lSgucW2FacbdrxI",
+	"v6EVv41mAcvSSpVdvuBRhCk5b3CwQs0ThMnFuSvNlXce9kbLilm6vw2PfaTuqr2tqrmpyJvTN6/AiW0C",
+	"fgkyswTXCDxJ9A3GcL3yL2NME71y28CPQvI5WuAqhsnJa+uWlH3kmVN4R1066IjGZknbRPjWCNcQkyLQ",
+	"FZcYwI2ghc4IYmEwIm0E2gf2fEotbFgEhIJrt7Q0jdIEeBshxm27JC6WHl0hWwGwR43qqkvbDGJSX75B",
+	"4tuxUTGeXW0+YE0esj89CBhp4skpobQbe4T3rp24kHpobctWJeVqEJvGthtyd5lsRwvfCo7jRKCins3S",
+	"NBEYg6jXwkwbEFJmvhdB0XrAYKRNbPtw5EuFy5kVRAtueERoLFxnVCec0qqHMqWVTyxOILUlGI5eND/g",
+	"M0IDZISUQs37vkpwmSbOdh/Y8dG7k14YhkNnFaNdLNk+T9IF95xwiYq0WY0Foewdjtyz2CcU2JRHvvuh",
+	"1J9E7z///tc/2XQjq4ajFw9m1cMMZjvbygWwLFZAIc0lneSftOlLobTpp5yiBZR5tanzsB/2QxawUf+g",
+	"/9yBTjkRGif8Hx8/xj99/Nhv/PuB7YV744iyifhM36CJuEWwin/GS3850ZbmBt//9QwK/68DowU34ia2",
+	"l+6lT8SAZRbNZeWsFv4PvPdl6v6EvT9fTv+0L/i6k22BP33/Fl78HA6BqjXO0hfnxy2Uo3D0vDcMe8OD",
+	"8+Hh+CAch+HfHba6ssWcsOeE7AfJ0/gtNO9eH8PhcDQC97r0/EbDzES8U76+TlDGSFwk9nJS3J4Ut927",
+	"/fIi/AXKhVCtDNpUzD/fFnAEi0xy1TPI4yLJb9OEFzUWbIqRmIkISAMthAUdRZkxqKK6m5R4uzRCY3Qx",
+	"JuBxLJxAnkw2QImqlm59Wz7gxvCVu2/10LSQBpKnDshMYBL3ElxiAkueiLiAXwLoKJNCWeIqwi57XLw7",
+	"BYMzLNSkBad14Fuvc22WR5nDEqesw4XnC4S/nJ9PoFgAkY6RbbeJgJGgpBOxXWhDQduRNpOSm1ULmSdH",
+	"XfBolT7RHC3Jj6KGhU61cbZbWu69NdMd0GqOaCHWkgsFjly4pjL2JMdWpC7wjC6Afr//DIpTliM6umpo",
+	"sY4yL6cPrx058swIFC79FMI6LY3O5guv7dHk9CVEvmXa6jDpelt1VHCLZMnkkhXMjJb+O0LFFf2/BUva",
+	"uNFGanAmbmvZ3om9RCwxBlvTcRvAzcJhKreGz4hpYXaJxGNOHBKhPhf6uMelTqJAVXYg11PL8GETnsiV",
+	"4a5iOIksYMuqkbHl0IWCTlHxVLAxO+iH/UPPRmjhQ3dQFfnBXX0+zQe1AQd31Uk1H6yPs/7LOVJH+ULK",
+	"jCr0aayvYraSG4DCG7QEM2GsZ6OuivgkP403Q+FMWGLBxpjynrPXesngntFZHjzxS0/c8qkLdZtqZYtq",
+	"NwrDxvnFXXLHuCL/3eCTLajEeoy1Pjqm3VWzvtj7aNmqq61kLOR10Mr9jp330vR86tN40/WOw8aQCEvO",
+	"281g8UvLCd695iqLzk/bZtvrhLyryXaAfeU6CfxYddtn3nRlgXX0yWnREcO8jmA/z5l7GtIIVjbNHxuq",
+	"jfHRw9FZz8ZdLKbadmagJ/Mt9MDn3PXI4nHk+E5NZlu56cuMKfOYN0pXH4opyPrQ6Vl5PVW4mlycX7mq",
+	"dVXPB64CsKhix5Wvjgu/99xJcQwfszA8iBqHR/8ArwK4xpk2CFf1YOFqZ30o5gvlEB4t/arj1aOycld4",
+	"bQ0v8jxvj/vzraow/Gb7bw2gOkJ5vaY8yGH8supjbv4jrM3QMdQF8rj8medMF3C2w8d9UQZEOWNsRpFB",
+	"qzMTbU7n24Qg//7yvfAzcFUZzp2TuWtSDe3vTfk8eGIbHdw1R8R54Y0ECbvSWuplRVdJuwm4T0HSc6QF",
+	"miIXBdmaRuzMmpNim63QPdxFy8B4EPF3WNAL8zkH7+HPoOI295rvNyT2lWRgv7Sv5/e7E3+mM/U9uuU3",
+	"pE2fVC2lJt8X787+16324bUbv5rnviBYjDIjaOVxXSM3aI4yWrDxh6nr3e4Hkgp15gb4bMBTMXB0fVqr",
+	"287E1/4MtOu8s/4dtWmpfJr/dwBPT3np9h8AAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/iam.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef1.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/pagination.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef2.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/primitives.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef3.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/problemdetails.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
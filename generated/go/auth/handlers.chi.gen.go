@@ -17,6 +17,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 	externalRef0 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/iam"
 	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
@@ -28,6 +29,17 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ServiceTokenResponseTokenType.
+const (
+	Bearer ServiceTokenResponseTokenType = "Bearer"
+)
+
+// Defines values for TokenRevocationKind.
+const (
+	TokenRevocationKindToken TokenRevocationKind = "token"
+	TokenRevocationKindUser  TokenRevocationKind = "user"
+)
+
 // AccessTokenResponse defines model for AccessTokenResponse.
 type AccessTokenResponse struct {
 	AccessToken  string             `json:"accessToken"`
@@ -35,6 +47,17 @@ type AccessTokenResponse struct {
 	User         *externalRef3.User `json:"user,omitempty"`
 }
 
+// CreateTokenRevocation Exactly one of userId or tokenId.
+type CreateTokenRevocation struct {
+	Reason *string `json:"reason,omitempty"`
+
+	// TokenId The jti of the token to revoke.
+	TokenId *string `json:"tokenId,omitempty"`
+
+	// UserId Token subject (uid) whose tokens are revoked.
+	UserId *string `json:"userId,omitempty"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	// Email Email address per RFC 5322 (simplified)
@@ -47,6 +70,27 @@ type RefreshRequest struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+// ServiceTokenRequest defines model for ServiceTokenRequest.
+type ServiceTokenRequest struct {
+	// Key The service account key returned when the account was created.
+	Key string `json:"key"`
+}
+
+// ServiceTokenResponse defines model for ServiceTokenResponse.
+type ServiceTokenResponse struct {
+	AccessToken string `json:"accessToken"`
+
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt externalRef1.Timestamp `json:"expiresAt"`
+
+	// ExpiresIn Seconds until the token expires.
+	ExpiresIn int                           `json:"expiresIn"`
+	TokenType ServiceTokenResponseTokenType `json:"tokenType"`
+}
+
+// ServiceTokenResponseTokenType defines model for ServiceTokenResponse.TokenType.
+type ServiceTokenResponseTokenType string
+
 // SignupRequest defines model for SignupRequest.
 type SignupRequest struct {
 	// Email Email address per RFC 5322 (simplified)
@@ -55,6 +99,61 @@ type SignupRequest struct {
 	Password string             `json:"password"`
 }
 
+// TenantMembership A tenant the caller can switch to
+type TenantMembership struct {
+	// Current True for the tenant of the token.
+	Current     bool    `json:"current"`
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Roles Tenant roles held there. For the current tenant without a membership, the roles of the token.
+	Roles []string `json:"roles"`
+	Slug  string   `json:"slug"`
+
+	// Status Tenant lifecycle state, as in the tenants contract.
+	Status string `json:"status"`
+
+	// TenantId RFC 4122 UUID string
+	TenantId externalRef1.UUID `json:"tenantId"`
+
+	// UserId RFC 4122 UUID string
+	UserId *externalRef1.UUID `json:"userId,omitempty"`
+}
+
+// TenantMembershipList defines model for TenantMembershipList.
+type TenantMembershipList struct {
+	Items []TenantMembership `json:"items"`
+}
+
+// TokenRevocation defines model for TokenRevocation.
+type TokenRevocation struct {
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt externalRef1.Timestamp `json:"expiresAt"`
+
+	// Id RFC 4122 UUID string
+	Id externalRef1.UUID `json:"id"`
+
+	// Kind `user` revokes every token of the subject issued up to revokedAt; `token` revokes one jti.
+	Kind   TokenRevocationKind `json:"kind"`
+	Reason *string             `json:"reason,omitempty"`
+
+	// RevokedAt ISO 8601 timestamp in UTC
+	RevokedAt externalRef1.Timestamp `json:"revokedAt"`
+
+	// RevokedBy Subject of the admin who recorded the revocation.
+	RevokedBy *string `json:"revokedBy,omitempty"`
+
+	// Subject The token subject (uid) for `user`, the jti for `token`.
+	Subject string `json:"subject"`
+}
+
+// TokenRevocationKind `user` revokes every token of the subject issued up to revokedAt; `token` revokes one jti.
+type TokenRevocationKind string
+
+// TokenRevocationList defines model for TokenRevocationList.
+type TokenRevocationList struct {
+	Items []TokenRevocation `json:"items"`
+}
+
 // User Minimal user view returned by auth endpoints
 type User struct {
 	// CreatedAt ISO 8601 timestamp in UTC
@@ -78,9 +177,15 @@ type AuthLoginJSONRequestBody = LoginRequest
 // AuthRefreshJSONRequestBody defines body for AuthRefresh for application/json ContentType.
 type AuthRefreshJSONRequestBody = RefreshRequest
 
+// AuthRevocationsCreateJSONRequestBody defines body for AuthRevocationsCreate for application/json ContentType.
+type AuthRevocationsCreateJSONRequestBody = CreateTokenRevocation
+
 // AuthSignupJSONRequestBody defines body for AuthSignup for application/json ContentType.
 type AuthSignupJSONRequestBody = SignupRequest
 
+// AuthTokenJSONRequestBody defines body for AuthToken for application/json ContentType.
+type AuthTokenJSONRequestBody = ServiceTokenRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Log in with email and password
@@ -89,12 +194,27 @@ type ServerInterface interface {
 	// Log out current user
 	// (POST /auth/logout)
 	AuthLogout(w http.ResponseWriter, r *http.Request)
+	// List the caller's tenants
+	// (GET /auth/me/tenants)
+	AuthMeTenants(w http.ResponseWriter, r *http.Request)
 	// Refresh access token
 	// (POST /auth/refresh)
 	AuthRefresh(w http.ResponseWriter, r *http.Request)
+	// List token revocations
+	// (GET /auth/revocations)
+	AuthRevocationsList(w http.ResponseWriter, r *http.Request)
+	// Revoke tokens
+	// (POST /auth/revocations)
+	AuthRevocationsCreate(w http.ResponseWriter, r *http.Request)
+	// Clear a token revocation
+	// (DELETE /auth/revocations/{revocationId})
+	AuthRevocationsDelete(w http.ResponseWriter, r *http.Request, revocationId externalRef1.UUID)
 	// Sign up a new user
 	// (POST /auth/signup)
 	AuthSignup(w http.ResponseWriter, r *http.Request)
+	// Issue a service account token
+	// (POST /auth/token)
+	AuthToken(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -113,18 +233,48 @@ func (_ Unimplemented) AuthLogout(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the caller's tenants
+// (GET /auth/me/tenants)
+func (_ Unimplemented) AuthMeTenants(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Refresh access token
 // (POST /auth/refresh)
 func (_ Unimplemented) AuthRefresh(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List token revocations
+// (GET /auth/revocations)
+func (_ Unimplemented) AuthRevocationsList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke tokens
+// (POST /auth/revocations)
+func (_ Unimplemented) AuthRevocationsCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clear a token revocation
+// (DELETE /auth/revocations/{revocationId})
+func (_ Unimplemented) AuthRevocationsDelete(w http.ResponseWriter, r *http.Request, revocationId externalRef1.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Sign up a new user
 // (POST /auth/signup)
 func (_ Unimplemented) AuthSignup(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Issue a service account token
+// (POST /auth/token)
+func (_ Unimplemented) AuthToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -168,6 +318,26 @@ func (siw *ServerInterfaceWrapper) AuthLogout(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// AuthMeTenants operation middleware
+func (siw *ServerInterfaceWrapper) AuthMeTenants(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthMeTenants(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AuthRefresh operation middleware
 func (siw *ServerInterfaceWrapper) AuthRefresh(w http.ResponseWriter, r *http.Request) {
 
@@ -182,6 +352,77 @@ func (siw *ServerInterfaceWrapper) AuthRefresh(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// AuthRevocationsList operation middleware
+func (siw *ServerInterfaceWrapper) AuthRevocationsList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tokens:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRevocationsList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthRevocationsCreate operation middleware
+func (siw *ServerInterfaceWrapper) AuthRevocationsCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tokens:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRevocationsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthRevocationsDelete operation middleware
+func (siw *ServerInterfaceWrapper) AuthRevocationsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "revocationId" -------------
+	var revocationId externalRef1.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revocationId", chi.URLParam(r, "revocationId"), &revocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revocationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tokens:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRevocationsDelete(w, r, revocationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AuthSignup operation middleware
 func (siw *ServerInterfaceWrapper) AuthSignup(w http.ResponseWriter, r *http.Request) {
 
@@ -196,6 +437,20 @@ func (siw *ServerInterfaceWrapper) AuthSignup(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// AuthToken operation middleware
+func (siw *ServerInterfaceWrapper) AuthToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -315,12 +570,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/logout", wrapper.AuthLogout)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/me/tenants", wrapper.AuthMeTenants)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/refresh", wrapper.AuthRefresh)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/revocations", wrapper.AuthRevocationsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/revocations", wrapper.AuthRevocationsCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/revocations/{revocationId}", wrapper.AuthRevocationsDelete)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/signup", wrapper.AuthSignup)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/token", wrapper.AuthToken)
+	})
 
 	return r
 }
@@ -381,6 +651,34 @@ func (response AuthLogoutdefaultApplicationProblemPlusJSONResponse) VisitAuthLog
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type AuthMeTenantsRequestObject struct {
+}
+
+type AuthMeTenantsResponseObject interface {
+	VisitAuthMeTenantsResponse(w http.ResponseWriter) error
+}
+
+type AuthMeTenants200JSONResponse TenantMembershipList
+
+func (response AuthMeTenants200JSONResponse) VisitAuthMeTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthMeTenantsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response AuthMeTenantsdefaultApplicationProblemPlusJSONResponse) VisitAuthMeTenantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type AuthRefreshRequestObject struct {
 	Body *AuthRefreshJSONRequestBody
 }
@@ -410,6 +708,91 @@ func (response AuthRefreshdefaultApplicationProblemPlusJSONResponse) VisitAuthRe
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type AuthRevocationsListRequestObject struct {
+}
+
+type AuthRevocationsListResponseObject interface {
+	VisitAuthRevocationsListResponse(w http.ResponseWriter) error
+}
+
+type AuthRevocationsList200JSONResponse TokenRevocationList
+
+func (response AuthRevocationsList200JSONResponse) VisitAuthRevocationsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthRevocationsListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response AuthRevocationsListdefaultApplicationProblemPlusJSONResponse) VisitAuthRevocationsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthRevocationsCreateRequestObject struct {
+	Body *AuthRevocationsCreateJSONRequestBody
+}
+
+type AuthRevocationsCreateResponseObject interface {
+	VisitAuthRevocationsCreateResponse(w http.ResponseWriter) error
+}
+
+type AuthRevocationsCreate201JSONResponse TokenRevocation
+
+func (response AuthRevocationsCreate201JSONResponse) VisitAuthRevocationsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthRevocationsCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response AuthRevocationsCreatedefaultApplicationProblemPlusJSONResponse) VisitAuthRevocationsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthRevocationsDeleteRequestObject struct {
+	RevocationId externalRef1.UUID `json:"revocationId"`
+}
+
+type AuthRevocationsDeleteResponseObject interface {
+	VisitAuthRevocationsDeleteResponse(w http.ResponseWriter) error
+}
+
+type AuthRevocationsDelete204Response struct {
+}
+
+func (response AuthRevocationsDelete204Response) VisitAuthRevocationsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AuthRevocationsDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response AuthRevocationsDeletedefaultApplicationProblemPlusJSONResponse) VisitAuthRevocationsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type AuthSignupRequestObject struct {
 	Body *AuthSignupJSONRequestBody
 }
@@ -442,6 +825,35 @@ func (response AuthSignupdefaultApplicationProblemPlusJSONResponse) VisitAuthSig
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type AuthTokenRequestObject struct {
+	Body *AuthTokenJSONRequestBody
+}
+
+type AuthTokenResponseObject interface {
+	VisitAuthTokenResponse(w http.ResponseWriter) error
+}
+
+type AuthToken200JSONResponse ServiceTokenResponse
+
+func (response AuthToken200JSONResponse) VisitAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthTokendefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response AuthTokendefaultApplicationProblemPlusJSONResponse) VisitAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Log in with email and password
@@ -450,12 +862,27 @@ type StrictServerInterface interface {
 	// Log out current user
 	// (POST /auth/logout)
 	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
+	// List the caller's tenants
+	// (GET /auth/me/tenants)
+	AuthMeTenants(ctx context.Context, request AuthMeTenantsRequestObject) (AuthMeTenantsResponseObject, error)
 	// Refresh access token
 	// (POST /auth/refresh)
 	AuthRefresh(ctx context.Context, request AuthRefreshRequestObject) (AuthRefreshResponseObject, error)
+	// List token revocations
+	// (GET /auth/revocations)
+	AuthRevocationsList(ctx context.Context, request AuthRevocationsListRequestObject) (AuthRevocationsListResponseObject, error)
+	// Revoke tokens
+	// (POST /auth/revocations)
+	AuthRevocationsCreate(ctx context.Context, request AuthRevocationsCreateRequestObject) (AuthRevocationsCreateResponseObject, error)
+	// Clear a token revocation
+	// (DELETE /auth/revocations/{revocationId})
+	AuthRevocationsDelete(ctx context.Context, request AuthRevocationsDeleteRequestObject) (AuthRevocationsDeleteResponseObject, error)
 	// Sign up a new user
 	// (POST /auth/signup)
 	AuthSignup(ctx context.Context, request AuthSignupRequestObject) (AuthSignupResponseObject, error)
+	// Issue a service account token
+	// (POST /auth/token)
+	AuthToken(ctx context.Context, request AuthTokenRequestObject) (AuthTokenResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -542,6 +969,30 @@ func (sh *strictHandler) AuthLogout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AuthMeTenants operation middleware
+func (sh *strictHandler) AuthMeTenants(w http.ResponseWriter, r *http.Request) {
+	var request AuthMeTenantsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthMeTenants(ctx, request.(AuthMeTenantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthMeTenants")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthMeTenantsResponseObject); ok {
+		if err := validResponse.VisitAuthMeTenantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // AuthRefresh operation middleware
 func (sh *strictHandler) AuthRefresh(w http.ResponseWriter, r *http.Request) {
 	var request AuthRefreshRequestObject
@@ -573,6 +1024,87 @@ func (sh *strictHandler) AuthRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AuthRevocationsList operation middleware
+func (sh *strictHandler) AuthRevocationsList(w http.ResponseWriter, r *http.Request) {
+	var request AuthRevocationsListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthRevocationsList(ctx, request.(AuthRevocationsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthRevocationsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthRevocationsListResponseObject); ok {
+		if err := validResponse.VisitAuthRevocationsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthRevocationsCreate operation middleware
+func (sh *strictHandler) AuthRevocationsCreate(w http.ResponseWriter, r *http.Request) {
+	var request AuthRevocationsCreateRequestObject
+
+	var body AuthRevocationsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthRevocationsCreate(ctx, request.(AuthRevocationsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthRevocationsCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthRevocationsCreateResponseObject); ok {
+		if err := validResponse.VisitAuthRevocationsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthRevocationsDelete operation middleware
+func (sh *strictHandler) AuthRevocationsDelete(w http.ResponseWriter, r *http.Request, revocationId externalRef1.UUID) {
+	var request AuthRevocationsDeleteRequestObject
+
+	request.RevocationId = revocationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthRevocationsDelete(ctx, request.(AuthRevocationsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthRevocationsDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthRevocationsDeleteResponseObject); ok {
+		if err := validResponse.VisitAuthRevocationsDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // AuthSignup operation middleware
 func (sh *strictHandler) AuthSignup(w http.ResponseWriter, r *http.Request) {
 	var request AuthSignupRequestObject
@@ -604,36 +1136,89 @@ func (sh *strictHandler) AuthSignup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AuthToken operation middleware
+func (sh *strictHandler) AuthToken(w http.ResponseWriter, r *http.Request) {
+	var request AuthTokenRequestObject
+
+	var body AuthTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthToken(ctx, request.(AuthTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthTokenResponseObject); ok {
+		if err := validResponse.VisitAuthTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYeW/juBX/Kg9s/9hFfefYGRcFmmamrYLJsV5nJycCWny2mFCkQlJx1MDfvSAlH5Ll",
-	"iVNsCky7fwSIaPK9H3/v5gsJVZwoidIa0n8hJowwpv7fgzBEY4bqAeUATaKkQbecaJWgthz9Jrrc5D5t",
-	"liDpE2M1lxMyaxCNY40m2rwhNajdD3/UOCZ98of2Ek+7ANN2e8zduds58zIfU66Rkf51Sf9tYy5eje4x",
-	"tE78FzXhcoCPKRq7jh5jysVr2kMVx0reJZrH3PInNHef/bFZgyTUmKnSzIkYKx1TS/rLxUb1thXsufYV",
-	"KXUXGOQEbrzCKwRXVJZ216n7hU9kmrwbYeNUiBMaY60r/MZsrmiru+l54XgMTah5YrmSpE+OueQxFeBc",
-	"Dp44TkGjTbVEBqMMaGojQMkSxV28NCrshBqpRXZg387QkMdoLI0TB+1dWebs7ZLPz4NPPpqVyG/KLcZm",
-	"Szmcxj52B0qgE1IgolrTzGeAhP0GrFW8gTv7z11iwcf8Bo0VU60CqPOTulusuc1BkggeUvcFToVTLtPY",
-	"ZygWc0nyRHcXU0knqIvPFX1L+2yy65pOvwyUMY3GQIIaBn8/hL2dXg9+MDxOBB9zZD86KM80Trzlrr3e",
-	"vxYLrVDFDsMi2OaEbQFqSf0asOCXU/iw3+mCne8BLuF8eFiB0uv09prdTrO7M+zu9nc6/U7nqgTHmaXp",
-	"hGwHyXvpGhpHym631wP3MxTnV5SkKWfflK9GAmOGlnJh7s7yz0/5Z722nz50foJiI8x3VnNFLrDGkyBK",
-	"YyqbGimjI4GAz4mgMnctk2DIxzwEq8BG3IAKw1RrlCGCGoONEAq8dTdCrZXOqzZj3Amk4qwEahHWa2er",
-	"QVsGfZrk0iCmiQMy5ihYU+ATCniigrMcfgGgJsi4NJbKsC6y4HwQgMYx5te0EbXAGUrr3Nv4Oy9oeRMd",
-	"xlKb1phwGCH8czg8g3wDhIqtOCCXFl0EO064rc0FYCKlbaNqSJPGMdVZBRl4uY1NjP8ndFQkLz1d81er",
-	"aH6nBTl1CXGlG1vrD/7nK+B/tVhtW6RmPoLGqsZdXMPCVEy5hFBJq2lo+2B8k9cA4ZrjBhRNof9WqW1B",
-	"ICPU3BqYCDWiAo6+DmGEVKMGg2Gquc3+XAhpexntQgRQjZCkI8HDFlkECDmjIs40dTkRDs4C0iBPqE2O",
-	"8KnraFUJSppw0ic7rU5r17dxNvIu1XZNV67G+5vK+9JK2vVNmgEqIZ8IwLoWF6hk8+vlKy04phmMELSr",
-	"LcIZB5nD6rzYZ6mAFbz50YHklkJj/6ZY5l1cSYvSY6DLqt++N0ouB6jXvKM0lszK/mB1in4hn7k8Cb1O",
-	"Zwvdy+L6QuJUWK6LZqVIPS6BL+clmHIbQUIzoShr+cYI/gLXNz7Ab0gDbko9yw25dYajIsW1wY9gdhSN",
-	"/hHyU34UnP8r6J7wwARysBceBvvBQ3Lx6+HRxxZmR9mo9yzCLNj/mh11w96vWcCnnF2ciFB8tJdf96Ir",
-	"tyY6JohFxA6D/ePh5d7xp4Op/zuc8quLaBrcq+eT+we35n8b/9wyweSkKS7U5d7jke0OenpHdtPTHf7h",
-	"YU9ejn7+yK+SzsVjd9rDA1IdSonxrDWL1ab3k9wo2xmzblD2x9dj0eXpok00qT82TkVeT8c0FfYbRi6y",
-	"+p/e5mhbdTE1YD+7Ug0/zNuZH33Omgc/6V/fNlac6ouauA7P+xPmXalksDq50Ynv+BwF5NaJWkS1Su3m",
-	"sA5k0T0UlS2v7raI8XYpsk0LBnkMGT+nLaneGN5O91qg7a7D+KImE2SgUrtiNZF9P3YrWcpdY06kn0I2",
-	"26cgeLOBPj+HEZUTBFpOszBWGihInJby8Ruyb/Hu8U75t/Kq8nsG/r/IwCcVdwRuTIrsu8+/g3n3tXK3",
-	"b0R13rltDupD33YCzR/BaBiqVNoWnKFkXE6AJolWT1TAVOmHsVBTiGnmVkVWH8r5m+I7RXL5wXKrQO6+",
-	"SXl5wNnmubr2obry3rPaupfZd6eh6Py/e8901oE0KUrBhmpTPv9C8lHD/9q/vp05gajdxOB/TbUgfdKm",
-	"CW+72eF2Ie+FSD/x5XJnt7N/BwAA//9TL86L3BgAAA==",
+	"H4sIAAAAAAAC/+xafW/bONL/KgM9D3AtTnbstOnuenHApWn3TkGbZlNn+7INGloaW2woUiUpu74g3/3A",
+	"F8myTSdu0hzQ3v1RNJKpmeHMb17JyygVRSk4cq2iwWWk0hwLYv/cT1NUaigukJ+gKgVXaF6XUpQoNUW7",
+	"iCwWmUc9LzEaREpLyifRVRxJHEtU+eYFlUJpfvh/ieNoEP3fzkKeHS/MjlmjPp6alVeW5ueKSsyiwZ9L",
+	"/M/imrwYfcJUG/IHEolGv4mpSImmwgqSoUolLd1j9PwLSTWbg+AIYgyGYZKBkKDNl0nWjeKVjUskylEq",
+	"yJcXyCc6jwZ7vV68vkVPY53rMEf4pKnhqHN0vEALkDgVF2h4FpTXtPtxWHlBwpaSqqwa4EFFs4cwy4Xy",
+	"PBQQiZ5LdiObq4BaX4gJ5Sf4uUKl10GBBaHsJqOmoigE/1hKWlBNp6g+PrefXcVRSZSaCWk3NhayIDoa",
+	"LF6GBGxDwnFvUQnh4sThcuMWbsDtCsul1SF2r1FOaVrjcAPPC5yHMaLc10DSVFRcwwXOQaKuJMcMZrkB",
+	"Tb74dUYUpBb21rbXS2543izwbb0fv5RUotrXXw+GIS1QaVKULTpJwHNfYyp4pqDimrKWG/lPWhqgXOME",
+	"ZeORQ/v6MkJeFUYVT5FIlC1tbFBZe9NtUm0x21sPqpdOeFXemwONK8aOSIFBo3xj72pxC+10iJxw/RKL",
+	"EUqV03Ldgvug7RprvJQwhhJSwkHNqE5z0GIt9qaVlMh1wFlkhTAW0uHAUW0H1xYaRkIwJNyImFFVMjLf",
+	"qDApGKoAM0ff/go5sszwkdiF37wAXsxakBnVuag0ECgabcR2oSOxKijVWKigQP4FkZLMzbNi1SS4UGmi",
+	"q82iMzrGdJ4yBLMQYyAKKG8pT0EquJYk1YFIEkduUZJ9PVJPT5NnywnsNt+vQLMRx2uk2X9twrhBzjZI",
+	"fUFDrtlYpfnjOslXaa5bb2UPjmpQvPUaZiVofKNgS+9gzwvKA+XIuTHzuS84FOAU5dyHaQ/6ulShSlWY",
+	"QVUuyqBsX/8K53b1goTgtnIyqKzDt+FRx+NAEI9bFVvgJ8/pjqrzdJ4G8vhrv0O/YZIVlJuaDCSmQmZo",
+	"owfIxr5Bh/NqClcJOlD0mVjotO8ijak27Tunz5vrA5pF3qoL7m193ZToVlD7TXxqmeQdXOrU9x7LunxJ",
+	"OS0Is00ATCnOFrXWaA6k0jkgz0pBuVbrqckVXneveO6zAriLhzfJcCtbeTqUFLZ9OxEMQwmsKrNvoLUQ",
+	"dOtypdFHKxc0pmoLEMJJaBfrZUxZMuogaRN6KzRZZ49ctvtYEE4mKP1jMFJtsut622peA8kyiUpBiRJO",
+	"fjuAvUe7u/BA0aJkdEwxe2i9lBSltZyLlH/3L7qpKIwMTSFYK2wLoRaqXxMsef0Kfn7S64Ou15jC4nR4",
+	"sCLKbm93r9PvdfqPhv3Hg0e9Qa/3fkkcY5aOIbKdSBala9IYpTzu7+6C+Rn89y0mVUWza+mLEcMiQ00o",
+	"Ux+P3eMz9xjm9tPPvZ/AL4R65WqscARDBXFeFYR3JJKMjBiaXoYR7qClSkzpmKYmQeqcKhCpK2pSrHOL",
+	"lze0I5RSSMucZBk1BAk7DsfiG6vOZaFflY4aFKQ0gowpsqzDcIoMpoTRzInvBQg4GeVKE56GPAtOTxKQ",
+	"OEa3TZ0TDTRDrg28ld1zo5avUsfG+jhH+OdweAxuAaQiw3AjSXUwFoDKhdTxqiFVVRREzlckA+3axw0a",
+	"v406VigvkC7pjSnf7alRTiggtgZy6zMBrSUdVRrV9mO9/cU3V/GPn0MZUXo/Ne/uvEdDyg7j7k7JUDkQ",
+	"VaivPqpMA2Ngq+iEdygHhUpRwRUoRG7qIoO6/eMkPG/5j+b3cF5f2mDcRun1AN9fgnM4ampZYRxssTsZ",
+	"jqmpHEspxpShC4sqrkMiZkAmxIQ+F8WsGkChmxbA8ekQdmz14JxlsBC749Z24Y2kmvIJLH4CiSUjaR0Z",
+	"tZCYgdtbdz3yXtnYOxaBQGNK3UwUhPJmDjCwCKjKGKw6Y/CjT/ssKh2vDSvdxDl2/7caHNWFhOcoqVYw",
+	"YWJEGBy+GcLITuFAYVpJque/en47lt2O52bn12U1YjS1O3JRODomrJhLYhKvwWIUR1OUym1m2jd2FSVy",
+	"UtJoED3q9rqP7RxL59a0O6ayd2zMYylUwBFObCeggHBwY0C/LcKzWhPuTRdekjmMEKQpYJiBsxvHGtTY",
+	"/SeZV7F138hhG5V+KjLbPhqN+yEXWZSWO598D+vMf5M/Lc3pr5Y9yKDWvnDTXauE3V5vC96LCu4yKiqm",
+	"qfQVsc9v0aA9JHVQLsmcCZJ13azrb/DnB+tkH6IYPiwVxh+iM2M4wipcGzFHOD/MR/9I6St6mJz+K+kf",
+	"0UQl/GQvPUieJBfl2z8ODn/p4vxwPtr9wtJ58uTN/LCf7v4xT+iMZm+PWMp+0e/e7OXvzTvWU0nB8uwg",
+	"efJy+G7v5bP9mf13MKPv3+az5JP4cvTpwryzv41/76pkctRhb8W7vc+Hun+yKx/xfvXqEf35Yo+/G/3+",
+	"C31f9t5+7s92cT9aPfyKlNVax7/tWJw4o2xnzNCBnP183W2R67oXUZX9bFwxV7SNScX0NUb2pcNfvw5o",
+	"W5XKAWGfSykkPKhr5oc2ytfOHw3+PItboHohJkA9ntC1PjyD9uiaTGxbYVQQnRlSjVeLSm9264TX8Vgt",
+	"TW4d+HaWPFt14cT5kAKypOqN7m14rzna43UxXojJxITqSresxubfj92WLGW2USuyHs5tsE+BO37gbOSd",
+	"oN40slarhwSVwsH6tJ/6g1soWdXMG/0UPkeJfs0UpW2QPZhywTK1NJ6HB810rj5mm1tQlNqkAMqnVDsv",
+	"s6P/h114jTyD87cdn4o6TuwBfKh6vUdpPaEGIcGMqO1bPAdDwNULKhUlmlxiQ7ZJL4tzkPp0WudYhLH2",
+	"Er2aolvF9e0gE5yTByBSW0yMW0b7HrFMVfts6i8KdKPkTYD2EWNzxHn+Jc0JnyCQ5brBTmcJcJwtFRhf",
+	"UU74k+17KihWzs3/V1L8V5QURytw9Oc0331BcVK3E629XevVTfOyMU8tDibsWepYyBRj48+oNIypVLoL",
+	"x4xoM5Jxp0AKBGfzTc7cULNh9j7DeuCkJqDf0P6+RxxcRq7TtDY2Uy+zfTWwFonOrs7WM8Bq/7oOlHhD",
+	"sH8qkVx0Jowo1SLgghgtCswo0Qg4HpsO3Z3XJZk57DQdeuC8lOjwgSkXM3hgMlWlfHlkmmdjJjtkgIoz",
+	"C3NbxUgEwpSAjCozm8we1qesbdaGmKJ8wurjRSuzleD8k6bnXXjOtaSo/H0bIGON/vaD4GM6qaStZgwe",
+	"qOAxCDexbG6f1RfScjJFT8O8E7d0Enfd755yX/gu4VYpsH9fbnq9izaHyz+6h55YNHkwbRfBdy4XD0l2",
+	"5byWocYN1ykVpGKK0p0CL5/VO2eybUE90Lslfp85CcxYSpICNUplNUG5vaOlTVnH7Ug6aksfrSIw/lrb",
+	"rd6sOdumX20BLWXGVj88zg7MNoGsJYNrIOfml5s7ARdVgLig7aemXThGntmxbllKMSUMZkJejJmYQUHm",
+	"5u0mNLmrhfcUApfvLd5D6Fs+S9rmcnjwWvjK0Xp71r2s/VObKd2JwXdfzhrrmFLA9Y83zFx0fWv3xgY1",
+	"dP34gUKE41evm/MJv6jjF6mHvpO1B6EdRqcmcLrZvu9oF3eWqAI/97CH2g2nptW2cz5iChJXM2n/gY+q",
+	"sM/VDKWCvV5/cR86w5KJeYFcQ04UcNFsxHE1jmk87AI3eFJ9xfdeHClwIfybNdO3EWFz8zf8kTq+xGwj",
+	"gOlNrd+1qcIlB5TTOk1XkkWDaIeUdMecN5019C7rrG2/vDq7+vcAvw9y0XgzAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
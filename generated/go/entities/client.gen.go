@@ -0,0 +1,2300 @@
+// Package entities provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package entities
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListDocuments request
+	ListDocuments(ctx context.Context, tableName externalRef2.TableName, params *ListDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDocumentWithBody request with any body
+	CreateDocumentWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDocument(ctx context.Context, tableName externalRef2.TableName, body CreateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDocument request
+	DeleteDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDocument request
+	GetDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDocumentWithBody request with any body
+	UpdateDocumentWithBody(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDocumentWithApplicationJSONPatchPlusJSONBody(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDocumentWithApplicationMergePatchPlusJSONBody(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffDocumentVersions request
+	DiffDocumentVersions(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *DiffDocumentVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDocumentVersion request
+	GetDocumentVersion(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDocumentVersionProof request
+	GetDocumentVersionProof(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyDocument request
+	VerifyDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BatchGetDocumentsWithBody request with any body
+	BatchGetDocumentsWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchGetDocuments(ctx context.Context, tableName externalRef2.TableName, body BatchGetDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkCreateDocumentsWithBody request with any body
+	BulkCreateDocumentsWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkCreateDocuments(ctx context.Context, tableName externalRef2.TableName, body BulkCreateDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchDocuments request
+	SearchDocuments(ctx context.Context, tableName externalRef2.TableName, params *SearchDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateDocumentWithBody request with any body
+	ValidateDocumentWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateDocument(ctx context.Context, tableName externalRef2.TableName, body ValidateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchDocuments request
+	WatchDocuments(ctx context.Context, tableName externalRef2.TableName, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListDocuments(ctx context.Context, tableName externalRef2.TableName, params *ListDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDocumentsRequest(c.Server, tableName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDocumentWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDocumentRequestWithBody(c.Server, tableName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDocument(ctx context.Context, tableName externalRef2.TableName, body CreateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDocumentRequest(c.Server, tableName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDocumentRequest(c.Server, tableName, entityId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocumentRequest(c.Server, tableName, entityId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDocumentWithBody(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDocumentRequestWithBody(c.Server, tableName, entityId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDocumentRequest(c.Server, tableName, entityId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDocumentWithApplicationJSONPatchPlusJSONBody(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDocumentRequestWithApplicationJSONPatchPlusJSONBody(c.Server, tableName, entityId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDocumentWithApplicationMergePatchPlusJSONBody(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDocumentRequestWithApplicationMergePatchPlusJSONBody(c.Server, tableName, entityId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DiffDocumentVersions(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *DiffDocumentVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffDocumentVersionsRequest(c.Server, tableName, entityId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDocumentVersion(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocumentVersionRequest(c.Server, tableName, entityId, entityVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDocumentVersionProof(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocumentVersionProofRequest(c.Server, tableName, entityId, entityVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyDocument(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyDocumentRequest(c.Server, tableName, entityId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchGetDocumentsWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchGetDocumentsRequestWithBody(c.Server, tableName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchGetDocuments(ctx context.Context, tableName externalRef2.TableName, body BatchGetDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchGetDocumentsRequest(c.Server, tableName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkCreateDocumentsWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkCreateDocumentsRequestWithBody(c.Server, tableName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkCreateDocuments(ctx context.Context, tableName externalRef2.TableName, body BulkCreateDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkCreateDocumentsRequest(c.Server, tableName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchDocuments(ctx context.Context, tableName externalRef2.TableName, params *SearchDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDocumentsRequest(c.Server, tableName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateDocumentWithBody(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateDocumentRequestWithBody(c.Server, tableName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateDocument(ctx context.Context, tableName externalRef2.TableName, body ValidateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateDocumentRequest(c.Server, tableName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WatchDocuments(ctx context.Context, tableName externalRef2.TableName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchDocumentsRequest(c.Server, tableName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListDocumentsRequest generates requests for ListDocuments
+func NewListDocumentsRequest(server string, tableName externalRef2.TableName, params *ListDocumentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDocumentRequest calls the generic CreateDocument builder with application/json body
+func NewCreateDocumentRequest(server string, tableName externalRef2.TableName, body CreateDocumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDocumentRequestWithBody(server, tableName, "application/json", bodyReader)
+}
+
+// NewCreateDocumentRequestWithBody generates requests for CreateDocument with any type of body
+func NewCreateDocumentRequestWithBody(server string, tableName externalRef2.TableName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDocumentRequest generates requests for DeleteDocument
+func NewDeleteDocumentRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDocumentRequest generates requests for GetDocument
+func NewGetDocumentRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDocumentRequest calls the generic UpdateDocument builder with application/json body
+func NewUpdateDocumentRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDocumentRequestWithBody(server, tableName, entityId, params, "application/json", bodyReader)
+}
+
+// NewUpdateDocumentRequestWithApplicationJSONPatchPlusJSONBody calls the generic UpdateDocument builder with application/json-patch+json body
+func NewUpdateDocumentRequestWithApplicationJSONPatchPlusJSONBody(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDocumentRequestWithBody(server, tableName, entityId, params, "application/json-patch+json", bodyReader)
+}
+
+// NewUpdateDocumentRequestWithApplicationMergePatchPlusJSONBody calls the generic UpdateDocument builder with application/merge-patch+json body
+func NewUpdateDocumentRequestWithApplicationMergePatchPlusJSONBody(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDocumentRequestWithBody(server, tableName, entityId, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewUpdateDocumentRequestWithBody generates requests for UpdateDocument with any type of body
+func NewUpdateDocumentRequestWithBody(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDiffDocumentVersionsRequest generates requests for DiffDocumentVersions
+func NewDiffDocumentVersionsRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *DiffDocumentVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/diff", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDocumentVersionRequest generates requests for GetDocumentVersion
+func NewGetDocumentVersionRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entityVersion", runtime.ParamLocationPath, entityVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/versions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDocumentVersionProofRequest generates requests for GetDocumentVersionProof
+func NewGetDocumentVersionProofRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entityVersion", runtime.ParamLocationPath, entityVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s/versions/%s/proof", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyDocumentRequest generates requests for VerifyDocument
+func NewVerifyDocumentRequest(server string, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "entityId", runtime.ParamLocationPath, entityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents/%s:verify", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBatchGetDocumentsRequest calls the generic BatchGetDocuments builder with application/json body
+func NewBatchGetDocumentsRequest(server string, tableName externalRef2.TableName, body BatchGetDocumentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchGetDocumentsRequestWithBody(server, tableName, "application/json", bodyReader)
+}
+
+// NewBatchGetDocumentsRequestWithBody generates requests for BatchGetDocuments with any type of body
+func NewBatchGetDocumentsRequestWithBody(server string, tableName externalRef2.TableName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents:batchGet", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBulkCreateDocumentsRequest calls the generic BulkCreateDocuments builder with application/json body
+func NewBulkCreateDocumentsRequest(server string, tableName externalRef2.TableName, body BulkCreateDocumentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkCreateDocumentsRequestWithBody(server, tableName, "application/json", bodyReader)
+}
+
+// NewBulkCreateDocumentsRequestWithBody generates requests for BulkCreateDocuments with any type of body
+func NewBulkCreateDocumentsRequestWithBody(server string, tableName externalRef2.TableName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents:bulk", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchDocumentsRequest generates requests for SearchDocuments
+func NewSearchDocumentsRequest(server string, tableName externalRef2.TableName, params *SearchDocumentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents:search", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewValidateDocumentRequest calls the generic ValidateDocument builder with application/json body
+func NewValidateDocumentRequest(server string, tableName externalRef2.TableName, body ValidateDocumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateDocumentRequestWithBody(server, tableName, "application/json", bodyReader)
+}
+
+// NewValidateDocumentRequestWithBody generates requests for ValidateDocument with any type of body
+func NewValidateDocumentRequestWithBody(server string, tableName externalRef2.TableName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents:validate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWatchDocumentsRequest generates requests for WatchDocuments
+func NewWatchDocumentsRequest(server string, tableName externalRef2.TableName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableName", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/entities/%s/documents:watch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListDocumentsWithResponse request
+	ListDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, params *ListDocumentsParams, reqEditors ...RequestEditorFn) (*ListDocumentsResponse, error)
+
+	// CreateDocumentWithBodyWithResponse request with any body
+	CreateDocumentWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDocumentResponse, error)
+
+	CreateDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, body CreateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDocumentResponse, error)
+
+	// DeleteDocumentWithResponse request
+	DeleteDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*DeleteDocumentResponse, error)
+
+	// GetDocumentWithResponse request
+	GetDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*GetDocumentResponse, error)
+
+	// UpdateDocumentWithBodyWithResponse request with any body
+	UpdateDocumentWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error)
+
+	UpdateDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error)
+
+	UpdateDocumentWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error)
+
+	UpdateDocumentWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error)
+
+	// DiffDocumentVersionsWithResponse request
+	DiffDocumentVersionsWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *DiffDocumentVersionsParams, reqEditors ...RequestEditorFn) (*DiffDocumentVersionsResponse, error)
+
+	// GetDocumentVersionWithResponse request
+	GetDocumentVersionWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*GetDocumentVersionResponse, error)
+
+	// GetDocumentVersionProofWithResponse request
+	GetDocumentVersionProofWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*GetDocumentVersionProofResponse, error)
+
+	// VerifyDocumentWithResponse request
+	VerifyDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*VerifyDocumentResponse, error)
+
+	// BatchGetDocumentsWithBodyWithResponse request with any body
+	BatchGetDocumentsWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchGetDocumentsResponse, error)
+
+	BatchGetDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, body BatchGetDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchGetDocumentsResponse, error)
+
+	// BulkCreateDocumentsWithBodyWithResponse request with any body
+	BulkCreateDocumentsWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkCreateDocumentsResponse, error)
+
+	BulkCreateDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, body BulkCreateDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkCreateDocumentsResponse, error)
+
+	// SearchDocumentsWithResponse request
+	SearchDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, params *SearchDocumentsParams, reqEditors ...RequestEditorFn) (*SearchDocumentsResponse, error)
+
+	// ValidateDocumentWithBodyWithResponse request with any body
+	ValidateDocumentWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateDocumentResponse, error)
+
+	ValidateDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, body ValidateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateDocumentResponse, error)
+
+	// WatchDocumentsWithResponse request
+	WatchDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, reqEditors ...RequestEditorFn) (*WatchDocumentsResponse, error)
+}
+
+type ListDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items []EntityDocument `json:"items"`
+
+		// NextCursor Cursor for the next page; omitted when there are no further documents.
+		NextCursor *string `json:"nextCursor,omitempty"`
+		Page       int     `json:"page"`
+		PageSize   int     `json:"pageSize"`
+		TotalItems int     `json:"totalItems"`
+		TotalPages int     `json:"totalPages"`
+	}
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDocumentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *EntityDocument
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDocumentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocumentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityDocument
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDocumentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityDocument
+	ApplicationproblemJSON412     *externalRef3.ProblemDetails
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffDocumentVersionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityDocumentDiff
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffDocumentVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffDocumentVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocumentVersionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityDocument
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocumentVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocumentVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocumentVersionProofResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityVersionProof
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocumentVersionProofResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocumentVersionProofResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyDocumentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityDocumentVerification
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchGetDocumentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *BatchGetEntityDocumentsResponse
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchGetDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchGetDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkCreateDocumentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *BulkCreateEntityDocumentsReport
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkCreateDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkCreateDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items      []EntityDocument `json:"items"`
+		Page       int              `json:"page"`
+		PageSize   int              `json:"pageSize"`
+		TotalItems int              `json:"totalItems"`
+		TotalPages int              `json:"totalPages"`
+	}
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateDocumentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityValidationResult
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchDocumentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListDocumentsWithResponse request returning *ListDocumentsResponse
+func (c *ClientWithResponses) ListDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, params *ListDocumentsParams, reqEditors ...RequestEditorFn) (*ListDocumentsResponse, error) {
+	rsp, err := c.ListDocuments(ctx, tableName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDocumentsResponse(rsp)
+}
+
+// CreateDocumentWithBodyWithResponse request with arbitrary body returning *CreateDocumentResponse
+func (c *ClientWithResponses) CreateDocumentWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDocumentResponse, error) {
+	rsp, err := c.CreateDocumentWithBody(ctx, tableName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, body CreateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDocumentResponse, error) {
+	rsp, err := c.CreateDocument(ctx, tableName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDocumentResponse(rsp)
+}
+
+// DeleteDocumentWithResponse request returning *DeleteDocumentResponse
+func (c *ClientWithResponses) DeleteDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*DeleteDocumentResponse, error) {
+	rsp, err := c.DeleteDocument(ctx, tableName, entityId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDocumentResponse(rsp)
+}
+
+// GetDocumentWithResponse request returning *GetDocumentResponse
+func (c *ClientWithResponses) GetDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*GetDocumentResponse, error) {
+	rsp, err := c.GetDocument(ctx, tableName, entityId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocumentResponse(rsp)
+}
+
+// UpdateDocumentWithBodyWithResponse request with arbitrary body returning *UpdateDocumentResponse
+func (c *ClientWithResponses) UpdateDocumentWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error) {
+	rsp, err := c.UpdateDocumentWithBody(ctx, tableName, entityId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error) {
+	rsp, err := c.UpdateDocument(ctx, tableName, entityId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDocumentWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error) {
+	rsp, err := c.UpdateDocumentWithApplicationJSONPatchPlusJSONBody(ctx, tableName, entityId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDocumentWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *UpdateDocumentParams, body UpdateDocumentApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDocumentResponse, error) {
+	rsp, err := c.UpdateDocumentWithApplicationMergePatchPlusJSONBody(ctx, tableName, entityId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDocumentResponse(rsp)
+}
+
+// DiffDocumentVersionsWithResponse request returning *DiffDocumentVersionsResponse
+func (c *ClientWithResponses) DiffDocumentVersionsWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params *DiffDocumentVersionsParams, reqEditors ...RequestEditorFn) (*DiffDocumentVersionsResponse, error) {
+	rsp, err := c.DiffDocumentVersions(ctx, tableName, entityId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffDocumentVersionsResponse(rsp)
+}
+
+// GetDocumentVersionWithResponse request returning *GetDocumentVersionResponse
+func (c *ClientWithResponses) GetDocumentVersionWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*GetDocumentVersionResponse, error) {
+	rsp, err := c.GetDocumentVersion(ctx, tableName, entityId, entityVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocumentVersionResponse(rsp)
+}
+
+// GetDocumentVersionProofWithResponse request returning *GetDocumentVersionProofResponse
+func (c *ClientWithResponses) GetDocumentVersionProofWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*GetDocumentVersionProofResponse, error) {
+	rsp, err := c.GetDocumentVersionProof(ctx, tableName, entityId, entityVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocumentVersionProofResponse(rsp)
+}
+
+// VerifyDocumentWithResponse request returning *VerifyDocumentResponse
+func (c *ClientWithResponses) VerifyDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, reqEditors ...RequestEditorFn) (*VerifyDocumentResponse, error) {
+	rsp, err := c.VerifyDocument(ctx, tableName, entityId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyDocumentResponse(rsp)
+}
+
+// BatchGetDocumentsWithBodyWithResponse request with arbitrary body returning *BatchGetDocumentsResponse
+func (c *ClientWithResponses) BatchGetDocumentsWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchGetDocumentsResponse, error) {
+	rsp, err := c.BatchGetDocumentsWithBody(ctx, tableName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchGetDocumentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchGetDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, body BatchGetDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchGetDocumentsResponse, error) {
+	rsp, err := c.BatchGetDocuments(ctx, tableName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchGetDocumentsResponse(rsp)
+}
+
+// BulkCreateDocumentsWithBodyWithResponse request with arbitrary body returning *BulkCreateDocumentsResponse
+func (c *ClientWithResponses) BulkCreateDocumentsWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkCreateDocumentsResponse, error) {
+	rsp, err := c.BulkCreateDocumentsWithBody(ctx, tableName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkCreateDocumentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkCreateDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, body BulkCreateDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkCreateDocumentsResponse, error) {
+	rsp, err := c.BulkCreateDocuments(ctx, tableName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkCreateDocumentsResponse(rsp)
+}
+
+// SearchDocumentsWithResponse request returning *SearchDocumentsResponse
+func (c *ClientWithResponses) SearchDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, params *SearchDocumentsParams, reqEditors ...RequestEditorFn) (*SearchDocumentsResponse, error) {
+	rsp, err := c.SearchDocuments(ctx, tableName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDocumentsResponse(rsp)
+}
+
+// ValidateDocumentWithBodyWithResponse request with arbitrary body returning *ValidateDocumentResponse
+func (c *ClientWithResponses) ValidateDocumentWithBodyWithResponse(ctx context.Context, tableName externalRef2.TableName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateDocumentResponse, error) {
+	rsp, err := c.ValidateDocumentWithBody(ctx, tableName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateDocumentWithResponse(ctx context.Context, tableName externalRef2.TableName, body ValidateDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateDocumentResponse, error) {
+	rsp, err := c.ValidateDocument(ctx, tableName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateDocumentResponse(rsp)
+}
+
+// WatchDocumentsWithResponse request returning *WatchDocumentsResponse
+func (c *ClientWithResponses) WatchDocumentsWithResponse(ctx context.Context, tableName externalRef2.TableName, reqEditors ...RequestEditorFn) (*WatchDocumentsResponse, error) {
+	rsp, err := c.WatchDocuments(ctx, tableName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchDocumentsResponse(rsp)
+}
+
+// ParseListDocumentsResponse parses an HTTP response from a ListDocumentsWithResponse call
+func ParseListDocumentsResponse(rsp *http.Response) (*ListDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items []EntityDocument `json:"items"`
+
+			// NextCursor Cursor for the next page; omitted when there are no further documents.
+			NextCursor *string `json:"nextCursor,omitempty"`
+			Page       int     `json:"page"`
+			PageSize   int     `json:"pageSize"`
+			TotalItems int     `json:"totalItems"`
+			TotalPages int     `json:"totalPages"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDocumentResponse parses an HTTP response from a CreateDocumentWithResponse call
+func ParseCreateDocumentResponse(rsp *http.Response) (*CreateDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest EntityDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDocumentResponse parses an HTTP response from a DeleteDocumentWithResponse call
+func ParseDeleteDocumentResponse(rsp *http.Response) (*DeleteDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDocumentResponse parses an HTTP response from a GetDocumentWithResponse call
+func ParseGetDocumentResponse(rsp *http.Response) (*GetDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateDocumentResponse parses an HTTP response from a UpdateDocumentWithResponse call
+func ParseUpdateDocumentResponse(rsp *http.Response) (*UpdateDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDiffDocumentVersionsResponse parses an HTTP response from a DiffDocumentVersionsWithResponse call
+func ParseDiffDocumentVersionsResponse(rsp *http.Response) (*DiffDocumentVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiffDocumentVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityDocumentDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDocumentVersionResponse parses an HTTP response from a GetDocumentVersionWithResponse call
+func ParseGetDocumentVersionResponse(rsp *http.Response) (*GetDocumentVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocumentVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDocumentVersionProofResponse parses an HTTP response from a GetDocumentVersionProofWithResponse call
+func ParseGetDocumentVersionProofResponse(rsp *http.Response) (*GetDocumentVersionProofResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocumentVersionProofResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityVersionProof
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyDocumentResponse parses an HTTP response from a VerifyDocumentWithResponse call
+func ParseVerifyDocumentResponse(rsp *http.Response) (*VerifyDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityDocumentVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchGetDocumentsResponse parses an HTTP response from a BatchGetDocumentsWithResponse call
+func ParseBatchGetDocumentsResponse(rsp *http.Response) (*BatchGetDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchGetDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchGetEntityDocumentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkCreateDocumentsResponse parses an HTTP response from a BulkCreateDocumentsWithResponse call
+func ParseBulkCreateDocumentsResponse(rsp *http.Response) (*BulkCreateDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkCreateDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkCreateEntityDocumentsReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchDocumentsResponse parses an HTTP response from a SearchDocumentsWithResponse call
+func ParseSearchDocumentsResponse(rsp *http.Response) (*SearchDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items      []EntityDocument `json:"items"`
+			Page       int              `json:"page"`
+			PageSize   int              `json:"pageSize"`
+			TotalItems int              `json:"totalItems"`
+			TotalPages int              `json:"totalPages"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateDocumentResponse parses an HTTP response from a ValidateDocumentWithResponse call
+func ParseValidateDocumentResponse(rsp *http.Response) (*ValidateDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityValidationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWatchDocumentsResponse parses an HTTP response from a WatchDocumentsWithResponse call
+func ParseWatchDocumentsResponse(rsp *http.Response) (*WatchDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WatchDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -29,6 +30,96 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for BulkCreateEntityDocumentResultStatus.
+const (
+	Created BulkCreateEntityDocumentResultStatus = "created"
+	Failed  BulkCreateEntityDocumentResultStatus = "failed"
+)
+
+// Defines values for EntityChangeEventType.
+const (
+	EntityCreated EntityChangeEventType = "entity.created"
+	EntityDeleted EntityChangeEventType = "entity.deleted"
+	EntityUpdated EntityChangeEventType = "entity.updated"
+)
+
+// Defines values for EntityDocumentChangeOp.
+const (
+	Added   EntityDocumentChangeOp = "added"
+	Changed EntityDocumentChangeOp = "changed"
+	Removed EntityDocumentChangeOp = "removed"
+)
+
+// Defines values for EntityVersionProofStatus.
+const (
+	Anchored EntityVersionProofStatus = "anchored"
+	Pending  EntityVersionProofStatus = "pending"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
+)
+
+// Defines values for MerkleProofStepPosition.
+const (
+	Left  MerkleProofStepPosition = "left"
+	Right MerkleProofStepPosition = "right"
+)
+
+// BatchGetEntityDocumentsRequest defines model for BatchGetEntityDocumentsRequest.
+type BatchGetEntityDocumentsRequest struct {
+	EntityIds []externalRef2.EntityIdentifier `json:"entityIds"`
+}
+
+// BatchGetEntityDocumentsResponse defines model for BatchGetEntityDocumentsResponse.
+type BatchGetEntityDocumentsResponse struct {
+	Items []EntityDocument `json:"items"`
+
+	// Missing Requested ids without an active document.
+	Missing []externalRef2.EntityIdentifier `json:"missing"`
+}
+
+// BulkCreateEntityDocumentResult defines model for BulkCreateEntityDocumentResult.
+type BulkCreateEntityDocumentResult struct {
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId *externalRef2.EntityIdentifier `json:"entityId,omitempty"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion *externalRef2.SemanticVersion `json:"entityVersion,omitempty"`
+
+	// Error Reason the row was rejected; present only when status is failed.
+	Error *string `json:"error,omitempty"`
+
+	// Index Zero-based position of the row in the request body.
+	Index  int                                  `json:"index"`
+	Status BulkCreateEntityDocumentResultStatus `json:"status"`
+}
+
+// BulkCreateEntityDocumentResultStatus defines model for BulkCreateEntityDocumentResult.Status.
+type BulkCreateEntityDocumentResultStatus string
+
+// BulkCreateEntityDocumentsReport defines model for BulkCreateEntityDocumentsReport.
+type BulkCreateEntityDocumentsReport struct {
+	// Failed Number of rows rejected.
+	Failed  int                              `json:"failed"`
+	Results []BulkCreateEntityDocumentResult `json:"results"`
+
+	// Succeeded Number of rows persisted.
+	Succeeded int `json:"succeeded"`
+
+	// Total Number of rows received.
+	Total int `json:"total"`
+}
+
+// BulkCreateEntityDocumentsRequest defines model for BulkCreateEntityDocumentsRequest.
+type BulkCreateEntityDocumentsRequest = []CreateEntityDocumentRequest
+
 // CreateEntityDocumentRequest defines model for CreateEntityDocumentRequest.
 type CreateEntityDocumentRequest struct {
 	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
@@ -38,6 +129,22 @@ type CreateEntityDocumentRequest struct {
 	Payload map[string]interface{} `json:"payload"`
 }
 
+// EntityChangeEvent Data of a `documents:watch` event.
+type EntityChangeEvent struct {
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion *externalRef2.SemanticVersion `json:"entityVersion,omitempty"`
+
+	// OccurredAt ISO 8601 timestamp in UTC
+	OccurredAt externalRef2.Timestamp `json:"occurredAt"`
+	Type       EntityChangeEventType  `json:"type"`
+}
+
+// EntityChangeEventType defines model for EntityChangeEvent.Type.
+type EntityChangeEventType string
+
 // EntityDocument Immutable record representing a JSON document plus metadata.
 type EntityDocument struct {
 	// CreatedAt ISO 8601 timestamp in UTC
@@ -49,6 +156,9 @@ type EntityDocument struct {
 	// EntityVersion Semantic version string in major.minor.patch format
 	EntityVersion externalRef2.SemanticVersion `json:"entityVersion"`
 
+	// Hash SHA-256 of the canonical payload; send it back in If-Match to guard updates against stale reads.
+	Hash string `json:"hash"`
+
 	// IsActive Indicates whether this is the active record version.
 	IsActive bool `json:"isActive"`
 
@@ -65,6 +175,141 @@ type EntityDocument struct {
 	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
 }
 
+// EntityDocumentChange defines model for EntityDocumentChange.
+type EntityDocumentChange struct {
+	// NewValue Value in the target version; omitted for removed paths.
+	NewValue interface{} `json:"newValue,omitempty"`
+
+	// OldValue Value in the base version; omitted for added paths.
+	OldValue interface{}            `json:"oldValue,omitempty"`
+	Op       EntityDocumentChangeOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) of the changed value within the payload.
+	Path string `json:"path"`
+}
+
+// EntityDocumentChangeOp defines model for EntityDocumentChange.Op.
+type EntityDocumentChangeOp string
+
+// EntityDocumentDiff defines model for EntityDocumentDiff.
+type EntityDocumentDiff struct {
+	// Changes Changes ordered by path.
+	Changes []EntityDocumentChange `json:"changes"`
+
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// FromVersion Semantic version string in major.minor.patch format
+	FromVersion externalRef2.SemanticVersion `json:"fromVersion"`
+
+	// ToVersion Semantic version string in major.minor.patch format
+	ToVersion externalRef2.SemanticVersion `json:"toVersion"`
+}
+
+// EntityDocumentVerification defines model for EntityDocumentVerification.
+type EntityDocumentVerification struct {
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// Mismatches Versions whose payload no longer hashes to the stored hash, ordered by version.
+	Mismatches []EntityHashMismatch `json:"mismatches"`
+
+	// Valid True when every stored version matches its hash.
+	Valid           bool `json:"valid"`
+	VersionsChecked int  `json:"versionsChecked"`
+}
+
+// EntityHashMismatch defines model for EntityHashMismatch.
+type EntityHashMismatch struct {
+	// Archived True when the payload was read back from an archive object.
+	Archived     bool   `json:"archived"`
+	ComputedHash string `json:"computedHash"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion externalRef2.SemanticVersion `json:"entityVersion"`
+	StoredHash    string                       `json:"storedHash"`
+}
+
+// EntityValidationResult defines model for EntityValidationResult.
+type EntityValidationResult struct {
+	// Errors Issues keyed by field; empty when the payload is valid.
+	Errors map[string][]string `json:"errors"`
+
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// SchemaVersion Semantic version string in major.minor.patch format
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+	Valid         bool                         `json:"valid"`
+}
+
+// EntityVersionProof defines model for EntityVersionProof.
+type EntityVersionProof struct {
+	// Anchor Ledger the batch root is submitted to.
+	Anchor string `json:"anchor"`
+
+	// AnchoredAt ISO 8601 timestamp in UTC
+	AnchoredAt *externalRef2.Timestamp `json:"anchoredAt,omitempty"`
+
+	// BatchId RFC 4122 UUID string
+	BatchId externalRef2.UUID `json:"batchId"`
+
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// EntityVersion Semantic version string in major.minor.patch format
+	EntityVersion externalRef2.SemanticVersion `json:"entityVersion"`
+
+	// Hash Document hash of the version when it was anchored.
+	Hash       string `json:"hash"`
+	Leaf       string `json:"leaf"`
+	LeafIndex  int    `json:"leafIndex"`
+	MerkleRoot string `json:"merkleRoot"`
+
+	// Proof Sibling hashes from the leaf up to the root.
+	Proof []MerkleProofStep `json:"proof"`
+
+	// Reference Ledger entry holding the root, e.g. a transaction id; set once anchored.
+	Reference *string                  `json:"reference,omitempty"`
+	Status    EntityVersionProofStatus `json:"status"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+}
+
+// EntityVersionProofStatus defines model for EntityVersionProof.Status.
+type EntityVersionProofStatus string
+
+// JSONPatchDocument RFC 6902 operations applied in order to the active payload.
+type JSONPatchDocument = []JSONPatchOperation
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From Source JSON Pointer for move and copy.
+	From *string              `json:"from,omitempty"`
+	Op   JSONPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) into the payload.
+	Path string `json:"path"`
+
+	// Value Value for add, replace and test.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
+// MerkleProofStep defines model for MerkleProofStep.
+type MerkleProofStep struct {
+	Hash string `json:"hash"`
+
+	// Position Side the sibling hash is combined on.
+	Position MerkleProofStepPosition `json:"position"`
+}
+
+// MerkleProofStepPosition Side the sibling hash is combined on.
+type MerkleProofStepPosition string
+
 // UpdateEntityDocumentRequest defines model for UpdateEntityDocumentRequest.
 type UpdateEntityDocumentRequest struct {
 	Payload *map[string]interface{} `json:"payload,omitempty"`
@@ -80,6 +325,43 @@ type ListDocumentsParams struct {
 
 	// Sort Sort fields, e.g. 'name,-createdAt'
 	Sort *externalRef1.Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque keyset cursor returned as `nextCursor` by a previous call. When present, `page` is ignored and the
+	// listing continues right after the cursor position, which stays fast on large tables.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// UpdateDocumentApplicationMergePatchPlusJSONBody defines parameters for UpdateDocument.
+type UpdateDocumentApplicationMergePatchPlusJSONBody map[string]interface{}
+
+// UpdateDocumentParams defines parameters for UpdateDocument.
+type UpdateDocumentParams struct {
+	// IfMatch Hash of the active document version the update is based on (quotes and weak prefix are ignored).
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// DiffDocumentVersionsParams defines parameters for DiffDocumentVersions.
+type DiffDocumentVersionsParams struct {
+	// From Base entity version.
+	From externalRef2.SemanticVersion `form:"from" json:"from"`
+
+	// To Target entity version.
+	To externalRef2.SemanticVersion `form:"to" json:"to"`
+}
+
+// SearchDocumentsParams defines parameters for SearchDocuments.
+type SearchDocumentsParams struct {
+	// Q Free-text query; terms are combined with AND.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Filter Exact payload match such as `payload.status:active`; repeat to combine with AND.
+	Filter *[]string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Page 1-indexed page number
+	Page *externalRef1.Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Number of items per page (max 100)
+	PageSize *externalRef1.PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
 // CreateDocumentJSONRequestBody defines body for CreateDocument for application/json ContentType.
@@ -88,6 +370,21 @@ type CreateDocumentJSONRequestBody = CreateEntityDocumentRequest
 // UpdateDocumentJSONRequestBody defines body for UpdateDocument for application/json ContentType.
 type UpdateDocumentJSONRequestBody = UpdateEntityDocumentRequest
 
+// UpdateDocumentApplicationJSONPatchPlusJSONRequestBody defines body for UpdateDocument for application/json-patch+json ContentType.
+type UpdateDocumentApplicationJSONPatchPlusJSONRequestBody = JSONPatchDocument
+
+// UpdateDocumentApplicationMergePatchPlusJSONRequestBody defines body for UpdateDocument for application/merge-patch+json ContentType.
+type UpdateDocumentApplicationMergePatchPlusJSONRequestBody UpdateDocumentApplicationMergePatchPlusJSONBody
+
+// BatchGetDocumentsJSONRequestBody defines body for BatchGetDocuments for application/json ContentType.
+type BatchGetDocumentsJSONRequestBody = BatchGetEntityDocumentsRequest
+
+// BulkCreateDocumentsJSONRequestBody defines body for BulkCreateDocuments for application/json ContentType.
+type BulkCreateDocumentsJSONRequestBody = BulkCreateEntityDocumentsRequest
+
+// ValidateDocumentJSONRequestBody defines body for ValidateDocument for application/json ContentType.
+type ValidateDocumentJSONRequestBody = CreateEntityDocumentRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List documents
@@ -104,7 +401,34 @@ type ServerInterface interface {
 	GetDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier)
 	// Update document (partial)
 	// (PATCH /entities/{tableName}/documents/{entityId})
-	UpdateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier)
+	UpdateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params UpdateDocumentParams)
+	// Diff two document versions
+	// (GET /entities/{tableName}/documents/{entityId}/diff)
+	DiffDocumentVersions(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params DiffDocumentVersionsParams)
+	// Get a document version
+	// (GET /entities/{tableName}/documents/{entityId}/versions/{entityVersion})
+	GetDocumentVersion(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion)
+	// Get the anchoring proof of a document version
+	// (GET /entities/{tableName}/documents/{entityId}/versions/{entityVersion}/proof)
+	GetDocumentVersionProof(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion)
+	// Verify document hashes
+	// (POST /entities/{tableName}/documents/{entityId}:verify)
+	VerifyDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier)
+	// Batch get documents
+	// (POST /entities/{tableName}/documents:batchGet)
+	BatchGetDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName)
+	// Bulk import documents
+	// (POST /entities/{tableName}/documents:bulk)
+	BulkCreateDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName)
+	// Search documents
+	// (GET /entities/{tableName}/documents:search)
+	SearchDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, params SearchDocumentsParams)
+	// Validate document (dry run)
+	// (POST /entities/{tableName}/documents:validate)
+	ValidateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName)
+	// Watch document changes
+	// (GET /entities/{tableName}/documents:watch)
+	WatchDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -137,7 +461,61 @@ func (_ Unimplemented) GetDocument(w http.ResponseWriter, r *http.Request, table
 
 // Update document (partial)
 // (PATCH /entities/{tableName}/documents/{entityId})
-func (_ Unimplemented) UpdateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) {
+func (_ Unimplemented) UpdateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params UpdateDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Diff two document versions
+// (GET /entities/{tableName}/documents/{entityId}/diff)
+func (_ Unimplemented) DiffDocumentVersions(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params DiffDocumentVersionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a document version
+// (GET /entities/{tableName}/documents/{entityId}/versions/{entityVersion})
+func (_ Unimplemented) GetDocumentVersion(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the anchoring proof of a document version
+// (GET /entities/{tableName}/documents/{entityId}/versions/{entityVersion}/proof)
+func (_ Unimplemented) GetDocumentVersionProof(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify document hashes
+// (POST /entities/{tableName}/documents/{entityId}:verify)
+func (_ Unimplemented) VerifyDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Batch get documents
+// (POST /entities/{tableName}/documents:batchGet)
+func (_ Unimplemented) BatchGetDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Bulk import documents
+// (POST /entities/{tableName}/documents:bulk)
+func (_ Unimplemented) BulkCreateDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search documents
+// (GET /entities/{tableName}/documents:search)
+func (_ Unimplemented) SearchDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, params SearchDocumentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Validate document (dry run)
+// (POST /entities/{tableName}/documents:validate)
+func (_ Unimplemented) ValidateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch document changes
+// (GET /entities/{tableName}/documents:watch)
+func (_ Unimplemented) WatchDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -197,6 +575,14 @@ func (siw *ServerInterfaceWrapper) ListDocuments(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListDocuments(w, r, tableName, params)
 	}))
@@ -348,8 +734,32 @@ func (siw *ServerInterfaceWrapper) UpdateDocument(w http.ResponseWriter, r *http
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateDocumentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateDocument(w, r, tableName, entityId)
+		siw.Handler.UpdateDocument(w, r, tableName, entityId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -359,86 +769,487 @@ func (siw *ServerInterfaceWrapper) UpdateDocument(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// DiffDocumentVersions operation middleware
+func (siw *ServerInterfaceWrapper) DiffDocumentVersions(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "entityId" -------------
+	var entityId externalRef2.EntityIdentifier
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx := r.Context()
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffDocumentVersionsParams
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Required query parameter "from" -------------
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// ------------- Required query parameter "to" -------------
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffDocumentVersions(w, r, tableName, entityId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDocumentVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetDocumentVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId externalRef2.EntityIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityVersion" -------------
+	var entityVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityVersion", chi.URLParam(r, "entityVersion"), &entityVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDocumentVersion(w, r, tableName, entityId, entityVersion)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDocumentVersionProof operation middleware
+func (siw *ServerInterfaceWrapper) GetDocumentVersionProof(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId externalRef2.EntityIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityVersion" -------------
+	var entityVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityVersion", chi.URLParam(r, "entityVersion"), &entityVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDocumentVersionProof(w, r, tableName, entityId, entityVersion)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyDocument operation middleware
+func (siw *ServerInterfaceWrapper) VerifyDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId externalRef2.EntityIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", chi.URLParam(r, "entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyDocument(w, r, tableName, entityId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BatchGetDocuments operation middleware
+func (siw *ServerInterfaceWrapper) BatchGetDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchGetDocuments(w, r, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkCreateDocuments operation middleware
+func (siw *ServerInterfaceWrapper) BulkCreateDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkCreateDocuments(w, r, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchDocuments operation middleware
+func (siw *ServerInterfaceWrapper) SearchDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDocumentsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchDocuments(w, r, tableName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ValidateDocument operation middleware
+func (siw *ServerInterfaceWrapper) ValidateDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateDocument(w, r, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WatchDocuments operation middleware
+func (siw *ServerInterfaceWrapper) WatchDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName externalRef2.TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchDocuments(w, r, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
 // HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
 func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
@@ -487,6 +1298,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/entities/{tableName}/documents/{entityId}", wrapper.UpdateDocument)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents/{entityId}/diff", wrapper.DiffDocumentVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents/{entityId}/versions/{entityVersion}", wrapper.GetDocumentVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents/{entityId}/versions/{entityVersion}/proof", wrapper.GetDocumentVersionProof)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/entities/{tableName}/documents/{entityId}:verify", wrapper.VerifyDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/entities/{tableName}/documents:batchGet", wrapper.BatchGetDocuments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/entities/{tableName}/documents:bulk", wrapper.BulkCreateDocuments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents:search", wrapper.SearchDocuments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/entities/{tableName}/documents:validate", wrapper.ValidateDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/entities/{tableName}/documents:watch", wrapper.WatchDocuments)
+	})
 
 	return r
 }
@@ -501,11 +1339,14 @@ type ListDocumentsResponseObject interface {
 }
 
 type ListDocuments200JSONResponse struct {
-	Items      []EntityDocument `json:"items"`
-	Page       int              `json:"page"`
-	PageSize   int              `json:"pageSize"`
-	TotalItems int              `json:"totalItems"`
-	TotalPages int              `json:"totalPages"`
+	Items []EntityDocument `json:"items"`
+
+	// NextCursor Cursor for the next page; omitted when there are no further documents.
+	NextCursor *string `json:"nextCursor,omitempty"`
+	Page       int     `json:"page"`
+	PageSize   int     `json:"pageSize"`
+	TotalItems int     `json:"totalItems"`
+	TotalPages int     `json:"totalPages"`
 }
 
 func (response ListDocuments200JSONResponse) VisitListDocumentsResponse(w http.ResponseWriter) error {
@@ -550,105 +1391,406 @@ func (response CreateDocument201JSONResponse) VisitCreateDocumentResponse(w http
 	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
 	w.WriteHeader(201)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateDocumentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response CreateDocumentdefaultApplicationProblemPlusJSONResponse) VisitCreateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteDocumentRequestObject struct {
+	TableName externalRef2.TableName        `json:"tableName"`
+	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
+}
+
+type DeleteDocumentResponseObject interface {
+	VisitDeleteDocumentResponse(w http.ResponseWriter) error
+}
+
+type DeleteDocument204Response struct {
+}
+
+func (response DeleteDocument204Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDocumentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response DeleteDocumentdefaultApplicationProblemPlusJSONResponse) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDocumentRequestObject struct {
+	TableName externalRef2.TableName        `json:"tableName"`
+	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
+}
+
+type GetDocumentResponseObject interface {
+	VisitGetDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetDocument200JSONResponse EntityDocument
+
+func (response GetDocument200JSONResponse) VisitGetDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDocumentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response GetDocumentdefaultApplicationProblemPlusJSONResponse) VisitGetDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateDocumentRequestObject struct {
+	TableName                         externalRef2.TableName        `json:"tableName"`
+	EntityId                          externalRef2.EntityIdentifier `json:"entityId"`
+	Params                            UpdateDocumentParams
+	JSONBody                          *UpdateDocumentJSONRequestBody
+	ApplicationJSONPatchPlusJSONBody  *UpdateDocumentApplicationJSONPatchPlusJSONRequestBody
+	ApplicationMergePatchPlusJSONBody *UpdateDocumentApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateDocumentResponseObject interface {
+	VisitUpdateDocumentResponse(w http.ResponseWriter) error
+}
+
+type UpdateDocument200JSONResponse EntityDocument
+
+func (response UpdateDocument200JSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDocument412ApplicationProblemPlusJSONResponse externalRef3.ProblemDetails
+
+func (response UpdateDocument412ApplicationProblemPlusJSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDocumentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UpdateDocumentdefaultApplicationProblemPlusJSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DiffDocumentVersionsRequestObject struct {
+	TableName externalRef2.TableName        `json:"tableName"`
+	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
+	Params    DiffDocumentVersionsParams
+}
+
+type DiffDocumentVersionsResponseObject interface {
+	VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error
+}
+
+type DiffDocumentVersions200JSONResponse EntityDocumentDiff
+
+func (response DiffDocumentVersions200JSONResponse) VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffDocumentVersionsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response DiffDocumentVersionsdefaultApplicationProblemPlusJSONResponse) VisitDiffDocumentVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDocumentVersionRequestObject struct {
+	TableName     externalRef2.TableName        `json:"tableName"`
+	EntityId      externalRef2.EntityIdentifier `json:"entityId"`
+	EntityVersion externalRef2.SemanticVersion  `json:"entityVersion"`
+}
+
+type GetDocumentVersionResponseObject interface {
+	VisitGetDocumentVersionResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentVersion200JSONResponse EntityDocument
+
+func (response GetDocumentVersion200JSONResponse) VisitGetDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDocumentVersiondefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response GetDocumentVersiondefaultApplicationProblemPlusJSONResponse) VisitGetDocumentVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDocumentVersionProofRequestObject struct {
+	TableName     externalRef2.TableName        `json:"tableName"`
+	EntityId      externalRef2.EntityIdentifier `json:"entityId"`
+	EntityVersion externalRef2.SemanticVersion  `json:"entityVersion"`
+}
+
+type GetDocumentVersionProofResponseObject interface {
+	VisitGetDocumentVersionProofResponse(w http.ResponseWriter) error
+}
+
+type GetDocumentVersionProof200JSONResponse EntityVersionProof
+
+func (response GetDocumentVersionProof200JSONResponse) VisitGetDocumentVersionProofResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDocumentVersionProofdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response GetDocumentVersionProofdefaultApplicationProblemPlusJSONResponse) VisitGetDocumentVersionProofResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type VerifyDocumentRequestObject struct {
+	TableName externalRef2.TableName        `json:"tableName"`
+	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
+}
+
+type VerifyDocumentResponseObject interface {
+	VisitVerifyDocumentResponse(w http.ResponseWriter) error
+}
+
+type VerifyDocument200JSONResponse EntityDocumentVerification
+
+func (response VerifyDocument200JSONResponse) VisitVerifyDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyDocumentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response VerifyDocumentdefaultApplicationProblemPlusJSONResponse) VisitVerifyDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type BatchGetDocumentsRequestObject struct {
+	TableName externalRef2.TableName `json:"tableName"`
+	Body      *BatchGetDocumentsJSONRequestBody
+}
+
+type BatchGetDocumentsResponseObject interface {
+	VisitBatchGetDocumentsResponse(w http.ResponseWriter) error
+}
+
+type BatchGetDocuments200JSONResponse BatchGetEntityDocumentsResponse
+
+func (response BatchGetDocuments200JSONResponse) VisitBatchGetDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetDocumentsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response BatchGetDocumentsdefaultApplicationProblemPlusJSONResponse) VisitBatchGetDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type BulkCreateDocumentsRequestObject struct {
+	TableName externalRef2.TableName `json:"tableName"`
+	JSONBody  *BulkCreateDocumentsJSONRequestBody
+	Body      io.Reader
+}
+
+type BulkCreateDocumentsResponseObject interface {
+	VisitBulkCreateDocumentsResponse(w http.ResponseWriter) error
+}
+
+type BulkCreateDocuments200JSONResponse BulkCreateEntityDocumentsReport
+
+func (response BulkCreateDocuments200JSONResponse) VisitBulkCreateDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDocumentdefaultApplicationProblemPlusJSONResponse struct {
+type BulkCreateDocumentsdefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response CreateDocumentdefaultApplicationProblemPlusJSONResponse) VisitCreateDocumentResponse(w http.ResponseWriter) error {
+func (response BulkCreateDocumentsdefaultApplicationProblemPlusJSONResponse) VisitBulkCreateDocumentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteDocumentRequestObject struct {
-	TableName externalRef2.TableName        `json:"tableName"`
-	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
+type SearchDocumentsRequestObject struct {
+	TableName externalRef2.TableName `json:"tableName"`
+	Params    SearchDocumentsParams
 }
 
-type DeleteDocumentResponseObject interface {
-	VisitDeleteDocumentResponse(w http.ResponseWriter) error
+type SearchDocumentsResponseObject interface {
+	VisitSearchDocumentsResponse(w http.ResponseWriter) error
 }
 
-type DeleteDocument204Response struct {
+type SearchDocuments200JSONResponse struct {
+	Items      []EntityDocument `json:"items"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"pageSize"`
+	TotalItems int              `json:"totalItems"`
+	TotalPages int              `json:"totalPages"`
 }
 
-func (response DeleteDocument204Response) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response SearchDocuments200JSONResponse) VisitSearchDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDocumentdefaultApplicationProblemPlusJSONResponse struct {
+type SearchDocumentsdefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response DeleteDocumentdefaultApplicationProblemPlusJSONResponse) VisitDeleteDocumentResponse(w http.ResponseWriter) error {
+func (response SearchDocumentsdefaultApplicationProblemPlusJSONResponse) VisitSearchDocumentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetDocumentRequestObject struct {
-	TableName externalRef2.TableName        `json:"tableName"`
-	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
+type ValidateDocumentRequestObject struct {
+	TableName externalRef2.TableName `json:"tableName"`
+	Body      *ValidateDocumentJSONRequestBody
 }
 
-type GetDocumentResponseObject interface {
-	VisitGetDocumentResponse(w http.ResponseWriter) error
+type ValidateDocumentResponseObject interface {
+	VisitValidateDocumentResponse(w http.ResponseWriter) error
 }
 
-type GetDocument200JSONResponse EntityDocument
+type ValidateDocument200JSONResponse EntityValidationResult
 
-func (response GetDocument200JSONResponse) VisitGetDocumentResponse(w http.ResponseWriter) error {
+func (response ValidateDocument200JSONResponse) VisitValidateDocumentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDocumentdefaultApplicationProblemPlusJSONResponse struct {
+type ValidateDocumentdefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response GetDocumentdefaultApplicationProblemPlusJSONResponse) VisitGetDocumentResponse(w http.ResponseWriter) error {
+func (response ValidateDocumentdefaultApplicationProblemPlusJSONResponse) VisitValidateDocumentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UpdateDocumentRequestObject struct {
-	TableName externalRef2.TableName        `json:"tableName"`
-	EntityId  externalRef2.EntityIdentifier `json:"entityId"`
-	Body      *UpdateDocumentJSONRequestBody
+type WatchDocumentsRequestObject struct {
+	TableName externalRef2.TableName `json:"tableName"`
 }
 
-type UpdateDocumentResponseObject interface {
-	VisitUpdateDocumentResponse(w http.ResponseWriter) error
+type WatchDocumentsResponseObject interface {
+	VisitWatchDocumentsResponse(w http.ResponseWriter) error
 }
 
-type UpdateDocument200JSONResponse EntityDocument
+type WatchDocuments200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response UpdateDocument200JSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response WatchDocuments200TexteventStreamResponse) VisitWatchDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type UpdateDocumentdefaultApplicationProblemPlusJSONResponse struct {
+type WatchDocumentsdefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response UpdateDocumentdefaultApplicationProblemPlusJSONResponse) VisitUpdateDocumentResponse(w http.ResponseWriter) error {
+func (response WatchDocumentsdefaultApplicationProblemPlusJSONResponse) VisitWatchDocumentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
@@ -672,6 +1814,33 @@ type StrictServerInterface interface {
 	// Update document (partial)
 	// (PATCH /entities/{tableName}/documents/{entityId})
 	UpdateDocument(ctx context.Context, request UpdateDocumentRequestObject) (UpdateDocumentResponseObject, error)
+	// Diff two document versions
+	// (GET /entities/{tableName}/documents/{entityId}/diff)
+	DiffDocumentVersions(ctx context.Context, request DiffDocumentVersionsRequestObject) (DiffDocumentVersionsResponseObject, error)
+	// Get a document version
+	// (GET /entities/{tableName}/documents/{entityId}/versions/{entityVersion})
+	GetDocumentVersion(ctx context.Context, request GetDocumentVersionRequestObject) (GetDocumentVersionResponseObject, error)
+	// Get the anchoring proof of a document version
+	// (GET /entities/{tableName}/documents/{entityId}/versions/{entityVersion}/proof)
+	GetDocumentVersionProof(ctx context.Context, request GetDocumentVersionProofRequestObject) (GetDocumentVersionProofResponseObject, error)
+	// Verify document hashes
+	// (POST /entities/{tableName}/documents/{entityId}:verify)
+	VerifyDocument(ctx context.Context, request VerifyDocumentRequestObject) (VerifyDocumentResponseObject, error)
+	// Batch get documents
+	// (POST /entities/{tableName}/documents:batchGet)
+	BatchGetDocuments(ctx context.Context, request BatchGetDocumentsRequestObject) (BatchGetDocumentsResponseObject, error)
+	// Bulk import documents
+	// (POST /entities/{tableName}/documents:bulk)
+	BulkCreateDocuments(ctx context.Context, request BulkCreateDocumentsRequestObject) (BulkCreateDocumentsResponseObject, error)
+	// Search documents
+	// (GET /entities/{tableName}/documents:search)
+	SearchDocuments(ctx context.Context, request SearchDocumentsRequestObject) (SearchDocumentsResponseObject, error)
+	// Validate document (dry run)
+	// (POST /entities/{tableName}/documents:validate)
+	ValidateDocument(ctx context.Context, request ValidateDocumentRequestObject) (ValidateDocumentResponseObject, error)
+	// Watch document changes
+	// (GET /entities/{tableName}/documents:watch)
+	WatchDocuments(ctx context.Context, request WatchDocumentsRequestObject) (WatchDocumentsResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -818,18 +1987,39 @@ func (sh *strictHandler) GetDocument(w http.ResponseWriter, r *http.Request, tab
 }
 
 // UpdateDocument operation middleware
-func (sh *strictHandler) UpdateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) {
+func (sh *strictHandler) UpdateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params UpdateDocumentParams) {
 	var request UpdateDocumentRequestObject
 
 	request.TableName = tableName
 	request.EntityId = entityId
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
 
-	var body UpdateDocumentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+		var body UpdateDocumentJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json-patch+json") {
+
+		var body UpdateDocumentApplicationJSONPatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/merge-patch+json") {
+
+		var body UpdateDocumentApplicationMergePatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationMergePatchPlusJSONBody = &body
 	}
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.UpdateDocument(ctx, request.(UpdateDocumentRequestObject))
@@ -851,42 +2041,369 @@ func (sh *strictHandler) UpdateDocument(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// DiffDocumentVersions operation middleware
+func (sh *strictHandler) DiffDocumentVersions(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, params DiffDocumentVersionsParams) {
+	var request DiffDocumentVersionsRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffDocumentVersions(ctx, request.(DiffDocumentVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffDocumentVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffDocumentVersionsResponseObject); ok {
+		if err := validResponse.VisitDiffDocumentVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDocumentVersion operation middleware
+func (sh *strictHandler) GetDocumentVersion(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion) {
+	var request GetDocumentVersionRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+	request.EntityVersion = entityVersion
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentVersion(ctx, request.(GetDocumentVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentVersionResponseObject); ok {
+		if err := validResponse.VisitGetDocumentVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDocumentVersionProof operation middleware
+func (sh *strictHandler) GetDocumentVersionProof(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier, entityVersion externalRef2.SemanticVersion) {
+	var request GetDocumentVersionProofRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+	request.EntityVersion = entityVersion
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocumentVersionProof(ctx, request.(GetDocumentVersionProofRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocumentVersionProof")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentVersionProofResponseObject); ok {
+		if err := validResponse.VisitGetDocumentVersionProofResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyDocument operation middleware
+func (sh *strictHandler) VerifyDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, entityId externalRef2.EntityIdentifier) {
+	var request VerifyDocumentRequestObject
+
+	request.TableName = tableName
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyDocument(ctx, request.(VerifyDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyDocumentResponseObject); ok {
+		if err := validResponse.VisitVerifyDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchGetDocuments operation middleware
+func (sh *strictHandler) BatchGetDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	var request BatchGetDocumentsRequestObject
+
+	request.TableName = tableName
+
+	var body BatchGetDocumentsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchGetDocuments(ctx, request.(BatchGetDocumentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchGetDocuments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchGetDocumentsResponseObject); ok {
+		if err := validResponse.VisitBatchGetDocumentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BulkCreateDocuments operation middleware
+func (sh *strictHandler) BulkCreateDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	var request BulkCreateDocumentsRequestObject
+
+	request.TableName = tableName
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body BulkCreateDocumentsJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-ndjson") {
+		request.Body = r.Body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkCreateDocuments(ctx, request.(BulkCreateDocumentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkCreateDocuments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BulkCreateDocumentsResponseObject); ok {
+		if err := validResponse.VisitBulkCreateDocumentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchDocuments operation middleware
+func (sh *strictHandler) SearchDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName, params SearchDocumentsParams) {
+	var request SearchDocumentsRequestObject
+
+	request.TableName = tableName
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchDocuments(ctx, request.(SearchDocumentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchDocuments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchDocumentsResponseObject); ok {
+		if err := validResponse.VisitSearchDocumentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ValidateDocument operation middleware
+func (sh *strictHandler) ValidateDocument(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	var request ValidateDocumentRequestObject
+
+	request.TableName = tableName
+
+	var body ValidateDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateDocument(ctx, request.(ValidateDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ValidateDocumentResponseObject); ok {
+		if err := validResponse.VisitValidateDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WatchDocuments operation middleware
+func (sh *strictHandler) WatchDocuments(w http.ResponseWriter, r *http.Request, tableName externalRef2.TableName) {
+	var request WatchDocumentsRequestObject
+
+	request.TableName = tableName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchDocuments(ctx, request.(WatchDocumentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchDocuments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchDocumentsResponseObject); ok {
+		if err := validResponse.VisitWatchDocumentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZb3PbuPH+Khj8buaS31ESpfh6qe6Va6dXd3yJ6z99UUf1rIiViBwBMADoWOfRTD9H",
-	"3/Qr9iN0AJAURVKK7El7kzcJaQKLB7vP7rOAHmmiRK4kSmvo9JHmoEGgRe3fEiWEknc5LLkEy8Mjui8M",
-	"TaJ57v5Gp3Q84JLhAzLivhNZiDlqGlHuPn4sUK9oRCUIpFPqLUTUJCkKCKYWUGSWTscRFVxyUQj/bFe5",
-	"G8+lxSVqul5HO/Bc8V97ML31IIhaEG5RGJKjDuheCHgg4zh+uQegN9kLchJHVMBDiTKOn4HZKG27eK+U",
-	"tmTBMWMmIjhcDsm3DlA0SDSCRXZsv90B2Ntrgi1RGKu5XNK1QxE++qCeeHtvpOV2daqSQqC0l/ixQONR",
-	"5VrlqC1HPxj9sDPmnr/RuKBT+n+jDWVGpd1RtUvNBbf8Hs3dm3Kms7DgzhkRzWGVKfDGgDHudg7ZRWNB",
-	"qwuMWo6pMJK5YqsfiUF9j5o4DIVFQ1IwKVloJYhNuSGJkhalHdI6GGr+ARPrY6HxY8E1Mjq9rbHMOgMj",
-	"uu2cbqzOhCgszDMkGhOlGdGYazRuq3JJgPz56t1bwirceVYYItACAwsO2LaL6/g+3cfXXKCxIHIH+suG",
-	"Klj7K2rjt/xUk1coQFqeVAbWEeXmOHEfe9wpGU/ABfNTijZFHULJDbEpEvCzKk/fB4ON+M6VyhDKJU4x",
-	"Q4usu8a5WvIEMsL8ALLIYPkjcXRza8qwYB2xchFiUlVkjMyRpJwxlIFnZS0gLg05mn4oz6L6sZ5zq0Gv",
-	"AoNKLpN7yDhzHCGwBC6NbfolxKCH71XSP4cRNzdnpxsLX44FrRysKdvmWwN7G8TGtVEjdRr0atKgL7lv",
-	"cnZ4/TswjN1S01m2qwMX9ePPaKFv7aC1+wQmok0FPFyYImqVhezMSePWGvHOsRewxM+O7RRZL/YNSW0s",
-	"u2V3tsdleypVJ89PMo7SDkyR5xlHRng9liyUJryu3IFwZVkxQ3KcJJhbQ0CuSJKChsR1QWReWCIKY10R",
-	"kEoOUOR2RUAyApYIZSwZT143J8DCugKmuRBcLl1e4gOIPHO+u6Unx5engziOx0EGFjxDM4QsT8GL+z1K",
-	"q/Rq6lqWwdHE/Y2RT9ymxOSQoPMZCvWBD/79r3/+w/lMwMM5yqVN6XQ8ee1jXr9H7Uagz6PtFO32JeWA",
-	"TVH01giXRMAHpYeCS6WHOdgkdS4WYFt7Hg/jYUwjOhm+Gn7vQOdgLWpn/O/v37Pv3r8fNv77hh6E+9oF",
-	"8a1vgLql/hPqBAwSI+EXvPOPF8rYpcarv5yTEP8NMVpwE9DM3Hl6cO/xwqC+q4LVwn8Lg19n7p948Pu7",
-	"2f8fCr7W7a4WXr0jr38Xj4mtxjhP31yftFBO4sn3g3E8GL+6Hh9NX8XTOP6bw1ZGYEpdkRs4I4dB8hW/",
-	"g+byjyfkaDyZEPe5jDxtLFIUnO21r+YZCoYWeGbuLsLraXjtX+2H1/EPpBxIqpHtlikY7Bo4JmkhQA40",
-	"AgtJ/pBnEGosMTkmfMETYlWQe5UkhdYoE3SnBCepJd6+HaHWKhyK+nXgkfKqlnbmln8ArWHl3rdBv8uD",
-	"NSIgd0D8GWCQ4T1mlfI7+CWAnjLpOgKQCfb54+byjGhcYNimTcFuiB/aq9otT3KHsWCLnhBep0j+dH19",
-	"QcIAkijWIGBTUrjNehGbVGkbtQNpCiFcX7SNjHi70S6PP8cdLcsbpmveXaildmFPtXO6krb20VqoHtm6",
-	"vDn1AuUbv1Kbqn7UEGOVdqdr1GW/N/JFzHd9wZHhzOJ2cXxxRiN6X9Vzej92HlE5Ssg5ndJXw3h45EXZ",
-	"pj6Co6rWjR5tVVXXo3pxN2SJvjdydPdsdD0lPefGntajoq27g9v+VnEzZLTjbmEdPXOm7y+eNdufn9cz",
-	"F0uTK2lCOk/iOFyB+B7cJ75rKRI/Z/TBBK3cHLohy94t/Mbz/rJQP+zroVsnz07xaDEu2OzpnQ7r1Hf2",
-	"ouuZ5+o2R12jxkjGjXVpuOGHH1hej+x0WJlX33Udd9CRYp+O9EB944oleVEJykvvuLKGlMxtbCCiFpZe",
-	"VaskorN1h88tZ6Qr4w+UoZuYq0Iypywgtw9m7rTIpReM6vrGJd7m9qZOOdoMbThWPNFJPc3R2vE6V6Yn",
-	"fcM9UE21sDoa+wfFVk9i/j5s+y6b1ttsdlted5Jw/MWgtFOry5r6pqk8V9KIpgisvAw9V2HZbvG+uTyv",
-	"hKmcublK0GhUoRPcf0H39aVQCGy9z/4cWkefE5fRY3UHsA5+def2LlfDeX6Lq1ssOeoGpQ4mK+8Cvj4f",
-	"h11/xsdRvzj/hHa3u+LfIqkWrkR+hVH4CTdaQeYrEk48BwjGb1Dso95VG9dsX2rR7pXxOhyMk7TLxXDj",
-	"9l9Wmn3Xegcpzf8yKQLYjUx8hWkRtrDJjBc5aMshe7lDCtxkTArN7cpnxxxBoz4ubEqntzPHnvDLTsid",
-	"Qmd0SkeQ85E7v8xqm+0y/zNIWOL2Ly7hx77QmL2YQ/ILMpe4ZUOmMVeGW6VXLzcpUiNdz9b/CQAA//+y",
-	"OL50FB0AAA==",
+	"H4sIAAAAAAAC/+xd73IbN5J/FdTcVq10ISlKdrJZ6pPW9m50Zcc6y06q1tR6wJkmiWgGGAMYSdyUqu45",
+	"7su94j3CVTeAmeHMkKIc2Rtd8iWhyBmg0ej//QP8c5SovFASpDXR5Oeo4JrnYEHTX4nKcyU/FHwhJLfC",
+	"fQT8JQWTaFHgd9EkOhwKmcINpAx/Z7LMZ6CjQSTwx48l6FU0iCTPIZpENMIgMskScu6GmvMys9HkcBDl",
+	"Qoq8zOmzXRX4vJAWFqCj29vBBnrOxT97aPqeiGBqzoSF3LACtKNuL+c37HA83t9CIA3ZS+TReBDl/MZT",
+	"OR5/As1Gadul91xpy+YCstQMGIwWI/ZHJGgwTDRwC+mJ/eMGgmm8JrGeCmO1kIvo9vY2/Eib+hduk+Xf",
+	"wL6QVtjVc5WUOe7+G/hYgiHCCq0K0FYAPQ/03GlKfxAv8cMfNMyjSfRvB7X8HPhJDsKStciFFVdgPrzw",
+	"Y+BYc4GcIS6eutECG8OfFR+51nxFXNTwsRQa0mjyvkHQRfWkmv0EicVhNy7PFEoa6K6vWtJOa1sfNrqt",
+	"KPC04kKMQb53dthzGFImUsOuhV2q0jIuGU+QSyz1g46iwW607Mbnrbx089RE93K0zC6fkRCuL/4NGFKJ",
+	"TQLzMOS70X4AbYiL9x3yHHIurUjCADii1kr3bQ83SjK7BKbVNbvmhmlAHkB6zAoNBqRlSmYrdr0EyYzl",
+	"tjRMGDbnIoN0FFWsC4qH6prCTXeqv4NWwxk3aDCVEfgtGqowtfBUOHlhM5WuRlHXuAwiR4PjOVqg95E3",
+	"FtEgclRFFx2q2hJAJFZj3UcAzBsolO6RAD/3Fpus1XXN3v7FaRKv3TXzDjHt0VRTJglAugOlBUqP2Uiq",
+	"VZZnOyw3AXHVP0ZrU9yATQqrDa0Zc8+tqsz7Ttzs56Qbo228t1vvQbRtrM9sPQq+yhSnwXiakqbx7Kwx",
+	"odUlDFr7FmgkzTtmBvQVaIY0lBYMW3KzZHOtcmaXwrBESeutdms7WpsaaOnbN0f7syWXC3hxBbInQHjO",
+	"LUdZ4iwOnsJMrtHbxQyuPAWPyxKrJCm1xtjm/sO9FTkYy/Oi1uzaDDpSR7U19F+URbr+RQoZ2F3MJP06",
+	"qDm6RvzmHa0Chc52nuZ5afksQzufKJ0yDd7JCLlgnP3H+evvq5CAFVlpWA6Wp9zy7kZXMeIv5OOvXWBQ",
+	"9XpC5+9OhkdffxNcaMKlkiLhGfMahyosUyYsm/HkEt3r6Xz4CjWHWcUWJdcpc5JhGF9wIY1F905bw1ND",
+	"7ObWgsbJ/vGeD+fj4Z8vfv7m6e0fep2+OaGArmfPZSoSmuZ6CXYJ2lkQYYhuHwZ6cbhyq26YlZlSGXDp",
+	"pnjuBbczx0u1oLU7yWbzjC+OGVo5F7bQhJVY+UmYWaoyS9kM2FKkKUhn3nzSwzDfEGD6SfkkC3uiZ8Jq",
+	"rldOzL0JZVc8E6Sg1TY0+OIEpcfMhuzmU8T23bvT5/UIDyeqG1KWqK0UDdrbRNSs9XI/aKh5Q8qa0nC3",
+	"IXIuput2JVz/wLOyR2jp6xCTWq4XUMnNMVO5sLhfc6WZhlxdUQ3ALs0Ip1ZZusugGAn3D8nTdH3Aomnl",
+	"6VcKiGhiZBCtrs+ckwr32A6SvzMlpAXN9t789Rn75s/jw/3KlrgBUTJRg4Rdepr93vRE/a2tV0Xk5757",
+	"c56L+by7NY4G06Xd7aVhSqegIWWzFbFq5/yxVzJ64uSH9QpoWh7SJ1j1JdS2SXVzzkG1PXdv7w+gxRwd",
+	"gCf2cwZruTA5erg+ufG0oxdSphJlJhXLlFyApggXDDpHFHVjFUoXfjloylrDQ91D3L7jZvnKE9cnbOQD",
+	"ujS/rTwYXIFeBaKCA/NrZcK6+LzfV/mnzbMlJJfOed5RvdsgDY7I7oBrfN8sEGs86AgC18kS88RtTGiY",
+	"IF+p4KmLbshzY1HJjcLc3P388ClN+p0Pq+4T5Tx8cOe29P609G5Tw8HWw7ZWPKhZvXmvfnAxiVByY70L",
+	"60lmcwDUyLg7XGxLfyteNKYEwy5h5TSOysPHDPLCrrpyIIwLoB5JiNRQ9bZktnY0aNuWUMnvwZZtdI+e",
+	"aaV6XCyXybKvJvgS0gVoH6RgvqCVsshoU858mGJVb+XPjfgAKRnN+0v27XHmdFUNBn8O0Viw9iT6wpLl",
+	"C4y+b5aWAZ/f1+bhO6ehpFt5jnFfSTAHfZnBG6XsfScpgoi2klwxy7Ay4H2zrz8BQ5pYWQRnjQK6s0d+",
+	"RUSSTpxbKPoMkoY5aJAJbFQOkFav2FJlKZIXaPA9LM6s5tJgCqckE5SKW6ZkAmsb12FCt7JdgMQJolq1",
+	"eqN8Kql8T42xe6td9Wqn+FP9MtiWyvksjSSrKSxhT9ekotbssKLtRXhMVM7wjc0lJZ+9HDE0beSyDONF",
+	"kQlIMdWi0C3IiU+qG2nMThJTUfE6TBHd3lH97Xml2zDQKu9riZY6AbaWomFWiNke4zJliSpWveLTyROr",
+	"LJE+FBlP8JP/AofBUcDYB8gchfQs3pwikuvbnBb7zHfAPKW0VqRudM/ssq3fHb4vPyHwCx2rPhOVAq3c",
+	"NGwVoxJ5PhMSUuayhbAvGcxRD7RYLO3dFdhqXq9ofQt+R1W8HTsNO1auukX9zrTdHv9Z9fEVWN439wLW",
+	"nMhhnxNpoht2Bx34ftRp0OftjoqePeMLuPPZTjuDgBwNuERj2rVxL7awbEu00a13ZAKkHZoy2LTqWVIZ",
+	"UVXUnWH2lVQzYidJAoXFWGGFFR3NEwvasFlpWV5ihxWYVHLo4mpUNm5Zroxlh0ffNl/gc9R1q0WeC7kg",
+	"Ub7heZEh795Hz07ePB+Ox+NDZ/DnIgMz4lmx5ATcwBaN0qsJ2tnh0yP8LqWKEjMFTwB5Brn6SQz/93/+",
+	"+7+QZzm/eQlygbbn8Ohb2vPq7x69vDvY6iqsf6CuA9No6Cxy/pPSo1xIpUcFhb5zpXNuW2s+HI1H42gQ",
+	"HY2ejL5GohuWZDpNv5pOR43//SHaie63TR/erm5fg064AWYkv4QP9PFMGbvQcP6fL5nb/1owWuQmXKfm",
+	"A/5IijiISgP6Q9isFv3v+fCfF/if8fDPHy7+fVfiq+C9W/4/f82+/WZ8yGx4Bjn97u2zFpVH46Ovh4fj",
+	"4eGTt4dPJ0/Gk/H470ib34FJhEZuiIPsRhJlAr3RwtPDoyOGP/udjxqTlKVIt46vZhnkKVguMvPhzP35",
+	"3P3ZP9ufvh3/ifkHWXiy3cpyA3YHOGHLMudyiJUOp+Q3RcadjWWmgARLay66EYb55hzGmT538PT2rejz",
+	"JfCvCzcay3mBhFACP8zgCrLQ7EDyPQE9ZlJIY3lv9H3C3r05ZVV4zuyS21rwXUepYsu92FHH3q3q0xLY",
+	"d2/fngXoS6JS6IdDCJv1UmyWSttBeyNNmefYClqnjPmO6waOfwo7WiPXkq7FnVUlt6YtEfot7dZc9bit",
+	"N++ek4OiiNH7pqqFHyqZBWjf4jogI0Zho2OkK2HgKk7OTuuqYzSJrg5dtAuSFyKaRE9G49FTHxHSDh4E",
+	"W3fwc5XE3B5Uk+MjC+hJJV4KY01IEqrHRyw2StuYce9Q46ojFTOlWRxC3mk5Hj9JkAr6BDGt3yQ845rV",
+	"+s5yri8hncr4JiBI49AUWuv6sT3KJuNhmICnqQZjRomwq3j/mMVJqY3SMatjMOa3b43K0VRGxDCXiZym",
+	"fq0VTiYarGFg3/enQ/UjBxswsreDT3yTYqlPehu3ht5s2yD+sQQsIhqwzPGJabClxoicGxZLuLHPPP9m",
+	"K8ZZoeFKKFRxnmUj9iOWWzw4YYCbvIAYw3qxkCS6lJ4sYSozYQi9kChphcTSJcX2IW7CfpqbPsTyA3a9",
+	"FMkSLcoKgXTGMiVZhk1G58qN27A+2Ksbaivw9QJ12OE+SdSPxuOIYM3UbsaPlB67jszBT8bFSPV4PMte",
+	"z0kIPi9ktN6AHuvhODZXjoH4KAo51I3SUAjWwLgGJhWblxr/bijunfbNraQnUt+tirIx87m9IMu4viZM",
+	"C1KG0oJGv6LS+U8PtN64Td6Kf9Xdrl0I3Rq19JD6Al0z2wvhyz4xznssbzsaCxhEli8ohgsmO7q47ViU",
+	"FjOWK0OIDRe7zlSJ6qQawGBvA1OYCxlSYNIISvwrhWhWqeqtdUnsPZnUWxO7oNyf9mPdgDp8XyXgbnYw",
+	"9i8qXd1L3z4ZkHh7217ybUf1Dx+MlLZCd6Um/MZqBNoSeOqPVbxUdQ94/b13b15WyAP3Zo3V0WCoHrYd",
+	"6v/4VMhtbLXOfh26HdwVyhz8HCqzt46vGVjoyqpDyqzJ6pqUPN3SighgwcfHY7fqO3g8CKHgOsf+BnYz",
+	"u8b/CqWao4l8hLvwN6h9BQZaIt20ES2H8S8w9oPeWRvNj4eatNtkvHVlmKSn5n7mcPiGcSbhulHxCwUs",
+	"bzyrozQsbktkHKrqLie8XqqsKtVTOJrBVHbeGhJBX7kBQrH/aJ9C37WHc9AL6D79pyfffrNP4VloyLgm",
+	"wVSuN2I82HxoRAohrmYO8I8Rt4ZhP0bSgyNdqB4HeGvMEq61T3+nsq+Vagkwm2WgWYbhN2blA/rWIWLd",
+	"rO6chqtWPj08YmLezNHCWEtuKsCcETKBvmzLlegbFmVrcPRdg+RWNrq2hJpYd6xGSbb3sVSE6JUpuwZ+",
+	"iRnMXNzQJvjMZX8UIinnnWtBDxy8O714+EhnWxMDDcoW2dx9km47sT10W5LXx94d6xvEn9GAjAZsaUFP",
+	"N7KnunJXgPclfZHbozo6Q2/09PDoV+OJ3m7RlxrnF1BzlbQ/Pp/qNqJe5F7BtRU823+AOPIg9Xjc3hLZ",
+	"s3AeyGEkdZnYUvPMW0DDZmCvASSz16qFVjTBohmerx/7bMWqYj5vYEfp1bsM5l+4qfpfTYBmTwGFmu4P",
+	"58h7wLSdCrJDj+9GnlWflbiLL2Y/cBv7JPvcywyaETGf1wKzBBKaIC2PMd/A9eAa2sbH/B7x9ka897NL",
+	"gZnhOy/Vtxtt1Rsq9xqmJLSR062IeRDchtLMlAVoAymkI1Zhxt0ZD6s8sJiGw2sVZisfzxKklmcUZ6E3",
+	"Bw9MJiBYwTVIm636IsNGolljqn4N+WZg1WPOO3lHFX/XxD5N3DZ3Uyo/m1t6GEtwUKFIt9oD1HwHEmNC",
+	"JllJYk6vupPGndgxNOcIsOgx0QGIS2e1rBq4NNE1hBxEtG7LEvKzBafGtHGGjq9ChLK3AdgqDKtOd14F",
+	"JPbKAhvfjMdsrrJMXRM2firxJ+qwUluFxe+nkWtDVjykP2EaDVj4KTBy0y+end2fMZUO317Exwx4svSM",
+	"MxaKNdAbN1O5tojxzfiwSbvH8c4t5aquZeZgvgOP7I1r4GjcsMVS2YrtjHuersBOpevvsafjp7tZ2jMP",
+	"UP3M5nZtth5DdtoVwqZzqkzX47TBteZgk3SLmv1umh+/aZ5c4Wm/VftSrd9mNde3DdtOKGmm0BtP8ZNR",
+	"V/P+g3+d8NU3aRyM0x/vmsoq8yY3l0I68ADyHCNSgz4soLbDVS/uHCF7VR3oYzlgT3bt3F0od6rSGo9+",
+	"nsqTs9Nj/LSiGFjTJTkOgo8THI3HLJhZprm/BgBHxrK2tzsj9mx9/UZpa0LMjViOAUu1KsxUCmnEQtLB",
+	"UmmxgG2BAKWu8KnxT38jm/EkCO2QWGAsoTqJWFouS9BBGQYy0avChoPPBmlzjO9zJ3SudfXlm0Rr52l7",
+	"THLzd9rqx9q+cwyu3YQLDj61wjWZ+SvSemzT44cmbA5017sVVIRz0dXheNzA4wlJabJ2i9WiGLF38lKq",
+	"a0n3tlFa6wyEkCz2F6fF3epduIiuCS37HO2CO+7z+8Kl87uu39uSZxuXYA+Qr55R7szSI9RYYgNbwJ3g",
+	"oF3UtcwufwOqepoX5ONyPKWxpo6coYpl1Y14Lj/Ey7kwzwJBLpQ3kz819/iZ8IphSk+lhOtMSBimkCFt",
+	"kLp39lDf158nOC4+uz9iLyjuoIv5zC5X1BxPJT3F6NY3ajdKAyEEwLlcoka9ZibaD1fxAlXNqmsiZ0rb",
+	"cMBSEKv6XHF9/9tnNzt33TTX7ifeDGXanaKFVZXAtgC9fCjogqCwQ33Yxi9r8u64HrEPAAl6SAJFG+m3",
+	"/DGauTK7DIv45YbOAAbsG4tW5/QzdGHpA3dYS0MGVxgDz4U2NqAnJCJFwPhvgzZb0Dlaifhj7I6A0QkJ",
+	"1E+jcpjKEONT+OvLO/FcZBZ0zPY24twn7gt6y30T0ylO4LTgfTcX5RMOhYEPMm7dcQUch8ENT1yB/Ecs",
+	"o3HmuMIIH88SJediQT0jfw0kBrZQHwenxwKsWhgPjMAi1EomS62kKk22cs8HBk6lSxMGzCi6pVLaqouZ",
+	"cMksvwTG2RyumYFEyZSuhpmB89fHTKEBvhbGH/509OqSsq1wOMuM2A8ulVDzqazzC3fjsQMuY4LnmBPy",
+	"M8dxNHtKsvhmWAgRh1cqeD9OGhdCTDTwNEa7QEGZkn0G0gnRFrj/usz9VQMMLWKuqTt5TILjyK3qe1Tu",
+	"PPn++aZG5sc1EEnjVN/Xh0c9sOw2CS9QIKqk08mOKRFDYeoDF+5YysRpRnzsZQ63yZO5RiUenVIpBLfe",
+	"2xwmzq9RXoHdG2fk/PzT6ej9PyYXX01G/cfk6utCj8Y997DaFR1zwVz00w9O/NIjF4/jsMCvB7ZPkoiq",
+	"+Zjx+84c/GYQ/Ds44RDg/haKA6U0NQ4nwTu8qNLlz4sFJYzZnkesiNSfgh6sL3HAboYa5nWfy+xXgbuv",
+	"Jwq5mEouVxZVZsROZBX4N65vMlZkGbr8MknAmHmZETZzElCgvmYILlGK6f0Jm/PMQMysWrg7Rev2m+i7",
+	"P2pL+OIO8YWycLzfW+zz8vFrP+7x4K2r9j1gfQXH6hksCGNg9hiLjX6DG5i6FPPfUn4yps5dU70xsH9d",
+	"gMTa97nDPp/jnHQJNmqEBp678BhyYR2Eha67pgQQeeBOwTmdHQQksNLhBlwVbi6dIbI7nKNzmOc/GmZB",
+	"culLCufnL1hMg/sos4mf9iExnR5me/H6FdPxgMXrd0zHg6mM12+Zjj1ePOWW0wFKLlncufk7bma5x7RC",
+	"XC2mui7wNH7xQqVof7MVdZgvAYohz9D6jVi4GZTTeUDrIO8rSCcsoTs0TLjxFy1WSEbIsbujmnsa9hMl",
+	"JSRouPrMwI9N7LC5u+aPMfQBsXbo9nRdznsS+A5YDgUBCztuG+DqkYYcxLlaudxy/t8HHsgCSEot7IoW",
+	"MgOuQZ+UmAe9v0Af7U4+uGWWOosm0QEvxAEecb+omNPmwCsuEXy2dlm6+7d+HEv2sIjmnJ9nBXpPI6zS",
+	"q/16/RXLby9u/28ADsNnShNpAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,1169 @@
+// Package features provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package features
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// FeatureFlagsList request
+	FeatureFlagsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeatureFlagsCreateWithBody request with any body
+	FeatureFlagsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FeatureFlagsCreate(ctx context.Context, body FeatureFlagsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeatureFlagsDelete request
+	FeatureFlagsDelete(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeatureFlagsGet request
+	FeatureFlagsGet(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeatureFlagsUpdateWithBody request with any body
+	FeatureFlagsUpdateWithBody(ctx context.Context, flagKey FlagKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FeatureFlagsUpdate(ctx context.Context, flagKey FlagKey, body FeatureFlagsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeatureFlagsDeleteOverride request
+	FeatureFlagsDeleteOverride(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeatureFlagsSetOverrideWithBody request with any body
+	FeatureFlagsSetOverrideWithBody(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FeatureFlagsSetOverride(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, body FeatureFlagsSetOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FeaturesGet request
+	FeaturesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) FeatureFlagsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsCreateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsCreate(ctx context.Context, body FeatureFlagsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsCreateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsDelete(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsDeleteRequest(c.Server, flagKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsGet(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsGetRequest(c.Server, flagKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsUpdateWithBody(ctx context.Context, flagKey FlagKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsUpdateRequestWithBody(c.Server, flagKey, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsUpdate(ctx context.Context, flagKey FlagKey, body FeatureFlagsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsUpdateRequest(c.Server, flagKey, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsDeleteOverride(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsDeleteOverrideRequest(c.Server, flagKey, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsSetOverrideWithBody(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsSetOverrideRequestWithBody(c.Server, flagKey, tenantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeatureFlagsSetOverride(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, body FeatureFlagsSetOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeatureFlagsSetOverrideRequest(c.Server, flagKey, tenantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FeaturesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeaturesGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewFeatureFlagsListRequest generates requests for FeatureFlagsList
+func NewFeatureFlagsListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFeatureFlagsCreateRequest calls the generic FeatureFlagsCreate builder with application/json body
+func NewFeatureFlagsCreateRequest(server string, body FeatureFlagsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFeatureFlagsCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewFeatureFlagsCreateRequestWithBody generates requests for FeatureFlagsCreate with any type of body
+func NewFeatureFlagsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFeatureFlagsDeleteRequest generates requests for FeatureFlagsDelete
+func NewFeatureFlagsDeleteRequest(server string, flagKey FlagKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "flagKey", runtime.ParamLocationPath, flagKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFeatureFlagsGetRequest generates requests for FeatureFlagsGet
+func NewFeatureFlagsGetRequest(server string, flagKey FlagKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "flagKey", runtime.ParamLocationPath, flagKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFeatureFlagsUpdateRequest calls the generic FeatureFlagsUpdate builder with application/json body
+func NewFeatureFlagsUpdateRequest(server string, flagKey FlagKey, body FeatureFlagsUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFeatureFlagsUpdateRequestWithBody(server, flagKey, "application/json", bodyReader)
+}
+
+// NewFeatureFlagsUpdateRequestWithBody generates requests for FeatureFlagsUpdate with any type of body
+func NewFeatureFlagsUpdateRequestWithBody(server string, flagKey FlagKey, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "flagKey", runtime.ParamLocationPath, flagKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFeatureFlagsDeleteOverrideRequest generates requests for FeatureFlagsDeleteOverride
+func NewFeatureFlagsDeleteOverrideRequest(server string, flagKey FlagKey, tenantId externalRef2.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "flagKey", runtime.ParamLocationPath, flagKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags/%s/tenants/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFeatureFlagsSetOverrideRequest calls the generic FeatureFlagsSetOverride builder with application/json body
+func NewFeatureFlagsSetOverrideRequest(server string, flagKey FlagKey, tenantId externalRef2.UUID, body FeatureFlagsSetOverrideJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFeatureFlagsSetOverrideRequestWithBody(server, flagKey, tenantId, "application/json", bodyReader)
+}
+
+// NewFeatureFlagsSetOverrideRequestWithBody generates requests for FeatureFlagsSetOverride with any type of body
+func NewFeatureFlagsSetOverrideRequestWithBody(server string, flagKey FlagKey, tenantId externalRef2.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "flagKey", runtime.ParamLocationPath, flagKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/feature-flags/%s/tenants/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFeaturesGetRequest generates requests for FeaturesGet
+func NewFeaturesGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/features")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// FeatureFlagsListWithResponse request
+	FeatureFlagsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeatureFlagsListResponse, error)
+
+	// FeatureFlagsCreateWithBodyWithResponse request with any body
+	FeatureFlagsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FeatureFlagsCreateResponse, error)
+
+	FeatureFlagsCreateWithResponse(ctx context.Context, body FeatureFlagsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*FeatureFlagsCreateResponse, error)
+
+	// FeatureFlagsDeleteWithResponse request
+	FeatureFlagsDeleteWithResponse(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*FeatureFlagsDeleteResponse, error)
+
+	// FeatureFlagsGetWithResponse request
+	FeatureFlagsGetWithResponse(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*FeatureFlagsGetResponse, error)
+
+	// FeatureFlagsUpdateWithBodyWithResponse request with any body
+	FeatureFlagsUpdateWithBodyWithResponse(ctx context.Context, flagKey FlagKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FeatureFlagsUpdateResponse, error)
+
+	FeatureFlagsUpdateWithResponse(ctx context.Context, flagKey FlagKey, body FeatureFlagsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*FeatureFlagsUpdateResponse, error)
+
+	// FeatureFlagsDeleteOverrideWithResponse request
+	FeatureFlagsDeleteOverrideWithResponse(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, reqEditors ...RequestEditorFn) (*FeatureFlagsDeleteOverrideResponse, error)
+
+	// FeatureFlagsSetOverrideWithBodyWithResponse request with any body
+	FeatureFlagsSetOverrideWithBodyWithResponse(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FeatureFlagsSetOverrideResponse, error)
+
+	FeatureFlagsSetOverrideWithResponse(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, body FeatureFlagsSetOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*FeatureFlagsSetOverrideResponse, error)
+
+	// FeaturesGetWithResponse request
+	FeaturesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeaturesGetResponse, error)
+}
+
+type FeatureFlagsListResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeatureFlagList
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeatureFlagsCreateResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *FeatureFlag
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeatureFlagsDeleteResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeatureFlagsGetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeatureFlag
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeatureFlagsUpdateResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeatureFlag
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeatureFlagsDeleteOverrideResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeatureFlag
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsDeleteOverrideResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsDeleteOverrideResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeatureFlagsSetOverrideResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FeatureFlag
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeatureFlagsSetOverrideResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeatureFlagsSetOverrideResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeaturesGetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TenantFeatures
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r FeaturesGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeaturesGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// FeatureFlagsListWithResponse request returning *FeatureFlagsListResponse
+func (c *ClientWithResponses) FeatureFlagsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeatureFlagsListResponse, error) {
+	rsp, err := c.FeatureFlagsList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsListResponse(rsp)
+}
+
+// FeatureFlagsCreateWithBodyWithResponse request with arbitrary body returning *FeatureFlagsCreateResponse
+func (c *ClientWithResponses) FeatureFlagsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FeatureFlagsCreateResponse, error) {
+	rsp, err := c.FeatureFlagsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) FeatureFlagsCreateWithResponse(ctx context.Context, body FeatureFlagsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*FeatureFlagsCreateResponse, error) {
+	rsp, err := c.FeatureFlagsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsCreateResponse(rsp)
+}
+
+// FeatureFlagsDeleteWithResponse request returning *FeatureFlagsDeleteResponse
+func (c *ClientWithResponses) FeatureFlagsDeleteWithResponse(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*FeatureFlagsDeleteResponse, error) {
+	rsp, err := c.FeatureFlagsDelete(ctx, flagKey, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsDeleteResponse(rsp)
+}
+
+// FeatureFlagsGetWithResponse request returning *FeatureFlagsGetResponse
+func (c *ClientWithResponses) FeatureFlagsGetWithResponse(ctx context.Context, flagKey FlagKey, reqEditors ...RequestEditorFn) (*FeatureFlagsGetResponse, error) {
+	rsp, err := c.FeatureFlagsGet(ctx, flagKey, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsGetResponse(rsp)
+}
+
+// FeatureFlagsUpdateWithBodyWithResponse request with arbitrary body returning *FeatureFlagsUpdateResponse
+func (c *ClientWithResponses) FeatureFlagsUpdateWithBodyWithResponse(ctx context.Context, flagKey FlagKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FeatureFlagsUpdateResponse, error) {
+	rsp, err := c.FeatureFlagsUpdateWithBody(ctx, flagKey, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) FeatureFlagsUpdateWithResponse(ctx context.Context, flagKey FlagKey, body FeatureFlagsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*FeatureFlagsUpdateResponse, error) {
+	rsp, err := c.FeatureFlagsUpdate(ctx, flagKey, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsUpdateResponse(rsp)
+}
+
+// FeatureFlagsDeleteOverrideWithResponse request returning *FeatureFlagsDeleteOverrideResponse
+func (c *ClientWithResponses) FeatureFlagsDeleteOverrideWithResponse(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, reqEditors ...RequestEditorFn) (*FeatureFlagsDeleteOverrideResponse, error) {
+	rsp, err := c.FeatureFlagsDeleteOverride(ctx, flagKey, tenantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsDeleteOverrideResponse(rsp)
+}
+
+// FeatureFlagsSetOverrideWithBodyWithResponse request with arbitrary body returning *FeatureFlagsSetOverrideResponse
+func (c *ClientWithResponses) FeatureFlagsSetOverrideWithBodyWithResponse(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FeatureFlagsSetOverrideResponse, error) {
+	rsp, err := c.FeatureFlagsSetOverrideWithBody(ctx, flagKey, tenantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsSetOverrideResponse(rsp)
+}
+
+func (c *ClientWithResponses) FeatureFlagsSetOverrideWithResponse(ctx context.Context, flagKey FlagKey, tenantId externalRef2.UUID, body FeatureFlagsSetOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*FeatureFlagsSetOverrideResponse, error) {
+	rsp, err := c.FeatureFlagsSetOverride(ctx, flagKey, tenantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeatureFlagsSetOverrideResponse(rsp)
+}
+
+// FeaturesGetWithResponse request returning *FeaturesGetResponse
+func (c *ClientWithResponses) FeaturesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeaturesGetResponse, error) {
+	rsp, err := c.FeaturesGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeaturesGetResponse(rsp)
+}
+
+// ParseFeatureFlagsListResponse parses an HTTP response from a FeatureFlagsListWithResponse call
+func ParseFeatureFlagsListResponse(rsp *http.Response) (*FeatureFlagsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlagList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeatureFlagsCreateResponse parses an HTTP response from a FeatureFlagsCreateWithResponse call
+func ParseFeatureFlagsCreateResponse(rsp *http.Response) (*FeatureFlagsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeatureFlagsDeleteResponse parses an HTTP response from a FeatureFlagsDeleteWithResponse call
+func ParseFeatureFlagsDeleteResponse(rsp *http.Response) (*FeatureFlagsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeatureFlagsGetResponse parses an HTTP response from a FeatureFlagsGetWithResponse call
+func ParseFeatureFlagsGetResponse(rsp *http.Response) (*FeatureFlagsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeatureFlagsUpdateResponse parses an HTTP response from a FeatureFlagsUpdateWithResponse call
+func ParseFeatureFlagsUpdateResponse(rsp *http.Response) (*FeatureFlagsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeatureFlagsDeleteOverrideResponse parses an HTTP response from a FeatureFlagsDeleteOverrideWithResponse call
+func ParseFeatureFlagsDeleteOverrideResponse(rsp *http.Response) (*FeatureFlagsDeleteOverrideResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsDeleteOverrideResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeatureFlagsSetOverrideResponse parses an HTTP response from a FeatureFlagsSetOverrideWithResponse call
+func ParseFeatureFlagsSetOverrideResponse(rsp *http.Response) (*FeatureFlagsSetOverrideResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeatureFlagsSetOverrideResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureFlag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFeaturesGetResponse parses an HTTP response from a FeaturesGetWithResponse call
+func ParseFeaturesGetResponse(rsp *http.Response) (*FeaturesGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeaturesGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantFeatures
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,1217 @@
+// Package features provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package features
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	externalRef0 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/iam"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/pagination"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// CreateFeatureFlag defines model for CreateFeatureFlag.
+type CreateFeatureFlag struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+
+	// Key Lower-case words separated by dots or dashes, such as `entities.bulk-import`.
+	Key FeatureFlagKey `json:"key"`
+}
+
+// FeatureFlag defines model for FeatureFlag.
+type FeatureFlag struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt   externalRef2.Timestamp `json:"createdAt"`
+	Description *string                `json:"description,omitempty"`
+
+	// Enabled Default for tenants without an override.
+	Enabled bool `json:"enabled"`
+
+	// Key Lower-case words separated by dots or dashes, such as `entities.bulk-import`.
+	Key       FeatureFlagKey        `json:"key"`
+	Overrides []FeatureFlagOverride `json:"overrides"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
+
+	// UpdatedBy Subject of the admin who last changed the flag.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+}
+
+// FeatureFlagKey Lower-case words separated by dots or dashes, such as `entities.bulk-import`.
+type FeatureFlagKey = string
+
+// FeatureFlagList defines model for FeatureFlagList.
+type FeatureFlagList struct {
+	Items []FeatureFlag `json:"items"`
+}
+
+// FeatureFlagOverride defines model for FeatureFlagOverride.
+type FeatureFlagOverride struct {
+	Enabled bool `json:"enabled"`
+
+	// TenantId RFC 4122 UUID string
+	TenantId externalRef2.UUID `json:"tenantId"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
+	UpdatedBy *string                `json:"updatedBy,omitempty"`
+}
+
+// FeatureFlagOverrideInput defines model for FeatureFlagOverrideInput.
+type FeatureFlagOverrideInput struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TenantFeatures defines model for TenantFeatures.
+type TenantFeatures struct {
+	// Flags Every defined flag and whether it is on for the caller's tenant.
+	Flags map[string]bool `json:"flags"`
+}
+
+// UpdateFeatureFlag defines model for UpdateFeatureFlag.
+type UpdateFeatureFlag struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// FlagKey Lower-case words separated by dots or dashes, such as `entities.bulk-import`.
+type FlagKey = FeatureFlagKey
+
+// FeatureFlagsCreateJSONRequestBody defines body for FeatureFlagsCreate for application/json ContentType.
+type FeatureFlagsCreateJSONRequestBody = CreateFeatureFlag
+
+// FeatureFlagsUpdateJSONRequestBody defines body for FeatureFlagsUpdate for application/json ContentType.
+type FeatureFlagsUpdateJSONRequestBody = UpdateFeatureFlag
+
+// FeatureFlagsSetOverrideJSONRequestBody defines body for FeatureFlagsSetOverride for application/json ContentType.
+type FeatureFlagsSetOverrideJSONRequestBody = FeatureFlagOverrideInput
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List feature flags
+	// (GET /admin/feature-flags)
+	FeatureFlagsList(w http.ResponseWriter, r *http.Request)
+	// Create a feature flag
+	// (POST /admin/feature-flags)
+	FeatureFlagsCreate(w http.ResponseWriter, r *http.Request)
+	// Delete a feature flag and its overrides
+	// (DELETE /admin/feature-flags/{flagKey})
+	FeatureFlagsDelete(w http.ResponseWriter, r *http.Request, flagKey FlagKey)
+	// Get a feature flag
+	// (GET /admin/feature-flags/{flagKey})
+	FeatureFlagsGet(w http.ResponseWriter, r *http.Request, flagKey FlagKey)
+	// Update the description or default of a feature flag
+	// (PATCH /admin/feature-flags/{flagKey})
+	FeatureFlagsUpdate(w http.ResponseWriter, r *http.Request, flagKey FlagKey)
+	// Return a tenant to the flag's default
+	// (DELETE /admin/feature-flags/{flagKey}/tenants/{tenantId})
+	FeatureFlagsDeleteOverride(w http.ResponseWriter, r *http.Request, flagKey FlagKey, tenantId externalRef2.UUID)
+	// Override a feature flag for a tenant
+	// (PUT /admin/feature-flags/{flagKey}/tenants/{tenantId})
+	FeatureFlagsSetOverride(w http.ResponseWriter, r *http.Request, flagKey FlagKey, tenantId externalRef2.UUID)
+	// Get the feature flags of the caller's tenant
+	// (GET /features)
+	FeaturesGet(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List feature flags
+// (GET /admin/feature-flags)
+func (_ Unimplemented) FeatureFlagsList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a feature flag
+// (POST /admin/feature-flags)
+func (_ Unimplemented) FeatureFlagsCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a feature flag and its overrides
+// (DELETE /admin/feature-flags/{flagKey})
+func (_ Unimplemented) FeatureFlagsDelete(w http.ResponseWriter, r *http.Request, flagKey FlagKey) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a feature flag
+// (GET /admin/feature-flags/{flagKey})
+func (_ Unimplemented) FeatureFlagsGet(w http.ResponseWriter, r *http.Request, flagKey FlagKey) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the description or default of a feature flag
+// (PATCH /admin/feature-flags/{flagKey})
+func (_ Unimplemented) FeatureFlagsUpdate(w http.ResponseWriter, r *http.Request, flagKey FlagKey) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Return a tenant to the flag's default
+// (DELETE /admin/feature-flags/{flagKey}/tenants/{tenantId})
+func (_ Unimplemented) FeatureFlagsDeleteOverride(w http.ResponseWriter, r *http.Request, flagKey FlagKey, tenantId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Override a feature flag for a tenant
+// (PUT /admin/feature-flags/{flagKey}/tenants/{tenantId})
+func (_ Unimplemented) FeatureFlagsSetOverride(w http.ResponseWriter, r *http.Request, flagKey FlagKey, tenantId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the feature flags of the caller's tenant
+// (GET /features)
+func (_ Unimplemented) FeaturesGet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// FeatureFlagsList operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeatureFlagsCreate operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeatureFlagsDelete operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", chi.URLParam(r, "flagKey"), &flagKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsDelete(w, r, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeatureFlagsGet operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", chi.URLParam(r, "flagKey"), &flagKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsGet(w, r, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeatureFlagsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", chi.URLParam(r, "flagKey"), &flagKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsUpdate(w, r, flagKey)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeatureFlagsDeleteOverride operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsDeleteOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", chi.URLParam(r, "flagKey"), &flagKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tenantId" -------------
+	var tenantId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", chi.URLParam(r, "tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsDeleteOverride(w, r, flagKey, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeatureFlagsSetOverride operation middleware
+func (siw *ServerInterfaceWrapper) FeatureFlagsSetOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flagKey" -------------
+	var flagKey FlagKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagKey", chi.URLParam(r, "flagKey"), &flagKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flagKey", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tenantId" -------------
+	var tenantId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", chi.URLParam(r, "tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"features:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeatureFlagsSetOverride(w, r, flagKey, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FeaturesGet operation middleware
+func (siw *ServerInterfaceWrapper) FeaturesGet(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FeaturesGet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/feature-flags", wrapper.FeatureFlagsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/feature-flags", wrapper.FeatureFlagsCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/feature-flags/{flagKey}", wrapper.FeatureFlagsDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/feature-flags/{flagKey}", wrapper.FeatureFlagsGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/admin/feature-flags/{flagKey}", wrapper.FeatureFlagsUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/feature-flags/{flagKey}/tenants/{tenantId}", wrapper.FeatureFlagsDeleteOverride)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/feature-flags/{flagKey}/tenants/{tenantId}", wrapper.FeatureFlagsSetOverride)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/features", wrapper.FeaturesGet)
+	})
+
+	return r
+}
+
+type FeatureFlagsListRequestObject struct {
+}
+
+type FeatureFlagsListResponseObject interface {
+	VisitFeatureFlagsListResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsList200JSONResponse FeatureFlagList
+
+func (response FeatureFlagsList200JSONResponse) VisitFeatureFlagsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeatureFlagsListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsListdefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeatureFlagsCreateRequestObject struct {
+	Body *FeatureFlagsCreateJSONRequestBody
+}
+
+type FeatureFlagsCreateResponseObject interface {
+	VisitFeatureFlagsCreateResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsCreate201JSONResponse FeatureFlag
+
+func (response FeatureFlagsCreate201JSONResponse) VisitFeatureFlagsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeatureFlagsCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsCreatedefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeatureFlagsDeleteRequestObject struct {
+	FlagKey FlagKey `json:"flagKey"`
+}
+
+type FeatureFlagsDeleteResponseObject interface {
+	VisitFeatureFlagsDeleteResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsDelete204Response struct {
+}
+
+func (response FeatureFlagsDelete204Response) VisitFeatureFlagsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type FeatureFlagsDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsDeletedefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeatureFlagsGetRequestObject struct {
+	FlagKey FlagKey `json:"flagKey"`
+}
+
+type FeatureFlagsGetResponseObject interface {
+	VisitFeatureFlagsGetResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsGet200JSONResponse FeatureFlag
+
+func (response FeatureFlagsGet200JSONResponse) VisitFeatureFlagsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeatureFlagsGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsGetdefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeatureFlagsUpdateRequestObject struct {
+	FlagKey FlagKey `json:"flagKey"`
+	Body    *FeatureFlagsUpdateJSONRequestBody
+}
+
+type FeatureFlagsUpdateResponseObject interface {
+	VisitFeatureFlagsUpdateResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsUpdate200JSONResponse FeatureFlag
+
+func (response FeatureFlagsUpdate200JSONResponse) VisitFeatureFlagsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeatureFlagsUpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsUpdatedefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeatureFlagsDeleteOverrideRequestObject struct {
+	FlagKey  FlagKey           `json:"flagKey"`
+	TenantId externalRef2.UUID `json:"tenantId"`
+}
+
+type FeatureFlagsDeleteOverrideResponseObject interface {
+	VisitFeatureFlagsDeleteOverrideResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsDeleteOverride200JSONResponse FeatureFlag
+
+func (response FeatureFlagsDeleteOverride200JSONResponse) VisitFeatureFlagsDeleteOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeatureFlagsDeleteOverridedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsDeleteOverridedefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsDeleteOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeatureFlagsSetOverrideRequestObject struct {
+	FlagKey  FlagKey           `json:"flagKey"`
+	TenantId externalRef2.UUID `json:"tenantId"`
+	Body     *FeatureFlagsSetOverrideJSONRequestBody
+}
+
+type FeatureFlagsSetOverrideResponseObject interface {
+	VisitFeatureFlagsSetOverrideResponse(w http.ResponseWriter) error
+}
+
+type FeatureFlagsSetOverride200JSONResponse FeatureFlag
+
+func (response FeatureFlagsSetOverride200JSONResponse) VisitFeatureFlagsSetOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeatureFlagsSetOverridedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeatureFlagsSetOverridedefaultApplicationProblemPlusJSONResponse) VisitFeatureFlagsSetOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FeaturesGetRequestObject struct {
+}
+
+type FeaturesGetResponseObject interface {
+	VisitFeaturesGetResponse(w http.ResponseWriter) error
+}
+
+type FeaturesGet200JSONResponse TenantFeatures
+
+func (response FeaturesGet200JSONResponse) VisitFeaturesGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FeaturesGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response FeaturesGetdefaultApplicationProblemPlusJSONResponse) VisitFeaturesGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List feature flags
+	// (GET /admin/feature-flags)
+	FeatureFlagsList(ctx context.Context, request FeatureFlagsListRequestObject) (FeatureFlagsListResponseObject, error)
+	// Create a feature flag
+	// (POST /admin/feature-flags)
+	FeatureFlagsCreate(ctx context.Context, request FeatureFlagsCreateRequestObject) (FeatureFlagsCreateResponseObject, error)
+	// Delete a feature flag and its overrides
+	// (DELETE /admin/feature-flags/{flagKey})
+	FeatureFlagsDelete(ctx context.Context, request FeatureFlagsDeleteRequestObject) (FeatureFlagsDeleteResponseObject, error)
+	// Get a feature flag
+	// (GET /admin/feature-flags/{flagKey})
+	FeatureFlagsGet(ctx context.Context, request FeatureFlagsGetRequestObject) (FeatureFlagsGetResponseObject, error)
+	// Update the description or default of a feature flag
+	// (PATCH /admin/feature-flags/{flagKey})
+	FeatureFlagsUpdate(ctx context.Context, request FeatureFlagsUpdateRequestObject) (FeatureFlagsUpdateResponseObject, error)
+	// Return a tenant to the flag's default
+	// (DELETE /admin/feature-flags/{flagKey}/tenants/{tenantId})
+	FeatureFlagsDeleteOverride(ctx context.Context, request FeatureFlagsDeleteOverrideRequestObject) (FeatureFlagsDeleteOverrideResponseObject, error)
+	// Override a feature flag for a tenant
+	// (PUT /admin/feature-flags/{flagKey}/tenants/{tenantId})
+	FeatureFlagsSetOverride(ctx context.Context, request FeatureFlagsSetOverrideRequestObject) (FeatureFlagsSetOverrideResponseObject, error)
+	// Get the feature flags of the caller's tenant
+	// (GET /features)
+	FeaturesGet(ctx context.Context, request FeaturesGetRequestObject) (FeaturesGetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// FeatureFlagsList operation middleware
+func (sh *strictHandler) FeatureFlagsList(w http.ResponseWriter, r *http.Request) {
+	var request FeatureFlagsListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsList(ctx, request.(FeatureFlagsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsListResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeatureFlagsCreate operation middleware
+func (sh *strictHandler) FeatureFlagsCreate(w http.ResponseWriter, r *http.Request) {
+	var request FeatureFlagsCreateRequestObject
+
+	var body FeatureFlagsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsCreate(ctx, request.(FeatureFlagsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsCreateResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeatureFlagsDelete operation middleware
+func (sh *strictHandler) FeatureFlagsDelete(w http.ResponseWriter, r *http.Request, flagKey FlagKey) {
+	var request FeatureFlagsDeleteRequestObject
+
+	request.FlagKey = flagKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsDelete(ctx, request.(FeatureFlagsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsDeleteResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeatureFlagsGet operation middleware
+func (sh *strictHandler) FeatureFlagsGet(w http.ResponseWriter, r *http.Request, flagKey FlagKey) {
+	var request FeatureFlagsGetRequestObject
+
+	request.FlagKey = flagKey
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsGet(ctx, request.(FeatureFlagsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsGetResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeatureFlagsUpdate operation middleware
+func (sh *strictHandler) FeatureFlagsUpdate(w http.ResponseWriter, r *http.Request, flagKey FlagKey) {
+	var request FeatureFlagsUpdateRequestObject
+
+	request.FlagKey = flagKey
+
+	var body FeatureFlagsUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsUpdate(ctx, request.(FeatureFlagsUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsUpdateResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeatureFlagsDeleteOverride operation middleware
+func (sh *strictHandler) FeatureFlagsDeleteOverride(w http.ResponseWriter, r *http.Request, flagKey FlagKey, tenantId externalRef2.UUID) {
+	var request FeatureFlagsDeleteOverrideRequestObject
+
+	request.FlagKey = flagKey
+	request.TenantId = tenantId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsDeleteOverride(ctx, request.(FeatureFlagsDeleteOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsDeleteOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsDeleteOverrideResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsDeleteOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeatureFlagsSetOverride operation middleware
+func (sh *strictHandler) FeatureFlagsSetOverride(w http.ResponseWriter, r *http.Request, flagKey FlagKey, tenantId externalRef2.UUID) {
+	var request FeatureFlagsSetOverrideRequestObject
+
+	request.FlagKey = flagKey
+	request.TenantId = tenantId
+
+	var body FeatureFlagsSetOverrideJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeatureFlagsSetOverride(ctx, request.(FeatureFlagsSetOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeatureFlagsSetOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeatureFlagsSetOverrideResponseObject); ok {
+		if err := validResponse.VisitFeatureFlagsSetOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FeaturesGet operation middleware
+func (sh *strictHandler) FeaturesGet(w http.ResponseWriter, r *http.Request) {
+	var request FeaturesGetRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FeaturesGet(ctx, request.(FeaturesGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FeaturesGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FeaturesGetResponseObject); ok {
+		if err := validResponse.VisitFeaturesGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+RZbXPbuBH+KzvozdwbZclOrnflfco5ydVzmYknsb/Uo8YQsTRxJgEWWMpRPfrvHQAk",
+	"TZGUFN3YvTb5YosisNiXZ59drO5ZootSK1RkWXzPSm54gYTGP73O+c1vuHIfpWIxKzllLGKKF8hiltZv",
+	"I2bwX5U0KFhMpsKI2STDgrttXxlMWcz+Mn04Zhre2ulr5FQZbA5Zr9fNTn/4qUFO2Fnk9TO6REMS/RKB",
+	"NjGyJKmVeyz4xzeobihj8Q+zWcRoVTo9LRmpbtg6Yqj4IkcRtqa8yonFKc8ttmsXWufIlVt8i6uDLei6",
+	"4spLmLei9eJ3TMhJ3mlT4s0WL2jf4YkuCq0+lEYWkuQS7YcLWaAlXpTukJ5vdvuis5S9DJ6BVBsgVFyR",
+	"hTtJma4IuAK9RGOkwCP2SE6LWCPS2y8JC3uAjLf1ZrZu9eHGcC+4KsWj+LKW88tq6K33lQ8r6BQoQ+Ci",
+	"kAruMg05twRJxtUNCv/KpUvHaU0kRjDzEJyub6IONLqm7UHYbzii9Rt9h2aScItwp42wYNFlPqGAxQqE",
+	"JgvagOA2QxuBrZIMuIVrVCQdTo8WVX47kUWpDV07mzqZd+wyr+REaNxJ/7zik3/P3Z/Z5G/z7765Opq0",
+	"T99/+91XbCRNO9q/kZaGOdJi5FCwDEHSc38QuMelLeQGinWSapgbIZnOxOFgvLw8e/lkeN6Nx1bpLig/",
+	"GXyNp85UWdFB7uqp0awcO+/Cq1ifaoenuMTzH7gQ0iUAz883FgxDtZktr5ZoViAwlQqFz2PgSsBdhpSh",
+	"AUkgLWgVGDNDSHieo/na1vTpEgQ/8qLMMRg9TKJQONcD43peCJaM+eDSR+SpiuVIcAYa7IJc/3R29v4t",
+	"/PTX2TFQswakgsuL0wdXWWfwyezkh8nxbHL87OL4efxsFs9m/3D2p9oUnFjMnNUTJ2SMR7Yk0kCbd69P",
+	"4fnxyQm411Dv7xxSVVLslK8XORYCicvcfjgPjy/D4/hpP/40+xHqhdCsjAYBc98PBbyArCq4mhjkwoUI",
+	"8GOZc8Xda7AlJjKVCZAGyhwuk6QyBlWCTY2q9R2zCI3RZmeutIw72NsvvptKvy2DNCh46RRJJeZikuMS",
+	"c1jyXIqgfq3ACL6kssRVgmP+uHx3BgZTDGZSxgmkcHmWSrTe5tYtB7nDEqdqJIQXGcLfLy7OISyARIsO",
+	"AKUivEHjfSIpH9XYZtpQ1A+krYqCm1VPM/Byo20e/yPu6El+QLqRezuUYFPrnCEbrX20Uj1UreFoELrg",
+	"UkGiFRmeUAwlmklgS0jDIs+zrvnQYKS9XTXfW0i4ggWC0XmOAlxbWu9crBrGhVc8yQJTZ9wChzLn5Kyc",
+	"3EmBUDf/nsV1A8yOCm3T9TPwIMU7UWi0oDQBfpQ2kH6aHoFDQ2q0IlQCXCztWBUI9riFBVxPG2Ouf25V",
+	"C72jhYIrflObD5USaOB66t81uyb+3fURnPr2MniE+C1CVbq8fzYDi4lWThHtNEoyQF/DXpyfudgnaK1v",
+	"RQM82TnPi5XhjpHcEhaxJRobYrY89i16iYqXksXs2dHs6DnzLV7mU2NMN/f9DdIQAaGUepe6OwVIstB3",
+	"egTaCDShF73FldPUUZBniDPxgCNX56zvEB1CbamVDSR1Mpu5f4mPideCl2UuEy9h+rsNFfDgW6o/ysN7",
+	"FNYhZIH66svlVh3qBPz+MF0+qeCMKPjKsSp801Seb31OW0wqI2nF4qt7tkBu0LyoXDtwxRp0xj60bL6e",
+	"R6zmJnd5cODfyFIHJfcvvmp98Tq0KuuIlTq08NtDGG769RwBLf2ixerR4jccI6w3Ga3uvHoAOn4KAO0D",
+	"D9Q3vM8fQyEqjl075u+A0Toa5ZnpfT2EWgeqyZFGKuKpFnUZtCTzHJIMk1vbXsrBIlrXxfNA6Dvp5mU4",
+	"Y4CX51urXTgj6PYFRDY4qBdZX2kd13fHGdspoy4d26PwK/63OH9fyn7+Af0V6dPzNNqYIF+N2/ewZNpO",
+	"AeduJyXZ7riHa+4TVYrhHfqTKsWfAjuoRzCfP/xCVDxZd47x48m6i9fpI9aRaT3ynt43k69eadlXGtrh",
+	"4J8HlEYFMFjo5ZcAkndIlVHAm7uEH34EMHxtG6A8BW1Fo7+MdYamf+ynsW0jYE+T1Z7i+B5pA4SPz5Rb",
+	"J7z/W4TZ5oElbb6ENGgN7nVfbjDd5MYeckw7Y/RdbdhTt2C9of6Y65Y8r/xPVv9n9+6NzsrTVPcu3Qz/",
+	"euOjYdh8xHaiJaADzbJhtcrkLGZTXsqpG+vMW5m7ri47NKrp7iFKUV/Q+cZwS1oynLRxv5bkq4GAGojr",
+	"+fo/AwDxkOnilyAAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/iam.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef1.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/pagination.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef2.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/primitives.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef3.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/problemdetails.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
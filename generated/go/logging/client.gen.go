@@ -0,0 +1,664 @@
+// Package logging provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// LoggingLevelGet request
+	LoggingLevelGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoggingLevelSetWithBody request with any body
+	LoggingLevelSetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoggingLevelSet(ctx context.Context, body LoggingLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoggingComponentLevelDelete request
+	LoggingComponentLevelDelete(ctx context.Context, component LogComponent, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoggingComponentLevelSetWithBody request with any body
+	LoggingComponentLevelSetWithBody(ctx context.Context, component LogComponent, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoggingComponentLevelSet(ctx context.Context, component LogComponent, body LoggingComponentLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) LoggingLevelGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoggingLevelGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoggingLevelSetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoggingLevelSetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoggingLevelSet(ctx context.Context, body LoggingLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoggingLevelSetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoggingComponentLevelDelete(ctx context.Context, component LogComponent, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoggingComponentLevelDeleteRequest(c.Server, component)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoggingComponentLevelSetWithBody(ctx context.Context, component LogComponent, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoggingComponentLevelSetRequestWithBody(c.Server, component, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoggingComponentLevelSet(ctx context.Context, component LogComponent, body LoggingComponentLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoggingComponentLevelSetRequest(c.Server, component, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewLoggingLevelGetRequest generates requests for LoggingLevelGet
+func NewLoggingLevelGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/logging/level")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoggingLevelSetRequest calls the generic LoggingLevelSet builder with application/json body
+func NewLoggingLevelSetRequest(server string, body LoggingLevelSetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoggingLevelSetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoggingLevelSetRequestWithBody generates requests for LoggingLevelSet with any type of body
+func NewLoggingLevelSetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/logging/level")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoggingComponentLevelDeleteRequest generates requests for LoggingComponentLevelDelete
+func NewLoggingComponentLevelDeleteRequest(server string, component LogComponent) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "component", runtime.ParamLocationPath, component)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/logging/level/components/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoggingComponentLevelSetRequest calls the generic LoggingComponentLevelSet builder with application/json body
+func NewLoggingComponentLevelSetRequest(server string, component LogComponent, body LoggingComponentLevelSetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoggingComponentLevelSetRequestWithBody(server, component, "application/json", bodyReader)
+}
+
+// NewLoggingComponentLevelSetRequestWithBody generates requests for LoggingComponentLevelSet with any type of body
+func NewLoggingComponentLevelSetRequestWithBody(server string, component LogComponent, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "component", runtime.ParamLocationPath, component)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/logging/level/components/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LoggingLevelGetWithResponse request
+	LoggingLevelGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LoggingLevelGetResponse, error)
+
+	// LoggingLevelSetWithBodyWithResponse request with any body
+	LoggingLevelSetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoggingLevelSetResponse, error)
+
+	LoggingLevelSetWithResponse(ctx context.Context, body LoggingLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*LoggingLevelSetResponse, error)
+
+	// LoggingComponentLevelDeleteWithResponse request
+	LoggingComponentLevelDeleteWithResponse(ctx context.Context, component LogComponent, reqEditors ...RequestEditorFn) (*LoggingComponentLevelDeleteResponse, error)
+
+	// LoggingComponentLevelSetWithBodyWithResponse request with any body
+	LoggingComponentLevelSetWithBodyWithResponse(ctx context.Context, component LogComponent, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoggingComponentLevelSetResponse, error)
+
+	LoggingComponentLevelSetWithResponse(ctx context.Context, component LogComponent, body LoggingComponentLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*LoggingComponentLevelSetResponse, error)
+}
+
+type LoggingLevelGetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LogLevels
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r LoggingLevelGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoggingLevelGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoggingLevelSetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LogLevels
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r LoggingLevelSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoggingLevelSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoggingComponentLevelDeleteResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LogLevels
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r LoggingComponentLevelDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoggingComponentLevelDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoggingComponentLevelSetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LogLevels
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r LoggingComponentLevelSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoggingComponentLevelSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// LoggingLevelGetWithResponse request returning *LoggingLevelGetResponse
+func (c *ClientWithResponses) LoggingLevelGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LoggingLevelGetResponse, error) {
+	rsp, err := c.LoggingLevelGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoggingLevelGetResponse(rsp)
+}
+
+// LoggingLevelSetWithBodyWithResponse request with arbitrary body returning *LoggingLevelSetResponse
+func (c *ClientWithResponses) LoggingLevelSetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoggingLevelSetResponse, error) {
+	rsp, err := c.LoggingLevelSetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoggingLevelSetResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoggingLevelSetWithResponse(ctx context.Context, body LoggingLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*LoggingLevelSetResponse, error) {
+	rsp, err := c.LoggingLevelSet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoggingLevelSetResponse(rsp)
+}
+
+// LoggingComponentLevelDeleteWithResponse request returning *LoggingComponentLevelDeleteResponse
+func (c *ClientWithResponses) LoggingComponentLevelDeleteWithResponse(ctx context.Context, component LogComponent, reqEditors ...RequestEditorFn) (*LoggingComponentLevelDeleteResponse, error) {
+	rsp, err := c.LoggingComponentLevelDelete(ctx, component, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoggingComponentLevelDeleteResponse(rsp)
+}
+
+// LoggingComponentLevelSetWithBodyWithResponse request with arbitrary body returning *LoggingComponentLevelSetResponse
+func (c *ClientWithResponses) LoggingComponentLevelSetWithBodyWithResponse(ctx context.Context, component LogComponent, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoggingComponentLevelSetResponse, error) {
+	rsp, err := c.LoggingComponentLevelSetWithBody(ctx, component, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoggingComponentLevelSetResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoggingComponentLevelSetWithResponse(ctx context.Context, component LogComponent, body LoggingComponentLevelSetJSONRequestBody, reqEditors ...RequestEditorFn) (*LoggingComponentLevelSetResponse, error) {
+	rsp, err := c.LoggingComponentLevelSet(ctx, component, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoggingComponentLevelSetResponse(rsp)
+}
+
+// ParseLoggingLevelGetResponse parses an HTTP response from a LoggingLevelGetWithResponse call
+func ParseLoggingLevelGetResponse(rsp *http.Response) (*LoggingLevelGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoggingLevelGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLevels
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoggingLevelSetResponse parses an HTTP response from a LoggingLevelSetWithResponse call
+func ParseLoggingLevelSetResponse(rsp *http.Response) (*LoggingLevelSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoggingLevelSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLevels
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoggingComponentLevelDeleteResponse parses an HTTP response from a LoggingComponentLevelDeleteWithResponse call
+func ParseLoggingComponentLevelDeleteResponse(rsp *http.Response) (*LoggingComponentLevelDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoggingComponentLevelDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLevels
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoggingComponentLevelSetResponse parses an HTTP response from a LoggingComponentLevelSetWithResponse call
+func ParseLoggingComponentLevelSetResponse(rsp *http.Response) (*LoggingComponentLevelSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoggingComponentLevelSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLevels
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
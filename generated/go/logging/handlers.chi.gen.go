@@ -0,0 +1,742 @@
+// Package logging provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package logging
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	externalRef0 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/iam"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/pagination"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for LogLevel.
+const (
+	Debug LogLevel = "debug"
+	Error LogLevel = "error"
+	Info  LogLevel = "info"
+	Warn  LogLevel = "warn"
+)
+
+// LogComponent Logger name, such as `entities` or `scheduler`.
+type LogComponent = string
+
+// LogLevel defines model for LogLevel.
+type LogLevel string
+
+// LogLevelInput defines model for LogLevelInput.
+type LogLevelInput struct {
+	Level LogLevel `json:"level"`
+}
+
+// LogLevels defines model for LogLevels.
+type LogLevels struct {
+	// Components Component overrides keyed by component.
+	Components map[string]LogLevel `json:"components"`
+	Level      LogLevel            `json:"level"`
+}
+
+// LoggingLevelSetJSONRequestBody defines body for LoggingLevelSet for application/json ContentType.
+type LoggingLevelSetJSONRequestBody = LogLevelInput
+
+// LoggingComponentLevelSetJSONRequestBody defines body for LoggingComponentLevelSet for application/json ContentType.
+type LoggingComponentLevelSetJSONRequestBody = LogLevelInput
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the log levels
+	// (GET /admin/logging/level)
+	LoggingLevelGet(w http.ResponseWriter, r *http.Request)
+	// Change the default log level
+	// (PUT /admin/logging/level)
+	LoggingLevelSet(w http.ResponseWriter, r *http.Request)
+	// Return a component to the default log level
+	// (DELETE /admin/logging/level/components/{component})
+	LoggingComponentLevelDelete(w http.ResponseWriter, r *http.Request, component LogComponent)
+	// Override the log level of a component
+	// (PUT /admin/logging/level/components/{component})
+	LoggingComponentLevelSet(w http.ResponseWriter, r *http.Request, component LogComponent)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Get the log levels
+// (GET /admin/logging/level)
+func (_ Unimplemented) LoggingLevelGet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change the default log level
+// (PUT /admin/logging/level)
+func (_ Unimplemented) LoggingLevelSet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Return a component to the default log level
+// (DELETE /admin/logging/level/components/{component})
+func (_ Unimplemented) LoggingComponentLevelDelete(w http.ResponseWriter, r *http.Request, component LogComponent) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Override the log level of a component
+// (PUT /admin/logging/level/components/{component})
+func (_ Unimplemented) LoggingComponentLevelSet(w http.ResponseWriter, r *http.Request, component LogComponent) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// LoggingLevelGet operation middleware
+func (siw *ServerInterfaceWrapper) LoggingLevelGet(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"logging:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoggingLevelGet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LoggingLevelSet operation middleware
+func (siw *ServerInterfaceWrapper) LoggingLevelSet(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"logging:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoggingLevelSet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LoggingComponentLevelDelete operation middleware
+func (siw *ServerInterfaceWrapper) LoggingComponentLevelDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "component" -------------
+	var component LogComponent
+
+	err = runtime.BindStyledParameterWithOptions("simple", "component", chi.URLParam(r, "component"), &component, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "component", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"logging:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoggingComponentLevelDelete(w, r, component)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LoggingComponentLevelSet operation middleware
+func (siw *ServerInterfaceWrapper) LoggingComponentLevelSet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "component" -------------
+	var component LogComponent
+
+	err = runtime.BindStyledParameterWithOptions("simple", "component", chi.URLParam(r, "component"), &component, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "component", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"logging:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoggingComponentLevelSet(w, r, component)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/logging/level", wrapper.LoggingLevelGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/logging/level", wrapper.LoggingLevelSet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/logging/level/components/{component}", wrapper.LoggingComponentLevelDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/logging/level/components/{component}", wrapper.LoggingComponentLevelSet)
+	})
+
+	return r
+}
+
+type LoggingLevelGetRequestObject struct {
+}
+
+type LoggingLevelGetResponseObject interface {
+	VisitLoggingLevelGetResponse(w http.ResponseWriter) error
+}
+
+type LoggingLevelGet200JSONResponse LogLevels
+
+func (response LoggingLevelGet200JSONResponse) VisitLoggingLevelGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoggingLevelGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response LoggingLevelGetdefaultApplicationProblemPlusJSONResponse) VisitLoggingLevelGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LoggingLevelSetRequestObject struct {
+	Body *LoggingLevelSetJSONRequestBody
+}
+
+type LoggingLevelSetResponseObject interface {
+	VisitLoggingLevelSetResponse(w http.ResponseWriter) error
+}
+
+type LoggingLevelSet200JSONResponse LogLevels
+
+func (response LoggingLevelSet200JSONResponse) VisitLoggingLevelSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoggingLevelSetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response LoggingLevelSetdefaultApplicationProblemPlusJSONResponse) VisitLoggingLevelSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LoggingComponentLevelDeleteRequestObject struct {
+	Component LogComponent `json:"component"`
+}
+
+type LoggingComponentLevelDeleteResponseObject interface {
+	VisitLoggingComponentLevelDeleteResponse(w http.ResponseWriter) error
+}
+
+type LoggingComponentLevelDelete200JSONResponse LogLevels
+
+func (response LoggingComponentLevelDelete200JSONResponse) VisitLoggingComponentLevelDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoggingComponentLevelDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response LoggingComponentLevelDeletedefaultApplicationProblemPlusJSONResponse) VisitLoggingComponentLevelDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type LoggingComponentLevelSetRequestObject struct {
+	Component LogComponent `json:"component"`
+	Body      *LoggingComponentLevelSetJSONRequestBody
+}
+
+type LoggingComponentLevelSetResponseObject interface {
+	VisitLoggingComponentLevelSetResponse(w http.ResponseWriter) error
+}
+
+type LoggingComponentLevelSet200JSONResponse LogLevels
+
+func (response LoggingComponentLevelSet200JSONResponse) VisitLoggingComponentLevelSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoggingComponentLevelSetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response LoggingComponentLevelSetdefaultApplicationProblemPlusJSONResponse) VisitLoggingComponentLevelSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the log levels
+	// (GET /admin/logging/level)
+	LoggingLevelGet(ctx context.Context, request LoggingLevelGetRequestObject) (LoggingLevelGetResponseObject, error)
+	// Change the default log level
+	// (PUT /admin/logging/level)
+	LoggingLevelSet(ctx context.Context, request LoggingLevelSetRequestObject) (LoggingLevelSetResponseObject, error)
+	// Return a component to the default log level
+	// (DELETE /admin/logging/level/components/{component})
+	LoggingComponentLevelDelete(ctx context.Context, request LoggingComponentLevelDeleteRequestObject) (LoggingComponentLevelDeleteResponseObject, error)
+	// Override the log level of a component
+	// (PUT /admin/logging/level/components/{component})
+	LoggingComponentLevelSet(ctx context.Context, request LoggingComponentLevelSetRequestObject) (LoggingComponentLevelSetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// LoggingLevelGet operation middleware
+func (sh *strictHandler) LoggingLevelGet(w http.ResponseWriter, r *http.Request) {
+	var request LoggingLevelGetRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoggingLevelGet(ctx, request.(LoggingLevelGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoggingLevelGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoggingLevelGetResponseObject); ok {
+		if err := validResponse.VisitLoggingLevelGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoggingLevelSet operation middleware
+func (sh *strictHandler) LoggingLevelSet(w http.ResponseWriter, r *http.Request) {
+	var request LoggingLevelSetRequestObject
+
+	var body LoggingLevelSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoggingLevelSet(ctx, request.(LoggingLevelSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoggingLevelSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoggingLevelSetResponseObject); ok {
+		if err := validResponse.VisitLoggingLevelSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoggingComponentLevelDelete operation middleware
+func (sh *strictHandler) LoggingComponentLevelDelete(w http.ResponseWriter, r *http.Request, component LogComponent) {
+	var request LoggingComponentLevelDeleteRequestObject
+
+	request.Component = component
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoggingComponentLevelDelete(ctx, request.(LoggingComponentLevelDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoggingComponentLevelDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoggingComponentLevelDeleteResponseObject); ok {
+		if err := validResponse.VisitLoggingComponentLevelDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoggingComponentLevelSet operation middleware
+func (sh *strictHandler) LoggingComponentLevelSet(w http.ResponseWriter, r *http.Request, component LogComponent) {
+	var request LoggingComponentLevelSetRequestObject
+
+	request.Component = component
+
+	var body LoggingComponentLevelSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoggingComponentLevelSet(ctx, request.(LoggingComponentLevelSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoggingComponentLevelSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoggingComponentLevelSetResponseObject); ok {
+		if err := validResponse.VisitLoggingComponentLevelSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+RXQW/bRhP9K4P5crDz0ZLSFkirW+q0qYEAMdL0lKjxiByJmyx32dmhHNXgfy92SVGy",
+	"JAdOESAwehFEcjn75s2bN8sbzH1Ve8dOA05vMOQlV5T+vvTL882zeF1wyMXUarzDaXy6ZAFHFWcQmrwE",
+	"CnDFTo0aDlfgBa5isKKxLFcjzLCiTy/ZLbXE6ZPJJMOaVFlirD/f0tnfs/gzOfvpbPb45N270d6t08eP",
+	"MENd14xTDCrGLbHNIoqXvGIb8bFrKpy+xYLnzRIzNG7hMcNrEocZsogXnH0mxoWrm5RoLb5miWnEK7uJ",
+	"/0h4gVP833jL2LinazzgaNsMhf9qjHARwXRvb7f18w+c6+624XDL2yWhojCRdLKXt1bdD0+2V7ehpOBX",
+	"LGIKDvCR11zAfA1DpBEeQfw1mMh2kztGS+6ryrv3tfi55apgJWPD+8vu8nl3eajG17+ew9MfJ0+hXwib",
+	"ldketV3AwwDPoGwqcmfCVNDcMvCn2pKj+BhCzblZmBzUg5YmgM/zRoRdzuAXoCVDj/eYRpPyPltJo1yl",
+	"Pwfv9jdIhNaHtXxVd9GgojoCWRi2xVkiGlZkTdHB7wEcIdu4oORyPsbHH68vQHjBXZpakoIpYn8vDIeU",
+	"80DLF9ERlLQ5UsI3JcNvb95cQrcAcl/w9n3jlJcsiROj9ijiUHrRbL+QoakqkvUeMkhxs7sY/zd07EVe",
+	"eKlIcYqNmMON9pqjy2kg57Ax2rY3tKNGbNwScu9UKFc4oaIy7sw7uz6dQuQByBWQl+SWnJBWxpmqqcD6",
+	"JQResRgd+Hl2eQGkII1TE6194SXdvy69TTnmHEJ09/iAIBi3tLw1jgyCh6htUi8BcnLALtUh2TLYHu21",
+	"0dI3CgTCQUl0BOcJYACqa7vuWq2Ds1Fpxzq5cM3SUR4p5KApP0tBIaK2YHQTNSQj6wSDl2SrtVD0iBgX",
+	"M1yxhI7F1ZNYfF+zo9rgFL8fTUY/YBpSZRLrOLE67vGPBy9ccpoZXcrGu4tiW5NkhC9YMRY71N6Frt+/",
+	"m0w6m3faz9aYs8lTgPGH4N12FN/Xb0OnkT2zT22pqdC2XxXXLKix+hkIvZT//2VQ7mXdR1D+Ev0JTjYe",
+	"fpq6o29bnOIL1lTsnSQyVFqG2Dg90zhrM+zH917nxrRih3qISt+ZcVsNumEWRrncXcrf+1Im0f3si/VX",
+	"r2J3Bmlv24NKw+23ktDzTiwd872JFA9QRedb+ys2KW0EdVRPbXa053ch3wz/2054lpXvdIPh5JXIft4t",
+	"/lZlfdULHoQrv3qQFX3N2ogD2unofmjcr8DR3IUqVpb45AZN3DQaPmboqGKcbg+ruN+Q2f2rMBQe23Z2",
+	"l03Z4IG2XhXT2EaDOVt/Hefayc5HFeTRtsLOd9ZIKXwMV6fQOMshzcg1lLRKujcC/voeVndbp/9Fzxua",
+	"I6iXB9kbQwa3Bmc84+20y3Hbi3E4b+KZMHXFnElYnjXxu/0t9l44Tc6Is6jnwLLatFAjFqc4ptqM44lq",
+	"Nuywr/dLSxpPyJACmaD9gTGeWrftt4HVztp/BgAHrdbNqRAAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/iam.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef1.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/pagination.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef2.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/primitives.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef3.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/problemdetails.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
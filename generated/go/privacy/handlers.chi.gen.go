@@ -0,0 +1,629 @@
+// Package privacy provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package privacy
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	externalRef0 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/iam"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/pagination"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ErasureMode.
+const (
+	Anonymize ErasureMode = "anonymize"
+	Purge     ErasureMode = "purge"
+)
+
+// Defines values for ErasureStatus.
+const (
+	Failed    ErasureStatus = "failed"
+	Queued    ErasureStatus = "queued"
+	Running   ErasureStatus = "running"
+	Succeeded ErasureStatus = "succeeded"
+)
+
+// CreateErasureRequest defines model for CreateErasureRequest.
+type CreateErasureRequest struct {
+	Mode ErasureMode `json:"mode"`
+
+	// SubjectId Value the `x-subject-id` properties of the subject's documents hold.
+	SubjectId string `json:"subjectId"`
+
+	// UserId RFC 4122 UUID string
+	UserId *externalRef2.UUID `json:"userId,omitempty"`
+}
+
+// ErasedEntity defines model for ErasedEntity.
+type ErasedEntity struct {
+	// EntityId Client-supplied identifier for immutable entity records. Accepts any characters but must be non-empty and at most 128 characters after trimming.
+	EntityId externalRef2.EntityIdentifier `json:"entityId"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+
+	// Versions Stored versions anonymized or removed.
+	Versions int `json:"versions"`
+}
+
+// ErasureMode defines model for ErasureMode.
+type ErasureMode string
+
+// ErasureReport defines model for ErasureReport.
+type ErasureReport struct {
+	// ArchivedObjects Archive objects in tenant storage that still hold earlier versions of the erased documents and need separate handling.
+	ArchivedObjects []string `json:"archivedObjects"`
+
+	// CompletedAt ISO 8601 timestamp in UTC
+	CompletedAt externalRef2.Timestamp `json:"completedAt"`
+	Entities    []ErasedEntity         `json:"entities"`
+	Mode        ErasureMode            `json:"mode"`
+
+	// RequestId RFC 4122 UUID string
+	RequestId externalRef2.UUID `json:"requestId"`
+	SubjectId string            `json:"subjectId"`
+
+	// TenantId RFC 4122 UUID string
+	TenantId    externalRef2.UUID `json:"tenantId"`
+	UserRemoved bool              `json:"userRemoved"`
+}
+
+// ErasureRequest defines model for ErasureRequest.
+type ErasureRequest struct {
+	Attempts int `json:"attempts"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// FinishedAt ISO 8601 timestamp in UTC
+	FinishedAt *externalRef2.Timestamp `json:"finishedAt,omitempty"`
+	LastError  *string                 `json:"lastError,omitempty"`
+	Mode       ErasureMode             `json:"mode"`
+	Report     *ErasureReport          `json:"report,omitempty"`
+
+	// RequestId RFC 4122 UUID string
+	RequestId externalRef2.UUID `json:"requestId"`
+
+	// Signature `hmac-sha256=<hex>` over the report serialized as JSON with sorted keys and no whitespace, keyed with the deployment's report signing key. Set once the erasure succeeded.
+	Signature *string       `json:"signature,omitempty"`
+	Status    ErasureStatus `json:"status"`
+	SubjectId string        `json:"subjectId"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
+
+	// UserId RFC 4122 UUID string
+	UserId *externalRef2.UUID `json:"userId,omitempty"`
+}
+
+// ErasureStatus defines model for ErasureStatus.
+type ErasureStatus string
+
+// PrivacyErasureRequestsCreateJSONRequestBody defines body for PrivacyErasureRequestsCreate for application/json ContentType.
+type PrivacyErasureRequestsCreateJSONRequestBody = CreateErasureRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Request the erasure of a data subject
+	// (POST /privacy/erasure-requests)
+	PrivacyErasureRequestsCreate(w http.ResponseWriter, r *http.Request)
+	// Get an erasure request with its report
+	// (GET /privacy/erasure-requests/{requestId})
+	PrivacyErasureRequestsGet(w http.ResponseWriter, r *http.Request, requestId externalRef2.UUID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Request the erasure of a data subject
+// (POST /privacy/erasure-requests)
+func (_ Unimplemented) PrivacyErasureRequestsCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an erasure request with its report
+// (GET /privacy/erasure-requests/{requestId})
+func (_ Unimplemented) PrivacyErasureRequestsGet(w http.ResponseWriter, r *http.Request, requestId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// PrivacyErasureRequestsCreate operation middleware
+func (siw *ServerInterfaceWrapper) PrivacyErasureRequestsCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"privacy:erase"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PrivacyErasureRequestsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PrivacyErasureRequestsGet operation middleware
+func (siw *ServerInterfaceWrapper) PrivacyErasureRequestsGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", chi.URLParam(r, "requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"privacy:erase"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PrivacyErasureRequestsGet(w, r, requestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/privacy/erasure-requests", wrapper.PrivacyErasureRequestsCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/privacy/erasure-requests/{requestId}", wrapper.PrivacyErasureRequestsGet)
+	})
+
+	return r
+}
+
+type PrivacyErasureRequestsCreateRequestObject struct {
+	Body *PrivacyErasureRequestsCreateJSONRequestBody
+}
+
+type PrivacyErasureRequestsCreateResponseObject interface {
+	VisitPrivacyErasureRequestsCreateResponse(w http.ResponseWriter) error
+}
+
+type PrivacyErasureRequestsCreate202ResponseHeaders struct {
+	Location string
+}
+
+type PrivacyErasureRequestsCreate202JSONResponse struct {
+	Body    ErasureRequest
+	Headers PrivacyErasureRequestsCreate202ResponseHeaders
+}
+
+func (response PrivacyErasureRequestsCreate202JSONResponse) VisitPrivacyErasureRequestsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PrivacyErasureRequestsCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response PrivacyErasureRequestsCreatedefaultApplicationProblemPlusJSONResponse) VisitPrivacyErasureRequestsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PrivacyErasureRequestsGetRequestObject struct {
+	RequestId externalRef2.UUID `json:"requestId"`
+}
+
+type PrivacyErasureRequestsGetResponseObject interface {
+	VisitPrivacyErasureRequestsGetResponse(w http.ResponseWriter) error
+}
+
+type PrivacyErasureRequestsGet200JSONResponse ErasureRequest
+
+func (response PrivacyErasureRequestsGet200JSONResponse) VisitPrivacyErasureRequestsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrivacyErasureRequestsGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response PrivacyErasureRequestsGetdefaultApplicationProblemPlusJSONResponse) VisitPrivacyErasureRequestsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Request the erasure of a data subject
+	// (POST /privacy/erasure-requests)
+	PrivacyErasureRequestsCreate(ctx context.Context, request PrivacyErasureRequestsCreateRequestObject) (PrivacyErasureRequestsCreateResponseObject, error)
+	// Get an erasure request with its report
+	// (GET /privacy/erasure-requests/{requestId})
+	PrivacyErasureRequestsGet(ctx context.Context, request PrivacyErasureRequestsGetRequestObject) (PrivacyErasureRequestsGetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// PrivacyErasureRequestsCreate operation middleware
+func (sh *strictHandler) PrivacyErasureRequestsCreate(w http.ResponseWriter, r *http.Request) {
+	var request PrivacyErasureRequestsCreateRequestObject
+
+	var body PrivacyErasureRequestsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PrivacyErasureRequestsCreate(ctx, request.(PrivacyErasureRequestsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PrivacyErasureRequestsCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PrivacyErasureRequestsCreateResponseObject); ok {
+		if err := validResponse.VisitPrivacyErasureRequestsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PrivacyErasureRequestsGet operation middleware
+func (sh *strictHandler) PrivacyErasureRequestsGet(w http.ResponseWriter, r *http.Request, requestId externalRef2.UUID) {
+	var request PrivacyErasureRequestsGetRequestObject
+
+	request.RequestId = requestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PrivacyErasureRequestsGet(ctx, request.(PrivacyErasureRequestsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PrivacyErasureRequestsGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PrivacyErasureRequestsGetResponseObject); ok {
+		if err := validResponse.VisitPrivacyErasureRequestsGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9RYbW/byPH/KoP9H5C7fylZUh4uVdAXbpKmLtKLz3ZaoI5rrbkjcWNyl5ldytYZAvo5",
+	"+qZfsR+hmF2KokTFdnwGir6xRXJ2Hn/ztDcitUVpDRrvxPhGuDTDQoafrwmlx7ckXUV4hF8qdJ7fl2RL",
+	"JK8xUBVWIf//jnAqxuL/9tb89mpmezWPPzPpMhGuuviMqT9QfE6hS0mXXlsjxuIvMq8QfIYwue7VdD2t",
+	"JrAWCnYaCOqvTxwom1YFC4TM5qovElHI6/doZj4T49HzF4kotFk9DxPhFyWKsXCetJmxQpVDOlB3WZHa",
+	"orDmvCRdaK/n6M4/fjx4I5bLRBB+qTShEuPTlnVJdM5ZI9GGLyyRPYLqrfHaL7o+xfD+IRq9rU8yh6lG",
+	"YlleXuT4kyzw29mdNEeXiZgjOW2N60bt2FtCBSsCkMaaRaF/QQWWgLCwc4xx0UYXVSHGg8Yn2nicIXXc",
+	"uNY6WfujpcTXvLrCWXAjizoVjToiEWVFs3ZI1iBogF5a2oFzSWmm56g+BGk7nLAfCSCq40Ab8Gik8eC8",
+	"JTljWEt+0HkegAooKddIa7/VyMYAjhaspVFgEBU4LCVJj5BJo3JtZuxU7bEI+nRMql9IIrngZ455jh7V",
+	"vn8AFnSBzsuiFMs6IrVnGvl3lYAG8DtUe0AZoViSHp64W5Wo674Qvl/DnwvLUYR/S8KFtTlK04H82qCW",
+	"7KRbUFru3xSRdFC6GfNbcuar9V16j0UZAX9b+iYiDf3i12Nrqo122SMwyqXzb4ks7YzugxC3qg33OFQX",
+	"kkcCqp4Z6SvCbt2ZZIVMey6To+cvfvepGgyephlehx84ATtHCkUl6g4OScs8lGbp4E/HH36CK+0zcJY8",
+	"KrjERV1uLFxl2qMrZYoJv0cVKZmZwjK3Cy5OT1zDWc+MNjMm7cMxerAmxaaeVcQdO00RVWwFnXg4L33l",
+	"7unc40h8ZwpXpXoUTD7qhNDO8x3JXTsiWadeO7faNt2Sz8eNN1dd8EuFVagRVBkOVBBeB0QkYip1jmpn",
+	"Z7zHmNFB5etco/E9V5VlrlGBbmhhagl0UVShw0Ps7UCYWlKuD/tpimXoeQtIM0ky9UgOLioPReU8XCAY",
+	"a3rsmEVAqvRQWOdhOHrZPiCnnpFPuijqPonXkkuhY2e83j960xsMBkORcL2b6hxdX+ZlJkUitJmj8ZYW",
+	"Y+2x6D0b8bsa/SEfOCJY2M+69+9//fMf4mxj4ByOXt45cN42aXVc+d5eIaXSITgjL/E8/Dy0zs8Ij39+",
+	"D9GPawdvmZpKUu58q2Ocr4xm5UsGGrGov5/K3i9n/GfQ++352f9/J+6nfJMqHeUPjj/AyxeDIfgVDQ9G",
+	"H09eb2k5Goye94aD3vDpyfDZ+OlgPBj8jXWbWiqkF2PBmO8xk/upFBKvo83RH17Ds+FoBPwZ6vMtIVWl",
+	"1a387UWOhUIvde7OD+Pjm/i4W9qPLwc/Qk0IK8pkq8dGhjtGSsiqQpoeoVQxWa7LXBrJn8GVmOqpTsFb",
+	"8Jl2YNO0IkKuuvUgWeu7yyLkvhgbvFKaGcr8cEOp+4+Vm0p/KCM3KGTJikw15qqX4xxzmMtcq6h+rcCO",
+	"CqaN89KkuMsfH48OgHCK0cwwUDfAd8Hmxi3f5I5189mUeJIh/PHk5BAiAaSxRHeHH699vlNjl1nyyXYg",
+	"XVUUkhZbmkHgm3zN4w9xxxbnNdJJdwVtr2DBpsY53W6zDNGa2q5qh6TnMl2AsoXUBlJrPBfmMSjp5Wp1",
+	"bwaD79+9OTwC0rPMM5rr1z/04Tg2VigkXa7MWV0DtK1dtG8EQDarE2gH8sJWPhbvySfRvlX4JMbgqcLJ",
+	"K5CmUabuzusl1vESG/ZGBzhHWqzZ8xrHM09beqvT8QqYIaQyz5GeuHofTMDWKZIv6uU4xqqQPs2YHVfo",
+	"JDS3ui2CDOMVKqjHec6gOHeFWSqCTxzKvFiQ5HoD+4cH63VZjMV8yECyJRpZajEWT/uD/jMRin8WgL9X",
+	"xpjt1Y7o1Y4IH0vrfDfKP/NI4TZmvKi0r8g4bvKotPSYL16BhAuZXs7IVkbBlaVLJKCKqXwfDm2e11Mq",
+	"H0Xm4WxFKUJlvM5hEjE44YBOmrFlwpGZxMll0odJE7IJXCKWUbPNNTrNUVL4oGnrjinhZzQpLUo/CcST",
+	"616p9cblkzY1BtzGnccrmASETDYCuhYd4CejkXq98ffhrxkamMTxMljn0Iez9d0Bf+HXka0Cb20fjmoH",
+	"Px88hStmYOyOKZyPpdZM9ayiOHOzFaH6Hqh1jm4ugC7e+olma/m9VeGGilMYTQCB5JkuDYz2Pjtr1veG",
+	"dw3IO68Ul5t1hzMyvHClNS72otFg9Gg67JK+CeuaApqJOUOpMHbL9zYK7SbDx6P37QucdilZgVkkLR23",
+	"a2/UYyqr3N9ia13Pf/NtNt9rftnpCbIE368GmR9Ci6h7Fw84tX1tm+0U5EaVF4nwchaGvBpx4ozZfLXe",
+	"7N00C9KSDZth8MJ9wPsOvehAZ/BfgM4q8lMud+J/L7Lv0O/qiaGMab/a+XeGllsKyQJ9yJjTG6FZHrcZ",
+	"kQgTNpyNDXgz85NvtXt71Q7YcphWFK7WT2/EBUpC2q94GTsVNerGbBqKs+UZU9N8pWxFuRiLPVnqPe6Y",
+	"Z42F2+n+ZscgszZw5Y7l2fI/AwAos5ByYhkAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/iam.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef1.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/pagination.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef2.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/primitives.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef3.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/problemdetails.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
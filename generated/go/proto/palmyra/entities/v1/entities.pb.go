@@ -0,0 +1,1077 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: palmyra/entities/v1/entities.proto
+
+package entitiesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Document is a version of a tenant document.
+type Document struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	EntityVersion string                 `protobuf:"bytes,2,opt,name=entity_version,json=entityVersion,proto3" json:"entity_version,omitempty"`
+	SchemaId      string                 `protobuf:"bytes,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	SchemaVersion string                 `protobuf:"bytes,4,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Payload       *structpb.Struct       `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Hash          string                 `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsDeleted     bool                   `protobuf:"varint,9,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Document) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *Document) GetEntityVersion() string {
+	if x != nil {
+		return x.EntityVersion
+	}
+	return ""
+}
+
+func (x *Document) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *Document) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Document) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Document) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Document) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Document) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Document) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+type GetDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableName     string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRequest) ProtoMessage() {}
+
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDocumentRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *GetDocumentRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type ListDocumentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TableName string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Sort expression as accepted by the REST list endpoint, e.g. "-created_at".
+	Sort string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Cursor is the next_cursor of a previous page; when set, page is ignored.
+	Cursor        string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsRequest) Reset() {
+	*x = ListDocumentsRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDocumentsRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *ListDocumentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDocumentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDocumentsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListDocumentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Document            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalItems    int32                  `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsResponse) Reset() {
+	*x = ListDocumentsResponse{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDocumentsResponse) GetItems() []*Document {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDocumentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDocumentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDocumentsResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListDocumentsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListDocumentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type BatchGetDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableName     string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	EntityIds     []string               `protobuf:"bytes,2,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetDocumentsRequest) Reset() {
+	*x = BatchGetDocumentsRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDocumentsRequest) ProtoMessage() {}
+
+func (x *BatchGetDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetDocumentsRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *BatchGetDocumentsRequest) GetEntityIds() []string {
+	if x != nil {
+		return x.EntityIds
+	}
+	return nil
+}
+
+type BatchGetDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Document            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Missing       []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetDocumentsResponse) Reset() {
+	*x = BatchGetDocumentsResponse{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetDocumentsResponse) ProtoMessage() {}
+
+func (x *BatchGetDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetDocumentsResponse) GetItems() []*Document {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchGetDocumentsResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type CreateDocumentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TableName string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// EntityId is generated when empty.
+	EntityId      string           `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Payload       *structpb.Struct `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDocumentRequest) Reset() {
+	*x = CreateDocumentRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentRequest) ProtoMessage() {}
+
+func (x *CreateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateDocumentRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type BulkCreateItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// EntityId is generated when empty.
+	EntityId      string           `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Payload       *structpb.Struct `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateItem) Reset() {
+	*x = BulkCreateItem{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateItem) ProtoMessage() {}
+
+func (x *BulkCreateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateItem.ProtoReflect.Descriptor instead.
+func (*BulkCreateItem) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BulkCreateItem) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *BulkCreateItem) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type BulkCreateDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableName     string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Items         []*BulkCreateItem      `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateDocumentsRequest) Reset() {
+	*x = BulkCreateDocumentsRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateDocumentsRequest) ProtoMessage() {}
+
+func (x *BulkCreateDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BulkCreateDocumentsRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *BulkCreateDocumentsRequest) GetItems() []*BulkCreateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// BulkCreateResult is the outcome of the item at index: the created document or the reason it failed.
+type BulkCreateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Document      *Document              `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateResult) Reset() {
+	*x = BulkCreateResult{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateResult) ProtoMessage() {}
+
+func (x *BulkCreateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateResult.ProtoReflect.Descriptor instead.
+func (*BulkCreateResult) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkCreateResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkCreateResult) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *BulkCreateResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkCreateDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*BulkCreateResult    `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateDocumentsResponse) Reset() {
+	*x = BulkCreateDocumentsResponse{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateDocumentsResponse) ProtoMessage() {}
+
+func (x *BulkCreateDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkCreateDocumentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkCreateDocumentsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkCreateDocumentsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkCreateDocumentsResponse) GetResults() []*BulkCreateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpdateDocumentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TableName string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	EntityId  string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Payload   *structpb.Struct       `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// ExpectedHash makes the update conditional on the current version hash, like If-Match; empty skips the check.
+	ExpectedHash  string `protobuf:"bytes,4,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDocumentRequest) Reset() {
+	*x = UpdateDocumentRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentRequest) ProtoMessage() {}
+
+func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateDocumentRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+type DeleteDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableName     string                 `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteDocumentRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *DeleteDocumentRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type DeleteDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_entities_v1_entities_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_palmyra_entities_v1_entities_proto_rawDescGZIP(), []int{13}
+}
+
+var File_palmyra_entities_v1_entities_proto protoreflect.FileDescriptor
+
+const file_palmyra_entities_v1_entities_proto_rawDesc = "" +
+	"\n" +
+	"\"palmyra/entities/v1/entities.proto\x12\x13palmyra.entities.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x02\n" +
+	"\bDocument\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12%\n" +
+	"\x0eentity_version\x18\x02 \x01(\tR\rentityVersion\x12\x1b\n" +
+	"\tschema_id\x18\x03 \x01(\tR\bschemaId\x12%\n" +
+	"\x0eschema_version\x18\x04 \x01(\tR\rschemaVersion\x121\n" +
+	"\apayload\x18\x05 \x01(\v2\x17.google.protobuf.StructR\apayload\x12\x12\n" +
+	"\x04hash\x18\x06 \x01(\tR\x04hash\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\tisDeleted\"P\n" +
+	"\x12GetDocumentRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"\x92\x01\n" +
+	"\x14ListDocumentsRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\xe0\x01\n" +
+	"\x15ListDocumentsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.palmyra.entities.v1.DocumentR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x05R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\"X\n" +
+	"\x18BatchGetDocumentsRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x1d\n" +
+	"\n" +
+	"entity_ids\x18\x02 \x03(\tR\tentityIds\"j\n" +
+	"\x19BatchGetDocumentsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.palmyra.entities.v1.DocumentR\x05items\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\"\x86\x01\n" +
+	"\x15CreateDocumentRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x121\n" +
+	"\apayload\x18\x03 \x01(\v2\x17.google.protobuf.StructR\apayload\"`\n" +
+	"\x0eBulkCreateItem\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x121\n" +
+	"\apayload\x18\x02 \x01(\v2\x17.google.protobuf.StructR\apayload\"v\n" +
+	"\x1aBulkCreateDocumentsRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x129\n" +
+	"\x05items\x18\x02 \x03(\v2#.palmyra.entities.v1.BulkCreateItemR\x05items\"y\n" +
+	"\x10BulkCreateResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x129\n" +
+	"\bdocument\x18\x02 \x01(\v2\x1d.palmyra.entities.v1.DocumentR\bdocument\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xaa\x01\n" +
+	"\x1bBulkCreateDocumentsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12?\n" +
+	"\aresults\x18\x04 \x03(\v2%.palmyra.entities.v1.BulkCreateResultR\aresults\"\xab\x01\n" +
+	"\x15UpdateDocumentRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x121\n" +
+	"\apayload\x18\x03 \x01(\v2\x17.google.protobuf.StructR\apayload\x12#\n" +
+	"\rexpected_hash\x18\x04 \x01(\tR\fexpectedHash\"S\n" +
+	"\x15DeleteDocumentRequest\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x01 \x01(\tR\ttableName\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"\x18\n" +
+	"\x16DeleteDocumentResponse2\xe3\x05\n" +
+	"\x0fEntitiesService\x12U\n" +
+	"\vGetDocument\x12'.palmyra.entities.v1.GetDocumentRequest\x1a\x1d.palmyra.entities.v1.Document\x12f\n" +
+	"\rListDocuments\x12).palmyra.entities.v1.ListDocumentsRequest\x1a*.palmyra.entities.v1.ListDocumentsResponse\x12r\n" +
+	"\x11BatchGetDocuments\x12-.palmyra.entities.v1.BatchGetDocumentsRequest\x1a..palmyra.entities.v1.BatchGetDocumentsResponse\x12[\n" +
+	"\x0eCreateDocument\x12*.palmyra.entities.v1.CreateDocumentRequest\x1a\x1d.palmyra.entities.v1.Document\x12x\n" +
+	"\x13BulkCreateDocuments\x12/.palmyra.entities.v1.BulkCreateDocumentsRequest\x1a0.palmyra.entities.v1.BulkCreateDocumentsResponse\x12[\n" +
+	"\x0eUpdateDocument\x12*.palmyra.entities.v1.UpdateDocumentRequest\x1a\x1d.palmyra.entities.v1.Document\x12i\n" +
+	"\x0eDeleteDocument\x12*.palmyra.entities.v1.DeleteDocumentRequest\x1a+.palmyra.entities.v1.DeleteDocumentResponseB^Z\\github.com/zenGate-Global/palmyra-pro-saas/generated/go/proto/palmyra/entities/v1;entitiesv1b\x06proto3"
+
+var (
+	file_palmyra_entities_v1_entities_proto_rawDescOnce sync.Once
+	file_palmyra_entities_v1_entities_proto_rawDescData []byte
+)
+
+func file_palmyra_entities_v1_entities_proto_rawDescGZIP() []byte {
+	file_palmyra_entities_v1_entities_proto_rawDescOnce.Do(func() {
+		file_palmyra_entities_v1_entities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_palmyra_entities_v1_entities_proto_rawDesc), len(file_palmyra_entities_v1_entities_proto_rawDesc)))
+	})
+	return file_palmyra_entities_v1_entities_proto_rawDescData
+}
+
+var file_palmyra_entities_v1_entities_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_palmyra_entities_v1_entities_proto_goTypes = []any{
+	(*Document)(nil),                    // 0: palmyra.entities.v1.Document
+	(*GetDocumentRequest)(nil),          // 1: palmyra.entities.v1.GetDocumentRequest
+	(*ListDocumentsRequest)(nil),        // 2: palmyra.entities.v1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),       // 3: palmyra.entities.v1.ListDocumentsResponse
+	(*BatchGetDocumentsRequest)(nil),    // 4: palmyra.entities.v1.BatchGetDocumentsRequest
+	(*BatchGetDocumentsResponse)(nil),   // 5: palmyra.entities.v1.BatchGetDocumentsResponse
+	(*CreateDocumentRequest)(nil),       // 6: palmyra.entities.v1.CreateDocumentRequest
+	(*BulkCreateItem)(nil),              // 7: palmyra.entities.v1.BulkCreateItem
+	(*BulkCreateDocumentsRequest)(nil),  // 8: palmyra.entities.v1.BulkCreateDocumentsRequest
+	(*BulkCreateResult)(nil),            // 9: palmyra.entities.v1.BulkCreateResult
+	(*BulkCreateDocumentsResponse)(nil), // 10: palmyra.entities.v1.BulkCreateDocumentsResponse
+	(*UpdateDocumentRequest)(nil),       // 11: palmyra.entities.v1.UpdateDocumentRequest
+	(*DeleteDocumentRequest)(nil),       // 12: palmyra.entities.v1.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),      // 13: palmyra.entities.v1.DeleteDocumentResponse
+	(*structpb.Struct)(nil),             // 14: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),       // 15: google.protobuf.Timestamp
+}
+var file_palmyra_entities_v1_entities_proto_depIdxs = []int32{
+	14, // 0: palmyra.entities.v1.Document.payload:type_name -> google.protobuf.Struct
+	15, // 1: palmyra.entities.v1.Document.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: palmyra.entities.v1.ListDocumentsResponse.items:type_name -> palmyra.entities.v1.Document
+	0,  // 3: palmyra.entities.v1.BatchGetDocumentsResponse.items:type_name -> palmyra.entities.v1.Document
+	14, // 4: palmyra.entities.v1.CreateDocumentRequest.payload:type_name -> google.protobuf.Struct
+	14, // 5: palmyra.entities.v1.BulkCreateItem.payload:type_name -> google.protobuf.Struct
+	7,  // 6: palmyra.entities.v1.BulkCreateDocumentsRequest.items:type_name -> palmyra.entities.v1.BulkCreateItem
+	0,  // 7: palmyra.entities.v1.BulkCreateResult.document:type_name -> palmyra.entities.v1.Document
+	9,  // 8: palmyra.entities.v1.BulkCreateDocumentsResponse.results:type_name -> palmyra.entities.v1.BulkCreateResult
+	14, // 9: palmyra.entities.v1.UpdateDocumentRequest.payload:type_name -> google.protobuf.Struct
+	1,  // 10: palmyra.entities.v1.EntitiesService.GetDocument:input_type -> palmyra.entities.v1.GetDocumentRequest
+	2,  // 11: palmyra.entities.v1.EntitiesService.ListDocuments:input_type -> palmyra.entities.v1.ListDocumentsRequest
+	4,  // 12: palmyra.entities.v1.EntitiesService.BatchGetDocuments:input_type -> palmyra.entities.v1.BatchGetDocumentsRequest
+	6,  // 13: palmyra.entities.v1.EntitiesService.CreateDocument:input_type -> palmyra.entities.v1.CreateDocumentRequest
+	8,  // 14: palmyra.entities.v1.EntitiesService.BulkCreateDocuments:input_type -> palmyra.entities.v1.BulkCreateDocumentsRequest
+	11, // 15: palmyra.entities.v1.EntitiesService.UpdateDocument:input_type -> palmyra.entities.v1.UpdateDocumentRequest
+	12, // 16: palmyra.entities.v1.EntitiesService.DeleteDocument:input_type -> palmyra.entities.v1.DeleteDocumentRequest
+	0,  // 17: palmyra.entities.v1.EntitiesService.GetDocument:output_type -> palmyra.entities.v1.Document
+	3,  // 18: palmyra.entities.v1.EntitiesService.ListDocuments:output_type -> palmyra.entities.v1.ListDocumentsResponse
+	5,  // 19: palmyra.entities.v1.EntitiesService.BatchGetDocuments:output_type -> palmyra.entities.v1.BatchGetDocumentsResponse
+	0,  // 20: palmyra.entities.v1.EntitiesService.CreateDocument:output_type -> palmyra.entities.v1.Document
+	10, // 21: palmyra.entities.v1.EntitiesService.BulkCreateDocuments:output_type -> palmyra.entities.v1.BulkCreateDocumentsResponse
+	0,  // 22: palmyra.entities.v1.EntitiesService.UpdateDocument:output_type -> palmyra.entities.v1.Document
+	13, // 23: palmyra.entities.v1.EntitiesService.DeleteDocument:output_type -> palmyra.entities.v1.DeleteDocumentResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_palmyra_entities_v1_entities_proto_init() }
+func file_palmyra_entities_v1_entities_proto_init() {
+	if File_palmyra_entities_v1_entities_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_palmyra_entities_v1_entities_proto_rawDesc), len(file_palmyra_entities_v1_entities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_palmyra_entities_v1_entities_proto_goTypes,
+		DependencyIndexes: file_palmyra_entities_v1_entities_proto_depIdxs,
+		MessageInfos:      file_palmyra_entities_v1_entities_proto_msgTypes,
+	}.Build()
+	File_palmyra_entities_v1_entities_proto = out.File
+	file_palmyra_entities_v1_entities_proto_goTypes = nil
+	file_palmyra_entities_v1_entities_proto_depIdxs = nil
+}
@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: palmyra/entities/v1/entities.proto
+
+package entitiesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EntitiesService_GetDocument_FullMethodName         = "/palmyra.entities.v1.EntitiesService/GetDocument"
+	EntitiesService_ListDocuments_FullMethodName       = "/palmyra.entities.v1.EntitiesService/ListDocuments"
+	EntitiesService_BatchGetDocuments_FullMethodName   = "/palmyra.entities.v1.EntitiesService/BatchGetDocuments"
+	EntitiesService_CreateDocument_FullMethodName      = "/palmyra.entities.v1.EntitiesService/CreateDocument"
+	EntitiesService_BulkCreateDocuments_FullMethodName = "/palmyra.entities.v1.EntitiesService/BulkCreateDocuments"
+	EntitiesService_UpdateDocument_FullMethodName      = "/palmyra.entities.v1.EntitiesService/UpdateDocument"
+	EntitiesService_DeleteDocument_FullMethodName      = "/palmyra.entities.v1.EntitiesService/DeleteDocument"
+)
+
+// EntitiesServiceClient is the client API for EntitiesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EntitiesService reads and writes the documents of the caller's tenant.
+type EntitiesServiceClient interface {
+	// GetDocument returns the active version of a document.
+	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	// ListDocuments returns a page of the active documents of a table.
+	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
+	// BatchGetDocuments returns up to 100 documents by identifier and reports the missing ones.
+	BatchGetDocuments(ctx context.Context, in *BatchGetDocumentsRequest, opts ...grpc.CallOption) (*BatchGetDocumentsResponse, error)
+	// CreateDocument validates the payload against the active schema and stores the first version of a document.
+	CreateDocument(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	// BulkCreateDocuments creates up to 1000 documents, reporting the outcome of each item.
+	BulkCreateDocuments(ctx context.Context, in *BulkCreateDocumentsRequest, opts ...grpc.CallOption) (*BulkCreateDocumentsResponse, error)
+	// UpdateDocument stores a new version of a document.
+	UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*Document, error)
+	// DeleteDocument soft-deletes a document.
+	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+}
+
+type entitiesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEntitiesServiceClient(cc grpc.ClientConnInterface) EntitiesServiceClient {
+	return &entitiesServiceClient{cc}
+}
+
+func (c *entitiesServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, EntitiesService_GetDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entitiesServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentsResponse)
+	err := c.cc.Invoke(ctx, EntitiesService_ListDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entitiesServiceClient) BatchGetDocuments(ctx context.Context, in *BatchGetDocumentsRequest, opts ...grpc.CallOption) (*BatchGetDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetDocumentsResponse)
+	err := c.cc.Invoke(ctx, EntitiesService_BatchGetDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entitiesServiceClient) CreateDocument(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, EntitiesService_CreateDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entitiesServiceClient) BulkCreateDocuments(ctx context.Context, in *BulkCreateDocumentsRequest, opts ...grpc.CallOption) (*BulkCreateDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateDocumentsResponse)
+	err := c.cc.Invoke(ctx, EntitiesService_BulkCreateDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entitiesServiceClient) UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, EntitiesService_UpdateDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entitiesServiceClient) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, EntitiesService_DeleteDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntitiesServiceServer is the server API for EntitiesService service.
+// All implementations must embed UnimplementedEntitiesServiceServer
+// for forward compatibility.
+//
+// EntitiesService reads and writes the documents of the caller's tenant.
+type EntitiesServiceServer interface {
+	// GetDocument returns the active version of a document.
+	GetDocument(context.Context, *GetDocumentRequest) (*Document, error)
+	// ListDocuments returns a page of the active documents of a table.
+	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	// BatchGetDocuments returns up to 100 documents by identifier and reports the missing ones.
+	BatchGetDocuments(context.Context, *BatchGetDocumentsRequest) (*BatchGetDocumentsResponse, error)
+	// CreateDocument validates the payload against the active schema and stores the first version of a document.
+	CreateDocument(context.Context, *CreateDocumentRequest) (*Document, error)
+	// BulkCreateDocuments creates up to 1000 documents, reporting the outcome of each item.
+	BulkCreateDocuments(context.Context, *BulkCreateDocumentsRequest) (*BulkCreateDocumentsResponse, error)
+	// UpdateDocument stores a new version of a document.
+	UpdateDocument(context.Context, *UpdateDocumentRequest) (*Document, error)
+	// DeleteDocument soft-deletes a document.
+	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
+	mustEmbedUnimplementedEntitiesServiceServer()
+}
+
+// UnimplementedEntitiesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEntitiesServiceServer struct{}
+
+func (UnimplementedEntitiesServiceServer) GetDocument(context.Context, *GetDocumentRequest) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
+}
+func (UnimplementedEntitiesServiceServer) ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocuments not implemented")
+}
+func (UnimplementedEntitiesServiceServer) BatchGetDocuments(context.Context, *BatchGetDocumentsRequest) (*BatchGetDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetDocuments not implemented")
+}
+func (UnimplementedEntitiesServiceServer) CreateDocument(context.Context, *CreateDocumentRequest) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDocument not implemented")
+}
+func (UnimplementedEntitiesServiceServer) BulkCreateDocuments(context.Context, *BulkCreateDocumentsRequest) (*BulkCreateDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateDocuments not implemented")
+}
+func (UnimplementedEntitiesServiceServer) UpdateDocument(context.Context, *UpdateDocumentRequest) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocument not implemented")
+}
+func (UnimplementedEntitiesServiceServer) DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocument not implemented")
+}
+func (UnimplementedEntitiesServiceServer) mustEmbedUnimplementedEntitiesServiceServer() {}
+func (UnimplementedEntitiesServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeEntitiesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EntitiesServiceServer will
+// result in compilation errors.
+type UnsafeEntitiesServiceServer interface {
+	mustEmbedUnimplementedEntitiesServiceServer()
+}
+
+func RegisterEntitiesServiceServer(s grpc.ServiceRegistrar, srv EntitiesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEntitiesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EntitiesService_ServiceDesc, srv)
+}
+
+func _EntitiesService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_GetDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).GetDocument(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntitiesService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).ListDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_ListDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntitiesService_BatchGetDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).BatchGetDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_BatchGetDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).BatchGetDocuments(ctx, req.(*BatchGetDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntitiesService_CreateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).CreateDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_CreateDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).CreateDocument(ctx, req.(*CreateDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntitiesService_BulkCreateDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).BulkCreateDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_BulkCreateDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).BulkCreateDocuments(ctx, req.(*BulkCreateDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntitiesService_UpdateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).UpdateDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_UpdateDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).UpdateDocument(ctx, req.(*UpdateDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntitiesService_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntitiesServiceServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntitiesService_DeleteDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntitiesServiceServer).DeleteDocument(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EntitiesService_ServiceDesc is the grpc.ServiceDesc for EntitiesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EntitiesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "palmyra.entities.v1.EntitiesService",
+	HandlerType: (*EntitiesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDocument",
+			Handler:    _EntitiesService_GetDocument_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _EntitiesService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "BatchGetDocuments",
+			Handler:    _EntitiesService_BatchGetDocuments_Handler,
+		},
+		{
+			MethodName: "CreateDocument",
+			Handler:    _EntitiesService_CreateDocument_Handler,
+		},
+		{
+			MethodName: "BulkCreateDocuments",
+			Handler:    _EntitiesService_BulkCreateDocuments_Handler,
+		},
+		{
+			MethodName: "UpdateDocument",
+			Handler:    _EntitiesService_UpdateDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _EntitiesService_DeleteDocument_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "palmyra/entities/v1/entities.proto",
+}
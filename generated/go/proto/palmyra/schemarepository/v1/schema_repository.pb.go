@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: palmyra/schemarepository/v1/schema_repository.proto
+
+package schemarepositoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Schema is a version of a schema definition.
+type Schema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaId      string                 `protobuf:"bytes,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	SchemaVersion string                 `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	TableName     string                 `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Definition is the JSON Schema document.
+	Definition *structpb.Struct       `protobuf:"bytes,6,opt,name=definition,proto3" json:"definition,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsActive   bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsDeleted  bool                   `protobuf:"varint,9,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	// Status is "draft" or "published".
+	Status        string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_palmyra_schemarepository_v1_schema_repository_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schema) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *Schema) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Schema) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *Schema) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Schema) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Schema) GetDefinition() *structpb.Struct {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+func (x *Schema) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Schema) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Schema) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Schema) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListSchemasRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSchemasRequest) Reset() {
+	*x = ListSchemasRequest{}
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchemasRequest) ProtoMessage() {}
+
+func (x *ListSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_schemarepository_v1_schema_repository_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSchemasRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*Schema              `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchemasResponse) Reset() {
+	*x = ListSchemasResponse{}
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchemasResponse) ProtoMessage() {}
+
+func (x *ListSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_palmyra_schemarepository_v1_schema_repository_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSchemasResponse) GetSchemas() []*Schema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+type ListSchemaVersionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SchemaId       string                 `protobuf:"bytes,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSchemaVersionsRequest) Reset() {
+	*x = ListSchemaVersionsRequest{}
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchemaVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchemaVersionsRequest) ProtoMessage() {}
+
+func (x *ListSchemaVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchemaVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSchemaVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_schemarepository_v1_schema_repository_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSchemaVersionsRequest) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *ListSchemaVersionsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaId      string                 `protobuf:"bytes,1,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaRequest) Reset() {
+	*x = GetSchemaRequest{}
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaRequest) ProtoMessage() {}
+
+func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_palmyra_schemarepository_v1_schema_repository_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSchemaRequest) GetSchemaId() string {
+	if x != nil {
+		return x.SchemaId
+	}
+	return ""
+}
+
+func (x *GetSchemaRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+var File_palmyra_schemarepository_v1_schema_repository_proto protoreflect.FileDescriptor
+
+const file_palmyra_schemarepository_v1_schema_repository_proto_rawDesc = "" +
+	"\n" +
+	"3palmyra/schemarepository/v1/schema_repository.proto\x12\x1bpalmyra.schemarepository.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x02\n" +
+	"\x06Schema\x12\x1b\n" +
+	"\tschema_id\x18\x01 \x01(\tR\bschemaId\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x03 \x01(\tR\ttableName\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"definition\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"definition\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\tisDeleted\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\"?\n" +
+	"\x12ListSchemasRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"T\n" +
+	"\x13ListSchemasResponse\x12=\n" +
+	"\aschemas\x18\x01 \x03(\v2#.palmyra.schemarepository.v1.SchemaR\aschemas\"a\n" +
+	"\x19ListSchemaVersionsRequest\x12\x1b\n" +
+	"\tschema_id\x18\x01 \x01(\tR\bschemaId\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"I\n" +
+	"\x10GetSchemaRequest\x12\x1b\n" +
+	"\tschema_id\x18\x01 \x01(\tR\bschemaId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion2\xec\x02\n" +
+	"\x17SchemaRepositoryService\x12p\n" +
+	"\vListSchemas\x12/.palmyra.schemarepository.v1.ListSchemasRequest\x1a0.palmyra.schemarepository.v1.ListSchemasResponse\x12~\n" +
+	"\x12ListSchemaVersions\x126.palmyra.schemarepository.v1.ListSchemaVersionsRequest\x1a0.palmyra.schemarepository.v1.ListSchemasResponse\x12_\n" +
+	"\tGetSchema\x12-.palmyra.schemarepository.v1.GetSchemaRequest\x1a#.palmyra.schemarepository.v1.SchemaBnZlgithub.com/zenGate-Global/palmyra-pro-saas/generated/go/proto/palmyra/schemarepository/v1;schemarepositoryv1b\x06proto3"
+
+var (
+	file_palmyra_schemarepository_v1_schema_repository_proto_rawDescOnce sync.Once
+	file_palmyra_schemarepository_v1_schema_repository_proto_rawDescData []byte
+)
+
+func file_palmyra_schemarepository_v1_schema_repository_proto_rawDescGZIP() []byte {
+	file_palmyra_schemarepository_v1_schema_repository_proto_rawDescOnce.Do(func() {
+		file_palmyra_schemarepository_v1_schema_repository_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_palmyra_schemarepository_v1_schema_repository_proto_rawDesc), len(file_palmyra_schemarepository_v1_schema_repository_proto_rawDesc)))
+	})
+	return file_palmyra_schemarepository_v1_schema_repository_proto_rawDescData
+}
+
+var file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_palmyra_schemarepository_v1_schema_repository_proto_goTypes = []any{
+	(*Schema)(nil),                    // 0: palmyra.schemarepository.v1.Schema
+	(*ListSchemasRequest)(nil),        // 1: palmyra.schemarepository.v1.ListSchemasRequest
+	(*ListSchemasResponse)(nil),       // 2: palmyra.schemarepository.v1.ListSchemasResponse
+	(*ListSchemaVersionsRequest)(nil), // 3: palmyra.schemarepository.v1.ListSchemaVersionsRequest
+	(*GetSchemaRequest)(nil),          // 4: palmyra.schemarepository.v1.GetSchemaRequest
+	(*structpb.Struct)(nil),           // 5: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),     // 6: google.protobuf.Timestamp
+}
+var file_palmyra_schemarepository_v1_schema_repository_proto_depIdxs = []int32{
+	5, // 0: palmyra.schemarepository.v1.Schema.definition:type_name -> google.protobuf.Struct
+	6, // 1: palmyra.schemarepository.v1.Schema.created_at:type_name -> google.protobuf.Timestamp
+	0, // 2: palmyra.schemarepository.v1.ListSchemasResponse.schemas:type_name -> palmyra.schemarepository.v1.Schema
+	1, // 3: palmyra.schemarepository.v1.SchemaRepositoryService.ListSchemas:input_type -> palmyra.schemarepository.v1.ListSchemasRequest
+	3, // 4: palmyra.schemarepository.v1.SchemaRepositoryService.ListSchemaVersions:input_type -> palmyra.schemarepository.v1.ListSchemaVersionsRequest
+	4, // 5: palmyra.schemarepository.v1.SchemaRepositoryService.GetSchema:input_type -> palmyra.schemarepository.v1.GetSchemaRequest
+	2, // 6: palmyra.schemarepository.v1.SchemaRepositoryService.ListSchemas:output_type -> palmyra.schemarepository.v1.ListSchemasResponse
+	2, // 7: palmyra.schemarepository.v1.SchemaRepositoryService.ListSchemaVersions:output_type -> palmyra.schemarepository.v1.ListSchemasResponse
+	0, // 8: palmyra.schemarepository.v1.SchemaRepositoryService.GetSchema:output_type -> palmyra.schemarepository.v1.Schema
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_palmyra_schemarepository_v1_schema_repository_proto_init() }
+func file_palmyra_schemarepository_v1_schema_repository_proto_init() {
+	if File_palmyra_schemarepository_v1_schema_repository_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_palmyra_schemarepository_v1_schema_repository_proto_rawDesc), len(file_palmyra_schemarepository_v1_schema_repository_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_palmyra_schemarepository_v1_schema_repository_proto_goTypes,
+		DependencyIndexes: file_palmyra_schemarepository_v1_schema_repository_proto_depIdxs,
+		MessageInfos:      file_palmyra_schemarepository_v1_schema_repository_proto_msgTypes,
+	}.Build()
+	File_palmyra_schemarepository_v1_schema_repository_proto = out.File
+	file_palmyra_schemarepository_v1_schema_repository_proto_goTypes = nil
+	file_palmyra_schemarepository_v1_schema_repository_proto_depIdxs = nil
+}
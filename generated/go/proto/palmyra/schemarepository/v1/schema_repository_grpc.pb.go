@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: palmyra/schemarepository/v1/schema_repository.proto
+
+package schemarepositoryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchemaRepositoryService_ListSchemas_FullMethodName        = "/palmyra.schemarepository.v1.SchemaRepositoryService/ListSchemas"
+	SchemaRepositoryService_ListSchemaVersions_FullMethodName = "/palmyra.schemarepository.v1.SchemaRepositoryService/ListSchemaVersions"
+	SchemaRepositoryService_GetSchema_FullMethodName          = "/palmyra.schemarepository.v1.SchemaRepositoryService/GetSchema"
+)
+
+// SchemaRepositoryServiceClient is the client API for SchemaRepositoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SchemaRepositoryService reads the schema versions entity tables are validated against.
+type SchemaRepositoryServiceClient interface {
+	// ListSchemas returns the active version of every schema, or every version when include_inactive is set.
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
+	// ListSchemaVersions returns the versions of one schema.
+	ListSchemaVersions(ctx context.Context, in *ListSchemaVersionsRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
+	// GetSchema returns a schema version, or the active version when version is empty.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error)
+}
+
+type schemaRepositoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchemaRepositoryServiceClient(cc grpc.ClientConnInterface) SchemaRepositoryServiceClient {
+	return &schemaRepositoryServiceClient{cc}
+}
+
+func (c *schemaRepositoryServiceClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, SchemaRepositoryService_ListSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaRepositoryServiceClient) ListSchemaVersions(ctx context.Context, in *ListSchemaVersionsRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, SchemaRepositoryService_ListSchemaVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaRepositoryServiceClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*Schema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, SchemaRepositoryService_GetSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchemaRepositoryServiceServer is the server API for SchemaRepositoryService service.
+// All implementations must embed UnimplementedSchemaRepositoryServiceServer
+// for forward compatibility.
+//
+// SchemaRepositoryService reads the schema versions entity tables are validated against.
+type SchemaRepositoryServiceServer interface {
+	// ListSchemas returns the active version of every schema, or every version when include_inactive is set.
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
+	// ListSchemaVersions returns the versions of one schema.
+	ListSchemaVersions(context.Context, *ListSchemaVersionsRequest) (*ListSchemasResponse, error)
+	// GetSchema returns a schema version, or the active version when version is empty.
+	GetSchema(context.Context, *GetSchemaRequest) (*Schema, error)
+	mustEmbedUnimplementedSchemaRepositoryServiceServer()
+}
+
+// UnimplementedSchemaRepositoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchemaRepositoryServiceServer struct{}
+
+func (UnimplementedSchemaRepositoryServiceServer) ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
+func (UnimplementedSchemaRepositoryServiceServer) ListSchemaVersions(context.Context, *ListSchemaVersionsRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemaVersions not implemented")
+}
+func (UnimplementedSchemaRepositoryServiceServer) GetSchema(context.Context, *GetSchemaRequest) (*Schema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedSchemaRepositoryServiceServer) mustEmbedUnimplementedSchemaRepositoryServiceServer() {
+}
+func (UnimplementedSchemaRepositoryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeSchemaRepositoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchemaRepositoryServiceServer will
+// result in compilation errors.
+type UnsafeSchemaRepositoryServiceServer interface {
+	mustEmbedUnimplementedSchemaRepositoryServiceServer()
+}
+
+func RegisterSchemaRepositoryServiceServer(s grpc.ServiceRegistrar, srv SchemaRepositoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSchemaRepositoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchemaRepositoryService_ServiceDesc, srv)
+}
+
+func _SchemaRepositoryService_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaRepositoryServiceServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaRepositoryService_ListSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaRepositoryServiceServer).ListSchemas(ctx, req.(*ListSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaRepositoryService_ListSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaRepositoryServiceServer).ListSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaRepositoryService_ListSchemaVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaRepositoryServiceServer).ListSchemaVersions(ctx, req.(*ListSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaRepositoryService_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaRepositoryServiceServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaRepositoryService_GetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaRepositoryServiceServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchemaRepositoryService_ServiceDesc is the grpc.ServiceDesc for SchemaRepositoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchemaRepositoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "palmyra.schemarepository.v1.SchemaRepositoryService",
+	HandlerType: (*SchemaRepositoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSchemas",
+			Handler:    _SchemaRepositoryService_ListSchemas_Handler,
+		},
+		{
+			MethodName: "ListSchemaVersions",
+			Handler:    _SchemaRepositoryService_ListSchemaVersions_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _SchemaRepositoryService_GetSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "palmyra/schemarepository/v1/schema_repository.proto",
+}
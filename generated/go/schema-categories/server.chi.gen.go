@@ -29,6 +29,50 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CategorySchemaSummaryStatus.
+const (
+	Draft     CategorySchemaSummaryStatus = "draft"
+	Published CategorySchemaSummaryStatus = "published"
+)
+
+// Defines values for DeleteSchemaCategoryParamsMode.
+const (
+	Cascade  DeleteSchemaCategoryParamsMode = "cascade"
+	Reparent DeleteSchemaCategoryParamsMode = "reparent"
+	Restrict DeleteSchemaCategoryParamsMode = "restrict"
+)
+
+// CategorySchemaList Collection wrapper for the schemas of a category.
+type CategorySchemaList struct {
+	Items []CategorySchemaSummary `json:"items"`
+}
+
+// CategorySchemaSummary Schema listed under a category, represented by its active or newest live version.
+type CategorySchemaSummary struct {
+	// CategoryId RFC 4122 UUID string
+	CategoryId externalRef2.UUID `json:"categoryId"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+	IsActive  bool                   `json:"isActive"`
+
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// SchemaVersion Semantic version string in major.minor.patch format
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug   externalRef2.Slug           `json:"slug"`
+	Status CategorySchemaSummaryStatus `json:"status"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+}
+
+// CategorySchemaSummaryStatus defines model for CategorySchemaSummary.Status.
+type CategorySchemaSummaryStatus string
+
 // CreateSchemaCategoryRequest defines model for CreateSchemaCategoryRequest.
 type CreateSchemaCategoryRequest struct {
 	Description      *string            `json:"description"`
@@ -60,11 +104,57 @@ type SchemaCategory struct {
 	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
 }
 
+// SchemaCategoryDeletion What a category delete changes, or would change on a dry run.
+type SchemaCategoryDeletion struct {
+	// CategoryId RFC 4122 UUID string
+	CategoryId externalRef2.UUID `json:"categoryId"`
+
+	// DeletedCategories Soft-deleted categories, the category itself and, with `cascade`, its descendants.
+	DeletedCategories []externalRef2.UUID `json:"deletedCategories"`
+
+	// DeletedSchemaVersions Schema version rows soft deleted with the subtree.
+	DeletedSchemaVersions int64 `json:"deletedSchemaVersions"`
+	DryRun                bool  `json:"dryRun"`
+
+	// Mode The delete mode, `restrict`, `reparent` or `cascade`.
+	Mode string `json:"mode"`
+
+	// ReparentedCategories Child categories moved to the deleted category's parent.
+	ReparentedCategories int64 `json:"reparentedCategories"`
+
+	// ReparentedSchemaVersions Schema version rows moved to the deleted category's parent.
+	ReparentedSchemaVersions int64 `json:"reparentedSchemaVersions"`
+}
+
 // SchemaCategoryList Collection wrapper for schema categories.
 type SchemaCategoryList struct {
 	Items []SchemaCategory `json:"items"`
 }
 
+// SchemaCategoryNode Schema category with its nested child categories.
+type SchemaCategoryNode struct {
+	// CategoryId RFC 4122 UUID string
+	CategoryId       externalRef2.UUID    `json:"categoryId"`
+	Children         []SchemaCategoryNode `json:"children"`
+	Description      *string              `json:"description"`
+	Name             string               `json:"name"`
+	ParentCategoryId *externalRef2.UUID   `json:"parentCategoryId"`
+
+	// SchemaCount Schemas with at least one live version in this category.
+	SchemaCount int64 `json:"schemaCount"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef2.Slug `json:"slug"`
+
+	// TotalSchemaCount schemaCount of this category plus that of every descendant.
+	TotalSchemaCount int64 `json:"totalSchemaCount"`
+}
+
+// SchemaCategoryTree Root nodes of the schema category hierarchy.
+type SchemaCategoryTree struct {
+	Items []SchemaCategoryNode `json:"items"`
+}
+
 // UpdateSchemaCategoryRequest Fields allowed to change for an existing schema category.
 type UpdateSchemaCategoryRequest struct {
 	Description      *string            `json:"description"`
@@ -81,6 +171,24 @@ type ListSchemaCategoriesParams struct {
 	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
 }
 
+// DeleteSchemaCategoryParams defines parameters for DeleteSchemaCategory.
+type DeleteSchemaCategoryParams struct {
+	// Mode How live child categories and schemas are handled.
+	Mode *DeleteSchemaCategoryParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Report what the delete would change without applying it.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// DeleteSchemaCategoryParamsMode defines parameters for DeleteSchemaCategory.
+type DeleteSchemaCategoryParamsMode string
+
+// ListSchemaCategorySchemasParams defines parameters for ListSchemaCategorySchemas.
+type ListSchemaCategorySchemasParams struct {
+	// IncludeDescendants Also list schemas of every live descendant category.
+	IncludeDescendants *bool `form:"includeDescendants,omitempty" json:"includeDescendants,omitempty"`
+}
+
 // CreateSchemaCategoryJSONRequestBody defines body for CreateSchemaCategory for application/json ContentType.
 type CreateSchemaCategoryJSONRequestBody = CreateSchemaCategoryRequest
 
@@ -95,15 +203,21 @@ type ServerInterface interface {
 	// Create schema category
 	// (POST /schema-categories)
 	CreateSchemaCategory(w http.ResponseWriter, r *http.Request)
+	// Get schema category tree
+	// (GET /schema-categories/tree)
+	GetSchemaCategoryTree(w http.ResponseWriter, r *http.Request)
 	// Soft delete schema category
 	// (DELETE /schema-categories/{categoryId})
-	DeleteSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID)
+	DeleteSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID, params DeleteSchemaCategoryParams)
 	// Retrieve schema category
 	// (GET /schema-categories/{categoryId})
 	GetSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID)
 	// Update schema category
 	// (PATCH /schema-categories/{categoryId})
 	UpdateSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID)
+	// List schemas in category
+	// (GET /schema-categories/{categoryId}/schemas)
+	ListSchemaCategorySchemas(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID, params ListSchemaCategorySchemasParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -122,9 +236,15 @@ func (_ Unimplemented) CreateSchemaCategory(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get schema category tree
+// (GET /schema-categories/tree)
+func (_ Unimplemented) GetSchemaCategoryTree(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Soft delete schema category
 // (DELETE /schema-categories/{categoryId})
-func (_ Unimplemented) DeleteSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID) {
+func (_ Unimplemented) DeleteSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID, params DeleteSchemaCategoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -140,6 +260,12 @@ func (_ Unimplemented) UpdateSchemaCategory(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List schemas in category
+// (GET /schema-categories/{categoryId}/schemas)
+func (_ Unimplemented) ListSchemaCategorySchemas(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID, params ListSchemaCategorySchemasParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -202,6 +328,26 @@ func (siw *ServerInterfaceWrapper) CreateSchemaCategory(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// GetSchemaCategoryTree operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaCategoryTree(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaCategoryTree(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteSchemaCategory operation middleware
 func (siw *ServerInterfaceWrapper) DeleteSchemaCategory(w http.ResponseWriter, r *http.Request) {
 
@@ -222,8 +368,27 @@ func (siw *ServerInterfaceWrapper) DeleteSchemaCategory(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSchemaCategoryParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSchemaCategory(w, r, categoryId)
+		siw.Handler.DeleteSchemaCategory(w, r, categoryId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -295,6 +460,48 @@ func (siw *ServerInterfaceWrapper) UpdateSchemaCategory(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// ListSchemaCategorySchemas operation middleware
+func (siw *ServerInterfaceWrapper) ListSchemaCategorySchemas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", chi.URLParam(r, "categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSchemaCategorySchemasParams
+
+	// ------------- Optional query parameter "includeDescendants" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDescendants", r.URL.Query(), &params.IncludeDescendants)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDescendants", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSchemaCategorySchemas(w, r, categoryId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -414,6 +621,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/schema-categories", wrapper.CreateSchemaCategory)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-categories/tree", wrapper.GetSchemaCategoryTree)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/schema-categories/{categoryId}", wrapper.DeleteSchemaCategory)
 	})
@@ -423,6 +633,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/schema-categories/{categoryId}", wrapper.UpdateSchemaCategory)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-categories/{categoryId}/schemas", wrapper.ListSchemaCategorySchemas)
+	})
 
 	return r
 }
@@ -493,14 +706,52 @@ func (response CreateSchemaCategorydefaultApplicationProblemPlusJSONResponse) Vi
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetSchemaCategoryTreeRequestObject struct {
+}
+
+type GetSchemaCategoryTreeResponseObject interface {
+	VisitGetSchemaCategoryTreeResponse(w http.ResponseWriter) error
+}
+
+type GetSchemaCategoryTree200JSONResponse SchemaCategoryTree
+
+func (response GetSchemaCategoryTree200JSONResponse) VisitGetSchemaCategoryTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaCategoryTreedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response GetSchemaCategoryTreedefaultApplicationProblemPlusJSONResponse) VisitGetSchemaCategoryTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type DeleteSchemaCategoryRequestObject struct {
 	CategoryId externalRef2.UUID `json:"categoryId"`
+	Params     DeleteSchemaCategoryParams
 }
 
 type DeleteSchemaCategoryResponseObject interface {
 	VisitDeleteSchemaCategoryResponse(w http.ResponseWriter) error
 }
 
+type DeleteSchemaCategory200JSONResponse SchemaCategoryDeletion
+
+func (response DeleteSchemaCategory200JSONResponse) VisitDeleteSchemaCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteSchemaCategory204Response struct {
 }
 
@@ -580,6 +831,36 @@ func (response UpdateSchemaCategorydefaultApplicationProblemPlusJSONResponse) Vi
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type ListSchemaCategorySchemasRequestObject struct {
+	CategoryId externalRef2.UUID `json:"categoryId"`
+	Params     ListSchemaCategorySchemasParams
+}
+
+type ListSchemaCategorySchemasResponseObject interface {
+	VisitListSchemaCategorySchemasResponse(w http.ResponseWriter) error
+}
+
+type ListSchemaCategorySchemas200JSONResponse CategorySchemaList
+
+func (response ListSchemaCategorySchemas200JSONResponse) VisitListSchemaCategorySchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSchemaCategorySchemasdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response ListSchemaCategorySchemasdefaultApplicationProblemPlusJSONResponse) VisitListSchemaCategorySchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// List schema categories
@@ -588,6 +869,9 @@ type StrictServerInterface interface {
 	// Create schema category
 	// (POST /schema-categories)
 	CreateSchemaCategory(ctx context.Context, request CreateSchemaCategoryRequestObject) (CreateSchemaCategoryResponseObject, error)
+	// Get schema category tree
+	// (GET /schema-categories/tree)
+	GetSchemaCategoryTree(ctx context.Context, request GetSchemaCategoryTreeRequestObject) (GetSchemaCategoryTreeResponseObject, error)
 	// Soft delete schema category
 	// (DELETE /schema-categories/{categoryId})
 	DeleteSchemaCategory(ctx context.Context, request DeleteSchemaCategoryRequestObject) (DeleteSchemaCategoryResponseObject, error)
@@ -597,6 +881,9 @@ type StrictServerInterface interface {
 	// Update schema category
 	// (PATCH /schema-categories/{categoryId})
 	UpdateSchemaCategory(ctx context.Context, request UpdateSchemaCategoryRequestObject) (UpdateSchemaCategoryResponseObject, error)
+	// List schemas in category
+	// (GET /schema-categories/{categoryId}/schemas)
+	ListSchemaCategorySchemas(ctx context.Context, request ListSchemaCategorySchemasRequestObject) (ListSchemaCategorySchemasResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -685,11 +972,36 @@ func (sh *strictHandler) CreateSchemaCategory(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// GetSchemaCategoryTree operation middleware
+func (sh *strictHandler) GetSchemaCategoryTree(w http.ResponseWriter, r *http.Request) {
+	var request GetSchemaCategoryTreeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSchemaCategoryTree(ctx, request.(GetSchemaCategoryTreeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSchemaCategoryTree")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSchemaCategoryTreeResponseObject); ok {
+		if err := validResponse.VisitGetSchemaCategoryTreeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeleteSchemaCategory operation middleware
-func (sh *strictHandler) DeleteSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID) {
+func (sh *strictHandler) DeleteSchemaCategory(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID, params DeleteSchemaCategoryParams) {
 	var request DeleteSchemaCategoryRequestObject
 
 	request.CategoryId = categoryId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.DeleteSchemaCategory(ctx, request.(DeleteSchemaCategoryRequestObject))
@@ -770,36 +1082,83 @@ func (sh *strictHandler) UpdateSchemaCategory(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// ListSchemaCategorySchemas operation middleware
+func (sh *strictHandler) ListSchemaCategorySchemas(w http.ResponseWriter, r *http.Request, categoryId externalRef2.UUID, params ListSchemaCategorySchemasParams) {
+	var request ListSchemaCategorySchemasRequestObject
+
+	request.CategoryId = categoryId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSchemaCategorySchemas(ctx, request.(ListSchemaCategorySchemasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSchemaCategorySchemas")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSchemaCategorySchemasResponseObject); ok {
+		if err := validResponse.VisitListSchemaCategorySchemasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYbW/bNhD+KwTXD+0qJXaarp2+DFmybsEyNHATDFjgFWfpZLGlSJWknLiB/vtAUras",
-	"lzhx0aJosW+WJR7v5XnuOfKWxjIvpEBhNI1uqY4zzMH9PFYIBt+4P47B4Fyq5QQ/lKiNfV0oWaAyDN3H",
-	"CepYscIwKexjDjdnKOYmo9Hz8UFARck5zDjSyKgSA2qWBdKIaqOYmNMqoAJy7CwcH7wMaM7E+nlgWQEK",
-	"hVl5d5pYE8D565RGV7f0kcKURvSH/SbG/TpA+1cuxdtCsZwZtkD99vLy9IRW066zVUA1L+fW8m723thV",
-	"VRVQhR9KpjCh0ZWPs7Y4XQckZ+8wNjagdrp7ma3fk7j+gORoIAEDNOgUJG7l5FMyEdDYISA5MrubuGA5",
-	"agN5Ye0kyHFt51PLs2FxqEbfEwDbJX/tfgAnfq+m9ixBYVjKUJFUKpIxVKDijMXAiUBtmJjv0c8M54CW",
-	"RfIZQNGhxQZaa/+CFVUaEG7ufT93zpjvU+1kHkvOMbYP5FpBUdS50y1aMdR7PUIxg3n7x7bgOzyu1u6C",
-	"UrDsxe9tDgV16UK+swu3o3vFkCeaAOfyGhNiJIkzEHN0MYIgeMMcLDrxLm20ORPnGwGPg/87/IM7fK9q",
-	"d3zbK9ifOINZGINGYl0gpcaEMEEuJ2eaBhRvIC+4zf8VnXGI34dcmlKHwIsMLFwKMAaVtfTvFYQfR+HP",
-	"06ePf4nC9cOTHx/Rgaxu42bPydM3r8nLn0ZjYlbfOBcvjjseHowOnofjUTh+djE+jJ6NotHoH+tkKlUO",
-	"hkbUIjm0Rh7mkitXz5vJq2NyOD44IPY1qddvbFKWLNlqX8445gkaYFy/PfePJ/5xeLcXL0cvSP0hWX3Z",
-	"p4f9v2/giGRlDiJUCImFHMGbgoMA14J0gTFLWWyZajKmiYzjUikUMRKZEpMhqf0digiVksptDknCvEic",
-	"D/es3tp2N7pTcnIorCOp7SshxwVysgDOEu9+7cAA/pnQBkSMQ/m4nJwShSn6ME0GplEy7WJep2WndGgD",
-	"phwo4UWG5I+Li3PiPyCxTDYAyITBOSqXE2b4oMc6k8oE3ULqMs9BLTueEWc3uCvjn5KOjuUG6Yr1N+oI",
-	"i49pnZy+wlSuWqnsu/YXCJhje6xoBNJ7KtUcBPuIpEClmTaY1NLiBLRO6GpoPW4WH52f0oAu7CK312Js",
-	"UyQLFFAwGtFne6O9Q+r6W+ZKWnfhsHHA/jvHARGcoCmVcCrY13UiVYIKEzJbEjdZWBzblrRHVqDnS8JE",
-	"zMsEiZapCevplaAwq8nAcswttXpE7aTREmjrnVMuyNGgpehV18m/MxTEKWV7kw1PQSFRLhZMCHAp5pol",
-	"SCC23ZFI4V1h1tqHEtVyNTJZTDv3T7xNW33nnU9VCiU3NEqB60amZ1JyBEErq44KdSGF9ik+GI3ccUIK",
-	"g8IP8EXBWezC33+n/TTQbPDwocgNaA5/2444NhMpmjiz0CrjGLVOS87rplUHc6d/NXWe7ubng6RiwPPf",
-	"bD8kj1ea8cSxsW4TNU76iLQ8gbkT0B6IpnYAkoNzrJuKNQEi8Lo7znluxiDIDAkYAy57Rm5ysw3hobM+",
-	"9Z0EtflVJsvPBoJt1wpVu33Vw1kHj+MvhMf7sVi3DEepDCFBr75n0u/er9Ll5GwlDwKv+Xp9t2AtinYb",
-	"evXtId3XeCDKLUivgoEmv3/bnAwrn1/b0wbuRWRqiH9pSdE93xC/oSacifeeC7Yo67oqzIEJUgojS0eW",
-	"WWms7Jc8sRxSCFqzuW3EM0ylQr8Vk6JPJd91B6jUAvHh/Vc7ugkp+Qa73UZFdgNCMCzrr5wIuOIyMec9",
-	"o1bRmxuRfl1+R3NfUUZfsbN8Jxo3QTskLXYu+dZh6bS56Kq7ad+6m4PsuNiMQa1LpbaqBLtmqXOHUPmz",
-	"d5wNTPS2NA6nBSjDgBN/a2V7DgxdvLRhOnTf84WUeNvV0oOU+Gvypb4K/AYp4tO+ozhaCxiXipmlI8gM",
-	"QaE6Kk1Go6uphaNGtVjRp1ScRnQfCrZvT1bTte3eGDm5PCFrAOrhu9CGUQOnnJtwhZJQyfoiCJKcCTqt",
-	"ptV/AQAA//91TNtxXxoAAA==",
+	"H4sIAAAAAAAC/+xb+XPbuPX/V97wuzPfpEvJspO91Ol0XGePTN3d1Ed3prFrQ+STiA0IcAHQijbj/73z",
+	"AJDiJVm2kz0y/SmWRD688/Mu5F2UqLxQEqU10fRdZJIMc+b+PGIWF0qvTt1Xx9xY+jZFk2heWK5kNI2O",
+	"lBCY0AdYalYUqGGuNNgMIVACNQcGSaA1juKo0KpAbTm6U7jFvP3HJxrn0TT6v701Z3uB2F6bp9Myz5le",
+	"RbdxZFcFRtOIac1W0e1tHGn8ueQa02j6OpC+rJ9Ss58wsfTaML2emP5nENxYTKGUKeqGUDFoLDQalPTr",
+	"bAXcGmCJ5TcISoPEJRoLgj7eoDZcyb4aKlov07tUkKg8V/Kq0DzndIS5Oj9/+YKESTQyi+mhvT+JM56j",
+	"sSwviA43h455IhM0NlNKIJP0qyfyGD79E//yqrg/mVPMmbQ8qQgQRVEuHkCI3qK3LbOlswLKMieHSTWb",
+	"W7JRORPcZJg2nMdYzaV7z7KZwO9Zjg/Qd/1q11dr9Xb1FKRsHhs33aZht1qkpk8Mur/71Xt3FQon+HOJ",
+	"PtbbLtoKiXdRzt4eo1zYLJp+tn8QR7IUgjiLplaXOKAuGTTVeHH/4Ms4yrmsPw+8VjCN0h614oMJ8cM8",
+	"mr5+mAdedpl9pAd1LCi9aRzFIaW31b0RbCrTQo6Wpcyy3zVmpCiwpvNQ8zQoDtnoY3LAtsl/cH8wAf6s",
+	"te15itLyOQ95NeOomU4ynjABEo3lcjGOuqI/GhDLIn0PTtEJixZUBSgLobJ2wubZd8fOC3K64A1thf6Y",
+	"MdvI0ODdE5KMyQWamNLyUpUiDd+AksAg1SvQ5QdKziFAAutDkBqdqrkdhecq1jlxS+XU2iWsQTEHJtMY",
+	"ltxmcJ0wk7AUr2P6DYgmypRJa0iSncqqzWy366pajNNmajIbMSyUO6DV0oBRcwuVeI5zksuUM6sRidW5",
+	"0jmz0TTi0n7+PKoP59LiArU7Xq9OSjlcmOQqxT4nZxlW1qcHYrjWSLGd2Gv3t4+4a/KIWpHjaAAGqke3",
+	"2/Ao46JpPMjVDaZgFdiakfr31f+bEPE7ir/m4SEGeI+sbIttZ4chh9+gwy1ibfK32hHuxoh79S2mlXo5",
+	"msf2K21eHtGotAl9P+jq3cLBxRhBgkTXtyQd3/xAXQidolE+UEdOtEHgaSX/nZP9b1VPeqFUKe0mQxlv",
+	"IGZBIDMWlMRWkwhcgs24aTXPO4DE43K/VZaJ023cN0Sj5r7FIhSiNGAp+6o54A3qVSMhPRpa2mVDU8cD",
+	"jDdc8e6IOtM4EFEnSlmQKkXjJcUOQqzqimz1fpFiOAp2RotzV0Rt7OvaQn7DUaQGmBBq6fNDKIoIEZkE",
+	"fMtdodmVnSTOuXzVEHo//l/PuHPP2LPa3aOOPpKEB2rM8Dog6MjZT0qPcy6VHhfMJhmE0IsjfMvyQpCF",
+	"Xkf748l4EsXRwfjZ+DNypoJZi5qI/+fiIv304mLc+OeTocpog4w9Zv+OMzYbJcwgkOqgNJgSp+cnx6bD",
+	"1Uyw5M1IKFuaERNFxjqcvWajXyajry4/ffLX6aj+8PRPO/J31pzctJk8VkvUnkfJ3uCV+/OVMnah8fSf",
+	"x+CmL42+rMN4wnRqruhH62ub0qC+KrSac3qiL8Vl4P7qcmfm6xarx/zL0x/gy88n+2CrZ5x+z446XB5M",
+	"Dj4b7U9G+8/O9p9Pn02mk8m/ibcanAk+RkRkN5ZcjPTR85sjeL5/cAD0c/DMZgYoS55upa9mAvMULePC",
+	"XL3yH1/4j8OnffHl5AsID0L1ZB+T6Ps+gUPIypzJkUaWOiPj20IwyayLqwITPueJL5+5AZUkpdYoE6xS",
+	"Q+B3SCLUWml3OEtT7nv9V8PJovfu1kJoPTnIWUGMzAnMRwJvUMANEzz17AcGBkCHS2OZTHBIH+cnL0Hj",
+	"HL2YLqvXjm98OqzUci91rAeu/V7tu7OzV+AfgMR3Ev0Sx3IrBjk2mdI27hrS+Ll+hzNwdONNGn+IOjqU",
+	"156uef+gTjb3MtXK6af1W2etueqz9g8m2QLb06FGC+rLMb1gkv+CUKA2fo8RcpTrdr1CqxZi3Z3B4auX",
+	"URzdVAkoutknFakCJSt4NI2ejSdjKuMKZjNn0pD6RkmrS17gQOVxgrbU0pUe/dYLlE5R+4WKGxCRHxMk",
+	"jaFyerECLhNRpgimOTtBaav+hmLMvUpFQETNYKsq8hhdMM1ytEgh+ro/SkIJrjxpH9LglGkE7WTBFJhQ",
+	"cmF4ivUKSHpWOFH7uUS9qkrYaRTYf+Fp1jWtV9WclcJG0zkTBuPewINKEo2mUNJ4FR9MJvRPoqRFX7ez",
+	"ohA8ceLv/WR8/bA+YPdqlNTm/W9bw0mamKNNMnKtMknQmHkpRACtIMxG/kLofHo/PndKFQOcf014CE+q",
+	"nPHURaOp1n/OT/oe6XYvC5dAe050SVWnGhw1aGSWnIQWgb3+wcVmwiTMEJi1zGnPqmZstl14aGUTeSRB",
+	"Y/+m0tV7c4Jt26HbNnyFirjjj/sfyB/v9sUAGS6kMmQp+ux7rPzpfSudnxxX6UHiUtTvdw3WCtEuoN/+",
+	"8Tzd23hAyi2efhsPgPyeDR30VqS3WZhx9BtoYPWgynXcY6Du24Pr+hg3M1GlBebp+E6PoJloX8iq5ffX",
+	"DoYAe+UohlU+s44lqwpwNdMYTvlMcLnw525MQReyF5jfoh0YKfxqGO1O2yEurEb8WGD6W7R9QPVav7f7",
+	"vlsPmm69/5LXDC9pwvCcML07E4FrGn9fQ4oJT8ldCd8zVhRI3q/cONYHQHdRwGRdkU0v5HpLAU+CVZ5S",
+	"8VmaEAPwfPIVLDMuEJhc+SFNa6FB034XcPmdI/8L+aR1W0fT2GuNpExKZSlB+QUCMeornrnr4Ctunsbu",
+	"p3qL0lz4GD8LvJDrYWDDZGqBNkPtKTWKwVB1Gj8JXe+K4Ee39/I7gL9Q7rluCEg7PKfOC8kNaJr0pzBj",
+	"yZs4FGk0IHF24ba1BfwzsFqoQIqb+hvHAMsRlmw1FP++husl5q215Xdqebc3OGVnTKYC0011ZNi5DFSP",
+	"UeVIUVxfb2l8VfmLu0vi7DZw1eU27uN5obT1WmxovrVTrZG6KMTKDaXsJva9JX+v5W+9Zh5AqBd+Zwza",
+	"KYQw9GDy/O7VTHMX+gcE3gYI3q90iIfLg29cPnJ4yuVC9Ii6S3X1yG18d+799fLuLjn3I0m3J2g1x5t7",
+	"m3wrBL6szbphxVJBBg0Y1ojRWgu1+5D4vlrqjPpv/ZA2yQZmQGQa56cF05YzAf66CmXYgVrgEK67m4hr",
+	"3/F5oMzZG+xd7gC1lBeSyQSNVdpnIBoArTPtxGUH994qERhT5VxToMm6icMd1Ws/9xv3uBhKYENLow/U",
+	"WW7bT+3UWf6W0RxuKP0BA9ir/X00e81qea9xZ3xr86ckutHcCgqs71r4mm9g8Y2N+9WNPoz8ewxfsySr",
+	"KHDTvH99IdsXsANNolFdzR64kA1LmvJJ4pBXr44hWD7FOZduau9rMaqGw+AuHQqk3pQxXC+/c9R4KIxy",
+	"nWnz8rxfojtmB4rnOweL1Rvm91NdDfzHggFfPqoRLeji45stusbmo82iTmxMSs3tyrE8Q6ZRH5Y2i6av",
+	"L8nFDOqbSqBSi2ga7bGC79GO4bJWR2+genL+AuqIM8MXt9YyDsz7344qWUdahZUoS3Muo8vby9v/DgDv",
+	"YRiPEzMAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,1969 @@
+// Package schemarepository provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package schemarepository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ExportSchemaBundle request
+	ExportSchemaBundle(ctx context.Context, params *ExportSchemaBundleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportSchemaBundleWithBody request with any body
+	ImportSchemaBundleWithBody(ctx context.Context, params *ImportSchemaBundleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportSchemaBundle(ctx context.Context, params *ImportSchemaBundleParams, body ImportSchemaBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAllSchemaVersions request
+	ListAllSchemaVersions(ctx context.Context, params *ListAllSchemaVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSchemaVersionWithBody request with any body
+	CreateSchemaVersionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSchemaVersion(ctx context.Context, body CreateSchemaVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffSchemaVersions request
+	DiffSchemaVersions(ctx context.Context, schemaId externalRef2.UUID, params *DiffSchemaVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSchemaSlugs request
+	GetSchemaSlugs(ctx context.Context, schemaId externalRef2.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenameSchemaSlugWithBody request with any body
+	RenameSchemaSlugWithBody(ctx context.Context, schemaId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RenameSchemaSlug(ctx context.Context, schemaId externalRef2.UUID, body RenameSchemaSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSchemaVersion request
+	DeleteSchemaVersion(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params *DeleteSchemaVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSchemaVersion request
+	GetSchemaVersion(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublishSchemaVersion request
+	PublishSchemaVersion(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateSchemaPayloadWithBody request with any body
+	ValidateSchemaPayloadWithBody(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateSchemaPayload(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, body ValidateSchemaPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifySchemaExamples request
+	VerifySchemaExamples(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MigrateSchemaIndexes request
+	MigrateSchemaIndexes(ctx context.Context, schemaId externalRef2.UUID, params *MigrateSchemaIndexesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ExportSchemaBundle(ctx context.Context, params *ExportSchemaBundleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportSchemaBundleRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportSchemaBundleWithBody(ctx context.Context, params *ImportSchemaBundleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportSchemaBundleRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportSchemaBundle(ctx context.Context, params *ImportSchemaBundleParams, body ImportSchemaBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportSchemaBundleRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAllSchemaVersions(ctx context.Context, params *ListAllSchemaVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAllSchemaVersionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSchemaVersionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSchemaVersionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSchemaVersion(ctx context.Context, body CreateSchemaVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSchemaVersionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DiffSchemaVersions(ctx context.Context, schemaId externalRef2.UUID, params *DiffSchemaVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffSchemaVersionsRequest(c.Server, schemaId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSchemaSlugs(ctx context.Context, schemaId externalRef2.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSchemaSlugsRequest(c.Server, schemaId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenameSchemaSlugWithBody(ctx context.Context, schemaId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameSchemaSlugRequestWithBody(c.Server, schemaId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenameSchemaSlug(ctx context.Context, schemaId externalRef2.UUID, body RenameSchemaSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameSchemaSlugRequest(c.Server, schemaId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSchemaVersion(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params *DeleteSchemaVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSchemaVersionRequest(c.Server, schemaId, schemaVersion, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSchemaVersion(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSchemaVersionRequest(c.Server, schemaId, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PublishSchemaVersion(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishSchemaVersionRequest(c.Server, schemaId, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateSchemaPayloadWithBody(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateSchemaPayloadRequestWithBody(c.Server, schemaId, schemaVersion, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateSchemaPayload(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, body ValidateSchemaPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateSchemaPayloadRequest(c.Server, schemaId, schemaVersion, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifySchemaExamples(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifySchemaExamplesRequest(c.Server, schemaId, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MigrateSchemaIndexes(ctx context.Context, schemaId externalRef2.UUID, params *MigrateSchemaIndexesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMigrateSchemaIndexesRequest(c.Server, schemaId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewExportSchemaBundleRequest generates requests for ExportSchemaBundle
+func NewExportSchemaBundleRequest(server string, params *ExportSchemaBundleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/bundles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SchemaId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schemaId", runtime.ParamLocationQuery, *params.SchemaId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportSchemaBundleRequest calls the generic ImportSchemaBundle builder with application/json body
+func NewImportSchemaBundleRequest(server string, params *ImportSchemaBundleParams, body ImportSchemaBundleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportSchemaBundleRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewImportSchemaBundleRequestWithBody generates requests for ImportSchemaBundle with any type of body
+func NewImportSchemaBundleRequestWithBody(server string, params *ImportSchemaBundleParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/bundles:import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAllSchemaVersionsRequest generates requests for ListAllSchemaVersions
+func NewListAllSchemaVersionsRequest(server string, params *ListAllSchemaVersionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeInactive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeInactive", runtime.ParamLocationQuery, *params.IncludeInactive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSchemaVersionRequest calls the generic CreateSchemaVersion builder with application/json body
+func NewCreateSchemaVersionRequest(server string, body CreateSchemaVersionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSchemaVersionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSchemaVersionRequestWithBody generates requests for CreateSchemaVersion with any type of body
+func NewCreateSchemaVersionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDiffSchemaVersionsRequest generates requests for DiffSchemaVersions
+func NewDiffSchemaVersionsRequest(server string, schemaId externalRef2.UUID, params *DiffSchemaVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSchemaSlugsRequest generates requests for GetSchemaSlugs
+func NewGetSchemaSlugsRequest(server string, schemaId externalRef2.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/slugs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRenameSchemaSlugRequest calls the generic RenameSchemaSlug builder with application/json body
+func NewRenameSchemaSlugRequest(server string, schemaId externalRef2.UUID, body RenameSchemaSlugJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenameSchemaSlugRequestWithBody(server, schemaId, "application/json", bodyReader)
+}
+
+// NewRenameSchemaSlugRequestWithBody generates requests for RenameSchemaSlug with any type of body
+func NewRenameSchemaSlugRequestWithBody(server string, schemaId externalRef2.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/slugs:rename", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSchemaVersionRequest generates requests for DeleteSchemaVersion
+func NewDeleteSchemaVersionRequest(server string, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params *DeleteSchemaVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schemaVersion", runtime.ParamLocationPath, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/versions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSchemaVersionRequest generates requests for GetSchemaVersion
+func NewGetSchemaVersionRequest(server string, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schemaVersion", runtime.ParamLocationPath, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/versions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPublishSchemaVersionRequest generates requests for PublishSchemaVersion
+func NewPublishSchemaVersionRequest(server string, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schemaVersion", runtime.ParamLocationPath, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/versions/%s:publish", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewValidateSchemaPayloadRequest calls the generic ValidateSchemaPayload builder with application/json body
+func NewValidateSchemaPayloadRequest(server string, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, body ValidateSchemaPayloadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateSchemaPayloadRequestWithBody(server, schemaId, schemaVersion, "application/json", bodyReader)
+}
+
+// NewValidateSchemaPayloadRequestWithBody generates requests for ValidateSchemaPayload with any type of body
+func NewValidateSchemaPayloadRequestWithBody(server string, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schemaVersion", runtime.ParamLocationPath, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/versions/%s:validate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifySchemaExamplesRequest generates requests for VerifySchemaExamples
+func NewVerifySchemaExamplesRequest(server string, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schemaVersion", runtime.ParamLocationPath, schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s/versions/%s:verify-examples", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMigrateSchemaIndexesRequest generates requests for MigrateSchemaIndexes
+func NewMigrateSchemaIndexesRequest(server string, schemaId externalRef2.UUID, params *MigrateSchemaIndexesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "schemaId", runtime.ParamLocationPath, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schema-repository/schemas/%s:migrate-indexes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ExportSchemaBundleWithResponse request
+	ExportSchemaBundleWithResponse(ctx context.Context, params *ExportSchemaBundleParams, reqEditors ...RequestEditorFn) (*ExportSchemaBundleResponse, error)
+
+	// ImportSchemaBundleWithBodyWithResponse request with any body
+	ImportSchemaBundleWithBodyWithResponse(ctx context.Context, params *ImportSchemaBundleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportSchemaBundleResponse, error)
+
+	ImportSchemaBundleWithResponse(ctx context.Context, params *ImportSchemaBundleParams, body ImportSchemaBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportSchemaBundleResponse, error)
+
+	// ListAllSchemaVersionsWithResponse request
+	ListAllSchemaVersionsWithResponse(ctx context.Context, params *ListAllSchemaVersionsParams, reqEditors ...RequestEditorFn) (*ListAllSchemaVersionsResponse, error)
+
+	// CreateSchemaVersionWithBodyWithResponse request with any body
+	CreateSchemaVersionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSchemaVersionResponse, error)
+
+	CreateSchemaVersionWithResponse(ctx context.Context, body CreateSchemaVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSchemaVersionResponse, error)
+
+	// DiffSchemaVersionsWithResponse request
+	DiffSchemaVersionsWithResponse(ctx context.Context, schemaId externalRef2.UUID, params *DiffSchemaVersionsParams, reqEditors ...RequestEditorFn) (*DiffSchemaVersionsResponse, error)
+
+	// GetSchemaSlugsWithResponse request
+	GetSchemaSlugsWithResponse(ctx context.Context, schemaId externalRef2.UUID, reqEditors ...RequestEditorFn) (*GetSchemaSlugsResponse, error)
+
+	// RenameSchemaSlugWithBodyWithResponse request with any body
+	RenameSchemaSlugWithBodyWithResponse(ctx context.Context, schemaId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameSchemaSlugResponse, error)
+
+	RenameSchemaSlugWithResponse(ctx context.Context, schemaId externalRef2.UUID, body RenameSchemaSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameSchemaSlugResponse, error)
+
+	// DeleteSchemaVersionWithResponse request
+	DeleteSchemaVersionWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params *DeleteSchemaVersionParams, reqEditors ...RequestEditorFn) (*DeleteSchemaVersionResponse, error)
+
+	// GetSchemaVersionWithResponse request
+	GetSchemaVersionWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*GetSchemaVersionResponse, error)
+
+	// PublishSchemaVersionWithResponse request
+	PublishSchemaVersionWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*PublishSchemaVersionResponse, error)
+
+	// ValidateSchemaPayloadWithBodyWithResponse request with any body
+	ValidateSchemaPayloadWithBodyWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateSchemaPayloadResponse, error)
+
+	ValidateSchemaPayloadWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, body ValidateSchemaPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateSchemaPayloadResponse, error)
+
+	// VerifySchemaExamplesWithResponse request
+	VerifySchemaExamplesWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*VerifySchemaExamplesResponse, error)
+
+	// MigrateSchemaIndexesWithResponse request
+	MigrateSchemaIndexesWithResponse(ctx context.Context, schemaId externalRef2.UUID, params *MigrateSchemaIndexesParams, reqEditors ...RequestEditorFn) (*MigrateSchemaIndexesResponse, error)
+}
+
+type ExportSchemaBundleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaBundle
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportSchemaBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportSchemaBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportSchemaBundleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaBundleReport
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportSchemaBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportSchemaBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAllSchemaVersionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaVersionList
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAllSchemaVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAllSchemaVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSchemaVersionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *SchemaVersion
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSchemaVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSchemaVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffSchemaVersionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaDiff
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffSchemaVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffSchemaVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSchemaSlugsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaSlugs
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSchemaSlugsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSchemaSlugsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RenameSchemaSlugResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaSlugs
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r RenameSchemaSlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenameSchemaSlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSchemaVersionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaVersionDeletion
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSchemaVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSchemaVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSchemaVersionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaVersion
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSchemaVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSchemaVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PublishSchemaVersionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaVersion
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r PublishSchemaVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PublishSchemaVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateSchemaPayloadResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaPayloadValidationResult
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateSchemaPayloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateSchemaPayloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifySchemaExamplesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SchemaExamplesVerificationResult
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifySchemaExamplesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifySchemaExamplesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MigrateSchemaIndexesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EntityIndexMigrationReport
+	ApplicationproblemJSONDefault *externalRef3.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r MigrateSchemaIndexesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MigrateSchemaIndexesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ExportSchemaBundleWithResponse request returning *ExportSchemaBundleResponse
+func (c *ClientWithResponses) ExportSchemaBundleWithResponse(ctx context.Context, params *ExportSchemaBundleParams, reqEditors ...RequestEditorFn) (*ExportSchemaBundleResponse, error) {
+	rsp, err := c.ExportSchemaBundle(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportSchemaBundleResponse(rsp)
+}
+
+// ImportSchemaBundleWithBodyWithResponse request with arbitrary body returning *ImportSchemaBundleResponse
+func (c *ClientWithResponses) ImportSchemaBundleWithBodyWithResponse(ctx context.Context, params *ImportSchemaBundleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportSchemaBundleResponse, error) {
+	rsp, err := c.ImportSchemaBundleWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportSchemaBundleResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportSchemaBundleWithResponse(ctx context.Context, params *ImportSchemaBundleParams, body ImportSchemaBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportSchemaBundleResponse, error) {
+	rsp, err := c.ImportSchemaBundle(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportSchemaBundleResponse(rsp)
+}
+
+// ListAllSchemaVersionsWithResponse request returning *ListAllSchemaVersionsResponse
+func (c *ClientWithResponses) ListAllSchemaVersionsWithResponse(ctx context.Context, params *ListAllSchemaVersionsParams, reqEditors ...RequestEditorFn) (*ListAllSchemaVersionsResponse, error) {
+	rsp, err := c.ListAllSchemaVersions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAllSchemaVersionsResponse(rsp)
+}
+
+// CreateSchemaVersionWithBodyWithResponse request with arbitrary body returning *CreateSchemaVersionResponse
+func (c *ClientWithResponses) CreateSchemaVersionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSchemaVersionResponse, error) {
+	rsp, err := c.CreateSchemaVersionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSchemaVersionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSchemaVersionWithResponse(ctx context.Context, body CreateSchemaVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSchemaVersionResponse, error) {
+	rsp, err := c.CreateSchemaVersion(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSchemaVersionResponse(rsp)
+}
+
+// DiffSchemaVersionsWithResponse request returning *DiffSchemaVersionsResponse
+func (c *ClientWithResponses) DiffSchemaVersionsWithResponse(ctx context.Context, schemaId externalRef2.UUID, params *DiffSchemaVersionsParams, reqEditors ...RequestEditorFn) (*DiffSchemaVersionsResponse, error) {
+	rsp, err := c.DiffSchemaVersions(ctx, schemaId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffSchemaVersionsResponse(rsp)
+}
+
+// GetSchemaSlugsWithResponse request returning *GetSchemaSlugsResponse
+func (c *ClientWithResponses) GetSchemaSlugsWithResponse(ctx context.Context, schemaId externalRef2.UUID, reqEditors ...RequestEditorFn) (*GetSchemaSlugsResponse, error) {
+	rsp, err := c.GetSchemaSlugs(ctx, schemaId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSchemaSlugsResponse(rsp)
+}
+
+// RenameSchemaSlugWithBodyWithResponse request with arbitrary body returning *RenameSchemaSlugResponse
+func (c *ClientWithResponses) RenameSchemaSlugWithBodyWithResponse(ctx context.Context, schemaId externalRef2.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameSchemaSlugResponse, error) {
+	rsp, err := c.RenameSchemaSlugWithBody(ctx, schemaId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameSchemaSlugResponse(rsp)
+}
+
+func (c *ClientWithResponses) RenameSchemaSlugWithResponse(ctx context.Context, schemaId externalRef2.UUID, body RenameSchemaSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameSchemaSlugResponse, error) {
+	rsp, err := c.RenameSchemaSlug(ctx, schemaId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameSchemaSlugResponse(rsp)
+}
+
+// DeleteSchemaVersionWithResponse request returning *DeleteSchemaVersionResponse
+func (c *ClientWithResponses) DeleteSchemaVersionWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params *DeleteSchemaVersionParams, reqEditors ...RequestEditorFn) (*DeleteSchemaVersionResponse, error) {
+	rsp, err := c.DeleteSchemaVersion(ctx, schemaId, schemaVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSchemaVersionResponse(rsp)
+}
+
+// GetSchemaVersionWithResponse request returning *GetSchemaVersionResponse
+func (c *ClientWithResponses) GetSchemaVersionWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*GetSchemaVersionResponse, error) {
+	rsp, err := c.GetSchemaVersion(ctx, schemaId, schemaVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSchemaVersionResponse(rsp)
+}
+
+// PublishSchemaVersionWithResponse request returning *PublishSchemaVersionResponse
+func (c *ClientWithResponses) PublishSchemaVersionWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*PublishSchemaVersionResponse, error) {
+	rsp, err := c.PublishSchemaVersion(ctx, schemaId, schemaVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublishSchemaVersionResponse(rsp)
+}
+
+// ValidateSchemaPayloadWithBodyWithResponse request with arbitrary body returning *ValidateSchemaPayloadResponse
+func (c *ClientWithResponses) ValidateSchemaPayloadWithBodyWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateSchemaPayloadResponse, error) {
+	rsp, err := c.ValidateSchemaPayloadWithBody(ctx, schemaId, schemaVersion, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateSchemaPayloadResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateSchemaPayloadWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, body ValidateSchemaPayloadJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateSchemaPayloadResponse, error) {
+	rsp, err := c.ValidateSchemaPayload(ctx, schemaId, schemaVersion, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateSchemaPayloadResponse(rsp)
+}
+
+// VerifySchemaExamplesWithResponse request returning *VerifySchemaExamplesResponse
+func (c *ClientWithResponses) VerifySchemaExamplesWithResponse(ctx context.Context, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, reqEditors ...RequestEditorFn) (*VerifySchemaExamplesResponse, error) {
+	rsp, err := c.VerifySchemaExamples(ctx, schemaId, schemaVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifySchemaExamplesResponse(rsp)
+}
+
+// MigrateSchemaIndexesWithResponse request returning *MigrateSchemaIndexesResponse
+func (c *ClientWithResponses) MigrateSchemaIndexesWithResponse(ctx context.Context, schemaId externalRef2.UUID, params *MigrateSchemaIndexesParams, reqEditors ...RequestEditorFn) (*MigrateSchemaIndexesResponse, error) {
+	rsp, err := c.MigrateSchemaIndexes(ctx, schemaId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMigrateSchemaIndexesResponse(rsp)
+}
+
+// ParseExportSchemaBundleResponse parses an HTTP response from a ExportSchemaBundleWithResponse call
+func ParseExportSchemaBundleResponse(rsp *http.Response) (*ExportSchemaBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportSchemaBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportSchemaBundleResponse parses an HTTP response from a ImportSchemaBundleWithResponse call
+func ParseImportSchemaBundleResponse(rsp *http.Response) (*ImportSchemaBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportSchemaBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaBundleReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAllSchemaVersionsResponse parses an HTTP response from a ListAllSchemaVersionsWithResponse call
+func ParseListAllSchemaVersionsResponse(rsp *http.Response) (*ListAllSchemaVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAllSchemaVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaVersionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSchemaVersionResponse parses an HTTP response from a CreateSchemaVersionWithResponse call
+func ParseCreateSchemaVersionResponse(rsp *http.Response) (*CreateSchemaVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSchemaVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SchemaVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDiffSchemaVersionsResponse parses an HTTP response from a DiffSchemaVersionsWithResponse call
+func ParseDiffSchemaVersionsResponse(rsp *http.Response) (*DiffSchemaVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiffSchemaVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSchemaSlugsResponse parses an HTTP response from a GetSchemaSlugsWithResponse call
+func ParseGetSchemaSlugsResponse(rsp *http.Response) (*GetSchemaSlugsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSchemaSlugsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaSlugs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRenameSchemaSlugResponse parses an HTTP response from a RenameSchemaSlugWithResponse call
+func ParseRenameSchemaSlugResponse(rsp *http.Response) (*RenameSchemaSlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RenameSchemaSlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaSlugs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSchemaVersionResponse parses an HTTP response from a DeleteSchemaVersionWithResponse call
+func ParseDeleteSchemaVersionResponse(rsp *http.Response) (*DeleteSchemaVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSchemaVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaVersionDeletion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSchemaVersionResponse parses an HTTP response from a GetSchemaVersionWithResponse call
+func ParseGetSchemaVersionResponse(rsp *http.Response) (*GetSchemaVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSchemaVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePublishSchemaVersionResponse parses an HTTP response from a PublishSchemaVersionWithResponse call
+func ParsePublishSchemaVersionResponse(rsp *http.Response) (*PublishSchemaVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PublishSchemaVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateSchemaPayloadResponse parses an HTTP response from a ValidateSchemaPayloadWithResponse call
+func ParseValidateSchemaPayloadResponse(rsp *http.Response) (*ValidateSchemaPayloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateSchemaPayloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaPayloadValidationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifySchemaExamplesResponse parses an HTTP response from a VerifySchemaExamplesWithResponse call
+func ParseVerifySchemaExamplesResponse(rsp *http.Response) (*VerifySchemaExamplesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifySchemaExamplesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaExamplesVerificationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMigrateSchemaIndexesResponse parses an HTTP response from a MigrateSchemaIndexesWithResponse call
+func ParseMigrateSchemaIndexesResponse(rsp *http.Response) (*MigrateSchemaIndexesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MigrateSchemaIndexesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EntityIndexMigrationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef3.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
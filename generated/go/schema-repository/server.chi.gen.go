@@ -29,26 +29,298 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CreateSchemaVersionRequestCompatibilityMode.
+const (
+	Annotate CreateSchemaVersionRequestCompatibilityMode = "annotate"
+	Enforce  CreateSchemaVersionRequestCompatibilityMode = "enforce"
+	None     CreateSchemaVersionRequestCompatibilityMode = "none"
+)
+
+// Defines values for SchemaBundleFormatVersion.
+const (
+	N1 SchemaBundleFormatVersion = 1
+)
+
+// Defines values for SchemaBundleItemAction.
+const (
+	Create    SchemaBundleItemAction = "create"
+	Unchanged SchemaBundleItemAction = "unchanged"
+	Update    SchemaBundleItemAction = "update"
+)
+
+// Defines values for SchemaCompatibilityLevel.
+const (
+	Backward SchemaCompatibilityLevel = "backward"
+	Breaking SchemaCompatibilityLevel = "breaking"
+)
+
+// Defines values for SchemaCompatibilityIssueKind.
+const (
+	EnumNarrowed    SchemaCompatibilityIssueKind = "enum-narrowed"
+	RequiredAdded   SchemaCompatibilityIssueKind = "required-added"
+	RequiredRemoved SchemaCompatibilityIssueKind = "required-removed"
+	TypeChanged     SchemaCompatibilityIssueKind = "type-changed"
+)
+
+// Defines values for SchemaStatus.
+const (
+	Draft     SchemaStatus = "draft"
+	Published SchemaStatus = "published"
+)
+
 // CreateSchemaVersionRequest defines model for CreateSchemaVersionRequest.
 type CreateSchemaVersionRequest struct {
 	// CategoryId RFC 4122 UUID string
 	CategoryId externalRef2.UUID `json:"categoryId"`
 
-	// SchemaDefinition JSON Schema document describing the entity.
+	// CompatibilityMode How to treat breaking changes against the active version.
+	CompatibilityMode *CreateSchemaVersionRequestCompatibilityMode `json:"compatibilityMode,omitempty"`
+
+	// SchemaDefinition JSON Schema document describing the entity. Sample payloads may be stored under a root `x-examples` array;
+	// each must validate against the definition or the request fails with 400.
+	SchemaDefinition map[string]interface{} `json:"schemaDefinition"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef2.Slug `json:"slug"`
+
+	// Status Drafts are never activated and can be re-submitted under the same version until they are published.
+	Status *SchemaStatus `json:"status,omitempty"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+}
+
+// CreateSchemaVersionRequestCompatibilityMode How to treat breaking changes against the active version.
+type CreateSchemaVersionRequestCompatibilityMode string
+
+// EntityIndexMigrationReport defines model for EntityIndexMigrationReport.
+type EntityIndexMigrationReport struct {
+	DryRun bool `json:"dryRun"`
+
+	// IndexedFields Dotted payload path of every `x-indexed` field of the active version, e.g. `address.city`.
+	IndexedFields []string `json:"indexedFields"`
+
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// SchemaVersion Semantic version string in major.minor.patch format
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+	Tenants       []EntityIndexMigrationTenant `json:"tenants"`
+}
+
+// EntityIndexMigrationTenant defines model for EntityIndexMigrationTenant.
+type EntityIndexMigrationTenant struct {
+	// CreatedIndexes Indexes created, or that would be created on a dry run.
+	CreatedIndexes []string `json:"createdIndexes"`
+
+	// DroppedIndexes Indexes dropped, or that would be dropped on a dry run.
+	DroppedIndexes []string `json:"droppedIndexes"`
+
+	// Error Set when the tenant could not be migrated.
+	Error       *string `json:"error,omitempty"`
+	TableExists bool    `json:"tableExists"`
+
+	// TenantId RFC 4122 UUID string
+	TenantId externalRef2.UUID `json:"tenantId"`
+
+	// TenantSlug Kebab-case slug used in URLs
+	TenantSlug externalRef2.Slug `json:"tenantSlug"`
+}
+
+// RenameSchemaSlugRequest defines model for RenameSchemaSlugRequest.
+type RenameSchemaSlugRequest struct {
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef2.Slug `json:"slug"`
+}
+
+// SchemaBundle defines model for SchemaBundle.
+type SchemaBundle struct {
+	Categories []SchemaBundleCategory `json:"categories"`
+
+	// ExportedAt ISO 8601 timestamp in UTC
+	ExportedAt    *externalRef2.Timestamp   `json:"exportedAt,omitempty"`
+	FormatVersion SchemaBundleFormatVersion `json:"formatVersion"`
+	Schemas       []SchemaBundleVersion     `json:"schemas"`
+}
+
+// SchemaBundleFormatVersion defines model for SchemaBundle.FormatVersion.
+type SchemaBundleFormatVersion int
+
+// SchemaBundleCategory defines model for SchemaBundleCategory.
+type SchemaBundleCategory struct {
+	// CategoryId RFC 4122 UUID string
+	CategoryId  externalRef2.UUID `json:"categoryId"`
+	Description *string           `json:"description,omitempty"`
+	Name        string            `json:"name"`
+
+	// ParentCategoryId RFC 4122 UUID string
+	ParentCategoryId *externalRef2.UUID `json:"parentCategoryId,omitempty"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef2.Slug `json:"slug"`
+}
+
+// SchemaBundleItem defines model for SchemaBundleItem.
+type SchemaBundleItem struct {
+	Action SchemaBundleItemAction `json:"action"`
+	Key    string                 `json:"key"`
+}
+
+// SchemaBundleItemAction defines model for SchemaBundleItem.Action.
+type SchemaBundleItemAction string
+
+// SchemaBundleReport defines model for SchemaBundleReport.
+type SchemaBundleReport struct {
+	// Applied False for dry runs.
+	Applied    bool               `json:"applied"`
+	Categories []SchemaBundleItem `json:"categories"`
+	Schemas    []SchemaBundleItem `json:"schemas"`
+}
+
+// SchemaBundleVersion defines model for SchemaBundleVersion.
+type SchemaBundleVersion struct {
+	// Active Activate this version on import.
+	Active bool `json:"active"`
+
+	// CategoryId RFC 4122 UUID string
+	CategoryId       externalRef2.UUID      `json:"categoryId"`
 	SchemaDefinition map[string]interface{} `json:"schemaDefinition"`
 
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// SchemaVersion Semantic version string in major.minor.patch format
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+
 	// Slug Kebab-case slug used in URLs
 	Slug externalRef2.Slug `json:"slug"`
 
+	// Status Drafts are never activated and can be re-submitted under the same version until they are published.
+	Status *SchemaStatus `json:"status,omitempty"`
+
 	// TableName Lowercase snake_case PostgreSQL table identifier
 	TableName externalRef2.TableName `json:"tableName"`
 }
 
+// SchemaCompatibility Compatibility of a version with the version that was active when it was registered.
+type SchemaCompatibility struct {
+	// BaseVersion Semantic version string in major.minor.patch format
+	BaseVersion externalRef2.SemanticVersion `json:"baseVersion"`
+	Issues      []SchemaCompatibilityIssue   `json:"issues"`
+
+	// Level `backward` when documents valid under the base version remain valid.
+	Level SchemaCompatibilityLevel `json:"level"`
+}
+
+// SchemaCompatibilityLevel `backward` when documents valid under the base version remain valid.
+type SchemaCompatibilityLevel string
+
+// SchemaCompatibilityIssue defines model for SchemaCompatibilityIssue.
+type SchemaCompatibilityIssue struct {
+	Kind    SchemaCompatibilityIssueKind `json:"kind"`
+	Message string                       `json:"message"`
+
+	// Path Dotted property path (`$` for the root, `[]` for array items).
+	Path string `json:"path"`
+}
+
+// SchemaCompatibilityIssueKind defines model for SchemaCompatibilityIssue.Kind.
+type SchemaCompatibilityIssueKind string
+
+// SchemaDiff defines model for SchemaDiff.
+type SchemaDiff struct {
+	// AddedProperties Paths of properties only present in `toVersion`.
+	AddedProperties   []string               `json:"addedProperties"`
+	ChangedProperties []SchemaPropertyChange `json:"changedProperties"`
+
+	// FromVersion Semantic version string in major.minor.patch format
+	FromVersion externalRef2.SemanticVersion `json:"fromVersion"`
+
+	// RemovedProperties Paths of properties only present in `fromVersion`.
+	RemovedProperties []string `json:"removedProperties"`
+
+	// RequiredAdded Paths that became required.
+	RequiredAdded []string `json:"requiredAdded"`
+
+	// RequiredRemoved Paths that are no longer required.
+	RequiredRemoved []string `json:"requiredRemoved"`
+
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// ToVersion Semantic version string in major.minor.patch format
+	ToVersion externalRef2.SemanticVersion `json:"toVersion"`
+}
+
+// SchemaExamplesVerificationResult defines model for SchemaExamplesVerificationResult.
+type SchemaExamplesVerificationResult struct {
+	// Errors Validation messages keyed by example path, e.g. `x-examples[1].name`.
+	Errors map[string][]string `json:"errors"`
+
+	// ExampleCount Number of examples stored under `x-examples`.
+	ExampleCount int  `json:"exampleCount"`
+	Valid        bool `json:"valid"`
+}
+
+// SchemaPayloadValidationRequest defines model for SchemaPayloadValidationRequest.
+type SchemaPayloadValidationRequest struct {
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// SchemaPayloadValidationResult defines model for SchemaPayloadValidationResult.
+type SchemaPayloadValidationResult struct {
+	// Errors Validation messages keyed by payload path, e.g. `payload.name`.
+	Errors map[string][]string `json:"errors"`
+	Valid  bool                `json:"valid"`
+}
+
+// SchemaPropertyChange defines model for SchemaPropertyChange.
+type SchemaPropertyChange struct {
+	// After Values of the changed keywords in `toVersion`; absent keywords are omitted.
+	After map[string]interface{} `json:"after"`
+
+	// Before Values of the changed keywords in `fromVersion`; absent keywords are omitted.
+	Before map[string]interface{} `json:"before"`
+
+	// Keywords JSON Schema keywords whose value differs, e.g. `type` or `enum`.
+	Keywords []string `json:"keywords"`
+
+	// Path Dotted property path (`$` for the root).
+	Path string `json:"path"`
+}
+
+// SchemaSlugAlias defines model for SchemaSlugAlias.
+type SchemaSlugAlias struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef2.Slug `json:"slug"`
+}
+
+// SchemaSlugs defines model for SchemaSlugs.
+type SchemaSlugs struct {
+	// Aliases Previous slugs that still resolve to the schema, newest first.
+	Aliases []SchemaSlugAlias `json:"aliases"`
+
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef2.Slug `json:"slug"`
+}
+
+// SchemaStatus Drafts are never activated and can be re-submitted under the same version until they are published.
+type SchemaStatus string
+
 // SchemaVersion Schema definition metadata stored in the repository.
 type SchemaVersion struct {
 	// CategoryId RFC 4122 UUID string
 	CategoryId externalRef2.UUID `json:"categoryId"`
 
+	// Compatibility Compatibility of a version with the version that was active when it was registered.
+	Compatibility *SchemaCompatibility `json:"compatibility,omitempty"`
+
 	// CreatedAt ISO 8601 timestamp in UTC
 	CreatedAt externalRef2.Timestamp `json:"createdAt"`
 
@@ -70,8 +342,34 @@ type SchemaVersion struct {
 	// Slug Kebab-case slug used in URLs
 	Slug externalRef2.Slug `json:"slug"`
 
+	// Status Drafts are never activated and can be re-submitted under the same version until they are published.
+	Status SchemaStatus `json:"status"`
+
+	// TableName Lowercase snake_case PostgreSQL table identifier
+	TableName externalRef2.TableName `json:"tableName"`
+}
+
+// SchemaVersionDeletion defines model for SchemaVersionDeletion.
+type SchemaVersionDeletion struct {
+	DryRun bool `json:"dryRun"`
+
+	// EntityRows Entity rows, across every tenant, pinned to the version. They keep their data.
+	EntityRows int64 `json:"entityRows"`
+
+	// EntityTables Tenant entity tables holding those rows.
+	EntityTables int `json:"entityTables"`
+
+	// SchemaId RFC 4122 UUID string
+	SchemaId externalRef2.UUID `json:"schemaId"`
+
+	// SchemaVersion Semantic version string in major.minor.patch format
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+
 	// TableName Lowercase snake_case PostgreSQL table identifier
 	TableName externalRef2.TableName `json:"tableName"`
+
+	// WasActive Whether the version was the active one; deleting it leaves the schema without an active version.
+	WasActive bool `json:"wasActive"`
 }
 
 // SchemaVersionList Collection of schema versions.
@@ -79,32 +377,119 @@ type SchemaVersionList struct {
 	Items []SchemaVersion `json:"items"`
 }
 
+// ExportSchemaBundleParams defines parameters for ExportSchemaBundle.
+type ExportSchemaBundleParams struct {
+	// SchemaId Schemas to export; repeat the parameter to select several.
+	SchemaId *[]externalRef2.UUID `form:"schemaId,omitempty" json:"schemaId,omitempty"`
+}
+
+// ImportSchemaBundleParams defines parameters for ImportSchemaBundle.
+type ImportSchemaBundleParams struct {
+	// DryRun Check the bundle and report the planned actions without writing.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListAllSchemaVersionsParams defines parameters for ListAllSchemaVersions.
 type ListAllSchemaVersionsParams struct {
 	// IncludeInactive Include inactive schema versions in the results.
 	IncludeInactive *bool `form:"includeInactive,omitempty" json:"includeInactive,omitempty"`
 }
 
+// DiffSchemaVersionsParams defines parameters for DiffSchemaVersions.
+type DiffSchemaVersionsParams struct {
+	// From Version used as the base of the comparison.
+	From externalRef2.SemanticVersion `form:"from" json:"from"`
+
+	// To Version compared against `from`.
+	To externalRef2.SemanticVersion `form:"to" json:"to"`
+}
+
+// DeleteSchemaVersionParams defines parameters for DeleteSchemaVersion.
+type DeleteSchemaVersionParams struct {
+	// Force Delete even when the version is active or referenced by entities
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// DryRun Report what the delete would change without applying it.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// MigrateSchemaIndexesParams defines parameters for MigrateSchemaIndexes.
+type MigrateSchemaIndexesParams struct {
+	// DryRun Report the planned index changes without applying them.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ImportSchemaBundleJSONRequestBody defines body for ImportSchemaBundle for application/json ContentType.
+type ImportSchemaBundleJSONRequestBody = SchemaBundle
+
 // CreateSchemaVersionJSONRequestBody defines body for CreateSchemaVersion for application/json ContentType.
 type CreateSchemaVersionJSONRequestBody = CreateSchemaVersionRequest
 
+// RenameSchemaSlugJSONRequestBody defines body for RenameSchemaSlug for application/json ContentType.
+type RenameSchemaSlugJSONRequestBody = RenameSchemaSlugRequest
+
+// ValidateSchemaPayloadJSONRequestBody defines body for ValidateSchemaPayload for application/json ContentType.
+type ValidateSchemaPayloadJSONRequestBody = SchemaPayloadValidationRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Export schema bundle
+	// (GET /schema-repository/bundles)
+	ExportSchemaBundle(w http.ResponseWriter, r *http.Request, params ExportSchemaBundleParams)
+	// Import schema bundle
+	// (POST /schema-repository/bundles:import)
+	ImportSchemaBundle(w http.ResponseWriter, r *http.Request, params ImportSchemaBundleParams)
 	// List schema versions
 	// (GET /schema-repository/schemas)
 	ListAllSchemaVersions(w http.ResponseWriter, r *http.Request, params ListAllSchemaVersionsParams)
 	// Create schema version
 	// (POST /schema-repository/schemas)
 	CreateSchemaVersion(w http.ResponseWriter, r *http.Request)
+	// Diff schema versions
+	// (GET /schema-repository/schemas/{schemaId}/diff)
+	DiffSchemaVersions(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, params DiffSchemaVersionsParams)
+	// Get schema slugs
+	// (GET /schema-repository/schemas/{schemaId}/slugs)
+	GetSchemaSlugs(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID)
+	// Rename schema slug
+	// (POST /schema-repository/schemas/{schemaId}/slugs:rename)
+	RenameSchemaSlug(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID)
+	// Soft delete schema version
+	// (DELETE /schema-repository/schemas/{schemaId}/versions/{schemaVersion})
+	DeleteSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params DeleteSchemaVersionParams)
 	// Get schema version
 	// (GET /schema-repository/schemas/{schemaId}/versions/{schemaVersion})
 	GetSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion)
+	// Publish draft schema version
+	// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:publish)
+	PublishSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion)
+	// Validate payload against schema version
+	// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:validate)
+	ValidateSchemaPayload(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion)
+	// Verify stored examples
+	// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:verify-examples)
+	VerifySchemaExamples(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion)
+	// Migrate entity table indexes
+	// (POST /schema-repository/schemas/{schemaId}:migrate-indexes)
+	MigrateSchemaIndexes(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, params MigrateSchemaIndexesParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Export schema bundle
+// (GET /schema-repository/bundles)
+func (_ Unimplemented) ExportSchemaBundle(w http.ResponseWriter, r *http.Request, params ExportSchemaBundleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import schema bundle
+// (POST /schema-repository/bundles:import)
+func (_ Unimplemented) ImportSchemaBundle(w http.ResponseWriter, r *http.Request, params ImportSchemaBundleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List schema versions
 // (GET /schema-repository/schemas)
 func (_ Unimplemented) ListAllSchemaVersions(w http.ResponseWriter, r *http.Request, params ListAllSchemaVersionsParams) {
@@ -117,12 +502,60 @@ func (_ Unimplemented) CreateSchemaVersion(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Diff schema versions
+// (GET /schema-repository/schemas/{schemaId}/diff)
+func (_ Unimplemented) DiffSchemaVersions(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, params DiffSchemaVersionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get schema slugs
+// (GET /schema-repository/schemas/{schemaId}/slugs)
+func (_ Unimplemented) GetSchemaSlugs(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename schema slug
+// (POST /schema-repository/schemas/{schemaId}/slugs:rename)
+func (_ Unimplemented) RenameSchemaSlug(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Soft delete schema version
+// (DELETE /schema-repository/schemas/{schemaId}/versions/{schemaVersion})
+func (_ Unimplemented) DeleteSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params DeleteSchemaVersionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get schema version
 // (GET /schema-repository/schemas/{schemaId}/versions/{schemaVersion})
 func (_ Unimplemented) GetSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Publish draft schema version
+// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:publish)
+func (_ Unimplemented) PublishSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Validate payload against schema version
+// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:validate)
+func (_ Unimplemented) ValidateSchemaPayload(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify stored examples
+// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:verify-examples)
+func (_ Unimplemented) VerifySchemaExamples(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Migrate entity table indexes
+// (POST /schema-repository/schemas/{schemaId}:migrate-indexes)
+func (_ Unimplemented) MigrateSchemaIndexes(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, params MigrateSchemaIndexesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -132,6 +565,72 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ExportSchemaBundle operation middleware
+func (siw *ServerInterfaceWrapper) ExportSchemaBundle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportSchemaBundleParams
+
+	// ------------- Optional query parameter "schemaId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaId", r.URL.Query(), &params.SchemaId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportSchemaBundle(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportSchemaBundle operation middleware
+func (siw *ServerInterfaceWrapper) ImportSchemaBundle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportSchemaBundleParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportSchemaBundle(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListAllSchemaVersions operation middleware
 func (siw *ServerInterfaceWrapper) ListAllSchemaVersions(w http.ResponseWriter, r *http.Request) {
 
@@ -185,8 +684,8 @@ func (siw *ServerInterfaceWrapper) CreateSchemaVersion(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetSchemaVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetSchemaVersion(w http.ResponseWriter, r *http.Request) {
+// DiffSchemaVersions operation middleware
+func (siw *ServerInterfaceWrapper) DiffSchemaVersions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -199,248 +698,933 @@ func (siw *ServerInterfaceWrapper) GetSchemaVersion(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "schemaVersion" -------------
-	var schemaVersion externalRef2.SemanticVersion
-
-	err = runtime.BindStyledParameterWithOptions("simple", "schemaVersion", chi.URLParam(r, "schemaVersion"), &schemaVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchemaVersion(w, r, schemaId, schemaVersion)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffSchemaVersionsParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Required query parameter "from" -------------
 
-	handler.ServeHTTP(w, r)
-}
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
 
-type UnescapedCookieParamError struct {
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffSchemaVersions(w, r, schemaId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSchemaSlugs operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaSlugs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaSlugs(w, r, schemaId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameSchemaSlug operation middleware
+func (siw *ServerInterfaceWrapper) RenameSchemaSlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameSchemaSlug(w, r, schemaId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSchemaVersion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSchemaVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schemaVersion" -------------
+	var schemaVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaVersion", chi.URLParam(r, "schemaVersion"), &schemaVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSchemaVersionParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSchemaVersion(w, r, schemaId, schemaVersion, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSchemaVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schemaVersion" -------------
+	var schemaVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaVersion", chi.URLParam(r, "schemaVersion"), &schemaVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaVersion(w, r, schemaId, schemaVersion)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishSchemaVersion operation middleware
+func (siw *ServerInterfaceWrapper) PublishSchemaVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schemaVersion" -------------
+	var schemaVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaVersion", chi.URLParam(r, "schemaVersion"), &schemaVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishSchemaVersion(w, r, schemaId, schemaVersion)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ValidateSchemaPayload operation middleware
+func (siw *ServerInterfaceWrapper) ValidateSchemaPayload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schemaVersion" -------------
+	var schemaVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaVersion", chi.URLParam(r, "schemaVersion"), &schemaVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateSchemaPayload(w, r, schemaId, schemaVersion)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifySchemaExamples operation middleware
+func (siw *ServerInterfaceWrapper) VerifySchemaExamples(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schemaVersion" -------------
+	var schemaVersion externalRef2.SemanticVersion
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaVersion", chi.URLParam(r, "schemaVersion"), &schemaVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaVersion", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifySchemaExamples(w, r, schemaId, schemaVersion)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MigrateSchemaIndexes operation middleware
+func (siw *ServerInterfaceWrapper) MigrateSchemaIndexes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", chi.URLParam(r, "schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MigrateSchemaIndexesParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MigrateSchemaIndexes(w, r, schemaId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-repository/bundles", wrapper.ExportSchemaBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/bundles:import", wrapper.ImportSchemaBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-repository/schemas", wrapper.ListAllSchemaVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/schemas", wrapper.CreateSchemaVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-repository/schemas/{schemaId}/diff", wrapper.DiffSchemaVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-repository/schemas/{schemaId}/slugs", wrapper.GetSchemaSlugs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/schemas/{schemaId}/slugs:rename", wrapper.RenameSchemaSlug)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/schema-repository/schemas/{schemaId}/versions/{schemaVersion}", wrapper.DeleteSchemaVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schema-repository/schemas/{schemaId}/versions/{schemaVersion}", wrapper.GetSchemaVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/schemas/{schemaId}/versions/{schemaVersion}:publish", wrapper.PublishSchemaVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/schemas/{schemaId}/versions/{schemaVersion}:validate", wrapper.ValidateSchemaPayload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/schemas/{schemaId}/versions/{schemaVersion}:verify-examples", wrapper.VerifySchemaExamples)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schema-repository/schemas/{schemaId}:migrate-indexes", wrapper.MigrateSchemaIndexes)
+	})
+
+	return r
+}
+
+type ExportSchemaBundleRequestObject struct {
+	Params ExportSchemaBundleParams
+}
+
+type ExportSchemaBundleResponseObject interface {
+	VisitExportSchemaBundleResponse(w http.ResponseWriter) error
+}
+
+type ExportSchemaBundle200JSONResponse SchemaBundle
+
+func (response ExportSchemaBundle200JSONResponse) VisitExportSchemaBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSchemaBundledefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response ExportSchemaBundledefaultApplicationProblemPlusJSONResponse) VisitExportSchemaBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportSchemaBundleRequestObject struct {
+	Params ImportSchemaBundleParams
+	Body   *ImportSchemaBundleJSONRequestBody
+}
+
+type ImportSchemaBundleResponseObject interface {
+	VisitImportSchemaBundleResponse(w http.ResponseWriter) error
+}
+
+type ImportSchemaBundle200JSONResponse SchemaBundleReport
+
+func (response ImportSchemaBundle200JSONResponse) VisitImportSchemaBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSchemaBundledefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response ImportSchemaBundledefaultApplicationProblemPlusJSONResponse) VisitImportSchemaBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type ListAllSchemaVersionsRequestObject struct {
+	Params ListAllSchemaVersionsParams
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type ListAllSchemaVersionsResponseObject interface {
+	VisitListAllSchemaVersionsResponse(w http.ResponseWriter) error
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type ListAllSchemaVersions200JSONResponse SchemaVersionList
+
+func (response ListAllSchemaVersions200JSONResponse) VisitListAllSchemaVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type ListAllSchemaVersionsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
 }
 
-type RequiredParamError struct {
-	ParamName string
+func (response ListAllSchemaVersionsdefaultApplicationProblemPlusJSONResponse) VisitListAllSchemaVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type CreateSchemaVersionRequestObject struct {
+	Body *CreateSchemaVersionJSONRequestBody
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type CreateSchemaVersionResponseObject interface {
+	VisitCreateSchemaVersionResponse(w http.ResponseWriter) error
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type CreateSchemaVersion201ResponseHeaders struct {
+	Location string
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type CreateSchemaVersion201JSONResponse struct {
+	Body    SchemaVersion
+	Headers CreateSchemaVersion201ResponseHeaders
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+func (response CreateSchemaVersion201JSONResponse) VisitCreateSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type CreateSchemaVersiondefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+func (response CreateSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitCreateSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type DiffSchemaVersionsRequestObject struct {
+	SchemaId externalRef2.UUID `json:"schemaId"`
+	Params   DiffSchemaVersionsParams
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type DiffSchemaVersionsResponseObject interface {
+	VisitDiffSchemaVersionsResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type DiffSchemaVersions200JSONResponse SchemaDiff
+
+func (response DiffSchemaVersions200JSONResponse) VisitDiffSchemaVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type DiffSchemaVersionsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+func (response DiffSchemaVersionsdefaultApplicationProblemPlusJSONResponse) VisitDiffSchemaVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type GetSchemaSlugsRequestObject struct {
+	SchemaId externalRef2.UUID `json:"schemaId"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type GetSchemaSlugsResponseObject interface {
+	VisitGetSchemaSlugsResponse(w http.ResponseWriter) error
+}
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+type GetSchemaSlugs200JSONResponse SchemaSlugs
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/schema-repository/schemas", wrapper.ListAllSchemaVersions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/schema-repository/schemas", wrapper.CreateSchemaVersion)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/schema-repository/schemas/{schemaId}/versions/{schemaVersion}", wrapper.GetSchemaVersion)
-	})
+func (response GetSchemaSlugs200JSONResponse) VisitGetSchemaSlugsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAllSchemaVersionsRequestObject struct {
-	Params ListAllSchemaVersionsParams
+type GetSchemaSlugsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response GetSchemaSlugsdefaultApplicationProblemPlusJSONResponse) VisitGetSchemaSlugsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RenameSchemaSlugRequestObject struct {
+	SchemaId externalRef2.UUID `json:"schemaId"`
+	Body     *RenameSchemaSlugJSONRequestBody
+}
+
+type RenameSchemaSlugResponseObject interface {
+	VisitRenameSchemaSlugResponse(w http.ResponseWriter) error
+}
+
+type RenameSchemaSlug200JSONResponse SchemaSlugs
+
+func (response RenameSchemaSlug200JSONResponse) VisitRenameSchemaSlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameSchemaSlugdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response RenameSchemaSlugdefaultApplicationProblemPlusJSONResponse) VisitRenameSchemaSlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteSchemaVersionRequestObject struct {
+	SchemaId      externalRef2.UUID            `json:"schemaId"`
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+	Params        DeleteSchemaVersionParams
+}
+
+type DeleteSchemaVersionResponseObject interface {
+	VisitDeleteSchemaVersionResponse(w http.ResponseWriter) error
+}
+
+type DeleteSchemaVersion200JSONResponse SchemaVersionDeletion
+
+func (response DeleteSchemaVersion200JSONResponse) VisitDeleteSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSchemaVersion204Response struct {
+}
+
+func (response DeleteSchemaVersion204Response) VisitDeleteSchemaVersionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSchemaVersiondefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response DeleteSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitDeleteSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSchemaVersionRequestObject struct {
+	SchemaId      externalRef2.UUID            `json:"schemaId"`
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+}
+
+type GetSchemaVersionResponseObject interface {
+	VisitGetSchemaVersionResponse(w http.ResponseWriter) error
+}
+
+type GetSchemaVersion200JSONResponse SchemaVersion
+
+func (response GetSchemaVersion200JSONResponse) VisitGetSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaVersiondefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response GetSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitGetSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PublishSchemaVersionRequestObject struct {
+	SchemaId      externalRef2.UUID            `json:"schemaId"`
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+}
+
+type PublishSchemaVersionResponseObject interface {
+	VisitPublishSchemaVersionResponse(w http.ResponseWriter) error
+}
+
+type PublishSchemaVersion200JSONResponse SchemaVersion
+
+func (response PublishSchemaVersion200JSONResponse) VisitPublishSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishSchemaVersiondefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response PublishSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitPublishSchemaVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ValidateSchemaPayloadRequestObject struct {
+	SchemaId      externalRef2.UUID            `json:"schemaId"`
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+	Body          *ValidateSchemaPayloadJSONRequestBody
 }
 
-type ListAllSchemaVersionsResponseObject interface {
-	VisitListAllSchemaVersionsResponse(w http.ResponseWriter) error
+type ValidateSchemaPayloadResponseObject interface {
+	VisitValidateSchemaPayloadResponse(w http.ResponseWriter) error
 }
 
-type ListAllSchemaVersions200JSONResponse SchemaVersionList
+type ValidateSchemaPayload200JSONResponse SchemaPayloadValidationResult
 
-func (response ListAllSchemaVersions200JSONResponse) VisitListAllSchemaVersionsResponse(w http.ResponseWriter) error {
+func (response ValidateSchemaPayload200JSONResponse) VisitValidateSchemaPayloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAllSchemaVersionsdefaultApplicationProblemPlusJSONResponse struct {
+type ValidateSchemaPayloaddefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response ListAllSchemaVersionsdefaultApplicationProblemPlusJSONResponse) VisitListAllSchemaVersionsResponse(w http.ResponseWriter) error {
+func (response ValidateSchemaPayloaddefaultApplicationProblemPlusJSONResponse) VisitValidateSchemaPayloadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateSchemaVersionRequestObject struct {
-	Body *CreateSchemaVersionJSONRequestBody
-}
-
-type CreateSchemaVersionResponseObject interface {
-	VisitCreateSchemaVersionResponse(w http.ResponseWriter) error
+type VerifySchemaExamplesRequestObject struct {
+	SchemaId      externalRef2.UUID            `json:"schemaId"`
+	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
 }
 
-type CreateSchemaVersion201ResponseHeaders struct {
-	Location string
+type VerifySchemaExamplesResponseObject interface {
+	VisitVerifySchemaExamplesResponse(w http.ResponseWriter) error
 }
 
-type CreateSchemaVersion201JSONResponse struct {
-	Body    SchemaVersion
-	Headers CreateSchemaVersion201ResponseHeaders
-}
+type VerifySchemaExamples200JSONResponse SchemaExamplesVerificationResult
 
-func (response CreateSchemaVersion201JSONResponse) VisitCreateSchemaVersionResponse(w http.ResponseWriter) error {
+func (response VerifySchemaExamples200JSONResponse) VisitVerifySchemaExamplesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateSchemaVersiondefaultApplicationProblemPlusJSONResponse struct {
+type VerifySchemaExamplesdefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response CreateSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitCreateSchemaVersionResponse(w http.ResponseWriter) error {
+func (response VerifySchemaExamplesdefaultApplicationProblemPlusJSONResponse) VisitVerifySchemaExamplesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetSchemaVersionRequestObject struct {
-	SchemaId      externalRef2.UUID            `json:"schemaId"`
-	SchemaVersion externalRef2.SemanticVersion `json:"schemaVersion"`
+type MigrateSchemaIndexesRequestObject struct {
+	SchemaId externalRef2.UUID `json:"schemaId"`
+	Params   MigrateSchemaIndexesParams
 }
 
-type GetSchemaVersionResponseObject interface {
-	VisitGetSchemaVersionResponse(w http.ResponseWriter) error
+type MigrateSchemaIndexesResponseObject interface {
+	VisitMigrateSchemaIndexesResponse(w http.ResponseWriter) error
 }
 
-type GetSchemaVersion200JSONResponse SchemaVersion
+type MigrateSchemaIndexes200JSONResponse EntityIndexMigrationReport
 
-func (response GetSchemaVersion200JSONResponse) VisitGetSchemaVersionResponse(w http.ResponseWriter) error {
+func (response MigrateSchemaIndexes200JSONResponse) VisitMigrateSchemaIndexesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSchemaVersiondefaultApplicationProblemPlusJSONResponse struct {
+type MigrateSchemaIndexesdefaultApplicationProblemPlusJSONResponse struct {
 	Body       externalRef3.ProblemDetails
 	StatusCode int
 }
 
-func (response GetSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitGetSchemaVersionResponse(w http.ResponseWriter) error {
+func (response MigrateSchemaIndexesdefaultApplicationProblemPlusJSONResponse) VisitMigrateSchemaIndexesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(response.StatusCode)
 
@@ -449,15 +1633,45 @@ func (response GetSchemaVersiondefaultApplicationProblemPlusJSONResponse) VisitG
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Export schema bundle
+	// (GET /schema-repository/bundles)
+	ExportSchemaBundle(ctx context.Context, request ExportSchemaBundleRequestObject) (ExportSchemaBundleResponseObject, error)
+	// Import schema bundle
+	// (POST /schema-repository/bundles:import)
+	ImportSchemaBundle(ctx context.Context, request ImportSchemaBundleRequestObject) (ImportSchemaBundleResponseObject, error)
 	// List schema versions
 	// (GET /schema-repository/schemas)
 	ListAllSchemaVersions(ctx context.Context, request ListAllSchemaVersionsRequestObject) (ListAllSchemaVersionsResponseObject, error)
 	// Create schema version
 	// (POST /schema-repository/schemas)
 	CreateSchemaVersion(ctx context.Context, request CreateSchemaVersionRequestObject) (CreateSchemaVersionResponseObject, error)
+	// Diff schema versions
+	// (GET /schema-repository/schemas/{schemaId}/diff)
+	DiffSchemaVersions(ctx context.Context, request DiffSchemaVersionsRequestObject) (DiffSchemaVersionsResponseObject, error)
+	// Get schema slugs
+	// (GET /schema-repository/schemas/{schemaId}/slugs)
+	GetSchemaSlugs(ctx context.Context, request GetSchemaSlugsRequestObject) (GetSchemaSlugsResponseObject, error)
+	// Rename schema slug
+	// (POST /schema-repository/schemas/{schemaId}/slugs:rename)
+	RenameSchemaSlug(ctx context.Context, request RenameSchemaSlugRequestObject) (RenameSchemaSlugResponseObject, error)
+	// Soft delete schema version
+	// (DELETE /schema-repository/schemas/{schemaId}/versions/{schemaVersion})
+	DeleteSchemaVersion(ctx context.Context, request DeleteSchemaVersionRequestObject) (DeleteSchemaVersionResponseObject, error)
 	// Get schema version
 	// (GET /schema-repository/schemas/{schemaId}/versions/{schemaVersion})
 	GetSchemaVersion(ctx context.Context, request GetSchemaVersionRequestObject) (GetSchemaVersionResponseObject, error)
+	// Publish draft schema version
+	// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:publish)
+	PublishSchemaVersion(ctx context.Context, request PublishSchemaVersionRequestObject) (PublishSchemaVersionResponseObject, error)
+	// Validate payload against schema version
+	// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:validate)
+	ValidateSchemaPayload(ctx context.Context, request ValidateSchemaPayloadRequestObject) (ValidateSchemaPayloadResponseObject, error)
+	// Verify stored examples
+	// (POST /schema-repository/schemas/{schemaId}/versions/{schemaVersion}:verify-examples)
+	VerifySchemaExamples(ctx context.Context, request VerifySchemaExamplesRequestObject) (VerifySchemaExamplesResponseObject, error)
+	// Migrate entity table indexes
+	// (POST /schema-repository/schemas/{schemaId}:migrate-indexes)
+	MigrateSchemaIndexes(ctx context.Context, request MigrateSchemaIndexesRequestObject) (MigrateSchemaIndexesResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -489,6 +1703,65 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// ExportSchemaBundle operation middleware
+func (sh *strictHandler) ExportSchemaBundle(w http.ResponseWriter, r *http.Request, params ExportSchemaBundleParams) {
+	var request ExportSchemaBundleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportSchemaBundle(ctx, request.(ExportSchemaBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportSchemaBundle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportSchemaBundleResponseObject); ok {
+		if err := validResponse.VisitExportSchemaBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportSchemaBundle operation middleware
+func (sh *strictHandler) ImportSchemaBundle(w http.ResponseWriter, r *http.Request, params ImportSchemaBundleParams) {
+	var request ImportSchemaBundleRequestObject
+
+	request.Params = params
+
+	var body ImportSchemaBundleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportSchemaBundle(ctx, request.(ImportSchemaBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportSchemaBundle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportSchemaBundleResponseObject); ok {
+		if err := validResponse.VisitImportSchemaBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListAllSchemaVersions operation middleware
 func (sh *strictHandler) ListAllSchemaVersions(w http.ResponseWriter, r *http.Request, params ListAllSchemaVersionsParams) {
 	var request ListAllSchemaVersionsRequestObject
@@ -546,6 +1819,120 @@ func (sh *strictHandler) CreateSchemaVersion(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// DiffSchemaVersions operation middleware
+func (sh *strictHandler) DiffSchemaVersions(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, params DiffSchemaVersionsParams) {
+	var request DiffSchemaVersionsRequestObject
+
+	request.SchemaId = schemaId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffSchemaVersions(ctx, request.(DiffSchemaVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffSchemaVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffSchemaVersionsResponseObject); ok {
+		if err := validResponse.VisitDiffSchemaVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSchemaSlugs operation middleware
+func (sh *strictHandler) GetSchemaSlugs(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID) {
+	var request GetSchemaSlugsRequestObject
+
+	request.SchemaId = schemaId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSchemaSlugs(ctx, request.(GetSchemaSlugsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSchemaSlugs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSchemaSlugsResponseObject); ok {
+		if err := validResponse.VisitGetSchemaSlugsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RenameSchemaSlug operation middleware
+func (sh *strictHandler) RenameSchemaSlug(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID) {
+	var request RenameSchemaSlugRequestObject
+
+	request.SchemaId = schemaId
+
+	var body RenameSchemaSlugJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RenameSchemaSlug(ctx, request.(RenameSchemaSlugRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenameSchemaSlug")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RenameSchemaSlugResponseObject); ok {
+		if err := validResponse.VisitRenameSchemaSlugResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSchemaVersion operation middleware
+func (sh *strictHandler) DeleteSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion, params DeleteSchemaVersionParams) {
+	var request DeleteSchemaVersionRequestObject
+
+	request.SchemaId = schemaId
+	request.SchemaVersion = schemaVersion
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSchemaVersion(ctx, request.(DeleteSchemaVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSchemaVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSchemaVersionResponseObject); ok {
+		if err := validResponse.VisitDeleteSchemaVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetSchemaVersion operation middleware
 func (sh *strictHandler) GetSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
 	var request GetSchemaVersionRequestObject
@@ -573,36 +1960,211 @@ func (sh *strictHandler) GetSchemaVersion(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// PublishSchemaVersion operation middleware
+func (sh *strictHandler) PublishSchemaVersion(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
+	var request PublishSchemaVersionRequestObject
+
+	request.SchemaId = schemaId
+	request.SchemaVersion = schemaVersion
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PublishSchemaVersion(ctx, request.(PublishSchemaVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PublishSchemaVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PublishSchemaVersionResponseObject); ok {
+		if err := validResponse.VisitPublishSchemaVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ValidateSchemaPayload operation middleware
+func (sh *strictHandler) ValidateSchemaPayload(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
+	var request ValidateSchemaPayloadRequestObject
+
+	request.SchemaId = schemaId
+	request.SchemaVersion = schemaVersion
+
+	var body ValidateSchemaPayloadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateSchemaPayload(ctx, request.(ValidateSchemaPayloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateSchemaPayload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ValidateSchemaPayloadResponseObject); ok {
+		if err := validResponse.VisitValidateSchemaPayloadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifySchemaExamples operation middleware
+func (sh *strictHandler) VerifySchemaExamples(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, schemaVersion externalRef2.SemanticVersion) {
+	var request VerifySchemaExamplesRequestObject
+
+	request.SchemaId = schemaId
+	request.SchemaVersion = schemaVersion
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifySchemaExamples(ctx, request.(VerifySchemaExamplesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifySchemaExamples")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifySchemaExamplesResponseObject); ok {
+		if err := validResponse.VisitVerifySchemaExamplesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MigrateSchemaIndexes operation middleware
+func (sh *strictHandler) MigrateSchemaIndexes(w http.ResponseWriter, r *http.Request, schemaId externalRef2.UUID, params MigrateSchemaIndexesParams) {
+	var request MigrateSchemaIndexesRequestObject
+
+	request.SchemaId = schemaId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MigrateSchemaIndexes(ctx, request.(MigrateSchemaIndexesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MigrateSchemaIndexes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MigrateSchemaIndexesResponseObject); ok {
+		if err := validResponse.VisitMigrateSchemaIndexesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xYW3PbuhH+Kxj0PJzTUDc7aVL1oePaberWTVzZ7kNt1bMiliISEGAAUIma4X/vACAp",
-	"XuSL0vT6JJICF9/ufvvtgl9prLJcSZTW0PlXauIUM/CXpxrB4pV/8BfUhiu5wE8FGuv+zbXKUVuOfm0M",
-	"FtdKb8+Zu/tBY0Ln9GeTne1JZdg9ypS8zzXPuOUbNPc3N+dntIyqrc8w4ZJbrqSzBIz5axCXrf2sLjCi",
-	"DE2seR6W0j9cvX9HAljCVFxkKC0JS1ZcrolNkaC03G7HNKJ2myOdU7X6gLH1m4tifTj0K/dWGVELK4Hv",
-	"IMPDTVw3r5ZlRDV+KrhGRue3w3i096kQR+3IL/f41UmfQ9eNWh2wZhOSoQUGFoixSiMjXPrQacyV4VZp",
-	"H77vn/zYk42d2G+IIM/QWMhyZ4ebk9g9Hrp6Lhl3SA35nKJNUXu/glmyCQEi3PincaE1Siu2BLy1VoBa",
-	"7FkpJRBk2PYMBVpkw30v1JrHIAjzC0giYP0r4hjscMgHQKScMZQk0Spze0MhLImVNEWG2uyH8J8tH//m",
-	"P1/9LaIeWImYgbQ8rg38N5f0OaN9f6PDq71dMy3et7n4pCBc8CDmXS6cKiEw9mqgkh45zbD8ucWse/FY",
-	"uLqCVDYIQWvYDgIWTO7z42kKDMWuWtAUmrHacZtLksEHpccZl0qPc7BxShKlM3CRxS+Q5cK5ektn4+l4",
-	"SiN6ND4ev3KwcrAWtTP+t7s79uLubtz6+WFXKWGnB3BXPO2C/SOuYDWKwSBxuSeFCWJ8s7gwPVQrAfHH",
-	"kVC2MCMQeQo9ZLcw+vt09Mvlix9/PR81Nz/9/Jn4rtuV0Ne2z6gDRgkf8d5fXipj1xqv/nxBPIMJZ045",
-	"Eo66BzwGzcy9lxXHpYgWBvV9rlXC3YqhF8sK/f3y2eCb5jBsCFfvyZtfTGfE1mt8fK9PeyiPpkevRrPp",
-	"aHZ8PXs5P57Op9O/OmwVQ+aUgcWRM/I8SF7xBmgWvzslL2dHR8T9XTGTtjYpCs4eta9WAjOGFrgw95fh",
-	"9izc7t/t9Zvpa1ItJPXKfnEHg0MDJyQtMpAjjcB8kvFLLkCCVw2TY8wTHhOriE25ISoOHTVGpyiul1R4",
-	"93mEWittHm5fLaEZvNsVk36Te58HaySD3AFJOAo2ErhBQTYgOAvwKwB7RIdLY0HGuC8eN4tzojHB4KZN",
-	"we6IH6aKJiwHhcNYsMWeFF6nSH5/fX1JwgISK9YiIJcW16h9TLgVexGbVGkb9RNpiiwDve0hI95u9FDE",
-	"vyUcPcs7pms+3KjXF4JPTXCGDaL02UrUENqfQMIa6x6AjLRGH9Obeqve1x1+q3jWs/Oi+ZOcXJ7TiG7q",
-	"/kM3MxchlaOEnNM5PR5Pxy+pV7XUZ7TqiqPdBpPWAWyNe5rzAm2hpSG4Qb3tT44PzewRkfgZjSUJ18Y6",
-	"J1w5eba7mY26OeBEiE5v9loAGjK06MrxdjhQx6JgSLishuTepLCDYQph/eDA3XufCtRbGlHpWwrlwcx5",
-	"ZaUZhYLrfvSl8wSEweHUWy4dLUyupAnKcDSd+hOJkhaljx7kuXBzP1dy8sGEsWC3wbMnFT8peVbtPUA1",
-	"Pido4xQZMUUcozFJIUQlRJUnD4KryuHFYSCfJf97cP/WaRz5se4DP/kKq0q/IkQ/n34iXfuWGLzeMZ8u",
-	"y4jmyuzl65obxyACjoR9xoJkRFWyLLYkA/3REG4JBI3Ye/zqcnfPZwoaxAKN/Y1i2+9GiEc+iJRdgXIn",
-	"rHJAzdm/hppP05JUJ4UuKyOaIjAMrfZCBSDDBN4sLupe0JjpWtdoVKHjbun29bv83yuCkO+et49XQRk9",
-	"oumTr/X5r5zURVU/q3JZPqb7muMGXR01g0SdgSo/wdSwRN6iHdbHv0M1n0HN/xPBfIv2IKI81VubI1M3",
-	"twTWa41rsFh3UzdL7Jpp6wNDV42iQ8PT+0BTRk+eprtA649Ij+HsyvX3ADv4DFT6mjQYF5rbrY/0CkGj",
-	"PilsSue3SzdCGNSbOg+FFnROJ5DziRvflk0WB19JFjdnpKkzQxKlG9+bdmV2Lg9IENEvo9rvkVbVaRNY",
-	"xiVdlsvyHwEAAP//QdHgMZsXAAA=",
+	"H4sIAAAAAAAC/+x9+3MbN5L/v4Ka71at/d0hTSneR6i6uvPaya7unMQn2dmqM3UmONMkEc0AswBGMs+l",
+	"//2q0cC8SZGWlFi5/GRxHkCj0c9PN8afokTlhZIgrYmmnyKTrCHn7s+XGriFc3fhR9BGKHkG/yzBWLxb",
+	"aFWAtgLcswm3sFJ6c5rir99pWEbT6P89q8d+5gfGS7mSHwotcmHFFZgP796dvopuYkcIt2IhMmE336kU",
+	"cKgUlrzMbDSNuJTKcgtRHKVgEi0KK5SMptHf1TWzilkkly008EshVyxZc7kCw/iKC2kss2tgPMEZ2RUt",
+	"ZhzFEcgyj6bvI6kkDtyYA+RS6QSiiziymwKiaWSsFnKFlNJaXsFSSEFEfIp4mrq/efamwRmrS+jS++/n",
+	"P3zPiK0sVUmZg7SMHlkg5UgpSCvsZszOeV5kwAq+yRRPDcv5hi2AGas0pKyUKWjGmVbKsvnHEXx0j5s5",
+	"41rzzclMAk/WLC+NZVc8Eym30GJIWi2BKe2uaNphtuQiM+xa2DV7PpmMZzKq+KAWP0FiHR+ycnX4fp/j",
+	"W/i25bY0t71PjDqnZ2/iyPJFBt/zHA6f+G316s1NHOFKhYYUt7+3oc15/DrjppBfDHDjG7dnpzKFj9+J",
+	"lebWKUyh9IC+pHpzVjq58cMslMqASxxH4AiQfisgS+nhlvi8UtZCGkSCFdyumVoyuAK9QSHwr8/ZEgfA",
+	"W33RjxmMV2M252mqwZhxIuxmjvogLOSmQVYt8/6CE6xaB+6i76ZpWT5DjCDn0ookDIA0guTejlUL2TXo",
+	"0I69dWP0VzwoMadp1F1Hd//isNc1efsKjyelb2ydYU7dwzAgIf4G88/FpNvcsmtVZinaD3+HKck4S/WG",
+	"6VIetv2pVkWxBw3+uQEa/J070ABaK92f+hwsu16DdHJPPGeJm1UqizPnjsGQjqMB2+70/puPwlgzrJ40",
+	"4l0En0Y4v4Px7AhjRVJr7PZa4q7Y9PZwSCzPQPLcxwA46NYAwNzfatxQQ8QQGX8tZZrB1hDE/9pL/5sD",
+	"vvTGfVDQPqIVh/SF/QynI3IwlucFDrRUOue2YfJ8/HFUL1dICyvQtYH8vOU0reIuO9amKG5ysSbgts2o",
+	"ePcgcWFLuwfMgvTBQO9GwTVI+/IeSLhH6W5wxJMe7yfxpxbyPoN5EvgSQllS8yiOyiL1f0gKhtPBYPYS",
+	"NgPc61CND8Vhstso3Rb08KLIBKR9i/0tzwywpdLBD5iGaW6Y3juquOPg1lDmPsfscC8s/DPVq2Ev+pt/",
+	"BX1+vsDr3AKza2FCzIeOVuS4MzuZu7l7SLd/YtRb9pcYVf7KspzBmHV3thMPJUhe+LYL78tmPt8X0tZt",
+	"TFN4Jaku7cToLVygyJGbkMa48E7QJQ0rYSxoiufaCrLgBu5TFIQx5cHGp7XQUxxhyAhlcAVZn0vzBU8u",
+	"r7lO57ToABgYSug9BIC8wrVWDNOQcyHpmSbOEUaL4iggJQNOoSM3RFrc4mbFiz33n9bds2CXQqZN5xWm",
+	"HWnI1ZUzmdUlnqbuAk43Ch6NljaSXGt1vcXD5WAMX22LEex6e45NtG4oyX4y/93cOSnktlbKxmz+/oIu",
+	"uW1kTiiejqPbGOomjWnxNXnbOflKLJcD1h/58aZ1qb2KN9yuDWpW/R5TMtuwQoMBaZmQbG6V39ID83+/",
+	"Ae35D9AK/+LmpRtnaIalVvl9Kq8XqTtzrEHXgTwLMvDCSfKW2Z2tW0DCc2Dhhc+b5szr0K6JuAYmFcuU",
+	"XIH+zPnu7rQrIbyHjd7u9JoC1Zwz7qnSkLAMiXx3S/u8367U33iw9kfQYikSDxcaB3Z3Vd0hHWZ7NPXp",
+	"EOSmJQo/EiyMPsPbIcMuYQMpW2yYx5Od+QuQYY0yvz+6GGMCMx8PYcP+qZeqJAirPe33Zb4A7XBLP1ob",
+	"026C2Th8LqTI0UVMhhJl5+aG0JqOLNBzHdriwN3tW/WGsNaaV1thEI/KHhj39twDDXIQQV+U4DTB6SA4",
+	"/toOkTl4G2/dt7aP6XvQpQV9WPHmR56VYAKq7i0CLvxa6dR0POoJ4wvnNqr7aG5VLmwbfazpXsBSabh3",
+	"kpou63CiwoN9NW6WsqrhrtcKI1GkiqViuQRtggzg0HOmNJtj1Hag97xLqHZAUBYWW21G7OVku5xhrvci",
+	"EwQhDGL1d0YO7xtfjRuU7V7YwKI4rnUwftJwJVRpGM7hIwxjRZYxDUZlV+AKtWtgRHLMJFy7aqPQxrak",
+	"YY9kuWL6w1Sn7pHhjbybeB8YuIPzFWxQV8CLcpEJs3ZxRkcJNF9aUmSJhUDKk12Jh8uUJVxi3UPDyJQL",
+	"UvRG4mh4XieOpbQiw8sbN1o1JVWAQ5qW4nxR3KBoKO8670IxnTqNL4HXNegcLE+55SEWENLXpAtlhFV6",
+	"08/w773x4DNy+uimqU1303NhXmwB9U5lKnC1aGDBrsPmERPD9gnjrial1iBttgmASc3kYfBPmFeQgR1K",
+	"Fl6rlUh4xlL3AFtmfHXC0A/VRbY+EWuRpiAZ+h3mBZglSpoyB70F3P3Zmiqi33DHLwZ3PLjxoqlpDW1p",
+	"SnC19u3W1RPg3hhE1nf1Z5AYnanrAQdIZXym1bWJGU+0MsZ3ZlBhNmaFkBLS4AdDKxJ7ixb3EqDAy0Iz",
+	"NIMoqVSfo3TnT8+joeyH6HHsH6CImgi87DPHXMPWKktJK5QBR+042l6B/LLU4n5ENI6u+VZT+4+Gga0w",
+	"aW6ajTRKwgmZROSjsCwDfgWmaQ4RxValZVwONJ7dkuLsCdXXa2iJZUcmqhaUWxXitTB2CKnPMnClP0wy",
+	"2sbe9F1yFcMdEMztW66mIYfWcbvsDDSK0APVHlPogmFHzn9SepwLqfS44DZZM6+IFXhgkJyj8WQ8ieLo",
+	"ePzV+I9IVsGtBY2D//dslv5hNhs3/vndUL/JFgveI/Y/YMEXo4QbcME1Kw0FSO/OXpsOVYuMJ5ejTNnS",
+	"jHhWrHmHsvd89D+T0dcXf3jyr9NR9ePp/9+TvrdNBezGCtegiUbJL+GD+/ONMnal4fw/X5P5YSJF8VwK",
+	"0B3CE65T8wFvenStNKA/FFotRQZmYBUXnvoPF3sTXwVb/QDr/Af2lz9NjpgNzzj+vn3ZofJ4cvzH0dFk",
+	"dPTV26Pn068m08nkv5C2ylSn3MIIB9mPJGcqe9ScffuSPT86PmZ420tm0x+UpUh3jq8WGeQpWC4y8+EN",
+	"/XxFP4dn+/NfJn9m/kEWnuwqNw04UHJm6zLncqSBp26T4WORcUkAkSkgQYyTvJ4wTCUUoSYQYAtP79CK",
+	"Hg7D+qGg0VjOCyTEdUyOXLkr9Msi+Z6AAaMjpLFcJkMlePbu7JRpWAIt06XCleB7PxHYchA7TCMzbDn6",
+	"NbC/v337htEDLFEpDDp1K2w2SLFZK23j7kaaMs+53nQoY27ceBvHP4cdnZFrSdfiVvCG1rQj8rtxu7VU",
+	"fdK+45KvKt8MKWukEqaTiXrf105IPT9DPntW3WQv3pxGcXQV/E90dYQcUgVIXohoGn01nowxpkPoye2o",
+	"94qjeoJnC9cC4u6uYMA5f+N604iDWSPIqABBA+i8IfXEG/aEAtIQpWASNw/xxhyTN48FPmVWrSgQCgV5",
+	"oWeybmHx2IL7uWGoB8gtE1O1TF85X1rZesMMxby0ogBKUEsKsZhL5aYDeSW0kpjDEe6Ayu508TStltzq",
+	"BkQeap6DBY02ehhqMGh/qJfvBPcQOHXBV6/ifWIXM8gkno2d7S8ydxqB8k6BA/6zBL0JDVzTfri2d/iz",
+	"oybWhbTsxokZ6kV0c4HybwolPQ53PJngP4mSFqjg4pqOqKz07CdD8U9N3L79TaQ5bW7SHRa6IpkpkwSM",
+	"WZZZ5o2sR6y20uNV/Q+H0bWXaxug9xutlWZPgo976qyHN2uVOAV9WASBsnzl3D1xo9bq6AJf366pU5Jo",
+	"l1OqoXD6XWEgaGxHmTqxNXXFeH1B/WBGyFUGzGouDXXjjdmbAMLV73ENMynyvHQBV4yax9vNNOC6gkmx",
+	"uYfpCTKp0DhhGEfQZpmJxJ54TUYkyAk2zuEMoRMBbmZyXvUZzsfsH2hWuKSeiGoUw1DDnV0w7FoLa91T",
+	"KePs+eTrygNkwgT+VC9iGYMc8TymQtNMLjZsHuzPdFZOJl8lGBu7v3ydwV8lvtY3/42ue5b4F4ZszWl+",
+	"qK15uYbksmnocH3EKHcVwyJ0M7R9pkoTkR1CrhwKPmBiqlMEta5UerbkmYGBnPKCnCQY+1eVbh7QPtSu",
+	"GG3kzc9km3yf6XYLVfmWJ0qzBPcFTyI0+0yfPkJ7RSJ5d3vVaHsdjCzOwJZaBvyqg/FuQ+j7JZ22PiHC",
+	"8CLLWlm/uU2lTmWSlSmaQA+kdO1kRQZWwc02FRI0zKkf5TN06UGFuonBDEjFeWfNS7DJ+vF7X1xudz93",
+	"S3O8xa+e+UZQdFsSrrsSi2ZY+YQv27Cc60vDhGVtXK9RKGHvZAbGsHnvYCpGyTM5l0rCPO4epBSYeuUF",
+	"R/WoGll7BZlA1RPfaFS1XjVaz5wuZZuZHL7nWx5dvkThA5bnzFP3J06qSpuoHJAir668uxr01Ejj3B93",
+	"nccz2T9C6zz9T5RF+COhX1ezUB+oewj9NlIJulOPbzjv8UzOZFD86iCaG3VO2duUufLi3A1Z2RnPt1Y5",
+	"s8h4Qg0nbpxQ52kWNGfSnXc9CZVMfCD30039NaLpbXsb3aHZBTBOSABrFZiQPnY8OZ6Mjo6rctNJ8/Rl",
+	"jD9AJnpT4Epkir8LIeZh4Jn0tsWEuxqWc8Y9RoYma8xqmMPJK6StUZWuBkVW+eu4zRZNoQ3MomNvM+lp",
+	"w/eqTDx1pQc6QYyHiw1YP+go5+Zyzp7MZZlluB6VC4v/FlxbwTOa3+kAZoosWStlgK3VdaBqJmt5dRTi",
+	"iJA6B5zwLENVDdHPvBBiqoFj7HhOMGwxtHhTuoR+JNK5q2KQCIZjrjNJ5WN6iDVKgcIwvlCljdn1WiRr",
+	"VmhxxZMNA81NqatzzzgVor1KnlARvGJfzd4x+45nmIVhCFpLzLCeTEgxnez7/ihUwm7da0zxKCrLjmB0",
+	"4ER+9DAh3o6z/3sFfEcP4xtv94uVRWm5xThaA0+BUMTXiggZyMzOXldtVGGY9ugajCp10o4dutDUzePz",
+	"wrTfndXeIah89ilAIjfPUt+9PhhkviRXaTpelNCra9VHs9C209gt80jmhSKixcY3HmpISm3ILWhVrtYz",
+	"Oa+tCpll6tyfu5wWrR129PtwEgd5ehIa2yp36A4sh7kqP9dKh11zzUzOex3Dg2qN7f2HBcQ/hm4dQ/ak",
+	"Ov8RpNdxVRglt8XC2JkRdfU4PlQId3Vhb6O5Co7Chyhcd+J8G6FWPSiZDx/W4+7usFuoHY4n5a8ASsO1",
+	"HhzM78z8Kvi4MgA0Ol+tNKzofKsTG9++2Qdk70t2CJe9udjb7pnQPLkzuyYUUCrpoDV8h1C/sE4fZRf7",
+	"dFaKgYT7b2CbvZwPLu40zXZ5J/p/Jcnr36DKXY1n7/9hWZ9qCGfxH9tCt2EK52ADnNBVUumBsep4dXMp",
+	"lFW1lBZTkEsoHODAJXMtwA6Zd6i0zJS6LAtDPWCk0y5wUa1R3fc48Doe5MSbSkJ75t8b5ruLMfzJuMgN",
+	"E/aE8bptxAHnodZWlTTJHD2ffD0UonS/A/JAace2z438IiDzPpaMkcinj9B2Ea+b5uue4v3g+MM1H2zd",
+	"kHZlYAf6A87V0voOY1M7v2Z3ZBc4Q8cYZqo8YQA13OG1uhXTB+dLEv6AYJ20ug6o5nQ90PknqmPnOCfi",
+	"GzmX7fGrmdEFszfcIDzoALV/QVmdo6bS6rAmds03AXajqk54aA3hKaxNuOmEYRrb71Kn8bHXU7QA166F",
+	"wn9QaeZLbydU5jDu+20+M9jQI5S3aOAZjj6Yh7i5u/DCTlNOr6AllHU7eJ9xSg9tDvV4DWYnqptj34rP",
+	"x/0Ay5VJHJsanCVeELfqFs2iyDbUyHnv9befo2ZQNTIPmIFXVOryySkaquPJ862nMcLemVohH6Nxa9iT",
+	"g0CNeGuwrgVckW0KXVvDjn97AN5E7H4OodgDLPv1heF77vIji0/jW1uX24QG2HsXnW0A+YHQlTsHDaFG",
+	"8wiTike7aduSodBoZFgogTWDsZxfgiuoDlZTb21fQu/L66odNYi6fqFqtltyFD/QF2tsq8OSjl3VCc1H",
+	"aG89p/22PEjRYKs9CN8x/s0g/PIGwX8DgsIi+lSIz4rCByACxt8Pm2JG7Tio906OTFyF48YqVwHmcuO6",
+	"Bccz2fjcBKY2pYZOB6Kv2RxPJixoPNPcJ3NcOtQlJHtD1iOspfWBjeghG+i2flXkF0E7tn1SZMA6NPYC",
+	"h3usOULY8Z6w/swGDbRYbkb1CaPf7NqXY9cI4q2/g0SC0vpUUbtw/XvT/hB/KB4Pf3V/TG1HrqjtgBsC",
+	"fhyS0jpPX33Kuwob3Be1q5AidjEFfbSfDvVW1q37Ff+O2XPS1/4i1cMHTTu+fTVkbxpPPW6L45gdxAdq",
+	"dt+DgZn6r5r7DjTza6rCvMjEypdKgwr6VfZqH81z7hgTkAL7dMR/DL7qyuz9bwk43kw2EhivglOWC2Po",
+	"bDDNy3XdI+Rw2poeP9LA5/X8ZO6q//g6WgBHFQ1ZaJWAMe6z+IBAqTtnzp1m4/R+BcK0Ah9h3cJxoTJl",
+	"qQLKm4xV7nMCzFV6TGMqR9uaX4F7rj6IUaHabYnYgG0HW9Q/2fi8/JQ5DHSwvYX+PwMfWNVfn98pnGf9",
+	"8xKOeVUTTg+5tWvIHwt2u+N/ChkwKu45/58WPHYL6GWhq6ZBJnbZQRwHklK7b/S8/xQtgGvQL0q7jqbv",
+	"L3C/DOirIE6lzqJp9IwX4hmeuryoxu41oJ29e9V0lticarpfJjK1LPVIi6OPo2AIR1r5Q+I8zYWMLm4u",
+	"bv53ALiXRUFiaQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,1725 @@
+// Package tenants provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package tenants
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// TenantsList request
+	TenantsList(ctx context.Context, params *TenantsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsCreateWithBody request with any body
+	TenantsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantsCreate(ctx context.Context, body TenantsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsGet request
+	TenantsGet(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsUpdateWithBody request with any body
+	TenantsUpdateWithBody(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantsUpdate(ctx context.Context, tenantId externalRef1.UUID, body TenantsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsGetJob request
+	TenantsGetJob(ctx context.Context, tenantId externalRef1.UUID, jobId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsGetUsage request
+	TenantsGetUsage(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsCloneWithBody request with any body
+	TenantsCloneWithBody(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantsClone(ctx context.Context, tenantId externalRef1.UUID, body TenantsCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsDeprovisionWithBody request with any body
+	TenantsDeprovisionWithBody(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantsDeprovision(ctx context.Context, tenantId externalRef1.UUID, body TenantsDeprovisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsProvision request
+	TenantsProvision(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsProvisionStatus request
+	TenantsProvisionStatus(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantsMigrateWithBody request with any body
+	TenantsMigrateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TenantsMigrate(ctx context.Context, body TenantsMigrateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) TenantsList(ctx context.Context, params *TenantsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsCreateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsCreate(ctx context.Context, body TenantsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsCreateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsGet(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsGetRequest(c.Server, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsUpdateWithBody(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsUpdateRequestWithBody(c.Server, tenantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsUpdate(ctx context.Context, tenantId externalRef1.UUID, body TenantsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsUpdateRequest(c.Server, tenantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsGetJob(ctx context.Context, tenantId externalRef1.UUID, jobId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsGetJobRequest(c.Server, tenantId, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsGetUsage(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsGetUsageRequest(c.Server, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsCloneWithBody(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsCloneRequestWithBody(c.Server, tenantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsClone(ctx context.Context, tenantId externalRef1.UUID, body TenantsCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsCloneRequest(c.Server, tenantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsDeprovisionWithBody(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsDeprovisionRequestWithBody(c.Server, tenantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsDeprovision(ctx context.Context, tenantId externalRef1.UUID, body TenantsDeprovisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsDeprovisionRequest(c.Server, tenantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsProvision(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsProvisionRequest(c.Server, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsProvisionStatus(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsProvisionStatusRequest(c.Server, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsMigrateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsMigrateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TenantsMigrate(ctx context.Context, body TenantsMigrateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantsMigrateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewTenantsListRequest generates requests for TenantsList
+func NewTenantsListRequest(server string, params *TenantsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantsCreateRequest calls the generic TenantsCreate builder with application/json body
+func NewTenantsCreateRequest(server string, body TenantsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantsCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTenantsCreateRequestWithBody generates requests for TenantsCreate with any type of body
+func NewTenantsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantsGetRequest generates requests for TenantsGet
+func NewTenantsGetRequest(server string, tenantId externalRef1.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantsUpdateRequest calls the generic TenantsUpdate builder with application/json body
+func NewTenantsUpdateRequest(server string, tenantId externalRef1.UUID, body TenantsUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantsUpdateRequestWithBody(server, tenantId, "application/json", bodyReader)
+}
+
+// NewTenantsUpdateRequestWithBody generates requests for TenantsUpdate with any type of body
+func NewTenantsUpdateRequestWithBody(server string, tenantId externalRef1.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantsGetJobRequest generates requests for TenantsGetJob
+func NewTenantsGetJobRequest(server string, tenantId externalRef1.UUID, jobId externalRef1.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s/jobs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantsGetUsageRequest generates requests for TenantsGetUsage
+func NewTenantsGetUsageRequest(server string, tenantId externalRef1.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s/usage", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantsCloneRequest calls the generic TenantsClone builder with application/json body
+func NewTenantsCloneRequest(server string, tenantId externalRef1.UUID, body TenantsCloneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantsCloneRequestWithBody(server, tenantId, "application/json", bodyReader)
+}
+
+// NewTenantsCloneRequestWithBody generates requests for TenantsClone with any type of body
+func NewTenantsCloneRequestWithBody(server string, tenantId externalRef1.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s:clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantsDeprovisionRequest calls the generic TenantsDeprovision builder with application/json body
+func NewTenantsDeprovisionRequest(server string, tenantId externalRef1.UUID, body TenantsDeprovisionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantsDeprovisionRequestWithBody(server, tenantId, "application/json", bodyReader)
+}
+
+// NewTenantsDeprovisionRequestWithBody generates requests for TenantsDeprovision with any type of body
+func NewTenantsDeprovisionRequestWithBody(server string, tenantId externalRef1.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s:deprovision", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantsProvisionRequest generates requests for TenantsProvision
+func NewTenantsProvisionRequest(server string, tenantId externalRef1.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s:provision", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantsProvisionStatusRequest generates requests for TenantsProvisionStatus
+func NewTenantsProvisionStatusRequest(server string, tenantId externalRef1.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantId", runtime.ParamLocationPath, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants/%s:provision-status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantsMigrateRequest calls the generic TenantsMigrate builder with application/json body
+func NewTenantsMigrateRequest(server string, body TenantsMigrateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantsMigrateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTenantsMigrateRequestWithBody generates requests for TenantsMigrate with any type of body
+func NewTenantsMigrateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/tenants:migrate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// TenantsListWithResponse request
+	TenantsListWithResponse(ctx context.Context, params *TenantsListParams, reqEditors ...RequestEditorFn) (*TenantsListResponse, error)
+
+	// TenantsCreateWithBodyWithResponse request with any body
+	TenantsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsCreateResponse, error)
+
+	TenantsCreateWithResponse(ctx context.Context, body TenantsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsCreateResponse, error)
+
+	// TenantsGetWithResponse request
+	TenantsGetWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsGetResponse, error)
+
+	// TenantsUpdateWithBodyWithResponse request with any body
+	TenantsUpdateWithBodyWithResponse(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsUpdateResponse, error)
+
+	TenantsUpdateWithResponse(ctx context.Context, tenantId externalRef1.UUID, body TenantsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsUpdateResponse, error)
+
+	// TenantsGetJobWithResponse request
+	TenantsGetJobWithResponse(ctx context.Context, tenantId externalRef1.UUID, jobId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsGetJobResponse, error)
+
+	// TenantsGetUsageWithResponse request
+	TenantsGetUsageWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsGetUsageResponse, error)
+
+	// TenantsCloneWithBodyWithResponse request with any body
+	TenantsCloneWithBodyWithResponse(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsCloneResponse, error)
+
+	TenantsCloneWithResponse(ctx context.Context, tenantId externalRef1.UUID, body TenantsCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsCloneResponse, error)
+
+	// TenantsDeprovisionWithBodyWithResponse request with any body
+	TenantsDeprovisionWithBodyWithResponse(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsDeprovisionResponse, error)
+
+	TenantsDeprovisionWithResponse(ctx context.Context, tenantId externalRef1.UUID, body TenantsDeprovisionJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsDeprovisionResponse, error)
+
+	// TenantsProvisionWithResponse request
+	TenantsProvisionWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsProvisionResponse, error)
+
+	// TenantsProvisionStatusWithResponse request
+	TenantsProvisionStatusWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsProvisionStatusResponse, error)
+
+	// TenantsMigrateWithBodyWithResponse request with any body
+	TenantsMigrateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsMigrateResponse, error)
+
+	TenantsMigrateWithResponse(ctx context.Context, body TenantsMigrateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsMigrateResponse, error)
+}
+
+type TenantsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items      []Tenant `json:"items"`
+		Page       int      `json:"page"`
+		PageSize   int      `json:"pageSize"`
+		TotalItems int      `json:"totalItems"`
+		TotalPages int      `json:"totalPages"`
+	}
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsCreateResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Tenant
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsGetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Tenant
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsUpdateResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Tenant
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsGetJobResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ProvisioningJob
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsGetJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsGetJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsGetUsageResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TenantUsage
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsGetUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsGetUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsCloneResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *ProvisioningJob
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsCloneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsCloneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsDeprovisionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TenantDeprovisionResult
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsDeprovisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsDeprovisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsProvisionResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *ProvisioningJob
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsProvisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsProvisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsProvisionStatusResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TenantProvisioningStatus
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsProvisionStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsProvisionStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantsMigrateResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *TenantMigrationJobs
+	ApplicationproblemJSONDefault *externalRef2.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantsMigrateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantsMigrateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// TenantsListWithResponse request returning *TenantsListResponse
+func (c *ClientWithResponses) TenantsListWithResponse(ctx context.Context, params *TenantsListParams, reqEditors ...RequestEditorFn) (*TenantsListResponse, error) {
+	rsp, err := c.TenantsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsListResponse(rsp)
+}
+
+// TenantsCreateWithBodyWithResponse request with arbitrary body returning *TenantsCreateResponse
+func (c *ClientWithResponses) TenantsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsCreateResponse, error) {
+	rsp, err := c.TenantsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) TenantsCreateWithResponse(ctx context.Context, body TenantsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsCreateResponse, error) {
+	rsp, err := c.TenantsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsCreateResponse(rsp)
+}
+
+// TenantsGetWithResponse request returning *TenantsGetResponse
+func (c *ClientWithResponses) TenantsGetWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsGetResponse, error) {
+	rsp, err := c.TenantsGet(ctx, tenantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsGetResponse(rsp)
+}
+
+// TenantsUpdateWithBodyWithResponse request with arbitrary body returning *TenantsUpdateResponse
+func (c *ClientWithResponses) TenantsUpdateWithBodyWithResponse(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsUpdateResponse, error) {
+	rsp, err := c.TenantsUpdateWithBody(ctx, tenantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) TenantsUpdateWithResponse(ctx context.Context, tenantId externalRef1.UUID, body TenantsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsUpdateResponse, error) {
+	rsp, err := c.TenantsUpdate(ctx, tenantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsUpdateResponse(rsp)
+}
+
+// TenantsGetJobWithResponse request returning *TenantsGetJobResponse
+func (c *ClientWithResponses) TenantsGetJobWithResponse(ctx context.Context, tenantId externalRef1.UUID, jobId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsGetJobResponse, error) {
+	rsp, err := c.TenantsGetJob(ctx, tenantId, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsGetJobResponse(rsp)
+}
+
+// TenantsGetUsageWithResponse request returning *TenantsGetUsageResponse
+func (c *ClientWithResponses) TenantsGetUsageWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsGetUsageResponse, error) {
+	rsp, err := c.TenantsGetUsage(ctx, tenantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsGetUsageResponse(rsp)
+}
+
+// TenantsCloneWithBodyWithResponse request with arbitrary body returning *TenantsCloneResponse
+func (c *ClientWithResponses) TenantsCloneWithBodyWithResponse(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsCloneResponse, error) {
+	rsp, err := c.TenantsCloneWithBody(ctx, tenantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsCloneResponse(rsp)
+}
+
+func (c *ClientWithResponses) TenantsCloneWithResponse(ctx context.Context, tenantId externalRef1.UUID, body TenantsCloneJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsCloneResponse, error) {
+	rsp, err := c.TenantsClone(ctx, tenantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsCloneResponse(rsp)
+}
+
+// TenantsDeprovisionWithBodyWithResponse request with arbitrary body returning *TenantsDeprovisionResponse
+func (c *ClientWithResponses) TenantsDeprovisionWithBodyWithResponse(ctx context.Context, tenantId externalRef1.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsDeprovisionResponse, error) {
+	rsp, err := c.TenantsDeprovisionWithBody(ctx, tenantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsDeprovisionResponse(rsp)
+}
+
+func (c *ClientWithResponses) TenantsDeprovisionWithResponse(ctx context.Context, tenantId externalRef1.UUID, body TenantsDeprovisionJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsDeprovisionResponse, error) {
+	rsp, err := c.TenantsDeprovision(ctx, tenantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsDeprovisionResponse(rsp)
+}
+
+// TenantsProvisionWithResponse request returning *TenantsProvisionResponse
+func (c *ClientWithResponses) TenantsProvisionWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsProvisionResponse, error) {
+	rsp, err := c.TenantsProvision(ctx, tenantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsProvisionResponse(rsp)
+}
+
+// TenantsProvisionStatusWithResponse request returning *TenantsProvisionStatusResponse
+func (c *ClientWithResponses) TenantsProvisionStatusWithResponse(ctx context.Context, tenantId externalRef1.UUID, reqEditors ...RequestEditorFn) (*TenantsProvisionStatusResponse, error) {
+	rsp, err := c.TenantsProvisionStatus(ctx, tenantId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsProvisionStatusResponse(rsp)
+}
+
+// TenantsMigrateWithBodyWithResponse request with arbitrary body returning *TenantsMigrateResponse
+func (c *ClientWithResponses) TenantsMigrateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantsMigrateResponse, error) {
+	rsp, err := c.TenantsMigrateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsMigrateResponse(rsp)
+}
+
+func (c *ClientWithResponses) TenantsMigrateWithResponse(ctx context.Context, body TenantsMigrateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantsMigrateResponse, error) {
+	rsp, err := c.TenantsMigrate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantsMigrateResponse(rsp)
+}
+
+// ParseTenantsListResponse parses an HTTP response from a TenantsListWithResponse call
+func ParseTenantsListResponse(rsp *http.Response) (*TenantsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items      []Tenant `json:"items"`
+			Page       int      `json:"page"`
+			PageSize   int      `json:"pageSize"`
+			TotalItems int      `json:"totalItems"`
+			TotalPages int      `json:"totalPages"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsCreateResponse parses an HTTP response from a TenantsCreateWithResponse call
+func ParseTenantsCreateResponse(rsp *http.Response) (*TenantsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsGetResponse parses an HTTP response from a TenantsGetWithResponse call
+func ParseTenantsGetResponse(rsp *http.Response) (*TenantsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsUpdateResponse parses an HTTP response from a TenantsUpdateWithResponse call
+func ParseTenantsUpdateResponse(rsp *http.Response) (*TenantsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsGetJobResponse parses an HTTP response from a TenantsGetJobWithResponse call
+func ParseTenantsGetJobResponse(rsp *http.Response) (*TenantsGetJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsGetJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsGetUsageResponse parses an HTTP response from a TenantsGetUsageWithResponse call
+func ParseTenantsGetUsageResponse(rsp *http.Response) (*TenantsGetUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsGetUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsCloneResponse parses an HTTP response from a TenantsCloneWithResponse call
+func ParseTenantsCloneResponse(rsp *http.Response) (*TenantsCloneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsCloneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsDeprovisionResponse parses an HTTP response from a TenantsDeprovisionWithResponse call
+func ParseTenantsDeprovisionResponse(rsp *http.Response) (*TenantsDeprovisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsDeprovisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantDeprovisionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsProvisionResponse parses an HTTP response from a TenantsProvisionWithResponse call
+func ParseTenantsProvisionResponse(rsp *http.Response) (*TenantsProvisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsProvisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ProvisioningJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsProvisionStatusResponse parses an HTTP response from a TenantsProvisionStatusWithResponse call
+func ParseTenantsProvisionStatusResponse(rsp *http.Response) (*TenantsProvisionStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsProvisionStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantProvisioningStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantsMigrateResponse parses an HTTP response from a TenantsMigrateWithResponse call
+func ParseTenantsMigrateResponse(rsp *http.Response) (*TenantsMigrateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantsMigrateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TenantMigrationJobs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef2.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
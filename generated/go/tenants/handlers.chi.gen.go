@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -28,30 +29,194 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ProvisioningJobKind.
+const (
+	Clone     ProvisioningJobKind = "clone"
+	Migrate   ProvisioningJobKind = "migrate"
+	Provision ProvisioningJobKind = "provision"
+)
+
+// Defines values for ProvisioningJobStatus.
+const (
+	ProvisioningJobStatusFailed    ProvisioningJobStatus = "failed"
+	ProvisioningJobStatusQueued    ProvisioningJobStatus = "queued"
+	ProvisioningJobStatusRunning   ProvisioningJobStatus = "running"
+	ProvisioningJobStatusSucceeded ProvisioningJobStatus = "succeeded"
+)
+
+// Defines values for ProvisioningJobComponentStatus.
+const (
+	ProvisioningJobComponentStatusFailed  ProvisioningJobComponentStatus = "failed"
+	ProvisioningJobComponentStatusPending ProvisioningJobComponentStatus = "pending"
+	ProvisioningJobComponentStatusReady   ProvisioningJobComponentStatus = "ready"
+)
+
 // Defines values for TenantStatus.
 const (
 	Active       TenantStatus = "active"
 	Disabled     TenantStatus = "disabled"
+	Maintenance  TenantStatus = "maintenance"
 	Pending      TenantStatus = "pending"
 	Provisioning TenantStatus = "provisioning"
+	Suspended    TenantStatus = "suspended"
 )
 
+// CORSOrigins Browser origins (`scheme://host[:port]`) allowed to call the API on top of the deployment-wide CORS list. Wildcards are not accepted.
+type CORSOrigins = []string
+
+// CloneTenant defines model for CloneTenant.
+type CloneTenant struct {
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// IncludeData Copy entity documents; when false only the entity tables are created.
+	IncludeData *bool `json:"includeData,omitempty"`
+
+	// Slug Kebab-case slug used in URLs
+	Slug externalRef1.Slug `json:"slug"`
+}
+
 // CreateTenant defines model for CreateTenant.
 type CreateTenant struct {
-	DisplayName *string `json:"displayName,omitempty"`
+	// CorsOrigins Browser origins (`scheme://host[:port]`) allowed to call the API on top of the deployment-wide CORS list. Wildcards are not accepted.
+	CorsOrigins *CORSOrigins `json:"corsOrigins,omitempty"`
+	DisplayName *string      `json:"displayName,omitempty"`
+
+	// Email Outgoing email of the tenant. Messages sent on the tenant's behalf, such as invitations, use its sender; omitted sender fields fall back to the platform sender. Quota alerts and provisioning outcomes are emailed to notifyAddress; without it they are only logged.
+	Email *TenantEmailSettings `json:"email,omitempty"`
+
+	// Quotas Per-tenant limits. An omitted limit means unlimited.
+	Quotas *TenantQuotas `json:"quotas,omitempty"`
 
 	// Slug Kebab-case slug used in URLs
 	Slug externalRef1.Slug `json:"slug"`
 
-	// Status Tenant lifecycle state (admin-only managed).
+	// Status Tenant lifecycle state (admin-only managed). `maintenance` keeps the tenant provisioned but answers every
+	// non-admin request to it with `503 Service Unavailable` and `Retry-After`, so operators can run migrations.
+	// `suspended` (e.g. an unpaid account in its grace period) keeps reads working but rejects every write with
+	// `403`; unlike `disabled`, the tenant still resolves and its data stays reachable.
 	Status *TenantStatus `json:"status,omitempty"`
 }
 
+// DeprovisionTenant defines model for DeprovisionTenant.
+type DeprovisionTenant struct {
+	// ConfirmSlug Kebab-case slug used in URLs
+	ConfirmSlug externalRef1.Slug `json:"confirmSlug"`
+
+	// DryRun Report what the teardown would remove without removing it; no export is written.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Export Export the tenant before tearing it down; rejected when no exporter is configured.
+	Export *bool `json:"export,omitempty"`
+}
+
+// MigrateTenants defines model for MigrateTenants.
+type MigrateTenants struct {
+	// DryRun Only record the pending migrations of each tenant.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// TenantIds Tenants to migrate; every active tenant with a provisioned database when omitted.
+	TenantIds *[]externalRef1.UUID `json:"tenantIds,omitempty"`
+}
+
+// ProvisioningJob Background provisioning job for one tenant (admin-only, read-only).
+type ProvisioningJob struct {
+	// Attempts Number of attempts started so far.
+	Attempts int `json:"attempts"`
+
+	// Clone Progress of a clone job; present only when `kind` is `clone`.
+	Clone      *ProvisioningJobClone     `json:"clone,omitempty"`
+	Components ProvisioningJobComponents `json:"components"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef1.Timestamp `json:"createdAt"`
+
+	// FinishedAt ISO 8601 timestamp in UTC
+	FinishedAt *externalRef1.Timestamp `json:"finishedAt,omitempty"`
+
+	// JobId RFC 4122 UUID string
+	JobId externalRef1.UUID `json:"jobId"`
+
+	// Kind `clone` jobs copy tables from another tenant once the tenant is provisioned. `migrate` jobs apply pending schema migrations to a provisioned tenant; their components mirror the tenant's provisioning state.
+	Kind ProvisioningJobKind `json:"kind"`
+
+	// LastError Error of the most recent attempt, if any.
+	LastError *string `json:"lastError,omitempty"`
+
+	// Migration Progress of a migrate job; present only when `kind` is `migrate`.
+	Migration *ProvisioningJobMigration `json:"migration,omitempty"`
+
+	// NextAttemptAt ISO 8601 timestamp in UTC
+	NextAttemptAt externalRef1.Timestamp `json:"nextAttemptAt"`
+
+	// Status `queued` waits for the worker (including scheduled retries), `running` is being processed, `succeeded` and `failed` are final.
+	Status ProvisioningJobStatus `json:"status"`
+
+	// TenantId RFC 4122 UUID string
+	TenantId externalRef1.UUID `json:"tenantId"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef1.Timestamp `json:"updatedAt"`
+}
+
+// ProvisioningJobKind `clone` jobs copy tables from another tenant once the tenant is provisioned. `migrate` jobs apply pending schema migrations to a provisioned tenant; their components mirror the tenant's provisioning state.
+type ProvisioningJobKind string
+
+// ProvisioningJobStatus `queued` waits for the worker (including scheduled retries), `running` is being processed, `succeeded` and `failed` are final.
+type ProvisioningJobStatus string
+
+// ProvisioningJobClone Progress of a clone job; present only when `kind` is `clone`.
+type ProvisioningJobClone struct {
+	CopiedTables int  `json:"copiedTables"`
+	IncludeData  bool `json:"includeData"`
+
+	// SourceTenantId RFC 4122 UUID string
+	SourceTenantId externalRef1.UUID `json:"sourceTenantId"`
+
+	// Tables Copy progress keyed by table name.
+	Tables      map[string]ProvisioningJobComponent `json:"tables"`
+	TotalTables int                                 `json:"totalTables"`
+}
+
+// ProvisioningJobComponent defines model for ProvisioningJobComponent.
+type ProvisioningJobComponent struct {
+	Attempts  int     `json:"attempts"`
+	LastError *string `json:"lastError,omitempty"`
+
+	// Status `failed` means the last attempt failed; the component is retried while the job is open.
+	Status ProvisioningJobComponentStatus `json:"status"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt *externalRef1.Timestamp `json:"updatedAt,omitempty"`
+}
+
+// ProvisioningJobComponentStatus `failed` means the last attempt failed; the component is retried while the job is open.
+type ProvisioningJobComponentStatus string
+
+// ProvisioningJobComponents defines model for ProvisioningJobComponents.
+type ProvisioningJobComponents struct {
+	Auth    ProvisioningJobComponent `json:"auth"`
+	Db      ProvisioningJobComponent `json:"db"`
+	Storage ProvisioningJobComponent `json:"storage"`
+}
+
+// ProvisioningJobMigration Progress of a migrate job; present only when `kind` is `migrate`.
+type ProvisioningJobMigration struct {
+	AppliedMigrations int  `json:"appliedMigrations"`
+	DryRun            bool `json:"dryRun"`
+
+	// Migrations Migration progress keyed by `<set>/<version>`. Known once the first attempt has run; in a dry run every entry stays `pending`.
+	Migrations      map[string]ProvisioningJobComponent `json:"migrations"`
+	TotalMigrations int                                 `json:"totalMigrations"`
+}
+
 // Tenant defines model for Tenant.
 type Tenant struct {
 	// BasePrefix Derived GCS base prefix `<envKey>/<tenantSlug>-<shortTenantId>/`. envKey comes from deployment config; prefix is computed server-side and immutable.
 	BasePrefix *string `json:"basePrefix,omitempty"`
 
+	// CorsOrigins Browser origins (`scheme://host[:port]`) allowed to call the API on top of the deployment-wide CORS list. Wildcards are not accepted.
+	CorsOrigins CORSOrigins `json:"corsOrigins"`
+
 	// CreatedAt ISO 8601 timestamp in UTC
 	CreatedAt externalRef1.Timestamp `json:"createdAt"`
 
@@ -59,9 +224,15 @@ type Tenant struct {
 	CreatedBy   externalRef1.UUID `json:"createdBy"`
 	DisplayName *string           `json:"displayName,omitempty"`
 
+	// Email Outgoing email of the tenant. Messages sent on the tenant's behalf, such as invitations, use its sender; omitted sender fields fall back to the platform sender. Quota alerts and provisioning outcomes are emailed to notifyAddress; without it they are only logged.
+	Email TenantEmailSettings `json:"email"`
+
 	// Provisioning Current provisioning state for tenant environment resources (admin-only, read-only).
 	Provisioning TenantProvisioningStatus `json:"provisioning"`
 
+	// Quotas Per-tenant limits. An omitted limit means unlimited.
+	Quotas TenantQuotas `json:"quotas"`
+
 	// SchemaName Derived PostgreSQL schema name for the tenant (`tenant_<slugSnake>`).
 	SchemaName *string `json:"schemaName,omitempty"`
 
@@ -71,13 +242,45 @@ type Tenant struct {
 	// Slug Kebab-case slug used in URLs
 	Slug externalRef1.Slug `json:"slug"`
 
-	// Status Tenant lifecycle state (admin-only managed).
+	// Status Tenant lifecycle state (admin-only managed). `maintenance` keeps the tenant provisioned but answers every
+	// non-admin request to it with `503 Service Unavailable` and `Retry-After`, so operators can run migrations.
+	// `suspended` (e.g. an unpaid account in its grace period) keeps reads working but rejects every write with
+	// `403`; unlike `disabled`, the tenant still resolves and its data stays reachable.
 	Status TenantStatus `json:"status"`
 
 	// TenantId RFC 4122 UUID string
 	TenantId externalRef1.UUID `json:"tenantId"`
 }
 
+// TenantDeprovisionResult defines model for TenantDeprovisionResult.
+type TenantDeprovisionResult struct {
+	DryRun bool `json:"dryRun"`
+
+	// ExportLocation Where the export was written, present when `export` was requested.
+	ExportLocation *string `json:"exportLocation,omitempty"`
+
+	// Teardown What each teardown step removed, or would remove on a dry run. A failed step carries its error.
+	Teardown TenantTeardown `json:"teardown"`
+	Tenant   Tenant         `json:"tenant"`
+}
+
+// TenantEmailSettings Outgoing email of the tenant. Messages sent on the tenant's behalf, such as invitations, use its sender; omitted sender fields fall back to the platform sender. Quota alerts and provisioning outcomes are emailed to notifyAddress; without it they are only logged.
+type TenantEmailSettings struct {
+	// FromAddress Sender address; the mail provider must be allowed to send from its domain.
+	FromAddress *string `json:"fromAddress,omitempty"`
+	FromName    *string `json:"fromName,omitempty"`
+
+	// NotifyAddress Recipient of the tenant's quota and provisioning notifications.
+	NotifyAddress *string `json:"notifyAddress,omitempty"`
+	ReplyTo       *string `json:"replyTo,omitempty"`
+}
+
+// TenantMigrationJobs defines model for TenantMigrationJobs.
+type TenantMigrationJobs struct {
+	// Jobs One migrate job per selected tenant.
+	Jobs []ProvisioningJob `json:"jobs"`
+}
+
 // TenantProvisioningStatus Current provisioning state for tenant environment resources (admin-only, read-only).
 type TenantProvisioningStatus struct {
 	// AuthReady External auth tenant (e.g., Firebase/Identity) has been created and linked.
@@ -96,14 +299,113 @@ type TenantProvisioningStatus struct {
 	StorageReady *bool `json:"storageReady,omitempty"`
 }
 
-// TenantStatus Tenant lifecycle state (admin-only managed).
+// TenantQuotas Per-tenant limits. An omitted limit means unlimited.
+type TenantQuotas struct {
+	// MaxEntities Maximum number of live entities across all entity tables.
+	MaxEntities *int64 `json:"maxEntities,omitempty"`
+
+	// MaxObjectBytes Maximum bytes of files under the tenant's object storage prefix. Not enforced on writes; crossing the configured percentages of it emits `tenant.storage_threshold_reached` events.
+	MaxObjectBytes *int64 `json:"maxObjectBytes,omitempty"`
+
+	// MaxSchemas Maximum number of schemas the tenant stores entities for.
+	MaxSchemas *int64 `json:"maxSchemas,omitempty"`
+
+	// MaxStorageBytes Maximum on-disk size of the tenant schema, including indexes.
+	MaxStorageBytes *int64 `json:"maxStorageBytes,omitempty"`
+
+	// MaxUsers Maximum number of users.
+	MaxUsers *int64 `json:"maxUsers,omitempty"`
+}
+
+// TenantStatus Tenant lifecycle state (admin-only managed). `maintenance` keeps the tenant provisioned but answers every
+// non-admin request to it with `503 Service Unavailable` and `Retry-After`, so operators can run migrations.
+// `suspended` (e.g. an unpaid account in its grace period) keeps reads working but rejects every write with
+// `403`; unlike `disabled`, the tenant still resolves and its data stays reachable.
 type TenantStatus string
 
-// UpdateTenant Update mutable tenant fields. Slug and derived fields are immutable after creation.
+// TenantTeardown What each teardown step removed, or would remove on a dry run. A failed step carries its error.
+type TenantTeardown struct {
+	Auth     TenantTeardownAuth     `json:"auth"`
+	Database TenantTeardownDatabase `json:"database"`
+	Storage  TenantTeardownStorage  `json:"storage"`
+}
+
+// TenantTeardownAuth defines model for TenantTeardownAuth.
+type TenantTeardownAuth struct {
+	Error          *string `json:"error,omitempty"`
+	ExternalTenant string  `json:"externalTenant"`
+}
+
+// TenantTeardownDatabase defines model for TenantTeardownDatabase.
+type TenantTeardownDatabase struct {
+	Error        *string `json:"error,omitempty"`
+	RoleExists   bool    `json:"roleExists"`
+	RoleName     string  `json:"roleName"`
+	SchemaExists bool    `json:"schemaExists"`
+	SchemaName   string  `json:"schemaName"`
+
+	// Tables Tables dropped with the schema and the rows they held.
+	Tables []TenantTeardownTable `json:"tables"`
+}
+
+// TenantTeardownStorage defines model for TenantTeardownStorage.
+type TenantTeardownStorage struct {
+	Bytes int64   `json:"bytes"`
+	Error *string `json:"error,omitempty"`
+
+	// Objects Objects under the prefix.
+	Objects int64  `json:"objects"`
+	Prefix  string `json:"prefix"`
+}
+
+// TenantTeardownTable defines model for TenantTeardownTable.
+type TenantTeardownTable struct {
+	Name string `json:"name"`
+	Rows int64  `json:"rows"`
+}
+
+// TenantUsage defines model for TenantUsage.
+type TenantUsage struct {
+	Entities int64 `json:"entities"`
+
+	// MeasuredAt ISO 8601 timestamp in UTC
+	MeasuredAt externalRef1.Timestamp `json:"measuredAt"`
+
+	// ObjectBytes Total size of the objects under the tenant's storage prefix at `objectsMeasuredAt`.
+	ObjectBytes int64 `json:"objectBytes"`
+
+	// Objects Number of objects under the tenant's storage prefix at `objectsMeasuredAt`.
+	Objects int64 `json:"objects"`
+
+	// ObjectsMeasuredAt ISO 8601 timestamp in UTC
+	ObjectsMeasuredAt *externalRef1.Timestamp `json:"objectsMeasuredAt,omitempty"`
+
+	// Quotas Per-tenant limits. An omitted limit means unlimited.
+	Quotas       TenantQuotas `json:"quotas"`
+	Schemas      int64        `json:"schemas"`
+	StorageBytes int64        `json:"storageBytes"`
+
+	// TenantId RFC 4122 UUID string
+	TenantId externalRef1.UUID `json:"tenantId"`
+	Users    int64             `json:"users"`
+}
+
+// UpdateTenant Update mutable tenant fields. Slug and derived fields are immutable after creation. When present, quotas replaces every limit; omitted limits become unlimited. When present, corsOrigins replaces the tenant's allowed origins; an empty list removes them. When present, email replaces every email setting; omitted settings are cleared.
 type UpdateTenant struct {
-	DisplayName *string `json:"displayName,omitempty"`
+	// CorsOrigins Browser origins (`scheme://host[:port]`) allowed to call the API on top of the deployment-wide CORS list. Wildcards are not accepted.
+	CorsOrigins *CORSOrigins `json:"corsOrigins,omitempty"`
+	DisplayName *string      `json:"displayName,omitempty"`
+
+	// Email Outgoing email of the tenant. Messages sent on the tenant's behalf, such as invitations, use its sender; omitted sender fields fall back to the platform sender. Quota alerts and provisioning outcomes are emailed to notifyAddress; without it they are only logged.
+	Email *TenantEmailSettings `json:"email,omitempty"`
 
-	// Status Tenant lifecycle state (admin-only managed).
+	// Quotas Per-tenant limits. An omitted limit means unlimited.
+	Quotas *TenantQuotas `json:"quotas,omitempty"`
+
+	// Status Tenant lifecycle state (admin-only managed). `maintenance` keeps the tenant provisioned but answers every
+	// non-admin request to it with `503 Service Unavailable` and `Retry-After`, so operators can run migrations.
+	// `suspended` (e.g. an unpaid account in its grace period) keeps reads working but rejects every write with
+	// `403`; unlike `disabled`, the tenant still resolves and its data stays reachable.
 	Status *TenantStatus `json:"status,omitempty"`
 }
 
@@ -120,6 +422,15 @@ type TenantsListParams struct {
 
 	// Status Optional filter by tenant status
 	Status *TenantStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Q Case-insensitive substring matched against the slug and display name
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// CreatedAfter Only tenants created at or after this instant
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only tenants created before this instant
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
 }
 
 // TenantsCreateJSONRequestBody defines body for TenantsCreate for application/json ContentType.
@@ -128,6 +439,15 @@ type TenantsCreateJSONRequestBody = CreateTenant
 // TenantsUpdateJSONRequestBody defines body for TenantsUpdate for application/json ContentType.
 type TenantsUpdateJSONRequestBody = UpdateTenant
 
+// TenantsCloneJSONRequestBody defines body for TenantsClone for application/json ContentType.
+type TenantsCloneJSONRequestBody = CloneTenant
+
+// TenantsDeprovisionJSONRequestBody defines body for TenantsDeprovision for application/json ContentType.
+type TenantsDeprovisionJSONRequestBody = DeprovisionTenant
+
+// TenantsMigrateJSONRequestBody defines body for TenantsMigrate for application/json ContentType.
+type TenantsMigrateJSONRequestBody = MigrateTenants
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List tenants (admin only)
@@ -142,12 +462,27 @@ type ServerInterface interface {
 	// Update tenant display or status (admin only)
 	// (PATCH /admin/tenants/{tenantId})
 	TenantsUpdate(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID)
+	// Get provisioning job progress (admin only)
+	// (GET /admin/tenants/{tenantId}/jobs/{jobId})
+	TenantsGetJob(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID, jobId externalRef1.UUID)
+	// Get tenant usage against quotas (admin only)
+	// (GET /admin/tenants/{tenantId}/usage)
+	TenantsGetUsage(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID)
+	// Clone a tenant into a new tenant (admin only)
+	// (POST /admin/tenants/{tenantId}:clone)
+	TenantsClone(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID)
+	// Deprovision tenant environment (admin only)
+	// (POST /admin/tenants/{tenantId}:deprovision)
+	TenantsDeprovision(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID)
 	// Provision or reprovision tenant environment (admin only)
 	// (POST /admin/tenants/{tenantId}:provision)
 	TenantsProvision(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID)
 	// Check provisioning status (admin only)
 	// (GET /admin/tenants/{tenantId}:provision-status)
 	TenantsProvisionStatus(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID)
+	// Apply pending schema migrations to tenant schemas (admin only)
+	// (POST /admin/tenants:migrate)
+	TenantsMigrate(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -178,6 +513,30 @@ func (_ Unimplemented) TenantsUpdate(w http.ResponseWriter, r *http.Request, ten
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get provisioning job progress (admin only)
+// (GET /admin/tenants/{tenantId}/jobs/{jobId})
+func (_ Unimplemented) TenantsGetJob(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID, jobId externalRef1.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get tenant usage against quotas (admin only)
+// (GET /admin/tenants/{tenantId}/usage)
+func (_ Unimplemented) TenantsGetUsage(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clone a tenant into a new tenant (admin only)
+// (POST /admin/tenants/{tenantId}:clone)
+func (_ Unimplemented) TenantsClone(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Deprovision tenant environment (admin only)
+// (POST /admin/tenants/{tenantId}:deprovision)
+func (_ Unimplemented) TenantsDeprovision(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Provision or reprovision tenant environment (admin only)
 // (POST /admin/tenants/{tenantId}:provision)
 func (_ Unimplemented) TenantsProvision(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
@@ -190,6 +549,12 @@ func (_ Unimplemented) TenantsProvisionStatus(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Apply pending schema migrations to tenant schemas (admin only)
+// (POST /admin/tenants:migrate)
+func (_ Unimplemented) TenantsMigrate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -206,7 +571,7 @@ func (siw *ServerInterfaceWrapper) TenantsList(w http.ResponseWriter, r *http.Re
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
 
 	r = r.WithContext(ctx)
 
@@ -245,6 +610,30 @@ func (siw *ServerInterfaceWrapper) TenantsList(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.TenantsList(w, r, params)
 	}))
@@ -261,7 +650,7 @@ func (siw *ServerInterfaceWrapper) TenantsCreate(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
 
 	r = r.WithContext(ctx)
 
@@ -292,7 +681,7 @@ func (siw *ServerInterfaceWrapper) TenantsGet(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
 
 	r = r.WithContext(ctx)
 
@@ -323,7 +712,7 @@ func (siw *ServerInterfaceWrapper) TenantsUpdate(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
 
 	r = r.WithContext(ctx)
 
@@ -338,6 +727,139 @@ func (siw *ServerInterfaceWrapper) TenantsUpdate(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// TenantsGetJob operation middleware
+func (siw *ServerInterfaceWrapper) TenantsGetJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantId" -------------
+	var tenantId externalRef1.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", chi.URLParam(r, "tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "jobId" -------------
+	var jobId externalRef1.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", chi.URLParam(r, "jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TenantsGetJob(w, r, tenantId, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TenantsGetUsage operation middleware
+func (siw *ServerInterfaceWrapper) TenantsGetUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantId" -------------
+	var tenantId externalRef1.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", chi.URLParam(r, "tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TenantsGetUsage(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TenantsClone operation middleware
+func (siw *ServerInterfaceWrapper) TenantsClone(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantId" -------------
+	var tenantId externalRef1.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", chi.URLParam(r, "tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TenantsClone(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TenantsDeprovision operation middleware
+func (siw *ServerInterfaceWrapper) TenantsDeprovision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantId" -------------
+	var tenantId externalRef1.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantId", chi.URLParam(r, "tenantId"), &tenantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TenantsDeprovision(w, r, tenantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // TenantsProvision operation middleware
 func (siw *ServerInterfaceWrapper) TenantsProvision(w http.ResponseWriter, r *http.Request) {
 
@@ -354,7 +876,7 @@ func (siw *ServerInterfaceWrapper) TenantsProvision(w http.ResponseWriter, r *ht
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
 
 	r = r.WithContext(ctx)
 
@@ -385,7 +907,7 @@ func (siw *ServerInterfaceWrapper) TenantsProvisionStatus(w http.ResponseWriter,
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
 
 	r = r.WithContext(ctx)
 
@@ -400,6 +922,26 @@ func (siw *ServerInterfaceWrapper) TenantsProvisionStatus(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
+// TenantsMigrate operation middleware
+func (siw *ServerInterfaceWrapper) TenantsMigrate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"tenants:admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TenantsMigrate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -525,12 +1067,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/admin/tenants/{tenantId}", wrapper.TenantsUpdate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/tenants/{tenantId}/jobs/{jobId}", wrapper.TenantsGetJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/tenants/{tenantId}/usage", wrapper.TenantsGetUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/tenants/{tenantId}:clone", wrapper.TenantsClone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/tenants/{tenantId}:deprovision", wrapper.TenantsDeprovision)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/tenants/{tenantId}:provision", wrapper.TenantsProvision)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/tenants/{tenantId}:provision-status", wrapper.TenantsProvisionStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/tenants:migrate", wrapper.TenantsMigrate)
+	})
 
 	return r
 }
@@ -666,6 +1223,133 @@ func (response TenantsUpdatedefaultApplicationProblemPlusJSONResponse) VisitTena
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type TenantsGetJobRequestObject struct {
+	TenantId externalRef1.UUID `json:"tenantId"`
+	JobId    externalRef1.UUID `json:"jobId"`
+}
+
+type TenantsGetJobResponseObject interface {
+	VisitTenantsGetJobResponse(w http.ResponseWriter) error
+}
+
+type TenantsGetJob200JSONResponse ProvisioningJob
+
+func (response TenantsGetJob200JSONResponse) VisitTenantsGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantsGetJobdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response TenantsGetJobdefaultApplicationProblemPlusJSONResponse) VisitTenantsGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TenantsGetUsageRequestObject struct {
+	TenantId externalRef1.UUID `json:"tenantId"`
+}
+
+type TenantsGetUsageResponseObject interface {
+	VisitTenantsGetUsageResponse(w http.ResponseWriter) error
+}
+
+type TenantsGetUsage200JSONResponse TenantUsage
+
+func (response TenantsGetUsage200JSONResponse) VisitTenantsGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantsGetUsagedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response TenantsGetUsagedefaultApplicationProblemPlusJSONResponse) VisitTenantsGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TenantsCloneRequestObject struct {
+	TenantId externalRef1.UUID `json:"tenantId"`
+	Body     *TenantsCloneJSONRequestBody
+}
+
+type TenantsCloneResponseObject interface {
+	VisitTenantsCloneResponse(w http.ResponseWriter) error
+}
+
+type TenantsClone202ResponseHeaders struct {
+	Location string
+}
+
+type TenantsClone202JSONResponse struct {
+	Body    ProvisioningJob
+	Headers TenantsClone202ResponseHeaders
+}
+
+func (response TenantsClone202JSONResponse) VisitTenantsCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TenantsClonedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response TenantsClonedefaultApplicationProblemPlusJSONResponse) VisitTenantsCloneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TenantsDeprovisionRequestObject struct {
+	TenantId externalRef1.UUID `json:"tenantId"`
+	Body     *TenantsDeprovisionJSONRequestBody
+}
+
+type TenantsDeprovisionResponseObject interface {
+	VisitTenantsDeprovisionResponse(w http.ResponseWriter) error
+}
+
+type TenantsDeprovision200JSONResponse TenantDeprovisionResult
+
+func (response TenantsDeprovision200JSONResponse) VisitTenantsDeprovisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantsDeprovisiondefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response TenantsDeprovisiondefaultApplicationProblemPlusJSONResponse) VisitTenantsDeprovisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type TenantsProvisionRequestObject struct {
 	TenantId externalRef1.UUID `json:"tenantId"`
 }
@@ -674,13 +1358,21 @@ type TenantsProvisionResponseObject interface {
 	VisitTenantsProvisionResponse(w http.ResponseWriter) error
 }
 
-type TenantsProvision202JSONResponse Tenant
+type TenantsProvision202ResponseHeaders struct {
+	Location string
+}
+
+type TenantsProvision202JSONResponse struct {
+	Body    ProvisioningJob
+	Headers TenantsProvision202ResponseHeaders
+}
 
 func (response TenantsProvision202JSONResponse) VisitTenantsProvisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
 	w.WriteHeader(202)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
 type TenantsProvisiondefaultApplicationProblemPlusJSONResponse struct {
@@ -724,6 +1416,35 @@ func (response TenantsProvisionStatusdefaultApplicationProblemPlusJSONResponse)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type TenantsMigrateRequestObject struct {
+	Body *TenantsMigrateJSONRequestBody
+}
+
+type TenantsMigrateResponseObject interface {
+	VisitTenantsMigrateResponse(w http.ResponseWriter) error
+}
+
+type TenantsMigrate202JSONResponse TenantMigrationJobs
+
+func (response TenantsMigrate202JSONResponse) VisitTenantsMigrateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantsMigratedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef2.ProblemDetails
+	StatusCode int
+}
+
+func (response TenantsMigratedefaultApplicationProblemPlusJSONResponse) VisitTenantsMigrateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// List tenants (admin only)
@@ -738,12 +1459,27 @@ type StrictServerInterface interface {
 	// Update tenant display or status (admin only)
 	// (PATCH /admin/tenants/{tenantId})
 	TenantsUpdate(ctx context.Context, request TenantsUpdateRequestObject) (TenantsUpdateResponseObject, error)
+	// Get provisioning job progress (admin only)
+	// (GET /admin/tenants/{tenantId}/jobs/{jobId})
+	TenantsGetJob(ctx context.Context, request TenantsGetJobRequestObject) (TenantsGetJobResponseObject, error)
+	// Get tenant usage against quotas (admin only)
+	// (GET /admin/tenants/{tenantId}/usage)
+	TenantsGetUsage(ctx context.Context, request TenantsGetUsageRequestObject) (TenantsGetUsageResponseObject, error)
+	// Clone a tenant into a new tenant (admin only)
+	// (POST /admin/tenants/{tenantId}:clone)
+	TenantsClone(ctx context.Context, request TenantsCloneRequestObject) (TenantsCloneResponseObject, error)
+	// Deprovision tenant environment (admin only)
+	// (POST /admin/tenants/{tenantId}:deprovision)
+	TenantsDeprovision(ctx context.Context, request TenantsDeprovisionRequestObject) (TenantsDeprovisionResponseObject, error)
 	// Provision or reprovision tenant environment (admin only)
 	// (POST /admin/tenants/{tenantId}:provision)
 	TenantsProvision(ctx context.Context, request TenantsProvisionRequestObject) (TenantsProvisionResponseObject, error)
 	// Check provisioning status (admin only)
 	// (GET /admin/tenants/{tenantId}:provision-status)
 	TenantsProvisionStatus(ctx context.Context, request TenantsProvisionStatusRequestObject) (TenantsProvisionStatusResponseObject, error)
+	// Apply pending schema migrations to tenant schemas (admin only)
+	// (POST /admin/tenants:migrate)
+	TenantsMigrate(ctx context.Context, request TenantsMigrateRequestObject) (TenantsMigrateResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -891,6 +1627,125 @@ func (sh *strictHandler) TenantsUpdate(w http.ResponseWriter, r *http.Request, t
 	}
 }
 
+// TenantsGetJob operation middleware
+func (sh *strictHandler) TenantsGetJob(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID, jobId externalRef1.UUID) {
+	var request TenantsGetJobRequestObject
+
+	request.TenantId = tenantId
+	request.JobId = jobId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantsGetJob(ctx, request.(TenantsGetJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantsGetJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TenantsGetJobResponseObject); ok {
+		if err := validResponse.VisitTenantsGetJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TenantsGetUsage operation middleware
+func (sh *strictHandler) TenantsGetUsage(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
+	var request TenantsGetUsageRequestObject
+
+	request.TenantId = tenantId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantsGetUsage(ctx, request.(TenantsGetUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantsGetUsage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TenantsGetUsageResponseObject); ok {
+		if err := validResponse.VisitTenantsGetUsageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TenantsClone operation middleware
+func (sh *strictHandler) TenantsClone(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
+	var request TenantsCloneRequestObject
+
+	request.TenantId = tenantId
+
+	var body TenantsCloneJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantsClone(ctx, request.(TenantsCloneRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantsClone")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TenantsCloneResponseObject); ok {
+		if err := validResponse.VisitTenantsCloneResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TenantsDeprovision operation middleware
+func (sh *strictHandler) TenantsDeprovision(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
+	var request TenantsDeprovisionRequestObject
+
+	request.TenantId = tenantId
+
+	var body TenantsDeprovisionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantsDeprovision(ctx, request.(TenantsDeprovisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantsDeprovision")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TenantsDeprovisionResponseObject); ok {
+		if err := validResponse.VisitTenantsDeprovisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // TenantsProvision operation middleware
 func (sh *strictHandler) TenantsProvision(w http.ResponseWriter, r *http.Request, tenantId externalRef1.UUID) {
 	var request TenantsProvisionRequestObject
@@ -943,49 +1798,138 @@ func (sh *strictHandler) TenantsProvisionStatus(w http.ResponseWriter, r *http.R
 	}
 }
 
+// TenantsMigrate operation middleware
+func (sh *strictHandler) TenantsMigrate(w http.ResponseWriter, r *http.Request) {
+	var request TenantsMigrateRequestObject
+
+	var body TenantsMigrateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantsMigrate(ctx, request.(TenantsMigrateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantsMigrate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TenantsMigrateResponseObject); ok {
+		if err := validResponse.VisitTenantsMigrateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xaUXPbNhL+Kzu4ztS+UpbspNdU93DjOE3OE1+js52X8/kSiFyKqEGABUDVqkf//WYB",
-	"kqJEypadTKbpG0WCwIfdb79dLHXHYp0XWqFylo3vWMENz9Gh8b9inedafSj4TCjuRLhEepKgjY0o6B4b",
-	"s8OBUAneYgL0HFSZT9GwiAl6+GuJZsEipniObMz8DBGzcYY5D1OlvJSOjQ8jlgsl8jL3125R0HihHM7Q",
-	"sOUy2oLnQvzeg+lnDwJ0CsJhbqFAE9Dt5fwWDkej/XsA+il7QR6NIpbz2wrlaPQEzFYb18V7oY2DVKBM",
-	"bAR4MDuAbwlQNIgNcofJsft2C2A/XxtshcI6I9SMLQlFeOideuLnu0TFlYdRGF2gcQL900TYQvLFz37q",
-	"O9rqGaqZy2jno2hz6ohZWc5o4DcGUzZmfxmu+DSsFh3WJjAiF07M0X64oLfobcddaR96P2C9CGNpNwZ/",
-	"LYXBhI2vAoDrBpme/oKxo7m37XDKLU4MpuK264RXaMQcE3hzcgE0Dgo/ED7+txyNnsWo5m9x4a9xGG65",
-	"gE2Ws3B7EG7bTBsXEJwm1QsfDyBMALHO0UJqdA4JFlIvclQOYq1SMft7vaawNK4oHSZg0czRDKxIELhK",
-	"QOR56fhU4gEja/DknZILNnamxB4fNQx6vKMuRY7W8bxozfNy8fh53r8/fUVTPJZehdFzYYVW9Hsnmkxa",
-	"b9SUqflfL9vv9Im2bmbw4t9nEIYDBRik2oDLEIKnYe9juPhQOVqWswvFbzA4+eP+Th5Zo0cX0WthrIMX",
-	"kOEtTzAWOZcQZ9zwmJSZNM1V70ZQWkxAqIo1aGn9gjuHhmb639Vo8CMfpMeD19d3L5bf7ATui4d0xFzL",
-	"Fk9h1oYoNNNVu2lgbTBqjRhRWxo2fdSOonYkbFeeHiJ2HH1SGkOh3wYFBLWiXaAcqrkwWnmRMGh1aWK0",
-	"sMeTXKiBVnIRATnVX3r+rQseL112jjxZdNf/6ZZ4wiXQmIbhlH0ieC0MkkGGpwkqJ9xiHzJuYYqooNq/",
-	"1yIp1A0m99B+qrVErnz4T7cAacVehaIKwd4lvTR7/bMr0+2KQXLrfjJGmy6Kd/6CS6Ax605BeiMCkQJX",
-	"C1qoJV3fk3Q9GFY052QF9pPV2Dpt+Ay3GPSysmIY5JXMFjxGSisGeZyR9WpX+3RXxjfohlXy0QakjrmE",
-	"KY9vUCX7u9h2IwhrZ0ctBm7A3h492yKm2pcUKcaLWGIVLa1ggJwrPsMAGRVVZleMx2RB5hMQ7ZwCukCV",
-	"BBFY04TrHt+9L5K1mmkdU3gKVUquCRxquQMgYfSsTao8Ex4AN7jK48BThyaQXGjVDeJH12VPq6w63uiW",
-	"r5Pm8l/oeLe+qo8I99XFEWsX7rvX0xFz2nF5ShX92hqjrWMnfIYPjt1gbnVGaZ0EWsuuzXt9j8k2cmOH",
-	"Nm9xyqeDmLSMklSTyd+fn9EqeMvzQhL2KzaVPL4ZSO1KO+CyyDgt3M7yfPD7aPDj9Xd7/xgPmh/7f6V8",
-	"3y0H79GVDsjTi3fw4m+jQ3D1GA/x8mQD4dHo6PvB4Whw+Ozy8Pn42Wg8Gv2HQKba5NyxMaMQGdAku0Hy",
-	"ab2D5vz1CTw/PDoCegzV+61FylIk986vpxLzBB0X0n6YhJ+vws/+1X54MfoBqoFQj+wEp7/fneAYsjLn",
-	"akDC6aMcbwvJQ/CALTAWqYjBaXCZsKDj2JcDMfoKL6PTh1+3b0c+H4X0niQipK3JGihRB0nn3eoGN4Yv",
-	"fFbuT4I5LwiIl6uBxDlKmHMpkgC/AtDDf6Gs4yrGPnu8Pz8FgymGbbqMOxC+vEgFWr/nxiyPMofdli4y",
-	"hH9eXk4gDIBYJy0CtrVCONmL2JeB0aYjbZnn3Cw2kIGfN9pm8aeYY2PmFdON6C60WQX7PTXG6WrV0nsr",
-	"1VvTrMGZsM4sfP5aq4daCXf/AN4iFgFvzJVWIg70KWhk67RCVCepG1beKGRpm7TYbNzYIIVUARtdOr/c",
-	"qk6PYFWmR7BWpe/7Lg/ByEvphF82XkCCVsx8Tq28zCZc5gvDKbDheHLKIjZHY8PW54fkMV2g4oVgY/bs",
-	"YHTwPByqMs+wod/6MGzK35mhrwoo+nxw0DmmMqE9E9b5t1eNtav+pLwaMtzSeFtGT3zTZ7Enve2bS/Tm",
-	"FpFIhaTCZbpo6vb6oNXbqqofrppVjyhPronettDKBoU7Go1Cl1I5DHUZLwopYo98+IslpHetpbiU71Jv",
-	"/qJfKZuLh0F1dXQj+MJcPfXBbkX/1npree3DduP4RAUvSGHdKtxskPaqb7nVTJXAfNc1105nk/sSag9Q",
-	"f/CCvTqz7nuzVWLKxoyCpYZfSQx4iaHY5TNfaVTSdEwP2TWVktr2FOWhzWmB18Q0GGsTzo8GXWnUSnpq",
-	"lalL9rojNOeyxFCp97XixrBSJZIsC/f3htrKVY3/LH3FyG9q7REd80gH01UnaUsHiWSxV7iCAVlgNVr3",
-	"Uodz5s7hdh9/1prQy/XYocPlshPqh59t7faqvVmvajWwiGXIk+pbyJkOi/Uc/87P6jqgblI0lAvNmvu7",
-	"819fmDZtDOCg8LemcbRTwC6jjQw6vKu5uHwomb7BnlzqEw1l51WeafX/1nkVPdZwm33GT81Bn0TMVJcq",
-	"+Qpl/Q3Wqk6Fgkh2l3bu4mwrG0Lf5Y9AiM8vkGsdp50E8gvysPTgvkYmVq26ioxVUw20qc+Hny5h4+aY",
-	"5Jth99YmdMBBZ0S7jb12ZNrS+vcIEazDYtzz2WqjRR4B9rT5Q9HA1QK0y5CABHqBUKnh1pkydqXBAziv",
-	"iiXKb5Xje75XbC0jmlzxhxPuoy8QMJMNQxmynja+nJT4dcbQKvt7/jZc6OPs54ynwarJU9UIG7ZGk2qT",
-	"U8kvxRwhzjC+AT7jQlnnP2R4Jyys/z+I0zBHI9IF/Jahj4AK/hq3M27BlnGMmGACe69e1jGGt8I6G619",
-	"OKvvoYsP9g8gaI3132B6Y8Z/TaKyXM38d5kEHcYOk4hiTTWnFF9YVh8KgwkeDraL5nPnn7pW6vvY/3AQ",
-	"Vt+cv7KwO/F0Lrp72TXIaDaMSyPcwnNhitygOS5dxsZX1+StcLYNTCmNZGM25IUYzg8ZPa3m7YSd5I7i",
-	"DjwKYZ3hTtMZU8lW52cNzPJ6+f8AAAD//7f0STt5JgAA",
+	"H4sIAAAAAAAC/9w8a3MbN5J/BTW3VbFuhxTlJLtZqrbuFDvJeROvFUmurbpEF4IzTRLRDDABMJK4Lv33",
+	"q24A8x6KkrXO2t8kDh6NRr8feBclKi+UBGlNNH8XFVzzHCxo+i9Rea7kLwVfC8mtcH8CfknBJFoU+Fs0",
+	"j44mQqZwCynD70yW+RJ0FEcCP/5Wgt5GcSR5DtE8ohXiyCQbyLlbasXLzEbzozjKhRR5mdPfdlvgeCEt",
+	"rEFHd3fxCDzn4p8DMP2dgGBqxYSF3LACtIPuWc5v2dFsdrADQFpyEMjnszjK+a2HcjZ7BMxGaduH91xp",
+	"y1YCstTEDKbrKfsMAYoniQZuIT2xn40ATOs1gfVQGKuFXEd3d3fhI13qizdn52+0WAtp+lB8rdWNQbS5",
+	"AezZgmbC/PBwo4z9aV4obS8XB4xnmbqBlFnFEp5lzG6AnZy+YkoyqwpEO/6SQpGpbQ7STm5ECgz3Zpkw",
+	"dsr+IbI04To1jGtgUlnGkwQKC+kUz4l3huDBLc+LDI+zsbYw88NDXhRT/+s0UXlE9/EDyLXdRPPnX34Z",
+	"d89PA165BZ/Pqs9ca77Fry8yJeECJJd0LYVWBWgrwKFHmCLj278Tqt/1lxYyycoUXnLbphOrS4g7yH2h",
+	"ii0DaYXdslQlJeLFHLObDUi24pkBpmS2JcT5UZYvM3AY8mSAyPFALJXKgEuEwmTlGrf/g4ZVNI/+47Dm",
+	"6kN/9YeBELXIhRXXYH45x1lIHRp+K4WGNJr/5Ja6rDZRy18hsYQmAmAMT4nSpkFVuwBpEuBd3MVw8zJn",
+	"s4HLhJyL7L49HJjf4NBzsFbINe31W6ksN/tN/tGNfU/sxpGx3JZ77nnuxu59Jy+h0OpaGKHk+MXIldD5",
+	"+XudIdXbs1K2CJwItkvhZ4Dygd1suCUytsB1qm4ku1FlljINuboGdiPsRpXW/Svkmgl7zKRicEuzhWE3",
+	"WlgLcpjY3bD7gfnGLefgQOSwJayUdlC5bRkCd8w0IEIhdaxYQQIaYSEErks9zHudm2pie+jCXou1rrjI",
+	"DIibPRH9BgWFhkTplA5YgEzxSDmtL5Q0KIKBJxt/9mFUum+v0gFN4EFEAe8WhWMG16C3jCdIHAGneJmM",
+	"s4oOIWUpt3zJDTh0qhzvsi3VH0aFb9++etkW40eznhy/G8D2aQBKyPXf1HJA3fHkaq1VKdP6AIjGX9WS",
+	"rZRmSlbnfMbTXMgJSuiYaeAp/XmAx2pfIbcW8sKaXSZJGMOM5RoJzyi24noa9U2IOEpQP92Htc5RSadF",
+	"zvxomHcPWaGeiMsEI+Tht3chcjCW5wWusxJSmM0TLPSrWr5KH09KV0Km/QtaEKoXeP3I90WlgFda5YxL",
+	"ZTegA0EomUBTuAjTZIIpW3i28cvxosi2FZ86CJvsalWHi9yyx7iF0Kw+HcuF1ko3tv7MtKnXWG4BaQkk",
+	"2qU/RdXXKFBTHLmtIbocULEZN/Yb3KSPIvo5mHe5MijFE5A2kHTMxIpxuZ1GA+tWx30gLb6u5t3FkYRb",
+	"e+I2e28qqnVzhxB+K6GEdMFuuLCGRAGe90bpK9DsmTP6wj2mZQao26wWYA5ittClRMAXSBJLwGGFVgkY",
+	"A2nMFqZMEoAUV+cyZYsVFxn9o4GthORZ8+YcHFEc+TWjOKrmR3Hk5g7eYRDtj+eRskifgOc7CtLxbQM8",
+	"z4vVXcS1AG1Jr+7FN2VSE9bL+/XAiyBQ25d+qtVagyHNyRnxCbLuMSs0GCCOz7ZOpy0QZrpfLzL6aiBR",
+	"hYD0guRHw3NoyPWO6zBg1atSJ3Dx3hdpKyB4mgo8Lc9OW8A+Ri+QXdj3b4qAxSvYQsqWXoYyyXOYRgOX",
+	"Y5Xl2TieuqZwGydtLLYXi9t3UOFhHwqpDtmz0JrqvX+nLcnZ48lRcRNkQA4cVcEGGC4UZCpzn0kV1IoA",
+	"qc8JHbRbReaUEVouwjBVgGzKEa93UJAAT7e7Rce/hPH77P2QexgwlXlpN/cBuJN2l+8z21ilfSzscUt0",
+	"0JMuo9gdqV57DwS9bmrUXeLMq/s9BFowWwYs26LIBKTVniM8UPswfZGWt+b+a8VRBeeATFr8XM5mnycG",
+	"LP0Bh+7/a9C4tPttMWXfS3ReK0tvJXSDKzfcMF3KYyYk4yzVW/zP+0ggrd4yY/nWsIVnvsW4+NuN0i6p",
+	"OPz2p8YDN9RC+RBBjcUN0IE71bASt33SeglaXEPKvntxznAcEtRK3Aasgrz+HrYtxDp1j26x+3nifjYb",
+	"pW0Q5n7CYsrcAijqgu1dBxK9P34c9iQPPS9K8qJAX4OeGAw1om0l8rwkoT/1gg/d5hCb64m9x4ewnso7",
+	"8ut8vX28rv+Q0bSm07HfEk3mDbGuR0fl6Pdw0GECPVXGrjWc//hD8Lgkz6Gy54Nrv3B//OKJMivX55Jf",
+	"gRcDB3tRT4uU+xB9S6LjK7aBW55CInKesWTDNU8saJLRwSKOWWkgRaHiKBwMSWJuLWhc6f9+mk3+wier",
+	"k8m3l+++uvvDXsB98Ajm+zsgHanXcBjoNA2HoUWHFTm1GTpQeotu4qaU615h28GoWXNciDaisWdgymxA",
+	"qu7SjS7o+INKRhT6PzagnR5yI9kNryKlcaXTnTp3IxY0BLEIpp07aPqJLkS73/VehNHVBe83b+Q6o7ih",
+	"zcLS4whuS6Aegt6Udq3Q3aarDkEKH/9kr8EYvgbDvOnTDqAsYcOzVcxMmWwYN0zIa2Gd2iSGZMLSzBT0",
+	"cQhp+v991g5TOBlb8uSKWUWLFxm3K6VzP27KSHgxnoG2hhRUK2yjSus0HtfgjuDya1JZsdqepKkGY46r",
+	"6Lmg0PaWRpMhl6n12l1ym+ZQgfrZA2lHdwQeVke4CXsEGn7KS4OR82bGD8/j9DJiJVU5F87bqJJ1piyQ",
+	"AP+bJznsytZ9MUCRuHAQ6/WKJ0kO7NwtG8X3K7YW1vrnPoNEFIIoYdWmhN/cJXVvh9YTjjdN+7CqMI86",
+	"qIYi216oTpoTsuIRq92NMk1lDP5NLQfcqF/VcgA9byQ0XQbKnhvIXJakzijsFdDvRuHvBkL3nRDRLlN1",
+	"wIbogf+i1Brvth8Wdbrf6X2Q10IrSValBhdZMA8I9Jd2c0bedD9KeovKmmcMx1RmBmb1Y/at0IBq5/BV",
+	"6rK8B+RJLAFkSPIS9WVCXjl2HlHvDdWRLkcAaRhAHgpvBw1uSba8D3k3Y9l7wbAjaPymcF6ei2q0LgVw",
+	"RjNo3KDzL2ez0Y3bwerTGtgniAmT9z2C0AuPRTeIzElT8ARcNIYnGwp2+asm/6hMrsAeem9FaZaphDs9",
+	"ATI92Ae3vVDBmY/h1BTYAXuce36sbO0OoYCeeArJEC9myk6q3J37yUenSkn/DWmanN9+gyQtYGCH165q",
+	"xlcHodjNMI0IfgLjiVbGoJppVz/gNqhFuXU+8Z++iBpFN7OhjFnOb9/Qub/e2l2gLPEzQrISSPIlqcKW",
+	"MnDoq67b3eKU/V2h9FgpnUCKpgRaYWCOGR0BydpF6kLiGMVnAtKS+UEVSQwQx8w7HlO//i92o8FsVJb+",
+	"QrSEIUG4RuJ9HBLO66qf++7CM0fTLUKgwNQXtFL6kWC4091zGUpOUmGumBH/hLZS9sDFrM67uHqzR6Ll",
+	"rQG9A5AaKSUOfPAe46p4TF1dBMZbQbJNMvCqqqGJWM4lX0N6gHlFjltJLhNYsCuAonVrzRTisrSMS3OD",
+	"HibFpX6WUskJLRvcArTnhE/jL76cfc7OQV+LBNhbya+5yJAHfabqDKzeTk5WFvQiZkYx5H1ulTYs4ZKi",
+	"X3WwafqzXJjSYOwLyZgkIuOSlbLgImU8SVQpLbq5yAhrjTK0AC1UeuAPhWLRUM4Nb3xJBSOIT38Wx3QE",
+	"+M9y8cXs88UxyaYrYItUGIQ7XcRtghZZRpo+uwZngJP9yi33obpKgk9/lo3ouat4iCi6QuuSnqpuAeVg",
+	"FV/vOKMVCgYj7R2nasDl4zZUcbgxzFgofCFNGqM+aZXWqEYocspOfObATUq41sjHeGZSu8MmzcMcwROc",
+	"gRaIr/l42OyXYdb+EfX2Al609HVkWHivoPrAkXp2MoxmdcAbe3UktW+bN2HrjL8foJcN3O4LlFYZfHMr",
+	"TCtR1bDW8PtoUaPD9a7p7eBbb4E649gRdPQ7S7UqCkid0EEGdcsRR+K/WITqnNsNZPtXDrWxRnvd62y0",
+	"okEVUjooaKFzZx5xmDr7wfWgCnuKpa+wxu/YbTvkvbkPDYPGGy5Dqqy/Y1GF/XcTchECZwGQ2B/sfsy4",
+	"y+nhRY4RFFLEXvjqgCjDxd7sguqtGbwlaNiye6AtB25K/QQeiNplu14oy7OWkaR6t12Zr227lXHLFn70",
+	"6wrYxZ5UMUptdVnbh4Xk9VPh+33yD/sSh+nYwHtMeYIKnmDoPpRtGsH2ignqE3dOE/ZpyoEmCTci8g0W",
+	"GeLGt1R4UOvRNp25r8yn9IJR54KvU4bJClIgqc/9uA8UHq3ygIyj8epiHkLJKfsHRst96Dx2oT+0AouM",
+	"JxDsTHJ3j9u+MAZPEpVDwxvurNVIPtQLtlgiBFR9r8UxWseQF3ZLHRLeoqM5eXdxF+DuwOl+NC483gxS",
+	"0w8OE0kGXA+57p90/f7jKvB75Nlv5Tmt/nwNroqrjdXQLrWrRyiOmk1M+/cW+fz/q2AZ7XZ7aewpX8O9",
+	"Y7tK3vVrNbqiGtu21r3cgbJOUrHH29/Dki8nCTfAMLtXpUDfnv1g6li7QYCWGU+uJpmypZnwrNjw6LKd",
+	"HuWTf84mf7n847P/mk+qfw7+8w/RYM5/XDP0gHx1/oZ99afZEbNhDIF48aID4fPZ8y8nR7PJ0ecXR1/M",
+	"P5/NZ7P/RSArKYxybIKL7AcSifN+BuPbF+yLo+fPGX5mfn5jk7IU6c711TKDPAXLRWZ+OXX/vnT/Du/2",
+	"569mf2Z+IAsju4LELdhf4IRtypzLCXr1JIrhtsi4Yx5mCkgwu+IyZ8IwlSQUwk8qK8fDO3QispB3FhRV",
+	"7kNvbrcZbCRwnfPCxQkhSycZXEPGrnkmUge+B2CA/oU0lkIEA/h4e/aKaViBO6ZFV19QSmAlvKqo0PIg",
+	"dIxVGV5sgP3PxcUpcwNYolIYNjyEzQYhpgR53L1IU+Y519sOZIzWjccw/hh0dFauKV2LKL7HV3FnqpDT",
+	"l1V3dFsrNRqd07AWBqu6eqnBRpzuYMq+ryJyCZdKisSRT4EjG2UelEfNyvWhv40iK01lu1QH18aJQsxa",
+	"aVVa2q72WWNWFzDErFW/cEA5OwQjLzMraNtky1IwYu0aq9wtR6c8y7eaI2NjB2cUR74KLppH10dkbxcg",
+	"eSGiefT5dDb9wlWjbIjCDunoh7buZ1rDWHOr6+80bIHHXsRs0bAc8F+HCB9rrGovFrELgCnPithEQecN",
+	"8YPPJp8RenBH312hNKXbX7oWKuqtWNR9tORmuNClUPJVWl2x+UEYG8WtJuifho2GesjhSJP0XfzImaRl",
+	"HzWbGoFx5ogQW4kMrV8syQ4BUV9BM9hWHD7WjcUPMZ96KVluYCKkAWlIrTFTLh2vspxbzHUwvuZCGtex",
+	"Zypz3hEJ8578EKS/tYBsGKBHz78akAvvhnrpPAXX6VCLsVXnLpBGcpLcjoAQiAvHt6DZR+fvCVLoXtwb",
+	"mq9pwsPBuUTRaQoljdOez2cz30lqfUk81Zm6WojDX40rVqo34Vn2ZkWsUwxr4QdE8+4N4Lm1BmzP/Zzm",
+	"UVv+7pJUQidLijkY555Votw5QaFbcxRNXnn9sY+uvXz7XcbaAKCuTetZsNoOCG1eUUfzCAVdRV9OfVEN",
+	"0QFFN9dkxXq1d4Ifo0t0U5QZEO2uLdwwHoSK70pF3tVgSy1rtRY0WPDZQ5nmNc/K0OY+UMs7Z7XGQ3Vo",
+	"2O6CzaZW9OOfpDA5pkO1PjFhGpXhVN45UtY5qnQcAiNH1WDs1yrd7qCjh9FPq2n/rs07Vpdw12P1oyfb",
+	"u7nroEXlxVQURxvgqQ9Vjdc/vj37IdiYfmZNcq54Z/crGB8fm1ZlLYwzCTftVuT7GPYu7lhnh+8CLd41",
+	"DLVBmvwOBuwg0jRo+dWKphEnbNNV/FDEdYt/31cHvRdhrrAj/CMU69+BrZ462DKR7i/a0QIbpQYXeP13",
+	"IIinF5CtkPNeAvID0qFvxPsIKdHH6j0xBhte6RB7eH8RdohloofvqJ/4btTxPPMWiN2Eohrqhus+9tAs",
+	"LSpATypEVa1jcf1mQ8hSYz9ypwF8O6rlvwOLta+/Lw/Fg/uFluyPSIL3SooHTPXOFX+k4rxLqXUv4xOw",
+	"UBny3oO84/OrnaxV4kurEyVNmdPYebuSM66KCd3bMLJVz8mEHCrtoxRiXPHWvcWAA7WAzDE7pI4zrWrO",
+	"cpmjXezpigA+davHnXKHynHfP2bTh45QBZN8Tvf9uWVevcNznyOMpnp46KX5ShIpIXJUOt0mTqfQWx+m",
+	"+fQEU5IJO2UXvrG/EgbGK6DgElCZo2T03oGpW5Brpgt8RWwWGMrD4m4GX+TDEtDGewp/paenFuOP0bl0",
+	"9bFfFT/w7AbrKAkQTInDcqPUFcX6AqrqB//CqG+owNEJhzDXHd0wdSOp1JS8PsKLMDSZs0IJaSdCUhiL",
+	"GckLs8EPro7cF4+zU+VfJyR8rjqIY9eC00/B7WTOFR331v3zOZ+gKdx8B3EvS/j5h9TnLyqmqN7EeXDU",
+	"oFriUw0YOCRVoTgh6VWpJw0dzNO6y3VcGGKdn6HX9XY2W82baR3Xs9oqZqewmhduvva6+T1u/wgDXVdx",
+	"s46mU4UWUyGq+9LvFHcBzGt15UvF67EeOK0ymLJz69J93D3TQrXr/s0uemqFrTK+ZgZsJYPpUDS41L5v",
+	"CA/uCh4WTYNvWvVULfzjL9gdk3CJ/ZgaCvciaF3m7TDI+JLw6PMFXG7thuwkE+rGpxhAdYWYC4pmG/dk",
+	"IyXamgXmQSm4Jt2FwyHPgSUbSK4cXgwhAIv/Q2dqsuFyjTuGreftKnzUENwwYZkwcbf+F7HqcY16QqsM",
+	"T0Zttfh5GHBXA7+ECu4x8d1o0f40hXj/RdDfJajRb4UfNDndVQbXGp84EtIUkHT8HmQh9wpbwbUVPAvc",
+	"8zHmXhqoGZKNTyCh95DPPzpbs4VltRqCpyGrh2Vko2k0HhHBOIzLLXPvJwZaZEKuNDdWl4ktNaAcW9bv",
+	"cfq39nTpjV1U2z6nRF0sXuTV9+ZsbLh1/wrfY6lWqyl71XIgeeYE84bsbnoqayAgRBLdv6alg2MppLHA",
+	"U0QVWSE42ulXJaFjaz7Usjz9dxJMv5+d143bPN7c64VOPv00kULu+mDiZVJXmg0GkE5BY70BurQUICKj",
+	"oXLMkTvxZszW0AP9VrFr0GJFL5I1H1pt3SMybfUCJnv28usgioC6c+JWx334DWwyPZgyF5R2vQiQDj0O",
+	"IOjlRzRfyDBLwdKLB94C1Y1gcgiDORTcz9Tn1WM1n3R4aeiBp3t43BPRR+hsETkX/bM8lsnm4U3e+9R2",
+	"6zk/souc5d5+oIO6Q+njY57tXgQyNPQsYHjF2wVrcFu6l1CZ2Yp2TUyisL2v/bqxsKYTwnXq3Dl9mHXJ",
+	"ew/2HQc131iKvILw7uWoym/6LX9F5lkExWzoXkKZys1GJJvu8v44aJF4iMkaGDIcmhexBmtquwFB8SYB",
+	"BJyhTbDYL6U1XqXo35D/F1WMdF6o/8CBoKEnawY483WNdRMMhI9PgJzc/yR4i2H2Fiy4CySlFnZLSmYJ",
+	"XIN2fc2hucvMuR9+GUeu2MpppFJn0Tw65IU4xPLfy2qbnnoPz1vRQsJY/xYAQldrsxZsd5d3/z8AMeUP",
+	"B3JoAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -29,25 +29,228 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for InvitationStatus.
+const (
+	Accepted InvitationStatus = "accepted"
+	Expired  InvitationStatus = "expired"
+	Pending  InvitationStatus = "pending"
+)
+
+// Defines values for ProviderIdentityReason.
+const (
+	EmailConflict ProviderIdentityReason = "email_conflict"
+	InvalidUid    ProviderIdentityReason = "invalid_uid"
+	MissingEmail  ProviderIdentityReason = "missing_email"
+	QuotaExceeded ProviderIdentityReason = "quota_exceeded"
+)
+
+// AcceptInvitation defines model for AcceptInvitation.
+type AcceptInvitation struct {
+	Token string `json:"token"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt *externalRef2.Timestamp `json:"expiresAt,omitempty"`
+
+	// Id RFC 4122 UUID string
+	Id externalRef2.UUID `json:"id"`
+
+	// LastUsedAt ISO 8601 timestamp in UTC
+	LastUsedAt *externalRef2.Timestamp `json:"lastUsedAt,omitempty"`
+	Name       string                  `json:"name"`
+
+	// Scopes Permissions the key may use, such as users:read.
+	Scopes []string `json:"scopes"`
+}
+
+// CreateApiKey defines model for CreateApiKey.
+type CreateApiKey struct {
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt *externalRef2.Timestamp `json:"expiresAt,omitempty"`
+	Name      string                  `json:"name"`
+	Scopes    []string                `json:"scopes"`
+}
+
+// CreateRole defines model for CreateRole.
+type CreateRole struct {
+	Description *string  `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// CreateServiceAccount defines model for CreateServiceAccount.
+type CreateServiceAccount struct {
+	Description *string  `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Scopes      []string `json:"scopes"`
+}
+
 // CreateUser defines model for CreateUser.
 type CreateUser struct {
+	// Attributes Tenant-defined profile fields, validated against the schema set with PUT /admin/users:attributes-schema. Writing attributes replaces the stored object.
+	Attributes *UserAttributes `json:"attributes,omitempty"`
+
+	// Email Email address per RFC 5322 (simplified)
+	Email    externalRef2.Email `json:"email"`
+	FullName string             `json:"fullName"`
+}
+
+// CreatedApiKey defines model for CreatedApiKey.
+type CreatedApiKey struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt *externalRef2.Timestamp `json:"expiresAt,omitempty"`
+
+	// Id RFC 4122 UUID string
+	Id externalRef2.UUID `json:"id"`
+
+	// Key The API key. It cannot be retrieved again.
+	Key string `json:"key"`
+
+	// LastUsedAt ISO 8601 timestamp in UTC
+	LastUsedAt *externalRef2.Timestamp `json:"lastUsedAt,omitempty"`
+	Name       string                  `json:"name"`
+
+	// Scopes Permissions the key may use, such as users:read.
+	Scopes []string `json:"scopes"`
+}
+
+// CreatedServiceAccount defines model for CreatedServiceAccount.
+type CreatedServiceAccount struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// CreatedBy RFC 4122 UUID string
+	CreatedBy   *externalRef2.UUID `json:"createdBy,omitempty"`
+	Description *string            `json:"description,omitempty"`
+
+	// Id RFC 4122 UUID string
+	Id externalRef2.UUID `json:"id"`
+
+	// Key The service account key to exchange at POST /auth/token. It cannot be retrieved again.
+	Key string `json:"key"`
+
+	// LastUsedAt ISO 8601 timestamp in UTC
+	LastUsedAt *externalRef2.Timestamp `json:"lastUsedAt,omitempty"`
+	Name       string                  `json:"name"`
+
+	// Scopes Permissions the account holds, such as entities:read.
+	Scopes []string `json:"scopes"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	// AcceptedAt ISO 8601 timestamp in UTC
+	AcceptedAt *externalRef2.Timestamp `json:"acceptedAt,omitempty"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// Email Email address per RFC 5322 (simplified)
+	Email externalRef2.Email `json:"email"`
+
+	// ExpiresAt ISO 8601 timestamp in UTC
+	ExpiresAt externalRef2.Timestamp `json:"expiresAt"`
+	FullName  string                 `json:"fullName"`
+	InvitedBy *string                `json:"invitedBy,omitempty"`
+	SendCount int                    `json:"sendCount"`
+
+	// SentAt ISO 8601 timestamp in UTC
+	SentAt *externalRef2.Timestamp `json:"sentAt,omitempty"`
+	Status InvitationStatus        `json:"status"`
+
+	// UserId RFC 4122 UUID string
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+// InvitationStatus defines model for Invitation.Status.
+type InvitationStatus string
+
+// InviteUser defines model for InviteUser.
+type InviteUser struct {
 	// Email Email address per RFC 5322 (simplified)
 	Email    externalRef2.Email `json:"email"`
 	FullName string             `json:"fullName"`
 }
 
+// ProviderIdentity defines model for ProviderIdentity.
+type ProviderIdentity struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Email       *string `json:"email,omitempty"`
+
+	// Reason Why the identity was not linked: invalid_uid (the uid is not a user id), missing_email, email_conflict (another user holds the email) or quota_exceeded.
+	Reason ProviderIdentityReason `json:"reason"`
+	Uid    string                 `json:"uid"`
+}
+
+// ProviderIdentityReason Why the identity was not linked: invalid_uid (the uid is not a user id), missing_email, email_conflict (another user holds the email) or quota_exceeded.
+type ProviderIdentityReason string
+
+// Role defines model for Role.
+type Role struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt   externalRef2.Timestamp `json:"createdAt"`
+	Description *string                `json:"description,omitempty"`
+	Name        string                 `json:"name"`
+
+	// Permissions Permissions granted by the role, such as users:read. "*" grants every permission.
+	Permissions []string `json:"permissions"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
+}
+
+// ServiceAccount defines model for ServiceAccount.
+type ServiceAccount struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// CreatedBy RFC 4122 UUID string
+	CreatedBy   *externalRef2.UUID `json:"createdBy,omitempty"`
+	Description *string            `json:"description,omitempty"`
+
+	// Id RFC 4122 UUID string
+	Id externalRef2.UUID `json:"id"`
+
+	// LastUsedAt ISO 8601 timestamp in UTC
+	LastUsedAt *externalRef2.Timestamp `json:"lastUsedAt,omitempty"`
+	Name       string                  `json:"name"`
+
+	// Scopes Permissions the account holds, such as entities:read.
+	Scopes []string `json:"scopes"`
+}
+
+// SetUserAttributesSchema defines model for SetUserAttributesSchema.
+type SetUserAttributesSchema struct {
+	SchemaSlug *string `json:"schemaSlug,omitempty"`
+}
+
 // UpdateSelf defines model for UpdateSelf.
 type UpdateSelf struct {
-	FullName *string `json:"fullName,omitempty"`
+	// Attributes Tenant-defined profile fields, validated against the schema set with PUT /admin/users:attributes-schema. Writing attributes replaces the stored object.
+	Attributes *UserAttributes `json:"attributes,omitempty"`
+	FullName   *string         `json:"fullName,omitempty"`
 }
 
 // UpdateUser defines model for UpdateUser.
 type UpdateUser struct {
-	FullName *string `json:"fullName,omitempty"`
+	// Attributes Tenant-defined profile fields, validated against the schema set with PUT /admin/users:attributes-schema. Writing attributes replaces the stored object.
+	Attributes *UserAttributes `json:"attributes,omitempty"`
+	FullName   *string         `json:"fullName,omitempty"`
 }
 
 // User defines model for User.
 type User struct {
+	// Attributes Tenant-defined profile fields, validated against the schema set with PUT /admin/users:attributes-schema. Writing attributes replaces the stored object.
+	Attributes UserAttributes `json:"attributes"`
+
 	// CreatedAt ISO 8601 timestamp in UTC
 	CreatedAt externalRef2.Timestamp `json:"createdAt"`
 
@@ -58,15 +261,57 @@ type User struct {
 	// Id RFC 4122 UUID string
 	Id externalRef2.UUID `json:"id"`
 
+	// LastActiveAt ISO 8601 timestamp in UTC
+	LastActiveAt *externalRef2.Timestamp `json:"lastActiveAt,omitempty"`
+
+	// LastLoginAt ISO 8601 timestamp in UTC
+	LastLoginAt *externalRef2.Timestamp `json:"lastLoginAt,omitempty"`
+
+	// LoginCount Number of sign-in sessions seen by the API.
+	LoginCount int `json:"loginCount"`
+
 	// UpdatedAt ISO 8601 timestamp in UTC
 	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
 }
 
+// UserAttributes Tenant-defined profile fields, validated against the schema set with PUT /admin/users:attributes-schema. Writing attributes replaces the stored object.
+type UserAttributes map[string]interface{}
+
+// UserAttributesSchema defines model for UserAttributesSchema.
+type UserAttributesSchema struct {
+	SchemaSlug *string `json:"schemaSlug,omitempty"`
+
+	// SchemaVersion The active published version currently used for validation.
+	SchemaVersion *string `json:"schemaVersion,omitempty"`
+}
+
 // UserFilter defines model for UserFilter.
 type UserFilter struct {
 	Email *string `json:"email,omitempty"`
 }
 
+// UserRoles defines model for UserRoles.
+type UserRoles struct {
+	Roles []string `json:"roles"`
+}
+
+// UserSyncReport defines model for UserSyncReport.
+type UserSyncReport struct {
+	// Created Users created from provider identities.
+	Created []User `json:"created"`
+	DryRun  bool   `json:"dryRun"`
+
+	// LocalOrphans Users without a provider identity; they cannot sign in.
+	LocalOrphans []User `json:"localOrphans"`
+
+	// ProviderOrphans Provider identities that could not be linked to a user.
+	ProviderOrphans []ProviderIdentity `json:"providerOrphans"`
+	Unchanged       int                `json:"unchanged"`
+
+	// Updated Users whose email or full name was copied from the provider.
+	Updated []User `json:"updated"`
+}
+
 // UsersListParams defines parameters for UsersList.
 type UsersListParams struct {
 	// Page 1-indexed page number
@@ -80,19 +325,67 @@ type UsersListParams struct {
 
 	// Email Filter by user email (contains)
 	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// LastActiveBefore Only users last active before this instant, including users that were never active.
+	LastActiveBefore *externalRef2.Timestamp `form:"lastActiveBefore,omitempty" json:"lastActiveBefore,omitempty"`
+}
+
+// UsersSyncParams defines parameters for UsersSync.
+type UsersSyncParams struct {
+	// DryRun Report what would change without writing.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
+// RolesCreateJSONRequestBody defines body for RolesCreate for application/json ContentType.
+type RolesCreateJSONRequestBody = CreateRole
+
+// ServiceAccountsCreateJSONRequestBody defines body for ServiceAccountsCreate for application/json ContentType.
+type ServiceAccountsCreateJSONRequestBody = CreateServiceAccount
+
 // UsersCreateJSONRequestBody defines body for UsersCreate for application/json ContentType.
 type UsersCreateJSONRequestBody = CreateUser
 
 // UsersUpdateJSONRequestBody defines body for UsersUpdate for application/json ContentType.
 type UsersUpdateJSONRequestBody = UpdateUser
 
+// UsersRolesSetJSONRequestBody defines body for UsersRolesSet for application/json ContentType.
+type UsersRolesSetJSONRequestBody = UserRoles
+
+// UsersAttributesSchemaSetJSONRequestBody defines body for UsersAttributesSchemaSet for application/json ContentType.
+type UsersAttributesSchemaSetJSONRequestBody = SetUserAttributesSchema
+
+// UsersInviteJSONRequestBody defines body for UsersInvite for application/json ContentType.
+type UsersInviteJSONRequestBody = InviteUser
+
+// InvitationsAcceptJSONRequestBody defines body for InvitationsAccept for application/json ContentType.
+type InvitationsAcceptJSONRequestBody = AcceptInvitation
+
 // UsersUpdateMeJSONRequestBody defines body for UsersUpdateMe for application/json ContentType.
 type UsersUpdateMeJSONRequestBody = UpdateSelf
 
+// UsersMeApiKeysCreateJSONRequestBody defines body for UsersMeApiKeysCreate for application/json ContentType.
+type UsersMeApiKeysCreateJSONRequestBody = CreateApiKey
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List roles
+	// (GET /admin/roles)
+	RolesList(w http.ResponseWriter, r *http.Request)
+	// Create role
+	// (POST /admin/roles)
+	RolesCreate(w http.ResponseWriter, r *http.Request)
+	// Delete role
+	// (DELETE /admin/roles/{roleName})
+	RolesDelete(w http.ResponseWriter, r *http.Request, roleName string)
+	// List service accounts
+	// (GET /admin/service-accounts)
+	ServiceAccountsList(w http.ResponseWriter, r *http.Request)
+	// Create a service account
+	// (POST /admin/service-accounts)
+	ServiceAccountsCreate(w http.ResponseWriter, r *http.Request)
+	// Revoke a service account
+	// (DELETE /admin/service-accounts/{serviceAccountId})
+	ServiceAccountsRevoke(w http.ResponseWriter, r *http.Request, serviceAccountId externalRef2.UUID)
 	// List users
 	// (GET /admin/users)
 	UsersList(w http.ResponseWriter, r *http.Request, params UsersListParams)
@@ -108,18 +401,93 @@ type ServerInterface interface {
 	// Update user
 	// (PATCH /admin/users/{userId})
 	UsersUpdate(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID)
+	// Get the invitation of a user
+	// (GET /admin/users/{userId}/invitation)
+	UsersInvitationGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID)
+	// Expire invitation
+	// (POST /admin/users/{userId}/invitation:expire)
+	UsersInvitationExpire(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID)
+	// Resend invitation
+	// (POST /admin/users/{userId}/invitation:resend)
+	UsersInvitationResend(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID)
+	// List the roles assigned to a user
+	// (GET /admin/users/{userId}/roles)
+	UsersRolesGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID)
+	// Replace the roles assigned to a user
+	// (PUT /admin/users/{userId}/roles)
+	UsersRolesSet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID)
+	// Get the user attributes schema
+	// (GET /admin/users:attributes-schema)
+	UsersAttributesSchemaGet(w http.ResponseWriter, r *http.Request)
+	// Set the user attributes schema
+	// (PUT /admin/users:attributes-schema)
+	UsersAttributesSchemaSet(w http.ResponseWriter, r *http.Request)
+	// Invite user
+	// (POST /admin/users:invite)
+	UsersInvite(w http.ResponseWriter, r *http.Request)
+	// Sync users from the auth provider
+	// (POST /admin/users:sync)
+	UsersSync(w http.ResponseWriter, r *http.Request, params UsersSyncParams)
+	// Accept an invitation
+	// (POST /invitations:accept)
+	InvitationsAccept(w http.ResponseWriter, r *http.Request)
 	// Get the current authenticated user
 	// (GET /users/me)
 	UsersMe(w http.ResponseWriter, r *http.Request)
 	// Update the current authenticated user profile
 	// (PATCH /users/me)
 	UsersUpdateMe(w http.ResponseWriter, r *http.Request)
+	// List my API keys
+	// (GET /users/me/api-keys)
+	UsersMeApiKeysList(w http.ResponseWriter, r *http.Request)
+	// Create an API key
+	// (POST /users/me/api-keys)
+	UsersMeApiKeysCreate(w http.ResponseWriter, r *http.Request)
+	// Revoke an API key
+	// (DELETE /users/me/api-keys/{keyId})
+	UsersMeApiKeysRevoke(w http.ResponseWriter, r *http.Request, keyId externalRef2.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List roles
+// (GET /admin/roles)
+func (_ Unimplemented) RolesList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create role
+// (POST /admin/roles)
+func (_ Unimplemented) RolesCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete role
+// (DELETE /admin/roles/{roleName})
+func (_ Unimplemented) RolesDelete(w http.ResponseWriter, r *http.Request, roleName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List service accounts
+// (GET /admin/service-accounts)
+func (_ Unimplemented) ServiceAccountsList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a service account
+// (POST /admin/service-accounts)
+func (_ Unimplemented) ServiceAccountsCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a service account
+// (DELETE /admin/service-accounts/{serviceAccountId})
+func (_ Unimplemented) ServiceAccountsRevoke(w http.ResponseWriter, r *http.Request, serviceAccountId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List users
 // (GET /admin/users)
 func (_ Unimplemented) UsersList(w http.ResponseWriter, r *http.Request, params UsersListParams) {
@@ -150,6 +518,66 @@ func (_ Unimplemented) UsersUpdate(w http.ResponseWriter, r *http.Request, userI
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the invitation of a user
+// (GET /admin/users/{userId}/invitation)
+func (_ Unimplemented) UsersInvitationGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Expire invitation
+// (POST /admin/users/{userId}/invitation:expire)
+func (_ Unimplemented) UsersInvitationExpire(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resend invitation
+// (POST /admin/users/{userId}/invitation:resend)
+func (_ Unimplemented) UsersInvitationResend(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the roles assigned to a user
+// (GET /admin/users/{userId}/roles)
+func (_ Unimplemented) UsersRolesGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the roles assigned to a user
+// (PUT /admin/users/{userId}/roles)
+func (_ Unimplemented) UsersRolesSet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the user attributes schema
+// (GET /admin/users:attributes-schema)
+func (_ Unimplemented) UsersAttributesSchemaGet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the user attributes schema
+// (PUT /admin/users:attributes-schema)
+func (_ Unimplemented) UsersAttributesSchemaSet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Invite user
+// (POST /admin/users:invite)
+func (_ Unimplemented) UsersInvite(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sync users from the auth provider
+// (POST /admin/users:sync)
+func (_ Unimplemented) UsersSync(w http.ResponseWriter, r *http.Request, params UsersSyncParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Accept an invitation
+// (POST /invitations:accept)
+func (_ Unimplemented) InvitationsAccept(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the current authenticated user
 // (GET /users/me)
 func (_ Unimplemented) UsersMe(w http.ResponseWriter, r *http.Request) {
@@ -162,6 +590,24 @@ func (_ Unimplemented) UsersUpdateMe(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List my API keys
+// (GET /users/me/api-keys)
+func (_ Unimplemented) UsersMeApiKeysList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an API key
+// (POST /users/me/api-keys)
+func (_ Unimplemented) UsersMeApiKeysCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an API key
+// (DELETE /users/me/api-keys/{keyId})
+func (_ Unimplemented) UsersMeApiKeysRevoke(w http.ResponseWriter, r *http.Request, keyId externalRef2.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -171,54 +617,17 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// UsersList operation middleware
-func (siw *ServerInterfaceWrapper) UsersList(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// RolesList operation middleware
+func (siw *ServerInterfaceWrapper) RolesList(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"roles:manage"})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UsersListParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "pageSize" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "sort" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "email" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "email", r.URL.Query(), &params.Email)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "email", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersList(w, r, params)
+		siw.Handler.RolesList(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -228,17 +637,17 @@ func (siw *ServerInterfaceWrapper) UsersList(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UsersCreate operation middleware
-func (siw *ServerInterfaceWrapper) UsersCreate(w http.ResponseWriter, r *http.Request) {
+// RolesCreate operation middleware
+func (siw *ServerInterfaceWrapper) RolesCreate(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"roles:manage"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersCreate(w, r)
+		siw.Handler.RolesCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -248,28 +657,28 @@ func (siw *ServerInterfaceWrapper) UsersCreate(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UsersDelete operation middleware
-func (siw *ServerInterfaceWrapper) UsersDelete(w http.ResponseWriter, r *http.Request) {
+// RolesDelete operation middleware
+func (siw *ServerInterfaceWrapper) RolesDelete(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId externalRef2.UUID
+	// ------------- Path parameter "roleName" -------------
+	var roleName string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "roleName", chi.URLParam(r, "roleName"), &roleName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleName", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"roles:manage"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersDelete(w, r, userId)
+		siw.Handler.RolesDelete(w, r, roleName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -279,28 +688,37 @@ func (siw *ServerInterfaceWrapper) UsersDelete(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UsersGet operation middleware
-func (siw *ServerInterfaceWrapper) UsersGet(w http.ResponseWriter, r *http.Request) {
+// ServiceAccountsList operation middleware
+func (siw *ServerInterfaceWrapper) ServiceAccountsList(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "userId" -------------
-	var userId externalRef2.UUID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"service-accounts:manage"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ServiceAccountsList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// ServiceAccountsCreate operation middleware
+func (siw *ServerInterfaceWrapper) ServiceAccountsCreate(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"service-accounts:manage"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersGet(w, r, userId)
+		siw.Handler.ServiceAccountsCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -310,28 +728,28 @@ func (siw *ServerInterfaceWrapper) UsersGet(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// UsersUpdate operation middleware
-func (siw *ServerInterfaceWrapper) UsersUpdate(w http.ResponseWriter, r *http.Request) {
+// ServiceAccountsRevoke operation middleware
+func (siw *ServerInterfaceWrapper) ServiceAccountsRevoke(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId externalRef2.UUID
+	// ------------- Path parameter "serviceAccountId" -------------
+	var serviceAccountId externalRef2.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceAccountId", chi.URLParam(r, "serviceAccountId"), &serviceAccountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceAccountId", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"service-accounts:manage"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersUpdate(w, r, userId)
+		siw.Handler.ServiceAccountsRevoke(w, r, serviceAccountId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -341,17 +759,62 @@ func (siw *ServerInterfaceWrapper) UsersUpdate(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UsersMe operation middleware
-func (siw *ServerInterfaceWrapper) UsersMe(w http.ResponseWriter, r *http.Request) {
-
+// UsersList operation middleware
+func (siw *ServerInterfaceWrapper) UsersList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:read"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", r.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "email", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lastActiveBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastActiveBefore", r.URL.Query(), &params.LastActiveBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lastActiveBefore", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersMe(w, r)
+		siw.Handler.UsersList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -361,17 +824,17 @@ func (siw *ServerInterfaceWrapper) UsersMe(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UsersUpdateMe operation middleware
-func (siw *ServerInterfaceWrapper) UsersUpdateMe(w http.ResponseWriter, r *http.Request) {
+// UsersCreate operation middleware
+func (siw *ServerInterfaceWrapper) UsersCreate(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersUpdateMe(w, r)
+		siw.Handler.UsersCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -381,433 +844,2025 @@ func (siw *ServerInterfaceWrapper) UsersUpdateMe(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UsersDelete operation middleware
+func (siw *ServerInterfaceWrapper) UsersDelete(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersDelete(w, r, userId)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// UsersGet operation middleware
+func (siw *ServerInterfaceWrapper) UsersGet(w http.ResponseWriter, r *http.Request) {
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	ctx := r.Context()
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:read"})
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	r = r.WithContext(ctx)
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersGet(w, r, userId)
+	}))
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// UsersUpdate operation middleware
+func (siw *ServerInterfaceWrapper) UsersUpdate(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	var err error
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/admin/users", wrapper.UsersList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/admin/users", wrapper.UsersCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/admin/users/{userId}", wrapper.UsersDelete)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/admin/users/{userId}", wrapper.UsersGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/admin/users/{userId}", wrapper.UsersUpdate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/me", wrapper.UsersMe)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/users/me", wrapper.UsersUpdateMe)
-	})
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
 
-	return r
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-type UsersListRequestObject struct {
-	Params UsersListParams
-}
+	ctx := r.Context()
 
-type UsersListResponseObject interface {
-	VisitUsersListResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
 
-type UsersList200JSONResponse struct {
-	Items      []User `json:"items"`
-	Page       int    `json:"page"`
-	PageSize   int    `json:"pageSize"`
-	TotalItems int    `json:"totalItems"`
-	TotalPages int    `json:"totalPages"`
-}
+	r = r.WithContext(ctx)
 
-func (response UsersList200JSONResponse) VisitUsersListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersUpdate(w, r, userId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UsersListdefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
+	handler.ServeHTTP(w, r)
 }
 
-func (response UsersListdefaultApplicationProblemPlusJSONResponse) VisitUsersListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+// UsersInvitationGet operation middleware
+func (siw *ServerInterfaceWrapper) UsersInvitationGet(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	var err error
 
-type UsersCreateRequestObject struct {
-	Body *UsersCreateJSONRequestBody
-}
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersInvitationGet(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersInvitationExpire operation middleware
+func (siw *ServerInterfaceWrapper) UsersInvitationExpire(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersInvitationExpire(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersInvitationResend operation middleware
+func (siw *ServerInterfaceWrapper) UsersInvitationResend(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersInvitationResend(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersRolesGet operation middleware
+func (siw *ServerInterfaceWrapper) UsersRolesGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"roles:manage"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersRolesGet(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersRolesSet operation middleware
+func (siw *ServerInterfaceWrapper) UsersRolesSet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"roles:manage"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersRolesSet(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersAttributesSchemaGet operation middleware
+func (siw *ServerInterfaceWrapper) UsersAttributesSchemaGet(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersAttributesSchemaGet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersAttributesSchemaSet operation middleware
+func (siw *ServerInterfaceWrapper) UsersAttributesSchemaSet(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersAttributesSchemaSet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersInvite operation middleware
+func (siw *ServerInterfaceWrapper) UsersInvite(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersInvite(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersSync operation middleware
+func (siw *ServerInterfaceWrapper) UsersSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"users:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersSyncParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersSync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InvitationsAccept operation middleware
+func (siw *ServerInterfaceWrapper) InvitationsAccept(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InvitationsAccept(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersMe operation middleware
+func (siw *ServerInterfaceWrapper) UsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersUpdateMe operation middleware
+func (siw *ServerInterfaceWrapper) UsersUpdateMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersUpdateMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersMeApiKeysList operation middleware
+func (siw *ServerInterfaceWrapper) UsersMeApiKeysList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersMeApiKeysList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersMeApiKeysCreate operation middleware
+func (siw *ServerInterfaceWrapper) UsersMeApiKeysCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersMeApiKeysCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersMeApiKeysRevoke operation middleware
+func (siw *ServerInterfaceWrapper) UsersMeApiKeysRevoke(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId externalRef2.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", chi.URLParam(r, "keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersMeApiKeysRevoke(w, r, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/roles", wrapper.RolesList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/roles", wrapper.RolesCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/roles/{roleName}", wrapper.RolesDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/service-accounts", wrapper.ServiceAccountsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/service-accounts", wrapper.ServiceAccountsCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/service-accounts/{serviceAccountId}", wrapper.ServiceAccountsRevoke)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users", wrapper.UsersList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users", wrapper.UsersCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{userId}", wrapper.UsersDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{userId}", wrapper.UsersGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/admin/users/{userId}", wrapper.UsersUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{userId}/invitation", wrapper.UsersInvitationGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userId}/invitation:expire", wrapper.UsersInvitationExpire)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userId}/invitation:resend", wrapper.UsersInvitationResend)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{userId}/roles", wrapper.UsersRolesGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/users/{userId}/roles", wrapper.UsersRolesSet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users:attributes-schema", wrapper.UsersAttributesSchemaGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/users:attributes-schema", wrapper.UsersAttributesSchemaSet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users:invite", wrapper.UsersInvite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users:sync", wrapper.UsersSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations:accept", wrapper.InvitationsAccept)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me", wrapper.UsersMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/users/me", wrapper.UsersUpdateMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me/api-keys", wrapper.UsersMeApiKeysList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/me/api-keys", wrapper.UsersMeApiKeysCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/me/api-keys/{keyId}", wrapper.UsersMeApiKeysRevoke)
+	})
+
+	return r
+}
+
+type RolesListRequestObject struct {
+}
+
+type RolesListResponseObject interface {
+	VisitRolesListResponse(w http.ResponseWriter) error
+}
+
+type RolesList200JSONResponse struct {
+	Items []Role `json:"items"`
+}
+
+func (response RolesList200JSONResponse) VisitRolesListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RolesListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response RolesListdefaultApplicationProblemPlusJSONResponse) VisitRolesListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RolesCreateRequestObject struct {
+	Body *RolesCreateJSONRequestBody
+}
+
+type RolesCreateResponseObject interface {
+	VisitRolesCreateResponse(w http.ResponseWriter) error
+}
+
+type RolesCreate201ResponseHeaders struct {
+	Location string
+}
+
+type RolesCreate201JSONResponse struct {
+	Body    Role
+	Headers RolesCreate201ResponseHeaders
+}
+
+func (response RolesCreate201JSONResponse) VisitRolesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RolesCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response RolesCreatedefaultApplicationProblemPlusJSONResponse) VisitRolesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RolesDeleteRequestObject struct {
+	RoleName string `json:"roleName"`
+}
+
+type RolesDeleteResponseObject interface {
+	VisitRolesDeleteResponse(w http.ResponseWriter) error
+}
+
+type RolesDelete204Response struct {
+}
+
+func (response RolesDelete204Response) VisitRolesDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RolesDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response RolesDeletedefaultApplicationProblemPlusJSONResponse) VisitRolesDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ServiceAccountsListRequestObject struct {
+}
+
+type ServiceAccountsListResponseObject interface {
+	VisitServiceAccountsListResponse(w http.ResponseWriter) error
+}
+
+type ServiceAccountsList200JSONResponse struct {
+	Items []ServiceAccount `json:"items"`
+}
+
+func (response ServiceAccountsList200JSONResponse) VisitServiceAccountsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServiceAccountsListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response ServiceAccountsListdefaultApplicationProblemPlusJSONResponse) VisitServiceAccountsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ServiceAccountsCreateRequestObject struct {
+	Body *ServiceAccountsCreateJSONRequestBody
+}
+
+type ServiceAccountsCreateResponseObject interface {
+	VisitServiceAccountsCreateResponse(w http.ResponseWriter) error
+}
+
+type ServiceAccountsCreate201JSONResponse CreatedServiceAccount
+
+func (response ServiceAccountsCreate201JSONResponse) VisitServiceAccountsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServiceAccountsCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response ServiceAccountsCreatedefaultApplicationProblemPlusJSONResponse) VisitServiceAccountsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ServiceAccountsRevokeRequestObject struct {
+	ServiceAccountId externalRef2.UUID `json:"serviceAccountId"`
+}
+
+type ServiceAccountsRevokeResponseObject interface {
+	VisitServiceAccountsRevokeResponse(w http.ResponseWriter) error
+}
+
+type ServiceAccountsRevoke204Response struct {
+}
+
+func (response ServiceAccountsRevoke204Response) VisitServiceAccountsRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ServiceAccountsRevokedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response ServiceAccountsRevokedefaultApplicationProblemPlusJSONResponse) VisitServiceAccountsRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersListRequestObject struct {
+	Params UsersListParams
+}
+
+type UsersListResponseObject interface {
+	VisitUsersListResponse(w http.ResponseWriter) error
+}
+
+type UsersList200JSONResponse struct {
+	Items      []User `json:"items"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"pageSize"`
+	TotalItems int    `json:"totalItems"`
+	TotalPages int    `json:"totalPages"`
+}
+
+func (response UsersList200JSONResponse) VisitUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersListdefaultApplicationProblemPlusJSONResponse) VisitUsersListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersCreateRequestObject struct {
+	Body *UsersCreateJSONRequestBody
+}
 
 type UsersCreateResponseObject interface {
 	VisitUsersCreateResponse(w http.ResponseWriter) error
 }
 
-type UsersCreate201ResponseHeaders struct {
-	Location string
-}
+type UsersCreate201ResponseHeaders struct {
+	Location string
+}
+
+type UsersCreate201JSONResponse struct {
+	Body    User
+	Headers UsersCreate201ResponseHeaders
+}
+
+func (response UsersCreate201JSONResponse) VisitUsersCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersCreatedefaultApplicationProblemPlusJSONResponse) VisitUsersCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersDeleteRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+type UsersDeleteResponseObject interface {
+	VisitUsersDeleteResponse(w http.ResponseWriter) error
+}
+
+type UsersDelete204Response struct {
+}
+
+func (response UsersDelete204Response) VisitUsersDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UsersDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersDeletedefaultApplicationProblemPlusJSONResponse) VisitUsersDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersGetRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+type UsersGetResponseObject interface {
+	VisitUsersGetResponse(w http.ResponseWriter) error
+}
+
+type UsersGet200JSONResponse User
+
+func (response UsersGet200JSONResponse) VisitUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersGetdefaultApplicationProblemPlusJSONResponse) VisitUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersUpdateRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+	Body   *UsersUpdateJSONRequestBody
+}
+
+type UsersUpdateResponseObject interface {
+	VisitUsersUpdateResponse(w http.ResponseWriter) error
+}
+
+type UsersUpdate200JSONResponse User
+
+func (response UsersUpdate200JSONResponse) VisitUsersUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersUpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersUpdatedefaultApplicationProblemPlusJSONResponse) VisitUsersUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersInvitationGetRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+type UsersInvitationGetResponseObject interface {
+	VisitUsersInvitationGetResponse(w http.ResponseWriter) error
+}
+
+type UsersInvitationGet200JSONResponse Invitation
+
+func (response UsersInvitationGet200JSONResponse) VisitUsersInvitationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersInvitationGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersInvitationGetdefaultApplicationProblemPlusJSONResponse) VisitUsersInvitationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersInvitationExpireRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+type UsersInvitationExpireResponseObject interface {
+	VisitUsersInvitationExpireResponse(w http.ResponseWriter) error
+}
+
+type UsersInvitationExpire200JSONResponse Invitation
+
+func (response UsersInvitationExpire200JSONResponse) VisitUsersInvitationExpireResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersInvitationExpiredefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersInvitationExpiredefaultApplicationProblemPlusJSONResponse) VisitUsersInvitationExpireResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersInvitationResendRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+type UsersInvitationResendResponseObject interface {
+	VisitUsersInvitationResendResponse(w http.ResponseWriter) error
+}
+
+type UsersInvitationResend200JSONResponse Invitation
+
+func (response UsersInvitationResend200JSONResponse) VisitUsersInvitationResendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersInvitationResenddefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersInvitationResenddefaultApplicationProblemPlusJSONResponse) VisitUsersInvitationResendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersRolesGetRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+}
+
+type UsersRolesGetResponseObject interface {
+	VisitUsersRolesGetResponse(w http.ResponseWriter) error
+}
+
+type UsersRolesGet200JSONResponse UserRoles
+
+func (response UsersRolesGet200JSONResponse) VisitUsersRolesGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersRolesGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersRolesGetdefaultApplicationProblemPlusJSONResponse) VisitUsersRolesGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersRolesSetRequestObject struct {
+	UserId externalRef2.UUID `json:"userId"`
+	Body   *UsersRolesSetJSONRequestBody
+}
+
+type UsersRolesSetResponseObject interface {
+	VisitUsersRolesSetResponse(w http.ResponseWriter) error
+}
+
+type UsersRolesSet200JSONResponse UserRoles
+
+func (response UsersRolesSet200JSONResponse) VisitUsersRolesSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersRolesSetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersRolesSetdefaultApplicationProblemPlusJSONResponse) VisitUsersRolesSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersAttributesSchemaGetRequestObject struct {
+}
+
+type UsersAttributesSchemaGetResponseObject interface {
+	VisitUsersAttributesSchemaGetResponse(w http.ResponseWriter) error
+}
+
+type UsersAttributesSchemaGet200JSONResponse UserAttributesSchema
+
+func (response UsersAttributesSchemaGet200JSONResponse) VisitUsersAttributesSchemaGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersAttributesSchemaGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersAttributesSchemaGetdefaultApplicationProblemPlusJSONResponse) VisitUsersAttributesSchemaGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersAttributesSchemaSetRequestObject struct {
+	Body *UsersAttributesSchemaSetJSONRequestBody
+}
+
+type UsersAttributesSchemaSetResponseObject interface {
+	VisitUsersAttributesSchemaSetResponse(w http.ResponseWriter) error
+}
+
+type UsersAttributesSchemaSet200JSONResponse UserAttributesSchema
+
+func (response UsersAttributesSchemaSet200JSONResponse) VisitUsersAttributesSchemaSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersAttributesSchemaSetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersAttributesSchemaSetdefaultApplicationProblemPlusJSONResponse) VisitUsersAttributesSchemaSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersInviteRequestObject struct {
+	Body *UsersInviteJSONRequestBody
+}
+
+type UsersInviteResponseObject interface {
+	VisitUsersInviteResponse(w http.ResponseWriter) error
+}
+
+type UsersInvite201ResponseHeaders struct {
+	Location string
+}
+
+type UsersInvite201JSONResponse struct {
+	Body    Invitation
+	Headers UsersInvite201ResponseHeaders
+}
+
+func (response UsersInvite201JSONResponse) VisitUsersInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersInvitedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersInvitedefaultApplicationProblemPlusJSONResponse) VisitUsersInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersSyncRequestObject struct {
+	Params UsersSyncParams
+}
+
+type UsersSyncResponseObject interface {
+	VisitUsersSyncResponse(w http.ResponseWriter) error
+}
+
+type UsersSync200JSONResponse UserSyncReport
+
+func (response UsersSync200JSONResponse) VisitUsersSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersSyncdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersSyncdefaultApplicationProblemPlusJSONResponse) VisitUsersSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type InvitationsAcceptRequestObject struct {
+	Body *InvitationsAcceptJSONRequestBody
+}
+
+type InvitationsAcceptResponseObject interface {
+	VisitInvitationsAcceptResponse(w http.ResponseWriter) error
+}
+
+type InvitationsAccept200JSONResponse User
+
+func (response InvitationsAccept200JSONResponse) VisitInvitationsAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InvitationsAcceptdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response InvitationsAcceptdefaultApplicationProblemPlusJSONResponse) VisitInvitationsAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersMeRequestObject struct {
+}
+
+type UsersMeResponseObject interface {
+	VisitUsersMeResponse(w http.ResponseWriter) error
+}
+
+type UsersMe200JSONResponse User
+
+func (response UsersMe200JSONResponse) VisitUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersMedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersMedefaultApplicationProblemPlusJSONResponse) VisitUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersUpdateMeRequestObject struct {
+	Body *UsersUpdateMeJSONRequestBody
+}
+
+type UsersUpdateMeResponseObject interface {
+	VisitUsersUpdateMeResponse(w http.ResponseWriter) error
+}
+
+type UsersUpdateMe200JSONResponse User
+
+func (response UsersUpdateMe200JSONResponse) VisitUsersUpdateMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersUpdateMedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersUpdateMedefaultApplicationProblemPlusJSONResponse) VisitUsersUpdateMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersMeApiKeysListRequestObject struct {
+}
+
+type UsersMeApiKeysListResponseObject interface {
+	VisitUsersMeApiKeysListResponse(w http.ResponseWriter) error
+}
+
+type UsersMeApiKeysList200JSONResponse struct {
+	Items []ApiKey `json:"items"`
+}
+
+func (response UsersMeApiKeysList200JSONResponse) VisitUsersMeApiKeysListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersMeApiKeysListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersMeApiKeysListdefaultApplicationProblemPlusJSONResponse) VisitUsersMeApiKeysListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersMeApiKeysCreateRequestObject struct {
+	Body *UsersMeApiKeysCreateJSONRequestBody
+}
+
+type UsersMeApiKeysCreateResponseObject interface {
+	VisitUsersMeApiKeysCreateResponse(w http.ResponseWriter) error
+}
+
+type UsersMeApiKeysCreate201JSONResponse CreatedApiKey
+
+func (response UsersMeApiKeysCreate201JSONResponse) VisitUsersMeApiKeysCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersMeApiKeysCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersMeApiKeysCreatedefaultApplicationProblemPlusJSONResponse) VisitUsersMeApiKeysCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersMeApiKeysRevokeRequestObject struct {
+	KeyId externalRef2.UUID `json:"keyId"`
+}
+
+type UsersMeApiKeysRevokeResponseObject interface {
+	VisitUsersMeApiKeysRevokeResponse(w http.ResponseWriter) error
+}
+
+type UsersMeApiKeysRevoke204Response struct {
+}
+
+func (response UsersMeApiKeysRevoke204Response) VisitUsersMeApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UsersMeApiKeysRevokedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response UsersMeApiKeysRevokedefaultApplicationProblemPlusJSONResponse) VisitUsersMeApiKeysRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List roles
+	// (GET /admin/roles)
+	RolesList(ctx context.Context, request RolesListRequestObject) (RolesListResponseObject, error)
+	// Create role
+	// (POST /admin/roles)
+	RolesCreate(ctx context.Context, request RolesCreateRequestObject) (RolesCreateResponseObject, error)
+	// Delete role
+	// (DELETE /admin/roles/{roleName})
+	RolesDelete(ctx context.Context, request RolesDeleteRequestObject) (RolesDeleteResponseObject, error)
+	// List service accounts
+	// (GET /admin/service-accounts)
+	ServiceAccountsList(ctx context.Context, request ServiceAccountsListRequestObject) (ServiceAccountsListResponseObject, error)
+	// Create a service account
+	// (POST /admin/service-accounts)
+	ServiceAccountsCreate(ctx context.Context, request ServiceAccountsCreateRequestObject) (ServiceAccountsCreateResponseObject, error)
+	// Revoke a service account
+	// (DELETE /admin/service-accounts/{serviceAccountId})
+	ServiceAccountsRevoke(ctx context.Context, request ServiceAccountsRevokeRequestObject) (ServiceAccountsRevokeResponseObject, error)
+	// List users
+	// (GET /admin/users)
+	UsersList(ctx context.Context, request UsersListRequestObject) (UsersListResponseObject, error)
+	// Create user
+	// (POST /admin/users)
+	UsersCreate(ctx context.Context, request UsersCreateRequestObject) (UsersCreateResponseObject, error)
+	// Delete user
+	// (DELETE /admin/users/{userId})
+	UsersDelete(ctx context.Context, request UsersDeleteRequestObject) (UsersDeleteResponseObject, error)
+	// Get user by id
+	// (GET /admin/users/{userId})
+	UsersGet(ctx context.Context, request UsersGetRequestObject) (UsersGetResponseObject, error)
+	// Update user
+	// (PATCH /admin/users/{userId})
+	UsersUpdate(ctx context.Context, request UsersUpdateRequestObject) (UsersUpdateResponseObject, error)
+	// Get the invitation of a user
+	// (GET /admin/users/{userId}/invitation)
+	UsersInvitationGet(ctx context.Context, request UsersInvitationGetRequestObject) (UsersInvitationGetResponseObject, error)
+	// Expire invitation
+	// (POST /admin/users/{userId}/invitation:expire)
+	UsersInvitationExpire(ctx context.Context, request UsersInvitationExpireRequestObject) (UsersInvitationExpireResponseObject, error)
+	// Resend invitation
+	// (POST /admin/users/{userId}/invitation:resend)
+	UsersInvitationResend(ctx context.Context, request UsersInvitationResendRequestObject) (UsersInvitationResendResponseObject, error)
+	// List the roles assigned to a user
+	// (GET /admin/users/{userId}/roles)
+	UsersRolesGet(ctx context.Context, request UsersRolesGetRequestObject) (UsersRolesGetResponseObject, error)
+	// Replace the roles assigned to a user
+	// (PUT /admin/users/{userId}/roles)
+	UsersRolesSet(ctx context.Context, request UsersRolesSetRequestObject) (UsersRolesSetResponseObject, error)
+	// Get the user attributes schema
+	// (GET /admin/users:attributes-schema)
+	UsersAttributesSchemaGet(ctx context.Context, request UsersAttributesSchemaGetRequestObject) (UsersAttributesSchemaGetResponseObject, error)
+	// Set the user attributes schema
+	// (PUT /admin/users:attributes-schema)
+	UsersAttributesSchemaSet(ctx context.Context, request UsersAttributesSchemaSetRequestObject) (UsersAttributesSchemaSetResponseObject, error)
+	// Invite user
+	// (POST /admin/users:invite)
+	UsersInvite(ctx context.Context, request UsersInviteRequestObject) (UsersInviteResponseObject, error)
+	// Sync users from the auth provider
+	// (POST /admin/users:sync)
+	UsersSync(ctx context.Context, request UsersSyncRequestObject) (UsersSyncResponseObject, error)
+	// Accept an invitation
+	// (POST /invitations:accept)
+	InvitationsAccept(ctx context.Context, request InvitationsAcceptRequestObject) (InvitationsAcceptResponseObject, error)
+	// Get the current authenticated user
+	// (GET /users/me)
+	UsersMe(ctx context.Context, request UsersMeRequestObject) (UsersMeResponseObject, error)
+	// Update the current authenticated user profile
+	// (PATCH /users/me)
+	UsersUpdateMe(ctx context.Context, request UsersUpdateMeRequestObject) (UsersUpdateMeResponseObject, error)
+	// List my API keys
+	// (GET /users/me/api-keys)
+	UsersMeApiKeysList(ctx context.Context, request UsersMeApiKeysListRequestObject) (UsersMeApiKeysListResponseObject, error)
+	// Create an API key
+	// (POST /users/me/api-keys)
+	UsersMeApiKeysCreate(ctx context.Context, request UsersMeApiKeysCreateRequestObject) (UsersMeApiKeysCreateResponseObject, error)
+	// Revoke an API key
+	// (DELETE /users/me/api-keys/{keyId})
+	UsersMeApiKeysRevoke(ctx context.Context, request UsersMeApiKeysRevokeRequestObject) (UsersMeApiKeysRevokeResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// RolesList operation middleware
+func (sh *strictHandler) RolesList(w http.ResponseWriter, r *http.Request) {
+	var request RolesListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RolesList(ctx, request.(RolesListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RolesList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RolesListResponseObject); ok {
+		if err := validResponse.VisitRolesListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RolesCreate operation middleware
+func (sh *strictHandler) RolesCreate(w http.ResponseWriter, r *http.Request) {
+	var request RolesCreateRequestObject
+
+	var body RolesCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RolesCreate(ctx, request.(RolesCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RolesCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RolesCreateResponseObject); ok {
+		if err := validResponse.VisitRolesCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RolesDelete operation middleware
+func (sh *strictHandler) RolesDelete(w http.ResponseWriter, r *http.Request, roleName string) {
+	var request RolesDeleteRequestObject
+
+	request.RoleName = roleName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RolesDelete(ctx, request.(RolesDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RolesDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RolesDeleteResponseObject); ok {
+		if err := validResponse.VisitRolesDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ServiceAccountsList operation middleware
+func (sh *strictHandler) ServiceAccountsList(w http.ResponseWriter, r *http.Request) {
+	var request ServiceAccountsListRequestObject
 
-type UsersCreate201JSONResponse struct {
-	Body    User
-	Headers UsersCreate201ResponseHeaders
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ServiceAccountsList(ctx, request.(ServiceAccountsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ServiceAccountsList")
+	}
 
-func (response UsersCreate201JSONResponse) VisitUsersCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ServiceAccountsListResponseObject); ok {
+		if err := validResponse.VisitServiceAccountsListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersCreatedefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
-}
+// ServiceAccountsCreate operation middleware
+func (sh *strictHandler) ServiceAccountsCreate(w http.ResponseWriter, r *http.Request) {
+	var request ServiceAccountsCreateRequestObject
 
-func (response UsersCreatedefaultApplicationProblemPlusJSONResponse) VisitUsersCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+	var body ServiceAccountsCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ServiceAccountsCreate(ctx, request.(ServiceAccountsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ServiceAccountsCreate")
+	}
 
-type UsersDeleteRequestObject struct {
-	UserId externalRef2.UUID `json:"userId"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UsersDeleteResponseObject interface {
-	VisitUsersDeleteResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ServiceAccountsCreateResponseObject); ok {
+		if err := validResponse.VisitServiceAccountsCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersDelete204Response struct {
-}
+// ServiceAccountsRevoke operation middleware
+func (sh *strictHandler) ServiceAccountsRevoke(w http.ResponseWriter, r *http.Request, serviceAccountId externalRef2.UUID) {
+	var request ServiceAccountsRevokeRequestObject
 
-func (response UsersDelete204Response) VisitUsersDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	request.ServiceAccountId = serviceAccountId
 
-type UsersDeletedefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ServiceAccountsRevoke(ctx, request.(ServiceAccountsRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ServiceAccountsRevoke")
+	}
 
-func (response UsersDeletedefaultApplicationProblemPlusJSONResponse) VisitUsersDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ServiceAccountsRevokeResponseObject); ok {
+		if err := validResponse.VisitServiceAccountsRevokeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersGetRequestObject struct {
-	UserId externalRef2.UUID `json:"userId"`
-}
+// UsersList operation middleware
+func (sh *strictHandler) UsersList(w http.ResponseWriter, r *http.Request, params UsersListParams) {
+	var request UsersListRequestObject
 
-type UsersGetResponseObject interface {
-	VisitUsersGetResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type UsersGet200JSONResponse User
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersList(ctx, request.(UsersListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersList")
+	}
 
-func (response UsersGet200JSONResponse) VisitUsersGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersListResponseObject); ok {
+		if err := validResponse.VisitUsersListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersGetdefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
-}
+// UsersCreate operation middleware
+func (sh *strictHandler) UsersCreate(w http.ResponseWriter, r *http.Request) {
+	var request UsersCreateRequestObject
 
-func (response UsersGetdefaultApplicationProblemPlusJSONResponse) VisitUsersGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+	var body UsersCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersCreate(ctx, request.(UsersCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersCreate")
+	}
 
-type UsersUpdateRequestObject struct {
-	UserId externalRef2.UUID `json:"userId"`
-	Body   *UsersUpdateJSONRequestBody
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersCreateResponseObject); ok {
+		if err := validResponse.VisitUsersCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersUpdateResponseObject interface {
-	VisitUsersUpdateResponse(w http.ResponseWriter) error
+// UsersDelete operation middleware
+func (sh *strictHandler) UsersDelete(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersDeleteRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersDelete(ctx, request.(UsersDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersDeleteResponseObject); ok {
+		if err := validResponse.VisitUsersDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersUpdate200JSONResponse User
+// UsersGet operation middleware
+func (sh *strictHandler) UsersGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersGetRequestObject
 
-func (response UsersUpdate200JSONResponse) VisitUsersUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.UserId = userId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersGet(ctx, request.(UsersGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersGetResponseObject); ok {
+		if err := validResponse.VisitUsersGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersUpdatedefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
+// UsersUpdate operation middleware
+func (sh *strictHandler) UsersUpdate(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersUpdateRequestObject
+
+	request.UserId = userId
+
+	var body UsersUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersUpdate(ctx, request.(UsersUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersUpdateResponseObject); ok {
+		if err := validResponse.VisitUsersUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UsersUpdatedefaultApplicationProblemPlusJSONResponse) VisitUsersUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+// UsersInvitationGet operation middleware
+func (sh *strictHandler) UsersInvitationGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersInvitationGetRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersInvitationGet(ctx, request.(UsersInvitationGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersInvitationGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersInvitationGetResponseObject); ok {
+		if err := validResponse.VisitUsersInvitationGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersMeRequestObject struct {
-}
+// UsersInvitationExpire operation middleware
+func (sh *strictHandler) UsersInvitationExpire(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersInvitationExpireRequestObject
 
-type UsersMeResponseObject interface {
-	VisitUsersMeResponse(w http.ResponseWriter) error
-}
+	request.UserId = userId
 
-type UsersMe200JSONResponse User
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersInvitationExpire(ctx, request.(UsersInvitationExpireRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersInvitationExpire")
+	}
 
-func (response UsersMe200JSONResponse) VisitUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersInvitationExpireResponseObject); ok {
+		if err := validResponse.VisitUsersInvitationExpireResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersMedefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
-}
+// UsersInvitationResend operation middleware
+func (sh *strictHandler) UsersInvitationResend(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersInvitationResendRequestObject
 
-func (response UsersMedefaultApplicationProblemPlusJSONResponse) VisitUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+	request.UserId = userId
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersInvitationResend(ctx, request.(UsersInvitationResendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersInvitationResend")
+	}
 
-type UsersUpdateMeRequestObject struct {
-	Body *UsersUpdateMeJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UsersUpdateMeResponseObject interface {
-	VisitUsersUpdateMeResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersInvitationResendResponseObject); ok {
+		if err := validResponse.VisitUsersInvitationResendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UsersUpdateMe200JSONResponse User
-
-func (response UsersUpdateMe200JSONResponse) VisitUsersUpdateMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// UsersRolesGet operation middleware
+func (sh *strictHandler) UsersRolesGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersRolesGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.UserId = userId
 
-type UsersUpdateMedefaultApplicationProblemPlusJSONResponse struct {
-	Body       externalRef3.ProblemDetails
-	StatusCode int
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersRolesGet(ctx, request.(UsersRolesGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersRolesGet")
+	}
 
-func (response UsersUpdateMedefaultApplicationProblemPlusJSONResponse) VisitUsersUpdateMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersRolesGetResponseObject); ok {
+		if err := validResponse.VisitUsersRolesGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List users
-	// (GET /admin/users)
-	UsersList(ctx context.Context, request UsersListRequestObject) (UsersListResponseObject, error)
-	// Create user
-	// (POST /admin/users)
-	UsersCreate(ctx context.Context, request UsersCreateRequestObject) (UsersCreateResponseObject, error)
-	// Delete user
-	// (DELETE /admin/users/{userId})
-	UsersDelete(ctx context.Context, request UsersDeleteRequestObject) (UsersDeleteResponseObject, error)
-	// Get user by id
-	// (GET /admin/users/{userId})
-	UsersGet(ctx context.Context, request UsersGetRequestObject) (UsersGetResponseObject, error)
-	// Update user
-	// (PATCH /admin/users/{userId})
-	UsersUpdate(ctx context.Context, request UsersUpdateRequestObject) (UsersUpdateResponseObject, error)
-	// Get the current authenticated user
-	// (GET /users/me)
-	UsersMe(ctx context.Context, request UsersMeRequestObject) (UsersMeResponseObject, error)
-	// Update the current authenticated user profile
-	// (PATCH /users/me)
-	UsersUpdateMe(ctx context.Context, request UsersUpdateMeRequestObject) (UsersUpdateMeResponseObject, error)
-}
+// UsersRolesSet operation middleware
+func (sh *strictHandler) UsersRolesSet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
+	var request UsersRolesSetRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.UserId = userId
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body UsersRolesSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersRolesSet(ctx, request.(UsersRolesSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersRolesSet")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersRolesSetResponseObject); ok {
+		if err := validResponse.VisitUsersRolesSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// UsersList operation middleware
-func (sh *strictHandler) UsersList(w http.ResponseWriter, r *http.Request, params UsersListParams) {
-	var request UsersListRequestObject
-
-	request.Params = params
+// UsersAttributesSchemaGet operation middleware
+func (sh *strictHandler) UsersAttributesSchemaGet(w http.ResponseWriter, r *http.Request) {
+	var request UsersAttributesSchemaGetRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UsersList(ctx, request.(UsersListRequestObject))
+		return sh.ssi.UsersAttributesSchemaGet(ctx, request.(UsersAttributesSchemaGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UsersList")
+		handler = middleware(handler, "UsersAttributesSchemaGet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UsersListResponseObject); ok {
-		if err := validResponse.VisitUsersListResponse(w); err != nil {
+	} else if validResponse, ok := response.(UsersAttributesSchemaGetResponseObject); ok {
+		if err := validResponse.VisitUsersAttributesSchemaGetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -815,11 +2870,11 @@ func (sh *strictHandler) UsersList(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// UsersCreate operation middleware
-func (sh *strictHandler) UsersCreate(w http.ResponseWriter, r *http.Request) {
-	var request UsersCreateRequestObject
+// UsersAttributesSchemaSet operation middleware
+func (sh *strictHandler) UsersAttributesSchemaSet(w http.ResponseWriter, r *http.Request) {
+	var request UsersAttributesSchemaSetRequestObject
 
-	var body UsersCreateJSONRequestBody
+	var body UsersAttributesSchemaSetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -827,18 +2882,18 @@ func (sh *strictHandler) UsersCreate(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UsersCreate(ctx, request.(UsersCreateRequestObject))
+		return sh.ssi.UsersAttributesSchemaSet(ctx, request.(UsersAttributesSchemaSetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UsersCreate")
+		handler = middleware(handler, "UsersAttributesSchemaSet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UsersCreateResponseObject); ok {
-		if err := validResponse.VisitUsersCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(UsersAttributesSchemaSetResponseObject); ok {
+		if err := validResponse.VisitUsersAttributesSchemaSetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -846,25 +2901,30 @@ func (sh *strictHandler) UsersCreate(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UsersDelete operation middleware
-func (sh *strictHandler) UsersDelete(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
-	var request UsersDeleteRequestObject
+// UsersInvite operation middleware
+func (sh *strictHandler) UsersInvite(w http.ResponseWriter, r *http.Request) {
+	var request UsersInviteRequestObject
 
-	request.UserId = userId
+	var body UsersInviteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UsersDelete(ctx, request.(UsersDeleteRequestObject))
+		return sh.ssi.UsersInvite(ctx, request.(UsersInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UsersDelete")
+		handler = middleware(handler, "UsersInvite")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UsersDeleteResponseObject); ok {
-		if err := validResponse.VisitUsersDeleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(UsersInviteResponseObject); ok {
+		if err := validResponse.VisitUsersInviteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -872,25 +2932,25 @@ func (sh *strictHandler) UsersDelete(w http.ResponseWriter, r *http.Request, use
 	}
 }
 
-// UsersGet operation middleware
-func (sh *strictHandler) UsersGet(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
-	var request UsersGetRequestObject
+// UsersSync operation middleware
+func (sh *strictHandler) UsersSync(w http.ResponseWriter, r *http.Request, params UsersSyncParams) {
+	var request UsersSyncRequestObject
 
-	request.UserId = userId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UsersGet(ctx, request.(UsersGetRequestObject))
+		return sh.ssi.UsersSync(ctx, request.(UsersSyncRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UsersGet")
+		handler = middleware(handler, "UsersSync")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UsersGetResponseObject); ok {
-		if err := validResponse.VisitUsersGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(UsersSyncResponseObject); ok {
+		if err := validResponse.VisitUsersSyncResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -898,13 +2958,11 @@ func (sh *strictHandler) UsersGet(w http.ResponseWriter, r *http.Request, userId
 	}
 }
 
-// UsersUpdate operation middleware
-func (sh *strictHandler) UsersUpdate(w http.ResponseWriter, r *http.Request, userId externalRef2.UUID) {
-	var request UsersUpdateRequestObject
-
-	request.UserId = userId
+// InvitationsAccept operation middleware
+func (sh *strictHandler) InvitationsAccept(w http.ResponseWriter, r *http.Request) {
+	var request InvitationsAcceptRequestObject
 
-	var body UsersUpdateJSONRequestBody
+	var body InvitationsAcceptJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -912,18 +2970,18 @@ func (sh *strictHandler) UsersUpdate(w http.ResponseWriter, r *http.Request, use
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UsersUpdate(ctx, request.(UsersUpdateRequestObject))
+		return sh.ssi.InvitationsAccept(ctx, request.(InvitationsAcceptRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UsersUpdate")
+		handler = middleware(handler, "InvitationsAccept")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UsersUpdateResponseObject); ok {
-		if err := validResponse.VisitUsersUpdateResponse(w); err != nil {
+	} else if validResponse, ok := response.(InvitationsAcceptResponseObject); ok {
+		if err := validResponse.VisitInvitationsAcceptResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -986,36 +3044,162 @@ func (sh *strictHandler) UsersUpdateMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UsersMeApiKeysList operation middleware
+func (sh *strictHandler) UsersMeApiKeysList(w http.ResponseWriter, r *http.Request) {
+	var request UsersMeApiKeysListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersMeApiKeysList(ctx, request.(UsersMeApiKeysListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersMeApiKeysList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersMeApiKeysListResponseObject); ok {
+		if err := validResponse.VisitUsersMeApiKeysListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UsersMeApiKeysCreate operation middleware
+func (sh *strictHandler) UsersMeApiKeysCreate(w http.ResponseWriter, r *http.Request) {
+	var request UsersMeApiKeysCreateRequestObject
+
+	var body UsersMeApiKeysCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersMeApiKeysCreate(ctx, request.(UsersMeApiKeysCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersMeApiKeysCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersMeApiKeysCreateResponseObject); ok {
+		if err := validResponse.VisitUsersMeApiKeysCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UsersMeApiKeysRevoke operation middleware
+func (sh *strictHandler) UsersMeApiKeysRevoke(w http.ResponseWriter, r *http.Request, keyId externalRef2.UUID) {
+	var request UsersMeApiKeysRevokeRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UsersMeApiKeysRevoke(ctx, request.(UsersMeApiKeysRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UsersMeApiKeysRevoke")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UsersMeApiKeysRevokeResponseObject); ok {
+		if err := validResponse.VisitUsersMeApiKeysRevokeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYf2/bOBL9KgPeAU1xciw76bXwX5dLf5wPTWs0CRbYwChocWSzEEmVpNx4A3/3BUnZ",
-	"li3JcbJtdwPsX5Yscvg48+bNkHckUSJXEqU1ZHBHcqqpQIvavyVKCCU/53TKJbU8PKL7wtAkmufuPzIg",
-	"vQ6XDG+RgfsOshAT1CQi3H38WqBekIhIKpAMiLcQEZPMUNBgKqVFZsmgFxHBJReF8M92kbvxXFqcoibL",
-	"ZdSC55L/1oDpgwcBKgVuURjIUQd0R4LeQi+On+8B6E02guzHERH0tkQZx4/AbJS2dbyXSltIOWbMRIDH",
-	"02N45gBFnUQjtcjO7LMWwN5eFWyJwljN5ZQsHYrw0Qf13Nu7Nqh9wLXKUVuO/hsKyjP38E+NKRmQf3Q3",
-	"9OiWNrqrHWkuuOVzNJ/f+GnLiKRFln3wqOooIqLxa8E1MjK4KVeqzBivvacmXzCxztx1zqjFS8zSOtT9",
-	"S7WYat71Q001GlmH6eHuu+ICjaUid9Z/YAgiwtnDLV9fD1+7uYX34B/d4A4NOCNRnQtRxZvVhcct0XjL",
-	"M7uXzgdEtZ6no/XjBVpat77Swn0CEJGqQh0uHBGxytJs6KRra424deyITvHesTvuL8W4InmVZbfsjve4",
-	"bJeFNW3zfwNlTKMJSvzp7Tm8OOn34chwkWc85cicIOMtFXnm9nFDCoP6P+Ufx4kSDkOqtKCWDNacqVF8",
-	"H/tqwIaXH+HVv+Me2NUY4BKur853oPTj/otOL+70Tq56p4OTeBDHv27BcQztOCOHQfI5VUPjnHLa6/fB",
-	"fYZyfmWRovDp0m5fTTIUDC3lmfk8Cq+vw2vzai9fxS+hHAirkdEOz4PBuoEzmBWCyo5GyugkQ8DbPKMh",
-	"Y8DkmPCUJ2AV2Bk3oJKk0Bplgq4m2xlCibdpR6i1Ci0IZYw7gzQbbYHiq8yozS3/oFrThXvfBv0xD9ZA",
-	"0NwB8RW3k+EcM5jTjLMAvwTQQHoujaUywSZ/XH8agsYUwzbtjFrgDKV19DZ+z2u3PMgdxlJbNITwaobw",
-	"v6urEYQBkChWIWBVILjNGhGbmdI22g2kKYSgerGDDLzdqM3jj3HHjuUN0zWvL7SjXWFPa+fUBWrpo5Wq",
-	"OjRXMwwwJSiXkChpNU3sACgTXHYElXSKDAo/6Bu3M8i4sVxOIwipEEEoSRFQyYDmuVZzmnUZN857XY1u",
-	"faCJW80cw1DOUHNrYJqpCc3g/79cHZN1TMiIZmKhqUtDOBsNSUTmqE0AOu85/6ocJc05GZCT4/j41Au2",
-	"nXk+dD3mrsfq3qfY0Fq+58ZWt6NWOZD6umn8NjaFz4FzqeZfhmzlL2fFL705Idw0NwKbId2WE8QyeuRM",
-	"X6UeNdt3yW7mtm9C6wCThXcQ+LICR44TlEvTdkZYVZ/2nnvsyGpyJU3Qq34chxOVtCh9jGieZzzx4Lpf",
-	"jANzV7FHs+xj6j2cN+ve+mFfK+Zb1Zom7vZg3lJDgT+szWttmJZjn4Lb/h753HIJ5dQl8NYPKs9XrS4q",
-	"peJfdVcd1IvuK40NMN84/YejVY187p1WyuJWRrlEplPfJDhnw4VXD+E2MHbdnzINCRmOYEBB4rfAO42J",
-	"0qwl88JwEqKGxv5XscWDyLTPQ5Xj4HKbGVYXuKzRuPfdVt6sWVdnKA8AJCIzpKxUt/cqLNSg6J/er6pV",
-	"OXPlV6MKneD+8/HTI2DJILfHvQxcRlslonvnfoZsGTyYoW0o3CPUgrpdZAsIY4AGb04WmwKuW8j6Olit",
-	"FQovo65ubVQ0YCG7nIse6tqdo2qD9p429wDl9tgTVKDg5vsJEK1agoZQvUP7F4tT/HPEJVWFfIpBf4e2",
-	"koj3lR5qk1lL5MNt2J8f/O9fzir3fAeVs5/AuHB9FVL16XEuwD+s0oQaE+4e22XnIrQyPzkM5/64bZ9q",
-	"GFzq++6m3AYt7MxV4mTDrU10/I35YRpw8aP6ysrd/d+J+B0TcT8JINcq5f5eZIcM3hgmheZ24bV+glSj",
-	"PivsjAxuxk6PDer5qhIUOiMD0qU57857xH0t7dWueVx3CUqH5cPliT8WlfVjVynqR/A3kuWKS2sgVfp+",
-	"kpd2A7PGy98DAAD//2fGdgTCHAAA",
+	"H4sIAAAAAAAC/+xce3MbOXL/Kl2Tq7KdDEVKu/cI959ovb6LcvZZJcnZVHyKC5xpkjjNALMARjJPxe+e",
+	"Ahrz4LxISbS01vofWyRngEbjh343boNIppkUKIwOprdBxhRL0aBynyKZplJ8ytiCC2Y4/Yn2lxh1pHhm",
+	"vwumweGIixg/Ywz2dxB5OkMVhAG3P/6So1oFYSBYisE0cCOEgY6WmDIaas7yxATTwzBIueBpnrq/zSqz",
+	"z3NhcIEqWK/DHnrO+T87aPqbIwLkHLjBVEOGiqh7mbLPcDiZvBog0A3ZSeTRJAxS9tlTOZncg2YtlWnT",
+	"ey6VgTnHJNYh4MHiAF5YgsJRpJAZjI/Nix6C3Xh1Yj0V2iguFsF6vS5+dJt6HEWYmRNxzQ2juW+DTMkM",
+	"leHonjDyCkXXQGGg8JecK4yD6Uf/2GW5ajn7B0YmWIfBccb/iqv2wOVS7IffKZwH0+BfxhUCx57MccE0",
+	"xVNu+DXqTxc8RW1Ymtnx8XPGFeoHj8Pjuw/w4cPJT/bdhGnzQe9hMbSLLWbbPZMZ6jZSTlGlXGsuhQaz",
+	"RLjCFaRsBbnGEHQeLYFp+0HpqUIWH1jY2CPQOYn/ginFVq0d5nGBspKasLaJXVv/2v3aB4B9bVzBs5R9",
+	"fotiYZbVWSw/h0Mc7WdIysUJ/Xi4hTubjOlnxplMsM2KjT3toKNYYsaMQWX3/f8+stE/L+0/k9G/fxpd",
+	"3k7CPxytfxd0LDSrILLn1dZH7l/yOaprHuFxFMlcmK2Lr23j7yeTctQ2M76K/f6gUbWXzIxRfJYb1Nuw",
+	"b98/rp628i5lPLn7kXnjXluHwTxPkr91i5nGGmmm2hv9y4yrQ86S5P08mH4cJtA/vw6brLnCVQsUwcUS",
+	"4fj0xEq3AzgxEDEhpIEZgkKjOF5jDGzBuDgIwi2LsuO313FZraQN191W1HjvDivT9CYwetXJcCMBP0dL",
+	"JhYIzMDp+/MLGLPcLMdO0345LgzZAsxZC3tQc3vT/Q88C/tSQQNnKgy45SjGP646f9Uo4tcF0pomo/vZ",
+	"PJg8bZjJSecKa5x+DDIUsSUgLPc0KLgR14BRkWlNiJN7W0gN9PnBwraEKWmtY6S+T3WGdYkjB98eqfsr",
+	"k5ynSl7z2HICheGmw0KKuc4StupFVrmg1i8KmZaiLW5+Xq6cmcj9nHDDNFghknBxhfEUuLhmCY8/5TyG",
+	"l/ZJ+wenZ5gzJIHHr0Jwel8sPjkSQnD/fYqkmCc8MvCSCWmWqOiFpUxisk7dY69AKvgll4Z9ws8RYozO",
+	"LC2gWaMgCIONeQrElBMFYbA5UDd2ybQf3jKazvOta7u6Lbd9SbJdLcBt1l2/d7BQTBiMYUYIUDLp9hDg",
+	"78G//j2gxzXgNaoVVJPcxYEIgzyL98Cd7cbnprSoZu3ax23m6L521I/z4+ru4xRu5TZQPA+XtTB7nJio",
+	"IOkEFMen8FvP0Wza3edlOGUTK8Si8yRfdAuY1sgfHDTPMZnv1ykYVk09ZOzfN7krGXsn4NdiWA4bhA88",
+	"tseR/e7Ba7RDvZULLh4+kh2lNGL7Qq+aL8SIC9DoD79GFIU6Oj49qXktNQP4i6gQ3mN8dmuRjQWGdXxe",
+	"9oD6eAPCLI655QZLTmtANyrHsOkNomDCjGKcc2ED6ErOeYJlJNgZRswUbp42jnXEANBo4IabJZx+sK5i",
+	"nHIxJrVeETyiZw/gZ8UNFwuofgKFWcIiJJmsjVQYA62qtjF9y7yXgCwi0f+NSnMpup1j5sAOWT5LuF5i",
+	"DNf0NES5UihM4mKdMcylKvjjzZQdBdCfeWIGvYUdx7H2oW4Po4qv76m+6P0+oJ2vRHSGmU8idBoybbba",
+	"FzX4n2GuZAqZ90UKx4Cj3lC526Rwl/EXq9VZXjdcZlImyARJjIgl71W2ZF1mKxFowSxz63Y0qVv9YEG6",
+	"KgIgVrAAFw8muJiml67TNpfALJmBSOZJDD4YQ64UGOkdpp3pajmEXRa1oKBQ3B0u8EKrl6VLqb0XZp0w",
+	"K/nAmkbODYxkxgs8WBlQsOOBfG3g2aOilLWVpA3qq2tApL05XSeinV47Lf98h6ZDPhUpzKG8XRjUE4u7",
+	"5/vCwEjDkpOCdeWzk95nT9kCtz7bYKjPodYylbVpN8YdYlnThmkByH0NLI4Vakqgnv35Nfz+u6MjeKl5",
+	"miV8zjF+5YI1LM2c0KNIz3/4Lw4imVoa5lKlzATTUgG3BOKQKm8RdnL+Hv70h8khmOIZ4AI+XLxukHI0",
+	"Ofr96HAyOvzu4vD76XeT6WTyvxvkWBCO7CC7keQsshY1linfHx4dgf0Z/Pu1SXKKM/SPL2cJpjEaxhP9",
+	"6ZQ+/kQfu2f7458mfwT/IBRPhq0ci+nc1WNY5ikTI+tjsVmCgJ+zhNGJAZ1hxOc8sqLMLLkGGZHSjdDa",
+	"c15G2Hm7VoRKSTVg/9zeIY6wSfT7jEaDlGWWEGcejRK8xqRmAoAnoAP0XGjDRIRd/PhwdgIK50jLdNKd",
+	"pP2cF7ZRwZY7saOKwbZtnP+8uDgFegAiGWOnIWy4STop1kupTNjcSJ2nKVOrBmXgxg37OH4fdjRGrpCu",
+	"+NY0BK2pZE5bQK3dbs1ln0KLZcq4gEgKo1hkpuDs3lHKBFtgDHlpSEDCtbV4Q6CjEAJpnRCYiIFlVr2w",
+	"ZBxzbbk3Vmjnd8anFDqELMk1GGedu8iZdq/Z4d1HYNoaISkKow/gRCxRcaNhkcgZS+C/fr74gQgDC3+H",
+	"Tg0xRglTSDwsQyJk2Ai0Nr4GBjNkCtVxbpbgghfOsCUkBKcsSVeK2cNv/acgDK4LSzq4PrS7KjMULOPB",
+	"NPjuYHLwvVMTZulQ6D2E0jxdYIfz9pZ7H4PW7LHk+eDYukm9BmTR0scOLanlem3uwIVQtR3TRVp1JoWm",
+	"yY8mk8CVGAmDPueWZQmP3Kvjf/hQtu5xMko5spOVYonYHjRyI3XjsSGCHQPXYVUV1LsOf1L+rb2enfza",
+	"Ic3QQdgbpaSCl4WKeOXWqDHKlcs1fLwNKmyVjsaUTk5wub4MAy9CChy4Byz82MIpVFs7pDW8tmdPJoFN",
+	"HmZSu1V3bDtlVwNiM2rzo4xXd9rzIR7VaivWm1tpvex1C22He5u5mrMNC6hM3CWy2NfRvZVRmV5tiLSz",
+	"t8UR82+ScFGoZa4iHK7reuYQpC12DBnC4DrckGzjW/ufje6sid8Jmg4995P7HpgX5la0CxLpwA15RJQI",
+	"cf4cWJ09y3libDiLBLt7sUrI00Rxjwik6YJwo8Ly4y0V9FkJXdXzFeQHTVAPYeGyBfjvO2xHmZR0Pnf5",
+	"5fd3d/D4koyRz03soCFJKb7QzWoOHyFYsmv0AQIUoPBaXmF8AGe0pxqaE/qFtAG0mb56cm3aqnrZl149",
+	"b7DxOUC0Z5M7tW0TRrsp3k36vMxkzcFcsJTBjEVXKGJwTgZBjGRb7YuyComMfYUmVzY0bWuUaBTnfowS",
+	"bquPaLngSpS6ypbI2I6MroxHT9ILTfatBimS1Q/AfT4QhKwZ3L4QwfCN+Bqc05tprp3wzRoJxoglSVGG",
+	"QPa+ncOFDTG2IpzcBFs2S3q3xXxii10zJwIrRnBBvnFxAvdwph/BVGoe28c1mror7bYLgdKg+u3Igr4j",
+	"fD81Nr7VGzw/iQfNojOnpurH1B5M7Q5CxIQ9m4kUC1QgZ8b64e6Q29Pi/geW2HCEPTQ6xxi0YSsK0EAu",
+	"DE/I16Xarj0cG6J2J6uqyYVB6+peBW87mWBNeHuz4DcEb4+we8Lbye1h06wWAZJF2HDuMn6kUapcQRtf",
+	"LsTkDawGprpYXD0y7umVWof3fNMF9u/1tpaKapE3eUNJT5t8dzEsygi9tEhjXOi+bqgiYN/vebQmei8o",
+	"Qas0JEybIp07w7l0sS+ugaKxJgQuoiS3Zan+BWc436BCENb78u8e9BBXVUb86AYP7n+G64UDlw80rqvi",
+	"8YeY2Tul1vqM63C39fdmzdaXHQLh1AVYE3vG5Jw27DnIraoQstMqdz/XhJPdF3jnRFtq17qDLS7wxgeO",
+	"MZIq7hE7j2ADEqYe1/Kr5mxH8/cQLvN8/Y2EywirN4qbPrPRPjGI1oYuHd9Saf6gVWjrN5mg0pu4CJw5",
+	"zs9WVXJI9QD7DrGvsk3gKWwzx6tnFB4bAIuPjm0FS1jYWR3b+hc0v7I9nTyO0JrLXMTPW/P9BU3tfG/T",
+	"fsxEyx6QUNnx0+Nk/xq1VlC9k0Z9BHBmcakSn7n8oqXeX9mN+UbPY7+Qq3ojn7O4q1bZtW/1X5+70HOd",
+	"cuV6rbHJmiir2KF3Q9iU4l0u79LpKLyxIXLwDZr16YW8Kdp+qedXozCQMNNra1XEvaFJv0H2WQtC2uUa",
+	"Zh4EVAewuB+oJ1rnhUNL2R0XZmMwV6iXFNdduSAbhZe48d3pLpmSKbzmMteuXBq0kZmGG6muuFhsRfMZ",
+	"UfYNzc8azbTLD0Jzs7qtA1WuIOO5ey++SK29XceasqC+uOu3UMZWlTOyYvFlKnlLkj0fBNE5mmfp2mzi",
+	"53E9m2/A9aLQ9ebdG7sNAdnuCOxNol2UDYYjhZnU3Ei18t9QgqZoStSbZVBRro1MHWW1LsMe1d5sJCR5",
+	"/EWR1ZyyE2TlM7UmS+Mr5p+/69PYO8+Dhout66Kx0frLUgQzBKCXsxXoJF+88p1pvc2eFciagIL3KTdQ",
+	"9ZnaA6EwldcI3PxQJ9/6TWaJ9bKFGYI1PYy9UOmcWl5rLzBFJXsKi+njHfF77vG7f2ncdzXBE8jmbyeo",
+	"33Y9v+sRakpoujSq3/kq04lFoMC+FlLPZtFHwquGWSpTq4lnW5NX9dVWTppZYgpM1OMO1kEr6gLLL/2N",
+	"TMDmBpUbOpJizhe5PUMXF2+HfLgvldas3f30yGnNXT22MlFpGa5RmHvkOv11Yt9yncVZo22/U2Buqlci",
+	"6j9cZxhJEfEEN89MrZaodocWp8YobpqHit47gJPqOdJy/kqt8jqtWqc7TaHkjVM+Hi0h+K5oOqNUuOTv",
+	"BnPt26SqqH+bClzrtKka4TSLK7FClTFl3JvK3SBALWeS+qsPwDdaAyvrb3qbGtzRtlcRtP2fJmPtRHDj",
+	"CntczzytrKTthu6l6KvxKZvHOy5jnrNEY9i6a+CL+9a1Kxi6KlhXIvL8fe4qz66UkFZeIbBxIHq0XqVU",
+	"9JQuIxzQeraSu7zCrC4JQ1/ObRVd84SuqrsupNNVaVg9Q5HLkuK6irOHrQ32mmCh27O/kDZrXc39K0ks",
+	"1rRZeXfkV4PsEq3E3U1LpwZQd0MX4ZNCiinWXOQO6fcOgyfYi9d0B85XluRtOZr+Kh8nLuyJjarMdWtL",
+	"dqkwePelLMza9W3f0vz7QACRvwUExT1Yw+dzzDI+usLVDr161BD0Qhd3Sg/36BWtPxojhaZuEBVNQD0W",
+	"0Tt/9f2TN+mVl23vqzmv4NtXiDgHgnRVbn2nhOk0Pt5x4RSGf9MZEymLlly4tgXUmppYUBsNlLoyhd/9",
+	"P6Pj05PRX3EF5O65ondkMdUUbLbNUYuctcUrrIaQ8NQZO+4uFgfIsmXuTg1wd2ll2wLrR6hRLpD7JP1p",
+	"9ck78f/19aO1+spKOO8oXMe3V7hqFQgPoeQObVlu6Ceq9y229KvrwWr1Ug1t6aB3Rw4dqutii3KVBNPA",
+	"7v3Y3idzWY7XYh5dbuPv+6YcltLVxjZr8dp9Qhe1u3VCH7ysi7He63aqSRpZr/YcznOp2ou48LKUAjXV",
+	"QPXQUXuUNyLOJBdGF97ksPnqxySb8XL9/wMA/pUTdWRrAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
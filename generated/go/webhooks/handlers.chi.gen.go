@@ -0,0 +1,1417 @@
+// Package webhooks provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package webhooks
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	externalRef0 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/iam"
+	externalRef1 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/pagination"
+	externalRef2 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/primitives"
+	externalRef3 "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	EntityCreated WebhookEventType = "entity.created"
+	EntityDeleted WebhookEventType = "entity.deleted"
+	EntityUpdated WebhookEventType = "entity.updated"
+)
+
+// CreateWebhook defines model for CreateWebhook.
+type CreateWebhook struct {
+	Description *string            `json:"description,omitempty"`
+	EventTypes  []WebhookEventType `json:"eventTypes"`
+
+	// Url Absolute http(s) URL that receives POST deliveries.
+	Url string `json:"url"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt   externalRef2.Timestamp `json:"createdAt"`
+	Description *string                `json:"description,omitempty"`
+	EventTypes  []WebhookEventType     `json:"eventTypes"`
+	Url         string                 `json:"url"`
+
+	// WebhookId RFC 4122 UUID string
+	WebhookId externalRef2.UUID `json:"webhookId"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts int `json:"attempts"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// DeliveryId RFC 4122 UUID string
+	DeliveryId externalRef2.UUID `json:"deliveryId"`
+	EntityId   string            `json:"entityId"`
+
+	// EventId RFC 4122 UUID string
+	EventId externalRef2.UUID `json:"eventId"`
+
+	// EventType Entity change that triggers a delivery.
+	EventType WebhookEventType `json:"eventType"`
+
+	// LastError Transport or HTTP error from the last attempt, if any.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP status returned by the endpoint on the last attempt.
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt ISO 8601 timestamp in UTC
+	NextAttemptAt *externalRef2.Timestamp `json:"nextAttemptAt,omitempty"`
+
+	// Status `pending` deliveries are retried with exponential backoff; `failed` means the maximum number of attempts was exhausted.
+	Status    WebhookDeliveryStatus `json:"status"`
+	TableName string                `json:"tableName"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
+
+	// WebhookId RFC 4122 UUID string
+	WebhookId externalRef2.UUID `json:"webhookId"`
+}
+
+// WebhookDeliveryDetail defines model for WebhookDeliveryDetail.
+type WebhookDeliveryDetail struct {
+	Attempts int `json:"attempts"`
+
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt externalRef2.Timestamp `json:"createdAt"`
+
+	// DeliveryId RFC 4122 UUID string
+	DeliveryId externalRef2.UUID `json:"deliveryId"`
+	EntityId   string            `json:"entityId"`
+
+	// EventId RFC 4122 UUID string
+	EventId externalRef2.UUID `json:"eventId"`
+
+	// EventType Entity change that triggers a delivery.
+	EventType WebhookEventType `json:"eventType"`
+
+	// Exchange The HTTP request sent on the latest attempt and the response received. `response` is absent when the endpoint could not be reached.
+	Exchange *WebhookExchange `json:"exchange,omitempty"`
+
+	// LastAttemptAt ISO 8601 timestamp in UTC
+	LastAttemptAt *externalRef2.Timestamp `json:"lastAttemptAt,omitempty"`
+
+	// LastError Transport or HTTP error from the last attempt, if any.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode HTTP status returned by the endpoint on the last attempt.
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt ISO 8601 timestamp in UTC
+	NextAttemptAt *externalRef2.Timestamp `json:"nextAttemptAt,omitempty"`
+
+	// RedeliveredAt ISO 8601 timestamp in UTC
+	RedeliveredAt *externalRef2.Timestamp `json:"redeliveredAt,omitempty"`
+
+	// RedeliveredBy User who last requested a redelivery.
+	RedeliveredBy *string `json:"redeliveredBy,omitempty"`
+
+	// Status `pending` deliveries are retried with exponential backoff; `failed` means the maximum number of attempts was exhausted.
+	Status    WebhookDeliveryStatus `json:"status"`
+	TableName string                `json:"tableName"`
+
+	// UpdatedAt ISO 8601 timestamp in UTC
+	UpdatedAt externalRef2.Timestamp `json:"updatedAt"`
+
+	// WebhookId RFC 4122 UUID string
+	WebhookId externalRef2.UUID `json:"webhookId"`
+}
+
+// WebhookDeliveryStatus `pending` deliveries are retried with exponential backoff; `failed` means the maximum number of attempts was exhausted.
+type WebhookDeliveryStatus string
+
+// WebhookEventType Entity change that triggers a delivery.
+type WebhookEventType string
+
+// WebhookExchange The HTTP request sent on the latest attempt and the response received. `response` is absent when the endpoint could not be reached.
+type WebhookExchange struct {
+	DurationMs int64            `json:"durationMs"`
+	Request    WebhookRequest   `json:"request"`
+	Response   *WebhookResponse `json:"response,omitempty"`
+}
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	Body    string            `json:"body"`
+	Headers map[string]string `json:"headers"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Body       string            `json:"body"`
+	Headers    map[string]string `json:"headers"`
+	StatusCode int               `json:"statusCode"`
+}
+
+// WebhookWithSecret defines model for WebhookWithSecret.
+type WebhookWithSecret struct {
+	// CreatedAt ISO 8601 timestamp in UTC
+	CreatedAt   externalRef2.Timestamp `json:"createdAt"`
+	Description *string                `json:"description,omitempty"`
+	EventTypes  []WebhookEventType     `json:"eventTypes"`
+
+	// Secret HMAC signing secret. Only returned when the webhook is created or its secret rotated.
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+
+	// WebhookId RFC 4122 UUID string
+	WebhookId externalRef2.UUID `json:"webhookId"`
+}
+
+// DeliveryId RFC 4122 UUID string
+type DeliveryId = externalRef2.UUID
+
+// WebhookId RFC 4122 UUID string
+type WebhookId = externalRef2.UUID
+
+// WebhooksListParams defines parameters for WebhooksList.
+type WebhooksListParams struct {
+	// Page 1-indexed page number
+	Page *externalRef1.Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Number of items per page (max 100)
+	PageSize *externalRef1.PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// WebhooksListDeliveriesParams defines parameters for WebhooksListDeliveries.
+type WebhooksListDeliveriesParams struct {
+	// Page 1-indexed page number
+	Page *externalRef1.Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Number of items per page (max 100)
+	PageSize *externalRef1.PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Status Optional filter by delivery status
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// WebhooksCreateJSONRequestBody defines body for WebhooksCreate for application/json ContentType.
+type WebhooksCreateJSONRequestBody = CreateWebhook
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List webhook subscriptions
+	// (GET /webhooks)
+	WebhooksList(w http.ResponseWriter, r *http.Request, params WebhooksListParams)
+	// Register a webhook endpoint
+	// (POST /webhooks)
+	WebhooksCreate(w http.ResponseWriter, r *http.Request)
+	// Remove a webhook subscription
+	// (DELETE /webhooks/{webhookId})
+	WebhooksDelete(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+	// Get a webhook subscription
+	// (GET /webhooks/{webhookId})
+	WebhooksGet(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+	// List deliveries for a webhook
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhooksListDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params WebhooksListDeliveriesParams)
+	// Inspect a delivery
+	// (GET /webhooks/{webhookId}/deliveries/{deliveryId})
+	WebhooksGetDelivery(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId)
+	// Redeliver an event
+	// (POST /webhooks/{webhookId}/deliveries/{deliveryId}:redeliver)
+	WebhooksRedeliver(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId)
+	// Rotate the signing secret of a webhook
+	// (POST /webhooks/{webhookId}:rotateSecret)
+	WebhooksRotateSecret(w http.ResponseWriter, r *http.Request, webhookId WebhookId)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List webhook subscriptions
+// (GET /webhooks)
+func (_ Unimplemented) WebhooksList(w http.ResponseWriter, r *http.Request, params WebhooksListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a webhook endpoint
+// (POST /webhooks)
+func (_ Unimplemented) WebhooksCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a webhook subscription
+// (DELETE /webhooks/{webhookId})
+func (_ Unimplemented) WebhooksDelete(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a webhook subscription
+// (GET /webhooks/{webhookId})
+func (_ Unimplemented) WebhooksGet(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List deliveries for a webhook
+// (GET /webhooks/{webhookId}/deliveries)
+func (_ Unimplemented) WebhooksListDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params WebhooksListDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Inspect a delivery
+// (GET /webhooks/{webhookId}/deliveries/{deliveryId})
+func (_ Unimplemented) WebhooksGetDelivery(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Redeliver an event
+// (POST /webhooks/{webhookId}/deliveries/{deliveryId}:redeliver)
+func (_ Unimplemented) WebhooksRedeliver(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rotate the signing secret of a webhook
+// (POST /webhooks/{webhookId}:rotateSecret)
+func (_ Unimplemented) WebhooksRotateSecret(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// WebhooksList operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksCreate operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksDelete operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksDelete(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksGet operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksGet(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksListDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksListDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksListDeliveriesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksListDeliveries(w, r, webhookId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksGetDelivery operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksGetDelivery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId DeliveryId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksGetDelivery(w, r, webhookId, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId DeliveryId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksRedeliver(w, r, webhookId, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksRotateSecret operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksRotateSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksRotateSecret(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.WebhooksList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.WebhooksCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhookId}", wrapper.WebhooksDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}", wrapper.WebhooksGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}/deliveries", wrapper.WebhooksListDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}/deliveries/{deliveryId}", wrapper.WebhooksGetDelivery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{webhookId}/deliveries/{deliveryId}:redeliver", wrapper.WebhooksRedeliver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{webhookId}:rotateSecret", wrapper.WebhooksRotateSecret)
+	})
+
+	return r
+}
+
+type WebhooksListRequestObject struct {
+	Params WebhooksListParams
+}
+
+type WebhooksListResponseObject interface {
+	VisitWebhooksListResponse(w http.ResponseWriter) error
+}
+
+type WebhooksList200JSONResponse struct {
+	Items      []Webhook `json:"items"`
+	Page       int       `json:"page"`
+	PageSize   int       `json:"pageSize"`
+	TotalItems int       `json:"totalItems"`
+	TotalPages int       `json:"totalPages"`
+}
+
+func (response WebhooksList200JSONResponse) VisitWebhooksListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhooksListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksListdefaultApplicationProblemPlusJSONResponse) VisitWebhooksListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksCreateRequestObject struct {
+	Body *WebhooksCreateJSONRequestBody
+}
+
+type WebhooksCreateResponseObject interface {
+	VisitWebhooksCreateResponse(w http.ResponseWriter) error
+}
+
+type WebhooksCreate201ResponseHeaders struct {
+	Location string
+}
+
+type WebhooksCreate201JSONResponse struct {
+	Body    WebhookWithSecret
+	Headers WebhooksCreate201ResponseHeaders
+}
+
+func (response WebhooksCreate201JSONResponse) VisitWebhooksCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksCreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksCreatedefaultApplicationProblemPlusJSONResponse) VisitWebhooksCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksDeleteRequestObject struct {
+	WebhookId WebhookId `json:"webhookId"`
+}
+
+type WebhooksDeleteResponseObject interface {
+	VisitWebhooksDeleteResponse(w http.ResponseWriter) error
+}
+
+type WebhooksDelete204Response struct {
+}
+
+func (response WebhooksDelete204Response) VisitWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type WebhooksDeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksDeletedefaultApplicationProblemPlusJSONResponse) VisitWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksGetRequestObject struct {
+	WebhookId WebhookId `json:"webhookId"`
+}
+
+type WebhooksGetResponseObject interface {
+	VisitWebhooksGetResponse(w http.ResponseWriter) error
+}
+
+type WebhooksGet200JSONResponse Webhook
+
+func (response WebhooksGet200JSONResponse) VisitWebhooksGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhooksGetdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksGetdefaultApplicationProblemPlusJSONResponse) VisitWebhooksGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksListDeliveriesRequestObject struct {
+	WebhookId WebhookId `json:"webhookId"`
+	Params    WebhooksListDeliveriesParams
+}
+
+type WebhooksListDeliveriesResponseObject interface {
+	VisitWebhooksListDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type WebhooksListDeliveries200JSONResponse struct {
+	Items      []WebhookDelivery `json:"items"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"pageSize"`
+	TotalItems int               `json:"totalItems"`
+	TotalPages int               `json:"totalPages"`
+}
+
+func (response WebhooksListDeliveries200JSONResponse) VisitWebhooksListDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhooksListDeliveriesdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksListDeliveriesdefaultApplicationProblemPlusJSONResponse) VisitWebhooksListDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksGetDeliveryRequestObject struct {
+	WebhookId  WebhookId  `json:"webhookId"`
+	DeliveryId DeliveryId `json:"deliveryId"`
+}
+
+type WebhooksGetDeliveryResponseObject interface {
+	VisitWebhooksGetDeliveryResponse(w http.ResponseWriter) error
+}
+
+type WebhooksGetDelivery200JSONResponse WebhookDeliveryDetail
+
+func (response WebhooksGetDelivery200JSONResponse) VisitWebhooksGetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhooksGetDeliverydefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksGetDeliverydefaultApplicationProblemPlusJSONResponse) VisitWebhooksGetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksRedeliverRequestObject struct {
+	WebhookId  WebhookId  `json:"webhookId"`
+	DeliveryId DeliveryId `json:"deliveryId"`
+}
+
+type WebhooksRedeliverResponseObject interface {
+	VisitWebhooksRedeliverResponse(w http.ResponseWriter) error
+}
+
+type WebhooksRedeliver202JSONResponse WebhookDelivery
+
+func (response WebhooksRedeliver202JSONResponse) VisitWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhooksRedeliverdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksRedeliverdefaultApplicationProblemPlusJSONResponse) VisitWebhooksRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type WebhooksRotateSecretRequestObject struct {
+	WebhookId WebhookId `json:"webhookId"`
+}
+
+type WebhooksRotateSecretResponseObject interface {
+	VisitWebhooksRotateSecretResponse(w http.ResponseWriter) error
+}
+
+type WebhooksRotateSecret200JSONResponse WebhookWithSecret
+
+func (response WebhooksRotateSecret200JSONResponse) VisitWebhooksRotateSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhooksRotateSecretdefaultApplicationProblemPlusJSONResponse struct {
+	Body       externalRef3.ProblemDetails
+	StatusCode int
+}
+
+func (response WebhooksRotateSecretdefaultApplicationProblemPlusJSONResponse) VisitWebhooksRotateSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List webhook subscriptions
+	// (GET /webhooks)
+	WebhooksList(ctx context.Context, request WebhooksListRequestObject) (WebhooksListResponseObject, error)
+	// Register a webhook endpoint
+	// (POST /webhooks)
+	WebhooksCreate(ctx context.Context, request WebhooksCreateRequestObject) (WebhooksCreateResponseObject, error)
+	// Remove a webhook subscription
+	// (DELETE /webhooks/{webhookId})
+	WebhooksDelete(ctx context.Context, request WebhooksDeleteRequestObject) (WebhooksDeleteResponseObject, error)
+	// Get a webhook subscription
+	// (GET /webhooks/{webhookId})
+	WebhooksGet(ctx context.Context, request WebhooksGetRequestObject) (WebhooksGetResponseObject, error)
+	// List deliveries for a webhook
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhooksListDeliveries(ctx context.Context, request WebhooksListDeliveriesRequestObject) (WebhooksListDeliveriesResponseObject, error)
+	// Inspect a delivery
+	// (GET /webhooks/{webhookId}/deliveries/{deliveryId})
+	WebhooksGetDelivery(ctx context.Context, request WebhooksGetDeliveryRequestObject) (WebhooksGetDeliveryResponseObject, error)
+	// Redeliver an event
+	// (POST /webhooks/{webhookId}/deliveries/{deliveryId}:redeliver)
+	WebhooksRedeliver(ctx context.Context, request WebhooksRedeliverRequestObject) (WebhooksRedeliverResponseObject, error)
+	// Rotate the signing secret of a webhook
+	// (POST /webhooks/{webhookId}:rotateSecret)
+	WebhooksRotateSecret(ctx context.Context, request WebhooksRotateSecretRequestObject) (WebhooksRotateSecretResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// WebhooksList operation middleware
+func (sh *strictHandler) WebhooksList(w http.ResponseWriter, r *http.Request, params WebhooksListParams) {
+	var request WebhooksListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksList(ctx, request.(WebhooksListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksListResponseObject); ok {
+		if err := validResponse.VisitWebhooksListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksCreate operation middleware
+func (sh *strictHandler) WebhooksCreate(w http.ResponseWriter, r *http.Request) {
+	var request WebhooksCreateRequestObject
+
+	var body WebhooksCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksCreate(ctx, request.(WebhooksCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksCreateResponseObject); ok {
+		if err := validResponse.VisitWebhooksCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksDelete operation middleware
+func (sh *strictHandler) WebhooksDelete(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	var request WebhooksDeleteRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksDelete(ctx, request.(WebhooksDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksDeleteResponseObject); ok {
+		if err := validResponse.VisitWebhooksDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksGet operation middleware
+func (sh *strictHandler) WebhooksGet(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	var request WebhooksGetRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksGet(ctx, request.(WebhooksGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksGetResponseObject); ok {
+		if err := validResponse.VisitWebhooksGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksListDeliveries operation middleware
+func (sh *strictHandler) WebhooksListDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params WebhooksListDeliveriesParams) {
+	var request WebhooksListDeliveriesRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksListDeliveries(ctx, request.(WebhooksListDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksListDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksListDeliveriesResponseObject); ok {
+		if err := validResponse.VisitWebhooksListDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksGetDelivery operation middleware
+func (sh *strictHandler) WebhooksGetDelivery(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId) {
+	var request WebhooksGetDeliveryRequestObject
+
+	request.WebhookId = webhookId
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksGetDelivery(ctx, request.(WebhooksGetDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksGetDelivery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksGetDeliveryResponseObject); ok {
+		if err := validResponse.VisitWebhooksGetDeliveryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksRedeliver operation middleware
+func (sh *strictHandler) WebhooksRedeliver(w http.ResponseWriter, r *http.Request, webhookId WebhookId, deliveryId DeliveryId) {
+	var request WebhooksRedeliverRequestObject
+
+	request.WebhookId = webhookId
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksRedeliver(ctx, request.(WebhooksRedeliverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksRedeliver")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksRedeliverResponseObject); ok {
+		if err := validResponse.VisitWebhooksRedeliverResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WebhooksRotateSecret operation middleware
+func (sh *strictHandler) WebhooksRotateSecret(w http.ResponseWriter, r *http.Request, webhookId WebhookId) {
+	var request WebhooksRotateSecretRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhooksRotateSecret(ctx, request.(WebhooksRotateSecretRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhooksRotateSecret")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WebhooksRotateSecretResponseObject); ok {
+		if err := validResponse.VisitWebhooksRotateSecretResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9Ra23LbyNF+la75/wu7AlGULHs33MqFbG92VbHXig61qTgqawg0iFkDM/BMQxKj4run",
+	"5oADCZASZTmxriQCc+jp7q/76x7cslgVpZIoybDJLSu55gUSavfrLebiCvX8KLG/hGQTVnLKWMQkL5BN",
+	"WNIOiJjGL5XQmLAJ6QojZuIMC25n/r/GlE3Y/+22m+36t8Y+KpT8VGpRCBJXaD6dnx+9ZYtFxH7HaabU",
+	"57W7Xzfvv8Hm9Ss+E5KT8P+iXTBBE2tR2mdswvZ2hEzwBhOw70FWxRQ1i7y8XyrU81Zgt0JXtgRTXuXE",
+	"JnsRK4QURVW4/2le2vFCEs5Qb5DnVPx7QKbfnBCgUhCEhYEStZfuWcFvYG88fr5BQLfkoJD744gV/CZI",
+	"OR7fIfOiXsT50huNnDCY1D4otSpRk0DTO8Ct3eYdyhllbPJyPG4WN6SFnLFFxPAKJZ3NSz/bHfMuY4e9",
+	"f65n2mUKIY/83PYEXGtudVJJ8aXC8Np61SJilc776j6cGpVXhJARlc/Mczg/eQeUcQKNMVq3guMPp2cQ",
+	"0CLQjFjEUqULTmzCKi1Y1D3x/vjgx96RF10f/+gEWdLCRTNDTf/AmFgLoL62Y2eM5JC2B8iZKNAQL0q7",
+	"wdOy2pYajzoB5uFxpGu0bsDqGTDqWGWDMeuY3DcqJ8Ki9HG8Aea4D8zoEc3fzQ8PUVDEUJKgsMKwv3zV",
+	"6o3bPMDNcm7oZ62V7kP+THNpSqUJlIZfz86OAe1ASLUqgDIEOxeCRSIQKXA5t6jvHdEOPCVOlXmjkoFY",
+	"7hY3bgBopEpLTGA6d5ugTEolJIGSvU1HbMjyEm/o0A/4aut7oe6p2Npt/VHtdOLTHH9zeWfA8lWZPIqL",
+	"PjaElxhPF8+1q3Z9rnvIjqc3qotazHZB2T39PSLBWyQuXIDjef4hZZOPW1mELaLVSII3ccbl7N6gqYcH",
+	"d34sB9MYtI3JY671et5H2blBDdeZ8hCyBkdDmACHZuIQfBc961z07XPa4GR5z8sSZSLk7LJDDIBrtDDX",
+	"AhO4FpQB3viTCp7DlMefVZr+BJcpFzkml1Agl8ZhPxAzkA33q30LrrkBvMl4ZY80cp5oU8NHFgSwDlnF",
+	"MWKC1jn92uyid9qI9aJk71A/OycH7xCeBJEWsxlqAxy6qqyF8LAYBfdvcDIKGGgfJJgj3SFYx3FXAnaG",
+	"Pk4H24LBbtwkbCMncJm4xxpNqaTBmsYlI7isn12CMMCnbpXrDOVyPI5VlScgFcHUzuZx5hW/wnkr7Zj8",
+	"e7PEToSkVwcsuiOHh3PcE6InYbSb6E9w75lh+GokrAWIuufYEK5OWoGX1TBVyXwwB2TIk1CJ8iQRdgue",
+	"Hy/N7c1Z2X1F5nrFyG+6UdpWS/89cevMUFOBgTqwe5zO4GiLs/0uKDvFWCNtnTQGkoVpVlohLu8P34AR",
+	"MynkDPygEXyQ+bylMQ1wQiK1mApxwPIqQSZMBK2Ih+ClkSd2mbrS31wnBekuBgN1v6Y+bv59j8T7xq97",
+	"AJsK34h1K/P7F8wRI0U8P6qros34d2OP+QzvHLuikdCE6JT6nW2X1h1yoU1ptucCR6cf4MdX4z2gegwI",
+	"Cednb1jE8IYXZW6l/8j2x/svd/bGO3svzvYOJi/Gk/H4n3b3JiTaTLBjFxli0GuYW0+ak7++gYO9/X2w",
+	"ryHM79bhlUg2rq+mORaJY1vm07H/6cmXGd7thx/HP0AYCPXIXhZo6NtKVwGyquByx/q7ZZGWCeTcOyeY",
+	"EmORihhIAWXCgIrjSmuUMdrUbzEV5B06katVNgaqpjRfF7F8ub3aAmAfSr8aFLy0gqQC82QnxyvM4Yrn",
+	"IvHiBwEG/EtIQ1zGOKSP85Mj0JiiP6bjFiJBSSIV6ElQo5at1GHWELSGM/gBEPtAO4BFQfmgxCZTmqJV",
+	"Q5qqKLier0gGFEqGYY0/RB0rK6/0PzYHTn+mRjn9WLBw1kpVX7SQKwwkquBCQqwkaR7TBAgll2RA40wY",
+	"Ql86nza8ySx1zVzyQEejrE2d5xifMzwjhETFVYGSPG0OmSOCwBxtCgmcMQIhrW94btdQeqekDvlWKViu",
+	"1sgT+eEu96ykMtfCC3Znxzwv5ppbqMPh8RGL2BVq45VxtWdtqEqUvBRswl6MxqMDF38pcz63G5Kf+zHz",
+	"mdRC0Z33KOno851wdKvbo1+Ttdshu2v62IvogTNdzlhctDzSyb0/Hts/1tIoPa0oyzwYbfcP4/uDbU+5",
+	"JR3lcNzZpjfYD0srvuwXG0hn9ysp19KDxYVDwbL329yZQC4MWX+qmY2pps0g4+Nm6Kqv1VpA75/62ruP",
+	"0Buz1YDYrtEFz+q09dwpMUQqNmHW99YcJmLEZy6P147KLLkqlRlghScB+Qa4bOulVHkszsSVRbctL13Q",
+	"MiM46xZiQsZ5ldTBfgmQP4Egyx/VEsNUNjRaEAsDlcHE5kurtIrQrXH5j50A3p1TMZOcKo2X4Kk0PLs0",
+	"Gd9/+eov/6rG4xdxhjdgSe3O6a+H+y9fuYd4CcoGkks/pF2tYUV+2Mi/t8w8zHtui0+0xfBSVTyMfH95",
+	"wpqq73WoQe6Nt00es3wzs1gGT+igr4B979E275ckA74ZBjVpw3UFOuXWO+X3HujrnLyr02xdWFw3ixlV",
+	"6Xj5rms1Jy6eHlJrhAFvjlrjbBiqi6jNQbu3TU9z4bWZI+EQjAt1VeOwEw2A1AwpQ+2bV7aCC02mpSaX",
+	"TBqnh1zN1jv+W79/zwEP1rIO0E60hD1Fy1nJO3branZdmN3IGH5BYl+Zqe+Vf9dDNlWVfIq2+AVpS0Ns",
+	"R8vabxsWF+sAuNtCpsMNV4FoM53pMlkHqSalhuUikHiNhiAV2tB6wNk8/7bd9vtgm9HtujIzFTmhtvdh",
+	"zeGbu5WhDxyal1s5+Orl1fdFfzsXOd8dDe665FNlvZ3EZUHVRIX/SSDYvW1vIBfbRQWXkcnR6c4VyNrL",
+	"DstPbf5evhwZwWuV1BdVpCsZO1JFCl4dwN/E6/WR5RekxlO/fU5auRwdMH094smmqKO6pdGY+NE98u6w",
+	"3flEcHv/nTSdmNVvD7+llOtK079XWFnHhlRIYbJO9IqgMhXP8znw9upVSYys308xAGlme10NyHz/keoL",
+	"jHDTaL+/gESYklOcua/yjBnBEdmspcn4YtKtwSGt8tzdBc9hWiUze33SZmbfKbMgNCTyHNrLZO5ukG1k",
+	"r6+QD8Z/Xg/Lk8YEPVDufytQDjl7I8ccvlhDPE0CH84APHQxtqu4Jr7V2N7NPTyVrO+/lDmPBzsooTfa",
+	"Zg6J19bLf4JcfEbrwa6EFkpGa3stvl9TarwSqmpu7wyp0sAU7V6uCyOKAhPBCfP5JDRBbJrRnS+mXKfH",
+	"TvBfQ7jmbCcTex+BKaZK+1aO050XztSt4waN9ijhDnI9ErrK//YZanPL43Tp3vMpYsFJPuRmKr2LQ7mV",
+	"MK60oLlz/ClyjfqwooxNPl5Y7zaor2pYuM872S4vxa7ttl80a27+OGX5XqH5PMhdqbl7ivZeoi0gGikX",
+	"F4v/DABu57c2PS8AAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/iam.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef1.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/pagination.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef2.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/primitives.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	for rawPath, rawFunc := range externalRef3.PathToRawSpec(path.Join(path.Dir(pathToFile), "./common/problemdetails.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,23 @@
+// Package anchoring commits batches of entity version hashes to an external ledger. Hashes are combined into a
+// Merkle tree whose root is submitted through an Anchor, so a single ledger entry proves every version in the batch.
+package anchoring
+
+import (
+	"context"
+	"time"
+)
+
+// Receipt identifies where an Anchor recorded a Merkle root.
+type Receipt struct {
+	// Reference locates the ledger entry, e.g. a transaction id.
+	Reference  string
+	AnchoredAt time.Time
+}
+
+// Anchor records Merkle roots on an external ledger.
+type Anchor interface {
+	// Name identifies the ledger; it is stored with every batch the anchor recorded.
+	Name() string
+	// Submit records root, the hex-encoded Merkle root of a batch, and returns where it was recorded.
+	Submit(ctx context.Context, root string) (Receipt, error)
+}
@@ -0,0 +1,134 @@
+package anchoring
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Domain separation prefixes of leaf and interior node hashes (as in RFC 6962), so a leaf cannot be passed off as a
+// node.
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// ErrEmptyTree is returned when a tree is built without leaves.
+var ErrEmptyTree = errors.New("merkle tree needs at least one leaf")
+
+// Sibling positions in a proof step.
+const (
+	Left  = "left"
+	Right = "right"
+)
+
+// ProofStep is a sibling hash on the path from a leaf to the root, and the side it is combined on.
+type ProofStep struct {
+	Position string `json:"position"`
+	Hash     string `json:"hash"`
+}
+
+// EntityLeaf returns the hex-encoded leaf hash of an entity version: SHA-256 over 0x00 followed by the JSON array
+// ["<table>", "<entity id>", "<version>", "<document hash>"].
+func EntityLeaf(tableName, entityID, version, documentHash string) string {
+	data, _ := json.Marshal([]string{tableName, entityID, version, documentHash})
+	sum := sha256.Sum256(append([]byte{leafPrefix}, data...))
+	return hex.EncodeToString(sum[:])
+}
+
+// Tree is a binary Merkle tree over hex-encoded leaf hashes. Each level pairs nodes left to right, hashing
+// 0x01 || left || right; an odd last node is carried up unchanged.
+type Tree struct {
+	levels [][][]byte
+}
+
+// NewTree builds the tree of the given leaves, in order.
+func NewTree(leaves []string) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, ErrEmptyTree
+	}
+
+	level := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		decoded, err := hex.DecodeString(leaf)
+		if err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("leaf %d is not a hex-encoded SHA-256 hash", i)
+		}
+		level[i] = decoded
+	}
+
+	levels := [][][]byte{level}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashNode(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return &Tree{levels: levels}, nil
+}
+
+// Root returns the hex-encoded root hash.
+func (t *Tree) Root() string {
+	return hex.EncodeToString(t.levels[len(t.levels)-1][0])
+}
+
+// Proof returns the sibling hashes from the leaf at index up to the root.
+func (t *Tree) Proof(index int) ([]ProofStep, error) {
+	if index < 0 || index >= len(t.levels[0]) {
+		return nil, fmt.Errorf("leaf index %d out of range", index)
+	}
+
+	steps := []ProofStep{}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			position := Right
+			if sibling < index {
+				position = Left
+			}
+			steps = append(steps, ProofStep{Position: position, Hash: hex.EncodeToString(level[sibling])})
+		}
+		index /= 2
+	}
+	return steps, nil
+}
+
+// VerifyProof reports whether combining leaf with the proof steps yields root.
+func VerifyProof(leaf string, proof []ProofStep, root string) bool {
+	current, err := hex.DecodeString(leaf)
+	if err != nil {
+		return false
+	}
+	for _, step := range proof {
+		sibling, err := hex.DecodeString(step.Hash)
+		if err != nil {
+			return false
+		}
+		switch step.Position {
+		case Left:
+			current = hashNode(sibling, current)
+		case Right:
+			current = hashNode(current, sibling)
+		default:
+			return false
+		}
+	}
+	return hex.EncodeToString(current) == root
+}
+
+func hashNode(left, right []byte) []byte {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, nodePrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
@@ -0,0 +1,63 @@
+package anchoring
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeProofsVerifyEveryLeaf(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{1, 2, 3, 5, 8, 13} {
+		leaves := make([]string, size)
+		for i := range leaves {
+			leaves[i] = EntityLeaf("cards_entities", fmt.Sprintf("card-%d", i), "1.0.0", strings.Repeat("a", 64))
+		}
+
+		tree, err := NewTree(leaves)
+		require.NoError(t, err)
+		for i, leaf := range leaves {
+			proof, err := tree.Proof(i)
+			require.NoError(t, err)
+			require.True(t, VerifyProof(leaf, proof, tree.Root()), "size %d leaf %d", size, i)
+		}
+
+		if size > 1 {
+			proof, err := tree.Proof(0)
+			require.NoError(t, err)
+			require.False(t, VerifyProof(leaves[1], proof, tree.Root()), "a proof does not verify another leaf")
+		}
+	}
+
+	single, err := NewTree([]string{EntityLeaf("t", "e", "1.0.0", "h")})
+	require.NoError(t, err)
+	require.Equal(t, EntityLeaf("t", "e", "1.0.0", "h"), single.Root())
+
+	_, err = NewTree(nil)
+	require.ErrorIs(t, err, ErrEmptyTree)
+	_, err = NewTree([]string{"not-hex"})
+	require.Error(t, err)
+}
+
+func TestEntityLeafSeparatesFields(t *testing.T) {
+	t.Parallel()
+
+	require.NotEqual(t, EntityLeaf("a", "b/c", "1.0.0", "h"), EntityLeaf("a/b", "c", "1.0.0", "h"))
+}
+
+func TestMockAnchorReferenceIsStablePerRoot(t *testing.T) {
+	t.Parallel()
+
+	anchor := NewMockAnchor()
+	first, err := anchor.Submit(context.Background(), "root")
+	require.NoError(t, err)
+	second, err := anchor.Submit(context.Background(), "root")
+	require.NoError(t, err)
+	require.Equal(t, first.Reference, second.Reference)
+	require.True(t, strings.HasPrefix(first.Reference, "mock:"))
+	require.False(t, first.AnchoredAt.IsZero())
+}
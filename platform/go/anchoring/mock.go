@@ -0,0 +1,29 @@
+package anchoring
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// MockAnchor records nothing and returns a reference derived from the root, for development and tests.
+type MockAnchor struct {
+	now func() time.Time
+}
+
+// NewMockAnchor constructs a MockAnchor.
+func NewMockAnchor() *MockAnchor {
+	return &MockAnchor{now: time.Now}
+}
+
+// Name implements Anchor.
+func (a *MockAnchor) Name() string {
+	return "mock"
+}
+
+// Submit implements Anchor. The reference is "mock:" followed by the SHA-256 of the root, so it is stable per root.
+func (a *MockAnchor) Submit(_ context.Context, root string) (Receipt, error) {
+	sum := sha256.Sum256([]byte(root))
+	return Receipt{Reference: "mock:" + hex.EncodeToString(sum[:]), AnchoredAt: a.now().UTC()}, nil
+}
@@ -0,0 +1,237 @@
+// Package apiclient is the Go SDK of the Palmyra Pro API. It puts the clients generated from each contract
+// (generated/go/<domain>/client.gen.go) behind one HTTP client that authenticates every request and retries the
+// ones that failed transiently, and adds helpers to page through list operations and read problem details.
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	attachmentsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/attachments"
+	authapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/auth"
+	entitiesapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/entities"
+	featuresapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/features"
+	graphqlapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/graphql"
+	jobsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/jobs"
+	loggingapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/logging"
+	privacyapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/privacy"
+	schedulerapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/scheduler"
+	schemacategories "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-categories"
+	schemarepository "github.com/zenGate-Global/palmyra-pro-saas/generated/go/schema-repository"
+	statsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/stats"
+	tenantsapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/tenants"
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+	webhooksapi "github.com/zenGate-Global/palmyra-pro-saas/generated/go/webhooks"
+)
+
+// BasePath is the path every v1 contract is served under.
+const BasePath = "/api/v1"
+
+// Header names the API reads besides Authorization; they match platform/go/auth and platform/go/tenant/middleware.
+const (
+	APIKeyHeader            = "X-API-Key"
+	SwitchTenantHeader      = "X-Palmyra-Tenant"
+	ImpersonateTenantHeader = "X-Palmyra-Impersonate-Tenant"
+)
+
+// Client holds a typed client per domain, all sharing one configured HTTP client.
+type Client struct {
+	Attachments      *attachmentsapi.ClientWithResponses
+	Auth             *authapi.ClientWithResponses
+	Entities         *entitiesapi.ClientWithResponses
+	Features         *featuresapi.ClientWithResponses
+	GraphQL          *graphqlapi.ClientWithResponses
+	Jobs             *jobsapi.ClientWithResponses
+	Logging          *loggingapi.ClientWithResponses
+	Privacy          *privacyapi.ClientWithResponses
+	Scheduler        *schedulerapi.ClientWithResponses
+	SchemaCategories *schemacategories.ClientWithResponses
+	SchemaRepository *schemarepository.ClientWithResponses
+	Stats            *statsapi.ClientWithResponses
+	Tenants          *tenantsapi.ClientWithResponses
+	Users            *users.ClientWithResponses
+	Webhooks         *webhooksapi.ClientWithResponses
+
+	// HTTPClient is the configured client, for the requests the generated clients do not cover.
+	HTTPClient *http.Client
+	// BaseURL is the API root the domain paths are resolved against, ending in BasePath.
+	BaseURL string
+}
+
+// TokenSource returns the bearer token for a request. It is called for every attempt, so it can refresh an
+// expiring token.
+type TokenSource func(ctx context.Context) (string, error)
+
+type options struct {
+	httpClient *http.Client
+	token      TokenSource
+	apiKey     string
+	tenant     string
+	userAgent  string
+	retry      RetryPolicy
+}
+
+// Option configures New.
+type Option func(*options)
+
+// WithBearerToken authenticates with a fixed bearer token, like one printed by cli-platform-admin devtoken.
+func WithBearerToken(token string) Option {
+	return WithTokenSource(func(context.Context) (string, error) { return token, nil })
+}
+
+// WithTokenSource authenticates with the bearer token source returns.
+func WithTokenSource(source TokenSource) Option {
+	return func(o *options) { o.token = source }
+}
+
+// WithAPIKey authenticates with a service account's API key instead of a bearer token.
+func WithAPIKey(key string) Option {
+	return func(o *options) { o.apiKey = key }
+}
+
+// WithTenant runs every request in the tenant named by id or slug, which the caller must be a member of.
+func WithTenant(tenant string) Option {
+	return func(o *options) { o.tenant = tenant }
+}
+
+// WithUserAgent identifies the calling service in the server logs.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) { o.userAgent = userAgent }
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy; RetryPolicy{MaxAttempts: 1} disables retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) { o.retry = policy }
+}
+
+// WithHTTPClient sends the requests through c, whose transport the authentication and retries wrap. Its Timeout
+// bounds each attempt.
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *options) { o.httpClient = c }
+}
+
+// Impersonate returns a request editor that runs one request as the tenant named by id or slug; only admins may
+// impersonate. It fits the reqEditors argument of every generated operation:
+//
+//	c.Users.UsersCreateWithResponse(ctx, body, apiclient.Impersonate("acme"))
+func Impersonate(tenant string) func(context.Context, *http.Request) error {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(ImpersonateTenantHeader, tenant)
+		return nil
+	}
+}
+
+// New builds a Client for the API at baseURL, the server root ("https://api.example.com"); a trailing BasePath is
+// accepted too.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	o := options{retry: DefaultRetryPolicy, userAgent: "palmyra-apiclient"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.token != nil && o.apiKey != "" {
+		return nil, errors.New("use either a bearer token or an API key, not both")
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid base url %q: expected scheme and host", baseURL)
+	}
+	root := strings.TrimSuffix(strings.TrimRight(parsed.String(), "/"), BasePath) + BasePath
+
+	base := http.DefaultTransport
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		copied := *o.httpClient
+		httpClient = &copied
+		if copied.Transport != nil {
+			base = copied.Transport
+		}
+	}
+	// Retries wrap authentication, so every attempt asks the token source again.
+	httpClient.Transport = &retryTransport{
+		base:   &authTransport{base: base, opts: o},
+		policy: o.retry,
+	}
+
+	c := &Client{HTTPClient: httpClient, BaseURL: root}
+	var errs []error
+	collect := func(name string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s client: %w", name, err))
+		}
+	}
+	c.Attachments, err = attachmentsapi.NewClientWithResponses(root, attachmentsapi.WithHTTPClient(httpClient))
+	collect("attachments", err)
+	c.Auth, err = authapi.NewClientWithResponses(root, authapi.WithHTTPClient(httpClient))
+	collect("auth", err)
+	c.Entities, err = entitiesapi.NewClientWithResponses(root, entitiesapi.WithHTTPClient(httpClient))
+	collect("entities", err)
+	c.Features, err = featuresapi.NewClientWithResponses(root, featuresapi.WithHTTPClient(httpClient))
+	collect("features", err)
+	c.GraphQL, err = graphqlapi.NewClientWithResponses(root, graphqlapi.WithHTTPClient(httpClient))
+	collect("graphql", err)
+	c.Jobs, err = jobsapi.NewClientWithResponses(root, jobsapi.WithHTTPClient(httpClient))
+	collect("jobs", err)
+	c.Logging, err = loggingapi.NewClientWithResponses(root, loggingapi.WithHTTPClient(httpClient))
+	collect("logging", err)
+	c.Privacy, err = privacyapi.NewClientWithResponses(root, privacyapi.WithHTTPClient(httpClient))
+	collect("privacy", err)
+	c.Scheduler, err = schedulerapi.NewClientWithResponses(root, schedulerapi.WithHTTPClient(httpClient))
+	collect("scheduler", err)
+	c.SchemaCategories, err = schemacategories.NewClientWithResponses(root, schemacategories.WithHTTPClient(httpClient))
+	collect("schema-categories", err)
+	c.SchemaRepository, err = schemarepository.NewClientWithResponses(root, schemarepository.WithHTTPClient(httpClient))
+	collect("schema-repository", err)
+	c.Stats, err = statsapi.NewClientWithResponses(root, statsapi.WithHTTPClient(httpClient))
+	collect("stats", err)
+	c.Tenants, err = tenantsapi.NewClientWithResponses(root, tenantsapi.WithHTTPClient(httpClient))
+	collect("tenants", err)
+	c.Users, err = users.NewClientWithResponses(root, users.WithHTTPClient(httpClient))
+	collect("users", err)
+	c.Webhooks, err = webhooksapi.NewClientWithResponses(root, webhooksapi.WithHTTPClient(httpClient))
+	collect("webhooks", err)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return c, nil
+}
+
+// authTransport sets the credentials, tenant and user agent on a copy of each request.
+type authTransport struct {
+	base http.RoundTripper
+	opts options
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	switch {
+	case t.opts.token != nil:
+		token, err := t.opts.token(req.Context())
+		if err != nil {
+			closeBody(req)
+			return nil, fmt.Errorf("bearer token: %w", err)
+		}
+		out.Header.Set("Authorization", "Bearer "+token)
+	case t.opts.apiKey != "":
+		out.Header.Set(APIKeyHeader, t.opts.apiKey)
+	}
+	if t.opts.tenant != "" && out.Header.Get(SwitchTenantHeader) == "" {
+		out.Header.Set(SwitchTenantHeader, t.opts.tenant)
+	}
+	if t.opts.userAgent != "" && out.Header.Get("User-Agent") == "" {
+		out.Header.Set("User-Agent", t.opts.userAgent)
+	}
+	return t.base.RoundTrip(out)
+}
+
+// closeBody honours the RoundTripper contract of closing the request body, even on errors.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
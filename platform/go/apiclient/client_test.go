@@ -0,0 +1,144 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	users "github.com/zenGate-Global/palmyra-pro-saas/generated/go/users"
+)
+
+var fastRetries = WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond})
+
+func TestClientAuthenticatesAndPages(t *testing.T) {
+	t.Parallel()
+
+	var tokens atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/admin/users", r.URL.Path)
+		require.Equal(t, "Bearer token-"+strconv.Itoa(int(tokens.Load())), r.Header.Get("Authorization"))
+		require.Equal(t, "acme", r.Header.Get(SwitchTenantHeader))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items":      []map[string]any{{"id": "00000000-0000-0000-0000-00000000000" + strconv.Itoa(page), "email": "user" + strconv.Itoa(page) + "@example.com"}},
+			"page":       page,
+			"pageSize":   1,
+			"totalItems": 3,
+			"totalPages": 3,
+		})
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL+"/", WithTenant("acme"), WithTokenSource(func(context.Context) (string, error) {
+		return "token-" + strconv.Itoa(int(tokens.Add(1))), nil
+	}))
+	require.NoError(t, err)
+	require.Equal(t, server.URL+BasePath, c.BaseURL)
+
+	all, err := All(context.Background(), func(ctx context.Context, page int) ([]users.User, int, error) {
+		rsp, err := c.Users.UsersListWithResponse(ctx, &users.UsersListParams{Page: &page})
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := Check(rsp.StatusCode(), rsp.Body); err != nil {
+			return nil, 0, err
+		}
+		return rsp.JSON200.Items, rsp.JSON200.TotalPages, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, "user3@example.com", string(all[2].Email))
+	require.EqualValues(t, 3, tokens.Load(), "the token source is asked for every request")
+
+	_, err = New("api.example.com", WithAPIKey("k"))
+	require.ErrorContains(t, err, "expected scheme and host")
+	_, err = New("https://api.example.com", WithAPIKey("k"), WithBearerToken("t"))
+	require.Error(t, err)
+}
+
+func TestClientRetries(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	var bodies []string
+	answers := []int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		require.Equal(t, "key-1", r.Header.Get(APIKeyHeader))
+		status := http.StatusOK
+		if n < len(answers) {
+			status = answers[n]
+		}
+		if status == http.StatusServiceUnavailable {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"title":"Service Unavailable","status":503,"detail":"tenant in maintenance"}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, WithAPIKey("key-1"), fastRetries)
+	require.NoError(t, err)
+	post := func() *http.Response {
+		calls.Store(0)
+		bodies = nil
+		rsp, err := c.Users.UsersInviteWithBody(context.Background(), "application/json", strings.NewReader(`{"email":"a@example.com"}`))
+		require.NoError(t, err)
+		_ = rsp.Body.Close()
+		return rsp
+	}
+
+	// Refused before acting: retried, with the body sent again.
+	answers = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	rsp := post()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	require.EqualValues(t, 3, calls.Load())
+	require.Equal(t, []string{`{"email":"a@example.com"}`, `{"email":"a@example.com"}`, `{"email":"a@example.com"}`}, bodies)
+
+	// A gateway error may have applied the POST, so it is not retried; a GET is.
+	answers = []int{http.StatusBadGateway}
+	require.Equal(t, http.StatusBadGateway, post().StatusCode)
+	require.EqualValues(t, 1, calls.Load())
+
+	calls.Store(0)
+	got, err := c.Users.UsersMeWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, got.StatusCode())
+	require.EqualValues(t, 2, calls.Load())
+
+	// Attempts run out: the last answer is returned and Check reads its problem details.
+	answers = []int{503, 503, 503}
+	calls.Store(0)
+	got, err = c.Users.UsersMeWithResponse(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 3, calls.Load())
+	err = Check(got.StatusCode(), got.Body)
+	require.EqualError(t, err, "api answered 503: Service Unavailable: tenant in maintenance")
+	require.Equal(t, http.StatusServiceUnavailable, StatusOf(err))
+}
+
+func TestRetryAfterBeyondMaxDelayIsNotWaited(t *testing.T) {
+	t.Parallel()
+
+	transport := &retryTransport{policy: RetryPolicy{MaxAttempts: 3, MaxDelay: time.Second}}
+	_, ok := transport.delay(1, &http.Response{Header: http.Header{"Retry-After": {"120"}}})
+	require.False(t, ok)
+
+	wait, ok := transport.delay(4, &http.Response{Header: http.Header{}})
+	require.True(t, ok)
+	require.LessOrEqual(t, wait, time.Second)
+}
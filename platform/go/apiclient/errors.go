@@ -0,0 +1,58 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	problemdetails "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// Error is an answer outside 2xx. Problem holds the problem details the API sent, when the body is one.
+type Error struct {
+	Status  int
+	Problem *problemdetails.ProblemDetails
+	Body    []byte
+}
+
+func (e *Error) Error() string {
+	if e.Problem == nil {
+		return fmt.Sprintf("api answered %d %s", e.Status, http.StatusText(e.Status))
+	}
+	msg := fmt.Sprintf("api answered %d: %s", e.Status, e.Problem.Title)
+	if e.Problem.Detail != nil && *e.Problem.Detail != "" {
+		msg += ": " + *e.Problem.Detail
+	}
+	return msg
+}
+
+// Check turns the status and body of a generated response into an *Error unless the status is 2xx:
+//
+//	rsp, err := c.Users.UsersGetWithResponse(ctx, id)
+//	if err != nil {
+//		return err
+//	}
+//	if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+//		return err
+//	}
+func Check(status int, body []byte) error {
+	if status >= 200 && status < 300 {
+		return nil
+	}
+	apiErr := &Error{Status: status, Body: body}
+	var problem problemdetails.ProblemDetails
+	if err := json.Unmarshal(body, &problem); err == nil && problem.Title != "" {
+		apiErr.Problem = &problem
+	}
+	return apiErr
+}
+
+// StatusOf returns the status of an *Error in err's chain, or 0.
+func StatusOf(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Status
+	}
+	return 0
+}
@@ -0,0 +1,64 @@
+package apiclient
+
+import (
+	"context"
+	"iter"
+)
+
+// PageFunc fetches one page of a list operation, 1-indexed, and returns its items and the total number of pages
+// from the pagination envelope.
+type PageFunc[T any] func(ctx context.Context, page int) (items []T, totalPages int, err error)
+
+// Items iterates over the items of every page fetch returns, requesting the next page only once the previous one
+// is consumed. It stops after the last page, at the first error, or when the loop breaks:
+//
+//	for user, err := range apiclient.Items(ctx, func(ctx context.Context, page int) ([]users.User, int, error) {
+//		rsp, err := c.Users.UsersListWithResponse(ctx, &users.UsersListParams{Page: &page})
+//		if err != nil {
+//			return nil, 0, err
+//		}
+//		if err := apiclient.Check(rsp.StatusCode(), rsp.Body); err != nil {
+//			return nil, 0, err
+//		}
+//		return rsp.JSON200.Items, rsp.JSON200.TotalPages, nil
+//	}) {
+//		...
+//	}
+func Items[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			items, totalPages, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			// An empty page ends the listing even if the total changed while paging.
+			if page >= totalPages || len(items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// All collects every item Items yields.
+func All[T any](ctx context.Context, fetch PageFunc[T]) ([]T, error) {
+	var all []T
+	for item, err := range Items(ctx, fetch) {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyHeader carries an API key on machine requests, in place of a bearer token.
+const APIKeyHeader = "X-API-Key"
+
+// apiKeyPrefix marks Palmyra API keys. A key reads plk_<tenant id>_<key id>_<secret>, ids as 32 hex characters,
+// so the tenant whose schema stores the key is known before any lookup.
+const apiKeyPrefix = "plk_"
+
+// ErrInvalidAPIKey is returned for malformed, unknown, revoked or expired API keys.
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// APIKeyResolver maps an API key to the credentials of the user who minted it.
+type APIKeyResolver interface {
+	ResolveAPIKey(ctx context.Context, key string) (*UserCredentials, error)
+}
+
+// NewAPIKey returns a fresh key for tenantID and keyID together with the hash to store; the key itself is only
+// ever shown to its owner.
+func NewAPIKey(tenantID, keyID uuid.UUID) (key, secretHash string, err error) {
+	return newSecretKey(apiKeyPrefix, tenantID, keyID)
+}
+
+// ParseAPIKey splits a key produced by NewAPIKey.
+func ParseAPIKey(key string) (tenantID, keyID uuid.UUID, secret string, err error) {
+	tenantID, keyID, secret, ok := parseSecretKey(apiKeyPrefix, key)
+	if !ok {
+		return uuid.Nil, uuid.Nil, "", ErrInvalidAPIKey
+	}
+	return tenantID, keyID, secret, nil
+}
+
+// newSecretKey builds <prefix><tenant id>_<id>_<secret> with a random secret and returns the secret's hash.
+func newSecretKey(prefix string, tenantID, id uuid.UUID) (key, secretHash string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	encoded := hex.EncodeToString(secret)
+	return prefix + hex.EncodeToString(tenantID[:]) + "_" + hex.EncodeToString(id[:]) + "_" + encoded, HashAPIKeySecret(encoded), nil
+}
+
+func parseSecretKey(prefix, key string) (tenantID, id uuid.UUID, secret string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(key, prefix), "_")
+	if !strings.HasPrefix(key, prefix) || len(parts) != 3 || parts[2] == "" {
+		return uuid.Nil, uuid.Nil, "", false
+	}
+	tenantID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, "", false
+	}
+	if id, err = uuid.Parse(parts[1]); err != nil {
+		return uuid.Nil, uuid.Nil, "", false
+	}
+	return tenantID, id, parts[2], true
+}
+
+// HashAPIKeySecret is the stored form of a key secret.
+func HashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKey authenticates requests that carry X-API-Key instead of a bearer token. It runs after JWT and leaves
+// requests already authenticated by a token alone. The resolved credentials have APIKeyID set and Scopes limited
+// to the key's scopes.
+func APIKey(resolver APIKeyResolver) func(http.Handler) http.Handler {
+	if resolver == nil {
+		panic("auth.APIKey: resolver must not be nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimSpace(r.Header.Get(APIKeyHeader))
+			if key == "" || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if creds, ok := UserFromContext(r.Context()); ok && creds != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			creds, err := resolver.ResolveAPIKey(r.Context(), key)
+			if err != nil {
+				if errors.Is(err, ErrInvalidAPIKey) {
+					w.Header().Set("WWW-Authenticate", `ApiKey realm="api", error="invalid_key"`)
+					http.Error(w, "unauthorized", http.StatusUnauthorized)
+					return
+				}
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithUserCredentials(r.Context(), creds)))
+		})
+	}
+}
+
+// ScopeAllows reports whether the credentials' API key or service account scopes cover permission. Credentials
+// from a user token carry no scopes and are not narrowed.
+func (c *UserCredentials) ScopeAllows(permission string) bool {
+	if c.APIKeyID == "" && c.ServiceAccountID == "" {
+		return true
+	}
+	return HasPermission(c.Scopes, permission)
+}
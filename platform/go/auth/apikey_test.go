@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyRoundTrip(t *testing.T) {
+	tenantID, keyID := uuid.New(), uuid.New()
+
+	key, secretHash, err := NewAPIKey(tenantID, keyID)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, "plk_"))
+
+	gotTenant, gotKey, secret, err := ParseAPIKey(key)
+	require.NoError(t, err)
+	require.Equal(t, tenantID, gotTenant)
+	require.Equal(t, keyID, gotKey)
+	require.Equal(t, secretHash, HashAPIKeySecret(secret))
+
+	for _, malformed := range []string{"", "plk_", "plk_a_b_c", key[4:], key + "_extra", strings.TrimSuffix(key, key[len(key)-64:])} {
+		_, _, _, err := ParseAPIKey(malformed)
+		require.ErrorIs(t, err, ErrInvalidAPIKey, malformed)
+	}
+}
+
+type stubAPIKeyResolver map[string]*UserCredentials
+
+func (s stubAPIKeyResolver) ResolveAPIKey(_ context.Context, key string) (*UserCredentials, error) {
+	if key == "broken" {
+		return nil, errors.New("database down")
+	}
+	creds, ok := s[key]
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+	return creds, nil
+}
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	resolver := stubAPIKeyResolver{"good": {Id: "user-1", APIKeyID: "key-1", Scopes: []string{"users:read"}}}
+
+	var got *UserCredentials
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = UserFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(key string, existing *UserCredentials) int {
+		got = nil
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users", nil)
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		if existing != nil {
+			req = req.WithContext(WithUserCredentials(req.Context(), existing))
+		}
+		rec := httptest.NewRecorder()
+		APIKey(resolver)(next).ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve("good", nil))
+	require.Equal(t, "key-1", got.APIKeyID)
+
+	require.Equal(t, http.StatusOK, serve("", nil))
+	require.Nil(t, got)
+
+	// A bearer token wins over the key.
+	require.Equal(t, http.StatusOK, serve("good", &UserCredentials{Id: "token-user"}))
+	require.Equal(t, "token-user", got.Id)
+
+	require.Equal(t, http.StatusUnauthorized, serve("unknown", nil))
+	require.Equal(t, http.StatusInternalServerError, serve("broken", nil))
+}
+
+type stubPermissionResolver []string
+
+func (s stubPermissionResolver) ResolvePermissions(context.Context, *UserCredentials) ([]string, error) {
+	return s, nil
+}
+
+func TestRequirePermissionHonoursAPIKeyScopes(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	serve := func(creds *UserCredentials, permission string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req = req.WithContext(WithUserCredentials(req.Context(), creds))
+		rec := httptest.NewRecorder()
+		RequirePermission(stubPermissionResolver{PermissionAll}, permission)(next).ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	scoped := &UserCredentials{Id: "user-1", APIKeyID: "key-1", Scopes: []string{"users:read"}}
+	require.Equal(t, http.StatusOK, serve(scoped, "users:read"))
+	require.Equal(t, http.StatusForbidden, serve(scoped, "users:write"))
+	require.Equal(t, http.StatusOK, serve(&UserCredentials{Id: "user-1"}, "users:write"))
+}
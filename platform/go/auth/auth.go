@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"firebase.google.com/go/v4/auth"
 )
@@ -26,6 +27,21 @@ type UserCredentials struct {
 	PictureURL    *string
 	IsAdmin       bool
 	TenantID      *string
+	// TenantRoles are the tenant-scoped roles carried by the token (tenantRoles claim); see RequirePermission.
+	TenantRoles []string
+	// IssuedAt (iat) changes with every token refresh; AuthTime (auth_time) only when the user signs in again.
+	// Either is zero when the token lacks the claim.
+	IssuedAt time.Time
+	AuthTime time.Time
+	// TokenID is the jti claim, empty when the token has none; see RevocationList.
+	TokenID string
+	// APIKeyID is set when the request authenticated with an API key; Scopes then lists the permissions the key
+	// may use (see ScopeAllows).
+	APIKeyID string
+	// ServiceAccountID is set when the request carries a service token (see ServiceTokenSigner). Id is then the
+	// service account id, and Scopes are the only permissions it holds.
+	ServiceAccountID string
+	Scopes           []string
 }
 
 // UserFromContext extracts UserCredentials previously stored in the context (typically by the JWT middleware).
@@ -39,6 +55,12 @@ func UserFromContext(ctx context.Context) (*UserCredentials, bool) {
 	return u, ok
 }
 
+// WithUserCredentials returns a derived context carrying creds, replacing the credentials set by JWT. Used when
+// middleware narrows the caller to another tenant (see the tenant middleware's tenant switching).
+func WithUserCredentials(ctx context.Context, creds *UserCredentials) context.Context {
+	return context.WithValue(ctx, ctxUserCredentials, creds)
+}
+
 // VerifyFunc validates the incoming JWT and returns its claims map.
 type VerifyFunc func(ctx context.Context, token string) (map[string]interface{}, error)
 
@@ -92,6 +114,9 @@ func DefaultCredentialExtractor(claims map[string]interface{}) (*UserCredentials
 	if claims == nil {
 		return nil, errors.New("missing claims")
 	}
+	if extractStringClaim(claims, "iss") == ServiceTokenIssuer {
+		return serviceAccountCredentials(claims)
+	}
 
 	creds := &UserCredentials{
 		Id:            fallbackStringClaim(claims, []string{"uid", "user_id", "sub"}, "unknown-user"),
@@ -101,6 +126,10 @@ func DefaultCredentialExtractor(claims map[string]interface{}) (*UserCredentials
 		PictureURL:    extractOptionalStringClaim(claims, "picture"),
 		IsAdmin:       extractBoolClaim(claims, "isAdmin"),
 		TenantID:      extractTenantID(claims),
+		TenantRoles:   extractStringSliceClaim(claims, "tenantRoles"),
+		IssuedAt:      extractTimeClaim(claims, "iat"),
+		AuthTime:      extractTimeClaim(claims, "auth_time"),
+		TokenID:       extractStringClaim(claims, "jti"),
 	}
 
 	if creds.TenantID == nil || *creds.TenantID == "" {
@@ -128,6 +157,43 @@ func extractStringClaim(claims map[string]interface{}, key string) string {
 	return ""
 }
 
+func extractStringSliceClaim(claims map[string]interface{}, key string) []string {
+	raw, ok := claims[key].([]interface{})
+	if !ok {
+		return nil
+	}
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if strVal, valid := v.(string); valid && strVal != "" {
+			values = append(values, strVal)
+		}
+	}
+	return values
+}
+
+// extractTimeClaim reads a NumericDate claim (seconds since the epoch).
+func extractTimeClaim(claims map[string]interface{}, key string) time.Time {
+	var seconds int64
+	switch v := claims[key].(type) {
+	case float64:
+		seconds = int64(v)
+	case int64:
+		seconds = v
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return time.Time{}
+		}
+		seconds = n
+	default:
+		return time.Time{}
+	}
+	if seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0).UTC()
+}
+
 func extractOptionalStringClaim(claims map[string]interface{}, key string) *string {
 	if v, ok := claims[key]; ok {
 		if strVal, valid := v.(string); valid && strVal != "" {
@@ -200,6 +266,9 @@ func FirebaseTokenVerifier(fbAuth *auth.Client) VerifyFunc {
 		}
 		claims["uid"] = t.UID
 		claims["sub"] = t.Subject
+		// VerifyIDToken moves the standard claims out of Claims; keep the ones credentials read.
+		claims["iat"] = t.IssuedAt
+		claims["auth_time"] = t.AuthTime
 		if tenant := t.Firebase.Tenant; tenant != "" {
 			if firebaseClaim, ok := claims["firebase"].(map[string]interface{}); ok {
 				firebaseClaim["tenant"] = tenant
@@ -245,3 +314,84 @@ func RequireRole(role string) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// PermissionAll is the wildcard permission; a caller holding it passes every RequirePermission check.
+const PermissionAll = "*"
+
+// PermissionResolver maps the caller's roles to the permissions they grant in the active tenant.
+type PermissionResolver interface {
+	ResolvePermissions(ctx context.Context, creds *UserCredentials) ([]string, error)
+}
+
+// ErrPermissionDenied is returned by Authorize when the caller lacks the permission.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// PlatformScopeSuffix marks platform scopes such as tenants:admin. Only platform admins hold them: tenant roles,
+// including the * wildcard, API keys and service accounts never grant them.
+const PlatformScopeSuffix = ":admin"
+
+// IsPlatformScope reports whether permission is reserved for platform admins.
+func IsPlatformScope(permission string) bool {
+	return strings.HasSuffix(permission, PlatformScopeSuffix)
+}
+
+// Authorize checks that creds hold permission: platform admins hold every permission, service accounts hold
+// their scopes, and everyone else holds the permissions resolver maps their roles to, narrowed by the scopes of
+// any API key used. It returns ErrPermissionDenied when they do not.
+func Authorize(ctx context.Context, resolver PermissionResolver, creds *UserCredentials, permission string) error {
+	if creds == nil {
+		return ErrPermissionDenied
+	}
+	if creds.IsAdmin {
+		return nil
+	}
+	if IsPlatformScope(permission) || !creds.ScopeAllows(permission) {
+		return ErrPermissionDenied
+	}
+	// A service account holds no roles; its scopes are its permissions.
+	if creds.ServiceAccountID != "" {
+		return nil
+	}
+
+	granted, err := resolver.ResolvePermissions(ctx, creds)
+	if err != nil {
+		return fmt.Errorf("resolve permissions: %w", err)
+	}
+	if !HasPermission(granted, permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// RequirePermission lets the request through when Authorize grants permission to the caller. It must run after
+// the tenant middleware, since roles are tenant scoped.
+func RequirePermission(resolver PermissionResolver, permission string) func(http.Handler) http.Handler {
+	if resolver == nil {
+		panic("auth.RequirePermission: resolver must not be nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			creds, _ := UserFromContext(r.Context())
+			err := Authorize(r.Context(), resolver, creds, permission)
+			switch {
+			case errors.Is(err, ErrPermissionDenied):
+				http.Error(w, "forbidden", http.StatusForbidden)
+			case err != nil:
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+			default:
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// HasPermission reports whether granted includes permission, directly or through PermissionAll.
+func HasPermission(granted []string, permission string) bool {
+	for _, p := range granted {
+		if p == permission || p == PermissionAll {
+			return true
+		}
+	}
+	return false
+}
@@ -50,8 +50,14 @@ func TestDefaultCredentialExtractorWithTenantID(t *testing.T) {
 		},
 		"isAdmin":        true,
 		"email_verified": true,
+		"tenantRoles":    []interface{}{"editor", 7, ""},
+		"iat":            float64(1700000600),
+		"auth_time":      int64(1700000000),
 	})
 	require.NoError(t, err)
 	require.NotNil(t, creds.TenantID)
 	require.Equal(t, "tenant-dev", *creds.TenantID)
+	require.Equal(t, []string{"editor"}, creds.TenantRoles)
+	require.Equal(t, int64(1700000600), creds.IssuedAt.Unix())
+	require.Equal(t, int64(1700000000), creds.AuthTime.Unix())
 }
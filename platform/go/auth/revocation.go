@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Revocation kinds.
+const (
+	// RevocationKindUser rejects every token of a subject issued at or before the revocation. A subject naming a
+	// users row id also rejects the API keys of that user, whose credentials carry that id and no iat.
+	RevocationKindUser = "user"
+	// RevocationKindToken rejects the single token carrying the jti.
+	RevocationKindToken = "token"
+)
+
+// ErrTokenRevoked is returned by RevocationList.Check for revoked tokens.
+var ErrTokenRevoked = errors.New("token revoked")
+
+// revocationRetry is how long a failed reload is not retried; the entries of the last load keep applying meanwhile.
+const revocationRetry = 5 * time.Second
+
+// Revocation is an entry of the revocation list. Subject is the token subject (uid) for RevocationKindUser and
+// the jti for RevocationKindToken.
+type Revocation struct {
+	Kind      string
+	Subject   string
+	RevokedAt time.Time
+}
+
+// RevocationLoader returns the revocations currently in force.
+type RevocationLoader func(ctx context.Context) ([]Revocation, error)
+
+// RevocationList keeps the revocations in memory and reloads them every ttl, so every instance sharing the
+// loader's store picks up new entries within ttl. Invalidate forces a reload on the next check, which makes a
+// change made through this instance take effect immediately. A failed reload keeps the previous entries and is
+// retried after revocationRetry rather than on every check.
+type RevocationList struct {
+	load   RevocationLoader
+	ttl    time.Duration
+	logger *zap.Logger
+
+	mu        sync.Mutex
+	loaded    bool
+	failing   bool
+	users     map[string]time.Time
+	tokens    map[string]struct{}
+	expiresAt time.Time
+	now       func() time.Time
+}
+
+// NewRevocationList returns a list backed by load and reloaded every ttl.
+func NewRevocationList(load RevocationLoader, ttl time.Duration, logger *zap.Logger) *RevocationList {
+	if load == nil {
+		panic("auth.NewRevocationList: loader must not be nil")
+	}
+	if logger == nil {
+		panic("auth.NewRevocationList: logger must not be nil")
+	}
+	return &RevocationList{load: load, ttl: ttl, logger: logger, now: time.Now}
+}
+
+// Invalidate drops the cached entries; the next check reloads them.
+func (l *RevocationList) Invalidate() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.expiresAt = time.Time{}
+}
+
+// Check returns ErrTokenRevoked when creds come from a revoked token. Tokens without an iat claim are treated
+// as issued before any revocation of their subject.
+func (l *RevocationList) Check(ctx context.Context, creds *UserCredentials) error {
+	if creds == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.refresh(ctx); err != nil {
+		return err
+	}
+
+	if creds.TokenID != "" {
+		if _, ok := l.tokens[creds.TokenID]; ok {
+			return ErrTokenRevoked
+		}
+	}
+	if revokedAt, ok := l.users[creds.Id]; ok && !creds.IssuedAt.After(revokedAt) {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+func (l *RevocationList) refresh(ctx context.Context) error {
+	if l.loaded && l.now().Before(l.expiresAt) {
+		return nil
+	}
+
+	revocations, err := l.load(ctx)
+	if err != nil {
+		if !l.loaded {
+			return fmt.Errorf("load token revocations: %w", err)
+		}
+		// Checks keep the last entries until a retry succeeds; an outage is logged once, when it starts.
+		if !l.failing {
+			l.logger.Warn("reload token revocations failed; keeping the previous entries", zap.Error(err))
+			l.failing = true
+		}
+		l.expiresAt = l.now().Add(revocationRetry)
+		return nil
+	}
+	if l.failing {
+		l.logger.Info("token revocations reloaded")
+		l.failing = false
+	}
+
+	users := make(map[string]time.Time)
+	tokens := make(map[string]struct{})
+	for _, revocation := range revocations {
+		switch revocation.Kind {
+		case RevocationKindUser:
+			// Token iat has second precision; the latest revocation of a subject wins.
+			revokedAt := revocation.RevokedAt.Truncate(time.Second)
+			if current, ok := users[revocation.Subject]; !ok || revokedAt.After(current) {
+				users[revocation.Subject] = revokedAt
+			}
+		case RevocationKindToken:
+			tokens[revocation.Subject] = struct{}{}
+		}
+	}
+	l.users, l.tokens = users, tokens
+	l.loaded = true
+	l.expiresAt = l.now().Add(l.ttl)
+	return nil
+}
+
+// RejectRevoked answers 401 for requests whose bearer token or API key is on list. Mount it after JWT and APIKey;
+// requests without credentials pass through.
+func RejectRevoked(list *RevocationList) func(http.Handler) http.Handler {
+	if list == nil {
+		panic("auth.RejectRevoked: revocation list must not be nil")
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			creds, ok := UserFromContext(r.Context())
+			if !ok || creds == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err := list.Check(r.Context(), creds)
+			switch {
+			case errors.Is(err, ErrTokenRevoked):
+				w.Header().Set("WWW-Authenticate", `Bearer realm="api", error="invalid_token", error_description="token revoked"`)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+			case err != nil:
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+			default:
+				next.ServeHTTP(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRevocationListCheck(t *testing.T) {
+	revokedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	entries := []Revocation{
+		{Kind: RevocationKindUser, Subject: "user-1", RevokedAt: revokedAt},
+		{Kind: RevocationKindToken, Subject: "jti-1", RevokedAt: revokedAt},
+	}
+	loads := 0
+	list := NewRevocationList(func(context.Context) ([]Revocation, error) {
+		loads++
+		return entries, nil
+	}, time.Minute, zap.NewNop())
+	ctx := context.Background()
+
+	require.ErrorIs(t, list.Check(ctx, &UserCredentials{Id: "user-1", IssuedAt: revokedAt.Add(-time.Minute)}), ErrTokenRevoked)
+	require.ErrorIs(t, list.Check(ctx, &UserCredentials{Id: "user-1"}), ErrTokenRevoked)
+	require.NoError(t, list.Check(ctx, &UserCredentials{Id: "user-1", IssuedAt: revokedAt.Add(time.Second)}))
+	require.ErrorIs(t, list.Check(ctx, &UserCredentials{Id: "user-2", TokenID: "jti-1"}), ErrTokenRevoked)
+	require.NoError(t, list.Check(ctx, &UserCredentials{Id: "user-2", TokenID: "jti-2"}))
+	require.Equal(t, 1, loads)
+
+	// Cleared entries stop applying once the list is invalidated.
+	entries = nil
+	list.Invalidate()
+	require.NoError(t, list.Check(ctx, &UserCredentials{Id: "user-1"}))
+	require.Equal(t, 2, loads)
+}
+
+func TestRevocationListKeepsEntriesWhenReloadFails(t *testing.T) {
+	fail := false
+	list := NewRevocationList(func(context.Context) ([]Revocation, error) {
+		if fail {
+			return nil, errors.New("database down")
+		}
+		return []Revocation{{Kind: RevocationKindToken, Subject: "jti-1"}}, nil
+	}, time.Minute, zap.NewNop())
+	ctx := context.Background()
+
+	require.ErrorIs(t, list.Check(ctx, &UserCredentials{TokenID: "jti-1"}), ErrTokenRevoked)
+	fail = true
+	list.Invalidate()
+	require.ErrorIs(t, list.Check(ctx, &UserCredentials{TokenID: "jti-1"}), ErrTokenRevoked)
+
+	broken := NewRevocationList(func(context.Context) ([]Revocation, error) {
+		return nil, errors.New("database down")
+	}, time.Minute, zap.NewNop())
+	err := broken.Check(ctx, &UserCredentials{TokenID: "jti-1"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrTokenRevoked)
+}
+
+func TestRevocationListBacksOffAfterAFailedReload(t *testing.T) {
+	loads, fail := 0, false
+	core, logs := observer.New(zap.InfoLevel)
+	list := NewRevocationList(func(context.Context) ([]Revocation, error) {
+		loads++
+		if fail {
+			return nil, errors.New("database down")
+		}
+		return []Revocation{{Kind: RevocationKindToken, Subject: "jti-1"}}, nil
+	}, time.Minute, zap.New(core))
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	list.now = func() time.Time { return now }
+	ctx := context.Background()
+	creds := &UserCredentials{TokenID: "jti-1"}
+
+	require.ErrorIs(t, list.Check(ctx, creds), ErrTokenRevoked)
+	fail = true
+	now = now.Add(time.Minute)
+	for range 3 {
+		require.ErrorIs(t, list.Check(ctx, creds), ErrTokenRevoked)
+	}
+	require.Equal(t, 2, loads, "checks within the retry delay reuse the previous entries")
+	now = now.Add(revocationRetry)
+	require.ErrorIs(t, list.Check(ctx, creds), ErrTokenRevoked)
+	require.Equal(t, 3, loads)
+	require.Equal(t, 1, logs.FilterMessageSnippet("failed").Len(), "an outage is logged once")
+
+	fail = false
+	now = now.Add(revocationRetry)
+	require.ErrorIs(t, list.Check(ctx, creds), ErrTokenRevoked)
+	require.Equal(t, 4, loads)
+	require.Equal(t, 1, logs.FilterMessage("token revocations reloaded").Len())
+}
+
+func TestRejectRevoked(t *testing.T) {
+	list := NewRevocationList(func(context.Context) ([]Revocation, error) {
+		return []Revocation{{Kind: RevocationKindToken, Subject: "jti-1"}}, nil
+	}, time.Minute, zap.NewNop())
+	handler := RejectRevoked(list)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(creds *UserCredentials) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if creds != nil {
+			req = req.WithContext(WithUserCredentials(req.Context(), creds))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(&UserCredentials{Id: "user-1", TokenID: "jti-1"})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Contains(t, rec.Header().Get("WWW-Authenticate"), "token revoked")
+	require.Equal(t, http.StatusOK, serve(&UserCredentials{Id: "user-1", TokenID: "jti-2"}).Code)
+	require.Equal(t, http.StatusOK, serve(nil).Code)
+}
+
+func TestRejectRevokedCoversAPIKeys(t *testing.T) {
+	list := NewRevocationList(func(context.Context) ([]Revocation, error) {
+		return []Revocation{{Kind: RevocationKindUser, Subject: "user-row-1", RevokedAt: time.Now()}}, nil
+	}, time.Minute, zap.NewNop())
+	resolver := stubAPIKeyResolver{
+		"revoked": {Id: "user-row-1", APIKeyID: "key-1"},
+		"active":  {Id: "user-row-2", APIKeyID: "key-2"},
+	}
+	handler := APIKey(resolver)(RejectRevoked(list)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(APIKeyHeader, key)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve("revoked")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Contains(t, rec.Header().Get("WWW-Authenticate"), "token revoked")
+	require.Equal(t, http.StatusOK, serve("active").Code)
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// ServiceTokenIssuer is the iss claim of tokens signed by ServiceTokenSigner. Identity provider tokens never carry
+// it, so it tells the two apart before any signature is checked.
+const ServiceTokenIssuer = "palmyra"
+
+// serviceTokenAudience is the aud claim of service tokens.
+const serviceTokenAudience = "palmyra-api"
+
+// serviceAccountKeyPrefix marks service account keys: psa_<tenant id>_<service account id>_<secret>, read like
+// API keys.
+const serviceAccountKeyPrefix = "psa_"
+
+// minServiceTokenKeyBytes is the shortest HMAC key accepted for signing service tokens.
+const minServiceTokenKeyBytes = 32
+
+var (
+	// ErrInvalidServiceAccountKey is returned for malformed, unknown or revoked service account keys.
+	ErrInvalidServiceAccountKey = errors.New("invalid service account key")
+	// ErrInvalidServiceToken is returned for service tokens with a bad signature, issuer, audience or expiry.
+	ErrInvalidServiceToken = errors.New("invalid service token")
+)
+
+// ServiceAccount is the identity a service token is issued for.
+type ServiceAccount struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	Name     string
+	Scopes   []string
+}
+
+// NewServiceAccountKey returns a fresh key for the service account together with the hash to store; the key is
+// only shown once, when the account is created.
+func NewServiceAccountKey(tenantID, accountID uuid.UUID) (key, secretHash string, err error) {
+	return newSecretKey(serviceAccountKeyPrefix, tenantID, accountID)
+}
+
+// ParseServiceAccountKey splits a key produced by NewServiceAccountKey.
+func ParseServiceAccountKey(key string) (tenantID, accountID uuid.UUID, secret string, err error) {
+	tenantID, accountID, secret, ok := parseSecretKey(serviceAccountKeyPrefix, key)
+	if !ok {
+		return uuid.Nil, uuid.Nil, "", ErrInvalidServiceAccountKey
+	}
+	return tenantID, accountID, secret, nil
+}
+
+// ServiceTokenSigner issues and verifies short-lived HS256 tokens for service accounts.
+type ServiceTokenSigner struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+}
+
+// NewServiceTokenSigner returns a signer using key, which must hold at least 32 bytes; tokens expire after ttl.
+func NewServiceTokenSigner(key []byte, ttl time.Duration) (*ServiceTokenSigner, error) {
+	if len(key) < minServiceTokenKeyBytes {
+		return nil, fmt.Errorf("service token signing key must be at least %d bytes", minServiceTokenKeyBytes)
+	}
+	if ttl <= 0 {
+		return nil, errors.New("service token ttl must be positive")
+	}
+	return &ServiceTokenSigner{key: append([]byte(nil), key...), ttl: ttl, now: time.Now}, nil
+}
+
+// Issue signs a token for account and returns it with its expiry.
+func (s *ServiceTokenSigner) Issue(account ServiceAccount) (string, time.Time, error) {
+	now := s.now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(s.ttl)
+	scopes := account.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":      ServiceTokenIssuer,
+		"aud":      serviceTokenAudience,
+		"sub":      account.ID.String(),
+		"jti":      uuid.NewString(),
+		"iat":      now.Unix(),
+		"nbf":      now.Unix(),
+		"exp":      expiresAt.Unix(),
+		"tenantId": account.TenantID.String(),
+		"name":     account.Name,
+		"scopes":   scopes,
+	})
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign service token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// Verify implements VerifyFunc for service tokens; failures wrap ErrInvalidServiceToken.
+func (s *ServiceTokenSigner) Verify(_ context.Context, token string) (map[string]interface{}, error) {
+	parsed, err := jwt.Parse(token, func(*jwt.Token) (interface{}, error) {
+		return s.key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidServiceToken, err)
+	}
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok || !claims.VerifyIssuer(ServiceTokenIssuer, true) || !claims.VerifyAudience(serviceTokenAudience, true) {
+		return nil, ErrInvalidServiceToken
+	}
+	if _, hasExpiry := claims["exp"]; !hasExpiry {
+		return nil, fmt.Errorf("%w: missing exp", ErrInvalidServiceToken)
+	}
+	return claims, nil
+}
+
+// ServiceTokenVerifier routes service tokens to signer and every other token to fallback, so one JWT middleware
+// accepts both. A nil signer leaves service tokens to fallback, which rejects them.
+func ServiceTokenVerifier(signer *ServiceTokenSigner, fallback VerifyFunc) VerifyFunc {
+	if fallback == nil {
+		panic("auth.ServiceTokenVerifier: fallback must not be nil")
+	}
+	if signer == nil {
+		return fallback
+	}
+	return func(ctx context.Context, token string) (map[string]interface{}, error) {
+		if claims, err := parseUnsignedJWTClaims(token); err == nil && extractStringClaim(claims, "iss") == ServiceTokenIssuer {
+			return signer.Verify(ctx, token)
+		}
+		return fallback(ctx, token)
+	}
+}
+
+// serviceAccountCredentials converts verified service token claims into credentials. The account acts only
+// through its scopes: it is never a platform admin and holds no roles.
+func serviceAccountCredentials(claims map[string]interface{}) (*UserCredentials, error) {
+	accountID := extractStringClaim(claims, "sub")
+	if _, err := uuid.Parse(accountID); err != nil {
+		return nil, errors.New("service token subject must be a service account id")
+	}
+	tenantID := extractStringClaim(claims, "tenantId")
+	if _, err := uuid.Parse(tenantID); err != nil {
+		return nil, errors.New("service token tenant claim required")
+	}
+
+	return &UserCredentials{
+		Id:               accountID,
+		Name:             extractOptionalStringClaim(claims, "name"),
+		TenantID:         &tenantID,
+		IssuedAt:         extractTimeClaim(claims, "iat"),
+		TokenID:          extractStringClaim(claims, "jti"),
+		ServiceAccountID: accountID,
+		Scopes:           extractStringSliceClaim(claims, "scopes"),
+	}, nil
+}
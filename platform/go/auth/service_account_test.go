@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var testServiceTokenKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestServiceAccountKeyRoundTrip(t *testing.T) {
+	tenantID, accountID := uuid.New(), uuid.New()
+
+	key, secretHash, err := NewServiceAccountKey(tenantID, accountID)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, "psa_"))
+
+	gotTenant, gotAccount, secret, err := ParseServiceAccountKey(key)
+	require.NoError(t, err)
+	require.Equal(t, tenantID, gotTenant)
+	require.Equal(t, accountID, gotAccount)
+	require.Equal(t, secretHash, HashAPIKeySecret(secret))
+
+	apiKey, _, err := NewAPIKey(tenantID, accountID)
+	require.NoError(t, err)
+	_, _, _, err = ParseServiceAccountKey(apiKey)
+	require.ErrorIs(t, err, ErrInvalidServiceAccountKey)
+}
+
+func TestNewServiceTokenSignerRejectsShortKeys(t *testing.T) {
+	_, err := NewServiceTokenSigner([]byte("short"), time.Hour)
+	require.Error(t, err)
+	_, err = NewServiceTokenSigner(testServiceTokenKey, 0)
+	require.Error(t, err)
+}
+
+func TestServiceTokenIssueAndVerify(t *testing.T) {
+	signer, err := NewServiceTokenSigner(testServiceTokenKey, time.Hour)
+	require.NoError(t, err)
+	now := time.Now()
+	signer.now = func() time.Time { return now }
+
+	account := ServiceAccount{ID: uuid.New(), TenantID: uuid.New(), Name: "billing-sync", Scopes: []string{"entities:read"}}
+	token, expiresAt, err := signer.Issue(account)
+	require.NoError(t, err)
+	require.WithinDuration(t, now.Add(time.Hour), expiresAt, time.Second)
+
+	fallbackCalls := 0
+	verify := ServiceTokenVerifier(signer, func(context.Context, string) (map[string]interface{}, error) {
+		fallbackCalls++
+		return map[string]interface{}{"sub": "user"}, nil
+	})
+
+	claims, err := verify(context.Background(), token)
+	require.NoError(t, err)
+	creds, err := DefaultCredentialExtractor(claims)
+	require.NoError(t, err)
+	require.Equal(t, account.ID.String(), creds.Id)
+	require.Equal(t, account.ID.String(), creds.ServiceAccountID)
+	require.Equal(t, account.TenantID.String(), *creds.TenantID)
+	require.Equal(t, []string{"entities:read"}, creds.Scopes)
+	require.False(t, creds.IsAdmin)
+	require.True(t, creds.ScopeAllows("entities:read"))
+	require.False(t, creds.ScopeAllows("entities:write"))
+
+	// Tokens from the identity provider go to the fallback verifier.
+	_, err = verify(context.Background(), "eyJhbGciOiJub25lIn0.eyJpc3MiOiJodHRwczovL3NlY3VyZXRva2VuIn0.")
+	require.NoError(t, err)
+	require.Equal(t, 1, fallbackCalls)
+
+	// A token signed with another key, or expired, is rejected.
+	other, err := NewServiceTokenSigner([]byte("fedcba9876543210fedcba9876543210"), time.Hour)
+	require.NoError(t, err)
+	forged, _, err := other.Issue(account)
+	require.NoError(t, err)
+	_, err = verify(context.Background(), forged)
+	require.ErrorIs(t, err, ErrInvalidServiceToken)
+
+	signer.now = func() time.Time { return now.Add(-2 * time.Hour) }
+	expired, _, err := signer.Issue(account)
+	require.NoError(t, err)
+	_, err = verify(context.Background(), expired)
+	require.ErrorIs(t, err, ErrInvalidServiceToken)
+}
@@ -0,0 +1,182 @@
+// Package config reloads the settings a process may change while it runs, such as the log level or the CORS origins,
+// without a restart. Everything else is read once at startup.
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WatcherConfig tells a Watcher where the reloadable settings live.
+type WatcherConfig struct {
+	// Path is a file of KEY=value lines (the .env format) or a directory holding one file per key, as a mounted
+	// Kubernetes ConfigMap does. Keys it lacks fall back to the process environment. Empty reads the environment only.
+	Path string
+	// Interval polls Path for changes; zero reloads only on SIGHUP.
+	Interval time.Duration
+}
+
+type setting struct {
+	key   string
+	apply func(value string) error
+}
+
+// Watcher applies the settings registered on it whenever their value changes, on SIGHUP or every Interval.
+type Watcher struct {
+	logger *zap.Logger
+	cfg    WatcherConfig
+
+	mu       sync.Mutex
+	settings []setting
+	// current holds the last value seen per key, applied or not, so a rejected value is reported once.
+	current map[string]string
+}
+
+// NewWatcher constructs a Watcher.
+func NewWatcher(logger *zap.Logger, cfg WatcherConfig) *Watcher {
+	if logger == nil {
+		panic("logger is required")
+	}
+	return &Watcher{logger: logger, cfg: cfg, current: make(map[string]string)}
+}
+
+// Register makes key reloadable. apply receives every new value and rejects an invalid one with an error, which
+// keeps the previous setting in place. The value in the environment at registration is taken as already applied.
+func (w *Watcher) Register(key string, apply func(value string) error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.settings = append(w.settings, setting{key: key, apply: apply})
+	w.current[key] = os.Getenv(key)
+}
+
+// Reload reads the settings and applies the changed ones. A key found nowhere keeps its setting.
+func (w *Watcher) Reload() error {
+	values, err := Load(w.cfg.Path)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var errs []error
+	for _, s := range w.settings {
+		value, ok := values[s.key]
+		if !ok {
+			value, ok = os.LookupEnv(s.key)
+		}
+		if !ok || value == w.current[s.key] {
+			continue
+		}
+		previous := w.current[s.key]
+		w.current[s.key] = value
+		if err := s.apply(value); err != nil {
+			w.logger.Error("setting not reloaded", zap.String("key", s.key), zap.String("value", value), zap.Error(err))
+			errs = append(errs, fmt.Errorf("%s: %w", s.key, err))
+			continue
+		}
+		w.logger.Warn("setting reloaded", zap.String("key", s.key), zap.String("from", previous), zap.String("to", value))
+	}
+	return errors.Join(errs...)
+}
+
+// Run reloads on SIGHUP and, with an Interval, on every tick until ctx is done.
+func (w *Watcher) Run(ctx context.Context) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	var tick <-chan time.Time
+	if w.cfg.Interval > 0 {
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			w.logger.Info("reloading settings", zap.String("trigger", "SIGHUP"))
+		case <-tick:
+		}
+		if err := w.Reload(); err != nil {
+			w.logger.Warn("reload settings failed", zap.Error(err))
+		}
+	}
+}
+
+// Load reads the settings at path, a .env file or a ConfigMap directory; an empty path has none.
+func Load(path string) (map[string]string, error) {
+	values := map[string]string{}
+	if path == "" {
+		return values, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("read settings: %w", err)
+	}
+	if info.IsDir() {
+		return loadDir(path, values)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read settings: %w", err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(text, "export "), "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%s:%d: expected KEY=value", path, line)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		values[key] = value
+	}
+	return values, scanner.Err()
+}
+
+// loadDir reads one setting per file. Kubernetes keeps the ConfigMap data behind "..data" links, which are skipped.
+func loadDir(dir string, values map[string]string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read settings: %w", err)
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		file := filepath.Join(dir, entry.Name())
+		// Keys are links into ..data, so the target decides whether the entry is a file.
+		info, err := os.Stat(file)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read settings: %w", err)
+		}
+		values[entry.Name()] = strings.TrimRight(string(data), "\r\n")
+	}
+	return values, nil
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLoadEnvFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "settings.env")
+	require.NoError(t, os.WriteFile(path, []byte("# reloadable\nLOG_LEVEL=debug\nexport CORS_ALLOWED_ORIGINS=\"https://a.example.com,https://b.example.com\"\n\nEMPTY=\n"), 0o600))
+
+	values, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"LOG_LEVEL":            "debug",
+		"CORS_ALLOWED_ORIGINS": "https://a.example.com,https://b.example.com",
+		"EMPTY":                "",
+	}, values)
+
+	require.NoError(t, os.WriteFile(path, []byte("LOG_LEVEL\n"), 0o600))
+	_, err = Load(path)
+	require.ErrorContains(t, err, "settings.env:1: expected KEY=value")
+}
+
+func TestLoadConfigMapDirectory(t *testing.T) {
+	t.Parallel()
+
+	// The layout Kubernetes mounts: keys link into ..data, which links to a timestamped directory.
+	dir := t.TempDir()
+	version := filepath.Join(dir, "..2026_10_16_10_00_00.1")
+	require.NoError(t, os.Mkdir(version, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(version, "LOG_LEVEL"), []byte("warn\n"), 0o600))
+	require.NoError(t, os.Symlink(filepath.Base(version), filepath.Join(dir, "..data")))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "LOG_LEVEL"), filepath.Join(dir, "LOG_LEVEL")))
+
+	values, err := Load(dir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"LOG_LEVEL": "warn"}, values)
+}
+
+func TestReloadAppliesChangedSettings(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "info")
+	t.Setenv("FEATURE_FLAGS_CACHE_TTL", "30s")
+
+	path := filepath.Join(t.TempDir(), "settings.env")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	write("LOG_LEVEL=info\n")
+
+	applied := map[string][]string{}
+	watcher := NewWatcher(zap.NewNop(), WatcherConfig{Path: path})
+	for _, key := range []string{"LOG_LEVEL", "FEATURE_FLAGS_CACHE_TTL", "CORS_ALLOWED_ORIGINS"} {
+		watcher.Register(key, func(value string) error {
+			if value == "bogus" {
+				return errors.New("invalid")
+			}
+			applied[key] = append(applied[key], value)
+			return nil
+		})
+	}
+
+	require.NoError(t, watcher.Reload())
+	require.Empty(t, applied, "unchanged values are not applied again")
+
+	write("LOG_LEVEL=debug\nCORS_ALLOWED_ORIGINS=https://app.example.com\n")
+	require.NoError(t, watcher.Reload())
+	require.NoError(t, watcher.Reload())
+	require.Equal(t, map[string][]string{
+		"LOG_LEVEL":            {"debug"},
+		"CORS_ALLOWED_ORIGINS": {"https://app.example.com"},
+	}, applied)
+
+	// Removing a key from the file falls back to the environment.
+	write("CORS_ALLOWED_ORIGINS=https://app.example.com\nFEATURE_FLAGS_CACHE_TTL=bogus\n")
+	err := watcher.Reload()
+	require.ErrorContains(t, err, "FEATURE_FLAGS_CACHE_TTL: invalid")
+	require.Equal(t, []string{"debug", "info"}, applied["LOG_LEVEL"])
+	require.NoError(t, watcher.Reload(), "a rejected value is reported once")
+}
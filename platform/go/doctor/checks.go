@@ -0,0 +1,138 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Database checks that connString reaches a Postgres server and reports its version.
+func Database(name, connString string) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		if strings.TrimSpace(connString) == "" {
+			return "", errors.New("connection string is empty")
+		}
+		pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: connString, MaxConns: 1})
+		if err != nil {
+			return "", err
+		}
+		defer pool.Close()
+
+		var version string
+		if err := pool.QueryRow(ctx, `SHOW server_version`).Scan(&version); err != nil {
+			return "", fmt.Errorf("read server version: %w", err)
+		}
+		return "PostgreSQL " + version, nil
+	}}
+}
+
+// Migrations checks that the admin schema exists and warns about the migrations not applied to it yet. Tenant
+// schemas are not inspected; `cli-platform-admin migrate --dry-run` lists theirs.
+func Migrations(connString, adminSchema string) Check {
+	return Check{Name: "migrations", Run: func(ctx context.Context) (string, error) {
+		pool, err := persistence.NewPool(ctx, persistence.PoolConfig{ConnString: connString, MaxConns: 1})
+		if err != nil {
+			return "", err
+		}
+		defer pool.Close()
+
+		var exists bool
+		if err := pool.QueryRow(ctx, `SELECT to_regnamespace($1) IS NOT NULL`, adminSchema).Scan(&exists); err != nil {
+			return "", fmt.Errorf("check admin schema: %w", err)
+		}
+		if !exists {
+			return "", fmt.Errorf("admin schema %s does not exist; run cli-platform-admin bootstrap platform", adminSchema)
+		}
+
+		migrator := persistence.NewMigrator(pool)
+		var pending []string
+		for _, set := range []string{persistence.AdminMigrationSet, persistence.TenantMigrationSet} {
+			migrations, err := migrator.Pending(ctx, set, adminSchema)
+			if err != nil {
+				return "", err
+			}
+			for _, migration := range migrations {
+				pending = append(pending, set+"/"+migration.Version)
+			}
+		}
+		if len(pending) > 0 {
+			return "", Warnf("%s has %d pending migration(s): %s; run cli-platform-admin migrate", adminSchema, len(pending), strings.Join(pending, ", "))
+		}
+		return adminSchema + " is up to date", nil
+	}}
+}
+
+// Contracts checks the OpenAPI contracts in dir: every name in required must exist, and every contract found must
+// load with the files it references, as the api server loads them. Without required names every *.yaml file in dir
+// is checked.
+func Contracts(dir string, required []string) Check {
+	return Check{Name: "contracts", Run: func(ctx context.Context) (string, error) {
+		names := map[string]bool{}
+		for _, name := range required {
+			names[name] = true
+		}
+		found, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+		if err != nil {
+			return "", err
+		}
+		for _, path := range found {
+			names[filepath.Base(path)] = true
+		}
+		if len(names) == 0 {
+			return "", fmt.Errorf("no contracts in %s", dir)
+		}
+
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+		sort.Strings(sorted)
+
+		var missing, invalid []string
+		for _, name := range sorted {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err != nil {
+				missing = append(missing, name)
+				continue
+			}
+			loader := openapi3.NewLoader()
+			loader.IsExternalRefsAllowed = true
+			loader.Context = ctx
+			if _, err := loader.LoadFromFile(path); err != nil {
+				invalid = append(invalid, fmt.Sprintf("%s (%v)", name, err))
+			}
+		}
+
+		var problems []string
+		if len(missing) > 0 {
+			problems = append(problems, "missing "+strings.Join(missing, ", "))
+		}
+		if len(invalid) > 0 {
+			problems = append(problems, "invalid "+strings.Join(invalid, ", "))
+		}
+		if len(problems) > 0 {
+			return "", errors.New(strings.Join(problems, "; "))
+		}
+		return fmt.Sprintf("%d contract(s) in %s", len(sorted), dir), nil
+	}}
+}
+
+// Prober is a dependency that can verify its own credentials, such as the storage object stores.
+type Prober interface {
+	Probe(ctx context.Context) error
+}
+
+// Probe checks a dependency through its Probe method; detail describes it in the report.
+func Probe(name, detail string, prober Prober) Check {
+	return Check{Name: name, Run: func(ctx context.Context) (string, error) {
+		return detail, prober.Probe(ctx)
+	}}
+}
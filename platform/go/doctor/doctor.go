@@ -0,0 +1,147 @@
+// Package doctor runs the startup self-checks of a deployment (configuration, database, contracts, storage) and
+// prints a readiness report, so CI and deploy pipelines can reject a broken environment before the server starts.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Check is one self-check. Run returns a short detail for the report; an error fails the check unless it is a
+// Warning.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) (string, error)
+}
+
+// Warning marks a finding that does not make the deployment unready, such as pending migrations.
+type Warning struct {
+	msg string
+}
+
+func (w *Warning) Error() string {
+	return w.msg
+}
+
+// Warnf returns a Warning.
+func Warnf(format string, args ...any) error {
+	return &Warning{msg: fmt.Sprintf(format, args...)}
+}
+
+// Result is the outcome of one check.
+type Result struct {
+	Name     string
+	Status   Status
+	Detail   string
+	Duration time.Duration
+}
+
+// Report lists the results in check order.
+type Report struct {
+	Results []Result
+}
+
+// Ready reports whether no check failed.
+func (r Report) Ready() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			return false
+		}
+	}
+	return true
+}
+
+// Write prints one line per check and a closing verdict.
+func (r Report) Write(w io.Writer) {
+	width := 0
+	for _, result := range r.Results {
+		width = max(width, len(result.Name))
+	}
+	failed, warned := 0, 0
+	for _, result := range r.Results {
+		switch result.Status {
+		case StatusFail:
+			failed++
+		case StatusWarn:
+			warned++
+		}
+		fmt.Fprintf(w, "[%-4s] %-*s  %s\n", result.Status, width, result.Name, result.Detail)
+	}
+	if r.Ready() {
+		fmt.Fprintf(w, "READY: %d check(s) passed, %d warning(s)\n", len(r.Results)-warned, warned)
+		return
+	}
+	fmt.Fprintf(w, "NOT READY: %d check(s) failed, %d warning(s)\n", failed, warned)
+}
+
+// Run runs the checks one after the other, each bounded by timeout, and reports all of them: a failing check does
+// not stop the others.
+func Run(ctx context.Context, timeout time.Duration, checks ...Check) Report {
+	report := Report{Results: make([]Result, 0, len(checks))}
+	for _, check := range checks {
+		report.Results = append(report.Results, run(ctx, timeout, check))
+	}
+	return report
+}
+
+func run(ctx context.Context, timeout time.Duration, check Check) (result Result) {
+	result = Result{Name: check.Name}
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	started := time.Now()
+	defer func() {
+		result.Duration = time.Since(started)
+		if recovered := recover(); recovered != nil {
+			result.Status, result.Detail = StatusFail, fmt.Sprintf("panic: %v", recovered)
+		}
+	}()
+
+	detail, err := check.Run(checkCtx)
+	var warning *Warning
+	switch {
+	case err == nil:
+		result.Status, result.Detail = StatusOK, detail
+	case errors.As(err, &warning):
+		result.Status, result.Detail = StatusWarn, join(detail, err.Error())
+	default:
+		result.Status, result.Detail = StatusFail, join(detail, err.Error())
+	}
+	return result
+}
+
+func join(detail, message string) string {
+	if strings.TrimSpace(detail) == "" {
+		return message
+	}
+	return detail + ": " + message
+}
+
+// Errors returns a check that fails with every problem found by validate, typically configuration values that do
+// not combine; no problem passes with detail.
+func Errors(name, detail string, validate func() []error) Check {
+	return Check{Name: name, Run: func(context.Context) (string, error) {
+		problems := validate()
+		if len(problems) == 0 {
+			return detail, nil
+		}
+		messages := make([]string, len(problems))
+		for i, problem := range problems {
+			messages[i] = problem.Error()
+		}
+		return "", errors.New(strings.Join(messages, "; "))
+	}}
+}
@@ -0,0 +1,76 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunReportsEveryCheck(t *testing.T) {
+	t.Parallel()
+
+	report := Run(context.Background(), time.Second,
+		Check{Name: "database", Run: func(context.Context) (string, error) { return "PostgreSQL 16.2", nil }},
+		Check{Name: "migrations", Run: func(context.Context) (string, error) { return "", Warnf("2 pending") }},
+		Check{Name: "storage", Run: func(context.Context) (string, error) { return "gcs bucket b", errors.New("forbidden") }},
+		Check{Name: "contracts", Run: func(context.Context) (string, error) { panic("boom") }},
+		Errors("environment", "every variable is valid", func() []error { return nil }),
+	)
+
+	require.False(t, report.Ready())
+	statuses := map[string]Status{}
+	for _, result := range report.Results {
+		statuses[result.Name] = result.Status
+	}
+	require.Equal(t, map[string]Status{
+		"database":    StatusOK,
+		"migrations":  StatusWarn,
+		"storage":     StatusFail,
+		"contracts":   StatusFail,
+		"environment": StatusOK,
+	}, statuses)
+	require.Equal(t, "gcs bucket b: forbidden", report.Results[2].Detail)
+
+	var out bytes.Buffer
+	report.Write(&out)
+	require.Contains(t, out.String(), "[warn] migrations   2 pending\n")
+	require.Contains(t, out.String(), "NOT READY: 2 check(s) failed, 1 warning(s)\n")
+
+	out.Reset()
+	Report{Results: report.Results[:2]}.Write(&out)
+	require.Contains(t, out.String(), "READY: 1 check(s) passed, 1 warning(s)\n")
+}
+
+func TestRunBoundsEachCheck(t *testing.T) {
+	t.Parallel()
+
+	report := Run(context.Background(), 10*time.Millisecond, Check{Name: "slow", Run: func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}})
+	require.Equal(t, StatusFail, report.Results[0].Status)
+	require.Equal(t, context.DeadlineExceeded.Error(), report.Results[0].Detail)
+}
+
+func TestContracts(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	valid := "openapi: 3.0.3\ninfo: {title: Users, version: 1.0.0}\npaths: {}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "users.yaml"), []byte(valid), 0o600))
+
+	report := Run(context.Background(), time.Second, Contracts(dir, nil))
+	require.Equal(t, StatusOK, report.Results[0].Status, report.Results[0].Detail)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.yaml"), []byte("openapi: 3.0.3\npaths: [\n"), 0o600))
+	report = Run(context.Background(), time.Second, Contracts(dir, []string{"users.yaml", "tenants.yaml"}))
+	require.Equal(t, StatusFail, report.Results[0].Status)
+	require.Contains(t, report.Results[0].Detail, "missing tenants.yaml")
+	require.Contains(t, report.Results[0].Detail, "invalid broken.yaml")
+}
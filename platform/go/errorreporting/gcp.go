@@ -0,0 +1,89 @@
+package errorreporting
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// reportedErrorEventType makes Cloud Logging forward an entry to Error Reporting.
+const reportedErrorEventType = "type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent"
+
+// GCPConfig configures GCPTransport.
+type GCPConfig struct {
+	// Service and Version fill the serviceContext Error Reporting groups errors by; Service is required.
+	Service string
+	Version string
+}
+
+// GCPTransport reports to GCP Error Reporting through Cloud Logging: it writes each event as an ERROR entry that
+// carries the ReportedErrorEvent type and a Go stack trace, which Error Reporting picks up from the logs of Cloud Run
+// and GKE workloads. It needs no credentials, but logger must write Cloud Logging JSON to stdout or stderr (see
+// platformlogging.NewLogger).
+type GCPTransport struct {
+	logger *zap.Logger
+	cfg    GCPConfig
+}
+
+// NewGCPTransport constructs a GCPTransport.
+func NewGCPTransport(logger *zap.Logger, cfg GCPConfig) (*GCPTransport, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+	if strings.TrimSpace(cfg.Service) == "" {
+		return nil, fmt.Errorf("error reporting service name is required")
+	}
+	return &GCPTransport{logger: logger, cfg: cfg}, nil
+}
+
+// Send writes the event to the log.
+func (t *GCPTransport) Send(_ context.Context, event Event) error {
+	serviceContext := map[string]string{"service": t.cfg.Service}
+	if t.cfg.Version != "" {
+		serviceContext["version"] = t.cfg.Version
+	}
+	errorContext := map[string]any{}
+	if event.Method != "" {
+		errorContext["httpRequest"] = map[string]any{
+			"method":             event.Method,
+			"url":                event.Path,
+			"responseStatusCode": event.Status,
+		}
+	}
+	if event.UserID != "" {
+		errorContext["user"] = event.UserID
+	}
+
+	fields := []zap.Field{
+		zap.String("@type", reportedErrorEventType),
+		zap.Any("serviceContext", serviceContext),
+		zap.Any("context", errorContext),
+		zap.Int("status", event.Status),
+	}
+	for key, value := range map[string]string{
+		"request_id":  event.RequestID,
+		"tenant_id":   event.TenantID,
+		"tenant_slug": event.TenantSlug,
+		"domain":      event.Domain,
+		"operation":   event.Operation,
+	} {
+		if value != "" {
+			fields = append(fields, zap.String(key, value))
+		}
+	}
+	// Error Reporting parses the stack trace from the message, so it follows the format of a Go panic.
+	t.logger.Error(event.Title()+"\n\n"+goStack(event), fields...)
+	return nil
+}
+
+// goStack renders the stack like runtime/debug.Stack.
+func goStack(event Event) string {
+	var b strings.Builder
+	b.WriteString("goroutine 1 [running]:\n")
+	for _, frame := range event.Stack {
+		fmt.Fprintf(&b, "%s(...)\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package errorreporting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// Middleware puts reporter on every request for Report, which the problem catalogs call for the server errors they
+// classify. It also reports panics, re-raised for the recoverer outside it, and the 500 responses nothing reported,
+// such as those written by other middleware. A nil reporter disables it.
+func Middleware(reporter *Reporter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if reporter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req := &request{method: r.Method, path: r.URL.Path}
+			ctx := context.WithValue(WithReporter(r.Context(), reporter), requestKey{}, req)
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if recovered != http.ErrAbortHandler {
+					err, ok := recovered.(error)
+					if !ok {
+						err = fmt.Errorf("%v", recovered)
+					}
+					report(ctx, Event{Err: err, Status: http.StatusInternalServerError, Panic: true}, 1)
+				}
+				panic(recovered)
+			}()
+
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			if ww.Status() == http.StatusInternalServerError && !req.wasReported() {
+				err := fmt.Errorf("%s %s answered %d", r.Method, r.URL.Path, ww.Status())
+				report(ctx, Event{Err: err, Status: ww.Status()}, 1)
+			}
+		})
+	}
+}
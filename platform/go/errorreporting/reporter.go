@@ -0,0 +1,235 @@
+package errorreporting
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Event is a server error forwarded to the error reporting backend.
+type Event struct {
+	Err    error
+	Status int
+	// Operation is the API operation that failed, e.g. "entitiesCreate"; Domain the catalog that classified it.
+	Operation string
+	Domain    string
+	// Panic is set when the error was recovered from a panic.
+	Panic bool
+
+	RequestID  string
+	TenantID   string
+	TenantSlug string
+	UserID     string
+	Method     string
+	Path       string
+
+	// Stack lists the callers of the report, innermost first.
+	Stack []runtime.Frame
+	Time  time.Time
+}
+
+// Transport delivers events to a backend.
+type Transport interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// Config tunes a Reporter. Zero values fall back to defaults.
+type Config struct {
+	// QueueSize bounds the events waiting to be sent; events beyond it are dropped (default 100).
+	QueueSize int
+	// SendTimeout bounds each delivery (default 10s).
+	SendTimeout time.Duration
+}
+
+// Reporter sends events in the background so reporting never delays a response. A nil Reporter drops every event.
+type Reporter struct {
+	transport Transport
+	logger    *zap.Logger
+	timeout   time.Duration
+	queue     chan Event
+	done      chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewReporter starts a Reporter delivering through transport; failed deliveries are logged to logger.
+func NewReporter(transport Transport, logger *zap.Logger, cfg Config) *Reporter {
+	if transport == nil {
+		panic("error reporting transport is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 100
+	}
+	if cfg.SendTimeout <= 0 {
+		cfg.SendTimeout = 10 * time.Second
+	}
+	r := &Reporter{
+		transport: transport,
+		logger:    logger,
+		timeout:   cfg.SendTimeout,
+		queue:     make(chan Event, cfg.QueueSize),
+		done:      make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Report queues event without blocking.
+func (r *Reporter) Report(event Event) {
+	if r == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.queue <- event:
+	default:
+		r.logger.Warn("error report dropped, queue full", zap.Error(event.Err))
+	}
+}
+
+// Close stops accepting events, which are dropped from then on, and waits until the queued ones are sent or ctx
+// ends.
+func (r *Reporter) Close(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Reporter) run() {
+	defer close(r.done)
+	for event := range r.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+		if err := r.transport.Send(ctx, event); err != nil {
+			r.logger.Warn("error report delivery failed", zap.Error(err))
+		}
+		cancel()
+	}
+}
+
+type reporterKey struct{}
+
+// WithReporter stores the reporter on the context for Report.
+func WithReporter(ctx context.Context, r *Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey{}, r)
+}
+
+// request holds what is known about the request when it enters the middleware, and whether it was reported.
+type request struct {
+	method   string
+	path     string
+	mu       sync.Mutex
+	reported bool
+}
+
+type requestKey struct{}
+
+func (r *request) markReported() {
+	r.mu.Lock()
+	r.reported = true
+	r.mu.Unlock()
+}
+
+func (r *request) wasReported() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reported
+}
+
+// Report sends err to the reporter on ctx, if any, with the request ID, tenant, user and request line found on ctx
+// and the stack of the caller. domain and operation name what failed and may be empty.
+func Report(ctx context.Context, err error, status int, domain, operation string) {
+	report(ctx, Event{Err: err, Status: status, Domain: domain, Operation: operation}, 2)
+}
+
+// report completes event from ctx; skip frames are left out of its stack, starting with report itself.
+func report(ctx context.Context, event Event, skip int) {
+	reporter, _ := ctx.Value(reporterKey{}).(*Reporter)
+	if reporter == nil || event.Err == nil {
+		return
+	}
+
+	event.RequestID = middleware.GetReqID(ctx)
+	if audit, ok := requesttrace.FromContext(ctx); ok {
+		if event.RequestID == "" {
+			event.RequestID = audit.RequestID
+		}
+		if audit.UserID != nil {
+			event.UserID = *audit.UserID
+		}
+		if audit.TenantID != nil {
+			event.TenantID = *audit.TenantID
+		}
+	}
+	if space, ok := tenant.FromContext(ctx); ok {
+		event.TenantID = space.TenantID.String()
+		event.TenantSlug = space.Slug
+	}
+	if req, ok := ctx.Value(requestKey{}).(*request); ok {
+		event.Method = req.method
+		event.Path = req.path
+		req.markReported()
+	}
+	event.Stack = callers(skip)
+	reporter.Report(event)
+}
+
+// callers returns the stack of its caller without the first skip frames, leaving out the runtime's own frames.
+func callers(skip int) []runtime.Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	out := make([]runtime.Frame, 0, n)
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			out = append(out, frame)
+		}
+		if !more {
+			return out
+		}
+	}
+}
+
+// Title summarises the event in one line.
+func (e Event) Title() string {
+	switch {
+	case e.Panic:
+		return fmt.Sprintf("panic: %v", e.Err)
+	case e.Operation != "":
+		return fmt.Sprintf("%s: %v", e.Operation, e.Err)
+	default:
+		return e.Err.Error()
+	}
+}
@@ -0,0 +1,99 @@
+package errorreporting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type recordingTransport struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (t *recordingTransport) Send(_ context.Context, event Event) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events = append(t.events, event)
+	return nil
+}
+
+// serve runs handler behind the middleware and returns the events it reported.
+func serve(t *testing.T, handler http.HandlerFunc) []Event {
+	t.Helper()
+
+	transport := &recordingTransport{}
+	reporter := NewReporter(transport, zap.NewNop(), Config{})
+	h := chimw.RequestID(chimw.Recoverer(Middleware(reporter)(handler)))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/entities/cards/documents", nil))
+
+	require.NoError(t, reporter.Close(context.Background()))
+	return transport.events
+}
+
+func TestReportCarriesRequestContext(t *testing.T) {
+	t.Parallel()
+
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme"}
+	events := serve(t, func(w http.ResponseWriter, r *http.Request) {
+		ctx := tenant.WithSpace(r.Context(), space)
+		Report(ctx, errors.New("database unavailable"), http.StatusInternalServerError, "entities", "entitiesCreate")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	require.Len(t, events, 1)
+	event := events[0]
+	require.EqualError(t, event.Err, "database unavailable")
+	require.Equal(t, "entitiesCreate", event.Operation)
+	require.Equal(t, space.TenantID.String(), event.TenantID)
+	require.Equal(t, "acme", event.TenantSlug)
+	require.NotEmpty(t, event.RequestID)
+	require.Equal(t, http.MethodPost, event.Method)
+	require.Equal(t, "/api/v1/entities/cards/documents", event.Path)
+	require.NotEmpty(t, event.Stack)
+	require.True(t, strings.HasSuffix(event.Stack[0].Function, "TestReportCarriesRequestContext.func1"), event.Stack[0].Function)
+}
+
+func TestMiddlewareReportsPanicsAndUnreportedFailures(t *testing.T) {
+	t.Parallel()
+
+	events := serve(t, func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})
+	require.Len(t, events, 1)
+	require.True(t, events[0].Panic)
+	require.Equal(t, "panic: boom", events[0].Title())
+
+	events = serve(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "internal", http.StatusInternalServerError)
+	})
+	require.Len(t, events, 1)
+	require.EqualError(t, events[0].Err, "POST /api/v1/entities/cards/documents answered 500")
+
+	events = serve(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	require.Empty(t, events)
+}
+
+func TestReportWithoutReporter(t *testing.T) {
+	t.Parallel()
+
+	// Nothing to report to: Report must not fail.
+	Report(context.Background(), errors.New("ignored"), http.StatusInternalServerError, "", "")
+
+	var reporter *Reporter
+	reporter.Report(Event{Err: errors.New("ignored")})
+	require.NoError(t, reporter.Close(context.Background()))
+}
@@ -0,0 +1,220 @@
+package errorreporting
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// modulePath marks the frames of this repository as in-app in Sentry.
+const modulePath = "github.com/zenGate-Global/palmyra-pro-saas/"
+
+// SentryConfig configures SentryTransport.
+type SentryConfig struct {
+	// DSN is the project's client key URL, https://<key>@<host>/<project>.
+	DSN string
+	// Environment and Release tag every event; both are optional.
+	Environment string
+	Release     string
+	ServerName  string
+	Client      *http.Client
+}
+
+// SentryTransport posts events to the Sentry envelope endpoint of a project.
+type SentryTransport struct {
+	cfg      SentryConfig
+	endpoint string
+	auth     string
+}
+
+// NewSentryTransport parses the DSN and constructs a SentryTransport.
+func NewSentryTransport(cfg SentryConfig) (*SentryTransport, error) {
+	dsn, err := url.Parse(strings.TrimSpace(cfg.DSN))
+	if err != nil {
+		return nil, fmt.Errorf("parse sentry dsn: %w", err)
+	}
+	if (dsn.Scheme != "https" && dsn.Scheme != "http") || dsn.Host == "" || dsn.User == nil || dsn.User.Username() == "" {
+		return nil, errors.New("sentry dsn must look like https://<key>@<host>/<project>")
+	}
+	path := strings.Trim(dsn.Path, "/")
+	idx := strings.LastIndexByte(path, '/')
+	prefix, project := "", path
+	if idx >= 0 {
+		prefix, project = "/"+path[:idx], path[idx+1:]
+	}
+	if project == "" {
+		return nil, errors.New("sentry dsn has no project id")
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &SentryTransport{
+		cfg:      cfg,
+		endpoint: fmt.Sprintf("%s://%s%s/api/%s/envelope/", dsn.Scheme, dsn.Host, prefix, project),
+		auth:     "Sentry sentry_version=7, sentry_client=palmyra-errorreporting/1.0, sentry_key=" + dsn.User.Username(),
+	}, nil
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename,omitempty"`
+	AbsPath  string `json:"abs_path,omitempty"`
+	Lineno   int    `json:"lineno,omitempty"`
+	InApp    bool   `json:"in_app"`
+}
+
+type sentryException struct {
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+	Stacktrace struct {
+		Frames []sentryFrame `json:"frames"`
+	} `json:"stacktrace"`
+	Mechanism *sentryMechanism `json:"mechanism,omitempty"`
+}
+
+type sentryMechanism struct {
+	Type    string `json:"type"`
+	Handled bool   `json:"handled"`
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Transaction string            `json:"transaction,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	User        map[string]string `json:"user,omitempty"`
+	Request     map[string]string `json:"request,omitempty"`
+	Exception   struct {
+		Values []sentryException `json:"values"`
+	} `json:"exception"`
+}
+
+// Send posts the event as a one-item envelope. Sentry answers 200 once it has accepted it.
+func (t *SentryTransport) Send(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(t.sentryEvent(event))
+	if err != nil {
+		return fmt.Errorf("encode sentry event: %w", err)
+	}
+
+	var envelope bytes.Buffer
+	header, _ := json.Marshal(map[string]string{"dsn": t.cfg.DSN, "sent_at": time.Now().UTC().Format(time.RFC3339)})
+	envelope.Write(header)
+	envelope.WriteString("\n")
+	fmt.Fprintf(&envelope, `{"type":"event","length":%d}`+"\n", len(payload))
+	envelope.Write(payload)
+	envelope.WriteString("\n")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, &envelope)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", t.auth)
+
+	resp, err := t.cfg.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sentry request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("sentry answered %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func (t *SentryTransport) sentryEvent(event Event) sentryEvent {
+	out := sentryEvent{
+		EventID:     newEventID(),
+		Timestamp:   event.Time.UTC().Format(time.RFC3339Nano),
+		Platform:    "go",
+		Level:       "error",
+		Environment: t.cfg.Environment,
+		Release:     t.cfg.Release,
+		ServerName:  t.cfg.ServerName,
+		Transaction: event.Operation,
+		Tags:        map[string]string{"status": strconv.Itoa(event.Status)},
+	}
+	for key, value := range map[string]string{
+		"request_id":  event.RequestID,
+		"tenant_id":   event.TenantID,
+		"tenant_slug": event.TenantSlug,
+		"domain":      event.Domain,
+	} {
+		if value != "" {
+			out.Tags[key] = value
+		}
+	}
+	if event.UserID != "" {
+		out.User = map[string]string{"id": event.UserID}
+	}
+	if event.Method != "" {
+		out.Request = map[string]string{"method": event.Method, "url": event.Path}
+	}
+
+	exception := sentryException{Type: errorType(event.Err), Value: event.Err.Error()}
+	if event.Panic {
+		exception.Mechanism = &sentryMechanism{Type: "panic", Handled: false}
+	}
+	// Sentry lists frames oldest first.
+	exception.Stacktrace.Frames = make([]sentryFrame, 0, len(event.Stack))
+	for i := len(event.Stack) - 1; i >= 0; i-- {
+		frame := event.Stack[i]
+		module, function := splitFunction(frame.Function)
+		exception.Stacktrace.Frames = append(exception.Stacktrace.Frames, sentryFrame{
+			Function: function,
+			Module:   module,
+			Filename: frame.File,
+			AbsPath:  frame.File,
+			Lineno:   frame.Line,
+			InApp:    strings.HasPrefix(frame.Function, modulePath),
+		})
+	}
+	out.Exception.Values = []sentryException{exception}
+	return out
+}
+
+// errorType names the innermost error of the chain, which says more than the wrappers around it.
+func errorType(err error) string {
+	for {
+		inner := errors.Unwrap(err)
+		if inner == nil {
+			return fmt.Sprintf("%T", err)
+		}
+		err = inner
+	}
+}
+
+// splitFunction splits "github.com/org/repo/pkg.(*T).Method" into its package and function.
+func splitFunction(name string) (string, string) {
+	slash := strings.LastIndexByte(name, '/')
+	dot := strings.IndexByte(name[slash+1:], '.')
+	if dot < 0 {
+		return "", name
+	}
+	dot += slash + 1
+	return name[:dot], name[dot+1:]
+}
+
+func newEventID() string {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
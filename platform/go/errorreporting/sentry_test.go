@@ -0,0 +1,121 @@
+package errorreporting
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSentryTransportSend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		gotPath string
+		gotAuth string
+		lines   []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("X-Sentry-Auth")
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dsn := strings.Replace(server.URL, "http://", "http://public-key@", 1) + "/prefix/42"
+	transport, err := NewSentryTransport(SentryConfig{DSN: dsn, Environment: "dev", Release: "1.2.3"})
+	require.NoError(t, err)
+
+	err = transport.Send(context.Background(), Event{
+		Err:        fmt.Errorf("create document: %w", io.ErrUnexpectedEOF),
+		Status:     http.StatusInternalServerError,
+		Operation:  "entitiesCreate",
+		RequestID:  "req-1",
+		TenantSlug: "acme",
+		UserID:     "user-1",
+		Method:     http.MethodPost,
+		Path:       "/api/v1/entities/cards/documents",
+		Stack: []runtime.Frame{
+			{Function: "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/handler.(*Handler).EntitiesCreate", File: "handler.go", Line: 10},
+			{Function: "net/http.HandlerFunc.ServeHTTP", File: "server.go", Line: 20},
+		},
+		Time: time.Now(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "/prefix/api/42/envelope/", gotPath)
+	require.Contains(t, gotAuth, "sentry_key=public-key")
+	require.Len(t, lines, 3)
+
+	var event struct {
+		Level       string            `json:"level"`
+		Environment string            `json:"environment"`
+		Transaction string            `json:"transaction"`
+		Tags        map[string]string `json:"tags"`
+		User        map[string]string `json:"user"`
+		Exception   struct {
+			Values []struct {
+				Type       string `json:"type"`
+				Value      string `json:"value"`
+				Stacktrace struct {
+					Frames []sentryFrame `json:"frames"`
+				} `json:"stacktrace"`
+			} `json:"values"`
+		} `json:"exception"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &event))
+	require.Equal(t, "error", event.Level)
+	require.Equal(t, "dev", event.Environment)
+	require.Equal(t, "entitiesCreate", event.Transaction)
+	require.Equal(t, map[string]string{"status": "500", "request_id": "req-1", "tenant_slug": "acme"}, event.Tags)
+	require.Equal(t, "user-1", event.User["id"])
+
+	require.Len(t, event.Exception.Values, 1)
+	exception := event.Exception.Values[0]
+	require.Equal(t, "*errors.errorString", exception.Type)
+	require.Equal(t, "create document: unexpected EOF", exception.Value)
+	frames := exception.Stacktrace.Frames
+	require.Len(t, frames, 2)
+	require.Equal(t, "HandlerFunc.ServeHTTP", frames[0].Function)
+	require.False(t, frames[0].InApp)
+	require.Equal(t, "github.com/zenGate-Global/palmyra-pro-saas/domains/entities/be/handler", frames[1].Module)
+	require.Equal(t, "(*Handler).EntitiesCreate", frames[1].Function)
+	require.True(t, frames[1].InApp)
+}
+
+func TestSentryTransportRejected(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport, err := NewSentryTransport(SentryConfig{DSN: strings.Replace(server.URL, "http://", "http://key@", 1) + "/1"})
+	require.NoError(t, err)
+	err = transport.Send(context.Background(), Event{Err: errors.New("boom")})
+	require.ErrorContains(t, err, "sentry answered 429")
+}
+
+func TestNewSentryTransportValidatesDSN(t *testing.T) {
+	t.Parallel()
+
+	for _, dsn := range []string{"", "https://o1.ingest.sentry.io/1", "https://key@o1.ingest.sentry.io/", "ftp://key@host/1"} {
+		_, err := NewSentryTransport(SentryConfig{DSN: dsn})
+		require.Error(t, err, dsn)
+	}
+}
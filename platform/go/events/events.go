@@ -0,0 +1,56 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Message attribute keys set on every published event.
+const (
+	AttributeEventID    = "event_id"
+	AttributeEventType  = "event_type"
+	AttributeTenantID   = "tenant_id"
+	AttributeOccurredAt = "occurred_at"
+)
+
+// Message is a broker-agnostic domain event ready to be published.
+// Topic is the logical topic (e.g. "entities"); publishers map it to a broker topic or subject.
+// Key carries ordering affinity; brokers that support it keep events with the same key in order.
+type Message struct {
+	ID         string
+	Topic      string
+	Type       string
+	Key        string
+	Data       []byte
+	Attributes map[string]string
+}
+
+// Publisher delivers messages to a broker. Publish must only return nil once the broker has accepted the message.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// MessageFromOutbox converts an outbox row into a Message. tenantID is empty for events
+// drained from the admin schema.
+func MessageFromOutbox(event persistence.OutboxEvent, tenantID string) Message {
+	attributes := map[string]string{
+		AttributeEventID:    event.EventID.String(),
+		AttributeEventType:  event.EventType,
+		AttributeOccurredAt: event.OccurredAt.UTC().Format(time.RFC3339Nano),
+	}
+	if tenantID != "" {
+		attributes[AttributeTenantID] = tenantID
+	}
+
+	return Message{
+		ID:         event.EventID.String(),
+		Topic:      event.Topic,
+		Type:       event.EventType,
+		Key:        event.Key,
+		Data:       append([]byte(nil), event.Payload...),
+		Attributes: attributes,
+	}
+}
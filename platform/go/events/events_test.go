@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+func TestMessageFromOutbox(t *testing.T) {
+	t.Parallel()
+
+	occurredAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	event := persistence.OutboxEvent{
+		EventID:    uuid.New(),
+		Topic:      persistence.OutboxTopicEntities,
+		EventType:  persistence.EntityEventUpdated,
+		Key:        "cards_entities/card-1",
+		Payload:    json.RawMessage(`{"entityId":"card-1"}`),
+		OccurredAt: occurredAt,
+	}
+
+	msg := MessageFromOutbox(event, "tenant-1")
+	require.Equal(t, event.EventID.String(), msg.ID)
+	require.Equal(t, "entities", msg.Topic)
+	require.Equal(t, "entity.updated", msg.Type)
+	require.Equal(t, "cards_entities/card-1", msg.Key)
+	require.JSONEq(t, `{"entityId":"card-1"}`, string(msg.Data))
+	require.Equal(t, "tenant-1", msg.Attributes[AttributeTenantID])
+	require.Equal(t, "entity.updated", msg.Attributes[AttributeEventType])
+	require.Equal(t, "2025-03-01T10:00:00Z", msg.Attributes[AttributeOccurredAt])
+
+	adminMsg := MessageFromOutbox(event, "")
+	require.NotContains(t, adminMsg.Attributes, AttributeTenantID)
+}
+
+func TestNATSSubject(t *testing.T) {
+	t.Parallel()
+
+	msg := Message{Topic: "tenants", Type: "tenant.created"}
+
+	require.Equal(t, "palmyra.tenants.tenant.created", (&NATSPublisher{subjectPrefix: "palmyra"}).Subject(msg))
+	require.Equal(t, "tenants.tenant.created", (&NATSPublisher{}).Subject(msg))
+}
+
+func TestLogPublisher(t *testing.T) {
+	t.Parallel()
+
+	publisher := NewLogPublisher(zap.NewNop())
+	require.NoError(t, publisher.Publish(context.Background(), Message{Topic: "entities", Type: "entity.created"}))
+	require.NoError(t, publisher.Close())
+}
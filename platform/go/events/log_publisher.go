@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// LogPublisher writes messages to the logger instead of a broker. Useful for local development.
+type LogPublisher struct {
+	logger *zap.Logger
+}
+
+// NewLogPublisher constructs a LogPublisher.
+func NewLogPublisher(logger *zap.Logger) *LogPublisher {
+	if logger == nil {
+		panic("logger is required")
+	}
+	return &LogPublisher{logger: logger}
+}
+
+// Publish logs the message and always succeeds.
+func (p *LogPublisher) Publish(_ context.Context, msg Message) error {
+	p.logger.Info("domain event published",
+		zap.String("topic", msg.Topic),
+		zap.String("type", msg.Type),
+		zap.String("key", msg.Key),
+		zap.String("event_id", msg.ID),
+		zap.ByteString("data", msg.Data),
+	)
+	return nil
+}
+
+// Close is a no-op.
+func (p *LogPublisher) Close() error {
+	return nil
+}
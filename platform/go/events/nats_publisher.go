@@ -0,0 +1,62 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSPublisher publishes messages to NATS JetStream on the subject "<subjectPrefix>.<topic>.<type>".
+// The event id is sent as Nats-Msg-Id so JetStream drops duplicates when the relay retries.
+// A stream covering the subjects must exist; the connection is owned by the caller.
+type NATSPublisher struct {
+	js            jetstream.JetStream
+	subjectPrefix string
+}
+
+// NewNATSPublisher constructs a NATSPublisher on top of an established connection.
+func NewNATSPublisher(conn *nats.Conn, subjectPrefix string) (*NATSPublisher, error) {
+	if conn == nil {
+		return nil, fmt.Errorf("nats connection is required")
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("init jetstream: %w", err)
+	}
+	return &NATSPublisher{js: js, subjectPrefix: strings.TrimSuffix(subjectPrefix, ".")}, nil
+}
+
+// Publish sends msg and waits for the JetStream acknowledgement.
+func (p *NATSPublisher) Publish(ctx context.Context, msg Message) error {
+	out := nats.NewMsg(p.Subject(msg))
+	out.Data = msg.Data
+	for key, value := range msg.Attributes {
+		out.Header.Set(key, value)
+	}
+	if msg.Key != "" {
+		out.Header.Set("key", msg.Key)
+	}
+
+	if _, err := p.js.PublishMsg(ctx, out, jetstream.WithMsgID(msg.ID)); err != nil {
+		return fmt.Errorf("publish to nats subject %s: %w", out.Subject, err)
+	}
+	return nil
+}
+
+// Subject returns the NATS subject used for msg.
+func (p *NATSPublisher) Subject(msg Message) string {
+	parts := make([]string, 0, 3)
+	if p.subjectPrefix != "" {
+		parts = append(parts, p.subjectPrefix)
+	}
+	parts = append(parts, msg.Topic, msg.Type)
+	return strings.Join(parts, ".")
+}
+
+// Close is a no-op; the caller closes the underlying connection.
+func (p *NATSPublisher) Close() error {
+	return nil
+}
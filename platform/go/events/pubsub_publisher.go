@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"cloud.google.com/go/pubsub/v2"
+)
+
+// PubSubPublisher publishes messages to Google Cloud Pub/Sub. Each logical topic maps to the
+// Pub/Sub topic "<topicPrefix><topic>", and Message.Key is used as the ordering key.
+// The client is owned by the caller; Close only flushes and stops the per-topic publishers.
+type PubSubPublisher struct {
+	client      *pubsub.Client
+	topicPrefix string
+
+	mu         sync.Mutex
+	publishers map[string]*pubsub.Publisher
+}
+
+// NewPubSubPublisher constructs a PubSubPublisher. topicPrefix may be empty.
+func NewPubSubPublisher(client *pubsub.Client, topicPrefix string) *PubSubPublisher {
+	if client == nil {
+		panic("pubsub client is required")
+	}
+	return &PubSubPublisher{client: client, topicPrefix: topicPrefix, publishers: make(map[string]*pubsub.Publisher)}
+}
+
+// Publish sends msg and waits for the server acknowledgement.
+func (p *PubSubPublisher) Publish(ctx context.Context, msg Message) error {
+	publisher := p.publisher(msg.Topic)
+
+	result := publisher.Publish(ctx, &pubsub.Message{
+		Data:        msg.Data,
+		Attributes:  msg.Attributes,
+		OrderingKey: msg.Key,
+	})
+	if _, err := result.Get(ctx); err != nil {
+		// A failed publish pauses the ordering key; resume so the next relay pass can retry it.
+		publisher.ResumePublish(msg.Key)
+		return fmt.Errorf("publish to pubsub topic %s: %w", p.topicPrefix+msg.Topic, err)
+	}
+	return nil
+}
+
+// Close flushes pending messages and stops every topic publisher.
+func (p *PubSubPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for name, publisher := range p.publishers {
+		publisher.Stop()
+		delete(p.publishers, name)
+	}
+	return nil
+}
+
+func (p *PubSubPublisher) publisher(topic string) *pubsub.Publisher {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	name := p.topicPrefix + topic
+	if publisher, ok := p.publishers[name]; ok {
+		return publisher
+	}
+
+	publisher := p.client.Publisher(name)
+	publisher.EnableMessageOrdering = true
+	p.publishers[name] = publisher
+	return publisher
+}
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceLister enumerates the tenant spaces whose outboxes the relay drains.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// RelayConfig tunes the outbox relay. Zero values fall back to defaults.
+type RelayConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Relay moves events from the admin and tenant outboxes to a Publisher.
+// Events are marked published only after the broker accepted them, so delivery is at-least-once;
+// consumers should de-duplicate on the event_id attribute.
+type Relay struct {
+	db        *persistence.SpaceDB
+	outbox    *persistence.OutboxStore
+	publisher Publisher
+	spaces    SpaceLister
+	logger    *zap.Logger
+	cfg       RelayConfig
+}
+
+// NewRelay constructs a Relay with defaults applied to cfg.
+func NewRelay(db *persistence.SpaceDB, outbox *persistence.OutboxStore, publisher Publisher, spaces SpaceLister, logger *zap.Logger, cfg RelayConfig) *Relay {
+	if db == nil {
+		panic("space db is required")
+	}
+	if outbox == nil {
+		panic("outbox store is required")
+	}
+	if publisher == nil {
+		panic("publisher is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 200
+	}
+	return &Relay{db: db, outbox: outbox, publisher: publisher, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// Run drains the outboxes every Interval until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("outbox relay failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce drains one batch from the admin outbox and from every active tenant outbox.
+// Failures in one space are logged and do not stop the others.
+func (r *Relay) RunOnce(ctx context.Context) error {
+	if err := r.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		return r.drain(ctx, tx, "")
+	}); err != nil {
+		r.logger.Error("outbox relay for admin schema failed", zap.Error(err))
+	}
+
+	spaces, err := r.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		tenantID := space.TenantID.String()
+		if err := r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+			return r.drain(ctx, tx, tenantID)
+		}); err != nil {
+			r.logger.Error("outbox relay for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// drain publishes one batch of pending events. Once an event fails, later events sharing its key are
+// left pending so per-key ordering is preserved across retries.
+func (r *Relay) drain(ctx context.Context, tx pgx.Tx, tenantID string) error {
+	pending, err := r.outbox.ClaimPending(ctx, tx, r.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	published := make([]uuid.UUID, 0, len(pending))
+	blocked := make(map[string]struct{})
+	for _, event := range pending {
+		if _, ok := blocked[event.Key]; ok {
+			continue
+		}
+
+		if err := r.publisher.Publish(ctx, MessageFromOutbox(event, tenantID)); err != nil {
+			blocked[event.Key] = struct{}{}
+			r.logger.Warn("publish outbox event failed",
+				zap.String("event_id", event.EventID.String()),
+				zap.String("topic", event.Topic),
+				zap.Error(err),
+			)
+			if markErr := r.outbox.MarkFailed(ctx, tx, event.EventID, err); markErr != nil {
+				return markErr
+			}
+			continue
+		}
+		published = append(published, event.EventID)
+	}
+
+	return r.outbox.MarkPublished(ctx, tx, published)
+}
@@ -0,0 +1,157 @@
+// Package features evaluates per-tenant feature flags. A flag has a platform-wide default and optional per-tenant
+// overrides, both managed through the admin feature flag endpoints; a flag that does not exist is off.
+package features
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Flags gating platform features.
+const (
+	// BulkImport gates POST /entities/{tableName}/documents:bulk.
+	BulkImport = "entities.bulk-import"
+)
+
+// Source loads the flags of a tenant, with its overrides applied; implemented by persistence.FeatureFlagStore.
+type Source interface {
+	TenantFlags(ctx context.Context, tenantID uuid.UUID) (map[string]bool, error)
+}
+
+// Config tunes the Evaluator. Zero values fall back to defaults: flags are cached for 30 seconds, which bounds how
+// long a change takes to reach every process.
+type Config struct {
+	TTL time.Duration
+}
+
+const defaultTTL = 30 * time.Second
+
+// Evaluator answers flag lookups from a per-tenant cache in front of the Source.
+type Evaluator struct {
+	source Source
+	logger *zap.Logger
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	items map[uuid.UUID]cachedFlags
+}
+
+type cachedFlags struct {
+	flags     map[string]bool
+	expiresAt time.Time
+}
+
+// NewEvaluator constructs an Evaluator with defaults applied to cfg.
+func NewEvaluator(source Source, logger *zap.Logger, cfg Config) *Evaluator {
+	if source == nil {
+		panic("feature flag source is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTTL
+	}
+	return &Evaluator{source: source, logger: logger, ttl: cfg.TTL, now: time.Now, items: make(map[uuid.UUID]cachedFlags)}
+}
+
+// Flags returns every flag of the tenant. The map is a copy the caller may keep.
+func (e *Evaluator) Flags(ctx context.Context, tenantID uuid.UUID) (map[string]bool, error) {
+	flags, err := e.load(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]bool, len(flags))
+	for key, enabled := range flags {
+		out[key] = enabled
+	}
+	return out, nil
+}
+
+// Enabled reports whether key is on for the tenant. Flags that cannot be loaded are treated as off.
+func (e *Evaluator) Enabled(ctx context.Context, tenantID uuid.UUID, key string) bool {
+	flags, err := e.load(ctx, tenantID)
+	if err != nil {
+		e.logger.Warn("loading feature flags failed", zap.String("tenant", tenantID.String()), zap.String("flag", key), zap.Error(err))
+		return false
+	}
+	return flags[key]
+}
+
+// SetTTL changes how long flags are cached from now on and drops the cache, so a shorter TTL applies at once. Zero
+// or less restores the default.
+func (e *Evaluator) SetTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+	e.mu.Lock()
+	e.ttl = ttl
+	e.items = make(map[uuid.UUID]cachedFlags)
+	e.mu.Unlock()
+}
+
+// Invalidate drops every cached tenant, so the next lookups see changes made by this process immediately.
+func (e *Evaluator) Invalidate() {
+	e.mu.Lock()
+	e.items = make(map[uuid.UUID]cachedFlags)
+	e.mu.Unlock()
+}
+
+func (e *Evaluator) load(ctx context.Context, tenantID uuid.UUID) (map[string]bool, error) {
+	now := e.now()
+	e.mu.Lock()
+	item, ok := e.items[tenantID]
+	e.mu.Unlock()
+	if ok && now.Before(item.expiresAt) {
+		return item.flags, nil
+	}
+
+	flags, err := e.source.TenantFlags(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	e.items[tenantID] = cachedFlags{flags: flags, expiresAt: now.Add(e.ttl)}
+	e.mu.Unlock()
+	return flags, nil
+}
+
+type evaluatorKey struct{}
+
+// WithEvaluator returns a context through which Enabled evaluates flags.
+func WithEvaluator(ctx context.Context, e *Evaluator) context.Context {
+	return context.WithValue(ctx, evaluatorKey{}, e)
+}
+
+// Middleware makes e available to Enabled for the rest of the request. Flags are only loaded when asked for.
+func Middleware(e *Evaluator) func(http.Handler) http.Handler {
+	if e == nil {
+		panic("feature flag evaluator is required")
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(WithEvaluator(r.Context(), e)))
+		})
+	}
+}
+
+// Enabled reports whether key is on for the tenant space in ctx. It is off without an evaluator or a tenant space.
+func Enabled(ctx context.Context, key string) bool {
+	e, ok := ctx.Value(evaluatorKey{}).(*Evaluator)
+	if !ok {
+		return false
+	}
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	return e.Enabled(ctx, space.TenantID, key)
+}
@@ -0,0 +1,88 @@
+package features
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type countingSource struct {
+	flags map[uuid.UUID]map[string]bool
+	err   error
+	calls int
+}
+
+func (s *countingSource) TenantFlags(_ context.Context, tenantID uuid.UUID) (map[string]bool, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.flags[tenantID], nil
+}
+
+func TestEvaluatorCachesTenantFlags(t *testing.T) {
+	t.Parallel()
+
+	acme, globex := uuid.New(), uuid.New()
+	source := &countingSource{flags: map[uuid.UUID]map[string]bool{
+		acme:   {BulkImport: true},
+		globex: {BulkImport: false},
+	}}
+	e := NewEvaluator(source, zap.NewNop(), Config{TTL: time.Minute})
+	now := time.Now()
+	e.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	require.True(t, e.Enabled(ctx, acme, BulkImport))
+	require.True(t, e.Enabled(ctx, acme, BulkImport))
+	require.False(t, e.Enabled(ctx, acme, "unknown"))
+	require.False(t, e.Enabled(ctx, globex, BulkImport))
+	require.Equal(t, 2, source.calls)
+
+	source.flags[acme][BulkImport] = false
+	now = now.Add(2 * time.Minute)
+	require.False(t, e.Enabled(ctx, acme, BulkImport))
+	require.Equal(t, 3, source.calls)
+
+	source.flags[acme][BulkImport] = true
+	e.Invalidate()
+	flags, err := e.Flags(ctx, acme)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{BulkImport: true}, flags)
+}
+
+func TestEvaluatorTreatsUnavailableFlagsAsOff(t *testing.T) {
+	t.Parallel()
+
+	e := NewEvaluator(&countingSource{err: errors.New("db down")}, zap.NewNop(), Config{})
+	require.False(t, e.Enabled(context.Background(), uuid.New(), BulkImport))
+	_, err := e.Flags(context.Background(), uuid.New())
+	require.Error(t, err)
+}
+
+func TestEnabledUsesTheRequestTenant(t *testing.T) {
+	t.Parallel()
+
+	acme := uuid.New()
+	e := NewEvaluator(&countingSource{flags: map[uuid.UUID]map[string]bool{acme: {BulkImport: true}}}, zap.NewNop(), Config{})
+
+	var got []bool
+	handler := Middleware(e)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = append(got, Enabled(r.Context(), BulkImport))
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	handler.ServeHTTP(httptest.NewRecorder(), req.WithContext(tenant.WithSpace(req.Context(), tenant.Space{TenantID: acme})))
+	require.Equal(t, []bool{false, true}, got, "requests without a tenant space have every flag off")
+
+	require.False(t, Enabled(tenant.WithSpace(context.Background(), tenant.Space{TenantID: acme}), BulkImport))
+}
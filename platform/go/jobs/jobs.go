@@ -0,0 +1,141 @@
+// Package jobs runs generic background work such as imports, exports and migrations. Features enqueue a job of a
+// kind with a JSON payload through a Queue; a Pool claims due jobs from the Postgres queue (FOR UPDATE SKIP LOCKED,
+// so several processes can share it), runs the Handler registered for the kind and retries failures with
+// exponential backoff until the job's attempt limit is reached.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Store is the job queue. *persistence.JobStore implements it.
+type Store interface {
+	Enqueue(ctx context.Context, rec persistence.JobRecord) (persistence.JobRecord, error)
+	Get(ctx context.Context, jobID uuid.UUID) (persistence.JobRecord, error)
+	ClaimDue(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]persistence.JobRecord, error)
+	Save(ctx context.Context, rec persistence.JobRecord) error
+}
+
+// Job is the attempt a Handler runs.
+type Job struct {
+	ID       uuid.UUID
+	TenantID *uuid.UUID
+	Kind     string
+	Payload  json.RawMessage
+	// Attempt counts from 1.
+	Attempt     int
+	MaxAttempts int
+	CreatedBy   *string
+}
+
+// Decode unmarshals the job payload into v.
+func (j Job) Decode(v any) error {
+	if err := json.Unmarshal(j.Payload, v); err != nil {
+		return Permanent(fmt.Errorf("decode %s job payload: %w", j.Kind, err))
+	}
+	return nil
+}
+
+// Handler runs one attempt of a job. The result is stored as JSON once the job succeeded. Returned errors are
+// retried unless wrapped with Permanent. Tenant jobs run with the tenant space in ctx.
+type Handler func(ctx context.Context, job Job) (any, error)
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying; the job fails on the current attempt.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// DefaultMaxAttempts applies when an EnqueueRequest does not set MaxAttempts.
+const DefaultMaxAttempts = 5
+
+// EnqueueRequest describes a job to queue. TenantID is nil for platform jobs. ID is generated when zero; features
+// that keep their own record of the work reuse its id, so GET /jobs/{jobId} answers for it.
+type EnqueueRequest struct {
+	ID          uuid.UUID
+	TenantID    *uuid.UUID
+	Kind        string
+	Payload     any
+	MaxAttempts int
+	CreatedBy   string
+}
+
+// Queue enqueues jobs and reads their status.
+type Queue struct {
+	store Store
+}
+
+// NewQueue constructs a Queue over store.
+func NewQueue(store Store) *Queue {
+	if store == nil {
+		panic("job store is required")
+	}
+	return &Queue{store: store}
+}
+
+// Enqueue queues a job that is due immediately.
+func (q *Queue) Enqueue(ctx context.Context, request EnqueueRequest) (persistence.JobRecord, error) {
+	if request.Kind == "" {
+		return persistence.JobRecord{}, errors.New("job kind is required")
+	}
+	payload := json.RawMessage(`{}`)
+	if request.Payload != nil {
+		encoded, err := json.Marshal(request.Payload)
+		if err != nil {
+			return persistence.JobRecord{}, fmt.Errorf("encode %s job payload: %w", request.Kind, err)
+		}
+		payload = encoded
+	}
+	maxAttempts := request.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	var createdBy *string
+	if request.CreatedBy != "" {
+		createdBy = &request.CreatedBy
+	}
+	jobID := request.ID
+	if jobID == uuid.Nil {
+		jobID = uuid.New()
+	}
+
+	return q.store.Enqueue(ctx, persistence.JobRecord{
+		JobID:       jobID,
+		TenantID:    request.TenantID,
+		Kind:        request.Kind,
+		Payload:     payload,
+		MaxAttempts: maxAttempts,
+		CreatedBy:   createdBy,
+	})
+}
+
+// Get returns a job by id, or persistence.ErrJobNotFound.
+func (q *Queue) Get(ctx context.Context, jobID uuid.UUID) (persistence.JobRecord, error) {
+	return q.store.Get(ctx, jobID)
+}
+
+// compile-time assertions to ensure interface compliance
+var _ Store = (*persistence.JobStore)(nil)
@@ -0,0 +1,260 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceResolver resolves the tenant space a tenant job runs in.
+type SpaceResolver interface {
+	ResolveTenantSpace(ctx context.Context, id uuid.UUID) (tenant.Space, error)
+}
+
+// PoolConfig tunes the worker pool. Zero values fall back to defaults: every 2 seconds up to 20 due jobs are
+// claimed and run 4 at a time, each attempt may take the 10 minute lease, and retries back off exponentially from
+// 10 seconds up to 10 minutes.
+type PoolConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	Concurrency int
+	Lease       time.Duration
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Pool claims due jobs of the registered kinds and runs their handlers.
+type Pool struct {
+	store  Store
+	spaces SpaceResolver
+	logger *zap.Logger
+	cfg    PoolConfig
+	now    func() time.Time
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewPool constructs a pool with defaults applied to cfg. Register handlers before calling Run.
+func NewPool(store Store, spaces SpaceResolver, logger *zap.Logger, cfg PoolConfig) *Pool {
+	if store == nil {
+		panic("job store is required")
+	}
+	if spaces == nil {
+		panic("space resolver is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 20
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 10 * time.Minute
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 10 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 10 * time.Minute
+	}
+
+	return &Pool{store: store, spaces: spaces, logger: logger, cfg: cfg, now: time.Now, handlers: make(map[string]Handler)}
+}
+
+// Register sets the handler of a job kind. Only registered kinds are claimed, so processes running different
+// pools can share the queue.
+func (p *Pool) Register(kind string, handler Handler) {
+	if kind == "" {
+		panic("job kind is required")
+	}
+	if handler == nil {
+		panic("job handler is required")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.handlers[kind]; ok {
+		panic(fmt.Sprintf("job kind %q is already registered", kind))
+	}
+	p.handlers[kind] = handler
+}
+
+// Kinds lists the registered job kinds in order.
+func (p *Pool) Kinds() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	kinds := make([]string, 0, len(p.handlers))
+	for kind := range p.handlers {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Run processes due jobs every Interval until ctx is cancelled.
+func (p *Pool) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error("jobs failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce claims a batch of due jobs and runs one attempt of each, up to Concurrency at a time. Outcomes are saved
+// even once ctx is cancelled: attempts interrupted by shutdown, and claimed jobs it left unstarted, are scheduled for
+// a retry instead of staying running until their lease expires.
+func (p *Pool) RunOnce(ctx context.Context) error {
+	kinds := p.Kinds()
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	claimed, err := p.store.ClaimDue(ctx, kinds, p.cfg.BatchSize, p.cfg.Lease)
+	if err != nil {
+		return err
+	}
+
+	bookkeeping := context.WithoutCancel(ctx)
+	slots := make(chan struct{}, p.cfg.Concurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	save := func(rec persistence.JobRecord) {
+		err := p.store.Save(bookkeeping, rec)
+		switch {
+		case errors.Is(err, persistence.ErrJobLeaseLost):
+			p.logger.Warn("job lease lost; outcome discarded", zap.String("job", rec.JobID.String()), zap.Int("attempt", rec.Attempts))
+		case err != nil:
+			p.logger.Error("save job failed", zap.String("job", rec.JobID.String()), zap.Error(err))
+		}
+	}
+	for i, rec := range claimed {
+		if ctx.Err() != nil {
+			for _, unstarted := range claimed[i:] {
+				save(p.outcome(unstarted, nil, ctx.Err()))
+			}
+			return ctx.Err()
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(rec persistence.JobRecord) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			result, err := p.attempt(ctx, rec)
+			save(p.outcome(rec, result, err))
+		}(rec)
+	}
+	return nil
+}
+
+// outcome decides from the result of an attempt whether the job is finished or scheduled for a retry.
+func (p *Pool) outcome(rec persistence.JobRecord, result json.RawMessage, err error) persistence.JobRecord {
+	now := p.now().UTC()
+
+	switch {
+	case err == nil:
+		rec.Status, rec.Result, rec.LastError, rec.FinishedAt = persistence.JobSucceeded, result, nil, &now
+		return rec
+	case IsPermanent(err) || rec.Attempts >= rec.MaxAttempts:
+		msg := err.Error()
+		rec.Status, rec.LastError, rec.FinishedAt = persistence.JobFailed, &msg, &now
+		p.logger.Warn("job failed",
+			zap.String("job", rec.JobID.String()),
+			zap.String("kind", rec.Kind),
+			zap.Int("attempts", rec.Attempts),
+			zap.Error(err))
+	default:
+		msg := err.Error()
+		rec.Status, rec.LastError = persistence.JobQueued, &msg
+		rec.NextAttemptAt = now.Add(p.backoff(rec.Attempts))
+	}
+	return rec
+}
+
+// attempt runs the handler within the lease, in the job's tenant space, and encodes its result.
+func (p *Pool) attempt(ctx context.Context, rec persistence.JobRecord) (result json.RawMessage, err error) {
+	p.mu.RLock()
+	handler, ok := p.handlers[rec.Kind]
+	p.mu.RUnlock()
+	if !ok {
+		return nil, Permanent(fmt.Errorf("no handler for job kind %q", rec.Kind))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Lease)
+	defer cancel()
+
+	if rec.TenantID != nil {
+		space, err := p.spaces.ResolveTenantSpace(ctx, *rec.TenantID)
+		if err != nil {
+			return nil, fmt.Errorf("resolve tenant space: %w", err)
+		}
+		ctx = tenant.WithSpace(ctx, space)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result, err = nil, fmt.Errorf("job handler panicked: %v", recovered)
+		}
+	}()
+
+	out, err := handler(ctx, Job{
+		ID:          rec.JobID,
+		TenantID:    rec.TenantID,
+		Kind:        rec.Kind,
+		Payload:     rec.Payload,
+		Attempt:     rec.Attempts,
+		MaxAttempts: rec.MaxAttempts,
+		CreatedBy:   rec.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(out)
+	if err != nil {
+		return nil, Permanent(fmt.Errorf("encode %s job result: %w", rec.Kind, err))
+	}
+	return encoded, nil
+}
+
+func (p *Pool) backoff(attempt int) time.Duration {
+	delay := p.cfg.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= p.cfg.MaxBackoff {
+			return p.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,260 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// inMemoryStore is a minimal in-memory Store for tests.
+type inMemoryStore struct {
+	mu   sync.Mutex
+	data map[uuid.UUID]persistence.JobRecord
+}
+
+func newInMemoryStore() *inMemoryStore {
+	return &inMemoryStore{data: make(map[uuid.UUID]persistence.JobRecord)}
+}
+
+func (s *inMemoryStore) Enqueue(_ context.Context, rec persistence.JobRecord) (persistence.JobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec.Status = persistence.JobQueued
+	s.data[rec.JobID] = rec
+	return rec, nil
+}
+
+func (s *inMemoryStore) Get(_ context.Context, jobID uuid.UUID) (persistence.JobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.data[jobID]
+	if !ok {
+		return persistence.JobRecord{}, persistence.ErrJobNotFound
+	}
+	return rec, nil
+}
+
+func (s *inMemoryStore) ClaimDue(_ context.Context, kinds []string, limit int, _ time.Duration) ([]persistence.JobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	claimed := make([]persistence.JobRecord, 0)
+	for id, rec := range s.data {
+		if len(claimed) >= limit || !slices.Contains(kinds, rec.Kind) || rec.NextAttemptAt.After(time.Now()) {
+			continue
+		}
+		if (rec.Status == persistence.JobQueued || rec.Status == persistence.JobRunning) && rec.Attempts < rec.MaxAttempts {
+			rec.Status = persistence.JobRunning
+			rec.Attempts++
+			s.data[id] = rec
+			claimed = append(claimed, rec)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *inMemoryStore) Save(ctx context.Context, rec persistence.JobRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.data[rec.JobID]
+	if !ok {
+		return persistence.ErrJobNotFound
+	}
+	if current.Status != persistence.JobRunning || current.Attempts != rec.Attempts {
+		return persistence.ErrJobLeaseLost
+	}
+	s.data[rec.JobID] = rec
+	return nil
+}
+
+type staticSpaces map[uuid.UUID]tenant.Space
+
+func (s staticSpaces) ResolveTenantSpace(_ context.Context, id uuid.UUID) (tenant.Space, error) {
+	space, ok := s[id]
+	if !ok {
+		return tenant.Space{}, errors.New("tenant not found")
+	}
+	return space, nil
+}
+
+func TestPoolRunsRegisteredKinds(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newInMemoryStore()
+	tenantID := uuid.New()
+	pool := NewPool(store, staticSpaces{tenantID: {TenantID: tenantID, Slug: "acme"}}, zap.NewNop(), PoolConfig{})
+
+	var seenSlug string
+	pool.Register("export", func(ctx context.Context, job Job) (any, error) {
+		space, _ := tenant.FromContext(ctx)
+		seenSlug = space.Slug
+
+		var payload struct {
+			Table string `json:"table"`
+		}
+		if err := job.Decode(&payload); err != nil {
+			return nil, err
+		}
+		return map[string]string{"exported": payload.Table}, nil
+	})
+
+	queue := NewQueue(store)
+	job, err := queue.Enqueue(ctx, EnqueueRequest{TenantID: &tenantID, Kind: "export", Payload: map[string]string{"table": "cards_entities"}})
+	require.NoError(t, err)
+	require.Equal(t, DefaultMaxAttempts, job.MaxAttempts)
+	otherID := uuid.New()
+	other, err := queue.Enqueue(ctx, EnqueueRequest{ID: otherID, Kind: "import"})
+	require.NoError(t, err)
+	require.Equal(t, otherID, other.JobID)
+
+	require.NoError(t, pool.RunOnce(ctx))
+
+	done, err := queue.Get(ctx, job.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobSucceeded, done.Status)
+	require.JSONEq(t, `{"exported":"cards_entities"}`, string(done.Result))
+	require.NotNil(t, done.FinishedAt)
+	require.Equal(t, "acme", seenSlug)
+
+	// Kinds without a handler stay queued for another pool.
+	untouched, err := queue.Get(ctx, other.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobQueued, untouched.Status)
+	require.Zero(t, untouched.Attempts)
+}
+
+func TestPoolRetriesFailures(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newInMemoryStore()
+	pool := NewPool(store, staticSpaces{}, zap.NewNop(), PoolConfig{BaseBackoff: time.Second, MaxBackoff: 3 * time.Second})
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	pool.now = func() time.Time { return now }
+
+	pool.Register("flaky", func(context.Context, Job) (any, error) {
+		return nil, errors.New("upstream unavailable")
+	})
+	job, err := NewQueue(store).Enqueue(ctx, EnqueueRequest{Kind: "flaky", MaxAttempts: 2})
+	require.NoError(t, err)
+
+	require.NoError(t, pool.RunOnce(ctx))
+	retried, err := store.Get(ctx, job.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobQueued, retried.Status)
+	require.Equal(t, "upstream unavailable", *retried.LastError)
+	require.Equal(t, now.Add(time.Second), retried.NextAttemptAt)
+	require.Nil(t, retried.FinishedAt)
+
+	// The last attempt fails the job.
+	store.mu.Lock()
+	retried.NextAttemptAt = time.Time{}
+	store.data[job.JobID] = retried
+	store.mu.Unlock()
+	require.NoError(t, pool.RunOnce(ctx))
+	failed, err := store.Get(ctx, job.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobFailed, failed.Status)
+	require.Equal(t, 2, failed.Attempts)
+	require.NotNil(t, failed.FinishedAt)
+}
+
+func TestPoolFailsPermanentErrorsAndPanics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newInMemoryStore()
+	pool := NewPool(store, staticSpaces{}, zap.NewNop(), PoolConfig{})
+	pool.Register("invalid", func(context.Context, Job) (any, error) {
+		return nil, Permanent(errors.New("table does not exist"))
+	})
+	pool.Register("broken", func(context.Context, Job) (any, error) {
+		panic("nil map")
+	})
+	missingTenant := uuid.New()
+
+	queue := NewQueue(store)
+	invalid, err := queue.Enqueue(ctx, EnqueueRequest{Kind: "invalid"})
+	require.NoError(t, err)
+	broken, err := queue.Enqueue(ctx, EnqueueRequest{Kind: "broken", MaxAttempts: 1})
+	require.NoError(t, err)
+	unresolved, err := queue.Enqueue(ctx, EnqueueRequest{Kind: "invalid", TenantID: &missingTenant})
+	require.NoError(t, err)
+
+	require.NoError(t, pool.RunOnce(ctx))
+
+	got, err := store.Get(ctx, invalid.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobFailed, got.Status)
+	require.Equal(t, "table does not exist", *got.LastError)
+
+	got, err = store.Get(ctx, broken.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobFailed, got.Status)
+	require.Contains(t, *got.LastError, "panicked")
+
+	// Tenant resolution errors are retried.
+	got, err = store.Get(ctx, unresolved.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobQueued, got.Status)
+	require.Contains(t, *got.LastError, "resolve tenant space")
+}
+
+func TestPoolSavesOutcomesAfterShutdown(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := newInMemoryStore()
+	pool := NewPool(store, staticSpaces{}, zap.NewNop(), PoolConfig{})
+	pool.Register("finished", func(context.Context, Job) (any, error) {
+		cancel()
+		return "done", nil
+	})
+	pool.Register("interrupted", func(ctx context.Context, _ Job) (any, error) {
+		cancel()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	queue := NewQueue(store)
+	finished, err := queue.Enqueue(context.Background(), EnqueueRequest{Kind: "finished"})
+	require.NoError(t, err)
+	interrupted, err := queue.Enqueue(context.Background(), EnqueueRequest{Kind: "interrupted"})
+	require.NoError(t, err)
+
+	_ = pool.RunOnce(ctx)
+
+	done, err := queue.Get(context.Background(), finished.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobSucceeded, done.Status)
+
+	// Depending on the order the jobs ran in, the interrupted one was cut short or never started; it is scheduled
+	// for a retry either way.
+	retry, err := queue.Get(context.Background(), interrupted.JobID)
+	require.NoError(t, err)
+	require.Equal(t, persistence.JobQueued, retry.Status)
+}
+
+func TestPoolRegisterRejectsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool(newInMemoryStore(), staticSpaces{}, zap.NewNop(), PoolConfig{})
+	handler := func(context.Context, Job) (any, error) { return nil, nil }
+	pool.Register("export", handler)
+	require.Panics(t, func() { pool.Register("export", handler) })
+	require.Equal(t, []string{"export"}, pool.Kinds())
+}
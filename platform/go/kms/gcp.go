@@ -0,0 +1,68 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	cloudkms "google.golang.org/api/cloudkms/v1"
+)
+
+// GCPKeyManager wraps data keys with a Cloud KMS symmetric crypto key, authenticating with Application Default
+// Credentials.
+type GCPKeyManager struct {
+	keys    *cloudkms.ProjectsLocationsKeyRingsCryptoKeysService
+	keyName string
+}
+
+// NewGCPKeyManager returns a key manager for the crypto key resource keyName, in the form
+// projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>.
+func NewGCPKeyManager(ctx context.Context, keyName string) (*GCPKeyManager, error) {
+	keyName = strings.TrimSpace(keyName)
+	if !strings.HasPrefix(keyName, "projects/") || !strings.Contains(keyName, "/cryptoKeys/") {
+		return nil, fmt.Errorf("cloud kms key must be a crypto key resource name, got %q", keyName)
+	}
+	svc, err := cloudkms.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create cloud kms client: %w", err)
+	}
+	return &GCPKeyManager{keys: svc.Projects.Locations.KeyRings.CryptoKeys, keyName: keyName}, nil
+}
+
+func (m *GCPKeyManager) KeyName() string {
+	return m.keyName
+}
+
+// Wrap encrypts dataKey with the primary version of the crypto key.
+func (m *GCPKeyManager) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	resp, err := m.keys.Encrypt(m.keyName, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dataKey),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("cloud kms encrypt: %w", err)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(resp.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("decode cloud kms ciphertext: %w", err)
+	}
+	return wrapped, nil
+}
+
+// Unwrap decrypts wrapped with whichever version of the crypto key encrypted it, so key rotation keeps existing data
+// keys readable.
+func (m *GCPKeyManager) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	resp, err := m.keys.Decrypt(m.keyName, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(wrapped),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("cloud kms decrypt: %w", err)
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("decode cloud kms plaintext: %w", err)
+	}
+	return dataKey, nil
+}
+
+var _ KeyManager = (*GCPKeyManager)(nil)
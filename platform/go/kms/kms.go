@@ -0,0 +1,22 @@
+// Package kms wraps the per-tenant data keys used for field-level encryption with a key-encryption key held by a key
+// management service, so only wrapped data keys are ever stored next to the data (envelope encryption).
+package kms
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidWrappedKey indicates a wrapped key that was not produced by the key manager or was tampered with.
+var ErrInvalidWrappedKey = errors.New("invalid wrapped key")
+
+// KeyManager wraps and unwraps data keys with a key-encryption key that never leaves the key manager.
+type KeyManager interface {
+	// KeyName identifies the key-encryption key, e.g. the Cloud KMS crypto key resource name. It is stored with every
+	// wrapped data key so a key wrapped by another key is detected instead of failing to decrypt later.
+	KeyName() string
+	// Wrap encrypts a data key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	// Unwrap decrypts a data key previously returned by Wrap.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
@@ -0,0 +1,38 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalKeyManagerWrapUnwrap(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLocalKeyManager([]byte("short"))
+	require.Error(t, err)
+
+	masterKey := bytes.Repeat([]byte{7}, LocalKeySize)
+	manager, err := NewLocalKeyManager(masterKey)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	dataKey := bytes.Repeat([]byte{1}, 32)
+	wrapped, err := manager.Wrap(ctx, dataKey)
+	require.NoError(t, err)
+	require.NotContains(t, string(wrapped), string(dataKey))
+
+	unwrapped, err := manager.Unwrap(ctx, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrapped)
+
+	wrapped[len(wrapped)-1] ^= 0xff
+	_, err = manager.Unwrap(ctx, wrapped)
+	require.ErrorIs(t, err, ErrInvalidWrappedKey)
+
+	other, err := NewLocalKeyManager(bytes.Repeat([]byte{8}, LocalKeySize))
+	require.NoError(t, err)
+	require.NotEqual(t, manager.KeyName(), other.KeyName())
+}
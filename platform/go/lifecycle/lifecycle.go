@@ -0,0 +1,133 @@
+// Package lifecycle shuts a service down in order. Components register a stop hook as they are built, so a component
+// is registered after everything it depends on; Shutdown runs the hooks in reverse, stopping each component before
+// its dependencies, e.g. the HTTP server before the background loops and those before the database pool.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type hook struct {
+	name string
+	stop func(context.Context) error
+}
+
+// Manager owns the background goroutines and stop hooks of a service.
+type Manager struct {
+	logger *zap.Logger
+
+	mu         sync.Mutex
+	hooks      []hook
+	background bool
+	stopping   bool
+
+	// Background loops share runCtx, cancelled by the hook the first Go registers.
+	runCtx    context.Context
+	cancelRun context.CancelFunc
+	running   sync.WaitGroup
+}
+
+// New constructs a Manager.
+func New(logger *zap.Logger) *Manager {
+	if logger == nil {
+		panic("lifecycle: logger is required")
+	}
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	return &Manager{logger: logger, runCtx: runCtx, cancelRun: cancelRun}
+}
+
+// OnStop registers stop to run at shutdown, before the hooks registered earlier. stop should return once ctx is
+// done even when the component has not stopped.
+func (m *Manager) OnStop(name string, stop func(context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, stop: stop})
+}
+
+// OnClose registers a close func that neither takes a context nor fails, such as a client's Close.
+func (m *Manager) OnClose(name string, closeFn func()) {
+	m.OnStop(name, func(context.Context) error {
+		closeFn()
+		return nil
+	})
+}
+
+// Go runs a background loop until shutdown cancels its context. The loops stop together, at the point of the first
+// Go call in the shutdown order, and Shutdown waits for them to return.
+func (m *Manager) Go(name string, run func(context.Context)) {
+	m.mu.Lock()
+	if !m.background {
+		m.background = true
+		m.hooks = append(m.hooks, hook{name: "background loops", stop: m.stopBackground})
+	}
+	m.mu.Unlock()
+
+	m.running.Add(1)
+	go func() {
+		defer m.running.Done()
+		run(m.runCtx)
+		if m.runCtx.Err() == nil {
+			m.logger.Debug("background loop returned", zap.String("component", name))
+		}
+	}()
+}
+
+func (m *Manager) stopBackground(ctx context.Context) error {
+	m.cancelRun()
+	stopped := make(chan struct{})
+	go func() {
+		m.running.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("background loops still running: %w", ctx.Err())
+	}
+}
+
+// Shutdown runs the stop hooks in reverse registration order. Every hook runs even when an earlier one failed or ctx
+// expired, so connections are still released; the failures are logged and returned joined. Shutdown runs once; later
+// calls return nil.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	if m.stopping {
+		m.mu.Unlock()
+		return nil
+	}
+	m.stopping = true
+	hooks := m.hooks
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		h := hooks[i]
+		started := time.Now()
+		if err := h.stop(ctx); err != nil {
+			m.logger.Error("component shutdown failed", zap.String("component", h.name), zap.Error(err))
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			continue
+		}
+		m.logger.Debug("component stopped", zap.String("component", h.name), zap.Duration("duration", time.Since(started)))
+	}
+	return errors.Join(errs...)
+}
+
+// WaitForSignal blocks until the process receives SIGINT or SIGTERM, the signal Cloud Run and Kubernetes send before
+// stopping a container, and returns it.
+func WaitForSignal() os.Signal {
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(stop)
+	return <-stop
+}
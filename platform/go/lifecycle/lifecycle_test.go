@@ -0,0 +1,66 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestShutdownStopsInReverseOrder(t *testing.T) {
+	t.Parallel()
+
+	manager := New(zap.NewNop())
+	var (
+		mu      sync.Mutex
+		stopped []string
+	)
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = append(stopped, name)
+	}
+
+	manager.OnClose("pool", func() { record("pool") })
+	manager.OnStop("publisher", func(context.Context) error {
+		record("publisher")
+		return errors.New("flush failed")
+	})
+	for _, name := range []string{"relay", "dispatcher"} {
+		manager.Go(name, func(ctx context.Context) {
+			<-ctx.Done()
+			record(name)
+		})
+	}
+	manager.OnClose("server", func() { record("server") })
+
+	err := manager.Shutdown(context.Background())
+	require.EqualError(t, err, "publisher: flush failed")
+	require.Equal(t, "server", stopped[0])
+	require.ElementsMatch(t, []string{"relay", "dispatcher"}, stopped[1:3])
+	require.Equal(t, []string{"publisher", "pool"}, stopped[3:])
+
+	require.NoError(t, manager.Shutdown(context.Background()))
+	require.Len(t, stopped, 5)
+}
+
+func TestShutdownGivesUpOnStuckLoops(t *testing.T) {
+	t.Parallel()
+
+	manager := New(zap.NewNop())
+	closed := false
+	manager.OnClose("pool", func() { closed = true })
+	release := make(chan struct{})
+	defer close(release)
+	manager.Go("stuck", func(context.Context) { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := manager.Shutdown(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, closed)
+}
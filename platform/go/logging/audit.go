@@ -0,0 +1,279 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Redacted replaces header values and payload fields in audit entries.
+const Redacted = "[REDACTED]"
+
+// redactedHeaders never reach the audit sink in clear.
+var redactedHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Proxy-Authorization": {},
+	"Cookie":              {},
+	"Set-Cookie":          {},
+	"X-Api-Key":           {},
+}
+
+// AuditConfig enables request/response audit entries for the routes it lists. A nil Sink disables auditing.
+// Zero values fall back to defaults: bodies are captured up to 4 KiB each.
+type AuditConfig struct {
+	// Sink receives one "request audited" entry per audited request; keep it apart from the application logs.
+	Sink *zap.Logger
+	// Routes opts routes in; requests matching none of them are not audited.
+	Routes []AuditRoute
+	// MaxBodyBytes caps the captured size of each body.
+	MaxBodyBytes int
+}
+
+// AuditRoute selects requests by method and path. Method is an HTTP method or "*" for any. Pattern is matched
+// segment by segment against the request path: "*" matches one segment and a final "**" matches any remainder,
+// so "/api/v1/entities/**" covers every entity route.
+type AuditRoute struct {
+	Method  string
+	Pattern string
+}
+
+// ParseAuditRoutes parses "METHOD PATTERN" entries such as "POST /api/v1/entities/**".
+func ParseAuditRoutes(entries []string) ([]AuditRoute, error) {
+	routes := make([]AuditRoute, 0, len(entries))
+	for _, entry := range entries {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+			return nil, fmt.Errorf("audit route %q must be a method and a path pattern", entry)
+		}
+		routes = append(routes, AuditRoute{Method: strings.ToUpper(fields[0]), Pattern: fields[1]})
+	}
+	return routes, nil
+}
+
+func (r AuditRoute) matches(method, path string) bool {
+	if r.Method != "*" && r.Method != method {
+		return false
+	}
+	pattern := strings.Split(strings.Trim(r.Pattern, "/"), "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, part := range pattern {
+		if part == "**" && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(segments) || (part != "*" && part != segments[i]) {
+			return false
+		}
+	}
+	return len(pattern) == len(segments)
+}
+
+func (c AuditConfig) audits(r *http.Request) bool {
+	if c.Sink == nil {
+		return false
+	}
+	for _, route := range c.Routes {
+		if route.matches(r.Method, r.URL.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditState collects, while the request runs, the payload fields to redact from its audit entry.
+type auditState struct {
+	mu     sync.Mutex
+	fields [][]string
+}
+
+type auditKey struct{}
+
+// RedactFields marks payload fields, given as property paths such as ["contact", "email"], for redaction from the
+// audit entry of the request in ctx. Each path is redacted wherever it occurs in the request and response bodies,
+// so envelopes such as {"payload": ...} or {"items": [...]} need no special casing. It does nothing when the
+// request is not audited.
+func RedactFields(ctx context.Context, paths ...[]string) {
+	state, ok := ctx.Value(auditKey{}).(*auditState)
+	if !ok {
+		return
+	}
+	state.mu.Lock()
+	state.fields = append(state.fields, paths...)
+	state.mu.Unlock()
+}
+
+// Auditing reports whether the request in ctx is audited, for callers that must do extra work to name the fields
+// to redact.
+func Auditing(ctx context.Context) bool {
+	_, ok := ctx.Value(auditKey{}).(*auditState)
+	return ok
+}
+
+func (s *auditState) paths() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]string(nil), s.fields...)
+}
+
+// bodyCapture keeps the first limit bytes that pass through it.
+type bodyCapture struct {
+	limit     int
+	buf       bytes.Buffer
+	total     int64
+	truncated bool
+}
+
+func (c *bodyCapture) record(p []byte) {
+	c.total += int64(len(p))
+	if room := c.limit - c.buf.Len(); room > 0 {
+		if len(p) > room {
+			p = p[:room]
+			c.truncated = true
+		}
+		c.buf.Write(p)
+	} else if len(p) > 0 {
+		c.truncated = true
+	}
+}
+
+// Write records p; it lets a bodyCapture tee a response.
+func (c *bodyCapture) Write(p []byte) (int, error) {
+	c.record(p)
+	return len(p), nil
+}
+
+type capturingReader struct {
+	io.ReadCloser
+	capture *bodyCapture
+}
+
+func (r *capturingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.capture.record(p[:n])
+	return n, err
+}
+
+// auditFields renders the audit fields of a finished request. Bodies are only kept when they can be redacted:
+// JSON must be complete to be parsed, NDJSON keeps its complete lines, other text is kept as is and binary content
+// is summarised. The response Content-Encoding is ignored: compression middleware set it on the shared header map
+// but encodes outside RequestLogger, which must therefore run inside it to see plain bodies.
+func auditFields(r *http.Request, responseHeader http.Header, request, response *bodyCapture, redact [][]string) []zap.Field {
+	return []zap.Field{
+		zap.Any("request_headers", redactHeaders(r.Header)),
+		zap.Any("request_body", renderBody(r.Header.Get("Content-Type"), r.Header.Get("Content-Encoding"), request, redact)),
+		zap.Int64("request_body_bytes", request.total),
+		zap.Any("response_body", renderBody(responseHeader.Get("Content-Type"), "", response, redact)),
+		zap.Int64("response_body_bytes", response.total),
+	}
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		if _, ok := redactedHeaders[http.CanonicalHeaderKey(name)]; ok {
+			out[name] = Redacted
+			continue
+		}
+		out[name] = strings.Join(values, ", ")
+	}
+	return out
+}
+
+func renderBody(contentType, encoding string, capture *bodyCapture, redact [][]string) interface{} {
+	if capture.total == 0 {
+		return nil
+	}
+	if encoding != "" && encoding != "identity" {
+		return fmt.Sprintf("[%s-encoded body not captured]", encoding)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		if capture.truncated {
+			return fmt.Sprintf("[JSON body over %d bytes not captured]", capture.limit)
+		}
+		var doc interface{}
+		if err := json.Unmarshal(capture.buf.Bytes(), &doc); err != nil {
+			return "[invalid JSON body not captured]"
+		}
+		return redactValue(doc, redact)
+	case mediaType == "application/x-ndjson":
+		data := capture.buf.Bytes()
+		if capture.truncated {
+			// The last line may be cut short; only complete lines can be parsed and redacted.
+			if idx := bytes.LastIndexByte(data, '\n'); idx >= 0 {
+				data = data[:idx]
+			} else {
+				data = nil
+			}
+		}
+		lines := make([]interface{}, 0)
+		for _, line := range bytes.Split(data, []byte("\n")) {
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			var doc interface{}
+			if err := json.Unmarshal(line, &doc); err != nil {
+				lines = append(lines, "[invalid JSON line not captured]")
+				continue
+			}
+			lines = append(lines, redactValue(doc, redact))
+		}
+		return lines
+	case strings.HasPrefix(mediaType, "text/"):
+		return capture.buf.String()
+	default:
+		if mediaType == "" {
+			mediaType = "unknown"
+		}
+		return fmt.Sprintf("[%s body not captured]", mediaType)
+	}
+}
+
+// redactValue replaces every occurrence of the paths in value, searching objects at any depth.
+func redactValue(value interface{}, paths [][]string) interface{} {
+	if len(paths) == 0 {
+		return value
+	}
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for _, path := range paths {
+			redactPath(typed, path)
+		}
+		for key, child := range typed {
+			typed[key] = redactValue(child, paths)
+		}
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = redactValue(child, paths)
+		}
+	}
+	return value
+}
+
+func redactPath(object map[string]interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	for _, segment := range path[:len(path)-1] {
+		next, ok := object[segment].(map[string]interface{})
+		if !ok {
+			return
+		}
+		object = next
+	}
+	if _, ok := object[path[len(path)-1]]; ok {
+		object[path[len(path)-1]] = Redacted
+	}
+}
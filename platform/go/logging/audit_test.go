@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRequestLoggerAudit(t *testing.T) {
+	t.Parallel()
+
+	core, entries := observer.New(zapcore.InfoLevel)
+	routes, err := ParseAuditRoutes([]string{"POST /api/v1/entities/**"})
+	require.NoError(t, err)
+
+	handler := RequestLogger(zap.NewNop(), AuditConfig{Sink: zap.New(core), Routes: routes})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.ReadAll(r.Body)
+			RedactFields(r.Context(), []string{"contact", "email"})
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"payload":{"name":"Ada","contact":{"email":"ada@example.com"}}}`))
+		}),
+	)
+
+	serve := func(method, path, body string) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("Content-Type", "application/json")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	serve(http.MethodGet, "/api/v1/entities/cards/documents", "")
+	require.Zero(t, entries.Len())
+
+	serve(http.MethodPost, "/api/v1/entities/cards/documents", `{"payload":{"contact":{"email":"ada@example.com"}}}`)
+	require.Equal(t, 1, entries.Len())
+	fields := entries.All()[0].ContextMap()
+
+	require.Equal(t, Redacted, fields["request_headers"].(map[string]string)["Authorization"])
+	require.Equal(t, map[string]interface{}{
+		"payload": map[string]interface{}{"contact": map[string]interface{}{"email": Redacted}},
+	}, fields["request_body"])
+	require.Equal(t, map[string]interface{}{
+		"payload": map[string]interface{}{"name": "Ada", "contact": map[string]interface{}{"email": Redacted}},
+	}, fields["response_body"])
+}
+
+func TestRequestLoggerAuditTruncatesBodies(t *testing.T) {
+	t.Parallel()
+
+	core, entries := observer.New(zapcore.InfoLevel)
+	handler := RequestLogger(zap.NewNop(), AuditConfig{
+		Sink:         zap.New(core),
+		Routes:       []AuditRoute{{Method: "*", Pattern: "/upload"}},
+		MaxBodyBytes: 4,
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("accepted"))
+	}))
+
+	req := httptest.NewRequest(http.MethodPut, "/upload", strings.NewReader(`{"a":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, 1, entries.Len())
+	fields := entries.All()[0].ContextMap()
+	require.Equal(t, "[JSON body over 4 bytes not captured]", fields["request_body"])
+	require.Equal(t, int64(7), fields["request_body_bytes"])
+	require.Equal(t, "acce", fields["response_body"])
+	require.Equal(t, int64(8), fields["response_body_bytes"])
+}
+
+func TestParseAuditRoutes(t *testing.T) {
+	t.Parallel()
+
+	routes, err := ParseAuditRoutes([]string{"post /api/v1/entities/*/documents", " "})
+	require.NoError(t, err)
+	require.Equal(t, []AuditRoute{{Method: http.MethodPost, Pattern: "/api/v1/entities/*/documents"}}, routes)
+	require.True(t, routes[0].matches(http.MethodPost, "/api/v1/entities/cards/documents"))
+	require.False(t, routes[0].matches(http.MethodPost, "/api/v1/entities/cards/documents/1"))
+
+	_, err = ParseAuditRoutes([]string{"/api/v1/entities"})
+	require.Error(t, err)
+}
@@ -22,6 +22,19 @@ func FromContext(ctx context.Context) (*zap.Logger, bool) {
 	return logger, ok
 }
 
+// FromContextOr returns the request logger from ctx, or fallback when there is none. The request logger takes the name
+// of fallback, so the component levels set for fallback (see Levels) still apply to it.
+func FromContextOr(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	logger, ok := FromContext(ctx)
+	if !ok {
+		return fallback
+	}
+	if fallback != nil && fallback.Name() != "" && logger.Name() == "" {
+		logger = logger.Named(fallback.Name())
+	}
+	return logger
+}
+
 // FromRequest pulls the request-scoped logger from the HTTP request when available, falling back to the provided default.
 func FromRequest(r *http.Request, fallback *zap.Logger) *zap.Logger {
 	if logger, ok := FromContext(r.Context()); ok {
@@ -31,8 +44,12 @@ func FromRequest(r *http.Request, fallback *zap.Logger) *zap.Logger {
 }
 
 // RequestLogger returns an HTTP middleware that enriches the base logger with request scoped fields,
-// stores it on the context, and emits a completion log once the handler finishes.
-func RequestLogger(base *zap.Logger) func(http.Handler) http.Handler {
+// stores it on the context, and emits a completion log once the handler finishes. Requests on the routes audit opts
+// in are also written to the audit sink, with their headers and size-capped bodies redacted (see AuditConfig).
+func RequestLogger(base *zap.Logger, audit AuditConfig) func(http.Handler) http.Handler {
+	if audit.MaxBodyBytes <= 0 {
+		audit.MaxBodyBytes = 4 << 10
+	}
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
@@ -52,13 +69,44 @@ func RequestLogger(base *zap.Logger) func(http.Handler) http.Handler {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			ctx := WithLogger(r.Context(), logger)
 
+			if !audit.audits(r) {
+				next.ServeHTTP(ww, r.WithContext(ctx))
+				logger.Info("request completed",
+					zap.Int("status", ww.Status()),
+					zap.Int("bytes", ww.BytesWritten()),
+					zap.Duration("duration", time.Since(start)),
+				)
+				return
+			}
+
+			state := &auditState{}
+			ctx = context.WithValue(ctx, auditKey{}, state)
+			requestBody := &bodyCapture{limit: audit.MaxBodyBytes}
+			responseBody := &bodyCapture{limit: audit.MaxBodyBytes}
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = &capturingReader{ReadCloser: r.Body, capture: requestBody}
+			}
+			ww.Tee(responseBody)
+
 			next.ServeHTTP(ww, r.WithContext(ctx))
 
+			duration := time.Since(start)
 			logger.Info("request completed",
 				zap.Int("status", ww.Status()),
 				zap.Int("bytes", ww.BytesWritten()),
-				zap.Duration("duration", time.Since(start)),
+				zap.Duration("duration", duration),
 			)
+			fields := []zap.Field{
+				zap.String("request_id", requestID),
+				zap.String("http_method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.String("query", r.URL.RawQuery),
+				zap.String("remote_addr", r.RemoteAddr),
+				zap.Int("status", ww.Status()),
+				zap.Duration("duration", duration),
+			}
+			fields = append(fields, auditFields(r, ww.Header(), requestBody, responseBody, state.paths())...)
+			audit.Sink.Info("request audited", fields...)
 		})
 	}
 }
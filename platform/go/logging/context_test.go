@@ -0,0 +1,24 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFromContextOr(t *testing.T) {
+	t.Parallel()
+
+	fallback := zap.NewNop().Named("tenants")
+	require.Same(t, fallback, FromContextOr(context.Background(), fallback))
+
+	request := zap.NewNop().With(zap.String("request_id", "req-1"))
+	logger := FromContextOr(WithLogger(context.Background(), request), fallback)
+	require.Equal(t, "tenants", logger.Name())
+
+	named := request.Named("entities")
+	require.Same(t, named, FromContextOr(WithLogger(context.Background(), named), fallback))
+	require.Same(t, request, FromContextOr(WithLogger(context.Background(), request), nil))
+}
@@ -0,0 +1,138 @@
+package logging
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Levels holds the minimum severity of the loggers built with it: a default and per-component overrides, both
+// adjustable at runtime. Components are zap logger names (see zap.Logger.Named); an override on "scheduler" also
+// covers "scheduler.tasks" unless that component has its own.
+type Levels struct {
+	mu        sync.RWMutex
+	level     zapcore.Level
+	overrides map[string]zapcore.Level
+	min       zapcore.Level
+}
+
+// NewLevels returns Levels defaulting to level ("debug", "info", "warn", "error"); empty means info.
+func NewLevels(level string) (*Levels, error) {
+	parsed := zapcore.InfoLevel
+	if level != "" {
+		var err error
+		if parsed, err = ParseLevel(level); err != nil {
+			return nil, err
+		}
+	}
+	return &Levels{level: parsed, overrides: map[string]zapcore.Level{}, min: parsed}, nil
+}
+
+// ParseLevel parses a severity name, ignoring case.
+func ParseLevel(level string) (zapcore.Level, error) {
+	return zapcore.ParseLevel(strings.ToLower(level))
+}
+
+// Default returns the level of components without an override.
+func (l *Levels) Default() zapcore.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.level
+}
+
+// SetDefault changes the level of components without an override.
+func (l *Levels) SetDefault(level zapcore.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.level = level
+	l.refreshMin()
+}
+
+// Overrides returns a copy of the per-component levels.
+func (l *Levels) Overrides() map[string]zapcore.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	out := make(map[string]zapcore.Level, len(l.overrides))
+	for component, level := range l.overrides {
+		out[component] = level
+	}
+	return out
+}
+
+// SetOverride sets the level of component and the components below it.
+func (l *Levels) SetOverride(component string, level zapcore.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.overrides[component] = level
+	l.refreshMin()
+}
+
+// ClearOverride returns component to the default level and reports whether it had an override.
+func (l *Levels) ClearOverride(component string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.overrides[component]; !ok {
+		return false
+	}
+	delete(l.overrides, component)
+	l.refreshMin()
+	return true
+}
+
+// Enabled reports whether any component logs at level; it lets Levels serve as a zapcore.LevelEnabler.
+func (l *Levels) Enabled(level zapcore.Level) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return level >= l.min
+}
+
+// enabledFor reports whether the logger named component logs at level.
+func (l *Levels) enabledFor(component string, level zapcore.Level) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if level < l.min {
+		return false
+	}
+	for name := component; name != ""; {
+		if override, ok := l.overrides[name]; ok {
+			return level >= override
+		}
+		idx := strings.LastIndexByte(name, '.')
+		if idx < 0 {
+			break
+		}
+		name = name[:idx]
+	}
+	return level >= l.level
+}
+
+func (l *Levels) refreshMin() {
+	l.min = l.level
+	for _, level := range l.overrides {
+		if level < l.min {
+			l.min = level
+		}
+	}
+}
+
+// leveledCore filters entries by the level of the logger that wrote them.
+type leveledCore struct {
+	zapcore.Core
+	levels *Levels
+}
+
+func (c *leveledCore) Enabled(level zapcore.Level) bool {
+	return c.levels.Enabled(level)
+}
+
+func (c *leveledCore) With(fields []zapcore.Field) zapcore.Core {
+	return &leveledCore{Core: c.Core.With(fields), levels: c.levels}
+}
+
+func (c *leveledCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.levels.enabledFor(entry.LoggerName, entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
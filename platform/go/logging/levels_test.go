@@ -0,0 +1,66 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLevelsComponentOverrides(t *testing.T) {
+	t.Parallel()
+
+	levels, err := NewLevels("INFO")
+	require.NoError(t, err)
+
+	observed, entries := observer.New(zapcore.DebugLevel)
+	logger := zap.New(&leveledCore{Core: observed, levels: levels})
+	scheduler := logger.Named("scheduler")
+	tasks := scheduler.Named("tasks")
+
+	logger.Debug("root")
+	tasks.Debug("tasks")
+	require.Zero(t, entries.Len())
+
+	levels.SetOverride("scheduler", zapcore.DebugLevel)
+	logger.Debug("root")
+	tasks.Debug("tasks")
+	require.Equal(t, []string{"tasks"}, messages(entries))
+
+	levels.SetOverride("scheduler.tasks", zapcore.ErrorLevel)
+	scheduler.Debug("scheduler")
+	tasks.Warn("tasks warn")
+	require.Equal(t, []string{"tasks", "scheduler"}, messages(entries))
+
+	require.True(t, levels.ClearOverride("scheduler"))
+	require.False(t, levels.ClearOverride("scheduler"))
+	require.Equal(t, map[string]zapcore.Level{"scheduler.tasks": zapcore.ErrorLevel}, levels.Overrides())
+	scheduler.Debug("scheduler")
+	require.Len(t, entries.All(), 2)
+
+	levels.SetDefault(zapcore.DebugLevel)
+	logger.Debug("root")
+	require.Equal(t, []string{"tasks", "scheduler", "root"}, messages(entries))
+	require.Equal(t, zapcore.DebugLevel, levels.Default())
+}
+
+func TestNewLevelsRejectsUnknownLevel(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewLevels("verbose")
+	require.Error(t, err)
+
+	levels, err := NewLevels("")
+	require.NoError(t, err)
+	require.Equal(t, zapcore.InfoLevel, levels.Default())
+}
+
+func messages(entries *observer.ObservedLogs) []string {
+	out := make([]string, 0, entries.Len())
+	for _, entry := range entries.All() {
+		out = append(out, entry.Message)
+	}
+	return out
+}
@@ -14,15 +14,21 @@ type Config struct {
 	Component string
 	// Level controls the minimum severity ("debug", "info", "warn", "error").
 	Level string
+	// Output is "stdout" (the default), "stderr" or a file path the logger appends to.
+	Output string
+	// Levels, when set, replaces Level so the severity of the logger and of each named component can change at
+	// runtime.
+	Levels *Levels
 }
 
 // NewLogger builds a structured zap logger that emits Google Cloud Logging compatible fields.
 func NewLogger(cfg Config) (*zap.Logger, error) {
-	level := zap.NewAtomicLevel()
-	if cfg.Level == "" {
-		level.SetLevel(zapcore.InfoLevel)
-	} else if err := level.UnmarshalText([]byte(strings.ToLower(cfg.Level))); err != nil {
-		return nil, err
+	levels := cfg.Levels
+	if levels == nil {
+		var err error
+		if levels, err = NewLevels(cfg.Level); err != nil {
+			return nil, err
+		}
 	}
 
 	encoderConfig := zapcore.EncoderConfig{
@@ -38,11 +44,18 @@ func NewLogger(cfg Config) (*zap.Logger, error) {
 		EncodeLevel:    gcpLevelEncoder,
 	}
 
-	core := zapcore.NewCore(
-		zapcore.NewJSONEncoder(encoderConfig),
-		zapcore.AddSync(os.Stdout),
-		level,
-	)
+	var sink zapcore.WriteSyncer = zapcore.AddSync(os.Stdout)
+	if cfg.Output != "" && cfg.Output != "stdout" {
+		var err error
+		if sink, _, err = zap.Open(cfg.Output); err != nil {
+			return nil, err
+		}
+	}
+
+	core := &leveledCore{
+		Core:   zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), sink, levels),
+		levels: levels,
+	}
 
 	logger := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1))
 	if cfg.Component != "" {
@@ -0,0 +1,32 @@
+package mailer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// LogMailer writes messages to the logger instead of sending them. Useful for local development.
+type LogMailer struct {
+	logger *zap.Logger
+}
+
+// NewLogMailer constructs a LogMailer.
+func NewLogMailer(logger *zap.Logger) *LogMailer {
+	if logger == nil {
+		panic("logger is required")
+	}
+	return &LogMailer{logger: logger}
+}
+
+// Send logs the message and always succeeds.
+func (m *LogMailer) Send(_ context.Context, msg Message) error {
+	m.logger.Info("email sent",
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("text", msg.Text),
+	)
+	return nil
+}
+
+var _ Mailer = (*LogMailer)(nil)
@@ -0,0 +1,26 @@
+package mailer
+
+import "context"
+
+// Message is an email with a plain-text body and an optional HTML alternative.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	// HTML, when set, is sent next to Text as a multipart/alternative body.
+	HTML string
+	// Sender overrides the transport's configured sender; empty fields keep it.
+	Sender Sender
+}
+
+// Sender names who a message comes from and where replies go.
+type Sender struct {
+	Name    string
+	Address string
+	ReplyTo string
+}
+
+// Mailer delivers email. Send must only return nil once the message has been handed to the transport.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
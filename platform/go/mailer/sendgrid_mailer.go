@@ -0,0 +1,121 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultSendGridURL is the SendGrid v3 API base URL.
+const DefaultSendGridURL = "https://api.sendgrid.com"
+
+// SendGridConfig configures SendGridMailer. From must be a sender verified in the SendGrid account; so must any
+// tenant sender address that overrides it.
+type SendGridConfig struct {
+	APIKey   string
+	From     string
+	FromName string
+	// BaseURL defaults to DefaultSendGridURL.
+	BaseURL string
+	Client  *http.Client
+}
+
+// SendGridMailer sends messages through the SendGrid v3 mail send API.
+type SendGridMailer struct {
+	cfg SendGridConfig
+}
+
+// NewSendGridMailer constructs a SendGridMailer.
+func NewSendGridMailer(cfg SendGridConfig) (*SendGridMailer, error) {
+	if strings.TrimSpace(cfg.APIKey) == "" {
+		return nil, fmt.Errorf("sendgrid api key is required")
+	}
+	if strings.TrimSpace(cfg.From) == "" {
+		return nil, fmt.Errorf("sendgrid from address is required")
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultSendGridURL
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &SendGridMailer{cfg: cfg}, nil
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	ReplyTo          *sendGridAddress          `json:"reply_to,omitempty"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+}
+
+// Send posts the message to /v3/mail/send. SendGrid answers 202 once it has accepted the message.
+func (m *SendGridMailer) Send(ctx context.Context, msg Message) error {
+	if err := validateMessage(msg); err != nil {
+		return err
+	}
+
+	payload := sendGridRequest{
+		Personalizations: []sendGridPersonalization{{To: []sendGridAddress{{Email: msg.To}}}},
+		From:             sendGridAddress{Email: m.cfg.From, Name: m.cfg.FromName},
+		Subject:          msg.Subject,
+		Content:          []sendGridContent{{Type: "text/plain", Value: msg.Text}},
+	}
+	if msg.Sender.Address != "" {
+		payload.From.Email = msg.Sender.Address
+	}
+	if msg.Sender.Name != "" {
+		payload.From.Name = msg.Sender.Name
+	}
+	if msg.Sender.ReplyTo != "" {
+		payload.ReplyTo = &sendGridAddress{Email: msg.Sender.ReplyTo}
+	}
+	if msg.HTML != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/html", Value: msg.HTML})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode sendgrid request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.cfg.BaseURL+"/v3/mail/send", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build sendgrid request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+m.cfg.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.cfg.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send mail to %s: %w", msg.To, err)
+	}
+	defer resp.Body.Close()
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("send mail to %s: sendgrid responded with status %d: %s", msg.To, resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+var _ Mailer = (*SendGridMailer)(nil)
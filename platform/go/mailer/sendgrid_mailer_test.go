@@ -0,0 +1,62 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendGridMailerSend(t *testing.T) {
+	t.Parallel()
+
+	var got sendGridRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/v3/mail/send", r.URL.Path)
+		require.Equal(t, "Bearer sg-key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	m, err := NewSendGridMailer(SendGridConfig{APIKey: "sg-key", From: "noreply@example.com", FromName: "Palmyra", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	require.NoError(t, m.Send(context.Background(), Message{
+		To:      "a@example.com",
+		Subject: "Hi",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+		Sender:  Sender{Address: "support@acme.example.com", ReplyTo: "help@acme.example.com"},
+	}))
+	require.Equal(t, "a@example.com", got.Personalizations[0].To[0].Email)
+	require.Equal(t, sendGridAddress{Email: "support@acme.example.com", Name: "Palmyra"}, got.From)
+	require.Equal(t, "help@acme.example.com", got.ReplyTo.Email)
+	require.Equal(t, []sendGridContent{{Type: "text/plain", Value: "plain body"}, {Type: "text/html", Value: "<p>html body</p>"}}, got.Content)
+}
+
+func TestSendGridMailerReportsRejections(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"The from address does not match a verified Sender Identity."}]}`))
+	}))
+	defer server.Close()
+
+	m, err := NewSendGridMailer(SendGridConfig{APIKey: "sg-key", From: "noreply@example.com", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), Message{To: "a@example.com", Subject: "Hi", Text: "body"})
+	require.ErrorContains(t, err, "status 403")
+	require.ErrorContains(t, err, "verified Sender Identity")
+
+	_, err = NewSendGridMailer(SendGridConfig{From: "noreply@example.com"})
+	require.Error(t, err)
+	_, err = NewSendGridMailer(SendGridConfig{APIKey: "sg-key"})
+	require.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// SMTPConfig configures SMTPMailer. Username and Password are optional; when set, PLAIN auth is used, which
+// net/smtp only allows over TLS or to localhost.
+type SMTPConfig struct {
+	Addr     string // host:port
+	From     string
+	Username string
+	Password string
+}
+
+// SMTPMailer sends messages through an SMTP relay.
+type SMTPMailer struct {
+	cfg  SMTPConfig
+	auth smtp.Auth
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+	now  func() time.Time
+}
+
+// NewSMTPMailer constructs an SMTPMailer.
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	host, _, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp address %q: %w", cfg.Addr, err)
+	}
+	if strings.TrimSpace(cfg.From) == "" {
+		return nil, fmt.Errorf("smtp from address is required")
+	}
+
+	m := &SMTPMailer{cfg: cfg, send: smtp.SendMail, now: time.Now}
+	if cfg.Username != "" {
+		m.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+	return m, nil
+}
+
+// Send delivers the message. net/smtp does not take a context, so ctx is only checked before dialing.
+// The envelope sender is always the configured From, so bounces reach the platform whatever msg.Sender says.
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validateMessage(msg); err != nil {
+		return err
+	}
+
+	body, err := m.render(msg)
+	if err != nil {
+		return err
+	}
+	if err := m.send(m.cfg.Addr, m.auth, m.cfg.From, []string{msg.To}, body); err != nil {
+		return fmt.Errorf("send mail to %s: %w", msg.To, err)
+	}
+	return nil
+}
+
+// render builds the RFC 5322 message with a UTF-8 subject and CRLF line endings. Messages with HTML are sent as
+// multipart/alternative with quoted-printable parts.
+func (m *SMTPMailer) render(msg Message) ([]byte, error) {
+	from := m.cfg.From
+	if msg.Sender.Address != "" {
+		from = msg.Sender.Address
+	}
+	if msg.Sender.Name != "" {
+		from = (&mail.Address{Name: msg.Sender.Name, Address: from}).String()
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	if msg.Sender.ReplyTo != "" {
+		fmt.Fprintf(&buf, "Reply-To: %s\r\n", msg.Sender.ReplyTo)
+	}
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", m.now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if msg.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+		buf.WriteString("\r\n")
+		buf.WriteString(crlf(msg.Text))
+		return buf.Bytes(), nil
+	}
+
+	parts := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n", parts.Boundary())
+	buf.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("render mail part: %w", err)
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(crlf(part.body))); err != nil {
+			return nil, fmt.Errorf("render mail part: %w", err)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, fmt.Errorf("render mail part: %w", err)
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("render mail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// crlf normalises line endings to CRLF.
+func crlf(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.ReplaceAll(text, "\n", "\r\n")
+}
+
+// validateMessage rejects recipients and sender fields that are empty or would inject headers.
+func validateMessage(msg Message) error {
+	if strings.ContainsAny(msg.To, "\r\n") || strings.TrimSpace(msg.To) == "" {
+		return fmt.Errorf("invalid recipient %q", msg.To)
+	}
+	for field, value := range map[string]string{
+		"sender name":    msg.Sender.Name,
+		"sender address": msg.Sender.Address,
+		"reply-to":       msg.Sender.ReplyTo,
+	} {
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid %s %q", field, value)
+		}
+	}
+	return nil
+}
+
+var _ Mailer = (*SMTPMailer)(nil)
@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"context"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPMailerSend(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewSMTPMailer(SMTPConfig{Addr: "smtp.example.com:587", From: "noreply@example.com"})
+	require.NoError(t, err)
+	m.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	var gotTo []string
+	var gotMsg string
+	m.send = func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		require.Equal(t, "smtp.example.com:587", addr)
+		require.Equal(t, "noreply@example.com", from)
+		gotTo = to
+		gotMsg = string(msg)
+		return nil
+	}
+
+	require.NoError(t, m.Send(context.Background(), Message{To: "a@example.com", Subject: "Hi", Text: "line 1\nline 2"}))
+	require.Equal(t, []string{"a@example.com"}, gotTo)
+	require.Contains(t, gotMsg, "To: a@example.com\r\n")
+	require.Contains(t, gotMsg, "Subject: Hi\r\n")
+	require.Contains(t, gotMsg, "Date: Thu, 02 Jan 2025 03:04:05 +0000\r\n")
+	require.Contains(t, gotMsg, "\r\n\r\nline 1\r\nline 2")
+
+	require.Error(t, m.Send(context.Background(), Message{To: "a@example.com\r\nBcc: b@example.com", Subject: "Hi"}))
+}
+
+func TestNewSMTPMailerValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSMTPMailer(SMTPConfig{Addr: "smtp.example.com", From: "noreply@example.com"})
+	require.Error(t, err)
+	_, err = NewSMTPMailer(SMTPConfig{Addr: "smtp.example.com:25"})
+	require.Error(t, err)
+}
+
+func TestSMTPMailerSendsHTMLAlternativeFromTenantSender(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewSMTPMailer(SMTPConfig{Addr: "smtp.example.com:587", From: "noreply@example.com"})
+	require.NoError(t, err)
+
+	var gotFrom, gotMsg string
+	m.send = func(_ string, _ smtp.Auth, from string, _ []string, msg []byte) error {
+		gotFrom, gotMsg = from, string(msg)
+		return nil
+	}
+
+	require.NoError(t, m.Send(context.Background(), Message{
+		To:      "a@example.com",
+		Subject: "Hi",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+		Sender:  Sender{Name: "Acme Support", Address: "support@acme.example.com", ReplyTo: "help@acme.example.com"},
+	}))
+	require.Equal(t, "noreply@example.com", gotFrom, "the envelope sender stays the relay's")
+	require.Contains(t, gotMsg, "From: \"Acme Support\" <support@acme.example.com>\r\n")
+	require.Contains(t, gotMsg, "Reply-To: help@acme.example.com\r\n")
+	require.Contains(t, gotMsg, "Content-Type: multipart/alternative; boundary=")
+	require.Contains(t, gotMsg, "Content-Type: text/plain; charset=utf-8\r\n")
+	require.Contains(t, gotMsg, "plain body")
+	require.Contains(t, gotMsg, "Content-Type: text/html; charset=utf-8\r\n")
+	require.Contains(t, gotMsg, "<p>html body</p>")
+
+	require.Error(t, m.Send(context.Background(), Message{To: "a@example.com", Subject: "Hi", Sender: Sender{ReplyTo: "x@example.com\r\nBcc: b@example.com"}}))
+}
@@ -0,0 +1,172 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+)
+
+// Templates shipped with the package. Each one renders from the data type named next to it.
+const (
+	TemplateInvitation               = "invitation"                 // InvitationData
+	TemplateQuotaAlert               = "quota_alert"                // QuotaAlertData
+	TemplateTenantProvisioned        = "tenant_provisioned"         // TenantData
+	TemplateTenantProvisioningFailed = "tenant_provisioning_failed" // TenantData
+)
+
+// InvitationData fills TemplateInvitation.
+type InvitationData struct {
+	Workspace string
+	FullName  string
+	Link      string
+	ExpiresAt time.Time
+}
+
+// QuotaAlertData fills TemplateQuotaAlert. Used and Limit are bytes.
+type QuotaAlertData struct {
+	Workspace        string
+	Resource         string
+	ThresholdPercent int
+	Used             int64
+	Limit            int64
+}
+
+// TenantData fills the tenant lifecycle templates. Error is only set for failures.
+type TenantData struct {
+	Workspace string
+	Slug      string
+	Error     string
+}
+
+//go:embed templates/*.tmpl
+var embeddedTemplates embed.FS
+
+// layoutFile wraps the HTML body of every template.
+const layoutFile = "layout.tmpl"
+
+// Templates renders messages from template files. A file named <name>.tmpl defines "subject" and "text", and
+// optionally "content", the HTML body, which is rendered inside the "layout" of layout.tmpl.
+type Templates struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+var (
+	defaultTemplates     *Templates
+	defaultTemplatesOnce sync.Once
+)
+
+// DefaultTemplates returns the templates embedded in the package.
+func DefaultTemplates() *Templates {
+	defaultTemplatesOnce.Do(func() {
+		sub, err := fs.Sub(embeddedTemplates, "templates")
+		if err == nil {
+			defaultTemplates, err = ParseTemplates(sub)
+		}
+		if err != nil {
+			panic(fmt.Sprintf("parse embedded mail templates: %v", err))
+		}
+	})
+	return defaultTemplates
+}
+
+// ParseTemplates parses every *.tmpl file at the root of fsys.
+func ParseTemplates(fsys fs.FS) (*Templates, error) {
+	layout, err := fs.ReadFile(fsys, layoutFile)
+	if err != nil {
+		return nil, fmt.Errorf("read mail layout: %w", err)
+	}
+	files, err := fs.Glob(fsys, "*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Templates{text: map[string]*texttemplate.Template{}, html: map[string]*htmltemplate.Template{}}
+	for _, file := range files {
+		if file == layoutFile {
+			continue
+		}
+		name := strings.TrimSuffix(path.Base(file), ".tmpl")
+		src, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("read mail template %s: %w", name, err)
+		}
+
+		text, err := texttemplate.New(name).Funcs(templateFuncs).Parse(string(src))
+		if err != nil {
+			return nil, fmt.Errorf("parse mail template %s: %w", name, err)
+		}
+		if text.Lookup("subject") == nil || text.Lookup("text") == nil {
+			return nil, fmt.Errorf("mail template %s must define subject and text", name)
+		}
+		t.text[name] = text
+
+		if text.Lookup("content") == nil {
+			continue
+		}
+		html, err := htmltemplate.New(name).Funcs(templateFuncs).Parse(string(layout))
+		if err == nil {
+			html, err = html.Parse(string(src))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse mail template %s: %w", name, err)
+		}
+		t.html[name] = html
+	}
+	return t, nil
+}
+
+// Render executes the named template with data. The returned message has no recipient or sender.
+func (t *Templates) Render(name string, data any) (Message, error) {
+	text, ok := t.text[name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown mail template %q", name)
+	}
+
+	var subject, body bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("render %s subject: %w", name, err)
+	}
+	if err := text.ExecuteTemplate(&body, "text", data); err != nil {
+		return Message{}, fmt.Errorf("render %s text: %w", name, err)
+	}
+	msg := Message{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    strings.TrimLeft(body.String(), "\n"),
+	}
+
+	if html, ok := t.html[name]; ok {
+		var out bytes.Buffer
+		if err := html.ExecuteTemplate(&out, "layout", data); err != nil {
+			return Message{}, fmt.Errorf("render %s html: %w", name, err)
+		}
+		msg.HTML = out.String()
+	}
+	return msg, nil
+}
+
+var templateFuncs = map[string]any{
+	"date":  func(t time.Time) string { return t.UTC().Format(time.RFC1123) },
+	"bytes": formatBytes,
+}
+
+// formatBytes renders n with a binary unit, e.g. 1.5 GiB.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,73 @@
+package mailer
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTemplatesRender(t *testing.T) {
+	t.Parallel()
+
+	templates := DefaultTemplates()
+
+	msg, err := templates.Render(TemplateInvitation, InvitationData{
+		Workspace: "acme",
+		FullName:  "Ada <Admin>",
+		Link:      "https://app.example.com/accept?token=abc&x=1",
+		ExpiresAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "You have been invited to acme", msg.Subject)
+	require.Contains(t, msg.Text, "Hi Ada <Admin>,")
+	require.Contains(t, msg.Text, "https://app.example.com/accept?token=abc&x=1")
+	require.Contains(t, msg.Text, "Fri, 02 Jan 2026 03:04:05 UTC")
+	require.Contains(t, msg.HTML, "Hi Ada &lt;Admin&gt;,", "the HTML body is escaped")
+	require.Contains(t, msg.HTML, `href="https://app.example.com/accept?token=abc&amp;x=1"`)
+	require.Contains(t, msg.HTML, "on behalf of acme")
+
+	msg, err = templates.Render(TemplateQuotaAlert, QuotaAlertData{Workspace: "acme", Resource: "storage", ThresholdPercent: 90, Used: 9 << 30, Limit: 10 << 30})
+	require.NoError(t, err)
+	require.Equal(t, "acme has used 90% of its storage quota", msg.Subject)
+	require.Contains(t, msg.Text, "9.0 GiB of 10.0 GiB")
+
+	for _, name := range []string{TemplateTenantProvisioned, TemplateTenantProvisioningFailed} {
+		msg, err = templates.Render(name, TenantData{Workspace: "Acme Inc", Slug: "acme", Error: "role exists"})
+		require.NoError(t, err, name)
+		require.Contains(t, msg.Subject, "Acme Inc", name)
+		require.NotEmpty(t, msg.HTML, name)
+	}
+	require.Contains(t, msg.Text, "Error: role exists")
+
+	_, err = templates.Render("missing", nil)
+	require.Error(t, err)
+}
+
+func TestParseTemplatesRequiresSubjectAndText(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"layout.tmpl": {Data: []byte(`{{define "layout"}}{{template "content" .}}{{end}}`)},
+		"plain.tmpl":  {Data: []byte(`{{define "subject"}}Hi{{end}}{{define "text"}}Body{{end}}`)},
+	}
+	templates, err := ParseTemplates(fsys)
+	require.NoError(t, err)
+	msg, err := templates.Render("plain", nil)
+	require.NoError(t, err)
+	require.Equal(t, Message{Subject: "Hi", Text: "Body"}, msg)
+
+	fsys["broken.tmpl"] = &fstest.MapFile{Data: []byte(`{{define "subject"}}Hi{{end}}`)}
+	_, err = ParseTemplates(fsys)
+	require.Error(t, err)
+}
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "512 B", formatBytes(512))
+	require.Equal(t, "1.0 KiB", formatBytes(1024))
+	require.Equal(t, "1.5 MiB", formatBytes(3<<19))
+	require.Equal(t, "2.0 TiB", formatBytes(2<<40))
+}
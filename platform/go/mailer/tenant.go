@@ -0,0 +1,26 @@
+package mailer
+
+import "github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+
+// TenantSender returns the sender configured for a tenant; unset fields keep the transport's sender.
+func TenantSender(settings tenant.EmailSettings) Sender {
+	var sender Sender
+	if settings.FromName != nil {
+		sender.Name = *settings.FromName
+	}
+	if settings.FromAddress != nil {
+		sender.Address = *settings.FromAddress
+	}
+	if settings.ReplyTo != nil {
+		sender.ReplyTo = *settings.ReplyTo
+	}
+	return sender
+}
+
+// Workspace is the name messages use for a tenant: its display name when it has one, else its slug.
+func Workspace(slug string, displayName *string) string {
+	if displayName != nil && *displayName != "" {
+		return *displayName
+	}
+	return slug
+}
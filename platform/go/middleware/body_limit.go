@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+// ErrBodyTooLarge is returned by request bodies read past the limit set by LimitRequestBody.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// LimitRequestBody rejects request bodies larger than maxBytes with 413 problem+json. A declared Content-Length
+// over the limit is rejected before the handler runs. Otherwise reads past the limit fail with ErrBodyTooLarge
+// and whatever the handler answers to that error is replaced with the 413, so request validators and decoders
+// need no knowledge of the limit. The limit counts decoded bytes when DecompressRequest runs first. maxBytes <= 0
+// disables the limit.
+func LimitRequestBody(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if maxBytes <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				writePayloadTooLarge(w, maxBytes)
+				return
+			}
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body := &limitedBody{ReadCloser: r.Body, remaining: maxBytes}
+			r.Body = body
+			next.ServeHTTP(&limitedResponseWriter{ResponseWriter: w, body: body, maxBytes: maxBytes}, r)
+		})
+	}
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	exceeded  atomic.Bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.exceeded.Load() {
+		return 0, ErrBodyTooLarge
+	}
+	// Read one byte past the limit to tell a body of exactly maxBytes from a larger one.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.remaining {
+		b.exceeded.Store(true)
+		b.remaining = 0
+		return 0, ErrBodyTooLarge
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+// limitedResponseWriter swaps the handler's response for a 413 once the body went over the limit. Responses
+// started before the overflow are left alone.
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	body     *limitedBody
+	maxBytes int64
+	started  bool
+	rejected bool
+}
+
+func (w *limitedResponseWriter) WriteHeader(status int) {
+	if w.started {
+		return
+	}
+	w.started = true
+	if w.body.exceeded.Load() {
+		w.rejected = true
+		writePayloadTooLarge(w.ResponseWriter, w.maxBytes)
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *limitedResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.rejected {
+		return len(p), nil
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *limitedResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func writePayloadTooLarge(w http.ResponseWriter, maxBytes int64) {
+	problems.Write(w, problems.New(http.StatusRequestEntityTooLarge, "Payload Too Large", problems.TypePayloadTooLarge,
+		fmt.Sprintf("request body exceeds the limit of %d bytes", maxBytes)))
+}
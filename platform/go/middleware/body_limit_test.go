@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+func TestLimitRequestBody(t *testing.T) {
+	t.Parallel()
+
+	var got []byte
+	handler := LimitRequestBody(8)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		got, err = io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "invalid body", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serve := func(body io.Reader, contentLength int64) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.ContentLength = contentLength
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(strings.NewReader("12345678"), 8)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "12345678", string(got))
+
+	rec = serve(strings.NewReader("123456789"), 9)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+
+	// Without a Content-Length the handler's answer to the read error is replaced.
+	rec = serve(io.MultiReader(strings.NewReader("12345"), strings.NewReader("6789")), -1)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	var problem struct {
+		Status int    `json:"status"`
+		Type   string `json:"type"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Equal(t, http.StatusRequestEntityTooLarge, problem.Status)
+	require.Equal(t, problems.TypePayloadTooLarge, problem.Type)
+
+	rec = serve(bytes.NewReader(nil), 0)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
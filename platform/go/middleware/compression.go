@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+// CompressibleContentTypes are the response types Compress encodes. Event streams are left out so Server-Sent
+// Events reach the client as they are written.
+var CompressibleContentTypes = []string{
+	"application/json",
+	"application/problem+json",
+	"application/x-ndjson",
+	"text/csv",
+	"text/plain",
+}
+
+// Compress gzip- or deflate-encodes responses of CompressibleContentTypes for clients that accept it.
+func Compress(level int) func(http.Handler) http.Handler {
+	return chimw.Compress(level, CompressibleContentTypes...)
+}
+
+// DecompressRequest transparently decodes request bodies sent with Content-Encoding: gzip, so handlers and the
+// request validator see plain payloads. Other encodings answer 415; a body that is not valid gzip answers 400.
+func DecompressRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+		switch encoding {
+		case "", "identity":
+			next.ServeHTTP(w, r)
+			return
+		case "gzip", "x-gzip":
+		default:
+			problems.Write(w, problems.New(http.StatusUnsupportedMediaType, "Unsupported Media Type", problems.TypeInvalidEncoding,
+				"unsupported Content-Encoding "+encoding+"; use gzip"))
+			return
+		}
+
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			problems.Write(w, problems.New(http.StatusBadRequest, "Bad Request", problems.TypeInvalidEncoding, "request body is not valid gzip"))
+			return
+		}
+		defer reader.Close()
+
+		r.Body = gzipBody{Reader: reader, body: r.Body}
+		r.Header.Del("Content-Encoding")
+		r.Header.Del("Content-Length")
+		r.ContentLength = -1
+		next.ServeHTTP(w, r)
+	})
+}
+
+type gzipBody struct {
+	*gzip.Reader
+	body interface{ Close() error }
+}
+
+func (b gzipBody) Close() error {
+	_ = b.Reader.Close()
+	return b.body.Close()
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecompressRequest(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	handler := DecompressRequest(LimitRequestBody(16)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "invalid body", http.StatusBadRequest)
+			return
+		}
+		got = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	})))
+
+	gzipped := func(s string) []byte {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, _ = zw.Write([]byte(s))
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+	serve := func(encoding string, body []byte) int {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.Header.Set("Content-Encoding", encoding)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusNoContent, serve("gzip", gzipped(`{"name":"acme"}`)))
+	require.Equal(t, `{"name":"acme"}`, got)
+
+	// The limit applies to the decoded body.
+	require.Equal(t, http.StatusRequestEntityTooLarge, serve("gzip", gzipped(strings.Repeat("a", 1024))))
+
+	require.Equal(t, http.StatusBadRequest, serve("gzip", []byte("plain")))
+	require.Equal(t, http.StatusUnsupportedMediaType, serve("br", []byte("plain")))
+}
+
+func TestCompressSkipsEventStreams(t *testing.T) {
+	t.Parallel()
+
+	serve := func(contentType string) string {
+		handler := Compress(5)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write([]byte(strings.Repeat("data", 100)))
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Header().Get("Content-Encoding")
+	}
+
+	require.Equal(t, "gzip", serve("application/json"))
+	require.Empty(t, serve("text/event-stream"))
+}
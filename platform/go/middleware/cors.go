@@ -1,14 +1,155 @@
 package middleware
 
-import "net/http"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// TenantOriginLister returns the extra origins tenants configured for their own front-ends, by tenant id.
+type TenantOriginLister interface {
+	ListCORSOrigins(ctx context.Context) (map[uuid.UUID][]string, error)
+}
+
+// ErrWildcardCredentials rejects "*" among the allowed origins together with AllowCredentials, which would let any
+// site make credentialed calls.
+var ErrWildcardCredentials = errors.New(`origin "*" cannot be combined with credentials; list the allowed origins`)
+
+const (
+	// tenantOriginsRetry is how long a failed lookup of the tenant origins is not retried; the origins of the last
+	// lookup keep applying meanwhile.
+	tenantOriginsRetry = 5 * time.Second
+	// tenantOriginsTimeout bounds a lookup of the tenant origins, which every request waiting for it shares.
+	tenantOriginsTimeout = 10 * time.Second
+)
+
+// CORSConfig controls which browser origins may call the API and what they may send.
+type CORSConfig struct {
+	// AllowedOrigins lists exact origins such as https://app.example.com; "*" allows any origin.
+	AllowedOrigins []string
+	// Origins, when set, replaces AllowedOrigins with a list that may be changed while serving.
+	Origins        *CORSOrigins
+	AllowedMethods []string
+	AllowedHeaders []string
+	// AllowCredentials lets browsers send cookies and Authorization headers. It cannot be combined with "*"; see
+	// CheckCORSOrigins.
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache a preflight answer; zero leaves it to the browser.
+	MaxAge time.Duration
+	// TenantOrigins, when set, allows the origins configured on an active tenant for the requests of that tenant;
+	// see TenantCORS, which every route group must mount. Preflights carry no credentials, so they are answered for
+	// an origin of any tenant and the actual request is checked against its own tenant.
+	TenantOrigins TenantOriginLister
+	// TenantOriginsTTL caches the tenant origins between lookups; zero queries on every unknown origin.
+	TenantOriginsTTL time.Duration
+}
+
+// DefaultCORSConfig allows any origin without credentials, along with the methods and headers the API uses.
+func DefaultCORSConfig() CORSConfig {
+	return CORSConfig{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{
+			"Authorization", "Content-Type", "Content-Encoding", "Idempotency-Key", "If-Match",
+			"X-API-Key", "X-Palmyra-Tenant", "X-Palmyra-Impersonate-Tenant",
+		},
+		MaxAge: 10 * time.Minute,
+	}
+}
+
+// DefaultCORS applies DefaultCORSConfig.
 func DefaultCORS() func(http.Handler) http.Handler {
-	// Keep it simple; tighten for prod
+	return CORS(DefaultCORSConfig())
+}
+
+// CheckCORSOrigins returns ErrWildcardCredentials when origins, in the syntax of CORSConfig.AllowedOrigins, hold
+// "*" and allowCredentials is set.
+func CheckCORSOrigins(origins []string, allowCredentials bool) error {
+	if allowCredentials && slices.ContainsFunc(origins, func(origin string) bool { return strings.TrimSpace(origin) == "*" }) {
+		return ErrWildcardCredentials
+	}
+	return nil
+}
+
+// CORS sets the Access-Control headers for allowed origins and answers preflight requests with 204. Requests from
+// other origins get no CORS headers, so the browser blocks them. It panics when cfg combines "*" with
+// AllowCredentials.
+func CORS(cfg CORSConfig) func(http.Handler) http.Handler {
+	origins := cfg.Origins
+	if origins == nil {
+		origins = NewCORSOrigins(cfg.AllowedOrigins)
+	}
+	if cfg.AllowCredentials && origins.current.Load().wildcard {
+		panic(ErrWildcardCredentials)
+	}
+	methods := strings.Join(cfg.AllowedMethods, ",")
+	headers := strings.Join(cfg.AllowedHeaders, ",")
+	maxAge := ""
+	if cfg.MaxAge > 0 {
+		maxAge = strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	}
+	var tenantOrigins *originCache
+	if cfg.TenantOrigins != nil {
+		tenantOrigins = &originCache{lister: cfg.TenantOrigins, ttl: cfg.TenantOriginsTTL, now: time.Now}
+	}
+
+	// allowOrigin checks origin against the deployment-wide list. A list changed to "*" while credentials are
+	// allowed allows nothing; reloads are expected to go through CheckCORSOrigins.
+	allowOrigin := func(allowed *originSet, origin string) string {
+		if allowed.wildcard {
+			if cfg.AllowCredentials {
+				return ""
+			}
+			return "*"
+		}
+		if origin == "" {
+			return ""
+		}
+		if _, ok := allowed.origins[normalizeOrigin(origin)]; ok {
+			return origin
+		}
+		return ""
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PATCH,DELETE,OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Authorization,Content-Type,Idempotency-Key")
+			origin := r.Header.Get("Origin")
+			allowed := origins.current.Load()
+			value := allowOrigin(allowed, origin)
+			if value == "" && origin != "" && tenantOrigins != nil {
+				key := normalizeOrigin(origin)
+				if set := tenantOrigins.current(r.Context()); set.anyTenant(key) {
+					value = origin
+					// TenantCORS withdraws the headers once the request resolves to a tenant without the origin.
+					r = r.WithContext(context.WithValue(r.Context(), tenantCORSKey{}, tenantCORS{origin: key, origins: set}))
+				}
+			}
+
+			h := w.Header()
+			if !allowed.wildcard || cfg.AllowCredentials {
+				h.Add("Vary", "Origin")
+			}
+			if value != "" {
+				h.Set("Access-Control-Allow-Origin", value)
+				h.Set("Access-Control-Allow-Methods", methods)
+				h.Set("Access-Control-Allow-Headers", headers)
+				if cfg.AllowCredentials {
+					h.Set("Access-Control-Allow-Credentials", "true")
+				}
+				if maxAge != "" && r.Method == http.MethodOptions {
+					h.Set("Access-Control-Max-Age", maxAge)
+				}
+			}
 			if r.Method == http.MethodOptions {
 				w.WriteHeader(http.StatusNoContent)
 				return
@@ -17,3 +158,166 @@ func DefaultCORS() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+type tenantCORSKey struct{}
+
+// tenantCORS is a tenant origin CORS allowed before the tenant of the request was known.
+type tenantCORS struct {
+	origin  string
+	origins *tenantOriginSet
+}
+
+// corsHeaders are the headers TenantCORS withdraws.
+var corsHeaders = []string{
+	"Access-Control-Allow-Origin", "Access-Control-Allow-Methods", "Access-Control-Allow-Headers",
+	"Access-Control-Allow-Credentials",
+}
+
+// TenantCORS scopes the tenant origins of CORSConfig.TenantOrigins to their tenant: it removes the Access-Control
+// headers CORS set for an origin of some tenant unless the request resolved to a tenant that lists it. Routes outside
+// the tenant space therefore only answer the deployment-wide origins. It goes after the middleware that resolves the
+// tenant space, on every route group; responses sent before, such as authentication failures, keep the headers so
+// the front-end can read them.
+func TenantCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if pending, ok := r.Context().Value(tenantCORSKey{}).(tenantCORS); ok {
+			if space, ok := tenant.FromContext(r.Context()); !ok || !pending.origins.has(space.TenantID, pending.origin) {
+				for _, name := range corsHeaders {
+					w.Header().Del(name)
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// CORSOrigins is the list of allowed origins of CORSConfig.Origins; Set replaces it for the next requests.
+type CORSOrigins struct {
+	current atomic.Pointer[originSet]
+}
+
+type originSet struct {
+	wildcard bool
+	origins  map[string]struct{}
+}
+
+// NewCORSOrigins constructs the list with the same syntax as CORSConfig.AllowedOrigins.
+func NewCORSOrigins(origins []string) *CORSOrigins {
+	o := &CORSOrigins{}
+	o.Set(origins)
+	return o
+}
+
+// Set replaces the allowed origins.
+func (o *CORSOrigins) Set(origins []string) {
+	set := &originSet{origins: make(map[string]struct{}, len(origins))}
+	for _, origin := range origins {
+		origin = strings.TrimSpace(origin)
+		if origin == "*" {
+			set.wildcard = true
+			continue
+		}
+		if origin != "" {
+			set.origins[normalizeOrigin(origin)] = struct{}{}
+		}
+	}
+	o.current.Store(set)
+}
+
+// normalizeOrigin is the form origins are compared in: lower case, without a trailing slash.
+func normalizeOrigin(origin string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(origin), "/"))
+}
+
+// tenantOriginSet holds the tenant origins by tenant, and those of every tenant for preflights.
+type tenantOriginSet struct {
+	all      map[string]struct{}
+	byTenant map[uuid.UUID]map[string]struct{}
+}
+
+func newTenantOriginSet(list map[uuid.UUID][]string) *tenantOriginSet {
+	set := &tenantOriginSet{all: map[string]struct{}{}, byTenant: make(map[uuid.UUID]map[string]struct{}, len(list))}
+	for tenantID, origins := range list {
+		own := make(map[string]struct{}, len(origins))
+		for _, origin := range origins {
+			if origin = normalizeOrigin(origin); origin != "" {
+				own[origin] = struct{}{}
+				set.all[origin] = struct{}{}
+			}
+		}
+		set.byTenant[tenantID] = own
+	}
+	return set
+}
+
+// anyTenant reports whether some tenant lists origin.
+func (s *tenantOriginSet) anyTenant(origin string) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.all[origin]
+	return ok
+}
+
+// has reports whether the tenant lists origin.
+func (s *tenantOriginSet) has(tenantID uuid.UUID, origin string) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.byTenant[tenantID][origin]
+	return ok
+}
+
+// originCache keeps the tenant origins in memory for ttl. One lookup runs at a time, outside the lock: meanwhile
+// requests keep the origins of the last lookup, or wait for the first one. A failed lookup keeps the previous
+// origins and is retried after tenantOriginsRetry rather than on every request.
+type originCache struct {
+	lister TenantOriginLister
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	origins   *tenantOriginSet
+	expiresAt time.Time
+	// loading is closed when the lookup in flight ends; nil when there is none.
+	loading chan struct{}
+}
+
+func (c *originCache) current(ctx context.Context) *tenantOriginSet {
+	c.mu.Lock()
+	if c.now().Before(c.expiresAt) || (c.loading != nil && c.origins != nil) {
+		defer c.mu.Unlock()
+		return c.origins
+	}
+	if loading := c.loading; loading != nil {
+		c.mu.Unlock()
+		select {
+		case <-loading:
+		case <-ctx.Done():
+			return nil
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.origins
+	}
+	loading := make(chan struct{})
+	c.loading = loading
+	c.mu.Unlock()
+
+	// The lookup answers the requests waiting for it too, so it does not end with the one that started it.
+	lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tenantOriginsTimeout)
+	list, err := c.lister.ListCORSOrigins(lookupCtx)
+	cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		c.origins = newTenantOriginSet(list)
+		c.expiresAt = c.now().Add(c.ttl)
+	} else {
+		c.expiresAt = c.now().Add(tenantOriginsRetry)
+	}
+	c.loading = nil
+	close(loading)
+	return c.origins
+}
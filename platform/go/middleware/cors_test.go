@@ -0,0 +1,277 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// staticOrigins lists origins, or fails with err.
+type staticOrigins struct {
+	origins map[uuid.UUID][]string
+	err     error
+	calls   atomic.Int32
+	// release, when set, holds every lookup until it is closed.
+	release chan struct{}
+}
+
+func (s *staticOrigins) ListCORSOrigins(context.Context) (map[uuid.UUID][]string, error) {
+	s.calls.Add(1)
+	if s.release != nil {
+		<-s.release
+	}
+	return s.origins, s.err
+}
+
+func TestCORS(t *testing.T) {
+	t.Parallel()
+
+	tenantOrigins := &staticOrigins{origins: map[uuid.UUID][]string{uuid.New(): {"https://tenant.example.com"}}}
+	handler := CORS(CORSConfig{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Authorization"},
+		AllowCredentials: true,
+		MaxAge:           time.Minute,
+		TenantOrigins:    tenantOrigins,
+		TenantOriginsTTL: time.Hour,
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodOptions, "https://app.example.com")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "GET,POST", rec.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+	require.Equal(t, "60", rec.Header().Get("Access-Control-Max-Age"))
+	require.Equal(t, "Origin", rec.Header().Get("Vary"))
+
+	rec = serve(http.MethodGet, "https://tenant.example.com")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "https://tenant.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Empty(t, rec.Header().Get("Access-Control-Max-Age"))
+
+	rec = serve(http.MethodGet, "https://evil.example.com")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	require.EqualValues(t, 1, tenantOrigins.calls.Load(), "tenant origins are cached")
+}
+
+func TestDefaultCORSAllowsAnyOrigin(t *testing.T) {
+	t.Parallel()
+
+	handler := DefaultCORS()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://anywhere.example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+	require.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "X-API-Key")
+}
+
+func TestCORSOriginsCanChange(t *testing.T) {
+	t.Parallel()
+
+	origins := NewCORSOrigins([]string{"https://app.example.com"})
+	handler := CORS(CORSConfig{Origins: origins})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	allowed := func(origin string) string {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Origin", origin)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Header().Get("Access-Control-Allow-Origin")
+	}
+
+	require.Equal(t, "https://app.example.com", allowed("https://app.example.com"))
+	require.Empty(t, allowed("https://new.example.com"))
+
+	origins.Set([]string{"https://new.example.com/"})
+	require.Empty(t, allowed("https://app.example.com"))
+	require.Equal(t, "https://new.example.com", allowed("https://new.example.com"))
+
+	origins.Set([]string{"*"})
+	require.Equal(t, "*", allowed("https://anywhere.example.com"))
+}
+
+func TestCORSScopesTenantOriginsToTheirTenant(t *testing.T) {
+	t.Parallel()
+
+	acme, beta := uuid.New(), uuid.New()
+	tenantOrigins := &staticOrigins{origins: map[uuid.UUID][]string{
+		acme: {"https://Acme.example.com/"},
+		beta: {"https://beta.example.com"},
+	}}
+	// resolve stands in for the authentication and tenant space middlewares: it picks the tenant from the
+	// credentials, rejects "invalid" ones and leaves the rest, like the routes outside the tenant space, without one.
+	resolve := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "invalid" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if id, err := uuid.Parse(r.Header.Get("Authorization")); err == nil {
+				r = r.WithContext(tenant.WithSpace(r.Context(), tenant.Space{TenantID: id}))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	handler := CORS(CORSConfig{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowCredentials: true,
+		TenantOrigins:    tenantOrigins,
+		TenantOriginsTTL: time.Hour,
+	})(resolve(TenantCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))))
+
+	tests := []struct {
+		name   string
+		method string
+		origin string
+		tenant uuid.UUID
+		auth   string
+		want   string
+	}{
+		{name: "own tenant", method: http.MethodGet, origin: "https://acme.example.com", tenant: acme, want: "https://acme.example.com"},
+		{name: "own tenant, other case", method: http.MethodGet, origin: "https://ACME.example.com", tenant: acme, want: "https://ACME.example.com"},
+		{name: "other tenant", method: http.MethodGet, origin: "https://acme.example.com", tenant: beta},
+		{name: "outside the tenant space", method: http.MethodGet, origin: "https://acme.example.com"},
+		{name: "deployment origin outside the tenant space", method: http.MethodGet, origin: "https://app.example.com", want: "https://app.example.com"},
+		{name: "rejected before the tenant is known", method: http.MethodGet, origin: "https://acme.example.com", auth: "invalid", want: "https://acme.example.com"},
+		{name: "preflight of any tenant", method: http.MethodOptions, origin: "https://beta.example.com", want: "https://beta.example.com"},
+		{name: "deployment origin for any tenant", method: http.MethodGet, origin: "https://app.example.com", tenant: beta, want: "https://app.example.com"},
+		{name: "unknown origin", method: http.MethodOptions, origin: "https://evil.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Header.Set("Origin", tt.origin)
+			if tt.tenant != uuid.Nil {
+				req.Header.Set("Authorization", tt.tenant.String())
+			}
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.want, rec.Header().Get("Access-Control-Allow-Origin"))
+			if tt.want == "" {
+				require.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+			} else {
+				require.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+			}
+		})
+	}
+}
+
+func TestOriginCacheBacksOffAfterAFailedLookup(t *testing.T) {
+	t.Parallel()
+
+	acme := uuid.New()
+	lister := &staticOrigins{origins: map[uuid.UUID][]string{acme: {"https://acme.example.com"}}}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := &originCache{lister: lister, ttl: time.Minute, now: func() time.Time { return now }}
+	ctx := context.Background()
+
+	require.True(t, cache.current(ctx).has(acme, "https://acme.example.com"))
+
+	now = now.Add(2 * time.Minute)
+	lister.err = errors.New("database unavailable")
+	require.True(t, cache.current(ctx).has(acme, "https://acme.example.com"), "a failed lookup keeps the previous origins")
+	require.True(t, cache.current(ctx).has(acme, "https://acme.example.com"))
+	require.EqualValues(t, 2, lister.calls.Load(), "a failed lookup is not retried on every request")
+
+	now = now.Add(tenantOriginsRetry)
+	lister.err = nil
+	lister.origins = map[uuid.UUID][]string{}
+	require.False(t, cache.current(ctx).has(acme, "https://acme.example.com"))
+	require.EqualValues(t, 3, lister.calls.Load())
+}
+
+func TestOriginCacheLooksUpOnceForConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	acme := uuid.New()
+	lister := &staticOrigins{origins: map[uuid.UUID][]string{acme: {"https://acme.example.com"}}, release: make(chan struct{})}
+	cache := &originCache{lister: lister, ttl: time.Minute, now: time.Now}
+
+	var wg sync.WaitGroup
+	found := make(chan bool, 8)
+	for range cap(found) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found <- cache.current(context.Background()).has(acme, "https://acme.example.com")
+		}()
+	}
+	require.Eventually(t, func() bool { return lister.calls.Load() == 1 }, time.Second, time.Millisecond)
+	close(lister.release)
+	wg.Wait()
+	close(found)
+
+	for ok := range found {
+		require.True(t, ok, "requests waiting for the first lookup get its origins")
+	}
+	require.EqualValues(t, 1, lister.calls.Load())
+}
+
+func TestCORSRejectsWildcardWithCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		origins     []string
+		credentials bool
+		wantErr     error
+	}{
+		{name: "wildcard without credentials", origins: []string{"*"}},
+		{name: "listed origins with credentials", origins: []string{"https://app.example.com"}, credentials: true},
+		{name: "wildcard with credentials", origins: []string{"https://app.example.com", " * "}, credentials: true, wantErr: ErrWildcardCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, CheckCORSOrigins(tt.origins, tt.credentials), tt.wantErr)
+			build := func() { CORS(CORSConfig{AllowedOrigins: tt.origins, AllowCredentials: tt.credentials}) }
+			if tt.wantErr != nil {
+				require.PanicsWithValue(t, tt.wantErr, build)
+			} else {
+				require.NotPanics(t, build)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+)
+
+// OpenAPI extensions that schedule the retirement of an operation marked `deprecated: true`. Dates are RFC 3339
+// dates ("2026-11-01") or timestamps.
+const (
+	// DeprecatedAtExtension is when the operation was deprecated. It is required on deprecated operations.
+	DeprecatedAtExtension = "x-deprecated-at"
+	// SunsetExtension is when the operation stops answering; it is optional.
+	SunsetExtension = "x-sunset"
+	// DeprecationLinkExtension is the URL of the replacement or a migration guide; it is optional.
+	DeprecationLinkExtension = "x-deprecation-link"
+)
+
+// Deprecation is the retirement schedule of an operation or of a whole API version.
+type Deprecation struct {
+	// Since is sent as the Deprecation header (RFC 9745).
+	Since time.Time
+	// Sunset is sent as the Sunset header (RFC 8594); zero sends none.
+	Sunset time.Time
+	// Link is sent as a Link header with rel="deprecation"; empty sends none.
+	Link string
+}
+
+func (d Deprecation) setHeaders(h http.Header) {
+	h.Set("Deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+	if !d.Sunset.IsZero() {
+		h.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Link != "" {
+		h.Set("Link", fmt.Sprintf("<%s>; rel=\"deprecation\"", d.Link))
+	}
+}
+
+// DeprecationHeaders announces d on every response, for an API version being retired as a whole.
+func DeprecationHeaders(d Deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d.setHeaders(w.Header())
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// OperationDeprecations announces the schedule of the deprecated operation a request matched, replacing the
+// headers of its API version. operations comes from DeprecatedOperations. The route is looked up by its chi
+// pattern, so the middleware must run after routing, as the middleware of a chi Group does.
+func OperationDeprecations(operations map[string]Deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if len(operations) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				if d, ok := operations[r.Method+" "+rctx.RoutePattern()]; ok {
+					d.setHeaders(w.Header())
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// DeprecatedOperations reads the schedule of every operation spec marks deprecated, keyed by method and route as
+// "GET /api/v1/users/{userId}": the path of the spec's first server followed by the path template. It reports the
+// deprecated operations without x-deprecated-at and the schedules that cannot be read.
+func DeprecatedOperations(spec *openapi3.T) (map[string]Deprecation, error) {
+	base := ""
+	if len(spec.Servers) > 0 {
+		base = spec.Servers[0].URL
+		if parsed, err := url.Parse(base); err == nil {
+			base = parsed.Path
+		}
+		base = strings.TrimRight(base, "/")
+	}
+
+	operations := map[string]Deprecation{}
+	var errs []error
+	for path, item := range spec.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if !operation.Deprecated {
+				continue
+			}
+			key := method + " " + base + path
+			d, err := readDeprecation(operation.Extensions)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", key, err))
+				continue
+			}
+			operations[key] = d
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return operations, errors.Join(errs...)
+}
+
+func readDeprecation(extensions map[string]any) (Deprecation, error) {
+	var d Deprecation
+	since, err := extensionTime(extensions, DeprecatedAtExtension)
+	if err != nil {
+		return d, err
+	}
+	if since.IsZero() {
+		return d, fmt.Errorf("deprecated without %s", DeprecatedAtExtension)
+	}
+	sunset, err := extensionTime(extensions, SunsetExtension)
+	if err != nil {
+		return d, err
+	}
+	if !sunset.IsZero() && sunset.Before(since) {
+		return d, fmt.Errorf("%s is before %s", SunsetExtension, DeprecatedAtExtension)
+	}
+	link, _ := extensions[DeprecationLinkExtension].(string)
+	return Deprecation{Since: since, Sunset: sunset, Link: link}, nil
+}
+
+func extensionTime(extensions map[string]any, name string) (time.Time, error) {
+	switch value := extensions[name].(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return value, nil
+	case string:
+		for _, layout := range []string{time.DateOnly, time.RFC3339} {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("%s: %q is not an RFC 3339 date", name, value)
+	default:
+		return time.Time{}, fmt.Errorf("%s: expected a date, got %T", name, value)
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const deprecationSpec = `
+openapi: 3.0.3
+info: {title: Users, version: 1.0.0}
+servers: [{url: /api/v1}]
+paths:
+  /users/{userId}:
+    get:
+      deprecated: true
+      x-deprecated-at: "2026-10-01"
+      x-sunset: "2027-04-01"
+      x-deprecation-link: https://docs.example.com/migrate/users-v2
+      responses: {"200": {description: ok}}
+    delete:
+      responses: {"204": {description: gone}}
+  /users:
+    get:
+      deprecated: true
+      responses: {"200": {description: ok}}
+`
+
+func TestOperationDeprecations(t *testing.T) {
+	t.Parallel()
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(deprecationSpec))
+	require.NoError(t, err)
+
+	operations, err := DeprecatedOperations(spec)
+	require.ErrorContains(t, err, "GET /api/v1/users: deprecated without x-deprecated-at")
+	require.Equal(t, map[string]Deprecation{
+		"GET /api/v1/users/{userId}": {
+			Since:  time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			Sunset: time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC),
+			Link:   "https://docs.example.com/migrate/users-v2",
+		},
+	}, operations)
+
+	version := chi.NewRouter()
+	version.Use(DeprecationHeaders(Deprecation{Since: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)}))
+	version.Group(func(r chi.Router) {
+		r.Use(OperationDeprecations(operations))
+		ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+		r.Get("/users/{userId}", ok)
+		r.Delete("/users/{userId}", ok)
+	})
+	root := chi.NewRouter()
+	root.Mount("/api/v1", version)
+
+	serve := func(method string) http.Header {
+		rec := httptest.NewRecorder()
+		root.ServeHTTP(rec, httptest.NewRequest(method, "/api/v1/users/42", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Header()
+	}
+
+	headers := serve(http.MethodGet)
+	require.Equal(t, "@1790812800", headers.Get("Deprecation"))
+	require.Equal(t, "Thu, 01 Apr 2027 00:00:00 GMT", headers.Get("Sunset"))
+	require.Equal(t, `<https://docs.example.com/migrate/users-v2>; rel="deprecation"`, headers.Get("Link"))
+
+	// Operations that are not deprecated carry the schedule of their version.
+	headers = serve(http.MethodDelete)
+	require.Equal(t, "@1788220800", headers.Get("Deprecation"))
+	require.Empty(t, headers.Get("Sunset"))
+}
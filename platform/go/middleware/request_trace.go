@@ -11,15 +11,17 @@ import (
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
 )
 
-// RequestTrace populates the context with request-scoped AuditInfo so services and repositories can stamp audit fields.
-// It should run after authentication middleware so user credentials are available when present.
+// RequestTrace populates the context with request-scoped AuditInfo so services and repositories can stamp audit fields,
+// and adds the actor to the request logger. It should run after authentication middleware so user credentials are
+// available when present.
 func RequestTrace(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger := platformlogging.FromRequest(r, nil)
 		requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
 
 		var audit requesttrace.AuditInfo
-		if creds, ok := platformauth.UserFromContext(r.Context()); ok && creds != nil {
+		creds, ok := platformauth.UserFromContext(r.Context())
+		if ok && creds != nil {
 			var err error
 			audit, err = requesttrace.FromCredentials(creds, requestID)
 			if err != nil {
@@ -39,6 +41,12 @@ func RequestTrace(next http.Handler) http.Handler {
 			if audit.UserID != nil && *audit.UserID != "" {
 				fields = append(fields, zap.String("user_id", *audit.UserID))
 			}
+			if creds != nil && creds.APIKeyID != "" {
+				fields = append(fields, zap.String("api_key_id", creds.APIKeyID))
+			}
+			if creds != nil && creds.ServiceAccountID != "" {
+				fields = append(fields, zap.String("service_account_id", creds.ServiceAccountID))
+			}
 			logger = logger.With(fields...)
 			ctx = platformlogging.WithLogger(ctx, logger)
 		}
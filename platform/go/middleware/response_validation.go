@@ -0,0 +1,215 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"go.uber.org/zap"
+
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+// ResponseValidationMode is what ValidateResponses does with a response that does not match the contract.
+type ResponseValidationMode string
+
+const (
+	// ResponseValidationOff skips response validation.
+	ResponseValidationOff ResponseValidationMode = "off"
+	// ResponseValidationLog logs the mismatch and sends the response unchanged.
+	ResponseValidationLog ResponseValidationMode = "log"
+	// ResponseValidationFail holds every response until it is validated and replaces a mismatch with a 500.
+	ResponseValidationFail ResponseValidationMode = "fail"
+)
+
+const defaultResponseValidationMaxBytes = 1 << 20
+
+// ResponseValidationConfig configures ValidateResponses.
+type ResponseValidationConfig struct {
+	Mode ResponseValidationMode
+	// MaxBodyBytes bounds the body kept for validation; larger responses, like event streams and flushed
+	// responses, pass unchecked. Zero keeps 1 MiB.
+	MaxBodyBytes int64
+}
+
+// ValidateResponses checks every response of the operations in spec against the contract: the status must be
+// declared, and the declared headers and body must match their schemas. It is meant for dev and staging, where it
+// catches contract drift before clients do; the cost is a copy of each body. Requests for routes the spec does
+// not describe pass through, since the request validator answers them.
+func ValidateResponses(spec *openapi3.T, logger *zap.Logger, cfg ResponseValidationConfig) (func(http.Handler) http.Handler, error) {
+	if logger == nil {
+		panic("logger is required")
+	}
+	switch cfg.Mode {
+	case ResponseValidationOff, "":
+		return func(next http.Handler) http.Handler { return next }, nil
+	case ResponseValidationLog, ResponseValidationFail:
+	default:
+		return nil, fmt.Errorf("invalid response validation mode %q (use off, log or fail)", cfg.Mode)
+	}
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("build response validation router: %w", err)
+	}
+	maxBytes := cfg.MaxBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultResponseValidationMaxBytes
+	}
+	options := &openapi3filter.Options{IncludeResponseStatus: true, MultiError: true}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &validatingWriter{ResponseWriter: w, hold: cfg.Mode == ResponseValidationFail, maxBytes: maxBytes}
+			if rec.hold {
+				rec.header = w.Header().Clone()
+			}
+			next.ServeHTTP(rec, r)
+			if !rec.wroteHeader {
+				rec.WriteHeader(http.StatusOK)
+			}
+			if rec.unchecked {
+				rec.release()
+				return
+			}
+
+			input := &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{Request: r, PathParams: pathParams, Route: route},
+				Status:                 rec.status,
+				Header:                 rec.Header(),
+				Options:                options,
+			}
+			input.SetBodyBytes(rec.body.Bytes())
+			err = openapi3filter.ValidateResponse(r.Context(), input)
+			var parseErr *openapi3filter.ParseError
+			if errors.As(err, &parseErr) && parseErr.Kind == openapi3filter.KindUnsupportedFormat {
+				// Bodies no decoder reads, like application/x-ndjson, cannot be checked.
+				err = nil
+			}
+			if err != nil {
+				platformlogging.FromContextOr(r.Context(), logger).Error("response does not match the contract",
+					zap.String("method", r.Method),
+					zap.String("route", route.Path),
+					zap.Int("status", rec.status),
+					zap.Error(err),
+				)
+			}
+
+			if !rec.hold {
+				return
+			}
+			if err != nil {
+				problems.Write(w, problems.Internal().WithDetail("response does not match the contract: "+err.Error()))
+				return
+			}
+			rec.release()
+		})
+	}, nil
+}
+
+// validatingWriter copies the response for validation. Holding, it keeps the status, headers and body back until
+// the response is validated. A response it cannot keep whole is released and passes unchecked.
+type validatingWriter struct {
+	http.ResponseWriter
+	hold     bool
+	maxBytes int64
+
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+	unchecked   bool
+	released    bool
+}
+
+func (w *validatingWriter) Header() http.Header {
+	if w.hold && !w.released {
+		return w.header
+	}
+	return w.ResponseWriter.Header()
+}
+
+func (w *validatingWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	if !w.hold {
+		w.ResponseWriter.WriteHeader(status)
+	}
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+		w.skip()
+	}
+}
+
+func (w *validatingWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.unchecked && int64(w.body.Len()+len(p)) > w.maxBytes {
+		w.skip()
+	}
+	if w.unchecked {
+		return w.ResponseWriter.Write(p)
+	}
+	w.body.Write(p)
+	if w.hold {
+		return len(p), nil
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush gives up validation: a flushing handler streams, and holding its response would defeat that.
+func (w *validatingWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.skip()
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *validatingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// skip stops validating and sends on whatever was held.
+func (w *validatingWriter) skip() {
+	if w.unchecked {
+		return
+	}
+	w.unchecked = true
+	w.release()
+}
+
+// release sends the held status, headers and body.
+func (w *validatingWriter) release() {
+	if !w.hold || w.released {
+		return
+	}
+	w.released = true
+	header := w.ResponseWriter.Header()
+	for key := range header {
+		if _, ok := w.header[key]; !ok {
+			header.Del(key)
+		}
+	}
+	maps.Copy(header, w.header)
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	w.body.Reset()
+}
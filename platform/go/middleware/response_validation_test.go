@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const responseSpec = `
+openapi: 3.0.3
+info: {title: Users, version: 1.0.0}
+servers: [{url: /api/v1}]
+paths:
+  /users/{userId}:
+    get:
+      parameters:
+        - {name: userId, in: path, required: true, schema: {type: string}}
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [id, email]
+                properties:
+                  id: {type: string}
+                  email: {type: string, format: email}
+        "404":
+          description: missing
+          content:
+            application/problem+json:
+              schema: {type: object, required: [title], properties: {title: {type: string}}}
+`
+
+func TestValidateResponses(t *testing.T) {
+	t.Parallel()
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(responseSpec))
+	require.NoError(t, err)
+
+	respond := func(status int, contentType, body string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("ETag", `"v1"`)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		})
+	}
+	serve := func(mode ResponseValidationMode, handler http.Handler) (*httptest.ResponseRecorder, *observer.ObservedLogs) {
+		core, logs := observer.New(zap.ErrorLevel)
+		validate, err := ValidateResponses(spec, zap.New(core), ResponseValidationConfig{Mode: mode})
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		validate(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users/42", nil))
+		return rec, logs
+	}
+
+	valid := respond(http.StatusOK, "application/json", `{"id":"42","email":"ada@example.com"}`)
+	drifted := respond(http.StatusOK, "application/json", `{"id":"42","mail":"ada@example.com"}`)
+	undeclared := respond(http.StatusConflict, "application/problem+json", `{"title":"Conflict"}`)
+
+	for _, mode := range []ResponseValidationMode{ResponseValidationLog, ResponseValidationFail} {
+		rec, logs := serve(mode, valid)
+		require.Equal(t, http.StatusOK, rec.Code, mode)
+		require.JSONEq(t, `{"id":"42","email":"ada@example.com"}`, rec.Body.String())
+		require.Equal(t, `"v1"`, rec.Header().Get("ETag"))
+		require.Zero(t, logs.Len())
+
+		_, logs = serve(mode, undeclared)
+		require.Equal(t, 1, logs.Len(), mode)
+	}
+
+	// Logging sends the drifted response unchanged.
+	rec, logs := serve(ResponseValidationLog, drifted)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"mail"`)
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, "/users/{userId}", logs.All()[0].ContextMap()["route"])
+
+	// Failing replaces it, and the handler's headers with it.
+	rec, _ = serve(ResponseValidationFail, drifted)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+	require.Empty(t, rec.Header().Get("ETag"))
+	require.Contains(t, rec.Body.String(), "response does not match the contract")
+	require.Contains(t, rec.Body.String(), "email")
+
+	// Streams pass unchecked.
+	rec, logs = serve(ResponseValidationFail, respond(http.StatusOK, "text/event-stream", "data: {}\n\n"))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "data: {}\n\n", rec.Body.String())
+	require.Zero(t, logs.Len())
+
+	_, err = ValidateResponses(spec, zap.NewNop(), ResponseValidationConfig{Mode: "strict"})
+	require.ErrorContains(t, err, `invalid response validation mode "strict"`)
+}
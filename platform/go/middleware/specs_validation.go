@@ -2,29 +2,84 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
 )
 
-// ValidateAuthenticationViaSwagger OpenAPI request validation against the embedded spec (with permissive auth func for public endpoints)
-// Provide AuthenticationFunc to satisfy operations that declare security in OpenAPI.
-func ValidateAuthenticationViaSwagger(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
-	// Enforce presence of Bearer token for endpoints that require bearerAuth.
-	// For operations that allow anonymous (security: [{}] or no security), the validator will not require bearerAuth.
-	if input != nil && input.SecuritySchemeName == "bearerAuth" {
-		// TODO: extract role from `r.Context().Value(ctxUserCredentials)` injected in JWT middleware.
+// ValidateAuthenticationViaSwagger returns the AuthenticationFunc of the OpenAPI request validator. Operations
+// that require bearerAuth need a bearer token (or a verified API key), and every scope they declare, as in
+// `bearerAuth: [users:read]`, must be granted by platformauth.Authorize; resolver maps the caller's roles to
+// permissions. Operations that allow anonymous access (security: [] or [{}]) never reach it.
+func ValidateAuthenticationViaSwagger(resolver platformauth.PermissionResolver) openapi3filter.AuthenticationFunc {
+	if resolver == nil {
+		panic("permission resolver is required")
+	}
+
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		if input == nil || input.SecuritySchemeName != "bearerAuth" {
+			return nil
+		}
 		r := input.RequestValidationInput.Request
 		if r == nil {
 			return fmt.Errorf("no request in validation input")
 		}
-		authz := r.Header.Get("Authorization")
-		if authz == "" || !strings.HasPrefix(strings.ToLower(authz), "bearer ") {
-			return fmt.Errorf("missing or invalid Authorization header")
+
+		creds, _ := platformauth.UserFromContext(r.Context())
+		// API keys stand in for the bearer token; platformauth.APIKey has already verified the key.
+		if creds == nil || creds.APIKeyID == "" {
+			authz := r.Header.Get("Authorization")
+			if authz == "" || !strings.HasPrefix(strings.ToLower(authz), "bearer ") {
+				return fmt.Errorf("missing or invalid Authorization header")
+			}
+		}
+
+		for _, scope := range input.Scopes {
+			if err := platformauth.Authorize(r.Context(), resolver, creds, scope); err != nil {
+				return fmt.Errorf("scope %s: %w", scope, err)
+			}
 		}
+		return nil
+	}
+}
+
+// SpecValidationErrorHandler answers requests rejected by the OpenAPI request validator with problem details,
+// using the status its default handler would, except that a missing scope answers 403 instead of 401.
+func SpecValidationErrorHandler(_ context.Context, err error, w http.ResponseWriter, _ *http.Request, opts oapimiddleware.ErrorHandlerOpts) {
+	status := opts.StatusCode
+	message := err.Error()
+
+	var requestErr *openapi3filter.RequestError
+	switch {
+	case errors.Is(err, platformauth.ErrPermissionDenied):
+		status = http.StatusForbidden
+	case errors.Is(err, routers.ErrMethodNotAllowed):
+		status = http.StatusMethodNotAllowed
+	case errors.As(err, &requestErr):
+		// kin-openapi errors are multi-line; the first line carries the message.
+		message, _, _ = strings.Cut(message, "\n")
+	}
 
-		// TODO: Validate if the user has one of the required roles.
+	var problem problems.Problem
+	switch status {
+	case http.StatusBadRequest:
+		problem = problems.BadRequest(message)
+	case http.StatusUnauthorized:
+		problem = problems.Unauthorized(message)
+	case http.StatusForbidden:
+		problem = problems.Forbidden(message)
+	case http.StatusNotFound:
+		problem = problems.NotFound(message)
+	default:
+		problem = problems.New(status, http.StatusText(status), problems.TypeValidation, message)
 	}
-	return nil
+	problems.Write(w, problem)
 }
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/stretchr/testify/require"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+)
+
+const scopedSpec = `
+openapi: 3.0.4
+info: {title: test, version: v1}
+security:
+  - bearerAuth: []
+paths:
+  /admin/users:
+    get:
+      security:
+        - bearerAuth: [users:read]
+      responses: {"200": {description: ok}}
+    post:
+      security:
+        - bearerAuth: [users:write]
+      responses: {"200": {description: ok}}
+  /admin/tenants:
+    get:
+      security:
+        - bearerAuth: [tenants:admin]
+      responses: {"200": {description: ok}}
+  /users/me:
+    get:
+      responses: {"200": {description: ok}}
+  /users:
+    get:
+      parameters:
+        - {name: limit, in: query, schema: {type: integer}}
+      responses: {"200": {description: ok}}
+components:
+  securitySchemes:
+    bearerAuth: {type: http, scheme: bearer}
+`
+
+type stubResolver map[string][]string
+
+func (s stubResolver) ResolvePermissions(_ context.Context, creds *platformauth.UserCredentials) ([]string, error) {
+	var permissions []string
+	for _, role := range creds.TenantRoles {
+		permissions = append(permissions, s[role]...)
+	}
+	return permissions, nil
+}
+
+func TestValidateAuthenticationViaSwaggerEnforcesScopes(t *testing.T) {
+	t.Parallel()
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(scopedSpec))
+	require.NoError(t, err)
+
+	validator := oapimiddleware.OapiRequestValidatorWithOptions(spec, &oapimiddleware.Options{
+		Options: openapi3filter.Options{
+			AuthenticationFunc: ValidateAuthenticationViaSwagger(stubResolver{"viewer": {"users:read"}, "owner": {"*"}}),
+		},
+		ErrorHandlerWithOpts: SpecValidationErrorHandler,
+	})
+	handler := validator(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }))
+
+	viewer := &platformauth.UserCredentials{Id: "user-1", TenantRoles: []string{"viewer"}}
+	owner := &platformauth.UserCredentials{Id: "user-2", TenantRoles: []string{"owner"}}
+	admin := &platformauth.UserCredentials{Id: "user-3", IsAdmin: true}
+	readKey := &platformauth.UserCredentials{Id: "user-2", TenantRoles: []string{"owner"}, APIKeyID: "key-1", Scopes: []string{"users:read"}}
+	account := &platformauth.UserCredentials{Id: "sa-1", ServiceAccountID: "sa-1", Scopes: []string{"users:write"}}
+
+	cases := []struct {
+		name   string
+		creds  *platformauth.UserCredentials
+		bearer bool
+		method string
+		path   string
+		want   int
+		// wantType is the problem type of a rejection.
+		wantType string
+	}{
+		{name: "viewer lists users", creds: viewer, bearer: true, method: http.MethodGet, path: "/admin/users", want: http.StatusOK},
+		{name: "viewer creates user", creds: viewer, bearer: true, method: http.MethodPost, path: "/admin/users", want: http.StatusForbidden, wantType: problems.TypeForbidden},
+		{name: "owner creates user", creds: owner, bearer: true, method: http.MethodPost, path: "/admin/users", want: http.StatusOK},
+		{name: "owner wildcard excludes platform scopes", creds: owner, bearer: true, method: http.MethodGet, path: "/admin/tenants", want: http.StatusForbidden, wantType: problems.TypeForbidden},
+		{name: "platform admin lists tenants", creds: admin, bearer: true, method: http.MethodGet, path: "/admin/tenants", want: http.StatusOK},
+		{name: "api key limited to its scopes", creds: readKey, method: http.MethodPost, path: "/admin/users", want: http.StatusForbidden, wantType: problems.TypeForbidden},
+		{name: "api key within its scopes", creds: readKey, method: http.MethodGet, path: "/admin/users", want: http.StatusOK},
+		{name: "service account uses its scopes", creds: account, bearer: true, method: http.MethodPost, path: "/admin/users", want: http.StatusOK},
+		{name: "unscoped operation", creds: viewer, bearer: true, method: http.MethodGet, path: "/users/me", want: http.StatusOK},
+		{name: "missing bearer token", creds: viewer, method: http.MethodGet, path: "/users/me", want: http.StatusUnauthorized, wantType: problems.TypeAuth},
+		{name: "method not allowed", creds: viewer, bearer: true, method: http.MethodDelete, path: "/users/me", want: http.StatusMethodNotAllowed, wantType: problems.TypeValidation},
+		{name: "invalid parameter", creds: viewer, bearer: true, method: http.MethodGet, path: "/users?limit=ten", want: http.StatusBadRequest, wantType: problems.TypeValidation},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		if tc.bearer {
+			req.Header.Set("Authorization", "Bearer token")
+		}
+		req = req.WithContext(platformauth.WithUserCredentials(req.Context(), tc.creds))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, tc.want, rec.Code, tc.name)
+		if tc.want == http.StatusOK {
+			continue
+		}
+
+		require.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"), tc.name)
+		var problem struct {
+			Status int    `json:"status"`
+			Type   string `json:"type"`
+			Detail string `json:"detail"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem), tc.name)
+		require.Equal(t, tc.want, problem.Status, tc.name)
+		require.Equal(t, tc.wantType, problem.Type, tc.name)
+		require.NotEmpty(t, problem.Detail, tc.name)
+		require.NotContains(t, problem.Detail, "\n", tc.name)
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+	"net/http"
+	"strings"
+)
+
+// ReadOnlyActions are the custom-method suffixes of POST operations that only read, such as
+// POST /entities/{tableName}/documents:batchGet. Suspended tenants may still call them.
+var ReadOnlyActions = []string{":batchGet", ":validate", ":verify-examples"}
+
+// BlockSuspendedWrites rejects writes to a suspended tenant with 403 while letting reads through. Safe methods
+// (GET, HEAD, OPTIONS) and POSTs whose path ends in one of readOnlyActions count as reads. It must run after the
+// tenant middleware has attached the tenant.Space; requests without one pass untouched.
+func BlockSuspendedWrites(readOnlyActions ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			space, ok := tenant.FromContext(r.Context())
+			if !ok || !space.Suspended || isReadRequest(r, readOnlyActions) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeTenantSuspended, "tenant is suspended; only read access is allowed"))
+		})
+	}
+}
+
+func isReadRequest(r *http.Request, readOnlyActions []string) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		for _, action := range readOnlyActions {
+			if strings.HasSuffix(r.URL.Path, action) {
+				return true
+			}
+		}
+	}
+	return false
+}
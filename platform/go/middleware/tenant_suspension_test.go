@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestBlockSuspendedWrites(t *testing.T) {
+	t.Parallel()
+
+	handler := BlockSuspendedWrites(ReadOnlyActions...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	cases := []struct {
+		name      string
+		method    string
+		path      string
+		suspended bool
+		want      int
+	}{
+		{name: "read while suspended", method: http.MethodGet, path: "/entities/cards/documents", suspended: true, want: http.StatusOK},
+		{name: "read-only action while suspended", method: http.MethodPost, path: "/entities/cards/documents:batchGet", suspended: true, want: http.StatusOK},
+		{name: "create while suspended", method: http.MethodPost, path: "/entities/cards/documents", suspended: true, want: http.StatusForbidden},
+		{name: "delete while suspended", method: http.MethodDelete, path: "/webhooks/1", suspended: true, want: http.StatusForbidden},
+		{name: "create while active", method: http.MethodPost, path: "/entities/cards/documents", want: http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		req = req.WithContext(tenant.WithSpace(req.Context(), tenant.Space{Slug: "acme", Suspended: tc.suspended}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, tc.want, rec.Code, tc.name)
+		if tc.want == http.StatusForbidden {
+			require.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"), tc.name)
+		}
+	}
+}
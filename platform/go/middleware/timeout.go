@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+)
+
+// TimeoutUnlessStreaming applies chi's request timeout to every request except those asking for
+// a Server-Sent Events stream (Accept: text/event-stream), which are expected to stay open.
+func TimeoutUnlessStreaming(timeout time.Duration) func(http.Handler) http.Handler {
+	withTimeout := chimw.Timeout(timeout)
+	return func(next http.Handler) http.Handler {
+		timed := withTimeout(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			timed.ServeHTTP(w, r)
+		})
+	}
+}
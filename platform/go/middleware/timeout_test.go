@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutUnlessStreaming(t *testing.T) {
+	t.Parallel()
+
+	var hasDeadline bool
+	handler := TimeoutUnlessStreaming(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline = r.Context().Deadline()
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	cases := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{name: "json request", accept: "application/json", want: true},
+		{name: "event stream", accept: "text/event-stream", want: false},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept", tc.accept)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		require.Equal(t, tc.want, hasDeadline, tc.name)
+	}
+}
@@ -0,0 +1,260 @@
+// Package openapi merges the per-domain OpenAPI contracts into one document describing the whole platform.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Spec is a domain contract to merge. Name identifies the domain, e.g. "schema-repository" or "v2/users", and
+// prefixes the components that clash with another domain's.
+type Spec struct {
+	Name string
+	Doc  *openapi3.T
+}
+
+var operationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+const componentRefPrefix = "#/components/"
+
+// Merge combines specs into a single document with info.
+//
+//   - The server is the base path the specs share; paths carry the rest of their spec's server path.
+//   - Components that are identical across specs are kept once. A component whose name another spec already uses
+//     for a different definition is renamed with its domain prefix and the spec's references follow it.
+//   - A spec's global security requirement moves onto its operations, since it does not hold for the others.
+//
+// Two specs defining the same method on the same path, or the same operationId, are an error.
+func Merge(info *openapi3.Info, specs []Spec) (*openapi3.T, error) {
+	if info == nil || len(specs) == 0 {
+		return nil, fmt.Errorf("info and at least one spec are required")
+	}
+
+	docs := make([]map[string]any, len(specs))
+	bases := make([]string, len(specs))
+	for i, spec := range specs {
+		doc, err := toMap(spec.Doc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", spec.Name, err)
+		}
+		docs[i] = doc
+		bases[i] = serverPath(doc)
+	}
+	common := commonBase(bases)
+
+	merged := map[string]any{
+		"openapi": docs[0]["openapi"],
+		"info":    info,
+	}
+	if common != "" {
+		merged["servers"] = []any{map[string]any{"url": common}}
+	}
+
+	components := map[string]map[string]any{}
+	paths := map[string]map[string]any{}
+	operationIDs := map[string]string{}
+	pathOwners := map[string]string{}
+	var tags []any
+	seenTags := map[string]bool{}
+
+	for i, spec := range specs {
+		doc := docs[i]
+		renames := componentRenames(spec.Name, doc, components)
+		doc = rewriteRefs(doc, renames).(map[string]any)
+
+		for section, entries := range asMap(doc["components"]) {
+			target := components[section]
+			if target == nil {
+				target = map[string]any{}
+				components[section] = target
+			}
+			for name, value := range asMap(entries) {
+				if renamed, ok := renames[section+"/"+name]; ok {
+					name = strings.TrimPrefix(renamed, section+"/")
+				}
+				if existing, ok := target[name]; ok && !reflect.DeepEqual(existing, value) {
+					return nil, fmt.Errorf("%s: component %s/%s clashes with another spec", spec.Name, section, name)
+				}
+				target[name] = value
+			}
+		}
+
+		security, hasSecurity := doc["security"]
+		suffix := strings.TrimPrefix(bases[i], common)
+		for path, item := range asMap(doc["paths"]) {
+			fullPath := suffix + path
+			target := paths[fullPath]
+			if target == nil {
+				target = map[string]any{}
+				paths[fullPath] = target
+			}
+			for key, value := range asMap(item) {
+				operation, isOperation := value.(map[string]any)
+				if !isOperation || !slices.Contains(operationMethods, key) {
+					// Path-level parameters, summary and description.
+					if existing, ok := target[key]; ok && !reflect.DeepEqual(existing, value) {
+						return nil, fmt.Errorf("%s: %s %s differs from %s", spec.Name, fullPath, key, pathOwners[fullPath])
+					}
+					target[key] = value
+					continue
+				}
+				if _, ok := target[key]; ok {
+					return nil, fmt.Errorf("%s: %s %s is also defined by %s", spec.Name, strings.ToUpper(key), fullPath, pathOwners[fullPath+" "+key])
+				}
+				if id, ok := operation["operationId"].(string); ok {
+					if owner, taken := operationIDs[id]; taken {
+						return nil, fmt.Errorf("%s: operationId %s is also defined by %s", spec.Name, id, owner)
+					}
+					operationIDs[id] = spec.Name
+				}
+				if _, ok := operation["security"]; !ok && hasSecurity {
+					operation["security"] = security
+				}
+				target[key] = operation
+				pathOwners[fullPath+" "+key] = spec.Name
+			}
+			if _, ok := pathOwners[fullPath]; !ok {
+				pathOwners[fullPath] = spec.Name
+			}
+		}
+
+		for _, tag := range asSlice(doc["tags"]) {
+			name, _ := asMap(tag)["name"].(string)
+			if seenTags[name] {
+				continue
+			}
+			seenTags[name] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	merged["paths"] = paths
+	if len(components) > 0 {
+		merged["components"] = components
+	}
+	if len(tags) > 0 {
+		merged["tags"] = tags
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("marshal merged spec: %w", err)
+	}
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("load merged spec: %w", err)
+	}
+	return doc, nil
+}
+
+// componentRenames decides which of doc's components must take a domain prefix because the merged components
+// already hold a different definition under the same name. Renaming one component changes the components that
+// reference it, so it repeats until no new clash appears.
+func componentRenames(domain string, doc map[string]any, merged map[string]map[string]any) map[string]string {
+	prefix := strings.NewReplacer("-", "_", "/", "_").Replace(domain) + "_"
+	renames := map[string]string{}
+	for {
+		rewritten := asMap(rewriteRefs(doc["components"], renames))
+		changed := false
+		for section, entries := range rewritten {
+			for name, value := range asMap(entries) {
+				key := section + "/" + name
+				if _, ok := renames[key]; ok {
+					continue
+				}
+				if existing, ok := merged[section][name]; ok && !reflect.DeepEqual(existing, value) {
+					renames[key] = section + "/" + prefix + name
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return renames
+		}
+	}
+}
+
+// rewriteRefs returns a copy of node with the local component references in renames pointing at their new names.
+func rewriteRefs(node any, renames map[string]string) any {
+	switch value := node.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(value))
+		for key, child := range value {
+			if ref, ok := child.(string); ok && key == "$ref" {
+				if renamed, ok := renames[strings.TrimPrefix(ref, componentRefPrefix)]; ok && strings.HasPrefix(ref, componentRefPrefix) {
+					child = componentRefPrefix + renamed
+				}
+				out[key] = child
+				continue
+			}
+			out[key] = rewriteRefs(child, renames)
+		}
+		return out
+	case []any:
+		out := make([]any, len(value))
+		for i, child := range value {
+			out[i] = rewriteRefs(child, renames)
+		}
+		return out
+	default:
+		return node
+	}
+}
+
+// serverPath is the path of doc's first server URL, without a trailing slash.
+func serverPath(doc map[string]any) string {
+	servers := asSlice(doc["servers"])
+	if len(servers) == 0 {
+		return ""
+	}
+	raw, _ := asMap(servers[0])["url"].(string)
+	if parsed, err := url.Parse(raw); err == nil {
+		raw = parsed.Path
+	}
+	return strings.TrimRight(raw, "/")
+}
+
+// commonBase is the longest run of leading path segments every base shares.
+func commonBase(bases []string) string {
+	common := strings.Split(bases[0], "/")
+	for _, base := range bases[1:] {
+		segments := strings.Split(base, "/")
+		n := 0
+		for n < len(common) && n < len(segments) && common[n] == segments[n] {
+			n++
+		}
+		common = common[:n]
+	}
+	return strings.Join(common, "/")
+}
+
+func toMap(doc *openapi3.T) (map[string]any, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("spec is nil")
+	}
+	data, err := doc.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshal spec: %w", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("decode spec: %w", err)
+	}
+	return out, nil
+}
+
+func asMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
+
+func asSlice(value any) []any {
+	s, _ := value.([]any)
+	return s
+}
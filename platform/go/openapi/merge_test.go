@@ -0,0 +1,132 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+const usersSpec = `
+openapi: 3.0.3
+info: {title: Users, version: 1.0.0}
+servers: [{url: /api/v1}]
+security: [{bearerAuth: []}]
+tags: [{name: Users}]
+paths:
+  /users:
+    get:
+      operationId: usersList
+      tags: [Users]
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/User"}
+        default: {$ref: "#/components/responses/Problem"}
+components:
+  securitySchemes:
+    bearerAuth: {type: http, scheme: bearer}
+  responses:
+    Problem:
+      description: problem
+      content:
+        application/problem+json:
+          schema: {$ref: "#/components/schemas/common_problemdetails_ProblemDetails"}
+  schemas:
+    common_problemdetails_ProblemDetails:
+      type: object
+      properties: {title: {type: string}}
+    User:
+      type: object
+      properties: {email: {type: string}}
+`
+
+const auditSpec = `
+openapi: 3.0.3
+info: {title: Audit, version: 1.0.0}
+servers: [{url: "https://api.example.com/api/v1/audit"}]
+security: [{bearerAuth: ["audit:read"]}]
+tags: [{name: Users}, {name: Audit}]
+paths:
+  /users:
+    get:
+      operationId: auditUsersList
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/Entry"}
+  /public:
+    get:
+      operationId: auditPublic
+      security: []
+      responses:
+        "204": {description: empty}
+components:
+  securitySchemes:
+    bearerAuth: {type: http, scheme: bearer}
+  schemas:
+    common_problemdetails_ProblemDetails:
+      type: object
+      properties: {title: {type: string}}
+    User:
+      type: object
+      properties: {actor: {type: string}}
+    Entry:
+      type: object
+      properties: {user: {$ref: "#/components/schemas/User"}}
+`
+
+func load(t *testing.T, spec string) *openapi3.T {
+	t.Helper()
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	merged, err := Merge(&openapi3.Info{Title: "Platform", Version: "1.0.0"}, []Spec{
+		{Name: "users", Doc: load(t, usersSpec)},
+		{Name: "audit-log", Doc: load(t, auditSpec)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, merged.Servers, 1)
+	require.Equal(t, "/api/v1", merged.Servers[0].URL)
+	require.Nil(t, merged.Security)
+
+	users := merged.Paths.Find("/users").Get
+	require.Equal(t, "#/components/schemas/User", users.Responses.Status(200).Value.Content.Get("application/json").Schema.Ref)
+	require.Equal(t, openapi3.SecurityRequirements{{"bearerAuth": []string{}}}, *users.Security)
+
+	// The audit paths keep the rest of their server path and their own security.
+	audit := merged.Paths.Find("/audit/users").Get
+	require.Equal(t, openapi3.SecurityRequirements{{"bearerAuth": []string{"audit:read"}}}, *audit.Security)
+	require.Empty(t, *merged.Paths.Find("/audit/public").Get.Security)
+
+	// The clashing User is renamed and Entry follows it; the shared schema is kept once.
+	schemas := merged.Components.Schemas
+	require.Len(t, schemas, 4)
+	require.Contains(t, schemas, "common_problemdetails_ProblemDetails")
+	require.Equal(t, "#/components/schemas/audit_log_User", schemas["Entry"].Value.Properties["user"].Ref)
+	require.Contains(t, schemas["audit_log_User"].Value.Properties, "actor")
+	require.Contains(t, schemas["User"].Value.Properties, "email")
+
+	require.Equal(t, []string{"Users", "Audit"}, []string{merged.Tags[0].Name, merged.Tags[1].Name})
+}
+
+func TestMergeRejectsDuplicateOperations(t *testing.T) {
+	t.Parallel()
+
+	info := &openapi3.Info{Title: "Platform", Version: "1.0.0"}
+	_, err := Merge(info, []Spec{{Name: "users", Doc: load(t, usersSpec)}, {Name: "copy", Doc: load(t, usersSpec)}})
+	require.ErrorContains(t, err, "copy: GET /users is also defined by users")
+
+	_, err = Merge(info, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,98 @@
+// Package pagination normalizes list paging and builds the page metadata every list endpoint returns.
+package pagination
+
+// Limits shared by every list endpoint.
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Params is a normalized page request: Page is 1-based and PageSize lies in [1, MaxPageSize].
+type Params struct {
+	Page     int
+	PageSize int
+	// Cursor, when set, selects cursor mode: the list resumes after the cursor and Page only labels the envelope.
+	Cursor string
+}
+
+// New normalizes raw values. Pages below 1 become 1, missing sizes DefaultPageSize and oversized ones MaxPageSize.
+func New(page, pageSize int) Params {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+	return Params{Page: page, PageSize: pageSize}
+}
+
+// FromQuery normalizes the optional page and pageSize query parameters of the generated contracts.
+func FromQuery(page, pageSize *int) Params {
+	var p, size int
+	if page != nil {
+		p = *page
+	}
+	if pageSize != nil {
+		size = *pageSize
+	}
+	return New(p, size)
+}
+
+// WithCursor returns a copy of p in cursor mode when cursor is set and non-empty.
+func (p Params) WithCursor(cursor *string) Params {
+	if cursor != nil {
+		p.Cursor = *cursor
+	}
+	return p
+}
+
+// CursorMode reports whether p resumes from a cursor rather than an offset.
+func (p Params) CursorMode() bool {
+	return p.Cursor != ""
+}
+
+// Offset is the number of rows skipped in offset mode.
+func (p Params) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+// Limit is the number of rows to fetch.
+func (p Params) Limit() int {
+	return p.PageSize
+}
+
+// Envelope is the page metadata returned with each list.
+type Envelope struct {
+	Page       int
+	PageSize   int
+	TotalItems int
+	TotalPages int
+	// NextCursor resumes the list after this page in cursor mode; empty on the last page or in offset mode.
+	NextCursor string
+}
+
+// Envelope describes the page p selected out of totalItems.
+func (p Params) Envelope(totalItems int) Envelope {
+	return Envelope{
+		Page:       p.Page,
+		PageSize:   p.PageSize,
+		TotalItems: totalItems,
+		TotalPages: TotalPages(totalItems, p.PageSize),
+	}
+}
+
+// TotalPages is the number of pages of pageSize needed for totalItems; zero items need zero pages.
+func TotalPages(totalItems, pageSize int) int {
+	if totalItems <= 0 || pageSize <= 0 {
+		return 0
+	}
+	return (totalItems + pageSize - 1) / pageSize
+}
+
+// HasNext reports whether a page follows e.
+func (e Envelope) HasNext() bool {
+	return e.NextCursor != "" || e.Page < e.TotalPages
+}
@@ -0,0 +1,35 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClampsParams(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, Params{Page: 1, PageSize: DefaultPageSize}, New(0, 0))
+	require.Equal(t, Params{Page: 3, PageSize: MaxPageSize}, New(3, 500))
+	require.Equal(t, Params{Page: 1, PageSize: DefaultPageSize}, FromQuery(nil, nil))
+
+	page, size := -2, 10
+	params := FromQuery(&page, &size)
+	require.Equal(t, Params{Page: 1, PageSize: 10}, params)
+	require.Equal(t, 0, params.Offset())
+}
+
+func TestEnvelope(t *testing.T) {
+	t.Parallel()
+
+	envelope := New(2, 10).Envelope(21)
+	require.Equal(t, Envelope{Page: 2, PageSize: 10, TotalItems: 21, TotalPages: 3}, envelope)
+	require.True(t, envelope.HasNext())
+
+	require.Equal(t, 0, New(1, 10).Envelope(0).TotalPages)
+	require.False(t, New(3, 10).Envelope(21).HasNext())
+
+	cursor := "abc"
+	require.True(t, New(1, 10).WithCursor(&cursor).CursorMode())
+	require.False(t, New(1, 10).WithCursor(nil).CursorMode())
+}
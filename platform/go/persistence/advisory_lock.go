@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdvisoryLock is a session-level Postgres advisory lock used for leader election: the process holding it keeps a
+// dedicated pooled connection open, and the lock is released when that session ends, so a crashed leader is
+// replaced as soon as its connection drops.
+type AdvisoryLock struct {
+	pool *pgxpool.Pool
+	key  string
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+// NewAdvisoryLock returns a lock identified by key. Processes that use the same key compete for the lock.
+func NewAdvisoryLock(pool *pgxpool.Pool, key string) *AdvisoryLock {
+	if pool == nil {
+		panic("pool is required")
+	}
+	if key == "" {
+		panic("advisory lock key is required")
+	}
+	return &AdvisoryLock{pool: pool, key: key}
+}
+
+// TryAcquire reports whether the lock is held by this process, taking it when it is free. A held lock is
+// confirmed by pinging its session; when the session is gone the lock is lost and taken again if possible.
+func (l *AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err == nil {
+			return true, nil
+		}
+		l.drop(ctx)
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("acquire connection: %w", err)
+	}
+	var acquired bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, l.key).Scan(&acquired); err != nil {
+		conn.Release()
+		return false, fmt.Errorf("try advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Release()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Release gives the lock up when it is held.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+	var released bool
+	err := l.conn.QueryRow(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, l.key).Scan(&released)
+	if err == nil && !released {
+		err = errors.New("advisory lock was not held")
+	}
+	if err != nil {
+		l.drop(ctx)
+		return fmt.Errorf("release advisory lock: %w", err)
+	}
+	l.conn.Release()
+	l.conn = nil
+	return nil
+}
+
+// drop closes the lock's session, which releases the lock server-side if it still exists, and returns the
+// connection to the pool to be discarded.
+func (l *AdvisoryLock) drop(ctx context.Context) {
+	_ = l.conn.Conn().Close(ctx)
+	l.conn.Release()
+	l.conn = nil
+}
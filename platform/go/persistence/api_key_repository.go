@@ -0,0 +1,176 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const APIKeysTable = "api_keys"
+
+// apiKeyTouchInterval limits how often last_used_at is written for a busy key.
+const apiKeyTouchInterval = time.Minute
+
+// APIKey represents a row in the api_keys table. Only the SHA-256 hash of the key secret is stored.
+type APIKey struct {
+	KeyID      uuid.UUID  `db:"key_id" json:"keyId"`
+	UserID     uuid.UUID  `db:"user_id" json:"userId"`
+	Name       string     `db:"name" json:"name"`
+	Scopes     []string   `db:"scopes" json:"scopes"`
+	SecretHash string     `db:"secret_hash" json:"-"`
+	CreatedAt  time.Time  `db:"created_at" json:"createdAt"`
+	ExpiresAt  *time.Time `db:"expires_at" json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `db:"revoked_at" json:"revokedAt,omitempty"`
+}
+
+// ErrAPIKeyNotFound indicates a missing API key, or one owned by another user.
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+// APIKeyStore persists per-user API keys in the tenant schema.
+type APIKeyStore struct {
+	db *SpaceDB
+}
+
+// NewAPIKeyStore returns an API key store working through db. The tables come from the tenant migrations.
+func NewAPIKeyStore(db *SpaceDB) *APIKeyStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &APIKeyStore{db: db}
+}
+
+// CreateAPIKeyParams captures a new API key.
+type CreateAPIKeyParams struct {
+	KeyID      uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Scopes     []string
+	SecretHash string
+	ExpiresAt  *time.Time
+}
+
+const apiKeySelectColumns = "key_id, user_id, name, scopes, secret_hash, created_at, expires_at, last_used_at, revoked_at"
+
+// CreateAPIKey inserts the key; an unknown user returns ErrUserNotFound.
+func (s *APIKeyStore) CreateAPIKey(ctx context.Context, space tenant.Space, params CreateAPIKeyParams) (APIKey, error) {
+	if params.KeyID == uuid.Nil || params.UserID == uuid.Nil || params.SecretHash == "" {
+		return APIKey{}, errors.New("key id, user id and secret hash are required")
+	}
+
+	var key APIKey
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (key_id, user_id, name, scopes, secret_hash, expires_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING %s
+    `, APIKeysTable, apiKeySelectColumns), params.KeyID, params.UserID, params.Name, append([]string{}, params.Scopes...), params.SecretHash, params.ExpiresAt)
+
+		scanned, err := scanAPIKey(row)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("insert api key: %w", err)
+		}
+		key = scanned
+		return nil
+	})
+	if err != nil {
+		return APIKey{}, err
+	}
+	return key, nil
+}
+
+// GetAPIKey returns a key by id, revoked or not.
+func (s *APIKeyStore) GetAPIKey(ctx context.Context, space tenant.Space, keyID uuid.UUID) (APIKey, error) {
+	var key APIKey
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		scanned, err := scanAPIKey(tx.QueryRow(ctx, fmt.Sprintf(`SELECT %s FROM %s WHERE key_id = $1`, apiKeySelectColumns, APIKeysTable), keyID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAPIKeyNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get api key: %w", err)
+		}
+		key = scanned
+		return nil
+	})
+	if err != nil {
+		return APIKey{}, err
+	}
+	return key, nil
+}
+
+// ListUserAPIKeys returns the user's keys that are not revoked, newest first.
+func (s *APIKeyStore) ListUserAPIKeys(ctx context.Context, space tenant.Space, userID uuid.UUID) ([]APIKey, error) {
+	keys := []APIKey{}
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		keys = keys[:0]
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT %s FROM %s
+        WHERE user_id = $1 AND revoked_at IS NULL
+        ORDER BY created_at DESC
+    `, apiKeySelectColumns, APIKeysTable), userID)
+		if err != nil {
+			return fmt.Errorf("list api keys: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			key, err := scanAPIKey(rows)
+			if err != nil {
+				return fmt.Errorf("scan api key: %w", err)
+			}
+			keys = append(keys, key)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes the user's key. Keys of other users, and keys already revoked, return ErrAPIKeyNotFound.
+func (s *APIKeyStore) RevokeAPIKey(ctx context.Context, space tenant.Space, userID, keyID uuid.UUID, at time.Time) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET revoked_at = $3
+        WHERE key_id = $1 AND user_id = $2 AND revoked_at IS NULL
+    `, APIKeysTable), keyID, userID, at.UTC())
+		if err != nil {
+			return fmt.Errorf("revoke api key: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrAPIKeyNotFound
+		}
+		return nil
+	})
+}
+
+// TouchAPIKey records that the key was used at the given time, writing at most once per apiKeyTouchInterval.
+func (s *APIKeyStore) TouchAPIKey(ctx context.Context, space tenant.Space, keyID uuid.UUID, at time.Time) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET last_used_at = $2
+        WHERE key_id = $1 AND (last_used_at IS NULL OR last_used_at < $3)
+    `, APIKeysTable), keyID, at.UTC(), at.UTC().Add(-apiKeyTouchInterval))
+		if err != nil {
+			return fmt.Errorf("touch api key: %w", err)
+		}
+		return nil
+	})
+}
+
+func scanAPIKey(row pgx.Row) (APIKey, error) {
+	var key APIKey
+	err := row.Scan(&key.KeyID, &key.UserID, &key.Name, &key.Scopes, &key.SecretHash, &key.CreatedAt, &key.ExpiresAt, &key.LastUsedAt, &key.RevokedAt)
+	return key, err
+}
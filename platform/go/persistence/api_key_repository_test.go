@@ -0,0 +1,118 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestAPIKeyStoreLifecycle(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping api key store integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA IF NOT EXISTS ` + adminSchema,
+		`CREATE SCHEMA IF NOT EXISTS ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+	} {
+		_, err = pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	users, err := NewUserStore(ctx, spaceDB)
+	require.NoError(t, err)
+	keys := NewAPIKeyStore(spaceDB)
+
+	owner, err := users.CreateUser(ctx, space, CreateUserParams{UserID: uuid.New(), Email: "owner@example.com", FullName: "Owner"})
+	require.NoError(t, err)
+
+	_, err = keys.CreateAPIKey(ctx, space, CreateAPIKeyParams{KeyID: uuid.New(), UserID: uuid.New(), Name: "ci", SecretHash: "hash"})
+	require.ErrorIs(t, err, ErrUserNotFound)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	expiresAt := now.Add(24 * time.Hour)
+	created, err := keys.CreateAPIKey(ctx, space, CreateAPIKeyParams{
+		KeyID:      uuid.New(),
+		UserID:     owner.UserID,
+		Name:       "ci",
+		Scopes:     []string{"users:read"},
+		SecretHash: "hash-1",
+		ExpiresAt:  &expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"users:read"}, created.Scopes)
+	require.Nil(t, created.LastUsedAt)
+
+	require.NoError(t, keys.TouchAPIKey(ctx, space, created.KeyID, now))
+	require.NoError(t, keys.TouchAPIKey(ctx, space, created.KeyID, now.Add(time.Second)))
+	fetched, err := keys.GetAPIKey(ctx, space, created.KeyID)
+	require.NoError(t, err)
+	require.Equal(t, "hash-1", fetched.SecretHash)
+	require.NotNil(t, fetched.LastUsedAt)
+	require.True(t, fetched.LastUsedAt.Equal(now), "touches within a minute are not written")
+
+	listed, err := keys.ListUserAPIKeys(ctx, space, owner.UserID)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	require.ErrorIs(t, keys.RevokeAPIKey(ctx, space, uuid.New(), created.KeyID, now), ErrAPIKeyNotFound)
+	require.NoError(t, keys.RevokeAPIKey(ctx, space, owner.UserID, created.KeyID, now))
+	require.ErrorIs(t, keys.RevokeAPIKey(ctx, space, owner.UserID, created.KeyID, now), ErrAPIKeyNotFound)
+
+	revoked, err := keys.GetAPIKey(ctx, space, created.KeyID)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+
+	listed, err = keys.ListUserAPIKeys(ctx, space, owner.UserID)
+	require.NoError(t, err)
+	require.Empty(t, listed)
+
+	// Deleting the owner removes their keys.
+	require.NoError(t, users.DeleteUser(ctx, space, owner.UserID))
+	_, err = keys.GetAPIKey(ctx, space, created.KeyID)
+	require.ErrorIs(t, err, ErrAPIKeyNotFound)
+}
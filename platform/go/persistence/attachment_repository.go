@@ -0,0 +1,206 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const EntityAttachmentsTable = "entity_attachments"
+
+// ErrAttachmentNotFound indicates a missing entity attachment.
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// EntityAttachment represents a row in the entity_attachments table.
+type EntityAttachment struct {
+	AttachmentID  uuid.UUID
+	TableName     string
+	EntityID      string
+	EntityVersion SemanticVersion
+	FileName      string
+	ContentType   string
+	SizeBytes     int64
+	ObjectPath    string
+	CreatedAt     time.Time
+	CreatedBy     *string
+}
+
+// CreateAttachmentParams captures the fields required to record an entity attachment.
+type CreateAttachmentParams struct {
+	AttachmentID  uuid.UUID
+	TableName     string
+	EntityID      string
+	EntityVersion SemanticVersion
+	FileName      string
+	ContentType   string
+	SizeBytes     int64
+	ObjectPath    string
+	CreatedBy     *string
+}
+
+// AttachmentStore persists the metadata of files attached to entity documents; the files themselves live in object
+// storage at ObjectPath.
+type AttachmentStore struct {
+	db *SpaceDB
+}
+
+// NewAttachmentStore returns a store instance; the table comes from the tenant migrations.
+func NewAttachmentStore(db *SpaceDB) *AttachmentStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &AttachmentStore{db: db}
+}
+
+// CreateAttachment inserts the metadata row of a new attachment and returns the persisted record.
+func (s *AttachmentStore) CreateAttachment(ctx context.Context, space tenant.Space, params CreateAttachmentParams) (EntityAttachment, error) {
+	if params.AttachmentID == uuid.Nil {
+		return EntityAttachment{}, errors.New("attachment id is required")
+	}
+
+	var attachment EntityAttachment
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (attachment_id, table_name, entity_id, entity_version, file_name, content_type, size_bytes, object_path, created_by)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        RETURNING attachment_id, table_name, entity_id, entity_version, file_name, content_type, size_bytes, object_path, created_at, created_by
+    `, EntityAttachmentsTable),
+			params.AttachmentID,
+			params.TableName,
+			params.EntityID,
+			params.EntityVersion.String(),
+			params.FileName,
+			params.ContentType,
+			params.SizeBytes,
+			params.ObjectPath,
+			params.CreatedBy,
+		)
+
+		scanned, scanErr := scanEntityAttachment(row)
+		if scanErr != nil {
+			return fmt.Errorf("insert attachment: %w", scanErr)
+		}
+		attachment = scanned
+		return nil
+	})
+	if err != nil {
+		return EntityAttachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// ListAttachments returns a page of the attachments of an entity, newest first, and the total count.
+func (s *AttachmentStore) ListAttachments(ctx context.Context, space tenant.Space, tableName, entityID string, limit, offset int) ([]EntityAttachment, int, error) {
+	var (
+		attachments []EntityAttachment
+		total       int
+	)
+	err := s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE table_name = $1 AND entity_id = $2`, EntityAttachmentsTable), tableName, entityID).Scan(&total); err != nil {
+			return fmt.Errorf("count attachments: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT attachment_id, table_name, entity_id, entity_version, file_name, content_type, size_bytes, object_path, created_at, created_by
+        FROM %s
+        WHERE table_name = $1 AND entity_id = $2
+        ORDER BY created_at DESC, attachment_id
+        LIMIT $3 OFFSET $4
+    `, EntityAttachmentsTable), tableName, entityID, limit, offset)
+		if err != nil {
+			return fmt.Errorf("list attachments: %w", err)
+		}
+		defer rows.Close()
+
+		attachments = make([]EntityAttachment, 0)
+		for rows.Next() {
+			attachment, scanErr := scanEntityAttachment(rows)
+			if scanErr != nil {
+				return fmt.Errorf("scan attachment: %w", scanErr)
+			}
+			attachments = append(attachments, attachment)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return attachments, total, nil
+}
+
+// GetAttachment returns a single attachment of an entity.
+func (s *AttachmentStore) GetAttachment(ctx context.Context, space tenant.Space, tableName, entityID string, id uuid.UUID) (EntityAttachment, error) {
+	var attachment EntityAttachment
+	err := s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT attachment_id, table_name, entity_id, entity_version, file_name, content_type, size_bytes, object_path, created_at, created_by
+        FROM %s
+        WHERE attachment_id = $1 AND table_name = $2 AND entity_id = $3
+    `, EntityAttachmentsTable), id, tableName, entityID)
+
+		scanned, scanErr := scanEntityAttachment(row)
+		if scanErr != nil {
+			if errors.Is(scanErr, pgx.ErrNoRows) {
+				return ErrAttachmentNotFound
+			}
+			return scanErr
+		}
+		attachment = scanned
+		return nil
+	})
+	if err != nil {
+		return EntityAttachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// DeleteAttachment removes the metadata row of an attachment.
+func (s *AttachmentStore) DeleteAttachment(ctx context.Context, space tenant.Space, tableName, entityID string, id uuid.UUID) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE attachment_id = $1 AND table_name = $2 AND entity_id = $3`, EntityAttachmentsTable), id, tableName, entityID)
+		if err != nil {
+			return fmt.Errorf("delete attachment: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrAttachmentNotFound
+		}
+		return nil
+	})
+}
+
+func scanEntityAttachment(scanner rowScanner) (EntityAttachment, error) {
+	var (
+		attachment    EntityAttachment
+		entityVersion string
+	)
+	if err := scanner.Scan(
+		&attachment.AttachmentID,
+		&attachment.TableName,
+		&attachment.EntityID,
+		&entityVersion,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.SizeBytes,
+		&attachment.ObjectPath,
+		&attachment.CreatedAt,
+		&attachment.CreatedBy,
+	); err != nil {
+		return EntityAttachment{}, err
+	}
+
+	version, err := ParseSemanticVersion(entityVersion)
+	if err != nil {
+		return EntityAttachment{}, fmt.Errorf("parse entity version %q: %w", entityVersion, err)
+	}
+	attachment.EntityVersion = version
+	return attachment, nil
+}
@@ -17,8 +17,10 @@ import (
 //  2. platform/entity_schemas.sql
 //  3. platform/tenants.sql
 //
-// SQL is embedded at build time so binaries stay self-contained. The helper is
-// idempotent and intended for CLI bootstrap and tests.
+// The admin and tenant migrations are then applied to the admin schema (see
+// Migrator.MigrateAdmin). SQL is embedded at build time so binaries stay
+// self-contained. The helper is idempotent and intended for CLI bootstrap and
+// tests.
 func BootstrapAdminSchema(ctx context.Context, pool *pgxpool.Pool, adminSchema string) error {
 	if pool == nil {
 		return fmt.Errorf("bootstrap admin schema: pool is required")
@@ -47,5 +49,12 @@ func BootstrapAdminSchema(ctx context.Context, pool *pgxpool.Pool, adminSchema s
 		}
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	if _, err := NewMigrator(pool).MigrateAdmin(ctx, adminSchema); err != nil {
+		return fmt.Errorf("migrate admin schema: %w", err)
+	}
+	return nil
 }
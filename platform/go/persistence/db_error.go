@@ -2,6 +2,8 @@ package persistence
 
 import (
 	"errors"
+	"io"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgconn"
 )
@@ -15,3 +17,47 @@ func isUniqueViolation(err error) bool {
 	}
 	return false
 }
+
+const foreignKeyViolationCode = "23503"
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == foreignKeyViolationCode
+	}
+	return false
+}
+
+const (
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+	// connectionExceptionClass is the SQLSTATE class of connection failures (08xxx).
+	connectionExceptionClass = "08"
+)
+
+// isTransactionRollback reports whether the server aborted the transaction because of a serialization failure or
+// a deadlock, in which case none of it was applied and it can be rerun.
+func isTransactionRollback(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode
+	}
+	return false
+}
+
+// isTransientDBError reports whether err is worth retrying: a transaction rollback, a connection failure reported by
+// the server or the driver, or a connection that dropped mid-transaction.
+func isTransientDBError(err error) bool {
+	if isTransactionRollback(err) {
+		return true
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, connectionExceptionClass)
+	}
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || pgconn.SafeToRetry(err) {
+		return true
+	}
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,326 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Anchoring tables of a tenant (tenant migration).
+const (
+	EntityAnchorBatchesTable = "entity_anchor_batches"
+	EntityAnchorLeavesTable  = "entity_anchor_leaves"
+)
+
+// Anchor batch statuses.
+const (
+	AnchorBatchPending  = "pending"
+	AnchorBatchAnchored = "anchored"
+)
+
+var (
+	// ErrAnchorLeafNotFound indicates an entity version that has not been added to an anchor batch yet.
+	ErrAnchorLeafNotFound = errors.New("entity version not anchored")
+	// ErrAnchorLeafTaken indicates a version was added to another batch concurrently; the new batch was not stored.
+	ErrAnchorLeafTaken = errors.New("entity version already anchored")
+)
+
+// EntityAnchorLeaf is an entity version hash included in an anchor batch at Index.
+type EntityAnchorLeaf struct {
+	TableName string
+	EntityID  string
+	Version   SemanticVersion
+	Hash      string
+	Index     int
+}
+
+// EntityAnchorBatch is a row of entity_anchor_batches: the Merkle root of a group of entity version hashes and
+// where the anchor recorded it.
+type EntityAnchorBatch struct {
+	BatchID    uuid.UUID
+	Anchor     string
+	MerkleRoot string
+	LeafCount  int
+	Status     string
+	Reference  *string
+	Attempts   int
+	LastError  *string
+	CreatedAt  time.Time
+	AnchoredAt *time.Time
+}
+
+// EntityAnchorProof carries the batch an entity version was anchored in, together with every leaf of the batch in
+// index order, from which the Merkle proof of Leaf is rebuilt.
+type EntityAnchorProof struct {
+	Batch  EntityAnchorBatch
+	Leaf   EntityAnchorLeaf
+	Leaves []EntityAnchorLeaf
+}
+
+// EntityAnchorStore keeps the anchor batches of a tenant and the entity versions each one covers. Computing Merkle
+// roots and submitting them is left to the caller (see the anchoring package).
+type EntityAnchorStore struct {
+	db *SpaceDB
+}
+
+// NewEntityAnchorStore returns a store instance; its tables come from the tenant migrations.
+func NewEntityAnchorStore(db *SpaceDB) *EntityAnchorStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &EntityAnchorStore{db: db}
+}
+
+const anchorBatchColumns = `batch_id, anchor, merkle_root, leaf_count, status, reference, attempts, last_error, created_at, anchored_at`
+
+// UnanchoredVersions returns up to limit entity versions of space, from every catalog entity table, that are not in an
+// anchor batch yet, oldest first within each table.
+func (s *EntityAnchorStore) UnanchoredVersions(ctx context.Context, space tenant.Space, limit int) ([]EntityAnchorLeaf, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var leaves []EntityAnchorLeaf
+	err := s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT DISTINCT sr.table_name
+			FROM schema_repository sr
+			JOIN pg_catalog.pg_tables t ON t.schemaname = $1 AND t.tablename = sr.table_name
+			ORDER BY sr.table_name
+		`, space.SchemaName)
+		if err != nil {
+			return fmt.Errorf("list entity tables: %w", err)
+		}
+		tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("list entity tables: %w", err)
+		}
+
+		for _, table := range tables {
+			remaining := limit - len(leaves)
+			if remaining == 0 {
+				break
+			}
+
+			rows, err := tx.Query(ctx, fmt.Sprintf(`
+				SELECT e.entity_id, e.entity_version, e.hash
+				FROM %s e
+				WHERE NOT EXISTS (
+					SELECT 1 FROM %s l
+					WHERE l.table_name = $1 AND l.entity_id = e.entity_id AND l.entity_version = e.entity_version
+				)
+				ORDER BY e.created_at, e.entity_id, e.entity_version
+				LIMIT $2
+			`, pgx.Identifier{table}.Sanitize(), EntityAnchorLeavesTable), table, remaining)
+			if err != nil {
+				return fmt.Errorf("list unanchored versions of %s: %w", table, err)
+			}
+			found, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (EntityAnchorLeaf, error) {
+				leaf := EntityAnchorLeaf{TableName: table}
+				var version string
+				if err := row.Scan(&leaf.EntityID, &version, &leaf.Hash); err != nil {
+					return EntityAnchorLeaf{}, err
+				}
+				parsed, err := ParseSemanticVersion(version)
+				if err != nil {
+					return EntityAnchorLeaf{}, fmt.Errorf("parse entity version %q: %w", version, err)
+				}
+				leaf.Version = parsed
+				return leaf, nil
+			})
+			if err != nil {
+				return fmt.Errorf("list unanchored versions of %s: %w", table, err)
+			}
+			leaves = append(leaves, found...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range leaves {
+		leaves[i].Index = i
+	}
+	return leaves, nil
+}
+
+// CreateAnchorBatch stores a pending batch with its leaves, at the index each leaf carries. It returns
+// ErrAnchorLeafTaken, storing nothing, when one of the versions was added to another batch in the meantime.
+func (s *EntityAnchorStore) CreateAnchorBatch(ctx context.Context, space tenant.Space, batch EntityAnchorBatch, leaves []EntityAnchorLeaf) (EntityAnchorBatch, error) {
+	if batch.BatchID == uuid.Nil {
+		return EntityAnchorBatch{}, errors.New("batch id is required")
+	}
+	if len(leaves) == 0 {
+		return EntityAnchorBatch{}, errors.New("anchor batch needs at least one leaf")
+	}
+
+	var created EntityAnchorBatch
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+			INSERT INTO %s (batch_id, anchor, merkle_root, leaf_count, status)
+			VALUES ($1, $2, $3, $4, '%s')
+			RETURNING `+anchorBatchColumns, EntityAnchorBatchesTable, AnchorBatchPending),
+			batch.BatchID, batch.Anchor, batch.MerkleRoot, len(leaves))
+		scanned, err := scanAnchorBatch(row)
+		if err != nil {
+			return fmt.Errorf("insert anchor batch: %w", err)
+		}
+		created = scanned
+
+		rows := make([][]any, 0, len(leaves))
+		for _, leaf := range leaves {
+			rows = append(rows, []any{leaf.TableName, leaf.EntityID, leaf.Version.String(), leaf.Hash, batch.BatchID, leaf.Index})
+		}
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{EntityAnchorLeavesTable},
+			[]string{"table_name", "entity_id", "entity_version", "hash", "batch_id", "leaf_index"},
+			pgx.CopyFromRows(rows)); err != nil {
+			if isUniqueViolation(err) {
+				return ErrAnchorLeafTaken
+			}
+			return fmt.Errorf("insert anchor leaves: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return EntityAnchorBatch{}, err
+	}
+	return created, nil
+}
+
+// PendingAnchorBatches returns up to limit batches the anchor has not accepted yet, oldest first.
+func (s *EntityAnchorStore) PendingAnchorBatches(ctx context.Context, space tenant.Space, limit int) ([]EntityAnchorBatch, error) {
+	var batches []EntityAnchorBatch
+	err := s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+			SELECT `+anchorBatchColumns+`
+			FROM %s
+			WHERE status = '%s'
+			ORDER BY created_at
+			LIMIT $1
+		`, EntityAnchorBatchesTable, AnchorBatchPending), limit)
+		if err != nil {
+			return fmt.Errorf("list pending anchor batches: %w", err)
+		}
+		batches, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (EntityAnchorBatch, error) {
+			return scanAnchorBatch(row)
+		})
+		if err != nil {
+			return fmt.Errorf("list pending anchor batches: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
+
+// MarkAnchorBatchAnchored records where the anchor recorded a batch's root.
+func (s *EntityAnchorStore) MarkAnchorBatchAnchored(ctx context.Context, space tenant.Space, batchID uuid.UUID, reference string, anchoredAt time.Time) error {
+	return s.updateAnchorBatch(ctx, space, batchID, fmt.Sprintf(`
+		UPDATE %s
+		SET status = '%s', reference = $2, anchored_at = $3, attempts = attempts + 1, last_error = NULL
+		WHERE batch_id = $1
+	`, EntityAnchorBatchesTable, AnchorBatchAnchored), reference, anchoredAt)
+}
+
+// RecordAnchorBatchFailure keeps a batch pending and records why submitting it failed.
+func (s *EntityAnchorStore) RecordAnchorBatchFailure(ctx context.Context, space tenant.Space, batchID uuid.UUID, message string) error {
+	return s.updateAnchorBatch(ctx, space, batchID, fmt.Sprintf(`
+		UPDATE %s SET attempts = attempts + 1, last_error = $2 WHERE batch_id = $1
+	`, EntityAnchorBatchesTable), message)
+}
+
+func (s *EntityAnchorStore) updateAnchorBatch(ctx context.Context, space tenant.Space, batchID uuid.UUID, stmt string, args ...any) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, stmt, append([]any{batchID}, args...)...)
+		if err != nil {
+			return fmt.Errorf("update anchor batch: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("anchor batch %s not found", batchID)
+		}
+		return nil
+	})
+}
+
+// AnchorProof returns the batch an entity version was anchored in with all the batch's leaves. It returns
+// ErrAnchorLeafNotFound when the version is not in a batch yet.
+func (s *EntityAnchorStore) AnchorProof(ctx context.Context, space tenant.Space, tableName, entityID string, version SemanticVersion) (EntityAnchorProof, error) {
+	normalized, err := NormalizeEntityIdentifier(entityID)
+	if err != nil {
+		return EntityAnchorProof{}, err
+	}
+
+	var proof EntityAnchorProof
+	err = s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		var batchID uuid.UUID
+		err := tx.QueryRow(ctx, fmt.Sprintf(`
+			SELECT batch_id FROM %s WHERE table_name = $1 AND entity_id = $2 AND entity_version = $3
+		`, EntityAnchorLeavesTable), tableName, normalized, version.String()).Scan(&batchID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAnchorLeafNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("find anchor leaf: %w", err)
+		}
+
+		row := tx.QueryRow(ctx, fmt.Sprintf(`SELECT `+anchorBatchColumns+` FROM %s WHERE batch_id = $1`, EntityAnchorBatchesTable), batchID)
+		if proof.Batch, err = scanAnchorBatch(row); err != nil {
+			return fmt.Errorf("load anchor batch: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+			SELECT table_name, entity_id, entity_version, hash, leaf_index
+			FROM %s
+			WHERE batch_id = $1
+			ORDER BY leaf_index
+		`, EntityAnchorLeavesTable), batchID)
+		if err != nil {
+			return fmt.Errorf("load anchor leaves: %w", err)
+		}
+		proof.Leaves, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (EntityAnchorLeaf, error) {
+			var (
+				leaf    EntityAnchorLeaf
+				version string
+			)
+			if err := row.Scan(&leaf.TableName, &leaf.EntityID, &version, &leaf.Hash, &leaf.Index); err != nil {
+				return EntityAnchorLeaf{}, err
+			}
+			parsed, err := ParseSemanticVersion(version)
+			if err != nil {
+				return EntityAnchorLeaf{}, fmt.Errorf("parse entity version %q: %w", version, err)
+			}
+			leaf.Version = parsed
+			return leaf, nil
+		})
+		if err != nil {
+			return fmt.Errorf("load anchor leaves: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return EntityAnchorProof{}, err
+	}
+
+	for _, leaf := range proof.Leaves {
+		if leaf.TableName == tableName && leaf.EntityID == normalized && leaf.Version == version {
+			proof.Leaf = leaf
+			return proof, nil
+		}
+	}
+	return EntityAnchorProof{}, ErrAnchorLeafNotFound
+}
+
+func scanAnchorBatch(row pgx.Row) (EntityAnchorBatch, error) {
+	var batch EntityAnchorBatch
+	err := row.Scan(&batch.BatchID, &batch.Anchor, &batch.MerkleRoot, &batch.LeafCount, &batch.Status, &batch.Reference,
+		&batch.Attempts, &batch.LastError, &batch.CreatedAt, &batch.AnchoredAt)
+	return batch, err
+}
@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestEntityAnchorStoreBatchesUnanchoredVersions(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping entity anchor integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, adminSchema))
+
+	hash := strings.Repeat("a", 64)
+	categoryID, schemaID := uuid.New(), uuid.New()
+	exec := func(stmt string, args ...any) {
+		_, err := pool.Exec(ctx, stmt, args...)
+		require.NoError(t, err)
+	}
+	exec(`INSERT INTO schema_categories (category_id, name, slug) VALUES ($1, 'Orders', 'orders')`, categoryID)
+	exec(`
+		INSERT INTO schema_repository (schema_id, schema_version, schema_definition, hash, table_name, slug, category_id, is_active)
+		VALUES ($1, '1.0.0', '{"type": "object"}', $2, 'orders_entities', 'orders', $3, TRUE)
+	`, schemaID, hash, categoryID)
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+		`GRANT SELECT, REFERENCES ON ` + adminSchema + `.schema_repository TO ` + role,
+	} {
+		exec(stmt)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	entityRepo := &EntityRepository{tableName: "orders_entities", tableIdent: pgx.Identifier{"orders_entities"}.Sanitize()}
+	require.NoError(t, spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := entityRepo.ensureEntityTable(ctx, tx)
+		return err
+	}))
+	exec(`
+		INSERT INTO `+pgx.Identifier{schema, "orders_entities"}.Sanitize()+` (entity_id, entity_version, schema_id, schema_version, payload, hash, is_active)
+		VALUES ('o-1', '1.0.0', $1, '1.0.0', '{}', $2, FALSE),
+		       ('o-1', '1.0.1', $1, '1.0.0', '{}', $2, TRUE),
+		       ('o-2', '1.0.0', $1, '1.0.0', '{}', $2, TRUE)
+	`, schemaID, hash)
+
+	store := NewEntityAnchorStore(spaceDB)
+
+	leaves, err := store.UnanchoredVersions(ctx, space, 2)
+	require.NoError(t, err)
+	require.Len(t, leaves, 2)
+	require.Equal(t, []int{0, 1}, []int{leaves[0].Index, leaves[1].Index})
+
+	batch, err := store.CreateAnchorBatch(ctx, space, EntityAnchorBatch{BatchID: uuid.New(), Anchor: "mock", MerkleRoot: strings.Repeat("b", 64)}, leaves)
+	require.NoError(t, err)
+	require.Equal(t, AnchorBatchPending, batch.Status)
+	require.Equal(t, 2, batch.LeafCount)
+
+	_, err = store.CreateAnchorBatch(ctx, space, EntityAnchorBatch{BatchID: uuid.New(), Anchor: "mock", MerkleRoot: strings.Repeat("c", 64)}, leaves[:1])
+	require.ErrorIs(t, err, ErrAnchorLeafTaken)
+
+	rest, err := store.UnanchoredVersions(ctx, space, 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 1, "versions already in a batch are skipped")
+
+	pending, err := store.PendingAnchorBatches(ctx, space, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	require.NoError(t, store.RecordAnchorBatchFailure(ctx, space, batch.BatchID, "ledger unavailable"))
+	require.NoError(t, store.MarkAnchorBatchAnchored(ctx, space, batch.BatchID, "tx-1", time.Now()))
+	pending, err = store.PendingAnchorBatches(ctx, space, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	proof, err := store.AnchorProof(ctx, space, leaves[1].TableName, leaves[1].EntityID, leaves[1].Version)
+	require.NoError(t, err)
+	require.Equal(t, AnchorBatchAnchored, proof.Batch.Status)
+	require.Equal(t, "tx-1", *proof.Batch.Reference)
+	require.Equal(t, 2, proof.Batch.Attempts)
+	require.Nil(t, proof.Batch.LastError)
+	require.Equal(t, leaves[1], proof.Leaf)
+	require.Len(t, proof.Leaves, 2)
+
+	_, err = store.AnchorProof(ctx, space, rest[0].TableName, rest[0].EntityID, rest[0].Version)
+	require.ErrorIs(t, err, ErrAnchorLeafNotFound)
+}
@@ -0,0 +1,291 @@
+package persistence
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrArchivedVersionMissing indicates an archived entity version could not be found in its archive object.
+var ErrArchivedVersionMissing = errors.New("archived entity version missing")
+
+// EntityArchiveObjects reads and writes archive objects by their full path in the environment bucket;
+// storage.ObjectStore satisfies it.
+type EntityArchiveObjects interface {
+	NewWriter(ctx context.Context, fullPath string) (io.WriteCloser, error)
+	NewReader(ctx context.Context, fullPath string) (io.ReadCloser, error)
+}
+
+// EntityArchiveReader loads the payload of an archived entity version, see EntityArchiveStore.
+type EntityArchiveReader interface {
+	LoadArchivedPayload(ctx context.Context, location string, entityID string, version SemanticVersion) (json.RawMessage, error)
+}
+
+// EntityArchiving configures the archival of cold entity versions: superseded, non-deleted versions created more
+// than MinAge ago are moved to the tenant's storage prefix in batches of BatchSize (default 500). Zero MinAge
+// disables archiving.
+type EntityArchiving struct {
+	MinAge    time.Duration
+	BatchSize int
+}
+
+// EntityArchiveReport lists the archive objects written for one entity table and the versions moved into them.
+type EntityArchiveReport struct {
+	Table    string
+	Objects  []string
+	Versions int
+}
+
+// EntityArchiveStore moves cold entity versions out of the tenant tables into gzipped NDJSON objects under
+// "<base prefix>archive/entities/<table>/". Each archived row stays behind as a stub that keeps its metadata and
+// hash, with an empty payload and archive_location pointing at the object holding the original document.
+type EntityArchiveStore struct {
+	db      *SpaceDB
+	objects EntityArchiveObjects
+	cfg     EntityArchiving
+	now     func() time.Time
+}
+
+// NewEntityArchiveStore constructs an archive store with defaults applied to cfg.
+func NewEntityArchiveStore(db *SpaceDB, objects EntityArchiveObjects, cfg EntityArchiving) *EntityArchiveStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	if objects == nil {
+		panic("archive object store is required")
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+	return &EntityArchiveStore{db: db, objects: objects, cfg: cfg, now: time.Now}
+}
+
+// archivedEntityLine is one NDJSON line of an archive object.
+type archivedEntityLine struct {
+	EntityID      string          `json:"entityId"`
+	EntityVersion SemanticVersion `json:"entityVersion"`
+	SchemaID      uuid.UUID       `json:"schemaId"`
+	SchemaVersion SemanticVersion `json:"schemaVersion"`
+	Hash          string          `json:"hash"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CreatedBy     *string         `json:"createdBy,omitempty"`
+}
+
+// ArchiveVersions archives the cold versions of every catalog entity table in space, one transaction per batch so
+// row locks are held only while a single object is written. A failing table does not stop the others; the first
+// error is returned along with the reports of the tables that were archived.
+func (s *EntityArchiveStore) ArchiveVersions(ctx context.Context, space tenant.Space) ([]EntityArchiveReport, error) {
+	if s.cfg.MinAge <= 0 {
+		return nil, nil
+	}
+
+	var tables []string
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT DISTINCT sr.table_name
+			FROM schema_repository sr
+			JOIN pg_catalog.pg_tables t ON t.schemaname = $1 AND t.tablename = sr.table_name
+			ORDER BY sr.table_name
+		`, space.SchemaName)
+		if err != nil {
+			return fmt.Errorf("list entity tables: %w", err)
+		}
+		tables, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("list entity tables: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := s.now().UTC().Add(-s.cfg.MinAge)
+	reports := make([]EntityArchiveReport, 0, len(tables))
+	var firstErr error
+	for _, table := range tables {
+		report := EntityArchiveReport{Table: table}
+		for {
+			location, archived, err := s.archiveBatch(ctx, space, table, cutoff)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("archive versions of %s: %w", table, err)
+				}
+				break
+			}
+			if archived == 0 {
+				break
+			}
+			report.Objects = append(report.Objects, location)
+			report.Versions += archived
+			if archived < s.cfg.BatchSize {
+				break
+			}
+		}
+		if len(report.Objects) > 0 {
+			reports = append(reports, report)
+		}
+	}
+	return reports, firstErr
+}
+
+// archiveBatch writes the next batch of cold versions of table to a new object and stubs their rows. Rows locked by
+// a concurrent run are skipped rather than waited for.
+func (s *EntityArchiveStore) archiveBatch(ctx context.Context, space tenant.Space, table string, cutoff time.Time) (string, int, error) {
+	tableIdent := pgx.Identifier{table}.Sanitize()
+
+	var (
+		location string
+		archived int
+	)
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		location, archived = "", 0
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+			SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active
+			FROM %s
+			WHERE NOT is_active AND NOT is_deleted AND archive_location IS NULL AND created_at < $1
+			ORDER BY created_at, entity_id, entity_version
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`, tableIdent), cutoff, s.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("select cold versions: %w", err)
+		}
+		records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (EntityRecord, error) {
+			return scanEntityRecord(row)
+		})
+		if err != nil {
+			return fmt.Errorf("select cold versions: %w", err)
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		key := fmt.Sprintf("archive/entities/%s/%s-%s.ndjson.gz", table, s.now().UTC().Format("20060102T150405Z"), uuid.NewString())
+		fullPath, err := entityArchivePath(space, key)
+		if err != nil {
+			return err
+		}
+		if err := s.writeArchive(ctx, fullPath, records); err != nil {
+			return err
+		}
+
+		entityIDs := make([]string, len(records))
+		versions := make([]string, len(records))
+		for i, record := range records {
+			entityIDs[i] = record.EntityID
+			versions[i] = record.EntityVersion.String()
+		}
+		// A rollback from here on leaves the object orphaned; the rows keep their payload and are archived again.
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %s t
+			SET payload = '{}'::jsonb,
+			    archive_location = $1
+			FROM unnest($2::text[], $3::text[]) AS a(entity_id, entity_version)
+			WHERE t.entity_id = a.entity_id AND t.entity_version = a.entity_version
+		`, tableIdent), fullPath, entityIDs, versions)
+		if err != nil {
+			return fmt.Errorf("stub archived versions: %w", err)
+		}
+		location, archived = fullPath, int(tag.RowsAffected())
+		return nil
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return location, archived, nil
+}
+
+// writeArchive stores records as gzipped NDJSON at fullPath. The object is discarded when writing fails.
+func (s *EntityArchiveStore) writeArchive(ctx context.Context, fullPath string, records []EntityRecord) error {
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := s.objects.NewWriter(writeCtx, fullPath)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	enc := json.NewEncoder(gz)
+	for _, record := range records {
+		line := archivedEntityLine{
+			EntityID:      record.EntityID,
+			EntityVersion: record.EntityVersion,
+			SchemaID:      record.SchemaID,
+			SchemaVersion: record.SchemaVersion,
+			Hash:          record.Hash,
+			Payload:       record.Payload,
+			CreatedAt:     record.CreatedAt,
+			CreatedBy:     record.CreatedBy,
+		}
+		if err := enc.Encode(line); err != nil {
+			cancel()
+			_ = w.Close()
+			return fmt.Errorf("write archive %s: %w", fullPath, err)
+		}
+	}
+	if err := gz.Close(); err != nil {
+		cancel()
+		_ = w.Close()
+		return fmt.Errorf("write archive %s: %w", fullPath, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("store archive %s: %w", fullPath, err)
+	}
+	return nil
+}
+
+// LoadArchivedPayload reads the payload of the given entity version from the archive object at location.
+func (s *EntityArchiveStore) LoadArchivedPayload(ctx context.Context, location string, entityID string, version SemanticVersion) (json.RawMessage, error) {
+	r, err := s.objects.NewReader(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("read archive %s: %w", location, err)
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var line archivedEntityLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return nil, fmt.Errorf("read archive %s: %w", location, err)
+		}
+		if line.EntityID == entityID && line.EntityVersion == version {
+			return line.Payload, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read archive %s: %w", location, err)
+	}
+	return nil, fmt.Errorf("%w: %s@%s in %s", ErrArchivedVersionMissing, entityID, version, location)
+}
+
+// entityArchivePath prefixes key with the tenant base prefix, like storage.ResolveObjectPath.
+func entityArchivePath(space tenant.Space, key string) (string, error) {
+	prefix := space.BasePrefix
+	if prefix == "" {
+		return "", errors.New("tenant base prefix is missing")
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix + key, nil
+}
@@ -0,0 +1,194 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// memoryObjects keeps archive objects in memory; like the real stores, cancelled writes are discarded.
+type memoryObjects struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemoryObjects() *memoryObjects {
+	return &memoryObjects{objects: map[string][]byte{}}
+}
+
+type memoryObjectWriter struct {
+	ctx   context.Context
+	store *memoryObjects
+	path  string
+	buf   bytes.Buffer
+}
+
+func (w *memoryObjectWriter) Write(p []byte) (int, error) { return w.buf.Write(p) }
+
+func (w *memoryObjectWriter) Close() error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+	w.store.objects[w.path] = w.buf.Bytes()
+	return nil
+}
+
+func (m *memoryObjects) NewWriter(ctx context.Context, fullPath string) (io.WriteCloser, error) {
+	return &memoryObjectWriter{ctx: ctx, store: m, path: fullPath}, nil
+}
+
+func (m *memoryObjects) NewReader(_ context.Context, fullPath string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[fullPath]
+	if !ok {
+		return nil, fmt.Errorf("object %s not found", fullPath)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func TestEntityArchiveStoreLoadsArchivedPayload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objects := newMemoryObjects()
+	store := &EntityArchiveStore{objects: objects, now: time.Now}
+
+	records := []EntityRecord{
+		{EntityID: "card-1", EntityVersion: SemanticVersion{Major: 1}, Payload: json.RawMessage(`{"name":"first"}`)},
+		{EntityID: "card-1", EntityVersion: SemanticVersion{Major: 1, Patch: 1}, Payload: json.RawMessage(`{"name":"second"}`)},
+	}
+	require.NoError(t, store.writeArchive(ctx, "dev/acme/archive/entities/cards_entities/a.ndjson.gz", records))
+
+	payload, err := store.LoadArchivedPayload(ctx, "dev/acme/archive/entities/cards_entities/a.ndjson.gz", "card-1", SemanticVersion{Major: 1, Patch: 1})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"second"}`, string(payload))
+
+	_, err = store.LoadArchivedPayload(ctx, "dev/acme/archive/entities/cards_entities/a.ndjson.gz", "card-2", SemanticVersion{Major: 1})
+	require.ErrorIs(t, err, ErrArchivedVersionMissing)
+
+	path, err := entityArchivePath(tenant.Space{BasePrefix: "dev/acme"}, "archive/x")
+	require.NoError(t, err)
+	require.Equal(t, "dev/acme/archive/x", path)
+	_, err = entityArchivePath(tenant.Space{}, "archive/x")
+	require.Error(t, err)
+}
+
+func TestEntityArchiveStoreArchivesColdVersions(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping entity archive integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schemaID := uuid.New()
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + adminSchema,
+		`CREATE TABLE ` + adminSchema + `.schema_repository (schema_id UUID, schema_version TEXT, table_name TEXT, PRIMARY KEY (schema_id, schema_version))`,
+		`INSERT INTO ` + adminSchema + `.schema_repository VALUES ('` + schemaID.String() + `', '1.0.0', 'cards_entities')`,
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+		`GRANT SELECT, REFERENCES ON ` + adminSchema + `.schema_repository TO ` + role,
+	} {
+		_, err := pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role, BasePrefix: "dev/acme-12345678/"}
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	objects := newMemoryObjects()
+	store := NewEntityArchiveStore(spaceDB, objects, EntityArchiving{MinAge: 30 * 24 * time.Hour, BatchSize: 2})
+	entityRepo := &EntityRepository{db: spaceDB, tableName: "cards_entities", tableIdent: pgx.Identifier{"cards_entities"}.Sanitize(), archive: store}
+
+	old, recent := time.Now().AddDate(0, 0, -60), time.Now().AddDate(0, 0, -1)
+	hash := strings.Repeat("a", 64)
+	err = spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if _, err := entityRepo.ensureEntityTable(ctx, tx); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO cards_entities (entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, is_active, is_deleted)
+			VALUES ('card-1', '1.0.0', $1, '1.0.0', '{"v":0}', $2, $3, FALSE, FALSE),
+			       ('card-1', '1.0.1', $1, '1.0.0', '{"v":1}', $2, $3, FALSE, FALSE),
+			       ('card-1', '1.0.2', $1, '1.0.0', '{"v":2}', $2, $3, FALSE, FALSE),
+			       ('card-1', '1.0.3', $1, '1.0.0', '{"v":3}', $2, $4, FALSE, FALSE),
+			       ('card-1', '1.0.4', $1, '1.0.0', '{"v":4}', $2, $4, TRUE, FALSE),
+			       ('card-2', '1.0.0', $1, '1.0.0', '{"v":0}', $2, $3, FALSE, TRUE)
+		`, schemaID, hash, old, recent)
+		return err
+	})
+	require.NoError(t, err)
+
+	reports, err := store.ArchiveVersions(ctx, space)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, "cards_entities", reports[0].Table)
+	require.Equal(t, 3, reports[0].Versions)
+	require.Len(t, reports[0].Objects, 2)
+	for _, object := range reports[0].Objects {
+		require.True(t, strings.HasPrefix(object, "dev/acme-12345678/archive/entities/cards_entities/"), object)
+	}
+
+	var stubs int
+	require.NoError(t, pool.QueryRow(ctx, `SELECT COUNT(*) FROM `+schema+`.cards_entities WHERE archive_location IS NOT NULL AND payload = '{}'::jsonb`).Scan(&stubs))
+	require.Equal(t, 3, stubs)
+
+	// Version reads load archived payloads transparently; recent history and deleted versions stay in place.
+	record, err := entityRepo.GetEntityVersion(ctx, space, "card-1", SemanticVersion{Major: 1, Patch: 1})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"v":1}`, string(record.Payload))
+	require.Equal(t, hash, record.Hash)
+	record, err = entityRepo.GetEntityVersion(ctx, space, "card-1", SemanticVersion{Major: 1, Patch: 3})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"v":3}`, string(record.Payload))
+
+	reports, err = store.ArchiveVersions(ctx, space)
+	require.NoError(t, err)
+	require.Empty(t, reports)
+}
@@ -0,0 +1,196 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EntityChangeChannel is the Postgres NOTIFY channel carrying entity changes of every tenant.
+const EntityChangeChannel = "palmyra_entity_changes"
+
+// entityChangeBuffer bounds the number of undelivered changes per subscriber.
+const entityChangeBuffer = 64
+
+// EntityChange is the lightweight notification broadcast after an entity write commits.
+// Payloads are deliberately left out to stay well below the 8000 byte NOTIFY limit.
+type EntityChange struct {
+	Schema        string    `json:"schema"`
+	Type          string    `json:"type"`
+	TableName     string    `json:"tableName"`
+	EntityID      string    `json:"entityId"`
+	EntityVersion string    `json:"entityVersion,omitempty"`
+	OccurredAt    time.Time `json:"occurredAt"`
+}
+
+// EntityChangeNotifier implements EntityEventSink by issuing pg_notify on EntityChangeChannel.
+// Postgres only delivers notifications once the surrounding transaction commits.
+type EntityChangeNotifier struct{}
+
+// NewEntityChangeNotifier returns a notifier sink.
+func NewEntityChangeNotifier() *EntityChangeNotifier {
+	return &EntityChangeNotifier{}
+}
+
+// RecordEntityEvents implements EntityEventSink. The schema is taken from the transaction's search_path.
+func (n *EntityChangeNotifier) RecordEntityEvents(ctx context.Context, tx pgx.Tx, events []EntityEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	payloads := make([]string, 0, len(events))
+	for _, event := range events {
+		body, err := json.Marshal(EntityChange{
+			Type:          event.Type,
+			TableName:     event.TableName,
+			EntityID:      event.EntityID,
+			EntityVersion: event.EntityVersion,
+			OccurredAt:    event.OccurredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("encode entity change: %w", err)
+		}
+		payloads = append(payloads, string(body))
+	}
+
+	if _, err := tx.Exec(ctx, `
+        SELECT pg_notify($1, jsonb_set(payload::jsonb, '{schema}', to_jsonb(current_schema()))::text)
+        FROM unnest($2::text[]) AS payload
+    `, EntityChangeChannel, payloads); err != nil {
+		return fmt.Errorf("notify entity changes: %w", err)
+	}
+	return nil
+}
+
+type entityChangeScope struct {
+	schema string
+	table  string
+}
+
+// EntityChangeHub listens on EntityChangeChannel with a single connection and fans changes out
+// to in-process subscribers filtered by tenant schema and table.
+type EntityChangeHub struct {
+	pool *pgxpool.Pool
+
+	mu          sync.Mutex
+	subscribers map[entityChangeScope]map[chan EntityChange]struct{}
+}
+
+// NewEntityChangeHub constructs a hub; call Run to start listening.
+func NewEntityChangeHub(pool *pgxpool.Pool) *EntityChangeHub {
+	if pool == nil {
+		panic("pool is required")
+	}
+	return &EntityChangeHub{pool: pool, subscribers: make(map[entityChangeScope]map[chan EntityChange]struct{})}
+}
+
+// Subscribe registers interest in changes of one table within one tenant schema.
+// The channel is closed when the returned cancel func is called, or when the subscriber falls
+// too far behind; consumers should treat a closed channel as "reconnect and resync".
+func (h *EntityChangeHub) Subscribe(schema, table string) (<-chan EntityChange, func()) {
+	scope := entityChangeScope{schema: schema, table: table}
+	ch := make(chan EntityChange, entityChangeBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[scope] == nil {
+		h.subscribers[scope] = make(map[chan EntityChange]struct{})
+	}
+	h.subscribers[scope][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.removeLocked(scope, ch)
+	}
+}
+
+// Run keeps a LISTEN session open until ctx is cancelled, reconnecting with backoff.
+// onError, when set, is called for every session failure.
+func (h *EntityChangeHub) Run(ctx context.Context, onError func(error)) {
+	backoff := time.Second
+	for {
+		err := h.Listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if onError != nil && err != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// Listen runs one LISTEN session, dispatching notifications until ctx is cancelled or the connection fails.
+func (h *EntityChangeHub) Listen(ctx context.Context) error {
+	conn, err := h.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire listen connection: %w", err)
+	}
+	defer func() {
+		// A connection broken by the failure is discarded by the pool; a healthy one must not keep listening.
+		_, _ = conn.Exec(context.Background(), "UNLISTEN *")
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{EntityChangeChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen %s: %w", EntityChangeChannel, err)
+	}
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for entity change: %w", err)
+		}
+
+		var change EntityChange
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			continue
+		}
+		h.dispatch(change)
+	}
+}
+
+// dispatch delivers change without blocking; subscribers whose buffer is full are dropped.
+func (h *EntityChangeHub) dispatch(change EntityChange) {
+	scope := entityChangeScope{schema: change.Schema, table: change.TableName}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[scope] {
+		select {
+		case ch <- change:
+		default:
+			h.removeLocked(scope, ch)
+		}
+	}
+}
+
+func (h *EntityChangeHub) removeLocked(scope entityChangeScope, ch chan EntityChange) {
+	subscribers, ok := h.subscribers[scope]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[ch]; !ok {
+		return
+	}
+	delete(subscribers, ch)
+	close(ch)
+	if len(subscribers) == 0 {
+		delete(h.subscribers, scope)
+	}
+}
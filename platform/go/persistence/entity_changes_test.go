@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEntityChangeHub() *EntityChangeHub {
+	return &EntityChangeHub{subscribers: make(map[entityChangeScope]map[chan EntityChange]struct{})}
+}
+
+func TestEntityChangeHubFiltersBySchemaAndTable(t *testing.T) {
+	t.Parallel()
+
+	hub := newTestEntityChangeHub()
+	cards, stopCards := hub.Subscribe("dev__tenant_acme", "cards_entities")
+	defer stopCards()
+	other, stopOther := hub.Subscribe("dev__tenant_other", "cards_entities")
+	defer stopOther()
+
+	hub.dispatch(EntityChange{Schema: "dev__tenant_acme", TableName: "cards_entities", Type: EntityEventCreated, EntityID: "card-1"})
+	hub.dispatch(EntityChange{Schema: "dev__tenant_acme", TableName: "decks_entities", Type: EntityEventCreated, EntityID: "deck-1"})
+
+	require.Len(t, cards, 1)
+	require.Equal(t, "card-1", (<-cards).EntityID)
+	require.Empty(t, other)
+}
+
+func TestEntityChangeHubCancelClosesChannel(t *testing.T) {
+	t.Parallel()
+
+	hub := newTestEntityChangeHub()
+	changes, stop := hub.Subscribe("dev__tenant_acme", "cards_entities")
+	stop()
+	stop()
+
+	_, open := <-changes
+	require.False(t, open)
+	require.Empty(t, hub.subscribers)
+}
+
+func TestEntityChangeHubDropsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := newTestEntityChangeHub()
+	changes, stop := hub.Subscribe("dev__tenant_acme", "cards_entities")
+	defer stop()
+
+	for i := 0; i <= entityChangeBuffer; i++ {
+		hub.dispatch(EntityChange{Schema: "dev__tenant_acme", TableName: "cards_entities", Type: EntityEventUpdated})
+	}
+
+	received := 0
+	for range changes {
+		received++
+	}
+	require.Equal(t, entityChangeBuffer, received)
+}
@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCursor indicates a keyset cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EntityCursor marks a position in a created_at ordered entity listing.
+// entity_id breaks ties between rows sharing the same creation timestamp.
+type EntityCursor struct {
+	CreatedAt time.Time
+	EntityID  string
+}
+
+type entityCursorWire struct {
+	CreatedAt string `json:"c"`
+	EntityID  string `json:"i"`
+}
+
+// CursorFor returns the cursor pointing just after the given record.
+func CursorFor(record EntityRecord) EntityCursor {
+	return EntityCursor{CreatedAt: record.CreatedAt, EntityID: record.EntityID}
+}
+
+// Encode renders the cursor as an opaque URL-safe token.
+func (c EntityCursor) Encode() string {
+	raw, _ := json.Marshal(entityCursorWire{
+		CreatedAt: c.CreatedAt.UTC().Format(time.RFC3339Nano),
+		EntityID:  c.EntityID,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeEntityCursor parses a token produced by EntityCursor.Encode.
+func DecodeEntityCursor(token string) (EntityCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return EntityCursor{}, ErrInvalidCursor
+	}
+
+	var wire entityCursorWire
+	if err := json.Unmarshal(raw, &wire); err != nil || wire.EntityID == "" {
+		return EntityCursor{}, ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, wire.CreatedAt)
+	if err != nil {
+		return EntityCursor{}, ErrInvalidCursor
+	}
+
+	return EntityCursor{CreatedAt: createdAt, EntityID: wire.EntityID}, nil
+}
@@ -0,0 +1,27 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityCursorRoundTrip(t *testing.T) {
+	cursor := EntityCursor{
+		CreatedAt: time.Date(2025, 3, 14, 15, 9, 26, 535897000, time.UTC),
+		EntityID:  "card/42",
+	}
+
+	decoded, err := DecodeEntityCursor(cursor.Encode())
+	require.NoError(t, err)
+	require.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	require.Equal(t, cursor.EntityID, decoded.EntityID)
+}
+
+func TestDecodeEntityCursorInvalid(t *testing.T) {
+	for _, token := range []string{"", "not base64!", "bm90LWpzb24", "eyJjIjoibm93IiwiaSI6ImEifQ"} {
+		_, err := DecodeEntityCursor(token)
+		require.ErrorIs(t, err, ErrInvalidCursor, token)
+	}
+}
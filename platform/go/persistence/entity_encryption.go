@@ -0,0 +1,493 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// EntityDataKeysTable holds the wrapped per-tenant data keys that encrypt payload fields.
+const EntityDataKeysTable = "entity_data_keys"
+
+// Schema extensions marking a property whose value is encrypted at rest. x-pii flags personal data and implies
+// x-encrypt.
+const (
+	encryptKeyword = "x-encrypt"
+	piiKeyword     = "x-pii"
+)
+
+// encryptedValuePrefix starts every encrypted payload value, which is stored as the string
+// enc:v1:<key id>:<base64 of nonce and ciphertext> in place of the original value.
+const encryptedValuePrefix = "enc:v1:"
+
+// dataKeySize is the length of the AES-256 data keys.
+const dataKeySize = 32
+
+// ErrPayloadEncryptionUnavailable is returned when a payload holds fields to encrypt but no key manager is configured.
+var ErrPayloadEncryptionUnavailable = errors.New("payload encryption is not configured")
+
+// EncryptedField describes a payload property declared with "x-encrypt": true or "x-pii": true.
+type EncryptedField struct {
+	Path []string
+}
+
+// Name renders the field path in dotted form, e.g. "contact.email".
+func (f EncryptedField) Name() string {
+	return strings.Join(f.Path, ".")
+}
+
+// EncryptedFields returns the (nested) object properties of a schema definition whose values are encrypted at rest,
+// ordered by path. Properties below an encrypted object are covered by it and not listed.
+func EncryptedFields(definition SchemaDefinition) ([]EncryptedField, error) {
+	if len(definition) == 0 {
+		return nil, nil
+	}
+
+	var fields []EncryptedField
+	err := walkSchemaProperties(definition, func(path []string, property map[string]interface{}) {
+		if !encryptsProperty(property) {
+			return
+		}
+		for _, field := range fields {
+			if isPathPrefix(field.Path, path) {
+				return
+			}
+		}
+		fields = append(fields, EncryptedField{Path: path})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func encryptsProperty(property map[string]interface{}) bool {
+	for _, keyword := range []string{encryptKeyword, piiKeyword} {
+		if enabled, _ := property[keyword].(bool); enabled {
+			return true
+		}
+	}
+	return false
+}
+
+func isPathPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type payloadDecryptionKey struct{}
+
+// WithPayloadDecryption marks ctx as allowed to read encrypted payload fields in clear. Without it the entity
+// repository returns them as null.
+func WithPayloadDecryption(ctx context.Context) context.Context {
+	return context.WithValue(ctx, payloadDecryptionKey{}, true)
+}
+
+// PayloadDecryptionAllowed reports whether ctx was marked with WithPayloadDecryption.
+func PayloadDecryptionAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(payloadDecryptionKey{}).(bool)
+	return allowed
+}
+
+// DataKeyWrapper wraps and unwraps data keys with a key-encryption key held by a key management service
+// (see the kms package).
+type DataKeyWrapper interface {
+	KeyName() string
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// EntityEncryption encrypts payload fields with per-tenant AES-256-GCM data keys. Each tenant's data keys are
+// generated on first use and stored wrapped by the key manager in EntityDataKeysTable (envelope encryption); unwrapped
+// keys are cached in memory for the life of the process.
+type EntityEncryption struct {
+	db   *SpaceDB
+	keys DataKeyWrapper
+
+	mu     sync.Mutex
+	active map[uuid.UUID]uuid.UUID
+	aeads  map[dataKeyRef]cipher.AEAD
+}
+
+type dataKeyRef struct {
+	tenantID uuid.UUID
+	keyID    uuid.UUID
+}
+
+// NewEntityEncryption returns an encryption helper wrapping the data keys of every tenant with keys.
+func NewEntityEncryption(db *SpaceDB, keys DataKeyWrapper) *EntityEncryption {
+	if db == nil {
+		panic("space db is required")
+	}
+	if keys == nil {
+		panic("data key wrapper is required")
+	}
+	return &EntityEncryption{
+		db:     db,
+		keys:   keys,
+		active: make(map[uuid.UUID]uuid.UUID),
+		aeads:  make(map[dataKeyRef]cipher.AEAD),
+	}
+}
+
+// Seal replaces the values of fields in payload with their encryption under the tenant's active data key. Absent and
+// null values are left as they are. Each value is bound to its table, entity and path, so it cannot be moved to
+// another document.
+func (e *EntityEncryption) Seal(ctx context.Context, space tenant.Space, table, entityID string, fields []EncryptedField, payload []byte) (json.RawMessage, error) {
+	document, err := decodePayloadObject(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		keyID uuid.UUID
+		aead  cipher.AEAD
+	)
+	for _, field := range fields {
+		value, ok := payloadValue(document, field.Path)
+		if !ok || value == nil {
+			continue
+		}
+		if aead == nil {
+			if keyID, aead, err = e.activeKey(ctx, space); err != nil {
+				return nil, err
+			}
+		}
+
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s: %w", field.Name(), err)
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, fmt.Errorf("generate nonce: %w", err)
+		}
+		sealed := aead.Seal(nonce, nonce, plaintext, encryptionAAD(table, entityID, field))
+		setPayloadValue(document, field.Path, encryptedValuePrefix+keyID.String()+":"+base64.RawStdEncoding.EncodeToString(sealed))
+	}
+	if aead == nil {
+		return payload, nil
+	}
+	return json.Marshal(document)
+}
+
+// Open decrypts the values of fields that Seal encrypted. Values stored before the field was declared encrypted are
+// returned as they are.
+func (e *EntityEncryption) Open(ctx context.Context, space tenant.Space, table, entityID string, fields []EncryptedField, payload []byte) (json.RawMessage, error) {
+	document, err := decodePayloadObject(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	for _, field := range fields {
+		keyID, sealed, ok, err := sealedPayloadValue(document, field)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		aead, err := e.key(ctx, space, keyID)
+		if err != nil {
+			return nil, err
+		}
+		if len(sealed) < aead.NonceSize() {
+			return nil, fmt.Errorf("decrypt %s: value is truncated", field.Name())
+		}
+		plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], encryptionAAD(table, entityID, field))
+		if err != nil {
+			return nil, fmt.Errorf("decrypt %s: %w", field.Name(), err)
+		}
+		value, err := decodePayloadValue(plaintext)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt %s: %w", field.Name(), err)
+		}
+		setPayloadValue(document, field.Path, value)
+		changed = true
+	}
+	if !changed {
+		return payload, nil
+	}
+	return json.Marshal(document)
+}
+
+// redactPayload replaces the encrypted values of fields in payload with null.
+func redactPayload(fields []EncryptedField, payload []byte) (json.RawMessage, error) {
+	document, err := decodePayloadObject(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	for _, field := range fields {
+		value, _ := payloadValue(document, field.Path)
+		if text, ok := value.(string); ok && strings.HasPrefix(text, encryptedValuePrefix) {
+			setPayloadValue(document, field.Path, nil)
+			changed = true
+		}
+	}
+	if !changed {
+		return payload, nil
+	}
+	return json.Marshal(document)
+}
+
+// activeKey returns the tenant's data key for new values, generating and storing one on first use.
+func (e *EntityEncryption) activeKey(ctx context.Context, space tenant.Space) (uuid.UUID, cipher.AEAD, error) {
+	e.mu.Lock()
+	keyID, ok := e.active[space.TenantID]
+	e.mu.Unlock()
+	if ok {
+		aead, err := e.key(ctx, space, keyID)
+		return keyID, aead, err
+	}
+
+	stored, err := e.loadActiveKey(ctx, space)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	if stored == nil {
+		if stored, err = e.createActiveKey(ctx, space); err != nil {
+			return uuid.Nil, nil, err
+		}
+	}
+
+	aead, err := e.unwrap(ctx, space, *stored)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	e.mu.Lock()
+	e.active[space.TenantID] = stored.keyID
+	e.mu.Unlock()
+	return stored.keyID, aead, nil
+}
+
+// key returns the tenant's data key keyID, active or retired.
+func (e *EntityEncryption) key(ctx context.Context, space tenant.Space, keyID uuid.UUID) (cipher.AEAD, error) {
+	e.mu.Lock()
+	aead, ok := e.aeads[dataKeyRef{tenantID: space.TenantID, keyID: keyID}]
+	e.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	var stored storedDataKey
+	err := e.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		stored = storedDataKey{keyID: keyID}
+		return tx.QueryRow(ctx, fmt.Sprintf(`SELECT key_name, wrapped_key FROM %s WHERE key_id = $1`, EntityDataKeysTable), keyID).
+			Scan(&stored.keyName, &stored.wrapped)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("data key %s not found", keyID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load data key %s: %w", keyID, err)
+	}
+	return e.unwrap(ctx, space, stored)
+}
+
+// storedDataKey is a row of EntityDataKeysTable.
+type storedDataKey struct {
+	keyID   uuid.UUID
+	keyName string
+	wrapped string
+}
+
+func (e *EntityEncryption) loadActiveKey(ctx context.Context, space tenant.Space) (*storedDataKey, error) {
+	var stored *storedDataKey
+	err := e.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		stored = nil
+		var row storedDataKey
+		err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT key_id, key_name, wrapped_key FROM %s WHERE is_active`, EntityDataKeysTable)).
+			Scan(&row.keyID, &row.keyName, &row.wrapped)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		stored = &row
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load active data key: %w", err)
+	}
+	return stored, nil
+}
+
+// createActiveKey generates a data key and stores it wrapped. When a concurrent writer stored one first, theirs is
+// returned instead; the unique index on the active key decides.
+func (e *EntityEncryption) createActiveKey(ctx context.Context, space tenant.Space) (*storedDataKey, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	wrapped, err := e.keys.Wrap(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	created := storedDataKey{keyID: uuid.New(), keyName: e.keys.KeyName(), wrapped: base64.StdEncoding.EncodeToString(wrapped)}
+
+	var stored storedDataKey
+	err = e.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (key_id, key_name, wrapped_key, is_active)
+			VALUES ($1, $2, $3, TRUE)
+			ON CONFLICT (is_active) WHERE is_active DO NOTHING
+		`, EntityDataKeysTable), created.keyID, created.keyName, created.wrapped); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, fmt.Sprintf(`SELECT key_id, key_name, wrapped_key FROM %s WHERE is_active`, EntityDataKeysTable)).
+			Scan(&stored.keyID, &stored.keyName, &stored.wrapped)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("store data key: %w", err)
+	}
+
+	if stored.keyID == created.keyID {
+		aead, err := newDataKeyAEAD(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		e.cacheKey(space, created.keyID, aead)
+	}
+	return &stored, nil
+}
+
+// unwrap unwraps a stored data key with the key manager and caches it.
+func (e *EntityEncryption) unwrap(ctx context.Context, space tenant.Space, stored storedDataKey) (cipher.AEAD, error) {
+	e.mu.Lock()
+	aead, ok := e.aeads[dataKeyRef{tenantID: space.TenantID, keyID: stored.keyID}]
+	e.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	if stored.keyName != e.keys.KeyName() {
+		return nil, fmt.Errorf("data key %s was wrapped by %s, not the configured %s", stored.keyID, stored.keyName, e.keys.KeyName())
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(stored.wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("decode data key %s: %w", stored.keyID, err)
+	}
+	dataKey, err := e.keys.Unwrap(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key %s: %w", stored.keyID, err)
+	}
+	if aead, err = newDataKeyAEAD(dataKey); err != nil {
+		return nil, err
+	}
+	e.cacheKey(space, stored.keyID, aead)
+	return aead, nil
+}
+
+func (e *EntityEncryption) cacheKey(space tenant.Space, keyID uuid.UUID, aead cipher.AEAD) {
+	e.mu.Lock()
+	e.aeads[dataKeyRef{tenantID: space.TenantID, keyID: keyID}] = aead
+	e.mu.Unlock()
+}
+
+func newDataKeyAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("data key must be %d bytes, got %d", dataKeySize, len(dataKey))
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("init data key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptionAAD binds an encrypted value to the table, entity and field it was written to.
+func encryptionAAD(table, entityID string, field EncryptedField) []byte {
+	return []byte(table + "\x00" + entityID + "\x00" + field.Name())
+}
+
+// sealedPayloadValue parses the encrypted value of field. ok is false when the value is not encrypted.
+func sealedPayloadValue(document map[string]interface{}, field EncryptedField) (uuid.UUID, []byte, bool, error) {
+	value, _ := payloadValue(document, field.Path)
+	text, isString := value.(string)
+	if !isString || !strings.HasPrefix(text, encryptedValuePrefix) {
+		return uuid.Nil, nil, false, nil
+	}
+	rawKeyID, encoded, found := strings.Cut(strings.TrimPrefix(text, encryptedValuePrefix), ":")
+	if !found {
+		return uuid.Nil, nil, false, fmt.Errorf("decrypt %s: malformed value", field.Name())
+	}
+	keyID, err := uuid.Parse(rawKeyID)
+	if err != nil {
+		return uuid.Nil, nil, false, fmt.Errorf("decrypt %s: malformed key id: %w", field.Name(), err)
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return uuid.Nil, nil, false, fmt.Errorf("decrypt %s: %w", field.Name(), err)
+	}
+	return keyID, sealed, true, nil
+}
+
+// decodePayloadObject decodes a payload object keeping numbers as written.
+func decodePayloadObject(payload []byte) (map[string]interface{}, error) {
+	value, err := decodePayloadValue(payload)
+	if err != nil {
+		return nil, fmt.Errorf("decode payload: %w", err)
+	}
+	document, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("decode payload: payload must be a JSON object")
+	}
+	return document, nil
+}
+
+func decodePayloadValue(raw []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func payloadValue(document map[string]interface{}, path []string) (interface{}, bool) {
+	var current interface{} = document
+	for _, segment := range path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setPayloadValue replaces the value at path, which payloadValue found.
+func setPayloadValue(document map[string]interface{}, path []string, value interface{}) {
+	parent := document
+	for _, segment := range path[:len(path)-1] {
+		parent = parent[segment].(map[string]interface{})
+	}
+	parent[path[len(path)-1]] = value
+}
@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestEncryptedFields(t *testing.T) {
+	t.Parallel()
+
+	definition := SchemaDefinition([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": { "type": "string" },
+			"email": { "type": "string", "x-pii": true },
+			"contact": {
+				"type": "object",
+				"properties": { "phone": { "type": "string", "x-encrypt": true } }
+			},
+			"medical": {
+				"type": "object",
+				"x-encrypt": true,
+				"properties": { "notes": { "type": "string", "x-pii": true } }
+			}
+		}
+	}`))
+
+	fields, err := EncryptedFields(definition)
+	require.NoError(t, err)
+	require.Equal(t, []EncryptedField{
+		{Path: []string{"contact", "phone"}},
+		{Path: []string{"email"}},
+		{Path: []string{"medical"}},
+	}, fields)
+	require.Equal(t, "contact.phone", fields[0].Name())
+}
+
+func TestEntityEncryptionSealOpen(t *testing.T) {
+	t.Parallel()
+
+	space := tenant.Space{TenantID: uuid.New(), SchemaName: "tenant_dev_acme"}
+	dataKey := make([]byte, dataKeySize)
+	_, err := rand.Read(dataKey)
+	require.NoError(t, err)
+	aead, err := newDataKeyAEAD(dataKey)
+	require.NoError(t, err)
+
+	// A primed key cache keeps the round trip off the database.
+	keyID := uuid.New()
+	encryption := &EntityEncryption{
+		active: map[uuid.UUID]uuid.UUID{space.TenantID: keyID},
+		aeads:  map[dataKeyRef]cipher.AEAD{{tenantID: space.TenantID, keyID: keyID}: aead},
+	}
+	fields := []EncryptedField{{Path: []string{"contact", "phone"}}, {Path: []string{"email"}}, {Path: []string{"ssn"}}}
+	payload := []byte(`{"name":"Ada","email":"ada@example.com","contact":{"phone":12345678901234567890},"ssn":null}`)
+
+	ctx := context.Background()
+	sealed, err := encryption.Seal(ctx, space, "people_entities", "ada", fields, payload)
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "ada@example.com")
+	require.NotContains(t, string(sealed), "12345678901234567890")
+
+	var stored map[string]any
+	require.NoError(t, json.Unmarshal(sealed, &stored))
+	require.Equal(t, "Ada", stored["name"])
+	require.Nil(t, stored["ssn"], "null values are not encrypted")
+	require.True(t, strings.HasPrefix(stored["email"].(string), encryptedValuePrefix+keyID.String()+":"))
+
+	opened, err := encryption.Open(ctx, space, "people_entities", "ada", fields, sealed)
+	require.NoError(t, err)
+	require.JSONEq(t, string(payload), string(opened))
+
+	_, err = encryption.Open(ctx, space, "people_entities", "grace", fields, sealed)
+	require.Error(t, err, "values are bound to the entity they were written to")
+
+	redacted, err := redactPayload(fields, sealed)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"Ada","email":null,"contact":{"phone":null},"ssn":null}`, string(redacted))
+
+	plain := []byte(`{"name":"Ada","email":"written before encryption"}`)
+	opened, err = encryption.Open(ctx, space, "people_entities", "ada", fields, plain)
+	require.NoError(t, err)
+	require.JSONEq(t, string(plain), string(opened))
+}
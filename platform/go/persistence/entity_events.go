@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Entity event types recorded on every successful entity write.
+const (
+	EntityEventCreated = "entity.created"
+	EntityEventUpdated = "entity.updated"
+	EntityEventDeleted = "entity.deleted"
+)
+
+// EntityEvent describes a single change to an entity document.
+// EntityVersion and Payload are empty for deletions.
+type EntityEvent struct {
+	Type          string
+	TableName     string
+	EntityID      string
+	EntityVersion string
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+}
+
+// EntityEventSink receives entity events inside the transaction that performed the write,
+// so events are committed or rolled back together with the change itself.
+type EntityEventSink interface {
+	RecordEntityEvents(ctx context.Context, tx pgx.Tx, events []EntityEvent) error
+}
+
+func (r *EntityRepository) recordEvents(ctx context.Context, tx pgx.Tx, events ...EntityEvent) error {
+	if r.events == nil || len(events) == 0 {
+		return nil
+	}
+	if err := r.events.RecordEntityEvents(ctx, tx, events); err != nil {
+		return fmt.Errorf("record entity events: %w", err)
+	}
+	return nil
+}
+
+func (r *EntityRepository) eventFor(eventType string, record EntityRecord) EntityEvent {
+	return EntityEvent{
+		Type:          eventType,
+		TableName:     r.tableName,
+		EntityID:      record.EntityID,
+		EntityVersion: record.EntityVersion.String(),
+		Payload:       record.Payload,
+		OccurredAt:    record.CreatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// EntityIndexMigration reports the payload index changes applied, or planned on dry runs, to one tenant's entity table.
+// Error is set when the tenant could not be migrated; other tenants are still processed.
+type EntityIndexMigration struct {
+	TenantID    uuid.UUID
+	TenantSlug  string
+	SchemaName  string
+	TableExists bool
+	Created     []string
+	Dropped     []string
+	Error       string
+}
+
+// MigrateEntityIndexes reconciles the payload indexes of the schema's entity table in every given tenant space with
+// the x-indexed fields of the provided version: missing indexes are created and indexes of fields that are no longer
+// indexed are dropped. Tenants without the entity table are skipped; the tenant migrations create it with the
+// right indexes. progress, when set, is called after each tenant.
+func (s *SchemaRepositoryStore) MigrateEntityIndexes(ctx context.Context, spaceDB *SpaceDB, schema SchemaRecord, spaces []tenant.Space, dryRun bool, progress func(EntityIndexMigration)) ([]EntityIndexMigration, error) {
+	if spaceDB == nil {
+		return nil, errors.New("space db is required")
+	}
+	if !tableNamePattern.MatchString(schema.TableName) {
+		return nil, fmt.Errorf("schema %s has invalid table name %q", schema.SchemaID, schema.TableName)
+	}
+
+	fields, err := IndexedFields(schema.SchemaDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("resolve indexed fields: %w", err)
+	}
+
+	results := make([]EntityIndexMigration, 0, len(spaces))
+	for _, space := range spaces {
+		result := EntityIndexMigration{
+			TenantID:   space.TenantID,
+			TenantSlug: space.Slug,
+			SchemaName: space.SchemaName,
+		}
+
+		err := spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+			return migrateEntityIndexesTx(ctx, tx, schema.TableName, fields, dryRun, &result)
+		})
+		if err != nil {
+			result.Created, result.Dropped = nil, nil
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+		if progress != nil {
+			progress(result)
+		}
+	}
+
+	return results, nil
+}
+
+// migrateEntityIndexesTx diffs the existing payload indexes of tableName in the tenant schema against fields.
+func migrateEntityIndexesTx(ctx context.Context, tx pgx.Tx, tableName string, fields []IndexedField, dryRun bool, result *EntityIndexMigration) error {
+	if err := tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_tables WHERE schemaname = $1 AND tablename = $2)
+	`, result.SchemaName, tableName).Scan(&result.TableExists); err != nil {
+		return fmt.Errorf("lookup entity table: %w", err)
+	}
+	if !result.TableExists {
+		return nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT indexname
+		FROM pg_catalog.pg_indexes
+		WHERE schemaname = $1 AND tablename = $2 AND starts_with(indexname, $3)
+	`, result.SchemaName, tableName, payloadIndexPrefix(tableName))
+	if err != nil {
+		return fmt.Errorf("list payload indexes: %w", err)
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("list payload indexes: %w", err)
+	}
+
+	tableIdent := pgx.Identifier{result.SchemaName, tableName}.Sanitize()
+	desired := make(map[string]string, len(fields))
+	for _, field := range fields {
+		desired[payloadIndexName(tableName, field)] = payloadIndexStatements(tableName, tableIdent, []IndexedField{field})[0]
+	}
+
+	present := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		present[name] = true
+		if _, ok := desired[name]; !ok {
+			result.Dropped = append(result.Dropped, name)
+		}
+	}
+	for name := range desired {
+		if !present[name] {
+			result.Created = append(result.Created, name)
+		}
+	}
+	sort.Strings(result.Created)
+	sort.Strings(result.Dropped)
+
+	if dryRun {
+		return nil
+	}
+
+	for _, name := range result.Dropped {
+		if _, err := tx.Exec(ctx, "DROP INDEX IF EXISTS "+pgx.Identifier{result.SchemaName, name}.Sanitize()); err != nil {
+			return fmt.Errorf("drop payload index %s: %w", name, err)
+		}
+	}
+	for _, name := range result.Created {
+		if _, err := tx.Exec(ctx, desired[name]); err != nil {
+			return fmt.Errorf("create payload index %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// payloadIndexPrefix is the name prefix shared by every payload index of a table, including hashed long names.
+func payloadIndexPrefix(tableName string) string {
+	prefix := tableName + "_x_"
+	if len(prefix) > maxIdentifierLength-9 {
+		prefix = prefix[:maxIdentifierLength-9]
+	}
+	return prefix
+}
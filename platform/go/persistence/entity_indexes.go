@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// indexedKeyword is the schema extension that requests a payload index for a property.
+const indexedKeyword = "x-indexed"
+
+// maxIdentifierLength mirrors Postgres' NAMEDATALEN-1 limit for identifiers.
+const maxIdentifierLength = 63
+
+// payloadKeyPattern restricts indexed property names to characters that can be embedded in SQL literals without quoting.
+var payloadKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// IndexedField describes a payload property marked with x-indexed in the schema definition.
+// Container reports whether the property holds an array or object, which are indexed with GIN instead of B-tree.
+type IndexedField struct {
+	Path      []string
+	Container bool
+}
+
+// JSONPath renders the field as a Postgres text-array path literal, e.g. {address,city}.
+func (f IndexedField) JSONPath() string {
+	return "{" + strings.Join(f.Path, ",") + "}"
+}
+
+// IndexedFields walks the schema's (nested) object properties and returns those marked with "x-indexed": true,
+// ordered by path. Properties whose names are not plain [A-Za-z0-9_] identifiers are skipped.
+func IndexedFields(definition SchemaDefinition) ([]IndexedField, error) {
+	if len(definition) == 0 {
+		return nil, nil
+	}
+
+	var fields []IndexedField
+	err := walkSchemaProperties(definition, func(path []string, property map[string]interface{}) {
+		if indexed, _ := property[indexedKeyword].(bool); indexed {
+			fields = append(fields, IndexedField{Path: path, Container: isContainerType(property["type"])})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// walkSchemaProperties visits every (nested) object property of a schema definition in path order.
+// Properties whose names are not plain [A-Za-z0-9_] identifiers are skipped along with their children.
+func walkSchemaProperties(definition SchemaDefinition, visit func(path []string, property map[string]interface{})) error {
+	var root map[string]interface{}
+	if err := json.Unmarshal(definition, &root); err != nil {
+		return fmt.Errorf("decode schema definition: %w", err)
+	}
+	walkProperties(root, nil, visit)
+	return nil
+}
+
+func walkProperties(node map[string]interface{}, prefix []string, visit func(path []string, property map[string]interface{})) {
+	properties, ok := node["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := properties[name].(map[string]interface{})
+		if !ok || !payloadKeyPattern.MatchString(name) {
+			continue
+		}
+
+		path := append(append([]string{}, prefix...), name)
+		visit(path, property)
+		walkProperties(property, path, visit)
+	}
+}
+
+func isContainerType(value interface{}) bool {
+	switch typed := value.(type) {
+	case string:
+		return typed == "array" || typed == "object"
+	case []interface{}:
+		for _, item := range typed {
+			if s, ok := item.(string); ok && (s == "array" || s == "object") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// payloadIndexStatements returns idempotent DDL creating one expression index per indexed field.
+func payloadIndexStatements(tableName, tableIdent string, fields []IndexedField) []string {
+	statements := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name := payloadIndexName(tableName, field)
+		if field.Container {
+			statements = append(statements, fmt.Sprintf(
+				`CREATE INDEX IF NOT EXISTS %s ON %s USING GIN ((payload #> '%s'));`,
+				name, tableIdent, field.JSONPath()))
+			continue
+		}
+		statements = append(statements, fmt.Sprintf(
+			`CREATE INDEX IF NOT EXISTS %s ON %s ((payload #>> '%s'));`,
+			name, tableIdent, field.JSONPath()))
+	}
+	return statements
+}
+
+// payloadIndexName derives a deterministic index name, hashing the tail when it would exceed the identifier limit.
+func payloadIndexName(tableName string, field IndexedField) string {
+	name := strings.ToLower(fmt.Sprintf("%s_x_%s_idx", tableName, strings.Join(field.Path, "_")))
+	if len(name) <= maxIdentifierLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	return name[:maxIdentifierLength-9] + "_" + hex.EncodeToString(sum[:])[:8]
+}
@@ -0,0 +1,65 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexedFields(t *testing.T) {
+	definition := SchemaDefinition([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": { "type": "string", "x-indexed": true },
+			"tags": { "type": "array", "items": { "type": "string" }, "x-indexed": true },
+			"notes": { "type": "string" },
+			"bad'name": { "type": "string", "x-indexed": true },
+			"address": {
+				"type": "object",
+				"properties": {
+					"city": { "type": "string", "x-indexed": true }
+				}
+			}
+		}
+	}`))
+
+	fields, err := IndexedFields(definition)
+	require.NoError(t, err)
+	require.Equal(t, []IndexedField{
+		{Path: []string{"address", "city"}},
+		{Path: []string{"name"}},
+		{Path: []string{"tags"}, Container: true},
+	}, fields)
+	require.Equal(t, "{address,city}", fields[0].JSONPath())
+}
+
+func TestPayloadIndexStatements(t *testing.T) {
+	statements := payloadIndexStatements("cards_entities", `"cards_entities"`, []IndexedField{
+		{Path: []string{"name"}},
+		{Path: []string{"tags"}, Container: true},
+	})
+	require.Equal(t, []string{
+		`CREATE INDEX IF NOT EXISTS cards_entities_x_name_idx ON "cards_entities" ((payload #>> '{name}'));`,
+		`CREATE INDEX IF NOT EXISTS cards_entities_x_tags_idx ON "cards_entities" USING GIN ((payload #> '{tags}'));`,
+	}, statements)
+}
+
+func TestPayloadIndexNameTruncates(t *testing.T) {
+	field := IndexedField{Path: []string{strings.Repeat("nested", 10), "value"}}
+	name := payloadIndexName("cards_entities", field)
+	require.Len(t, name, maxIdentifierLength)
+	require.Equal(t, name, payloadIndexName("cards_entities", field))
+}
+
+func TestPayloadIndexPrefixMatchesIndexNames(t *testing.T) {
+	short := IndexedField{Path: []string{"name"}}
+	long := IndexedField{Path: []string{strings.Repeat("nested", 10), "value"}}
+	longTable := strings.Repeat("t", 60)
+
+	for _, tableName := range []string{"cards_entities", longTable} {
+		prefix := payloadIndexPrefix(tableName)
+		require.True(t, strings.HasPrefix(payloadIndexName(tableName, short), prefix))
+		require.True(t, strings.HasPrefix(payloadIndexName(tableName, long), prefix))
+	}
+}
@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// piiMaskKeyword is the root-level schema extension choosing how x-pii properties are masked for callers that may not
+// read personal data.
+const piiMaskKeyword = "x-pii-mask"
+
+// PIIMaskMode is a value of the x-pii-mask schema extension.
+type PIIMaskMode string
+
+const (
+	// PIIMaskNull replaces masked values with null. It is the default.
+	PIIMaskNull PIIMaskMode = "null"
+	// PIIMaskOmit removes masked properties from the payload.
+	PIIMaskOmit PIIMaskMode = "omit"
+	// PIIMaskPartial keeps the last characters of string values and replaces the rest with '*'; other values
+	// become null.
+	PIIMaskPartial PIIMaskMode = "partial"
+	// PIIMaskNone disables masking for the schema.
+	PIIMaskNone PIIMaskMode = "none"
+)
+
+// piiMaskModes lists the accepted x-pii-mask values.
+var piiMaskModes = []PIIMaskMode{PIIMaskNull, PIIMaskOmit, PIIMaskPartial, PIIMaskNone}
+
+// partialMaskVisible is the number of trailing characters PIIMaskPartial keeps.
+const partialMaskVisible = 4
+
+// PIIMasking describes how the x-pii properties of a schema are masked.
+type PIIMasking struct {
+	Mode   PIIMaskMode
+	Fields [][]string
+}
+
+// Enabled reports whether the masking changes any payload.
+func (m PIIMasking) Enabled() bool {
+	return m.Mode != PIIMaskNone && len(m.Fields) > 0
+}
+
+// PIIMaskingFor returns the (nested) object properties of a schema definition marked "x-pii": true, ordered by path,
+// with the schema's x-pii-mask mode. Properties below a masked object are covered by it and not listed.
+func PIIMaskingFor(definition SchemaDefinition) (PIIMasking, error) {
+	masking := PIIMasking{Mode: PIIMaskNull}
+	if len(definition) == 0 {
+		return masking, nil
+	}
+
+	var root map[string]interface{}
+	if err := json.Unmarshal(definition, &root); err != nil {
+		return PIIMasking{}, fmt.Errorf("decode schema definition: %w", err)
+	}
+	if mode, ok := root[piiMaskKeyword].(string); ok && isPIIMaskMode(mode) {
+		masking.Mode = PIIMaskMode(mode)
+	}
+
+	walkProperties(root, nil, func(path []string, property map[string]interface{}) {
+		if pii, _ := property[piiKeyword].(bool); !pii {
+			return
+		}
+		for _, field := range masking.Fields {
+			if isPathPrefix(field, path) {
+				return
+			}
+		}
+		masking.Fields = append(masking.Fields, path)
+	})
+	return masking, nil
+}
+
+func isPIIMaskMode(value string) bool {
+	for _, mode := range piiMaskModes {
+		if string(mode) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply masks the x-pii properties of a decoded payload in place. Missing properties are left alone.
+func (m PIIMasking) Apply(payload map[string]interface{}) {
+	if !m.Enabled() {
+		return
+	}
+	for _, path := range m.Fields {
+		parent := payload
+		for _, segment := range path[:len(path)-1] {
+			next, ok := parent[segment].(map[string]interface{})
+			if !ok {
+				parent = nil
+				break
+			}
+			parent = next
+		}
+		name := path[len(path)-1]
+		if parent == nil {
+			continue
+		}
+		value, ok := parent[name]
+		if !ok {
+			continue
+		}
+
+		switch m.Mode {
+		case PIIMaskOmit:
+			delete(parent, name)
+		case PIIMaskPartial:
+			text, isString := value.(string)
+			if !isString {
+				parent[name] = nil
+				continue
+			}
+			parent[name] = maskPartial(text)
+		default:
+			parent[name] = nil
+		}
+	}
+}
+
+// maskPartial keeps the last partialMaskVisible characters of values long enough to keep the rest hidden.
+func maskPartial(value string) string {
+	runes := []rune(value)
+	visible := partialMaskVisible
+	if len(runes) <= 2*partialMaskVisible {
+		visible = 0
+	}
+	return strings.Repeat("*", len(runes)-visible) + string(runes[len(runes)-visible:])
+}
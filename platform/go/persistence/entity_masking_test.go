@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPIIMaskingFor(t *testing.T) {
+	t.Parallel()
+
+	definition := SchemaDefinition([]byte(`{
+		"type": "object",
+		"x-pii-mask": "partial",
+		"properties": {
+			"name": { "type": "string" },
+			"email": { "type": "string", "x-pii": true },
+			"phone": { "type": "string", "x-encrypt": true },
+			"address": { "type": "object", "x-pii": true, "properties": { "zip": { "type": "string", "x-pii": true } } }
+		}
+	}`))
+
+	masking, err := PIIMaskingFor(definition)
+	require.NoError(t, err)
+	require.Equal(t, PIIMasking{Mode: PIIMaskPartial, Fields: [][]string{{"address"}, {"email"}}}, masking)
+	require.True(t, masking.Enabled())
+
+	masking, err = PIIMaskingFor(SchemaDefinition(`{"type": "object", "properties": {"email": {"type": "string", "x-pii": true}}}`))
+	require.NoError(t, err)
+	require.Equal(t, PIIMaskNull, masking.Mode)
+
+	masking, err = PIIMaskingFor(SchemaDefinition(`{"type": "object", "x-pii-mask": "none", "properties": {"email": {"type": "string", "x-pii": true}}}`))
+	require.NoError(t, err)
+	require.False(t, masking.Enabled())
+}
+
+func TestPIIMaskingApply(t *testing.T) {
+	t.Parallel()
+
+	payload := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name":    "Ada",
+			"email":   "ada@example.com",
+			"pin":     "1234",
+			"age":     36.0,
+			"contact": map[string]interface{}{"phone": "+44 20 7946 0958"},
+		}
+	}
+	fields := [][]string{{"age"}, {"contact", "phone"}, {"email"}, {"missing", "value"}, {"pin"}}
+
+	masked := payload()
+	PIIMasking{Mode: PIIMaskNull, Fields: fields}.Apply(masked)
+	require.Equal(t, map[string]interface{}{
+		"name": "Ada", "email": nil, "pin": nil, "age": nil, "contact": map[string]interface{}{"phone": nil},
+	}, masked)
+
+	masked = payload()
+	PIIMasking{Mode: PIIMaskOmit, Fields: fields}.Apply(masked)
+	require.Equal(t, map[string]interface{}{"name": "Ada", "contact": map[string]interface{}{}}, masked)
+
+	masked = payload()
+	PIIMasking{Mode: PIIMaskPartial, Fields: fields}.Apply(masked)
+	require.Equal(t, "***********.com", masked["email"])
+	require.Equal(t, "****", masked["pin"], "short values are hidden entirely")
+	require.Nil(t, masked["age"])
+	require.Equal(t, "************0958", masked["contact"].(map[string]interface{})["phone"])
+
+	masked = payload()
+	PIIMasking{Mode: PIIMaskNone, Fields: fields}.Apply(masked)
+	require.Equal(t, payload(), masked)
+}
@@ -0,0 +1,241 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// entityPartitionSuffixLength is the length of the "_pYYYYMM" suffix of monthly partition names.
+const entityPartitionSuffixLength = len("_p200601")
+
+// EntityPartitioning configures native partitioning of entity tables by month of created_at. Enabled only affects
+// tables created from then on; existing tables keep their layout. Premake is the number of future months the
+// maintenance pre-creates (default 3). Partitions whose month ended more than RetainMonths ago are detached from the
+// entity table once they hold no active version; they stay in the tenant schema as standalone tables for archiving
+// unless DropDetached is set. Zero RetainMonths keeps every partition attached.
+type EntityPartitioning struct {
+	Enabled      bool
+	Premake      int
+	RetainMonths int
+	DropDetached bool
+}
+
+// EntityPartitionMaintenance reports the partitions created, detached and dropped for one entity table. Kept lists
+// the partitions past retention that still hold active versions and therefore stay attached.
+type EntityPartitionMaintenance struct {
+	Table    string
+	Created  []string
+	Detached []string
+	Dropped  []string
+	Kept     []string
+}
+
+// EntityPartitionStore maintains the monthly partitions of the partitioned entity tables in tenant spaces.
+type EntityPartitionStore struct {
+	db  *SpaceDB
+	cfg EntityPartitioning
+}
+
+// NewEntityPartitionStore constructs a partition store with defaults applied to cfg.
+func NewEntityPartitionStore(db *SpaceDB, cfg EntityPartitioning) *EntityPartitionStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	if cfg.Premake <= 0 {
+		cfg.Premake = 3
+	}
+	return &EntityPartitionStore{db: db, cfg: cfg}
+}
+
+// MaintainPartitions pre-creates the partitions of the coming months and applies the retention to every
+// partitioned entity table in space, one transaction per table. A failing table does not stop the others; the
+// first error is returned along with the reports of the tables that were maintained.
+func (s *EntityPartitionStore) MaintainPartitions(ctx context.Context, space tenant.Space) ([]EntityPartitionMaintenance, error) {
+	var tables []string
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT c.relname
+			FROM pg_catalog.pg_partitioned_table pt
+			JOIN pg_catalog.pg_class c ON c.oid = pt.partrelid
+			JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+			WHERE n.nspname = $1
+			ORDER BY c.relname
+		`, space.SchemaName)
+		if err != nil {
+			return fmt.Errorf("list partitioned tables: %w", err)
+		}
+		tables, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("list partitioned tables: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	reports := make([]EntityPartitionMaintenance, 0, len(tables))
+	var firstErr error
+	for _, table := range tables {
+		var report EntityPartitionMaintenance
+		err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+			report = EntityPartitionMaintenance{Table: table}
+			return s.maintainTableTx(ctx, tx, table, now, &report)
+		})
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("maintain partitions of %s: %w", table, err)
+			}
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, firstErr
+}
+
+func (s *EntityPartitionStore) maintainTableTx(ctx context.Context, tx pgx.Tx, table string, now time.Time, report *EntityPartitionMaintenance) error {
+	current := monthStart(now)
+	for i := 0; i <= s.cfg.Premake; i++ {
+		created, err := ensureEntityPartition(ctx, tx, table, current.AddDate(0, i, 0))
+		if err != nil {
+			return err
+		}
+		if created != "" {
+			report.Created = append(report.Created, created)
+		}
+	}
+
+	if s.cfg.RetainMonths <= 0 {
+		return nil
+	}
+
+	partitions, err := attachedEntityPartitions(ctx, tx, table)
+	if err != nil {
+		return err
+	}
+	cutoff := current.AddDate(0, -s.cfg.RetainMonths, 0)
+	tableIdent := pgx.Identifier{table}.Sanitize()
+	for _, partition := range partitions {
+		month, ok := entityPartitionMonth(table, partition)
+		if !ok || !month.Before(cutoff) {
+			continue
+		}
+
+		partitionIdent := pgx.Identifier{partition}.Sanitize()
+		var active bool
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE is_active)`, partitionIdent)).Scan(&active); err != nil {
+			return fmt.Errorf("check active versions in %s: %w", partition, err)
+		}
+		if active {
+			report.Kept = append(report.Kept, partition)
+			continue
+		}
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE %s DETACH PARTITION %s`, tableIdent, partitionIdent)); err != nil {
+			return fmt.Errorf("detach partition %s: %w", partition, err)
+		}
+		report.Detached = append(report.Detached, partition)
+
+		if s.cfg.DropDetached {
+			if _, err := tx.Exec(ctx, `DROP TABLE `+partitionIdent); err != nil {
+				return fmt.Errorf("drop partition %s: %w", partition, err)
+			}
+			report.Dropped = append(report.Dropped, partition)
+		}
+	}
+	return nil
+}
+
+// ensureEntityPartition creates the partition of table holding the month starting at month and returns its name,
+// or an empty name when it already exists.
+func ensureEntityPartition(ctx context.Context, tx pgx.Tx, table string, month time.Time) (string, error) {
+	name := entityPartitionName(table, month)
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, pgx.Identifier{name}.Sanitize()).Scan(&exists); err != nil {
+		return "", fmt.Errorf("check partition %s: %w", name, err)
+	}
+	if exists {
+		return "", nil
+	}
+
+	stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')`,
+		pgx.Identifier{name}.Sanitize(), pgx.Identifier{table}.Sanitize(),
+		month.Format(time.RFC3339), month.AddDate(0, 1, 0).Format(time.RFC3339))
+	if _, err := tx.Exec(ctx, stmt); err != nil {
+		return "", fmt.Errorf("create partition %s: %w", name, err)
+	}
+	return name, nil
+}
+
+// attachedEntityPartitions lists the partitions currently attached to table, sorted by name (and thus by month).
+func attachedEntityPartitions(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.relname
+		FROM pg_catalog.pg_inherits i
+		JOIN pg_catalog.pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = to_regclass($1)
+	`, pgx.Identifier{table}.Sanitize())
+	if err != nil {
+		return nil, fmt.Errorf("list partitions of %s: %w", table, err)
+	}
+	partitions, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("list partitions of %s: %w", table, err)
+	}
+	sort.Strings(partitions)
+	return partitions, nil
+}
+
+// entityPartitionName names the partition of table for month as <table>_pYYYYMM, shortening the table part so
+// the name fits the identifier limit.
+func entityPartitionName(table string, month time.Time) string {
+	return entityPartitionPrefix(table) + month.UTC().Format("200601")
+}
+
+// entityPartitionMonth parses the month of a partition named by entityPartitionName for table.
+func entityPartitionMonth(table, name string) (time.Time, bool) {
+	prefix := entityPartitionPrefix(table)
+	if !strings.HasPrefix(name, prefix) || len(name) != len(prefix)+len("200601") {
+		return time.Time{}, false
+	}
+	month, err := time.Parse("200601", name[len(prefix):])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+func entityPartitionPrefix(table string) string {
+	if len(table) > maxIdentifierLength-entityPartitionSuffixLength {
+		table = table[:maxIdentifierLength-entityPartitionSuffixLength]
+	}
+	return table + "_p"
+}
+
+// monthStart truncates t to the first instant of its month in UTC, the boundary partitions are cut at.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// entityTableKind reports whether the entity table exists in the tenant schema and whether it is partitioned.
+func entityTableKind(ctx context.Context, tx pgx.Tx, tableIdent string) (exists, partitioned bool, err error) {
+	var kind string
+	err = tx.QueryRow(ctx, `SELECT relkind::text FROM pg_catalog.pg_class WHERE oid = to_regclass($1)`, tableIdent).Scan(&kind)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return true, kind == "p", nil
+}
@@ -0,0 +1,153 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestEntityPartitionNames(t *testing.T) {
+	t.Parallel()
+
+	month := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	name := entityPartitionName("cards_entities", month)
+	require.Equal(t, "cards_entities_p202603", name)
+
+	parsed, ok := entityPartitionMonth("cards_entities", name)
+	require.True(t, ok)
+	require.Equal(t, month, parsed)
+
+	_, ok = entityPartitionMonth("cards_entities", "cards_entities_archive")
+	require.False(t, ok)
+	_, ok = entityPartitionMonth("cards", "cards_entities_p202603")
+	require.False(t, ok)
+
+	long := strings.Repeat("x", maxIdentifierLength)
+	name = entityPartitionName(long, month)
+	require.Len(t, name, maxIdentifierLength)
+	parsed, ok = entityPartitionMonth(long, name)
+	require.True(t, ok)
+	require.Equal(t, month, parsed)
+
+	require.Equal(t, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), monthStart(time.Date(2026, time.October, 16, 13, 4, 0, 0, time.FixedZone("x", 3600))))
+}
+
+func TestEntityPartitionStoreMaintainsPartitions(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping entity partition integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schemaID := uuid.New()
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + adminSchema,
+		`CREATE TABLE ` + adminSchema + `.schema_repository (schema_id UUID, schema_version TEXT, table_name TEXT, PRIMARY KEY (schema_id, schema_version))`,
+		`INSERT INTO ` + adminSchema + `.schema_repository VALUES ('` + schemaID.String() + `', '1.0.0', 'cards_entities')`,
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+		`GRANT SELECT, REFERENCES ON ` + adminSchema + `.schema_repository TO ` + role,
+	} {
+		_, err := pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	entityRepo := &EntityRepository{tableName: "cards_entities", tableIdent: pgx.Identifier{"cards_entities"}.Sanitize(), partitioned: true}
+
+	current := monthStart(time.Now())
+	expired, history := current.AddDate(0, -3, 0), current.AddDate(0, -2, 0)
+	insert := func(tx pgx.Tx, entityID, version string, createdAt time.Time, active bool) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO cards_entities (entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, is_active)
+			VALUES ($1, $2, $3, '1.0.0', '{}', $4, $5, $6)
+		`, entityID, version, schemaID, strings.Repeat("a", 64), createdAt, active)
+		return err
+	}
+	err = spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		partitioned, err := entityRepo.ensureEntityTable(ctx, tx)
+		if err != nil {
+			return err
+		}
+		require.True(t, partitioned)
+		for _, month := range []time.Time{expired, history} {
+			if _, err := ensureEntityPartition(ctx, tx, "cards_entities", month); err != nil {
+				return err
+			}
+		}
+		// The expired month only holds superseded history; the other one still holds an active version.
+		if err := insert(tx, "card-1", "1.0.0", expired.Add(time.Hour), false); err != nil {
+			return err
+		}
+		if err := insert(tx, "card-1", "1.0.1", current.Add(time.Hour), true); err != nil {
+			return err
+		}
+		return insert(tx, "card-2", "1.0.0", history.Add(time.Hour), true)
+	})
+	require.NoError(t, err)
+
+	store := NewEntityPartitionStore(spaceDB, EntityPartitioning{Enabled: true, Premake: 2, RetainMonths: 1})
+	reports, err := store.MaintainPartitions(ctx, space)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, "cards_entities", reports[0].Table)
+	require.Equal(t, []string{entityPartitionName("cards_entities", current.AddDate(0, 2, 0))}, reports[0].Created)
+	require.Equal(t, []string{entityPartitionName("cards_entities", expired)}, reports[0].Detached)
+	require.Equal(t, []string{entityPartitionName("cards_entities", history)}, reports[0].Kept)
+	require.Empty(t, reports[0].Dropped)
+
+	var versions int
+	require.NoError(t, pool.QueryRow(ctx, `SELECT COUNT(*) FROM `+schema+`.cards_entities`).Scan(&versions))
+	require.Equal(t, 2, versions)
+
+	// The detached partition stays available for archiving.
+	var archived int
+	require.NoError(t, pool.QueryRow(ctx, `SELECT COUNT(*) FROM `+schema+`.`+entityPartitionName("cards_entities", expired)).Scan(&archived))
+	require.Equal(t, 1, archived)
+
+	reports, err = store.MaintainPartitions(ctx, space)
+	require.NoError(t, err)
+	require.Empty(t, reports[0].Created)
+	require.Empty(t, reports[0].Detached)
+}
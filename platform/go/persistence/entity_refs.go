@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"strings"
+)
+
+// referenceKeyword is the schema extension declaring that a property holds identifiers of another table's entities.
+const referenceKeyword = "x-ref"
+
+// ReferenceField describes a payload property declared with "x-ref": "<table_name>".
+// Many is true for array properties, whose string items are each treated as a reference.
+type ReferenceField struct {
+	Path  []string
+	Table string
+	Many  bool
+}
+
+// Name renders the field path in dotted form for error reporting, e.g. "owner.id".
+func (f ReferenceField) Name() string {
+	return strings.Join(f.Path, ".")
+}
+
+// Values extracts the referenced identifiers from a decoded payload. Missing or null values yield no identifiers;
+// non-string values are left to schema validation.
+func (f ReferenceField) Values(payload map[string]interface{}) []string {
+	var current interface{} = payload
+	for _, segment := range f.Path {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+
+	switch typed := current.(type) {
+	case string:
+		return []string{typed}
+	case []interface{}:
+		ids := make([]string, 0, len(typed))
+		for _, item := range typed {
+			if id, ok := item.(string); ok {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	default:
+		return nil
+	}
+}
+
+// ReferenceFields returns the properties of a schema definition that declare an x-ref to another entity table.
+// Targets that are not valid table names are ignored.
+func ReferenceFields(definition SchemaDefinition) ([]ReferenceField, error) {
+	if len(definition) == 0 {
+		return nil, nil
+	}
+
+	var fields []ReferenceField
+	err := walkSchemaProperties(definition, func(path []string, property map[string]interface{}) {
+		target, _ := property[referenceKeyword].(string)
+		if target == "" || !tableNamePattern.MatchString(target) {
+			return
+		}
+		many := false
+		if kind, _ := property["type"].(string); kind == "array" {
+			many = true
+		}
+		fields = append(fields, ReferenceField{Path: path, Table: target, Many: many})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceFields(t *testing.T) {
+	definition := SchemaDefinition([]byte(`{
+		"type": "object",
+		"properties": {
+			"deckId": { "type": "string", "x-ref": "decks_entities" },
+			"cardIds": { "type": "array", "items": { "type": "string" }, "x-ref": "cards_entities" },
+			"owner": {
+				"type": "object",
+				"properties": { "id": { "type": "string", "x-ref": "players_entities" } }
+			},
+			"bogus": { "type": "string", "x-ref": "Not A Table" }
+		}
+	}`))
+
+	fields, err := ReferenceFields(definition)
+	require.NoError(t, err)
+	require.Equal(t, []ReferenceField{
+		{Path: []string{"cardIds"}, Table: "cards_entities", Many: true},
+		{Path: []string{"deckId"}, Table: "decks_entities"},
+		{Path: []string{"owner", "id"}, Table: "players_entities"},
+	}, fields)
+	require.Equal(t, "owner.id", fields[2].Name())
+}
+
+func TestReferenceFieldValues(t *testing.T) {
+	payload := map[string]interface{}{
+		"deckId":  "deck-1",
+		"cardIds": []interface{}{"card-1", 7, "card-2"},
+		"owner":   map[string]interface{}{"id": "player-1"},
+	}
+
+	require.Equal(t, []string{"deck-1"}, ReferenceField{Path: []string{"deckId"}}.Values(payload))
+	require.Equal(t, []string{"card-1", "card-2"}, ReferenceField{Path: []string{"cardIds"}, Many: true}.Values(payload))
+	require.Equal(t, []string{"player-1"}, ReferenceField{Path: []string{"owner", "id"}}.Values(payload))
+	require.Empty(t, ReferenceField{Path: []string{"missing", "id"}}.Values(payload))
+}
@@ -19,6 +19,12 @@ var ErrEntityNotFound = errors.New("entity not found")
 // ErrEntityAlreadyExists indicates an entity is being created with an identifier that already exists.
 var ErrEntityAlreadyExists = errors.New("entity already exists")
 
+// ErrEntityHashMismatch indicates an update was based on a version that is no longer the active one.
+var ErrEntityHashMismatch = errors.New("entity hash mismatch")
+
+// ErrUnsupportedSort indicates a listing was requested with a sort field or order the table cannot serve.
+var ErrUnsupportedSort = errors.New("unsupported sort")
+
 // SchemaResolver exposes the subset of schema store operations needed by the entity repository.
 type SchemaResolver interface {
 	GetActiveSchema(ctx context.Context, adminDB *SpaceDB, schemaID uuid.UUID) (SchemaRecord, error)
@@ -31,19 +37,31 @@ type PayloadValidator interface {
 }
 
 // EntityRepositoryConfig provides the wiring required to manage a specific entity table.
+// Events is optional; when set every write also records an EntityEvent in the same transaction.
+// Archive is optional; it loads the payloads of versions moved to object storage (see EntityArchiveStore).
+// Encryption is optional; without it writes to schemas declaring x-encrypt or x-pii fields fail with
+// ErrPayloadEncryptionUnavailable.
 type EntityRepositoryConfig struct {
-	SchemaID uuid.UUID
+	SchemaID   uuid.UUID
+	Events     EntityEventSink
+	Archive    EntityArchiveReader
+	Encryption *EntityEncryption
 }
 
 // EntityRepository persists immutable entity documents with schema validation and versioning.
 // tableName holds the raw schema-owned table (e.g. cards_entities) while tableIdent caches the quoted/sanitized identifier generated via pgx.Identifier to embed safely in SQL strings.
 type EntityRepository struct {
-	db         *SpaceDB
-	schemas    SchemaResolver
-	validator  PayloadValidator
-	tableName  string
-	schemaID   uuid.UUID
-	tableIdent string
+	db          *SpaceDB
+	schemas     SchemaResolver
+	validator   PayloadValidator
+	tableName   string
+	schemaID    uuid.UUID
+	tableIdent  string
+	indexed     []IndexedField
+	events      EntityEventSink
+	partitioned bool
+	archive     EntityArchiveReader
+	encryption  *EntityEncryption
 }
 
 // EntityRecord mirrors the entity table shape, capturing every immutable version of a document.
@@ -69,11 +87,13 @@ type CreateEntityParams struct {
 }
 
 // UpdateEntityParams defines the payload required to add a new immutable version of an entity.
+// When ExpectedHash is set the update only proceeds if it matches the hash of the active version.
 type UpdateEntityParams struct {
 	EntityID      string
 	SchemaVersion *SemanticVersion
 	Payload       SchemaDefinition
 	CreatedBy     *string
+	ExpectedHash  *string
 }
 
 // CreateOrUpdateEntityParams unifies the payload for upserting immutable entity records.
@@ -85,7 +105,15 @@ type CreateOrUpdateEntityParams struct {
 	CreatedBy     *string
 }
 
+// BulkEntityResult reports the outcome of a single row submitted to BulkCreateEntities.
+// Exactly one of Record or Err is populated.
+type BulkEntityResult struct {
+	Record *EntityRecord
+	Err    error
+}
+
 // ListEntitiesParams defines filters when listing entities.
+// When Cursor is set the listing uses keyset pagination starting after the cursor and Offset is ignored.
 type ListEntitiesParams struct {
 	OnlyActive     bool
 	IncludeDeleted bool
@@ -93,9 +121,11 @@ type ListEntitiesParams struct {
 	Offset         int
 	SortField      string
 	SortOrder      string
+	Cursor         *EntityCursor
 }
 
-// NewEntityRepository ensures the backing table exists and returns a repository instance.
+// NewEntityRepository resolves the active schema of cfg.SchemaID and returns a repository for its entity table. The
+// table itself is created by EntityTableStore; writes to a tenant where it is missing fail with ErrEntityTableMissing.
 func NewEntityRepository(ctx context.Context, db *SpaceDB, schemaStore SchemaResolver, validator PayloadValidator, cfg EntityRepositoryConfig) (*EntityRepository, error) {
 	if db == nil {
 		return nil, errors.New("space db is required")
@@ -118,6 +148,11 @@ func NewEntityRepository(ctx context.Context, db *SpaceDB, schemaStore SchemaRes
 		return nil, fmt.Errorf("schema %s has invalid table name %q", cfg.SchemaID, activeSchema.TableName)
 	}
 
+	indexed, err := IndexedFields(activeSchema.SchemaDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("resolve indexed fields: %w", err)
+	}
+
 	repo := &EntityRepository{
 		db:         db,
 		schemas:    schemaStore,
@@ -125,6 +160,10 @@ func NewEntityRepository(ctx context.Context, db *SpaceDB, schemaStore SchemaRes
 		tableName:  activeSchema.TableName,
 		schemaID:   cfg.SchemaID,
 		tableIdent: pgx.Identifier{activeSchema.TableName}.Sanitize(),
+		indexed:    indexed,
+		events:     cfg.Events,
+		archive:    cfg.Archive,
+		encryption: cfg.Encryption,
 	}
 
 	return repo, nil
@@ -156,16 +195,26 @@ func (r *EntityRepository) CreateEntity(ctx context.Context, space tenant.Space,
 		return EntityRecord{}, err
 	}
 
-	hash, err := computeJSONHash(params.Payload)
+	payload, err := r.sealPayload(ctx, space, schemaRecord, entityID, params.Payload)
+	if err != nil {
+		return EntityRecord{}, err
+	}
+	hash, err := computeCanonicalJSONHash(payload)
 	if err != nil {
 		return EntityRecord{}, fmt.Errorf("compute entity hash: %w", err)
 	}
 
 	var record EntityRecord
 	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+		partitioned, err := r.entityTable(ctx, tx)
+		if err != nil {
 			return err
 		}
+		if partitioned {
+			if err := r.lockEntityIDs(ctx, tx, entityID); err != nil {
+				return err
+			}
+		}
 
 		existsQuery := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE entity_id = $1)`, r.tableIdent)
 		var exists bool
@@ -184,7 +233,7 @@ func (r *EntityRepository) CreateEntity(ctx context.Context, space tenant.Space,
 			$1, $2, $3, $4, $5, $6, TRUE, FALSE, NOW(), $7
         )`, r.tableIdent)
 
-		if _, err := tx.Exec(ctx, insertStmt, entityID, version.String(), schemaRecord.SchemaID, schemaRecord.VersionString(), []byte(params.Payload), hash, params.CreatedBy); err != nil {
+		if _, err := tx.Exec(ctx, insertStmt, entityID, version.String(), schemaRecord.SchemaID, schemaRecord.VersionString(), []byte(payload), hash, params.CreatedBy); err != nil {
 			return fmt.Errorf("insert entity: %w", err)
 		}
 
@@ -199,13 +248,212 @@ WHERE entity_id = $1 AND entity_version = $2
 		if err != nil {
 			return fmt.Errorf("fetch entity: %w", err)
 		}
-		return nil
+		return r.recordEvents(ctx, tx, r.eventFor(EntityEventCreated, record))
 	})
 	if err != nil {
 		return EntityRecord{}, err
 	}
 
-	return record, nil
+	return r.openRecord(ctx, space, record)
+}
+
+// BulkCreateEntities validates each row against the active schema and inserts the valid ones in a single COPY.
+// Invalid rows, identifiers already present in the table and duplicates inside the batch are reported per row
+// without aborting the import; the returned slice is index-aligned with rows. An identifier another transaction
+// inserts while the COPY runs is reported the same way, and the COPY is retried without it.
+func (r *EntityRepository) BulkCreateEntities(ctx context.Context, space tenant.Space, rows []CreateEntityParams) ([]BulkEntityResult, error) {
+	results := make([]BulkEntityResult, len(rows))
+	if len(rows) == 0 {
+		return results, nil
+	}
+
+	schemaRecord, err := r.resolveSchema(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	version := SemanticVersion{Major: 1, Minor: 0, Patch: 0}
+	seen := make(map[string]struct{}, len(rows))
+	pending := make([]pendingEntity, 0, len(rows))
+	for i, row := range rows {
+		entityID := strings.TrimSpace(row.EntityID)
+		if entityID == "" {
+			entityID = uuid.NewString()
+		} else if entityID, err = NormalizeEntityIdentifier(entityID); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		if _, dup := seen[entityID]; dup {
+			results[i].Err = ErrEntityAlreadyExists
+			continue
+		}
+
+		if len(row.Payload) == 0 {
+			results[i].Err = errors.New("payload is required")
+			continue
+		}
+
+		if err := r.validator.Validate(ctx, schemaRecord, row.Payload); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		payload, err := r.sealPayload(ctx, space, schemaRecord, entityID, row.Payload)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := computeCanonicalJSONHash(payload)
+		if err != nil {
+			return nil, fmt.Errorf("compute entity hash: %w", err)
+		}
+
+		seen[entityID] = struct{}{}
+		pending = append(pending, pendingEntity{index: i, record: EntityRecord{
+			EntityID:      entityID,
+			EntityVersion: version,
+			SchemaID:      schemaRecord.SchemaID,
+			SchemaVersion: schemaRecord.SchemaVersion,
+			Hash:          hash,
+			Payload:       json.RawMessage(payload),
+			CreatedBy:     row.CreatedBy,
+			IsActive:      true,
+		}})
+	}
+
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		partitioned, err := r.entityTable(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]string, 0, len(pending))
+		for _, row := range pending {
+			ids = append(ids, row.record.EntityID)
+		}
+		if partitioned {
+			if err := r.lockEntityIDs(ctx, tx, ids...); err != nil {
+				return err
+			}
+		}
+
+		// created_at is the transaction time, as NOW() gives CreateEntity.
+		var createdAt time.Time
+		if err := tx.QueryRow(ctx, `SELECT NOW()`).Scan(&createdAt); err != nil {
+			return fmt.Errorf("read transaction time: %w", err)
+		}
+		for i := range pending {
+			pending[i].record.CreatedAt = createdAt.UTC()
+		}
+
+		// The existence check does not see rows other transactions have yet to commit; when one of them takes an
+		// identifier of the batch, the COPY fails with a unique violation once it commits. The identifier is then
+		// visible, so the batch is checked again and copied without it.
+		var conflict error
+		for {
+			taken, err := r.existingEntityIDs(ctx, tx, pending)
+			if err != nil {
+				return err
+			}
+			remaining := pending[:0]
+			for _, row := range pending {
+				if _, ok := taken[row.record.EntityID]; ok {
+					results[row.index].Err = ErrEntityAlreadyExists
+					continue
+				}
+				remaining = append(remaining, row)
+			}
+			if conflict != nil && len(remaining) == len(pending) {
+				return fmt.Errorf("bulk insert entities: %w", conflict)
+			}
+			pending = remaining
+			if len(pending) == 0 {
+				return nil
+			}
+
+			copyRows := make([][]any, 0, len(pending))
+			for _, row := range pending {
+				rec := row.record
+				copyRows = append(copyRows, []any{
+					rec.EntityID, rec.EntityVersion.String(), rec.SchemaID, rec.SchemaVersion.String(),
+					[]byte(rec.Payload), rec.Hash, true, false, rec.CreatedAt, rec.CreatedBy,
+				})
+			}
+			err = r.copyEntities(ctx, tx, copyRows)
+			if err == nil {
+				break
+			}
+			if !isUniqueViolation(err) {
+				return fmt.Errorf("bulk insert entities: %w", err)
+			}
+			conflict = err
+		}
+
+		events := make([]EntityEvent, 0, len(pending))
+		for _, row := range pending {
+			events = append(events, r.eventFor(EntityEventCreated, row.record))
+		}
+		return r.recordEvents(ctx, tx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range pending {
+		record, err := r.openRecord(ctx, space, row.record)
+		if err != nil {
+			return nil, err
+		}
+		results[row.index].Record = &record
+	}
+
+	return results, nil
+}
+
+// pendingEntity is a row of BulkCreateEntities that passed validation, with its index in the batch.
+type pendingEntity struct {
+	index  int
+	record EntityRecord
+}
+
+// existingEntityIDs returns the identifiers of rows already present in the entity table.
+func (r *EntityRepository) existingEntityIDs(ctx context.Context, tx pgx.Tx, rows []pendingEntity) (map[string]struct{}, error) {
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.record.EntityID)
+	}
+	existsQuery := fmt.Sprintf(`SELECT DISTINCT entity_id FROM %s WHERE entity_id = ANY($1)`, r.tableIdent)
+	existingRows, err := tx.Query(ctx, existsQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("check entity existence: %w", err)
+	}
+	existing, err := pgx.CollectRows(existingRows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("check entity existence: %w", err)
+	}
+	taken := make(map[string]struct{}, len(existing))
+	for _, id := range existing {
+		taken[id] = struct{}{}
+	}
+	return taken, nil
+}
+
+// copyEntities copies rows into the entity table in a savepoint, so that a failed COPY leaves tx usable.
+func (r *EntityRepository) copyEntities(ctx context.Context, tx pgx.Tx, rows [][]any) error {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	columns := []string{"entity_id", "entity_version", "schema_id", "schema_version", "payload", "hash", "is_active", "is_deleted", "created_at", "created_by"}
+	if _, err := savepoint.CopyFrom(ctx, pgx.Identifier{r.tableName}, columns, pgx.CopyFromRows(rows)); err != nil {
+		_ = savepoint.Rollback(ctx)
+		return err
+	}
+	return savepoint.Commit(ctx)
 }
 
 // UpdateEntity creates a new immutable version of an existing entity, bumping the patch segment.
@@ -227,16 +475,24 @@ func (r *EntityRepository) UpdateEntity(ctx context.Context, space tenant.Space,
 		return EntityRecord{}, err
 	}
 
-	hash, err := computeJSONHash(params.Payload)
+	payload, err := r.sealPayload(ctx, space, schemaRecord, entityID, params.Payload)
+	if err != nil {
+		return EntityRecord{}, err
+	}
+	hash, err := computeCanonicalJSONHash(payload)
 	if err != nil {
 		return EntityRecord{}, fmt.Errorf("compute entity hash: %w", err)
 	}
 
 	var record EntityRecord
 	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil {
 			return err
 		}
+		if !exists {
+			return ErrEntityNotFound
+		}
 
 		activeSelect := fmt.Sprintf(`
 		SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active
@@ -253,6 +509,10 @@ func (r *EntityRepository) UpdateEntity(ctx context.Context, space tenant.Space,
 			return fmt.Errorf("fetch active entity: %w", err)
 		}
 
+		if params.ExpectedHash != nil && *params.ExpectedHash != currentRecord.Hash {
+			return ErrEntityHashMismatch
+		}
+
 		nextVersion := currentRecord.EntityVersion.NextPatch()
 		deactivateStmt := fmt.Sprintf(`
 	UPDATE %s
@@ -270,7 +530,7 @@ func (r *EntityRepository) UpdateEntity(ctx context.Context, space tenant.Space,
 			$1, $2, $3, $4, $5, $6, TRUE, FALSE, NOW(), $7
         )
     `, r.tableIdent)
-		if _, err := tx.Exec(ctx, insertStmt, entityID, nextVersion.String(), schemaRecord.SchemaID, schemaRecord.VersionString(), []byte(params.Payload), hash, params.CreatedBy); err != nil {
+		if _, err := tx.Exec(ctx, insertStmt, entityID, nextVersion.String(), schemaRecord.SchemaID, schemaRecord.VersionString(), []byte(payload), hash, params.CreatedBy); err != nil {
 			return fmt.Errorf("insert entity version: %w", err)
 		}
 
@@ -284,13 +544,13 @@ func (r *EntityRepository) UpdateEntity(ctx context.Context, space tenant.Space,
 		if err != nil {
 			return fmt.Errorf("fetch new entity version: %w", err)
 		}
-		return nil
+		return r.recordEvents(ctx, tx, r.eventFor(EntityEventUpdated, record))
 	})
 	if err != nil {
 		return EntityRecord{}, err
 	}
 
-	return record, nil
+	return r.openRecord(ctx, space, record)
 }
 
 // CreateOrUpdateEntity attempts to update an existing entity version; if it does not exist it falls back to creation.
@@ -329,8 +589,8 @@ func (r *EntityRepository) CreateOrUpdateEntity(ctx context.Context, space tenan
 	})
 }
 
-// GetEntityByID fetches the latest active entity version.
-
+// GetEntityByID fetches the latest active entity version. It reads from the replica unless ctx is marked with
+// WithPrimaryReads.
 func (r *EntityRepository) GetEntityByID(ctx context.Context, space tenant.Space, entityID string) (EntityRecord, error) {
 	normalized, err := NormalizeEntityIdentifier(entityID)
 	if err != nil {
@@ -338,10 +598,14 @@ func (r *EntityRepository) GetEntityByID(ctx context.Context, space tenant.Space
 	}
 
 	var record EntityRecord
-	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+	err = r.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil {
 			return err
 		}
+		if !exists {
+			return ErrEntityNotFound
+		}
 
 		query := fmt.Sprintf(`
 		SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active
@@ -364,7 +628,59 @@ func (r *EntityRepository) GetEntityByID(ctx context.Context, space tenant.Space
 		return EntityRecord{}, err
 	}
 
-	return record, nil
+	return r.openRecord(ctx, space, record)
+}
+
+// GetEntitiesByIDs fetches the active versions of the given entities in a single query.
+// Identifiers without an active version are omitted, so callers can compare the result against their input.
+func (r *EntityRepository) GetEntitiesByIDs(ctx context.Context, space tenant.Space, entityIDs []string) ([]EntityRecord, error) {
+	normalized := make([]string, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		id, err := NormalizeEntityIdentifier(entityID)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, id)
+	}
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+
+	var records []EntityRecord
+	err := r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		records = records[:0]
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil || !exists {
+			return err
+		}
+
+		query := fmt.Sprintf(`
+		SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active
+		FROM %s
+		WHERE entity_id = ANY($1) AND is_active = TRUE AND is_deleted = FALSE
+	`, r.tableIdent)
+
+		rows, err := tx.Query(ctx, query, normalized)
+		if err != nil {
+			return fmt.Errorf("get entities by ids: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			record, err := scanEntityRecord(rows)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.openRecords(ctx, space, records)
 }
 
 // GetEntityVersion fetches a specific entity version.
@@ -374,21 +690,28 @@ func (r *EntityRepository) GetEntityVersion(ctx context.Context, space tenant.Sp
 		return EntityRecord{}, err
 	}
 
-	var record EntityRecord
+	var (
+		record   EntityRecord
+		location *string
+	)
 	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil {
 			return err
 		}
+		if !exists {
+			return ErrEntityNotFound
+		}
 
 		query := fmt.Sprintf(`
-		SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active
+		SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active, archive_location
 		FROM %s
 		WHERE entity_id = $1 AND entity_version = $2
 	`, r.tableIdent)
 
 		row := tx.QueryRow(ctx, query, normalized, version.String())
 		var scanErr error
-		record, scanErr = scanEntityRecord(row)
+		record, scanErr = scanEntityRecord(archiveLocationScanner{row: row, location: &location})
 		if scanErr != nil {
 			if errors.Is(scanErr, pgx.ErrNoRows) {
 				return ErrEntityNotFound
@@ -401,7 +724,19 @@ func (r *EntityRepository) GetEntityVersion(ctx context.Context, space tenant.Sp
 		return EntityRecord{}, err
 	}
 
-	return record, nil
+	// Archived versions keep a stub row; the payload is read back from the archive object outside the transaction.
+	if location != nil {
+		if r.archive == nil {
+			return EntityRecord{}, fmt.Errorf("entity %s version %s is archived but no archive reader is configured", normalized, version)
+		}
+		payload, err := r.archive.LoadArchivedPayload(ctx, *location, normalized, version)
+		if err != nil {
+			return EntityRecord{}, fmt.Errorf("load archived entity version: %w", err)
+		}
+		record.Payload = payload
+	}
+
+	return r.openRecord(ctx, space, record)
 }
 
 // ListEntities returns entities ordered by creation time.
@@ -415,14 +750,32 @@ func (r *EntityRepository) ListEntities(ctx context.Context, space tenant.Space,
 		offset = 0
 	}
 
-	sortField, sortOrder, err := sanitizeEntitySort(params.SortField, params.SortOrder)
+	sortField, sortOrder, err := sanitizeEntitySort(params.SortField, params.SortOrder, r.indexed)
 	if err != nil {
 		return nil, err
 	}
 
+	args := []any{params.OnlyActive, params.IncludeDeleted, limit, offset}
+	keyset := ""
+	if params.Cursor != nil {
+		if sortField != "created_at" {
+			return nil, fmt.Errorf("%w: cursor pagination requires created_at ordering", ErrUnsupportedSort)
+		}
+		// Row comparison keeps the predicate index-friendly; entity_id makes the ordering total.
+		comparator := "<"
+		if sortOrder == "ASC" {
+			comparator = ">"
+		}
+		keyset = fmt.Sprintf("AND (%s, entity_id) %s ($5, $6)", sortField, comparator)
+		args[3] = 0
+		args = append(args, params.Cursor.CreatedAt, params.Cursor.EntityID)
+	}
+
 	var records []EntityRecord
-	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+	err = r.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		records = records[:0]
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil || !exists {
 			return err
 		}
 
@@ -431,11 +784,12 @@ func (r *EntityRepository) ListEntities(ctx context.Context, space tenant.Space,
 		FROM %s
 		WHERE ($1::bool = FALSE OR is_active = TRUE)
 		  AND ($2::bool = TRUE OR is_deleted = FALSE)
-		ORDER BY %s %s
+		  %s
+		ORDER BY %s %s, entity_id %s
 		LIMIT $3 OFFSET $4
-	`, r.tableIdent, sortField, sortOrder)
+	`, r.tableIdent, keyset, sortField, sortOrder, sortOrder)
 
-		rows, err := tx.Query(ctx, query, params.OnlyActive, params.IncludeDeleted, limit, offset)
+		rows, err := tx.Query(ctx, query, args...)
 		if err != nil {
 			return fmt.Errorf("list entities: %w", err)
 		}
@@ -455,7 +809,7 @@ func (r *EntityRepository) ListEntities(ctx context.Context, space tenant.Space,
 		return nil, err
 	}
 
-	return records, nil
+	return r.openRecords(ctx, space, records)
 }
 
 // CountEntities returns the total number of entities matching the provided filters.
@@ -468,8 +822,9 @@ func (r *EntityRepository) CountEntities(ctx context.Context, space tenant.Space
 	`, r.tableIdent)
 
 	var total int64
-	err := r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+	err := r.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil || !exists {
 			return err
 		}
 
@@ -485,14 +840,26 @@ func (r *EntityRepository) CountEntities(ctx context.Context, space tenant.Space
 	return total, nil
 }
 
-func sanitizeEntitySort(field, order string) (string, string, error) {
+// payloadSortPrefix marks sort fields that address payload properties, e.g. "payload.address.city".
+const payloadSortPrefix = "payload."
+
+// sanitizeEntitySort maps a sort request onto a safe ORDER BY expression. Besides created_at, callers may sort on
+// scalar payload properties declared x-indexed in the schema, which guarantees a matching expression index exists.
+func sanitizeEntitySort(field, order string, indexed []IndexedField) (string, string, error) {
 	column := "created_at"
 	if field != "" {
-		switch field {
-		case "created_at":
+		switch {
+		case field == "created_at":
 			column = field
+		case strings.HasPrefix(field, payloadSortPrefix):
+			path := strings.Split(strings.TrimPrefix(field, payloadSortPrefix), ".")
+			sortable, ok := findSortableField(indexed, path)
+			if !ok {
+				return "", "", fmt.Errorf("%w: payload field %q is not indexed", ErrUnsupportedSort, field)
+			}
+			column = fmt.Sprintf("(payload #>> '%s')", sortable.JSONPath())
 		default:
-			return "", "", fmt.Errorf("unsupported sort field %q", field)
+			return "", "", fmt.Errorf("%w: field %q", ErrUnsupportedSort, field)
 		}
 	}
 
@@ -502,15 +869,34 @@ func sanitizeEntitySort(field, order string) (string, string, error) {
 	} else if strings.EqualFold(order, "desc") || order == "" {
 		sortOrder = "DESC"
 	} else {
-		return "", "", fmt.Errorf("unsupported sort order %q", order)
+		return "", "", fmt.Errorf("%w: order %q", ErrUnsupportedSort, order)
 	}
 
 	return column, sortOrder, nil
 }
 
-// DeleteEntity marks all versions of the entity as deleted and non-active.
-// deletedAt is ignored because entity versions are immutable and only track creation time.
-func (r *EntityRepository) DeleteEntity(ctx context.Context, space tenant.Space, entityID string, _ time.Time) error {
+func findSortableField(indexed []IndexedField, path []string) (IndexedField, bool) {
+	for _, field := range indexed {
+		if field.Container || len(field.Path) != len(path) {
+			continue
+		}
+		match := true
+		for i := range path {
+			if field.Path[i] != path[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return field, true
+		}
+	}
+	return IndexedField{}, false
+}
+
+// DeleteEntity marks all versions of the entity as deleted and non-active. deletedAt starts the retention window
+// after which the cleanup purges the entity.
+func (r *EntityRepository) DeleteEntity(ctx context.Context, space tenant.Space, entityID string, deletedAt time.Time) error {
 	normalized, err := NormalizeEntityIdentifier(entityID)
 	if err != nil {
 		return err
@@ -519,16 +905,21 @@ func (r *EntityRepository) DeleteEntity(ctx context.Context, space tenant.Space,
 	stmt := fmt.Sprintf(`
 		UPDATE %s
 		SET is_deleted = TRUE,
-		    is_active = FALSE
+		    is_active = FALSE,
+		    deleted_at = $2
 		WHERE entity_id = $1 AND is_deleted = FALSE
 	`, r.tableIdent)
 
 	err = r.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := r.ensureEntityTable(ctx, tx); err != nil {
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil {
 			return err
 		}
+		if !exists {
+			return ErrEntityNotFound
+		}
 
-		tag, execErr := tx.Exec(ctx, stmt, normalized)
+		tag, execErr := tx.Exec(ctx, stmt, normalized, deletedAt)
 		if execErr != nil {
 			return fmt.Errorf("soft delete entity: %w", execErr)
 		}
@@ -536,12 +927,83 @@ func (r *EntityRepository) DeleteEntity(ctx context.Context, space tenant.Space,
 		if tag.RowsAffected() == 0 {
 			return ErrEntityNotFound
 		}
-		return nil
+		return r.recordEvents(ctx, tx, EntityEvent{
+			Type:       EntityEventDeleted,
+			TableName:  r.tableName,
+			EntityID:   normalized,
+			OccurredAt: time.Now().UTC(),
+		})
 	})
 
 	return err
 }
 
+// sealPayload encrypts the fields schema declares x-encrypt or x-pii in payload, which is returned unchanged when
+// there are none. The entity hash covers the encrypted payload, so it reveals nothing about the clear values.
+func (r *EntityRepository) sealPayload(ctx context.Context, space tenant.Space, schema SchemaRecord, entityID string, payload []byte) ([]byte, error) {
+	fields, err := EncryptedFields(schema.SchemaDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("resolve encrypted fields: %w", err)
+	}
+	if len(fields) == 0 {
+		return payload, nil
+	}
+	if r.encryption == nil {
+		return nil, ErrPayloadEncryptionUnavailable
+	}
+	return r.encryption.Seal(ctx, space, r.tableName, entityID, fields, payload)
+}
+
+// openRecord decrypts the encrypted fields of record, see openRecords.
+func (r *EntityRepository) openRecord(ctx context.Context, space tenant.Space, record EntityRecord) (EntityRecord, error) {
+	records, err := r.openRecords(ctx, space, []EntityRecord{record})
+	if err != nil {
+		return EntityRecord{}, err
+	}
+	return records[0], nil
+}
+
+// openRecords decrypts the encrypted fields of records in place, as declared by the schema version each record was
+// written with. Unless ctx allows payload decryption (see WithPayloadDecryption) encrypted values are returned as
+// null instead.
+func (r *EntityRepository) openRecords(ctx context.Context, space tenant.Space, records []EntityRecord) ([]EntityRecord, error) {
+	allowed := PayloadDecryptionAllowed(ctx) && r.encryption != nil
+	fieldsByVersion := make(map[SemanticVersion][]EncryptedField)
+	for i := range records {
+		record := &records[i]
+		fields, ok := fieldsByVersion[record.SchemaVersion]
+		if !ok {
+			version := record.SchemaVersion
+			schema, err := r.resolveSchema(ctx, &version)
+			if errors.Is(err, ErrSchemaNotFound) {
+				// Purged schema versions fall back to the fields the active version declares.
+				schema, err = r.resolveSchema(ctx, nil)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("resolve schema version %s: %w", version, err)
+			}
+			if fields, err = EncryptedFields(schema.SchemaDefinition); err != nil {
+				return nil, fmt.Errorf("resolve encrypted fields: %w", err)
+			}
+			fieldsByVersion[record.SchemaVersion] = fields
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		var err error
+		if allowed {
+			record.Payload, err = r.encryption.Open(ctx, space, r.tableName, record.EntityID, fields, record.Payload)
+		} else {
+			record.Payload, err = redactPayload(fields, record.Payload)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("open entity %s: %w", record.EntityID, err)
+		}
+	}
+	return records, nil
+}
+
 func (r *EntityRepository) resolveSchema(ctx context.Context, version *SemanticVersion) (SchemaRecord, error) {
 	if version == nil {
 		schema, err := r.schemas.GetActiveSchema(ctx, r.db, r.schemaID)
@@ -563,7 +1025,26 @@ func (r *EntityRepository) resolveSchema(ctx context.Context, version *SemanticV
 	return schema, nil
 }
 
-func (r *EntityRepository) ensureEntityTable(ctx context.Context, tx pgx.Tx) error {
+// ensureEntityTable creates the entity table and its indexes when missing and reports whether the table is
+// partitioned. Only EntityTableStore and the tenant clone and restore call it; request paths use entityTable
+// instead. New tables are partitioned by month of created_at when partitioning is enabled; partitioned tables
+// always get the partitions of the current and next month so writes never miss one while the maintenance lags.
+// Postgres cannot enforce the single active version with a unique index on a partitioned table, so writers to one
+// take the entity locks (see lockEntityIDs) instead.
+func (r *EntityRepository) ensureEntityTable(ctx context.Context, tx pgx.Tx) (bool, error) {
+	exists, partitioned, err := entityTableKind(ctx, tx, r.tableIdent)
+	if err != nil {
+		return false, fmt.Errorf("ensure entity table %s: %w", r.tableName, err)
+	}
+	if !exists {
+		partitioned = r.partitioned
+	}
+
+	primaryKey, partitionBy, activeUnique := "entity_id, entity_version", "", "UNIQUE "
+	if partitioned {
+		primaryKey, partitionBy, activeUnique = "entity_id, entity_version, created_at", " PARTITION BY RANGE (created_at)", ""
+	}
+
 	tableDDL := fmt.Sprintf(`
 CREATE TABLE IF NOT EXISTS %s (
 	entity_id TEXT NOT NULL CHECK (char_length(entity_id) >= 1 AND char_length(entity_id) <= 128),
@@ -576,28 +1057,93 @@ CREATE TABLE IF NOT EXISTS %s (
 	created_by TEXT NULL,
 	is_active BOOLEAN NOT NULL DEFAULT TRUE,
 	is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
-	PRIMARY KEY (entity_id, entity_version),
+	deleted_at TIMESTAMPTZ NULL,
+	archive_location TEXT NULL,
+	PRIMARY KEY (%s),
 	FOREIGN KEY (schema_id, schema_version) REFERENCES schema_repository(schema_id, schema_version)
-);`, r.tableIdent)
+)%s;`, r.tableIdent, primaryKey, partitionBy)
 
 	activeIndex := fmt.Sprintf(`
-CREATE UNIQUE INDEX IF NOT EXISTS %s_active_idx ON %s (entity_id)
+CREATE %sINDEX IF NOT EXISTS %s_active_idx ON %s (entity_id)
 WHERE is_active AND NOT is_deleted;
-`, r.tableName, r.tableIdent)
+`, activeUnique, r.tableName, r.tableIdent)
 	schemaIndex := fmt.Sprintf(`
 CREATE INDEX IF NOT EXISTS %s_schema_idx ON %s (schema_id, schema_version);
 `, r.tableName, r.tableIdent)
 
 	statements := []string{tableDDL, activeIndex, schemaIndex}
+	statements = append(statements, payloadIndexStatements(r.tableName, r.tableIdent, r.indexed)...)
 	for _, stmt := range statements {
 		if _, err := tx.Exec(ctx, stmt); err != nil {
-			return fmt.Errorf("ensure entity table %s: %w", r.tableName, err)
+			return false, fmt.Errorf("ensure entity table %s: %w", r.tableName, err)
+		}
+	}
+
+	if partitioned {
+		current := monthStart(time.Now())
+		for _, month := range []time.Time{current, current.AddDate(0, 1, 0)} {
+			if _, err := ensureEntityPartition(ctx, tx, r.tableName, month); err != nil {
+				return false, fmt.Errorf("ensure entity table %s: %w", r.tableName, err)
+			}
 		}
 	}
 
+	return partitioned, nil
+}
+
+// lockEntityIDs serialises the creation of the given entities in a partitioned table, whose primary key includes
+// created_at and so cannot reject a concurrent duplicate. Single creates hold the table lock shared and the
+// entity's lock; bulk creates hold the table lock exclusively rather than one lock per row.
+func (r *EntityRepository) lockEntityIDs(ctx context.Context, tx pgx.Tx, entityIDs ...string) error {
+	tableKey := r.tableName
+	if len(entityIDs) != 1 {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended(current_schema() || '.' || $1, 0))`, tableKey); err != nil {
+			return fmt.Errorf("lock entity table: %w", err)
+		}
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `
+		SELECT pg_advisory_xact_lock_shared(hashtextextended(current_schema() || '.' || $1, 0)),
+		       pg_advisory_xact_lock(hashtextextended(current_schema() || '.' || $1 || '/' || $2, 0))
+	`, tableKey, entityIDs[0]); err != nil {
+		return fmt.Errorf("lock entity: %w", err)
+	}
 	return nil
 }
 
+// entityTable reports whether the entity table is partitioned, or fails with ErrEntityTableMissing when the table
+// has not been created in the tenant schema.
+func (r *EntityRepository) entityTable(ctx context.Context, tx pgx.Tx) (bool, error) {
+	exists, partitioned, err := entityTableKind(ctx, tx, r.tableIdent)
+	if err != nil {
+		return false, fmt.Errorf("check entity table %s: %w", r.tableName, err)
+	}
+	if !exists {
+		return false, fmt.Errorf("%w: %s", ErrEntityTableMissing, r.tableName)
+	}
+	return partitioned, nil
+}
+
+// entityTableExists reports whether the entity table exists in the tenant schema. Paths that cannot find an entity
+// in a missing table check it and report nothing found, rather than failing with ErrEntityTableMissing.
+func (r *EntityRepository) entityTableExists(ctx context.Context, tx pgx.Tx) (bool, error) {
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, r.tableIdent).Scan(&exists); err != nil {
+		return false, fmt.Errorf("check entity table %s: %w", r.tableName, err)
+	}
+	return exists, nil
+}
+
+// archiveLocationScanner scans the archive_location column selected after the entity record columns.
+type archiveLocationScanner struct {
+	row      rowScanner
+	location **string
+}
+
+func (s archiveLocationScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.location)...)
+}
+
 func scanEntityRecord(scanner rowScanner) (EntityRecord, error) {
 	var (
 		entityID      string
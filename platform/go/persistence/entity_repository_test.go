@@ -155,6 +155,21 @@ END$$;`)
 		BasePrefix:    "dev/beta-inc-beta0001/",
 	}
 
+	// Entity tables only exist once created outside the request paths.
+	_, err = entityRepo.CreateEntity(ctx, spaceA, CreateEntityParams{
+		Payload: SchemaDefinition([]byte(`{"name":"Mox Pearl"}`)),
+	})
+	require.ErrorIs(t, err, ErrEntityTableMissing)
+	missing, err := entityRepo.GetEntitiesByIDs(ctx, spaceA, []string{"mox-pearl"})
+	require.NoError(t, err)
+	require.Empty(t, missing)
+	_, err = entityRepo.GetEntityVersion(ctx, spaceA, "mox-pearl", version)
+	require.ErrorIs(t, err, ErrEntityNotFound)
+
+	entityTables := NewEntityTableStore(spaceDB, EntityPartitioning{})
+	require.NoError(t, entityTables.EnsureTables(ctx, spaceA))
+	require.NoError(t, entityTables.EnsureTables(ctx, spaceB))
+
 	// Tenant A create
 	createPayload := SchemaDefinition([]byte(`{"name":"Black Lotus"}`))
 	createdA, err := entityRepo.CreateEntity(ctx, spaceA, CreateEntityParams{
@@ -210,8 +225,118 @@ END$$;`)
 	require.NoError(t, err)
 	require.Equal(t, createdA.EntityVersion.NextPatch(), updatedA.EntityVersion)
 
+	// A stale expected hash must not produce a new version.
+	staleHash := createdA.Hash
+	_, err = entityRepo.UpdateEntity(ctx, spaceA, UpdateEntityParams{
+		EntityID:     createdA.EntityID,
+		Payload:      updatePayload,
+		ExpectedHash: &staleHash,
+	})
+	require.ErrorIs(t, err, ErrEntityHashMismatch)
+
 	assertCount(tenantSchemaA, 2) // new version inserted; old still present
 	assertCount(tenantSchemaB, 1)
+
+	// Postgres search matches scalar values case-insensitively and only sees the tenant's active versions.
+	found, total, err := entityRepo.SearchEntities(ctx, spaceA, SearchEntitiesParams{Text: "lotus", Limit: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, total)
+	require.Equal(t, updatedA.EntityVersion, found[0].EntityVersion)
+	_, total, err = entityRepo.SearchEntities(ctx, spaceA, SearchEntitiesParams{
+		Filters: []EntityFilter{{Path: []string{"rarity"}, Value: "common"}},
+	})
+	require.NoError(t, err)
+	require.Zero(t, total)
+	_, total, err = entityRepo.SearchEntities(ctx, spaceB, SearchEntitiesParams{Text: "lotus"})
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	// Every stored version still hashes to its persisted hash until a payload is changed behind the repository.
+	verification, err := entityRepo.VerifyEntity(ctx, spaceA, createdA.EntityID)
+	require.NoError(t, err)
+	require.Equal(t, 2, verification.Versions)
+	require.Empty(t, verification.Mismatches)
+
+	_, err = pool.Exec(ctx, `UPDATE `+tenantSchemaA+`.cards_entities SET payload = '{"name":"Forged"}' WHERE entity_id = $1 AND entity_version = $2`,
+		createdA.EntityID, createdA.EntityVersion.String())
+	require.NoError(t, err)
+	verification, err = entityRepo.VerifyEntity(ctx, spaceA, createdA.EntityID)
+	require.NoError(t, err)
+	require.Len(t, verification.Mismatches, 1)
+	require.Equal(t, createdA.EntityVersion, verification.Mismatches[0].Version)
+	require.Equal(t, createdA.Hash, verification.Mismatches[0].StoredHash)
+
+	_, err = entityRepo.VerifyEntity(ctx, spaceB, createdA.EntityID)
+	require.ErrorIs(t, err, ErrEntityNotFound)
+
+	// Bulk import reports per-row failures and only persists valid, unique rows.
+	bulkResults, err := entityRepo.BulkCreateEntities(ctx, spaceB, []CreateEntityParams{
+		{EntityID: "bulk-1", Payload: SchemaDefinition([]byte(`{"name":"Mox Pearl"}`))},
+		{EntityID: createdB.EntityID, Payload: SchemaDefinition([]byte(`{"name":"Taken"}`))},
+		{Payload: SchemaDefinition([]byte(`{"rarity":"rare"}`))},
+		{EntityID: "bulk-1", Payload: SchemaDefinition([]byte(`{"name":"Duplicate"}`))},
+		{Payload: SchemaDefinition([]byte(`{"name":"Ancestral Recall"}`))},
+	})
+	require.NoError(t, err)
+	require.Len(t, bulkResults, 5)
+	require.NotNil(t, bulkResults[0].Record)
+	require.Equal(t, "bulk-1", bulkResults[0].Record.EntityID)
+	require.ErrorIs(t, bulkResults[1].Err, ErrEntityAlreadyExists)
+	require.Error(t, bulkResults[2].Err)
+	require.ErrorIs(t, bulkResults[3].Err, ErrEntityAlreadyExists)
+	require.NotNil(t, bulkResults[4].Record)
+
+	imported, err := entityRepo.GetEntityByID(ctx, spaceB, "bulk-1")
+	require.NoError(t, err)
+	require.Equal(t, bulkResults[0].Record.Hash, imported.Hash)
+	assertCount(tenantSchemaB, 3)
+
+	// Keyset pagination walks every active row exactly once, even when rows share created_at.
+	firstPage, err := entityRepo.ListEntities(ctx, spaceB, ListEntitiesParams{OnlyActive: true, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, firstPage, 2)
+	cursor := CursorFor(firstPage[1])
+	secondPage, err := entityRepo.ListEntities(ctx, spaceB, ListEntitiesParams{OnlyActive: true, Limit: 2, Cursor: &cursor})
+	require.NoError(t, err)
+	require.Len(t, secondPage, 1)
+	require.NotContains(t, []string{firstPage[0].EntityID, firstPage[1].EntityID}, secondPage[0].EntityID)
+
+	// An identifier another transaction commits while the COPY waits on it is reported per row, and the rest of the
+	// batch is still imported.
+	racing, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = racing.Rollback(context.Background()) }()
+	_, err = racing.Exec(ctx, `INSERT INTO `+tenantSchemaB+`.cards_entities (entity_id, entity_version, schema_id, schema_version, payload, hash)
+		SELECT 'bulk-race', entity_version, schema_id, schema_version, payload, hash FROM `+tenantSchemaB+`.cards_entities WHERE entity_id = 'bulk-1'`)
+	require.NoError(t, err)
+
+	type bulkOutcome struct {
+		results []BulkEntityResult
+		err     error
+	}
+	done := make(chan bulkOutcome, 1)
+	go func() {
+		results, err := entityRepo.BulkCreateEntities(ctx, spaceB, []CreateEntityParams{
+			{EntityID: "bulk-race", Payload: SchemaDefinition([]byte(`{"name":"Timetwister"}`))},
+			{EntityID: "bulk-2", Payload: SchemaDefinition([]byte(`{"name":"Mox Sapphire"}`))},
+		})
+		done <- bulkOutcome{results: results, err: err}
+	}()
+	require.Eventually(t, func() bool {
+		var waiting bool
+		err := pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pg_stat_activity WHERE wait_event_type = 'Lock' AND query ILIKE 'copy %')`).Scan(&waiting)
+		return err == nil && waiting
+	}, 30*time.Second, 50*time.Millisecond)
+	require.NoError(t, racing.Commit(ctx))
+
+	outcome := <-done
+	require.NoError(t, outcome.err)
+	require.ErrorIs(t, outcome.results[0].Err, ErrEntityAlreadyExists)
+	require.NotNil(t, outcome.results[1].Record)
+	stored, err := entityRepo.GetEntityByID(ctx, spaceB, "bulk-2")
+	require.NoError(t, err)
+	require.True(t, stored.CreatedAt.Equal(outcome.results[1].Record.CreatedAt))
+	assertCount(tenantSchemaB, 5)
 }
 
 func TestSanitizeEntitySort(t *testing.T) {
@@ -227,11 +352,20 @@ func TestSanitizeEntitySort(t *testing.T) {
 		{name: "asc", field: "created_at", order: "asc", wantField: "created_at", wantOrder: "ASC"},
 		{name: "invalid-field", field: "DROP", order: "asc", wantErr: true},
 		{name: "invalid-order", field: "created_at", order: "sideways", wantErr: true},
+		{name: "payload-indexed", field: "payload.address.city", order: "asc", wantField: "(payload #>> '{address,city}')", wantOrder: "ASC"},
+		{name: "payload-not-indexed", field: "payload.notes", order: "asc", wantErr: true},
+		{name: "payload-container", field: "payload.tags", order: "asc", wantErr: true},
+		{name: "payload-injection", field: "payload.name'); DROP TABLE x; --", order: "asc", wantErr: true},
+	}
+	indexed := []IndexedField{
+		{Path: []string{"address", "city"}},
+		{Path: []string{"name"}},
+		{Path: []string{"tags"}, Container: true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			field, order, err := sanitizeEntitySort(tt.field, tt.order)
+			field, order, err := sanitizeEntitySort(tt.field, tt.order, indexed)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -0,0 +1,122 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// EntityFilter matches documents whose payload value at Path, rendered as text, equals Value.
+type EntityFilter struct {
+	Path  []string
+	Value string
+}
+
+// SearchEntitiesParams narrows a search over active documents. Every whitespace-separated term of Text must occur,
+// case-insensitively, in some scalar value of the payload; every filter must match.
+type SearchEntitiesParams struct {
+	Text    string
+	Filters []EntityFilter
+	Limit   int
+	Offset  int
+}
+
+// SearchEntities is the Postgres search over active documents, newest first, used when no search index is
+// configured. It returns the requested page and the total number of matches. Values of encrypted fields are stored
+// sealed, so they never match.
+func (r *EntityRepository) SearchEntities(ctx context.Context, space tenant.Space, params SearchEntitiesParams) ([]EntityRecord, int64, error) {
+	limit := params.Limit
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	offset := params.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+	for _, term := range strings.Fields(params.Text) {
+		args = append(args, "%"+escapeLikePattern(term)+"%")
+		// strict $.** yields every nested value once; only scalars are compared.
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM jsonb_path_query(payload, 'strict $.**') AS v(value)
+			WHERE jsonb_typeof(v.value) IN ('string', 'number', 'boolean') AND v.value #>> '{}' ILIKE $%d
+		)`, len(args)))
+	}
+	for _, filter := range params.Filters {
+		if len(filter.Path) == 0 {
+			return nil, 0, fmt.Errorf("search filter path is required")
+		}
+		args = append(args, filter.Path, filter.Value)
+		conditions = append(conditions, fmt.Sprintf(`payload #>> $%d::text[] = $%d`, len(args)-1, len(args)))
+	}
+
+	where := "is_active = TRUE AND is_deleted = FALSE"
+	for _, condition := range conditions {
+		where += "\n\t\t  AND " + condition
+	}
+
+	var (
+		records []EntityRecord
+		total   int64
+	)
+	err := r.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		records = records[:0]
+		exists, err := r.entityTableExists(ctx, tx)
+		if err != nil || !exists {
+			return err
+		}
+
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, r.tableIdent, where), args...).Scan(&total); err != nil {
+			return fmt.Errorf("count search results: %w", err)
+		}
+		if total == 0 {
+			return nil
+		}
+
+		query := fmt.Sprintf(`
+		SELECT entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, created_by, is_deleted, is_active
+		FROM %s
+		WHERE %s
+		ORDER BY created_at DESC, entity_id DESC
+		LIMIT $%d OFFSET $%d
+	`, r.tableIdent, where, len(args)+1, len(args)+2)
+
+		rows, err := tx.Query(ctx, query, append(args, limit, offset)...)
+		if err != nil {
+			return fmt.Errorf("search entities: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			record, err := scanEntityRecord(rows)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records, err = r.openRecords(ctx, space, records)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// escapeLikePattern escapes the LIKE wildcards of a literal search term (backslash is the default escape).
+func escapeLikePattern(term string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// ErrEntityTableMissing indicates a write to an entity table that does not exist in the tenant space. Entity tables
+// are created by EntityTableStore when their schema is activated and when the tenant is migrated, never by the
+// request paths.
+var ErrEntityTableMissing = errors.New("entity table missing")
+
+// EntityTableStore creates the entity tables of active schemas, with the payload indexes their definitions declare,
+// in tenant spaces.
+type EntityTableStore struct {
+	db          *SpaceDB
+	partitioned bool
+}
+
+// NewEntityTableStore returns a table store working through db. New tables are partitioned by month of created_at
+// when partitioning is enabled; existing tables keep their layout.
+func NewEntityTableStore(db *SpaceDB, partitioning EntityPartitioning) *EntityTableStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &EntityTableStore{db: db, partitioned: partitioning.Enabled}
+}
+
+// EnsureTable creates the entity table of schema in every given space and adds the payload indexes of its definition
+// that an existing table lacks. A space that fails does not stop the others; the error names each failed tenant.
+func (s *EntityTableStore) EnsureTable(ctx context.Context, schema SchemaRecord, spaces []tenant.Space) error {
+	entityRepo, err := s.tableRepository(schema.TableName, schema.SchemaDefinition)
+	if err != nil {
+		return fmt.Errorf("schema %s: %w", schema.SchemaID, err)
+	}
+	var failed []error
+	for _, space := range spaces {
+		err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+			_, err := entityRepo.ensureEntityTable(ctx, tx)
+			return err
+		})
+		if err != nil {
+			failed = append(failed, fmt.Errorf("tenant %s: %w", space.Slug, err))
+		}
+	}
+	return errors.Join(failed...)
+}
+
+// EnsureTables creates the entity tables of every active schema in space, one transaction per table.
+func (s *EntityTableStore) EnsureTables(ctx context.Context, space tenant.Space) error {
+	var schemas []SchemaRecord
+	err := s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT table_name, schema_definition
+			FROM schema_repository
+			WHERE is_active = TRUE AND is_deleted = FALSE
+			ORDER BY table_name
+		`)
+		if err != nil {
+			return err
+		}
+		schemas, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (SchemaRecord, error) {
+			var schema SchemaRecord
+			err := row.Scan(&schema.TableName, &schema.SchemaDefinition)
+			return schema, err
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("list active schemas: %w", err)
+	}
+
+	for _, schema := range schemas {
+		entityRepo, err := s.tableRepository(schema.TableName, schema.SchemaDefinition)
+		if err != nil {
+			return err
+		}
+		err = s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+			_, err := entityRepo.ensureEntityTable(ctx, tx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tableRepository returns the repository shape ensureEntityTable needs to create table with the payload indexes of
+// definition.
+func (s *EntityTableStore) tableRepository(table string, definition SchemaDefinition) (*EntityRepository, error) {
+	if !tableNamePattern.MatchString(table) {
+		return nil, fmt.Errorf("invalid entity table name %q", table)
+	}
+	indexed, err := IndexedFields(definition)
+	if err != nil {
+		return nil, fmt.Errorf("resolve indexed fields of %s: %w", table, err)
+	}
+	return &EntityRepository{
+		tableName:   table,
+		tableIdent:  pgx.Identifier{table}.Sanitize(),
+		indexed:     indexed,
+		partitioned: s.partitioned,
+	}, nil
+}
@@ -0,0 +1,318 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	FeatureFlagsTable         = "feature_flags"
+	FeatureFlagOverridesTable = "feature_flag_overrides"
+)
+
+// Errors returned by FeatureFlagStore.
+var (
+	ErrFeatureFlagNotFound      = errors.New("feature flag not found")
+	ErrFeatureFlagExists        = errors.New("feature flag already exists")
+	ErrFeatureFlagNoOverride    = errors.New("feature flag has no override for the tenant")
+	ErrFeatureFlagTenantUnknown = errors.New("feature flag override references an unknown tenant")
+)
+
+// FeatureFlagRecord is a flag, its platform-wide default and the tenants that override it, ordered by tenant id.
+type FeatureFlagRecord struct {
+	Key         string
+	Description *string
+	Enabled     bool
+	Overrides   []FeatureFlagOverride
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	UpdatedBy   *string
+}
+
+// FeatureFlagOverride replaces a flag's default for one tenant.
+type FeatureFlagOverride struct {
+	TenantID  uuid.UUID
+	Enabled   bool
+	UpdatedAt time.Time
+	UpdatedBy *string
+}
+
+// FeatureFlagUpdate holds the fields to change; nil fields keep their value.
+type FeatureFlagUpdate struct {
+	Description *string
+	Enabled     *bool
+}
+
+// FeatureFlagStore keeps the feature flags and their tenant overrides in the admin schema. The tables come from the
+// admin migrations.
+type FeatureFlagStore struct {
+	adminDB *SpaceDB
+}
+
+// NewFeatureFlagStore creates a feature flag store scoped to the admin schema.
+func NewFeatureFlagStore(pool *pgxpool.Pool, schema string) *FeatureFlagStore {
+	return &FeatureFlagStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+const featureFlagSelectColumns = `key, description, enabled, created_at, updated_at, updated_by`
+
+// List returns every flag with its overrides, ordered by key.
+func (s *FeatureFlagStore) List(ctx context.Context) ([]FeatureFlagRecord, error) {
+	var out []FeatureFlagRecord
+	err := s.adminDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT `+featureFlagSelectColumns+`
+        FROM %s
+        ORDER BY key
+    `, FeatureFlagsTable))
+		if err != nil {
+			return fmt.Errorf("list feature flags: %w", err)
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (FeatureFlagRecord, error) {
+			return scanFeatureFlag(row)
+		})
+		if err != nil {
+			return err
+		}
+
+		overrides, err := listFeatureFlagOverrides(ctx, tx, nil)
+		if err != nil {
+			return err
+		}
+		for i := range out {
+			out[i].Overrides = overrides[out[i].Key]
+			if out[i].Overrides == nil {
+				out[i].Overrides = []FeatureFlagOverride{}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Get returns a flag with its overrides.
+func (s *FeatureFlagStore) Get(ctx context.Context, key string) (FeatureFlagRecord, error) {
+	var out FeatureFlagRecord
+	err := s.adminDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
+		var err error
+		out, err = getFeatureFlag(ctx, tx, key)
+		return err
+	})
+	return out, err
+}
+
+// Create stores a new flag without overrides.
+func (s *FeatureFlagStore) Create(ctx context.Context, key string, description *string, enabled bool, createdBy *string) (FeatureFlagRecord, error) {
+	var out FeatureFlagRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (key, description, enabled, updated_by)
+        VALUES ($1, $2, $3, $4)
+        RETURNING `+featureFlagSelectColumns, FeatureFlagsTable), key, description, enabled, createdBy)
+		var err error
+		out, err = scanFeatureFlag(row)
+		if isUniqueViolation(err) {
+			return ErrFeatureFlagExists
+		}
+		if err != nil {
+			return fmt.Errorf("create feature flag: %w", err)
+		}
+		out.Overrides = []FeatureFlagOverride{}
+		return nil
+	})
+	return out, err
+}
+
+// Update changes the description or default of a flag.
+func (s *FeatureFlagStore) Update(ctx context.Context, key string, update FeatureFlagUpdate, updatedBy *string) (FeatureFlagRecord, error) {
+	var out FeatureFlagRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET description = CASE WHEN $2 THEN $3 ELSE description END,
+            enabled = COALESCE($4, enabled),
+            updated_at = NOW(),
+            updated_by = $5
+        WHERE key = $1
+    `, FeatureFlagsTable), key, update.Description != nil, update.Description, update.Enabled, updatedBy)
+		if err != nil {
+			return fmt.Errorf("update feature flag: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrFeatureFlagNotFound
+		}
+		out, err = getFeatureFlag(ctx, tx, key)
+		return err
+	})
+	return out, err
+}
+
+// Delete removes a flag and its overrides.
+func (s *FeatureFlagStore) Delete(ctx context.Context, key string) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE key = $1`, FeatureFlagsTable), key)
+		if err != nil {
+			return fmt.Errorf("delete feature flag: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrFeatureFlagNotFound
+		}
+		return nil
+	})
+}
+
+// SetOverride sets the value of a flag for one tenant, replacing any previous override.
+func (s *FeatureFlagStore) SetOverride(ctx context.Context, key string, tenantID uuid.UUID, enabled bool, updatedBy *string) (FeatureFlagRecord, error) {
+	var out FeatureFlagRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		if err := requireFeatureFlag(ctx, tx, key); err != nil {
+			return err
+		}
+		var known bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM tenants WHERE tenant_id = $1 AND is_active = TRUE AND is_deleted = FALSE)`, tenantID).Scan(&known); err != nil {
+			return fmt.Errorf("check tenant: %w", err)
+		}
+		if !known {
+			return ErrFeatureFlagTenantUnknown
+		}
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (flag_key, tenant_id, enabled, updated_by)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (flag_key, tenant_id) DO UPDATE
+        SET enabled = EXCLUDED.enabled, updated_at = NOW(), updated_by = EXCLUDED.updated_by
+    `, FeatureFlagOverridesTable), key, tenantID, enabled, updatedBy); err != nil {
+			return fmt.Errorf("set feature flag override: %w", err)
+		}
+		var err error
+		out, err = getFeatureFlag(ctx, tx, key)
+		return err
+	})
+	return out, err
+}
+
+// DeleteOverride returns the tenant to the flag's default.
+func (s *FeatureFlagStore) DeleteOverride(ctx context.Context, key string, tenantID uuid.UUID) (FeatureFlagRecord, error) {
+	var out FeatureFlagRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		if err := requireFeatureFlag(ctx, tx, key); err != nil {
+			return err
+		}
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE flag_key = $1 AND tenant_id = $2`, FeatureFlagOverridesTable), key, tenantID)
+		if err != nil {
+			return fmt.Errorf("delete feature flag override: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrFeatureFlagNoOverride
+		}
+		out, err = getFeatureFlag(ctx, tx, key)
+		return err
+	})
+	return out, err
+}
+
+// TenantFlags evaluates every flag for a tenant: its override when it has one, else the flag's default.
+func (s *FeatureFlagStore) TenantFlags(ctx context.Context, tenantID uuid.UUID) (map[string]bool, error) {
+	out := make(map[string]bool)
+	err := s.adminDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT f.key, COALESCE(o.enabled, f.enabled)
+        FROM %s f
+        LEFT JOIN %s o ON o.flag_key = f.key AND o.tenant_id = $1
+    `, FeatureFlagsTable, FeatureFlagOverridesTable), tenantID)
+		if err != nil {
+			return fmt.Errorf("evaluate feature flags: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var (
+				key     string
+				enabled bool
+			)
+			if err := rows.Scan(&key, &enabled); err != nil {
+				return err
+			}
+			out[key] = enabled
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func getFeatureFlag(ctx context.Context, tx pgx.Tx, key string) (FeatureFlagRecord, error) {
+	row := tx.QueryRow(ctx, fmt.Sprintf(`SELECT `+featureFlagSelectColumns+` FROM %s WHERE key = $1`, FeatureFlagsTable), key)
+	out, err := scanFeatureFlag(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return FeatureFlagRecord{}, ErrFeatureFlagNotFound
+	}
+	if err != nil {
+		return FeatureFlagRecord{}, fmt.Errorf("get feature flag: %w", err)
+	}
+	overrides, err := listFeatureFlagOverrides(ctx, tx, &key)
+	if err != nil {
+		return FeatureFlagRecord{}, err
+	}
+	out.Overrides = overrides[key]
+	if out.Overrides == nil {
+		out.Overrides = []FeatureFlagOverride{}
+	}
+	return out, nil
+}
+
+func requireFeatureFlag(ctx context.Context, tx pgx.Tx, key string) error {
+	var exists bool
+	if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE key = $1)`, FeatureFlagsTable), key).Scan(&exists); err != nil {
+		return fmt.Errorf("check feature flag: %w", err)
+	}
+	if !exists {
+		return ErrFeatureFlagNotFound
+	}
+	return nil
+}
+
+// listFeatureFlagOverrides groups the overrides of one flag, or of every flag when key is nil, by flag key.
+func listFeatureFlagOverrides(ctx context.Context, tx pgx.Tx, key *string) (map[string][]FeatureFlagOverride, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT flag_key, tenant_id, enabled, updated_at, updated_by
+        FROM %s
+        WHERE $1::text IS NULL OR flag_key = $1
+        ORDER BY flag_key, tenant_id
+    `, FeatureFlagOverridesTable), key)
+	if err != nil {
+		return nil, fmt.Errorf("list feature flag overrides: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string][]FeatureFlagOverride)
+	for rows.Next() {
+		var (
+			flagKey  string
+			override FeatureFlagOverride
+		)
+		if err := rows.Scan(&flagKey, &override.TenantID, &override.Enabled, &override.UpdatedAt, &override.UpdatedBy); err != nil {
+			return nil, err
+		}
+		out[flagKey] = append(out[flagKey], override)
+	}
+	return out, rows.Err()
+}
+
+func scanFeatureFlag(row pgx.Row) (FeatureFlagRecord, error) {
+	var rec FeatureFlagRecord
+	if err := row.Scan(&rec.Key, &rec.Description, &rec.Enabled, &rec.CreatedAt, &rec.UpdatedAt, &rec.UpdatedBy); err != nil {
+		return FeatureFlagRecord{}, err
+	}
+	return rec, nil
+}
@@ -0,0 +1,99 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestFeatureFlagStoreLifecycle(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := "tenant_admin_" + uuid.NewString()[:8]
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, schema))
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE") })
+
+	tenants, err := NewTenantStore(ctx, pool, schema)
+	require.NoError(t, err)
+	tenantID := uuid.New()
+	tenantSchema := tenant.BuildSchemaName("dev", "flags_co")
+	_, err = tenants.Create(ctx, TenantRecord{
+		TenantID:      tenantID,
+		TenantVersion: SemanticVersion{Major: 1},
+		Slug:          "flags-co",
+		Status:        "active",
+		SchemaName:    tenantSchema,
+		RoleName:      tenant.BuildRoleName(tenantSchema),
+		BasePrefix:    "dev/flags-co-" + tenantID.String()[:8] + "/",
+		ShortTenantID: tenantID.String()[:8],
+		IsActive:      true,
+		CreatedAt:     time.Now().UTC(),
+		CreatedBy:     uuid.New(),
+	})
+	require.NoError(t, err)
+
+	store := NewFeatureFlagStore(pool, schema)
+	admin := "admin"
+
+	// The migration seeds bulk import on for everyone.
+	flags, err := store.TenantFlags(ctx, tenantID)
+	require.NoError(t, err)
+	require.True(t, flags["entities.bulk-import"])
+
+	description := "New editor"
+	flag, err := store.Create(ctx, "ui.new-editor", &description, false, &admin)
+	require.NoError(t, err)
+	require.Empty(t, flag.Overrides)
+	_, err = store.Create(ctx, "ui.new-editor", nil, true, &admin)
+	require.ErrorIs(t, err, ErrFeatureFlagExists)
+
+	flag, err = store.SetOverride(ctx, "ui.new-editor", tenantID, true, &admin)
+	require.NoError(t, err)
+	require.Len(t, flag.Overrides, 1)
+	require.Equal(t, tenantID, flag.Overrides[0].TenantID)
+	_, err = store.SetOverride(ctx, "ui.new-editor", uuid.New(), true, &admin)
+	require.ErrorIs(t, err, ErrFeatureFlagTenantUnknown)
+	_, err = store.SetOverride(ctx, "missing", tenantID, true, &admin)
+	require.ErrorIs(t, err, ErrFeatureFlagNotFound)
+
+	flags, err = store.TenantFlags(ctx, tenantID)
+	require.NoError(t, err)
+	require.True(t, flags["ui.new-editor"])
+	flags, err = store.TenantFlags(ctx, uuid.New())
+	require.NoError(t, err)
+	require.False(t, flags["ui.new-editor"])
+
+	enabled := true
+	flag, err = store.Update(ctx, "ui.new-editor", FeatureFlagUpdate{Enabled: &enabled}, &admin)
+	require.NoError(t, err)
+	require.True(t, flag.Enabled)
+	require.Equal(t, description, *flag.Description)
+
+	flag, err = store.DeleteOverride(ctx, "ui.new-editor", tenantID)
+	require.NoError(t, err)
+	require.Empty(t, flag.Overrides)
+	_, err = store.DeleteOverride(ctx, "ui.new-editor", tenantID)
+	require.ErrorIs(t, err, ErrFeatureFlagNoOverride)
+
+	listed, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	require.Equal(t, "entities.bulk-import", listed[0].Key)
+
+	require.NoError(t, store.Delete(ctx, "ui.new-editor"))
+	require.ErrorIs(t, store.Delete(ctx, "ui.new-editor"), ErrFeatureFlagNotFound)
+	_, err = store.Get(ctx, "ui.new-editor")
+	require.ErrorIs(t, err, ErrFeatureFlagNotFound)
+}
@@ -5,11 +5,15 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 )
 
 // computeJSONHash returns a deterministic SHA-256 hex digest for the provided JSON payload.
-// FIXME: This uses json.Compact, so hashes still depend on map key order and number lexemes; replace with full canonical JSON (sorted keys, stable numbers).
+// FIXME: This uses json.Compact, so schema hashes still depend on map key order and number lexemes. Entity payloads
+// use computeCanonicalJSONHash; schemas keep this form so existing hashes keep matching bundle imports.
 func computeJSONHash(raw []byte) (string, error) {
 	if len(raw) == 0 {
 		return "", fmt.Errorf("payload is required to compute hash")
@@ -23,3 +27,103 @@ func computeJSONHash(raw []byte) (string, error) {
 	sum := sha256.Sum256(compact.Bytes())
 	return hex.EncodeToString(sum[:]), nil
 }
+
+// computeCanonicalJSONHash returns the SHA-256 hex digest of the canonical form of the provided JSON payload (see
+// canonicalJSON), so the hash of a stored document can be recomputed from the JSONB column whatever key order and
+// number lexemes it was written with.
+func computeCanonicalJSONHash(raw []byte) (string, error) {
+	if len(raw) == 0 {
+		return "", fmt.Errorf("payload is required to compute hash")
+	}
+
+	canonical, err := canonicalJSON(raw)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalJSON re-encodes a JSON document without insignificant whitespace, with object keys in lexical order and
+// numbers in their shortest form (integers as integers, other numbers as the shortest float64 representation).
+func canonicalJSON(raw []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("canonicalize json: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("canonicalize json: unexpected data after the document")
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, value); err != nil {
+		return nil, fmt.Errorf("canonicalize json: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonicalJSON(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONValue(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeCanonicalJSON(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case json.Number:
+		if n, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			buf.WriteString(strconv.FormatInt(n, 10))
+			return nil
+		}
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return fmt.Errorf("number %s: %w", v, err)
+		}
+		buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	default:
+		return writeJSONValue(buf, v)
+	}
+	return nil
+}
+
+// writeJSONValue encodes a string, boolean or null without escaping HTML characters.
+func writeJSONValue(buf *bytes.Buffer, value any) error {
+	var encoded bytes.Buffer
+	encoder := json.NewEncoder(&encoded)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return err
+	}
+	buf.Write(bytes.TrimSuffix(encoded.Bytes(), []byte("\n")))
+	return nil
+}
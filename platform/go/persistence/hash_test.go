@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	t.Parallel()
+
+	canonical, err := canonicalJSON([]byte(`{ "b": [1.0, 2.50, -0, 1e2, true], "a": {"z": null, "y": "<x>"} }`))
+	require.NoError(t, err)
+	require.Equal(t, `{"a":{"y":"<x>","z":null},"b":[1,2.5,0,100,true]}`, string(canonical))
+
+	_, err = canonicalJSON([]byte(`{"a": 1} {"b": 2}`))
+	require.Error(t, err)
+}
+
+func TestComputeCanonicalJSONHashIgnoresKeyOrderAndNumberLexemes(t *testing.T) {
+	t.Parallel()
+
+	first, err := computeCanonicalJSONHash([]byte(`{"name": "Black Lotus", "price": 10.0, "tags": ["a", "b"]}`))
+	require.NoError(t, err)
+	second, err := computeCanonicalJSONHash([]byte(`{"tags":["a","b"],"price":1e1,"name":"Black Lotus"}`))
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	reordered, err := computeCanonicalJSONHash([]byte(`{"name": "Black Lotus", "price": 10, "tags": ["b", "a"]}`))
+	require.NoError(t, err)
+	require.NotEqual(t, first, reordered, "array order is significant")
+
+	_, err = computeCanonicalJSONHash(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,226 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const UserInvitationsTable = "user_invitations"
+
+// Invitation represents a row in the user_invitations table joined with the invited user.
+type Invitation struct {
+	UserID     uuid.UUID  `db:"user_id" json:"userId"`
+	Email      string     `db:"email" json:"email"`
+	FullName   string     `db:"full_name" json:"fullName"`
+	InvitedBy  *string    `db:"invited_by" json:"invitedBy,omitempty"`
+	CreatedAt  time.Time  `db:"created_at" json:"createdAt"`
+	ExpiresAt  time.Time  `db:"expires_at" json:"expiresAt"`
+	SentAt     *time.Time `db:"sent_at" json:"sentAt,omitempty"`
+	SendCount  int        `db:"send_count" json:"sendCount"`
+	AcceptedAt *time.Time `db:"accepted_at" json:"acceptedAt,omitempty"`
+}
+
+var (
+	// ErrInvitationNotFound indicates a missing invitation (or an unknown token).
+	ErrInvitationNotFound = errors.New("invitation not found")
+	// ErrInvitationAccepted indicates the invitation was already accepted and can no longer change.
+	ErrInvitationAccepted = errors.New("invitation already accepted")
+	// ErrInvitationExpired indicates the invitation expired before it was accepted.
+	ErrInvitationExpired = errors.New("invitation expired")
+)
+
+// InvitationStore persists pending user invitations. Only the SHA-256 hash of the invitation token is stored.
+type InvitationStore struct {
+	db *SpaceDB
+}
+
+// NewInvitationStore returns an invitation store working through db. The tables come from the tenant migrations.
+func NewInvitationStore(db *SpaceDB) *InvitationStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &InvitationStore{db: db}
+}
+
+// CreateInvitationParams captures the user to create and the invitation that goes with it.
+type CreateInvitationParams struct {
+	User      CreateUserParams
+	TokenHash string
+	InvitedBy *string
+	ExpiresAt time.Time
+}
+
+// CreateInvitation inserts the invited user and the invitation in one transaction. A duplicated email returns
+// ErrUserConflict.
+func (s *InvitationStore) CreateInvitation(ctx context.Context, space tenant.Space, params CreateInvitationParams) (Invitation, error) {
+	if params.User.UserID == uuid.Nil {
+		return Invitation{}, errors.New("user id is required")
+	}
+
+	var invitation Invitation
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (user_id, email, full_name)
+        VALUES ($1, $2, $3)
+    `, UsersTable), params.User.UserID, params.User.Email, params.User.FullName)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return ErrUserConflict
+			}
+			return fmt.Errorf("insert invited user: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (user_id, token_hash, invited_by, expires_at)
+        VALUES ($1, $2, $3, $4)
+    `, UserInvitationsTable), params.User.UserID, params.TokenHash, params.InvitedBy, params.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("insert invitation: %w", err)
+		}
+
+		invitation, err = getInvitationTx(ctx, tx, "i.user_id = $1", params.User.UserID)
+		return err
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	return invitation, nil
+}
+
+// GetInvitation returns the invitation of the user.
+func (s *InvitationStore) GetInvitation(ctx context.Context, space tenant.Space, userID uuid.UUID) (Invitation, error) {
+	return s.read(ctx, space, "i.user_id = $1", userID)
+}
+
+// FindInvitationByToken returns the invitation whose token hashes to tokenHash.
+func (s *InvitationStore) FindInvitationByToken(ctx context.Context, space tenant.Space, tokenHash string) (Invitation, error) {
+	return s.read(ctx, space, "i.token_hash = $1", tokenHash)
+}
+
+// RenewInvitation replaces the token of an unaccepted invitation and moves its expiry, invalidating the previous
+// token.
+func (s *InvitationStore) RenewInvitation(ctx context.Context, space tenant.Space, userID uuid.UUID, tokenHash string, expiresAt time.Time) (Invitation, error) {
+	return s.update(ctx, space, userID, "token_hash = $2, expires_at = $3", tokenHash, expiresAt)
+}
+
+// ExpireInvitation ends an unaccepted invitation at the given time, unless it already expired earlier.
+func (s *InvitationStore) ExpireInvitation(ctx context.Context, space tenant.Space, userID uuid.UUID, at time.Time) (Invitation, error) {
+	return s.update(ctx, space, userID, "expires_at = LEAST(expires_at, $2)", at)
+}
+
+// MarkInvitationSent records a delivery of the invitation email.
+func (s *InvitationStore) MarkInvitationSent(ctx context.Context, space tenant.Space, userID uuid.UUID, at time.Time) (Invitation, error) {
+	return s.update(ctx, space, userID, "sent_at = $2, send_count = send_count + 1", at)
+}
+
+// AcceptInvitation marks the invitation accepted at the given time. It fails with ErrInvitationExpired once
+// expires_at has passed.
+func (s *InvitationStore) AcceptInvitation(ctx context.Context, space tenant.Space, userID uuid.UUID, at time.Time) (Invitation, error) {
+	var invitation Invitation
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		current, err := lockInvitationTx(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if !at.Before(current.ExpiresAt) {
+			return ErrInvitationExpired
+		}
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET accepted_at = $2 WHERE user_id = $1`, UserInvitationsTable), userID, at); err != nil {
+			return fmt.Errorf("accept invitation: %w", err)
+		}
+		invitation, err = getInvitationTx(ctx, tx, "i.user_id = $1", userID)
+		return err
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	return invitation, nil
+}
+
+func (s *InvitationStore) read(ctx context.Context, space tenant.Space, where string, arg any) (Invitation, error) {
+	var invitation Invitation
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		var err error
+		invitation, err = getInvitationTx(ctx, tx, where, arg)
+		return err
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	return invitation, nil
+}
+
+// update applies set to an unaccepted invitation; set may reference $2 onwards.
+func (s *InvitationStore) update(ctx context.Context, space tenant.Space, userID uuid.UUID, set string, args ...any) (Invitation, error) {
+	var invitation Invitation
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if _, err := lockInvitationTx(ctx, tx, userID); err != nil {
+			return err
+		}
+
+		query := fmt.Sprintf(`UPDATE %s SET %s WHERE user_id = $1`, UserInvitationsTable, set)
+		if _, err := tx.Exec(ctx, query, append([]any{userID}, args...)...); err != nil {
+			return fmt.Errorf("update invitation: %w", err)
+		}
+
+		var err error
+		invitation, err = getInvitationTx(ctx, tx, "i.user_id = $1", userID)
+		return err
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	return invitation, nil
+}
+
+// lockInvitationTx locks the invitation row and rejects accepted invitations.
+func lockInvitationTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID) (Invitation, error) {
+	var current Invitation
+	err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT expires_at, accepted_at FROM %s WHERE user_id = $1 FOR UPDATE`, UserInvitationsTable), userID).
+		Scan(&current.ExpiresAt, &current.AcceptedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Invitation{}, ErrInvitationNotFound
+	}
+	if err != nil {
+		return Invitation{}, fmt.Errorf("lock invitation: %w", err)
+	}
+	if current.AcceptedAt != nil {
+		return Invitation{}, ErrInvitationAccepted
+	}
+	return current, nil
+}
+
+func getInvitationTx(ctx context.Context, tx pgx.Tx, where string, arg any) (Invitation, error) {
+	var invitation Invitation
+	err := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT i.user_id, u.email, u.full_name, i.invited_by, i.created_at, i.expires_at, i.sent_at, i.send_count, i.accepted_at
+        FROM %s i
+        JOIN %s u ON u.user_id = i.user_id
+        WHERE %s
+    `, UserInvitationsTable, UsersTable, where), arg).Scan(
+		&invitation.UserID,
+		&invitation.Email,
+		&invitation.FullName,
+		&invitation.InvitedBy,
+		&invitation.CreatedAt,
+		&invitation.ExpiresAt,
+		&invitation.SentAt,
+		&invitation.SendCount,
+		&invitation.AcceptedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Invitation{}, ErrInvitationNotFound
+	}
+	if err != nil {
+		return Invitation{}, fmt.Errorf("get invitation: %w", err)
+	}
+	return invitation, nil
+}
@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestInvitationStoreLifecycle(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping invitation store integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA IF NOT EXISTS ` + adminSchema,
+		`CREATE SCHEMA IF NOT EXISTS ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+	} {
+		_, err = pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	users, err := NewUserStore(ctx, spaceDB)
+	require.NoError(t, err)
+	invitations := NewInvitationStore(spaceDB)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	inviter := "admin-1"
+	created, err := invitations.CreateInvitation(ctx, space, CreateInvitationParams{
+		User:      CreateUserParams{UserID: uuid.New(), Email: "new@example.com", FullName: "New User"},
+		TokenHash: "hash-1",
+		InvitedBy: &inviter,
+		ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "new@example.com", created.Email)
+	require.Equal(t, &inviter, created.InvitedBy)
+	require.Nil(t, created.SentAt)
+
+	// The invited user exists right away.
+	_, err = users.GetUser(ctx, space, created.UserID)
+	require.NoError(t, err)
+
+	_, err = invitations.CreateInvitation(ctx, space, CreateInvitationParams{
+		User:      CreateUserParams{UserID: uuid.New(), Email: "new@example.com", FullName: "Again"},
+		TokenHash: "hash-2",
+		ExpiresAt: now.Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrUserConflict)
+
+	sent, err := invitations.MarkInvitationSent(ctx, space, created.UserID, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, sent.SendCount)
+
+	// Renewing replaces the token.
+	_, err = invitations.RenewInvitation(ctx, space, created.UserID, "hash-3", now.Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = invitations.FindInvitationByToken(ctx, space, "hash-1")
+	require.ErrorIs(t, err, ErrInvitationNotFound)
+	found, err := invitations.FindInvitationByToken(ctx, space, "hash-3")
+	require.NoError(t, err)
+	require.Equal(t, created.UserID, found.UserID)
+
+	expired, err := invitations.ExpireInvitation(ctx, space, created.UserID, now)
+	require.NoError(t, err)
+	require.True(t, expired.ExpiresAt.Equal(now))
+	_, err = invitations.AcceptInvitation(ctx, space, created.UserID, now.Add(time.Second))
+	require.ErrorIs(t, err, ErrInvitationExpired)
+
+	_, err = invitations.RenewInvitation(ctx, space, created.UserID, "hash-4", now.Add(time.Hour))
+	require.NoError(t, err)
+	accepted, err := invitations.AcceptInvitation(ctx, space, created.UserID, now.Add(time.Second))
+	require.NoError(t, err)
+	require.NotNil(t, accepted.AcceptedAt)
+
+	_, err = invitations.RenewInvitation(ctx, space, created.UserID, "hash-5", now.Add(time.Hour))
+	require.ErrorIs(t, err, ErrInvitationAccepted)
+
+	// Deleting the user drops the invitation.
+	require.NoError(t, users.DeleteUser(ctx, space, created.UserID))
+	_, err = invitations.GetInvitation(ctx, space, created.UserID)
+	require.ErrorIs(t, err, ErrInvitationNotFound)
+}
@@ -0,0 +1,230 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const JobsTable = "jobs"
+
+// Job statuses.
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// Job store errors.
+var (
+	// ErrJobNotFound is returned when a job does not exist.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobLeaseLost is returned by Save when the attempt no longer holds the job: its lease expired and another
+	// worker claimed it again, or the job was finished meanwhile.
+	ErrJobLeaseLost = errors.New("job lease lost")
+)
+
+// jobLeaseExpiredError is the last error of jobs whose lease expired on their last attempt.
+const jobLeaseExpiredError = "lease expired on the last attempt"
+
+// JobRecord is a generic background job. TenantID is nil for platform jobs; Payload holds the kind-specific
+// input and Result what the handler returned once the job succeeded.
+type JobRecord struct {
+	JobID         uuid.UUID
+	TenantID      *uuid.UUID
+	Kind          string
+	Status        string
+	Payload       json.RawMessage
+	Result        json.RawMessage
+	Attempts      int
+	MaxAttempts   int
+	LastError     *string
+	CreatedBy     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+// JobStore queues generic jobs in the admin schema. The table comes from the admin migrations.
+type JobStore struct {
+	adminDB *SpaceDB
+}
+
+// NewJobStore creates a job store scoped to the admin schema.
+func NewJobStore(pool *pgxpool.Pool, schema string) *JobStore {
+	return &JobStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+const jobSelectColumns = `job_id, tenant_id, kind, status, payload, result, attempts, max_attempts, last_error, created_by,
+        next_attempt_at, created_at, updated_at, started_at, finished_at`
+
+// Enqueue inserts a queued job that is due immediately.
+func (s *JobStore) Enqueue(ctx context.Context, rec JobRecord) (JobRecord, error) {
+	if rec.JobID == uuid.Nil {
+		return JobRecord{}, errors.New("job id is required")
+	}
+	if rec.Kind == "" {
+		return JobRecord{}, errors.New("job kind is required")
+	}
+	if rec.MaxAttempts <= 0 {
+		return JobRecord{}, errors.New("job max attempts must be positive")
+	}
+	payload := rec.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage(`{}`)
+	}
+
+	var out JobRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (job_id, tenant_id, kind, status, payload, max_attempts, created_by)
+        VALUES ($1, $2, $3, '%s', $4, $5, $6)
+        RETURNING `+jobSelectColumns, JobsTable, JobQueued),
+			rec.JobID, rec.TenantID, rec.Kind, payload, rec.MaxAttempts, rec.CreatedBy)
+		var err error
+		out, err = scanJob(row)
+		return err
+	})
+	if err != nil {
+		return JobRecord{}, fmt.Errorf("enqueue job: %w", err)
+	}
+	return out, nil
+}
+
+// Get returns a job by id.
+func (s *JobStore) Get(ctx context.Context, jobID uuid.UUID) (JobRecord, error) {
+	var out JobRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT `+jobSelectColumns+`
+        FROM %s
+        WHERE job_id = $1
+    `, JobsTable), jobID)
+		var err error
+		out, err = scanJob(row)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrJobNotFound
+		}
+		return err
+	})
+	return out, err
+}
+
+// ClaimDue leases up to limit open jobs of the given kinds whose next attempt is due. Claimed jobs are marked
+// running, have their attempt counter bumped and next_attempt_at pushed out by lease, so a crashed worker only
+// delays the job. Jobs whose lease expired on their last attempt are failed rather than claimed, so a job that
+// keeps crashing its worker stops after max_attempts. Rows locked by another worker are skipped.
+func (s *JobStore) ClaimDue(ctx context.Context, kinds []string, limit int, lease time.Duration) ([]JobRecord, error) {
+	var claimed []JobRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %[1]s
+        SET status = '%[3]s', last_error = $2, finished_at = NOW(), updated_at = NOW()
+        WHERE job_id IN (
+            SELECT job_id
+            FROM %[1]s
+            WHERE status = '%[2]s' AND next_attempt_at <= NOW() AND attempts >= max_attempts AND kind = ANY($1)
+            FOR UPDATE SKIP LOCKED
+        )
+    `, JobsTable, JobRunning, JobFailed), kinds, jobLeaseExpiredError); err != nil {
+			return fmt.Errorf("fail expired jobs: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        WITH due AS (
+            SELECT job_id
+            FROM %[1]s
+            WHERE status IN ('%[2]s', '%[3]s') AND next_attempt_at <= NOW() AND attempts < max_attempts AND kind = ANY($1)
+            ORDER BY next_attempt_at
+            LIMIT $2
+            FOR UPDATE SKIP LOCKED
+        )
+        UPDATE %[1]s j
+        SET status = '%[3]s',
+            attempts = j.attempts + 1,
+            next_attempt_at = NOW() + $3 * INTERVAL '1 millisecond',
+            started_at = COALESCE(j.started_at, NOW()),
+            updated_at = NOW()
+        FROM due
+        WHERE j.job_id = due.job_id
+        RETURNING j.job_id, j.tenant_id, j.kind, j.status, j.payload, j.result, j.attempts, j.max_attempts, j.last_error,
+                  j.created_by, j.next_attempt_at, j.created_at, j.updated_at, j.started_at, j.finished_at
+    `, JobsTable, JobQueued, JobRunning), kinds, limit, lease.Milliseconds())
+		if err != nil {
+			return fmt.Errorf("claim jobs: %w", err)
+		}
+		defer rows.Close()
+
+		claimed = make([]JobRecord, 0)
+		for rows.Next() {
+			job, err := scanJob(rows)
+			if err != nil {
+				return err
+			}
+			claimed = append(claimed, job)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// Save stores the outcome of the attempt rec was claimed for: status, result, last error, next attempt and finish
+// time. It returns ErrJobLeaseLost unless the job is still running that attempt, so a worker whose lease expired
+// cannot overwrite the outcome of the worker that claimed the job again.
+func (s *JobStore) Save(ctx context.Context, rec JobRecord) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		var result any
+		if len(rec.Result) > 0 {
+			result = rec.Result
+		}
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET status = $2, result = $3, last_error = $4, next_attempt_at = $5, finished_at = $6, updated_at = NOW()
+        WHERE job_id = $1 AND status = '%s' AND attempts = $7
+    `, JobsTable, JobRunning), rec.JobID, rec.Status, result, rec.LastError, rec.NextAttemptAt, rec.FinishedAt, rec.Attempts)
+		if err != nil {
+			return fmt.Errorf("save job: %w", err)
+		}
+		if tag.RowsAffected() > 0 {
+			return nil
+		}
+
+		var exists bool
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE job_id = $1)`, JobsTable), rec.JobID).Scan(&exists); err != nil {
+			return fmt.Errorf("save job: %w", err)
+		}
+		if !exists {
+			return ErrJobNotFound
+		}
+		return ErrJobLeaseLost
+	})
+}
+
+func scanJob(row pgx.Row) (JobRecord, error) {
+	var (
+		rec     JobRecord
+		payload []byte
+		result  []byte
+	)
+	if err := row.Scan(&rec.JobID, &rec.TenantID, &rec.Kind, &rec.Status, &payload, &result, &rec.Attempts, &rec.MaxAttempts,
+		&rec.LastError, &rec.CreatedBy, &rec.NextAttemptAt, &rec.CreatedAt, &rec.UpdatedAt, &rec.StartedAt, &rec.FinishedAt); err != nil {
+		return JobRecord{}, err
+	}
+	rec.Payload = json.RawMessage(payload)
+	if len(result) > 0 {
+		rec.Result = json.RawMessage(result)
+	}
+	return rec, nil
+}
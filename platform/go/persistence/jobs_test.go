@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStoreLifecycle(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := "tenant_admin_" + uuid.NewString()[:8]
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, schema))
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE") })
+
+	store := NewJobStore(pool, schema)
+	tenantID := uuid.New()
+	createdBy := "user-1"
+
+	job, err := store.Enqueue(ctx, JobRecord{
+		JobID:       uuid.New(),
+		TenantID:    &tenantID,
+		Kind:        "export",
+		Payload:     json.RawMessage(`{"table":"cards_entities"}`),
+		MaxAttempts: 3,
+		CreatedBy:   &createdBy,
+	})
+	require.NoError(t, err)
+	require.Equal(t, JobQueued, job.Status)
+	require.Equal(t, tenantID, *job.TenantID)
+	require.Nil(t, job.Result)
+
+	_, err = store.Enqueue(ctx, JobRecord{JobID: uuid.New(), Kind: "import", MaxAttempts: 1})
+	require.NoError(t, err)
+
+	claimed, err := store.ClaimDue(ctx, []string{"export"}, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, job.JobID, claimed[0].JobID)
+	require.Equal(t, JobRunning, claimed[0].Status)
+	require.Equal(t, 1, claimed[0].Attempts)
+	require.NotNil(t, claimed[0].StartedAt)
+
+	// The lease keeps the job away from other workers.
+	again, err := store.ClaimDue(ctx, []string{"export"}, 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, again)
+
+	finished := time.Now().UTC()
+	done := claimed[0]
+	done.Status = JobSucceeded
+	done.Result = json.RawMessage(`{"rows":2}`)
+	done.FinishedAt = &finished
+	require.NoError(t, store.Save(ctx, done))
+
+	found, err := store.Get(ctx, job.JobID)
+	require.NoError(t, err)
+	require.Equal(t, JobSucceeded, found.Status)
+	require.JSONEq(t, `{"rows":2}`, string(found.Result))
+	require.NotNil(t, found.FinishedAt)
+
+	_, err = store.Get(ctx, uuid.New())
+	require.ErrorIs(t, err, ErrJobNotFound)
+	require.ErrorIs(t, store.Save(ctx, JobRecord{JobID: uuid.New(), Status: JobFailed}), ErrJobNotFound)
+	require.ErrorIs(t, store.Save(ctx, done), ErrJobLeaseLost, "a finished job takes no further outcome")
+}
+
+func TestJobStoreLeases(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := "tenant_admin_" + uuid.NewString()[:8]
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, schema))
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE") })
+
+	store := NewJobStore(pool, schema)
+	// A zero lease expires at once, as if the worker holding the job had crashed or stalled.
+	claim := func(kind string) JobRecord {
+		t.Helper()
+		claimed, err := store.ClaimDue(ctx, []string{kind}, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		return claimed[0]
+	}
+
+	t.Run("a stale attempt cannot overwrite the next one", func(t *testing.T) {
+		job, err := store.Enqueue(ctx, JobRecord{JobID: uuid.New(), Kind: "export", MaxAttempts: 3})
+		require.NoError(t, err)
+
+		stale := claim("export")
+		current := claim("export")
+		require.Equal(t, job.JobID, current.JobID)
+		require.Equal(t, 2, current.Attempts)
+
+		stale.Status, stale.Result = JobSucceeded, json.RawMessage(`{"from":"stale"}`)
+		require.ErrorIs(t, store.Save(ctx, stale), ErrJobLeaseLost)
+
+		current.Status, current.Result = JobSucceeded, json.RawMessage(`{"from":"current"}`)
+		require.NoError(t, store.Save(ctx, current))
+		found, err := store.Get(ctx, job.JobID)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"from":"current"}`, string(found.Result))
+	})
+
+	t.Run("a job crashing every attempt fails after the last one", func(t *testing.T) {
+		job, err := store.Enqueue(ctx, JobRecord{JobID: uuid.New(), Kind: "import", MaxAttempts: 2})
+		require.NoError(t, err)
+
+		claim("import")
+		require.Equal(t, 2, claim("import").Attempts)
+
+		again, err := store.ClaimDue(ctx, []string{"import"}, 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, again)
+		found, err := store.Get(ctx, job.JobID)
+		require.NoError(t, err)
+		require.Equal(t, JobFailed, found.Status)
+		require.Equal(t, 2, found.Attempts)
+		require.NotNil(t, found.FinishedAt)
+		require.NotNil(t, found.LastError)
+	})
+}
@@ -0,0 +1,146 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const TenantMembershipsTable = "tenant_memberships"
+
+// MembershipTenant is a tenant an identity can use, as listed by ListMembershipTenants.
+type MembershipTenant struct {
+	TenantID    uuid.UUID
+	Slug        string
+	DisplayName *string
+	Status      string
+	// UserID and Roles come from the membership; both are empty for the home tenant without one.
+	UserID *uuid.UUID
+	Roles  []string
+	Home   bool
+}
+
+// MembershipStore keeps cross-tenant memberships in the admin schema. The table comes from the admin migrations.
+type MembershipStore struct {
+	adminDB *SpaceDB
+}
+
+// NewMembershipStore creates a membership store scoped to the admin schema.
+func NewMembershipStore(pool *pgxpool.Pool, schema string) *MembershipStore {
+	return &MembershipStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+// UpsertMembership records that the email is a member of the tenant, replacing the user id and roles of an
+// existing membership.
+func (s *MembershipStore) UpsertMembership(ctx context.Context, membership tenant.Membership) error {
+	email := tenant.NormalizeEmail(membership.Email)
+	if email == "" || membership.TenantID == uuid.Nil || membership.UserID == uuid.Nil {
+		return errors.New("email, tenant id and user id are required")
+	}
+
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (email, tenant_id, user_id, roles)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (email, tenant_id) DO UPDATE
+        SET user_id = EXCLUDED.user_id, roles = EXCLUDED.roles, updated_at = NOW()
+    `, TenantMembershipsTable), email, membership.TenantID, membership.UserID, append([]string{}, membership.Roles...))
+		if err != nil {
+			return fmt.Errorf("upsert membership: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetUserMembershipRoles replaces the roles of every membership pointing at the user of the tenant.
+func (s *MembershipStore) SetUserMembershipRoles(ctx context.Context, tenantID, userID uuid.UUID, roles []string) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET roles = $3, updated_at = NOW() WHERE tenant_id = $1 AND user_id = $2`,
+			TenantMembershipsTable), tenantID, userID, append([]string{}, roles...))
+		if err != nil {
+			return fmt.Errorf("update membership roles: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteUserMemberships removes every membership pointing at the user of the tenant.
+func (s *MembershipStore) DeleteUserMemberships(ctx context.Context, tenantID, userID uuid.UUID) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE tenant_id = $1 AND user_id = $2`, TenantMembershipsTable), tenantID, userID); err != nil {
+			return fmt.Errorf("delete memberships: %w", err)
+		}
+		return nil
+	})
+}
+
+// ResolveMembership returns the membership of the email in the tenant, or tenant.ErrNoMembership.
+func (s *MembershipStore) ResolveMembership(ctx context.Context, email string, tenantID uuid.UUID) (tenant.Membership, error) {
+	email = tenant.NormalizeEmail(email)
+	if email == "" {
+		return tenant.Membership{}, tenant.ErrNoMembership
+	}
+
+	var out tenant.Membership
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT email, tenant_id, user_id, roles
+        FROM %s
+        WHERE email = $1 AND tenant_id = $2
+    `, TenantMembershipsTable), email, tenantID).Scan(&out.Email, &out.TenantID, &out.UserID, &out.Roles)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tenant.ErrNoMembership
+		}
+		if err != nil {
+			return fmt.Errorf("get membership: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return tenant.Membership{}, err
+	}
+	return out, nil
+}
+
+// ListMembershipTenants lists the active, non-disabled tenants the email is a member of, plus homeTenantID (the
+// tenant of the token) when set, ordered by slug.
+func (s *MembershipStore) ListMembershipTenants(ctx context.Context, email string, homeTenantID *uuid.UUID) ([]MembershipTenant, error) {
+	email = tenant.NormalizeEmail(email)
+
+	var out []MembershipTenant
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		out = out[:0]
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT t.tenant_id, t.slug, t.display_name, t.status, m.user_id, COALESCE(m.roles, '{}'),
+               t.tenant_id IS NOT DISTINCT FROM $2::uuid AS home
+        FROM tenants t
+        LEFT JOIN %s m ON m.tenant_id = t.tenant_id AND m.email = $1
+        WHERE t.is_active = TRUE AND t.is_deleted = FALSE AND t.status <> 'disabled'
+          AND (m.email IS NOT NULL OR t.tenant_id IS NOT DISTINCT FROM $2::uuid)
+        ORDER BY t.slug
+    `, TenantMembershipsTable), email, homeTenantID)
+		if err != nil {
+			return fmt.Errorf("list membership tenants: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var item MembershipTenant
+			if err := rows.Scan(&item.TenantID, &item.Slug, &item.DisplayName, &item.Status, &item.UserID, &item.Roles, &item.Home); err != nil {
+				return fmt.Errorf("scan membership tenant: %w", err)
+			}
+			out = append(out, item)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestMembershipStoreLifecycle(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	tenants, err := NewTenantStore(ctx, pool, "tenant_admin")
+	require.NoError(t, err)
+
+	createTenant := func(slug, status string) uuid.UUID {
+		id := uuid.New()
+		schema := tenant.BuildSchemaName("dev", tenant.ToSnake(slug))
+		_, err := tenants.Create(ctx, TenantRecord{
+			TenantID:      id,
+			TenantVersion: SemanticVersion{Major: 1},
+			Slug:          slug,
+			Status:        status,
+			SchemaName:    schema,
+			RoleName:      tenant.BuildRoleName(schema),
+			BasePrefix:    "dev/" + slug + "-" + id.String()[:8] + "/",
+			ShortTenantID: id.String()[:8],
+			IsActive:      true,
+			CreatedAt:     time.Now().UTC(),
+			CreatedBy:     uuid.New(),
+		})
+		require.NoError(t, err)
+		return id
+	}
+	suffix := uuid.NewString()[:8]
+	home := createTenant("home-"+suffix, "active")
+	member := createTenant("member-"+suffix, "active")
+	disabled := createTenant("off-"+suffix, "disabled")
+
+	store := NewMembershipStore(pool, "tenant_admin")
+	email := "ann-" + suffix + "@example.com"
+	userID := uuid.New()
+
+	_, err = store.ResolveMembership(ctx, email, member)
+	require.ErrorIs(t, err, tenant.ErrNoMembership)
+
+	require.NoError(t, store.UpsertMembership(ctx, tenant.Membership{Email: " Ann-" + suffix + "@Example.com", TenantID: member, UserID: userID, Roles: []string{"viewer"}}))
+	require.NoError(t, store.UpsertMembership(ctx, tenant.Membership{Email: email, TenantID: disabled, UserID: uuid.New()}))
+	require.NoError(t, store.SetUserMembershipRoles(ctx, member, userID, []string{"editor"}))
+
+	membership, err := store.ResolveMembership(ctx, email, member)
+	require.NoError(t, err)
+	require.Equal(t, userID, membership.UserID)
+	require.Equal(t, []string{"editor"}, membership.Roles)
+
+	// Disabled tenants are left out; the home tenant is listed without a membership.
+	listed, err := store.ListMembershipTenants(ctx, email, &home)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	require.Equal(t, home, listed[0].TenantID)
+	require.True(t, listed[0].Home)
+	require.Nil(t, listed[0].UserID)
+	require.Equal(t, member, listed[1].TenantID)
+	require.Equal(t, userID, *listed[1].UserID)
+
+	require.NoError(t, store.DeleteUserMemberships(ctx, member, userID))
+	_, err = store.ResolveMembership(ctx, email, member)
+	require.ErrorIs(t, err, tenant.ErrNoMembership)
+}
@@ -0,0 +1,276 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	sqlassets "github.com/zenGate-Global/palmyra-pro-saas/database"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SchemaMigrationsTable records, in every migrated schema, which migrations have been applied.
+const SchemaMigrationsTable = "schema_migrations"
+
+// Migration sets. The admin set runs in the admin schema; the tenant set runs in every tenant schema and in the admin
+// schema, which is also a tenant space.
+const (
+	AdminMigrationSet  = "admin"
+	TenantMigrationSet = "tenant"
+)
+
+// Migration is a forward-only SQL change set embedded from database/migrations/<set>/<version>.sql.
+type Migration struct {
+	Set     string
+	Version string
+	SQL     string
+}
+
+// LoadMigrations returns the migrations of set in version order.
+func LoadMigrations(set string) ([]Migration, error) {
+	dir := path.Join("migrations", set)
+	entries, err := fs.ReadDir(sqlassets.Migrations, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read %s migrations: %w", set, err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		body, err := fs.ReadFile(sqlassets.Migrations, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+		migrations = append(migrations, Migration{
+			Set:     set,
+			Version: strings.TrimSuffix(entry.Name(), ".sql"),
+			SQL:     string(body),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies the embedded migrations. Each migration runs in its own transaction together with its
+// schema_migrations row, under an advisory lock per schema so concurrent runners apply it once.
+type Migrator struct {
+	pool   *pgxpool.Pool
+	tables *EntityTableStore
+}
+
+// NewMigrator returns a migrator using pool.
+func NewMigrator(pool *pgxpool.Pool) *Migrator {
+	if pool == nil {
+		panic("pool is required")
+	}
+	return &Migrator{pool: pool}
+}
+
+// UseEntityTables makes MigrateTenant create the entity tables of the active schemas once the tenant set is applied,
+// so provisioned and migrated tenants can store entities right away.
+func (m *Migrator) UseEntityTables(tables *EntityTableStore) {
+	m.tables = tables
+}
+
+// MigrateAdmin applies the admin and tenant sets to the admin schema with the connection's identity and returns
+// the versions it applied.
+func (m *Migrator) MigrateAdmin(ctx context.Context, adminSchema string) ([]Migration, error) {
+	var applied []Migration
+	for _, set := range []string{AdminMigrationSet, TenantMigrationSet} {
+		done, err := m.migrate(ctx, set, adminSchema, "")
+		applied = append(applied, done...)
+		if err != nil {
+			return applied, err
+		}
+	}
+	return applied, nil
+}
+
+// MigrateTenant applies the tenant set to the space's schema under the space's role, so the tables are owned by the
+// role like the ones it creates itself, and returns the versions it applied. With UseEntityTables it then creates
+// the missing entity tables.
+func (m *Migrator) MigrateTenant(ctx context.Context, space tenant.Space) ([]Migration, error) {
+	if strings.TrimSpace(space.RoleName) == "" {
+		return nil, errors.New("space role is required in tenant.Space")
+	}
+	applied, err := m.migrate(ctx, TenantMigrationSet, space.SchemaName, space.RoleName)
+	if err != nil || m.tables == nil {
+		return applied, err
+	}
+	if err := m.tables.EnsureTables(ctx, space); err != nil {
+		return applied, fmt.Errorf("create entity tables in %s: %w", space.SchemaName, err)
+	}
+	return applied, nil
+}
+
+// TenantMigrationOptions controls MigrateTenants. Parallelism below 1 migrates one schema at a time.
+type TenantMigrationOptions struct {
+	DryRun      bool
+	Parallelism int
+}
+
+// TenantMigrationResult is the outcome for one tenant schema: the migrations applied (or pending, in a dry run)
+// and the error that stopped it, if any.
+type TenantMigrationResult struct {
+	Space      tenant.Space
+	Migrations []Migration
+	Err        error
+}
+
+// MigrateTenants applies the tenant set to every space, or lists what is pending when opts.DryRun is set, running up
+// to opts.Parallelism schemas at once. A failing schema does not stop the others; results follow the order of spaces.
+func (m *Migrator) MigrateTenants(ctx context.Context, spaces []tenant.Space, opts TenantMigrationOptions) []TenantMigrationResult {
+	parallelism := opts.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]TenantMigrationResult, len(spaces))
+	slots := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, space := range spaces {
+		results[i].Space = space
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(result *TenantMigrationResult) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if opts.DryRun {
+				result.Migrations, result.Err = m.Pending(ctx, TenantMigrationSet, result.Space.SchemaName)
+				return
+			}
+			result.Migrations, result.Err = m.MigrateTenant(ctx, result.Space)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+// Pending returns the migrations of set not yet applied to schema.
+func (m *Migrator) Pending(ctx context.Context, set, schema string) ([]Migration, error) {
+	migrations, err := LoadMigrations(set)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[string]bool{}
+	table := pgx.Identifier{schema, SchemaMigrationsTable}.Sanitize()
+	var exists bool
+	if err := m.pool.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, table).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check schema migrations table: %w", err)
+	}
+	// A schema without the table has no migration applied yet.
+	if exists {
+		rows, err := m.pool.Query(ctx, fmt.Sprintf(`SELECT version FROM %s WHERE migration_set = $1`, table), set)
+		if err != nil {
+			return nil, fmt.Errorf("list applied migrations: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var version string
+			if err := rows.Scan(&version); err != nil {
+				return nil, fmt.Errorf("scan applied migration: %w", err)
+			}
+			applied[version] = true
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("list applied migrations: %w", err)
+		}
+	}
+
+	pending := make([]Migration, 0, len(migrations))
+	for _, migration := range migrations {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) migrate(ctx context.Context, set, schema, role string) ([]Migration, error) {
+	if strings.TrimSpace(schema) == "" {
+		return nil, errors.New("schema is required")
+	}
+	migrations, err := LoadMigrations(set)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range migrations {
+		ran, err := m.apply(ctx, schema, role, migration)
+		if err != nil {
+			return applied, fmt.Errorf("apply %s migration %s to %s: %w", set, migration.Version, schema, err)
+		}
+		if ran {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, schema, role string, migration Migration) (bool, error) {
+	tx, err := m.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, SchemaMigrationsTable+":"+schema); err != nil {
+		return false, fmt.Errorf("lock schema migrations: %w", err)
+	}
+	if role != "" {
+		if _, err := tx.Exec(ctx, "SET LOCAL ROLE "+pgx.Identifier{role}.Sanitize()); err != nil {
+			return false, fmt.Errorf("set role: %w", err)
+		}
+	}
+	if _, err := tx.Exec(ctx, `SELECT set_config('search_path', $1, true)`, schema); err != nil {
+		return false, fmt.Errorf("set search_path: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS %s (
+    migration_set TEXT NOT NULL,
+    version TEXT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (migration_set, version)
+);`, SchemaMigrationsTable)); err != nil {
+		return false, fmt.Errorf("ensure schema migrations table: %w", err)
+	}
+
+	var done bool
+	if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE migration_set = $1 AND version = $2)`,
+		SchemaMigrationsTable), migration.Set, migration.Version).Scan(&done); err != nil {
+		return false, fmt.Errorf("check migration: %w", err)
+	}
+	if done {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, migration.SQL); err != nil {
+		return false, err
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s (migration_set, version) VALUES ($1, $2)`, SchemaMigrationsTable),
+		migration.Set, migration.Version); err != nil {
+		return false, fmt.Errorf("record migration: %w", err)
+	}
+	return true, tx.Commit(ctx)
+}
@@ -0,0 +1,83 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestLoadMigrationsOrdersVersions(t *testing.T) {
+	t.Parallel()
+
+	for _, set := range []string{AdminMigrationSet, TenantMigrationSet} {
+		migrations, err := LoadMigrations(set)
+		require.NoError(t, err)
+		require.NotEmpty(t, migrations)
+		for i, migration := range migrations {
+			require.Equal(t, set, migration.Set)
+			require.NotEmpty(t, migration.SQL)
+			if i > 0 {
+				require.Less(t, migrations[i-1].Version, migration.Version)
+			}
+		}
+	}
+
+	_, err := LoadMigrations("unknown")
+	require.Error(t, err)
+}
+
+func TestMigratorAppliesTenantMigrationsOnce(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := tenant.BuildSchemaName("dev", "migrate_"+uuid.NewString()[:8])
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+	} {
+		_, err := pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DROP SCHEMA IF EXISTS `+schema+` CASCADE`)
+		_, _ = pool.Exec(context.Background(), `DROP ROLE IF EXISTS `+role)
+	})
+
+	migrator := NewMigrator(pool)
+	all, err := LoadMigrations(TenantMigrationSet)
+	require.NoError(t, err)
+
+	pending, err := migrator.Pending(ctx, TenantMigrationSet, schema)
+	require.NoError(t, err)
+	require.Equal(t, all, pending)
+
+	space := tenant.Space{SchemaName: schema, RoleName: role}
+	applied, err := migrator.MigrateTenant(ctx, space)
+	require.NoError(t, err)
+	require.Equal(t, all, applied)
+
+	applied, err = migrator.MigrateTenant(ctx, space)
+	require.NoError(t, err)
+	require.Empty(t, applied)
+
+	pending, err = migrator.Pending(ctx, TenantMigrationSet, schema)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	var owner string
+	require.NoError(t, pool.QueryRow(ctx, `SELECT tableowner FROM pg_tables WHERE schemaname = $1 AND tablename = $2`, schema, RolesTable).Scan(&owner))
+	require.Equal(t, role, owner)
+}
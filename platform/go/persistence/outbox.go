@@ -0,0 +1,191 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const OutboxTable = "outbox_events"
+
+// Outbox topics used by the built-in producers.
+const (
+	OutboxTopicEntities = "entities"
+	OutboxTopicTenants  = "tenants"
+)
+
+// OutboxEvent is a domain event stored in the transactional outbox until it is published.
+// Key groups events that must be delivered in order (e.g. all changes of one entity).
+type OutboxEvent struct {
+	EventID    uuid.UUID
+	Topic      string
+	EventType  string
+	Key        string
+	Payload    json.RawMessage
+	OccurredAt time.Time
+	Attempts   int
+}
+
+// OutboxStore appends and drains outbox events using the caller's transaction.
+// The table lives in whatever schema the transaction's search_path points at, so the same helper
+// serves the admin schema (tenant registry events) and every tenant schema (entity events).
+type OutboxStore struct{}
+
+// NewOutboxStore returns an outbox helper; the tables come from the admin and tenant migrations.
+func NewOutboxStore() *OutboxStore {
+	return &OutboxStore{}
+}
+
+// Append stores events in the outbox as part of tx.
+func (o *OutboxStore) Append(ctx context.Context, tx pgx.Tx, events ...OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	rows := make([][]any, 0, len(events))
+	for _, event := range events {
+		if event.Topic == "" || event.EventType == "" {
+			return fmt.Errorf("outbox event requires topic and type")
+		}
+		eventID := event.EventID
+		if eventID == uuid.Nil {
+			eventID = uuid.New()
+		}
+		occurredAt := event.OccurredAt
+		if occurredAt.IsZero() {
+			occurredAt = time.Now().UTC()
+		}
+		payload := []byte(event.Payload)
+		if len(payload) == 0 {
+			payload = []byte("{}")
+		}
+		rows = append(rows, []any{eventID, event.Topic, event.EventType, event.Key, payload, occurredAt})
+	}
+
+	columns := []string{"event_id", "topic", "event_type", "event_key", "payload", "occurred_at"}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{OutboxTable}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("append outbox events: %w", err)
+	}
+	return nil
+}
+
+// ClaimPending locks up to limit unpublished events in occurrence order. Rows locked by another
+// relay are skipped, and the locks are held until tx ends.
+func (o *OutboxStore) ClaimPending(ctx context.Context, tx pgx.Tx, limit int) ([]OutboxEvent, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT event_id, topic, event_type, event_key, payload, occurred_at, attempts
+        FROM %s
+        WHERE published_at IS NULL
+        ORDER BY occurred_at, event_id
+        LIMIT $1
+        FOR UPDATE SKIP LOCKED
+    `, OutboxTable), limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]OutboxEvent, 0)
+	for rows.Next() {
+		var (
+			event   OutboxEvent
+			payload []byte
+		)
+		if err := rows.Scan(&event.EventID, &event.Topic, &event.EventType, &event.Key, &payload, &event.OccurredAt, &event.Attempts); err != nil {
+			return nil, fmt.Errorf("scan outbox event: %w", err)
+		}
+		event.Payload = json.RawMessage(payload)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkPublished flags the given events as published.
+func (o *OutboxStore) MarkPublished(ctx context.Context, tx pgx.Tx, eventIDs []uuid.UUID) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET published_at = NOW(), attempts = attempts + 1, last_error = NULL
+        WHERE event_id = ANY($1)
+    `, OutboxTable), eventIDs); err != nil {
+		return fmt.Errorf("mark outbox events published: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed publish attempt; the event stays pending and is retried on the next pass.
+func (o *OutboxStore) MarkFailed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, cause error) error {
+	message := cause.Error()
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET attempts = attempts + 1, last_error = $2
+        WHERE event_id = $1
+    `, OutboxTable), eventID, message); err != nil {
+		return fmt.Errorf("mark outbox event failed: %w", err)
+	}
+	return nil
+}
+
+// RecordEntityEvents implements EntityEventSink by appending entity changes to the outbox.
+func (o *OutboxStore) RecordEntityEvents(ctx context.Context, tx pgx.Tx, events []EntityEvent) error {
+	outboxEvents := make([]OutboxEvent, 0, len(events))
+	for _, event := range events {
+		outboxEvent, err := entityOutboxEvent(event)
+		if err != nil {
+			return err
+		}
+		outboxEvents = append(outboxEvents, outboxEvent)
+	}
+	return o.Append(ctx, tx, outboxEvents...)
+}
+
+// entityOutboxPayload is the published body of entity events.
+type entityOutboxPayload struct {
+	TableName     string          `json:"tableName"`
+	EntityID      string          `json:"entityId"`
+	EntityVersion string          `json:"entityVersion,omitempty"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+}
+
+func entityOutboxEvent(event EntityEvent) (OutboxEvent, error) {
+	body, err := json.Marshal(entityOutboxPayload{
+		TableName:     event.TableName,
+		EntityID:      event.EntityID,
+		EntityVersion: event.EntityVersion,
+		Payload:       event.Payload,
+	})
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("encode entity event: %w", err)
+	}
+
+	return OutboxEvent{
+		Topic:      OutboxTopicEntities,
+		EventType:  event.Type,
+		Key:        event.TableName + "/" + event.EntityID,
+		Payload:    body,
+		OccurredAt: event.OccurredAt,
+	}, nil
+}
+
+// EntityEventSinks fans entity events out to several sinks within the same transaction.
+type EntityEventSinks []EntityEventSink
+
+// RecordEntityEvents implements EntityEventSink.
+func (s EntityEventSinks) RecordEntityEvents(ctx context.Context, tx pgx.Tx, events []EntityEvent) error {
+	for _, sink := range s {
+		if sink == nil {
+			continue
+		}
+		if err := sink.RecordEntityEvents(ctx, tx, events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
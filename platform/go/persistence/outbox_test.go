@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityOutboxEvent(t *testing.T) {
+	t.Parallel()
+
+	occurredAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	event, err := entityOutboxEvent(EntityEvent{
+		Type:          EntityEventCreated,
+		TableName:     "cards_entities",
+		EntityID:      "card-1",
+		EntityVersion: "1.0.0",
+		Payload:       json.RawMessage(`{"name":"Ace"}`),
+		OccurredAt:    occurredAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, OutboxTopicEntities, event.Topic)
+	require.Equal(t, EntityEventCreated, event.EventType)
+	require.Equal(t, "cards_entities/card-1", event.Key)
+	require.Equal(t, occurredAt, event.OccurredAt)
+	require.JSONEq(t, `{"tableName":"cards_entities","entityId":"card-1","entityVersion":"1.0.0","payload":{"name":"Ace"}}`, string(event.Payload))
+
+	deleted, err := entityOutboxEvent(EntityEvent{Type: EntityEventDeleted, TableName: "cards_entities", EntityID: "card-1"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"tableName":"cards_entities","entityId":"card-1"}`, string(deleted.Payload))
+}
+
+func TestTenantLifecycleEvents(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		prev TenantRecord
+		next TenantRecord
+		want []string
+	}{
+		{
+			name: "provisioning completes",
+			prev: TenantRecord{Status: "provisioning", DBReady: true},
+			next: TenantRecord{Status: "active", DBReady: true, AuthReady: true},
+			want: []string{TenantEventProvisioned},
+		},
+		{
+			name: "maintenance ends",
+			prev: TenantRecord{Status: "maintenance", DBReady: true, AuthReady: true},
+			next: TenantRecord{Status: "active", DBReady: true, AuthReady: true},
+		},
+		{
+			name: "disabled before teardown",
+			prev: TenantRecord{Status: "active", DBReady: true, AuthReady: true},
+			next: TenantRecord{Status: "disabled", DBReady: true, AuthReady: true},
+			want: []string{TenantEventDisabled},
+		},
+		{
+			name: "teardown finishes",
+			prev: TenantRecord{Status: "disabled", DBReady: true, AuthReady: true},
+			next: TenantRecord{Status: "disabled"},
+			want: []string{TenantEventDeprovisioned},
+		},
+		{
+			name: "partial teardown",
+			prev: TenantRecord{Status: "disabled", DBReady: true, AuthReady: true},
+			next: TenantRecord{Status: "disabled", DBReady: true},
+		},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.want, tenantLifecycleEvents(tc.prev, tc.next), tc.name)
+	}
+}
+
+func TestTenantOutboxEventsKeepOrder(t *testing.T) {
+	t.Parallel()
+
+	rec := TenantRecord{TenantID: uuid.New(), Slug: "acme", Status: "active", CreatedAt: time.Now().UTC()}
+	events, err := tenantOutboxEvents(rec, "provisioning", TenantEventUpdated, TenantEventProvisioned)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, TenantEventUpdated, events[0].EventType)
+	require.Equal(t, TenantEventProvisioned, events[1].EventType)
+	require.Less(t, events[0].EventID.String(), events[1].EventID.String())
+	require.Equal(t, rec.TenantID.String(), events[1].Key)
+	require.Contains(t, string(events[1].Payload), `"previousStatus":"provisioning"`)
+}
@@ -0,0 +1,163 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TenantStatusCounts holds the number of live tenants per status.
+type TenantStatusCounts map[string]int64
+
+// UsageTotals adds up the latest usage snapshot of every live tenant. MeasuredAt is the oldest snapshot included and
+// nil when no tenant was measured yet.
+type UsageTotals struct {
+	Users           int64
+	Entities        int64
+	MeasuredTenants int64
+	MeasuredAt      *time.Time
+}
+
+// TenantJobFailure is a failed tenant job together with the slug of its tenant, when the tenant still exists.
+type TenantJobFailure struct {
+	JobID      uuid.UUID
+	TenantID   uuid.UUID
+	TenantSlug *string
+	Kind       string
+	Attempts   int
+	LastError  *string
+	FailedAt   time.Time
+}
+
+// PoolHealth is the outcome of a ping through the pool and the pool's connection counters.
+type PoolHealth struct {
+	PingErr           error
+	PingDuration      time.Duration
+	MaxConns          int32
+	TotalConns        int32
+	IdleConns         int32
+	AcquiredConns     int32
+	EmptyAcquireCount int64
+}
+
+// PlatformStatsStore aggregates platform-wide figures from the admin schema for the admin dashboard.
+type PlatformStatsStore struct {
+	pool    *pgxpool.Pool
+	adminDB *SpaceDB
+}
+
+// NewPlatformStatsStore creates a statistics store scoped to the admin schema.
+func NewPlatformStatsStore(pool *pgxpool.Pool, schema string) *PlatformStatsStore {
+	if pool == nil {
+		panic("pool is required")
+	}
+	return &PlatformStatsStore{pool: pool, adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+// TenantStatusCounts counts the active version of every tenant that is not deleted, by status.
+func (s *PlatformStatsStore) TenantStatusCounts(ctx context.Context) (TenantStatusCounts, error) {
+	out := make(TenantStatusCounts)
+	err := s.adminDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+        SELECT status, COUNT(*)
+        FROM tenants
+        WHERE is_active = TRUE AND is_deleted = FALSE
+        GROUP BY status
+    `)
+		if err != nil {
+			return fmt.Errorf("count tenants: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				status string
+				count  int64
+			)
+			if err := rows.Scan(&status, &count); err != nil {
+				return err
+			}
+			out[status] = count
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsageTotals sums the latest usage snapshot of each tenant that is not deleted.
+func (s *PlatformStatsStore) UsageTotals(ctx context.Context) (UsageTotals, error) {
+	var out UsageTotals
+	err := s.adminDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, fmt.Sprintf(`
+        WITH latest AS (
+            SELECT DISTINCT ON (u.tenant_id) u.tenant_id, u.measured_at, u.users, u.entities
+            FROM %s u
+            JOIN tenants t ON t.tenant_id = u.tenant_id AND t.is_active = TRUE AND t.is_deleted = FALSE
+            ORDER BY u.tenant_id, u.measured_at DESC
+        )
+        SELECT COALESCE(SUM(users), 0)::BIGINT, COALESCE(SUM(entities), 0)::BIGINT, COUNT(*), MIN(measured_at)
+        FROM latest
+    `, TenantUsageSnapshotsTable)).Scan(&out.Users, &out.Entities, &out.MeasuredTenants, &out.MeasuredAt)
+		if err != nil {
+			return fmt.Errorf("sum usage snapshots: %w", err)
+		}
+		return nil
+	})
+	return out, err
+}
+
+// RecentTenantJobFailures returns up to limit failed tenant jobs, newest first.
+func (s *PlatformStatsStore) RecentTenantJobFailures(ctx context.Context, limit int) ([]TenantJobFailure, error) {
+	out := make([]TenantJobFailure, 0)
+	err := s.adminDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT j.job_id, j.tenant_id, t.slug, j.kind, j.attempts, j.last_error, COALESCE(j.finished_at, j.updated_at)
+        FROM %s j
+        LEFT JOIN tenants t ON t.tenant_id = j.tenant_id AND t.is_active = TRUE
+        WHERE j.status = '%s'
+        ORDER BY COALESCE(j.finished_at, j.updated_at) DESC, j.job_id
+        LIMIT $1
+    `, TenantJobsTable, TenantJobFailed), limit)
+		if err != nil {
+			return fmt.Errorf("list failed tenant jobs: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var rec TenantJobFailure
+			if err := rows.Scan(&rec.JobID, &rec.TenantID, &rec.TenantSlug, &rec.Kind, &rec.Attempts, &rec.LastError, &rec.FailedAt); err != nil {
+				return err
+			}
+			out = append(out, rec)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PoolHealth pings the database through the pool and reports its connection counters. A failed ping is reported
+// in PingErr rather than returned, so callers can still show the counters.
+func (s *PlatformStatsStore) PoolHealth(ctx context.Context) PoolHealth {
+	started := time.Now()
+	err := s.pool.Ping(ctx)
+	stat := s.pool.Stat()
+	return PoolHealth{
+		PingErr:           err,
+		PingDuration:      time.Since(started),
+		MaxConns:          stat.MaxConns(),
+		TotalConns:        stat.TotalConns(),
+		IdleConns:         stat.IdleConns(),
+		AcquiredConns:     stat.AcquiredConns(),
+		EmptyAcquireCount: stat.EmptyAcquireCount(),
+	}
+}
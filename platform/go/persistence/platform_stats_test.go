@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestPlatformStatsStoreAggregates(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := "tenant_admin_" + uuid.NewString()[:8]
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, schema))
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE") })
+
+	tenants, err := NewTenantStore(ctx, pool, schema)
+	require.NoError(t, err)
+	createTenant := func(slug, status string) uuid.UUID {
+		tenantID := uuid.New()
+		tenantSchema := tenant.BuildSchemaName("dev", slug)
+		_, err := tenants.Create(ctx, TenantRecord{
+			TenantID:      tenantID,
+			TenantVersion: SemanticVersion{Major: 1},
+			Slug:          slug,
+			Status:        status,
+			SchemaName:    tenantSchema,
+			RoleName:      tenant.BuildRoleName(tenantSchema),
+			BasePrefix:    "dev/" + slug + "-" + tenantID.String()[:8] + "/",
+			ShortTenantID: tenantID.String()[:8],
+			IsActive:      true,
+			CreatedAt:     time.Now().UTC(),
+			CreatedBy:     uuid.New(),
+		})
+		require.NoError(t, err)
+		return tenantID
+	}
+	acme := createTenant("acme", "active")
+	globex := createTenant("globex", "active")
+	createTenant("initech", "provisioning")
+
+	snapshots := NewTenantUsageSnapshotStore(NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema}))
+	earlier := time.Now().UTC().Add(-2 * time.Hour).Truncate(time.Millisecond)
+	require.NoError(t, snapshots.RecordUsageSnapshot(ctx, acme, tenant.Usage{Users: 1, Entities: 10}, earlier))
+	require.NoError(t, snapshots.RecordUsageSnapshot(ctx, acme, tenant.Usage{Users: 3, Entities: 40}, earlier.Add(time.Hour)))
+	require.NoError(t, snapshots.RecordUsageSnapshot(ctx, globex, tenant.Usage{Users: 2, Entities: 5}, earlier))
+
+	jobs := NewTenantJobStore(pool, schema)
+	job, err := jobs.Enqueue(ctx, TenantJobRecord{JobID: uuid.New(), TenantID: globex, Kind: TenantJobKindProvision})
+	require.NoError(t, err)
+	msg := "create role: permission denied"
+	finished := time.Now().UTC()
+	job.Status, job.LastError, job.FinishedAt = TenantJobFailed, &msg, &finished
+	require.NoError(t, jobs.Save(ctx, job))
+
+	store := NewPlatformStatsStore(pool, schema)
+
+	counts, err := store.TenantStatusCounts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, TenantStatusCounts{"active": 2, "provisioning": 1}, counts)
+
+	usage, err := store.UsageTotals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), usage.Users)
+	require.Equal(t, int64(45), usage.Entities)
+	require.Equal(t, int64(2), usage.MeasuredTenants)
+	require.True(t, earlier.Equal(*usage.MeasuredAt))
+
+	failures, err := store.RecentTenantJobFailures(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	require.Equal(t, job.JobID, failures[0].JobID)
+	require.Equal(t, "globex", *failures[0].TenantSlug)
+	require.Equal(t, msg, *failures[0].LastError)
+
+	health := store.PoolHealth(ctx)
+	require.NoError(t, health.PingErr)
+	require.Positive(t, health.MaxConns)
+}
@@ -17,10 +17,49 @@ type PoolConfig struct {
 	MaxConnLifetime     time.Duration // recycle connections after this duration (0 leaves pgx default)
 	MaxConnIdleTime     time.Duration // close idle connections after this duration (0 leaves pgx default)
 	HealthCheckInterval time.Duration // override pgx health check period (0 leaves pgx default)
+	ReadPool            *PoolConfig   // optional read replica with its own DSN; see NewReadPool
 }
 
 // NewPool builds a pgxpool.Pool using the shared configuration and eagerly verifies connectivity.
 func NewPool(ctx context.Context, cfg PoolConfig) (*pgxpool.Pool, error) {
+	poolConfig, err := parsePoolConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create pgx pool: %w", err)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping postgres: %w", err)
+	}
+
+	return pool, nil
+}
+
+// NewReadPool builds the read replica pool described by cfg.ReadPool and returns nil when none is configured.
+// Connectivity is not verified: SpaceDB falls back to the primary while the replica is unreachable.
+func NewReadPool(ctx context.Context, cfg PoolConfig) (*pgxpool.Pool, error) {
+	if cfg.ReadPool == nil || cfg.ReadPool.ConnString == "" {
+		return nil, nil
+	}
+
+	poolConfig, err := parsePoolConfig(*cfg.ReadPool)
+	if err != nil {
+		return nil, fmt.Errorf("read pool: %w", err)
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create read pgx pool: %w", err)
+	}
+	return pool, nil
+}
+
+func parsePoolConfig(cfg PoolConfig) (*pgxpool.Config, error) {
 	if cfg.ConnString == "" {
 		return nil, fmt.Errorf("conn string is required")
 	}
@@ -45,18 +84,8 @@ func NewPool(ctx context.Context, cfg PoolConfig) (*pgxpool.Pool, error) {
 	if cfg.HealthCheckInterval > 0 {
 		poolConfig.HealthCheckPeriod = cfg.HealthCheckInterval
 	}
-
-	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
-	if err != nil {
-		return nil, fmt.Errorf("create pgx pool: %w", err)
-	}
-
-	if err := pool.Ping(ctx); err != nil {
-		pool.Close()
-		return nil, fmt.Errorf("ping postgres: %w", err)
-	}
-
-	return pool, nil
+	poolConfig.ConnConfig.Tracer = queryTracer{}
+	return poolConfig, nil
 }
 
 // ClosePool shuts down the pool gracefully; safe to call with nil.
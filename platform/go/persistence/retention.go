@@ -0,0 +1,314 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// PurgeAuditTable records every record permanently removed by the retention cleanup (admin schema).
+const PurgeAuditTable = "purge_audit"
+
+// PurgeKind identifies what a purged record was.
+type PurgeKind string
+
+// Purge kinds.
+const (
+	PurgeEntity        PurgeKind = "entity"
+	PurgeSchemaVersion PurgeKind = "schema_version"
+	PurgeCategory      PurgeKind = "category"
+)
+
+// errPurgeDryRun rolls back a dry-run purge after it ran, so dry runs report exactly what a real run removes.
+var errPurgeDryRun = errors.New("purge dry run")
+
+// PurgeOptions selects the soft-deleted records a purge removes: those deleted before Before. Dry runs roll the
+// purge back and write no audit records. RunID groups the audit records of one cleanup run; PurgedBy names the
+// actor in them.
+type PurgeOptions struct {
+	Before   time.Time
+	DryRun   bool
+	RunID    uuid.UUID
+	PurgedBy string
+}
+
+// PurgedRecord describes one purged (or, with Reason set, skipped) record. Entities carry the number of versions
+// removed with them; schema versions carry their version.
+type PurgedRecord struct {
+	Kind      PurgeKind
+	TenantID  *uuid.UUID
+	TableName string
+	RecordID  string
+	Version   string
+	Versions  int
+	DeletedAt time.Time
+	Reason    string
+}
+
+// PurgeReport lists the records a purge removed and the expired ones it had to keep.
+type PurgeReport struct {
+	Purged  []PurgedRecord
+	Skipped []PurgedRecord
+}
+
+// RetentionStore permanently removes soft-deleted entities, schema versions and schema categories once their
+// retention window has passed, recording each removal in purge_audit. Records deleted before their deletion time
+// was tracked fall back to their creation time.
+type RetentionStore struct {
+	db      *SpaceDB
+	schemas *SchemaRepositoryStore
+}
+
+// NewRetentionStore constructs a retention store. schemas is optional; when set its cache is invalidated for the
+// purged schema versions.
+func NewRetentionStore(db *SpaceDB, schemas *SchemaRepositoryStore) *RetentionStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &RetentionStore{db: db, schemas: schemas}
+}
+
+// PurgeEntities removes the entities of space whose versions are all deleted, the last one before opts.Before,
+// from every catalog entity table in the tenant schema. It runs in one admin transaction, like the tenant clone.
+func (s *RetentionStore) PurgeEntities(ctx context.Context, space tenant.Space, opts PurgeOptions) (PurgeReport, error) {
+	var report PurgeReport
+	err := s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		report = PurgeReport{}
+		rows, err := tx.Query(ctx, `
+			SELECT DISTINCT sr.table_name
+			FROM schema_repository sr
+			JOIN pg_catalog.pg_tables t ON t.schemaname = $1 AND t.tablename = sr.table_name
+			ORDER BY sr.table_name
+		`, space.SchemaName)
+		if err != nil {
+			return fmt.Errorf("list entity tables: %w", err)
+		}
+		tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("list entity tables: %w", err)
+		}
+
+		tenantID := space.TenantID
+		for _, table := range tables {
+			rows, err := tx.Query(ctx, fmt.Sprintf(`
+				WITH expired AS (
+					SELECT entity_id
+					FROM %[1]s
+					GROUP BY entity_id
+					HAVING bool_and(is_deleted) AND MAX(COALESCE(deleted_at, created_at)) < $1
+				), purged AS (
+					DELETE FROM %[1]s
+					WHERE entity_id IN (SELECT entity_id FROM expired)
+					RETURNING entity_id, COALESCE(deleted_at, created_at) AS deleted_at
+				)
+				SELECT entity_id, COUNT(*)::int, MAX(deleted_at)
+				FROM purged
+				GROUP BY entity_id
+				ORDER BY entity_id
+			`, pgx.Identifier{space.SchemaName, table}.Sanitize()), opts.Before)
+			if err != nil {
+				return fmt.Errorf("purge entities of %s: %w", table, err)
+			}
+			purged, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (PurgedRecord, error) {
+				record := PurgedRecord{Kind: PurgeEntity, TenantID: &tenantID, TableName: table}
+				err := row.Scan(&record.RecordID, &record.Versions, &record.DeletedAt)
+				return record, err
+			})
+			if err != nil {
+				return fmt.Errorf("purge entities of %s: %w", table, err)
+			}
+			report.Purged = append(report.Purged, purged...)
+		}
+
+		return finishPurgeTx(ctx, tx, opts, report.Purged)
+	})
+	if errors.Is(err, errPurgeDryRun) {
+		err = nil
+	}
+	return report, err
+}
+
+// PurgeSchemas removes the schema versions deleted before opts.Before, then the schema categories deleted before it
+// that no schema or child category references any more. Versions still referenced by entity rows in any tenant are
+// skipped. Slug aliases of schemas left without versions go with them.
+func (s *RetentionStore) PurgeSchemas(ctx context.Context, opts PurgeOptions) (PurgeReport, error) {
+	var report PurgeReport
+	err := s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		report = PurgeReport{}
+		if err := s.purgeSchemaVersionsTx(ctx, tx, opts, &report); err != nil {
+			return err
+		}
+		if err := purgeCategoriesTx(ctx, tx, opts, &report); err != nil {
+			return err
+		}
+		return finishPurgeTx(ctx, tx, opts, report.Purged)
+	})
+	if errors.Is(err, errPurgeDryRun) {
+		err = nil
+	}
+	if err == nil && !opts.DryRun && s.schemas != nil {
+		for _, record := range report.Purged {
+			if record.Kind == PurgeSchemaVersion {
+				s.schemas.invalidate(ctx, uuid.MustParse(record.RecordID))
+			}
+		}
+	}
+	return report, err
+}
+
+func (s *RetentionStore) purgeSchemaVersionsTx(ctx context.Context, tx pgx.Tx, opts PurgeOptions, report *PurgeReport) error {
+	type candidate struct {
+		schemaID  uuid.UUID
+		version   string
+		tableName string
+		deletedAt time.Time
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT schema_id, schema_version, table_name, COALESCE(deleted_at, created_at)
+		FROM schema_repository
+		WHERE is_deleted AND COALESCE(deleted_at, created_at) < $1
+		ORDER BY schema_id, schema_version
+		FOR UPDATE
+	`, opts.Before)
+	if err != nil {
+		return fmt.Errorf("list deleted schema versions: %w", err)
+	}
+	candidates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (candidate, error) {
+		var c candidate
+		err := row.Scan(&c.schemaID, &c.version, &c.tableName, &c.deletedAt)
+		return c, err
+	})
+	if err != nil {
+		return fmt.Errorf("list deleted schema versions: %w", err)
+	}
+
+	purgedSchemas := make([]uuid.UUID, 0, len(candidates))
+	for _, c := range candidates {
+		record := PurgedRecord{Kind: PurgeSchemaVersion, TableName: c.tableName, RecordID: c.schemaID.String(), Version: c.version, Versions: 1, DeletedAt: c.deletedAt}
+
+		version, err := ParseSemanticVersion(c.version)
+		if err != nil {
+			return fmt.Errorf("parse schema version %q: %w", c.version, err)
+		}
+		entityCount, _, err := countSchemaVersionReferences(ctx, tx, c.tableName, c.schemaID, version)
+		if err != nil {
+			return err
+		}
+		if entityCount > 0 {
+			record.Reason = fmt.Sprintf("referenced by %d entity versions", entityCount)
+			report.Skipped = append(report.Skipped, record)
+			continue
+		}
+
+		// Rows outside the catalog tables (e.g. detached partitions) can still reference the version.
+		deleted, err := execInSavepoint(ctx, tx, `DELETE FROM schema_repository WHERE schema_id = $1 AND schema_version = $2`, c.schemaID, c.version)
+		if err != nil {
+			return fmt.Errorf("purge schema version: %w", err)
+		}
+		if !deleted {
+			record.Reason = "still referenced"
+			report.Skipped = append(report.Skipped, record)
+			continue
+		}
+		report.Purged = append(report.Purged, record)
+		purgedSchemas = append(purgedSchemas, c.schemaID)
+	}
+
+	if len(purgedSchemas) == 0 {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM schema_slug_aliases a
+		WHERE a.schema_id = ANY($1)
+		  AND NOT EXISTS (SELECT 1 FROM schema_repository sr WHERE sr.schema_id = a.schema_id)
+	`, purgedSchemas); err != nil {
+		return fmt.Errorf("purge schema slug aliases: %w", err)
+	}
+	return nil
+}
+
+// purgeCategoriesTx removes expired deleted categories leaf first, repeating until no further category is freed, so
+// a deleted subtree goes in one run.
+func purgeCategoriesTx(ctx context.Context, tx pgx.Tx, opts PurgeOptions, report *PurgeReport) error {
+	for {
+		rows, err := tx.Query(ctx, `
+			DELETE FROM schema_categories c
+			WHERE c.deleted_at < $1
+			  AND NOT EXISTS (SELECT 1 FROM schema_repository sr WHERE sr.category_id = c.category_id)
+			  AND NOT EXISTS (SELECT 1 FROM schema_categories ch WHERE ch.parent_category_id = c.category_id)
+			RETURNING c.category_id::text, c.deleted_at
+		`, opts.Before)
+		if err != nil {
+			return fmt.Errorf("purge schema categories: %w", err)
+		}
+		purged, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (PurgedRecord, error) {
+			record := PurgedRecord{Kind: PurgeCategory, Versions: 1}
+			err := row.Scan(&record.RecordID, &record.DeletedAt)
+			return record, err
+		})
+		if err != nil {
+			return fmt.Errorf("purge schema categories: %w", err)
+		}
+		if len(purged) == 0 {
+			return nil
+		}
+		report.Purged = append(report.Purged, purged...)
+	}
+}
+
+// execInSavepoint runs stmt in a savepoint and reports whether it succeeded; a foreign key violation rolls back to
+// the savepoint instead of aborting tx.
+func execInSavepoint(ctx context.Context, tx pgx.Tx, stmt string, args ...any) (bool, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	if _, err := savepoint.Exec(ctx, stmt, args...); err != nil {
+		_ = savepoint.Rollback(ctx)
+		if isForeignKeyViolation(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, savepoint.Commit(ctx)
+}
+
+// finishPurgeTx records the audit rows of a real purge, or rolls a dry run back by returning errPurgeDryRun.
+func finishPurgeTx(ctx context.Context, tx pgx.Tx, opts PurgeOptions, purged []PurgedRecord) error {
+	if opts.DryRun {
+		return errPurgeDryRun
+	}
+	if len(purged) == 0 {
+		return nil
+	}
+
+	var purgedBy *string
+	if opts.PurgedBy != "" {
+		purgedBy = &opts.PurgedBy
+	}
+	batch := &pgx.Batch{}
+	for _, record := range purged {
+		var tableName, version *string
+		if record.TableName != "" {
+			tableName = &record.TableName
+		}
+		if record.Version != "" {
+			version = &record.Version
+		}
+		batch.Queue(`
+			INSERT INTO `+PurgeAuditTable+` (purge_id, run_id, tenant_id, kind, table_name, record_id, record_version, versions, deleted_at, purged_by)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`, uuid.New(), opts.RunID, record.TenantID, string(record.Kind), tableName, record.RecordID, version, record.Versions, record.DeletedAt, purgedBy)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("record purge audit: %w", err)
+	}
+	return nil
+}
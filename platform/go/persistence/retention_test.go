@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestRetentionStorePurgesExpiredSoftDeletes(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping retention integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, adminSchema))
+
+	now := time.Now().UTC()
+	expired, recent := now.AddDate(0, 0, -60), now.AddDate(0, 0, -1)
+	hash := strings.Repeat("a", 64)
+
+	live, parent, child := uuid.New(), uuid.New(), uuid.New()
+	schemaID := uuid.New()
+	exec := func(stmt string, args ...any) {
+		_, err := pool.Exec(ctx, stmt, args...)
+		require.NoError(t, err)
+	}
+	exec(`INSERT INTO schema_categories (category_id, name, slug) VALUES ($1, 'Live', 'live')`, live)
+	exec(`INSERT INTO schema_categories (category_id, name, slug, deleted_at) VALUES ($1, 'Parent', 'parent', $2)`, parent, expired)
+	exec(`INSERT INTO schema_categories (category_id, parent_category_id, name, slug, deleted_at) VALUES ($1, $2, 'Child', 'child', $3)`, child, parent, expired)
+	exec(`
+		INSERT INTO schema_repository (schema_id, schema_version, schema_definition, hash, table_name, slug, category_id, is_active, is_deleted, deleted_at)
+		VALUES ($1, '1.0.0', '{}', $2, 'cards_entities', 'cards', $3, FALSE, TRUE, $4),
+		       ($1, '1.0.1', '{}', $2, 'cards_entities', 'cards', $3, TRUE, FALSE, NULL)
+	`, schemaID, hash, live, expired)
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+		`GRANT SELECT, REFERENCES ON ` + adminSchema + `.schema_repository TO ` + role,
+	} {
+		exec(stmt)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	entityRepo := &EntityRepository{tableName: "cards_entities", tableIdent: pgx.Identifier{"cards_entities"}.Sanitize()}
+	require.NoError(t, spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := entityRepo.ensureEntityTable(ctx, tx)
+		return err
+	}))
+	entities := pgx.Identifier{schema, "cards_entities"}.Sanitize()
+	exec(`
+		INSERT INTO `+entities+` (entity_id, entity_version, schema_id, schema_version, payload, hash, created_at, is_active, is_deleted, deleted_at)
+		VALUES ('gone', '1.0.0', $1, '1.0.1', '{}', $2, $3, FALSE, TRUE, $3),
+		       ('gone', '1.0.1', $1, '1.0.1', '{}', $2, $3, FALSE, TRUE, $3),
+		       ('legacy', '1.0.0', $1, '1.0.1', '{}', $2, $3, FALSE, TRUE, NULL),
+		       ('fresh', '1.0.0', $1, '1.0.1', '{}', $2, $3, FALSE, TRUE, $4),
+		       ('kept', '1.0.0', $1, '1.0.1', '{}', $2, $3, TRUE, FALSE, NULL)
+	`, schemaID, hash, expired, recent)
+
+	store := NewRetentionStore(spaceDB, nil)
+	opts := PurgeOptions{Before: now.AddDate(0, 0, -30), RunID: uuid.New(), PurgedBy: "test"}
+	countRows := func(query string) int {
+		var count int
+		require.NoError(t, pool.QueryRow(ctx, query).Scan(&count))
+		return count
+	}
+
+	// A dry run reports what a real run removes and leaves everything in place.
+	dryRun := opts
+	dryRun.DryRun = true
+	report, err := store.PurgeEntities(ctx, space, dryRun)
+	require.NoError(t, err)
+	require.Len(t, report.Purged, 2)
+	require.Equal(t, 5, countRows(`SELECT COUNT(*) FROM `+entities))
+	require.Zero(t, countRows(`SELECT COUNT(*) FROM purge_audit`))
+
+	report, err = store.PurgeEntities(ctx, space, opts)
+	require.NoError(t, err)
+	require.Len(t, report.Purged, 2)
+	require.Equal(t, "gone", report.Purged[0].RecordID)
+	require.Equal(t, 2, report.Purged[0].Versions)
+	require.Equal(t, "legacy", report.Purged[1].RecordID)
+	require.Equal(t, 2, countRows(`SELECT COUNT(*) FROM `+entities))
+
+	report, err = store.PurgeSchemas(ctx, opts)
+	require.NoError(t, err)
+	require.Empty(t, report.Skipped)
+	require.Len(t, report.Purged, 3)
+	require.Equal(t, PurgeSchemaVersion, report.Purged[0].Kind)
+	require.Equal(t, "1.0.0", report.Purged[0].Version)
+	require.Equal(t, []string{child.String(), parent.String()}, []string{report.Purged[1].RecordID, report.Purged[2].RecordID})
+	require.Equal(t, 1, countRows(`SELECT COUNT(*) FROM schema_repository`))
+	require.Equal(t, 1, countRows(`SELECT COUNT(*) FROM schema_categories`))
+
+	require.Equal(t, 5, countRows(`SELECT COUNT(*) FROM purge_audit WHERE run_id = '`+opts.RunID.String()+`'`))
+	require.Equal(t, 2, countRows(`SELECT COUNT(*) FROM purge_audit WHERE kind = 'entity' AND tenant_id = '`+space.TenantID.String()+`'`))
+}
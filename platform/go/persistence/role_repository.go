@@ -0,0 +1,262 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const (
+	RolesTable           = "roles"
+	RolePermissionsTable = "role_permissions"
+	UserRolesTable       = "user_roles"
+
+	// AdminRole is seeded in every tenant schema and grants every permission. It cannot be deleted.
+	AdminRole = "admin"
+	// adminRolePermission is the wildcard permission of AdminRole (see platformauth.PermissionAll).
+	adminRolePermission = "*"
+)
+
+// Role represents a row in the roles table together with the permissions it grants.
+type Role struct {
+	Name        string    `db:"role_name" json:"name"`
+	Description *string   `db:"description" json:"description,omitempty"`
+	Permissions []string  `db:"permissions" json:"permissions"`
+	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+var (
+	// ErrRoleNotFound indicates a missing role.
+	ErrRoleNotFound = errors.New("role not found")
+	// ErrRoleConflict indicates the role already exists.
+	ErrRoleConflict = errors.New("role conflict")
+)
+
+// RoleStore exposes persistence helpers for tenant roles, their permissions and the roles assigned to users.
+type RoleStore struct {
+	db *SpaceDB
+}
+
+// NewRoleStore returns a role store working through db. The tables come from the tenant migrations.
+func NewRoleStore(db *SpaceDB) *RoleStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &RoleStore{db: db}
+}
+
+// CreateRoleParams captures the fields required to insert a new role.
+type CreateRoleParams struct {
+	Name        string
+	Description *string
+	Permissions []string
+}
+
+// ListRoles returns every role of the tenant ordered by name.
+func (s *RoleStore) ListRoles(ctx context.Context, space tenant.Space) ([]Role, error) {
+	var roles []Role
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT r.role_name, r.description,
+               COALESCE(array_agg(p.permission ORDER BY p.permission) FILTER (WHERE p.permission IS NOT NULL), '{}'),
+               r.created_at, r.updated_at
+        FROM %s r
+        LEFT JOIN %s p ON p.role_name = r.role_name
+        GROUP BY r.role_name
+        ORDER BY r.role_name
+    `, RolesTable, RolePermissionsTable))
+		if err != nil {
+			return fmt.Errorf("list roles: %w", err)
+		}
+		roles, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (Role, error) {
+			return scanRole(row)
+		})
+		if err != nil {
+			return fmt.Errorf("scan roles: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// CreateRole inserts a role with its permissions and returns the persisted record.
+func (s *RoleStore) CreateRole(ctx context.Context, space tenant.Space, params CreateRoleParams) (Role, error) {
+	var role Role
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (role_name, description)
+        VALUES ($1, $2)
+        RETURNING created_at, updated_at
+    `, RolesTable), params.Name, params.Description).Scan(&role.CreatedAt, &role.UpdatedAt)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return ErrRoleConflict
+			}
+			return fmt.Errorf("insert role: %w", err)
+		}
+
+		permissions := append([]string{}, params.Permissions...)
+		slices.Sort(permissions)
+		permissions = slices.Compact(permissions)
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (role_name, permission)
+        SELECT $1, unnest($2::text[])
+    `, RolePermissionsTable), params.Name, permissions)
+		if err != nil {
+			return fmt.Errorf("insert role permissions: %w", err)
+		}
+
+		role.Name = params.Name
+		role.Description = params.Description
+		role.Permissions = permissions
+		return nil
+	})
+	if err != nil {
+		return Role{}, err
+	}
+	return role, nil
+}
+
+// DeleteRole removes a role; its permissions and user assignments go with it.
+func (s *RoleStore) DeleteRole(ctx context.Context, space tenant.Space, name string) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE role_name = $1`, RolesTable), name)
+		if err != nil {
+			return fmt.Errorf("delete role: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrRoleNotFound
+		}
+		return nil
+	})
+}
+
+// ListUserRoles returns the names of the roles assigned to the user, ordered by name.
+func (s *RoleStore) ListUserRoles(ctx context.Context, space tenant.Space, userID uuid.UUID) ([]string, error) {
+	var names []string
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if err := requireUserTx(ctx, tx, userID); err != nil {
+			return err
+		}
+
+		var err error
+		names, err = userRolesTx(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// SetUserRoles replaces the roles assigned to the user and returns the new assignment. Unknown roles are
+// rejected with ErrRoleNotFound and leave the previous assignment in place.
+func (s *RoleStore) SetUserRoles(ctx context.Context, space tenant.Space, userID uuid.UUID, roles []string) ([]string, error) {
+	var names []string
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if err := requireUserTx(ctx, tx, userID); err != nil {
+			return err
+		}
+
+		wanted := append([]string{}, roles...)
+		slices.Sort(wanted)
+		wanted = slices.Compact(wanted)
+
+		var known int
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE role_name = ANY($1)`, RolesTable), wanted).Scan(&known); err != nil {
+			return fmt.Errorf("check roles: %w", err)
+		}
+		if known != len(wanted) {
+			return ErrRoleNotFound
+		}
+
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1 AND role_name <> ALL($2)`, UserRolesTable), userID, wanted); err != nil {
+			return fmt.Errorf("clear user roles: %w", err)
+		}
+		_, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (user_id, role_name)
+        SELECT $1, unnest($2::text[])
+        ON CONFLICT DO NOTHING
+    `, UserRolesTable), userID, wanted)
+		if err != nil {
+			return fmt.Errorf("assign user roles: %w", err)
+		}
+
+		names, err = userRolesTx(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// RolePermissions returns the distinct permissions granted by roles plus the roles assigned to userID. A nil
+// userID only resolves roles, which is how token roles of callers without a users row are mapped. Unknown roles
+// grant nothing.
+func (s *RoleStore) RolePermissions(ctx context.Context, space tenant.Space, userID *uuid.UUID, roles []string) ([]string, error) {
+	var permissions []string
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT DISTINCT p.permission
+        FROM %s p
+        WHERE p.role_name = ANY($1)
+           OR p.role_name IN (SELECT role_name FROM %s WHERE user_id = $2)
+        ORDER BY p.permission
+    `, RolePermissionsTable, UserRolesTable), roles, userID)
+		if err != nil {
+			return fmt.Errorf("resolve permissions: %w", err)
+		}
+		permissions, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("scan permissions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func requireUserTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID) error {
+	var exists bool
+	if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE user_id = $1)`, UsersTable), userID).Scan(&exists); err != nil {
+		return fmt.Errorf("check user: %w", err)
+	}
+	if !exists {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func userRolesTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID) ([]string, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT role_name FROM %s WHERE user_id = $1 ORDER BY role_name`, UserRolesTable), userID)
+	if err != nil {
+		return nil, fmt.Errorf("list user roles: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("scan user roles: %w", err)
+	}
+	return names, nil
+}
+
+func scanRole(row pgx.Row) (Role, error) {
+	var role Role
+	if err := row.Scan(&role.Name, &role.Description, &role.Permissions, &role.CreatedAt, &role.UpdatedAt); err != nil {
+		return Role{}, err
+	}
+	return role, nil
+}
@@ -0,0 +1,115 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestRoleStoreAssignsAndResolvesPermissions(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping role store integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA IF NOT EXISTS ` + adminSchema,
+		`CREATE SCHEMA IF NOT EXISTS ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+	} {
+		_, err = pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	users, err := NewUserStore(ctx, spaceDB)
+	require.NoError(t, err)
+	roles := NewRoleStore(spaceDB)
+
+	// The admin role is seeded with the wildcard permission.
+	listed, err := roles.ListRoles(ctx, space)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, AdminRole, listed[0].Name)
+	require.Equal(t, []string{"*"}, listed[0].Permissions)
+
+	_, err = roles.CreateRole(ctx, space, CreateRoleParams{Name: "editor", Permissions: []string{"users:write", "users:read", "users:read"}})
+	require.NoError(t, err)
+	_, err = roles.CreateRole(ctx, space, CreateRoleParams{Name: "editor", Permissions: []string{"users:read"}})
+	require.ErrorIs(t, err, ErrRoleConflict)
+
+	user, err := users.CreateUser(ctx, space, CreateUserParams{UserID: uuid.New(), Email: "a@example.com", FullName: "Alice A"})
+	require.NoError(t, err)
+
+	_, err = roles.SetUserRoles(ctx, space, user.UserID, []string{"editor", "ghost"})
+	require.ErrorIs(t, err, ErrRoleNotFound)
+	_, err = roles.SetUserRoles(ctx, space, uuid.New(), []string{"editor"})
+	require.ErrorIs(t, err, ErrUserNotFound)
+
+	assigned, err := roles.SetUserRoles(ctx, space, user.UserID, []string{"editor"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"editor"}, assigned)
+
+	permissions, err := roles.RolePermissions(ctx, space, &user.UserID, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"users:read", "users:write"}, permissions)
+
+	// Token roles resolve without a users row.
+	permissions, err = roles.RolePermissions(ctx, space, nil, []string{AdminRole, "unknown"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"*"}, permissions)
+
+	assigned, err = roles.SetUserRoles(ctx, space, user.UserID, nil)
+	require.NoError(t, err)
+	require.Empty(t, assigned)
+
+	_, err = roles.SetUserRoles(ctx, space, user.UserID, []string{"editor"})
+	require.NoError(t, err)
+	require.NoError(t, roles.DeleteRole(ctx, space, "editor"))
+	require.ErrorIs(t, roles.DeleteRole(ctx, space, "editor"), ErrRoleNotFound)
+
+	assigned, err = roles.ListUserRoles(ctx, space, user.UserID)
+	require.NoError(t, err)
+	require.Empty(t, assigned)
+}
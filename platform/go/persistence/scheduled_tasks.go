@@ -0,0 +1,192 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const ScheduledTasksTable = "scheduled_tasks"
+
+// Scheduled task run statuses.
+const (
+	ScheduledTaskRunning   = "running"
+	ScheduledTaskSucceeded = "succeeded"
+	ScheduledTaskFailed    = "failed"
+)
+
+// ErrScheduledTaskNotFound is returned when no task with the name was ever registered.
+var ErrScheduledTaskNotFound = errors.New("scheduled task not found")
+
+// ScheduledTaskRecord is the bookkeeping of a periodic task. TriggerRequestedAt is set while a manual run is
+// pending; the Last* fields describe the latest run and are nil before the first one.
+type ScheduledTaskRecord struct {
+	Name               string
+	Schedule           string
+	NextRunAt          time.Time
+	TriggerRequestedAt *time.Time
+	TriggerRequestedBy *string
+	LastStatus         *string
+	LastError          *string
+	LastStartedAt      *time.Time
+	LastFinishedAt     *time.Time
+	LastDuration       *time.Duration
+	UpdatedAt          time.Time
+}
+
+// ScheduledTaskRun is the outcome of a finished run and the time the task is due next.
+type ScheduledTaskRun struct {
+	Status     string
+	Error      *string
+	FinishedAt time.Time
+	Duration   time.Duration
+	NextRunAt  time.Time
+}
+
+// ScheduledTaskStore keeps the scheduled task bookkeeping in the admin schema. The table comes from the admin
+// migrations.
+type ScheduledTaskStore struct {
+	adminDB *SpaceDB
+}
+
+// NewScheduledTaskStore creates a scheduled task store scoped to the admin schema.
+func NewScheduledTaskStore(pool *pgxpool.Pool, schema string) *ScheduledTaskStore {
+	return &ScheduledTaskStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+const scheduledTaskSelectColumns = `name, schedule, next_run_at, trigger_requested_at, trigger_requested_by, last_status,
+        last_error, last_started_at, last_finished_at, last_duration_ms, updated_at`
+
+// Register records a task and its schedule. An existing task keeps its bookkeeping; its next run is only replaced
+// by nextRunAt when the schedule changed.
+func (s *ScheduledTaskStore) Register(ctx context.Context, name, schedule string, nextRunAt time.Time) (ScheduledTaskRecord, error) {
+	if name == "" {
+		return ScheduledTaskRecord{}, errors.New("scheduled task name is required")
+	}
+
+	var out ScheduledTaskRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %[1]s (name, schedule, next_run_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (name) DO UPDATE
+        SET schedule = EXCLUDED.schedule,
+            next_run_at = CASE WHEN %[1]s.schedule = EXCLUDED.schedule THEN %[1]s.next_run_at ELSE EXCLUDED.next_run_at END,
+            updated_at = NOW()
+        RETURNING `+scheduledTaskSelectColumns, ScheduledTasksTable), name, schedule, nextRunAt)
+		var err error
+		out, err = scanScheduledTask(row)
+		return err
+	})
+	if err != nil {
+		return ScheduledTaskRecord{}, fmt.Errorf("register scheduled task: %w", err)
+	}
+	return out, nil
+}
+
+// List returns every registered task ordered by name.
+func (s *ScheduledTaskStore) List(ctx context.Context) ([]ScheduledTaskRecord, error) {
+	var out []ScheduledTaskRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT `+scheduledTaskSelectColumns+`
+        FROM %s
+        ORDER BY name
+    `, ScheduledTasksTable))
+		if err != nil {
+			return fmt.Errorf("list scheduled tasks: %w", err)
+		}
+		defer rows.Close()
+
+		out = make([]ScheduledTaskRecord, 0)
+		for rows.Next() {
+			rec, err := scanScheduledTask(rows)
+			if err != nil {
+				return err
+			}
+			out = append(out, rec)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RequestRun asks the scheduler to run the task on its next tick, whatever its schedule.
+func (s *ScheduledTaskStore) RequestRun(ctx context.Context, name string, requestedBy *string) (ScheduledTaskRecord, error) {
+	var out ScheduledTaskRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET trigger_requested_at = COALESCE(trigger_requested_at, NOW()), trigger_requested_by = $2, updated_at = NOW()
+        WHERE name = $1
+        RETURNING `+scheduledTaskSelectColumns, ScheduledTasksTable), name, requestedBy)
+		var err error
+		out, err = scanScheduledTask(row)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrScheduledTaskNotFound
+		}
+		return err
+	})
+	return out, err
+}
+
+// MarkStarted records that a run started and consumes any pending trigger.
+func (s *ScheduledTaskStore) MarkStarted(ctx context.Context, name string, startedAt time.Time) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET last_status = '%s', last_started_at = $2, trigger_requested_at = NULL, trigger_requested_by = NULL,
+            updated_at = NOW()
+        WHERE name = $1
+    `, ScheduledTasksTable, ScheduledTaskRunning), name, startedAt)
+		if err != nil {
+			return fmt.Errorf("mark scheduled task started: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrScheduledTaskNotFound
+		}
+		return nil
+	})
+}
+
+// MarkFinished records the outcome of a run and when the task is due next.
+func (s *ScheduledTaskStore) MarkFinished(ctx context.Context, name string, run ScheduledTaskRun) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET last_status = $2, last_error = $3, last_finished_at = $4, last_duration_ms = $5, next_run_at = $6,
+            updated_at = NOW()
+        WHERE name = $1
+    `, ScheduledTasksTable), name, run.Status, run.Error, run.FinishedAt, run.Duration.Milliseconds(), run.NextRunAt)
+		if err != nil {
+			return fmt.Errorf("mark scheduled task finished: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrScheduledTaskNotFound
+		}
+		return nil
+	})
+}
+
+func scanScheduledTask(row pgx.Row) (ScheduledTaskRecord, error) {
+	var (
+		rec        ScheduledTaskRecord
+		durationMS *int64
+	)
+	if err := row.Scan(&rec.Name, &rec.Schedule, &rec.NextRunAt, &rec.TriggerRequestedAt, &rec.TriggerRequestedBy,
+		&rec.LastStatus, &rec.LastError, &rec.LastStartedAt, &rec.LastFinishedAt, &durationMS, &rec.UpdatedAt); err != nil {
+		return ScheduledTaskRecord{}, err
+	}
+	if durationMS != nil {
+		duration := time.Duration(*durationMS) * time.Millisecond
+		rec.LastDuration = &duration
+	}
+	return rec, nil
+}
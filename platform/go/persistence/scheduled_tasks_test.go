@@ -0,0 +1,99 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledTaskStoreLifecycle(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := "tenant_admin_" + uuid.NewString()[:8]
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, schema))
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE") })
+
+	store := NewScheduledTaskStore(pool, schema)
+	next := time.Now().UTC().Add(time.Hour).Truncate(time.Millisecond)
+
+	rec, err := store.Register(ctx, "retention-cleanup", "0 3 * * *", next)
+	require.NoError(t, err)
+	require.True(t, next.Equal(rec.NextRunAt))
+	require.Nil(t, rec.LastStatus)
+
+	// Re-registering the same schedule keeps the bookkeeping.
+	rec, err = store.Register(ctx, "retention-cleanup", "0 3 * * *", next.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, next.Equal(rec.NextRunAt))
+
+	admin := "admin"
+	_, err = store.RequestRun(ctx, "missing", &admin)
+	require.ErrorIs(t, err, ErrScheduledTaskNotFound)
+	rec, err = store.RequestRun(ctx, "retention-cleanup", &admin)
+	require.NoError(t, err)
+	require.NotNil(t, rec.TriggerRequestedAt)
+	require.Equal(t, "admin", *rec.TriggerRequestedBy)
+
+	started := time.Now().UTC()
+	require.NoError(t, store.MarkStarted(ctx, "retention-cleanup", started))
+	msg := "tenant acme: boom"
+	require.NoError(t, store.MarkFinished(ctx, "retention-cleanup", ScheduledTaskRun{
+		Status:     ScheduledTaskFailed,
+		Error:      &msg,
+		FinishedAt: started.Add(2 * time.Second),
+		Duration:   2 * time.Second,
+		NextRunAt:  next.Add(24 * time.Hour),
+	}))
+
+	tasks, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Nil(t, tasks[0].TriggerRequestedAt)
+	require.Equal(t, ScheduledTaskFailed, *tasks[0].LastStatus)
+	require.Equal(t, msg, *tasks[0].LastError)
+	require.Equal(t, 2*time.Second, *tasks[0].LastDuration)
+	require.True(t, next.Add(24*time.Hour).Equal(tasks[0].NextRunAt))
+
+	require.ErrorIs(t, store.MarkStarted(ctx, "missing", started), ErrScheduledTaskNotFound)
+}
+
+func TestAdvisoryLockElectsOneLeader(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	key := "scheduler:test_" + uuid.NewString()
+	first := NewAdvisoryLock(pool, key)
+	second := NewAdvisoryLock(pool, key)
+
+	leading, err := first.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, leading)
+	leading, err = first.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, leading)
+
+	leading, err = second.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.False(t, leading)
+
+	require.NoError(t, first.Release(ctx))
+	leading, err = second.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, leading)
+	require.NoError(t, second.Release(ctx))
+}
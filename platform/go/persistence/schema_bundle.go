@@ -0,0 +1,547 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// SchemaBundleFormatVersion is the bundle layout produced by ExportBundle and accepted by ImportBundle.
+const SchemaBundleFormatVersion = 1
+
+// Actions reported per bundle item by ImportBundle.
+const (
+	SchemaBundleActionCreate    = "create"
+	SchemaBundleActionUpdate    = "update"
+	SchemaBundleActionUnchanged = "unchanged"
+)
+
+// ErrSchemaBundleConflict is returned by ImportBundle, together with the report, when any item conflicts
+// with the target environment. Nothing is written in that case.
+var ErrSchemaBundleConflict = errors.New("schema bundle conflicts with existing data")
+
+// errSchemaBundleDryRun rolls back a dry-run import after the report has been built.
+var errSchemaBundleDryRun = errors.New("schema bundle dry run")
+
+// SchemaBundle is a portable snapshot of schema categories and schema versions used to move schemas
+// between environments. Identifiers are preserved so repeated imports are idempotent.
+type SchemaBundle struct {
+	FormatVersion int                    `json:"formatVersion"`
+	ExportedAt    time.Time              `json:"exportedAt"`
+	Categories    []SchemaBundleCategory `json:"categories"`
+	Schemas       []SchemaBundleVersion  `json:"schemas"`
+}
+
+// SchemaBundleCategory is a live schema category as stored in a bundle.
+type SchemaBundleCategory struct {
+	CategoryID       uuid.UUID  `json:"categoryId"`
+	ParentCategoryID *uuid.UUID `json:"parentCategoryId,omitempty"`
+	Name             string     `json:"name"`
+	Slug             string     `json:"slug"`
+	Description      *string    `json:"description,omitempty"`
+}
+
+// SchemaBundleVersion is a live schema version as stored in a bundle. Active marks the version to activate on import.
+type SchemaBundleVersion struct {
+	SchemaID      uuid.UUID        `json:"schemaId"`
+	SchemaVersion SemanticVersion  `json:"schemaVersion"`
+	TableName     string           `json:"tableName"`
+	Slug          string           `json:"slug"`
+	CategoryID    uuid.UUID        `json:"categoryId"`
+	Definition    SchemaDefinition `json:"schemaDefinition"`
+	Status        string           `json:"status"`
+	Active        bool             `json:"active"`
+}
+
+// Key identifies the version in reports, e.g. "cards@1.2.0".
+func (v SchemaBundleVersion) Key() string {
+	return v.Slug + "@" + v.SchemaVersion.String()
+}
+
+// SchemaBundleItem records the action planned (or taken) for one bundle item.
+type SchemaBundleItem struct {
+	Key    string `json:"key"`
+	Action string `json:"action"`
+}
+
+// SchemaBundleConflict explains why a bundle item cannot be imported.
+type SchemaBundleConflict struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+// SchemaBundleReport summarises an import. Applied is false for dry runs and when conflicts were found.
+type SchemaBundleReport struct {
+	Applied    bool                   `json:"applied"`
+	Categories []SchemaBundleItem     `json:"categories"`
+	Schemas    []SchemaBundleItem     `json:"schemas"`
+	Conflicts  []SchemaBundleConflict `json:"conflicts"`
+}
+
+// SchemaBundleStore exports and imports schema bundles across the category and schema repository tables.
+type SchemaBundleStore struct {
+	schemas    *SchemaRepositoryStore
+	categories *SchemaCategoryStore
+}
+
+// NewSchemaBundleStore constructs a bundle store on top of the existing stores.
+func NewSchemaBundleStore(schemas *SchemaRepositoryStore, categories *SchemaCategoryStore) *SchemaBundleStore {
+	if schemas == nil {
+		panic("schema repository store is required")
+	}
+	if categories == nil {
+		panic("schema category store is required")
+	}
+	return &SchemaBundleStore{schemas: schemas, categories: categories}
+}
+
+// ExportBundle snapshots the live versions of the given schemas (every schema when schemaIDs is empty) along with
+// their categories and the categories' ancestors.
+func (s *SchemaBundleStore) ExportBundle(ctx context.Context, spaceDB *SpaceDB, schemaIDs []uuid.UUID) (SchemaBundle, error) {
+	if spaceDB == nil {
+		return SchemaBundle{}, errors.New("admin db is required")
+	}
+
+	bundle := SchemaBundle{
+		FormatVersion: SchemaBundleFormatVersion,
+		ExportedAt:    time.Now().UTC(),
+		Categories:    []SchemaBundleCategory{},
+		Schemas:       []SchemaBundleVersion{},
+	}
+
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		bundle.Categories = bundle.Categories[:0]
+		bundle.Schemas = bundle.Schemas[:0]
+		records, err := s.schemas.ListAllSchemaVersionsTx(ctx, tx, true)
+		if err != nil {
+			return err
+		}
+
+		wanted := make(map[uuid.UUID]bool, len(schemaIDs))
+		for _, id := range schemaIDs {
+			wanted[id] = true
+		}
+
+		usedCategories := map[uuid.UUID]bool{}
+		for _, record := range records {
+			if record.IsDeleted || (len(wanted) > 0 && !wanted[record.SchemaID]) {
+				continue
+			}
+			bundle.Schemas = append(bundle.Schemas, SchemaBundleVersion{
+				SchemaID:      record.SchemaID,
+				SchemaVersion: record.SchemaVersion,
+				TableName:     record.TableName,
+				Slug:          record.Slug,
+				CategoryID:    record.CategoryID,
+				Definition:    record.SchemaDefinition,
+				Status:        record.Status,
+				Active:        record.IsActive,
+			})
+			usedCategories[record.CategoryID] = true
+		}
+
+		categories, err := s.categories.ListSchemaCategoriesTx(ctx, tx, false)
+		if err != nil {
+			return err
+		}
+		byID := make(map[uuid.UUID]SchemaCategory, len(categories))
+		for _, category := range categories {
+			byID[category.CategoryID] = category
+		}
+
+		// Pull in ancestors so the category tree can be rebuilt on import.
+		for id := range usedCategories {
+			for current, ok := byID[id]; ok && current.ParentCategoryID != nil; current, ok = byID[*current.ParentCategoryID] {
+				usedCategories[*current.ParentCategoryID] = true
+			}
+		}
+
+		for _, category := range categories {
+			if len(wanted) > 0 && !usedCategories[category.CategoryID] {
+				continue
+			}
+			bundle.Categories = append(bundle.Categories, SchemaBundleCategory{
+				CategoryID:       category.CategoryID,
+				ParentCategoryID: category.ParentCategoryID,
+				Name:             category.Name,
+				Slug:             category.Slug,
+				Description:      category.Description,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return SchemaBundle{}, err
+	}
+
+	sort.Slice(bundle.Schemas, func(i, j int) bool {
+		a, b := bundle.Schemas[i], bundle.Schemas[j]
+		if a.Slug != b.Slug {
+			return a.Slug < b.Slug
+		}
+		return a.SchemaVersion.Compare(b.SchemaVersion) < 0
+	})
+
+	return bundle, nil
+}
+
+// ImportBundle upserts the bundle in a single transaction. Every item is checked against the target environment
+// first; when any conflict is found nothing is written and ErrSchemaBundleConflict is returned with the report.
+// With dryRun the checks run and the report is returned without writing.
+func (s *SchemaBundleStore) ImportBundle(ctx context.Context, spaceDB *SpaceDB, bundle SchemaBundle, dryRun bool) (SchemaBundleReport, error) {
+	if spaceDB == nil {
+		return SchemaBundleReport{}, errors.New("admin db is required")
+	}
+	if bundle.FormatVersion != SchemaBundleFormatVersion {
+		return SchemaBundleReport{}, fmt.Errorf("unsupported schema bundle format version %d", bundle.FormatVersion)
+	}
+
+	var report SchemaBundleReport
+	defer func() {
+		if !report.Applied {
+			return
+		}
+		// Drop versions cached while the import was in flight; the Tx writers already invalidated them once.
+		for _, version := range bundle.Schemas {
+			s.schemas.invalidate(ctx, version.SchemaID)
+		}
+	}()
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		plan, err := s.planImport(ctx, tx, bundle)
+		if err != nil {
+			return err
+		}
+		report = plan.report
+
+		if len(report.Conflicts) > 0 {
+			return ErrSchemaBundleConflict
+		}
+		if dryRun {
+			return errSchemaBundleDryRun
+		}
+		if err := s.applyImport(ctx, tx, plan); err != nil {
+			return err
+		}
+		report.Applied = true
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errSchemaBundleDryRun):
+		return report, nil
+	case errors.Is(err, ErrSchemaBundleConflict):
+		return report, err
+	case err != nil:
+		return SchemaBundleReport{}, err
+	}
+	return report, nil
+}
+
+type schemaBundlePlan struct {
+	report     SchemaBundleReport
+	categories []plannedBundleCategory
+	versions   []plannedBundleVersion
+}
+
+type plannedBundleCategory struct {
+	category SchemaBundleCategory
+	action   string
+}
+
+type plannedBundleVersion struct {
+	version SchemaBundleVersion
+	action  string
+}
+
+func (s *SchemaBundleStore) planImport(ctx context.Context, tx pgx.Tx, bundle SchemaBundle) (schemaBundlePlan, error) {
+	plan := schemaBundlePlan{report: SchemaBundleReport{
+		Categories: []SchemaBundleItem{},
+		Schemas:    []SchemaBundleItem{},
+		Conflicts:  []SchemaBundleConflict{},
+	}}
+	conflict := func(key, message string) {
+		plan.report.Conflicts = append(plan.report.Conflicts, SchemaBundleConflict{Key: key, Message: message})
+	}
+
+	live, err := s.categories.ListSchemaCategoriesTx(ctx, tx, true)
+	if err != nil {
+		return schemaBundlePlan{}, err
+	}
+	existing := make(map[uuid.UUID]SchemaCategory, len(live))
+	for _, category := range live {
+		existing[category.CategoryID] = category
+	}
+
+	inBundle := make(map[uuid.UUID]bool, len(bundle.Categories))
+	for _, category := range bundle.Categories {
+		inBundle[category.CategoryID] = true
+	}
+	categoryAvailable := func(id uuid.UUID) bool {
+		current, ok := existing[id]
+		return inBundle[id] || (ok && current.DeletedAt == nil)
+	}
+
+	for _, category := range orderBundleCategories(bundle.Categories) {
+		key := "category:" + category.Slug
+		slug, err := NormalizeSlug(category.Slug)
+		if err != nil {
+			conflict(key, err.Error())
+			continue
+		}
+		category.Slug = slug
+
+		if category.ParentCategoryID != nil && !categoryAvailable(*category.ParentCategoryID) {
+			conflict(key, fmt.Sprintf("parent category %s does not exist", *category.ParentCategoryID))
+			continue
+		}
+
+		action := SchemaBundleActionCreate
+		if current, ok := existing[category.CategoryID]; ok {
+			switch {
+			case current.DeletedAt != nil:
+				conflict(key, "category was deleted in the target environment")
+				continue
+			case current.Slug != slug:
+				conflict(key, fmt.Sprintf("category exists with slug %q", current.Slug))
+				continue
+			case sameBundleCategory(current, category):
+				action = SchemaBundleActionUnchanged
+			default:
+				action = SchemaBundleActionUpdate
+			}
+		}
+
+		for _, other := range live {
+			if other.CategoryID == category.CategoryID || other.DeletedAt != nil {
+				continue
+			}
+			if other.Slug == slug || other.Name == category.Name {
+				conflict(key, fmt.Sprintf("name or slug already used by category %s", other.CategoryID))
+				action = ""
+				break
+			}
+		}
+		if action == "" {
+			continue
+		}
+
+		plan.categories = append(plan.categories, plannedBundleCategory{category: category, action: action})
+		plan.report.Categories = append(plan.report.Categories, SchemaBundleItem{Key: key, Action: action})
+	}
+
+	versions := append([]SchemaBundleVersion(nil), bundle.Schemas...)
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].SchemaID != versions[j].SchemaID {
+			return versions[i].SchemaID.String() < versions[j].SchemaID.String()
+		}
+		return versions[i].SchemaVersion.Compare(versions[j].SchemaVersion) < 0
+	})
+
+	for _, version := range versions {
+		action, message, err := s.planBundleVersion(ctx, tx, version, categoryAvailable)
+		if err != nil {
+			return schemaBundlePlan{}, err
+		}
+		if message != "" {
+			conflict(version.Key(), message)
+			continue
+		}
+		plan.versions = append(plan.versions, plannedBundleVersion{version: version, action: action})
+		plan.report.Schemas = append(plan.report.Schemas, SchemaBundleItem{Key: version.Key(), Action: action})
+	}
+
+	return plan, nil
+}
+
+// planBundleVersion returns the action for one version, or a conflict message.
+func (s *SchemaBundleStore) planBundleVersion(ctx context.Context, tx pgx.Tx, version SchemaBundleVersion, categoryAvailable func(uuid.UUID) bool) (string, string, error) {
+	if version.Status != "" && version.Status != SchemaStatusDraft && version.Status != SchemaStatusPublished {
+		return "", fmt.Sprintf("unsupported status %q", version.Status), nil
+	}
+	if version.Active && version.Status == SchemaStatusDraft {
+		return "", "draft versions cannot be active", nil
+	}
+	if !categoryAvailable(version.CategoryID) {
+		return "", fmt.Sprintf("category %s does not exist", version.CategoryID), nil
+	}
+
+	var definitionErr *InvalidSchemaDefinitionError
+	if err := ValidateSchemaDefinition(version.Definition); errors.As(err, &definitionErr) {
+		return "", fmt.Sprintf("invalid schema definition (%d issue(s))", len(definitionErr.Issues)), nil
+	} else if err != nil {
+		return "", "", err
+	}
+
+	hash, err := computeJSONHash(version.Definition)
+	if err != nil {
+		return "", "", fmt.Errorf("compute schema hash: %w", err)
+	}
+
+	var (
+		tableName, slug, otherSchema string
+	)
+	switch err := tx.QueryRow(ctx, `
+		SELECT table_name, slug
+		FROM schema_repository
+		WHERE schema_id = $1
+		LIMIT 1
+	`, version.SchemaID).Scan(&tableName, &slug); {
+	case err == nil:
+		if tableName != version.TableName || slug != version.Slug {
+			return "", fmt.Sprintf("schema exists with table %q and slug %q", tableName, slug), nil
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return "", "", fmt.Errorf("lookup schema %s: %w", version.SchemaID, err)
+	}
+
+	switch err := tx.QueryRow(ctx, `
+		SELECT schema_id::text
+		FROM schema_repository
+		WHERE schema_id <> $1 AND (table_name = $2 OR slug = $3) AND is_deleted = FALSE
+		LIMIT 1
+	`, version.SchemaID, version.TableName, version.Slug).Scan(&otherSchema); {
+	case err == nil:
+		return "", fmt.Sprintf("table name or slug already used by schema %s", otherSchema), nil
+	case !errors.Is(err, pgx.ErrNoRows):
+		return "", "", fmt.Errorf("lookup schema table name: %w", err)
+	}
+
+	var (
+		existingHash, existingStatus string
+		existingDeleted              bool
+	)
+	switch err := tx.QueryRow(ctx, `
+		SELECT hash, status, is_deleted
+		FROM schema_repository
+		WHERE schema_id = $1 AND schema_version = $2
+	`, version.SchemaID, version.SchemaVersion.String()).Scan(&existingHash, &existingStatus, &existingDeleted); {
+	case errors.Is(err, pgx.ErrNoRows):
+		return SchemaBundleActionCreate, "", nil
+	case err != nil:
+		return "", "", fmt.Errorf("lookup schema version: %w", err)
+	case existingDeleted:
+		return "", "version was deleted in the target environment", nil
+	case existingHash == hash && existingStatus == bundleStatus(version):
+		return SchemaBundleActionUnchanged, "", nil
+	case existingStatus == SchemaStatusDraft:
+		return SchemaBundleActionUpdate, "", nil
+	default:
+		return "", "published version exists with a different definition", nil
+	}
+}
+
+func (s *SchemaBundleStore) applyImport(ctx context.Context, tx pgx.Tx, plan schemaBundlePlan) error {
+	for _, planned := range plan.categories {
+		category := planned.category
+		switch planned.action {
+		case SchemaBundleActionCreate:
+			if _, err := s.categories.CreateSchemaCategoryTx(ctx, tx, CreateSchemaCategoryParams{
+				CategoryID:       category.CategoryID,
+				ParentCategoryID: category.ParentCategoryID,
+				Name:             category.Name,
+				Slug:             category.Slug,
+				Description:      category.Description,
+			}); err != nil {
+				return fmt.Errorf("import category %s: %w", category.Slug, err)
+			}
+		case SchemaBundleActionUpdate:
+			if _, err := s.categories.UpdateSchemaCategoryTx(ctx, tx, category.CategoryID, UpdateSchemaCategoryParams{
+				ParentCategoryID: category.ParentCategoryID,
+				Name:             &category.Name,
+				Description:      category.Description,
+			}); err != nil {
+				return fmt.Errorf("import category %s: %w", category.Slug, err)
+			}
+		}
+	}
+
+	for _, planned := range plan.versions {
+		version := planned.version
+		if planned.action != SchemaBundleActionUnchanged {
+			if _, err := s.schemas.CreateOrUpdateSchemaTx(ctx, tx, CreateSchemaParams{
+				SchemaID:   version.SchemaID,
+				Version:    version.SchemaVersion,
+				Definition: version.Definition,
+				TableName:  version.TableName,
+				Slug:       version.Slug,
+				CategoryID: version.CategoryID,
+				Status:     bundleStatus(version),
+			}); err != nil {
+				return fmt.Errorf("import schema %s: %w", version.Key(), err)
+			}
+		}
+	}
+
+	// Activate once every version exists so the active flag ends on the version marked in the bundle.
+	for _, planned := range plan.versions {
+		if !planned.version.Active {
+			continue
+		}
+		if err := s.schemas.ActivateSchemaVersionTx(ctx, tx, planned.version.SchemaID, planned.version.SchemaVersion); err != nil {
+			return fmt.Errorf("activate schema %s: %w", planned.version.Key(), err)
+		}
+	}
+
+	return nil
+}
+
+// orderBundleCategories returns parents before their children; cycles and dangling parents keep bundle order.
+func orderBundleCategories(categories []SchemaBundleCategory) []SchemaBundleCategory {
+	ordered := make([]SchemaBundleCategory, 0, len(categories))
+	placed := make(map[uuid.UUID]bool, len(categories))
+	inBundle := make(map[uuid.UUID]bool, len(categories))
+	for _, category := range categories {
+		inBundle[category.CategoryID] = true
+	}
+
+	pending := categories
+	for len(pending) > 0 {
+		var next []SchemaBundleCategory
+		for _, category := range pending {
+			parent := category.ParentCategoryID
+			if parent == nil || placed[*parent] || !inBundle[*parent] {
+				ordered = append(ordered, category)
+				placed[category.CategoryID] = true
+				continue
+			}
+			next = append(next, category)
+		}
+		if len(next) == len(pending) {
+			return append(ordered, next...)
+		}
+		pending = next
+	}
+	return ordered
+}
+
+func sameBundleCategory(current SchemaCategory, category SchemaBundleCategory) bool {
+	return current.Name == category.Name &&
+		equalUUIDPtr(current.ParentCategoryID, category.ParentCategoryID) &&
+		equalStringPtr(current.Description, category.Description)
+}
+
+func bundleStatus(version SchemaBundleVersion) string {
+	if version.Status == "" {
+		return SchemaStatusPublished
+	}
+	return version.Status
+}
+
+func equalUUIDPtr(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
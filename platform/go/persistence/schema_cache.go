@@ -0,0 +1,123 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// schemaCacheActiveKey is the cache key of a schema's active version; other keys are version strings.
+const schemaCacheActiveKey = "active"
+
+// SchemaCache holds resolved schema versions for SchemaRepositoryStore. Entries are grouped per schema so that
+// Invalidate drops the active version and every cached version at once. Implementations treat backend failures
+// as cache misses: the store falls back to Postgres.
+type SchemaCache interface {
+	Get(ctx context.Context, schemaID uuid.UUID, key string) (SchemaRecord, bool)
+	Put(ctx context.Context, schemaID uuid.UUID, key string, record SchemaRecord)
+	Invalidate(ctx context.Context, schemaID uuid.UUID)
+}
+
+// MemorySchemaCache is a per-process SchemaCache. Invalidations only reach the local process, so with several API
+// instances the TTL bounds how long another instance serves a replaced version.
+type MemorySchemaCache struct {
+	ttl     time.Duration
+	mu      sync.RWMutex
+	schemas map[uuid.UUID]map[string]memorySchemaEntry
+}
+
+type memorySchemaEntry struct {
+	record    SchemaRecord
+	expiresAt time.Time
+}
+
+// NewMemorySchemaCache returns an empty in-memory cache keeping entries for ttl.
+func NewMemorySchemaCache(ttl time.Duration) *MemorySchemaCache {
+	return &MemorySchemaCache{ttl: ttl, schemas: make(map[uuid.UUID]map[string]memorySchemaEntry)}
+}
+
+// Get returns the cached record unless it is missing or expired.
+func (c *MemorySchemaCache) Get(_ context.Context, schemaID uuid.UUID, key string) (SchemaRecord, bool) {
+	c.mu.RLock()
+	entry, ok := c.schemas[schemaID][key]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(entry.expiresAt) {
+		return SchemaRecord{}, false
+	}
+	return entry.record, true
+}
+
+// Put caches the record for the TTL.
+func (c *MemorySchemaCache) Put(_ context.Context, schemaID uuid.UUID, key string, record SchemaRecord) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, ok := c.schemas[schemaID]
+	if !ok {
+		entries = make(map[string]memorySchemaEntry)
+		c.schemas[schemaID] = entries
+	}
+	entries[key] = memorySchemaEntry{record: record, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// Invalidate drops every entry of the schema.
+func (c *MemorySchemaCache) Invalidate(_ context.Context, schemaID uuid.UUID) {
+	c.mu.Lock()
+	delete(c.schemas, schemaID)
+	c.mu.Unlock()
+}
+
+// RedisSchemaCache is a SchemaCache shared by every API instance: each schema is a Redis hash keyed by prefix and
+// schema id, so an invalidation applies everywhere at once.
+type RedisSchemaCache struct {
+	client redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisSchemaCache returns a cache storing entries under prefix for ttl. Use a distinct prefix per environment
+// sharing the Redis instance.
+func NewRedisSchemaCache(client redis.UniversalClient, prefix string, ttl time.Duration) *RedisSchemaCache {
+	if client == nil {
+		panic("redis client is required")
+	}
+	return &RedisSchemaCache{client: client, prefix: prefix, ttl: ttl}
+}
+
+// Get returns the cached record; missing entries and Redis errors are misses.
+func (c *RedisSchemaCache) Get(ctx context.Context, schemaID uuid.UUID, key string) (SchemaRecord, bool) {
+	raw, err := c.client.HGet(ctx, c.key(schemaID), key).Bytes()
+	if err != nil {
+		return SchemaRecord{}, false
+	}
+	var record SchemaRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return SchemaRecord{}, false
+	}
+	return record, true
+}
+
+// Put caches the record. The TTL applies to the schema's hash and is renewed by every write.
+func (c *RedisSchemaCache) Put(ctx context.Context, schemaID uuid.UUID, key string, record SchemaRecord) {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	_, _ = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, c.key(schemaID), key, raw)
+		pipe.Expire(ctx, c.key(schemaID), c.ttl)
+		return nil
+	})
+}
+
+// Invalidate deletes the schema's hash.
+func (c *RedisSchemaCache) Invalidate(ctx context.Context, schemaID uuid.UUID) {
+	_ = c.client.Del(ctx, c.key(schemaID)).Err()
+}
+
+func (c *RedisSchemaCache) key(schemaID uuid.UUID) string {
+	return c.prefix + "schemas:" + schemaID.String()
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemorySchemaCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := NewMemorySchemaCache(time.Minute)
+	schemaID := uuid.New()
+	record := SchemaRecord{SchemaID: schemaID, SchemaVersion: SemanticVersion{Major: 1}, Slug: "orders"}
+
+	_, ok := cache.Get(ctx, schemaID, schemaCacheActiveKey)
+	require.False(t, ok)
+
+	cache.Put(ctx, schemaID, schemaCacheActiveKey, record)
+	cache.Put(ctx, schemaID, record.VersionString(), record)
+	got, ok := cache.Get(ctx, schemaID, record.VersionString())
+	require.True(t, ok)
+	require.Equal(t, record, got)
+
+	cache.Invalidate(ctx, schemaID)
+	_, ok = cache.Get(ctx, schemaID, schemaCacheActiveKey)
+	require.False(t, ok)
+	_, ok = cache.Get(ctx, schemaID, record.VersionString())
+	require.False(t, ok)
+
+	expiring := NewMemorySchemaCache(-time.Second)
+	expiring.Put(ctx, schemaID, schemaCacheActiveKey, record)
+	_, ok = expiring.Get(ctx, schemaID, schemaCacheActiveKey)
+	require.False(t, ok)
+}
+
+func TestRedisSchemaCache(t *testing.T) {
+	url, ok := os.LookupEnv("TEST_REDIS_URL")
+	if !ok {
+		t.Skip("TEST_REDIS_URL not set; skipping integration test")
+	}
+
+	options, err := redis.ParseURL(url)
+	require.NoError(t, err)
+	client := redis.NewClient(options)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	cache := NewRedisSchemaCache(client, "palmyra-test-"+uuid.NewString()[:8]+":", time.Minute)
+	schemaID := uuid.New()
+	createdBy := "admin-1"
+	record := SchemaRecord{
+		SchemaID:         schemaID,
+		SchemaVersion:    SemanticVersion{Major: 1, Minor: 2},
+		SchemaDefinition: SchemaDefinition(`{"type":"object"}`),
+		Slug:             "orders",
+		CreatedAt:        time.Now().UTC().Truncate(time.Second),
+		CreatedBy:        &createdBy,
+		IsActive:         true,
+		Status:           SchemaStatusPublished,
+	}
+
+	cache.Put(ctx, schemaID, schemaCacheActiveKey, record)
+	got, ok := cache.Get(ctx, schemaID, schemaCacheActiveKey)
+	require.True(t, ok)
+	require.Equal(t, record, got)
+
+	cache.Invalidate(ctx, schemaID)
+	_, ok = cache.Get(ctx, schemaID, schemaCacheActiveKey)
+	require.False(t, ok)
+}
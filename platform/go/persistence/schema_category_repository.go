@@ -51,6 +51,16 @@ var (
 	ErrSchemaCategoryConflict = errors.New("schema category conflict")
 )
 
+// SchemaCategoryCycleError reports a parent assignment that would make a category its own ancestor. Path lists the
+// category slugs from the updated category up through its would-be ancestors back to itself.
+type SchemaCategoryCycleError struct {
+	Path []string
+}
+
+func (e *SchemaCategoryCycleError) Error() string {
+	return "category parent would create a cycle: " + strings.Join(e.Path, " -> ")
+}
+
 func (s *SchemaCategoryStore) CreateSchemaCategoryTx(ctx context.Context, tx pgx.Tx, params CreateSchemaCategoryParams) (SchemaCategory, error) {
 	if params.CategoryID == uuid.Nil {
 		return SchemaCategory{}, errors.New("category id is required")
@@ -156,26 +166,159 @@ func (s *SchemaCategoryStore) ListSchemaCategoriesTx(ctx context.Context, tx pgx
 	return categories, nil
 }
 
-func (s *SchemaCategoryStore) DeleteSchemaCategoryTx(ctx context.Context, tx pgx.Tx, categoryID uuid.UUID, deletedAt time.Time) error {
+// SchemaCategoryDeleteMode decides what happens to the live child categories and schemas of a deleted category.
+type SchemaCategoryDeleteMode string
+
+const (
+	// SchemaCategoryDeleteRestrict refuses the delete while the category has live children or schemas.
+	SchemaCategoryDeleteRestrict SchemaCategoryDeleteMode = "restrict"
+	// SchemaCategoryDeleteReparent moves child categories and schemas to the parent of the deleted category.
+	SchemaCategoryDeleteReparent SchemaCategoryDeleteMode = "reparent"
+	// SchemaCategoryDeleteCascade soft deletes every descendant category and every schema version in the subtree.
+	SchemaCategoryDeleteCascade SchemaCategoryDeleteMode = "cascade"
+)
+
+// SchemaCategoryInUseError is returned when a delete cannot proceed because of the category's live children:
+// always in restrict mode, and in reparent mode when schemas would be left without a parent category.
+type SchemaCategoryInUseError struct {
+	ChildCategories int64
+	Schemas         int64
+}
+
+func (e *SchemaCategoryInUseError) Error() string {
+	return fmt.Sprintf("schema category has %d child categories and %d schemas", e.ChildCategories, e.Schemas)
+}
+
+// SchemaCategoryDeletion reports what deleting a schema category changed, or would change on a dry run. The counts
+// are schema_categories and schema_repository rows; a schema counts once per version.
+type SchemaCategoryDeletion struct {
+	// Categories are the soft-deleted categories: the category itself and, on cascade, its subtree.
+	Categories               []uuid.UUID
+	ReparentedCategories     int64
+	ReparentedSchemaVersions int64
+	DeletedSchemaVersions    int64
+}
+
+// errSchemaCategoryDeleteDryRun rolls back a dry-run delete after it ran, so dry runs report exactly what a real run
+// changes.
+var errSchemaCategoryDeleteDryRun = errors.New("schema category delete dry run")
+
+func (s *SchemaCategoryStore) DeleteSchemaCategoryTx(ctx context.Context, tx pgx.Tx, categoryID uuid.UUID, deletedAt time.Time, mode SchemaCategoryDeleteMode) (SchemaCategoryDeletion, error) {
 	if deletedAt.IsZero() {
 		deletedAt = time.Now().UTC()
 	}
+	if mode == "" {
+		mode = SchemaCategoryDeleteRestrict
+	}
+
+	var parentID *uuid.UUID
+	if err := tx.QueryRow(ctx, `
+		SELECT parent_category_id
+		FROM schema_categories
+		WHERE category_id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, categoryID).Scan(&parentID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SchemaCategoryDeletion{}, ErrSchemaNotFound
+		}
+		return SchemaCategoryDeletion{}, fmt.Errorf("load schema category: %w", err)
+	}
 
-	result, err := tx.Exec(ctx, `
+	var (
+		deletion SchemaCategoryDeletion
+		inUse    SchemaCategoryInUseError
+	)
+	if err := tx.QueryRow(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM schema_categories WHERE parent_category_id = $1 AND deleted_at IS NULL),
+			(SELECT COUNT(DISTINCT schema_id) FROM schema_repository WHERE category_id = $1 AND NOT is_deleted)
+	`, categoryID).Scan(&inUse.ChildCategories, &inUse.Schemas); err != nil {
+		return SchemaCategoryDeletion{}, fmt.Errorf("count schema category children: %w", err)
+	}
+
+	subtree := []uuid.UUID{categoryID}
+	switch mode {
+	case SchemaCategoryDeleteRestrict:
+		if inUse.ChildCategories > 0 || inUse.Schemas > 0 {
+			return SchemaCategoryDeletion{}, &inUse
+		}
+	case SchemaCategoryDeleteReparent:
+		if parentID == nil && inUse.Schemas > 0 {
+			return SchemaCategoryDeletion{}, &SchemaCategoryInUseError{Schemas: inUse.Schemas}
+		}
+		children, err := tx.Exec(ctx, `
+			UPDATE schema_categories
+			SET parent_category_id = $2,
+			    updated_at = NOW()
+			WHERE parent_category_id = $1 AND deleted_at IS NULL
+		`, categoryID, parentID)
+		if err != nil {
+			return SchemaCategoryDeletion{}, fmt.Errorf("reparent child categories: %w", err)
+		}
+		deletion.ReparentedCategories = children.RowsAffected()
+		if parentID != nil {
+			versions, err := tx.Exec(ctx, `
+				UPDATE schema_repository
+				SET category_id = $2
+				WHERE category_id = $1
+			`, categoryID, *parentID)
+			if err != nil {
+				return SchemaCategoryDeletion{}, fmt.Errorf("reparent schemas: %w", err)
+			}
+			deletion.ReparentedSchemaVersions = versions.RowsAffected()
+		}
+	case SchemaCategoryDeleteCascade:
+		rows, err := tx.Query(ctx, `
+			WITH RECURSIVE subtree AS (
+				SELECT category_id, ARRAY[category_id] AS path
+				FROM schema_categories
+				WHERE category_id = $1
+				UNION ALL
+				SELECT c.category_id, s.path || c.category_id
+				FROM schema_categories c
+				JOIN subtree s ON c.parent_category_id = s.category_id
+				WHERE c.deleted_at IS NULL AND NOT c.category_id = ANY(s.path)
+			)
+			SELECT category_id FROM subtree
+		`, categoryID)
+		if err != nil {
+			return SchemaCategoryDeletion{}, fmt.Errorf("load schema category subtree: %w", err)
+		}
+		subtree, err = pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+		if err != nil {
+			return SchemaCategoryDeletion{}, fmt.Errorf("load schema category subtree: %w", err)
+		}
+		versions, err := tx.Exec(ctx, `
+			UPDATE schema_repository
+			SET is_deleted = TRUE,
+			    is_active = FALSE,
+			    deleted_at = $2
+			WHERE category_id = ANY($1) AND NOT is_deleted
+		`, subtree, deletedAt)
+		if err != nil {
+			return SchemaCategoryDeletion{}, fmt.Errorf("soft delete subtree schemas: %w", err)
+		}
+		deletion.DeletedSchemaVersions = versions.RowsAffected()
+	default:
+		return SchemaCategoryDeletion{}, fmt.Errorf("unknown schema category delete mode %q", mode)
+	}
+
+	rows, err := tx.Query(ctx, `
 		UPDATE schema_categories
 		SET deleted_at = $2,
 		    updated_at = NOW()
-		WHERE category_id = $1 AND deleted_at IS NULL
-	`, categoryID, deletedAt)
+		WHERE category_id = ANY($1) AND deleted_at IS NULL
+		RETURNING category_id
+	`, subtree, deletedAt)
 	if err != nil {
-		return fmt.Errorf("soft delete schema category: %w", err)
+		return SchemaCategoryDeletion{}, fmt.Errorf("soft delete schema category: %w", err)
 	}
-
-	if result.RowsAffected() == 0 {
-		return ErrSchemaNotFound
+	deletion.Categories, err = pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return SchemaCategoryDeletion{}, fmt.Errorf("soft delete schema category: %w", err)
 	}
 
-	return nil
+	return deletion, nil
 }
 
 type UpdateSchemaCategoryParams struct {
@@ -211,6 +354,10 @@ func (s *SchemaCategoryStore) UpdateSchemaCategoryTx(ctx context.Context, tx pgx
 			return SchemaCategory{}, errors.New("category cannot reference itself as parent")
 		}
 		parentID = params.ParentCategoryID
+
+		if err := checkSchemaCategoryAncestry(ctx, tx, current, *params.ParentCategoryID); err != nil {
+			return SchemaCategory{}, err
+		}
 	}
 
 	name := current.Name
@@ -265,6 +412,50 @@ func (s *SchemaCategoryStore) UpdateSchemaCategoryTx(ctx context.Context, tx pgx
 	return category, nil
 }
 
+// checkSchemaCategoryAncestry walks the ancestors of parentID and fails with SchemaCategoryCycleError when category
+// is one of them.
+func checkSchemaCategoryAncestry(ctx context.Context, tx pgx.Tx, category SchemaCategory, parentID uuid.UUID) error {
+	rows, err := tx.Query(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT category_id, parent_category_id, slug, 1 AS depth, ARRAY[category_id] AS path
+			FROM schema_categories
+			WHERE category_id = $1
+			UNION ALL
+			SELECT c.category_id, c.parent_category_id, c.slug, a.depth + 1, a.path || c.category_id
+			FROM schema_categories c
+			JOIN ancestors a ON c.category_id = a.parent_category_id
+			WHERE NOT c.category_id = ANY(a.path)
+		)
+		SELECT category_id, slug
+		FROM ancestors
+		ORDER BY depth
+	`, parentID)
+	if err != nil {
+		return fmt.Errorf("load category ancestors: %w", err)
+	}
+	defer rows.Close()
+
+	path := []string{category.Slug}
+	for rows.Next() {
+		var (
+			ancestorID uuid.UUID
+			slug       string
+		)
+		if err := rows.Scan(&ancestorID, &slug); err != nil {
+			return fmt.Errorf("scan category ancestor: %w", err)
+		}
+		path = append(path, slug)
+		if ancestorID == category.CategoryID {
+			return &SchemaCategoryCycleError{Path: path}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate category ancestors: %w", err)
+	}
+
+	return nil
+}
+
 // CreateSchemaCategory wraps CreateSchemaCategoryTx inside WithAdmin to scope queries to the admin schema.
 func (s *SchemaCategoryStore) CreateSchemaCategory(ctx context.Context, adminDB *SpaceDB, params CreateSchemaCategoryParams) (SchemaCategory, error) {
 	if adminDB == nil {
@@ -333,15 +524,28 @@ func (s *SchemaCategoryStore) UpdateSchemaCategory(ctx context.Context, adminDB
 	})
 }
 
-// DeleteSchemaCategory wraps DeleteSchemaCategoryTx inside WithAdmin.
-func (s *SchemaCategoryStore) DeleteSchemaCategory(ctx context.Context, adminDB *SpaceDB, categoryID uuid.UUID, deletedAt time.Time) error {
+// DeleteSchemaCategory wraps DeleteSchemaCategoryTx inside WithAdmin. With dryRun the delete runs and is rolled
+// back.
+func (s *SchemaCategoryStore) DeleteSchemaCategory(ctx context.Context, adminDB *SpaceDB, categoryID uuid.UUID, deletedAt time.Time, mode SchemaCategoryDeleteMode, dryRun bool) (SchemaCategoryDeletion, error) {
 	if adminDB == nil {
-		return errors.New("admin db is required")
+		return SchemaCategoryDeletion{}, errors.New("admin db is required")
 	}
 
-	return adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
-		return s.DeleteSchemaCategoryTx(ctx, tx, categoryID, deletedAt)
+	var deletion SchemaCategoryDeletion
+	err := adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		var err error
+		if deletion, err = s.DeleteSchemaCategoryTx(ctx, tx, categoryID, deletedAt, mode); err != nil {
+			return err
+		}
+		if dryRun {
+			return errSchemaCategoryDeleteDryRun
+		}
+		return nil
 	})
+	if err != nil && !errors.Is(err, errSchemaCategoryDeleteDryRun) {
+		return SchemaCategoryDeletion{}, err
+	}
+	return deletion, nil
 }
 
 func scanSchemaCategory(scanner rowScanner) (SchemaCategory, error) {
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListCategorySchemasTx returns one record per schema with a live version in the category, or in the category and
+// all of its live descendants when includeDescendants is set. The active version represents each schema; schemas
+// without one are represented by their newest live version. Records are ordered by slug.
+func (s *SchemaCategoryStore) ListCategorySchemasTx(ctx context.Context, tx pgx.Tx, categoryID uuid.UUID, includeDescendants bool) ([]SchemaRecord, error) {
+	if _, err := s.GetSchemaCategoryTx(ctx, tx, categoryID); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT category_id, ARRAY[category_id] AS path
+			FROM schema_categories
+			WHERE category_id = $1
+			UNION ALL
+			SELECT c.category_id, s.path || c.category_id
+			FROM schema_categories c
+			JOIN subtree s ON c.parent_category_id = s.category_id
+			WHERE $2::bool AND c.deleted_at IS NULL AND NOT c.category_id = ANY(s.path)
+		)
+		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
+		FROM (
+			SELECT DISTINCT ON (sr.schema_id) sr.*
+			FROM schema_repository sr
+			WHERE sr.category_id IN (SELECT category_id FROM subtree) AND NOT sr.is_deleted
+			ORDER BY sr.schema_id, sr.is_active DESC, sr.created_at DESC
+		) latest
+		ORDER BY slug
+	`, categoryID, includeDescendants)
+	if err != nil {
+		return nil, fmt.Errorf("list category schemas: %w", err)
+	}
+	defer rows.Close()
+
+	var records []SchemaRecord
+	for rows.Next() {
+		record, scanErr := scanSchemaRecord(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate category schemas: %w", err)
+	}
+
+	return records, nil
+}
+
+// ListCategorySchemas wraps ListCategorySchemasTx inside WithAdmin.
+func (s *SchemaCategoryStore) ListCategorySchemas(ctx context.Context, adminDB *SpaceDB, categoryID uuid.UUID, includeDescendants bool) ([]SchemaRecord, error) {
+	if adminDB == nil {
+		return nil, errors.New("admin db is required")
+	}
+
+	var records []SchemaRecord
+	return records, adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		list, err := s.ListCategorySchemasTx(ctx, tx, categoryID, includeDescendants)
+		if err != nil {
+			return err
+		}
+		records = list
+		return nil
+	})
+}
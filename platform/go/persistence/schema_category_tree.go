@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// SchemaCategoryTreeNode is a live category with its nested live children. SchemaCount counts the schemas with at
+// least one live version in the category; TotalSchemaCount adds the counts of every descendant.
+type SchemaCategoryTreeNode struct {
+	SchemaCategory
+	SchemaCount      int64                    `json:"schemaCount"`
+	TotalSchemaCount int64                    `json:"totalSchemaCount"`
+	Children         []SchemaCategoryTreeNode `json:"children"`
+}
+
+// SchemaCategoryTreeTx returns the live category hierarchy. Roots are categories without a live parent, so children
+// of a soft-deleted category are promoted to the top level. Siblings are ordered by creation time.
+func (s *SchemaCategoryStore) SchemaCategoryTreeTx(ctx context.Context, tx pgx.Tx) ([]SchemaCategoryTreeNode, error) {
+	rows, err := tx.Query(ctx, `
+		WITH RECURSIVE live AS (
+			SELECT category_id, parent_category_id, name, slug, description, created_at, updated_at, deleted_at
+			FROM schema_categories
+			WHERE deleted_at IS NULL
+		), tree AS (
+			SELECT l.*, ARRAY[l.category_id] AS path
+			FROM live l
+			WHERE l.parent_category_id IS NULL
+			   OR NOT EXISTS (SELECT 1 FROM live p WHERE p.category_id = l.parent_category_id)
+			UNION ALL
+			SELECT l.*, t.path || l.category_id
+			FROM live l
+			JOIN tree t ON l.parent_category_id = t.category_id
+			WHERE NOT l.category_id = ANY(t.path)
+		)
+		SELECT t.category_id, t.parent_category_id, t.name, t.slug, t.description, t.created_at, t.updated_at, t.deleted_at,
+		       cardinality(t.path) = 1 AS is_root,
+		       (
+		           SELECT COUNT(DISTINCT sr.schema_id)
+		           FROM schema_repository sr
+		           WHERE sr.category_id = t.category_id AND NOT sr.is_deleted
+		       ) AS schema_count
+		FROM tree t
+		ORDER BY t.created_at ASC, t.category_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("load schema category tree: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		roots    []uuid.UUID
+		nodes    = map[uuid.UUID]SchemaCategoryTreeNode{}
+		children = map[uuid.UUID][]uuid.UUID{}
+	)
+	for rows.Next() {
+		var (
+			node   SchemaCategoryTreeNode
+			isRoot bool
+		)
+		category, scanErr := scanSchemaCategory(scannerWithExtras{rows: rows, extras: []any{&isRoot, &node.SchemaCount}})
+		if scanErr != nil {
+			return nil, fmt.Errorf("scan schema category tree: %w", scanErr)
+		}
+		node.SchemaCategory = category
+		nodes[category.CategoryID] = node
+
+		if isRoot {
+			roots = append(roots, category.CategoryID)
+		} else {
+			children[*category.ParentCategoryID] = append(children[*category.ParentCategoryID], category.CategoryID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate schema category tree: %w", err)
+	}
+
+	tree := make([]SchemaCategoryTreeNode, 0, len(roots))
+	for _, id := range roots {
+		tree = append(tree, assembleCategoryNode(id, nodes, children))
+	}
+	return tree, nil
+}
+
+// SchemaCategoryTree wraps SchemaCategoryTreeTx inside WithAdmin.
+func (s *SchemaCategoryStore) SchemaCategoryTree(ctx context.Context, adminDB *SpaceDB) ([]SchemaCategoryTreeNode, error) {
+	if adminDB == nil {
+		return nil, errors.New("admin db is required")
+	}
+
+	var tree []SchemaCategoryTreeNode
+	return tree, adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		nodes, err := s.SchemaCategoryTreeTx(ctx, tx)
+		if err != nil {
+			return err
+		}
+		tree = nodes
+		return nil
+	})
+}
+
+func assembleCategoryNode(id uuid.UUID, nodes map[uuid.UUID]SchemaCategoryTreeNode, children map[uuid.UUID][]uuid.UUID) SchemaCategoryTreeNode {
+	node := nodes[id]
+	node.TotalSchemaCount = node.SchemaCount
+	node.Children = make([]SchemaCategoryTreeNode, 0, len(children[id]))
+	for _, childID := range children[id] {
+		child := assembleCategoryNode(childID, nodes, children)
+		node.TotalSchemaCount += child.TotalSchemaCount
+		node.Children = append(node.Children, child)
+	}
+	return node
+}
+
+// scannerWithExtras lets scanSchemaCategory read rows that carry additional trailing columns.
+type scannerWithExtras struct {
+	rows   pgx.Rows
+	extras []any
+}
+
+func (s scannerWithExtras) Scan(dest ...any) error {
+	return s.rows.Scan(append(dest, s.extras...)...)
+}
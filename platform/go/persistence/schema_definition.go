@@ -0,0 +1,188 @@
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// metaSchemaURL identifies the only JSON Schema dialect accepted for schema definitions.
+const metaSchemaURL = "https://json-schema.org/draft/2020-12/schema"
+
+// compiledMetaSchema compiles the draft 2020-12 meta-schema bundled with the jsonschema library once.
+var compiledMetaSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	return jsonschema.NewCompiler().Compile(metaSchemaURL)
+})
+
+// SchemaDefinitionIssue is one problem found in a schema definition. Pointer is a JSON pointer into the definition
+// ("" for the root).
+type SchemaDefinitionIssue struct {
+	Pointer string
+	Message string
+}
+
+// InvalidSchemaDefinitionError lists every issue found by ValidateSchemaDefinition.
+type InvalidSchemaDefinitionError struct {
+	Issues []SchemaDefinitionIssue
+}
+
+func (e *InvalidSchemaDefinitionError) Error() string {
+	return fmt.Sprintf("invalid schema definition: %d issue(s)", len(e.Issues))
+}
+
+// ValidateSchemaDefinition checks a definition against the JSON Schema draft 2020-12 meta-schema and the Palmyra
+// extensions (x-indexed, x-encrypt, x-pii and x-subject-id must be booleans, x-ref a table name, x-examples an array
+// of objects, x-pii-mask a mask mode on the root; encrypted properties cannot be indexed, referenced or subject ids),
+// and makes sure it compiles. Problems with the definition itself are reported as *InvalidSchemaDefinitionError.
+func ValidateSchemaDefinition(definition SchemaDefinition) error {
+	meta, err := compiledMetaSchema()
+	if err != nil {
+		return fmt.Errorf("compile meta-schema: %w", err)
+	}
+
+	var document any
+	if err := json.Unmarshal(definition, &document); err != nil {
+		return &InvalidSchemaDefinitionError{Issues: []SchemaDefinitionIssue{{Message: "schema definition must be valid JSON"}}}
+	}
+
+	var issues []SchemaDefinitionIssue
+	root, _ := document.(map[string]any)
+	if dialect, ok := root["$schema"]; ok && strings.TrimSuffix(fmt.Sprint(dialect), "#") != metaSchemaURL {
+		issues = append(issues, SchemaDefinitionIssue{Pointer: "/$schema", Message: fmt.Sprintf("only %s is supported", metaSchemaURL)})
+	}
+
+	if err := meta.Validate(document); err != nil {
+		validationErr, ok := err.(*jsonschema.ValidationError)
+		if !ok {
+			return fmt.Errorf("validate schema definition: %w", err)
+		}
+		issues = append(issues, metaSchemaIssues(validationErr)...)
+	}
+
+	issues = append(issues, extensionIssues("", root)...)
+	if value, ok := root[piiMaskKeyword]; ok {
+		if mode, isString := value.(string); !isString || !isPIIMaskMode(mode) {
+			issues = append(issues, SchemaDefinitionIssue{Pointer: "/" + piiMaskKeyword, Message: fmt.Sprintf("must be one of %v", piiMaskModes)})
+		}
+	}
+	issues = append(issues, examplesIssues(root)...)
+
+	if len(issues) == 0 {
+		compiler := jsonschema.NewCompiler()
+		if err := compiler.AddResource("memory://schema-definition", bytes.NewReader(definition)); err == nil {
+			_, err = compiler.Compile("memory://schema-definition")
+		}
+		if err != nil {
+			issues = append(issues, SchemaDefinitionIssue{Message: err.Error()})
+		}
+	}
+
+	if len(issues) > 0 {
+		return &InvalidSchemaDefinitionError{Issues: issues}
+	}
+	return nil
+}
+
+// metaSchemaIssues flattens a meta-schema validation error into its leaf causes.
+func metaSchemaIssues(err *jsonschema.ValidationError) []SchemaDefinitionIssue {
+	var issues []SchemaDefinitionIssue
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			issues = append(issues, SchemaDefinitionIssue{Pointer: e.InstanceLocation, Message: e.Message})
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(err)
+	return issues
+}
+
+// extensionIssues checks the Palmyra keywords on a subschema and recurses through properties and items.
+func extensionIssues(pointer string, node map[string]any) []SchemaDefinitionIssue {
+	if node == nil {
+		return nil
+	}
+
+	var issues []SchemaDefinitionIssue
+	if value, ok := node[indexedKeyword]; ok {
+		if _, isBool := value.(bool); !isBool {
+			issues = append(issues, SchemaDefinitionIssue{Pointer: pointer + "/" + indexedKeyword, Message: "must be a boolean"})
+		}
+	}
+	if value, ok := node[referenceKeyword]; ok {
+		if target, isString := value.(string); !isString || !tableNamePattern.MatchString(target) {
+			issues = append(issues, SchemaDefinitionIssue{
+				Pointer: pointer + "/" + referenceKeyword,
+				Message: fmt.Sprintf("must be a table name matching %s", tableNamePattern.String()),
+			})
+		}
+	}
+
+	for _, keyword := range []string{encryptKeyword, piiKeyword, subjectIDKeyword} {
+		if value, ok := node[keyword]; ok {
+			if _, isBool := value.(bool); !isBool {
+				issues = append(issues, SchemaDefinitionIssue{Pointer: pointer + "/" + keyword, Message: "must be a boolean"})
+			}
+		}
+	}
+	if encryptsProperty(node) {
+		issues = append(issues, encryptionIssues(pointer, node)...)
+	}
+
+	if properties, ok := node["properties"].(map[string]any); ok {
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, _ := properties[name].(map[string]any)
+			issues = append(issues, extensionIssues(pointer+"/properties/"+escapePointerToken(name), property)...)
+		}
+	}
+	if items, ok := node["items"].(map[string]any); ok {
+		issues = append(issues, extensionIssues(pointer+"/items", items)...)
+	}
+
+	return issues
+}
+
+// encryptionIssues checks an encrypted property. Encrypted values are opaque to the database, so they cannot back a
+// payload index or a reference, and only object properties with plain names are encrypted (see EncryptedFields).
+func encryptionIssues(pointer string, node map[string]any) []SchemaDefinitionIssue {
+	var issues []SchemaDefinitionIssue
+	if indexed, _ := node[indexedKeyword].(bool); indexed {
+		issues = append(issues, SchemaDefinitionIssue{Pointer: pointer + "/" + indexedKeyword, Message: "encrypted properties cannot be indexed"})
+	}
+	if _, ok := node[referenceKeyword]; ok {
+		issues = append(issues, SchemaDefinitionIssue{Pointer: pointer + "/" + referenceKeyword, Message: "encrypted properties cannot hold references"})
+	}
+	if subject, _ := node[subjectIDKeyword].(bool); subject {
+		issues = append(issues, SchemaDefinitionIssue{Pointer: pointer + "/" + subjectIDKeyword, Message: "encrypted properties cannot hold subject ids"})
+	}
+
+	segments := strings.Split(pointer, "/")[1:]
+	encryptable := len(segments) > 0 && len(segments)%2 == 0
+	for i := 0; encryptable && i < len(segments); i += 2 {
+		encryptable = segments[i] == "properties" && payloadKeyPattern.MatchString(segments[i+1])
+	}
+	if !encryptable {
+		issues = append(issues, SchemaDefinitionIssue{
+			Pointer: pointer,
+			Message: fmt.Sprintf("only object properties named %s can be encrypted", payloadKeyPattern.String()),
+		})
+	}
+	return issues
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
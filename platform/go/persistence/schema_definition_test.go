@@ -0,0 +1,106 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchemaDefinitionAcceptsPalmyraExtensions(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateSchemaDefinition(SchemaDefinition(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": { "type": "string", "x-indexed": true },
+			"deck": { "type": "string", "x-ref": "decks_entities" },
+			"tags": { "type": "array", "items": { "type": "string" } }
+		}
+	}`))
+	require.NoError(t, err)
+}
+
+func TestValidateSchemaDefinitionReportsIssues(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateSchemaDefinition(SchemaDefinition(`{
+		"type": "object",
+		"required": "name",
+		"properties": {
+			"name": { "type": "string", "minLength": -1, "x-indexed": "yes" },
+			"deck": { "type": "string", "x-ref": "Decks" }
+		}
+	}`))
+
+	var definitionErr *InvalidSchemaDefinitionError
+	require.True(t, errors.As(err, &definitionErr))
+
+	pointers := map[string]bool{}
+	for _, issue := range definitionErr.Issues {
+		pointers[issue.Pointer] = true
+	}
+	require.True(t, pointers["/required"])
+	require.True(t, pointers["/properties/name/minLength"])
+	require.True(t, pointers["/properties/name/x-indexed"])
+	require.True(t, pointers["/properties/deck/x-ref"])
+}
+
+func TestValidateSchemaDefinitionRejectsOtherDialects(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateSchemaDefinition(SchemaDefinition(`{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object"}`))
+
+	var definitionErr *InvalidSchemaDefinitionError
+	require.True(t, errors.As(err, &definitionErr))
+	require.Equal(t, "/$schema", definitionErr.Issues[0].Pointer)
+}
+
+func TestValidateSchemaDefinitionChecksEncryptedProperties(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateSchemaDefinition(SchemaDefinition(`{
+		"type": "object",
+		"properties": {
+			"email": { "type": "string", "x-pii": true },
+			"contact": { "type": "object", "properties": { "phone": { "type": "string", "x-encrypt": true } } }
+		}
+	}`)))
+
+	err := ValidateSchemaDefinition(SchemaDefinition(`{
+		"type": "object",
+		"properties": {
+			"email": { "type": "string", "x-pii": true, "x-indexed": true },
+			"owner": { "type": "string", "x-encrypt": true, "x-ref": "users_entities" },
+			"notes": { "type": "array", "items": { "type": "string", "x-encrypt": true } },
+			"flag": { "type": "string", "x-pii": "yes" },
+			"customer": { "type": "string", "x-pii": true, "x-subject-id": true }
+		}
+	}`))
+
+	var definitionErr *InvalidSchemaDefinitionError
+	require.True(t, errors.As(err, &definitionErr))
+
+	pointers := map[string]bool{}
+	for _, issue := range definitionErr.Issues {
+		pointers[issue.Pointer] = true
+	}
+	require.True(t, pointers["/properties/email/x-indexed"])
+	require.True(t, pointers["/properties/owner/x-ref"])
+	require.True(t, pointers["/properties/notes/items"])
+	require.True(t, pointers["/properties/flag/x-pii"])
+	require.True(t, pointers["/properties/customer/x-subject-id"])
+}
+
+func TestValidateSchemaDefinitionChecksPIIMask(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateSchemaDefinition(SchemaDefinition(`{"type": "object", "x-pii-mask": "partial"}`)))
+
+	err := ValidateSchemaDefinition(SchemaDefinition(`{"type": "object", "x-pii-mask": "hash"}`))
+	var definitionErr *InvalidSchemaDefinitionError
+	require.True(t, errors.As(err, &definitionErr))
+	require.Equal(t, "/x-pii-mask", definitionErr.Issues[0].Pointer)
+}
@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// examplesKeyword holds sample payloads on the root of a schema definition. Every example must validate against
+// the definition that carries it.
+const examplesKeyword = "x-examples"
+
+// SchemaExampleIssue is one validation failure of a stored example. Pointer is a JSON pointer into the example
+// ("" for the example root).
+type SchemaExampleIssue struct {
+	Index   int
+	Pointer string
+	Message string
+}
+
+// ExamplePath renders an example issue as a dotted path, e.g. "x-examples[0].name".
+func (i SchemaExampleIssue) ExamplePath() string {
+	path := fmt.Sprintf("%s[%d]", examplesKeyword, i.Index)
+	if i.Pointer != "" {
+		path += "." + strings.ReplaceAll(strings.TrimPrefix(i.Pointer, "/"), "/", ".")
+	}
+	return path
+}
+
+// SchemaExamples returns the example payloads stored under x-examples, or nil when the definition has none.
+func SchemaExamples(definition SchemaDefinition) ([]json.RawMessage, error) {
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(definition, &root); err != nil {
+		return nil, fmt.Errorf("decode schema definition: %w", err)
+	}
+
+	raw, ok := root[examplesKeyword]
+	if !ok {
+		return nil, nil
+	}
+
+	var examples []json.RawMessage
+	if err := json.Unmarshal(raw, &examples); err != nil {
+		return nil, fmt.Errorf("%s must be an array: %w", examplesKeyword, err)
+	}
+	return examples, nil
+}
+
+// VerifySchemaExamples validates every x-examples payload against the definition and returns the failures,
+// ordered by example index. The definition is expected to have passed ValidateSchemaDefinition.
+func VerifySchemaExamples(definition SchemaDefinition) ([]SchemaExampleIssue, error) {
+	examples, err := SchemaExamples(definition)
+	if err != nil || len(examples) == 0 {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("memory://schema-examples", bytes.NewReader(definition)); err != nil {
+		return nil, fmt.Errorf("register schema definition: %w", err)
+	}
+	compiled, err := compiler.Compile("memory://schema-examples")
+	if err != nil {
+		return nil, fmt.Errorf("compile schema definition: %w", err)
+	}
+
+	var issues []SchemaExampleIssue
+	for idx, example := range examples {
+		var document any
+		if err := json.Unmarshal(example, &document); err != nil {
+			issues = append(issues, SchemaExampleIssue{Index: idx, Message: "example must be valid JSON"})
+			continue
+		}
+
+		err := compiled.Validate(document)
+		if err == nil {
+			continue
+		}
+		var validationErr *jsonschema.ValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, fmt.Errorf("validate example %d: %w", idx, err)
+		}
+		for _, leaf := range metaSchemaIssues(validationErr) {
+			issues = append(issues, SchemaExampleIssue{Index: idx, Pointer: leaf.Pointer, Message: leaf.Message})
+		}
+	}
+
+	return issues, nil
+}
+
+// examplesIssues checks the shape of the root x-examples keyword: an array of JSON objects.
+func examplesIssues(root map[string]any) []SchemaDefinitionIssue {
+	value, ok := root[examplesKeyword]
+	if !ok {
+		return nil
+	}
+
+	pointer := "/" + examplesKeyword
+	examples, isArray := value.([]any)
+	if !isArray {
+		return []SchemaDefinitionIssue{{Pointer: pointer, Message: "must be an array of example payloads"}}
+	}
+
+	var issues []SchemaDefinitionIssue
+	for idx, example := range examples {
+		if _, isObject := example.(map[string]any); !isObject {
+			issues = append(issues, SchemaDefinitionIssue{Pointer: fmt.Sprintf("%s/%d", pointer, idx), Message: "example must be a JSON object"})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySchemaExamples(t *testing.T) {
+	t.Parallel()
+
+	issues, err := VerifySchemaExamples(SchemaDefinition(`{
+		"type": "object",
+		"required": ["name"],
+		"properties": { "name": { "type": "string" } },
+		"x-examples": [
+			{ "name": "Ace" },
+			{ "name": 7 }
+		]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, 1, issues[0].Index)
+	require.Equal(t, "x-examples[1].name", issues[0].ExamplePath())
+}
+
+func TestVerifySchemaExamplesWithoutExamples(t *testing.T) {
+	t.Parallel()
+
+	issues, err := VerifySchemaExamples(SchemaDefinition(`{"type": "object"}`))
+	require.NoError(t, err)
+	require.Empty(t, issues)
+}
+
+func TestValidateSchemaDefinitionRejectsMalformedExamples(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateSchemaDefinition(SchemaDefinition(`{"type": "object", "x-examples": [{"name": "Ace"}, "Ace"]}`))
+
+	var definitionErr *InvalidSchemaDefinitionError
+	require.True(t, errors.As(err, &definitionErr))
+	require.Equal(t, "/x-examples/1", definitionErr.Issues[0].Pointer)
+}
@@ -52,6 +52,21 @@ func (v SemanticVersion) String() string {
 	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
 }
 
+// MarshalText encodes the version as major.minor.patch so it serialises as a JSON string.
+func (v SemanticVersion) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText parses a major.minor.patch string.
+func (v *SemanticVersion) UnmarshalText(text []byte) error {
+	parsed, err := ParseSemanticVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // Compare returns -1, 0, or 1 depending on the lexical ordering of the versions.
 func (v SemanticVersion) Compare(other SemanticVersion) int {
 	if v.Major != other.Major {
@@ -102,6 +117,36 @@ type SchemaRecord struct {
 	CreatedBy        *string          `db:"created_by" json:"createdBy"`
 	IsDeleted        bool             `db:"is_deleted" json:"isDeleted"`
 	IsActive         bool             `db:"is_active" json:"isActive"`
+	// Compatibility is nil for the first version of a schema or when no check was requested.
+	Compatibility *SchemaCompatibility `db:"compatibility" json:"compatibility,omitempty"`
+	// Status is SchemaStatusDraft or SchemaStatusPublished; drafts are never active.
+	Status string `db:"status" json:"status"`
+}
+
+// Schema version statuses. Drafts can be edited in place and are never resolved as the active schema.
+const (
+	SchemaStatusDraft     = "draft"
+	SchemaStatusPublished = "published"
+)
+
+// Schema compatibility levels of a version relative to the version that was active when it was registered.
+const (
+	SchemaCompatibilityBackward = "backward"
+	SchemaCompatibilityBreaking = "breaking"
+)
+
+// SchemaCompatibility records how a schema version relates to the version it replaced.
+type SchemaCompatibility struct {
+	Level       string                     `json:"level"`
+	BaseVersion string                     `json:"baseVersion"`
+	Issues      []SchemaCompatibilityIssue `json:"issues"`
+}
+
+// SchemaCompatibilityIssue describes one breaking change. Path is the dotted property path ("[]" marks array items).
+type SchemaCompatibilityIssue struct {
+	Path    string `json:"path"`
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
 }
 
 // VersionString returns the dotted semantic version for convenient SQL bindings.
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -15,9 +16,35 @@ import (
 // ErrSchemaNotFound indicates the requested schema/version could not be located.
 var ErrSchemaNotFound = errors.New("schema not found")
 
+// ErrSchemaVersionDraft indicates an operation that requires a published version targeted a draft.
+var ErrSchemaVersionDraft = errors.New("schema version is a draft")
+
+// ErrSchemaVersionPublished indicates an attempt to publish a version that is already published.
+var ErrSchemaVersionPublished = errors.New("schema version is already published")
+
+// SchemaVersionInUseError is returned by DeleteSchema when the version is active or entity rows still
+// reference it and force was not requested.
+type SchemaVersionInUseError struct {
+	Active bool
+	// EntityCount is the number of entity rows, across every tenant space, pinned to the version.
+	EntityCount int64
+	// TableCount is the number of entity tables holding those rows.
+	TableCount int
+}
+
+func (e *SchemaVersionInUseError) Error() string {
+	if e.Active {
+		return fmt.Sprintf("schema version is active and referenced by %d entity row(s) in %d table(s)", e.EntityCount, e.TableCount)
+	}
+	return fmt.Sprintf("schema version is referenced by %d entity row(s) in %d table(s)", e.EntityCount, e.TableCount)
+}
+
 // SchemaRepositoryStore provides PostgreSQL-backed access to the schema_repository table.
+// GetActiveSchema and GetSchemaByVersion are served from the cache set with UseCache, which every write to a
+// schema invalidates.
 type SchemaRepositoryStore struct {
-	pool *pgxpool.Pool
+	pool  *pgxpool.Pool
+	cache SchemaCache
 }
 
 // CreateSchemaParams defines the payload required to persist a schema version.
@@ -30,6 +57,10 @@ type CreateSchemaParams struct {
 	CategoryID uuid.UUID
 	Activate   bool
 	CreatedBy  *string
+	// Compatibility is the outcome of comparing Definition with the previously active version, when computed.
+	Compatibility *SchemaCompatibility
+	// Status defaults to SchemaStatusPublished; drafts cannot be activated.
+	Status string
 }
 
 // NewSchemaRepositoryStore ensures the schema repository table exists and returns a store instance.
@@ -41,6 +72,11 @@ func NewSchemaRepositoryStore(ctx context.Context, pool *pgxpool.Pool) (*SchemaR
 	return &SchemaRepositoryStore{pool: pool}, nil
 }
 
+// UseCache enables caching of resolved schema versions. Call it before the store is shared.
+func (s *SchemaRepositoryStore) UseCache(cache SchemaCache) {
+	s.cache = cache
+}
+
 // CreateOrUpdateSchema persists the provided schema definition and optionally activates it.
 func (s *SchemaRepositoryStore) CreateOrUpdateSchema(ctx context.Context, spaceDB *SpaceDB, params CreateSchemaParams) (SchemaRecord, error) {
 	if spaceDB == nil {
@@ -48,7 +84,7 @@ func (s *SchemaRepositoryStore) CreateOrUpdateSchema(ctx context.Context, spaceD
 	}
 
 	var record SchemaRecord
-	return record, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
 		rec, err := s.CreateOrUpdateSchemaTx(ctx, tx, params)
 		if err != nil {
 			return err
@@ -56,6 +92,8 @@ func (s *SchemaRepositoryStore) CreateOrUpdateSchema(ctx context.Context, spaceD
 		record = rec
 		return nil
 	})
+	s.invalidate(ctx, params.SchemaID)
+	return record, err
 }
 
 // CreateOrUpdateSchemaTx performs the upsert inside the provided transaction.
@@ -72,11 +110,31 @@ func (s *SchemaRepositoryStore) CreateOrUpdateSchemaTx(ctx context.Context, tx p
 		return SchemaRecord{}, errors.New("category id is required")
 	}
 
+	status := params.Status
+	switch status {
+	case "":
+		status = SchemaStatusPublished
+	case SchemaStatusDraft:
+		if params.Activate {
+			return SchemaRecord{}, ErrSchemaVersionDraft
+		}
+	case SchemaStatusPublished:
+	default:
+		return SchemaRecord{}, fmt.Errorf("unsupported schema status %q", status)
+	}
+
 	hash, err := computeJSONHash(params.Definition)
 	if err != nil {
 		return SchemaRecord{}, fmt.Errorf("compute schema hash: %w", err)
 	}
 
+	var compatibility []byte
+	if params.Compatibility != nil {
+		if compatibility, err = json.Marshal(params.Compatibility); err != nil {
+			return SchemaRecord{}, fmt.Errorf("encode schema compatibility: %w", err)
+		}
+	}
+
 	tableName, err := s.resolveSchemaTableName(ctx, tx, params.SchemaID, params.TableName)
 	if err != nil {
 		return SchemaRecord{}, err
@@ -86,6 +144,7 @@ func (s *SchemaRepositoryStore) CreateOrUpdateSchemaTx(ctx context.Context, tx p
 	if err != nil {
 		return SchemaRecord{}, err
 	}
+	s.invalidate(ctx, params.SchemaID)
 
 	if params.Activate {
 		if _, err = tx.Exec(ctx, `
@@ -99,9 +158,9 @@ func (s *SchemaRepositoryStore) CreateOrUpdateSchemaTx(ctx context.Context, tx p
 
 	if _, err = tx.Exec(ctx, `
         INSERT INTO schema_repository (
-			schema_id, schema_version, schema_definition, hash, table_name, slug, category_id, is_active, is_deleted, created_at, created_by
+			schema_id, schema_version, schema_definition, hash, table_name, slug, category_id, is_active, is_deleted, created_at, created_by, compatibility, status
         ) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, FALSE, NOW(), $9
+			$1, $2, $3, $4, $5, $6, $7, $8, FALSE, NOW(), $9, $10, $11
         )
         ON CONFLICT (schema_id, schema_version)
         DO UPDATE
@@ -112,13 +171,15 @@ func (s *SchemaRepositoryStore) CreateOrUpdateSchemaTx(ctx context.Context, tx p
 			table_name = EXCLUDED.table_name,
 			slug = EXCLUDED.slug,
 			category_id = EXCLUDED.category_id,
-			created_by = COALESCE(EXCLUDED.created_by, schema_repository.created_by)
-	`, params.SchemaID, params.Version.String(), []byte(params.Definition), hash, tableName, slug, params.CategoryID, params.Activate, params.CreatedBy); err != nil {
+			created_by = COALESCE(EXCLUDED.created_by, schema_repository.created_by),
+			compatibility = EXCLUDED.compatibility,
+			status = EXCLUDED.status
+	`, params.SchemaID, params.Version.String(), []byte(params.Definition), hash, tableName, slug, params.CategoryID, params.Activate, params.CreatedBy, compatibility, status); err != nil {
 		return SchemaRecord{}, fmt.Errorf("upsert schema: %w", err)
 	}
 
 	row := tx.QueryRow(ctx, `
-        SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+        SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
         FROM schema_repository
         WHERE schema_id = $1 AND schema_version = $2
     `, params.SchemaID, params.Version.String())
@@ -137,8 +198,12 @@ func (s *SchemaRepositoryStore) GetSchemaByVersion(ctx context.Context, spaceDB
 		return SchemaRecord{}, errors.New("admin db is required")
 	}
 
+	if record, ok := s.cached(ctx, schemaID, version.String()); ok {
+		return record, nil
+	}
+
 	var record SchemaRecord
-	return record, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
 		rec, err := s.GetSchemaByVersionTx(ctx, tx, schemaID, version)
 		if err != nil {
 			return err
@@ -146,12 +211,17 @@ func (s *SchemaRepositoryStore) GetSchemaByVersion(ctx context.Context, spaceDB
 		record = rec
 		return nil
 	})
+	if err != nil {
+		return SchemaRecord{}, err
+	}
+	s.store(ctx, schemaID, version.String(), record)
+	return record, nil
 }
 
 // GetSchemaByVersionTx retrieves a specific schema version inside a transaction.
 func (s *SchemaRepositoryStore) GetSchemaByVersionTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, version SemanticVersion) (SchemaRecord, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
 		FROM schema_repository
 		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE
 	`, schemaID, version.String())
@@ -173,8 +243,12 @@ func (s *SchemaRepositoryStore) GetActiveSchema(ctx context.Context, spaceDB *Sp
 		return SchemaRecord{}, errors.New("admin db is required")
 	}
 
+	if record, ok := s.cached(ctx, schemaID, schemaCacheActiveKey); ok {
+		return record, nil
+	}
+
 	var record SchemaRecord
-	return record, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
 		rec, err := s.GetActiveSchemaTx(ctx, tx, schemaID)
 		if err != nil {
 			return err
@@ -182,14 +256,19 @@ func (s *SchemaRepositoryStore) GetActiveSchema(ctx context.Context, spaceDB *Sp
 		record = rec
 		return nil
 	})
+	if err != nil {
+		return SchemaRecord{}, err
+	}
+	s.store(ctx, schemaID, schemaCacheActiveKey, record)
+	return record, nil
 }
 
 // GetActiveSchemaTx fetches the currently active schema inside a transaction.
 func (s *SchemaRepositoryStore) GetActiveSchemaTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID) (SchemaRecord, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
 		FROM schema_repository
-		WHERE schema_id = $1 AND is_active = TRUE AND is_deleted = FALSE
+		WHERE schema_id = $1 AND is_active = TRUE AND is_deleted = FALSE AND status = 'published'
 	`, schemaID)
 
 	record, err := scanSchemaRecord(row)
@@ -210,7 +289,7 @@ func (s *SchemaRepositoryStore) ListSchemas(ctx context.Context, spaceDB *SpaceD
 	}
 
 	var records []SchemaRecord
-	return records, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	return records, spaceDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
 		list, err := s.ListSchemasTx(ctx, tx, schemaID)
 		if err != nil {
 			return err
@@ -223,7 +302,7 @@ func (s *SchemaRepositoryStore) ListSchemas(ctx context.Context, spaceDB *SpaceD
 // ListSchemasTx lists schema versions for a schema ID inside a transaction.
 func (s *SchemaRepositoryStore) ListSchemasTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID) ([]SchemaRecord, error) {
 	rows, err := tx.Query(ctx, `
-		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
 		FROM schema_repository
 		WHERE schema_id = $1
 		ORDER BY created_at DESC
@@ -256,7 +335,7 @@ func (s *SchemaRepositoryStore) ListAllSchemaVersions(ctx context.Context, space
 	}
 
 	var records []SchemaRecord
-	return records, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	return records, spaceDB.WithAdminRead(ctx, func(tx pgx.Tx) error {
 		list, err := s.ListAllSchemaVersionsTx(ctx, tx, includeInactive)
 		if err != nil {
 			return err
@@ -269,7 +348,7 @@ func (s *SchemaRepositoryStore) ListAllSchemaVersions(ctx context.Context, space
 // ListAllSchemaVersionsTx returns every schema version inside a transaction.
 func (s *SchemaRepositoryStore) ListAllSchemaVersionsTx(ctx context.Context, tx pgx.Tx, includeInactive bool) ([]SchemaRecord, error) {
 	query := `
-	        SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+	        SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
 	        FROM schema_repository
 	        WHERE $1::bool = TRUE OR is_active = TRUE
 	        ORDER BY created_at DESC
@@ -325,9 +404,9 @@ func (s *SchemaRepositoryStore) GetActiveSchemaByTableNameTx(ctx context.Context
 	}
 
 	row := tx.QueryRow(ctx, `
-		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
 		FROM schema_repository
-		WHERE table_name = $1 AND is_active = TRUE AND is_deleted = FALSE
+		WHERE table_name = $1 AND is_active = TRUE AND is_deleted = FALSE AND status = 'published'
 		LIMIT 1
 	`, normalized)
 
@@ -343,6 +422,7 @@ func (s *SchemaRepositoryStore) GetActiveSchemaByTableNameTx(ctx context.Context
 }
 
 // GetLatestSchemaBySlug returns the most recent schema record that matches the provided slug.
+// Slugs of renamed schemas resolve through schema_slug_aliases.
 func (s *SchemaRepositoryStore) GetLatestSchemaBySlug(ctx context.Context, spaceDB *SpaceDB, slug string) (SchemaRecord, error) {
 	if spaceDB == nil {
 		return SchemaRecord{}, errors.New("admin db is required")
@@ -362,9 +442,10 @@ func (s *SchemaRepositoryStore) GetLatestSchemaBySlug(ctx context.Context, space
 // GetLatestSchemaBySlugTx returns the most recent schema record that matches the provided slug inside a transaction.
 func (s *SchemaRepositoryStore) GetLatestSchemaBySlugTx(ctx context.Context, tx pgx.Tx, slug string) (SchemaRecord, error) {
 	row := tx.QueryRow(ctx, `
-		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active
+		SELECT schema_id, schema_version, category_id, table_name, slug, schema_definition, hash, created_at, created_by, is_deleted, is_active, compatibility, status
 		FROM schema_repository
 		WHERE slug = $1
+		   OR schema_id = (SELECT schema_id FROM schema_slug_aliases WHERE slug = $1)
 		ORDER BY created_at DESC
 		LIMIT 1
 	`, slug)
@@ -386,13 +467,16 @@ func (s *SchemaRepositoryStore) ActivateSchemaVersion(ctx context.Context, space
 		return errors.New("admin db is required")
 	}
 
-	return spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
 		return s.ActivateSchemaVersionTx(ctx, tx, schemaID, version)
 	})
+	s.invalidate(ctx, schemaID)
+	return err
 }
 
 // ActivateSchemaVersionTx toggles the target version as the active one inside a transaction.
 func (s *SchemaRepositoryStore) ActivateSchemaVersionTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, version SemanticVersion) error {
+	s.invalidate(ctx, schemaID)
 	if _, err := tx.Exec(ctx, `
 		UPDATE schema_repository
 		SET is_active = FALSE
@@ -404,7 +488,7 @@ func (s *SchemaRepositoryStore) ActivateSchemaVersionTx(ctx context.Context, tx
 	result, err := tx.Exec(ctx, `
 		UPDATE schema_repository
 		SET is_active = TRUE
-		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE
+		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE AND status = 'published'
 	`, schemaID, version.String())
 	if err != nil {
 		return fmt.Errorf("activate schema: %w", err)
@@ -412,43 +496,209 @@ func (s *SchemaRepositoryStore) ActivateSchemaVersionTx(ctx context.Context, tx
 
 	affected := result.RowsAffected()
 	if affected == 0 {
-		return ErrSchemaNotFound
+		return s.missingVersionError(ctx, tx, schemaID, version)
 	}
 
 	return nil
 }
 
-// DeleteSchema marks the provided schema version as deleted and deactivates it when needed.
-// deletedAt is ignored because schema versions are immutable and only track creation timestamps.
-func (s *SchemaRepositoryStore) DeleteSchema(ctx context.Context, spaceDB *SpaceDB, schemaID uuid.UUID, version SemanticVersion, deletedAt time.Time) error {
+// PublishSchemaVersion publishes a draft version and makes it the active one in a single transaction.
+func (s *SchemaRepositoryStore) PublishSchemaVersion(ctx context.Context, spaceDB *SpaceDB, schemaID uuid.UUID, version SemanticVersion) error {
 	if spaceDB == nil {
 		return errors.New("admin db is required")
 	}
 
-	return spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
-		return s.DeleteSchemaTx(ctx, tx, schemaID, version, deletedAt)
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		return s.PublishSchemaVersionTx(ctx, tx, schemaID, version)
 	})
+	s.invalidate(ctx, schemaID)
+	return err
 }
 
-// DeleteSchemaTx marks the provided schema version as deleted inside a transaction.
-// deletedAt is ignored because schema versions are immutable and only track creation timestamps.
-func (s *SchemaRepositoryStore) DeleteSchemaTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, version SemanticVersion, _ time.Time) error {
+// PublishSchemaVersionTx publishes a draft version and activates it inside a transaction.
+// ErrSchemaVersionPublished is returned when the version is not a draft.
+func (s *SchemaRepositoryStore) PublishSchemaVersionTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, version SemanticVersion) error {
+	s.invalidate(ctx, schemaID)
+	result, err := tx.Exec(ctx, `
+		UPDATE schema_repository
+		SET status = 'published'
+		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE AND status = 'draft'
+	`, schemaID, version.String())
+	if err != nil {
+		return fmt.Errorf("publish schema: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		if err := s.missingVersionError(ctx, tx, schemaID, version); !errors.Is(err, ErrSchemaVersionDraft) {
+			return err
+		}
+		return ErrSchemaVersionPublished
+	}
+
+	return s.ActivateSchemaVersionTx(ctx, tx, schemaID, version)
+}
+
+// missingVersionError explains why an update targeting a single live version matched no row:
+// ErrSchemaVersionDraft for drafts, ErrSchemaNotFound when it does not exist or is deleted.
+func (s *SchemaRepositoryStore) missingVersionError(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, version SemanticVersion) error {
+	var status string
+	err := tx.QueryRow(ctx, `
+		SELECT status
+		FROM schema_repository
+		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE
+	`, schemaID, version.String()).Scan(&status)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return ErrSchemaNotFound
+	case err != nil:
+		return fmt.Errorf("lookup schema status: %w", err)
+	case status == SchemaStatusDraft:
+		return ErrSchemaVersionDraft
+	default:
+		return ErrSchemaNotFound
+	}
+}
+
+// SchemaVersionDeletion reports what deleting a schema version changed, or would change on a dry run. The entity
+// rows pinned to the version keep their data; they are counted because they no longer resolve to a live version.
+type SchemaVersionDeletion struct {
+	TableName string
+	WasActive bool
+	// EntityCount is the number of entity rows, across every tenant space, pinned to the version.
+	EntityCount int64
+	// TableCount is the number of entity tables holding those rows.
+	TableCount int
+}
+
+// errSchemaDeleteDryRun rolls back a dry-run delete after it ran, so dry runs report exactly what a real run changes.
+var errSchemaDeleteDryRun = errors.New("schema delete dry run")
+
+// DeleteSchema marks the provided schema version as deleted and deactivates it when needed.
+// Unless force is set, active versions and versions still referenced by entity rows are refused with
+// a *SchemaVersionInUseError. With dryRun the delete runs and is rolled back.
+func (s *SchemaRepositoryStore) DeleteSchema(ctx context.Context, spaceDB *SpaceDB, schemaID uuid.UUID, version SemanticVersion, deletedAt time.Time, force, dryRun bool) (SchemaVersionDeletion, error) {
+	if spaceDB == nil {
+		return SchemaVersionDeletion{}, errors.New("admin db is required")
+	}
+
+	var deletion SchemaVersionDeletion
+	err := spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		var err error
+		if deletion, err = s.DeleteSchemaTx(ctx, tx, schemaID, version, deletedAt, force); err != nil {
+			return err
+		}
+		if dryRun {
+			return errSchemaDeleteDryRun
+		}
+		return nil
+	})
+	s.invalidate(ctx, schemaID)
+	if err != nil && !errors.Is(err, errSchemaDeleteDryRun) {
+		return SchemaVersionDeletion{}, err
+	}
+	return deletion, nil
+}
+
+// DeleteSchemaTx marks the provided schema version as deleted inside a transaction. deletedAt starts the retention
+// window after which the cleanup purges the version.
+func (s *SchemaRepositoryStore) DeleteSchemaTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, version SemanticVersion, deletedAt time.Time, force bool) (SchemaVersionDeletion, error) {
+	s.invalidate(ctx, schemaID)
+	var deletion SchemaVersionDeletion
+	err := tx.QueryRow(ctx, `
+		SELECT is_active, table_name
+		FROM schema_repository
+		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE
+		FOR UPDATE
+	`, schemaID, version.String()).Scan(&deletion.WasActive, &deletion.TableName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SchemaVersionDeletion{}, ErrSchemaNotFound
+		}
+		return SchemaVersionDeletion{}, fmt.Errorf("lookup schema: %w", err)
+	}
+
+	deletion.EntityCount, deletion.TableCount, err = countSchemaVersionReferences(ctx, tx, deletion.TableName, schemaID, version)
+	if err != nil {
+		return SchemaVersionDeletion{}, err
+	}
+	if !force && (deletion.WasActive || deletion.EntityCount > 0) {
+		return SchemaVersionDeletion{}, &SchemaVersionInUseError{Active: deletion.WasActive, EntityCount: deletion.EntityCount, TableCount: deletion.TableCount}
+	}
+
 	result, err := tx.Exec(ctx, `
 		UPDATE schema_repository
 		SET is_deleted = TRUE,
-		    is_active = FALSE
+		    is_active = FALSE,
+		    deleted_at = $3
 		WHERE schema_id = $1 AND schema_version = $2 AND is_deleted = FALSE
-	`, schemaID, version.String())
+	`, schemaID, version.String(), deletedAt)
 	if err != nil {
-		return fmt.Errorf("soft delete schema: %w", err)
+		return SchemaVersionDeletion{}, fmt.Errorf("soft delete schema: %w", err)
 	}
 
 	affected := result.RowsAffected()
 	if affected == 0 {
-		return ErrSchemaNotFound
+		return SchemaVersionDeletion{}, ErrSchemaNotFound
 	}
 
-	return nil
+	return deletion, nil
+}
+
+func (s *SchemaRepositoryStore) cached(ctx context.Context, schemaID uuid.UUID, key string) (SchemaRecord, bool) {
+	if s.cache == nil {
+		return SchemaRecord{}, false
+	}
+	return s.cache.Get(ctx, schemaID, key)
+}
+
+func (s *SchemaRepositoryStore) store(ctx context.Context, schemaID uuid.UUID, key string, record SchemaRecord) {
+	if s.cache != nil {
+		s.cache.Put(ctx, schemaID, key, record)
+	}
+}
+
+// invalidate drops the cached versions of the schema. Writers call it inside the transaction and again once it
+// has ended, so a read racing the commit cannot leave the previous version cached.
+func (s *SchemaRepositoryStore) invalidate(ctx context.Context, schemaID uuid.UUID) {
+	if s.cache != nil {
+		s.cache.Invalidate(context.WithoutCancel(ctx), schemaID)
+	}
+}
+
+// countSchemaVersionReferences counts entity rows pinned to a schema version. Entity tables share the
+// schema's table name in every tenant space, so each existing copy is counted.
+func countSchemaVersionReferences(ctx context.Context, tx pgx.Tx, tableName string, schemaID uuid.UUID, version SemanticVersion) (int64, int, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT schemaname
+		FROM pg_catalog.pg_tables
+		WHERE tablename = $1
+		ORDER BY schemaname
+	`, tableName)
+	if err != nil {
+		return 0, 0, fmt.Errorf("list entity tables: %w", err)
+	}
+	spaces, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, 0, fmt.Errorf("list entity tables: %w", err)
+	}
+
+	var (
+		entityCount int64
+		tableCount  int
+	)
+	for _, space := range spaces {
+		var count int64
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE schema_id = $1 AND schema_version = $2`, pgx.Identifier{space, tableName}.Sanitize())
+		if err := tx.QueryRow(ctx, query, schemaID, version.String()).Scan(&count); err != nil {
+			return 0, 0, fmt.Errorf("count entity references in %s.%s: %w", space, tableName, err)
+		}
+		if count > 0 {
+			entityCount += count
+			tableCount++
+		}
+	}
+
+	return entityCount, tableCount, nil
 }
 
 type rowScanner interface {
@@ -468,9 +718,11 @@ func scanSchemaRecord(scanner rowScanner) (SchemaRecord, error) {
 		createdBy   *string
 		isDeleted   bool
 		isActive    bool
+		rawCompat   []byte
+		status      string
 	)
 
-	if err := scanner.Scan(&schemaID, &versionText, &categoryID, &tableName, &slug, &rawDef, &hash, &createdAt, &createdBy, &isDeleted, &isActive); err != nil {
+	if err := scanner.Scan(&schemaID, &versionText, &categoryID, &tableName, &slug, &rawDef, &hash, &createdAt, &createdBy, &isDeleted, &isActive, &rawCompat, &status); err != nil {
 		return SchemaRecord{}, err
 	}
 
@@ -479,6 +731,14 @@ func scanSchemaRecord(scanner rowScanner) (SchemaRecord, error) {
 		return SchemaRecord{}, fmt.Errorf("parse schema version %q: %w", versionText, err)
 	}
 
+	var compatibility *SchemaCompatibility
+	if len(rawCompat) > 0 {
+		compatibility = &SchemaCompatibility{}
+		if err := json.Unmarshal(rawCompat, compatibility); err != nil {
+			return SchemaRecord{}, fmt.Errorf("decode schema compatibility: %w", err)
+		}
+	}
+
 	return SchemaRecord{
 		SchemaID:         schemaID,
 		SchemaVersion:    version,
@@ -491,6 +751,8 @@ func scanSchemaRecord(scanner rowScanner) (SchemaRecord, error) {
 		CreatedBy:        createdBy,
 		IsDeleted:        isDeleted,
 		IsActive:         isActive,
+		Compatibility:    compatibility,
+		Status:           status,
 	}, nil
 }
 
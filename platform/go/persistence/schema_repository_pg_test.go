@@ -93,6 +93,11 @@ func TestSchemaRepositoryStoreIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, categories, 2)
 
+	var cycleErr *SchemaCategoryCycleError
+	_, err = categoryStore.UpdateSchemaCategory(ctx, spaceDB, rootCategoryID, UpdateSchemaCategoryParams{ParentCategoryID: &childCategoryID})
+	require.ErrorAs(t, err, &cycleErr)
+	require.Equal(t, []string{"system-schemas", "cards", "system-schemas"}, cycleErr.Path)
+
 	defV1 := SchemaDefinition(`{"title":"schema-v1"}`)
 	versionV1 := SemanticVersion{Major: 1, Minor: 0, Patch: 0}
 
@@ -111,6 +116,24 @@ func TestSchemaRepositoryStoreIntegration(t *testing.T) {
 	require.Equal(t, "cards-schema", recordV1.Slug)
 	require.Equal(t, childCategoryID, recordV1.CategoryID)
 
+	tree, err := categoryStore.SchemaCategoryTree(ctx, spaceDB)
+	require.NoError(t, err)
+	require.Len(t, tree, 1)
+	require.Equal(t, rootCategoryID, tree[0].CategoryID)
+	require.Zero(t, tree[0].SchemaCount)
+	require.Equal(t, int64(1), tree[0].TotalSchemaCount)
+	require.Len(t, tree[0].Children, 1)
+	require.Equal(t, childCategoryID, tree[0].Children[0].CategoryID)
+	require.Equal(t, int64(1), tree[0].Children[0].SchemaCount)
+
+	rootSchemas, err := categoryStore.ListCategorySchemas(ctx, spaceDB, rootCategoryID, false)
+	require.NoError(t, err)
+	require.Empty(t, rootSchemas)
+	rootSchemas, err = categoryStore.ListCategorySchemas(ctx, spaceDB, rootCategoryID, true)
+	require.NoError(t, err)
+	require.Len(t, rootSchemas, 1)
+	require.Equal(t, schemaID, rootSchemas[0].SchemaID)
+
 	gotV1, err := store.GetSchemaByVersion(ctx, spaceDB, schemaID, versionV1)
 	require.NoError(t, err)
 	require.JSONEq(t, string(defV1), string(gotV1.SchemaDefinition))
@@ -160,7 +183,20 @@ func TestSchemaRepositoryStoreIntegration(t *testing.T) {
 	require.Equal(t, versionV1, active.SchemaVersion)
 
 	deleteTime := time.Now().UTC()
-	require.NoError(t, store.DeleteSchema(ctx, spaceDB, schemaID, versionV1, deleteTime))
+	var inUseErr *SchemaVersionInUseError
+	_, err = store.DeleteSchema(ctx, spaceDB, schemaID, versionV1, deleteTime, false, false)
+	require.ErrorAs(t, err, &inUseErr)
+	require.True(t, inUseErr.Active)
+	require.Zero(t, inUseErr.EntityCount)
+
+	deletion, err := store.DeleteSchema(ctx, spaceDB, schemaID, versionV1, deleteTime, true, true)
+	require.NoError(t, err)
+	require.True(t, deletion.WasActive)
+	_, err = store.GetSchemaByVersion(ctx, spaceDB, schemaID, versionV1)
+	require.NoError(t, err, "dry run rolls the delete back")
+
+	_, err = store.DeleteSchema(ctx, spaceDB, schemaID, versionV1, deleteTime, true, false)
+	require.NoError(t, err)
 
 	_, err = store.GetSchemaByVersion(ctx, spaceDB, schemaID, versionV1)
 	require.ErrorIs(t, err, ErrSchemaNotFound)
@@ -186,8 +222,54 @@ func TestSchemaRepositoryStoreIntegration(t *testing.T) {
 	})
 	require.Error(t, err)
 
-	require.NoError(t, categoryStore.DeleteSchemaCategory(ctx, spaceDB, rootCategoryID, time.Now().UTC()))
+	previousSlug, err := store.RenameSchemaSlug(ctx, spaceDB, schemaID, "playing-cards", nil)
+	require.NoError(t, err)
+	require.Equal(t, "cards-schema", previousSlug)
+
+	byAlias, err := store.GetLatestSchemaBySlug(ctx, spaceDB, "cards-schema")
+	require.NoError(t, err)
+	require.Equal(t, schemaID, byAlias.SchemaID)
+	require.Equal(t, "playing-cards", byAlias.Slug)
+
+	aliases, err := store.ListSchemaSlugAliases(ctx, spaceDB, schemaID)
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+
+	bundles := NewSchemaBundleStore(store, categoryStore)
+	bundle, err := bundles.ExportBundle(ctx, spaceDB, []uuid.UUID{schemaID})
+	require.NoError(t, err)
+	require.NotEmpty(t, bundle.Schemas)
+
+	report, err := bundles.ImportBundle(ctx, spaceDB, bundle, false)
+	require.NoError(t, err)
+	for _, item := range report.Schemas {
+		require.Equal(t, SchemaBundleActionUnchanged, item.Action)
+	}
+
+	bundle.Schemas[0].Definition = SchemaDefinition(`{"title":"changed"}`)
+	report, err = bundles.ImportBundle(ctx, spaceDB, bundle, true)
+	require.ErrorIs(t, err, ErrSchemaBundleConflict)
+	require.False(t, report.Applied)
+	require.Len(t, report.Conflicts, 1)
+
+	var categoryInUseErr *SchemaCategoryInUseError
+	_, err = categoryStore.DeleteSchemaCategory(ctx, spaceDB, rootCategoryID, time.Now().UTC(), SchemaCategoryDeleteRestrict, false)
+	require.ErrorAs(t, err, &categoryInUseErr)
+	require.Equal(t, int64(1), categoryInUseErr.ChildCategories)
+
+	categoryDeletion, err := categoryStore.DeleteSchemaCategory(ctx, spaceDB, rootCategoryID, time.Now().UTC(), SchemaCategoryDeleteCascade, true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uuid.UUID{rootCategoryID, childCategoryID}, categoryDeletion.Categories)
+	_, err = categoryStore.GetSchemaCategory(ctx, spaceDB, childCategoryID)
+	require.NoError(t, err, "dry run rolls the delete back")
+
+	_, err = categoryStore.DeleteSchemaCategory(ctx, spaceDB, rootCategoryID, time.Now().UTC(), SchemaCategoryDeleteCascade, false)
+	require.NoError(t, err)
 
 	_, err = categoryStore.GetSchemaCategory(ctx, spaceDB, rootCategoryID)
 	require.ErrorIs(t, err, ErrSchemaNotFound)
+	_, err = categoryStore.GetSchemaCategory(ctx, spaceDB, childCategoryID)
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+	_, err = store.GetActiveSchema(ctx, spaceDB, schemaID)
+	require.ErrorIs(t, err, ErrSchemaNotFound)
 }
@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrSchemaSlugTaken indicates a slug is already the canonical slug, or an alias, of another schema.
+var ErrSchemaSlugTaken = errors.New("schema slug is already in use")
+
+// SchemaSlugAlias is a previous slug of a schema that still resolves to it.
+type SchemaSlugAlias struct {
+	Slug      string    `db:"slug" json:"slug"`
+	SchemaID  uuid.UUID `db:"schema_id" json:"schemaId"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+	CreatedBy *string   `db:"created_by" json:"createdBy"`
+}
+
+// RenameSchemaSlug gives every version of a schema a new canonical slug and keeps the previous slug as an alias.
+// It returns the previous slug.
+func (s *SchemaRepositoryStore) RenameSchemaSlug(ctx context.Context, spaceDB *SpaceDB, schemaID uuid.UUID, slug string, createdBy *string) (string, error) {
+	if spaceDB == nil {
+		return "", errors.New("admin db is required")
+	}
+
+	var previous string
+	return previous, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		prev, err := s.RenameSchemaSlugTx(ctx, tx, schemaID, slug, createdBy)
+		if err != nil {
+			return err
+		}
+		previous = prev
+		return nil
+	})
+}
+
+// RenameSchemaSlugTx renames a schema slug inside a transaction. Renaming back to one of the schema's own aliases
+// reclaims it; slugs owned by other schemas are refused with ErrSchemaSlugTaken.
+func (s *SchemaRepositoryStore) RenameSchemaSlugTx(ctx context.Context, tx pgx.Tx, schemaID uuid.UUID, slug string, createdBy *string) (string, error) {
+	normalized, err := NormalizeSlug(slug)
+	if err != nil {
+		return "", err
+	}
+
+	var previous string
+	err = tx.QueryRow(ctx, `
+		SELECT slug
+		FROM schema_repository
+		WHERE schema_id = $1
+		LIMIT 1
+		FOR UPDATE
+	`, schemaID).Scan(&previous)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrSchemaNotFound
+		}
+		return "", fmt.Errorf("lookup schema slug: %w", err)
+	}
+	if previous == normalized {
+		return previous, nil
+	}
+
+	var taken bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM schema_repository WHERE slug = $1 AND schema_id <> $2
+		) OR EXISTS (
+			SELECT 1 FROM schema_slug_aliases WHERE slug = $1 AND schema_id <> $2
+		)
+	`, normalized, schemaID).Scan(&taken)
+	if err != nil {
+		return "", fmt.Errorf("check schema slug: %w", err)
+	}
+	if taken {
+		return "", ErrSchemaSlugTaken
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM schema_slug_aliases WHERE slug = $1`, normalized); err != nil {
+		return "", fmt.Errorf("reclaim schema slug alias: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO schema_slug_aliases (slug, schema_id, created_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (slug) DO NOTHING
+	`, previous, schemaID, createdBy); err != nil {
+		return "", fmt.Errorf("record schema slug alias: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE schema_repository
+		SET slug = $2
+		WHERE schema_id = $1
+	`, schemaID, normalized); err != nil {
+		return "", fmt.Errorf("rename schema slug: %w", err)
+	}
+
+	return previous, nil
+}
+
+// ListSchemaSlugAliases returns the previous slugs of a schema, newest first.
+func (s *SchemaRepositoryStore) ListSchemaSlugAliases(ctx context.Context, spaceDB *SpaceDB, schemaID uuid.UUID) ([]SchemaSlugAlias, error) {
+	if spaceDB == nil {
+		return nil, errors.New("admin db is required")
+	}
+
+	var aliases []SchemaSlugAlias
+	return aliases, spaceDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT slug, schema_id, created_at, created_by
+			FROM schema_slug_aliases
+			WHERE schema_id = $1
+			ORDER BY created_at DESC, slug
+		`, schemaID)
+		if err != nil {
+			return fmt.Errorf("list schema slug aliases: %w", err)
+		}
+		collected, err := pgx.CollectRows(rows, pgx.RowToStructByName[SchemaSlugAlias])
+		if err != nil {
+			return fmt.Errorf("list schema slug aliases: %w", err)
+		}
+		aliases = collected
+		return nil
+	})
+}
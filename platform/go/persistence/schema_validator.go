@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"bytes"
+	"container/list"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -10,16 +11,37 @@ import (
 	"github.com/santhosh-tekuri/jsonschema/v5"
 )
 
-// SchemaValidator validates payloads against JSON Schemas compiled via santhosh-tekuri/jsonschema.
+// DefaultSchemaValidatorCacheSize bounds the compiled schemas kept by NewSchemaValidator.
+const DefaultSchemaValidatorCacheSize = 1024
+
+// SchemaValidator validates payloads against JSON Schemas compiled via santhosh-tekuri/jsonschema. Compiled schemas
+// are cached per schema version and definition hash, evicting the least recently used beyond the cache size.
 type SchemaValidator struct {
-	mu    sync.RWMutex
-	cache map[string]*jsonschema.Schema
+	size  int
+	mu    sync.Mutex
+	order *list.List // front is the most recently used *compiledSchema
+	cache map[string]*list.Element
+}
+
+type compiledSchema struct {
+	key    string
+	schema *jsonschema.Schema
 }
 
-// NewSchemaValidator returns a validator with an empty schema cache.
+// NewSchemaValidator returns a validator with an empty schema cache of DefaultSchemaValidatorCacheSize entries.
 func NewSchemaValidator() *SchemaValidator {
+	return NewSchemaValidatorWithCacheSize(DefaultSchemaValidatorCacheSize)
+}
+
+// NewSchemaValidatorWithCacheSize returns a validator caching up to size compiled schemas; size below 1 is treated as 1.
+func NewSchemaValidatorWithCacheSize(size int) *SchemaValidator {
+	if size < 1 {
+		size = 1
+	}
 	return &SchemaValidator{
-		cache: make(map[string]*jsonschema.Schema),
+		size:  size,
+		order: list.New(),
+		cache: make(map[string]*list.Element),
 	}
 }
 
@@ -48,36 +70,56 @@ func (v *SchemaValidator) Validate(ctx context.Context, schema SchemaRecord, pay
 
 func (v *SchemaValidator) getOrCompile(schema SchemaRecord) (*jsonschema.Schema, error) {
 	key := v.cacheKey(schema)
-
-	v.mu.RLock()
-	compiled, ok := v.cache[key]
-	v.mu.RUnlock()
-	if ok {
-		return compiled, nil
-	}
-
-	v.mu.Lock()
-	defer v.mu.Unlock()
-
-	// another goroutine may have populated the cache while we were waiting
-	if compiled, ok = v.cache[key]; ok {
+	if compiled, ok := v.lookup(key); ok {
 		return compiled, nil
 	}
 
+	// Compile outside the lock so a slow schema does not stall validations of cached ones; concurrent misses on
+	// the same key compile twice and the first stored result wins.
 	compiler := jsonschema.NewCompiler()
 	if err := compiler.AddResource(key, bytes.NewReader(schema.SchemaDefinition)); err != nil {
 		return nil, fmt.Errorf("register schema %s: %w", key, err)
 	}
 
-	newCompiled, err := compiler.Compile(key)
+	compiled, err := compiler.Compile(key)
 	if err != nil {
 		return nil, fmt.Errorf("compile schema %s: %w", key, err)
 	}
 
-	v.cache[key] = newCompiled
-	return newCompiled, nil
+	return v.store(key, compiled), nil
+}
+
+func (v *SchemaValidator) lookup(key string) (*jsonschema.Schema, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	element, ok := v.cache[key]
+	if !ok {
+		return nil, false
+	}
+	v.order.MoveToFront(element)
+	return element.Value.(*compiledSchema).schema, true
+}
+
+func (v *SchemaValidator) store(key string, compiled *jsonschema.Schema) *jsonschema.Schema {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if element, ok := v.cache[key]; ok {
+		v.order.MoveToFront(element)
+		return element.Value.(*compiledSchema).schema
+	}
+
+	v.cache[key] = v.order.PushFront(&compiledSchema{key: key, schema: compiled})
+	for v.order.Len() > v.size {
+		oldest := v.order.Back()
+		v.order.Remove(oldest)
+		delete(v.cache, oldest.Value.(*compiledSchema).key)
+	}
+	return compiled
 }
 
+// cacheKey includes the definition hash because draft versions can be rewritten in place.
 func (v *SchemaValidator) cacheKey(schema SchemaRecord) string {
-	return fmt.Sprintf("memory://schemas/%s/%s", schema.SchemaID.String(), schema.VersionString())
+	return fmt.Sprintf("memory://schemas/%s/%s/%s", schema.SchemaID.String(), schema.VersionString(), schema.Hash)
 }
@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const benchmarkSchemaDefinition = `{
+  "type": "object",
+  "required": ["sku", "quantity", "price"],
+  "additionalProperties": false,
+  "properties": {
+    "sku": {"type": "string", "pattern": "^[A-Z]{3}-[0-9]{4}$"},
+    "quantity": {"type": "integer", "minimum": 1},
+    "price": {"type": "number", "exclusiveMinimum": 0},
+    "tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true},
+    "shipping": {
+      "type": "object",
+      "required": ["country"],
+      "properties": {
+        "country": {"type": "string", "minLength": 2, "maxLength": 2},
+        "express": {"type": "boolean"}
+      }
+    }
+  }
+}`
+
+func validatorTestSchema(hash string) SchemaRecord {
+	return SchemaRecord{
+		SchemaID:         uuid.New(),
+		SchemaVersion:    SemanticVersion{Major: 1},
+		SchemaDefinition: SchemaDefinition(benchmarkSchemaDefinition),
+		Hash:             hash,
+	}
+}
+
+func TestSchemaValidatorEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	validator := NewSchemaValidatorWithCacheSize(2)
+	payload := []byte(`{"sku":"ABC-1234","quantity":1,"price":9.5}`)
+
+	first, second, third := validatorTestSchema("a"), validatorTestSchema("b"), validatorTestSchema("c")
+	require.NoError(t, validator.Validate(ctx, first, payload))
+	require.NoError(t, validator.Validate(ctx, second, payload))
+	// Touch the first schema so the second becomes the least recently used.
+	require.NoError(t, validator.Validate(ctx, first, payload))
+	require.NoError(t, validator.Validate(ctx, third, payload))
+
+	require.Len(t, validator.cache, 2)
+	require.Contains(t, validator.cache, validator.cacheKey(first))
+	require.Contains(t, validator.cache, validator.cacheKey(third))
+	require.NotContains(t, validator.cache, validator.cacheKey(second))
+
+	require.Error(t, validator.Validate(ctx, second, []byte(`{"sku":"bad","quantity":0,"price":1}`)))
+}
+
+// BenchmarkSchemaValidatorBulkWrites validates a batch of entity payloads the way a bulk write does, with the
+// compiled schema cached versus compiled for every payload.
+func BenchmarkSchemaValidatorBulkWrites(b *testing.B) {
+	ctx := context.Background()
+	schema := validatorTestSchema("bench")
+
+	payloads := make([][]byte, 100)
+	for i := range payloads {
+		payloads[i] = []byte(fmt.Sprintf(`{"sku":"ABC-%04d","quantity":%d,"price":%d.5,"tags":["a","b"],"shipping":{"country":"ES","express":true}}`, i, i+1, i))
+	}
+
+	b.Run("cached", func(b *testing.B) {
+		validator := NewSchemaValidator()
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, payload := range payloads {
+				if err := validator.Validate(ctx, schema, payload); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("compile-per-payload", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, payload := range payloads {
+				if err := NewSchemaValidator().Validate(ctx, schema, payload); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
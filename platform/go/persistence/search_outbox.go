@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const SearchOutboxTable = "search_outbox"
+
+// SearchOutboxEvent is an entity change waiting to be applied to the search index.
+type SearchOutboxEvent struct {
+	EventID  uuid.UUID
+	Event    EntityEvent
+	Attempts int
+}
+
+// SearchOutboxStore queues entity changes for the search indexer using the caller's transaction, like OutboxStore.
+type SearchOutboxStore struct{}
+
+// NewSearchOutboxStore returns a search outbox helper; the table comes from the tenant migrations.
+func NewSearchOutboxStore() *SearchOutboxStore {
+	return &SearchOutboxStore{}
+}
+
+// RecordEntityEvents implements EntityEventSink by queueing entity changes for indexing.
+func (s *SearchOutboxStore) RecordEntityEvents(ctx context.Context, tx pgx.Tx, events []EntityEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	rows := make([][]any, 0, len(events))
+	for _, event := range events {
+		var version *string
+		if event.EntityVersion != "" {
+			version = &event.EntityVersion
+		}
+		var payload []byte
+		if len(event.Payload) > 0 {
+			payload = event.Payload
+		}
+		occurredAt := event.OccurredAt
+		if occurredAt.IsZero() {
+			occurredAt = time.Now().UTC()
+		}
+		rows = append(rows, []any{uuid.New(), event.Type, event.TableName, event.EntityID, version, payload, occurredAt})
+	}
+
+	columns := []string{"event_id", "event_type", "table_name", "entity_id", "entity_version", "payload", "occurred_at"}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{SearchOutboxTable}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("enqueue search events: %w", err)
+	}
+	return nil
+}
+
+// ClaimPending locks up to limit unindexed events in occurrence order. Rows locked by another indexer are skipped,
+// and the locks are held until tx ends.
+func (s *SearchOutboxStore) ClaimPending(ctx context.Context, tx pgx.Tx, limit int) ([]SearchOutboxEvent, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT event_id, event_type, table_name, entity_id, entity_version, payload, occurred_at, attempts
+        FROM %s
+        WHERE indexed_at IS NULL
+        ORDER BY occurred_at, event_id
+        LIMIT $1
+        FOR UPDATE SKIP LOCKED
+    `, SearchOutboxTable), limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim search events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]SearchOutboxEvent, 0)
+	for rows.Next() {
+		var (
+			event   SearchOutboxEvent
+			version *string
+			payload []byte
+		)
+		if err := rows.Scan(&event.EventID, &event.Event.Type, &event.Event.TableName, &event.Event.EntityID, &version,
+			&payload, &event.Event.OccurredAt, &event.Attempts); err != nil {
+			return nil, fmt.Errorf("scan search event: %w", err)
+		}
+		if version != nil {
+			event.Event.EntityVersion = *version
+		}
+		if len(payload) > 0 {
+			event.Event.Payload = json.RawMessage(payload)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate search events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkIndexed flags the given events as applied to the index.
+func (s *SearchOutboxStore) MarkIndexed(ctx context.Context, tx pgx.Tx, eventIDs []uuid.UUID) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET indexed_at = NOW(), attempts = attempts + 1, last_error = NULL
+        WHERE event_id = ANY($1)
+    `, SearchOutboxTable), eventIDs); err != nil {
+		return fmt.Errorf("mark search events indexed: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed indexing attempt; the event stays pending and is retried on the next pass.
+func (s *SearchOutboxStore) MarkFailed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, cause error) error {
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET attempts = attempts + 1, last_error = $2
+        WHERE event_id = $1
+    `, SearchOutboxTable), eventID, cause.Error()); err != nil {
+		return fmt.Errorf("mark search event failed: %w", err)
+	}
+	return nil
+}
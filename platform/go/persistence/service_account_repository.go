@@ -0,0 +1,177 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const ServiceAccountsTable = "service_accounts"
+
+// ServiceAccount represents a row in the service_accounts table. Only the SHA-256 hash of the key secret is stored.
+type ServiceAccount struct {
+	ServiceAccountID uuid.UUID  `db:"service_account_id" json:"serviceAccountId"`
+	Name             string     `db:"name" json:"name"`
+	Description      *string    `db:"description" json:"description,omitempty"`
+	Scopes           []string   `db:"scopes" json:"scopes"`
+	SecretHash       string     `db:"secret_hash" json:"-"`
+	CreatedBy        *uuid.UUID `db:"created_by" json:"createdBy,omitempty"`
+	CreatedAt        time.Time  `db:"created_at" json:"createdAt"`
+	LastUsedAt       *time.Time `db:"last_used_at" json:"lastUsedAt,omitempty"`
+	RevokedAt        *time.Time `db:"revoked_at" json:"revokedAt,omitempty"`
+}
+
+// Service account errors.
+var (
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrServiceAccountConflict = errors.New("service account name already exists")
+)
+
+// ServiceAccountStore persists tenant service accounts in the tenant schema.
+type ServiceAccountStore struct {
+	db *SpaceDB
+}
+
+// NewServiceAccountStore returns a service account store working through db. The table comes from the tenant
+// migrations.
+func NewServiceAccountStore(db *SpaceDB) *ServiceAccountStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &ServiceAccountStore{db: db}
+}
+
+// CreateServiceAccountParams captures a new service account.
+type CreateServiceAccountParams struct {
+	ServiceAccountID uuid.UUID
+	Name             string
+	Description      *string
+	Scopes           []string
+	SecretHash       string
+	CreatedBy        *uuid.UUID
+}
+
+const serviceAccountSelectColumns = "service_account_id, name, description, scopes, secret_hash, created_by, created_at, last_used_at, revoked_at"
+
+// CreateServiceAccount inserts the account; a name already used by a live account returns ErrServiceAccountConflict.
+func (s *ServiceAccountStore) CreateServiceAccount(ctx context.Context, space tenant.Space, params CreateServiceAccountParams) (ServiceAccount, error) {
+	if params.ServiceAccountID == uuid.Nil || params.SecretHash == "" {
+		return ServiceAccount{}, errors.New("service account id and secret hash are required")
+	}
+
+	var account ServiceAccount
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (service_account_id, name, description, scopes, secret_hash, created_by)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING %s
+    `, ServiceAccountsTable, serviceAccountSelectColumns), params.ServiceAccountID, params.Name, params.Description,
+			append([]string{}, params.Scopes...), params.SecretHash, params.CreatedBy)
+
+		scanned, err := scanServiceAccount(row)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return ErrServiceAccountConflict
+			}
+			return fmt.Errorf("insert service account: %w", err)
+		}
+		account = scanned
+		return nil
+	})
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+	return account, nil
+}
+
+// GetServiceAccount returns an account by id, revoked or not.
+func (s *ServiceAccountStore) GetServiceAccount(ctx context.Context, space tenant.Space, id uuid.UUID) (ServiceAccount, error) {
+	var account ServiceAccount
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		scanned, err := scanServiceAccount(tx.QueryRow(ctx, fmt.Sprintf(`SELECT %s FROM %s WHERE service_account_id = $1`,
+			serviceAccountSelectColumns, ServiceAccountsTable), id))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrServiceAccountNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get service account: %w", err)
+		}
+		account = scanned
+		return nil
+	})
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+	return account, nil
+}
+
+// ListServiceAccounts returns the accounts that are not revoked, ordered by name.
+func (s *ServiceAccountStore) ListServiceAccounts(ctx context.Context, space tenant.Space) ([]ServiceAccount, error) {
+	accounts := []ServiceAccount{}
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		accounts = accounts[:0]
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT %s FROM %s
+        WHERE revoked_at IS NULL
+        ORDER BY name
+    `, serviceAccountSelectColumns, ServiceAccountsTable))
+		if err != nil {
+			return fmt.Errorf("list service accounts: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			account, err := scanServiceAccount(rows)
+			if err != nil {
+				return fmt.Errorf("scan service account: %w", err)
+			}
+			accounts = append(accounts, account)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// RevokeServiceAccount revokes the account; unknown or already revoked accounts return ErrServiceAccountNotFound.
+func (s *ServiceAccountStore) RevokeServiceAccount(ctx context.Context, space tenant.Space, id uuid.UUID, at time.Time) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s SET revoked_at = $2
+        WHERE service_account_id = $1 AND revoked_at IS NULL
+    `, ServiceAccountsTable), id, at.UTC())
+		if err != nil {
+			return fmt.Errorf("revoke service account: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrServiceAccountNotFound
+		}
+		return nil
+	})
+}
+
+// TouchServiceAccount records that the account obtained a token at the given time.
+func (s *ServiceAccountStore) TouchServiceAccount(ctx context.Context, space tenant.Space, id uuid.UUID, at time.Time) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET last_used_at = $2 WHERE service_account_id = $1`,
+			ServiceAccountsTable), id, at.UTC()); err != nil {
+			return fmt.Errorf("touch service account: %w", err)
+		}
+		return nil
+	})
+}
+
+func scanServiceAccount(row pgx.Row) (ServiceAccount, error) {
+	var account ServiceAccount
+	err := row.Scan(&account.ServiceAccountID, &account.Name, &account.Description, &account.Scopes, &account.SecretHash,
+		&account.CreatedBy, &account.CreatedAt, &account.LastUsedAt, &account.RevokedAt)
+	return account, err
+}
@@ -0,0 +1,108 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestServiceAccountStoreLifecycle(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping service account store integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA IF NOT EXISTS ` + adminSchema,
+		`CREATE SCHEMA IF NOT EXISTS ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+	} {
+		_, err = pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	accounts := NewServiceAccountStore(spaceDB)
+
+	creator := uuid.New()
+	description := "Nightly billing export"
+	created, err := accounts.CreateServiceAccount(ctx, space, CreateServiceAccountParams{
+		ServiceAccountID: uuid.New(),
+		Name:             "billing-sync",
+		Description:      &description,
+		Scopes:           []string{"entities:read"},
+		SecretHash:       "hash-1",
+		CreatedBy:        &creator,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"entities:read"}, created.Scopes)
+	require.Equal(t, creator, *created.CreatedBy)
+	require.Nil(t, created.LastUsedAt)
+
+	_, err = accounts.CreateServiceAccount(ctx, space, CreateServiceAccountParams{ServiceAccountID: uuid.New(), Name: "billing-sync", SecretHash: "hash-2"})
+	require.ErrorIs(t, err, ErrServiceAccountConflict)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	require.NoError(t, accounts.TouchServiceAccount(ctx, space, created.ServiceAccountID, now))
+	fetched, err := accounts.GetServiceAccount(ctx, space, created.ServiceAccountID)
+	require.NoError(t, err)
+	require.Equal(t, "hash-1", fetched.SecretHash)
+	require.True(t, fetched.LastUsedAt.Equal(now))
+
+	listed, err := accounts.ListServiceAccounts(ctx, space)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	require.ErrorIs(t, accounts.RevokeServiceAccount(ctx, space, uuid.New(), now), ErrServiceAccountNotFound)
+	require.NoError(t, accounts.RevokeServiceAccount(ctx, space, created.ServiceAccountID, now))
+	require.ErrorIs(t, accounts.RevokeServiceAccount(ctx, space, created.ServiceAccountID, now), ErrServiceAccountNotFound)
+
+	listed, err = accounts.ListServiceAccounts(ctx, space)
+	require.NoError(t, err)
+	require.Empty(t, listed)
+
+	// A revoked account frees its name.
+	_, err = accounts.CreateServiceAccount(ctx, space, CreateServiceAccountParams{ServiceAccountID: uuid.New(), Name: "billing-sync", SecretHash: "hash-3"})
+	require.NoError(t, err)
+}
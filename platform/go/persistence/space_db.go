@@ -3,7 +3,10 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -16,15 +19,71 @@ type txBeginner interface {
 	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
 }
 
-// SpaceDB wraps a pgx pool to execute queries within a space-specific search_path.
+// replicaRetryAfter is how long reads stay on the primary after the read replica failed to start a transaction.
+const replicaRetryAfter = 30 * time.Second
+
+// RetryPolicy controls how SpaceDB reruns a transaction that failed transiently: a serialization failure, a
+// deadlock or a dropped connection. Zero values fall back to defaults; MaxAttempts 1 disables retries.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	if p.BaseBackoff <= 0 {
+		p.BaseBackoff = 20 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = time.Second
+	}
+	return p
+}
+
+// backoff returns the delay before the attempt after attempt: BaseBackoff doubling per attempt, capped at
+// MaxBackoff, with up to half of it randomised so contending transactions do not retry in lockstep.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// SpaceDB wraps a pgx pool to execute queries within a space-specific search_path. Read-only work can go to an
+// optional read replica through WithAdminRead and WithTenantRead.
 type SpaceDB struct {
 	pool        txBeginner
+	readPool    txBeginner
 	adminSchema string
+	retry       RetryPolicy
+	timeouts    QueryTimeouts
+	// replicaDownUntil holds the unix nanoseconds until which reads skip the replica.
+	replicaDownUntil atomic.Int64
 }
 
 type SpaceDBConfig struct {
-	Pool        *pgxpool.Pool
+	Pool *pgxpool.Pool
+	// ReadPool is an optional read replica; nil sends reads to Pool.
+	ReadPool    *pgxpool.Pool
 	AdminSchema string
+	Retry       RetryPolicy
+	Timeouts    QueryTimeouts
+}
+
+// QueryTimeouts bounds how long statements of a SpaceDB transaction may run (statement_timeout) and wait for locks
+// (lock_timeout). Zero leaves the server setting in place. Both are capped by the time left before the context
+// deadline, so a request's queries are cancelled by the server once the request has timed out.
+type QueryTimeouts struct {
+	Statement time.Duration
+	Lock      time.Duration
 }
 
 func NewSpaceDB(cfg SpaceDBConfig) *SpaceDB {
@@ -36,55 +95,192 @@ func NewSpaceDB(cfg SpaceDBConfig) *SpaceDB {
 	if adminSchema == "" {
 		panic("SpaceDB requires admin schema")
 	}
-	return &SpaceDB{pool: cfg.Pool, adminSchema: adminSchema}
+	db := &SpaceDB{pool: cfg.Pool, adminSchema: adminSchema, retry: cfg.Retry.withDefaults(), timeouts: cfg.Timeouts}
+	if cfg.ReadPool != nil {
+		db.readPool = cfg.ReadPool
+	}
+	return db
 }
 
 // WithAdmin executes fn inside a transaction scoped to the admin schema only.
 // No role switching is performed; caller must rely on the connection's identity.
+//
+// Transactions that fail transiently are rerun according to the retry policy, so fn may run more than once and
+// must reset any state it accumulates outside the transaction.
 func (db *SpaceDB) WithAdmin(ctx context.Context, fn func(tx pgx.Tx) error) error {
-	tx, err := db.pool.BeginTx(ctx, pgx.TxOptions{})
+	return db.run(ctx, func() (pgx.Tx, error) {
+		return db.begin(ctx, db.pool, pgx.TxOptions{}, "", db.adminSchema)
+	}, fn)
+}
+
+// WithTenant executes fn inside a transaction with search_path set to space + admin schema. It is retried like
+// WithAdmin.
+func (db *SpaceDB) WithTenant(ctx context.Context, tenantSpace tenant.Space, fn func(tx pgx.Tx) error) error {
+	if strings.TrimSpace(tenantSpace.RoleName) == "" {
+		return fmt.Errorf("space role is required in tenant.Space")
+	}
+
+	// TODO: Check if it is possible to set read permissions for the schema tables for this transaction.
+
+	return db.run(ctx, func() (pgx.Tx, error) {
+		return db.begin(ctx, db.pool, pgx.TxOptions{}, tenantSpace.RoleName, db.tenantSearchPath(tenantSpace))
+	}, fn)
+}
+
+// WithAdminRead is WithAdmin for read-only work: fn runs in a read-only transaction on the read replica when one is
+// configured, and on the primary otherwise, while the replica is unavailable or when ctx is marked with
+// WithPrimaryReads. Replicas lag, so callers that must see their own writes keep using WithAdmin or mark ctx.
+func (db *SpaceDB) WithAdminRead(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	return db.run(ctx, func() (pgx.Tx, error) {
+		return db.beginRead(ctx, "", db.adminSchema)
+	}, fn)
+}
+
+// WithTenantRead is WithTenant for read-only work, routed like WithAdminRead.
+func (db *SpaceDB) WithTenantRead(ctx context.Context, tenantSpace tenant.Space, fn func(tx pgx.Tx) error) error {
+	if strings.TrimSpace(tenantSpace.RoleName) == "" {
+		return fmt.Errorf("space role is required in tenant.Space")
+	}
+
+	return db.run(ctx, func() (pgx.Tx, error) {
+		return db.beginRead(ctx, tenantSpace.RoleName, db.tenantSearchPath(tenantSpace))
+	}, fn)
+}
+
+type primaryReadsKey struct{}
+
+// WithPrimaryReads marks ctx so WithTenantRead and WithAdminRead run on the primary. Read-modify-write paths use it:
+// a lagging replica can return a version that is no longer current, and a write based on it is lost or rejected.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsKey{}, true)
+}
+
+// PrimaryReadsRequired reports whether ctx was marked with WithPrimaryReads.
+func PrimaryReadsRequired(ctx context.Context) bool {
+	required, _ := ctx.Value(primaryReadsKey{}).(bool)
+	return required
+}
+
+func (db *SpaceDB) tenantSearchPath(tenantSpace tenant.Space) string {
+	return fmt.Sprintf("%s, %s", tenantSpace.SchemaName, db.adminSchema)
+}
+
+// beginRead starts a read-only transaction on the replica, falling back to the primary when the replica cannot start
+// one. After a failure the replica is skipped for replicaRetryAfter so reads do not keep paying its timeout.
+func (db *SpaceDB) beginRead(ctx context.Context, role, searchPath string) (pgx.Tx, error) {
+	readOnly := pgx.TxOptions{AccessMode: pgx.ReadOnly}
+	if db.readPool != nil && !PrimaryReadsRequired(ctx) && time.Now().UnixNano() >= db.replicaDownUntil.Load() {
+		tx, err := db.begin(ctx, db.readPool, readOnly, role, searchPath)
+		if err == nil {
+			return tx, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		db.replicaDownUntil.Store(time.Now().Add(replicaRetryAfter).UnixNano())
+	}
+	return db.begin(ctx, db.pool, readOnly, role, searchPath)
+}
+
+// begin starts a transaction on pool, switching to role when set, with search_path set to searchPath and the query
+// timeouts applied.
+func (db *SpaceDB) begin(ctx context.Context, pool txBeginner, opts pgx.TxOptions, role, searchPath string) (pgx.Tx, error) {
+	tx, err := pool.BeginTx(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("begin tx: %w", err)
+		return nil, fmt.Errorf("begin tx: %w", err)
 	}
-	defer tx.Rollback(ctx) // nolint:errcheck
 
-	if _, err := tx.Exec(ctx, `SELECT set_config('search_path', $1, true)`, db.adminSchema); err != nil {
-		return fmt.Errorf("set search_path: %w", err)
+	if role != "" {
+		if _, err = tx.Exec(ctx, fmt.Sprintf("SET LOCAL ROLE %s", pgx.Identifier{role}.Sanitize())); err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, fmt.Errorf("set role: %w", err)
+		}
 	}
 
-	if err := fn(tx); err != nil {
-		return err
+	if _, err = tx.Exec(ctx, `SELECT set_config('search_path', $1, true)`, searchPath); err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, fmt.Errorf("set search_path: %w", err)
 	}
 
-	return tx.Commit(ctx)
+	statement, lock := db.timeouts.forContext(ctx)
+	for _, setting := range []struct {
+		name    string
+		timeout time.Duration
+	}{{"statement_timeout", statement}, {"lock_timeout", lock}} {
+		if setting.timeout <= 0 {
+			continue
+		}
+		if _, err = tx.Exec(ctx, `SELECT set_config($1, $2, true)`, setting.name, fmt.Sprintf("%dms", max(setting.timeout.Milliseconds(), 1))); err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, fmt.Errorf("set %s: %w", setting.name, err)
+		}
+	}
+	return tx, nil
 }
 
-// WithTenant executes fn inside a transaction with search_path set to space + admin schema.
-func (db *SpaceDB) WithTenant(ctx context.Context, tenantSpace tenant.Space, fn func(tx pgx.Tx) error) error {
-	tx, err := db.pool.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return fmt.Errorf("begin tx: %w", err)
+// forContext returns the statement and lock timeouts for a transaction started under ctx: the configured values
+// capped by the time left before its deadline. Without a configured statement timeout the deadline alone sets it.
+// A deadline already reached still yields a millisecond, because zero would disable the timeout.
+func (t QueryTimeouts) forContext(ctx context.Context) (statement, lock time.Duration) {
+	statement, lock = t.Statement, t.Lock
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return statement, lock
 	}
-	defer tx.Rollback(ctx) // nolint:errcheck
 
-	if strings.TrimSpace(tenantSpace.RoleName) == "" {
-		return fmt.Errorf("space role is required in tenant.Space")
+	remaining := max(time.Until(deadline), time.Millisecond)
+	if statement <= 0 || statement > remaining {
+		statement = remaining
 	}
+	if lock > remaining {
+		lock = remaining
+	}
+	return statement, lock
+}
 
-	if _, err = tx.Exec(ctx, fmt.Sprintf("SET LOCAL ROLE %s", pgx.Identifier{tenantSpace.RoleName}.Sanitize())); err != nil {
-		return fmt.Errorf("set role: %w", err)
+// run executes fn in a transaction from begin and commits it, rerunning the whole transaction with backoff while it
+// fails transiently, attempts remain and ctx is live. A failed commit is only rerun when the server reports it rolled
+// the transaction back; after a dropped connection the commit may have applied.
+func (db *SpaceDB) run(ctx context.Context, begin func() (pgx.Tx, error), fn func(tx pgx.Tx) error) error {
+	retry := db.retry
+	if retry.MaxAttempts <= 0 {
+		retry.MaxAttempts = 1
 	}
 
-	searchPath := fmt.Sprintf("%s, %s", tenantSpace.SchemaName, db.adminSchema)
-	if _, err = tx.Exec(ctx, `SELECT set_config('search_path', $1, true)`, searchPath); err != nil {
-		return fmt.Errorf("set search_path: %w", err)
+	for attempt := 1; ; attempt++ {
+		committing, err := attemptTx(ctx, begin, fn)
+		if err == nil || attempt >= retry.MaxAttempts {
+			return err
+		}
+		retryable := isTransientDBError(err)
+		if committing {
+			retryable = isTransactionRollback(err)
+		}
+		if !retryable {
+			return err
+		}
+
+		timer := time.NewTimer(retry.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
 	}
+}
 
-	// TODO: Check if it is possible to set read permissions for the schema tables for this transaction.
+// attemptTx runs fn in a transaction from begin and commits it. committing reports whether err came from COMMIT.
+func attemptTx(ctx context.Context, begin func() (pgx.Tx, error), fn func(tx pgx.Tx) error) (committing bool, err error) {
+	tx, err := begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
 
 	if err := fn(tx); err != nil {
-		return err
+		return false, err
 	}
 
-	return tx.Commit(ctx)
+	return true, tx.Commit(ctx)
 }
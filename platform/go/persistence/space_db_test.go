@@ -3,11 +3,14 @@ package persistence
 import (
 	"context"
 	"errors"
+	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
@@ -81,3 +84,208 @@ func TestSpaceDBWithSpaceMissingRole(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "space role is required")
 }
+
+// recordingPool counts transactions, remembers their options and fails them while err is set.
+type recordingPool struct {
+	tx    *fakeTx
+	err   error
+	opts  []pgx.TxOptions
+	begun int
+}
+
+func (p *recordingPool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	p.begun++
+	p.opts = append(p.opts, txOptions)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.tx, nil
+}
+
+func TestSpaceDBReadsUseReplica(t *testing.T) {
+	primary := &recordingPool{tx: &fakeTx{}}
+	replica := &recordingPool{tx: &fakeTx{}}
+	db := &SpaceDB{pool: primary, readPool: replica, adminSchema: "admin"}
+	space := tenant.Space{SchemaName: "tenant_acme", RoleName: "tenant_acme_role"}
+
+	require.NoError(t, db.WithTenantRead(context.Background(), space, func(tx pgx.Tx) error { return nil }))
+	require.NoError(t, db.WithAdminRead(context.Background(), func(tx pgx.Tx) error { return nil }))
+	require.NoError(t, db.WithTenant(context.Background(), space, func(tx pgx.Tx) error { return nil }))
+
+	require.Equal(t, 2, replica.begun)
+	require.Equal(t, pgx.ReadOnly, replica.opts[0].AccessMode)
+	require.Contains(t, replica.tx.calls[0].sql, "tenant_acme_role")
+	require.Equal(t, "admin", replica.tx.calls[2].args[0])
+	require.Equal(t, 1, primary.begun)
+}
+
+func TestSpaceDBPrimaryReadsSkipReplica(t *testing.T) {
+	primary := &recordingPool{tx: &fakeTx{}}
+	replica := &recordingPool{tx: &fakeTx{}}
+	db := &SpaceDB{pool: primary, readPool: replica, adminSchema: "admin"}
+	space := tenant.Space{SchemaName: "tenant_acme", RoleName: "tenant_acme_role"}
+	ctx := WithPrimaryReads(context.Background())
+
+	require.NoError(t, db.WithTenantRead(ctx, space, func(tx pgx.Tx) error { return nil }))
+	require.NoError(t, db.WithAdminRead(ctx, func(tx pgx.Tx) error { return nil }))
+
+	require.Zero(t, replica.begun)
+	require.Equal(t, 2, primary.begun)
+	require.Equal(t, pgx.ReadOnly, primary.opts[0].AccessMode)
+	require.False(t, PrimaryReadsRequired(context.Background()))
+}
+
+func TestSpaceDBReadsFallBackToPrimary(t *testing.T) {
+	primary := &recordingPool{tx: &fakeTx{}}
+	replica := &recordingPool{tx: &fakeTx{}, err: errors.New("replica down")}
+	db := &SpaceDB{pool: primary, readPool: replica, adminSchema: "admin"}
+
+	require.NoError(t, db.WithAdminRead(context.Background(), func(tx pgx.Tx) error { return nil }))
+	require.NoError(t, db.WithAdminRead(context.Background(), func(tx pgx.Tx) error { return nil }))
+
+	// The replica is skipped after its failure until replicaRetryAfter has passed.
+	require.Equal(t, 1, replica.begun)
+	require.Equal(t, 2, primary.begun)
+	require.Equal(t, pgx.ReadOnly, primary.opts[0].AccessMode)
+
+	replica.err = nil
+	db.replicaDownUntil.Store(0)
+	require.NoError(t, db.WithAdminRead(context.Background(), func(tx pgx.Tx) error { return nil }))
+	require.Equal(t, 2, replica.begun)
+	require.Equal(t, 2, primary.begun)
+}
+
+func TestSpaceDBReadsWithoutReplicaUsePrimary(t *testing.T) {
+	// A nil ReadPool must not end up as a non-nil txBeginner holding a nil pool.
+	primary := &recordingPool{tx: &fakeTx{}}
+	db := NewSpaceDB(SpaceDBConfig{Pool: &pgxpool.Pool{}, AdminSchema: "admin"})
+	db.pool = primary
+
+	require.NoError(t, db.WithAdminRead(context.Background(), func(tx pgx.Tx) error { return nil }))
+	require.Equal(t, 1, primary.begun)
+	require.Equal(t, pgx.ReadOnly, primary.opts[0].AccessMode)
+}
+
+// commitFailingTx fails Commit with the queued errors, one per call.
+type commitFailingTx struct {
+	fakeTx
+	commitErrs []error
+}
+
+func (f *commitFailingTx) Commit(ctx context.Context) error {
+	if len(f.commitErrs) == 0 {
+		return nil
+	}
+	err := f.commitErrs[0]
+	f.commitErrs = f.commitErrs[1:]
+	return err
+}
+
+type txPool struct {
+	tx    pgx.Tx
+	begun int
+}
+
+func (p *txPool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	p.begun++
+	return p.tx, nil
+}
+
+func retryingSpaceDB(pool txBeginner) *SpaceDB {
+	return &SpaceDB{pool: pool, adminSchema: "admin", retry: RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond}}
+}
+
+func TestSpaceDBRetriesTransientFailures(t *testing.T) {
+	pool := &txPool{tx: &fakeTx{}}
+	db := retryingSpaceDB(pool)
+
+	calls := 0
+	err := db.WithAdmin(context.Background(), func(tx pgx.Tx) error {
+		calls++
+		if calls == 1 {
+			return &pgconn.PgError{Code: deadlockDetectedCode}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, 2, pool.begun)
+
+	pool = &txPool{tx: &commitFailingTx{commitErrs: []error{&pgconn.PgError{Code: serializationFailureCode}}}}
+	db = retryingSpaceDB(pool)
+	require.NoError(t, db.WithAdmin(context.Background(), func(tx pgx.Tx) error { return nil }))
+	require.Equal(t, 2, pool.begun)
+}
+
+func TestSpaceDBDoesNotRetryPermanentOrAmbiguousFailures(t *testing.T) {
+	pool := &txPool{tx: &fakeTx{}}
+	db := retryingSpaceDB(pool)
+	err := db.WithAdmin(context.Background(), func(tx pgx.Tx) error {
+		return &pgconn.PgError{Code: uniqueViolationCode}
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, pool.begun)
+
+	// A connection lost during COMMIT may have committed, so the transaction is not rerun.
+	pool = &txPool{tx: &commitFailingTx{commitErrs: []error{io.ErrUnexpectedEOF}}}
+	db = retryingSpaceDB(pool)
+	require.ErrorIs(t, db.WithAdmin(context.Background(), func(tx pgx.Tx) error { return nil }), io.ErrUnexpectedEOF)
+	require.Equal(t, 1, pool.begun)
+}
+
+func TestSpaceDBRetriesStopAtMaxAttemptsAndContext(t *testing.T) {
+	pool := &txPool{tx: &fakeTx{}}
+	db := retryingSpaceDB(pool)
+	deadlock := &pgconn.PgError{Code: deadlockDetectedCode}
+
+	err := db.WithAdmin(context.Background(), func(tx pgx.Tx) error { return deadlock })
+	require.ErrorIs(t, err, deadlock)
+	require.Equal(t, 3, pool.begun)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.begun = 0
+	err = db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		cancel()
+		return deadlock
+	})
+	require.ErrorIs(t, err, deadlock)
+	require.Equal(t, 1, pool.begun)
+}
+
+func TestRetryPolicyBackoffIsCapped(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+	for attempt := 1; attempt < 10; attempt++ {
+		delay := policy.backoff(attempt)
+		require.GreaterOrEqual(t, delay, policy.BaseBackoff/2)
+		require.LessOrEqual(t, delay, policy.MaxBackoff)
+	}
+}
+
+func TestSpaceDBAppliesQueryTimeouts(t *testing.T) {
+	ftx := &fakeTx{}
+	db := &SpaceDB{pool: &fakePool{tx: ftx}, adminSchema: "admin", timeouts: QueryTimeouts{Statement: 30 * time.Second, Lock: 2 * time.Second}}
+
+	require.NoError(t, db.WithAdmin(context.Background(), func(tx pgx.Tx) error { return nil }))
+	require.Len(t, ftx.calls, 3)
+	require.Equal(t, []any{"statement_timeout", "30000ms"}, ftx.calls[1].args)
+	require.Equal(t, []any{"lock_timeout", "2000ms"}, ftx.calls[2].args)
+}
+
+func TestQueryTimeoutsFollowContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	statement, lock := QueryTimeouts{}.forContext(ctx)
+	require.Greater(t, statement, 900*time.Millisecond)
+	require.LessOrEqual(t, statement, time.Second)
+	require.Zero(t, lock)
+
+	statement, lock = QueryTimeouts{Statement: 100 * time.Millisecond, Lock: time.Minute}.forContext(ctx)
+	require.Equal(t, 100*time.Millisecond, statement)
+	require.LessOrEqual(t, lock, time.Second)
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	statement, _ = QueryTimeouts{}.forContext(expired)
+	require.Equal(t, time.Millisecond, statement)
+}
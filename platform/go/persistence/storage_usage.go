@@ -0,0 +1,179 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const StorageUsageTable = "storage_usage"
+
+// TenantEventStorageThreshold is recorded in the tenant outbox when a tenant's object storage usage reaches one of the
+// configured percentages of its maxObjectBytes quota.
+const TenantEventStorageThreshold = "tenant.storage_threshold_reached"
+
+// StorageUsageSample is one measurement of a tenant's object storage prefix.
+type StorageUsageSample struct {
+	Objects    int64
+	Bytes      int64
+	MeasuredAt time.Time
+}
+
+// StorageUsageReport describes a recorded sample. Reached is the threshold percentage the sample newly reached, and
+// 0 when no new threshold was crossed.
+type StorageUsageReport struct {
+	StorageUsageSample
+	Limit   *int64
+	Reached int
+}
+
+// StorageUsageStore records the object storage usage of tenants and announces quota thresholds as they are crossed.
+type StorageUsageStore struct {
+	db         *SpaceDB
+	outbox     *OutboxStore
+	thresholds []int
+}
+
+// NewStorageUsageStore returns a store alerting at thresholds, percentages of the maxObjectBytes quota. outbox may be
+// nil, in which case crossings are tracked and reported but no event is recorded.
+func NewStorageUsageStore(db *SpaceDB, outbox *OutboxStore, thresholds []int) *StorageUsageStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	sorted := slices.Clone(thresholds)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	for _, threshold := range sorted {
+		if threshold <= 0 {
+			panic("storage usage thresholds must be positive percentages")
+		}
+	}
+	return &StorageUsageStore{db: db, outbox: outbox, thresholds: sorted}
+}
+
+// storageThresholdPayload is the published body of storage threshold events.
+type storageThresholdPayload struct {
+	TenantID       uuid.UUID `json:"tenantId"`
+	Slug           string    `json:"slug"`
+	ThresholdPct   int       `json:"thresholdPercent"`
+	Objects        int64     `json:"objects"`
+	ObjectBytes    int64     `json:"objectBytes"`
+	MaxObjectBytes int64     `json:"maxObjectBytes"`
+	MeasuredAt     time.Time `json:"measuredAt"`
+}
+
+// RecordStorageUsage stores sample as the tenant's current object storage usage. When the sample reaches a threshold
+// above the last one announced, a TenantEventStorageThreshold event is appended to the tenant outbox in the same
+// transaction. Falling below a threshold re-arms it.
+func (s *StorageUsageStore) RecordStorageUsage(ctx context.Context, space tenant.Space, sample StorageUsageSample) (StorageUsageReport, error) {
+	if sample.MeasuredAt.IsZero() {
+		sample.MeasuredAt = time.Now().UTC()
+	}
+	limit := space.Quotas.MaxObjectBytes
+	level := storageAlertLevel(s.thresholds, sample.Bytes, limit)
+
+	var report StorageUsageReport
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		report = StorageUsageReport{StorageUsageSample: sample, Limit: limit}
+
+		var previous *int
+		err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT alerted_percent FROM %s WHERE id FOR UPDATE`, StorageUsageTable)).Scan(&previous)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("load storage usage: %w", err)
+		}
+
+		var alerted *int
+		if level > 0 {
+			alerted = &level
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (id, object_count, object_bytes, measured_at, alerted_percent)
+        VALUES (TRUE, $1, $2, $3, $4)
+        ON CONFLICT (id) DO UPDATE
+        SET object_count = EXCLUDED.object_count,
+            object_bytes = EXCLUDED.object_bytes,
+            measured_at = EXCLUDED.measured_at,
+            alerted_percent = EXCLUDED.alerted_percent
+    `, StorageUsageTable), sample.Objects, sample.Bytes, sample.MeasuredAt, alerted); err != nil {
+			return fmt.Errorf("record storage usage: %w", err)
+		}
+
+		if level == 0 || (previous != nil && *previous >= level) {
+			return nil
+		}
+		report.Reached = level
+		if s.outbox == nil {
+			return nil
+		}
+
+		body, err := json.Marshal(storageThresholdPayload{
+			TenantID:       space.TenantID,
+			Slug:           space.Slug,
+			ThresholdPct:   level,
+			Objects:        sample.Objects,
+			ObjectBytes:    sample.Bytes,
+			MaxObjectBytes: *limit,
+			MeasuredAt:     sample.MeasuredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("encode storage threshold event: %w", err)
+		}
+		return s.outbox.Append(ctx, tx, OutboxEvent{
+			Topic:      OutboxTopicTenants,
+			EventType:  TenantEventStorageThreshold,
+			Key:        space.TenantID.String(),
+			Payload:    body,
+			OccurredAt: sample.MeasuredAt,
+		})
+	})
+	if err != nil {
+		return StorageUsageReport{}, err
+	}
+	return report, nil
+}
+
+// storageAlertLevel returns the highest of the ascending thresholds that used bytes reach, as a percentage of limit,
+// or 0 when there is no limit or no threshold is reached.
+func storageAlertLevel(thresholds []int, used int64, limit *int64) int {
+	if limit == nil {
+		return 0
+	}
+	level := 0
+	for _, threshold := range thresholds {
+		// used/limit >= threshold/100, kept in integers; a zero limit is reached by any usage.
+		if (*limit == 0 && used > 0) || (*limit > 0 && used*100 >= int64(threshold)**limit) {
+			level = threshold
+		}
+	}
+	return level
+}
+
+// storageUsageTx returns the recorded object storage usage of the tenant schema; measuredAt is nil until the worker
+// first records it.
+func storageUsageTx(ctx context.Context, tx pgx.Tx, schemaName string) (objects, bytes int64, measuredAt *time.Time, err error) {
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, pgx.Identifier{schemaName, StorageUsageTable}.Sanitize()).Scan(&exists); err != nil {
+		return 0, 0, nil, fmt.Errorf("check storage usage table: %w", err)
+	}
+	if !exists {
+		return 0, 0, nil, nil
+	}
+
+	var at time.Time
+	err = tx.QueryRow(ctx, "SELECT object_count, object_bytes, measured_at FROM "+pgx.Identifier{schemaName, StorageUsageTable}.Sanitize()+" WHERE id").Scan(&objects, &bytes, &at)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, 0, nil, nil
+	}
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("load storage usage: %w", err)
+	}
+	return objects, bytes, &at, nil
+}
@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestStorageAlertLevel(t *testing.T) {
+	t.Parallel()
+
+	limit := func(n int64) *int64 { return &n }
+	thresholds := []int{80, 90, 100}
+
+	require.Equal(t, 0, storageAlertLevel(thresholds, 1<<40, nil))
+	require.Equal(t, 0, storageAlertLevel(thresholds, 799, limit(1000)))
+	require.Equal(t, 80, storageAlertLevel(thresholds, 800, limit(1000)))
+	require.Equal(t, 90, storageAlertLevel(thresholds, 999, limit(1000)))
+	require.Equal(t, 100, storageAlertLevel(thresholds, 1500, limit(1000)))
+	require.Equal(t, 0, storageAlertLevel(thresholds, 0, limit(0)))
+	require.Equal(t, 100, storageAlertLevel(thresholds, 1, limit(0)))
+}
+
+func TestStorageUsageStoreRecordsAndAlerts(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping storage usage integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + adminSchema,
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+	} {
+		_, err := pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	maxBytes := int64(1000)
+	space := tenant.Space{
+		TenantID:   uuid.New(),
+		Slug:       "acme_co",
+		SchemaName: schema,
+		RoleName:   role,
+		BasePrefix: "dev/acme-12345678/",
+		Quotas:     tenant.Quotas{MaxObjectBytes: &maxBytes},
+	}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	store := NewStorageUsageStore(spaceDB, NewOutboxStore(), []int{90, 80, 100})
+	meter := NewTenantUsageMeter(spaceDB)
+
+	usage, err := meter.Measure(ctx, space)
+	require.NoError(t, err)
+	require.Nil(t, usage.ObjectsMeasuredAt)
+
+	record := func(bytes int64) StorageUsageReport {
+		report, err := store.RecordStorageUsage(ctx, space, StorageUsageSample{Objects: 3, Bytes: bytes})
+		require.NoError(t, err)
+		return report
+	}
+	require.Equal(t, 0, record(100).Reached)
+	require.Equal(t, 90, record(950).Reached)
+	require.Equal(t, 0, record(960).Reached, "a threshold is announced once")
+	require.Equal(t, 0, record(850).Reached, "falling back re-arms the higher threshold")
+	require.Equal(t, 90, record(900).Reached)
+
+	usage, err = meter.Measure(ctx, space)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), usage.Objects)
+	require.Equal(t, int64(900), usage.ObjectBytes)
+	require.NotNil(t, usage.ObjectsMeasuredAt)
+
+	var payloads []json.RawMessage
+	err = spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `SELECT payload FROM outbox_events WHERE topic = $1 AND event_type = $2 ORDER BY occurred_at`, OutboxTopicTenants, TenantEventStorageThreshold)
+		if err != nil {
+			return err
+		}
+		payloads, err = pgx.CollectRows(rows, pgx.RowTo[json.RawMessage])
+		return err
+	})
+	require.NoError(t, err)
+	require.Len(t, payloads, 2)
+
+	var event storageThresholdPayload
+	require.NoError(t, json.Unmarshal(payloads[0], &event))
+	require.Equal(t, space.TenantID, event.TenantID)
+	require.Equal(t, 90, event.ThresholdPct)
+	require.Equal(t, int64(950), event.ObjectBytes)
+	require.Equal(t, maxBytes, event.MaxObjectBytes)
+
+	ctx = tenant.WithSpace(ctx, space)
+	require.NoError(t, meter.CheckQuota(ctx, tenant.QuotaObjectBytes, 100))
+	var quotaErr *tenant.QuotaExceededError
+	require.ErrorAs(t, meter.CheckQuota(ctx, tenant.QuotaObjectBytes, 101), &quotaErr)
+}
@@ -0,0 +1,498 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// PrivacyErasureRequestsTable queues the subject erasure requests of a tenant (tenant migration).
+const PrivacyErasureRequestsTable = "privacy_erasure_requests"
+
+// subjectIDKeyword marks a string property holding the identifier of the data subject a document is about.
+const subjectIDKeyword = "x-subject-id"
+
+// ErrErasureRequestNotFound is returned when an erasure request does not exist.
+var ErrErasureRequestNotFound = errors.New("erasure request not found")
+
+// ErasureMode selects what EraseSubject does with the documents of a subject.
+type ErasureMode string
+
+const (
+	// ErasureAnonymize keeps the documents and clears their x-subject-id, x-encrypt and x-pii properties in every
+	// version.
+	ErasureAnonymize ErasureMode = "anonymize"
+	// ErasurePurge removes the documents with all their versions.
+	ErasurePurge ErasureMode = "purge"
+)
+
+// Erasure request statuses.
+const (
+	ErasureQueued    = "queued"
+	ErasureRunning   = "running"
+	ErasureSucceeded = "succeeded"
+	ErasureFailed    = "failed"
+)
+
+// SubjectField describes a payload property declared with "x-subject-id": true.
+type SubjectField struct {
+	Path []string
+}
+
+// Name renders the field path in dotted form, e.g. "customer.id".
+func (f SubjectField) Name() string {
+	return strings.Join(f.Path, ".")
+}
+
+// SubjectFields returns the (nested) object properties of a schema definition marked "x-subject-id": true, ordered
+// by path.
+func SubjectFields(definition SchemaDefinition) ([]SubjectField, error) {
+	if len(definition) == 0 {
+		return nil, nil
+	}
+
+	var fields []SubjectField
+	err := walkSchemaProperties(definition, func(path []string, property map[string]interface{}) {
+		if subject, _ := property[subjectIDKeyword].(bool); subject {
+			fields = append(fields, SubjectField{Path: path})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ErasureRequest is a row of the privacy_erasure_requests table. Report and Signature are set once the request
+// succeeded.
+type ErasureRequest struct {
+	RequestID   uuid.UUID
+	SubjectID   string
+	UserID      *uuid.UUID
+	Mode        ErasureMode
+	Status      string
+	Attempts    int
+	Report      json.RawMessage
+	Signature   *string
+	LastError   *string
+	RequestedBy *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+// CreateErasureRequestParams captures the fields required to queue an erasure request.
+type CreateErasureRequestParams struct {
+	RequestID   uuid.UUID
+	SubjectID   string
+	UserID      *uuid.UUID
+	Mode        ErasureMode
+	RequestedBy *string
+}
+
+// ErasedEntity reports one document of the subject and how many of its versions were anonymized or removed.
+type ErasedEntity struct {
+	TableName string `json:"tableName"`
+	EntityID  string `json:"entityId"`
+	Versions  int    `json:"versions"`
+}
+
+// SubjectErasureReport lists what EraseSubject changed. ArchivedObjects lists the archive objects that still hold
+// earlier versions of the documents, which the erasure cannot rewrite.
+type SubjectErasureReport struct {
+	Entities        []ErasedEntity `json:"entities"`
+	ArchivedObjects []string       `json:"archivedObjects"`
+}
+
+// SubjectErasureStore finds the documents of a data subject by their x-subject-id properties and anonymizes or
+// purges them, and queues the erasure requests that ask for it.
+type SubjectErasureStore struct {
+	db *SpaceDB
+}
+
+// NewSubjectErasureStore returns a store instance; the request table comes from the tenant migrations.
+func NewSubjectErasureStore(db *SpaceDB) *SubjectErasureStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &SubjectErasureStore{db: db}
+}
+
+const erasureRequestColumns = `request_id, subject_id, user_id, mode, status, attempts, report, signature, last_error,
+        requested_by, created_at, updated_at, finished_at`
+
+// CreateErasureRequest queues a new erasure request.
+func (s *SubjectErasureStore) CreateErasureRequest(ctx context.Context, space tenant.Space, params CreateErasureRequestParams) (ErasureRequest, error) {
+	if params.RequestID == uuid.Nil {
+		return ErasureRequest{}, errors.New("request id is required")
+	}
+
+	var request ErasureRequest
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (request_id, subject_id, user_id, mode, status, requested_by)
+        VALUES ($1, $2, $3, $4, '%s', $5)
+        RETURNING `+erasureRequestColumns, PrivacyErasureRequestsTable, ErasureQueued),
+			params.RequestID, params.SubjectID, params.UserID, string(params.Mode), params.RequestedBy)
+		scanned, err := scanErasureRequest(row)
+		if err != nil {
+			return fmt.Errorf("insert erasure request: %w", err)
+		}
+		request = scanned
+		return nil
+	})
+	return request, err
+}
+
+// GetErasureRequest returns an erasure request of the tenant.
+func (s *SubjectErasureStore) GetErasureRequest(ctx context.Context, space tenant.Space, id uuid.UUID) (ErasureRequest, error) {
+	var request ErasureRequest
+	err := s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`SELECT `+erasureRequestColumns+` FROM %s WHERE request_id = $1`, PrivacyErasureRequestsTable), id)
+		scanned, err := scanErasureRequest(row)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrErasureRequestNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get erasure request: %w", err)
+		}
+		request = scanned
+		return nil
+	})
+	return request, err
+}
+
+// StartErasureRequest marks an open request running and counts the attempt. A finished request is returned
+// unchanged, so a repeated job attempt does not erase again.
+func (s *SubjectErasureStore) StartErasureRequest(ctx context.Context, space tenant.Space, id uuid.UUID) (ErasureRequest, error) {
+	var request ErasureRequest
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        UPDATE %[1]s
+        SET status = '%[3]s', attempts = attempts + 1, updated_at = NOW()
+        WHERE request_id = $1 AND status IN ('%[2]s', '%[3]s')
+        RETURNING `+erasureRequestColumns, PrivacyErasureRequestsTable, ErasureQueued, ErasureRunning), id)
+		scanned, err := scanErasureRequest(row)
+		if errors.Is(err, pgx.ErrNoRows) {
+			row = tx.QueryRow(ctx, fmt.Sprintf(`SELECT `+erasureRequestColumns+` FROM %s WHERE request_id = $1`, PrivacyErasureRequestsTable), id)
+			scanned, err = scanErasureRequest(row)
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrErasureRequestNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("start erasure request: %w", err)
+		}
+		request = scanned
+		return nil
+	})
+	return request, err
+}
+
+// SaveErasureRequest stores the outcome of an erasure attempt: status, report, signature, last error and finish
+// time.
+func (s *SubjectErasureStore) SaveErasureRequest(ctx context.Context, space tenant.Space, request ErasureRequest) error {
+	var report []byte
+	if len(request.Report) > 0 {
+		report = request.Report
+	}
+
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET status = $2, report = $3, signature = $4, last_error = $5, finished_at = $6, updated_at = NOW()
+        WHERE request_id = $1
+    `, PrivacyErasureRequestsTable), request.RequestID, request.Status, report, request.Signature, request.LastError,
+			request.FinishedAt)
+		if err != nil {
+			return fmt.Errorf("save erasure request: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrErasureRequestNotFound
+		}
+		return nil
+	})
+}
+
+// erasureTable is an entity table of the tenant with the fields an erasure matches and clears. Fields are collected
+// from every published, non-deleted schema version of the table, so older documents are covered too.
+type erasureTable struct {
+	name    string
+	subject [][]string
+	clear   [][]string
+}
+
+// EraseSubject anonymizes or purges, as mode says, every document of space that holds subjectID in one of its
+// x-subject-id properties in any version. It runs in one tenant transaction, so a failure leaves every table as it
+// was. Hashes of anonymized versions are recomputed; superseded versions moved to object storage are only listed in
+// the report.
+func (s *SubjectErasureStore) EraseSubject(ctx context.Context, space tenant.Space, subjectID string, mode ErasureMode) (SubjectErasureReport, error) {
+	if strings.TrimSpace(subjectID) == "" {
+		return SubjectErasureReport{}, errors.New("subject id is required")
+	}
+	if mode != ErasureAnonymize && mode != ErasurePurge {
+		return SubjectErasureReport{}, fmt.Errorf("unsupported erasure mode %q", mode)
+	}
+
+	var report SubjectErasureReport
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		report = SubjectErasureReport{Entities: []ErasedEntity{}, ArchivedObjects: []string{}}
+		tables, err := erasureTables(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		archived := map[string]struct{}{}
+		for _, table := range tables {
+			ident := pgx.Identifier{table.name}.Sanitize()
+			var exists bool
+			if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, ident).Scan(&exists); err != nil {
+				return fmt.Errorf("check entity table %s: %w", table.name, err)
+			}
+			if !exists {
+				continue
+			}
+
+			entityIDs, err := subjectEntityIDs(ctx, tx, ident, table.subject, subjectID)
+			if err != nil {
+				return fmt.Errorf("find subject documents in %s: %w", table.name, err)
+			}
+			if len(entityIDs) == 0 {
+				continue
+			}
+
+			rows, err := tx.Query(ctx, fmt.Sprintf(`
+				SELECT DISTINCT archive_location FROM %s WHERE entity_id = ANY($1) AND archive_location IS NOT NULL
+			`, ident), entityIDs)
+			if err != nil {
+				return fmt.Errorf("list archived versions of %s: %w", table.name, err)
+			}
+			locations, err := pgx.CollectRows(rows, pgx.RowTo[string])
+			if err != nil {
+				return fmt.Errorf("list archived versions of %s: %w", table.name, err)
+			}
+			for _, location := range locations {
+				archived[location] = struct{}{}
+			}
+
+			var erased []ErasedEntity
+			if mode == ErasurePurge {
+				erased, err = purgeSubjectEntities(ctx, tx, table.name, ident, entityIDs)
+			} else {
+				erased, err = anonymizeSubjectEntities(ctx, tx, table, ident, entityIDs)
+			}
+			if err != nil {
+				return err
+			}
+			report.Entities = append(report.Entities, erased...)
+		}
+
+		for location := range archived {
+			report.ArchivedObjects = append(report.ArchivedObjects, location)
+		}
+		sort.Strings(report.ArchivedObjects)
+		return nil
+	})
+	if err != nil {
+		return SubjectErasureReport{}, err
+	}
+	return report, nil
+}
+
+// erasureTables lists the entity tables whose schemas declare x-subject-id properties.
+func erasureTables(ctx context.Context, tx pgx.Tx) ([]erasureTable, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT table_name, schema_definition
+		FROM schema_repository
+		WHERE NOT is_deleted AND status = 'published'
+		ORDER BY table_name, schema_id, schema_version
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("list schema definitions: %w", err)
+	}
+	type definitionRow struct {
+		table      string
+		definition []byte
+	}
+	definitions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (definitionRow, error) {
+		var r definitionRow
+		err := row.Scan(&r.table, &r.definition)
+		return r, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list schema definitions: %w", err)
+	}
+
+	var tables []erasureTable
+	byName := map[string]int{}
+	for _, r := range definitions {
+		subject, err := SubjectFields(SchemaDefinition(r.definition))
+		if err != nil {
+			return nil, fmt.Errorf("resolve subject fields of %s: %w", r.table, err)
+		}
+		encrypted, err := EncryptedFields(SchemaDefinition(r.definition))
+		if err != nil {
+			return nil, fmt.Errorf("resolve encrypted fields of %s: %w", r.table, err)
+		}
+
+		idx, ok := byName[r.table]
+		if !ok {
+			idx = len(tables)
+			byName[r.table] = idx
+			tables = append(tables, erasureTable{name: r.table})
+		}
+		table := &tables[idx]
+		for _, field := range subject {
+			table.subject = appendPath(table.subject, field.Path)
+			table.clear = appendPath(table.clear, field.Path)
+		}
+		for _, field := range encrypted {
+			table.clear = appendPath(table.clear, field.Path)
+		}
+	}
+
+	withSubject := tables[:0]
+	for _, table := range tables {
+		if len(table.subject) > 0 {
+			withSubject = append(withSubject, table)
+		}
+	}
+	return withSubject, nil
+}
+
+func appendPath(paths [][]string, path []string) [][]string {
+	for _, existing := range paths {
+		if strings.Join(existing, "\x00") == strings.Join(path, "\x00") {
+			return paths
+		}
+	}
+	return append(paths, path)
+}
+
+// subjectEntityIDs returns the ids of the documents holding subjectID in one of paths in any version.
+func subjectEntityIDs(ctx context.Context, tx pgx.Tx, ident string, paths [][]string, subjectID string) ([]string, error) {
+	conditions := make([]string, 0, len(paths))
+	args := []any{subjectID}
+	for _, path := range paths {
+		args = append(args, path)
+		conditions = append(conditions, fmt.Sprintf("payload #>> $%d::text[] = $1", len(args)))
+	}
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+		SELECT DISTINCT entity_id FROM %s WHERE %s ORDER BY entity_id
+	`, ident, strings.Join(conditions, " OR ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// purgeSubjectEntities removes every version of the given documents.
+func purgeSubjectEntities(ctx context.Context, tx pgx.Tx, table, ident string, entityIDs []string) ([]ErasedEntity, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+		WITH purged AS (
+			DELETE FROM %s WHERE entity_id = ANY($1) RETURNING entity_id
+		)
+		SELECT entity_id, COUNT(*)::int FROM purged GROUP BY entity_id ORDER BY entity_id
+	`, ident), entityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("purge subject documents of %s: %w", table, err)
+	}
+	erased, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (ErasedEntity, error) {
+		entity := ErasedEntity{TableName: table}
+		err := row.Scan(&entity.EntityID, &entity.Versions)
+		return entity, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("purge subject documents of %s: %w", table, err)
+	}
+	return erased, nil
+}
+
+// anonymizeSubjectEntities clears the subject and encrypted properties of every stored version of the given
+// documents and recomputes their hashes. Stubs of archived versions have no payload to clear.
+func anonymizeSubjectEntities(ctx context.Context, tx pgx.Tx, table erasureTable, ident string, entityIDs []string) ([]ErasedEntity, error) {
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+		SELECT entity_id, entity_version, payload
+		FROM %s
+		WHERE entity_id = ANY($1) AND archive_location IS NULL
+		ORDER BY entity_id, entity_version
+		FOR UPDATE
+	`, ident), entityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load subject documents of %s: %w", table.name, err)
+	}
+	type version struct {
+		entityID string
+		version  string
+		payload  []byte
+	}
+	versions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (version, error) {
+		var v version
+		err := row.Scan(&v.entityID, &v.version, &v.payload)
+		return v, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load subject documents of %s: %w", table.name, err)
+	}
+
+	counts := map[string]int{}
+	for _, v := range versions {
+		document, err := decodePayloadObject(v.payload)
+		if err != nil {
+			return nil, fmt.Errorf("anonymize %s/%s: %w", table.name, v.entityID, err)
+		}
+		for _, path := range table.clear {
+			if _, ok := payloadValue(document, path); ok {
+				setPayloadValue(document, path, nil)
+			}
+		}
+		payload, err := json.Marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("anonymize %s/%s: %w", table.name, v.entityID, err)
+		}
+		hash, err := computeCanonicalJSONHash(payload)
+		if err != nil {
+			return nil, fmt.Errorf("anonymize %s/%s: %w", table.name, v.entityID, err)
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %s SET payload = $3, hash = $4 WHERE entity_id = $1 AND entity_version = $2
+		`, ident), v.entityID, v.version, payload, hash); err != nil {
+			return nil, fmt.Errorf("anonymize %s/%s: %w", table.name, v.entityID, err)
+		}
+		counts[v.entityID]++
+	}
+
+	erased := make([]ErasedEntity, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		erased = append(erased, ErasedEntity{TableName: table.name, EntityID: id, Versions: counts[id]})
+	}
+	return erased, nil
+}
+
+func scanErasureRequest(row pgx.Row) (ErasureRequest, error) {
+	var (
+		request ErasureRequest
+		mode    string
+		report  []byte
+	)
+	if err := row.Scan(&request.RequestID, &request.SubjectID, &request.UserID, &mode, &request.Status, &request.Attempts,
+		&report, &request.Signature, &request.LastError, &request.RequestedBy, &request.CreatedAt,
+		&request.UpdatedAt, &request.FinishedAt); err != nil {
+		return ErasureRequest{}, err
+	}
+	request.Mode = ErasureMode(mode)
+	if len(report) > 0 {
+		request.Report = json.RawMessage(report)
+	}
+	return request, nil
+}
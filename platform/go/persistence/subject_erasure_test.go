@@ -0,0 +1,143 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestSubjectFields(t *testing.T) {
+	t.Parallel()
+
+	fields, err := SubjectFields(SchemaDefinition(`{
+		"type": "object",
+		"properties": {
+			"customerId": { "type": "string", "x-subject-id": true },
+			"name": { "type": "string" },
+			"billing": { "type": "object", "properties": { "payerId": { "type": "string", "x-subject-id": true } } }
+		}
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, []SubjectField{{Path: []string{"billing", "payerId"}}, {Path: []string{"customerId"}}}, fields)
+	require.Equal(t, "billing.payerId", fields[0].Name())
+
+	fields, err = SubjectFields(nil)
+	require.NoError(t, err)
+	require.Empty(t, fields)
+}
+
+func TestSubjectErasureStoreErasesSubjectDocuments(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping subject erasure integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, adminSchema))
+
+	hash := strings.Repeat("a", 64)
+	categoryID, schemaID := uuid.New(), uuid.New()
+	exec := func(stmt string, args ...any) {
+		_, err := pool.Exec(ctx, stmt, args...)
+		require.NoError(t, err)
+	}
+	exec(`INSERT INTO schema_categories (category_id, name, slug) VALUES ($1, 'Orders', 'orders')`, categoryID)
+	exec(`
+		INSERT INTO schema_repository (schema_id, schema_version, schema_definition, hash, table_name, slug, category_id, is_active)
+		VALUES ($1, '1.0.0', $2, $3, 'orders_entities', 'orders', $4, TRUE)
+	`, schemaID, `{"type": "object", "properties": {
+		"customerId": {"type": "string", "x-subject-id": true},
+		"email": {"type": "string", "x-pii": true},
+		"total": {"type": "number"}
+	}}`, hash, categoryID)
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+		`GRANT USAGE ON SCHEMA ` + adminSchema + ` TO ` + role,
+		`GRANT SELECT, REFERENCES ON ` + adminSchema + `.schema_repository TO ` + role,
+	} {
+		exec(stmt)
+	}
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme_co", SchemaName: schema, RoleName: role}
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	entityRepo := &EntityRepository{tableName: "orders_entities", tableIdent: pgx.Identifier{"orders_entities"}.Sanitize()}
+	require.NoError(t, spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := entityRepo.ensureEntityTable(ctx, tx)
+		return err
+	}))
+	orders := pgx.Identifier{schema, "orders_entities"}.Sanitize()
+	exec(`
+		INSERT INTO `+orders+` (entity_id, entity_version, schema_id, schema_version, payload, hash, is_active)
+		VALUES ('o-1', '1.0.0', $1, '1.0.0', '{"customerId": "ann", "email": "enc:v1:x", "total": 10}', $2, FALSE),
+		       ('o-1', '1.0.1', $1, '1.0.0', '{"customerId": "ann", "email": "enc:v1:y", "total": 12}', $2, TRUE),
+		       ('o-2', '1.0.0', $1, '1.0.0', '{"customerId": "bob", "email": "enc:v1:z", "total": 5}', $2, TRUE)
+	`, schemaID, hash)
+
+	store := NewSubjectErasureStore(spaceDB)
+
+	report, err := store.EraseSubject(ctx, space, "ann", ErasureAnonymize)
+	require.NoError(t, err)
+	require.Equal(t, []ErasedEntity{{TableName: "orders_entities", EntityID: "o-1", Versions: 2}}, report.Entities)
+	require.Empty(t, report.ArchivedObjects)
+
+	var payload []byte
+	var storedHash string
+	require.NoError(t, pool.QueryRow(ctx, `SELECT payload, hash FROM `+orders+` WHERE entity_id = 'o-1' AND entity_version = '1.0.1'`).Scan(&payload, &storedHash))
+	var document map[string]any
+	require.NoError(t, json.Unmarshal(payload, &document))
+	require.Equal(t, map[string]any{"customerId": nil, "email": nil, "total": 12.0}, document)
+	require.NotEqual(t, hash, storedHash)
+
+	report, err = store.EraseSubject(ctx, space, "bob", ErasurePurge)
+	require.NoError(t, err)
+	require.Equal(t, []ErasedEntity{{TableName: "orders_entities", EntityID: "o-2", Versions: 1}}, report.Entities)
+
+	var remaining int
+	require.NoError(t, pool.QueryRow(ctx, `SELECT COUNT(*) FROM `+orders).Scan(&remaining))
+	require.Equal(t, 2, remaining)
+
+	report, err = store.EraseSubject(ctx, space, "ann", ErasurePurge)
+	require.NoError(t, err)
+	require.Empty(t, report.Entities, "anonymized documents no longer match the subject")
+}
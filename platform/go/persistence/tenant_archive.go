@@ -0,0 +1,350 @@
+package persistence
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// TenantArchiveFormatVersion is the version of the archive layout written by TenantArchiveStore.Export.
+const TenantArchiveFormatVersion = 1
+
+const (
+	tenantArchiveManifest   = "manifest.json"
+	tenantArchiveTablesDir  = "tables/"
+	tenantArchiveImportRows = 500
+)
+
+// ErrTenantArchiveTargetNotEmpty is returned when an archive is imported into a tenant that already holds entities.
+var ErrTenantArchiveTargetNotEmpty = errors.New("target tenant already has entity tables")
+
+// TenantArchiveManifest describes the contents of a tenant archive. It is written last, so readers must not rely
+// on it before the table files have been seen.
+type TenantArchiveManifest struct {
+	FormatVersion int                  `json:"formatVersion"`
+	TenantID      string               `json:"tenantId"`
+	TenantSlug    string               `json:"tenantSlug"`
+	ExportedAt    time.Time            `json:"exportedAt"`
+	Quotas        tenant.Quotas        `json:"quotas"`
+	Tables        []TenantArchiveTable `json:"tables"`
+}
+
+// TenantArchiveTable lists the columns and row count of one table file in the archive.
+type TenantArchiveTable struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+}
+
+// TenantArchiveStore writes a tenant space to a gzip-compressed tar archive and restores it into another tenant.
+// The archive holds one newline-delimited JSON file per table under tables/ and a trailing manifest.json with the
+// tenant settings. Webhook tables are left out for the same reason clones skip them.
+type TenantArchiveStore struct {
+	db *SpaceDB
+}
+
+// NewTenantArchiveStore returns an archive store working through db.
+func NewTenantArchiveStore(db *SpaceDB) *TenantArchiveStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &TenantArchiveStore{db: db}
+}
+
+// Export writes every entity table and the users of space to w and returns the manifest it appended.
+func (s *TenantArchiveStore) Export(ctx context.Context, space tenant.Space, w io.Writer) (TenantArchiveManifest, error) {
+	tables, err := NewTenantCloneStore(s.db).CloneTables(ctx, space)
+	if err != nil {
+		return TenantArchiveManifest{}, err
+	}
+
+	manifest := TenantArchiveManifest{
+		FormatVersion: TenantArchiveFormatVersion,
+		TenantID:      space.TenantID.String(),
+		TenantSlug:    space.Slug,
+		ExportedAt:    time.Now().UTC(),
+		Quotas:        space.Quotas,
+		Tables:        make([]TenantArchiveTable, 0, len(tables)),
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, table := range tables {
+		entry, err := s.exportTable(ctx, space, table, tw, manifest.ExportedAt)
+		if err != nil {
+			return TenantArchiveManifest{}, err
+		}
+		manifest.Tables = append(manifest.Tables, entry)
+	}
+
+	encoded, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return TenantArchiveManifest{}, fmt.Errorf("encode archive manifest: %w", err)
+	}
+	if err := writeTarEntry(tw, tenantArchiveManifest, bytes.NewReader(encoded), int64(len(encoded)), manifest.ExportedAt); err != nil {
+		return TenantArchiveManifest{}, err
+	}
+	if err := tw.Close(); err != nil {
+		return TenantArchiveManifest{}, fmt.Errorf("close archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return TenantArchiveManifest{}, fmt.Errorf("compress archive: %w", err)
+	}
+	return manifest, nil
+}
+
+// exportTable reads table from space as newline-delimited JSON rows and appends it to tw. Tar entries need their
+// size up front, so the rows are spooled to a temporary file rather than held in memory.
+func (s *TenantArchiveStore) exportTable(ctx context.Context, space tenant.Space, table string, tw *tar.Writer, modTime time.Time) (TenantArchiveTable, error) {
+	spool, err := os.CreateTemp("", "tenant-export-*.ndjson")
+	if err != nil {
+		return TenantArchiveTable{}, fmt.Errorf("spool %s: %w", table, err)
+	}
+	defer os.Remove(spool.Name()) // nolint:errcheck
+	defer spool.Close()           // nolint:errcheck
+
+	entry := TenantArchiveTable{Name: table}
+	out := bufio.NewWriter(spool)
+	err = s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		var err error
+		if entry.Columns, err = tableColumnsTx(ctx, tx, space.SchemaName, table); err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx, "SELECT row_to_json(t)::TEXT FROM "+pgx.Identifier{space.SchemaName, table}.Sanitize()+" t")
+		if err != nil {
+			return fmt.Errorf("read %s: %w", table, err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var line string
+			if err := rows.Scan(&line); err != nil {
+				return fmt.Errorf("scan %s: %w", table, err)
+			}
+			if _, err := out.WriteString(line + "\n"); err != nil {
+				return fmt.Errorf("spool %s: %w", table, err)
+			}
+			entry.Rows++
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return TenantArchiveTable{}, fmt.Errorf("export %s: %w", table, err)
+	}
+	if err := out.Flush(); err != nil {
+		return TenantArchiveTable{}, fmt.Errorf("spool %s: %w", table, err)
+	}
+
+	size, err := spool.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return TenantArchiveTable{}, fmt.Errorf("spool %s: %w", table, err)
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return TenantArchiveTable{}, fmt.Errorf("spool %s: %w", table, err)
+	}
+	if err := writeTarEntry(tw, tenantArchiveTablesDir+table+".ndjson", spool, size, modTime); err != nil {
+		return TenantArchiveTable{}, err
+	}
+	return entry, nil
+}
+
+// Import restores an archive produced by Export into target, which must not hold any entity table yet. Users and
+// data keys are replaced by those in the archive. Rows are written in a single transaction and only committed once the manifest
+// confirms every table was read in full; the tables themselves are created up front and stay behind on failure.
+func (s *TenantArchiveStore) Import(ctx context.Context, target tenant.Space, r io.Reader) (TenantArchiveManifest, error) {
+	existing, err := NewTenantCloneStore(s.db).CloneTables(ctx, target)
+	if err != nil {
+		return TenantArchiveManifest{}, err
+	}
+	for _, table := range existing {
+		if !isTenantSupportTable(table) {
+			return TenantArchiveManifest{}, ErrTenantArchiveTargetNotEmpty
+		}
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return TenantArchiveManifest{}, fmt.Errorf("open archive: %w", err)
+	}
+	defer gz.Close()
+
+	var manifest *TenantArchiveManifest
+	imported := make(map[string]int64)
+	err = s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tr := tar.NewReader(gz)
+		for {
+			header, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("read archive: %w", err)
+			}
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+
+			if header.Name == tenantArchiveManifest {
+				manifest = &TenantArchiveManifest{}
+				if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+					return fmt.Errorf("decode archive manifest: %w", err)
+				}
+				continue
+			}
+
+			dir, file := path.Split(header.Name)
+			table, ok := strings.CutSuffix(file, ".ndjson")
+			if dir != tenantArchiveTablesDir || !ok || !tableNamePattern.MatchString(table) {
+				return fmt.Errorf("unexpected archive entry %q", header.Name)
+			}
+			if err := ensureTenantTable(ctx, s.db, target, table); err != nil {
+				return fmt.Errorf("create %s: %w", table, err)
+			}
+			if imported[table], err = importTableTx(ctx, tx, target.SchemaName, table, tr); err != nil {
+				return err
+			}
+		}
+
+		if manifest == nil {
+			return errors.New("archive manifest missing")
+		}
+		if manifest.FormatVersion != TenantArchiveFormatVersion {
+			return fmt.Errorf("unsupported archive format version %d", manifest.FormatVersion)
+		}
+		for _, entry := range manifest.Tables {
+			if imported[entry.Name] != entry.Rows {
+				return fmt.Errorf("archive table %s: expected %d rows, read %d", entry.Name, entry.Rows, imported[entry.Name])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return TenantArchiveManifest{}, fmt.Errorf("import tenant archive: %w", err)
+	}
+	return *manifest, nil
+}
+
+// importTableTx replaces the rows of table with the newline-delimited JSON rows read from r. Only columns present in
+// both the rows and the target table are written, so archives taken before a column was added still import and the
+// column defaults apply to the rest.
+func importTableTx(ctx context.Context, tx pgx.Tx, schemaName, table string, r io.Reader) (int64, error) {
+	ident := pgx.Identifier{schemaName, table}.Sanitize()
+	if _, err := tx.Exec(ctx, "DELETE FROM "+ident); err != nil {
+		return 0, fmt.Errorf("clear %s: %w", table, err)
+	}
+
+	var (
+		insert string
+		total  int64
+		batch  []json.RawMessage
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		encoded, err := json.Marshal(batch)
+		if err != nil {
+			return fmt.Errorf("encode %s rows: %w", table, err)
+		}
+		if _, err := tx.Exec(ctx, insert, string(encoded)); err != nil {
+			return fmt.Errorf("import %s: %w", table, err)
+		}
+		total += int64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var row map[string]json.RawMessage
+		if err := json.Unmarshal(line, &row); err != nil {
+			return 0, fmt.Errorf("archive table %s: row %d: %w", table, total+int64(len(batch))+1, err)
+		}
+		if insert == "" {
+			columns, err := tableColumnsTx(ctx, tx, schemaName, table)
+			if err != nil {
+				return 0, err
+			}
+			quoted := make([]string, 0, len(columns))
+			for _, column := range columns {
+				if _, ok := row[column]; ok {
+					quoted = append(quoted, pgx.Identifier{column}.Sanitize())
+				}
+			}
+			if len(quoted) == 0 {
+				return 0, fmt.Errorf("archive table %s shares no columns with the target", table)
+			}
+			columnList := strings.Join(quoted, ", ")
+			insert = fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM json_populate_recordset(NULL::%s, $1::JSON)", ident, columnList, columnList, ident)
+		}
+		batch = append(batch, json.RawMessage(bytes.Clone(line)))
+		if len(batch) == tenantArchiveImportRows {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("read archive table %s: %w", table, err)
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// tableColumnsTx lists the columns of schemaName.table in ordinal order.
+func tableColumnsTx(ctx context.Context, tx pgx.Tx, schemaName, table string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = $1 AND table_name = $2
+		ORDER BY ordinal_position
+	`, schemaName, table)
+	if err != nil {
+		return nil, fmt.Errorf("list columns of %s: %w", table, err)
+	}
+	columns, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("scan columns of %s: %w", table, err)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", table)
+	}
+	return columns, nil
+}
+
+func writeTarEntry(tw *tar.Writer, name string, r io.Reader, size int64, modTime time.Time) error {
+	header := &tar.Header{
+		Name:     name,
+		Mode:     0o644,
+		Size:     size,
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("write archive entry %s: %w", name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("write archive entry %s: %w", name, err)
+	}
+	return nil
+}
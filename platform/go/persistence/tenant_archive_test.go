@@ -0,0 +1,106 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestTenantArchiveStoreRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping tenant archive integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	_, err = pool.Exec(ctx, `CREATE SCHEMA IF NOT EXISTS `+adminSchema)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+adminSchema+`.schema_repository (schema_id UUID, schema_version TEXT, table_name TEXT, PRIMARY KEY (schema_id, schema_version))`)
+	require.NoError(t, err)
+
+	newSpace := func(slug string) tenant.Space {
+		schema := tenant.BuildSchemaName("dev", slug)
+		role := schema + "_role"
+		_, err := pool.Exec(ctx, `CREATE SCHEMA IF NOT EXISTS `+schema)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `CREATE ROLE `+role+` NOLOGIN`)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `GRANT `+role+` TO CURRENT_USER`)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `ALTER SCHEMA `+schema+` OWNER TO `+role)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `GRANT USAGE ON SCHEMA `+adminSchema+` TO `+role)
+		require.NoError(t, err)
+		space := tenant.Space{TenantID: uuid.New(), Slug: slug, SchemaName: schema, RoleName: role}
+		_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+		require.NoError(t, err)
+		return space
+	}
+	source := newSpace("acme_co")
+	target := newSpace("acme_restore")
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	users, err := NewUserStore(ctx, spaceDB)
+	require.NoError(t, err)
+	_, err = users.CreateUser(ctx, source, CreateUserParams{UserID: uuid.New(), Email: "a@example.com", FullName: "Alice A"})
+	require.NoError(t, err)
+
+	maxUsers := int64(10)
+	source.Quotas.MaxUsers = &maxUsers
+
+	archives := NewTenantArchiveStore(spaceDB)
+	var archive bytes.Buffer
+	manifest, err := archives.Export(ctx, source, &archive)
+	require.NoError(t, err)
+	require.Equal(t, TenantArchiveFormatVersion, manifest.FormatVersion)
+	require.Equal(t, []TenantArchiveTable{
+		{Name: UsersTable, Columns: []string{"user_id", "email", "full_name", "created_at", "updated_at"}, Rows: 1},
+		{Name: EntityDataKeysTable, Columns: []string{"key_id", "key_name", "wrapped_key", "is_active", "created_at"}, Rows: 0},
+	}, manifest.Tables)
+
+	// A truncated archive is rejected without writing any rows.
+	_, err = archives.Import(ctx, target, bytes.NewReader(archive.Bytes()[:archive.Len()/2]))
+	require.Error(t, err)
+
+	imported, err := archives.Import(ctx, target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), *imported.Quotas.MaxUsers)
+
+	restored, err := users.ListUsers(ctx, target, ListUsersParams{})
+	require.NoError(t, err)
+	require.Equal(t, 1, restored.TotalItems)
+	require.Equal(t, "a@example.com", restored.Users[0].Email)
+}
@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TenantChangeChannel is the Postgres NOTIFY channel carrying the ids of tenants whose registry record changed.
+const TenantChangeChannel = "palmyra_tenant_changes"
+
+// TenantSpaceInvalidator drops cached tenant spaces; the tenant middleware's SpaceCache implements it.
+type TenantSpaceInvalidator interface {
+	Invalidate(id uuid.UUID)
+	Purge()
+}
+
+// TenantChanges broadcasts tenant registry changes through Postgres NOTIFY so every API instance sharing the
+// database drops its cached tenant space right away instead of waiting for the cache TTL.
+type TenantChanges struct {
+	pool *pgxpool.Pool
+}
+
+// NewTenantChanges constructs the broadcaster; call Run to start listening.
+func NewTenantChanges(pool *pgxpool.Pool) *TenantChanges {
+	if pool == nil {
+		panic("pool is required")
+	}
+	return &TenantChanges{pool: pool}
+}
+
+// NotifyTenantChanged announces that the tenant's record changed.
+func (c *TenantChanges) NotifyTenantChanged(ctx context.Context, id uuid.UUID) error {
+	if _, err := c.pool.Exec(ctx, `SELECT pg_notify($1, $2)`, TenantChangeChannel, id.String()); err != nil {
+		return fmt.Errorf("notify tenant change: %w", err)
+	}
+	return nil
+}
+
+// Run keeps a LISTEN session open until ctx is cancelled, reconnecting with backoff, and invalidates the
+// changed tenants in cache. onError, when set, is called for every session failure.
+func (c *TenantChanges) Run(ctx context.Context, cache TenantSpaceInvalidator, onError func(error)) {
+	backoff := time.Second
+	for {
+		err := c.Listen(ctx, cache)
+		if ctx.Err() != nil {
+			return
+		}
+		if onError != nil && err != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// Listen runs one LISTEN session until ctx is cancelled or the connection fails. The cache is purged once
+// listening starts, since changes made while no session was open were missed.
+func (c *TenantChanges) Listen(ctx context.Context, cache TenantSpaceInvalidator) error {
+	conn, err := c.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire listen connection: %w", err)
+	}
+	defer func() {
+		// A connection broken by the failure is discarded by the pool; a healthy one must not keep listening.
+		_, _ = conn.Exec(context.Background(), "UNLISTEN *")
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{TenantChangeChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen %s: %w", TenantChangeChannel, err)
+	}
+	cache.Purge()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for tenant change: %w", err)
+		}
+
+		id, err := uuid.Parse(notification.Payload)
+		if err != nil {
+			continue
+		}
+		cache.Invalidate(id)
+	}
+}
@@ -0,0 +1,63 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingInvalidator struct {
+	mu          sync.Mutex
+	purges      int
+	invalidated []uuid.UUID
+}
+
+func (r *recordingInvalidator) Invalidate(id uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.invalidated = append(r.invalidated, id)
+}
+
+func (r *recordingInvalidator) Purge() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.purges++
+}
+
+func (r *recordingInvalidator) snapshot() (int, []uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.purges, append([]uuid.UUID(nil), r.invalidated...)
+}
+
+func TestTenantChangesInvalidatesListeners(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	changes := NewTenantChanges(pool)
+	cache := &recordingInvalidator{}
+	go changes.Run(ctx, cache, nil)
+
+	require.Eventually(t, func() bool {
+		purges, _ := cache.snapshot()
+		return purges == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tenantID := uuid.New()
+	require.NoError(t, changes.NotifyTenantChanged(ctx, tenantID))
+	require.Eventually(t, func() bool {
+		_, invalidated := cache.snapshot()
+		return len(invalidated) == 1 && invalidated[0] == tenantID
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,172 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// TenantCloneStore copies the tables of one tenant schema into another. Entity tables are created through the
+// same ensure path EntityTableStore uses (see ensureTenantTable).
+type TenantCloneStore struct {
+	db *SpaceDB
+}
+
+// NewTenantCloneStore returns a clone store working through db.
+func NewTenantCloneStore(db *SpaceDB) *TenantCloneStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &TenantCloneStore{db: db}
+}
+
+// tenantSupportTables are the tables created by the tenant migrations that clones always copy: users so the clone
+// can be signed into, and the data keys so encrypted payload fields stay readable.
+var tenantSupportTables = []string{UsersTable, EntityDataKeysTable}
+
+func isTenantSupportTable(table string) bool {
+	for _, supportTable := range tenantSupportTables {
+		if table == supportTable {
+			return true
+		}
+	}
+	return false
+}
+
+// CloneTables lists the tables of source that a clone copies: every catalog entity table present in the schema,
+// followed by the support tables (users, data keys) that exist. Webhook tables are deliberately left out so a clone
+// never calls the source tenant's receivers.
+func (s *TenantCloneStore) CloneTables(ctx context.Context, source tenant.Space) ([]string, error) {
+	var tables []string
+	err := s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		var err error
+		if tables, err = tenantEntityTablesTx(ctx, tx, source.SchemaName); err != nil {
+			return err
+		}
+		for _, table := range tenantSupportTables {
+			var exists bool
+			if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, pgx.Identifier{source.SchemaName, table}.Sanitize()).Scan(&exists); err != nil {
+				return fmt.Errorf("check %s table: %w", table, err)
+			}
+			if exists {
+				tables = append(tables, table)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list clone tables: %w", err)
+	}
+	return tables, nil
+}
+
+// CopyTable creates table in target and replaces its rows with those of source. Entity rows are only copied when
+// includeData is set; support tables are always copied. Repeating a copy is safe.
+func (s *TenantCloneStore) CopyTable(ctx context.Context, source, target tenant.Space, table string, includeData bool) error {
+	if !tableNamePattern.MatchString(table) {
+		return fmt.Errorf("invalid table name %q", table)
+	}
+
+	if err := ensureTenantTable(ctx, s.db, target, table); err != nil {
+		return fmt.Errorf("create %s in clone: %w", table, err)
+	}
+	if !isTenantSupportTable(table) && !includeData {
+		return nil
+	}
+
+	// Reading the source and writing the target needs both schemas, which only the admin connection can see.
+	err := s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		columns, err := sharedColumnsTx(ctx, tx, source.SchemaName, target.SchemaName, table)
+		if err != nil {
+			return err
+		}
+		sourceIdent := pgx.Identifier{source.SchemaName, table}.Sanitize()
+		targetIdent := pgx.Identifier{target.SchemaName, table}.Sanitize()
+		if _, err := tx.Exec(ctx, "DELETE FROM "+targetIdent); err != nil {
+			return fmt.Errorf("clear %s: %w", table, err)
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", targetIdent, columns, columns, sourceIdent)
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("copy %s: %w", table, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("copy %s into clone: %w", table, err)
+	}
+	return nil
+}
+
+// ensureTenantTable creates the entity table in space through the entity repository's ensure path, under the
+// tenant role, so the table ends up identical to one created when its schema was activated. Support tables are left
+// alone: the tenant migrations applied at provisioning created them.
+func ensureTenantTable(ctx context.Context, db *SpaceDB, space tenant.Space, table string) error {
+	if isTenantSupportTable(table) {
+		return nil
+	}
+
+	indexed, err := tableIndexedFields(ctx, db, table)
+	if err != nil {
+		return err
+	}
+	entityRepo := &EntityRepository{tableName: table, tableIdent: pgx.Identifier{table}.Sanitize(), indexed: indexed}
+	return db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := entityRepo.ensureEntityTable(ctx, tx)
+		return err
+	})
+}
+
+// tableIndexedFields resolves the payload indexes declared by the active schema that owns table. Tables whose
+// schema is no longer active are recreated without payload indexes.
+func tableIndexedFields(ctx context.Context, db *SpaceDB, table string) ([]IndexedField, error) {
+	var definition SchemaDefinition
+	err := db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, `
+			SELECT schema_definition
+			FROM schema_repository
+			WHERE table_name = $1 AND is_active = TRUE AND is_deleted = FALSE
+			LIMIT 1
+		`, table).Scan(&definition)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolve schema of %s: %w", table, err)
+	}
+	return IndexedFields(definition)
+}
+
+// sharedColumnsTx returns the quoted, comma-separated columns table has in both schemas, in target order, so
+// tables created before a column was added still copy.
+func sharedColumnsTx(ctx context.Context, tx pgx.Tx, sourceSchema, targetSchema, table string) (string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT t.column_name
+		FROM information_schema.columns t
+		JOIN information_schema.columns s
+		  ON s.table_schema = $1 AND s.table_name = t.table_name AND s.column_name = t.column_name
+		WHERE t.table_schema = $2 AND t.table_name = $3
+		ORDER BY t.ordinal_position
+	`, sourceSchema, targetSchema, table)
+	if err != nil {
+		return "", fmt.Errorf("list columns of %s: %w", table, err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return "", fmt.Errorf("scan columns of %s: %w", table, err)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("table %s has no shared columns", table)
+	}
+
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, pgx.Identifier{name}.Sanitize())
+	}
+	return strings.Join(quoted, ", "), nil
+}
@@ -0,0 +1,221 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const TenantJobsTable = "tenant_jobs"
+
+// Tenant job kinds and statuses.
+const (
+	TenantJobKindProvision = "provision"
+	TenantJobKindClone     = "clone"
+	TenantJobKindMigrate   = "migrate"
+
+	TenantJobQueued    = "queued"
+	TenantJobRunning   = "running"
+	TenantJobSucceeded = "succeeded"
+	TenantJobFailed    = "failed"
+)
+
+// ErrTenantJobNotFound is returned when a tenant job does not exist.
+var ErrTenantJobNotFound = errors.New("tenant job not found")
+
+// TenantJobComponent tracks the progress of one provisioned resource (db, auth, storage) within a job.
+type TenantJobComponent struct {
+	Status    string     `json:"status"`
+	Attempts  int        `json:"attempts"`
+	LastError *string    `json:"lastError,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// TenantJobRecord is a background job run against one tenant. Components is keyed by component name and
+// Params holds kind-specific input as JSON.
+type TenantJobRecord struct {
+	JobID         uuid.UUID
+	TenantID      uuid.UUID
+	Kind          string
+	Status        string
+	Attempts      int
+	Components    map[string]TenantJobComponent
+	Params        json.RawMessage
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	FinishedAt    *time.Time
+}
+
+// TenantJobStore queues tenant jobs in the admin schema. The table comes from the admin migrations.
+type TenantJobStore struct {
+	adminDB *SpaceDB
+}
+
+// NewTenantJobStore creates a job store scoped to the admin schema.
+func NewTenantJobStore(pool *pgxpool.Pool, schema string) *TenantJobStore {
+	return &TenantJobStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+const tenantJobSelectColumns = `job_id, tenant_id, kind, status, attempts, components, params, last_error,
+        next_attempt_at, created_at, updated_at, finished_at`
+
+// Enqueue inserts a queued job. When the tenant already has an open (queued or running) job of the same
+// kind, that job is returned instead so repeated requests do not pile up work.
+func (s *TenantJobStore) Enqueue(ctx context.Context, rec TenantJobRecord) (TenantJobRecord, error) {
+	if rec.JobID == uuid.Nil || rec.TenantID == uuid.Nil {
+		return TenantJobRecord{}, errors.New("job id and tenant id are required")
+	}
+	if rec.Kind == "" {
+		return TenantJobRecord{}, errors.New("job kind is required")
+	}
+	components, err := json.Marshal(rec.Components)
+	if err != nil {
+		return TenantJobRecord{}, fmt.Errorf("encode job components: %w", err)
+	}
+	params := rec.Params
+	if len(params) == 0 {
+		params = json.RawMessage(`{}`)
+	}
+
+	var out TenantJobRecord
+	err = s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (job_id, tenant_id, kind, status, components, params)
+        VALUES ($1, $2, $3, '%s', $4, $5)
+        ON CONFLICT (tenant_id, kind) WHERE status IN ('%s', '%s') DO NOTHING
+        RETURNING `+tenantJobSelectColumns,
+			TenantJobsTable, TenantJobQueued, TenantJobQueued, TenantJobRunning),
+			rec.JobID, rec.TenantID, rec.Kind, components, params)
+		out, err = scanTenantJob(row)
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		row = tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT `+tenantJobSelectColumns+`
+        FROM %s
+        WHERE tenant_id = $1 AND kind = $2 AND status IN ('%s', '%s')
+    `, TenantJobsTable, TenantJobQueued, TenantJobRunning), rec.TenantID, rec.Kind)
+		out, err = scanTenantJob(row)
+		return err
+	})
+	if err != nil {
+		return TenantJobRecord{}, fmt.Errorf("enqueue tenant job: %w", err)
+	}
+	return out, nil
+}
+
+// Get returns a job of the given tenant.
+func (s *TenantJobStore) Get(ctx context.Context, tenantID, jobID uuid.UUID) (TenantJobRecord, error) {
+	var out TenantJobRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT `+tenantJobSelectColumns+`
+        FROM %s
+        WHERE tenant_id = $1 AND job_id = $2
+    `, TenantJobsTable), tenantID, jobID)
+		var err error
+		out, err = scanTenantJob(row)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTenantJobNotFound
+		}
+		return err
+	})
+	return out, err
+}
+
+// ClaimDue leases up to limit open jobs whose next attempt is due. Claimed jobs are marked running, have their
+// attempt counter bumped and next_attempt_at pushed out by lease, so a crashed worker only delays the job.
+func (s *TenantJobStore) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]TenantJobRecord, error) {
+	var claimed []TenantJobRecord
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        WITH due AS (
+            SELECT job_id
+            FROM %[1]s
+            WHERE status IN ('%[2]s', '%[3]s') AND next_attempt_at <= NOW()
+            ORDER BY next_attempt_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        UPDATE %[1]s j
+        SET status = '%[3]s',
+            attempts = j.attempts + 1,
+            next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond',
+            updated_at = NOW()
+        FROM due
+        WHERE j.job_id = due.job_id
+        RETURNING j.job_id, j.tenant_id, j.kind, j.status, j.attempts, j.components, j.params, j.last_error,
+                  j.next_attempt_at, j.created_at, j.updated_at, j.finished_at
+    `, TenantJobsTable, TenantJobQueued, TenantJobRunning), limit, lease.Milliseconds())
+		if err != nil {
+			return fmt.Errorf("claim tenant jobs: %w", err)
+		}
+		defer rows.Close()
+
+		claimed = make([]TenantJobRecord, 0)
+		for rows.Next() {
+			job, err := scanTenantJob(rows)
+			if err != nil {
+				return err
+			}
+			claimed = append(claimed, job)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// Save stores the outcome of a job attempt: status, component progress, last error, next attempt and finish time.
+func (s *TenantJobStore) Save(ctx context.Context, rec TenantJobRecord) error {
+	components, err := json.Marshal(rec.Components)
+	if err != nil {
+		return fmt.Errorf("encode job components: %w", err)
+	}
+
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET status = $2, components = $3, last_error = $4, next_attempt_at = $5, finished_at = $6, updated_at = NOW()
+        WHERE job_id = $1
+    `, TenantJobsTable), rec.JobID, rec.Status, components, rec.LastError, rec.NextAttemptAt, rec.FinishedAt)
+		if err != nil {
+			return fmt.Errorf("save tenant job: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrTenantJobNotFound
+		}
+		return nil
+	})
+}
+
+func scanTenantJob(row pgx.Row) (TenantJobRecord, error) {
+	var (
+		rec        TenantJobRecord
+		components []byte
+		params     []byte
+	)
+	if err := row.Scan(&rec.JobID, &rec.TenantID, &rec.Kind, &rec.Status, &rec.Attempts, &components, &params, &rec.LastError,
+		&rec.NextAttemptAt, &rec.CreatedAt, &rec.UpdatedAt, &rec.FinishedAt); err != nil {
+		return TenantJobRecord{}, err
+	}
+	rec.Components = map[string]TenantJobComponent{}
+	if len(components) > 0 {
+		if err := json.Unmarshal(components, &rec.Components); err != nil {
+			return TenantJobRecord{}, fmt.Errorf("decode job components: %w", err)
+		}
+	}
+	rec.Params = json.RawMessage(params)
+	return rec, nil
+}
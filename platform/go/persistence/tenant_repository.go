@@ -26,6 +26,27 @@ type TenantRecord struct {
 	AuthReady         bool            `db:"auth_ready"`
 	LastProvisionedAt *time.Time      `db:"last_provisioned_at"`
 	LastError         *string         `db:"last_error"`
+	MaxEntities       *int64          `db:"max_entities"`
+	MaxSchemas        *int64          `db:"max_schemas"`
+	MaxStorageBytes   *int64          `db:"max_storage_bytes"`
+	MaxUsers          *int64          `db:"max_users"`
+	MaxObjectBytes    *int64          `db:"max_object_bytes"`
+	CORSOrigins       []string        `db:"cors_origins"`
+	EmailFromName     *string         `db:"email_from_name"`
+	EmailFromAddress  *string         `db:"email_from_address"`
+	EmailReplyTo      *string         `db:"email_reply_to"`
+	NotifyEmail       *string         `db:"notify_email"`
+}
+
+// TenantListParams filters and orders ListActive. Nil fields are not applied.
+type TenantListParams struct {
+	Status *string
+	// Search matches a case-insensitive substring of the slug or display name.
+	Search        *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Sort is a comma-separated list of slug, displayName, status or createdAt, each optionally prefixed with '-'.
+	Sort *string
 }
 
 // ErrNotFound is returned when a tenant record is not found.
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -15,6 +16,7 @@ import (
 type TenantStore struct {
 	adminDB *SpaceDB
 	table   string
+	outbox  *OutboxStore
 }
 
 // NewTenantStore creates a store; assumes migrations already created the table.
@@ -24,9 +26,18 @@ func NewTenantStore(ctx context.Context, pool *pgxpool.Pool, schema string) (*Te
 	return &TenantStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema}), table: table}, nil
 }
 
+// UseOutbox makes Create and AppendVersion record tenant.created / tenant.updated events, plus the lifecycle
+// events implied by each new version (see tenantLifecycleEvents), in the admin schema outbox within the same
+// transaction as the write.
+func (s *TenantStore) UseOutbox(outbox *OutboxStore) {
+	s.outbox = outbox
+}
+
 const tenantSelectColumns = `tenant_id, tenant_version, slug, display_name, status, schema_name, role_name,
         base_prefix, short_tenant_id, is_active, is_deleted, created_at, created_by,
-        db_ready, auth_ready, last_provisioned_at, last_error`
+        db_ready, auth_ready, last_provisioned_at, last_error,
+        max_entities, max_schemas, max_storage_bytes, max_users, max_object_bytes, cors_origins,
+        email_from_name, email_from_address, email_reply_to, notify_email`
 
 // Create inserts the initial tenant version.
 func (s *TenantStore) Create(ctx context.Context, rec TenantRecord) (TenantRecord, error) {
@@ -41,9 +52,11 @@ func (s *TenantStore) Create(ctx context.Context, rec TenantRecord) (TenantRecor
 	        INSERT INTO %s (
 	            tenant_id, tenant_version, slug, display_name, status, schema_name, role_name,
 	            base_prefix, short_tenant_id, is_active, is_deleted, created_at,
-	            created_by, db_ready, auth_ready, last_provisioned_at, last_error
+	            created_by, db_ready, auth_ready, last_provisioned_at, last_error,
+	            max_entities, max_schemas, max_storage_bytes, max_users, max_object_bytes, cors_origins,
+	            email_from_name, email_from_address, email_reply_to, notify_email
 	        ) VALUES (
-	            $1,$2,$3,$4,$5,$6,$7,$8,$9,TRUE,FALSE,$10,$11,$12,$13,$14,$15
+	            $1,$2,$3,$4,$5,$6,$7,$8,$9,TRUE,FALSE,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25
 	        )
 	        RETURNING `+tenantSelectColumns+`
 	    `, s.table)
@@ -54,11 +67,16 @@ func (s *TenantStore) Create(ctx context.Context, rec TenantRecord) (TenantRecor
 			rec.TenantID, rec.TenantVersion.String(), rec.Slug, rec.DisplayName, rec.Status,
 			rec.SchemaName, rec.RoleName, rec.BasePrefix, rec.ShortTenantID, rec.CreatedAt, rec.CreatedBy,
 			rec.DBReady, rec.AuthReady, rec.LastProvisionedAt, rec.LastError,
+			rec.MaxEntities, rec.MaxSchemas, rec.MaxStorageBytes, rec.MaxUsers, rec.MaxObjectBytes, corsOriginsParam(rec.CORSOrigins),
+			rec.EmailFromName, rec.EmailFromAddress, rec.EmailReplyTo, rec.NotifyEmail,
 		)
 
 		var scanErr error
 		out, scanErr = scanTenantRecord(row)
-		return scanErr
+		if scanErr != nil {
+			return scanErr
+		}
+		return s.recordEvents(ctx, tx, out, "", TenantEventCreated)
 	})
 	if err != nil {
 		return TenantRecord{}, err
@@ -70,6 +88,18 @@ func (s *TenantStore) Create(ctx context.Context, rec TenantRecord) (TenantRecor
 func (s *TenantStore) AppendVersion(ctx context.Context, rec TenantRecord) (TenantRecord, error) {
 	var out TenantRecord
 	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		var previous *TenantRecord
+		if s.outbox != nil {
+			current := fmt.Sprintf(`SELECT %s FROM %s WHERE tenant_id = $1 AND is_active = TRUE AND is_deleted = FALSE FOR UPDATE`, tenantSelectColumns, s.table)
+			prev, err := scanTenantRecord(tx.QueryRow(ctx, current, rec.TenantID))
+			switch {
+			case err == nil:
+				previous = &prev
+			case !errors.Is(err, ErrNotFound):
+				return err
+			}
+		}
+
 		deactivate := fmt.Sprintf("UPDATE %s SET is_active = FALSE WHERE tenant_id = $1", s.table)
 		if _, err := tx.Exec(ctx, deactivate, rec.TenantID); err != nil {
 			return err
@@ -79,9 +109,11 @@ func (s *TenantStore) AppendVersion(ctx context.Context, rec TenantRecord) (Tena
 	        INSERT INTO %s (
 	            tenant_id, tenant_version, slug, display_name, status, schema_name, role_name,
 	            base_prefix, short_tenant_id, is_active, is_deleted, created_at,
-	            created_by, db_ready, auth_ready, last_provisioned_at, last_error
+	            created_by, db_ready, auth_ready, last_provisioned_at, last_error,
+	            max_entities, max_schemas, max_storage_bytes, max_users, max_object_bytes, cors_origins,
+	            email_from_name, email_from_address, email_reply_to, notify_email
 	        ) VALUES (
-	            $1,$2,$3,$4,$5,$6,$7,$8,$9,TRUE,FALSE,$10,$11,$12,$13,$14,$15
+	            $1,$2,$3,$4,$5,$6,$7,$8,$9,TRUE,FALSE,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25
 	        )
 	        RETURNING `+tenantSelectColumns+`
 	    `, s.table)
@@ -90,11 +122,22 @@ func (s *TenantStore) AppendVersion(ctx context.Context, rec TenantRecord) (Tena
 			rec.TenantID, rec.TenantVersion.String(), rec.Slug, rec.DisplayName, rec.Status,
 			rec.SchemaName, rec.RoleName, rec.BasePrefix, rec.ShortTenantID, rec.CreatedAt, rec.CreatedBy,
 			rec.DBReady, rec.AuthReady, rec.LastProvisionedAt, rec.LastError,
+			rec.MaxEntities, rec.MaxSchemas, rec.MaxStorageBytes, rec.MaxUsers, rec.MaxObjectBytes, corsOriginsParam(rec.CORSOrigins),
+			rec.EmailFromName, rec.EmailFromAddress, rec.EmailReplyTo, rec.NotifyEmail,
 		)
 
 		var scanErr error
 		out, scanErr = scanTenantRecord(row)
-		return scanErr
+		if scanErr != nil {
+			return scanErr
+		}
+		eventTypes := []string{TenantEventUpdated}
+		var previousStatus string
+		if previous != nil {
+			previousStatus = previous.Status
+			eventTypes = append(eventTypes, tenantLifecycleEvents(*previous, out)...)
+		}
+		return s.recordEvents(ctx, tx, out, previousStatus, eventTypes...)
 	})
 	if err != nil {
 		return TenantRecord{}, err
@@ -132,26 +175,77 @@ func (s *TenantStore) GetBySlug(ctx context.Context, slug string) (TenantRecord,
 	return out, nil
 }
 
-// ListActive returns paginated active tenants with optional status filter.
-func (s *TenantStore) ListActive(ctx context.Context, status *string, limit, offset int) ([]TenantRecord, int, error) {
-	where := "WHERE is_active = TRUE AND is_deleted = FALSE"
+// ListCORSOrigins returns the CORS origins configured on active tenant versions, by tenant id. Disabled tenants are
+// skipped so their origins stop being honoured as soon as the tenant is switched off.
+func (s *TenantStore) ListCORSOrigins(ctx context.Context) (map[uuid.UUID][]string, error) {
+	query := fmt.Sprintf(`SELECT tenant_id, cors_origins FROM %s
+	        WHERE is_active = TRUE AND is_deleted = FALSE AND status <> $1 AND cardinality(cors_origins) > 0`, s.table)
+	var origins map[uuid.UUID][]string
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		origins = map[uuid.UUID][]string{}
+		rows, err := tx.Query(ctx, query, tenantStatusDisabled)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				tenantID uuid.UUID
+				list     []string
+			)
+			if err := rows.Scan(&tenantID, &list); err != nil {
+				return err
+			}
+			origins[tenantID] = list
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return origins, nil
+}
+
+// ListActive returns paginated active tenants matching params, along with the total number of matches.
+func (s *TenantStore) ListActive(ctx context.Context, params TenantListParams, limit, offset int) ([]TenantRecord, int, error) {
+	whereParts := []string{"is_active = TRUE", "is_deleted = FALSE"}
 	args := []any{}
-	if status != nil {
-		where += " AND status = $1"
-		args = append(args, *status)
+	if params.Status != nil {
+		args = append(args, *params.Status)
+		whereParts = append(whereParts, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if params.Search != nil && strings.TrimSpace(*params.Search) != "" {
+		args = append(args, "%"+escapeLike(strings.TrimSpace(*params.Search))+"%")
+		whereParts = append(whereParts, fmt.Sprintf("(slug ILIKE $%d OR display_name ILIKE $%d)", len(args), len(args)))
+	}
+	if params.CreatedAfter != nil {
+		args = append(args, *params.CreatedAfter)
+		whereParts = append(whereParts, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if params.CreatedBefore != nil {
+		args = append(args, *params.CreatedBefore)
+		whereParts = append(whereParts, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	orderSQL, err := buildTenantOrderBy(params.Sort)
+	if err != nil {
+		return nil, 0, err
 	}
 
+	where := "WHERE " + strings.Join(whereParts, " AND ")
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", s.table, where)
 	query := fmt.Sprintf(`SELECT %s FROM %s %s
-	        ORDER BY created_at DESC
-	        LIMIT %d OFFSET %d`, tenantSelectColumns, s.table, where, limit, offset)
+	        %s
+	        LIMIT %d OFFSET %d`, tenantSelectColumns, s.table, where, orderSQL, limit, offset)
 
 	var (
 		total   int
 		records []TenantRecord
 	)
 
-	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+	err = s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		records = records[:0]
 		if err := tx.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
 			return err
 		}
@@ -179,10 +273,170 @@ func (s *TenantStore) ListActive(ctx context.Context, status *string, limit, off
 	return records, total, nil
 }
 
+// buildTenantOrderBy translates a sort expression into an ORDER BY clause. tenant_id is always appended so pages
+// stay stable when the sort keys tie.
+func buildTenantOrderBy(sort *string) (string, error) {
+	const defaultOrder = "ORDER BY created_at DESC, tenant_id"
+	if sort == nil || strings.TrimSpace(*sort) == "" {
+		return defaultOrder, nil
+	}
+
+	mapping := map[string]string{
+		"slug":        "slug",
+		"displayName": "display_name",
+		"status":      "status",
+		"createdAt":   "created_at",
+	}
+
+	orderClauses := make([]string, 0)
+	for _, raw := range strings.Split(strings.TrimSpace(*sort), ",") {
+		f := strings.TrimSpace(raw)
+		if f == "" {
+			continue
+		}
+
+		direction := "ASC"
+		if strings.HasPrefix(f, "-") {
+			direction = "DESC"
+			f = strings.TrimPrefix(f, "-")
+		}
+
+		column, ok := mapping[f]
+		if !ok {
+			return "", fmt.Errorf("unsupported sort field %q", f)
+		}
+		if column == "display_name" {
+			direction += " NULLS LAST"
+		}
+		orderClauses = append(orderClauses, fmt.Sprintf("%s %s", column, direction))
+	}
+
+	if len(orderClauses) == 0 {
+		return defaultOrder, nil
+	}
+	return "ORDER BY " + strings.Join(orderClauses, ", ") + ", tenant_id", nil
+}
+
+// likeEscaper escapes LIKE wildcards so search input matches literally (backslash is the default escape character).
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// Tenant event types recorded in the admin outbox. Created and updated accompany every write; the others mark
+// lifecycle transitions so consumers such as billing do not have to diff versions themselves.
+const (
+	TenantEventCreated       = "tenant.created"
+	TenantEventUpdated       = "tenant.updated"
+	TenantEventProvisioned   = "tenant.provisioned"
+	TenantEventDisabled      = "tenant.disabled"
+	TenantEventDeprovisioned = "tenant.deprovisioned"
+)
+
+// Tenant statuses the lifecycle events depend on.
+const (
+	tenantStatusActive       = "active"
+	tenantStatusDisabled     = "disabled"
+	tenantStatusPending      = "pending"
+	tenantStatusProvisioning = "provisioning"
+)
+
+// tenantLifecycleEvents returns the lifecycle events implied by replacing prev with next:
+//   - provisioned when a pending or provisioning tenant becomes active;
+//   - disabled when the status changes to disabled;
+//   - deprovisioned when a disabled tenant loses its last ready flag, i.e. its teardown finished.
+func tenantLifecycleEvents(prev, next TenantRecord) []string {
+	var events []string
+	if next.Status == tenantStatusActive && (prev.Status == tenantStatusPending || prev.Status == tenantStatusProvisioning) {
+		events = append(events, TenantEventProvisioned)
+	}
+	if next.Status == tenantStatusDisabled && prev.Status != tenantStatusDisabled {
+		events = append(events, TenantEventDisabled)
+	}
+	if next.Status == tenantStatusDisabled && (prev.DBReady || prev.AuthReady) && !next.DBReady && !next.AuthReady {
+		events = append(events, TenantEventDeprovisioned)
+	}
+	return events
+}
+
+// tenantEventPayload is the published body of tenant events.
+type tenantEventPayload struct {
+	TenantID       uuid.UUID `json:"tenantId"`
+	TenantVersion  string    `json:"tenantVersion"`
+	Slug           string    `json:"slug"`
+	DisplayName    *string   `json:"displayName,omitempty"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previousStatus,omitempty"`
+	SchemaName     string    `json:"schemaName"`
+	BasePrefix     string    `json:"basePrefix"`
+	ShortTenantID  string    `json:"shortTenantId"`
+	DBReady        bool      `json:"dbReady"`
+	AuthReady      bool      `json:"authReady"`
+}
+
+// recordEvents appends one event per type, all describing rec. Event ids are UUIDv7 so events of the same write,
+// which share occurred_at, are still relayed in the order given.
+func (s *TenantStore) recordEvents(ctx context.Context, tx pgx.Tx, rec TenantRecord, previousStatus string, eventTypes ...string) error {
+	if s.outbox == nil {
+		return nil
+	}
+
+	events, err := tenantOutboxEvents(rec, previousStatus, eventTypes...)
+	if err != nil {
+		return err
+	}
+	return s.outbox.Append(ctx, tx, events...)
+}
+
+func tenantOutboxEvents(rec TenantRecord, previousStatus string, eventTypes ...string) ([]OutboxEvent, error) {
+	body, err := json.Marshal(tenantEventPayload{
+		TenantID:       rec.TenantID,
+		TenantVersion:  rec.TenantVersion.String(),
+		Slug:           rec.Slug,
+		DisplayName:    rec.DisplayName,
+		Status:         rec.Status,
+		PreviousStatus: previousStatus,
+		SchemaName:     rec.SchemaName,
+		BasePrefix:     rec.BasePrefix,
+		ShortTenantID:  rec.ShortTenantID,
+		DBReady:        rec.DBReady,
+		AuthReady:      rec.AuthReady,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode tenant event: %w", err)
+	}
+
+	events := make([]OutboxEvent, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventID, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("generate tenant event id: %w", err)
+		}
+		events = append(events, OutboxEvent{
+			EventID:    eventID,
+			Topic:      OutboxTopicTenants,
+			EventType:  eventType,
+			Key:        rec.TenantID.String(),
+			Payload:    body,
+			OccurredAt: rec.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// corsOriginsParam keeps the NOT NULL cors_origins column satisfied when a record carries no origins.
+func corsOriginsParam(origins []string) []string {
+	if origins == nil {
+		return []string{}
+	}
+	return origins
+}
+
 func scanTenantRecord(row pgx.Row) (TenantRecord, error) {
 	var rec TenantRecord
 	var versionStr string
-	if err := row.Scan(&rec.TenantID, &versionStr, &rec.Slug, &rec.DisplayName, &rec.Status, &rec.SchemaName, &rec.RoleName, &rec.BasePrefix, &rec.ShortTenantID, &rec.IsActive, &rec.IsDeleted, &rec.CreatedAt, &rec.CreatedBy, &rec.DBReady, &rec.AuthReady, &rec.LastProvisionedAt, &rec.LastError); err != nil {
+	if err := row.Scan(&rec.TenantID, &versionStr, &rec.Slug, &rec.DisplayName, &rec.Status, &rec.SchemaName, &rec.RoleName, &rec.BasePrefix, &rec.ShortTenantID, &rec.IsActive, &rec.IsDeleted, &rec.CreatedAt, &rec.CreatedBy, &rec.DBReady, &rec.AuthReady, &rec.LastProvisionedAt, &rec.LastError, &rec.MaxEntities, &rec.MaxSchemas, &rec.MaxStorageBytes, &rec.MaxUsers, &rec.MaxObjectBytes, &rec.CORSOrigins, &rec.EmailFromName, &rec.EmailFromAddress, &rec.EmailReplyTo, &rec.NotifyEmail); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return TenantRecord{}, ErrNotFound
 		}
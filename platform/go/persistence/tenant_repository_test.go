@@ -72,11 +72,54 @@ func TestTenantRepositoryLifecycle(t *testing.T) {
 	require.Equal(t, rec2.TenantVersion, active.TenantVersion)
 
 	// Listing should return only active versions (1 item) for default includeInactive=false.
-	records, total, err := repo.ListActive(ctx, nil, 10, 0)
+	records, total, err := repo.ListActive(ctx, TenantListParams{}, 10, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, total)
 	require.Len(t, records, 1)
 	require.Equal(t, tenantID, records[0].TenantID)
+
+	search := "ACME"
+	records, total, err = repo.ListActive(ctx, TenantListParams{Search: &search}, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Len(t, records, 1)
+
+	search = "ac%"
+	_, total, err = repo.ListActive(ctx, TenantListParams{Search: &search}, 10, 0)
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	future := now.Add(time.Hour)
+	_, total, err = repo.ListActive(ctx, TenantListParams{CreatedAfter: &future}, 10, 0)
+	require.NoError(t, err)
+	require.Zero(t, total)
+}
+
+func TestBuildTenantOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", sort: "", want: "ORDER BY created_at DESC, tenant_id"},
+		{name: "multiple", sort: "status,-createdAt", want: "ORDER BY status ASC, created_at DESC, tenant_id"},
+		{name: "display-name", sort: "-displayName", want: "ORDER BY display_name DESC NULLS LAST, tenant_id"},
+		{name: "unsupported", sort: "schemaName", wantErr: true},
+		{name: "injection", sort: "slug; DROP TABLE tenants", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildTenantOrderBy(&tt.sort)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
 }
 
 func strPtr(s string) *string { return &s }
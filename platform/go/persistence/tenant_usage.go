@@ -0,0 +1,180 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// TenantUsageMeter measures tenant consumption and enforces the quotas carried by the tenant.Space in context.
+type TenantUsageMeter struct {
+	db *SpaceDB
+}
+
+// NewTenantUsageMeter returns a meter that reads tenant schemas through db.
+func NewTenantUsageMeter(db *SpaceDB) *TenantUsageMeter {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &TenantUsageMeter{db: db}
+}
+
+// Measure returns the full usage of space.
+func (m *TenantUsageMeter) Measure(ctx context.Context, space tenant.Space) (tenant.Usage, error) {
+	var usage tenant.Usage
+	err := m.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tables, err := tenantEntityTablesTx(ctx, tx, space.SchemaName)
+		if err != nil {
+			return err
+		}
+		usage.Schemas = int64(len(tables))
+		if usage.Entities, err = countTenantEntitiesTx(ctx, tx, space.SchemaName, tables); err != nil {
+			return err
+		}
+		if usage.Users, err = countTenantUsersTx(ctx, tx, space.SchemaName); err != nil {
+			return err
+		}
+		if usage.StorageBytes, err = tenantStorageBytesTx(ctx, tx, space.SchemaName); err != nil {
+			return err
+		}
+		usage.Objects, usage.ObjectBytes, usage.ObjectsMeasuredAt, err = storageUsageTx(ctx, tx, space.SchemaName)
+		return err
+	})
+	if err != nil {
+		return tenant.Usage{}, fmt.Errorf("measure tenant usage: %w", err)
+	}
+	return usage, nil
+}
+
+// CheckQuota implements tenant.QuotaChecker. Only the requested resource is measured, and nothing is measured when
+// the tenant has no limit for it.
+func (m *TenantUsageMeter) CheckQuota(ctx context.Context, resource tenant.QuotaResource, n int64) error {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return errors.New("tenant space missing from context")
+	}
+	if space.Quotas.Limit(resource) == nil {
+		return nil
+	}
+
+	var used int64
+	err := m.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		var err error
+		switch resource {
+		case tenant.QuotaEntities:
+			var tables []string
+			if tables, err = tenantEntityTablesTx(ctx, tx, space.SchemaName); err == nil {
+				used, err = countTenantEntitiesTx(ctx, tx, space.SchemaName, tables)
+			}
+		case tenant.QuotaSchemas:
+			var tables []string
+			tables, err = tenantEntityTablesTx(ctx, tx, space.SchemaName)
+			used = int64(len(tables))
+		case tenant.QuotaStorageBytes:
+			used, err = tenantStorageBytesTx(ctx, tx, space.SchemaName)
+		case tenant.QuotaUsers:
+			used, err = countTenantUsersTx(ctx, tx, space.SchemaName)
+		case tenant.QuotaObjectBytes:
+			// Object storage is only measured periodically, so this checks against the last recorded figure.
+			_, used, _, err = storageUsageTx(ctx, tx, space.SchemaName)
+		default:
+			err = fmt.Errorf("unknown quota resource %q", resource)
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("check %s quota: %w", resource, err)
+	}
+	return space.Quotas.CheckLimit(resource, used, n)
+}
+
+// CheckTableQuota implements tenant.QuotaChecker.
+func (m *TenantUsageMeter) CheckTableQuota(ctx context.Context, tableName string) error {
+	space, ok := tenant.FromContext(ctx)
+	if !ok {
+		return errors.New("tenant space missing from context")
+	}
+	if space.Quotas.MaxSchemas == nil {
+		return nil
+	}
+
+	var tables []string
+	err := m.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		var err error
+		tables, err = tenantEntityTablesTx(ctx, tx, space.SchemaName)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("check schemas quota: %w", err)
+	}
+	for _, table := range tables {
+		if table == tableName {
+			return nil
+		}
+	}
+	return space.Quotas.CheckLimit(tenant.QuotaSchemas, int64(len(tables)), 1)
+}
+
+// tenantEntityTablesTx lists the catalog entity tables that exist in the tenant schema.
+func tenantEntityTablesTx(ctx context.Context, tx pgx.Tx, schemaName string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT DISTINCT sr.table_name
+		FROM schema_repository sr
+		JOIN pg_tables t ON t.schemaname = $1 AND t.tablename = sr.table_name
+		ORDER BY sr.table_name
+	`, schemaName)
+	if err != nil {
+		return nil, fmt.Errorf("list tenant entity tables: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("scan tenant entity tables: %w", err)
+	}
+	return tables, nil
+}
+
+func countTenantEntitiesTx(ctx context.Context, tx pgx.Tx, schemaName string, tables []string) (int64, error) {
+	var total int64
+	for _, table := range tables {
+		var count int64
+		query := "SELECT COUNT(*) FROM " + pgx.Identifier{schemaName, table}.Sanitize() + " WHERE is_active AND NOT is_deleted"
+		if err := tx.QueryRow(ctx, query).Scan(&count); err != nil {
+			return 0, fmt.Errorf("count entities in %s: %w", table, err)
+		}
+		total += count
+	}
+	return total, nil
+}
+
+func countTenantUsersTx(ctx context.Context, tx pgx.Tx, schemaName string) (int64, error) {
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, pgx.Identifier{schemaName, "users"}.Sanitize()).Scan(&exists); err != nil {
+		return 0, fmt.Errorf("check users table: %w", err)
+	}
+	if !exists {
+		return 0, nil
+	}
+
+	var count int64
+	if err := tx.QueryRow(ctx, "SELECT COUNT(*) FROM "+pgx.Identifier{schemaName, "users"}.Sanitize()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count users: %w", err)
+	}
+	return count, nil
+}
+
+func tenantStorageBytesTx(ctx context.Context, tx pgx.Tx, schemaName string) (int64, error) {
+	var size int64
+	if err := tx.QueryRow(ctx, `
+		SELECT COALESCE(SUM(pg_total_relation_size(c.oid)), 0)::BIGINT
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relkind IN ('r', 'm', 'p')
+	`, schemaName).Scan(&size); err != nil {
+		return 0, fmt.Errorf("measure tenant storage: %w", err)
+	}
+	return size, nil
+}
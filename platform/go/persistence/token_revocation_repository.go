@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const TokenRevocationsTable = "token_revocations"
+
+// TokenRevocation represents a row in the token_revocations table. Subject is a token subject (uid) when Kind is
+// "user" and a jti when Kind is "token" (see platformauth.RevocationKindUser).
+type TokenRevocation struct {
+	RevocationID uuid.UUID `db:"revocation_id" json:"revocationId"`
+	Kind         string    `db:"kind" json:"kind"`
+	Subject      string    `db:"subject" json:"subject"`
+	Reason       *string   `db:"reason" json:"reason,omitempty"`
+	RevokedBy    *string   `db:"revoked_by" json:"revokedBy,omitempty"`
+	RevokedAt    time.Time `db:"revoked_at" json:"revokedAt"`
+	ExpiresAt    time.Time `db:"expires_at" json:"expiresAt"`
+}
+
+// ErrTokenRevocationNotFound indicates a missing or expired revocation.
+var ErrTokenRevocationNotFound = errors.New("token revocation not found")
+
+// TokenRevocationStore keeps the platform-wide token revocation list in the admin schema. The table comes from the
+// admin migrations.
+type TokenRevocationStore struct {
+	adminDB *SpaceDB
+}
+
+// NewTokenRevocationStore creates a revocation store scoped to the admin schema.
+func NewTokenRevocationStore(pool *pgxpool.Pool, schema string) *TokenRevocationStore {
+	return &TokenRevocationStore{adminDB: NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: schema})}
+}
+
+// CreateTokenRevocationParams captures a new revocation.
+type CreateTokenRevocationParams struct {
+	Kind      string
+	Subject   string
+	Reason    *string
+	RevokedBy *string
+	RevokedAt time.Time
+	ExpiresAt time.Time
+}
+
+const tokenRevocationSelectColumns = "revocation_id, kind, subject, reason, revoked_by, revoked_at, expires_at"
+
+// CreateTokenRevocation inserts the revocation. Expired entries are purged on the way.
+func (s *TokenRevocationStore) CreateTokenRevocation(ctx context.Context, params CreateTokenRevocationParams) (TokenRevocation, error) {
+	if params.Kind == "" || params.Subject == "" {
+		return TokenRevocation{}, errors.New("revocation kind and subject are required")
+	}
+
+	var revocation TokenRevocation
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE expires_at <= $1`, TokenRevocationsTable), params.RevokedAt.UTC()); err != nil {
+			return fmt.Errorf("purge token revocations: %w", err)
+		}
+
+		scanned, err := scanTokenRevocation(tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (revocation_id, kind, subject, reason, revoked_by, revoked_at, expires_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING %s
+    `, TokenRevocationsTable, tokenRevocationSelectColumns), uuid.New(), params.Kind, params.Subject, params.Reason,
+			params.RevokedBy, params.RevokedAt.UTC(), params.ExpiresAt.UTC()))
+		if err != nil {
+			return fmt.Errorf("insert token revocation: %w", err)
+		}
+		revocation = scanned
+		return nil
+	})
+	if err != nil {
+		return TokenRevocation{}, err
+	}
+	return revocation, nil
+}
+
+// ListTokenRevocations returns the revocations that have not expired at now, newest first.
+func (s *TokenRevocationStore) ListTokenRevocations(ctx context.Context, now time.Time) ([]TokenRevocation, error) {
+	revocations := []TokenRevocation{}
+	err := s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		revocations = revocations[:0]
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT %s FROM %s
+        WHERE expires_at > $1
+        ORDER BY revoked_at DESC, revocation_id
+    `, tokenRevocationSelectColumns, TokenRevocationsTable), now.UTC())
+		if err != nil {
+			return fmt.Errorf("list token revocations: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			revocation, err := scanTokenRevocation(rows)
+			if err != nil {
+				return fmt.Errorf("scan token revocation: %w", err)
+			}
+			revocations = append(revocations, revocation)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return revocations, nil
+}
+
+// DeleteTokenRevocation clears a revocation; unknown ids return ErrTokenRevocationNotFound.
+func (s *TokenRevocationStore) DeleteTokenRevocation(ctx context.Context, id uuid.UUID) error {
+	return s.adminDB.WithAdmin(ctx, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE revocation_id = $1`, TokenRevocationsTable), id)
+		if err != nil {
+			return fmt.Errorf("delete token revocation: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrTokenRevocationNotFound
+		}
+		return nil
+	})
+}
+
+func scanTokenRevocation(row pgx.Row) (TokenRevocation, error) {
+	var revocation TokenRevocation
+	err := row.Scan(&revocation.RevocationID, &revocation.Kind, &revocation.Subject, &revocation.Reason,
+		&revocation.RevokedBy, &revocation.RevokedAt, &revocation.ExpiresAt)
+	return revocation, err
+}
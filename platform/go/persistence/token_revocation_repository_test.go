@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRevocationStoreLifecycle(t *testing.T) {
+	if _, ok := os.LookupEnv("TEST_DATABASE_URL"); !ok {
+		t.Skip("TEST_DATABASE_URL not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	pool, cleanup := mustTestPool(t)
+	defer cleanup()
+
+	schema := "tenant_admin_" + uuid.NewString()[:8]
+	require.NoError(t, BootstrapAdminSchema(ctx, pool, schema))
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE") })
+
+	store := NewTokenRevocationStore(pool, schema)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	stale, err := store.CreateTokenRevocation(ctx, CreateTokenRevocationParams{
+		Kind: "token", Subject: "jti-old", RevokedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	reason := "laptop stolen"
+	revokedBy := "admin-1"
+	user, err := store.CreateTokenRevocation(ctx, CreateTokenRevocationParams{
+		Kind: "user", Subject: "uid-1", Reason: &reason, RevokedBy: &revokedBy, RevokedAt: now, ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "uid-1", user.Subject)
+	require.Equal(t, reason, *user.Reason)
+
+	listed, err := store.ListTokenRevocations(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, user.RevocationID, listed[0].RevocationID)
+
+	// Creating the second entry purged the expired one.
+	require.ErrorIs(t, store.DeleteTokenRevocation(ctx, stale.RevocationID), ErrTokenRevocationNotFound)
+
+	require.NoError(t, store.DeleteTokenRevocation(ctx, user.RevocationID))
+	require.ErrorIs(t, store.DeleteTokenRevocation(ctx, user.RevocationID), ErrTokenRevocationNotFound)
+	listed, err = store.ListTokenRevocations(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, listed)
+}
@@ -0,0 +1,13 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLOperation(t *testing.T) {
+	require.Equal(t, "SELECT", sqlOperation("\n\tselect 1"))
+	require.Equal(t, "INSERT", sqlOperation("INSERT INTO users VALUES ($1)"))
+	require.Equal(t, "QUERY", sqlOperation("  "))
+}
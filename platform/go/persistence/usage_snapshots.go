@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const TenantUsageSnapshotsTable = "tenant_usage_snapshots"
+
+// TenantUsageSnapshotStore records periodic samples of tenant usage in the admin schema, for metering.
+type TenantUsageSnapshotStore struct {
+	db *SpaceDB
+}
+
+// NewTenantUsageSnapshotStore returns a snapshot store writing through db.
+func NewTenantUsageSnapshotStore(db *SpaceDB) *TenantUsageSnapshotStore {
+	if db == nil {
+		panic("space db is required")
+	}
+	return &TenantUsageSnapshotStore{db: db}
+}
+
+// RecordUsageSnapshot stores the usage of a tenant measured at measuredAt. Recording the same instant twice keeps
+// the latest values.
+func (s *TenantUsageSnapshotStore) RecordUsageSnapshot(ctx context.Context, tenantID uuid.UUID, usage tenant.Usage, measuredAt time.Time) error {
+	return s.db.WithAdmin(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (tenant_id, measured_at, entities, schemas, storage_bytes, users, objects, object_bytes)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        ON CONFLICT (tenant_id, measured_at) DO UPDATE
+        SET entities = EXCLUDED.entities, schemas = EXCLUDED.schemas, storage_bytes = EXCLUDED.storage_bytes,
+            users = EXCLUDED.users, objects = EXCLUDED.objects, object_bytes = EXCLUDED.object_bytes
+    `, TenantUsageSnapshotsTable), tenantID, measuredAt, usage.Entities, usage.Schemas, usage.StorageBytes, usage.Users,
+			usage.Objects, usage.ObjectBytes)
+		if err != nil {
+			return fmt.Errorf("record usage snapshot: %w", err)
+		}
+		return nil
+	})
+}
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,11 +11,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/pagination"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
 const UsersTable = "users"
 
+// UserProfileSettingsTable holds the tenant's single row of user profile settings.
+const UserProfileSettingsTable = "user_profile_settings"
+
+const userSelectColumns = "user_id, email, full_name, created_at, updated_at, last_login_at, last_active_at, login_count, attributes"
+
 // User represents a row in the users table.
 type User struct {
 	UserID    uuid.UUID `db:"user_id" json:"userId"`
@@ -22,6 +29,12 @@ type User struct {
 	FullName  string    `db:"full_name" json:"fullName"`
 	CreatedAt time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+	// LastLoginAt is the start of the most recent sign-in session (the token auth_time); see RecordActivity.
+	LastLoginAt  *time.Time `db:"last_login_at" json:"lastLoginAt,omitempty"`
+	LastActiveAt *time.Time `db:"last_active_at" json:"lastActiveAt,omitempty"`
+	LoginCount   int        `db:"login_count" json:"loginCount"`
+	// Attributes holds the tenant-defined profile fields as a JSON object.
+	Attributes json.RawMessage `db:"attributes" json:"attributes"`
 }
 
 var (
@@ -36,7 +49,7 @@ type UserStore struct {
 	db *SpaceDB
 }
 
-// NewUserStore ensures the users table exists and returns a store instance.
+// NewUserStore returns a store instance; the users table comes from the tenant migrations.
 func NewUserStore(ctx context.Context, db *SpaceDB) (*UserStore, error) {
 	if db == nil {
 		return nil, errors.New("space db is required")
@@ -51,6 +64,8 @@ type ListUsersParams struct {
 	PageSize int
 	Sort     *string
 	Email    *string
+	// LastActiveBefore keeps users last active before the instant, including users never active.
+	LastActiveBefore *time.Time
 }
 
 // ListUsersResult includes the rows and the total count for pagination metadata.
@@ -64,6 +79,8 @@ type CreateUserParams struct {
 	UserID   uuid.UUID
 	Email    string
 	FullName string
+	// Attributes defaults to an empty object.
+	Attributes json.RawMessage
 }
 
 // CreateUser inserts a new user and returns the persisted record.
@@ -74,18 +91,15 @@ func (s *UserStore) CreateUser(ctx context.Context, space tenant.Space, params C
 
 	var user User
 	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := ensureUserTable(ctx, tx); err != nil {
-			return err
-		}
-
 		row := tx.QueryRow(ctx, fmt.Sprintf(`
-        INSERT INTO %s (user_id, email, full_name)
-        VALUES ($1, $2, $3)
-        RETURNING user_id, email, full_name, created_at, updated_at
-    `, UsersTable),
+        INSERT INTO %s (user_id, email, full_name, attributes)
+        VALUES ($1, $2, $3, $4)
+        RETURNING %s
+    `, UsersTable, userSelectColumns),
 			params.UserID,
 			strings.TrimSpace(params.Email),
 			strings.TrimSpace(params.FullName),
+			userAttributes(params.Attributes),
 		)
 
 		scanned, scanErr := scanUser(row)
@@ -107,15 +121,7 @@ func (s *UserStore) CreateUser(ctx context.Context, space tenant.Space, params C
 
 // ListUsers returns users matching the filters with pagination applied.
 func (s *UserStore) ListUsers(ctx context.Context, space tenant.Space, params ListUsersParams) (ListUsersResult, error) {
-	if params.Page < 1 {
-		params.Page = 1
-	}
-	if params.PageSize <= 0 {
-		params.PageSize = 20
-	}
-	if params.PageSize > 100 {
-		params.PageSize = 100
-	}
+	page := pagination.New(params.Page, params.PageSize)
 
 	whereParts := []string{"1=1"}
 	var args []any
@@ -125,6 +131,10 @@ func (s *UserStore) ListUsers(ctx context.Context, space tenant.Space, params Li
 		args = append(args, "%"+strings.ToLower(email)+"%")
 		whereParts = append(whereParts, fmt.Sprintf("LOWER(email) LIKE $%d", len(args)))
 	}
+	if params.LastActiveBefore != nil {
+		args = append(args, *params.LastActiveBefore)
+		whereParts = append(whereParts, fmt.Sprintf("(last_active_at IS NULL OR last_active_at < $%d)", len(args)))
+	}
 
 	whereSQL := strings.Join(whereParts, " AND ")
 
@@ -134,11 +144,7 @@ func (s *UserStore) ListUsers(ctx context.Context, space tenant.Space, params Li
 	}
 
 	var result ListUsersResult
-	err = s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := ensureUserTable(ctx, tx); err != nil {
-			return err
-		}
-
+	err = s.db.WithTenantRead(ctx, space, func(tx pgx.Tx) error {
 		countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", UsersTable, whereSQL)
 		var total int
 		if err := tx.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
@@ -151,19 +157,19 @@ func (s *UserStore) ListUsers(ctx context.Context, space tenant.Space, params Li
 			return nil
 		}
 
-		limit := params.PageSize
-		offset := (params.Page - 1) * params.PageSize
+		limit := page.Limit()
+		offset := page.Offset()
 
 		dataArgs := append([]any{}, args...)
 		dataArgs = append(dataArgs, limit, offset)
 
 		query := fmt.Sprintf(`
-        SELECT user_id, email, full_name, created_at, updated_at
+        SELECT %s
         FROM %s
         WHERE %s
         %s
         LIMIT $%d OFFSET $%d
-    `, UsersTable, whereSQL, orderSQL, len(dataArgs)-1, len(dataArgs))
+    `, userSelectColumns, UsersTable, whereSQL, orderSQL, len(dataArgs)-1, len(dataArgs))
 
 		rows, err := tx.Query(ctx, query, dataArgs...)
 		if err != nil {
@@ -203,10 +209,12 @@ func buildUserOrderBy(sort *string) (string, error) {
 	fields := strings.Split(strings.TrimSpace(*sort), ",")
 	orderClauses := make([]string, 0, len(fields))
 	mapping := map[string]string{
-		"email":     "email",
-		"fullName":  "full_name",
-		"createdAt": "created_at",
-		"updatedAt": "updated_at",
+		"email":        "email",
+		"fullName":     "full_name",
+		"createdAt":    "created_at",
+		"updatedAt":    "updated_at",
+		"lastLoginAt":  "last_login_at",
+		"lastActiveAt": "last_active_at",
 	}
 
 	for _, raw := range fields {
@@ -240,14 +248,10 @@ func buildUserOrderBy(sort *string) (string, error) {
 func (s *UserStore) GetUser(ctx context.Context, space tenant.Space, id uuid.UUID) (User, error) {
 	var user User
 	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := ensureUserTable(ctx, tx); err != nil {
-			return err
-		}
-
 		row := tx.QueryRow(ctx, fmt.Sprintf(`
-        SELECT user_id, email, full_name, created_at, updated_at
+        SELECT %s
         FROM %s WHERE user_id = $1
-    `, UsersTable), id)
+    `, userSelectColumns, UsersTable), id)
 
 		scanned, scanErr := scanUser(row)
 		if scanErr != nil {
@@ -266,9 +270,12 @@ func (s *UserStore) GetUser(ctx context.Context, space tenant.Space, id uuid.UUI
 	return user, nil
 }
 
-// UpdateUserParams represents admin-editable fields.
+// UpdateUserParams represents admin-editable fields. Email is only changed by the auth provider sync.
 type UpdateUserParams struct {
 	FullName *string
+	Email    *string
+	// Attributes replaces the stored attributes when set.
+	Attributes json.RawMessage
 }
 
 // UpdateUser applies the provided fields and returns the updated record.
@@ -280,6 +287,14 @@ func (s *UserStore) UpdateUser(ctx context.Context, space tenant.Space, id uuid.
 		args = append(args, strings.TrimSpace(*params.FullName))
 		setParts = append(setParts, fmt.Sprintf("full_name = $%d", len(args)))
 	}
+	if params.Email != nil {
+		args = append(args, strings.ToLower(strings.TrimSpace(*params.Email)))
+		setParts = append(setParts, fmt.Sprintf("email = $%d", len(args)))
+	}
+	if params.Attributes != nil {
+		args = append(args, userAttributes(params.Attributes))
+		setParts = append(setParts, fmt.Sprintf("attributes = $%d", len(args)))
+	}
 
 	if len(setParts) == 0 {
 		return User{}, errors.New("no fields to update")
@@ -289,16 +304,12 @@ func (s *UserStore) UpdateUser(ctx context.Context, space tenant.Space, id uuid.
 
 	var user User
 	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := ensureUserTable(ctx, tx); err != nil {
-			return err
-		}
-
 		query := fmt.Sprintf(`
         UPDATE %s
         SET %s, updated_at = NOW()
         WHERE user_id = $%d
-        RETURNING user_id, email, full_name, created_at, updated_at
-    `, UsersTable, strings.Join(setParts, ", "), len(args))
+        RETURNING %s
+    `, UsersTable, strings.Join(setParts, ", "), len(args), userSelectColumns)
 
 		row := tx.QueryRow(ctx, query, args...)
 
@@ -326,16 +337,12 @@ func (s *UserStore) UpdateUser(ctx context.Context, space tenant.Space, id uuid.
 func (s *UserStore) UpdateUserFullName(ctx context.Context, space tenant.Space, id uuid.UUID, fullName string) (User, error) {
 	var user User
 	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := ensureUserTable(ctx, tx); err != nil {
-			return err
-		}
-
 		row := tx.QueryRow(ctx, fmt.Sprintf(`
         UPDATE %s
         SET full_name = $1, updated_at = NOW()
         WHERE user_id = $2
-        RETURNING user_id, email, full_name, created_at, updated_at
-    `, UsersTable), strings.TrimSpace(fullName), id)
+        RETURNING %s
+    `, UsersTable, userSelectColumns), strings.TrimSpace(fullName), id)
 
 		scanned, scanErr := scanUser(row)
 		if scanErr != nil {
@@ -358,6 +365,38 @@ func (s *UserStore) UpdateUserFullName(ctx context.Context, space tenant.Space,
 	return user, nil
 }
 
+// RecordActivity notes that the user made a request at activeAt within a sign-in session that started at
+// sessionStart. A session newer than last_login_at counts as a new login. Both timestamps only move forward and
+// updated_at is left alone, since activity is not an edit of the user.
+func (s *UserStore) RecordActivity(ctx context.Context, space tenant.Space, id uuid.UUID, sessionStart, activeAt time.Time) (User, error) {
+	var user User
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET login_count = login_count + CASE WHEN last_login_at IS NULL OR last_login_at < $2 THEN 1 ELSE 0 END,
+            last_login_at = GREATEST(last_login_at, $2),
+            last_active_at = GREATEST(last_active_at, $3)
+        WHERE user_id = $1
+        RETURNING %s
+    `, UsersTable, userSelectColumns), id, sessionStart.UTC(), activeAt.UTC())
+
+		scanned, scanErr := scanUser(row)
+		if scanErr != nil {
+			if errors.Is(scanErr, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return scanErr
+		}
+		user = scanned
+		return nil
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
 // DeleteUser removes a user by identifier.
 func (s *UserStore) DeleteUser(ctx context.Context, space tenant.Space, id uuid.UUID) error {
 	if id == uuid.Nil {
@@ -365,10 +404,6 @@ func (s *UserStore) DeleteUser(ctx context.Context, space tenant.Space, id uuid.
 	}
 
 	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
-		if err := ensureUserTable(ctx, tx); err != nil {
-			return err
-		}
-
 		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, UsersTable), id)
 		if err != nil {
 			return fmt.Errorf("delete user: %w", err)
@@ -385,30 +420,57 @@ func (s *UserStore) DeleteUser(ctx context.Context, space tenant.Space, id uuid.
 func scanUser(row pgx.Row) (User, error) {
 	var user User
 
-	if err := row.Scan(&user.UserID, &user.Email, &user.FullName, &user.CreatedAt, &user.UpdatedAt); err != nil {
+	if err := row.Scan(&user.UserID, &user.Email, &user.FullName, &user.CreatedAt, &user.UpdatedAt,
+		&user.LastLoginAt, &user.LastActiveAt, &user.LoginCount, &user.Attributes); err != nil {
 		return User{}, err
 	}
 
 	return user, nil
 }
 
-func ensureUserTable(ctx context.Context, tx pgx.Tx) error {
-	stmt := fmt.Sprintf(`
-CREATE TABLE IF NOT EXISTS %s (
-    user_id UUID PRIMARY KEY,
-    email TEXT NOT NULL UNIQUE,
-    full_name TEXT NOT NULL,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
-);`, UsersTable)
-
-	indexStmt := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_created_at_idx ON %s(created_at DESC);`, UsersTable, UsersTable)
+// GetAttributesSchema returns the slug of the schema-repository schema that validates user attributes, or ""
+// when the tenant has not configured one.
+func (s *UserStore) GetAttributesSchema(ctx context.Context, space tenant.Space) (string, error) {
+	var slug *string
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT attributes_schema FROM %s WHERE id`, UserProfileSettingsTable)).Scan(&slug)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("get attributes schema: %w", err)
+	}
+	if slug == nil {
+		return "", nil
+	}
+	return *slug, nil
+}
 
-	if _, err := tx.Exec(ctx, stmt); err != nil {
-		return fmt.Errorf("ensure users table: %w", err)
+// SetAttributesSchema stores the slug of the schema that validates user attributes; "" removes it.
+func (s *UserStore) SetAttributesSchema(ctx context.Context, space tenant.Space, slug string) error {
+	var value *string
+	if slug = strings.TrimSpace(slug); slug != "" {
+		value = &slug
 	}
-	if _, err := tx.Exec(ctx, indexStmt); err != nil {
-		return fmt.Errorf("ensure users index: %w", err)
+
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+        INSERT INTO %s (id, attributes_schema, updated_at)
+        VALUES (TRUE, $1, NOW())
+        ON CONFLICT (id) DO UPDATE SET attributes_schema = EXCLUDED.attributes_schema, updated_at = NOW()
+    `, UserProfileSettingsTable), value); err != nil {
+			return fmt.Errorf("set attributes schema: %w", err)
+		}
+		return nil
+	})
+}
+
+// userAttributes stores missing attributes as an empty object.
+func userAttributes(attributes json.RawMessage) json.RawMessage {
+	if len(attributes) == 0 {
+		return json.RawMessage(`{}`)
 	}
-	return nil
+	return attributes
 }
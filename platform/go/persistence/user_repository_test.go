@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -108,6 +109,10 @@ END$$;`)
 		RoleName:      tenantSchemaB + "_role",
 		BasePrefix:    "dev/beta-inc-beta0001/",
 	}
+	for _, space := range []tenant.Space{spaceA, spaceB} {
+		_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+		require.NoError(t, err)
+	}
 
 	userA, err := store.CreateUser(ctx, spaceA, CreateUserParams{
 		UserID:   uuid.New(),
@@ -145,6 +150,49 @@ END$$;`)
 	assertCount(tenantSchemaA, 1)
 	assertCount(tenantSchemaB, 1)
 
+	// A new session counts as a login; later tokens of the same session only move last_active_at.
+	session := time.Now().UTC().Truncate(time.Second)
+	active, err := store.RecordActivity(ctx, spaceA, userA.UserID, session, session.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, active.LoginCount)
+	active, err = store.RecordActivity(ctx, spaceA, userA.UserID, session, session.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, active.LoginCount)
+	require.True(t, session.Equal(*active.LastLoginAt))
+	require.True(t, session.Add(time.Hour).Equal(*active.LastActiveAt))
+	require.Equal(t, updated.UpdatedAt, active.UpdatedAt)
+
+	cutoff := session.Add(30 * time.Minute)
+	idle, err := store.ListUsers(ctx, spaceA, ListUsersParams{LastActiveBefore: &cutoff})
+	require.NoError(t, err)
+	require.Zero(t, idle.TotalItems)
+	cutoff = session.Add(2 * time.Hour)
+	idle, err = store.ListUsers(ctx, spaceA, ListUsersParams{LastActiveBefore: &cutoff})
+	require.NoError(t, err)
+	require.Equal(t, 1, idle.TotalItems)
+
+	// Attributes start empty and are replaced as a whole.
+	require.JSONEq(t, `{}`, string(active.Attributes))
+	withAttributes, err := store.UpdateUser(ctx, spaceA, userA.UserID, UpdateUserParams{Attributes: json.RawMessage(`{"department":"sales"}`)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"department":"sales"}`, string(withAttributes.Attributes))
+
+	// The attributes schema setting is per tenant.
+	slug, err := store.GetAttributesSchema(ctx, spaceA)
+	require.NoError(t, err)
+	require.Empty(t, slug)
+	require.NoError(t, store.SetAttributesSchema(ctx, spaceA, "user-profile"))
+	slug, err = store.GetAttributesSchema(ctx, spaceA)
+	require.NoError(t, err)
+	require.Equal(t, "user-profile", slug)
+	slug, err = store.GetAttributesSchema(ctx, spaceB)
+	require.NoError(t, err)
+	require.Empty(t, slug)
+	require.NoError(t, store.SetAttributesSchema(ctx, spaceA, ""))
+	slug, err = store.GetAttributesSchema(ctx, spaceA)
+	require.NoError(t, err)
+	require.Empty(t, slug)
+
 	// Delete in B keeps A untouched.
 	err = store.DeleteUser(ctx, spaceB, userB.UserID)
 	require.NoError(t, err)
@@ -0,0 +1,633 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+const (
+	WebhookSubscriptionsTable = "webhook_subscriptions"
+	WebhookOutboxTable        = "webhook_outbox"
+	WebhookDeliveriesTable    = "webhook_deliveries"
+)
+
+// Webhook delivery states stored in webhook_deliveries.status.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// ErrWebhookNotFound indicates a missing webhook subscription.
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+// ErrWebhookDeliveryNotFound indicates a missing delivery, or one that belongs to another subscription.
+var ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+// ErrWebhookDeliveryPending is returned when redelivering a delivery that is still scheduled.
+var ErrWebhookDeliveryPending = errors.New("webhook delivery is still pending")
+
+// WebhookSubscription represents a row in the webhook_subscriptions table.
+type WebhookSubscription struct {
+	WebhookID   uuid.UUID
+	URL         string
+	EventTypes  []string
+	Secret      string
+	Description *string
+	CreatedAt   time.Time
+	CreatedBy   *string
+}
+
+// WebhookDelivery represents a row in the webhook_deliveries table joined with its outbox event.
+type WebhookDelivery struct {
+	DeliveryID     uuid.UUID
+	WebhookID      uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	TableName      string
+	EntityID       string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// WebhookExchange is the HTTP request sent by a delivery attempt and the response it got. Response fields are empty
+// when the endpoint could not be reached.
+type WebhookExchange struct {
+	RequestHeaders  map[string]string
+	RequestBody     []byte
+	ResponseHeaders map[string]string
+	ResponseBody    []byte
+	Duration        time.Duration
+}
+
+// WebhookDeliveryDetail is a delivery with the exchange of its latest attempt.
+// LastAttemptAt and Exchange are nil until the first attempt completes.
+type WebhookDeliveryDetail struct {
+	WebhookDelivery
+	LastAttemptAt *time.Time
+	Exchange      *WebhookExchange
+	RedeliveredAt *time.Time
+	RedeliveredBy *string
+}
+
+// ClaimedWebhookDelivery carries everything the dispatcher needs to perform one delivery attempt.
+// Attempt is the 1-based number of the attempt being made.
+type ClaimedWebhookDelivery struct {
+	DeliveryID uuid.UUID
+	Attempt    int
+	WebhookID  uuid.UUID
+	URL        string
+	Secret     string
+	EventID    uuid.UUID
+	Event      EntityEvent
+}
+
+// WebhookDeliveryOutcome records the result of a delivery attempt.
+// A nil RetryAt on an unsuccessful attempt marks the delivery as permanently failed.
+type WebhookDeliveryOutcome struct {
+	Succeeded  bool
+	StatusCode *int
+	Error      *string
+	RetryAt    *time.Time
+	Exchange   *WebhookExchange
+}
+
+// CreateWebhookParams captures the fields required to register a webhook subscription.
+type CreateWebhookParams struct {
+	WebhookID   uuid.UUID
+	URL         string
+	EventTypes  []string
+	Secret      string
+	Description *string
+	CreatedBy   *string
+}
+
+// WebhookStore persists webhook subscriptions, the entity event outbox and the delivery log.
+// It implements EntityEventSink so entity writes enqueue events in their own transaction.
+type WebhookStore struct {
+	db *SpaceDB
+}
+
+// NewWebhookStore returns a store instance; the tables come from the tenant migrations.
+func NewWebhookStore(ctx context.Context, db *SpaceDB) (*WebhookStore, error) {
+	if db == nil {
+		return nil, errors.New("space db is required")
+	}
+
+	return &WebhookStore{db: db}, nil
+}
+
+// CreateSubscription inserts a new webhook subscription and returns the persisted record.
+func (s *WebhookStore) CreateSubscription(ctx context.Context, space tenant.Space, params CreateWebhookParams) (WebhookSubscription, error) {
+	if params.WebhookID == uuid.Nil {
+		return WebhookSubscription{}, errors.New("webhook id is required")
+	}
+	if len(params.EventTypes) == 0 {
+		return WebhookSubscription{}, errors.New("event types are required")
+	}
+
+	var subscription WebhookSubscription
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        INSERT INTO %s (webhook_id, url, event_types, secret, description, created_by)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING webhook_id, url, event_types, secret, description, created_at, created_by
+    `, WebhookSubscriptionsTable),
+			params.WebhookID,
+			strings.TrimSpace(params.URL),
+			params.EventTypes,
+			params.Secret,
+			params.Description,
+			params.CreatedBy,
+		)
+
+		scanned, scanErr := scanWebhookSubscription(row)
+		if scanErr != nil {
+			return fmt.Errorf("insert webhook: %w", scanErr)
+		}
+		subscription = scanned
+		return nil
+	})
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+// ListSubscriptions returns a page of subscriptions ordered by creation time and the total count.
+func (s *WebhookStore) ListSubscriptions(ctx context.Context, space tenant.Space, limit, offset int) ([]WebhookSubscription, int, error) {
+	var (
+		subscriptions []WebhookSubscription
+		total         int
+	)
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s`, WebhookSubscriptionsTable)).Scan(&total); err != nil {
+			return fmt.Errorf("count webhooks: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT webhook_id, url, event_types, secret, description, created_at, created_by
+        FROM %s
+        ORDER BY created_at DESC, webhook_id
+        LIMIT $1 OFFSET $2
+    `, WebhookSubscriptionsTable), limit, offset)
+		if err != nil {
+			return fmt.Errorf("list webhooks: %w", err)
+		}
+		defer rows.Close()
+
+		subscriptions = make([]WebhookSubscription, 0)
+		for rows.Next() {
+			subscription, scanErr := scanWebhookSubscription(rows)
+			if scanErr != nil {
+				return fmt.Errorf("scan webhook: %w", scanErr)
+			}
+			subscriptions = append(subscriptions, subscription)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return subscriptions, total, nil
+}
+
+// GetSubscription returns a single subscription by identifier.
+func (s *WebhookStore) GetSubscription(ctx context.Context, space tenant.Space, id uuid.UUID) (WebhookSubscription, error) {
+	var subscription WebhookSubscription
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT webhook_id, url, event_types, secret, description, created_at, created_by
+        FROM %s WHERE webhook_id = $1
+    `, WebhookSubscriptionsTable), id)
+
+		scanned, scanErr := scanWebhookSubscription(row)
+		if scanErr != nil {
+			if errors.Is(scanErr, pgx.ErrNoRows) {
+				return ErrWebhookNotFound
+			}
+			return scanErr
+		}
+		subscription = scanned
+		return nil
+	})
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription removes a subscription; its deliveries are removed by cascade.
+func (s *WebhookStore) DeleteSubscription(ctx context.Context, space tenant.Space, id uuid.UUID) error {
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE webhook_id = $1`, WebhookSubscriptionsTable), id)
+		if err != nil {
+			return fmt.Errorf("delete webhook: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrWebhookNotFound
+		}
+		return nil
+	})
+}
+
+// ListDeliveries returns the delivery log for a subscription, newest first, and the total count.
+// A non-empty status only returns deliveries in that state.
+func (s *WebhookStore) ListDeliveries(ctx context.Context, space tenant.Space, webhookID uuid.UUID, status string, limit, offset int) ([]WebhookDelivery, int, error) {
+	var (
+		deliveries []WebhookDelivery
+		total      int
+	)
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if err := requireWebhookSubscription(ctx, tx, webhookID); err != nil {
+			return err
+		}
+
+		if err := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT COUNT(*) FROM %s WHERE webhook_id = $1 AND ($2 = '' OR status = $2)
+    `, WebhookDeliveriesTable), webhookID, status).Scan(&total); err != nil {
+			return fmt.Errorf("count webhook deliveries: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        SELECT `+webhookDeliveryColumns+`
+        FROM %s d
+        JOIN %s o ON o.event_id = d.event_id
+        WHERE d.webhook_id = $1 AND ($2 = '' OR d.status = $2)
+        ORDER BY d.created_at DESC, d.delivery_id
+        LIMIT $3 OFFSET $4
+    `, WebhookDeliveriesTable, WebhookOutboxTable), webhookID, status, limit, offset)
+		if err != nil {
+			return fmt.Errorf("list webhook deliveries: %w", err)
+		}
+		defer rows.Close()
+
+		deliveries = make([]WebhookDelivery, 0)
+		for rows.Next() {
+			var d WebhookDelivery
+			if err := rows.Scan(webhookDeliveryDest(&d)...); err != nil {
+				return fmt.Errorf("scan webhook delivery: %w", err)
+			}
+			deliveries = append(deliveries, d)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// GetDelivery returns a delivery of the subscription together with the exchange of its latest attempt.
+func (s *WebhookStore) GetDelivery(ctx context.Context, space tenant.Space, webhookID, deliveryID uuid.UUID) (WebhookDeliveryDetail, error) {
+	var detail WebhookDeliveryDetail
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if err := requireWebhookSubscription(ctx, tx, webhookID); err != nil {
+			return err
+		}
+
+		var (
+			durationMS      *int64
+			requestHeaders  map[string]string
+			requestBody     []byte
+			responseHeaders map[string]string
+			responseBody    []byte
+		)
+		dest := append(webhookDeliveryDest(&detail.WebhookDelivery),
+			&detail.LastAttemptAt, &durationMS, &requestHeaders, &requestBody, &responseHeaders, &responseBody,
+			&detail.RedeliveredAt, &detail.RedeliveredBy)
+		err := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT `+webhookDeliveryColumns+`,
+               d.last_attempt_at, d.last_duration_ms, d.request_headers, d.request_body, d.response_headers,
+               d.response_body, d.redelivered_at, d.redelivered_by
+        FROM %s d
+        JOIN %s o ON o.event_id = d.event_id
+        WHERE d.webhook_id = $1 AND d.delivery_id = $2
+    `, WebhookDeliveriesTable, WebhookOutboxTable), webhookID, deliveryID).Scan(dest...)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWebhookDeliveryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get webhook delivery: %w", err)
+		}
+
+		if detail.LastAttemptAt != nil {
+			exchange := &WebhookExchange{
+				RequestHeaders:  requestHeaders,
+				RequestBody:     requestBody,
+				ResponseHeaders: responseHeaders,
+				ResponseBody:    responseBody,
+			}
+			if durationMS != nil {
+				exchange.Duration = time.Duration(*durationMS) * time.Millisecond
+			}
+			detail.Exchange = exchange
+		}
+		return nil
+	})
+	if err != nil {
+		return WebhookDeliveryDetail{}, err
+	}
+
+	return detail, nil
+}
+
+// RedeliverDelivery schedules a finished delivery to be attempted again on the next dispatcher pass, with a fresh
+// attempt budget. The exchange of the previous attempt stays available until the new attempt completes.
+func (s *WebhookStore) RedeliverDelivery(ctx context.Context, space tenant.Space, webhookID, deliveryID uuid.UUID, requestedBy *string) (WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		if err := requireWebhookSubscription(ctx, tx, webhookID); err != nil {
+			return err
+		}
+
+		var status string
+		err := tx.QueryRow(ctx, fmt.Sprintf(`
+        SELECT status FROM %s WHERE webhook_id = $1 AND delivery_id = $2 FOR UPDATE
+    `, WebhookDeliveriesTable), webhookID, deliveryID).Scan(&status)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWebhookDeliveryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("lock webhook delivery: %w", err)
+		}
+		if status == WebhookDeliveryPending {
+			return ErrWebhookDeliveryPending
+		}
+
+		err = tx.QueryRow(ctx, fmt.Sprintf(`
+        WITH updated AS (
+            UPDATE %[1]s
+            SET status = '%[3]s', attempts = 0, next_attempt_at = NOW(), redelivered_at = NOW(), redelivered_by = $2,
+                updated_at = NOW()
+            WHERE delivery_id = $1
+            RETURNING *
+        )
+        SELECT `+webhookDeliveryColumns+`
+        FROM updated d
+        JOIN %[2]s o ON o.event_id = d.event_id
+    `, WebhookDeliveriesTable, WebhookOutboxTable, WebhookDeliveryPending), deliveryID, requestedBy).Scan(webhookDeliveryDest(&delivery)...)
+		if err != nil {
+			return fmt.Errorf("redeliver webhook delivery: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	return delivery, nil
+}
+
+// RotateSubscriptionSecret replaces the signing secret of a subscription. Attempts made after the rotation,
+// including retries of deliveries queued before it, are signed with the new secret.
+func (s *WebhookStore) RotateSubscriptionSecret(ctx context.Context, space tenant.Space, id uuid.UUID, secret string) (WebhookSubscription, error) {
+	if secret == "" {
+		return WebhookSubscription{}, errors.New("secret is required")
+	}
+
+	var subscription WebhookSubscription
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		row := tx.QueryRow(ctx, fmt.Sprintf(`
+        UPDATE %s SET secret = $2 WHERE webhook_id = $1
+        RETURNING webhook_id, url, event_types, secret, description, created_at, created_by
+    `, WebhookSubscriptionsTable), id, secret)
+
+		scanned, scanErr := scanWebhookSubscription(row)
+		if scanErr != nil {
+			if errors.Is(scanErr, pgx.ErrNoRows) {
+				return ErrWebhookNotFound
+			}
+			return fmt.Errorf("rotate webhook secret: %w", scanErr)
+		}
+		subscription = scanned
+		return nil
+	})
+	if err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+// RecordEntityEvents appends events to the outbox using the caller's transaction.
+func (s *WebhookStore) RecordEntityEvents(ctx context.Context, tx pgx.Tx, events []EntityEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	rows := make([][]any, 0, len(events))
+	for _, event := range events {
+		var version *string
+		if event.EntityVersion != "" {
+			version = &event.EntityVersion
+		}
+		var payload []byte
+		if len(event.Payload) > 0 {
+			payload = event.Payload
+		}
+		occurredAt := event.OccurredAt
+		if occurredAt.IsZero() {
+			occurredAt = time.Now().UTC()
+		}
+		rows = append(rows, []any{uuid.New(), event.Type, event.TableName, event.EntityID, version, payload, occurredAt})
+	}
+
+	columns := []string{"event_id", "event_type", "table_name", "entity_id", "entity_version", "payload", "occurred_at"}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{WebhookOutboxTable}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("enqueue webhook events: %w", err)
+	}
+	return nil
+}
+
+// FanOutEvents turns up to limit undispatched outbox events into one pending delivery per matching subscription
+// and marks the events as dispatched. It returns the number of events processed.
+func (s *WebhookStore) FanOutEvents(ctx context.Context, space tenant.Space, limit int) (int, error) {
+	var processed int
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, fmt.Sprintf(`
+        WITH events AS (
+            SELECT event_id, event_type
+            FROM %[1]s
+            WHERE dispatched_at IS NULL
+            ORDER BY occurred_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        ), fanned AS (
+            INSERT INTO %[2]s (delivery_id, webhook_id, event_id, status)
+            SELECT gen_random_uuid(), s.webhook_id, e.event_id, '%[4]s'
+            FROM events e
+            JOIN %[3]s s ON e.event_type = ANY(s.event_types)
+            ON CONFLICT (webhook_id, event_id) DO NOTHING
+        )
+        UPDATE %[1]s SET dispatched_at = NOW()
+        WHERE event_id IN (SELECT event_id FROM events)
+    `, WebhookOutboxTable, WebhookDeliveriesTable, WebhookSubscriptionsTable, WebhookDeliveryPending), limit)
+		if err != nil {
+			return fmt.Errorf("fan out webhook events: %w", err)
+		}
+		processed = int(tag.RowsAffected())
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return processed, nil
+}
+
+// ClaimDueDeliveries leases up to limit pending deliveries whose next attempt is due.
+// Claimed rows have their attempt counter bumped and next_attempt_at pushed out by lease,
+// so a crashed dispatcher only delays the delivery instead of losing it.
+func (s *WebhookStore) ClaimDueDeliveries(ctx context.Context, space tenant.Space, limit int, lease time.Duration) ([]ClaimedWebhookDelivery, error) {
+	var claimed []ClaimedWebhookDelivery
+	err := s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+        WITH due AS (
+            SELECT delivery_id
+            FROM %[1]s
+            WHERE status = '%[4]s' AND next_attempt_at <= NOW()
+            ORDER BY next_attempt_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        UPDATE %[1]s d
+        SET attempts = d.attempts + 1,
+            next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond',
+            updated_at = NOW()
+        FROM due, %[2]s s, %[3]s o
+        WHERE d.delivery_id = due.delivery_id
+          AND s.webhook_id = d.webhook_id
+          AND o.event_id = d.event_id
+        RETURNING d.delivery_id, d.attempts, s.webhook_id, s.url, s.secret,
+                  o.event_id, o.event_type, o.table_name, o.entity_id, o.entity_version, o.payload, o.occurred_at
+    `, WebhookDeliveriesTable, WebhookSubscriptionsTable, WebhookOutboxTable, WebhookDeliveryPending), limit, lease.Milliseconds())
+		if err != nil {
+			return fmt.Errorf("claim webhook deliveries: %w", err)
+		}
+		defer rows.Close()
+
+		claimed = make([]ClaimedWebhookDelivery, 0)
+		for rows.Next() {
+			var (
+				c       ClaimedWebhookDelivery
+				version *string
+				payload []byte
+			)
+			if err := rows.Scan(&c.DeliveryID, &c.Attempt, &c.WebhookID, &c.URL, &c.Secret,
+				&c.EventID, &c.Event.Type, &c.Event.TableName, &c.Event.EntityID, &version, &payload, &c.Event.OccurredAt); err != nil {
+				return fmt.Errorf("scan claimed delivery: %w", err)
+			}
+			if version != nil {
+				c.Event.EntityVersion = *version
+			}
+			if len(payload) > 0 {
+				c.Event.Payload = json.RawMessage(payload)
+			}
+			claimed = append(claimed, c)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// CompleteDelivery stores the outcome of a delivery attempt.
+func (s *WebhookStore) CompleteDelivery(ctx context.Context, space tenant.Space, deliveryID uuid.UUID, outcome WebhookDeliveryOutcome) error {
+	status := WebhookDeliveryFailed
+	nextAttemptAt := time.Now().UTC()
+	switch {
+	case outcome.Succeeded:
+		status = WebhookDeliverySucceeded
+	case outcome.RetryAt != nil:
+		status = WebhookDeliveryPending
+		nextAttemptAt = *outcome.RetryAt
+	}
+
+	var (
+		durationMS      *int64
+		requestHeaders  map[string]string
+		requestBody     []byte
+		responseHeaders map[string]string
+		responseBody    []byte
+	)
+	if exchange := outcome.Exchange; exchange != nil {
+		ms := exchange.Duration.Milliseconds()
+		durationMS = &ms
+		requestHeaders, requestBody = exchange.RequestHeaders, exchange.RequestBody
+		responseHeaders, responseBody = exchange.ResponseHeaders, exchange.ResponseBody
+	}
+
+	return s.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, fmt.Sprintf(`
+        UPDATE %s
+        SET status = $2, next_attempt_at = $3, last_status_code = $4, last_error = $5, last_attempt_at = NOW(),
+            last_duration_ms = $6, request_headers = $7, request_body = $8, response_headers = $9, response_body = $10,
+            updated_at = NOW()
+        WHERE delivery_id = $1
+    `, WebhookDeliveriesTable), deliveryID, status, nextAttemptAt, outcome.StatusCode, outcome.Error,
+			durationMS, requestHeaders, requestBody, responseHeaders, responseBody)
+		if err != nil {
+			return fmt.Errorf("complete webhook delivery: %w", err)
+		}
+		return nil
+	})
+}
+
+const webhookDeliveryColumns = `d.delivery_id, d.webhook_id, d.event_id, o.event_type, o.table_name, o.entity_id,
+               d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error, d.created_at, d.updated_at`
+
+// webhookDeliveryDest returns the scan destinations matching webhookDeliveryColumns.
+func webhookDeliveryDest(d *WebhookDelivery) []any {
+	return []any{&d.DeliveryID, &d.WebhookID, &d.EventID, &d.EventType, &d.TableName, &d.EntityID,
+		&d.Status, &d.Attempts, &d.NextAttemptAt, &d.LastStatusCode, &d.LastError, &d.CreatedAt, &d.UpdatedAt}
+}
+
+func requireWebhookSubscription(ctx context.Context, tx pgx.Tx, webhookID uuid.UUID) error {
+	var exists bool
+	if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE webhook_id = $1)`, WebhookSubscriptionsTable), webhookID).Scan(&exists); err != nil {
+		return fmt.Errorf("check webhook existence: %w", err)
+	}
+	if !exists {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func scanWebhookSubscription(row pgx.Row) (WebhookSubscription, error) {
+	var subscription WebhookSubscription
+
+	if err := row.Scan(
+		&subscription.WebhookID,
+		&subscription.URL,
+		&subscription.EventTypes,
+		&subscription.Secret,
+		&subscription.Description,
+		&subscription.CreatedAt,
+		&subscription.CreatedBy,
+	); err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
@@ -0,0 +1,158 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+func TestWebhookStoreDeliveryManagement(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping webhook store integration test in short mode")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	pgContainer, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("palmyra"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp").WithStartupTimeout(2*time.Minute)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pgContainer.Terminate(context.Background())
+	})
+
+	adminSchema := "tenant_admin"
+	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	connString = fmt.Sprintf("%s&search_path=%s", connString, adminSchema)
+
+	pool, err := NewPool(ctx, PoolConfig{ConnString: connString})
+	require.NoError(t, err)
+	t.Cleanup(func() { ClosePool(pool) })
+
+	schema := tenant.BuildSchemaName("dev", "acme_co")
+	role := schema + "_role"
+	for _, stmt := range []string{
+		`CREATE SCHEMA ` + adminSchema,
+		`CREATE SCHEMA ` + schema,
+		`CREATE ROLE ` + role + ` NOLOGIN`,
+		`GRANT ` + role + ` TO CURRENT_USER`,
+		`ALTER SCHEMA ` + schema + ` OWNER TO ` + role,
+	} {
+		_, err := pool.Exec(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	space := tenant.Space{
+		TenantID:   uuid.New(),
+		Slug:       "acme_co",
+		SchemaName: schema,
+		RoleName:   role,
+		BasePrefix: "dev/acme-12345678/",
+	}
+	_, err = NewMigrator(pool).MigrateTenant(ctx, space)
+	require.NoError(t, err)
+
+	spaceDB := NewSpaceDB(SpaceDBConfig{Pool: pool, AdminSchema: adminSchema})
+	store, err := NewWebhookStore(ctx, spaceDB)
+	require.NoError(t, err)
+
+	subscription, err := store.CreateSubscription(ctx, space, CreateWebhookParams{
+		WebhookID:  uuid.New(),
+		URL:        "https://example.com/hook",
+		EventTypes: []string{EntityEventCreated},
+		Secret:     "whsec_old",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, spaceDB.WithTenant(ctx, space, func(tx pgx.Tx) error {
+		return store.RecordEntityEvents(ctx, tx, []EntityEvent{{Type: EntityEventCreated, TableName: "cards_entities", EntityID: "card-1"}})
+	}))
+	_, err = store.FanOutEvents(ctx, space, 10)
+	require.NoError(t, err)
+	claimed, err := store.ClaimDueDeliveries(ctx, space, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	deliveryID := claimed[0].DeliveryID
+
+	// Still pending: nothing to inspect yet and nothing to redeliver.
+	detail, err := store.GetDelivery(ctx, space, subscription.WebhookID, deliveryID)
+	require.NoError(t, err)
+	require.Nil(t, detail.Exchange)
+	_, err = store.RedeliverDelivery(ctx, space, subscription.WebhookID, deliveryID, nil)
+	require.ErrorIs(t, err, ErrWebhookDeliveryPending)
+
+	status := 500
+	message := "endpoint responded with status 500"
+	require.NoError(t, store.CompleteDelivery(ctx, space, deliveryID, WebhookDeliveryOutcome{
+		StatusCode: &status,
+		Error:      &message,
+		Exchange: &WebhookExchange{
+			RequestHeaders:  map[string]string{"X-Palmyra-Event": EntityEventCreated},
+			RequestBody:     []byte(`{"type":"entity.created"}`),
+			ResponseHeaders: map[string]string{"Content-Type": "text/plain"},
+			ResponseBody:    []byte("boom"),
+			Duration:        120 * time.Millisecond,
+		},
+	}))
+
+	failed, total, err := store.ListDeliveries(ctx, space, subscription.WebhookID, WebhookDeliveryFailed, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, deliveryID, failed[0].DeliveryID)
+	_, total, err = store.ListDeliveries(ctx, space, subscription.WebhookID, WebhookDeliveryPending, 10, 0)
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	detail, err = store.GetDelivery(ctx, space, subscription.WebhookID, deliveryID)
+	require.NoError(t, err)
+	require.Equal(t, WebhookDeliveryFailed, detail.Status)
+	require.NotNil(t, detail.LastAttemptAt)
+	require.Equal(t, EntityEventCreated, detail.Exchange.RequestHeaders["X-Palmyra-Event"])
+	require.Equal(t, "boom", string(detail.Exchange.ResponseBody))
+	require.Equal(t, 120*time.Millisecond, detail.Exchange.Duration)
+
+	_, err = store.GetDelivery(ctx, space, subscription.WebhookID, uuid.New())
+	require.ErrorIs(t, err, ErrWebhookDeliveryNotFound)
+	_, err = store.GetDelivery(ctx, space, uuid.New(), deliveryID)
+	require.ErrorIs(t, err, ErrWebhookNotFound)
+
+	requestedBy := "user-1"
+	redelivered, err := store.RedeliverDelivery(ctx, space, subscription.WebhookID, deliveryID, &requestedBy)
+	require.NoError(t, err)
+	require.Equal(t, WebhookDeliveryPending, redelivered.Status)
+	require.Zero(t, redelivered.Attempts)
+
+	rotated, err := store.RotateSubscriptionSecret(ctx, space, subscription.WebhookID, "whsec_new")
+	require.NoError(t, err)
+	require.Equal(t, "whsec_new", rotated.Secret)
+	_, err = store.RotateSubscriptionSecret(ctx, space, uuid.New(), "whsec_new")
+	require.ErrorIs(t, err, ErrWebhookNotFound)
+
+	claimed, err = store.ClaimDueDeliveries(ctx, space, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, 1, claimed[0].Attempt)
+	require.Equal(t, "whsec_new", claimed[0].Secret)
+
+	detail, err = store.GetDelivery(ctx, space, subscription.WebhookID, deliveryID)
+	require.NoError(t, err)
+	require.Equal(t, requestedBy, *detail.RedeliveredBy)
+	require.NotNil(t, detail.Exchange, "the previous exchange is kept until the next attempt completes")
+}
@@ -0,0 +1,101 @@
+package problems
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	problemdetails "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/errorreporting"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+)
+
+// Catalog maps a domain's errors to problems. Rules are tried in registration order; unmatched errors become
+// Internal.
+type Catalog struct {
+	domain string
+	rules  []func(error) (Problem, bool)
+}
+
+// NewCatalog returns an empty catalog; domain prefixes its log messages.
+func NewCatalog(domain string) *Catalog {
+	return &Catalog{domain: domain}
+}
+
+// Register maps errors matching target (errors.Is) to p.
+func (c *Catalog) Register(target error, p Problem) *Catalog {
+	c.rules = append(c.rules, func(err error) (Problem, bool) {
+		return p, errors.Is(err, target)
+	})
+	return c
+}
+
+// RegisterDetail maps errors matching target to p, using the error message as the detail.
+func (c *Catalog) RegisterDetail(target error, p Problem) *Catalog {
+	c.rules = append(c.rules, func(err error) (Problem, bool) {
+		if !errors.Is(err, target) {
+			return Problem{}, false
+		}
+		return p.WithDetail(err.Error()), true
+	})
+	return c
+}
+
+// RegisterAs maps errors of type E (errors.As) to the problem built from them. It is a function because methods
+// cannot take type parameters.
+func RegisterAs[E error](c *Catalog, build func(E) Problem) *Catalog {
+	c.rules = append(c.rules, func(err error) (Problem, bool) {
+		var target E
+		if !errors.As(err, &target) {
+			return Problem{}, false
+		}
+		return build(target), true
+	})
+	return c
+}
+
+// Problem returns the problem registered for err, or Internal.
+func (c *Catalog) Problem(err error) Problem {
+	for _, rule := range c.rules {
+		if p, ok := rule(err); ok {
+			return p
+		}
+	}
+	return Internal()
+}
+
+// Resolve maps err, logs it at a level matching the status and returns what the strict handlers need: server
+// errors log at error, 404s at info and other rejections, 501 included, at warn. The request logger in ctx is preferred over
+// fallback. Server errors are also sent to the error reporter in ctx, if any (see errorreporting.Middleware).
+func (c *Catalog) Resolve(ctx context.Context, fallback *zap.Logger, err error, operation string) (int, problemdetails.ProblemDetails) {
+	p := c.Problem(err)
+	c.log(ctx, fallback, p.Status, err, operation)
+	return p.Status, p.Details()
+}
+
+func (c *Catalog) log(ctx context.Context, fallback *zap.Logger, status int, err error, operation string) {
+	serverError := status >= http.StatusInternalServerError && status != http.StatusNotImplemented
+	if serverError {
+		errorreporting.Report(ctx, err, status, c.domain, operation)
+	}
+
+	logger := platformlogging.FromContextOr(ctx, fallback)
+	if logger == nil {
+		return
+	}
+
+	fields := []zap.Field{zap.Int("status", status), zap.Error(err)}
+	if operation != "" {
+		fields = append(fields, zap.String("operation", operation))
+	}
+	switch {
+	case serverError:
+		logger.Error(c.domain+" operation failed", fields...)
+	case status == http.StatusNotFound:
+		logger.Info(c.domain+" resource not found", fields...)
+	default:
+		logger.Warn(c.domain+" request rejected", fields...)
+	}
+}
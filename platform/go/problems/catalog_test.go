@@ -0,0 +1,52 @@
+package problems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var errMissing = errors.New("widget not found")
+
+type fieldsError struct{ fields map[string][]string }
+
+func (e *fieldsError) Error() string { return "invalid widget" }
+
+func TestCatalogResolve(t *testing.T) {
+	t.Parallel()
+
+	catalog := NewCatalog("widgets").
+		Register(errMissing, NotFound("widget not found"))
+	RegisterAs(catalog, func(err *fieldsError) Problem {
+		return Validation(err.Error(), err.fields)
+	})
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+
+	status, problem := catalog.Resolve(context.Background(), logger, fmt.Errorf("load: %w", errMissing), "widgetsGet")
+	require.Equal(t, http.StatusNotFound, status)
+	require.Equal(t, TypeNotFound, *problem.Type)
+
+	status, problem = catalog.Resolve(context.Background(), logger, &fieldsError{fields: map[string][]string{"name": {"required"}}}, "widgetsCreate")
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, []string{"required"}, (*problem.Errors)["name"])
+
+	status, problem = catalog.Resolve(context.Background(), logger, errors.New("connection refused"), "widgetsList")
+	require.Equal(t, http.StatusInternalServerError, status)
+	require.Equal(t, "an unexpected error occurred", *problem.Detail)
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 3)
+	require.Equal(t, zapcore.InfoLevel, entries[0].Level)
+	require.Equal(t, zapcore.WarnLevel, entries[1].Level)
+	require.Equal(t, zapcore.ErrorLevel, entries[2].Level)
+	require.Equal(t, "widgets operation failed", entries[2].Message)
+}
@@ -0,0 +1,153 @@
+// Package problems builds RFC 7807 problem details for the HTTP handlers. It owns the problem type URIs, typed
+// constructors for the common cases and a per-domain Catalog mapping service errors to problems.
+package problems
+
+import (
+	"encoding/json"
+	"net/http"
+
+	problemdetails "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// Problem type URIs. New types are added here so every domain answers with the same URI for the same problem.
+const (
+	TypeValidation         = "https://palmyra.pro/problems/validation-error"
+	TypeAuth               = "https://palmyra.pro/problems/auth"
+	TypeForbidden          = "https://palmyra.pro/problems/forbidden"
+	TypeNotFound           = "https://palmyra.pro/problems/not-found"
+	TypeConflict           = "https://palmyra.pro/problems/conflict"
+	TypeGone               = "https://palmyra.pro/problems/gone"
+	TypePrecondition       = "https://palmyra.pro/problems/precondition-failed"
+	TypePayloadTooLarge    = "https://palmyra.pro/problems/payload-too-large"
+	TypeInvalidEncoding    = "https://palmyra.pro/problems/invalid-content-encoding"
+	TypeQuota              = "https://palmyra.pro/problems/quota-exceeded"
+	TypeTenantSuspended    = "https://palmyra.pro/problems/tenant-suspended"
+	TypeMaintenance        = "https://palmyra.pro/problems/maintenance"
+	TypeIncompatibleSchema = "https://palmyra.pro/problems/incompatible-schema"
+	TypeInvitationExpired  = "https://palmyra.pro/problems/invitation-expired"
+	TypeDeliveryFailed     = "https://palmyra.pro/problems/delivery-failed"
+	TypeNotConfigured      = "https://palmyra.pro/problems/not-configured"
+	TypeNotImplemented     = "https://palmyra.pro/problems/not-implemented"
+	TypeInternal           = "https://palmyra.pro/problems/internal-error"
+)
+
+// FieldErrors maps a request field to its validation messages.
+type FieldErrors map[string][]string
+
+// Add appends a message for field.
+func (f FieldErrors) Add(field, message string) {
+	f[field] = append(f[field], message)
+}
+
+// Problem is one RFC 7807 problem, ready to be written with Details or Write.
+type Problem struct {
+	Status int
+	Title  string
+	Type   string
+	Detail string
+	Fields FieldErrors
+}
+
+// New builds a problem of any status and type.
+func New(status int, title, problemType, detail string) Problem {
+	return Problem{Status: status, Title: title, Type: problemType, Detail: detail}
+}
+
+// Validation is a 400 for a request with invalid fields; fields may be nil.
+func Validation(detail string, fields map[string][]string) Problem {
+	return Problem{Status: http.StatusBadRequest, Title: "Validation failed", Type: TypeValidation, Detail: detail, Fields: fields}
+}
+
+// BadRequest is a 400 for a request that cannot be processed as sent.
+func BadRequest(detail string) Problem {
+	return New(http.StatusBadRequest, "Invalid request", TypeValidation, detail)
+}
+
+// MissingBody is the 400 for operations called without their request body.
+func MissingBody() Problem {
+	return New(http.StatusBadRequest, "Invalid request body", TypeValidation, "request body is required")
+}
+
+// Unauthorized is a 401 for missing or unusable credentials.
+func Unauthorized(detail string) Problem {
+	return New(http.StatusUnauthorized, "Unauthorized", TypeAuth, detail)
+}
+
+// Forbidden is a 403 for callers lacking access.
+func Forbidden(detail string) Problem {
+	return New(http.StatusForbidden, "Forbidden", TypeForbidden, detail)
+}
+
+// NotFound is a 404.
+func NotFound(detail string) Problem {
+	return New(http.StatusNotFound, "Resource not found", TypeNotFound, detail)
+}
+
+// Conflict is a 409.
+func Conflict(detail string) Problem {
+	return New(http.StatusConflict, "Conflict", TypeConflict, detail)
+}
+
+// PreconditionFailed is a 412 for a failed If-Match.
+func PreconditionFailed(detail string) Problem {
+	return New(http.StatusPreconditionFailed, "Precondition failed", TypePrecondition, detail)
+}
+
+// QuotaExceeded is the 403 for writes over a tenant quota.
+func QuotaExceeded(detail string) Problem {
+	return New(http.StatusForbidden, "Quota exceeded", TypeQuota, detail)
+}
+
+// NotConfigured is a 503 for features the deployment has not enabled.
+func NotConfigured(title, detail string) Problem {
+	return New(http.StatusServiceUnavailable, title, TypeNotConfigured, detail)
+}
+
+// Internal is the 500 for unexpected errors; the cause is logged, never returned.
+func Internal() Problem {
+	return New(http.StatusInternalServerError, "Internal server error", TypeInternal, "an unexpected error occurred")
+}
+
+// WithDetail returns a copy of p with detail replaced.
+func (p Problem) WithDetail(detail string) Problem {
+	p.Detail = detail
+	return p
+}
+
+// WithFields returns a copy of p carrying per-field messages.
+func (p Problem) WithFields(fields map[string][]string) Problem {
+	p.Fields = fields
+	return p
+}
+
+// Details converts p to the generated contract type.
+func (p Problem) Details() problemdetails.ProblemDetails {
+	out := problemdetails.ProblemDetails{
+		Title:  p.Title,
+		Status: p.Status,
+	}
+	if p.Detail != "" {
+		detail := p.Detail
+		out.Detail = &detail
+	}
+	if p.Type != "" {
+		problemType := p.Type
+		out.Type = &problemType
+	}
+	if len(p.Fields) > 0 {
+		copied := make(map[string][]string, len(p.Fields))
+		for field, messages := range p.Fields {
+			copied[field] = append([]string(nil), messages...)
+		}
+		out.Errors = &copied
+	}
+	return out
+}
+
+// Write sends p as application/problem+json, for middleware outside the generated strict handlers.
+func Write(w http.ResponseWriter, p Problem) {
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p.Details())
+}
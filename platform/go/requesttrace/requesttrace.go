@@ -25,11 +25,14 @@ const (
 // AuditInfo captures request-scoped metadata needed for traceability and auditing.
 // UserID is optional; set only when ActorKind is user.
 // TenantID may be nil when the auth provider does not supply a tenant; RequestID is optional but encouraged.
+// ImpersonatorTenantID is set when an admin acts as another tenant: it holds the admin's own tenant, while UserID
+// stays the admin and TenantID becomes the impersonated tenant.
 type AuditInfo struct {
-	ActorKind ActorKind
-	UserID    *string
-	TenantID  *string
-	RequestID string
+	ActorKind            ActorKind
+	UserID               *string
+	TenantID             *string
+	ImpersonatorTenantID *string
+	RequestID            string
 }
 
 // IntoContext stores the AuditInfo in the provided context.
@@ -77,6 +80,16 @@ func FromCredentials(creds *platformauth.UserCredentials, requestID string) (Aud
 	}, nil
 }
 
+// AsTenant returns a copy of the audit info for the same actor acting as tenantID, keeping the actor's own tenant in
+// ImpersonatorTenantID. Impersonating twice keeps the original tenant.
+func (a AuditInfo) AsTenant(tenantID string) AuditInfo {
+	if a.ImpersonatorTenantID == nil {
+		a.ImpersonatorTenantID = a.TenantID
+	}
+	a.TenantID = &tenantID
+	return a
+}
+
 // Anonymous builds an AuditInfo for unauthenticated requests (e.g., signup) where no user ID exists yet.
 func Anonymous(requestID string) AuditInfo {
 	return AuditInfo{ActorKind: ActorKindAnonymous, RequestID: requestID}
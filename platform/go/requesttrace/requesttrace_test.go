@@ -41,6 +41,20 @@ func TestFromCredentialsMissingUser(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestAsTenantKeepsRealActor(t *testing.T) {
+	audit := AuditInfo{ActorKind: ActorKindUser, UserID: ptr("admin-1"), TenantID: ptr("admin-tenant"), RequestID: "req-1"}
+
+	impersonated := audit.AsTenant("tenant-2")
+	require.Equal(t, "admin-1", *impersonated.UserID)
+	require.Equal(t, "tenant-2", *impersonated.TenantID)
+	require.Equal(t, "admin-tenant", *impersonated.ImpersonatorTenantID)
+	require.Nil(t, audit.ImpersonatorTenantID)
+
+	again := impersonated.AsTenant("tenant-3")
+	require.Equal(t, "tenant-3", *again.TenantID)
+	require.Equal(t, "admin-tenant", *again.ImpersonatorTenantID)
+}
+
 func TestAnonymous(t *testing.T) {
 	audit := Anonymous("req-anon")
 	require.Equal(t, ActorKindAnonymous, audit.ActorKind)
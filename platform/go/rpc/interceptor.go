@@ -0,0 +1,166 @@
+// Package rpc serves the gRPC surface of the API. Calls run through the same HTTP middleware chain as REST
+// requests, so authentication, API keys, tenant resolution, suspension and permission checks behave identically,
+// and domain errors map to status codes through the problem each REST handler would answer with.
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+)
+
+// PathPrefix prefixes the full gRPC method name to form the path of the request the middleware chain sees.
+const PathPrefix = "/grpc"
+
+// Method declares how an RPC maps onto the HTTP pipeline.
+type Method struct {
+	// Read marks RPCs that only read; suspended tenants may still call them.
+	Read bool
+	// Scopes are the bearerAuth scopes the equivalent REST operation declares.
+	Scopes []string
+}
+
+// Config describes the pipeline every call goes through. Methods not listed are rejected, so exposing an RPC is an
+// explicit decision like adding an operation to a contract.
+type Config struct {
+	// Middleware runs in order around each call, as it would with chi's Use.
+	Middleware []func(http.Handler) http.Handler
+	// Authenticate enforces the method scopes like the REST request validator; nil skips the check.
+	Authenticate openapi3filter.AuthenticationFunc
+	// Methods maps full method names, such as "/palmyra.entities.v1.EntitiesService/GetDocument", to their settings.
+	Methods map[string]Method
+}
+
+// UnaryServerInterceptor runs each unary call inside the configured middleware chain. The call's metadata becomes
+// the request headers, so "authorization", "x-api-key" and the tenant switch headers work as they do over HTTP.
+// Reads are presented as GET and writes as POST. When a middleware answers instead of calling through, its HTTP
+// status and problem are translated into the call's error.
+func UnaryServerInterceptor(cfg Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		method, ok := cfg.Methods[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.Unimplemented, "method %s is not exposed", info.FullMethod)
+		}
+
+		httpMethod := http.MethodPost
+		if method.Read {
+			httpMethod = http.MethodGet
+		}
+		r, err := http.NewRequestWithContext(ctx, httpMethod, PathPrefix+info.FullMethod, http.NoBody)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		copyMetadata(ctx, r)
+
+		var (
+			resp    any
+			callErr error
+			called  bool
+		)
+		var next http.Handler = http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			called = true
+			if cfg.Authenticate != nil {
+				if err := cfg.Authenticate(r.Context(), &openapi3filter.AuthenticationInput{
+					RequestValidationInput: &openapi3filter.RequestValidationInput{Request: r},
+					SecuritySchemeName:     "bearerAuth",
+					Scopes:                 method.Scopes,
+				}); err != nil {
+					callErr = authError(err)
+					return
+				}
+			}
+			resp, callErr = handler(r.Context(), req)
+		})
+		for i := len(cfg.Middleware) - 1; i >= 0; i-- {
+			next = cfg.Middleware[i](next)
+		}
+
+		recorder := &responseRecorder{header: http.Header{}}
+		next.ServeHTTP(recorder, r)
+		if !called {
+			return nil, recorder.err()
+		}
+		return resp, callErr
+	}
+}
+
+func copyMetadata(ctx context.Context, r *http.Request) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for key, values := range md {
+			if strings.HasPrefix(key, ":") || strings.HasPrefix(key, "grpc-") || strings.HasSuffix(key, "-bin") {
+				continue
+			}
+			for _, value := range values {
+				r.Header.Add(key, value)
+			}
+		}
+		if authority := md.Get(":authority"); len(authority) > 0 {
+			r.Host = authority[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.RemoteAddr = p.Addr.String()
+	}
+}
+
+func authError(err error) error {
+	if errors.Is(err, platformauth.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Unauthenticated, err.Error())
+}
+
+// responseRecorder keeps what a middleware answered when it stopped the call.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseRecorder) Header() http.Header { return w.header }
+
+func (w *responseRecorder) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *responseRecorder) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+func (w *responseRecorder) err() error {
+	code := CodeFromHTTPStatus(w.status)
+	if w.status == 0 {
+		code = codes.Internal
+	}
+
+	message := strings.TrimSpace(w.body.String())
+	var problem struct {
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	}
+	if json.Unmarshal(w.body.Bytes(), &problem) == nil {
+		message = problem.Detail
+		if message == "" {
+			message = problem.Title
+		}
+	}
+	if message == "" {
+		message = http.StatusText(w.status)
+	}
+	return status.Error(code, message)
+}
@@ -0,0 +1,138 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+)
+
+const testMethod = "/palmyra.test.v1.TestService/Get"
+
+type contextKey struct{}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs the handler inside the middleware chain", func(t *testing.T) {
+		var seen *http.Request
+		interceptor := UnaryServerInterceptor(Config{
+			Middleware: []func(http.Handler) http.Handler{func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					seen = r
+					next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey{}, "tenant-a")))
+				})
+			}},
+			Methods: map[string]Method{testMethod: {Read: true}},
+		})
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"authorization", "Bearer token",
+			"x-tenant-id", "acme",
+			"grpc-accept-encoding", "gzip",
+		))
+		resp, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: testMethod}, func(ctx context.Context, req any) (any, error) {
+			return ctx.Value(contextKey{}), nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "tenant-a", resp)
+		require.Equal(t, http.MethodGet, seen.Method)
+		require.Equal(t, PathPrefix+testMethod, seen.URL.Path)
+		require.Equal(t, "Bearer token", seen.Header.Get("Authorization"))
+		require.Equal(t, "acme", seen.Header.Get("X-Tenant-Id"))
+		require.Empty(t, seen.Header.Get("Grpc-Accept-Encoding"))
+	})
+
+	t.Run("writes are presented as POST", func(t *testing.T) {
+		var method string
+		interceptor := UnaryServerInterceptor(Config{
+			Middleware: []func(http.Handler) http.Handler{func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					method = r.Method
+					next.ServeHTTP(w, r)
+				})
+			}},
+			Methods: map[string]Method{testMethod: {}},
+		})
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, okHandler)
+		require.NoError(t, err)
+		require.Equal(t, http.MethodPost, method)
+	})
+
+	t.Run("rejects methods that are not exposed", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(Config{})
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, okHandler)
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("translates a middleware answer into a status", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(Config{
+			Middleware: []func(http.Handler) http.Handler{func(http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/problem+json")
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"title":"Forbidden","status":403,"detail":"tenant is suspended"}`))
+				})
+			}},
+			Methods: map[string]Method{testMethod: {}},
+		})
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, func(context.Context, any) (any, error) {
+			t.Fatal("handler must not run")
+			return nil, nil
+		})
+		st := status.Convert(err)
+		require.Equal(t, codes.PermissionDenied, st.Code())
+		require.Equal(t, "tenant is suspended", st.Message())
+	})
+
+	t.Run("enforces method scopes", func(t *testing.T) {
+		var scopes []string
+		interceptor := UnaryServerInterceptor(Config{
+			Authenticate: func(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+				scopes = input.Scopes
+				return fmt.Errorf("missing scope: %w", platformauth.ErrPermissionDenied)
+			},
+			Methods: map[string]Method{testMethod: {Scopes: []string{"admin"}}},
+		})
+
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, okHandler)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Equal(t, []string{"admin"}, scopes)
+	})
+}
+
+func TestFromProblem(t *testing.T) {
+	t.Parallel()
+
+	detail := "validation failed"
+	errs := map[string][]string{"tableName": {"is required"}, "entityId": {"must be a UUID"}}
+	err := FromProblem(http.StatusBadRequest, problemdetails.ProblemDetails{Title: "Bad Request", Detail: &detail, Errors: &errs})
+
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, "validation failed", st.Message())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Equal(t, "entityId", badRequest.GetFieldViolations()[0].GetField())
+	require.Equal(t, "tableName", badRequest.GetFieldViolations()[1].GetField())
+
+	require.Equal(t, codes.NotFound, status.Code(FromProblem(http.StatusNotFound, problemdetails.ProblemDetails{Title: "Not Found"})))
+}
+
+func okHandler(context.Context, any) (any, error) { return "ok", nil }
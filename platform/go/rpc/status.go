@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"net/http"
+	"sort"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
+)
+
+// CodeFromHTTPStatus returns the gRPC code closest to an HTTP status.
+func CodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		return codes.OK
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.FailedPrecondition
+}
+
+// FromProblem converts the problem a REST handler would answer with into a gRPC status error. Field errors are
+// attached as a google.rpc.BadRequest detail.
+func FromProblem(httpStatus int, problem problemdetails.ProblemDetails) error {
+	message := problem.Title
+	if problem.Detail != nil && *problem.Detail != "" {
+		message = *problem.Detail
+	}
+	st := status.New(CodeFromHTTPStatus(httpStatus), message)
+	if problem.Errors == nil || len(*problem.Errors) == 0 {
+		return st.Err()
+	}
+
+	fields := make([]string, 0, len(*problem.Errors))
+	for field := range *problem.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range fields {
+		for _, description := range (*problem.Errors)[field] {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: description,
+			})
+		}
+	}
+	if detailed, err := st.WithDetails(badRequest); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
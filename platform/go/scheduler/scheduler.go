@@ -0,0 +1,262 @@
+// Package scheduler runs periodic platform tasks such as retention cleanup, usage metering and provisioning
+// reconciliation on cron-style schedules. Every process of an environment may run a Scheduler; a Postgres
+// advisory lock elects the one that actually runs the tasks, so each run happens once per environment. Runs are
+// recorded in the scheduled task store, which also carries manual run requests from the admin API.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// Store keeps the task bookkeeping. *persistence.ScheduledTaskStore implements it.
+type Store interface {
+	Register(ctx context.Context, name, schedule string, nextRunAt time.Time) (persistence.ScheduledTaskRecord, error)
+	List(ctx context.Context) ([]persistence.ScheduledTaskRecord, error)
+	MarkStarted(ctx context.Context, name string, startedAt time.Time) error
+	MarkFinished(ctx context.Context, name string, run persistence.ScheduledTaskRun) error
+}
+
+// Leader elects the process that runs the tasks. *persistence.AdvisoryLock implements it.
+type Leader interface {
+	// TryAcquire reports whether this process leads, taking leadership when it is free.
+	TryAcquire(ctx context.Context) (bool, error)
+	Release(ctx context.Context) error
+}
+
+// Task is a periodic task. Schedule is parsed with Parse.
+type Task struct {
+	Name     string
+	Schedule string
+	Run      func(ctx context.Context) error
+}
+
+// Config tunes the scheduler. Zero values fall back to defaults: due tasks are looked for every 30 seconds and a
+// run may take up to an hour.
+type Config struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+type registeredTask struct {
+	Task
+	schedule Schedule
+}
+
+// Scheduler runs the registered tasks when they are due or were requested, while it leads.
+type Scheduler struct {
+	store  Store
+	leader Leader
+	logger *zap.Logger
+	cfg    Config
+	now    func() time.Time
+
+	mu    sync.RWMutex
+	tasks map[string]registeredTask
+
+	// runMu serialises RunOnce. synced is set once the leader recorded the registered tasks in the store, and
+	// reset when leadership is lost.
+	runMu  sync.Mutex
+	synced bool
+}
+
+// New constructs a scheduler with defaults applied to cfg. Register tasks before calling Run.
+func New(store Store, leader Leader, logger *zap.Logger, cfg Config) *Scheduler {
+	if store == nil {
+		panic("scheduled task store is required")
+	}
+	if leader == nil {
+		panic("leader election is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = time.Hour
+	}
+
+	return &Scheduler{store: store, leader: leader, logger: logger, cfg: cfg, now: time.Now, tasks: make(map[string]registeredTask)}
+}
+
+// Register adds a task. It panics on invalid or duplicate tasks, which are programming errors.
+func (s *Scheduler) Register(task Task) {
+	if task.Name == "" {
+		panic("scheduled task name is required")
+	}
+	if task.Run == nil {
+		panic("scheduled task run function is required")
+	}
+	schedule, err := Parse(task.Schedule)
+	if err != nil {
+		panic(fmt.Sprintf("scheduled task %q: %v", task.Name, err))
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		panic(fmt.Sprintf("scheduled task %q: schedule %q never fires", task.Name, task.Schedule))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tasks[task.Name]; ok {
+		panic(fmt.Sprintf("scheduled task %q is already registered", task.Name))
+	}
+	s.tasks[task.Name] = registeredTask{Task: task, schedule: schedule}
+}
+
+// Tasks lists the registered task names in order.
+func (s *Scheduler) Tasks() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.tasks))
+	for name := range s.tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run looks for due tasks every Interval until ctx is cancelled, then gives leadership up.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	defer func() {
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		if err := s.leader.Release(releaseCtx); err != nil {
+			s.logger.Warn("release scheduler leadership failed", zap.Error(err))
+		}
+	}()
+
+	for {
+		if err := s.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Error("scheduler failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs the tasks that are due or were requested, one after the other, when this process leads. Other
+// processes return without doing anything.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	s.mu.RLock()
+	tasks := maps.Clone(s.tasks)
+	s.mu.RUnlock()
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	leading, err := s.leader.TryAcquire(ctx)
+	if err != nil {
+		s.synced = false
+		return fmt.Errorf("acquire scheduler leadership: %w", err)
+	}
+	if !leading {
+		s.synced = false
+		return nil
+	}
+
+	if !s.synced {
+		if err := s.sync(ctx, tasks); err != nil {
+			return err
+		}
+		s.synced = true
+	}
+
+	records, err := s.store.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rec := range records {
+		task, ok := tasks[rec.Name]
+		if !ok || (rec.TriggerRequestedAt == nil && s.now().Before(rec.NextRunAt)) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.run(ctx, task, rec.TriggerRequestedAt != nil)
+	}
+	return nil
+}
+
+// sync records the registered tasks and their schedules in the store.
+func (s *Scheduler) sync(ctx context.Context, tasks map[string]registeredTask) error {
+	now := s.now()
+	for _, task := range tasks {
+		if _, err := s.store.Register(ctx, task.Name, task.Schedule, task.schedule.Next(now)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run executes one run of task within the timeout and records its outcome. The bookkeeping outlives ctx, so runs
+// interrupted by shutdown are recorded as failed.
+func (s *Scheduler) run(ctx context.Context, task registeredTask, triggered bool) {
+	bookkeeping := context.WithoutCancel(ctx)
+	logger := s.logger.With(zap.String("task", task.Name), zap.Bool("triggered", triggered))
+
+	started := s.now().UTC()
+	if err := s.store.MarkStarted(bookkeeping, task.Name, started); err != nil {
+		logger.Error("record scheduled task start failed", zap.Error(err))
+		return
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	err := call(runCtx, task.Run)
+	cancel()
+
+	finished := s.now().UTC()
+	outcome := persistence.ScheduledTaskRun{
+		Status:     persistence.ScheduledTaskSucceeded,
+		FinishedAt: finished,
+		Duration:   finished.Sub(started),
+		NextRunAt:  task.schedule.Next(finished),
+	}
+	if err != nil {
+		msg := err.Error()
+		outcome.Status, outcome.Error = persistence.ScheduledTaskFailed, &msg
+		logger.Error("scheduled task failed", zap.Duration("duration", outcome.Duration), zap.Error(err))
+	} else {
+		logger.Info("scheduled task finished", zap.Duration("duration", outcome.Duration))
+	}
+	if err := s.store.MarkFinished(bookkeeping, task.Name, outcome); err != nil {
+		logger.Error("record scheduled task outcome failed", zap.Error(err))
+	}
+}
+
+// call runs fn, turning a panic into an error.
+func call(ctx context.Context, fn func(context.Context) error) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("scheduled task panicked: %v", recovered)
+		}
+	}()
+	return fn(ctx)
+}
+
+// compile-time assertions to ensure interface compliance
+var (
+	_ Store  = (*persistence.ScheduledTaskStore)(nil)
+	_ Leader = (*persistence.AdvisoryLock)(nil)
+)
@@ -0,0 +1,204 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+)
+
+// inMemoryStore is a minimal in-memory Store for tests.
+type inMemoryStore struct {
+	mu   sync.Mutex
+	data map[string]persistence.ScheduledTaskRecord
+}
+
+func newInMemoryStore() *inMemoryStore {
+	return &inMemoryStore{data: make(map[string]persistence.ScheduledTaskRecord)}
+}
+
+func (s *inMemoryStore) Register(_ context.Context, name, schedule string, nextRunAt time.Time) (persistence.ScheduledTaskRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.data[name]
+	if !ok || rec.Schedule != schedule {
+		rec.NextRunAt = nextRunAt
+	}
+	rec.Name, rec.Schedule = name, schedule
+	s.data[name] = rec
+	return rec, nil
+}
+
+func (s *inMemoryStore) List(context.Context) ([]persistence.ScheduledTaskRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]persistence.ScheduledTaskRecord, 0, len(s.data))
+	for _, rec := range s.data {
+		out = append(out, rec)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (s *inMemoryStore) MarkStarted(_ context.Context, name string, startedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.data[name]
+	if !ok {
+		return persistence.ErrScheduledTaskNotFound
+	}
+	status := persistence.ScheduledTaskRunning
+	rec.LastStatus, rec.LastStartedAt, rec.TriggerRequestedAt = &status, &startedAt, nil
+	s.data[name] = rec
+	return nil
+}
+
+func (s *inMemoryStore) MarkFinished(_ context.Context, name string, run persistence.ScheduledTaskRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.data[name]
+	if !ok {
+		return persistence.ErrScheduledTaskNotFound
+	}
+	rec.LastStatus, rec.LastError, rec.LastFinishedAt, rec.LastDuration = &run.Status, run.Error, &run.FinishedAt, &run.Duration
+	rec.NextRunAt = run.NextRunAt
+	s.data[name] = rec
+	return nil
+}
+
+func (s *inMemoryStore) get(name string) persistence.ScheduledTaskRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data[name]
+}
+
+func (s *inMemoryStore) trigger(name string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec := s.data[name]
+	rec.TriggerRequestedAt = &at
+	s.data[name] = rec
+}
+
+type fakeLeader struct {
+	leading  bool
+	released bool
+}
+
+func (l *fakeLeader) TryAcquire(context.Context) (bool, error) { return l.leading, nil }
+func (l *fakeLeader) Release(context.Context) error            { l.released = true; return nil }
+
+func TestSchedulerRunsDueTasksWhileLeading(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newInMemoryStore()
+	leader := &fakeLeader{}
+	s := New(store, leader, zap.NewNop(), Config{})
+	now := time.Date(2026, 1, 14, 2, 59, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	runs := 0
+	s.Register(Task{Name: "retention-cleanup", Schedule: "0 3 * * *", Run: func(context.Context) error {
+		runs++
+		return nil
+	}})
+
+	// Followers neither record nor run tasks.
+	require.NoError(t, s.RunOnce(ctx))
+	require.Empty(t, store.data)
+
+	leader.leading = true
+	require.NoError(t, s.RunOnce(ctx))
+	require.Equal(t, time.Date(2026, 1, 14, 3, 0, 0, 0, time.UTC), store.get("retention-cleanup").NextRunAt)
+	require.Zero(t, runs)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, s.RunOnce(ctx))
+	require.Equal(t, 1, runs)
+	rec := store.get("retention-cleanup")
+	require.Equal(t, persistence.ScheduledTaskSucceeded, *rec.LastStatus)
+	require.Equal(t, time.Date(2026, 1, 15, 3, 0, 0, 0, time.UTC), rec.NextRunAt)
+
+	// Not due again until tomorrow.
+	require.NoError(t, s.RunOnce(ctx))
+	require.Equal(t, 1, runs)
+}
+
+func TestSchedulerRunsTriggeredTasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newInMemoryStore()
+	s := New(store, &fakeLeader{leading: true}, zap.NewNop(), Config{})
+	now := time.Date(2026, 1, 14, 10, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	runs := 0
+	s.Register(Task{Name: "usage-metering", Schedule: "@daily", Run: func(context.Context) error {
+		runs++
+		return errors.New("meter unavailable")
+	}})
+	require.NoError(t, s.RunOnce(ctx))
+	require.Zero(t, runs)
+
+	store.trigger("usage-metering", now)
+	require.NoError(t, s.RunOnce(ctx))
+	require.Equal(t, 1, runs)
+	rec := store.get("usage-metering")
+	require.Nil(t, rec.TriggerRequestedAt)
+	require.Equal(t, persistence.ScheduledTaskFailed, *rec.LastStatus)
+	require.Equal(t, "meter unavailable", *rec.LastError)
+	require.Equal(t, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), rec.NextRunAt)
+}
+
+func TestSchedulerRecoversPanics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newInMemoryStore()
+	s := New(store, &fakeLeader{leading: true}, zap.NewNop(), Config{})
+	s.Register(Task{Name: "broken", Schedule: "@every 1m", Run: func(context.Context) error {
+		panic("nil map")
+	}})
+	require.NoError(t, s.RunOnce(ctx))
+
+	store.trigger("broken", time.Now())
+	require.NoError(t, s.RunOnce(ctx))
+	rec := store.get("broken")
+	require.Equal(t, persistence.ScheduledTaskFailed, *rec.LastStatus)
+	require.Contains(t, *rec.LastError, "panicked")
+}
+
+func TestSchedulerRunReleasesLeadership(t *testing.T) {
+	t.Parallel()
+
+	leader := &fakeLeader{leading: true}
+	s := New(newInMemoryStore(), leader, zap.NewNop(), Config{})
+	s.Register(Task{Name: "noop", Schedule: "@hourly", Run: func(context.Context) error { return nil }})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Run(ctx)
+	require.True(t, leader.released)
+}
+
+func TestSchedulerRegisterRejectsInvalidTasks(t *testing.T) {
+	t.Parallel()
+
+	s := New(newInMemoryStore(), &fakeLeader{}, zap.NewNop(), Config{})
+	run := func(context.Context) error { return nil }
+	s.Register(Task{Name: "retention-cleanup", Schedule: "@daily", Run: run})
+
+	require.Panics(t, func() { s.Register(Task{Name: "retention-cleanup", Schedule: "@daily", Run: run}) })
+	require.Panics(t, func() { s.Register(Task{Name: "bad", Schedule: "every day", Run: run}) })
+	require.Panics(t, func() { s.Register(Task{Name: "never", Schedule: "0 0 31 2 *", Run: run}) })
+	require.Equal(t, []string{"retention-cleanup"}, s.Tasks())
+}
@@ -0,0 +1,149 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceLister enumerates the tenant spaces whose search outboxes the indexer drains.
+type SpaceLister interface {
+	ListActiveSpaces(ctx context.Context) ([]tenant.Space, error)
+}
+
+// IndexerConfig tunes the indexer. Zero values fall back to defaults.
+type IndexerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Indexer applies the entity changes queued in every tenant's search outbox to the Index. Events are marked indexed
+// only after the index accepted them, and replaying an event is harmless, so indexing is at-least-once.
+type Indexer struct {
+	db     *persistence.SpaceDB
+	outbox *persistence.SearchOutboxStore
+	index  Index
+	spaces SpaceLister
+	logger *zap.Logger
+	cfg    IndexerConfig
+}
+
+// NewIndexer constructs an Indexer with defaults applied to cfg.
+func NewIndexer(db *persistence.SpaceDB, outbox *persistence.SearchOutboxStore, index Index, spaces SpaceLister, logger *zap.Logger, cfg IndexerConfig) *Indexer {
+	if db == nil {
+		panic("space db is required")
+	}
+	if outbox == nil {
+		panic("search outbox store is required")
+	}
+	if index == nil {
+		panic("search index is required")
+	}
+	if spaces == nil {
+		panic("space lister is required")
+	}
+	if logger == nil {
+		panic("logger is required")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 200
+	}
+	return &Indexer{db: db, outbox: outbox, index: index, spaces: spaces, logger: logger, cfg: cfg}
+}
+
+// Run drains the search outboxes every Interval until ctx is cancelled.
+func (i *Indexer) Run(ctx context.Context) {
+	ticker := time.NewTicker(i.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			i.logger.Error("search indexer failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce drains one batch from every active tenant's search outbox. Failures in one tenant are logged and do not
+// stop the others.
+func (i *Indexer) RunOnce(ctx context.Context) error {
+	spaces, err := i.spaces.ListActiveSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("list tenant spaces: %w", err)
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := i.db.WithTenant(ctx, space, func(tx pgx.Tx) error {
+			return i.drain(ctx, tx, space)
+		}); err != nil {
+			i.logger.Error("search indexer for tenant failed", zap.String("tenant", space.Slug), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// drain applies one batch of pending events. Once an event fails, later events of the same document are left
+// pending so an older version never overwrites a newer one.
+func (i *Indexer) drain(ctx context.Context, tx pgx.Tx, space tenant.Space) error {
+	pending, err := i.outbox.ClaimPending(ctx, tx, i.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	indexed := make([]uuid.UUID, 0, len(pending))
+	blocked := make(map[string]struct{})
+	for _, event := range pending {
+		key := event.Event.TableName + "/" + event.Event.EntityID
+		if _, ok := blocked[key]; ok {
+			continue
+		}
+
+		if err := i.apply(ctx, space, event.Event); err != nil {
+			blocked[key] = struct{}{}
+			i.logger.Warn("index search event failed",
+				zap.String("event_id", event.EventID.String()),
+				zap.String("tenant", space.Slug),
+				zap.String("table", event.Event.TableName),
+				zap.Error(err),
+			)
+			if markErr := i.outbox.MarkFailed(ctx, tx, event.EventID, err); markErr != nil {
+				return markErr
+			}
+			continue
+		}
+		indexed = append(indexed, event.EventID)
+	}
+
+	return i.outbox.MarkIndexed(ctx, tx, indexed)
+}
+
+func (i *Indexer) apply(ctx context.Context, space tenant.Space, event persistence.EntityEvent) error {
+	if event.Type == persistence.EntityEventDeleted {
+		return i.index.Delete(ctx, space, event.TableName, event.EntityID)
+	}
+	return i.index.Upsert(ctx, space, Document{
+		TableName:     event.TableName,
+		EntityID:      event.EntityID,
+		EntityVersion: event.EntityVersion,
+		Payload:       event.Payload,
+		CreatedAt:     event.OccurredAt,
+	})
+}
@@ -0,0 +1,259 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// OpenSearchConfig locates the cluster. IndexPrefix is prepended to the tenant schema name to form index names.
+type OpenSearchConfig struct {
+	URL         string
+	Username    string
+	Password    string
+	IndexPrefix string
+	HTTPClient  *http.Client
+}
+
+// OpenSearchIndex implements Index over the OpenSearch (or Elasticsearch) REST API. Each tenant gets its own index;
+// a document's payload is stored under payload.<table name>, so tables with clashing field types do not share
+// mappings. Strings are mapped as keyword, for exact filters, with a text sub-field for full-text search.
+type OpenSearchIndex struct {
+	baseURL  string
+	username string
+	password string
+	prefix   string
+	client   *http.Client
+
+	created sync.Map // index names known to exist
+}
+
+// NewOpenSearchIndex validates cfg and returns an index client.
+func NewOpenSearchIndex(cfg OpenSearchConfig) (*OpenSearchIndex, error) {
+	parsed, err := url.Parse(strings.TrimSpace(cfg.URL))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid opensearch url %q", cfg.URL)
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &OpenSearchIndex{
+		baseURL:  strings.TrimSuffix(parsed.String(), "/"),
+		username: cfg.Username,
+		password: cfg.Password,
+		prefix:   cfg.IndexPrefix,
+		client:   client,
+	}, nil
+}
+
+// IndexName returns the index holding the documents of space.
+func (o *OpenSearchIndex) IndexName(space tenant.Space) string {
+	return strings.ToLower(o.prefix + space.SchemaName)
+}
+
+// indexSettings is applied when a tenant index is created. Malformed values (e.g. a string in a numeric field) are
+// left unindexed instead of rejecting the whole document.
+var indexSettings = map[string]any{
+	"settings": map[string]any{
+		"index": map[string]any{"mapping": map[string]any{"ignore_malformed": true}},
+	},
+	"mappings": map[string]any{
+		"dynamic_templates": []any{
+			map[string]any{"strings": map[string]any{
+				"match_mapping_type": "string",
+				"mapping": map[string]any{
+					"type":         "keyword",
+					"ignore_above": 1024,
+					"fields":       map[string]any{"text": map[string]any{"type": "text"}},
+				},
+			}},
+		},
+		"properties": map[string]any{
+			"tableName":     map[string]any{"type": "keyword"},
+			"entityId":      map[string]any{"type": "keyword"},
+			"entityVersion": map[string]any{"type": "keyword"},
+			"createdAt":     map[string]any{"type": "date"},
+		},
+	},
+}
+
+// Upsert implements Index.
+func (o *OpenSearchIndex) Upsert(ctx context.Context, space tenant.Space, doc Document) error {
+	index := o.IndexName(space)
+	if err := o.ensureIndex(ctx, index); err != nil {
+		return err
+	}
+
+	payload := doc.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+	body := map[string]any{
+		"tableName":     doc.TableName,
+		"entityId":      doc.EntityID,
+		"entityVersion": doc.EntityVersion,
+		"createdAt":     doc.CreatedAt.UTC().Format(time.RFC3339Nano),
+		"payload":       map[string]json.RawMessage{doc.TableName: payload},
+	}
+	_, err := o.do(ctx, http.MethodPut, "/"+index+"/_doc/"+documentID(doc.TableName, doc.EntityID), body)
+	if err != nil {
+		return fmt.Errorf("index document %s/%s: %w", doc.TableName, doc.EntityID, err)
+	}
+	return nil
+}
+
+// Delete implements Index. Deleting a document that was never indexed is not an error.
+func (o *OpenSearchIndex) Delete(ctx context.Context, space tenant.Space, tableName, entityID string) error {
+	_, err := o.do(ctx, http.MethodDelete, "/"+o.IndexName(space)+"/_doc/"+documentID(tableName, entityID), nil)
+	if err != nil && !errors.Is(err, errNotFound) {
+		return fmt.Errorf("delete document %s/%s: %w", tableName, entityID, err)
+	}
+	return nil
+}
+
+// Search implements Index. A tenant without an index yet has no matches.
+func (o *OpenSearchIndex) Search(ctx context.Context, space tenant.Space, query Query) (Result, error) {
+	field := "payload." + query.TableName
+	filters := []any{map[string]any{"term": map[string]any{"tableName": query.TableName}}}
+	for _, filter := range query.Filters {
+		filters = append(filters, map[string]any{
+			"term": map[string]any{field + "." + strings.Join(filter.Path, "."): filter.Value},
+		})
+	}
+	boolQuery := map[string]any{"filter": filters}
+	if text := strings.TrimSpace(query.Text); text != "" {
+		boolQuery["must"] = []any{map[string]any{"simple_query_string": map[string]any{
+			"query":            text,
+			"fields":           []string{field + ".*"},
+			"default_operator": "and",
+			"lenient":          true,
+		}}}
+	}
+
+	body := map[string]any{
+		"from":             query.From,
+		"size":             query.Size,
+		"track_total_hits": true,
+		"_source":          []string{"entityId"},
+		"query":            map[string]any{"bool": boolQuery},
+		"sort": []any{
+			"_score",
+			map[string]any{"createdAt": map[string]any{"order": "desc"}},
+			map[string]any{"entityId": map[string]any{"order": "desc"}},
+		},
+	}
+
+	raw, err := o.do(ctx, http.MethodPost, "/"+o.IndexName(space)+"/_search", body)
+	if errors.Is(err, errNotFound) {
+		return Result{EntityIDs: []string{}}, nil
+	}
+	if err != nil {
+		return Result{}, fmt.Errorf("search documents: %w", err)
+	}
+
+	var response struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source struct {
+					EntityID string `json:"entityId"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return Result{}, fmt.Errorf("decode search response: %w", err)
+	}
+
+	result := Result{EntityIDs: make([]string, 0, len(response.Hits.Hits)), Total: response.Hits.Total.Value}
+	for _, hit := range response.Hits.Hits {
+		result.EntityIDs = append(result.EntityIDs, hit.Source.EntityID)
+	}
+	return result, nil
+}
+
+// ensureIndex creates a tenant index with indexSettings the first time it is written to.
+func (o *OpenSearchIndex) ensureIndex(ctx context.Context, index string) error {
+	if _, ok := o.created.Load(index); ok {
+		return nil
+	}
+	_, err := o.do(ctx, http.MethodPut, "/"+index, indexSettings)
+	if err != nil && !errors.Is(err, errIndexExists) {
+		return fmt.Errorf("create index %s: %w", index, err)
+	}
+	o.created.Store(index, struct{}{})
+	return nil
+}
+
+var (
+	errNotFound    = errors.New("not found")
+	errIndexExists = errors.New("index already exists")
+)
+
+func (o *OpenSearchIndex) do(ctx context.Context, method, path string, body any) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encode request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, o.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if o.username != "" {
+		req.SetBasicAuth(o.username, o.password)
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	switch {
+	case resp.StatusCode < 300:
+		return raw, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errNotFound
+	case resp.StatusCode == http.StatusBadRequest && bytes.Contains(raw, []byte("resource_already_exists_exception")):
+		return nil, errIndexExists
+	default:
+		return nil, fmt.Errorf("opensearch returned %d: %s", resp.StatusCode, truncate(raw, 512))
+	}
+}
+
+// documentID is the index document id of an entity, unique across the tenant's tables.
+func documentID(tableName, entityID string) string {
+	return url.PathEscape(tableName + ":" + entityID)
+}
+
+func truncate(raw []byte, limit int) string {
+	if len(raw) > limit {
+		return string(raw[:limit]) + "..."
+	}
+	return string(raw)
+}
@@ -0,0 +1,113 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+func newTestIndex(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*OpenSearchIndex, *[]recordedRequest) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		requests []recordedRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		recorded := recordedRequest{Method: r.Method, Path: r.URL.EscapedPath()}
+		if len(raw) > 0 {
+			require.NoError(t, json.Unmarshal(raw, &recorded.Body))
+		}
+		mu.Lock()
+		requests = append(requests, recorded)
+		mu.Unlock()
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	index, err := NewOpenSearchIndex(OpenSearchConfig{URL: server.URL + "/", IndexPrefix: "Palmyra-dev-"})
+	require.NoError(t, err)
+	return index, &requests
+}
+
+func TestOpenSearchIndexUpsertCreatesIndexOnce(t *testing.T) {
+	t.Parallel()
+
+	index, requests := newTestIndex(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	space := tenant.Space{SchemaName: "tenant_acme"}
+	doc := Document{
+		TableName:     "cards_entities",
+		EntityID:      "card/1",
+		EntityVersion: "1.0.0",
+		Payload:       json.RawMessage(`{"name":"Black Lotus"}`),
+		CreatedAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	require.NoError(t, index.Upsert(context.Background(), space, doc))
+	require.NoError(t, index.Upsert(context.Background(), space, doc))
+
+	require.Len(t, *requests, 3)
+	require.Equal(t, "/palmyra-dev-tenant_acme", (*requests)[0].Path)
+	require.Contains(t, (*requests)[0].Body, "mappings")
+	require.Equal(t, "/palmyra-dev-tenant_acme/_doc/cards_entities:card%2F1", (*requests)[1].Path)
+	require.Equal(t, map[string]any{"cards_entities": map[string]any{"name": "Black Lotus"}}, (*requests)[1].Body["payload"])
+	require.Equal(t, "2026-01-02T03:04:05Z", (*requests)[1].Body["createdAt"])
+}
+
+func TestOpenSearchIndexSearch(t *testing.T) {
+	t.Parallel()
+
+	index, requests := newTestIndex(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":7},"hits":[{"_source":{"entityId":"card-2"}},{"_source":{"entityId":"card-1"}}]}}`))
+	})
+
+	result, err := index.Search(context.Background(), tenant.Space{SchemaName: "tenant_acme"}, Query{
+		TableName: "cards_entities",
+		Text:      "lotus",
+		Filters:   []persistence.EntityFilter{{Path: []string{"rarity"}, Value: "mythic"}},
+		From:      20,
+		Size:      10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, Result{EntityIDs: []string{"card-2", "card-1"}, Total: 7}, result)
+
+	body := (*requests)[0].Body
+	require.EqualValues(t, 20, body["from"])
+	boolQuery := body["query"].(map[string]any)["bool"].(map[string]any)
+	require.Contains(t, boolQuery["filter"], map[string]any{"term": map[string]any{"payload.cards_entities.rarity": "mythic"}})
+	must := boolQuery["must"].([]any)[0].(map[string]any)["simple_query_string"].(map[string]any)
+	require.Equal(t, []any{"payload.cards_entities.*"}, must["fields"])
+}
+
+func TestOpenSearchIndexMissingIndex(t *testing.T) {
+	t.Parallel()
+
+	index, _ := newTestIndex(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	space := tenant.Space{SchemaName: "tenant_acme"}
+
+	result, err := index.Search(context.Background(), space, Query{TableName: "cards_entities", Size: 10})
+	require.NoError(t, err)
+	require.Zero(t, result.Total)
+	require.NoError(t, index.Delete(context.Background(), space, "cards_entities", "card-1"))
+}
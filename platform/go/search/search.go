@@ -0,0 +1,45 @@
+// Package search mirrors active entity documents into a per-tenant search index and queries it. Changes reach the
+// index through the search outbox (persistence.SearchOutboxStore) drained by an Indexer, so the index is eventually
+// consistent with Postgres, which stays the source of truth for the documents returned to callers.
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/persistence"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// Document is the active version of an entity as stored in the index.
+type Document struct {
+	TableName     string
+	EntityID      string
+	EntityVersion string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+}
+
+// Query searches the documents of one table. Text terms must all match (in any payload field); filters match
+// payload values exactly. Results are ordered by relevance, then newest first.
+type Query struct {
+	TableName string
+	Text      string
+	Filters   []persistence.EntityFilter
+	From      int
+	Size      int
+}
+
+// Result is a page of matching entity ids, in result order, with the total number of matches.
+type Result struct {
+	EntityIDs []string
+	Total     int64
+}
+
+// Index stores the documents of every tenant in a separate index.
+type Index interface {
+	Upsert(ctx context.Context, space tenant.Space, doc Document) error
+	Delete(ctx context.Context, space tenant.Space, tableName, entityID string) error
+	Search(ctx context.Context, space tenant.Space, query Query) (Result, error)
+}
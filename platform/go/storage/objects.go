@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// ObjectStore reads and writes whole objects by their full path in the environment bucket.
+type ObjectStore interface {
+	// NewWriter opens the object at fullPath for writing. The object is only stored once the writer is closed;
+	// cancelling ctx before Close discards it.
+	NewWriter(ctx context.Context, fullPath string) (io.WriteCloser, error)
+	// NewReader opens the object at fullPath for reading.
+	NewReader(ctx context.Context, fullPath string) (io.ReadCloser, error)
+}
+
+// GCSObjectStore stores objects in a GCS bucket.
+type GCSObjectStore struct {
+	client *storage.Client
+	bucket string
+}
+
+func NewGCSObjectStore(client *storage.Client, bucket string) *GCSObjectStore {
+	if client == nil {
+		panic("storage client is required")
+	}
+	if strings.TrimSpace(bucket) == "" {
+		panic("bucket is required")
+	}
+	return &GCSObjectStore{client: client, bucket: bucket}
+}
+
+func (s *GCSObjectStore) NewWriter(ctx context.Context, fullPath string) (io.WriteCloser, error) {
+	if fullPath == "" {
+		return nil, fmt.Errorf("object path is required")
+	}
+	return s.client.Bucket(s.bucket).Object(fullPath).NewWriter(ctx), nil
+}
+
+func (s *GCSObjectStore) NewReader(ctx context.Context, fullPath string) (io.ReadCloser, error) {
+	if fullPath == "" {
+		return nil, fmt.Errorf("object path is required")
+	}
+	r, err := s.client.Bucket(s.bucket).Object(fullPath).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("open object %s: %w", fullPath, err)
+	}
+	return r, nil
+}
+
+// Probe verifies the credentials can list the bucket, without reading or writing any object.
+func (s *GCSObjectStore) Probe(ctx context.Context) error {
+	query := &storage.Query{}
+	if err := query.SetAttrSelection([]string{"Name"}); err != nil {
+		return fmt.Errorf("select object attributes: %w", err)
+	}
+	_, err := s.client.Bucket(s.bucket).Objects(ctx, query).Next()
+	if err != nil && !errors.Is(err, iterator.Done) {
+		return fmt.Errorf("list bucket %s: %w", s.bucket, err)
+	}
+	return nil
+}
+
+// LocalObjectStore stores objects as files below BasePath, mirroring the local storage provisioner layout.
+type LocalObjectStore struct {
+	BasePath string
+}
+
+func NewLocalObjectStore(basePath string) *LocalObjectStore {
+	if basePath == "" {
+		panic("local object store requires basePath")
+	}
+	return &LocalObjectStore{BasePath: basePath}
+}
+
+func (s *LocalObjectStore) NewWriter(ctx context.Context, fullPath string) (io.WriteCloser, error) {
+	filePath, err := s.resolve(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return nil, fmt.Errorf("create object dir: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.partial")
+	if err != nil {
+		return nil, fmt.Errorf("create object %s: %w", fullPath, err)
+	}
+	return &localObjectWriter{ctx: ctx, file: f, target: filePath}, nil
+}
+
+func (s *LocalObjectStore) NewReader(_ context.Context, fullPath string) (io.ReadCloser, error) {
+	filePath, err := s.resolve(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open object %s: %w", fullPath, err)
+	}
+	return f, nil
+}
+
+// Probe verifies BasePath can be created and written, by writing and removing an empty file.
+func (s *LocalObjectStore) Probe(_ context.Context) error {
+	if err := os.MkdirAll(s.BasePath, 0o755); err != nil {
+		return fmt.Errorf("create base path: %w", err)
+	}
+	f, err := os.CreateTemp(s.BasePath, ".probe-*")
+	if err != nil {
+		return fmt.Errorf("write base path: %w", err)
+	}
+	_ = f.Close()
+	return os.Remove(f.Name())
+}
+
+// localObjectWriter writes to a temporary file that is renamed into place on Close, so readers never see a
+// partial object.
+type localObjectWriter struct {
+	ctx    context.Context
+	file   *os.File
+	target string
+}
+
+func (w *localObjectWriter) Write(p []byte) (int, error) {
+	return w.file.Write(p)
+}
+
+func (w *localObjectWriter) Close() error {
+	closeErr := w.file.Close()
+	if err := w.ctx.Err(); err != nil {
+		_ = os.Remove(w.file.Name())
+		return err
+	}
+	if closeErr != nil {
+		_ = os.Remove(w.file.Name())
+		return fmt.Errorf("close object: %w", closeErr)
+	}
+	if err := os.Rename(w.file.Name(), w.target); err != nil {
+		_ = os.Remove(w.file.Name())
+		return fmt.Errorf("store object: %w", err)
+	}
+	return nil
+}
+
+// resolve maps fullPath below BasePath, rejecting paths that would escape it.
+func (s *LocalObjectStore) resolve(fullPath string) (string, error) {
+	if fullPath == "" {
+		return "", fmt.Errorf("object path is required")
+	}
+	base := filepath.Clean(s.BasePath)
+	filePath := filepath.Join(base, fullPath)
+	if !strings.HasPrefix(filePath, base+string(filepath.Separator)) {
+		return "", fmt.Errorf("object path %q escapes base path", fullPath)
+	}
+	return filePath, nil
+}
+
+var (
+	_ ObjectStore = (*GCSObjectStore)(nil)
+	_ ObjectStore = (*LocalObjectStore)(nil)
+)
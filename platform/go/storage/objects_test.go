@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalObjectStoreRoundTrip(t *testing.T) {
+	store := NewLocalObjectStore(t.TempDir())
+	ctx := context.Background()
+
+	w, err := store.NewWriter(ctx, "dev/acme-co-12345678/exports/acme.tar.gz")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("archive"))
+	require.NoError(t, err)
+
+	// Nothing is visible until the writer is closed.
+	_, err = store.NewReader(ctx, "dev/acme-co-12345678/exports/acme.tar.gz")
+	require.Error(t, err)
+
+	require.NoError(t, w.Close())
+	r, err := store.NewReader(ctx, "dev/acme-co-12345678/exports/acme.tar.gz")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "archive", string(data))
+}
+
+func TestLocalObjectStoreDiscardsCancelledWrites(t *testing.T) {
+	base := t.TempDir()
+	store := NewLocalObjectStore(base)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	w, err := store.NewWriter(ctx, "dev/acme-co-12345678/exports/acme.tar.gz")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("partial"))
+	require.NoError(t, err)
+	cancel()
+	require.ErrorIs(t, w.Close(), context.Canceled)
+
+	entries, err := os.ReadDir(filepath.Join(base, "dev/acme-co-12345678/exports"))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestLocalObjectStoreRejectsEscapingPaths(t *testing.T) {
+	store := NewLocalObjectStore(t.TempDir())
+
+	_, err := store.NewWriter(context.Background(), "../outside.tar.gz")
+	require.Error(t, err)
+	_, err = store.NewReader(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestLocalObjectStoreMeasurePrefix(t *testing.T) {
+	store := NewLocalObjectStore(t.TempDir())
+	ctx := context.Background()
+
+	usage, err := store.MeasurePrefix(ctx, "dev/acme-co-12345678/")
+	require.NoError(t, err)
+	require.Equal(t, PrefixUsage{}, usage)
+
+	for path, body := range map[string]string{
+		"dev/acme-co-12345678/exports/acme.tar.gz":              "archive",
+		"dev/acme-co-12345678/entities/cards/attachments/a.png": "png",
+		"dev/other-co-87654321/exports/other.tar.gz":            "not counted",
+	} {
+		w, err := store.NewWriter(ctx, path)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+	pending, err := store.NewWriter(ctx, "dev/acme-co-12345678/exports/pending.tar.gz")
+	require.NoError(t, err)
+	_, err = pending.Write([]byte("in flight"))
+	require.NoError(t, err)
+
+	usage, err = store.MeasurePrefix(ctx, "dev/acme-co-12345678/")
+	require.NoError(t, err)
+	require.Equal(t, PrefixUsage{Objects: 2, Bytes: 10}, usage)
+	require.NoError(t, pending.Close())
+}
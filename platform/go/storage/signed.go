@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+// MaxSignedURLTTL is the longest validity of a signed URL, the limit of GCS V4 signing.
+const MaxSignedURLTTL = 7 * 24 * time.Hour
+
+// SignedURLOptions describes the single request a signed URL authorises. Uploads (http.MethodPut) must send
+// ContentType as their Content-Type header; downloads (http.MethodGet) are served with it. The URL is valid until
+// Expires or, when Expires is zero, for TTL from signing.
+type SignedURLOptions struct {
+	Method      string
+	ContentType string
+	Expires     time.Time
+	TTL         time.Duration
+}
+
+// expiry resolves when a URL signed at now expires.
+func (o SignedURLOptions) expiry(now time.Time) (time.Time, error) {
+	switch o.Method {
+	case http.MethodGet, http.MethodPut:
+	default:
+		return time.Time{}, fmt.Errorf("unsupported signed url method %q", o.Method)
+	}
+	expires := o.Expires
+	if expires.IsZero() {
+		if o.TTL <= 0 {
+			return time.Time{}, fmt.Errorf("signed url expiry or ttl is required")
+		}
+		expires = now.Add(o.TTL)
+	}
+	if !expires.After(now) {
+		return time.Time{}, fmt.Errorf("signed url expiry %s is in the past", expires.Format(time.RFC3339))
+	}
+	if expires.Sub(now) > MaxSignedURLTTL {
+		return time.Time{}, fmt.Errorf("signed url validity must not exceed %s", MaxSignedURLTTL)
+	}
+	return expires, nil
+}
+
+// SignURL signs a method request on the object at fullPath valid for ttl, and returns the URL with its expiry.
+func SignURL(ctx context.Context, objects SignedStore, method, fullPath string, ttl time.Duration) (string, time.Time, error) {
+	expires, err := SignedURLOptions{Method: method, TTL: ttl}.expiry(time.Now())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	signed, err := objects.SignedURL(ctx, fullPath, SignedURLOptions{Method: method, Expires: expires})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expires, nil
+}
+
+// SignedStore lets clients upload and download objects directly through time-limited URLs, so file bytes never pass
+// through the API, and removes objects by their full path.
+type SignedStore interface {
+	// SignedURL returns a URL allowing one opts.Method request on the object at fullPath until opts.Expires.
+	SignedURL(ctx context.Context, fullPath string, opts SignedURLOptions) (string, error)
+	// Delete removes the object at fullPath. Removing a missing object is not an error.
+	Delete(ctx context.Context, fullPath string) error
+}
+
+// SignedURL returns a V4 signed URL for the object. The client's credentials must be able to sign, either with a
+// service account key or through the IAM signBlob permission.
+func (s *GCSObjectStore) SignedURL(_ context.Context, fullPath string, opts SignedURLOptions) (string, error) {
+	if fullPath == "" {
+		return "", fmt.Errorf("object path is required")
+	}
+	expires, err := opts.expiry(time.Now())
+	if err != nil {
+		return "", err
+	}
+	signed, err := s.client.Bucket(s.bucket).SignedURL(fullPath, &storage.SignedURLOptions{
+		Scheme:      storage.SigningSchemeV4,
+		Method:      opts.Method,
+		ContentType: opts.ContentType,
+		Expires:     expires,
+	})
+	if err != nil {
+		return "", fmt.Errorf("sign url for %s: %w", fullPath, err)
+	}
+	return signed, nil
+}
+
+func (s *GCSObjectStore) Delete(ctx context.Context, fullPath string) error {
+	if fullPath == "" {
+		return fmt.Errorf("object path is required")
+	}
+	if err := s.client.Bucket(s.bucket).Object(fullPath).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("delete object %s: %w", fullPath, err)
+	}
+	return nil
+}
+
+// LocalSignedStore signs URLs for a LocalObjectStore and serves them: it is the http.Handler behind BaseURL.
+// Signatures are HMAC-SHA256 over the method, path, expiry and content type with Key.
+type LocalSignedStore struct {
+	objects  *LocalObjectStore
+	baseURL  string
+	key      []byte
+	maxBytes int64
+	now      func() time.Time
+}
+
+// NewLocalSignedStore constructs a signed store serving objects below baseURL, the public URL the store is mounted
+// at. Uploads larger than maxBytes are rejected; zero disables the limit.
+func NewLocalSignedStore(objects *LocalObjectStore, baseURL string, key []byte, maxBytes int64) *LocalSignedStore {
+	if objects == nil {
+		panic("local object store is required")
+	}
+	if strings.TrimSpace(baseURL) == "" {
+		panic("base url is required")
+	}
+	if len(key) == 0 {
+		panic("signing key is required")
+	}
+	return &LocalSignedStore{objects: objects, baseURL: strings.TrimSuffix(baseURL, "/"), key: key, maxBytes: maxBytes, now: time.Now}
+}
+
+func (s *LocalSignedStore) SignedURL(_ context.Context, fullPath string, opts SignedURLOptions) (string, error) {
+	if _, err := s.objects.resolve(fullPath); err != nil {
+		return "", err
+	}
+	expiresAt, err := opts.expiry(s.now())
+	if err != nil {
+		return "", err
+	}
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	query := url.Values{
+		"method":      {opts.Method},
+		"expires":     {expires},
+		"contentType": {opts.ContentType},
+		"signature":   {s.sign(opts.Method, fullPath, expires, opts.ContentType)},
+	}
+	return s.baseURL + "/" + (&url.URL{Path: fullPath}).EscapedPath() + "?" + query.Encode(), nil
+}
+
+func (s *LocalSignedStore) Delete(_ context.Context, fullPath string) error {
+	filePath, err := s.objects.resolve(fullPath)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete object %s: %w", fullPath, err)
+	}
+	return nil
+}
+
+// ServeHTTP serves the signed requests issued by SignedURL. The handler expects the request path relative to the
+// mount point, e.g. behind http.StripPrefix.
+func (s *LocalSignedStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fullPath := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+	method, expires, contentType := query.Get("method"), query.Get("expires"), query.Get("contentType")
+
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || method != r.Method || !hmac.Equal([]byte(query.Get("signature")), []byte(s.sign(method, fullPath, expires, contentType))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if s.now().Unix() > expiresAt {
+		http.Error(w, "signed url expired", http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("Content-Type") != contentType {
+			http.Error(w, "content type does not match the signed url", http.StatusBadRequest)
+			return
+		}
+		s.upload(w, r, fullPath)
+	case http.MethodGet:
+		s.download(w, r, fullPath, contentType)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *LocalSignedStore) upload(w http.ResponseWriter, r *http.Request, fullPath string) {
+	body := io.Reader(r.Body)
+	if s.maxBytes > 0 {
+		body = http.MaxBytesReader(w, r.Body, s.maxBytes)
+	}
+
+	// Cancelling the writer context discards the object, so a failed upload never leaves a truncated file.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	out, err := s.objects.NewWriter(ctx, fullPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := io.Copy(out, body); err != nil {
+		cancel()
+		_ = out.Close()
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "object too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "upload failed", http.StatusBadRequest)
+		return
+	}
+	if err := out.Close(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *LocalSignedStore) download(w http.ResponseWriter, r *http.Request, fullPath, contentType string) {
+	in, err := s.objects.NewReader(r.Context(), fullPath)
+	if err != nil {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	}
+	defer in.Close()
+
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	_, _ = io.Copy(w, in)
+}
+
+func (s *LocalSignedStore) sign(method, fullPath, expires, contentType string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(method + "\n" + fullPath + "\n" + expires + "\n" + contentType))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var (
+	_ SignedStore = (*GCSObjectStore)(nil)
+	_ SignedStore = (*LocalSignedStore)(nil)
+)
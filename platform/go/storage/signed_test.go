@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSignedStoreRoundTrip(t *testing.T) {
+	store := NewLocalSignedStore(NewLocalObjectStore(t.TempDir()), "http://localhost:3000/storage/local/", []byte("key"), 16)
+	server := httptest.NewServer(http.StripPrefix("/storage/local", store))
+	t.Cleanup(server.Close)
+	store.baseURL = server.URL + "/storage/local"
+
+	ctx := context.Background()
+	const path = "dev/acme-co-12345678/entities/cards_entities/card-1/a1/1.0.0/photo one.png"
+	expires := time.Now().Add(time.Minute)
+
+	uploadURL, err := store.SignedURL(ctx, path, SignedURLOptions{Method: http.MethodPut, ContentType: "image/png", Expires: expires})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(uploadURL, server.URL+"/storage/local/dev/acme-co-12345678/"), uploadURL)
+
+	put := func(url, contentType, body string) int {
+		req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusBadRequest, put(uploadURL, "image/jpeg", "png"))
+	require.Equal(t, http.StatusRequestEntityTooLarge, put(uploadURL, "image/png", strings.Repeat("x", 17)))
+	require.Equal(t, http.StatusOK, put(uploadURL, "image/png", "png"))
+
+	// An upload URL does not allow downloads, and a tampered signature is rejected.
+	resp, err := http.Get(uploadURL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	downloadURL, err := store.SignedURL(ctx, path, SignedURLOptions{Method: http.MethodGet, ContentType: "image/png", Expires: expires})
+	require.NoError(t, err)
+	resp, err = http.Get(strings.Replace(downloadURL, "signature=", "signature=0", 1))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, err = http.Get(downloadURL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	resp.Body.Close()
+
+	store.now = func() time.Time { return expires.Add(time.Second) }
+	resp, err = http.Get(downloadURL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	require.NoError(t, store.Delete(ctx, path))
+	require.NoError(t, store.Delete(ctx, path))
+	_, err = store.objects.NewReader(ctx, path)
+	require.Error(t, err)
+}
+
+func TestSignedURLOptionsExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	expires, err := SignedURLOptions{Method: http.MethodGet, TTL: time.Hour}.expiry(now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), expires)
+
+	expires, err = SignedURLOptions{Method: http.MethodPut, Expires: now.Add(time.Minute), TTL: time.Hour}.expiry(now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Minute), expires)
+
+	for name, opts := range map[string]SignedURLOptions{
+		"no expiry":    {Method: http.MethodGet},
+		"past":         {Method: http.MethodGet, Expires: now.Add(-time.Second)},
+		"too long":     {Method: http.MethodGet, TTL: MaxSignedURLTTL + time.Second},
+		"bad method":   {Method: http.MethodDelete, TTL: time.Hour},
+		"empty method": {TTL: time.Hour},
+	} {
+		_, err := opts.expiry(now)
+		require.Error(t, err, name)
+	}
+}
+
+func TestSignURL(t *testing.T) {
+	store := NewLocalSignedStore(NewLocalObjectStore(t.TempDir()), "http://localhost:3000/storage/local", []byte("key"), 0)
+
+	signed, expires, err := SignURL(context.Background(), store, http.MethodGet, "dev/acme-co-12345678/exports/acme.tar.gz", time.Hour)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Hour), expires, time.Minute)
+	require.Contains(t, signed, "expires="+strconv.FormatInt(expires.Unix(), 10))
+}
@@ -23,21 +23,29 @@ func ResolveObjectLocation(space tenant.Space, bucket string, logicalKey string)
 	if bucket == "" {
 		return ObjectLocation{}, fmt.Errorf("bucket is required")
 	}
+	fullPath, err := ResolveObjectPath(space, logicalKey)
+	if err != nil {
+		return ObjectLocation{}, err
+	}
+	return ObjectLocation{Bucket: bucket, FullPath: fullPath}, nil
+}
+
+// ResolveObjectPath prefixes a tenant-relative logical key with the tenant base prefix.
+func ResolveObjectPath(space tenant.Space, logicalKey string) (string, error) {
 	key := strings.TrimSpace(logicalKey)
 	key = strings.TrimPrefix(key, "/")
 	if key == "" {
-		return ObjectLocation{}, fmt.Errorf("logical key is required")
+		return "", fmt.Errorf("logical key is required")
 	}
 
 	prefix := space.BasePrefix
 	if prefix == "" {
-		return ObjectLocation{}, fmt.Errorf("tenant base prefix is missing")
+		return "", fmt.Errorf("tenant base prefix is missing")
 	}
 
 	if !strings.HasSuffix(prefix, "/") {
 		prefix += "/"
 	}
 
-	fullPath := prefix + key
-	return ObjectLocation{Bucket: bucket, FullPath: fullPath}, nil
+	return prefix + key, nil
 }
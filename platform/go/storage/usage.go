@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// PrefixUsage is the number and total size of the objects stored under a prefix.
+type PrefixUsage struct {
+	Objects int64
+	Bytes   int64
+}
+
+// PrefixMeter measures the objects stored under a prefix.
+type PrefixMeter interface {
+	// MeasurePrefix lists every object below prefix. A prefix without objects measures zero.
+	MeasurePrefix(ctx context.Context, prefix string) (PrefixUsage, error)
+}
+
+func (s *GCSObjectStore) MeasurePrefix(ctx context.Context, prefix string) (PrefixUsage, error) {
+	if strings.TrimSpace(prefix) == "" {
+		return PrefixUsage{}, fmt.Errorf("prefix is required")
+	}
+
+	query := &storage.Query{Prefix: prefix}
+	if err := query.SetAttrSelection([]string{"Size"}); err != nil {
+		return PrefixUsage{}, fmt.Errorf("select object attributes: %w", err)
+	}
+
+	var usage PrefixUsage
+	objects := s.client.Bucket(s.bucket).Objects(ctx, query)
+	for {
+		attrs, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			return usage, nil
+		}
+		if err != nil {
+			return PrefixUsage{}, fmt.Errorf("list objects under %s: %w", prefix, err)
+		}
+		usage.Objects++
+		usage.Bytes += attrs.Size
+	}
+}
+
+// MeasurePrefix walks the directory of prefix. Uploads still being written are not counted.
+func (s *LocalObjectStore) MeasurePrefix(ctx context.Context, prefix string) (PrefixUsage, error) {
+	dir, err := s.resolve(prefix)
+	if err != nil {
+		return PrefixUsage{}, err
+	}
+
+	var usage PrefixUsage
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			if path == dir && errors.Is(walkErr, os.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return walkErr
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() || strings.HasSuffix(entry.Name(), ".partial") {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		usage.Objects++
+		usage.Bytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return PrefixUsage{}, fmt.Errorf("measure %s: %w", prefix, err)
+	}
+	return usage, nil
+}
+
+var (
+	_ PrefixMeter = (*GCSObjectStore)(nil)
+	_ PrefixMeter = (*LocalObjectStore)(nil)
+)
@@ -17,6 +17,12 @@ type Space struct {
 	SchemaName    string
 	BasePrefix    string
 	RoleName      string
+	Quotas        Quotas
+	Email         EmailSettings
+	// Maintenance is set while the tenant is in maintenance; only admins are let through.
+	Maintenance bool
+	// Suspended is set while the tenant is suspended; it may read but not write.
+	Suspended bool
 }
 
 type ctxKey string
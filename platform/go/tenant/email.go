@@ -0,0 +1,11 @@
+package tenant
+
+// EmailSettings are the tenant's outgoing email preferences, stored with the tenant record. Nil sender fields fall
+// back to the platform sender; without NotifyAddress no tenant notifications (quota alerts, provisioning outcomes)
+// are emailed.
+type EmailSettings struct {
+	FromName      *string `json:"fromName,omitempty"`
+	FromAddress   *string `json:"fromAddress,omitempty"`
+	ReplyTo       *string `json:"replyTo,omitempty"`
+	NotifyAddress *string `json:"notifyAddress,omitempty"`
+}
@@ -0,0 +1,26 @@
+package tenant
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrNoMembership indicates the identity is not a member of the tenant.
+var ErrNoMembership = errors.New("no tenant membership")
+
+// Membership links a platform identity to a tenant it may switch into. Identity Platform users are scoped to one
+// auth tenant, so the identity shared across tenants is the verified email; UserID is the member's users row in the
+// tenant schema and Roles are the tenant roles granted there.
+type Membership struct {
+	Email    string
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+	Roles    []string
+}
+
+// NormalizeEmail returns the form emails are stored and compared in.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
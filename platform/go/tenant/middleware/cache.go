@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+// SpaceCache holds tenant spaces resolved by WithTenantSpace. Invalidate drops one tenant after it changes and
+// Purge drops every entry when changes may have been missed, such as after a lost notification connection.
+type SpaceCache interface {
+	Get(id uuid.UUID) (tenant.Space, bool)
+	Put(space tenant.Space)
+	Invalidate(id uuid.UUID)
+	Purge()
+}
+
+// TTLCache is an in-memory SpaceCache whose entries expire after a fixed TTL, bounding staleness when no
+// invalidation arrives.
+type TTLCache struct {
+	ttl   time.Duration
+	mu    sync.RWMutex
+	items map[uuid.UUID]cacheItem
+}
+
+type cacheItem struct {
+	space     tenant.Space
+	expiresAt time.Time
+}
+
+// NewTTLCache returns an empty cache keeping entries for ttl.
+func NewTTLCache(ttl time.Duration) *TTLCache {
+	return &TTLCache{ttl: ttl, items: make(map[uuid.UUID]cacheItem)}
+}
+
+// Get returns the cached space of the tenant unless it is missing or expired.
+func (c *TTLCache) Get(id uuid.UUID) (tenant.Space, bool) {
+	c.mu.RLock()
+	item, ok := c.items[id]
+	c.mu.RUnlock()
+	if !ok || time.Now().After(item.expiresAt) {
+		if ok {
+			c.mu.Lock()
+			delete(c.items, id)
+			c.mu.Unlock()
+		}
+		return tenant.Space{}, false
+	}
+	return item.space, true
+}
+
+// Put caches space for the TTL.
+func (c *TTLCache) Put(space tenant.Space) {
+	c.mu.Lock()
+	c.items[space.TenantID] = cacheItem{space: space, expiresAt: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+}
+
+// Invalidate drops the tenant's entry.
+func (c *TTLCache) Invalidate(id uuid.UUID) {
+	c.mu.Lock()
+	delete(c.items, id)
+	c.mu.Unlock()
+}
+
+// Purge drops every entry.
+func (c *TTLCache) Purge() {
+	c.mu.Lock()
+	c.items = make(map[uuid.UUID]cacheItem)
+	c.mu.Unlock()
+}
+
+func cacheGet(c SpaceCache, id uuid.UUID) *tenant.Space {
+	if c == nil {
+		return nil
+	}
+	space, ok := c.Get(id)
+	if !ok {
+		return nil
+	}
+	return &space
+}
+
+func cachePut(c SpaceCache, space tenant.Space) {
+	if c == nil {
+		return
+	}
+	c.Put(space)
+}
@@ -2,18 +2,21 @@ package middleware
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
-	problems "github.com/zenGate-Global/palmyra-pro-saas/generated/go/common/problemdetails"
 	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/problems"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
 	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
 )
 
@@ -27,12 +30,39 @@ type Resolver interface {
 // Config controls middleware behavior.
 type Config struct {
 	EnvKey string
-	// Optional small in-memory TTL cache to avoid DB hits; zero disables caching.
+	// Optional small in-memory TTL cache to avoid DB hits; zero disables caching. Ignored when Cache is set.
 	CacheTTL time.Duration
+	// Cache, when set, holds resolved spaces instead of a private TTL cache, so whoever learns of tenant
+	// changes can invalidate them (see SpaceCache).
+	Cache SpaceCache
+	// MaintenanceRetryAfter is advertised in Retry-After when a tenant in maintenance rejects a request.
+	// Defaults to DefaultMaintenanceRetryAfter.
+	MaintenanceRetryAfter time.Duration
+	// Memberships enables SwitchTenantHeader; nil rejects tenant switching.
+	Memberships MembershipResolver
 }
 
+// MembershipResolver looks up the membership of a verified email in a tenant, returning tenant.ErrNoMembership when
+// there is none. Implemented by persistence.MembershipStore.
+type MembershipResolver interface {
+	ResolveMembership(ctx context.Context, email string, tenantID uuid.UUID) (tenant.Membership, error)
+}
+
+// DefaultMaintenanceRetryAfter is used when Config.MaintenanceRetryAfter is zero.
+const DefaultMaintenanceRetryAfter = 2 * time.Minute
+
+// ImpersonateTenantHeader lets an admin run a tenant-scoped request as another tenant, named by id or slug.
+const ImpersonateTenantHeader = "X-Palmyra-Impersonate-Tenant"
+
+// SwitchTenantHeader lets a user run a request in another tenant they are a member of, named by id or slug.
+const SwitchTenantHeader = "X-Palmyra-Tenant"
+
 // WithTenantSpace resolves tenant from JWT claims and attaches tenant.Space to context.
-// It enforces that the tenant claim is present and that the resolved space matches the current envKey.
+// It enforces that the tenant claim is present and that the resolved space matches the current envKey. Requests to a
+// tenant in maintenance are rejected with 503 and Retry-After unless the caller is an admin. Admins may send
+// ImpersonateTenantHeader to resolve that tenant instead of their own; see impersonate for the audit trail. Any
+// caller with a verified email may send SwitchTenantHeader to use another tenant they are a member of; see
+// switchTenant.
 func WithTenantSpace(resolver Resolver, cfg Config) func(http.Handler) http.Handler {
 	if resolver == nil {
 		panic("tenant middleware: resolver is required")
@@ -41,120 +71,187 @@ func WithTenantSpace(resolver Resolver, cfg Config) func(http.Handler) http.Hand
 		panic("tenant middleware: envKey is required")
 	}
 
-	var cache *tenantCache
-	if cfg.CacheTTL > 0 {
-		cache = newTenantCache(cfg.CacheTTL)
+	cache := cfg.Cache
+	if cache == nil && cfg.CacheTTL > 0 {
+		cache = NewTTLCache(cfg.CacheTTL)
+	}
+	retryAfter := cfg.MaintenanceRetryAfter
+	if retryAfter <= 0 {
+		retryAfter = DefaultMaintenanceRetryAfter
+	}
+	retryAfterSeconds := strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+
+	serve := func(w http.ResponseWriter, r *http.Request, next http.Handler, creds *platformauth.UserCredentials, space tenant.Space, impersonating, switching bool) {
+		ctx := tenant.WithSpace(r.Context(), space)
+		if impersonating {
+			ctx = impersonate(ctx, r, creds, space)
+		}
+		if switching && space.TenantID.String() != *creds.TenantID {
+			var status int
+			ctx, creds, status = switchTenant(ctx, r, cfg.Memberships, creds, space)
+			if status != 0 {
+				detail := "not a member of tenant"
+				if status == http.StatusInternalServerError {
+					detail = "membership lookup failed"
+				}
+				problems.Write(w, problems.New(status, http.StatusText(status), problems.TypeAuth, detail))
+				return
+			}
+		}
+		// Every later log line of the request names the tenant it is served in.
+		if logger, ok := platformlogging.FromContext(ctx); ok {
+			logger = logger.With(zap.String("tenant_id", space.TenantID.String()), zap.String("tenant_slug", space.Slug))
+			ctx = platformlogging.WithLogger(ctx, logger)
+		}
+		if space.Maintenance && !creds.IsAdmin {
+			w.Header().Set("Retry-After", retryAfterSeconds)
+			problems.Write(w, problems.New(http.StatusServiceUnavailable, "Service Unavailable", problems.TypeMaintenance, "tenant under maintenance"))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			creds, ok := platformauth.UserFromContext(r.Context())
 			if !ok || creds == nil || creds.TenantID == nil || *creds.TenantID == "" {
-				writeProblem(w, http.StatusUnauthorized, "Unauthorized", "tenant required", problemTypeAuth)
+				problems.Write(w, problems.New(http.StatusUnauthorized, "Unauthorized", problems.TypeAuth, "tenant required"))
 				return
 			}
 
+			tenantKey := *creds.TenantID
+			target := strings.TrimSpace(r.Header.Get(ImpersonateTenantHeader))
+			switchTarget := strings.TrimSpace(r.Header.Get(SwitchTenantHeader))
+			impersonating := target != ""
+			switching := switchTarget != ""
+			switch {
+			case impersonating && switching:
+				problems.Write(w, problems.New(http.StatusBadRequest, "Bad Request", problems.TypeAuth, "tenant impersonation and switching are exclusive"))
+				return
+			case impersonating:
+				if !creds.IsAdmin {
+					problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeAuth, "tenant impersonation requires admin"))
+					return
+				}
+			case switching:
+				if cfg.Memberships == nil {
+					problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeAuth, "tenant switching is disabled"))
+					return
+				}
+				target = switchTarget
+			}
+			if target != "" {
+				tenantKey = target
+				if _, parseErr := uuid.Parse(target); parseErr != nil {
+					tenantKey = cfg.EnvKey + "-" + target
+				}
+			}
+
 			var (
 				space tenant.Space
 				err   error
 			)
 
-			if tid, parseErr := uuid.Parse(*creds.TenantID); parseErr == nil {
+			if tid, parseErr := uuid.Parse(tenantKey); parseErr == nil {
 				if cached := cacheGet(cache, tid); cached != nil {
-					ctx := tenant.WithSpace(r.Context(), *cached)
-					next.ServeHTTP(w, r.WithContext(ctx))
+					serve(w, r, next, creds, *cached, impersonating, switching)
 					return
 				}
 				space, err = resolver.ResolveTenantSpace(r.Context(), tid)
 			} else {
-				space, err = resolver.ResolveTenantSpaceByExternal(r.Context(), *creds.TenantID)
+				space, err = resolver.ResolveTenantSpaceByExternal(r.Context(), tenantKey)
 			}
 
 			if err != nil {
 				switch {
 				case errors.Is(err, service.ErrEnvMismatch):
-					writeProblem(w, http.StatusForbidden, "Forbidden", "tenant env mismatch", problemTypeAuth)
+					problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeAuth, "tenant env mismatch"))
 				case errors.Is(err, service.ErrDisabled):
-					writeProblem(w, http.StatusForbidden, "Forbidden", "tenant disabled", problemTypeAuth)
+					problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeAuth, "tenant disabled"))
 				case errors.Is(err, service.ErrNotFound):
-					writeProblem(w, http.StatusForbidden, "Forbidden", "tenant unknown", problemTypeAuth)
+					problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeAuth, "tenant unknown"))
 				default:
-					writeProblem(w, http.StatusUnauthorized, "Unauthorized", "invalid tenant", problemTypeAuth)
+					problems.Write(w, problems.New(http.StatusUnauthorized, "Unauthorized", problems.TypeAuth, "invalid tenant"))
 				}
 				return
 			}
 			if cached := cacheGet(cache, space.TenantID); cached != nil {
-				ctx := tenant.WithSpace(r.Context(), *cached)
-				next.ServeHTTP(w, r.WithContext(ctx))
+				serve(w, r, next, creds, *cached, impersonating, switching)
 				return
 			}
 
 			prefix := cfg.EnvKey + "/"
 			if len(space.BasePrefix) < len(prefix) || !strings.HasPrefix(space.BasePrefix, prefix) {
-				writeProblem(w, http.StatusForbidden, "Forbidden", "tenant env mismatch", problemTypeAuth)
+				problems.Write(w, problems.New(http.StatusForbidden, "Forbidden", problems.TypeAuth, "tenant env mismatch"))
 				return
 			}
 
 			cachePut(cache, space)
-
-			ctx := tenant.WithSpace(r.Context(), space)
-			next.ServeHTTP(w, r.WithContext(ctx))
+			serve(w, r, next, creds, space, impersonating, switching)
 		})
 	}
 }
 
-type tenantCache struct {
-	ttl   time.Duration
-	mu    sync.RWMutex
-	items map[uuid.UUID]cacheItem
-}
+// impersonate records that an admin is acting as space. The audit info keeps the admin as the actor and moves their
+// own tenant to ImpersonatorTenantID, the request logger gains both tenants, and every impersonated request is
+// logged, so stamped rows and logs always point back to the real actor.
+func impersonate(ctx context.Context, r *http.Request, creds *platformauth.UserCredentials, space tenant.Space) context.Context {
+	audit, ok := requesttrace.FromContext(ctx)
+	if !ok {
+		var err error
+		if audit, err = requesttrace.FromCredentials(creds, ""); err != nil {
+			audit = requesttrace.Anonymous("")
+		}
+	}
+	audit = audit.AsTenant(space.TenantID.String())
+	ctx = requesttrace.IntoContext(ctx, audit)
 
-type cacheItem struct {
-	space     tenant.Space
-	expiresAt time.Time
+	if logger := platformlogging.FromRequest(r, nil); logger != nil {
+		fields := []zap.Field{zap.String("impersonated_tenant_id", space.TenantID.String())}
+		if audit.ImpersonatorTenantID != nil {
+			fields = append(fields, zap.String("impersonator_tenant_id", *audit.ImpersonatorTenantID))
+		}
+		logger = logger.With(fields...)
+		logger.Info("tenant impersonation", zap.String("impersonator_user_id", creds.Id), zap.String("tenant_slug", space.Slug))
+		ctx = platformlogging.WithLogger(ctx, logger)
+	}
+	return ctx
 }
 
-func newTenantCache(ttl time.Duration) *tenantCache {
-	return &tenantCache{ttl: ttl, items: make(map[uuid.UUID]cacheItem)}
-}
+// switchTenant narrows the caller to their membership in space: the credentials carry the member's users row id,
+// the target tenant and the membership roles, and the audit info is rebuilt from them, so permission checks and
+// stamped rows use the member user of that tenant. A non-zero status means the switch is refused.
+func switchTenant(ctx context.Context, r *http.Request, memberships MembershipResolver, creds *platformauth.UserCredentials, space tenant.Space) (context.Context, *platformauth.UserCredentials, int) {
+	if !creds.EmailVerified || creds.Email == "" {
+		return ctx, creds, http.StatusForbidden
+	}
 
-func cacheGet(c *tenantCache, id uuid.UUID) *tenant.Space {
-	if c == nil {
-		return nil
+	membership, err := memberships.ResolveMembership(ctx, creds.Email, space.TenantID)
+	if errors.Is(err, tenant.ErrNoMembership) {
+		return ctx, creds, http.StatusForbidden
 	}
-	c.mu.RLock()
-	item, ok := c.items[id]
-	c.mu.RUnlock()
-	if !ok || time.Now().After(item.expiresAt) {
-		if ok {
-			c.mu.Lock()
-			delete(c.items, id)
-			c.mu.Unlock()
-		}
-		return nil
+	if err != nil {
+		return ctx, creds, http.StatusInternalServerError
 	}
-	return &item.space
-}
 
-func cachePut(c *tenantCache, space tenant.Space) {
-	if c == nil {
-		return
-	}
-	c.mu.Lock()
-	c.items[space.TenantID] = cacheItem{space: space, expiresAt: time.Now().Add(c.ttl)}
-	c.mu.Unlock()
-}
+	member := *creds
+	member.Id = membership.UserID.String()
+	tenantID := space.TenantID.String()
+	member.TenantID = &tenantID
+	member.TenantRoles = membership.Roles
+	ctx = platformauth.WithUserCredentials(ctx, &member)
 
-const problemTypeAuth = "https://palmyra.pro/problems/auth"
+	requestID := ""
+	if audit, ok := requesttrace.FromContext(ctx); ok {
+		requestID = audit.RequestID
+	}
+	if audit, err := requesttrace.FromCredentials(&member, requestID); err == nil {
+		ctx = requesttrace.IntoContext(ctx, audit)
+	}
 
-func writeProblem(w http.ResponseWriter, status int, title, detail, problemType string) {
-	p := problems.ProblemDetails{
-		Title:  title,
-		Status: status,
-		Type:   &problemType,
-		Detail: &detail,
+	if logger := platformlogging.FromRequest(r, nil); logger != nil {
+		logger = logger.With(zap.String("switched_tenant_id", tenantID), zap.String("member_user_id", member.Id))
+		ctx = platformlogging.WithLogger(ctx, logger)
 	}
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(p)
+	return ctx, &member, 0
 }
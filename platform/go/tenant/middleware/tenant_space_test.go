@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/zenGate-Global/palmyra-pro-saas/domains/tenants/be/service"
+	platformauth "github.com/zenGate-Global/palmyra-pro-saas/platform/go/auth"
+	platformlogging "github.com/zenGate-Global/palmyra-pro-saas/platform/go/logging"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/requesttrace"
+	"github.com/zenGate-Global/palmyra-pro-saas/platform/go/tenant"
+)
+
+type stubResolver map[uuid.UUID]tenant.Space
+
+func (s stubResolver) ResolveTenantSpace(_ context.Context, id uuid.UUID) (tenant.Space, error) {
+	space, ok := s[id]
+	if !ok {
+		return tenant.Space{}, service.ErrNotFound
+	}
+	return space, nil
+}
+
+func (s stubResolver) ResolveTenantSpaceByExternal(_ context.Context, external string) (tenant.Space, error) {
+	for _, space := range s {
+		if "dev-"+space.Slug == external {
+			return space, nil
+		}
+	}
+	return tenant.Space{}, service.ErrNotFound
+}
+
+type stubMemberships []tenant.Membership
+
+func (s stubMemberships) ResolveMembership(_ context.Context, email string, tenantID uuid.UUID) (tenant.Membership, error) {
+	for _, m := range s {
+		if m.Email == tenant.NormalizeEmail(email) && m.TenantID == tenantID {
+			return m, nil
+		}
+	}
+	return tenant.Membership{}, tenant.ErrNoMembership
+}
+
+func TestWithTenantSpaceSwitchesToMemberTenant(t *testing.T) {
+	t.Parallel()
+
+	home := tenant.Space{TenantID: uuid.New(), Slug: "home", BasePrefix: "dev/home-1/"}
+	other := tenant.Space{TenantID: uuid.New(), Slug: "other", BasePrefix: "dev/other-1/"}
+	stranger := tenant.Space{TenantID: uuid.New(), Slug: "stranger", BasePrefix: "dev/stranger-1/"}
+	memberID := uuid.New()
+
+	resolver := stubResolver{home.TenantID: home, other.TenantID: other, stranger.TenantID: stranger}
+	memberships := stubMemberships{{Email: "ann@example.com", TenantID: other.TenantID, UserID: memberID, Roles: []string{"editor"}}}
+
+	var (
+		gotSpace tenant.Space
+		gotCreds *platformauth.UserCredentials
+		gotAudit requesttrace.AuditInfo
+	)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSpace, _ = tenant.FromContext(r.Context())
+		gotCreds, _ = platformauth.UserFromContext(r.Context())
+		gotAudit, _ = requesttrace.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve := func(cfg Config, header string, verified bool) int {
+		homeID := home.TenantID.String()
+		creds := &platformauth.UserCredentials{Id: "uid-1", Email: "Ann@example.com", EmailVerified: verified, TenantID: &homeID, TenantRoles: []string{"admin"}}
+		audit, err := requesttrace.FromCredentials(creds, "req-1")
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+		if header != "" {
+			req.Header.Set(SwitchTenantHeader, header)
+		}
+		ctx := platformauth.WithUserCredentials(req.Context(), creds)
+		ctx = requesttrace.IntoContext(ctx, audit)
+		rec := httptest.NewRecorder()
+		WithTenantSpace(resolver, cfg)(next).ServeHTTP(rec, req.WithContext(ctx))
+		return rec.Code
+	}
+	cfg := Config{EnvKey: "dev", Memberships: memberships}
+
+	require.Equal(t, http.StatusOK, serve(cfg, "other", true))
+	require.Equal(t, other.TenantID, gotSpace.TenantID)
+	require.Equal(t, memberID.String(), gotCreds.Id)
+	require.Equal(t, other.TenantID.String(), *gotCreds.TenantID)
+	require.Equal(t, []string{"editor"}, gotCreds.TenantRoles)
+	require.Equal(t, memberID.String(), *gotAudit.UserID)
+	require.Equal(t, other.TenantID.String(), *gotAudit.TenantID)
+	require.Equal(t, "req-1", gotAudit.RequestID)
+
+	// Naming the home tenant keeps the token identity.
+	require.Equal(t, http.StatusOK, serve(cfg, home.TenantID.String(), true))
+	require.Equal(t, "uid-1", gotCreds.Id)
+
+	require.Equal(t, http.StatusForbidden, serve(cfg, "stranger", true))
+	require.Equal(t, http.StatusForbidden, serve(cfg, "other", false))
+	require.Equal(t, http.StatusForbidden, serve(Config{EnvKey: "dev"}, "other", true))
+}
+
+func TestWithTenantSpaceAddsTenantToLogger(t *testing.T) {
+	t.Parallel()
+
+	space := tenant.Space{TenantID: uuid.New(), Slug: "acme", BasePrefix: "dev/acme-1/"}
+	core, entries := observer.New(zapcore.InfoLevel)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		platformlogging.FromContextOr(r.Context(), nil).Info("handled")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tenantID := space.TenantID.String()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+	ctx := platformauth.WithUserCredentials(req.Context(), &platformauth.UserCredentials{Id: "uid-1", TenantID: &tenantID})
+	ctx = platformlogging.WithLogger(ctx, zap.New(core))
+	rec := httptest.NewRecorder()
+	WithTenantSpace(stubResolver{space.TenantID: space}, Config{EnvKey: "dev"})(next).ServeHTTP(rec, req.WithContext(ctx))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, entries.Len())
+	fields := entries.All()[0].ContextMap()
+	require.Equal(t, tenantID, fields["tenant_id"])
+	require.Equal(t, "acme", fields["tenant_slug"])
+}
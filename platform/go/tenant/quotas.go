@@ -0,0 +1,87 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// QuotaResource names a metered tenant resource.
+type QuotaResource string
+
+const (
+	QuotaEntities     QuotaResource = "entities"
+	QuotaSchemas      QuotaResource = "schemas"
+	QuotaStorageBytes QuotaResource = "storageBytes"
+	QuotaUsers        QuotaResource = "users"
+	QuotaObjectBytes  QuotaResource = "objectBytes"
+)
+
+// Quotas are the per-tenant limits stored with the tenant record. A nil limit means unlimited.
+type Quotas struct {
+	MaxEntities     *int64 `json:"maxEntities,omitempty"`
+	MaxSchemas      *int64 `json:"maxSchemas,omitempty"`
+	MaxStorageBytes *int64 `json:"maxStorageBytes,omitempty"`
+	MaxUsers        *int64 `json:"maxUsers,omitempty"`
+	MaxObjectBytes  *int64 `json:"maxObjectBytes,omitempty"`
+}
+
+// Limit returns the limit configured for resource, or nil when it is unlimited.
+func (q Quotas) Limit(resource QuotaResource) *int64 {
+	switch resource {
+	case QuotaEntities:
+		return q.MaxEntities
+	case QuotaSchemas:
+		return q.MaxSchemas
+	case QuotaStorageBytes:
+		return q.MaxStorageBytes
+	case QuotaUsers:
+		return q.MaxUsers
+	case QuotaObjectBytes:
+		return q.MaxObjectBytes
+	default:
+		return nil
+	}
+}
+
+// Usage is the measured consumption of a tenant. Schemas counts the schemas with an entity table in the tenant
+// schema; StorageBytes is the on-disk size of the tenant schema including indexes. Objects and ObjectBytes cover the
+// tenant's object storage prefix as of ObjectsMeasuredAt, which is nil until the prefix has been measured.
+type Usage struct {
+	Entities          int64
+	Schemas           int64
+	StorageBytes      int64
+	Users             int64
+	Objects           int64
+	ObjectBytes       int64
+	ObjectsMeasuredAt *time.Time
+}
+
+// QuotaExceededError is returned when a write would take a tenant over one of its quotas.
+type QuotaExceededError struct {
+	Resource QuotaResource
+	Limit    int64
+	Used     int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("tenant quota exceeded for %s: %d of %d used", e.Resource, e.Used, e.Limit)
+}
+
+// CheckLimit returns a *QuotaExceededError when adding n units to used goes over the limit of resource.
+func (q Quotas) CheckLimit(resource QuotaResource, used, n int64) error {
+	limit := q.Limit(resource)
+	if limit == nil || used+n <= *limit {
+		return nil
+	}
+	return &QuotaExceededError{Resource: resource, Limit: *limit, Used: used}
+}
+
+// QuotaChecker rejects writes that would take the tenant in the context over one of its quotas.
+type QuotaChecker interface {
+	// CheckQuota fails with *QuotaExceededError when adding n units of resource exceeds the quota.
+	CheckQuota(ctx context.Context, resource QuotaResource, n int64) error
+	// CheckTableQuota fails with *QuotaExceededError when writing to tableName makes the tenant use one schema
+	// more than allowed. Tables the tenant already has never fail.
+	CheckTableQuota(ctx context.Context, tableName string) error
+}
@@ -3,7 +3,8 @@
 //   go generate ./tools/codegen/openapi/go
 //
 // Generates code into /generated/go/<domain>/ following config files
-// stored under /tools/codegen/openapi/go/configs/.
+// stored under /tools/codegen/openapi/go/configs/ (servers and models) and
+// /tools/codegen/openapi/go/configs/clients/ (typed clients).
 
 package main
 
@@ -22,5 +23,32 @@ package main
 //go:generate go tool oapi-codegen -config ./configs/schema-repository.yaml ../../../../contracts/schema-repository.yaml
 //go:generate go tool oapi-codegen -config ./configs/entities.yaml           ../../../../contracts/entities.yaml
 //go:generate go tool oapi-codegen -config ./configs/tenants.yaml           ../../../../contracts/tenants.yaml
+//go:generate go tool oapi-codegen -config ./configs/webhooks.yaml          ../../../../contracts/webhooks.yaml
+//go:generate go tool oapi-codegen -config ./configs/attachments.yaml       ../../../../contracts/attachments.yaml
+//go:generate go tool oapi-codegen -config ./configs/privacy.yaml           ../../../../contracts/privacy.yaml
+//go:generate go tool oapi-codegen -config ./configs/graphql.yaml           ../../../../contracts/graphql.yaml
+//go:generate go tool oapi-codegen -config ./configs/jobs.yaml              ../../../../contracts/jobs.yaml
+//go:generate go tool oapi-codegen -config ./configs/scheduler.yaml         ../../../../contracts/scheduler.yaml
+//go:generate go tool oapi-codegen -config ./configs/features.yaml          ../../../../contracts/features.yaml
+//go:generate go tool oapi-codegen -config ./configs/stats.yaml             ../../../../contracts/stats.yaml
+//go:generate go tool oapi-codegen -config ./configs/logging.yaml           ../../../../contracts/logging.yaml
+
+// Clients: the same domains again with client: true, into client.gen.go beside the models (see platform/go/apiclient).
+
+//go:generate go tool oapi-codegen -config ./configs/clients/auth.yaml              ../../../../contracts/auth.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/users.yaml             ../../../../contracts/users.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/schema-categories.yaml ../../../../contracts/schema-categories.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/schema-repository.yaml ../../../../contracts/schema-repository.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/entities.yaml           ../../../../contracts/entities.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/tenants.yaml           ../../../../contracts/tenants.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/webhooks.yaml          ../../../../contracts/webhooks.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/attachments.yaml       ../../../../contracts/attachments.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/privacy.yaml           ../../../../contracts/privacy.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/graphql.yaml           ../../../../contracts/graphql.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/jobs.yaml              ../../../../contracts/jobs.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/scheduler.yaml         ../../../../contracts/scheduler.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/features.yaml          ../../../../contracts/features.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/stats.yaml             ../../../../contracts/stats.yaml
+//go:generate go tool oapi-codegen -config ./configs/clients/logging.yaml           ../../../../contracts/logging.yaml
 
 func main() {}
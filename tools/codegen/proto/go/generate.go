@@ -0,0 +1,14 @@
+// This file triggers Go code generation from the protobuf contracts.
+// Run manually with:
+//   go generate ./tools/codegen/proto/go
+//
+// Requires protoc plus protoc-gen-go (google.golang.org/protobuf v1.36.10) and
+// protoc-gen-go-grpc (google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.5.1) on PATH.
+// Generates code into /generated/go/proto/<package path>/ following each file's go_package.
+
+package main
+
+//go:generate protoc -I ../../../../contracts/proto --go_out=../../../.. --go_opt=module=github.com/zenGate-Global/palmyra-pro-saas --go-grpc_out=../../../.. --go-grpc_opt=module=github.com/zenGate-Global/palmyra-pro-saas palmyra/entities/v1/entities.proto
+//go:generate protoc -I ../../../../contracts/proto --go_out=../../../.. --go_opt=module=github.com/zenGate-Global/palmyra-pro-saas --go-grpc_out=../../../.. --go-grpc_opt=module=github.com/zenGate-Global/palmyra-pro-saas palmyra/schemarepository/v1/schema_repository.proto
+
+func main() {}